@@ -25,6 +25,7 @@ func NewCheckCommand(w *world.World) *cobra.Command {
 	}
 	cmdCheck.Flags().StringVar(&w.CheckRelease, "check-release", "SKIP", "Whether to fail on warning/error. Valid values: SKIP, FAIL_ON_WARNING, FAIL_ON_ERROR")
 	cmdCheck.Flags().StringVar(&w.CustomRules, "custom-rules", "", "Custom linting rules in natural language for AI-powered validation")
+	cmdCheck.Flags().BoolVar(&w.CheckFormat, "check-format", false, "Whether to report a warning for each release file that is not formatted")
 	return cmdCheck
 }
 
@@ -70,6 +71,7 @@ func runCheck(w *world.World) func(*cobra.Command, []string) error {
 			Release:     &v1pb.Release{Files: releaseFiles},
 			Targets:     w.Targets,
 			CustomRules: w.CustomRules,
+			CheckFormat: w.CheckFormat,
 		})
 		if err != nil {
 			return err
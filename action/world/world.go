@@ -36,6 +36,8 @@ type World struct {
 	CheckRelease string
 	// Custom linting rules in natural language for AI-powered validation.
 	CustomRules string
+	// Whether to report the release files which are not formatted.
+	CheckFormat bool
 
 	// bytebase-action rollout flags
 	ReleaseTitle string // The title of the release
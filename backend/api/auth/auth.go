@@ -90,13 +90,19 @@ func (in *APIAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFun
 		}
 		ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-		user, err := in.getUserConnect(ctx, accessTokenStr)
+		user, scope, err := in.getUserConnect(ctx, accessTokenStr)
 		if err != nil {
 			if IsAuthenticationAllowed(req.Spec().Procedure, authContext) {
 				return next(ctx, req)
 			}
 			return nil, err
 		}
+		if scope != nil {
+			if err := checkPersonalAccessTokenScope(scope, authContext); err != nil {
+				return nil, err
+			}
+			ctx = common.WithPersonalAccessTokenScope(ctx, scope)
+		}
 
 		ctx = context.WithValue(ctx, common.UserContextKey, user)
 		return next(ctx, req)
@@ -124,13 +130,19 @@ func (in *APIAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandler
 		}
 		ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-		user, err := in.getUserConnect(ctx, accessTokenStr)
+		user, scope, err := in.getUserConnect(ctx, accessTokenStr)
 		if err != nil {
 			if IsAuthenticationAllowed(conn.Spec().Procedure, authContext) {
 				return next(ctx, conn)
 			}
 			return err
 		}
+		if scope != nil {
+			if err := checkPersonalAccessTokenScope(scope, authContext); err != nil {
+				return err
+			}
+			ctx = common.WithPersonalAccessTokenScope(ctx, scope)
+		}
 
 		ctx = context.WithValue(ctx, common.UserContextKey, user)
 
@@ -144,7 +156,10 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 	if accessTokenStr == "" {
 		return nil, nil, errs.New("access token not found")
 	}
-	if _, ok := in.stateCfg.ExpireCache.Get(accessTokenStr); ok {
+	if isPersonalAccessToken(accessTokenStr) {
+		return in.authenticatePersonalAccessToken(ctx, accessTokenStr)
+	}
+	if in.stateCfg.IsAccessTokenExpired(accessTokenStr) {
 		return nil, nil, errs.New("access token expired")
 	}
 
@@ -193,24 +208,25 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 }
 
 // authenticateConnect is a ConnectRPC-specific version that returns ConnectRPC errors.
-func (in *APIAuthInterceptor) authenticateConnect(ctx context.Context, accessTokenStr string) (*store.UserMessage, error) {
-	user, _, err := in.authenticate(ctx, accessTokenStr)
+func (in *APIAuthInterceptor) authenticateConnect(ctx context.Context, accessTokenStr string) (*store.UserMessage, *claimsMessage, error) {
+	user, claims, err := in.authenticate(ctx, accessTokenStr)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		return nil, nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
-	return user, nil
+	return user, claims, nil
 }
 
 // getUserConnect is a ConnectRPC-specific version that returns ConnectRPC errors.
-func (in *APIAuthInterceptor) getUserConnect(ctx context.Context, accessTokenStr string) (*store.UserMessage, error) {
-	user, err := in.authenticateConnect(ctx, accessTokenStr)
+// The scope is not nil if the request is authenticated by a personal access token.
+func (in *APIAuthInterceptor) getUserConnect(ctx context.Context, accessTokenStr string) (*store.UserMessage, *common.PersonalAccessTokenScope, error) {
+	user, claims, err := in.authenticateConnect(ctx, accessTokenStr)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Only update for authorized request.
 	in.profile.LastActiveTS.Store(time.Now().Unix())
-	return user, nil
+	return user, claims.scope, nil
 }
 
 // GetUserIDFromMFATempToken returns the user ID from the MFA temp token.
@@ -247,6 +263,10 @@ func (in *APIAuthInterceptor) AuthenticateToken(ctx context.Context, accessToken
 	if err != nil {
 		return nil, time.Time{}, err
 	}
+	// The callers don't enforce the token scope, so only the unrestricted personal access tokens are accepted.
+	if claims.scope != nil && len(claims.scope.Permissions) > 0 {
+		return nil, time.Time{}, errs.New("personal access token restricted to permissions is not supported")
+	}
 
 	var tokenExpiry time.Time
 	if claims.ExpiresAt != nil {
@@ -289,6 +309,9 @@ func audienceContains(audience jwt.ClaimStrings, token string) bool {
 type claimsMessage struct {
 	Name string `json:"name"`
 	jwt.RegisteredClaims
+
+	// scope is the scope of the personal access token, it is nil for the JWT access tokens.
+	scope *common.PersonalAccessTokenScope
 }
 
 // GenerateAPIToken generates an API token.
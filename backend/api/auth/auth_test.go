@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
 )
 
 func TestGetAllowMissingRequiredPermission(t *testing.T) {
@@ -99,3 +101,25 @@ func TestGetAllowMissingRequiredPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestPersonalAccessToken(t *testing.T) {
+	a := require.New(t)
+	token, hash, err := GeneratePersonalAccessToken()
+	a.NoError(err)
+	a.True(isPersonalAccessToken(token))
+	a.Equal(HashPersonalAccessToken(token), hash)
+	a.Len(hash, 64)
+
+	another, _, err := GeneratePersonalAccessToken()
+	a.NoError(err)
+	a.NotEqual(token, another)
+
+	unrestricted := &common.PersonalAccessTokenScope{}
+	restricted := &common.PersonalAccessTokenScope{Permissions: map[string]bool{"bb.databases.get": true}}
+	a.NoError(checkPersonalAccessTokenScope(unrestricted, &common.AuthContext{AuthMethod: common.AuthMethodCustom}))
+	a.NoError(checkPersonalAccessTokenScope(restricted, &common.AuthContext{AuthMethod: common.AuthMethodIAM}))
+	a.Error(checkPersonalAccessTokenScope(restricted, &common.AuthContext{AuthMethod: common.AuthMethodCustom}))
+	a.True(restricted.Allows("bb.databases.get"))
+	a.False(restricted.Allows("bb.databases.update"))
+	a.True(unrestricted.Allows("bb.databases.update"))
+}
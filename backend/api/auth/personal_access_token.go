@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	errs "github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// PersonalAccessTokenPrefix is the prefix of the personal access tokens, which tells them from the JWT access tokens.
+	PersonalAccessTokenPrefix = "bbp_"
+	// personalAccessTokenLastUsedInterval is the interval to update the last used time of the personal access token,
+	// so that the scripts calling the API frequently don't write the database for every request.
+	personalAccessTokenLastUsedInterval = time.Minute
+)
+
+// GeneratePersonalAccessToken generates a personal access token, and returns the token and its hash to store.
+func GeneratePersonalAccessToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", errs.Wrap(err, "failed to generate personal access token")
+	}
+	token := PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashPersonalAccessToken(token), nil
+}
+
+// HashPersonalAccessToken returns the SHA-256 hex digest of the personal access token.
+func HashPersonalAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func isPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+// authenticatePersonalAccessToken validates the personal access token.
+// It returns the claims with the expiration of the token and the scope restricting the request.
+func (in *APIAuthInterceptor) authenticatePersonalAccessToken(ctx context.Context, tokenStr string) (*store.UserMessage, *claimsMessage, error) {
+	tokenHash := HashPersonalAccessToken(tokenStr)
+	token, err := in.store.GetPersonalAccessToken(ctx, &store.FindPersonalAccessTokenMessage{TokenHash: &tokenHash})
+	if err != nil {
+		return nil, nil, errs.Wrap(err, "failed to find personal access token")
+	}
+	if token == nil {
+		return nil, nil, errs.New("invalid personal access token")
+	}
+	now := time.Now()
+	if token.ExpiresAt != nil && !now.Before(*token.ExpiresAt) {
+		return nil, nil, errs.New("personal access token expired")
+	}
+
+	user, err := in.store.GetUserByID(ctx, token.PrincipalUID)
+	if err != nil {
+		return nil, nil, errs.Errorf("failed to find user ID %q of the personal access token", token.PrincipalUID)
+	}
+	if user == nil {
+		return nil, nil, errs.Errorf("user ID %q not exists for the personal access token", token.PrincipalUID)
+	}
+	if user.MemberDeleted {
+		return nil, nil, errs.Errorf("user ID %q has been deactivated by administrators", user.ID)
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= personalAccessTokenLastUsedInterval {
+		if err := in.store.UpdatePersonalAccessTokenLastUsedAt(ctx, token.UID, now); err != nil {
+			slog.Warn("failed to update the last used time of the personal access token", slog.Int("token", token.UID), log.BBError(err))
+		}
+	}
+
+	scope := &common.PersonalAccessTokenScope{}
+	if permissions := token.Payload.GetPermissions(); len(permissions) > 0 {
+		scope.Permissions = make(map[string]bool)
+		for _, permission := range permissions {
+			scope.Permissions[permission] = true
+		}
+	}
+	claims := &claimsMessage{
+		Name:  user.Name,
+		scope: scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: strconv.Itoa(user.ID),
+		},
+	}
+	if token.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*token.ExpiresAt)
+	}
+	return user, claims, nil
+}
+
+// checkPersonalAccessTokenScope checks if the method can be called with the token scope.
+// Only the methods authorized by the IAM manager enforce the token scope,
+// so the other methods are denied for the tokens restricted to permissions.
+func checkPersonalAccessTokenScope(scope *common.PersonalAccessTokenScope, authContext *common.AuthContext) error {
+	if len(scope.Permissions) == 0 {
+		return nil
+	}
+	if authContext.AuthMethod != common.AuthMethodIAM {
+		return connect.NewError(connect.CodePermissionDenied, errs.New("the method is not allowed for personal access token restricted to permissions"))
+	}
+	return nil
+}
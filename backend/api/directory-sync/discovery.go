@@ -0,0 +1,263 @@
+package directorysync
+
+// The SCIM service provider configuration endpoints, which are used by the identity providers
+// to discover the supported features, resource types and schemas.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-4
+
+const (
+	// maxResults is the maximum number of resources returned in a list response.
+	maxResults = 1000
+	// documentationURI is the SCIM documentation of Bytebase.
+	documentationURI = "https://docs.bytebase.com/administration/scim/overview"
+)
+
+// Supported is whether a SCIM feature is supported.
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// FilterSupported is the filter feature configuration.
+type FilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// BulkSupported is the bulk feature configuration.
+type BulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+// AuthenticationScheme is the supported authentication scheme.
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+// ServiceProviderConfig is the SCIM service provider configuration.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-5
+type ServiceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationURI      string                  `json:"documentationUri"`
+	Patch                 *Supported              `json:"patch"`
+	Bulk                  *BulkSupported          `json:"bulk"`
+	Filter                *FilterSupported        `json:"filter"`
+	ChangePassword        *Supported              `json:"changePassword"`
+	Sort                  *Supported              `json:"sort"`
+	ETag                  *Supported              `json:"etag"`
+	AuthenticationSchemes []*AuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *ResourceMeta           `json:"meta"`
+}
+
+// ResourceType is the SCIM resource type.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-6
+type ResourceType struct {
+	Schemas          []string      `json:"schemas"`
+	ID               string        `json:"id"`
+	Name             string        `json:"name"`
+	Endpoint         string        `json:"endpoint"`
+	Description      string        `json:"description"`
+	Schema           string        `json:"schema"`
+	SchemaExtensions []any         `json:"schemaExtensions"`
+	Meta             *ResourceMeta `json:"meta"`
+}
+
+// SchemaAttribute is the attribute definition of a SCIM schema.
+type SchemaAttribute struct {
+	Name           string             `json:"name"`
+	Type           string             `json:"type"`
+	MultiValued    bool               `json:"multiValued"`
+	Description    string             `json:"description"`
+	Required       bool               `json:"required"`
+	CaseExact      bool               `json:"caseExact"`
+	Mutability     string             `json:"mutability"`
+	Returned       string             `json:"returned"`
+	Uniqueness     string             `json:"uniqueness"`
+	ReferenceTypes []string           `json:"referenceTypes,omitempty"`
+	SubAttributes  []*SchemaAttribute `json:"subAttributes,omitempty"`
+}
+
+// Schema is the SCIM schema definition.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-7
+type Schema struct {
+	Schemas     []string           `json:"schemas"`
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Attributes  []*SchemaAttribute `json:"attributes"`
+	Meta        *ResourceMeta      `json:"meta"`
+}
+
+func newServiceProviderConfig(baseURL string) *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas:          []string{serviceProviderConfigSchema},
+		DocumentationURI: documentationURI,
+		Patch:            &Supported{Supported: true},
+		Bulk:             &BulkSupported{Supported: false},
+		Filter:           &FilterSupported{Supported: true, MaxResults: maxResults},
+		ChangePassword:   &Supported{Supported: false},
+		Sort:             &Supported{Supported: false},
+		ETag:             &Supported{Supported: false},
+		AuthenticationSchemes: []*AuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication scheme using the secret token in the Authorization header",
+				Primary:     true,
+			},
+		},
+		Meta: &ResourceMeta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     baseURL + "/ServiceProviderConfig",
+		},
+	}
+}
+
+func newResourceTypes(baseURL string) []*ResourceType {
+	return []*ResourceType{
+		{
+			Schemas:          []string{resourceTypeSchema},
+			ID:               "User",
+			Name:             "User",
+			Endpoint:         "/Users",
+			Description:      "User Account",
+			Schema:           userSchema,
+			SchemaExtensions: []any{},
+			Meta: &ResourceMeta{
+				ResourceType: "ResourceType",
+				Location:     baseURL + "/ResourceTypes/User",
+			},
+		},
+		{
+			Schemas:          []string{resourceTypeSchema},
+			ID:               "Group",
+			Name:             "Group",
+			Endpoint:         "/Groups",
+			Description:      "Group",
+			Schema:           groupSchema,
+			SchemaExtensions: []any{},
+			Meta: &ResourceMeta{
+				ResourceType: "ResourceType",
+				Location:     baseURL + "/ResourceTypes/Group",
+			},
+		},
+	}
+}
+
+func newSchemas(baseURL string) []*Schema {
+	return []*Schema{
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          userSchema,
+			Name:        "User",
+			Description: "User Account",
+			Attributes: []*SchemaAttribute{
+				newStringAttribute("userName", "The email of the user, which is the unique identifier of the user.", true, false, "readWrite", "server"),
+				{
+					Name:        "name",
+					Type:        "complex",
+					Description: "The components of the user's name. It's used as the display name if displayName is absent.",
+					Mutability:  "writeOnly",
+					Returned:    "never",
+					Uniqueness:  "none",
+					SubAttributes: []*SchemaAttribute{
+						newStringAttribute("formatted", "The full name.", false, false, "writeOnly", "none"),
+						newStringAttribute("familyName", "The family name of the user.", false, false, "writeOnly", "none"),
+						newStringAttribute("givenName", "The given name of the user.", false, false, "writeOnly", "none"),
+					},
+				},
+				newStringAttribute("displayName", "The name of the user.", false, false, "readWrite", "none"),
+				{
+					Name:        "active",
+					Type:        "boolean",
+					Description: "Whether the user is active. The inactive users are archived in Bytebase.",
+					Mutability:  "readWrite",
+					Returned:    "default",
+					Uniqueness:  "none",
+				},
+				{
+					Name:        "emails",
+					Type:        "complex",
+					MultiValued: true,
+					Description: "The email addresses of the user. The primary email is used if userName is not an email.",
+					Mutability:  "readWrite",
+					Returned:    "default",
+					Uniqueness:  "none",
+					SubAttributes: []*SchemaAttribute{
+						newStringAttribute("value", "The email address.", false, false, "readWrite", "none"),
+						newStringAttribute("type", "The type of the email, e.g. work.", false, false, "readWrite", "none"),
+						{
+							Name:        "primary",
+							Type:        "boolean",
+							Description: "Whether it's the primary email.",
+							Mutability:  "readWrite",
+							Returned:    "default",
+							Uniqueness:  "none",
+						},
+					},
+				},
+			},
+			Meta: &ResourceMeta{
+				ResourceType: "Schema",
+				Location:     baseURL + "/Schemas/" + userSchema,
+			},
+		},
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          groupSchema,
+			Name:        "Group",
+			Description: "Group",
+			Attributes: []*SchemaAttribute{
+				newStringAttribute("displayName", "The name of the group.", true, false, "readWrite", "none"),
+				newStringAttribute("email", "The email of the group.", false, false, "readWrite", "server"),
+				{
+					Name:        "members",
+					Type:        "complex",
+					MultiValued: true,
+					Description: "The members of the group. Only users are supported.",
+					Mutability:  "readWrite",
+					Returned:    "default",
+					Uniqueness:  "none",
+					SubAttributes: []*SchemaAttribute{
+						newStringAttribute("value", "The id of the member user.", false, true, "immutable", "none"),
+						{
+							Name:           "$ref",
+							Type:           "reference",
+							Description:    "The URI of the member user.",
+							CaseExact:      true,
+							Mutability:     "immutable",
+							Returned:       "default",
+							Uniqueness:     "none",
+							ReferenceTypes: []string{"User"},
+						},
+						newStringAttribute("display", "The display name of the member.", false, false, "immutable", "none"),
+					},
+				},
+			},
+			Meta: &ResourceMeta{
+				ResourceType: "Schema",
+				Location:     baseURL + "/Schemas/" + groupSchema,
+			},
+		},
+	}
+}
+
+func newStringAttribute(name, description string, required, caseExact bool, mutability, uniqueness string) *SchemaAttribute {
+	returned := "default"
+	if mutability == "writeOnly" {
+		returned = "never"
+	}
+	return &SchemaAttribute{
+		Name:        name,
+		Type:        "string",
+		Description: description,
+		Required:    required,
+		CaseExact:   caseExact,
+		Mutability:  mutability,
+		Returned:    returned,
+		Uniqueness:  uniqueness,
+	}
+}
@@ -0,0 +1,548 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// The SCIM filter implementation.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2
+//
+// The filters are evaluated on the JSON object representation of the resources.
+//
+//	FILTER    = attrExp / logExp / valuePath / *1"not" "(" FILTER ")"
+//	valuePath = attrPath "[" valFilter "]"
+//	attrExp   = (attrPath SP "pr") / (attrPath SP compareOp SP compValue)
+//	logExp    = FILTER SP ("and" / "or") SP FILTER
+//	compValue = false / null / true / number / string
+//	attrPath  = [URI ":"] ATTRNAME *1subAttr
+
+const (
+	operatorEqual          = "eq"
+	operatorNotEqual       = "ne"
+	operatorContains       = "co"
+	operatorStartsWith     = "sw"
+	operatorEndsWith       = "ew"
+	operatorGreaterThan    = "gt"
+	operatorGreaterOrEqual = "ge"
+	operatorLessThan       = "lt"
+	operatorLessOrEqual    = "le"
+	operatorPresent        = "pr"
+)
+
+// caseExactAttributes are the attributes compared case-sensitively. Others are case-insensitive.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-3.1
+var caseExactAttributes = map[string]bool{
+	"id":         true,
+	"externalid": true,
+}
+
+// filterExpr is a parsed SCIM filter.
+type filterExpr interface {
+	match(resource map[string]any) bool
+}
+
+// attrPath is the path to an attribute, e.g. userName, name.givenName or emails.value.
+type attrPath struct {
+	attribute string
+	subAttr   string
+}
+
+// parseAttrPath parses the attribute path and trims the core schema URN prefix.
+func parseAttrPath(s string) (attrPath, error) {
+	path := s
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		i := strings.LastIndex(path, ":")
+		uri := path[:i]
+		if !strings.EqualFold(uri, userSchema) && !strings.EqualFold(uri, groupSchema) {
+			return attrPath{}, errors.Errorf("unsupported schema %q in attribute path %q", uri, s)
+		}
+		path = path[i+1:]
+	}
+	attribute, subAttr, _ := strings.Cut(path, ".")
+	if !isAttrName(attribute) || (subAttr != "" && !isAttrName(subAttr)) {
+		return attrPath{}, errors.Errorf("invalid attribute path %q", s)
+	}
+	return attrPath{attribute: attribute, subAttr: subAttr}, nil
+}
+
+func isAttrName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r == '$' && i == 0 {
+			continue
+		}
+		if !unicode.IsLetter(r) && (i == 0 || (!unicode.IsDigit(r) && r != '_' && r != '-')) {
+			return false
+		}
+	}
+	return true
+}
+
+type logicalExpr struct {
+	and   bool
+	left  filterExpr
+	right filterExpr
+}
+
+func (e *logicalExpr) match(resource map[string]any) bool {
+	if e.and {
+		return e.left.match(resource) && e.right.match(resource)
+	}
+	return e.left.match(resource) || e.right.match(resource)
+}
+
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e *notExpr) match(resource map[string]any) bool {
+	return !e.expr.match(resource)
+}
+
+// valuePathExpr filters the elements of a multi-valued complex attribute, e.g. emails[type eq "work"].
+type valuePathExpr struct {
+	attribute string
+	filter    filterExpr
+}
+
+func (e *valuePathExpr) match(resource map[string]any) bool {
+	value, _ := getAttribute(resource, e.attribute)
+	for _, element := range toSlice(value) {
+		if m, ok := element.(map[string]any); ok && e.filter.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+type attrExpr struct {
+	path     attrPath
+	operator string
+	// value is the JSON value to compare with, which is one of string, float64, bool and nil.
+	value any
+}
+
+func (e *attrExpr) match(resource map[string]any) bool {
+	values := getAttributeValues(resource, e.path)
+	if e.operator == operatorPresent {
+		for _, v := range values {
+			if !isEmptyValue(v) {
+				return true
+			}
+		}
+		return false
+	}
+	if e.operator == operatorNotEqual {
+		for _, v := range values {
+			if compareValue(operatorEqual, v, e.value, caseExactAttributes[strings.ToLower(e.path.attribute)]) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compareValue(e.operator, v, e.value, caseExactAttributes[strings.ToLower(e.path.attribute)]) {
+			return true
+		}
+	}
+	// "eq null" matches the unassigned attributes.
+	return e.operator == operatorEqual && e.value == nil && len(values) == 0
+}
+
+// getAttribute gets the attribute by name case-insensitively.
+func getAttribute(resource map[string]any, name string) (any, bool) {
+	if v, ok := resource[name]; ok {
+		return v, true
+	}
+	for k, v := range resource {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// getAttributeValues gets the values of the attribute path.
+// Multi-valued attributes are flattened, and the "value" sub-attribute is used for
+// multi-valued complex attributes if the sub-attribute is not specified.
+func getAttributeValues(resource map[string]any, path attrPath) []any {
+	value, ok := getAttribute(resource, path.attribute)
+	if !ok || value == nil {
+		return nil
+	}
+	var values []any
+	for _, element := range toSlice(value) {
+		m, isMap := element.(map[string]any)
+		switch {
+		case path.subAttr != "":
+			if !isMap {
+				continue
+			}
+			if v, ok := getAttribute(m, path.subAttr); ok && v != nil {
+				values = append(values, v)
+			}
+		case isMap:
+			if v, ok := getAttribute(m, "value"); ok && v != nil {
+				values = append(values, v)
+			}
+		default:
+			values = append(values, element)
+		}
+	}
+	return values
+}
+
+func toSlice(value any) []any {
+	if s, ok := value.([]any); ok {
+		return s
+	}
+	if value == nil {
+		return nil
+	}
+	return []any{value}
+}
+
+func isEmptyValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+func compareValue(operator string, actual, expected any, caseExact bool) bool {
+	switch expected := expected.(type) {
+	case nil:
+		return false
+	case bool:
+		b, ok := toBool(actual)
+		return ok && operator == operatorEqual && b == expected
+	case float64:
+		var f float64
+		switch actual := actual.(type) {
+		case float64:
+			f = actual
+		case string:
+			v, err := strconv.ParseFloat(actual, 64)
+			if err != nil {
+				return false
+			}
+			f = v
+		default:
+			return false
+		}
+		switch operator {
+		case operatorEqual:
+			return f == expected
+		case operatorGreaterThan:
+			return f > expected
+		case operatorGreaterOrEqual:
+			return f >= expected
+		case operatorLessThan:
+			return f < expected
+		case operatorLessOrEqual:
+			return f <= expected
+		default:
+			return false
+		}
+	case string:
+		var s string
+		switch actual := actual.(type) {
+		case string:
+			s = actual
+		case float64:
+			s = strconv.FormatFloat(actual, 'f', -1, 64)
+		case bool:
+			s = strconv.FormatBool(actual)
+		default:
+			return false
+		}
+		if !caseExact {
+			s, expected = strings.ToLower(s), strings.ToLower(expected)
+		}
+		switch operator {
+		case operatorEqual:
+			return s == expected
+		case operatorContains:
+			return strings.Contains(s, expected)
+		case operatorStartsWith:
+			return strings.HasPrefix(s, expected)
+		case operatorEndsWith:
+			return strings.HasSuffix(s, expected)
+		case operatorGreaterThan:
+			return s > expected
+		case operatorGreaterOrEqual:
+			return s >= expected
+		case operatorLessThan:
+			return s < expected
+		case operatorLessOrEqual:
+			return s <= expected
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
+
+// toBool converts the JSON boolean or its string form to bool.
+func toBool(v any) (bool, bool) {
+	switch v := v.(type) {
+	case bool:
+		return v, true
+	case string:
+		if strings.EqualFold(v, "true") {
+			return true, true
+		}
+		if strings.EqualFold(v, "false") {
+			return false, true
+		}
+	default:
+	}
+	return false, false
+}
+
+// parseFilter parses the SCIM filter.
+func parseFilter(filter string) (filterExpr, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid filter %q", filter)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, errors.Errorf("invalid filter %q: unexpected %q", filter, p.tokens[p.pos].text)
+	}
+	return expr, nil
+}
+
+type filterTokenType int
+
+const (
+	filterTokenWord filterTokenType = iota
+	filterTokenString
+	filterTokenPunct
+)
+
+type filterToken struct {
+	tokenType filterTokenType
+	text      string
+}
+
+func tokenizeFilter(filter string) ([]*filterToken, error) {
+	var tokens []*filterToken
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, &filterToken{tokenType: filterTokenPunct, text: string(c)})
+			i++
+		case c == '"':
+			// Find the closing quote, skipping the escaped characters.
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, errors.Errorf("invalid filter %q: unterminated string", filter)
+			}
+			var s string
+			if err := json.Unmarshal([]byte(filter[i:j+1]), &s); err != nil {
+				return nil, errors.Wrapf(err, "invalid filter %q: invalid string %s", filter, filter[i:j+1])
+			}
+			tokens = append(tokens, &filterToken{tokenType: filterTokenString, text: s})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter) && !strings.ContainsRune(" \t\n\r()[]\"", rune(filter[j])); j++ {
+			}
+			tokens = append(tokens, &filterToken{tokenType: filterTokenWord, text: filter[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []*filterToken
+	pos    int
+}
+
+func (p *filterParser) peek() *filterToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() *filterToken {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	t := p.peek()
+	return t != nil && t.tokenType == filterTokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (p *filterParser) expectPunct(punct string) error {
+	t := p.next()
+	if t == nil {
+		return errors.Errorf("expect %q but got end of filter", punct)
+	}
+	if t.tokenType != filterTokenPunct || t.text != punct {
+		return errors.Errorf("expect %q but got %q", punct, t.text)
+	}
+	return nil
+}
+
+// parseOr parses the "or" expressions, which have the lowest precedence.
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	t := p.next()
+	if t == nil {
+		return nil, errors.New("unexpected end of filter")
+	}
+	if t.tokenType == filterTokenWord && strings.EqualFold(t.text, "not") {
+		if err := p.expectPunct("("); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(")"); err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	}
+	if t.tokenType == filterTokenPunct && t.text == "(" {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	if t.tokenType != filterTokenWord {
+		return nil, errors.Errorf("expect attribute path but got %q", t.text)
+	}
+	path, err := parseAttrPath(t.text)
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next != nil && next.tokenType == filterTokenPunct && next.text == "[" {
+		p.next()
+		if path.subAttr != "" {
+			return nil, errors.Errorf("invalid value path %q", t.text)
+		}
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		return &valuePathExpr{attribute: path.attribute, filter: filter}, nil
+	}
+
+	op := p.next()
+	if op == nil || op.tokenType != filterTokenWord {
+		return nil, errors.Errorf("expect operator after %q", t.text)
+	}
+	operator := strings.ToLower(op.text)
+	switch operator {
+	case operatorPresent:
+		return &attrExpr{path: path, operator: operator}, nil
+	case operatorEqual, operatorNotEqual, operatorContains, operatorStartsWith, operatorEndsWith,
+		operatorGreaterThan, operatorGreaterOrEqual, operatorLessThan, operatorLessOrEqual:
+	default:
+		return nil, errors.Errorf("unsupported operator %q", op.text)
+	}
+
+	v := p.next()
+	if v == nil {
+		return nil, errors.Errorf("expect value after %q", op.text)
+	}
+	var value any
+	switch v.tokenType {
+	case filterTokenString:
+		value = v.text
+	case filterTokenWord:
+		switch strings.ToLower(v.text) {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			f, err := strconv.ParseFloat(v.text, 64)
+			if err != nil {
+				return nil, errors.Errorf("invalid value %q", v.text)
+			}
+			value = f
+		}
+	default:
+		return nil, errors.Errorf("invalid value %q", v.text)
+	}
+	return &attrExpr{path: path, operator: operator, value: value}, nil
+}
@@ -0,0 +1,97 @@
+package directorysync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	resource := map[string]any{
+		"id":          "101",
+		"externalId":  "00u1abcd",
+		"userName":    "Alice@Example.com",
+		"displayName": "Alice Liddell",
+		"active":      true,
+		"name": map[string]any{
+			"givenName":  "Alice",
+			"familyName": "Liddell",
+		},
+		"emails": []any{
+			map[string]any{"value": "alice@example.com", "type": "work", "primary": true},
+			map[string]any{"value": "alice@home.example.com", "type": "home"},
+		},
+		"meta": map[string]any{
+			"created": "2024-01-02T03:04:05Z",
+		},
+	}
+
+	tests := []struct {
+		filter string
+		match  bool
+	}{
+		// Entra ID.
+		{filter: `userName eq "alice@example.com"`, match: true},
+		{filter: `userName eq "bob@example.com"`, match: false},
+		{filter: `externalId eq "00u1abcd"`, match: true},
+		// The externalId is case exact.
+		{filter: `externalId eq "00U1ABCD"`, match: false},
+		// Okta.
+		{filter: `userName eq "ALICE@example.com"`, match: true},
+		{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice@example.com"`, match: true},
+		// OneLogin.
+		{filter: `emails.value eq "alice@home.example.com"`, match: true},
+		{filter: `emails eq "alice@example.com"`, match: true},
+		{filter: `emails[type eq "work" and value co "@example.com"]`, match: true},
+		{filter: `emails[type eq "work" and value ew "@home.example.com"]`, match: false},
+		{filter: `name.givenName sw "ali"`, match: true},
+		{filter: `displayName co "Liddell" and active eq true`, match: true},
+		{filter: `displayName co "Liddell" and active eq false`, match: false},
+		{filter: `displayName eq "Bob" or (active eq true and userName pr)`, match: true},
+		{filter: `not (userName eq "alice@example.com")`, match: false},
+		{filter: `title pr`, match: false},
+		{filter: `title eq null`, match: true},
+		{filter: `title ne "Manager"`, match: true},
+		{filter: `userName ne "alice@example.com"`, match: false},
+		{filter: `meta.created gt "2023-12-31T00:00:00Z"`, match: true},
+		{filter: `meta.created lt "2023-12-31T00:00:00Z"`, match: false},
+		{filter: `userName eq "a" or userName eq "b" and userName eq "alice@example.com"`, match: false},
+		{filter: `userName eq "alice@example.com" or userName eq "b" and userName eq "c"`, match: true},
+	}
+	for _, test := range tests {
+		expr, err := parseFilter(test.filter)
+		require.NoError(t, err, test.filter)
+		assert.Equal(t, test.match, expr.match(resource), test.filter)
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+	for _, filter := range []string{
+		`userName`,
+		`userName eq`,
+		`userName like "alice"`,
+		`userName eq "alice`,
+		`(userName eq "alice"`,
+		`userName eq "alice")`,
+		`emails[type eq "work"`,
+		`userName eq alice`,
+		`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "R&D"`,
+	} {
+		_, err := parseFilter(filter)
+		require.Error(t, err, filter)
+	}
+}
+
+func TestGetEqualFilterValue(t *testing.T) {
+	expr, err := parseFilter(`userName eq "alice@example.com" and active eq true`)
+	require.NoError(t, err)
+	value, ok := getEqualFilterValue(expr, "userName")
+	require.True(t, ok)
+	assert.Equal(t, "alice@example.com", value)
+
+	expr, err = parseFilter(`userName eq "alice@example.com" or userName eq "bob@example.com"`)
+	require.NoError(t, err)
+	_, ok = getEqualFilterValue(expr, "userName")
+	require.False(t, ok)
+}
@@ -0,0 +1,329 @@
+package directorysync
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// createGroup creates a group.
+// Entra ID sync group process:
+//  1. POST users
+//  2. POST group without members
+//  3. PATCH group with members
+//
+// Okta and OneLogin POST the group with members.
+func (s *Service) createGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	var scimGroup Group
+	if err := readBody(c, &scimGroup); err != nil {
+		return writeError(c, err)
+	}
+	if scimGroup.DisplayName == "" {
+		return writeError(c, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.New("displayName is required")))
+	}
+	if scimGroup.ExternalID != "" {
+		existing, err := s.store.GetGroup(ctx, &store.FindGroupMessage{ID: &scimGroup.ExternalID})
+		if err != nil {
+			return writeError(c, errors.Wrapf(err, "failed to find group"))
+		}
+		if existing != nil {
+			return writeError(c, newSCIMError(http.StatusConflict, errorTypeUniqueness, errors.Errorf("group %q already exists", scimGroup.ExternalID)))
+		}
+	}
+
+	members, err := s.getGroupMembers(ctx, nil, scimGroup.Members)
+	if err != nil {
+		return writeError(c, err)
+	}
+	group, err := s.store.CreateGroup(ctx, &store.GroupMessage{
+		ID:    scimGroup.ExternalID,
+		Email: scimGroup.Email,
+		Title: scimGroup.DisplayName,
+		Payload: &storepb.GroupPayload{
+			Source:  getSource(c),
+			Members: members,
+		},
+	})
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to create group"))
+	}
+	if len(members) > 0 {
+		if err := s.iamManager.ReloadCache(ctx); err != nil {
+			return writeError(c, errors.Wrapf(err, "failed to reload iam cache"))
+		}
+	}
+
+	return writeJSON(c, http.StatusCreated, convertToGroup(group, getBaseURL(c)))
+}
+
+// listGroups lists groups.
+// Entra ID sends ?filter=externalId eq "{value}" query, and Okta and OneLogin send ?filter=displayName eq "{value}" query.
+// externalId can be Azure's objectId or group email depending on customer's attribute mapping:
+//   - New default: objectId -> externalId (recommended, stable across email changes)
+//   - Legacy mapping: mail -> externalId (for backward compatibility)
+//
+// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups#get-group-by-query
+func (s *Service) listGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	query, err := parseListQuery(c)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	find := &store.FindGroupMessage{}
+	if value, ok := getEqualFilterValue(query.filter, "externalId"); ok {
+		// externalId can be either Azure's objectId or group email, depending on customer's attribute mapping.
+		// - New default: objectId -> externalId (UUID format, no @)
+		// - Legacy mapping: mail -> externalId (email format, contains @)
+		if strings.Contains(value, "@") {
+			find.Email = &value
+			// The externalId of the group is the group id rather than the email, so the filter is resolved by the store.
+			if _, ok := query.filter.(*attrExpr); ok {
+				query.filter = nil
+			}
+		} else {
+			find.ID = &value
+		}
+	} else if value, ok := getEqualFilterValue(query.filter, "id"); ok {
+		find.ID = &value
+	}
+	groups, err := s.store.ListGroups(ctx, find)
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to list groups"))
+	}
+
+	var scimGroups []*Group
+	for _, group := range groups {
+		scimGroups = append(scimGroups, convertToGroup(group, getBaseURL(c)))
+	}
+	response, err := filterAndPaginate(scimGroups, query)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, response)
+}
+
+// getGroup gets a single group. The group id is the Bytebase group resource id.
+func (s *Service) getGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("groupID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	resource, err := toResourceMap(convertToGroup(group, getBaseURL(c)))
+	if err != nil {
+		return writeError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, projectAttributes(resource, splitAttributes(c.QueryParam("attributes")), splitAttributes(c.QueryParam("excludedAttributes"))))
+}
+
+// replaceGroup replaces the group name and members.
+func (s *Service) replaceGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	var scimGroup Group
+	if err := readBody(c, &scimGroup); err != nil {
+		return writeError(c, err)
+	}
+	if scimGroup.DisplayName == "" {
+		return writeError(c, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.New("displayName is required")))
+	}
+	group, err := s.findGroup(ctx, c.Param("groupID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	updatedGroup, err := s.updateGroup(ctx, c, group, &scimGroup)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, convertToGroup(updatedGroup, getBaseURL(c)))
+}
+
+func (s *Service) patchGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	var patch PatchRequest
+	if err := readBody(c, &patch); err != nil {
+		return writeError(c, err)
+	}
+	group, err := s.findGroup(ctx, c.Param("groupID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	patched, err := getPatchedGroup(group, patch.Operations)
+	if err != nil {
+		return writeError(c, err)
+	}
+	updatedGroup, err := s.updateGroup(ctx, c, group, patched)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, convertToGroup(updatedGroup, getBaseURL(c)))
+}
+
+func (s *Service) deleteGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("groupID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	if err := s.store.DeleteGroup(ctx, group.ID); err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to delete group"))
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to reload iam cache"))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// findGroup finds the group by the SCIM id, which is the group id, or the group email for the legacy Entra ID mapping.
+func (s *Service) findGroup(ctx context.Context, groupID string) (*store.GroupMessage, error) {
+	identifier, err := decodeGroupIdentifier(groupID)
+	if err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, err)
+	}
+	find := &store.FindGroupMessage{}
+	if strings.Contains(identifier, "@") {
+		find.Email = &identifier
+	} else {
+		find.ID = &identifier
+	}
+	group, err := s.store.GetGroup(ctx, find)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find group")
+	}
+	if group == nil {
+		return nil, newSCIMError(http.StatusNotFound, "", errors.Errorf("group %q not found", groupID))
+	}
+	return group, nil
+}
+
+// getPatchedGroup applies the PATCH operations to the group.
+func getPatchedGroup(group *store.GroupMessage, operations []*PatchOperation) (*Group, error) {
+	resource, err := toResourceMap(convertToGroup(group, ""))
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPatch(resource, operations); err != nil {
+		return nil, err
+	}
+	var patched Group
+	if err := fromResourceMap(resource, &patched); err != nil {
+		return nil, err
+	}
+	return &patched, nil
+}
+
+// updateGroup updates the group name and members to the SCIM group.
+func (s *Service) updateGroup(ctx context.Context, c echo.Context, group *store.GroupMessage, scimGroup *Group) (*store.GroupMessage, error) {
+	members, err := s.getGroupMembers(ctx, group.Payload.GetMembers(), scimGroup.Members)
+	if err != nil {
+		return nil, err
+	}
+	update := &store.UpdateGroupMessage{
+		ID: group.ID,
+		Payload: &storepb.GroupPayload{
+			Source:  getSource(c),
+			Members: members,
+		},
+	}
+	if scimGroup.DisplayName != "" && scimGroup.DisplayName != group.Title {
+		update.Title = &scimGroup.DisplayName
+	}
+	if scimGroup.Email != "" && scimGroup.Email != group.Email {
+		update.Email = &scimGroup.Email
+	}
+
+	updatedGroup, err := s.store.UpdateGroup(ctx, update)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update group")
+	}
+	// Reload IAM cache to make sure the group members are updated.
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return nil, errors.Wrapf(err, "failed to reload iam cache")
+	}
+	return updatedGroup, nil
+}
+
+// getGroupMembers returns the group members for the SCIM members.
+// The roles of the existing members are kept, and the new members are added with the member role.
+// The member identifier is the Bytebase user uid, and the unknown users are skipped.
+func (s *Service) getGroupMembers(ctx context.Context, existing []*storepb.GroupMember, references []*Reference) ([]*storepb.GroupMember, error) {
+	var members []*storepb.GroupMember
+	for _, reference := range references {
+		uid, err := strconv.Atoi(reference.Value)
+		if err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.Errorf("invalid member %q", reference.Value))
+		}
+		member := common.FormatUserUID(uid)
+		if slices.ContainsFunc(members, func(m *storepb.GroupMember) bool { return m.Member == member }) {
+			continue
+		}
+		if index := slices.IndexFunc(existing, func(m *storepb.GroupMember) bool { return m.Member == member }); index >= 0 {
+			members = append(members, existing[index])
+			continue
+		}
+
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user")
+		}
+		if user == nil {
+			slog.Warn("cannot found user", slog.String("uid", reference.Value))
+			continue
+		}
+		members = append(members, &storepb.GroupMember{
+			Member: member,
+			Role:   storepb.GroupMember_MEMBER,
+		})
+	}
+	return members, nil
+}
+
+func convertToGroup(group *store.GroupMessage, baseURL string) *Group {
+	scimGroup := &Group{
+		Schemas: []string{
+			groupSchema,
+		},
+		// We use the Entra ID group object id (external id) to create the group.
+		// So both ID and ExternalID should be the group.ID (equals external id).
+		ID:          group.ID,
+		ExternalID:  group.ID,
+		Email:       group.Email,
+		DisplayName: group.Title,
+		Meta: &ResourceMeta{
+			ResourceType: "Group",
+		},
+	}
+	for _, member := range group.Payload.GetMembers() {
+		uid, err := common.GetUserID(member.Member)
+		if err != nil {
+			continue
+		}
+		id := strconv.Itoa(uid)
+		reference := &Reference{
+			Value: id,
+		}
+		if baseURL != "" {
+			reference.Ref = baseURL + "/Users/" + id
+		}
+		scimGroup.Members = append(scimGroup.Members, reference)
+	}
+	if baseURL != "" {
+		scimGroup.Meta.Location = baseURL + "/Groups/" + group.ID
+	}
+	return scimGroup
+}
@@ -0,0 +1,294 @@
+package directorysync
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The SCIM PATCH implementation.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+//
+// The operations are applied to the JSON object representation of the resource, and
+// the callers convert the patched object back to the resource and update the store.
+//
+//	PATH = attrPath / valuePath [subAttr]
+//
+// Examples of the PATCH requests sent by the identity providers:
+//   - Entra ID: {"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "alice@example.com"}
+//   - Entra ID: {"op": "Remove", "path": "members", "value": [{"value": "101"}]}
+//   - Okta: {"op": "replace", "value": {"active": false}}
+//   - Okta: {"op": "remove", "path": "members[value eq \"101\"]"}
+//   - OneLogin: {"op": "add", "path": "members", "value": [{"value": "101"}]}
+
+const (
+	patchOperationAdd     = "add"
+	patchOperationReplace = "replace"
+	patchOperationRemove  = "remove"
+)
+
+// readOnlyAttributes are ignored in PATCH operations without path.
+// Okta sends the id in the value of the group rename operation.
+var readOnlyAttributes = map[string]bool{
+	"id":      true,
+	"schemas": true,
+	"meta":    true,
+}
+
+// patchPath is the parsed PATCH path.
+type patchPath struct {
+	attribute string
+	// filter is the value filter of the multi-valued attribute, e.g. [type eq "work"].
+	filter  filterExpr
+	subAttr string
+}
+
+func parsePatchPath(s string) (*patchPath, error) {
+	head, rest := s, ""
+	var filter filterExpr
+	if i := strings.Index(s, "["); i >= 0 {
+		j := strings.LastIndex(s, "]")
+		if j < i {
+			return nil, errors.Errorf("invalid path %q", s)
+		}
+		f, err := parseFilter(s[i+1 : j])
+		if err != nil {
+			return nil, err
+		}
+		head, rest, filter = s[:i], s[j+1:], f
+	}
+	path, err := parseAttrPath(head)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		if path.subAttr != "" {
+			return nil, errors.Errorf("invalid path %q", s)
+		}
+		if rest != "" {
+			subAttr, ok := strings.CutPrefix(rest, ".")
+			if !ok || !isAttrName(subAttr) {
+				return nil, errors.Errorf("invalid path %q", s)
+			}
+			path.subAttr = subAttr
+		}
+	}
+	return &patchPath{
+		attribute: path.attribute,
+		filter:    filter,
+		subAttr:   path.subAttr,
+	}, nil
+}
+
+// applyPatch applies the PATCH operations to the JSON object representation of the resource.
+func applyPatch(resource map[string]any, operations []*PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.OP)
+		switch op {
+		case patchOperationAdd, patchOperationReplace, patchOperationRemove:
+		default:
+			return newSCIMError(http.StatusBadRequest, errorTypeInvalidSyntax, errors.Errorf("unsupported operation %q", operation.OP))
+		}
+
+		if operation.Path == "" {
+			// The value is a set of attributes to add or replace if the path is omitted.
+			if op == patchOperationRemove {
+				return newSCIMError(http.StatusBadRequest, errorTypeNoTarget, errors.New("path is required for remove operation"))
+			}
+			values, ok := operation.Value.(map[string]any)
+			if !ok {
+				return newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.Errorf("expect object value for %q operation without path", operation.OP))
+			}
+			for key, value := range values {
+				if readOnlyAttributes[strings.ToLower(key)] {
+					continue
+				}
+				if err := applyPatchOperation(resource, op, key, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := applyPatchOperation(resource, op, operation.Path, operation.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPatchOperation(resource map[string]any, op, pathString string, value any) error {
+	path, err := parsePatchPath(pathString)
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, errorTypeInvalidPath, err)
+	}
+	if readOnlyAttributes[strings.ToLower(path.attribute)] {
+		return newSCIMError(http.StatusBadRequest, errorTypeInvalidPath, errors.Errorf("attribute %q is read-only", path.attribute))
+	}
+	current, _ := getAttribute(resource, path.attribute)
+
+	if path.filter != nil {
+		return applyFilteredPatchOperation(resource, op, path, current, value)
+	}
+
+	if path.subAttr != "" {
+		switch current := current.(type) {
+		case []any:
+			// Apply to all the elements of the multi-valued complex attribute.
+			for _, element := range current {
+				if m, ok := element.(map[string]any); ok {
+					setOrDeleteAttribute(m, op, path.subAttr, value)
+				}
+			}
+		case map[string]any:
+			setOrDeleteAttribute(current, op, path.subAttr, value)
+		default:
+			if op != patchOperationRemove {
+				setAttribute(resource, path.attribute, map[string]any{path.subAttr: value})
+			}
+		}
+		return nil
+	}
+
+	switch op {
+	case patchOperationAdd, patchOperationReplace:
+		if currentMap, ok := current.(map[string]any); ok {
+			// Merge the sub-attributes of the complex attribute.
+			if valueMap, ok := value.(map[string]any); ok {
+				for k, v := range valueMap {
+					setAttribute(currentMap, k, v)
+				}
+				return nil
+			}
+		}
+		if elements, ok := current.([]any); ok && op == patchOperationAdd {
+			// Add the new values to the multi-valued attribute.
+			for _, v := range toSlice(value) {
+				if !containsValue(elements, v) {
+					elements = append(elements, v)
+				}
+			}
+			setAttribute(resource, path.attribute, elements)
+			return nil
+		}
+		setAttribute(resource, path.attribute, value)
+	case patchOperationRemove:
+		elements, ok := current.([]any)
+		if !ok || value == nil {
+			deleteAttribute(resource, path.attribute)
+			return nil
+		}
+		// Entra ID removes the members with the value rather than the value filter in the path.
+		var remaining []any
+		for _, element := range elements {
+			if !containsValue(toSlice(value), element) {
+				remaining = append(remaining, element)
+			}
+		}
+		setAttribute(resource, path.attribute, remaining)
+	default:
+	}
+	return nil
+}
+
+// applyFilteredPatchOperation applies the operation to the elements matching the value filter.
+func applyFilteredPatchOperation(resource map[string]any, op string, path *patchPath, current any, value any) error {
+	elements := toSlice(current)
+	var remaining []any
+	matched := false
+	for _, element := range elements {
+		m, ok := element.(map[string]any)
+		if !ok || !path.filter.match(m) {
+			remaining = append(remaining, element)
+			continue
+		}
+		matched = true
+		switch {
+		case op == patchOperationRemove && path.subAttr == "":
+			// Remove the element.
+		case path.subAttr != "":
+			setOrDeleteAttribute(m, op, path.subAttr, value)
+			remaining = append(remaining, m)
+		default:
+			if valueMap, ok := value.(map[string]any); ok {
+				for k, v := range valueMap {
+					setAttribute(m, k, v)
+				}
+			}
+			remaining = append(remaining, m)
+		}
+	}
+
+	if !matched {
+		if op == patchOperationRemove {
+			return nil
+		}
+		// Entra ID replaces emails[type eq "work"].value even if there is no work email,
+		// so create the element for the simple equality filter.
+		expr, ok := path.filter.(*attrExpr)
+		if !ok || expr.operator != operatorEqual || expr.path.subAttr != "" || expr.value == nil {
+			return newSCIMError(http.StatusBadRequest, errorTypeNoTarget, errors.Errorf("no value matches the path filter of %q", path.attribute))
+		}
+		element := map[string]any{expr.path.attribute: expr.value}
+		if path.subAttr != "" {
+			element[path.subAttr] = value
+		} else if valueMap, ok := value.(map[string]any); ok {
+			for k, v := range valueMap {
+				element[k] = v
+			}
+		}
+		remaining = append(remaining, element)
+	}
+	setAttribute(resource, path.attribute, remaining)
+	return nil
+}
+
+func setOrDeleteAttribute(m map[string]any, op, name string, value any) {
+	if op == patchOperationRemove {
+		deleteAttribute(m, name)
+		return
+	}
+	setAttribute(m, name, value)
+}
+
+// setAttribute sets the attribute by name case-insensitively.
+func setAttribute(resource map[string]any, name string, value any) {
+	for k := range resource {
+		if strings.EqualFold(k, name) {
+			resource[k] = value
+			return
+		}
+	}
+	resource[name] = value
+}
+
+// deleteAttribute deletes the attribute by name case-insensitively.
+func deleteAttribute(resource map[string]any, name string) {
+	for k := range resource {
+		if strings.EqualFold(k, name) {
+			delete(resource, k)
+		}
+	}
+}
+
+// containsValue returns whether the elements contain the value.
+// The complex values are compared by the "value" sub-attribute, e.g. the member id.
+func containsValue(elements []any, value any) bool {
+	key := getElementKey(value)
+	for _, element := range elements {
+		if getElementKey(element) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func getElementKey(element any) string {
+	if m, ok := element.(map[string]any); ok {
+		if v, ok := getAttribute(m, "value"); ok {
+			return fmt.Sprint(v)
+		}
+	}
+	return fmt.Sprint(element)
+}
@@ -0,0 +1,242 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SCIM schema URNs.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-8.7
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// SCIM error types.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.12
+const (
+	errorTypeInvalidFilter = "invalidFilter"
+	errorTypeInvalidSyntax = "invalidSyntax"
+	errorTypeInvalidPath   = "invalidPath"
+	errorTypeInvalidValue  = "invalidValue"
+	errorTypeNoTarget      = "noTarget"
+	errorTypeUniqueness    = "uniqueness"
+)
+
+// Boolean is a SCIM boolean.
+// Entra ID sends boolean values as strings such as "True" and "False" in PATCH requests,
+// so the unmarshaler accepts both JSON booleans and their string forms.
+// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/application-provisioning-config-problem-scim-compatibility
+type Boolean bool
+
+func (b *Boolean) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	value, ok := toBool(v)
+	if !ok {
+		return errors.Errorf("invalid boolean %s", string(data))
+	}
+	*b = Boolean(value)
+	return nil
+}
+
+// MultiValuedAttribute is a SCIM multi-valued attribute such as emails and phoneNumbers.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-2.4
+type MultiValuedAttribute struct {
+	Value   string   `json:"value"`
+	Display string   `json:"display,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Primary *Boolean `json:"primary,omitempty"`
+}
+
+// Reference is a reference from one SCIM resource to another, e.g. the group members.
+type Reference struct {
+	// value is the SCIM id of the referenced resource.
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// ResourceMeta is the metadata of a SCIM resource.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-3.1
+type ResourceMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+// UserName is the components of the user's name.
+type UserName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// fullName returns the formatted name, or the name composed of the given name and the family name.
+func (n *UserName) fullName() string {
+	if n == nil {
+		return ""
+	}
+	if n.Formatted != "" {
+		return n.Formatted
+	}
+	return strings.TrimSpace(n.GivenName + " " + n.FamilyName)
+}
+
+// User represents the SCIM core User schema.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-4.1
+//
+// SCIM ID mapping for users:
+//   - id: Bytebase's user UID (numeric, returned as string). Used by the IdP in subsequent API calls.
+//   - externalId: the IdP's identifier of the user (optional). We use userName (email) for user matching.
+//   - userName: User's email address. Primary identifier for user lookup.
+//
+// Unlike groups, users are matched by userName (email) rather than externalId because:
+//   - Email is the natural unique identifier for users in Bytebase
+//   - The default attribute mappings of Entra ID (userPrincipalName), Okta (login) and OneLogin (email) map an email to userName
+//
+// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups#get-user-by-query
+// Docs: https://developer.okta.com/docs/api/openapi/okta-scim/guides/scim-20
+type User struct {
+	// id is Bytebase's user UID, used by the IdP in subsequent requests (GET/PUT/PATCH/DELETE /Users/{id}).
+	ID      string   `json:"id"`
+	Schemas []string `json:"schemas"`
+	// externalId is the IdP's identifier of the user. We don't use this for user matching; we use userName instead.
+	ExternalID string `json:"externalId,omitempty"`
+	// userName is the primary identifier for user lookup, which should be an email.
+	UserName    string                  `json:"userName"`
+	Name        *UserName               `json:"name,omitempty"`
+	DisplayName string                  `json:"displayName,omitempty"`
+	Active      *Boolean                `json:"active,omitempty"`
+	Emails      []*MultiValuedAttribute `json:"emails,omitempty"`
+	Meta        *ResourceMeta           `json:"meta,omitempty"`
+}
+
+// email returns the email of the user.
+// userName is used if it is an email, otherwise fall back to the primary email.
+func (u *User) email() string {
+	if strings.Contains(u.UserName, "@") {
+		return normalizeEmail(u.UserName)
+	}
+	var email string
+	for _, e := range u.Emails {
+		if e.Value == "" {
+			continue
+		}
+		if e.Primary != nil && bool(*e.Primary) {
+			return normalizeEmail(e.Value)
+		}
+		if email == "" {
+			email = e.Value
+		}
+	}
+	return normalizeEmail(email)
+}
+
+// displayName returns the display name of the user.
+// Okta and OneLogin don't send displayName by default, so fall back to the name components.
+func (u *User) displayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if name := u.Name.fullName(); name != "" {
+		return name
+	}
+	return u.email()
+}
+
+// active returns whether the user is active. A user is active unless set otherwise.
+func (u *User) active() bool {
+	return u.Active == nil || bool(*u.Active)
+}
+
+// Group represents the SCIM core Group schema.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7643#section-4.2
+//
+// SCIM ID mapping:
+//   - id: Bytebase's internal group identifier (returned to the IdP, used in subsequent API calls)
+//   - externalId: the IdP's identifier of the group. Entra ID sends its objectId so we use it as the group id,
+//     while Okta and OneLogin don't send it and the group id is generated by Bytebase.
+//
+// Docs: https://learn.microsoft.com/en-us/answers/questions/1394370/azure-ad-scim-provisioning-group-update-requests-w
+// Docs: https://stackoverflow.com/questions/67198152/where-does-azuread-store-the-id-attribute-returned-by-a-scim-endpoint
+type Group struct {
+	// id is returned by our SCIM server and used by the IdP in subsequent requests (GET/PUT/PATCH/DELETE /Groups/{id}).
+	ID      string   `json:"id"`
+	Schemas []string `json:"schemas"`
+	// externalId is the IdP's identifier of the group.
+	// By default, Entra ID maps objectId -> externalId in attribute mappings.
+	ExternalID string `json:"externalId,omitempty"`
+	// email is a custom attribute mapped from Entra ID group's mail attribute.
+	// Configure in Azure: Entra ID -> Enterprise apps -> Provisioning -> Attribute Mappings -> Groups
+	// Add custom attribute "email" and map Azure "mail" -> SCIM "email".
+	// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/customize-application-attributes
+	Email       string        `json:"email,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []*Reference  `json:"members,omitempty"`
+	Meta        *ResourceMeta `json:"meta,omitempty"`
+}
+
+// ListResponse is the response of a query.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// scimError is an error with the HTTP status and the SCIM error type.
+type scimError struct {
+	status   int
+	scimType string
+	err      error
+}
+
+func newSCIMError(status int, scimType string, err error) *scimError {
+	return &scimError{
+		status:   status,
+		scimType: scimType,
+		err:      err,
+	}
+}
+
+func (e *scimError) Error() string {
+	return e.err.Error()
+}
+
+// ErrorResponse is the SCIM error response.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.12
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// PatchOperation is a single PATCH operation.
+// The op is case-insensitive: Entra ID sends "Add"/"Replace"/"Remove" while Okta and OneLogin send lower case.
+type PatchOperation struct {
+	OP    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// PatchRequest is the SCIM PATCH request.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups#update-user-multi-valued-properties
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
@@ -0,0 +1,360 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// The conformance tests with the requests sent by the identity providers.
+// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups
+// Docs: https://developer.okta.com/docs/api/openapi/okta-scim/guides/scim-20
+// Docs: https://developers.onelogin.com/scim/create-app
+
+func TestCreateUserRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		email       string
+		displayName string
+		active      bool
+	}{
+		{
+			name: "Entra ID",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"],
+				"externalId": "0a21f0f2-8d2a-4f8e-bf98-7363c4aed4ef",
+				"userName": "Test_User_ab6490ee-1e48-479e-a20b-2d77186b5dd1@contoso.com",
+				"active": true,
+				"emails": [{"primary": true, "type": "work", "value": "Test_User_fd0ea19b-0777-472c-9f96-4f70d2226f2e@testuser.com"}],
+				"meta": {"resourceType": "User"},
+				"name": {"formatted": "givenName familyName", "familyName": "familyName", "givenName": "givenName"},
+				"roles": []
+			}`,
+			email:       "test_user_ab6490ee-1e48-479e-a20b-2d77186b5dd1@contoso.com",
+			displayName: "givenName familyName",
+			active:      true,
+		},
+		{
+			name: "Okta",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+				"userName": "test.user@okta.local",
+				"name": {"givenName": "Test", "familyName": "User"},
+				"emails": [{"primary": true, "value": "test.user@okta.local", "type": "work"}],
+				"displayName": "Test User",
+				"locale": "en-US",
+				"externalId": "00ujl29u0le5T6Aj10h7",
+				"groups": [],
+				"password": "1mz050nq",
+				"active": true
+			}`,
+			email:       "test.user@okta.local",
+			displayName: "Test User",
+			active:      true,
+		},
+		{
+			name: "OneLogin",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+				"userName": "jdoe",
+				"name": {"givenName": "John", "familyName": "Doe"},
+				"emails": [{"value": "John.Doe@onelogin.example.com", "primary": true}]
+			}`,
+			email:       "john.doe@onelogin.example.com",
+			displayName: "John Doe",
+			active:      true,
+		},
+		{
+			name: "inactive with string boolean",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+				"userName": "bob@example.com",
+				"active": "False"
+			}`,
+			email:       "bob@example.com",
+			displayName: "bob@example.com",
+			active:      false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var user User
+			require.NoError(t, json.Unmarshal([]byte(test.body), &user))
+			assert.Equal(t, test.email, user.email())
+			assert.Equal(t, test.displayName, user.displayName())
+			assert.Equal(t, test.active, user.active())
+		})
+	}
+}
+
+func TestUserPatchRequest(t *testing.T) {
+	user := &store.UserMessage{
+		ID:    101,
+		Email: "alice@example.com",
+		Name:  "Alice",
+		Type:  storepb.PrincipalType_END_USER,
+	}
+	ptr := func(s string) *string { return &s }
+	boolPtr := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name   string
+		body   string
+		want   *store.UpdateUserMessage
+		errMsg string
+	}{
+		{
+			name: "Entra ID replace attributes",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [
+					{"op": "Replace", "path": "userName", "value": "Alice.New@example.com"},
+					{"op": "Replace", "path": "displayName", "value": "Alice New"},
+					{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "alice.new@example.com"},
+					{"op": "Replace", "path": "name.familyName", "value": "New"}
+				]
+			}`,
+			want: &store.UpdateUserMessage{
+				Name:  ptr("Alice New"),
+				Email: ptr("alice.new@example.com"),
+			},
+		},
+		{
+			name: "Entra ID disable user",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "Replace", "path": "active", "value": "False"}]
+			}`,
+			want: &store.UpdateUserMessage{
+				Delete: boolPtr(true),
+			},
+		},
+		{
+			name: "Entra ID add non-existent email type",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "Add", "path": "emails[type eq \"home\"].value", "value": "alice@home.example.com"}]
+			}`,
+			want: &store.UpdateUserMessage{},
+		},
+		{
+			name: "Okta deactivate user",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "replace", "value": {"active": false}}]
+			}`,
+			want: &store.UpdateUserMessage{
+				Delete: boolPtr(true),
+			},
+		},
+		{
+			name: "Okta update name",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "replace", "value": {"name": {"givenName": "Alicia", "familyName": "Liddell"}}}]
+			}`,
+			want: &store.UpdateUserMessage{
+				Name: ptr("Alicia Liddell"),
+			},
+		},
+		{
+			name: "OneLogin replace with dotted attribute",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "replace", "value": {"name.givenName": "Alicia", "userName": "alicia@example.com"}}]
+			}`,
+			want: &store.UpdateUserMessage{
+				Name:  ptr("Alicia"),
+				Email: ptr("alicia@example.com"),
+			},
+		},
+		{
+			name: "unsupported operation",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "move", "path": "displayName", "value": "Alice"}]
+			}`,
+			errMsg: `unsupported operation "move"`,
+		},
+		{
+			name: "read-only attribute",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "replace", "path": "id", "value": "102"}]
+			}`,
+			errMsg: `attribute "id" is read-only`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var patch PatchRequest
+			require.NoError(t, json.Unmarshal([]byte(test.body), &patch))
+			update, err := getUserPatch(user, patch.Operations)
+			if test.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errMsg)
+				var e *scimError
+				require.True(t, errors.As(err, &e))
+				assert.Equal(t, http.StatusBadRequest, e.status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, update)
+		})
+	}
+}
+
+func TestGroupRequest(t *testing.T) {
+	group := &store.GroupMessage{
+		ID:    "e9e30dba-f08f-4109-8486-d5c6a331660a",
+		Title: "Engineering",
+		Payload: &storepb.GroupPayload{
+			Members: []*storepb.GroupMember{
+				{Member: "users/101", Role: storepb.GroupMember_OWNER},
+				{Member: "users/102", Role: storepb.GroupMember_MEMBER},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		displayName string
+		members     []string
+	}{
+		{
+			name: "Entra ID add members",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "Add", "path": "members", "value": [{"value": "103"}, {"value": "101"}]}]
+			}`,
+			displayName: "Engineering",
+			members:     []string{"101", "102", "103"},
+		},
+		{
+			name: "Entra ID remove members",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "Remove", "path": "members", "value": [{"value": "101"}]}]
+			}`,
+			displayName: "Engineering",
+			members:     []string{"102"},
+		},
+		{
+			name: "Entra ID rename",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "Replace", "path": "displayName", "value": "R&D"}]
+			}`,
+			displayName: "R&D",
+			members:     []string{"101", "102"},
+		},
+		{
+			name: "Okta rename",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "replace", "value": {"id": "e9e30dba-f08f-4109-8486-d5c6a331660a", "displayName": "R&D"}}]
+			}`,
+			displayName: "R&D",
+			members:     []string{"101", "102"},
+		},
+		{
+			name: "Okta remove member",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "remove", "path": "members[value eq \"102\"]"}]
+			}`,
+			displayName: "Engineering",
+			members:     []string{"101"},
+		},
+		{
+			name: "Okta add member",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "add", "path": "members", "value": [{"value": "104", "display": "dave@example.com"}]}]
+			}`,
+			displayName: "Engineering",
+			members:     []string{"101", "102", "104"},
+		},
+		{
+			name: "OneLogin replace members",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "replace", "path": "members", "value": [{"value": "102"}, {"value": "105"}]}]
+			}`,
+			displayName: "Engineering",
+			members:     []string{"102", "105"},
+		},
+		{
+			name: "remove all members",
+			body: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [{"op": "remove", "path": "members"}]
+			}`,
+			displayName: "Engineering",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var patch PatchRequest
+			require.NoError(t, json.Unmarshal([]byte(test.body), &patch))
+			patched, err := getPatchedGroup(group, patch.Operations)
+			require.NoError(t, err)
+			assert.Equal(t, test.displayName, patched.DisplayName)
+			var members []string
+			for _, member := range patched.Members {
+				members = append(members, member.Value)
+			}
+			assert.Equal(t, test.members, members)
+		})
+	}
+}
+
+func TestFilterAndPaginate(t *testing.T) {
+	var users []*User
+	for i, email := range []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "e@other.com"} {
+		users = append(users, convertToUser(&store.UserMessage{ID: 101 + i, Email: email, Name: email}, "https://bytebase.example.com/hook/scim/workspaces/ws"))
+	}
+	filter, err := parseFilter(`userName ew "@example.com"`)
+	require.NoError(t, err)
+
+	response, err := filterAndPaginate(users, &listQuery{filter: filter, startIndex: 2, count: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 4, response.TotalResults)
+	assert.Equal(t, 2, response.StartIndex)
+	assert.Equal(t, 2, response.ItemsPerPage)
+	require.Len(t, response.Resources, 2)
+	assert.Equal(t, "102", response.Resources[0].(map[string]any)["id"])
+	assert.Equal(t, "103", response.Resources[1].(map[string]any)["id"])
+
+	// The pages beyond the last item have no resources.
+	response, err = filterAndPaginate(users, &listQuery{startIndex: 10, count: 100})
+	require.NoError(t, err)
+	assert.Equal(t, 5, response.TotalResults)
+	assert.Equal(t, 0, response.ItemsPerPage)
+	assert.Equal(t, []any{}, response.Resources)
+
+	response, err = filterAndPaginate(users, &listQuery{startIndex: 1, count: -1, excludedAttributes: []string{"emails", "meta"}})
+	require.NoError(t, err)
+	assert.Equal(t, 5, response.ItemsPerPage)
+	resource := response.Resources[0].(map[string]any)
+	assert.NotContains(t, resource, "emails")
+	assert.NotContains(t, resource, "meta")
+	assert.Equal(t, "a@example.com", resource["userName"])
+
+	response, err = filterAndPaginate(users, &listQuery{startIndex: 1, count: -1, attributes: []string{"userName"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"id":       "101",
+		"schemas":  []any{userSchema},
+		"userName": "a@example.com",
+	}, response.Resources[0])
+}
@@ -0,0 +1,315 @@
+package directorysync
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func (s *Service) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	var scimUser User
+	if err := readBody(c, &scimUser); err != nil {
+		return writeError(c, err)
+	}
+	email := scimUser.email()
+	if email == "" {
+		return writeError(c, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.New("userName or emails must contain an email")))
+	}
+
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to get user %s", email))
+	}
+	if user != nil && !user.MemberDeleted {
+		return writeError(c, newSCIMError(http.StatusConflict, errorTypeUniqueness, errors.Errorf("user %q already exists", email)))
+	}
+
+	source := getSource(c)
+	if user == nil {
+		password, err := common.RandomString(20)
+		if err != nil {
+			return writeError(c, errors.Wrapf(err, "failed to generate random password"))
+		}
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return writeError(c, errors.Wrapf(err, "failed to generate password hash"))
+		}
+		newUser, err := s.store.CreateUser(ctx, &store.UserMessage{
+			Name:          scimUser.displayName(),
+			Email:         email,
+			Type:          storepb.PrincipalType_END_USER,
+			MemberDeleted: !scimUser.active(),
+			PasswordHash:  string(passwordHash),
+			Profile: &storepb.UserProfile{
+				Source: source,
+			},
+		})
+		if err != nil {
+			return writeError(c, errors.Wrapf(err, "failed to create user %q", email))
+		}
+		user = newUser
+	} else {
+		// Restore the archived user.
+		deleted := !scimUser.active()
+		name := scimUser.displayName()
+		updatedUser, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
+			Delete: &deleted,
+			Name:   &name,
+			Profile: &storepb.UserProfile{
+				Source:                 source,
+				LastLoginTime:          user.Profile.GetLastLoginTime(),
+				LastChangePasswordTime: user.Profile.GetLastChangePasswordTime(),
+			},
+		})
+		if err != nil {
+			return writeError(c, errors.Wrapf(err, "failed to update user %q", user.Email))
+		}
+		user = updatedUser
+	}
+
+	return writeJSON(c, http.StatusCreated, convertToUser(user, getBaseURL(c)))
+}
+
+// listUsers lists users. The IdPs send ?filter=userName eq "{email}" query to find the user before creating it.
+// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups#get-user-by-query
+func (s *Service) listUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	query, err := parseListQuery(c)
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	endUser := storepb.PrincipalType_END_USER
+	find := &store.FindUserMessage{
+		Type: &endUser,
+	}
+	if value, ok := getEqualFilterValue(query.filter, "userName"); ok {
+		// Normalize email to lowercase for consistent lookup
+		email := normalizeEmail(value)
+		find.Email = &email
+	}
+	users, err := s.store.ListUsers(ctx, find)
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to list users"))
+	}
+
+	var scimUsers []*User
+	for _, user := range users {
+		if user.MemberDeleted {
+			continue
+		}
+		scimUsers = append(scimUsers, convertToUser(user, getBaseURL(c)))
+	}
+	response, err := filterAndPaginate(scimUsers, query)
+	if err != nil {
+		return writeError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, response)
+}
+
+// getUser gets a single user. The user id is the Bytebase user uid.
+func (s *Service) getUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("userID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+	if user.MemberDeleted {
+		return writeError(c, newSCIMError(http.StatusNotFound, "", errors.Errorf("user %q not found", c.Param("userID"))))
+	}
+
+	resource, err := toResourceMap(convertToUser(user, getBaseURL(c)))
+	if err != nil {
+		return writeError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, projectAttributes(resource, splitAttributes(c.QueryParam("attributes")), splitAttributes(c.QueryParam("excludedAttributes"))))
+}
+
+// replaceUser replaces the user attributes. Okta and OneLogin update the user profile with PUT.
+func (s *Service) replaceUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	var scimUser User
+	if err := readBody(c, &scimUser); err != nil {
+		return writeError(c, err)
+	}
+	user, err := s.findUser(ctx, c.Param("userID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+	email := scimUser.email()
+	if email == "" {
+		return writeError(c, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.New("userName or emails must contain an email")))
+	}
+
+	name := scimUser.displayName()
+	deleted := !scimUser.active()
+	update := &store.UpdateUserMessage{
+		Name:   &name,
+		Delete: &deleted,
+		Profile: &storepb.UserProfile{
+			Source:                 getSource(c),
+			LastLoginTime:          user.Profile.GetLastLoginTime(),
+			LastChangePasswordTime: user.Profile.GetLastChangePasswordTime(),
+		},
+	}
+	if email != user.Email {
+		if err := s.checkEmailAvailable(ctx, email); err != nil {
+			return writeError(c, err)
+		}
+		update.Email = &email
+	}
+	updatedUser, err := s.store.UpdateUser(ctx, user, update)
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to update user %q", user.Email))
+	}
+	return writeJSON(c, http.StatusOK, convertToUser(updatedUser, getBaseURL(c)))
+}
+
+func (s *Service) patchUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	var patch PatchRequest
+	if err := readBody(c, &patch); err != nil {
+		return writeError(c, err)
+	}
+	user, err := s.findUser(ctx, c.Param("userID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+
+	update, err := getUserPatch(user, patch.Operations)
+	if err != nil {
+		return writeError(c, err)
+	}
+	if update.Email != nil {
+		if err := s.checkEmailAvailable(ctx, *update.Email); err != nil {
+			return writeError(c, err)
+		}
+	}
+	updatedUser, err := s.store.UpdateUser(ctx, user, update)
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to update user %q", user.Email))
+	}
+	return writeJSON(c, http.StatusOK, convertToUser(updatedUser, getBaseURL(c)))
+}
+
+// deleteUser archives the user.
+func (s *Service) deleteUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("userID"))
+	if err != nil {
+		return writeError(c, err)
+	}
+	if user.MemberDeleted {
+		return writeError(c, newSCIMError(http.StatusNotFound, "", errors.Errorf("user %q not found", c.Param("userID"))))
+	}
+
+	deleteUser := true
+	if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
+		Delete: &deleteUser,
+	}); err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to delete user"))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// findUser finds the end user by the SCIM id, which is the Bytebase user uid.
+func (s *Service) findUser(ctx context.Context, userID string) (*store.UserMessage, error) {
+	uid, err := strconv.Atoi(userID)
+	if err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", errors.Errorf("user %q not found", userID))
+	}
+	user, err := s.store.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user")
+	}
+	if user == nil || user.Type != storepb.PrincipalType_END_USER {
+		return nil, newSCIMError(http.StatusNotFound, "", errors.Errorf("user %q not found", userID))
+	}
+	return user, nil
+}
+
+func (s *Service) checkEmailAvailable(ctx context.Context, email string) error {
+	existing, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get user %s", email)
+	}
+	if existing != nil {
+		return newSCIMError(http.StatusConflict, errorTypeUniqueness, errors.Errorf("email %q is used by another user", email))
+	}
+	return nil
+}
+
+// getUserPatch applies the PATCH operations to the user and returns the store update.
+func getUserPatch(user *store.UserMessage, operations []*PatchOperation) (*store.UpdateUserMessage, error) {
+	original := convertToUser(user, "")
+	resource, err := toResourceMap(original)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPatch(resource, operations); err != nil {
+		return nil, err
+	}
+	var patched User
+	if err := fromResourceMap(resource, &patched); err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateUserMessage{}
+	if patched.DisplayName != original.DisplayName {
+		name := patched.displayName()
+		update.Name = &name
+	} else if name := patched.Name.fullName(); name != "" && name != user.Name {
+		// The name is write-only, so any name in the patched user comes from the PATCH operations.
+		update.Name = &name
+	}
+	if email := patched.email(); email != "" && email != user.Email {
+		update.Email = &email
+	}
+	if patched.active() == user.MemberDeleted {
+		deleted := !patched.active()
+		update.Delete = &deleted
+	}
+	return update, nil
+}
+
+func convertToUser(user *store.UserMessage, baseURL string) *User {
+	id := strconv.Itoa(user.ID)
+	active := Boolean(!user.MemberDeleted)
+	primary := Boolean(true)
+	scimUser := &User{
+		Schemas: []string{
+			userSchema,
+		},
+		ID:          id,
+		UserName:    user.Email,
+		Active:      &active,
+		DisplayName: user.Name,
+		Emails: []*MultiValuedAttribute{
+			{
+				Type:    "work",
+				Primary: &primary,
+				Value:   user.Email,
+			},
+		},
+		Meta: &ResourceMeta{
+			ResourceType: "User",
+		},
+	}
+	if !user.CreatedAt.IsZero() {
+		scimUser.Meta.Created = user.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if baseURL != "" {
+		scimUser.Meta.Location = baseURL + "/Users/" + id
+	}
+	return scimUser
+}
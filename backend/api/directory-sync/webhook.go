@@ -4,644 +4,397 @@ import (
 	"context"
 	"crypto/subtle"
 	"encoding/json"
-	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
-	"slices"
 	"strconv"
 	"strings"
 
-	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
-
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 
-	v1api "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
-	"github.com/bytebase/bytebase/backend/store"
-	"github.com/bytebase/bytebase/backend/utils"
 )
 
-const entraIDSource = "Entra ID"
+const (
+	entraIDSource  = "Entra ID"
+	oktaSource     = "Okta"
+	oneLoginSource = "OneLogin"
+
+	scimContentType = "application/scim+json"
+	// baseURLContextKey is the echo context key of the SCIM base URL,
+	// e.g. https://bytebase.example.com/hook/scim/workspaces/{workspaceID}.
+	baseURLContextKey = "scimBaseURL"
+)
 
+// RegisterDirectorySyncRoutes registers the SCIM 2.0 endpoints.
+// https://datatracker.ietf.org/doc/html/rfc7644
 // https://developer.xurrent.com/v1/scim/service_provider_config/
 // https://scim.cloud/
 // https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups
+// https://developer.okta.com/docs/api/openapi/okta-scim/guides/scim-20
+// https://developers.onelogin.com/scim
 func (s *Service) RegisterDirectorySyncRoutes(g *echo.Group) {
-	g.POST("/workspaces/:workspaceID/Users", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		body, err := io.ReadAll(c.Request().Body)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
-		}
-
-		var aadUser AADUser
-		if err := json.Unmarshal(body, &aadUser); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
-		}
-
-		// Normalize email to lowercase as Bytebase requires lowercase emails
-		normalizedEmail := normalizeEmail(aadUser.UserName)
-		user, err := s.store.GetUserByEmail(ctx, normalizedEmail)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user %s, error %v", aadUser.UserName, err))
-		}
-		if user == nil {
-			password, err := common.RandomString(20)
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to generate random password, error %v", err))
-			}
-			passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to generate password hash, error %v", err))
-			}
-			newUser, err := s.store.CreateUser(ctx, &store.UserMessage{
-				Name:          aadUser.DisplayName,
-				Email:         normalizedEmail,
-				Type:          storepb.PrincipalType_END_USER,
-				MemberDeleted: !aadUser.Active,
-				PasswordHash:  string(passwordHash),
-				Profile: &storepb.UserProfile{
-					Source: entraIDSource,
-				},
-			})
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to create user "%s", error %v`, aadUser.UserName, err))
-			}
-			user = newUser
-		} else {
-			deleted := !aadUser.Active
-			updatedUser, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
-				Delete: &deleted,
-				Name:   &aadUser.DisplayName,
-				Email:  &normalizedEmail,
-				Profile: &storepb.UserProfile{
-					Source:                 entraIDSource,
-					LastLoginTime:          user.Profile.LastLoginTime,
-					LastChangePasswordTime: user.Profile.LastChangePasswordTime,
-				},
-			})
-			if err != nil {
-				return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to update user "%s", error %v`, user.Email, err))
-			}
-			user = updatedUser
-		}
+	w := g.Group("/workspaces/:workspaceID", s.authenticate)
 
-		return c.JSON(http.StatusCreated, convertToAADUser(user))
+	w.GET("/ServiceProviderConfig", func(c echo.Context) error {
+		return writeJSON(c, http.StatusOK, newServiceProviderConfig(getBaseURL(c)))
 	})
-
-	// Get a single user. The user id is the Bytebase user uid.
-	g.GET("/workspaces/:workspaceID/Users/:userID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		uid, err := strconv.Atoi(c.Param("userID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-		}
-
-		user, err := s.store.GetUserByID(ctx, uid)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
+	w.GET("/ResourceTypes", func(c echo.Context) error {
+		var resources []any
+		for _, resourceType := range newResourceTypes(getBaseURL(c)) {
+			resources = append(resources, resourceType)
 		}
-		if user == nil || user.MemberDeleted {
-			return c.JSON(http.StatusNotFound, map[string]any{
-				"schemas": []string{
-					"urn:ietf:params:scim:api:messages:2.0:Error",
-				},
-				"status": "404",
-			})
-		}
-
-		return c.JSON(http.StatusOK, convertToAADUser(user))
+		return writeJSON(c, http.StatusOK, newListResponse(resources, 1, len(resources)))
 	})
-
-	// List users. AAD SCIM will send ?filter=userName eq "{email}" query.
-	// userName maps to userPrincipalName in Azure, which is typically the user's email.
-	// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups#get-user-by-query
-	g.GET("/workspaces/:workspaceID/Users", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		response := &ListUsersResponse{
-			Schemas: []string{
-				"urn:ietf:params:scim:api:messages:2.0:ListResponse",
-			},
-			TotalResults: 0,
-			Resources:    []*AADUser{},
-		}
-
-		filter := c.QueryParam("filter")
-		if filter == "" {
-			return c.JSON(http.StatusOK, response)
-		}
-
-		filters, err := v1api.ParseFilter(filter)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse filter, error %v", err))
-		}
-
-		find := &store.FindUserMessage{}
-		for _, expr := range filters {
-			if expr.Operator != v1api.ComparatorTypeEqual {
-				slog.Warn("unsupport filter operation", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
+	w.GET("/ResourceTypes/:id", func(c echo.Context) error {
+		for _, resourceType := range newResourceTypes(getBaseURL(c)) {
+			if resourceType.ID == c.Param("id") {
+				return writeJSON(c, http.StatusOK, resourceType)
 			}
-			if expr.Key != "userName" {
-				slog.Warn("unsupport filter key", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			// Normalize email to lowercase for consistent lookup
-			normalizedEmail := normalizeEmail(expr.Value)
-			find.Email = &normalizedEmail
-		}
-
-		users, err := s.store.ListUsers(ctx, find)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to list user, error %v`, err))
-		}
-
-		for _, user := range users {
-			if user.MemberDeleted {
-				continue
-			}
-			response.TotalResults++
-			response.Resources = append(response.Resources, convertToAADUser(user))
 		}
-
-		return c.JSON(http.StatusOK, response)
+		return writeError(c, newSCIMError(http.StatusNotFound, "", errors.Errorf("resource type %q not found", c.Param("id"))))
 	})
-
-	g.DELETE("/workspaces/:workspaceID/Users/:userID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+	w.GET("/Schemas", func(c echo.Context) error {
+		var resources []any
+		for _, schema := range newSchemas(getBaseURL(c)) {
+			resources = append(resources, schema)
 		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		uid, err := strconv.Atoi(c.Param("userID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-		}
-
-		user, err := s.store.GetUserByID(ctx, uid)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-		}
-		if user == nil || user.MemberDeleted {
-			return c.String(http.StatusNoContent, "")
-		}
-
-		deleteUser := true
-		if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
-			Delete: &deleteUser,
-		}); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to delete user, error %v", err))
-		}
-
-		return c.String(http.StatusNoContent, "")
+		return writeJSON(c, http.StatusOK, newListResponse(resources, 1, len(resources)))
 	})
-
-	g.PATCH("/workspaces/:workspaceID/Users/:userID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		body, err := io.ReadAll(c.Request().Body)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
-		}
-
-		var patch PatchRequest
-		if err := json.Unmarshal(body, &patch); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
-		}
-
-		uid, err := strconv.Atoi(c.Param("userID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-		}
-
-		user, err := s.store.GetUserByID(ctx, uid)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-		}
-		if user == nil {
-			return c.String(http.StatusNotFound, "cannot found user")
-		}
-
-		updateUser := &store.UpdateUserMessage{}
-		for _, op := range patch.Operations {
-			if op.OP != "Replace" {
-				slog.Warn("unsupport operation type", slog.String("operation", op.OP), slog.String("path", op.Path))
-				continue
-			}
-			switch op.Path {
-			case "displayName":
-				displayName, ok := op.Value.(string)
-				if !ok {
-					slog.Warn("unsupport value, expect string", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				updateUser.Name = &displayName
-			case "userName":
-				email, ok := op.Value.(string)
-				if !ok {
-					slog.Warn("unsupport value, expect string", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				// Normalize email to lowercase as Bytebase requires lowercase emails
-				normalizedEmail := normalizeEmail(email)
-				updateUser.Email = &normalizedEmail
-			case "active":
-				active, ok := op.Value.(bool)
-				if !ok {
-					slog.Warn("unsupport value, expect bool", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				isDelete := !active
-				updateUser.Delete = &isDelete
-			default:
-				slog.Warn("unsupport patch", slog.String("operation", op.OP), slog.String("path", op.Path))
+	w.GET("/Schemas/:id", func(c echo.Context) error {
+		for _, schema := range newSchemas(getBaseURL(c)) {
+			if schema.ID == c.Param("id") {
+				return writeJSON(c, http.StatusOK, schema)
 			}
 		}
-
-		updatedUser, err := s.store.UpdateUser(ctx, user, updateUser)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to update user, error %v", err))
-		}
-
-		return c.JSON(http.StatusOK, convertToAADUser(updatedUser))
+		return writeError(c, newSCIMError(http.StatusNotFound, "", errors.Errorf("schema %q not found", c.Param("id"))))
 	})
 
-	g.POST("/workspaces/:workspaceID/Groups", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		body, err := io.ReadAll(c.Request().Body)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
-		}
-
-		var aadGroup AADGroup
-		if err := json.Unmarshal(body, &aadGroup); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
-		}
-
-		// Azure SCIM sync group process:
-		// 1. POST users
-		// 2. POST group without members
-		// 3. PATCH group with members
-		group, err := s.store.CreateGroup(ctx, &store.GroupMessage{
-			ID:    aadGroup.ExternalID,
-			Email: aadGroup.Email,
-			Title: aadGroup.DisplayName,
-			Payload: &storepb.GroupPayload{
-				Source: entraIDSource,
-			},
-		})
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to create group, error %v", err))
-		}
-
-		return c.JSON(http.StatusCreated, convertToAADGroup(group))
-	})
+	w.POST("/Users", s.createUser)
+	w.GET("/Users", s.listUsers)
+	w.GET("/Users/:userID", s.getUser)
+	w.PUT("/Users/:userID", s.replaceUser)
+	w.PATCH("/Users/:userID", s.patchUser)
+	w.DELETE("/Users/:userID", s.deleteUser)
+
+	w.POST("/Groups", s.createGroup)
+	w.GET("/Groups", s.listGroups)
+	w.GET("/Groups/:groupID", s.getGroup)
+	w.PUT("/Groups/:groupID", s.replaceGroup)
+	w.PATCH("/Groups/:groupID", s.patchGroup)
+	w.DELETE("/Groups/:groupID", s.deleteGroup)
+}
 
-	// Get a single group. The group id is the Bytebase group resource id.
-	g.GET("/workspaces/:workspaceID/Groups/:groupID", func(c echo.Context) error {
+// authenticate validates the request and sets the SCIM base URL in the context.
+func (s *Service) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
 		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
-
-		groupName, err := decodeGroupIdentifier(c.Param("groupID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse group %v, error %v", c.Param("groupID"), err))
-		}
-		group, err := utils.GetGroupByName(ctx, s.store, groupName)
+		externalURL, err := s.validRequestURL(ctx, c)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to find group, error %v", err))
-		}
-		if group == nil {
-			return c.JSON(http.StatusNotFound, map[string]any{
-				"schemas": []string{
-					"urn:ietf:params:scim:api:messages:2.0:Error",
-				},
-				"status": "404",
-			})
-		}
-
-		return c.JSON(http.StatusOK, convertToAADGroup(group))
-	})
-
-	// List groups. AAD SCIM will send ?filter=externalId eq "{value}" query.
-	// externalId can be Azure's objectId or group email depending on customer's attribute mapping:
-	//   - New default: objectId -> externalId (recommended, stable across email changes)
-	//   - Legacy mapping: mail -> externalId (for backward compatibility)
-	// Docs: https://learn.microsoft.com/en-us/entra/identity/app-provisioning/use-scim-to-provision-users-and-groups#get-group-by-query
-	g.GET("/workspaces/:workspaceID/Groups", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
+			return writeError(c, newSCIMError(http.StatusUnauthorized, "", err))
 		}
 		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
+			return writeError(c, newSCIMError(http.StatusForbidden, "", err))
 		}
 
-		response := &ListGroupsResponse{
-			Schemas: []string{
-				"urn:ietf:params:scim:api:messages:2.0:ListResponse",
-			},
-			TotalResults: 0,
-			Resources:    []*AADGroup{},
+		path := c.Request().URL.Path
+		workspacePath := "/workspaces/" + c.Param("workspaceID")
+		if i := strings.Index(path, workspacePath); i >= 0 {
+			path = path[:i+len(workspacePath)]
 		}
+		c.Set(baseURLContextKey, strings.TrimSuffix(externalURL, "/")+path)
+		return next(c)
+	}
+}
 
-		filter := c.QueryParam("filter")
-		if filter == "" {
-			return c.JSON(http.StatusOK, response)
-		}
+// validRequestURL validates the workspace and the token, and returns the external URL.
+func (s *Service) validRequestURL(ctx context.Context, c echo.Context) (string, error) {
+	authorization := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+	if authorization == "" {
+		return "", errors.Errorf("missing authorization token")
+	}
 
-		filters, err := v1api.ParseFilter(filter)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse filter, error %v", err))
-		}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	// Use command-line flag value if set, otherwise use database value
+	externalURL := common.GetEffectiveExternalURL(s.profile.ExternalURL, setting.ExternalUrl)
+	if externalURL == "" {
+		return "", errors.Errorf("external URL is empty")
+	}
 
-		find := &store.FindGroupMessage{}
-		for _, expr := range filters {
-			if expr.Operator != v1api.ComparatorTypeEqual {
-				slog.Warn("unsupport filter operation", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			if expr.Key != "externalId" {
-				slog.Warn("unsupport filter key", slog.String("key", expr.Key), slog.String("operator", string(expr.Operator)), slog.String("value", expr.Value))
-				continue
-			}
-			// externalId can be either Azure's objectId or group email, depending on customer's attribute mapping.
-			// - New default: objectId -> externalId (UUID format, no @)
-			// - Legacy mapping: mail -> externalId (email format, contains @)
-			if strings.Contains(expr.Value, "@") {
-				find.Email = &expr.Value
-			} else {
-				find.ID = &expr.Value
-			}
-		}
+	workspaceID := c.Param("workspaceID")
 
-		groups, err := s.store.ListGroups(ctx, find)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf(`failed to list group, error %v`, err))
-		}
+	myWorkspaceID, err := s.store.GetWorkspaceID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if myWorkspaceID != workspaceID {
+		return "", errors.Errorf("invalid workspace id %q, my ID %q", workspaceID, myWorkspaceID)
+	}
 
-		for _, group := range groups {
-			response.TotalResults++
-			response.Resources = append(response.Resources, convertToAADGroup(group))
-		}
+	scimSetting, err := s.store.GetSettingV2(ctx, storepb.SettingName_SCIM)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find scim setting")
+	}
+	if scimSetting == nil {
+		return "", errors.Errorf("cannot found scim setting")
+	}
+	payload := new(storepb.SCIMSetting)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(scimSetting.Value), payload); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal scim setting")
+	}
 
-		return c.JSON(http.StatusOK, response)
-	})
+	if subtle.ConstantTimeCompare([]byte(payload.Token), []byte(authorization)) != 1 {
+		return "", errors.Errorf("invalid authorization token")
+	}
 
-	g.DELETE("/workspaces/:workspaceID/Groups/:groupID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
+	return externalURL, nil
+}
 
-		groupName, err := decodeGroupIdentifier(c.Param("groupID"))
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse group %v, error %v", c.Param("groupID"), err))
-		}
-		group, err := utils.GetGroupByName(ctx, s.store, groupName)
-		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to find group, error %v", err))
-		}
-		if group == nil {
-			return c.JSON(http.StatusNotFound, map[string]any{
-				"schemas": []string{
-					"urn:ietf:params:scim:api:messages:2.0:Error",
-				},
-				"status": "404",
-			})
-		}
+func getBaseURL(c echo.Context) string {
+	baseURL, _ := c.Get(baseURLContextKey).(string)
+	return baseURL
+}
 
-		if err := s.store.DeleteGroup(ctx, group.ID); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to delete group, error %v", err))
-		}
+// getSource returns the source of the users and groups by the user agent of the SCIM client.
+func getSource(c echo.Context) string {
+	userAgent := strings.ToLower(c.Request().UserAgent())
+	switch {
+	case strings.Contains(userAgent, "okta"):
+		return oktaSource
+	case strings.Contains(userAgent, "onelogin"):
+		return oneLoginSource
+	default:
+		return entraIDSource
+	}
+}
 
-		if err := s.iamManager.ReloadCache(ctx); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to reload iam cache, error %v", err))
-		}
+// readBody reads and unmarshals the request body.
+func readBody(c echo.Context, v any) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, errorTypeInvalidSyntax, errors.Wrapf(err, "failed to read body"))
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return newSCIMError(http.StatusBadRequest, errorTypeInvalidSyntax, errors.Wrapf(err, "failed to unmarshal body"))
+	}
+	return nil
+}
+
+func writeJSON(c echo.Context, status int, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return writeError(c, errors.Wrapf(err, "failed to marshal response"))
+	}
+	return c.Blob(status, scimContentType, body)
+}
 
-		return c.JSON(http.StatusNoContent, "")
+// writeError writes the SCIM error response.
+// The errors other than scimError are internal errors.
+func writeError(c echo.Context, err error) error {
+	status, scimType := http.StatusInternalServerError, ""
+	var e *scimError
+	if errors.As(err, &e) {
+		status, scimType = e.status, e.scimType
+	}
+	if status >= http.StatusInternalServerError {
+		slog.Error("failed to handle SCIM request", slog.String("method", c.Request().Method), slog.String("path", c.Request().URL.Path), log.BBError(err))
+	}
+	body, marshalErr := json.Marshal(&ErrorResponse{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(status),
+		SCIMType: scimType,
+		Detail:   err.Error(),
 	})
+	if marshalErr != nil {
+		return c.String(http.StatusInternalServerError, marshalErr.Error())
+	}
+	return c.Blob(status, scimContentType, body)
+}
 
-	g.PATCH("/workspaces/:workspaceID/Groups/:groupID", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		if err := s.validRequestURL(ctx, c); err != nil {
-			return c.String(http.StatusInternalServerError, err.Error())
-		}
-		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DIRECTORY_SYNC); err != nil {
-			return c.String(http.StatusForbidden, err.Error())
-		}
+// listQuery is the query parameters of the list requests.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2
+type listQuery struct {
+	filter filterExpr
+	// startIndex is the 1-based index of the first result.
+	startIndex int
+	// count is the maximum number of results, or -1 if unspecified.
+	count              int
+	attributes         []string
+	excludedAttributes []string
+}
 
-		body, err := io.ReadAll(c.Request().Body)
+func parseListQuery(c echo.Context) (*listQuery, error) {
+	query := &listQuery{
+		startIndex:         1,
+		count:              -1,
+		attributes:         splitAttributes(c.QueryParam("attributes")),
+		excludedAttributes: splitAttributes(c.QueryParam("excludedAttributes")),
+	}
+	if v := c.QueryParam("filter"); v != "" {
+		filter, err := parseFilter(v)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
-		}
-
-		var patch PatchRequest
-		if err := json.Unmarshal(body, &patch); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal body, error %v", err))
+			return nil, newSCIMError(http.StatusBadRequest, errorTypeInvalidFilter, err)
 		}
-
-		groupName, err := decodeGroupIdentifier(c.Param("groupID"))
+		query.filter = filter
+	}
+	if v := c.QueryParam("startIndex"); v != "" {
+		startIndex, err := strconv.Atoi(v)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse group %v, error %v", c.Param("groupID"), err))
+			return nil, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.Errorf("invalid startIndex %q", v))
 		}
-		group, err := utils.GetGroupByName(ctx, s.store, groupName)
+		// A value less than 1 is interpreted as 1.
+		query.startIndex = max(startIndex, 1)
+	}
+	if v := c.QueryParam("count"); v != "" {
+		count, err := strconv.Atoi(v)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to find group, error %v", err))
-		}
-		if group == nil {
-			return c.JSON(http.StatusNotFound, map[string]any{
-				"schemas": []string{
-					"urn:ietf:params:scim:api:messages:2.0:Error",
-				},
-				"status": "404",
-			})
+			return nil, newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.Errorf("invalid count %q", v))
 		}
+		// A negative value is interpreted as 0.
+		query.count = max(count, 0)
+	}
+	return query, nil
+}
 
-		updateGroup := &store.UpdateGroupMessage{
-			ID: group.ID,
-		}
-		for _, op := range patch.Operations {
-			switch op.Path {
-			case "members":
-				values, ok := op.Value.([]any)
-				if !ok {
-					slog.Warn("unsupport value, expect PatchMember slice", slog.Any("value", op.Value), slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-
-				updateGroup.Payload = group.Payload
-				updateGroup.Payload.Source = entraIDSource
-
-				for _, value := range values {
-					var patchMember PatchMember
-					bytes, err := json.Marshal(value)
-					if err != nil {
-						slog.Warn("failed to marshal patch member", slog.Any("value", value), slog.String("operation", op.OP), slog.String("path", op.Path), log.BBError(err))
-						continue
-					}
-					if err := json.Unmarshal(bytes, &patchMember); err != nil {
-						slog.Warn("failed to unmarshal patch member", slog.Any("value", value), slog.String("operation", op.OP), slog.String("path", op.Path), log.BBError(err))
-						continue
-					}
-
-					// the member identifier in group patch is Bytebase user uid
-					uid, err := strconv.Atoi(patchMember.Value)
-					if err != nil {
-						return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to parse user id, error %v", err))
-					}
-					user, err := s.store.GetUserByID(ctx, uid)
-					if err != nil {
-						return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error %v", err))
-					}
-					if user == nil {
-						slog.Warn("cannot found user", slog.String("operation", op.OP), slog.String("uid", patchMember.Value))
-						continue
-					}
-
-					member := &storepb.GroupMember{
-						Member: common.FormatUserUID(user.ID),
-						Role:   storepb.GroupMember_MEMBER,
-					}
-					index := slices.IndexFunc(group.Payload.Members, func(m *storepb.GroupMember) bool {
-						return m.Member == member.Member
-					})
-					switch op.OP {
-					case "Add":
-						if index < 0 {
-							updateGroup.Payload.Members = append(updateGroup.Payload.Members, member)
-						}
-					case "Remove":
-						if index >= 0 {
-							updateGroup.Payload.Members = slices.Delete(updateGroup.Payload.Members, index, index+1)
-						}
-					default:
-						slog.Warn("unsupport operation type", slog.String("operation", op.OP), slog.String("path", op.Path))
-						continue
-					}
-				}
-			case "displayName":
-				if op.OP != "Replace" {
-					slog.Warn("unsupport operation type", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				displayName, ok := op.Value.(string)
-				if !ok {
-					slog.Warn("unsupport value, expect string", slog.String("operation", op.OP), slog.String("path", op.Path))
-					continue
-				}
-				updateGroup.Title = &displayName
-			default:
-				slog.Warn("unsupport patch", slog.String("operation", op.OP), slog.String("path", op.Path))
-			}
+func splitAttributes(s string) []string {
+	var attributes []string
+	for _, attribute := range strings.Split(s, ",") {
+		if attribute = strings.TrimSpace(attribute); attribute != "" {
+			attributes = append(attributes, attribute)
 		}
+	}
+	return attributes
+}
 
-		updatedGroup, err := s.store.UpdateGroup(ctx, updateGroup)
+// filterAndPaginate filters the resources and returns the requested page.
+// The resources are converted to their JSON object representation to evaluate the filter and project the attributes.
+func filterAndPaginate[T any](resources []T, query *listQuery) (*ListResponse, error) {
+	var matched []map[string]any
+	for _, resource := range resources {
+		m, err := toResourceMap(resource)
 		if err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to update group, error %v", err))
+			return nil, err
 		}
-		// Reload IAM cache to make sure the group members are updated.
-		if err := s.iamManager.ReloadCache(ctx); err != nil {
-			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to reload iam cache, error %v", err))
+		if query.filter != nil && !query.filter.match(m) {
+			continue
 		}
+		matched = append(matched, m)
+	}
 
-		return c.JSON(http.StatusOK, convertToAADGroup(updatedGroup))
-	})
+	count := query.count
+	if count < 0 || count > maxResults {
+		count = maxResults
+	}
+	var page []any
+	for i := query.startIndex - 1; i < len(matched) && len(page) < count; i++ {
+		page = append(page, projectAttributes(matched[i], query.attributes, query.excludedAttributes))
+	}
+	response := newListResponse(page, query.startIndex, len(matched))
+	return response, nil
 }
 
-func (s *Service) validRequestURL(ctx context.Context, c echo.Context) error {
-	authorization := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
-	if authorization == "" {
-		return errors.Errorf("missing authorization token")
+func newListResponse(resources []any, startIndex, totalResults int) *ListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+	return &ListResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
 	}
+}
 
-	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
-	if err != nil {
-		return err
+// projectAttributes returns the resource with the requested top-level attributes.
+// The id and schemas are always returned.
+// Docs: https://datatracker.ietf.org/doc/html/rfc7644#section-3.9
+func projectAttributes(resource map[string]any, attributes, excludedAttributes []string) map[string]any {
+	if len(attributes) == 0 && len(excludedAttributes) == 0 {
+		return resource
 	}
-	// Use command-line flag value if set, otherwise use database value
-	externalURL := common.GetEffectiveExternalURL(s.profile.ExternalURL, setting.ExternalUrl)
-	if externalURL == "" {
-		return errors.Errorf("external URL is empty")
+	included := map[string]bool{}
+	for _, attribute := range attributes {
+		if path, err := parseAttrPath(attribute); err == nil {
+			included[strings.ToLower(path.attribute)] = true
+		}
 	}
+	excluded := map[string]bool{}
+	for _, attribute := range excludedAttributes {
+		if path, err := parseAttrPath(attribute); err == nil && path.subAttr == "" {
+			excluded[strings.ToLower(path.attribute)] = true
+		}
+	}
+	result := map[string]any{}
+	for k, v := range resource {
+		key := strings.ToLower(k)
+		if key != "id" && key != "schemas" {
+			if len(included) > 0 && !included[key] {
+				continue
+			}
+			if excluded[key] {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
 
-	workspaceID := c.Param("workspaceID")
-
-	myWorkspaceID, err := s.store.GetWorkspaceID(ctx)
+// toResourceMap converts the resource to its JSON object representation.
+func toResourceMap(resource any) (map[string]any, error) {
+	bytes, err := json.Marshal(resource)
 	if err != nil {
-		return err
+		return nil, errors.Wrapf(err, "failed to marshal resource")
 	}
-	if myWorkspaceID != workspaceID {
-		return errors.Errorf("invalid workspace id %q, my ID %q", workspaceID, myWorkspaceID)
+	m := map[string]any{}
+	if err := json.Unmarshal(bytes, &m); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal resource")
 	}
+	return m, nil
+}
 
-	scimSetting, err := s.store.GetSettingV2(ctx, storepb.SettingName_SCIM)
+// fromResourceMap converts the JSON object representation back to the resource.
+func fromResourceMap(m map[string]any, resource any) error {
+	bytes, err := json.Marshal(m)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find scim setting")
+		return errors.Wrapf(err, "failed to marshal resource")
 	}
-	if scimSetting == nil {
-		return errors.Errorf("cannot found scim setting")
-	}
-	payload := new(storepb.SCIMSetting)
-	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(scimSetting.Value), payload); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal scim setting")
+	if err := json.Unmarshal(bytes, resource); err != nil {
+		return newSCIMError(http.StatusBadRequest, errorTypeInvalidValue, errors.Wrapf(err, "invalid patched resource"))
 	}
+	return nil
+}
 
-	if subtle.ConstantTimeCompare([]byte(payload.Token), []byte(authorization)) != 1 {
-		return errors.Errorf("invalid authorization token")
+// getEqualFilterValue returns the value of the top-level "attribute eq value" expression in the filter,
+// which is used to narrow down the resources to query from the store.
+func getEqualFilterValue(filter filterExpr, attribute string) (string, bool) {
+	switch filter := filter.(type) {
+	case *attrExpr:
+		if filter.operator != operatorEqual || filter.path.subAttr != "" || !strings.EqualFold(filter.path.attribute, attribute) {
+			return "", false
+		}
+		value, ok := filter.value.(string)
+		return value, ok
+	case *logicalExpr:
+		if !filter.and {
+			return "", false
+		}
+		if value, ok := getEqualFilterValue(filter.left, attribute); ok {
+			return value, true
+		}
+		return getEqualFilterValue(filter.right, attribute)
+	default:
+		return "", false
 	}
-
-	return nil
 }
 
 func decodeGroupIdentifier(groupID string) (string, error) {
@@ -652,45 +405,6 @@ func decodeGroupIdentifier(groupID string) (string, error) {
 	return identifier, nil
 }
 
-func convertToAADUser(user *store.UserMessage) *AADUser {
-	return &AADUser{
-		Schemas: []string{
-			"urn:ietf:params:scim:schemas:core:2.0:User",
-		},
-		UserName:    user.Email,
-		Active:      !user.MemberDeleted,
-		DisplayName: user.Name,
-		ID:          fmt.Sprintf("%d", user.ID),
-		Emails: []*AADUserEmail{
-			{
-				Type:    "work",
-				Primary: true,
-				Value:   user.Email,
-			},
-		},
-		Meta: &AADResourceMeta{
-			ResourceType: "User",
-		},
-	}
-}
-
-func convertToAADGroup(group *store.GroupMessage) *AADGroup {
-	return &AADGroup{
-		Schemas: []string{
-			"urn:ietf:params:scim:schemas:core:2.0:Group",
-		},
-		// We use the azure group object id (external id) to create the group.
-		// So both ID and ExternalID should be the group.ID (equals external id).
-		ID:          group.ID,
-		ExternalID:  group.ID,
-		Email:       group.Email,
-		DisplayName: group.Title,
-		Meta: &AADResourceMeta{
-			ResourceType: "Group",
-		},
-	}
-}
-
 // normalizeEmail converts email to lowercase to ensure consistency.
 // Bytebase requires all emails to be lowercase for proper user lookup and authentication.
 func normalizeEmail(email string) string {
@@ -0,0 +1,191 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func (h *Handler) handleTextDocumentCodeAction(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.CodeActionParams) ([]lsp.CodeAction, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/codeAction not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	if !wantQuickFix(params.Context.Only) {
+		return nil, nil
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+
+	advices, err := h.reviewStatement(ctx, string(content))
+	if err != nil {
+		slog.Debug("Failed to review statement for code actions", log.BBError(err))
+		return nil, nil
+	}
+	return getQuickFixes(params.TextDocument.URI, string(content), params.Range, advices), nil
+}
+
+// reviewStatement runs the SQL review of the connected database against the statement.
+// It returns nil if the worksheet is not connected to a database.
+func (h *Handler) reviewStatement(ctx context.Context, statement string) ([]*storepb.Advice, error) {
+	instance := h.getInstance(ctx)
+	databaseName := h.getDefaultDatabase()
+	if instance == nil || databaseName == "" {
+		return nil, nil
+	}
+	database, err := h.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instance.ResourceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+	}
+	if database == nil {
+		return nil, nil
+	}
+	dbSchema, err := h.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database schema for %q", databaseName)
+	}
+	if dbSchema == nil {
+		return nil, nil
+	}
+	reviewConfig, err := h.store.GetReviewConfigForDatabase(ctx, database)
+	if err != nil {
+		if e, ok := err.(*common.Error); ok && e.Code == common.NotFound {
+			// Continue to check the builtin rules.
+			reviewConfig = &storepb.ReviewConfigPayload{}
+		} else {
+			return nil, errors.Wrapf(err, "failed to get SQL review policy")
+		}
+	}
+
+	engine := instance.Metadata.GetEngine()
+	isObjectCaseSensitive := store.IsObjectCaseSensitive(instance)
+	dbSchemaProto := dbSchema.GetProto()
+	return advisor.SQLReviewCheck(ctx, h.sheetManager, statement, reviewConfig.SqlReviewRules, advisor.Context{
+		Charset:               dbSchemaProto.CharacterSet,
+		Collation:             dbSchemaProto.Collation,
+		DBSchema:              dbSchemaProto,
+		DBType:                engine,
+		OriginalMetadata:      model.NewDatabaseMetadata(dbSchemaProto, nil, nil, engine, isObjectCaseSensitive),
+		CurrentDatabase:       database.DatabaseName,
+		ListDatabaseNamesFunc: h.ListDatabaseNamesFunc,
+		InstanceID:            instance.ResourceID,
+		IsObjectCaseSensitive: isObjectCaseSensitive,
+	})
+}
+
+// wantQuickFix returns true if the client accepts the quick fix code actions.
+func wantQuickFix(only []lsp.CodeActionKind) bool {
+	if len(only) == 0 {
+		return true
+	}
+	for _, kind := range only {
+		if kind == lsp.QuickFix || kind == lsp.Empty {
+			return true
+		}
+	}
+	return false
+}
+
+// getQuickFixes converts the suggested fixes of the advices overlapping the range to the quick fixes.
+func getQuickFixes(uri lsp.DocumentURI, content string, rng lsp.Range, advices []*storepb.Advice) []lsp.CodeAction {
+	lines := strings.Split(content, "\n")
+	var actions []lsp.CodeAction
+	for _, advice := range advices {
+		fix := advice.GetSuggestedFix()
+		if fix == nil || len(fix.Edits) == 0 {
+			continue
+		}
+		if advice.Status != storepb.Advice_WARNING && advice.Status != storepb.Advice_ERROR {
+			continue
+		}
+		overlapped := false
+		var edits []lsp.TextEdit
+		for _, edit := range fix.Edits {
+			editRange := lsp.Range{
+				Start: convertAdvicePosition(lines, edit.StartPosition),
+				End:   convertAdvicePosition(lines, edit.EndPosition),
+			}
+			if rangesOverlap(editRange, rng) {
+				overlapped = true
+			}
+			edits = append(edits, lsp.TextEdit{Range: editRange, NewText: edit.NewText})
+		}
+		if advice.StartPosition != nil {
+			adviceRange := lsp.Range{Start: convertAdvicePosition(lines, advice.StartPosition)}
+			if advice.EndPosition != nil {
+				adviceRange.End = convertAdvicePosition(lines, advice.EndPosition)
+			} else {
+				// Most advices only report the start position, the quick fix is available on the whole line.
+				line := int(adviceRange.Start.Line)
+				adviceRange.End = convertRunePosition(lines, line, utf8.RuneCountInString(lines[line]))
+			}
+			if rangesOverlap(adviceRange, rng) {
+				overlapped = true
+			}
+		}
+		if !overlapped {
+			continue
+		}
+		actions = append(actions, lsp.CodeAction{
+			Title:       fmt.Sprintf("%s (%s)", fix.Title, advice.Title),
+			Kind:        lsp.QuickFix,
+			IsPreferred: true,
+			Edit: &lsp.WorkspaceEdit{
+				Changes: map[lsp.DocumentURI][]lsp.TextEdit{uri: edits},
+			},
+		})
+	}
+	return actions
+}
+
+// convertAdvicePosition converts the one-based line and rune column of the advice to the LSP position.
+func convertAdvicePosition(lines []string, position *storepb.Position) lsp.Position {
+	if position == nil {
+		return lsp.Position{}
+	}
+	line, column := int(position.Line)-1, int(position.Column)-1
+	if column < 0 {
+		column = 0
+	}
+	return convertRunePosition(lines, line, column)
+}
+
+// rangesOverlap returns true if the two ranges overlap, the ranges touching each other are treated as overlapped
+// so that the cursor right after an insertion point gets the quick fix.
+func rangesOverlap(a, b lsp.Range) bool {
+	return !positionLess(a.End, b.Start) && !positionLess(b.End, a.Start)
+}
+
+func positionLess(a, b lsp.Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Character < b.Character
+}
@@ -0,0 +1,70 @@
+package lsp
+
+import (
+	"testing"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestGetQuickFixes(t *testing.T) {
+	uri := lsp.DocumentURI("file:///worksheet.sql")
+	content := "SELECT '世界';\nCREATE INDEX idx ON t(a);"
+	advices := []*storepb.Advice{
+		{
+			Status:        storepb.Advice_WARNING,
+			Title:         "index.create-concurrently",
+			StartPosition: &storepb.Position{Line: 2, Column: 1},
+			SuggestedFix: &storepb.SuggestedFix{
+				Title: "Add CONCURRENTLY",
+				Edits: []*storepb.TextEdit{{
+					StartPosition: &storepb.Position{Line: 2, Column: 13},
+					EndPosition:   &storepb.Position{Line: 2, Column: 13},
+					NewText:       " CONCURRENTLY",
+				}},
+			},
+		},
+		{
+			Status:        storepb.Advice_WARNING,
+			Title:         "naming.index.idx",
+			StartPosition: &storepb.Position{Line: 1, Column: 1},
+			SuggestedFix: &storepb.SuggestedFix{
+				Title: `Rename to "idx_t_a"`,
+				Edits: []*storepb.TextEdit{{
+					StartPosition: &storepb.Position{Line: 1, Column: 9},
+					EndPosition:   &storepb.Position{Line: 1, Column: 13},
+					NewText:       "idx_t_a",
+				}},
+			},
+		},
+		{
+			Status:        storepb.Advice_WARNING,
+			Title:         "statement.where.require",
+			StartPosition: &storepb.Position{Line: 2, Column: 1},
+		},
+	}
+
+	actions := getQuickFixes(uri, content, lsp.Range{Start: lsp.Position{Line: 1, Character: 5}, End: lsp.Position{Line: 1, Character: 5}}, advices)
+	require.Equal(t, []lsp.CodeAction{{
+		Title:       "Add CONCURRENTLY (index.create-concurrently)",
+		Kind:        lsp.QuickFix,
+		IsPreferred: true,
+		Edit: &lsp.WorkspaceEdit{
+			Changes: map[lsp.DocumentURI][]lsp.TextEdit{uri: {{
+				Range:   lsp.Range{Start: lsp.Position{Line: 1, Character: 12}, End: lsp.Position{Line: 1, Character: 12}},
+				NewText: " CONCURRENTLY",
+			}}},
+		},
+	}}, actions)
+
+	// The rune columns are converted to the UTF-16 characters.
+	actions = getQuickFixes(uri, content, lsp.Range{Start: lsp.Position{Line: 0, Character: 10}, End: lsp.Position{Line: 0, Character: 10}}, advices)
+	require.Len(t, actions, 1)
+	require.Equal(t, lsp.Range{Start: lsp.Position{Line: 0, Character: 8}, End: lsp.Position{Line: 0, Character: 12}}, actions[0].Edit.Changes[uri][0].Range)
+
+	require.True(t, wantQuickFix(nil))
+	require.True(t, wantQuickFix([]lsp.CodeActionKind{lsp.QuickFix}))
+	require.False(t, wantQuickFix([]lsp.CodeActionKind{lsp.RefactorRewrite}))
+}
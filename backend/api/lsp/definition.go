@@ -0,0 +1,211 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+const (
+	// definitionURIScheme is the scheme of the virtual documents for the object definitions in the database.
+	// The client fetches the content with the workspace/textDocumentContent request.
+	definitionURIScheme = "bytebase"
+)
+
+var objectKindPaths = map[objectKind]string{
+	objectKindView:             "views",
+	objectKindMaterializedView: "materializedViews",
+	objectKindFunction:         "functions",
+	objectKindProcedure:        "procedures",
+}
+
+// TextDocumentContentResult is the result of the workspace/textDocumentContent request.
+type TextDocumentContentResult struct {
+	Text string `json:"text"`
+}
+
+func (h *Handler) handleTextDocumentDefinition(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DefinitionParams) ([]lsp.Location, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/definition not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+	offset, err := offsetForPosition(content, params.Position)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Position.Line, params.Position.Character)
+	}
+
+	resolver := h.newMetadataResolver(ctx)
+	if resolver == nil {
+		return nil, nil
+	}
+	statements, err := splitStatements(resolver.engine, content)
+	if err != nil {
+		slog.Debug("Failed to split statements", slog.String("engine", resolver.engine.String()), log.BBError(err))
+		return nil, nil
+	}
+	statement := getStatementAtOffset(statements, offset)
+	if statement == nil {
+		return nil, nil
+	}
+	parts, _ := getIdentifierAtOffset(statement.text, offset-statement.start)
+	if len(parts) == 0 {
+		return nil, nil
+	}
+
+	// The objects created in the worksheet take precedence over the ones in the database.
+	if location := findDefinitionInDocument(resolver, params.TextDocument.URI, string(content), statements, parts); location != nil {
+		return []lsp.Location{*location}, nil
+	}
+	object := resolver.resolveObject(ctx, parts)
+	if object == nil {
+		return nil, nil
+	}
+	uri, ok := formatDefinitionURI(resolver.instanceID, object)
+	if !ok {
+		return nil, nil
+	}
+	return []lsp.Location{{URI: uri}}, nil
+}
+
+// findDefinitionInDocument finds the CREATE statement of the object in the document.
+func findDefinitionInDocument(resolver *metadataResolver, uri lsp.DocumentURI, content string, statements []*sqlStatement, parts []identifierPart) *lsp.Location {
+	for _, statement := range statements {
+		_, name, ok := getCreatedObject(statement.text)
+		if !ok || len(name) < len(parts) {
+			continue
+		}
+		// The reference matches the created object if the trailing parts are the same, e.g. `t` matches `s.t`.
+		matched := true
+		for i := 1; i <= len(parts); i++ {
+			if normalizeIdentifier(resolver.engine, parts[len(parts)-i]) != normalizeIdentifier(resolver.engine, name[len(name)-i]) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		return &lsp.Location{
+			URI: uri,
+			Range: lsp.Range{
+				Start: positionForOffset(content, statement.start+name[0].start),
+				End:   positionForOffset(content, statement.start+name[len(name)-1].end),
+			},
+		}
+	}
+	return nil
+}
+
+// formatDefinitionURI formats the virtual document URI for the object definition,
+// e.g. bytebase:///instances/{instance}/databases/{database}/schemas/{schema}/views/{view}.
+func formatDefinitionURI(instanceID string, object *databaseObject) (lsp.DocumentURI, bool) {
+	kindPath, ok := objectKindPaths[object.kind]
+	if !ok {
+		return "", false
+	}
+	segments := []string{"instances", instanceID, "databases", object.database, "schemas", object.schema, kindPath, object.name}
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return lsp.DocumentURI(fmt.Sprintf("%s:///%s", definitionURIScheme, strings.Join(segments, "/"))), true
+}
+
+// parseDefinitionURI parses the virtual document URI, and returns the instance ID, the object kind and the qualified name.
+func parseDefinitionURI(uri lsp.DocumentURI) (string, objectKind, []identifierPart, error) {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return "", "", nil, errors.Wrapf(err, "invalid uri %q", uri)
+	}
+	if u.Scheme != definitionURIScheme {
+		return "", "", nil, errors.Errorf("unsupported uri scheme %q", u.Scheme)
+	}
+	segments := strings.Split(strings.TrimPrefix(u.EscapedPath(), "/"), "/")
+	for i, segment := range segments {
+		if segments[i], err = url.PathUnescape(segment); err != nil {
+			return "", "", nil, errors.Wrapf(err, "invalid uri %q", uri)
+		}
+	}
+	if len(segments) != 8 || segments[0] != "instances" || segments[2] != "databases" || segments[4] != "schemas" {
+		return "", "", nil, errors.Errorf("invalid definition uri %q", uri)
+	}
+	var kind objectKind
+	for k, path := range objectKindPaths {
+		if path == segments[6] {
+			kind = k
+		}
+	}
+	if kind == "" {
+		return "", "", nil, errors.Errorf("invalid object type %q", segments[6])
+	}
+	// The names in the uri are normalized, so they are treated as quoted identifiers.
+	parts := []identifierPart{{name: segments[3], quoted: true}}
+	if segments[5] != "" {
+		parts = append(parts, identifierPart{name: segments[5], quoted: true})
+	}
+	parts = append(parts, identifierPart{name: segments[7], quoted: true})
+	return segments[1], kind, parts, nil
+}
+
+func (h *Handler) handleTextDocumentContent(ctx context.Context, params lsp.TextDocumentContentParams) (*TextDocumentContentResult, error) {
+	instanceID, kind, parts, err := parseDefinitionURI(params.URI)
+	if err != nil {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: err.Error()}
+	}
+	resolver := h.newMetadataResolver(ctx)
+	if resolver == nil || resolver.instanceID != instanceID {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: fmt.Sprintf("instance %q is not connected", instanceID)}
+	}
+	object := resolver.resolveObject(ctx, parts)
+	if object == nil || object.kind != kind {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: fmt.Sprintf("%s %q not found", strings.ToLower(string(kind)), joinIdentifierParts(parts))}
+	}
+	return &TextDocumentContentResult{Text: getObjectDefinition(object)}, nil
+}
+
+// getObjectDefinition returns the CREATE statement of the object.
+func getObjectDefinition(object *databaseObject) string {
+	switch object.kind {
+	case objectKindView:
+		return getViewDefinition("VIEW", object.qualifiedName(), object.view.GetDefinition())
+	case objectKindMaterializedView:
+		return getViewDefinition("MATERIALIZED VIEW", object.qualifiedName(), object.materializedView.GetDefinition())
+	case objectKindFunction:
+		var definitions []string
+		for _, function := range object.functions {
+			definitions = append(definitions, strings.TrimSpace(function.GetDefinition()))
+		}
+		return strings.Join(definitions, "\n\n") + "\n"
+	case objectKindProcedure:
+		return strings.TrimSpace(object.procedure.GetDefinition()) + "\n"
+	default:
+		return ""
+	}
+}
+
+// getViewDefinition returns the CREATE statement for the view, most engines only sync the SELECT statement of the view.
+func getViewDefinition(viewType, name, definition string) string {
+	definition = strings.TrimSpace(definition)
+	if strings.HasPrefix(strings.ToUpper(definition), "CREATE") {
+		return definition + "\n"
+	}
+	definition = strings.TrimSuffix(definition, ";")
+	return fmt.Sprintf("CREATE %s %s AS\n%s;\n", viewType, name, definition)
+}
@@ -0,0 +1,88 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func (h *Handler) handleTextDocumentFormatting(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DocumentFormattingParams) ([]lsp.TextEdit, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/formatting not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	return h.formatRange(ctx, string(content), 0, len(content), params.Options)
+}
+
+func (h *Handler) handleTextDocumentRangeFormatting(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DocumentRangeFormattingParams) ([]lsp.TextEdit, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/rangeFormatting not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	start, err := offsetForPosition(content, params.Range.Start)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Range.Start.Line, params.Range.Start.Character)
+	}
+	end, err := offsetForPosition(content, params.Range.End)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Range.End.Line, params.Range.End.Character)
+	}
+	if start > end {
+		start, end = end, start
+	}
+	return h.formatRange(ctx, string(content), start, end, params.Options)
+}
+
+// formatRange formats the content between the byte offsets start and end, and returns the edit replacing the range.
+func (h *Handler) formatRange(ctx context.Context, content string, start, end int, options lsp.FormattingOptions) ([]lsp.TextEdit, error) {
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+	engine := h.getEngineType(ctx)
+	if !base.IsFormatSupported(engine) {
+		return nil, nil
+	}
+	text := content[start:end]
+	formatted, err := base.Format(engine, text, getFormatOptions(options))
+	if err != nil {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidRequest,
+			Message: fmt.Sprintf("failed to format SQL: %v", err),
+		}
+	}
+	if formatted == text {
+		return []lsp.TextEdit{}, nil
+	}
+	return []lsp.TextEdit{{
+		Range: lsp.Range{
+			Start: positionForOffset(content, start),
+			End:   positionForOffset(content, end),
+		},
+		NewText: formatted,
+	}}, nil
+}
+
+func getFormatOptions(options lsp.FormattingOptions) base.FormatOptions {
+	return base.FormatOptions{
+		IndentSize: int(options.TabSize),
+		UseTabs:    !options.InsertSpaces,
+	}
+}
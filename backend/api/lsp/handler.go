@@ -16,6 +16,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
@@ -24,15 +25,24 @@ import (
 type Method string
 
 const (
-	LSPMethodPing           Method = "$ping"
-	LSPMethodInitialize     Method = "initialize"
-	LSPMethodInitialized    Method = "initialized"
-	LSPMethodShutdown       Method = "shutdown"
-	LSPMethodExit           Method = "exit"
-	LSPMethodCancelRequest  Method = "$/cancelRequest"
-	LSPMethodSetTrace       Method = "$/setTrace"
-	LSPMethodExecuteCommand Method = "workspace/executeCommand"
-	LSPMethodCompletion     Method = "textDocument/completion"
+	LSPMethodPing            Method = "$ping"
+	LSPMethodInitialize      Method = "initialize"
+	LSPMethodInitialized     Method = "initialized"
+	LSPMethodShutdown        Method = "shutdown"
+	LSPMethodExit            Method = "exit"
+	LSPMethodCancelRequest   Method = "$/cancelRequest"
+	LSPMethodSetTrace        Method = "$/setTrace"
+	LSPMethodExecuteCommand  Method = "workspace/executeCommand"
+	LSPMethodCompletion      Method = "textDocument/completion"
+	LSPMethodHover           Method = "textDocument/hover"
+	LSPMethodDefinition      Method = "textDocument/definition"
+	LSPMethodDocumentSymbol  Method = "textDocument/documentSymbol"
+	LSPMethodSignatureHelp   Method = "textDocument/signatureHelp"
+	LSPMethodFormatting      Method = "textDocument/formatting"
+	LSPMethodRangeFormatting Method = "textDocument/rangeFormatting"
+	LSPMethodCodeAction      Method = "textDocument/codeAction"
+	// See https://microsoft.github.io/language-server-protocol/specifications/lsp/3.18/specification/#workspace_textDocumentContent.
+	LSPMethodTextDocumentContent Method = "workspace/textDocumentContent"
 
 	LSPMethodTextDocumentDidOpen   Method = "textDocument/didOpen"
 	LSPMethodTextDocumentDidChange Method = "textDocument/didChange"
@@ -48,9 +58,10 @@ const (
 )
 
 // NewHandlerWithAuth creates a new Language Server Protocol handler with authentication.
-func NewHandlerWithAuth(s *store.Store, profile *config.Profile, iamManager *iam.Manager, user *store.UserMessage, tokenExpiry time.Time) jsonrpc2.Handler {
+func NewHandlerWithAuth(s *store.Store, sheetManager *sheet.Manager, profile *config.Profile, iamManager *iam.Manager, user *store.UserMessage, tokenExpiry time.Time) jsonrpc2.Handler {
 	handler := &Handler{
 		store:                s,
+		sheetManager:         sheetManager,
 		profile:              profile,
 		user:                 user,
 		tokenExpiry:          tokenExpiry,
@@ -76,11 +87,12 @@ func (h lspHandler) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrp
 
 // Handler handles Language Server Protocol requests.
 type Handler struct {
-	mu       sync.Mutex
-	fs       *MemFS
-	init     *lsp.InitializeParams // set by LSPMethodInitialize request
-	metadata *SetMetadataCommandArguments
-	store    *store.Store
+	mu           sync.Mutex
+	fs           *MemFS
+	init         *lsp.InitializeParams // set by LSPMethodInitialize request
+	metadata     *SetMetadataCommandArguments
+	store        *store.Store
+	sheetManager *sheet.Manager
 
 	// Auth-related fields
 	user        *store.UserMessage
@@ -161,9 +173,17 @@ func (h *Handler) getScene() base.SceneType {
 }
 
 func (h *Handler) getEngineType(ctx context.Context) storepb.Engine {
+	instance := h.getInstance(ctx)
+	if instance == nil {
+		return storepb.Engine_ENGINE_UNSPECIFIED
+	}
+	return instance.Metadata.GetEngine()
+}
+
+func (h *Handler) getInstance(ctx context.Context) *store.InstanceMessage {
 	instanceID := h.getInstanceID()
 	if instanceID == "" {
-		return storepb.Engine_ENGINE_UNSPECIFIED
+		return nil
 	}
 
 	instance, err := h.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
@@ -171,13 +191,13 @@ func (h *Handler) getEngineType(ctx context.Context) storepb.Engine {
 	})
 	if err != nil {
 		slog.Error("Failed to get instance", log.BBError(err))
-		return storepb.Engine_ENGINE_UNSPECIFIED
+		return nil
 	}
 	if instance == nil {
 		slog.Error("Instance not found", slog.String("instanceID", instanceID))
-		return storepb.Engine_ENGINE_UNSPECIFIED
+		return nil
 	}
-	return instance.Metadata.GetEngine()
+	return instance
 }
 
 func (h *Handler) checkInitialized(req *jsonrpc2.Request) error {
@@ -315,6 +335,23 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 				CompletionProvider: &lsp.CompletionOptions{
 					TriggerCharacters: []string{".", " "},
 				},
+				HoverProvider:                   &lsp.Or_ServerCapabilities_hoverProvider{Value: true},
+				DefinitionProvider:              &lsp.Or_ServerCapabilities_definitionProvider{Value: true},
+				DocumentSymbolProvider:          &lsp.Or_ServerCapabilities_documentSymbolProvider{Value: true},
+				DocumentFormattingProvider:      &lsp.Or_ServerCapabilities_documentFormattingProvider{Value: true},
+				DocumentRangeFormattingProvider: &lsp.Or_ServerCapabilities_documentRangeFormattingProvider{Value: true},
+				CodeActionProvider: &lsp.CodeActionOptions{
+					CodeActionKinds: []lsp.CodeActionKind{lsp.QuickFix},
+				},
+				SignatureHelpProvider: &lsp.SignatureHelpOptions{
+					TriggerCharacters:   []string{"("},
+					RetriggerCharacters: []string{","},
+				},
+				Workspace: &lsp.WorkspaceOptions{
+					TextDocumentContent: &lsp.Or_WorkspaceOptions_textDocumentContent{
+						Value: lsp.TextDocumentContentOptions{Scheme: definitionURIScheme},
+					},
+				},
 				ExecuteCommandProvider: &lsp.ExecuteCommandOptions{
 					Commands: []string{string(CommandNameSetMetadata)},
 				},
@@ -402,6 +439,102 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 			h.cancelF.Delete(req.ID)
 		}()
 		return h.handleTextDocumentCompletion(childCtx, conn, req, params)
+	case LSPMethodHover:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.HoverParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentHover(childCtx, conn, req, params)
+	case LSPMethodDefinition:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DefinitionParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentDefinition(childCtx, conn, req, params)
+	case LSPMethodDocumentSymbol:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DocumentSymbolParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentDocumentSymbol(ctx, conn, req, params)
+	case LSPMethodSignatureHelp:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.SignatureHelpParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentSignatureHelp(childCtx, conn, req, params)
+	case LSPMethodFormatting:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DocumentFormattingParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentFormatting(ctx, conn, req, params)
+	case LSPMethodRangeFormatting:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DocumentRangeFormattingParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentRangeFormatting(ctx, conn, req, params)
+	case LSPMethodCodeAction:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.CodeActionParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentCodeAction(childCtx, conn, req, params)
+	case LSPMethodTextDocumentContent:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.TextDocumentContentParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentContent(ctx, params)
 	default:
 		if isFileSystemRequest(req.Method) {
 			_, _, err := h.handleFileSystemRequest(ctx, conn, req)
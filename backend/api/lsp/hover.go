@@ -0,0 +1,193 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// hoverColumnLimit is the max number of the columns listed in the table hover.
+	hoverColumnLimit = 50
+	// hoverDefinitionLimit is the max length of the definition shown in the hover.
+	hoverDefinitionLimit = 2048
+)
+
+func (h *Handler) handleTextDocumentHover(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.HoverParams) (*lsp.Hover, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/hover not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+	offset, err := offsetForPosition(content, params.Position)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Position.Line, params.Position.Character)
+	}
+
+	resolver := h.newMetadataResolver(ctx)
+	if resolver == nil {
+		return nil, nil
+	}
+	statements, err := splitStatements(resolver.engine, content)
+	if err != nil {
+		slog.Debug("Failed to split statements", slog.String("engine", resolver.engine.String()), log.BBError(err))
+		return nil, nil
+	}
+	statement := getStatementAtOffset(statements, offset)
+	if statement == nil {
+		return nil, nil
+	}
+	parts, lastIndex := getIdentifierAtOffset(statement.text, offset-statement.start)
+	if len(parts) == 0 {
+		return nil, nil
+	}
+	hovered := parts[len(parts)-1]
+	hoverRange := lsp.Range{
+		Start: positionForOffset(string(content), statement.start+hovered.start),
+		End:   positionForOffset(string(content), statement.start+hovered.end),
+	}
+
+	// The qualifiers such as the table in `t.c` are never columns.
+	if len(parts)-1 == lastIndex {
+		if columns := resolver.resolveColumns(ctx, statement.text, parts); len(columns) > 0 {
+			var sections []string
+			for _, column := range columns {
+				sections = append(sections, h.getColumnHoverContent(ctx, column))
+			}
+			return &lsp.Hover{
+				Contents: lsp.MarkupContent{Kind: lsp.Markdown, Value: strings.Join(sections, "\n\n---\n\n")},
+				Range:    hoverRange,
+			}, nil
+		}
+	}
+	object := resolver.resolveObject(ctx, parts)
+	if object == nil {
+		return nil, nil
+	}
+	return &lsp.Hover{
+		Contents: lsp.MarkupContent{Kind: lsp.Markdown, Value: getObjectHoverContent(object)},
+		Range:    hoverRange,
+	}, nil
+}
+
+func (h *Handler) getColumnHoverContent(ctx context.Context, object *databaseObject) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "**%s** `%s`\n", object.kind, object.qualifiedName())
+	column := object.column
+	fmt.Fprintf(&buf, "\n- Type: `%s`", column.GetType())
+	nullable := "NO"
+	if column.GetNullable() {
+		nullable = "YES"
+	}
+	fmt.Fprintf(&buf, "\n- Nullable: `%s`", nullable)
+	if column.GetDefault() != "" {
+		fmt.Fprintf(&buf, "\n- Default: `%s`", column.GetDefault())
+	}
+	if classification := object.columnCatalog.GetClassification(); classification != "" {
+		fmt.Fprintf(&buf, "\n- Classification: %s", h.getClassificationTitle(ctx, object.database, classification))
+	}
+	if column.GetComment() != "" {
+		fmt.Fprintf(&buf, "\n- Comment: %s", column.GetComment())
+	}
+	return buf.String()
+}
+
+func getObjectHoverContent(object *databaseObject) string {
+	var buf strings.Builder
+	switch object.kind {
+	case objectKindTable:
+		fmt.Fprintf(&buf, "**%s** `%s`\n", object.kind, object.qualifiedName())
+		if comment := object.tableMetadata.GetTableComment(); comment != "" {
+			fmt.Fprintf(&buf, "\n%s\n", comment)
+		}
+		columns := object.tableMetadata.GetProto().GetColumns()
+		buf.WriteString("\n| Column | Type | Nullable |\n| --- | --- | --- |")
+		for i, column := range columns {
+			if i >= hoverColumnLimit {
+				fmt.Fprintf(&buf, "\n| ... | %d more | |", len(columns)-hoverColumnLimit)
+				break
+			}
+			nullable := "NO"
+			if column.GetNullable() {
+				nullable = "YES"
+			}
+			fmt.Fprintf(&buf, "\n| %s | `%s` | %s |", column.GetName(), column.GetType(), nullable)
+		}
+	case objectKindView:
+		writeDefinitionHover(&buf, object, object.view.GetComment(), object.view.GetDefinition())
+	case objectKindMaterializedView:
+		writeDefinitionHover(&buf, object, object.materializedView.GetComment(), object.materializedView.GetDefinition())
+	case objectKindFunction:
+		function := object.functions[0]
+		if len(object.functions) > 1 {
+			var signatures []string
+			for _, f := range object.functions {
+				signatures = append(signatures, "- `"+getFunctionSignature(f.GetName(), f.GetSignature(), f.GetDefinition())+"`")
+			}
+			fmt.Fprintf(&buf, "**%s** `%s` (%d overloads)\n\n%s", object.kind, object.qualifiedName(), len(object.functions), strings.Join(signatures, "\n"))
+			break
+		}
+		writeDefinitionHover(&buf, object, function.GetComment(), function.GetDefinition())
+	case objectKindProcedure:
+		writeDefinitionHover(&buf, object, object.procedure.GetComment(), object.procedure.GetDefinition())
+	default:
+	}
+	return buf.String()
+}
+
+func writeDefinitionHover(buf *strings.Builder, object *databaseObject, comment, definition string) {
+	fmt.Fprintf(buf, "**%s** `%s`\n", object.kind, object.qualifiedName())
+	if comment != "" {
+		fmt.Fprintf(buf, "\n%s\n", comment)
+	}
+	definition = strings.TrimSpace(definition)
+	if definition == "" {
+		return
+	}
+	if len(definition) > hoverDefinitionLimit {
+		definition = strings.ToValidUTF8(definition[:hoverDefinitionLimit], "") + "\n..."
+	}
+	fmt.Fprintf(buf, "\n```sql\n%s\n```", definition)
+}
+
+// getClassificationTitle returns the classification with its title in the data classification config of the project.
+func (h *Handler) getClassificationTitle(ctx context.Context, databaseName, classificationID string) string {
+	instanceID := h.getInstanceID()
+	database, err := h.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil || database == nil {
+		return fmt.Sprintf("`%s`", classificationID)
+	}
+	project, err := h.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil || project == nil {
+		return fmt.Sprintf("`%s`", classificationID)
+	}
+	config, err := h.store.GetDataClassificationConfigByID(ctx, project.DataClassificationConfigID)
+	if err != nil {
+		return fmt.Sprintf("`%s`", classificationID)
+	}
+	classification, ok := config.GetClassification()[classificationID]
+	if !ok {
+		return fmt.Sprintf("`%s`", classificationID)
+	}
+	return fmt.Sprintf("`%s` %s", classificationID, classification.GetTitle())
+}
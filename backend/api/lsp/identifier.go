@@ -0,0 +1,223 @@
+package lsp
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenIdentifier tokenKind = iota
+	tokenPunctuation
+	tokenOther
+)
+
+// identifierPart is a part of a qualified identifier, such as the `t` in `s.t`.
+type identifierPart struct {
+	// name is the identifier without quotes.
+	name   string
+	quoted bool
+	// start and end are the byte offsets of the part in the text, end is exclusive.
+	start int
+	end   int
+}
+
+// sqlToken is a lightweight SQL token. It only recognizes the qualified identifiers and the punctuations,
+// which is enough to locate the identifier and the function call under the cursor.
+type sqlToken struct {
+	kind tokenKind
+	// parts are the parts of the qualified identifier for the identifier token.
+	parts []identifierPart
+	// text is the text of the punctuation token.
+	text  string
+	start int
+	end   int
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentifierChar(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// tokenizeSQL splits the text into tokens. Comments and whitespaces are skipped,
+// string literals and numbers are returned as tokenOther.
+func tokenizeSQL(text string) []*sqlToken {
+	var tokens []*sqlToken
+	i := 0
+	for i < len(text) {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case strings.HasPrefix(text[i:], "--"):
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				i = len(text)
+			} else {
+				i += end + 1
+			}
+		case strings.HasPrefix(text[i:], "/*"):
+			end := strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				i = len(text)
+			} else {
+				i += end + 4
+			}
+		case r == '\'':
+			end := scanQuoted(text, i)
+			tokens = append(tokens, &sqlToken{kind: tokenOther, start: i, end: end})
+			i = end
+		case r == '"' || r == '`' || isIdentifierStart(r):
+			token := scanIdentifier(text, i)
+			tokens = append(tokens, token)
+			i = token.end
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(text) {
+				r, size := utf8.DecodeRuneInString(text[i:])
+				if !isIdentifierChar(r) && r != '.' {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, &sqlToken{kind: tokenOther, start: start, end: i})
+		default:
+			tokens = append(tokens, &sqlToken{kind: tokenPunctuation, text: text[i : i+size], start: i, end: i + size})
+			i += size
+		}
+	}
+	return tokens
+}
+
+// scanQuoted returns the exclusive end offset of the quoted text starting at start.
+// The doubled quote is treated as an escaped quote.
+func scanQuoted(text string, start int) int {
+	quote := text[start]
+	i := start + 1
+	for i < len(text) {
+		if text[i] == quote {
+			if i+1 < len(text) && text[i+1] == quote {
+				i += 2
+				continue
+			}
+			return i + 1
+		}
+		if text[i] == '\\' && quote == '\'' {
+			i++
+		}
+		i++
+	}
+	return len(text)
+}
+
+// scanIdentifier scans the qualified identifier starting at start, such as `db`.`t` or "s".t.
+func scanIdentifier(text string, start int) *sqlToken {
+	token := &sqlToken{kind: tokenIdentifier, start: start}
+	i := start
+	for {
+		var part identifierPart
+		part.start = i
+		if text[i] == '"' || text[i] == '`' {
+			quote := string(text[i])
+			i = scanQuoted(text, i)
+			name := strings.TrimPrefix(text[part.start:i], quote)
+			name = strings.TrimSuffix(name, quote)
+			part.name = strings.ReplaceAll(name, quote+quote, quote)
+			part.quoted = true
+		} else {
+			for i < len(text) {
+				r, size := utf8.DecodeRuneInString(text[i:])
+				if !isIdentifierChar(r) {
+					break
+				}
+				i += size
+			}
+			part.name = text[part.start:i]
+		}
+		part.end = i
+		token.parts = append(token.parts, part)
+
+		// Continue if the identifier is followed by a dot and another identifier.
+		if i+1 >= len(text) || text[i] != '.' {
+			break
+		}
+		next, _ := utf8.DecodeRuneInString(text[i+1:])
+		if next != '"' && next != '`' && !isIdentifierStart(next) {
+			break
+		}
+		i++
+	}
+	token.end = i
+	return token
+}
+
+// getIdentifierAtOffset returns the identifier parts up to the part under the offset.
+// For example, the offset on `t` in `s.t.c` returns [s, t], and index is the index of the last part in the whole identifier.
+func getIdentifierAtOffset(text string, offset int) ([]identifierPart, int) {
+	for _, token := range tokenizeSQL(text) {
+		if token.kind != tokenIdentifier || offset < token.start || offset > token.end {
+			continue
+		}
+		for i, part := range token.parts {
+			if offset >= part.start && offset <= part.end {
+				return token.parts[:i+1], len(token.parts) - 1
+			}
+		}
+	}
+	return nil, 0
+}
+
+// functionCall is the function call that encloses the cursor.
+type functionCall struct {
+	name []identifierPart
+	// activeParameter is the zero-based index of the argument under the cursor.
+	activeParameter int
+}
+
+// getFunctionCallAtOffset returns the innermost function call enclosing the offset.
+func getFunctionCallAtOffset(text string, offset int) *functionCall {
+	type frame struct {
+		name   []identifierPart
+		commas int
+	}
+	var stack []*frame
+	var previous *sqlToken
+	for _, token := range tokenizeSQL(text) {
+		if token.start >= offset {
+			break
+		}
+		if token.kind == tokenPunctuation {
+			switch token.text {
+			case "(":
+				f := &frame{}
+				if previous != nil && previous.kind == tokenIdentifier && strings.TrimSpace(text[previous.end:token.start]) == "" {
+					f.name = previous.parts
+				}
+				stack = append(stack, f)
+			case ")":
+				if len(stack) > 0 {
+					stack = stack[:len(stack)-1]
+				}
+			case ",":
+				if len(stack) > 0 {
+					stack[len(stack)-1].commas++
+				}
+			case ";":
+				stack = nil
+			default:
+			}
+		}
+		previous = token
+	}
+	for i := len(stack) - 1; i >= 0; i-- {
+		if len(stack[i].name) > 0 {
+			return &functionCall{name: stack[i].name, activeParameter: stack[i].commas}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package lsp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIdentifierAtOffset(t *testing.T) {
+	testCases := []struct {
+		text string
+		// The cursor is at the `|`.
+		want      []string
+		wantIndex int
+	}{
+		{text: "SELECT na|me FROM users", want: []string{"name"}, wantIndex: 0},
+		{text: "SELECT u.na|me FROM users u", want: []string{"u", "name"}, wantIndex: 1},
+		{text: "SELECT |u.name FROM users u", want: []string{"u"}, wantIndex: 1},
+		{text: "SELECT * FROM \"My Schema\".\"Us|ers\"", want: []string{"My Schema", "Users"}, wantIndex: 1},
+		{text: "SELECT * FROM `db`.`t|`", want: []string{"db", "t"}, wantIndex: 1},
+		{text: "SELECT 'u.na|me' FROM users", want: nil},
+		{text: "SELECT 1 -- u.na|me", want: nil},
+		{text: "SELECT * FROM users WHERE id = 1|0", want: nil},
+	}
+	for _, tc := range testCases {
+		offset := strings.Index(tc.text, "|")
+		text := strings.Replace(tc.text, "|", "", 1)
+		parts, index := getIdentifierAtOffset(text, offset)
+		var names []string
+		for _, part := range parts {
+			names = append(names, part.name)
+		}
+		require.Equal(t, tc.want, names, tc.text)
+		if tc.want != nil {
+			require.Equal(t, tc.wantIndex, index, tc.text)
+		}
+	}
+}
+
+func TestGetFunctionCallAtOffset(t *testing.T) {
+	testCases := []struct {
+		text string
+		// The cursor is at the `|`.
+		want            string
+		activeParameter int
+	}{
+		{text: "SELECT add(|", want: "add", activeParameter: 0},
+		{text: "SELECT public.add(1, |", want: "public.add", activeParameter: 1},
+		{text: "SELECT add(1, lower('a,b'), |)", want: "add", activeParameter: 2},
+		{text: "SELECT add(1, lower(|))", want: "lower", activeParameter: 0},
+		{text: "SELECT add(1, (2 + |))", want: "add", activeParameter: 1},
+		{text: "SELECT add(1, 2) + |", want: ""},
+		{text: "SELECT 1 + (|", want: ""},
+	}
+	for _, tc := range testCases {
+		offset := strings.Index(tc.text, "|")
+		text := strings.Replace(tc.text, "|", "", 1)
+		call := getFunctionCallAtOffset(text, offset)
+		if tc.want == "" {
+			require.Nil(t, call, tc.text)
+			continue
+		}
+		require.NotNil(t, call, tc.text)
+		require.Equal(t, tc.want, joinIdentifierParts(call.name), tc.text)
+		require.Equal(t, tc.activeParameter, call.activeParameter, tc.text)
+	}
+}
+
+func TestGetFunctionSignature(t *testing.T) {
+	testCases := []struct {
+		name       string
+		signature  string
+		definition string
+		want       string
+		parameters []string
+	}{
+		{
+			name:       "add",
+			signature:  "add(a integer, b numeric(10,2))",
+			want:       "add(a integer, b numeric(10,2))",
+			parameters: []string{"a integer", "b numeric(10,2)"},
+		},
+		{
+			name:       "hello",
+			definition: "CREATE DEFINER=`root`@`%` FUNCTION `hello`(s CHAR(20),\n  n INT) RETURNS char(50) CHARSET utf8mb4\n    DETERMINISTIC\nRETURN CONCAT('Hello, ',s,'!')",
+			want:       "hello(s CHAR(20), n INT)",
+			parameters: []string{"s CHAR(20)", "n INT"},
+		},
+		{
+			name:       "now_utc",
+			definition: "CREATE FUNCTION now_utc() RETURNS datetime RETURN UTC_TIMESTAMP()",
+			want:       "now_utc()",
+		},
+	}
+	for _, tc := range testCases {
+		signature := getFunctionSignature(tc.name, tc.signature, tc.definition)
+		require.Equal(t, tc.want, signature)
+		require.Equal(t, tc.parameters, getSignatureParameters(signature))
+	}
+}
+
+func TestDefinitionURI(t *testing.T) {
+	uri, ok := formatDefinitionURI("prod", &databaseObject{
+		kind:     objectKindView,
+		database: "db",
+		schema:   "public",
+		name:     "active/users",
+	})
+	require.True(t, ok)
+	require.Equal(t, "bytebase:///instances/prod/databases/db/schemas/public/views/active%2Fusers", string(uri))
+
+	instanceID, kind, parts, err := parseDefinitionURI(uri)
+	require.NoError(t, err)
+	require.Equal(t, "prod", instanceID)
+	require.Equal(t, objectKindView, kind)
+	require.Equal(t, "db.public.active/users", joinIdentifierParts(parts))
+
+	_, ok = formatDefinitionURI("prod", &databaseObject{kind: objectKindTable, database: "db", name: "t"})
+	require.False(t, ok)
+	_, _, _, err = parseDefinitionURI("file:///instances/prod/databases/db/schemas/public/views/v")
+	require.Error(t, err)
+}
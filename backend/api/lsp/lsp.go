@@ -19,13 +19,14 @@ import (
 	"github.com/bytebase/bytebase/backend/common/stacktrace"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
 var (
 	upgrader   = websocket.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }}
-	newHandler = func(s *store.Store, profile *config.Profile, iamManager *iam.Manager, user *store.UserMessage, tokenExpiry time.Time) (jsonrpc2.Handler, io.Closer) {
-		return NewHandlerWithAuth(s, profile, iamManager, user, tokenExpiry), io.NopCloser(strings.NewReader(""))
+	newHandler = func(s *store.Store, sheetManager *sheet.Manager, profile *config.Profile, iamManager *iam.Manager, user *store.UserMessage, tokenExpiry time.Time) (jsonrpc2.Handler, io.Closer) {
+		return NewHandlerWithAuth(s, sheetManager, profile, iamManager, user, tokenExpiry), io.NopCloser(strings.NewReader(""))
 	}
 )
 
@@ -57,7 +58,7 @@ func (s *Server) Router(c echo.Context) error {
 	})
 	connectionID := s.connectionCount.Add(1)
 
-	handler, closer := newHandler(s.store, s.profile, s.iamManager, user, tokenExpiry)
+	handler, closer := newHandler(s.store, s.sheetManager, s.profile, s.iamManager, user, tokenExpiry)
 	ctx := c.Request().Context()
 	<-jsonrpc2.NewConn(ctx, wsjsonrpc2.NewObjectStream(connection), handler, nil /* connOpt */).DisconnectNotify()
 	err = closer.Close()
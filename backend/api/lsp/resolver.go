@@ -0,0 +1,331 @@
+package lsp
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+type objectKind string
+
+const (
+	objectKindColumn           objectKind = "Column"
+	objectKindTable            objectKind = "Table"
+	objectKindView             objectKind = "View"
+	objectKindMaterializedView objectKind = "Materialized View"
+	objectKindFunction         objectKind = "Function"
+	objectKindProcedure        objectKind = "Procedure"
+)
+
+// databaseObject is a database object resolved from the metadata.
+type databaseObject struct {
+	kind     objectKind
+	database string
+	schema   string
+	// table is the table or view name of the column.
+	table string
+	name  string
+
+	column           *storepb.ColumnMetadata
+	columnCatalog    *storepb.ColumnCatalog
+	tableMetadata    *model.TableMetadata
+	view             *storepb.ViewMetadata
+	materializedView *storepb.MaterializedViewMetadata
+	functions        []*storepb.FunctionMetadata
+	procedure        *storepb.ProcedureMetadata
+}
+
+// qualifiedName returns the object name qualified by the schema, and the table for the column.
+func (o *databaseObject) qualifiedName() string {
+	var list []string
+	if o.schema != "" {
+		list = append(list, o.schema)
+	}
+	if o.table != "" {
+		list = append(list, o.table)
+	}
+	list = append(list, o.name)
+	return strings.Join(list, ".")
+}
+
+// metadataResolver resolves the identifiers in the worksheet to the database objects in the connected instance.
+type metadataResolver struct {
+	h                   *Handler
+	engine              storepb.Engine
+	instanceID          string
+	defaultDatabase     string
+	defaultSchema       string
+	ignoreCaseSensitive bool
+
+	databases map[string]*model.DatabaseMetadata
+}
+
+func (h *Handler) newMetadataResolver(ctx context.Context) *metadataResolver {
+	instance := h.getInstance(ctx)
+	if instance == nil {
+		return nil
+	}
+	return &metadataResolver{
+		h:                   h,
+		engine:              instance.Metadata.GetEngine(),
+		instanceID:          instance.ResourceID,
+		defaultDatabase:     h.getDefaultDatabase(),
+		defaultSchema:       h.getDefaultSchema(),
+		ignoreCaseSensitive: !store.IsObjectCaseSensitive(instance),
+		databases:           make(map[string]*model.DatabaseMetadata),
+	}
+}
+
+// isDatabaseQualified returns true if the object is qualified by the database rather than the schema, such as MySQL.
+func isDatabaseQualified(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL,
+		storepb.Engine_TIDB,
+		storepb.Engine_MARIADB,
+		storepb.Engine_OCEANBASE,
+		storepb.Engine_CLICKHOUSE,
+		storepb.Engine_STARROCKS,
+		storepb.Engine_DORIS:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeIdentifier folds the unquoted identifier to lower case for the PostgreSQL family.
+func normalizeIdentifier(engine storepb.Engine, part identifierPart) string {
+	if part.quoted {
+		return part.name
+	}
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_COCKROACHDB:
+		return strings.ToLower(part.name)
+	default:
+		return part.name
+	}
+}
+
+func (r *metadataResolver) getDatabaseMetadata(ctx context.Context, database string) *model.DatabaseMetadata {
+	if database == "" {
+		return nil
+	}
+	if metadata, ok := r.databases[database]; ok {
+		return metadata
+	}
+	// The permission of the default database is checked when setting the metadata.
+	if database != r.defaultDatabase {
+		if err := r.h.checkMetadataPermissions(ctx, SetMetadataCommandArguments{
+			InstanceID:   common.FormatInstance(r.instanceID),
+			DatabaseName: database,
+		}); err != nil {
+			slog.Debug("Failed to check database permission", slog.String("database", database), log.BBError(err))
+			r.databases[database] = nil
+			return nil
+		}
+	}
+	_, metadata, err := r.h.GetDatabaseMetadataFunc(ctx, r.instanceID, database)
+	if err != nil {
+		slog.Debug("Failed to get database metadata", slog.String("database", database), log.BBError(err))
+	}
+	r.databases[database] = metadata
+	return metadata
+}
+
+// getSchemaNames returns the schemas to search the unqualified object in.
+func (r *metadataResolver) getSchemaNames(metadata *model.DatabaseMetadata, schema string) []string {
+	if schema != "" || isDatabaseQualified(r.engine) {
+		return []string{schema}
+	}
+	var schemas []string
+	if r.defaultSchema != "" {
+		schemas = append(schemas, r.defaultSchema)
+	}
+	for _, s := range metadata.GetSearchPath() {
+		if !slices.Contains(schemas, s) {
+			schemas = append(schemas, s)
+		}
+	}
+	if len(schemas) == 0 {
+		schemas = append(schemas, "public")
+	}
+	return schemas
+}
+
+// resolveObject resolves the qualified name to the table, view, function or procedure.
+func (r *metadataResolver) resolveObject(ctx context.Context, parts []identifierPart) *databaseObject {
+	if len(parts) == 0 {
+		return nil
+	}
+	var names []string
+	for _, part := range parts {
+		names = append(names, normalizeIdentifier(r.engine, part))
+	}
+	database, schema, name := r.defaultDatabase, "", names[len(names)-1]
+	if isDatabaseQualified(r.engine) {
+		if len(names) > 1 {
+			database = names[len(names)-2]
+		}
+	} else {
+		if len(names) > 1 {
+			schema = names[len(names)-2]
+		}
+		if len(names) > 2 {
+			database = names[len(names)-3]
+		}
+	}
+
+	metadata := r.getDatabaseMetadata(ctx, database)
+	if metadata == nil {
+		return nil
+	}
+	for _, schemaName := range r.getSchemaNames(metadata, schema) {
+		schemaMetadata := metadata.GetSchemaMetadata(schemaName)
+		if schemaMetadata == nil {
+			continue
+		}
+		object := &databaseObject{
+			database: database,
+			schema:   schemaMetadata.GetProto().GetName(),
+		}
+		if table := schemaMetadata.GetTable(name); table != nil {
+			object.kind = objectKindTable
+			object.name = table.GetProto().GetName()
+			object.tableMetadata = table
+			return object
+		}
+		if view := schemaMetadata.GetView(name); view != nil {
+			object.kind = objectKindView
+			object.name = view.GetName()
+			object.view = view
+			return object
+		}
+		if materializedView := schemaMetadata.GetMaterializedView(name); materializedView != nil {
+			object.kind = objectKindMaterializedView
+			object.name = materializedView.GetName()
+			object.materializedView = materializedView
+			return object
+		}
+		for _, function := range schemaMetadata.GetProto().GetFunctions() {
+			if function.GetName() == name || r.ignoreCaseSensitive && strings.EqualFold(function.GetName(), name) {
+				object.functions = append(object.functions, function)
+			}
+		}
+		if len(object.functions) > 0 {
+			object.kind = objectKindFunction
+			object.name = object.functions[0].GetName()
+			return object
+		}
+		if procedure := schemaMetadata.GetProcedure(name); procedure != nil {
+			object.kind = objectKindProcedure
+			object.name = procedure.GetName()
+			object.procedure = procedure
+			return object
+		}
+	}
+	return nil
+}
+
+// resolveColumns resolves the column under the cursor with the query span of the statement.
+// The columns of the aliased tables are matched by the column name only, so there may be multiple candidates.
+func (r *metadataResolver) resolveColumns(ctx context.Context, statement string, parts []identifierPart) []*databaseObject {
+	spans, err := parserbase.GetQuerySpan(ctx, parserbase.GetQuerySpanContext{
+		InstanceID:              r.instanceID,
+		GetDatabaseMetadataFunc: r.h.GetDatabaseMetadataFunc,
+		ListDatabaseNamesFunc:   r.h.ListDatabaseNamesFunc,
+	}, r.engine, statement, r.defaultDatabase, r.defaultSchema, r.ignoreCaseSensitive)
+	if err != nil {
+		slog.Debug("Failed to get query span", log.BBError(err))
+		return nil
+	}
+
+	name := normalizeIdentifier(r.engine, parts[len(parts)-1])
+	var candidates []parserbase.ColumnResource
+	addCandidate := func(column parserbase.ColumnResource) {
+		if !slices.Contains(candidates, column) {
+			candidates = append(candidates, column)
+		}
+	}
+	for _, span := range spans {
+		for column := range span.SourceColumns {
+			if strings.EqualFold(column.Column, name) {
+				addCandidate(column)
+			}
+		}
+		for _, result := range span.Results {
+			if !strings.EqualFold(result.Name, name) || len(result.SourceColumns) != 1 {
+				continue
+			}
+			for column := range result.SourceColumns {
+				addCandidate(column)
+			}
+		}
+	}
+	if len(parts) > 1 {
+		qualifier := normalizeIdentifier(r.engine, parts[len(parts)-2])
+		qualified := slices.DeleteFunc(slices.Clone(candidates), func(column parserbase.ColumnResource) bool {
+			return !strings.EqualFold(column.Table, qualifier)
+		})
+		if len(qualified) > 0 {
+			candidates = qualified
+		}
+	}
+	slices.SortFunc(candidates, func(a, b parserbase.ColumnResource) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	var objects []*databaseObject
+	for _, candidate := range candidates {
+		if object := r.getColumn(ctx, candidate); object != nil {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+func (r *metadataResolver) getColumn(ctx context.Context, resource parserbase.ColumnResource) *databaseObject {
+	if resource.Server != "" {
+		return nil
+	}
+	metadata := r.getDatabaseMetadata(ctx, resource.Database)
+	if metadata == nil {
+		return nil
+	}
+	schema := metadata.GetSchemaMetadata(resource.Schema)
+	if schema == nil {
+		return nil
+	}
+	object := &databaseObject{
+		kind:     objectKindColumn,
+		database: resource.Database,
+		schema:   resource.Schema,
+		table:    resource.Table,
+	}
+	if table := schema.GetTable(resource.Table); table != nil {
+		column := table.GetColumn(resource.Column)
+		if column == nil {
+			return nil
+		}
+		object.name = column.GetProto().GetName()
+		object.column = column.GetProto()
+		object.columnCatalog = column.GetCatalog()
+		return object
+	}
+	if view := schema.GetView(resource.Table); view != nil {
+		for _, column := range view.GetColumns() {
+			if strings.EqualFold(column.GetName(), resource.Column) {
+				object.name = column.GetName()
+				object.column = column
+				return object
+			}
+		}
+	}
+	return nil
+}
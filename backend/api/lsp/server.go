@@ -6,6 +6,7 @@ import (
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	"github.com/bytebase/bytebase/backend/store"
@@ -16,6 +17,7 @@ type Server struct {
 	connectionCount atomic.Uint64
 
 	store           *store.Store
+	sheetManager    *sheet.Manager
 	profile         *config.Profile
 	secret          string
 	stateCfg        *state.State
@@ -27,6 +29,7 @@ type Server struct {
 // NewServer creates a Language Server Protocol service.
 func NewServer(
 	store *store.Store,
+	sheetManager *sheet.Manager,
 	profile *config.Profile,
 	secret string,
 	stateCfg *state.State,
@@ -35,6 +38,7 @@ func NewServer(
 ) *Server {
 	return &Server{
 		store:           store,
+		sheetManager:    sheetManager,
 		profile:         profile,
 		secret:          secret,
 		stateCfg:        stateCfg,
@@ -0,0 +1,171 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+func (h *Handler) handleTextDocumentSignatureHelp(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.SignatureHelpParams) (*lsp.SignatureHelp, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/signatureHelp not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+	offset, err := offsetForPosition(content, params.Position)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Position.Line, params.Position.Character)
+	}
+
+	resolver := h.newMetadataResolver(ctx)
+	if resolver == nil {
+		return nil, nil
+	}
+	statements, err := splitStatements(resolver.engine, content)
+	if err != nil {
+		slog.Debug("Failed to split statements", slog.String("engine", resolver.engine.String()), log.BBError(err))
+		return nil, nil
+	}
+	statement := getStatementAtOffset(statements, offset)
+	if statement == nil {
+		return nil, nil
+	}
+	call := getFunctionCallAtOffset(statement.text, offset-statement.start)
+	if call == nil {
+		return nil, nil
+	}
+	object := resolver.resolveObject(ctx, call.name)
+	if object == nil {
+		return nil, nil
+	}
+
+	var signatures []lsp.SignatureInformation
+	switch object.kind {
+	case objectKindFunction:
+		for _, function := range object.functions {
+			signatures = append(signatures, newSignatureInformation(getFunctionSignature(function.GetName(), function.GetSignature(), function.GetDefinition()), function.GetComment()))
+		}
+	case objectKindProcedure:
+		procedure := object.procedure
+		signatures = append(signatures, newSignatureInformation(getFunctionSignature(procedure.GetName(), procedure.GetSignature(), procedure.GetDefinition()), procedure.GetComment()))
+	default:
+		return nil, nil
+	}
+
+	// Prefer the first overload which accepts the active parameter.
+	activeSignature := 0
+	for i, signature := range signatures {
+		if len(signature.Parameters) > call.activeParameter {
+			activeSignature = i
+			break
+		}
+	}
+	return &lsp.SignatureHelp{
+		Signatures:      signatures,
+		ActiveSignature: uint32(activeSignature),
+		ActiveParameter: uint32(call.activeParameter),
+	}, nil
+}
+
+func newSignatureInformation(signature, comment string) lsp.SignatureInformation {
+	information := lsp.SignatureInformation{
+		Label: signature,
+	}
+	if comment != "" {
+		information.Documentation = &lsp.Or_SignatureInformation_documentation{Value: comment}
+	}
+	for _, parameter := range getSignatureParameters(signature) {
+		information.Parameters = append(information.Parameters, lsp.ParameterInformation{Label: parameter})
+	}
+	return information
+}
+
+// getFunctionSignature returns the signature such as `f(a integer, b text)`.
+// PostgreSQL syncs the signature, and the signature of the other engines is extracted from the definition.
+func getFunctionSignature(name, signature, definition string) string {
+	if signature != "" {
+		return signature
+	}
+	begin := strings.Index(strings.ToLower(definition), strings.ToLower(name))
+	if begin < 0 {
+		return name + "()"
+	}
+	open := strings.IndexByte(definition[begin:], '(')
+	if open < 0 {
+		return name + "()"
+	}
+	open += begin
+	closing := findClosingParenthesis(definition, open)
+	if closing < 0 {
+		return name + "()"
+	}
+	return name + whitespaceRegexp.ReplaceAllString(definition[open:closing+1], " ")
+}
+
+// getSignatureParameters returns the parameters in the signature, the commas in the nested parentheses such as `numeric(10, 2)` are ignored.
+func getSignatureParameters(signature string) []string {
+	open := strings.IndexByte(signature, '(')
+	if open < 0 {
+		return nil
+	}
+	closing := findClosingParenthesis(signature, open)
+	if closing < 0 {
+		return nil
+	}
+	var parameters []string
+	depth, begin := 0, open+1
+	for i := open + 1; i < closing; i++ {
+		switch signature[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parameters = append(parameters, strings.TrimSpace(signature[begin:i]))
+				begin = i + 1
+			}
+		default:
+		}
+	}
+	if last := strings.TrimSpace(signature[begin:closing]); last != "" || len(parameters) > 0 {
+		parameters = append(parameters, last)
+	}
+	return parameters
+}
+
+// findClosingParenthesis returns the index of the parenthesis matching the one at open, or -1 if not found.
+func findClosingParenthesis(text string, open int) int {
+	depth := 0
+	for i := open; i < len(text); i++ {
+		switch text[i] {
+		case '\'', '"', '`':
+			i = scanQuoted(text, i) - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		default:
+		}
+	}
+	return -1
+}
@@ -0,0 +1,234 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	// symbolNameMaxLength is the max length of the symbol name for the statements which don't define an object.
+	symbolNameMaxLength = 64
+)
+
+var (
+	// createObjectRegexp matches the object definition statements, such as `CREATE OR REPLACE VIEW s.v AS ...`.
+	createObjectRegexp = regexp.MustCompile(`(?is)^CREATE\s+(?:OR\s+REPLACE\s+)?(?:(?:GLOBAL|LOCAL)\s+)?(?:(?:TEMP|TEMPORARY|UNLOGGED|UNIQUE)\s+)?(?:ALGORITHM\s*=\s*\w+\s+)?(?:DEFINER\s*=\s*\S+\s+)?(?:SQL\s+SECURITY\s+\w+\s+)?(TABLE|VIEW|MATERIALIZED\s+VIEW|FUNCTION|PROCEDURE|INDEX|SCHEMA|DATABASE|TRIGGER|SEQUENCE|TYPE)\s+(?:CONCURRENTLY\s+)?(?:IF\s+NOT\s+EXISTS\s+)?`)
+	whitespaceRegexp   = regexp.MustCompile(`\s+`)
+)
+
+// sqlStatement is a non-empty statement in the document.
+type sqlStatement struct {
+	text string
+	// start and end are the byte offsets of the statement in the document, end is exclusive.
+	start int
+	end   int
+	// rng is the range of the statement, not including the leading comments and whitespaces.
+	rng lsp.Range
+}
+
+// splitStatements splits the document into statements with their positions.
+func splitStatements(engine storepb.Engine, content []byte) ([]*sqlStatement, error) {
+	list, err := parserbase.SplitMultiSQL(engine, string(content))
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(content), "\n")
+	var statements []*sqlStatement
+	for _, sql := range list {
+		if sql.Empty || sql.Start == nil || sql.End == nil {
+			continue
+		}
+		// The position of the SingleSQL is one-based and measured in runes, and the end is inclusive.
+		start := convertRunePosition(lines, int(sql.Start.Line)-1, int(sql.Start.Column)-1)
+		end := convertRunePosition(lines, int(sql.End.Line)-1, int(sql.End.Column))
+		startOffset, err := offsetForPosition(content, start)
+		if err != nil {
+			continue
+		}
+		endOffset, err := offsetForPosition(content, end)
+		if err != nil || endOffset < startOffset {
+			continue
+		}
+		statements = append(statements, &sqlStatement{
+			text:  string(content[startOffset:endOffset]),
+			start: startOffset,
+			end:   endOffset,
+			rng:   lsp.Range{Start: start, End: end},
+		})
+	}
+	return statements, nil
+}
+
+// convertRunePosition converts the zero-based line and rune column to the LSP UTF-16 position.
+func convertRunePosition(lines []string, line, column int) lsp.Position {
+	if line >= len(lines) {
+		line = len(lines) - 1
+	}
+	if line < 0 {
+		return lsp.Position{}
+	}
+	character := 0
+	text := lines[line]
+	for i := 0; i < column && len(text) > 0; i++ {
+		r, size := utf8.DecodeRuneInString(text)
+		text = text[size:]
+		if r > 0xFFFF {
+			character += 2
+		} else {
+			character++
+		}
+	}
+	return lsp.Position{Line: uint32(line), Character: uint32(character)}
+}
+
+// getStatementAtOffset returns the statement containing the byte offset.
+func getStatementAtOffset(statements []*sqlStatement, offset int) *sqlStatement {
+	for _, statement := range statements {
+		if offset >= statement.start && offset <= statement.end {
+			return statement
+		}
+	}
+	return nil
+}
+
+// positionForOffset converts the byte offset in the content to the LSP UTF-16 position.
+func positionForOffset(content string, offset int) lsp.Position {
+	if offset > len(content) {
+		offset = len(content)
+	}
+	prefix := content[:offset]
+	line := strings.Count(prefix, "\n")
+	lineStart := strings.LastIndexByte(prefix, '\n') + 1
+	character := 0
+	for _, r := range prefix[lineStart:] {
+		if r > 0xFFFF {
+			character += 2
+		} else {
+			character++
+		}
+	}
+	return lsp.Position{Line: uint32(line), Character: uint32(character)}
+}
+
+// getDocumentSymbols returns one symbol for each statement. The statements defining an object are named by the object.
+func getDocumentSymbols(content string, statements []*sqlStatement) []lsp.DocumentSymbol {
+	symbols := []lsp.DocumentSymbol{}
+	for _, statement := range statements {
+		symbol := lsp.DocumentSymbol{
+			Range:          statement.rng,
+			SelectionRange: statement.rng,
+		}
+		if objectType, name, ok := getCreatedObject(statement.text); ok {
+			symbol.Name = joinIdentifierParts(name)
+			symbol.Detail = objectType
+			symbol.Kind = getSymbolKind(objectType)
+			symbol.SelectionRange = lsp.Range{
+				Start: positionForOffset(content, statement.start+name[0].start),
+				End:   positionForOffset(content, statement.start+name[len(name)-1].end),
+			}
+		} else {
+			symbol.Name = getStatementSummary(statement.text)
+			symbol.Kind = lsp.Object
+			if fields := strings.Fields(statement.text); len(fields) > 0 {
+				symbol.Detail = strings.ToUpper(fields[0])
+			}
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// getCreatedObject returns the object type and name if the statement defines an object.
+func getCreatedObject(statement string) (string, []identifierPart, bool) {
+	match := createObjectRegexp.FindStringSubmatchIndex(statement)
+	if match == nil {
+		return "", nil, false
+	}
+	objectType := strings.ToUpper(whitespaceRegexp.ReplaceAllString(statement[match[2]:match[3]], " "))
+	tokens := tokenizeSQL(statement[match[1]:])
+	if len(tokens) == 0 || tokens[0].kind != tokenIdentifier {
+		return "", nil, false
+	}
+	var name []identifierPart
+	for _, part := range tokens[0].parts {
+		name = append(name, identifierPart{
+			name:   part.name,
+			quoted: part.quoted,
+			start:  part.start + match[1],
+			end:    part.end + match[1],
+		})
+	}
+	return objectType, name, true
+}
+
+func getSymbolKind(objectType string) lsp.SymbolKind {
+	switch objectType {
+	case "TABLE":
+		return lsp.Struct
+	case "VIEW", "MATERIALIZED VIEW":
+		return lsp.Interface
+	case "FUNCTION", "PROCEDURE", "TRIGGER":
+		return lsp.Function
+	case "INDEX":
+		return lsp.Key
+	case "SCHEMA", "DATABASE":
+		return lsp.Namespace
+	case "TYPE":
+		return lsp.TypeParameter
+	default:
+		return lsp.Variable
+	}
+}
+
+// getStatementSummary returns the statement text in one line, truncated to symbolNameMaxLength runes.
+func getStatementSummary(statement string) string {
+	summary := whitespaceRegexp.ReplaceAllString(strings.TrimSpace(statement), " ")
+	if utf8.RuneCountInString(summary) > symbolNameMaxLength {
+		runes := []rune(summary)
+		summary = string(runes[:symbolNameMaxLength]) + "..."
+	}
+	return summary
+}
+
+func joinIdentifierParts(parts []identifierPart) string {
+	var names []string
+	for _, part := range parts {
+		names = append(names, part.name)
+	}
+	return strings.Join(names, ".")
+}
+
+func (h *Handler) handleTextDocumentDocumentSymbol(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DocumentSymbolParams) ([]lsp.DocumentSymbol, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/documentSymbol not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return []lsp.DocumentSymbol{}, nil
+	}
+	engine := h.getEngineType(ctx)
+	statements, err := splitStatements(engine, content)
+	if err != nil {
+		slog.Debug("Failed to split statements", slog.String("engine", engine.String()), log.BBError(err))
+		return []lsp.DocumentSymbol{}, nil
+	}
+	return getDocumentSymbols(string(content), statements), nil
+}
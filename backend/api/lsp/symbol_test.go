@@ -0,0 +1,68 @@
+package lsp
+
+import (
+	"testing"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+
+	// Register the parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+)
+
+func TestGetDocumentSymbols(t *testing.T) {
+	content := "SELECT 1;\n\n-- The active users.\nCREATE OR REPLACE VIEW public.\"Active Users\" AS\n  SELECT * FROM users WHERE active;\nCREATE FUNCTION add(a int, b int) RETURNS int AS 'select a + b' LANGUAGE SQL;\nupdate users set name = '世界' where id = 1"
+	for _, engine := range []storepb.Engine{storepb.Engine_POSTGRES, storepb.Engine_MYSQL} {
+		statements, err := splitStatements(engine, []byte(content))
+		require.NoError(t, err)
+		require.Len(t, statements, 4)
+		symbols := getDocumentSymbols(content, statements)
+		require.Equal(t, []lsp.DocumentSymbol{
+			{
+				Name:           "SELECT 1;",
+				Detail:         "SELECT",
+				Kind:           lsp.Object,
+				Range:          lsp.Range{Start: lsp.Position{Line: 0, Character: 0}, End: lsp.Position{Line: 0, Character: 9}},
+				SelectionRange: lsp.Range{Start: lsp.Position{Line: 0, Character: 0}, End: lsp.Position{Line: 0, Character: 9}},
+			},
+			{
+				Name:           "public.Active Users",
+				Detail:         "VIEW",
+				Kind:           lsp.Interface,
+				Range:          lsp.Range{Start: lsp.Position{Line: 3, Character: 0}, End: lsp.Position{Line: 4, Character: 35}},
+				SelectionRange: lsp.Range{Start: lsp.Position{Line: 3, Character: 23}, End: lsp.Position{Line: 3, Character: 44}},
+			},
+			{
+				Name:           "add",
+				Detail:         "FUNCTION",
+				Kind:           lsp.Function,
+				Range:          lsp.Range{Start: lsp.Position{Line: 5, Character: 0}, End: lsp.Position{Line: 5, Character: 77}},
+				SelectionRange: lsp.Range{Start: lsp.Position{Line: 5, Character: 16}, End: lsp.Position{Line: 5, Character: 19}},
+			},
+			{
+				Name:           "update users set name = '世界' where id = 1",
+				Detail:         "UPDATE",
+				Kind:           lsp.Object,
+				Range:          lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 41}},
+				SelectionRange: lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 41}},
+			},
+		}, symbols, engine.String())
+	}
+}
+
+func TestGetStatementAtOffset(t *testing.T) {
+	content := "SELECT 1;\nSELECT 世界 FROM t;"
+	statements, err := splitStatements(storepb.Engine_POSTGRES, []byte(content))
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+
+	statement := getStatementAtOffset(statements, 3)
+	require.NotNil(t, statement)
+	require.Equal(t, "SELECT 1;", statement.text)
+	statement = getStatementAtOffset(statements, len(content)-1)
+	require.NotNil(t, statement)
+	require.Equal(t, "SELECT 世界 FROM t;", statement.text)
+}
@@ -0,0 +1,126 @@
+// Package saml is the API endpoint of the SAML 2.0 service provider.
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/config"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	samlidp "github.com/bytebase/bytebase/backend/plugin/idp/saml"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// callbackPath is the frontend route to finish the login with the SAMLResponse.
+	callbackPath = "/saml/callback"
+	// requestIDCookieDuration is the lifetime of the AuthnRequest ID cookie,
+	// within which the user must finish the login in the IdP.
+	requestIDCookieDuration = 10 * time.Minute
+)
+
+// Service is the API endpoint for the SAML 2.0 service provider.
+type Service struct {
+	store   *store.Store
+	profile *config.Profile
+}
+
+// NewService creates a SAML service.
+func NewService(store *store.Store, profile *config.Profile) *Service {
+	return &Service{
+		store:   store,
+		profile: profile,
+	}
+}
+
+// RegisterRoutes registers the SP endpoints.
+//
+// The login flow is:
+//  1. The frontend navigates to the SSO endpoint with the OAuth state token as the relay state.
+//  2. The SSO endpoint saves the AuthnRequest ID in a cookie and redirects to the IdP with a signed AuthnRequest.
+//  3. The IdP posts the SAMLResponse to the ACS endpoint, which redirects to the frontend callback.
+//     The SAMLResponse is passed in the URL fragment so that it never reaches server logs.
+//  4. The frontend logs in with the SAMLResponse, which is validated against the AuthnRequest ID in the cookie.
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.GET("/:idpID/metadata", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, _, err := s.getIdentityProvider(ctx, c.Param("idpID"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		metadata, err := identityProvider.Metadata()
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.Blob(http.StatusOK, "application/samlmetadata+xml", metadata)
+	})
+
+	g.GET("/:idpID/sso", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, externalURL, err := s.getIdentityProvider(ctx, c.Param("idpID"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		redirectURL, requestID, err := identityProvider.AuthnRequestURL(c.QueryParam("relay_state"))
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		c.SetCookie(&http.Cookie{
+			Name:     samlidp.RequestIDCookieName,
+			Value:    requestID,
+			Path:     "/",
+			Expires:  time.Now().Add(requestIDCookieDuration),
+			HttpOnly: true,
+			Secure:   strings.HasPrefix(externalURL, "https"),
+			SameSite: http.SameSiteLaxMode,
+		})
+		return c.Redirect(http.StatusFound, redirectURL.String())
+	})
+
+	g.POST("/:idpID/acs", func(c echo.Context) error {
+		samlResponse := c.FormValue("SAMLResponse")
+		if samlResponse == "" {
+			return c.String(http.StatusBadRequest, "missing SAMLResponse")
+		}
+		query := url.Values{}
+		query.Set("state", c.FormValue("RelayState"))
+		query.Set("idp", c.Param("idpID"))
+		fragment := url.Values{}
+		fragment.Set("SAMLResponse", samlResponse)
+		return c.Redirect(http.StatusSeeOther, fmt.Sprintf("%s?%s#%s", callbackPath, query.Encode(), fragment.Encode()))
+	})
+}
+
+func (s *Service) getIdentityProvider(ctx context.Context, idpID string) (*samlidp.IdentityProvider, string, error) {
+	idp, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{
+		ResourceID: &idpID,
+	})
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to get identity provider")
+	}
+	if idp == nil || idp.Type != storepb.IdentityProviderType_SAML {
+		return nil, "", errors.Errorf("SAML identity provider %q not found", idpID)
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to get workspace setting")
+	}
+	// Use command-line flag value if set, otherwise use database value
+	externalURL := common.GetEffectiveExternalURL(s.profile.ExternalURL, setting.ExternalUrl)
+	if externalURL == "" {
+		return nil, "", errors.Errorf("external URL is not set")
+	}
+	identityProvider, err := samlidp.NewIdentityProvider(ctx, idp.Config.GetSamlConfig(), samlidp.GetEndpoints(externalURL, idp.ResourceID))
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to create new SAML identity provider")
+	}
+	return identityProvider, externalURL, nil
+}
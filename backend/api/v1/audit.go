@@ -124,10 +124,13 @@ func (c *auditConnectStreamingConn) Send(resp any) error {
 	if err != nil {
 		return err
 	}
-	// Create audit log for each message pair
+	// Create audit log for each message pair.
+	// The request is logged with its first response only, so that the server-streaming methods are logged once.
 	if c.curRequest != nil {
 		latency := time.Since(c.startTime)
-		if auditErr := createAuditLogConnect(c.ctx, c.curRequest, resp, c.method, c.interceptor.store, c.interceptor.secret, c.interceptor.profile, nil, nil, c.RequestHeader(), c.Peer().Addr, latency); auditErr != nil {
+		request := c.curRequest
+		c.curRequest = nil
+		if auditErr := createAuditLogConnect(c.ctx, request, resp, c.method, c.interceptor.store, c.interceptor.secret, c.interceptor.profile, nil, nil, c.RequestHeader(), c.Peer().Addr, latency); auditErr != nil {
 			return auditErr
 		}
 	}
@@ -293,10 +296,20 @@ func getRequestResource(request any) string {
 		return r.GetCatalog().GetName()
 	case *v1pb.SetIamPolicyRequest:
 		return r.Resource
+	case *v1pb.BreakGlassRequest:
+		return r.Parent
 	case *v1pb.CreateUserRequest:
 		return r.GetUser().GetName()
 	case *v1pb.UpdateUserRequest:
 		return r.GetUser().GetName()
+	case *v1pb.CreatePersonalAccessTokenRequest:
+		return r.Parent
+	case *v1pb.RevokePersonalAccessTokenRequest:
+		return r.Name
+	case *v1pb.CreateWebAuthnCredentialRequest:
+		return r.Parent
+	case *v1pb.DeleteWebAuthnCredentialRequest:
+		return r.Name
 	case *v1pb.LoginRequest:
 		return r.GetEmail()
 	case *v1pb.CreateInstanceRequest:
@@ -383,6 +396,8 @@ func getResponseString(response any) (string, error) {
 			return redactLoginResponse(r)
 		case *v1pb.User:
 			return redactUser(r)
+		case *v1pb.PersonalAccessToken:
+			return redactPersonalAccessToken(r)
 		case *v1pb.Instance:
 			return redactInstance(r)
 		default:
@@ -402,6 +417,17 @@ func getResponseString(response any) (string, error) {
 	return string(b), nil
 }
 
+func redactPersonalAccessToken(r *v1pb.PersonalAccessToken) *v1pb.PersonalAccessToken {
+	if r == nil {
+		return nil
+	}
+	r = proto.CloneOf(r)
+	if r.Token != "" {
+		r.Token = maskedString
+	}
+	return r
+}
+
 func redactExportRequest(r *v1pb.ExportRequest) *v1pb.ExportRequest {
 	if r == nil {
 		return nil
@@ -434,6 +460,9 @@ func redactLoginRequest(r *v1pb.LoginRequest) *v1pb.LoginRequest {
 	if r.MfaTempToken != nil {
 		r.MfaTempToken = &maskedString
 	}
+	if r.WebauthnAssertion != nil {
+		r.WebauthnAssertion = &maskedString
+	}
 	if r.IdpContext != nil {
 		r.IdpContext = nil
 	}
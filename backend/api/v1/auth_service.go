@@ -1,10 +1,12 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"crypto/subtle"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"slices"
 	"strings"
 	"time"
@@ -31,7 +33,9 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
+	"github.com/bytebase/bytebase/backend/plugin/webauthn"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/store"
 )
@@ -56,6 +60,7 @@ const (
 	errMsgInvalidCredentials  = "invalid email or password"
 	errMsgInvalidMFACode      = "invalid MFA code"
 	errMsgInvalidRecoveryCode = "invalid recovery code"
+	errMsgInvalidWebAuthn     = "invalid WebAuthn assertion"
 	errMsgTooManyPassword     = "too many failed login attempts, please try again later" // Will be used for password rate limiting
 	errMsgTooManyMFA          = "too many failed MFA attempts, please try again later"
 )
@@ -101,10 +106,19 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 	if !mfaSecondLogin {
 		var err error
 		if loginViaIDP {
-			loginUser, err = s.getOrCreateUserWithIDP(ctx, request)
+			loginUser, err = s.getOrCreateUserWithIDP(ctx, request, req.Header())
 			if err != nil {
 				return nil, err
 			}
+			if request.IdpContext.GetSamlContext() != nil {
+				// The SAML request is single-use.
+				resp.Header().Add("Set-Cookie", (&http.Cookie{
+					Name:    saml.RequestIDCookieName,
+					Value:   "",
+					Expires: time.Unix(0, 0),
+					Path:    "/",
+				}).String())
+			}
 		} else {
 			loginUser, err = s.getAndVerifyUser(ctx, request)
 			if err != nil {
@@ -139,8 +153,12 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 			if err := s.challengeRecoveryCode(ctx, user, *request.RecoveryCode); err != nil {
 				return nil, err
 			}
+		} else if request.WebauthnAssertion != nil {
+			if err := s.challengeWebAuthnAssertion(ctx, user, *request.MfaTempToken, *request.WebauthnAssertion); err != nil {
+				return nil, err
+			}
 		} else {
-			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Errorf("OTP or recovery code is required for MFA"))
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Errorf("OTP, recovery code or WebAuthn assertion is required for MFA"))
 		}
 		loginUser = user
 	}
@@ -172,7 +190,9 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 	}
 
 	tokenDuration := auth.GetTokenDuration(ctx, s.store, s.licenseService)
-	userMFAEnabled := loginUser.MFAConfig != nil && loginUser.MFAConfig.OtpSecret != ""
+	otpEnabled := loginUser.MFAConfig.GetOtpSecret() != ""
+	webAuthnEnabled := len(loginUser.MFAConfig.GetWebauthnCredentials()) > 0
+	userMFAEnabled := otpEnabled || webAuthnEnabled
 	// We only allow MFA login (2-step) when the feature is enabled and user has enabled MFA.
 	if s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_TWO_FA) == nil && !mfaSecondLogin && userMFAEnabled {
 		mfaTempToken, err := auth.GenerateMFATempToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret, mfaTempTokenDuration)
@@ -180,7 +200,9 @@ func (s *AuthService) Login(ctx context.Context, req *connect.Request[v1pb.Login
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to generate MFA temp token"))
 		}
 		return connect.NewResponse(&v1pb.LoginResponse{
-			MfaTempToken: &mfaTempToken,
+			MfaTempToken:    &mfaTempToken,
+			OtpEnabled:      otpEnabled,
+			WebauthnEnabled: webAuthnEnabled,
 		}), nil
 	}
 
@@ -285,7 +307,9 @@ func (s *AuthService) Logout(ctx context.Context, req *connect.Request[v1pb.Logo
 	if err != nil {
 		return nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
-	s.stateCfg.ExpireCache.Add(accessTokenStr, true)
+	if err := s.stateCfg.ExpireAccessToken(ctx, accessTokenStr); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to expire access token"))
+	}
 
 	resp := connect.NewResponse(&emptypb.Empty{})
 
@@ -319,7 +343,7 @@ func (s *AuthService) getAndVerifyUser(ctx context.Context, request *v1pb.LoginR
 	return user, nil
 }
 
-func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.LoginRequest) (*store.UserMessage, error) {
+func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.LoginRequest, headers http.Header) (*store.UserMessage, error) {
 	idpID, err := common.GetIdentityProviderID(request.IdpName)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to get identity provider ID"))
@@ -383,6 +407,25 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get user info"))
 		}
+	case storepb.IdentityProviderType_SAML:
+		samlContext := request.IdpContext.GetSamlContext()
+		if samlContext == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("missing SAML context"))
+		}
+		// The SAMLResponse must be in response to the AuthnRequest initiated in this browser.
+		requestID := getCookieValue(headers, saml.RequestIDCookieName)
+		if requestID == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("missing SAML request, please sign in again"))
+		}
+
+		samlIDP, err := saml.NewIdentityProvider(ctx, idp.Config.GetSamlConfig(), saml.GetEndpoints(externalURL, idp.ResourceID))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create new SAML identity provider"))
+		}
+		userInfo, _, err = samlIDP.UserInfo(samlContext.SamlResponse, []string{requestID})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Wrapf(err, "failed to get user info"))
+		}
 	case storepb.IdentityProviderType_LDAP:
 		idpConfig := idp.Config.GetLdapConfig()
 		ldapIDP, err := ldap.NewIdentityProvider(
@@ -570,7 +613,7 @@ func (s *AuthService) checkPasswordLockout(ctx context.Context, email string) er
 // checkMFALockout checks if the user has exceeded the MFA failure rate limit.
 // Returns an error if the user is locked out due to too many failed MFA attempts.
 func (s *AuthService) checkMFALockout(ctx context.Context, email string) error {
-	count, err := s.countRecentLoginFailures(ctx, email, mfaLockoutWindow, errMsgInvalidMFACode, errMsgInvalidRecoveryCode)
+	count, err := s.countRecentLoginFailures(ctx, email, mfaLockoutWindow, errMsgInvalidMFACode, errMsgInvalidRecoveryCode, errMsgInvalidWebAuthn)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to count recent MFA failures"))
 	}
@@ -590,23 +633,89 @@ func challengeMFACode(user *store.UserMessage, mfaCode string) error {
 }
 
 func (s *AuthService) challengeRecoveryCode(ctx context.Context, user *store.UserMessage, recoveryCode string) error {
-	for i, code := range user.MFAConfig.RecoveryCodes {
-		if subtle.ConstantTimeCompare([]byte(code), []byte(recoveryCode)) == 1 {
-			// If the recovery code is valid, delete it from the user's recovery code list.
-			user.MFAConfig.RecoveryCodes = slices.Delete(user.MFAConfig.RecoveryCodes, i, i+1)
-			_, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{
-				MFAConfig: &storepb.MFAConfig{
-					OtpSecret:     user.MFAConfig.OtpSecret,
-					RecoveryCodes: user.MFAConfig.RecoveryCodes,
-				},
-			})
-			if err != nil {
-				return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to update user"))
+	// If the recovery code is valid, delete it from the user's recovery code list.
+	return updateMFAConfig(ctx, s.store, user, func(mfaConfig *storepb.MFAConfig) error {
+		for i, code := range mfaConfig.RecoveryCodes {
+			if subtle.ConstantTimeCompare([]byte(code), []byte(recoveryCode)) == 1 {
+				mfaConfig.RecoveryCodes = slices.Delete(mfaConfig.RecoveryCodes, i, i+1)
+				return nil
 			}
+		}
+		return connect.NewError(connect.CodeUnauthenticated, errors.Errorf(errMsgInvalidRecoveryCode))
+	})
+}
+
+// BeginWebAuthnLogin starts the WebAuthn assertion ceremony for the user of the MFA temp token.
+func (s *AuthService) BeginWebAuthnLogin(ctx context.Context, req *connect.Request[v1pb.BeginWebAuthnLoginRequest]) (*connect.Response[v1pb.BeginWebAuthnLoginResponse], error) {
+	userID, err := auth.GetUserIDFromMFATempToken(req.Msg.MfaTempToken, s.profile.Mode, s.secret)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to find user"))
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, invalidCredentialsError
+	}
+	credentials := convertToWebAuthnCredentials(user.MFAConfig.GetWebauthnCredentials())
+	if len(credentials) == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("no WebAuthn credential is registered"))
+	}
+	rp, err := getWebAuthnRelyingParty(ctx, s.store, s.profile)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	options, err := rp.RequestOptions(challenge, credentials)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to build WebAuthn options"))
+	}
+	if err := setWebAuthnChallenge(ctx, s.store, user, storepb.WebAuthnChallenge_LOGIN, getWebAuthnChallengeBinding(req.Msg.MfaTempToken), challenge); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.BeginWebAuthnLoginResponse{
+		Options: string(options),
+	}), nil
+}
+
+// challengeWebAuthnAssertion verifies the WebAuthn assertion against the pending login challenge of the MFA temp token.
+func (s *AuthService) challengeWebAuthnAssertion(ctx context.Context, user *store.UserMessage, mfaTempToken, assertion string) error {
+	rp, err := getWebAuthnRelyingParty(ctx, s.store, s.profile)
+	if err != nil {
+		return err
+	}
+
+	var verifyErr error
+	// The challenge is single-use, so it is removed whether the verification succeeds or not.
+	if err := updateMFAConfig(ctx, s.store, user, func(mfaConfig *storepb.MFAConfig) error {
+		challenge := takeWebAuthnChallenge(mfaConfig, storepb.WebAuthnChallenge_LOGIN, getWebAuthnChallengeBinding(mfaTempToken))
+		if challenge == nil {
+			return connect.NewError(connect.CodeUnauthenticated, errors.Errorf("WebAuthn challenge has expired, please try again"))
+		}
+		used, err := rp.VerifyAssertion(challenge.Challenge, convertToWebAuthnCredentials(mfaConfig.WebauthnCredentials), []byte(assertion))
+		verifyErr = err
+		if err != nil {
 			return nil
 		}
+		for _, c := range mfaConfig.WebauthnCredentials {
+			if bytes.Equal(c.Id, used.ID) {
+				c.SignCount = used.SignCount
+				c.LastUsedTime = timestamppb.Now()
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
-	return connect.NewError(connect.CodeUnauthenticated, errors.Errorf(errMsgInvalidRecoveryCode))
+	if verifyErr != nil {
+		slog.Debug("failed to verify WebAuthn assertion", slog.String("user", user.Email), log.BBError(verifyErr))
+		return connect.NewError(connect.CodeUnauthenticated, errors.Errorf(errMsgInvalidWebAuthn))
+	}
+	return nil
 }
 
 // validateWithCodeAndSecret validates the given code against the given secret.
@@ -671,3 +780,16 @@ func (s *AuthService) syncUserGroups(ctx context.Context, user *store.UserMessag
 
 	return nil
 }
+
+// getCookieValue returns the value of the cookie in the request headers, including the gRPC gateway cookies.
+func getCookieValue(headers http.Header, name string) string {
+	for _, cookieHeader := range append(headers.Values("cookie"), headers.Values("grpcgateway-cookie")...) {
+		header := http.Header{}
+		header.Add("Cookie", cookieHeader)
+		request := http.Request{Header: header}
+		if cookie, _ := request.Cookie(name); cookie != nil {
+			return cookie.Value
+		}
+	}
+	return ""
+}
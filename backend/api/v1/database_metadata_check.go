@@ -8,7 +8,7 @@ import (
 
 func checkDatabaseMetadata(engine storepb.Engine, metadata *storepb.DatabaseSchemaMetadata) error {
 	switch engine {
-	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES, storepb.Engine_ORACLE:
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES, storepb.Engine_ORACLE, storepb.Engine_MSSQL, storepb.Engine_SNOWFLAKE, storepb.Engine_REDSHIFT, storepb.Engine_CLICKHOUSE:
 	default:
 		return errors.Errorf("unsupported engine for check database metadata: %v", engine)
 	}
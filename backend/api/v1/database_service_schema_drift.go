@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// GetSchemaDrift gets the schema drift of a database detected by the last sync.
+func (s *DatabaseService) GetSchemaDrift(ctx context.Context, req *connect.Request[v1pb.GetSchemaDriftRequest]) (*connect.Response[v1pb.SchemaDrift], error) {
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(req.Msg.Name, common.SchemaDriftSuffix)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get instance %s", instanceID))
+	}
+	if instance == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("instance %q not found", instanceID))
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:      &instanceID,
+		DatabaseName:    &databaseName,
+		IsCaseSensitive: store.IsObjectCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database"))
+	}
+	if database == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q not found", databaseName))
+	}
+
+	drift := &v1pb.SchemaDrift{
+		Name:    common.FormatDatabase(database.InstanceID, database.DatabaseName) + common.SchemaDriftSuffix,
+		Drifted: database.Metadata.GetDrifted(),
+	}
+	if !drift.Drifted {
+		return connect.NewResponse(drift), nil
+	}
+	syncHistory, err := s.store.GetLatestDriftSyncHistory(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get drift sync history"))
+	}
+	// The databases drifted before the drift reports were introduced have no report until the next sync.
+	if syncHistory == nil {
+		return connect.NewResponse(drift), nil
+	}
+	drift.Changelog = common.FormatChangelog(database.InstanceID, database.DatabaseName, syncHistory.DriftReport.GetChangelogUid())
+	drift.DetectTime = timestamppb.New(syncHistory.CreatedAt)
+	drift.Objects = convertToV1SchemaDriftObjects(syncHistory.DriftReport.GetObjects())
+	return connect.NewResponse(drift), nil
+}
+
+func convertToV1SchemaDriftObjects(objects []*storepb.SchemaDriftObject) []*v1pb.SchemaDriftObject {
+	var result []*v1pb.SchemaDriftObject
+	for _, object := range objects {
+		result = append(result, &v1pb.SchemaDriftObject{
+			Type:   v1pb.SchemaDriftObject_Type(object.Type),
+			Action: v1pb.SchemaDriftObject_Action(object.Action),
+			Schema: object.Schema,
+			Table:  object.Table,
+			Name:   object.Name,
+		})
+	}
+	return result
+}
+
+// ReconcileSchemaDrift generates the statement between the schema recorded by the latest changelog and the drifted
+// schema, and either creates a plan reverting the drift or records a baseline changelog adopting the drift.
+// Adopting the drift doesn't require the statement, so it falls back to the drifted schema for the engines without
+// migration generation.
+func (s *DatabaseService) ReconcileSchemaDrift(ctx context.Context, req *connect.Request[v1pb.ReconcileSchemaDriftRequest]) (*connect.Response[v1pb.ReconcileSchemaDriftResponse], error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("user not found"))
+	}
+	strategy := req.Msg.Strategy
+	if strategy != v1pb.ReconcileSchemaDriftRequest_REVERT && strategy != v1pb.ReconcileSchemaDriftRequest_ADOPT {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported strategy %q", strategy))
+	}
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(req.Msg.Name, common.SchemaDriftSuffix)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get instance %s", instanceID))
+	}
+	if instance == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("instance %q not found", instanceID))
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:      &instanceID,
+		DatabaseName:    &databaseName,
+		IsCaseSensitive: store.IsObjectCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database"))
+	}
+	if database == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q not found", databaseName))
+	}
+	// Both strategies change the schema history of the database outside the rollout, so they require the permission
+	// to create plans in the project as well.
+	ok, err = s.iamManager.CheckPermission(ctx, iam.PermissionPlansCreate, user, database.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check permission"))
+	}
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", iam.PermissionPlansCreate))
+	}
+	if !database.Metadata.GetDrifted() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("database %q is not drifted", databaseName))
+	}
+	driftSyncHistory, err := s.store.GetLatestDriftSyncHistory(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get drift sync history"))
+	}
+	if driftSyncHistory == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("schema drift report of database %q not found, please sync the database first", databaseName))
+	}
+	changelogUID := driftSyncHistory.DriftReport.GetChangelogUid()
+	changelog, err := s.store.GetChangelog(ctx, &store.FindChangelogMessage{UID: &changelogUID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get changelog %d", changelogUID))
+	}
+	if changelog == nil || changelog.SyncHistoryUID == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("recorded schema of changelog %d not found", changelogUID))
+	}
+	recordedSyncHistory, err := s.store.GetSyncHistoryByUID(ctx, *changelog.SyncHistoryUID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get sync history %d", *changelog.SyncHistoryUID))
+	}
+
+	// Revert migrates the drifted schema to the recorded one, and adopt records the opposite.
+	oldSyncHistory, newSyncHistory := driftSyncHistory, recordedSyncHistory
+	if strategy == v1pb.ReconcileSchemaDriftRequest_ADOPT {
+		oldSyncHistory, newSyncHistory = recordedSyncHistory, driftSyncHistory
+	}
+	statement, err := generateSyncHistoryMigration(instance, oldSyncHistory, newSyncHistory)
+	if err != nil {
+		if strategy == v1pb.ReconcileSchemaDriftRequest_REVERT {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Wrapf(err, "failed to generate the statement reconciling the schema drift"))
+		}
+		// The baseline changelog records the drifted schema itself if the engine doesn't support generating the statement.
+		statement = driftSyncHistory.Schema
+	}
+	if strings.TrimSpace(statement) == "" && strategy == v1pb.ReconcileSchemaDriftRequest_REVERT {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("no statement is generated to revert the schema drift of database %q", databaseName))
+	}
+
+	databaseResourceName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+	title := fmt.Sprintf("Revert schema drift of %s", database.DatabaseName)
+	if strategy == v1pb.ReconcileSchemaDriftRequest_ADOPT {
+		title = fmt.Sprintf("Adopt schema drift of %s", database.DatabaseName)
+	}
+	sheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID: user.ID,
+		ProjectID: database.ProjectID,
+		Title:     title,
+		Statement: statement,
+		Payload: &storepb.SheetPayload{
+			Engine: instance.Metadata.GetEngine(),
+		},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create sheet"))
+	}
+	sheetName := common.FormatSheet(database.ProjectID, sheet.UID)
+	response := &v1pb.ReconcileSchemaDriftResponse{Statement: statement}
+
+	if strategy == v1pb.ReconcileSchemaDriftRequest_ADOPT {
+		baseline, err := s.store.CreateChangelog(ctx, &store.ChangelogMessage{
+			InstanceID:         database.InstanceID,
+			DatabaseName:       database.DatabaseName,
+			Status:             store.ChangelogStatusDone,
+			PrevSyncHistoryUID: &recordedSyncHistory.UID,
+			SyncHistoryUID:     &driftSyncHistory.UID,
+			Payload: &storepb.ChangelogPayload{
+				Type:      storepb.ChangelogPayload_BASELINE,
+				Sheet:     sheetName,
+				GitCommit: s.profile.GitCommit,
+			},
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create changelog"))
+		}
+		if _, err := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+			InstanceID:   database.InstanceID,
+			DatabaseName: database.DatabaseName,
+			MetadataUpdates: []func(*storepb.DatabaseMetadata){
+				func(dm *storepb.DatabaseMetadata) {
+					dm.Drifted = false
+				},
+			},
+		}); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to update database"))
+		}
+		response.Changelog = common.FormatChangelog(database.InstanceID, database.DatabaseName, baseline)
+		return connect.NewResponse(response), nil
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project %q", database.ProjectID))
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", database.ProjectID))
+	}
+	planMessage := &store.PlanMessage{
+		ProjectID:   project.ResourceID,
+		Name:        title,
+		Description: fmt.Sprintf("Migrate %s back to the schema recorded by changelog %d.", databaseResourceName, changelogUID),
+		Config: &storepb.PlanConfig{
+			Specs: []*storepb.PlanConfig_Spec{
+				{
+					Id: uuid.NewString(),
+					Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+						ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+							Targets: []string{databaseResourceName},
+							Sheet:   sheetName,
+							Type:    storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE,
+						},
+					},
+				},
+			},
+		},
+	}
+	deployment, err := getPlanDeployment(ctx, s.store, planMessage.Config.GetSpecs(), project)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get plan deployment snapshot"))
+	}
+	planMessage.Config.Deployment = deployment
+	if _, err := GetPipelineCreate(ctx, s.store, s.sheetManager, s.dbFactory, planMessage.Config.GetSpecs(), deployment, project); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get pipeline from the plan"))
+	}
+	plan, err := s.store.CreatePlan(ctx, planMessage, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create plan"))
+	}
+	planCheckRuns, err := getPlanCheckRunsFromPlan(ctx, s.store, plan)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get plan check runs for plan"))
+	}
+	if err := s.store.CreatePlanCheckRuns(ctx, plan, planCheckRuns...); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create plan check runs"))
+	}
+	s.stateCfg.TicklePlanCheckScheduler(ctx)
+
+	response.Plan = common.FormatPlan(plan.ProjectID, plan.UID)
+	return connect.NewResponse(response), nil
+}
+
+// generateSyncHistoryMigration generates the statement migrating the schema of the old sync history to the new one.
+func generateSyncHistoryMigration(instance *store.InstanceMessage, oldSyncHistory, newSyncHistory *store.SyncHistory) (string, error) {
+	engine := instance.Metadata.GetEngine()
+	isCaseSensitive := store.IsObjectCaseSensitive(instance)
+	oldSchema := model.NewDatabaseMetadata(oldSyncHistory.Metadata, []byte(oldSyncHistory.Schema), &storepb.DatabaseConfig{}, engine, isCaseSensitive)
+	newSchema := model.NewDatabaseMetadata(newSyncHistory.Metadata, []byte(newSyncHistory.Schema), &storepb.DatabaseConfig{}, engine, isCaseSensitive)
+	diff, err := schema.GetDatabaseSchemaDiff(engine, oldSchema, newSchema)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to compute schema diff")
+	}
+	if engine == storepb.Engine_POSTGRES {
+		diff = schema.FilterPostgresArchiveSchema(diff)
+	}
+	return schema.GenerateMigration(engine, diff)
+}
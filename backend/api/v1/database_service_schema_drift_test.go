@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/mysql"
+)
+
+func TestGenerateSyncHistoryMigration(t *testing.T) {
+	instance := &store.InstanceMessage{
+		Metadata: &storepb.Instance{Engine: storepb.Engine_MYSQL},
+	}
+	recorded := &store.SyncHistory{
+		Metadata: &storepb.DatabaseSchemaMetadata{
+			Name: "db",
+			Schemas: []*storepb.SchemaMetadata{
+				{Tables: []*storepb.TableMetadata{
+					{Name: "t", Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "int"}}},
+				}},
+			},
+		},
+	}
+	drifted := &store.SyncHistory{
+		Metadata: &storepb.DatabaseSchemaMetadata{
+			Name: "db",
+			Schemas: []*storepb.SchemaMetadata{
+				{Tables: []*storepb.TableMetadata{
+					{Name: "t", Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "int"}, {Name: "c", Type: "int", Nullable: true}}},
+				}},
+			},
+		},
+	}
+
+	revert, err := generateSyncHistoryMigration(instance, drifted, recorded)
+	require.NoError(t, err)
+	require.Contains(t, revert, "DROP COLUMN `c`")
+
+	adopt, err := generateSyncHistoryMigration(instance, recorded, drifted)
+	require.NoError(t, err)
+	require.Contains(t, adopt, "ADD COLUMN `c`")
+
+	same, err := generateSyncHistoryMigration(instance, recorded, recorded)
+	require.NoError(t, err)
+	require.Empty(t, same)
+}
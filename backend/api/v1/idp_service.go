@@ -20,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -46,7 +47,11 @@ func (s *IdentityProviderService) GetIdentityProvider(ctx context.Context, req *
 	if err != nil {
 		return nil, err
 	}
-	identityProvider := convertToIdentityProvider(identityProviderMessage)
+	externalURL, err := s.getExternalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identityProvider := convertToIdentityProvider(identityProviderMessage, externalURL)
 	return connect.NewResponse(identityProvider), nil
 }
 
@@ -56,9 +61,13 @@ func (s *IdentityProviderService) ListIdentityProviders(ctx context.Context, _ *
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	externalURL, err := s.getExternalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
 	response := &v1pb.ListIdentityProvidersResponse{}
 	for _, identityProviderMessage := range identityProviders {
-		identityProvider := convertToIdentityProvider(identityProviderMessage)
+		identityProvider := convertToIdentityProvider(identityProviderMessage, externalURL)
 		response.IdentityProviders = append(response.IdentityProviders, identityProvider)
 	}
 	return connect.NewResponse(response), nil
@@ -101,7 +110,7 @@ func (s *IdentityProviderService) CreateIdentityProvider(ctx context.Context, re
 		Config:     convertIdentityProviderConfigToStore(req.Msg.IdentityProvider.GetConfig()),
 	}
 	if req.Msg.ValidateOnly {
-		identityProvider := convertToIdentityProvider(identityProviderMessage)
+		identityProvider := convertToIdentityProvider(identityProviderMessage, externalURL)
 		return connect.NewResponse(identityProvider), nil
 	}
 
@@ -109,7 +118,7 @@ func (s *IdentityProviderService) CreateIdentityProvider(ctx context.Context, re
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create identity provider"))
 	}
-	identityProvider := convertToIdentityProvider(identityProviderMessage)
+	identityProvider := convertToIdentityProvider(identityProviderMessage, externalURL)
 	return connect.NewResponse(identityProvider), nil
 }
 
@@ -181,6 +190,10 @@ func (s *IdentityProviderService) UpdateIdentityProvider(ctx context.Context, re
 			if req.Msg.IdentityProvider.Config.GetLdapConfig().BindPassword == "" {
 				patch.Config.GetLdapConfig().BindPassword = identityProviderMessage.Config.GetLdapConfig().BindPassword
 			}
+		case storepb.IdentityProviderType_SAML:
+			if req.Msg.IdentityProvider.Config.GetSamlConfig().PrivateKey == "" {
+				patch.Config.GetSamlConfig().PrivateKey = identityProviderMessage.Config.GetSamlConfig().PrivateKey
+			}
 		default:
 		}
 	}
@@ -189,7 +202,11 @@ func (s *IdentityProviderService) UpdateIdentityProvider(ctx context.Context, re
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	identityProvider := convertToIdentityProvider(identityProviderMessage)
+	externalURL, err := s.getExternalURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identityProvider := convertToIdentityProvider(identityProviderMessage, externalURL)
 	return connect.NewResponse(identityProvider), nil
 }
 
@@ -381,6 +398,62 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, req
 
 		// LDAP cannot return claims without username and password so we return an empty claims map.
 		return connect.NewResponse(&v1pb.TestIdentityProviderResponse{Claims: make(map[string]string)}), nil
+	case v1pb.IdentityProviderType_SAML:
+		// Retrieve private key from stored identity provider if not provided.
+		if identityProvider.Config.GetSamlConfig().PrivateKey == "" {
+			storedIdentityProvider, err := s.getIdentityProviderMessage(ctx, identityProvider.Name)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to find identity provider, error: %s", err.Error()))
+			}
+			if storedIdentityProvider == nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("identity provider %s not found", identityProvider.Name))
+			}
+			identityProvider.Config.GetSamlConfig().PrivateKey = storedIdentityProvider.Config.GetSamlConfig().PrivateKey
+		}
+		identityProviderID, err := common.GetIdentityProviderID(identityProvider.Name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config)
+		samlIdentityProvider, err := saml.NewIdentityProvider(ctx, identityProviderConfig.GetSamlConfig(), saml.GetEndpoints(externalURL, identityProviderID))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to create new SAML identity provider, error: %s", err.Error()))
+		}
+
+		samlContext := req.Msg.GetSamlContext()
+		if samlContext.GetSamlResponse() == "" {
+			// Without a SAMLResponse, we can only validate the configuration and the IdP metadata.
+			claimsMap := map[string]string{
+				"entityID": samlIdentityProvider.IDPEntityID(),
+				"ssoURL":   samlIdentityProvider.SSOURL(),
+			}
+			return connect.NewResponse(&v1pb.TestIdentityProviderResponse{Claims: claimsMap}), nil
+		}
+		userInfo, claims, err := samlIdentityProvider.UserInfo(samlContext.SamlResponse, nil)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to get user info, error: %s", err.Error()))
+		}
+
+		claimsMap := make(map[string]string)
+		for key, value := range claims {
+			claimsMap[key] = fmt.Sprintf("%v", value)
+		}
+		userInfoMap := make(map[string]string)
+		if userInfo != nil {
+			if userInfo.Identifier != "" {
+				userInfoMap["email"] = userInfo.Identifier
+			}
+			if userInfo.DisplayName != "" {
+				userInfoMap["title"] = userInfo.DisplayName
+			}
+			if userInfo.Phone != "" {
+				userInfoMap["phone"] = userInfo.Phone
+			}
+			if userInfo.Groups != nil {
+				userInfoMap["groups"] = fmt.Sprintf("%v", userInfo.Groups)
+			}
+		}
+		return connect.NewResponse(&v1pb.TestIdentityProviderResponse{Claims: claimsMap, UserInfo: userInfoMap}), nil
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("identity provider type %s not supported", identityProvider.Type.String()))
 	}
@@ -405,9 +478,24 @@ func (s *IdentityProviderService) getIdentityProviderMessage(ctx context.Context
 	return identityProvider, nil
 }
 
-func convertToIdentityProvider(identityProvider *store.IdentityProviderMessage) *v1pb.IdentityProvider {
+func (s *IdentityProviderService) getExternalURL(ctx context.Context) (string, error) {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get workspace setting"))
+	}
+	// Use command-line flag value if set, otherwise use database value
+	return common.GetEffectiveExternalURL(s.profile.ExternalURL, setting.ExternalUrl), nil
+}
+
+func convertToIdentityProvider(identityProvider *store.IdentityProviderMessage, externalURL string) *v1pb.IdentityProvider {
 	identityProviderType := v1pb.IdentityProviderType(identityProvider.Type)
 	config := convertIdentityProviderConfigFromStore(identityProvider.Config)
+	if samlConfig := config.GetSamlConfig(); samlConfig != nil && externalURL != "" {
+		// The SP endpoints are derived from the external URL and need to be registered in the IdP.
+		endpoints := saml.GetEndpoints(externalURL, identityProvider.ResourceID)
+		samlConfig.SpMetadataUrl = endpoints.MetadataURL
+		samlConfig.AcsUrl = endpoints.ACSURL
+	}
 	return &v1pb.IdentityProvider{
 		Name:   fmt.Sprintf("%s%s", common.IdentityProviderNamePrefix, identityProvider.ResourceID),
 		Title:  identityProvider.Title,
@@ -495,6 +583,26 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := v1pb.FieldMapping{
+			Identifier:  v.FieldMapping.GetIdentifier(),
+			DisplayName: v.FieldMapping.GetDisplayName(),
+			Phone:       v.FieldMapping.GetPhone(),
+			Groups:      v.FieldMapping.GetGroups(),
+		}
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &v1pb.SAMLIdentityProviderConfig{
+					MetadataUrl:   v.MetadataUrl,
+					MetadataXml:   v.MetadataXml,
+					EntityId:      v.EntityId,
+					Certificate:   v.Certificate,
+					PrivateKey:    "", // SECURITY: We do not expose the private key
+					FieldMapping:  &fieldMapping,
+					SkipTlsVerify: v.SkipTlsVerify,
+				},
+			},
+		}
 	}
 	return nil
 }
@@ -564,6 +672,26 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := storepb.FieldMapping{
+			Identifier:  v.FieldMapping.GetIdentifier(),
+			DisplayName: v.FieldMapping.GetDisplayName(),
+			Phone:       v.FieldMapping.GetPhone(),
+			Groups:      v.FieldMapping.GetGroups(),
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &storepb.SAMLIdentityProviderConfig{
+					MetadataUrl:   v.MetadataUrl,
+					MetadataXml:   v.MetadataXml,
+					EntityId:      v.EntityId,
+					Certificate:   v.Certificate,
+					PrivateKey:    v.PrivateKey,
+					FieldMapping:  &fieldMapping,
+					SkipTlsVerify: v.SkipTlsVerify,
+				},
+			},
+		}
 	}
 	return nil
 }
@@ -583,6 +711,10 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 		if identityProviderConfig.GetLdapConfig() == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+	case v1pb.IdentityProviderType_SAML:
+		if identityProviderConfig.GetSamlConfig() == nil {
+			return errors.Errorf("unexpected provider config value")
+		}
 	default:
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sampleinstance"
+	secretcomp "github.com/bytebase/bytebase/backend/component/secret"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -777,6 +778,8 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, req *connect.Req
 			if err != nil {
 				return nil, err
 			}
+			// Evict the cached secret so that the next connection fetches it again.
+			secretcomp.InvalidateExternalSecret(dataSource.ExternalSecret)
 			dataSource.ExternalSecret = externalSecret
 		case "sasl_config":
 			dataSource.SaslConfig = convertV1DataSourceSaslConfig(req.Msg.DataSource.SaslConfig)
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"path/filepath"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -134,6 +135,7 @@ func convertDataSourceExternalSecret(externalSecret *storepb.DataSourceExternalS
 		SecretName:               externalSecret.SecretName,
 		PasswordKeyName:          externalSecret.PasswordKeyName,
 		SkipVaultTlsVerification: externalSecret.SkipVaultTlsVerification,
+		CacheTtlSeconds:          externalSecret.CacheTtlSeconds,
 		// Clear sensitive Vault SSL data (INPUT_ONLY fields, should not be returned)
 		VaultSslCa:   "",
 		VaultSslCert: "",
@@ -270,6 +272,7 @@ func convertV1DataSourceExternalSecret(externalSecret *v1pb.DataSourceExternalSe
 		VaultSslCa:               externalSecret.VaultSslCa,
 		VaultSslCert:             externalSecret.VaultSslCert,
 		VaultSslKey:              externalSecret.VaultSslKey,
+		CacheTtlSeconds:          externalSecret.CacheTtlSeconds,
 	}
 
 	// Convert auth options
@@ -309,6 +312,21 @@ func convertV1DataSourceExternalSecret(externalSecret *v1pb.DataSourceExternalSe
 		if secret.SecretName == "" {
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing GCP secret name"))
 		}
+	case storepb.DataSourceExternalSecret_AZURE_KEY_VAULT:
+		if secret.Url == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing Azure Key Vault URL"))
+		}
+		if secret.SecretName == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing secret name"))
+		}
+	case storepb.DataSourceExternalSecret_FILE:
+		if !filepath.IsAbs(secret.SecretName) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("secret file path must be absolute"))
+		}
+	case storepb.DataSourceExternalSecret_EXEC:
+		if !filepath.IsAbs(secret.Url) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("secret command path must be absolute"))
+		}
 	default:
 	}
 
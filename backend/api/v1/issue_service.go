@@ -493,7 +493,7 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to create issue, error: %v", err))
 	}
-	s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+	s.stateCfg.FindApprovalTemplate(ctx, issue)
 
 	s.webhookManager.CreateEvent(ctx, &webhook.Event{
 		Actor:   user,
@@ -583,7 +583,7 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to create issue, error: %v", err))
 	}
-	s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+	s.stateCfg.FindApprovalTemplate(ctx, issue)
 
 	s.webhookManager.CreateEvent(ctx, &webhook.Event{
 		Actor:   user,
@@ -687,7 +687,7 @@ func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, reques
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to create issue, error: %v", err))
 	}
-	s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+	s.stateCfg.FindApprovalTemplate(ctx, issue)
 
 	s.webhookManager.CreateEvent(ctx, &webhook.Event{
 		Actor:   user,
@@ -765,7 +765,8 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 	}
 
 	// Grant the privilege if the issue is approved.
-	if approved && issue.Type == storepb.Issue_GRANT_REQUEST {
+	// The break-glass access has been granted on creation, and the approval is the post-incident review.
+	if approved && issue.Type == storepb.Issue_GRANT_REQUEST && !payload.GrantRequest.GetBreakGlass() {
 		if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, s.store, issue, payload.GrantRequest); err != nil {
 			return nil, err
 		}
@@ -1246,7 +1247,7 @@ func (s *IssueService) UpdateIssue(ctx context.Context, req *connect.Request[v1p
 	}
 
 	if updateMasks["approval_finding_done"] {
-		s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+		s.stateCfg.FindApprovalTemplate(ctx, issue)
 	}
 
 	for _, e := range webhookEvents {
@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// breakGlassMaxDuration caps the duration of the break-glass access.
+const breakGlassMaxDuration = 4 * time.Hour
+
+// breakGlassConditionTitle is the condition title of the IAM bindings granted by break-glass access.
+const breakGlassConditionTitle = "Break-glass access"
+
+// BreakGlass grants the caller emergency SQL Editor access to the databases without approval.
+// The grant request issue is created for the post-incident review, whose approval doesn't grant the access again.
+func (s *IssueService) BreakGlass(ctx context.Context, req *connect.Request[v1pb.BreakGlassRequest]) (*connect.Response[v1pb.Issue], error) {
+	if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_REQUEST_ROLE_WORKFLOW); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			errors.Errorf("break-glass access requires approval workflow feature (available in Enterprise plan)"))
+	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+
+	justification := strings.TrimSpace(req.Msg.Justification)
+	if justification == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("justification is required"))
+	}
+	duration := req.Msg.Duration.AsDuration()
+	if duration <= 0 || duration > breakGlassMaxDuration {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("duration must be positive and no longer than %v", breakGlassMaxDuration))
+	}
+	if len(req.Msg.Databases) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("databases are required"))
+	}
+
+	projectID, err := common.GetProjectID(req.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project"))
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", projectID))
+	}
+
+	var databases []string
+	for _, name := range req.Msg.Databases {
+		database, err := getDatabaseMessage(ctx, s.store, name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if database.Deleted || database.ProjectID != project.ResourceID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database %q is not in project %q", name, project.ResourceID))
+		}
+		databases = append(databases, common.FormatDatabase(database.InstanceID, database.DatabaseName))
+	}
+	slices.Sort(databases)
+	databases = slices.Compact(databases)
+
+	expireTime := time.Now().Add(duration)
+	grantRequest := &storepb.GrantRequest{
+		Role: common.FormatRole(common.SQLEditorUser),
+		User: common.FormatUserUID(user.ID),
+		Condition: &expr.Expr{
+			Title:      breakGlassConditionTitle,
+			Expression: buildBreakGlassExpression(databases, expireTime),
+		},
+		Expiration: durationpb.New(duration),
+		BreakGlass: true,
+	}
+	issue, err := s.store.CreateIssueV2(ctx, &store.IssueMessage{
+		Project:     project,
+		Title:       fmt.Sprintf("Review break-glass access by %s", user.Name),
+		Status:      storepb.Issue_OPEN,
+		Type:        storepb.Issue_GRANT_REQUEST,
+		Description: justification,
+		Payload: &storepb.Issue{
+			GrantRequest: grantRequest,
+			Approval: &storepb.IssuePayloadApproval{
+				ApprovalFindingDone: false,
+			},
+		},
+	}, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create issue"))
+	}
+	if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, s.store, issue, grantRequest); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to grant break-glass access"))
+	}
+	s.stateCfg.FindApprovalTemplate(ctx, issue)
+
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   user,
+		Type:    storepb.Activity_ISSUE_CREATE,
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+	})
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor: user,
+		Type:  storepb.Activity_NOTIFY_BREAK_GLASS,
+		Comment: fmt.Sprintf("%s\nDatabases: %s\nExpires at: %s",
+			justification, strings.Join(databases, ", "), expireTime.UTC().Format(time.RFC3339)),
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+	})
+
+	converted, err := s.convertToIssue(ctx, issue)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to convert to issue"))
+	}
+	return connect.NewResponse(converted), nil
+}
+
+// buildBreakGlassExpression builds the IAM condition that limits the access to the databases until the expire time.
+func buildBreakGlassExpression(databases []string, expireTime time.Time) string {
+	var quoted []string
+	for _, database := range databases {
+		quoted = append(quoted, strconv.Quote(database))
+	}
+	return fmt.Sprintf(`%s < timestamp(%q) && %s in [%s]`,
+		common.CELAttributeRequestTime,
+		expireTime.UTC().Format(time.RFC3339),
+		common.CELAttributeResourceDatabase,
+		strings.Join(quoted, ", "),
+	)
+}
+
+// breakGlassAccess collects the break-glass grants that the access checks of a request rely on.
+type breakGlassAccess struct {
+	mu        sync.Mutex
+	issueUIDs []int
+}
+
+// withBreakGlassAccess returns a context that collects the break-glass grants used by the access checks.
+func withBreakGlassAccess(ctx context.Context) (context.Context, *breakGlassAccess) {
+	access := &breakGlassAccess{}
+	return context.WithValue(ctx, common.BreakGlassAccessKey, access), access
+}
+
+// isBreakGlassBinding returns true if the binding is granted by break-glass access.
+func isBreakGlassBinding(binding *storepb.Binding) bool {
+	return binding.GetCondition().GetTitle() == breakGlassConditionTitle && strings.HasPrefix(binding.GetCondition().GetDescription(), "#")
+}
+
+// recordBreakGlassBinding records that the access of the request is granted solely by the break-glass binding.
+func recordBreakGlassBinding(ctx context.Context, binding *storepb.Binding) {
+	access, ok := ctx.Value(common.BreakGlassAccessKey).(*breakGlassAccess)
+	if !ok {
+		return
+	}
+	issueUID, err := strconv.Atoi(strings.TrimPrefix(binding.GetCondition().GetDescription(), "#"))
+	if err != nil {
+		return
+	}
+	access.mu.Lock()
+	defer access.mu.Unlock()
+	if !slices.Contains(access.issueUIDs, issueUID) {
+		access.issueUIDs = append(access.issueUIDs, issueUID)
+	}
+}
+
+// getIssue returns the name of the break-glass issue under which the request accesses the databases.
+// It returns an empty string if no access of the request relies on break-glass access.
+func (a *breakGlassAccess) getIssue(ctx context.Context, stores *store.Store) (string, error) {
+	a.mu.Lock()
+	issueUIDs := slices.Clone(a.issueUIDs)
+	a.mu.Unlock()
+	for _, issueUID := range issueUIDs {
+		issue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get issue %d", issueUID)
+		}
+		if issue == nil || !issue.Payload.GetGrantRequest().GetBreakGlass() {
+			continue
+		}
+		return common.FormatIssue(issue.Project.ResourceID, issue.UID), nil
+	}
+	return "", nil
+}
+
+// resolveBreakGlassIssue returns the break-glass issue of the request and tags it in the audit log.
+func resolveBreakGlassIssue(ctx context.Context, stores *store.Store, access *breakGlassAccess) string {
+	breakGlassIssue, err := access.getIssue(ctx, stores)
+	if err != nil {
+		slog.Warn("failed to get break-glass issue", log.BBError(err))
+		return ""
+	}
+	if breakGlassIssue != "" {
+		tagBreakGlassAccess(ctx, breakGlassIssue)
+	}
+	return breakGlassIssue
+}
+
+// tagBreakGlassAccess records the break-glass issue in the audit log of the request.
+func tagBreakGlassAccess(ctx context.Context, breakGlassIssue string) {
+	setServiceData, ok := common.GetSetServiceDataFromContext(ctx)
+	if !ok {
+		return
+	}
+	p, err := anypb.New(&v1pb.AuditData{BreakGlassIssue: breakGlassIssue})
+	if err != nil {
+		slog.Warn("audit: failed to convert to anypb.Any", log.BBError(err))
+		return
+	}
+	setServiceData(p)
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestBuildBreakGlassExpression(t *testing.T) {
+	a := require.New(t)
+	expireTime := time.Date(2025, 4, 26, 11, 24, 48, 0, time.UTC)
+	expression := buildBreakGlassExpression([]string{"instances/prod/databases/db1", "instances/prod/databases/db2"}, expireTime)
+	a.Equal(`request.time < timestamp("2025-04-26T11:24:48Z") && resource.database in ["instances/prod/databases/db1", "instances/prod/databases/db2"]`, expression)
+
+	tests := []struct {
+		database string
+		now      time.Time
+		want     bool
+	}{
+		{database: "instances/prod/databases/db1", now: expireTime.Add(-time.Minute), want: true},
+		{database: "instances/prod/databases/db2", now: expireTime.Add(-time.Minute), want: true},
+		{database: "instances/prod/databases/db3", now: expireTime.Add(-time.Minute), want: false},
+		{database: "instances/prod/databases/db1", now: expireTime.Add(time.Minute), want: false},
+	}
+	for _, tc := range tests {
+		got, err := evaluateQueryExportPolicyCondition(expression, map[string]any{
+			common.CELAttributeRequestTime:      tc.now,
+			common.CELAttributeResourceDatabase: tc.database,
+		})
+		a.NoError(err)
+		a.Equal(tc.want, got, "%s at %v", tc.database, tc.now)
+	}
+}
+
+func TestRecordBreakGlassBinding(t *testing.T) {
+	a := require.New(t)
+	breakGlassBinding := &storepb.Binding{
+		Role:      common.FormatRole(common.SQLEditorUser),
+		Condition: &expr.Expr{Title: breakGlassConditionTitle, Description: "#102"},
+	}
+	grantBinding := &storepb.Binding{
+		Role:      common.FormatRole(common.SQLEditorUser),
+		Condition: &expr.Expr{Title: "Query access", Description: "#101"},
+	}
+	a.True(isBreakGlassBinding(breakGlassBinding))
+	a.False(isBreakGlassBinding(grantBinding))
+
+	// The bindings are not recorded without the break-glass access in the context.
+	recordBreakGlassBinding(context.Background(), breakGlassBinding)
+
+	ctx, access := withBreakGlassAccess(context.Background())
+	recordBreakGlassBinding(ctx, breakGlassBinding)
+	recordBreakGlassBinding(ctx, breakGlassBinding)
+	a.Equal([]int{102}, access.issueUIDs)
+}
@@ -321,6 +321,7 @@ func convertToGrantRequest(ctx context.Context, s *store.Store, v *storepb.Grant
 		User:       common.FormatUserEmail(user.Email),
 		Condition:  v.Condition,
 		Expiration: v.Expiration,
+		BreakGlass: v.BreakGlass,
 	}, nil
 }
 
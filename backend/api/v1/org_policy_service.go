@@ -28,6 +28,7 @@ var (
 		storepb.Policy_MASKING_EXCEPTION: {storepb.Policy_PROJECT},
 		storepb.Policy_IAM:               {storepb.Policy_WORKSPACE},
 		storepb.Policy_DATA_SOURCE_QUERY: {storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
+		storepb.Policy_ROW_ACCESS:        {storepb.Policy_PROJECT},
 	}
 )
 
@@ -223,6 +224,8 @@ func pathMatchType(path string, policyType storepb.Policy_Type) bool {
 		return path == "data_source_query_policy"
 	case storepb.Policy_QUERY_DATA:
 		return path == "query_data_policy"
+	case storepb.Policy_ROW_ACCESS:
+		return path == "row_access_policy"
 	default:
 		return false
 	}
@@ -408,6 +411,14 @@ func (s *OrgPolicyService) checkPolicyFeatureGuard(policyType v1pb.PolicyType) e
 
 func validatePolicyPayload(policyType storepb.Policy_Type, policy *v1pb.Policy) error {
 	switch policyType {
+	case storepb.Policy_ROLLOUT:
+		rolloutPolicy, ok := policy.Policy.(*v1pb.Policy_RolloutPolicy)
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unmatched policy type %v and policy %v", policyType, policy.Policy))
+		}
+		if err := common.ValidateRolloutWindow(convertToStorePBRolloutWindow(rolloutPolicy.RolloutPolicy.GetWindow())); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid rollout window"))
+		}
 	case storepb.Policy_MASKING_RULE:
 		maskingRulePolicy, ok := policy.Policy.(*v1pb.Policy_MaskingRulePolicy)
 		if !ok {
@@ -443,6 +454,28 @@ func validatePolicyPayload(policyType storepb.Policy_Type, policy *v1pb.Policy)
 				return err
 			}
 		}
+	case storepb.Policy_ROW_ACCESS:
+		rowAccessPolicy, ok := policy.Policy.(*v1pb.Policy_RowAccessPolicy)
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unmatched policy type %v and policy %v", policyType, policy.Policy))
+		}
+		if rowAccessPolicy.RowAccessPolicy == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("row access policy must be set"))
+		}
+		for _, rule := range rowAccessPolicy.RowAccessPolicy.Rules {
+			if _, _, err := common.GetInstanceDatabaseID(rule.Database); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid row access rule database %q", rule.Database))
+			}
+			if rule.Table == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.New("row access rule must have table set"))
+			}
+			if strings.TrimSpace(rule.Predicate) == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.New("row access rule must have predicate set"))
+			}
+			if _, err := common.ValidateRowAccessCELExpr(rule.Condition); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid row access rule expression: %v", err))
+			}
+		}
 	default:
 	}
 	return nil
@@ -505,6 +538,19 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", errors.Wrap(err, "failed to marshal masking exception policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_ACCESS:
+		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_DATA_MASKING); err != nil {
+			return "", connect.NewError(connect.CodePermissionDenied, err)
+		}
+		payload, err := s.convertToStorePBRowAccessPolicy(ctx, policy.GetRowAccessPolicy())
+		if err != nil {
+			return "", connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row access policy")
+		}
+		return string(payloadBytes), nil
 	case v1pb.PolicyType_DATA_SOURCE_QUERY:
 		payload := convertToDataSourceQueryPayload(policy.GetDataSourceQueryPolicy())
 		payloadBytes, err := protojson.Marshal(payload)
@@ -575,6 +621,14 @@ func (s *OrgPolicyService) convertToPolicy(ctx context.Context, policyMessage *s
 		policy.Policy = &v1pb.Policy_MaskingExceptionPolicy{
 			MaskingExceptionPolicy: payload,
 		}
+	case storepb.Policy_ROW_ACCESS:
+		rowAccessPolicy := &storepb.RowAccessPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), rowAccessPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row access policy")
+		}
+		policy.Policy = &v1pb.Policy_RowAccessPolicy{
+			RowAccessPolicy: convertToV1PBRowAccessPolicy(rowAccessPolicy),
+		}
 	case storepb.Policy_DATA_SOURCE_QUERY:
 		payload, err := convertToV1PBDataSourceQueryPolicy(policyMessage.Payload)
 		if err != nil {
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"google.golang.org/genproto/googleapis/type/expr"
@@ -9,6 +10,8 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
 )
 
 func convertToV1PBSQLReviewRules(ruleList []*storepb.SQLReviewRule) []*v1pb.SQLReviewRule {
@@ -99,6 +102,7 @@ func convertToStorePBRolloutPolicy(policy *v1pb.RolloutPolicy) *storepb.RolloutP
 		Automatic: policy.Automatic,
 		Roles:     policy.Roles,
 		Checkers:  convertToStorePBCheckers(policy.Checkers),
+		Window:    convertToStorePBRolloutWindow(policy.Window),
 	}
 }
 
@@ -107,9 +111,46 @@ func convertToV1PBRolloutPolicy(policy *storepb.RolloutPolicy) *v1pb.RolloutPoli
 		Automatic: policy.Automatic,
 		Roles:     policy.Roles,
 		Checkers:  convertToV1PBCheckers(policy.Checkers),
+		Window:    convertToV1PBRolloutWindow(policy.Window),
 	}
 }
 
+func convertToV1PBRolloutWindow(window *storepb.RolloutPolicy_Window) *v1pb.RolloutPolicy_Window {
+	if window == nil {
+		return nil
+	}
+	result := &v1pb.RolloutPolicy_Window{
+		TimeZone:      window.TimeZone,
+		BlackoutDates: window.BlackoutDates,
+	}
+	for _, r := range window.TimeRanges {
+		result.TimeRanges = append(result.TimeRanges, &v1pb.RolloutPolicy_Window_TimeRange{
+			DaysOfWeek: r.DaysOfWeek,
+			StartTime:  r.StartTime,
+			EndTime:    r.EndTime,
+		})
+	}
+	return result
+}
+
+func convertToStorePBRolloutWindow(window *v1pb.RolloutPolicy_Window) *storepb.RolloutPolicy_Window {
+	if window == nil {
+		return nil
+	}
+	result := &storepb.RolloutPolicy_Window{
+		TimeZone:      window.TimeZone,
+		BlackoutDates: window.BlackoutDates,
+	}
+	for _, r := range window.TimeRanges {
+		result.TimeRanges = append(result.TimeRanges, &storepb.RolloutPolicy_Window_TimeRange{
+			DaysOfWeek: r.DaysOfWeek,
+			StartTime:  r.StartTime,
+			EndTime:    r.EndTime,
+		})
+	}
+	return result
+}
+
 func convertToV1PBCheckers(checkers *storepb.RolloutPolicy_Checkers) *v1pb.RolloutPolicy_Checkers {
 	if checkers == nil {
 		return nil
@@ -255,6 +296,60 @@ func (s *OrgPolicyService) convertToV1PBMaskingExceptionPolicyPayload(ctx contex
 	}
 }
 
+func (s *OrgPolicyService) convertToStorePBRowAccessPolicy(ctx context.Context, policy *v1pb.RowAccessPolicy) (*storepb.RowAccessPolicy, error) {
+	var rules []*storepb.RowAccessPolicy_Rule
+	for _, rule := range policy.GetRules() {
+		database, err := getDatabaseMessage(ctx, s.store, rule.Database)
+		if err != nil {
+			return nil, err
+		}
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+		}
+		if instance == nil {
+			return nil, errors.Errorf("instance %q not found", database.InstanceID)
+		}
+		engine := instance.Metadata.GetEngine()
+		if !parserbase.IsRowFilterSupported(engine) {
+			return nil, errors.Errorf("row access policy is not supported for engine %s", engine)
+		}
+		// The predicate is injected into the queries, so it must be a single complete expression.
+		asts, err := parserbase.Parse(engine, fmt.Sprintf("SELECT * FROM t WHERE (%s)", rule.Predicate))
+		if err != nil || len(asts) != 1 {
+			return nil, errors.Errorf("invalid predicate %q of table %q", rule.Predicate, rule.Table)
+		}
+		rules = append(rules, &storepb.RowAccessPolicy_Rule{
+			Database:  rule.Database,
+			Schema:    rule.Schema,
+			Table:     rule.Table,
+			Condition: rule.Condition,
+			Predicate: rule.Predicate,
+		})
+	}
+
+	return &storepb.RowAccessPolicy{
+		Rules: rules,
+	}, nil
+}
+
+func convertToV1PBRowAccessPolicy(policy *storepb.RowAccessPolicy) *v1pb.RowAccessPolicy {
+	var rules []*v1pb.RowAccessPolicy_Rule
+	for _, rule := range policy.Rules {
+		rules = append(rules, &v1pb.RowAccessPolicy_Rule{
+			Database:  rule.Database,
+			Schema:    rule.Schema,
+			Table:     rule.Table,
+			Condition: rule.Condition,
+			Predicate: rule.Predicate,
+		})
+	}
+
+	return &v1pb.RowAccessPolicy{
+		Rules: rules,
+	}
+}
+
 func convertToV1PBDataSourceQueryPolicy(payloadStr string) (*v1pb.Policy_DataSourceQueryPolicy, error) {
 	payload := &storepb.DataSourceQueryPolicy{}
 	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(payloadStr), payload); err != nil {
@@ -292,6 +387,8 @@ func convertV1PBToStorePBPolicyType(pType v1pb.PolicyType) (storepb.Policy_Type,
 		return storepb.Policy_QUERY_DATA, nil
 	case v1pb.PolicyType_DATA_SOURCE_QUERY:
 		return storepb.Policy_DATA_SOURCE_QUERY, nil
+	case v1pb.PolicyType_ROW_ACCESS:
+		return storepb.Policy_ROW_ACCESS, nil
 	default:
 	}
 	return storepb.Policy_TYPE_UNSPECIFIED, errors.Errorf("invalid policy type %v", pType)
@@ -311,6 +408,8 @@ func convertStorePBToV1PBPolicyType(pType storepb.Policy_Type) v1pb.PolicyType {
 		return v1pb.PolicyType_DATA_QUERY
 	case storepb.Policy_DATA_SOURCE_QUERY:
 		return v1pb.PolicyType_DATA_SOURCE_QUERY
+	case storepb.Policy_ROW_ACCESS:
+		return v1pb.PolicyType_ROW_ACCESS
 	default:
 	}
 	return v1pb.PolicyType_POLICY_TYPE_UNSPECIFIED
@@ -19,6 +19,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/pgosc"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
@@ -243,6 +244,9 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *connect.Request[v
 	if err := validateSpecs(req.Plan.Specs); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to validate plan specs, error: %v", err))
 	}
+	if err := validateRolloutStrategy(req.Plan.GetDeployment().GetRolloutStrategy()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to validate rollout strategy, error: %v", err))
+	}
 
 	planMessage := &store.PlanMessage{
 		ProjectID:   projectID,
@@ -255,6 +259,7 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *connect.Request[v
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get plan deployment snapshot, error: %v", err))
 	}
+	deployment.RolloutStrategy = convertRolloutStrategy(req.Plan.GetDeployment().GetRolloutStrategy())
 	planMessage.Config.Deployment = deployment
 
 	if _, err := GetPipelineCreate(ctx, s.store, s.sheetManager, s.dbFactory, planMessage.Config.GetSpecs(), deployment, project); err != nil {
@@ -277,7 +282,7 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *connect.Request[v
 	}
 
 	// Tickle plan check scheduler.
-	s.stateCfg.PlanCheckTickleChan <- 0
+	s.stateCfg.TicklePlanCheckScheduler(ctx)
 
 	convertedPlan, err := convertToPlan(ctx, s.store, plan)
 	if err != nil {
@@ -381,10 +386,14 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *connect.Request[v
 			if planUpdate.Specs != nil {
 				specs = *planUpdate.Specs
 			}
+			if err := validateRolloutStrategy(req.Plan.GetDeployment().GetRolloutStrategy()); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to validate rollout strategy, error: %v", err))
+			}
 			deployment, err := getPlanDeployment(ctx, s.store, specs, project)
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get plan deployment snapshot, error: %v", err))
 			}
+			deployment.RolloutStrategy = convertRolloutStrategy(req.Plan.GetDeployment().GetRolloutStrategy())
 			planUpdate.Deployment = &deployment
 		case "specs":
 			// Use specs directly for internal storage
@@ -520,6 +529,11 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *connect.Request[v
 								taskPatch.EnableGhost = &newEnableGhost
 								doUpdate = true
 							}
+							newEnableOnlineSchemaChange := config.ChangeDatabaseConfig.EnableOnlineSchemaChange
+							if newEnableOnlineSchemaChange != task.Payload.GetEnableOnlineSchemaChange() {
+								taskPatch.EnableOnlineSchemaChange = &newEnableOnlineSchemaChange
+								doUpdate = true
+							}
 						}
 					} else if newTaskType != task.Type {
 						// Task type changed - only allow within DATABASE_MIGRATE types or to/from DATABASE_SDL
@@ -530,6 +544,8 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *connect.Request[v
 								if config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig); ok {
 									newEnableGhost := config.ChangeDatabaseConfig.EnableGhost
 									taskPatch.EnableGhost = &newEnableGhost
+									newEnableOnlineSchemaChange := config.ChangeDatabaseConfig.EnableOnlineSchemaChange
+									taskPatch.EnableOnlineSchemaChange = &newEnableOnlineSchemaChange
 									doUpdate = true
 								}
 							}
@@ -538,19 +554,30 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *connect.Request[v
 						}
 					}
 
-					// Flags for gh-ost.
+					// Flags for gh-ost or the PostgreSQL online schema change.
 					if err := func() error {
 						config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig)
 						if !ok {
 							return nil
 						}
-						if config.ChangeDatabaseConfig.Type != v1pb.DatabaseChangeType_MIGRATE || !config.ChangeDatabaseConfig.EnableGhost {
+						if config.ChangeDatabaseConfig.Type != v1pb.DatabaseChangeType_MIGRATE {
 							return nil
 						}
 
-						newFlags := config.ChangeDatabaseConfig.GetGhostFlags()
-						if _, err := ghost.GetUserFlags(newFlags); err != nil {
-							return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid ghost flags %q, error %v", newFlags, err))
+						var newFlags map[string]string
+						switch {
+						case config.ChangeDatabaseConfig.EnableGhost:
+							newFlags = config.ChangeDatabaseConfig.GetGhostFlags()
+							if _, err := ghost.GetUserFlags(newFlags); err != nil {
+								return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid ghost flags %q, error %v", newFlags, err))
+							}
+						case config.ChangeDatabaseConfig.EnableOnlineSchemaChange:
+							newFlags = config.ChangeDatabaseConfig.GetOnlineSchemaChangeFlags()
+							if _, err := pgosc.GetUserFlags(newFlags); err != nil {
+								return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid online schema change flags %q, error %v", newFlags, err))
+							}
+						default:
+							return nil
 						}
 						oldFlags := task.Payload.GetFlags()
 						if maps.Equal(oldFlags, newFlags) {
@@ -569,8 +596,8 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *connect.Request[v
 						if !ok {
 							return
 						}
-						// Prior backup is allowed for non-ghost migrations
-						if config.ChangeDatabaseConfig.Type != v1pb.DatabaseChangeType_MIGRATE || config.ChangeDatabaseConfig.EnableGhost {
+						// Prior backup is allowed for non-ghost and non-online schema change migrations
+						if config.ChangeDatabaseConfig.Type != v1pb.DatabaseChangeType_MIGRATE || config.ChangeDatabaseConfig.EnableGhost || config.ChangeDatabaseConfig.EnableOnlineSchemaChange {
 							return
 						}
 
@@ -741,7 +768,7 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *connect.Request[v
 					if err != nil {
 						return errors.Errorf("failed to update issue: %v", err)
 					}
-					s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+					s.stateCfg.FindApprovalTemplate(ctx, issue)
 					return nil
 				}(); err != nil {
 					slog.Error("failed to update issue to refind approval", log.BBError(err))
@@ -1033,7 +1060,7 @@ func (s *PlanService) RunPlanChecks(ctx context.Context, request *connect.Reques
 	}
 
 	// Tickle plan check scheduler.
-	s.stateCfg.PlanCheckTickleChan <- 0
+	s.stateCfg.TicklePlanCheckScheduler(ctx)
 
 	return connect.NewResponse(&v1pb.RunPlanChecksResponse{}), nil
 }
@@ -1092,8 +1119,8 @@ func (s *PlanService) BatchCancelPlanCheckRuns(ctx context.Context, request *con
 	}
 	// Cancel the plan check runs.
 	for _, planCheckRun := range planCheckRuns {
-		if cancelFunc, ok := s.stateCfg.RunningPlanCheckRunsCancelFunc.Load(planCheckRun.UID); ok {
-			cancelFunc.(context.CancelFunc)()
+		if err := s.stateCfg.CancelPlanCheckRun(ctx, planCheckRun.UID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to cancel plan check run %v, error: %v", planCheckRun.UID, err))
 		}
 	}
 	// Update the status of the plan check runs to canceled.
@@ -1177,6 +1204,9 @@ func validateSpecs(specs []*v1pb.Plan_Spec) error {
 			if databaseTarget > 0 && databaseGroupTarget > 0 {
 				return errors.Errorf("found databaseTarget and databaseGroupTarget, expect only one kind")
 			}
+			if config.ChangeDatabaseConfig.EnableGhost && config.ChangeDatabaseConfig.EnableOnlineSchemaChange {
+				return errors.Errorf("enable_ghost and enable_online_schema_change cannot be both enabled")
+			}
 			// Track if this spec uses release or sheet.
 			if config.ChangeDatabaseConfig.Release != "" {
 				releaseCount++
@@ -1232,6 +1262,22 @@ func getAllEnvironmentIDs(ctx context.Context, s *store.Store) ([]string, error)
 	return environmentIDs, nil
 }
 
+func validateRolloutStrategy(strategy *v1pb.Plan_Deployment_RolloutStrategy) error {
+	if strategy == nil {
+		return nil
+	}
+	if strategy.CanaryCount < 0 {
+		return errors.Errorf("canary count must not be negative, but got %d", strategy.CanaryCount)
+	}
+	if strategy.BatchPercentage < 0 || strategy.BatchPercentage > 100 {
+		return errors.Errorf("batch percentage must be between 0 and 100, but got %d", strategy.BatchPercentage)
+	}
+	if strategy.FailureThreshold < 0 {
+		return errors.Errorf("failure threshold must not be negative, but got %d", strategy.FailureThreshold)
+	}
+	return nil
+}
+
 func getPlanDeployment(ctx context.Context, s *store.Store, specs []*storepb.PlanConfig_Spec, project *store.ProjectMessage) (*storepb.PlanConfig_Deployment, error) {
 	snapshot := &storepb.PlanConfig_Deployment{}
 
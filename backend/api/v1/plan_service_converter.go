@@ -113,13 +113,15 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 	c := config.ChangeDatabaseConfig
 	return &v1pb.Plan_Spec_ChangeDatabaseConfig{
 		ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
-			Targets:           c.Targets,
-			Sheet:             c.Sheet,
-			Release:           c.Release,
-			Type:              convertToPlanSpecChangeDatabaseConfigType(c.Type),
-			GhostFlags:        c.GhostFlags,
-			EnablePriorBackup: c.EnablePriorBackup,
-			EnableGhost:       c.EnableGhost,
+			Targets:                  c.Targets,
+			Sheet:                    c.Sheet,
+			Release:                  c.Release,
+			Type:                     convertToPlanSpecChangeDatabaseConfigType(c.Type),
+			GhostFlags:               c.GhostFlags,
+			EnablePriorBackup:        c.EnablePriorBackup,
+			EnableGhost:              c.EnableGhost,
+			OnlineSchemaChangeFlags:  c.OnlineSchemaChangeFlags,
+			EnableOnlineSchemaChange: c.EnableOnlineSchemaChange,
 		},
 	}
 }
@@ -156,6 +158,29 @@ func convertToPlanDeployment(deployment *storepb.PlanConfig_Deployment) *v1pb.Pl
 	return &v1pb.Plan_Deployment{
 		Environments:          deployment.Environments,
 		DatabaseGroupMappings: convertToDatabaseGroupMappings(deployment.DatabaseGroupMappings),
+		RolloutStrategy:       convertToRolloutStrategy(deployment.RolloutStrategy),
+	}
+}
+
+func convertToRolloutStrategy(strategy *storepb.PlanConfig_Deployment_RolloutStrategy) *v1pb.Plan_Deployment_RolloutStrategy {
+	if strategy == nil {
+		return nil
+	}
+	return &v1pb.Plan_Deployment_RolloutStrategy{
+		CanaryCount:      strategy.CanaryCount,
+		BatchPercentage:  strategy.BatchPercentage,
+		FailureThreshold: strategy.FailureThreshold,
+	}
+}
+
+func convertRolloutStrategy(strategy *v1pb.Plan_Deployment_RolloutStrategy) *storepb.PlanConfig_Deployment_RolloutStrategy {
+	if strategy == nil {
+		return nil
+	}
+	return &storepb.PlanConfig_Deployment_RolloutStrategy{
+		CanaryCount:      strategy.CanaryCount,
+		BatchPercentage:  strategy.BatchPercentage,
+		FailureThreshold: strategy.FailureThreshold,
 	}
 }
 
@@ -250,13 +275,15 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 
 	return &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
 		ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
-			Targets:           c.Targets,
-			Sheet:             c.Sheet,
-			Release:           c.Release,
-			Type:              storeType,
-			GhostFlags:        c.GhostFlags,
-			EnablePriorBackup: c.EnablePriorBackup,
-			EnableGhost:       c.EnableGhost,
+			Targets:                  c.Targets,
+			Sheet:                    c.Sheet,
+			Release:                  c.Release,
+			Type:                     storeType,
+			GhostFlags:               c.GhostFlags,
+			EnablePriorBackup:        c.EnablePriorBackup,
+			EnableGhost:              c.EnableGhost,
+			OnlineSchemaChangeFlags:  c.OnlineSchemaChangeFlags,
+			EnableOnlineSchemaChange: c.EnableOnlineSchemaChange,
 		},
 	}
 }
@@ -323,6 +350,12 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabaseOnlineSchemaChange:
+		return v1pb.PlanCheckRun_DATABASE_ONLINE_SCHEMA_CHANGE
+	case store.PlanCheckDatabaseStatementLockImpact:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_LOCK_IMPACT
+	case store.PlanCheckDatabaseStatementExplain:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_EXPLAIN
 	default:
 		return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
 	}
@@ -366,6 +399,7 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				StatementTypes:   report.SqlSummaryReport.StatementTypes,
 				AffectedRows:     report.SqlSummaryReport.AffectedRows,
 				ChangedResources: convertToChangedResources(report.SqlSummaryReport.ChangedResources),
+				EstimatedCost:    report.SqlSummaryReport.EstimatedCost,
 			},
 		}
 	case *storepb.PlanCheckRunResult_Result_SqlReviewReport_:
@@ -375,10 +409,58 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				EndPosition:   convertToPosition(report.SqlReviewReport.EndPosition),
 			},
 		}
+	case *storepb.PlanCheckRunResult_Result_LockImpactReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_LockImpactReport_{
+			LockImpactReport: &v1pb.PlanCheckRun_Result_LockImpactReport{
+				Schema:                   report.LockImpactReport.Schema,
+				Table:                    report.LockImpactReport.Table,
+				LockLevel:                v1pb.PlanCheckRun_Result_LockImpactReport_LockLevel(report.LockImpactReport.LockLevel),
+				Algorithm:                v1pb.PlanCheckRun_Result_LockImpactReport_Algorithm(report.LockImpactReport.Algorithm),
+				TableRewrite:             report.LockImpactReport.TableRewrite,
+				TableScan:                report.LockImpactReport.TableScan,
+				TableRows:                report.LockImpactReport.TableRows,
+				TableSize:                report.LockImpactReport.TableSize,
+				EstimatedBlockingSeconds: report.LockImpactReport.EstimatedBlockingSeconds,
+				StartPosition:            convertToPosition(report.LockImpactReport.StartPosition),
+			},
+		}
+	case *storepb.PlanCheckRunResult_Result_ExplainReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_ExplainReport_{
+			ExplainReport: &v1pb.PlanCheckRun_Result_ExplainReport{
+				Statement:          report.ExplainReport.Statement,
+				Plan:               convertToExplainPlanNode(report.ExplainReport.Plan),
+				TotalCost:          report.ExplainReport.TotalCost,
+				FullScanTables:     report.ExplainReport.FullScanTables,
+				MissingIndexTables: report.ExplainReport.MissingIndexTables,
+				PreviousTarget:     report.ExplainReport.PreviousTarget,
+				PreviousTotalCost:  report.ExplainReport.PreviousTotalCost,
+				RegressedTables:    report.ExplainReport.RegressedTables,
+				StartPosition:      convertToPosition(report.ExplainReport.StartPosition),
+			},
+		}
 	}
 	return resultV1
 }
 
+func convertToExplainPlanNode(node *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) *v1pb.PlanCheckRun_Result_ExplainReport_PlanNode {
+	if node == nil {
+		return nil
+	}
+	v1Node := &v1pb.PlanCheckRun_Result_ExplainReport_PlanNode{
+		Operation: node.Operation,
+		Table:     node.Table,
+		Index:     node.Index,
+		Cost:      node.Cost,
+		Rows:      node.Rows,
+		FullScan:  node.FullScan,
+		HasFilter: node.HasFilter,
+	}
+	for _, child := range node.Children {
+		v1Node.Children = append(v1Node.Children, convertToExplainPlanNode(child))
+	}
+	return v1Node
+}
+
 func convertToPlanCheckRunResultStatus(status storepb.Advice_Status) v1pb.Advice_Level {
 	switch status {
 	case storepb.Advice_STATUS_UNSPECIFIED:
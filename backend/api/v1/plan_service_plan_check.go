@@ -93,6 +93,9 @@ func getPlanCheckRunsFromChangeDatabaseConfigDatabaseGroupTarget(ctx context.Con
 		if config.EnableGhost {
 			return nil, errors.Errorf("ghost migration is not supported for database group target")
 		}
+		if config.EnableOnlineSchemaChange {
+			return nil, errors.Errorf("online schema change is not supported for database group target")
+		}
 	default:
 		return nil, errors.Errorf("unsupported change database config type %q for database group target", config.Type)
 	}
@@ -230,6 +233,35 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			EnableSdl:    enableSDL,
 		},
 	})
+	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && common.EngineSupportLockImpact(instance.Metadata.GetEngine()) {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			PlanUID: plan.UID,
+			Status:  store.PlanCheckRunStatusRunning,
+			Type:    store.PlanCheckDatabaseStatementLockImpact,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:     int32(sheetUID),
+				InstanceId:   instance.ResourceID,
+				DatabaseName: database.DatabaseName,
+				EnableGhost:  config.EnableGhost,
+				EnableSdl:    enableSDL,
+			},
+		})
+	}
+	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && common.EngineSupportExplain(instance.Metadata.GetEngine()) {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			PlanUID: plan.UID,
+			Status:  store.PlanCheckRunStatusRunning,
+			Type:    store.PlanCheckDatabaseStatementExplain,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:     int32(sheetUID),
+				InstanceId:   instance.ResourceID,
+				DatabaseName: database.DatabaseName,
+				EnableGhost:  config.EnableGhost,
+				EnableSdl:    enableSDL,
+				PlanUid:      plan.UID,
+			},
+		})
+	}
 	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && config.EnableGhost {
 		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
 			PlanUID: plan.UID,
@@ -245,6 +277,21 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			},
 		})
 	}
+	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && config.EnableOnlineSchemaChange {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			PlanUID: plan.UID,
+			Status:  store.PlanCheckRunStatusRunning,
+			Type:    store.PlanCheckDatabaseOnlineSchemaChange,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:                 int32(sheetUID),
+				InstanceId:               instance.ResourceID,
+				DatabaseName:             database.DatabaseName,
+				EnableSdl:                enableSDL,
+				EnableOnlineSchemaChange: config.EnableOnlineSchemaChange,
+				OnlineSchemaChangeFlags:  config.OnlineSchemaChangeFlags,
+			},
+		})
+	}
 
 	return planCheckRuns, nil
 }
@@ -911,6 +911,8 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, req *connect.Request
 			updatedPayload.Activities = types
 		case "direct_message":
 			updatedPayload.DirectMessage = req.Msg.Webhook.DirectMessage
+		case "signing_secret":
+			updatedPayload.SigningSecret = req.Msg.Webhook.SigningSecret
 		default:
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid field %q", path))
 		}
@@ -981,6 +983,63 @@ func (s *ProjectService) RemoveWebhook(ctx context.Context, req *connect.Request
 	return connect.NewResponse(convertToProject(project)), nil
 }
 
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListWebhookDeliveriesResponse], error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(req.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid webhook id %q", webhookID))
+	}
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &projectID,
+		ID:        &webhookIDInt,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if webhook == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook %q not found", req.Msg.Parent))
+	}
+
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   req.Msg.PageToken,
+		limit:   int(req.Msg.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		WebhookID: &webhook.ID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset.offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list webhook deliveries"))
+	}
+
+	var nextPageToken string
+	if len(deliveries) == limitPlusOne {
+		if nextPageToken, err = offset.getNextPageToken(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		deliveries = deliveries[:offset.limit]
+	}
+
+	resp := &v1pb.ListWebhookDeliveriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	webhookName := fmt.Sprintf("%s/%s%d", common.FormatProject(projectID), common.WebhookIDPrefix, webhook.ID)
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, convertToV1WebhookDelivery(webhookName, delivery))
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // TestWebhook tests a webhook.
 func (s *ProjectService) TestWebhook(ctx context.Context, req *connect.Request[v1pb.TestWebhookRequest]) (*connect.Response[v1pb.TestWebhookResponse], error) {
 	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
@@ -1024,17 +1083,18 @@ func (s *ProjectService) TestWebhook(ctx context.Context, req *connect.Request[v
 	err = webhookplugin.Post(
 		webhook.Payload.GetType(),
 		webhookplugin.Context{
-			URL:         webhook.Payload.GetUrl(),
-			Level:       webhookplugin.WebhookInfo,
-			EventType:   storepb.Activity_ISSUE_CREATE.String(),
-			Title:       fmt.Sprintf("Test webhook %q", webhook.Payload.GetTitle()),
-			TitleZh:     fmt.Sprintf("测试 webhook %q", webhook.Payload.GetTitle()),
-			Description: "This is a test",
-			Link:        fmt.Sprintf("%s/projects/%s/webhooks/%s", externalURL, project.ResourceID, fmt.Sprintf("%s-%d", slug.Make(webhook.Payload.GetTitle()), webhook.ID)),
-			ActorID:     common.SystemBotID,
-			ActorName:   "Bytebase",
-			ActorEmail:  s.store.GetSystemBotUser(ctx).Email,
-			CreatedTS:   time.Now().Unix(),
+			URL:           webhook.Payload.GetUrl(),
+			SigningSecret: webhook.Payload.GetSigningSecret(),
+			Level:         webhookplugin.WebhookInfo,
+			EventType:     storepb.Activity_ISSUE_CREATE.String(),
+			Title:         fmt.Sprintf("Test webhook %q", webhook.Payload.GetTitle()),
+			TitleZh:       fmt.Sprintf("测试 webhook %q", webhook.Payload.GetTitle()),
+			Description:   "This is a test",
+			Link:          fmt.Sprintf("%s/projects/%s/webhooks/%s", externalURL, project.ResourceID, fmt.Sprintf("%s-%d", slug.Make(webhook.Payload.GetTitle()), webhook.ID)),
+			ActorID:       common.SystemBotID,
+			ActorName:     "Bytebase",
+			ActorEmail:    s.store.GetSystemBotUser(ctx).Email,
+			CreatedTS:     time.Now().Unix(),
 			Issue: &webhookplugin.Issue{
 				ID:          1,
 				Name:        "Test issue",
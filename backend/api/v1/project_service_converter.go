@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -51,6 +52,7 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 			Url:           webhook.Url,
 			Activities:    activityTypes,
 			DirectMessage: webhook.DirectMessage,
+			SigningSecret: webhook.SigningSecret,
 		},
 	}, nil
 }
@@ -79,6 +81,12 @@ func convertToStoreActivityTypes(types []v1pb.Activity_Type) ([]storepb.Activity
 			result = append(result, storepb.Activity_NOTIFY_ISSUE_APPROVED)
 		case v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, storepb.Activity_NOTIFY_PIPELINE_ROLLOUT)
+		case v1pb.Activity_NOTIFY_ROLLOUT_WINDOW_OPEN:
+			result = append(result, storepb.Activity_NOTIFY_ROLLOUT_WINDOW_OPEN)
+		case v1pb.Activity_NOTIFY_BREAK_GLASS:
+			result = append(result, storepb.Activity_NOTIFY_BREAK_GLASS)
+		case v1pb.Activity_NOTIFY_SCHEMA_DRIFT:
+			result = append(result, storepb.Activity_NOTIFY_SCHEMA_DRIFT)
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -89,32 +97,42 @@ func convertToStoreActivityTypes(types []v1pb.Activity_Type) ([]storepb.Activity
 func convertToV1ActivityTypes(types []storepb.Activity_Type) []v1pb.Activity_Type {
 	var result []v1pb.Activity_Type
 	for _, tp := range types {
-		switch tp {
-		case storepb.Activity_ISSUE_CREATE:
-			result = append(result, v1pb.Activity_ISSUE_CREATE)
-		case storepb.Activity_ISSUE_COMMENT_CREATE:
-			result = append(result, v1pb.Activity_ISSUE_COMMENT_CREATE)
-		case storepb.Activity_ISSUE_FIELD_UPDATE:
-			result = append(result, v1pb.Activity_ISSUE_FIELD_UPDATE)
-		case storepb.Activity_ISSUE_STATUS_UPDATE:
-			result = append(result, v1pb.Activity_ISSUE_STATUS_UPDATE)
-		case storepb.Activity_ISSUE_APPROVAL_NOTIFY:
-			result = append(result, v1pb.Activity_ISSUE_APPROVAL_NOTIFY)
-		case storepb.Activity_ISSUE_PIPELINE_STAGE_STATUS_UPDATE:
-			result = append(result, v1pb.Activity_ISSUE_PIPELINE_STAGE_STATUS_UPDATE)
-		case storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE:
-			result = append(result, v1pb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE)
-		case storepb.Activity_NOTIFY_ISSUE_APPROVED:
-			result = append(result, v1pb.Activity_NOTIFY_ISSUE_APPROVED)
-		case storepb.Activity_NOTIFY_PIPELINE_ROLLOUT:
-			result = append(result, v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT)
-		default:
-			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
-		}
+		result = append(result, convertToV1ActivityType(tp))
 	}
 	return result
 }
 
+func convertToV1ActivityType(tp storepb.Activity_Type) v1pb.Activity_Type {
+	switch tp {
+	case storepb.Activity_ISSUE_CREATE:
+		return v1pb.Activity_ISSUE_CREATE
+	case storepb.Activity_ISSUE_COMMENT_CREATE:
+		return v1pb.Activity_ISSUE_COMMENT_CREATE
+	case storepb.Activity_ISSUE_FIELD_UPDATE:
+		return v1pb.Activity_ISSUE_FIELD_UPDATE
+	case storepb.Activity_ISSUE_STATUS_UPDATE:
+		return v1pb.Activity_ISSUE_STATUS_UPDATE
+	case storepb.Activity_ISSUE_APPROVAL_NOTIFY:
+		return v1pb.Activity_ISSUE_APPROVAL_NOTIFY
+	case storepb.Activity_ISSUE_PIPELINE_STAGE_STATUS_UPDATE:
+		return v1pb.Activity_ISSUE_PIPELINE_STAGE_STATUS_UPDATE
+	case storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE:
+		return v1pb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE
+	case storepb.Activity_NOTIFY_ISSUE_APPROVED:
+		return v1pb.Activity_NOTIFY_ISSUE_APPROVED
+	case storepb.Activity_NOTIFY_PIPELINE_ROLLOUT:
+		return v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT
+	case storepb.Activity_NOTIFY_ROLLOUT_WINDOW_OPEN:
+		return v1pb.Activity_NOTIFY_ROLLOUT_WINDOW_OPEN
+	case storepb.Activity_NOTIFY_BREAK_GLASS:
+		return v1pb.Activity_NOTIFY_BREAK_GLASS
+	case storepb.Activity_NOTIFY_SCHEMA_DRIFT:
+		return v1pb.Activity_NOTIFY_SCHEMA_DRIFT
+	default:
+		return v1pb.Activity_TYPE_UNSPECIFIED
+	}
+}
+
 func convertToStoreWebhookType(tp v1pb.Webhook_Type) (storepb.ProjectWebhook_Type, error) {
 	switch tp {
 	case v1pb.Webhook_TYPE_UNSPECIFIED:
@@ -133,6 +151,8 @@ func convertToStoreWebhookType(tp v1pb.Webhook_Type) (storepb.ProjectWebhook_Typ
 		return storepb.ProjectWebhook_WECOM, nil
 	case v1pb.Webhook_LARK:
 		return storepb.ProjectWebhook_LARK, nil
+	case v1pb.Webhook_GENERIC:
+		return storepb.ProjectWebhook_GENERIC, nil
 	default:
 		return storepb.ProjectWebhook_TYPE_UNSPECIFIED, common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -154,6 +174,8 @@ func convertToV1WebhookType(tp storepb.ProjectWebhook_Type) v1pb.Webhook_Type {
 		return v1pb.Webhook_WECOM
 	case storepb.ProjectWebhook_LARK:
 		return v1pb.Webhook_LARK
+	case storepb.ProjectWebhook_GENERIC:
+		return v1pb.Webhook_GENERIC
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
@@ -377,3 +399,34 @@ func convertToProjectMessage(resourceID string, project *v1pb.Project) *store.Pr
 		Setting:    setting,
 	}
 }
+
+func convertToV1WebhookDelivery(webhookName string, delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	v1Delivery := &v1pb.WebhookDelivery{
+		Name:           fmt.Sprintf("%s/%s%d", webhookName, common.WebhookDeliveryPrefix, delivery.ID),
+		Status:         convertToV1WebhookDeliveryStatus(delivery.Status),
+		EventType:      convertToV1ActivityType(delivery.Payload.GetEventType()),
+		Body:           delivery.Payload.GetBody(),
+		Attempts:       int32(delivery.Attempts),
+		LastStatusCode: delivery.Payload.GetLastStatusCode(),
+		LastError:      delivery.Payload.GetLastError(),
+		CreateTime:     timestamppb.New(delivery.CreatedAt),
+		UpdateTime:     timestamppb.New(delivery.UpdatedAt),
+	}
+	if delivery.Status == store.WebhookDeliveryStatusPending {
+		v1Delivery.NextAttemptTime = timestamppb.New(delivery.NextAttemptAt)
+	}
+	return v1Delivery
+}
+
+func convertToV1WebhookDeliveryStatus(status store.WebhookDeliveryStatus) v1pb.WebhookDelivery_Status {
+	switch status {
+	case store.WebhookDeliveryStatusPending:
+		return v1pb.WebhookDelivery_PENDING
+	case store.WebhookDeliveryStatusSucceeded:
+		return v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryStatusFailed:
+		return v1pb.WebhookDelivery_FAILED
+	default:
+		return v1pb.WebhookDelivery_STATUS_UNSPECIFIED
+	}
+}
@@ -27,26 +27,11 @@ func NewQueryResultMasker(store *store.Store) *QueryResultMasker {
 
 // MaskResults masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*parserbase.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, user *store.UserMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
-	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
-	}
-
-	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
-	}
-
-	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	m, err := s.getMaskingLevelEvaluator(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
+		return err
 	}
 
-	m := newEmptyMaskingLevelEvaluator().
-		withMaskingRulePolicy(maskingRulePolicy).
-		withDataClassificationSetting(classificationSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
 	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
 	loopBoundary := min(len(spans), len(results))
 	for i := 0; i < loopBoundary; i++ {
@@ -73,6 +58,48 @@ func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*parserbase
 	return nil
 }
 
+// GetMaskers returns the maskers of the result columns and the masking reasons for the query span.
+// It is used to mask the rows of a streamed result chunk by chunk.
+func (s *QueryResultMasker) GetMaskers(ctx context.Context, span *parserbase.QuerySpan, instance *store.InstanceMessage, user *store.UserMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, []*v1pb.MaskingReason, error) {
+	if span.FunctionNotSupportedError != nil {
+		return nil, nil, errors.Errorf("masking error: %v", span.FunctionNotSupportedError)
+	}
+	if span.NotFoundError != nil {
+		return nil, nil, errors.Errorf("masking error: %v", span.NotFoundError)
+	}
+	m, err := s.getMaskingLevelEvaluator(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	maskers, reasons, err := s.getMaskersForQuerySpan(ctx, m, instance, user, span, action)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get maskers for query span")
+	}
+	return maskers, reasons, nil
+}
+
+func (s *QueryResultMasker) getMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
+	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+
+	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
+		withMaskingRulePolicy(maskingRulePolicy).
+		withDataClassificationSetting(classificationSetting).
+		withSemanticTypeSetting(semanticTypesSetting), nil
+}
+
 func getAlgorithmName(m masker.Masker) string {
 	switch m.(type) {
 	case *masker.NoneMasker:
@@ -8,8 +8,8 @@ import (
 
 	"github.com/pkg/errors"
 
-	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/ai"
 )
 
 // aiLintResult represents a single lint result from AI.
@@ -52,57 +52,26 @@ func (s *ReleaseService) runAIPoweredLintBatch(ctx context.Context, files []file
 	prompt := buildBatchLintPrompt(files, customRules)
 	slog.Debug("AI batch prompt built", "promptLength", len(prompt))
 
-	// Call AI using the existing AICompletion infrastructure
-	request := &v1pb.AICompletionRequest{
-		Messages: []*v1pb.AICompletionRequest_Message{
-			{
-				Role:    "user",
-				Content: prompt,
-			},
+	provider, err := ai.NewProvider(aiSetting)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Calling AI for batch schema linting", "endpoint", aiSetting.Endpoint, "filesCount", len(files))
+	texts, err := provider.Complete(ctx, []ai.Message{
+		{
+			Role:    "user",
+			Content: prompt,
 		},
+	})
+	if err != nil {
+		slog.Error("AI call failed", "provider", aiSetting.Provider, "error", err)
+		return nil, errors.Wrap(err, "AI API call failed")
 	}
-
+	slog.Info("AI call successful", "candidatesCount", len(texts))
 	var responseText string
-	switch aiSetting.Provider {
-	case storepb.AISetting_OPEN_AI, storepb.AISetting_AZURE_OPENAI:
-		slog.Info("Calling OpenAI for batch schema linting", "endpoint", aiSetting.Endpoint, "filesCount", len(files))
-		resp, err := callOpenAI(ctx, aiSetting, request)
-		if err != nil {
-			slog.Error("OpenAI call failed", "error", err)
-			return nil, errors.Wrap(err, "OpenAI API call failed")
-		}
-		slog.Info("OpenAI call successful", "candidatesCount", len(resp.Msg.Candidates))
-		if len(resp.Msg.Candidates) > 0 && len(resp.Msg.Candidates[0].Content.Parts) > 0 {
-			responseText = resp.Msg.Candidates[0].Content.Parts[0].Text
-			slog.Debug("OpenAI response received", "responseLength", len(responseText))
-		}
-	case storepb.AISetting_GEMINI:
-		slog.Info("Calling Gemini for batch schema linting", "endpoint", aiSetting.Endpoint, "filesCount", len(files))
-		resp, err := callGemini(ctx, aiSetting, request)
-		if err != nil {
-			slog.Error("Gemini call failed", "error", err)
-			return nil, errors.Wrap(err, "Gemini API call failed")
-		}
-		slog.Info("Gemini call successful", "candidatesCount", len(resp.Msg.Candidates))
-		if len(resp.Msg.Candidates) > 0 && len(resp.Msg.Candidates[0].Content.Parts) > 0 {
-			responseText = resp.Msg.Candidates[0].Content.Parts[0].Text
-			slog.Debug("Gemini response received", "responseLength", len(responseText))
-		}
-	case storepb.AISetting_CLAUDE:
-		slog.Info("Calling Claude for batch schema linting", "endpoint", aiSetting.Endpoint, "filesCount", len(files))
-		resp, err := callClaude(ctx, aiSetting, request)
-		if err != nil {
-			slog.Error("Claude call failed", "error", err)
-			return nil, errors.Wrap(err, "Claude API call failed")
-		}
-		slog.Info("Claude call successful", "candidatesCount", len(resp.Msg.Candidates))
-		if len(resp.Msg.Candidates) > 0 && len(resp.Msg.Candidates[0].Content.Parts) > 0 {
-			responseText = resp.Msg.Candidates[0].Content.Parts[0].Text
-			slog.Debug("Claude response received", "responseLength", len(responseText))
-		}
-	default:
-		slog.Error("Unsupported AI provider", "provider", aiSetting.Provider)
-		return nil, errors.Errorf("unsupported AI provider %s", aiSetting.Provider)
+	if len(texts) > 0 {
+		responseText = texts[0]
+		slog.Debug("AI response received", "responseLength", len(responseText))
 	}
 
 	if responseText == "" {
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -119,13 +120,13 @@ func (s *ReleaseService) CheckRelease(ctx context.Context, req *connect.Request[
 	var response *v1pb.CheckReleaseResponse
 	switch releaseFileType {
 	case v1pb.Release_File_DECLARATIVE:
-		resp, err := s.checkReleaseDeclarative(ctx, sanitizedFiles, targetDatabases, request.CustomRules)
+		resp, err := s.checkReleaseDeclarative(ctx, sanitizedFiles, targetDatabases, request.CustomRules, request.CheckFormat)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check release declarative"))
 		}
 		response = resp
 	case v1pb.Release_File_VERSIONED:
-		resp, err := s.checkReleaseVersioned(ctx, sanitizedFiles, targetDatabases, request.CustomRules)
+		resp, err := s.checkReleaseVersioned(ctx, sanitizedFiles, targetDatabases, request.CustomRules, request.CheckFormat)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check release versioned"))
 		}
@@ -137,7 +138,7 @@ func (s *ReleaseService) CheckRelease(ctx context.Context, req *connect.Request[
 	return connect.NewResponse(response), nil
 }
 
-func (s *ReleaseService) checkReleaseVersioned(ctx context.Context, files []*v1pb.Release_File, databases []*store.DatabaseMessage, customRules string) (*v1pb.CheckReleaseResponse, error) {
+func (s *ReleaseService) checkReleaseVersioned(ctx context.Context, files []*v1pb.Release_File, databases []*store.DatabaseMessage, customRules string, checkFormat bool) (*v1pb.CheckReleaseResponse, error) {
 	resp := &v1pb.CheckReleaseResponse{}
 	var errorAdviceCount, warningAdviceCount int
 
@@ -265,6 +266,11 @@ loop:
 						return checkResult, nil
 					}
 				}
+				if checkFormat {
+					if advice := checkStatementFormat(engine, statement); advice != nil {
+						checkResult.Advices = append(checkResult.Advices, advice)
+					}
+				}
 
 				// Get SQL summary report for the statement and target database.
 				// Including affected rows.
@@ -342,7 +348,7 @@ loop:
 	return resp, nil
 }
 
-func (s *ReleaseService) checkReleaseDeclarative(ctx context.Context, files []*v1pb.Release_File, databases []*store.DatabaseMessage, customRules string) (*v1pb.CheckReleaseResponse, error) {
+func (s *ReleaseService) checkReleaseDeclarative(ctx context.Context, files []*v1pb.Release_File, databases []*store.DatabaseMessage, customRules string, checkFormat bool) (*v1pb.CheckReleaseResponse, error) {
 	var results []*v1pb.CheckReleaseResponse_CheckResult
 	var errorAdviceCount, warningAdviceCount int
 	for _, database := range databases {
@@ -574,6 +580,12 @@ func (s *ReleaseService) checkReleaseDeclarative(ctx context.Context, files []*v
 							}
 						}
 					}
+
+					if checkFormat {
+						if advice := checkStatementFormat(engine, statement); advice != nil {
+							checkResult.Advices = append(checkResult.Advices, advice)
+						}
+					}
 				}
 			}
 
@@ -774,3 +786,36 @@ func getStatementTypesWithPositionsForEngine(engine storepb.Engine, asts []base.
 		return []statementTypeWithPosition{}, nil
 	}
 }
+
+// checkStatementFormat returns a warning advice if the statement is not formatted by the SQL formatter.
+// It returns nil if the engine doesn't support formatting or the statement cannot be formatted.
+func checkStatementFormat(engine storepb.Engine, statement string) *v1pb.Advice {
+	if !base.IsFormatSupported(engine) {
+		return nil
+	}
+	formatted, err := base.Format(engine, statement, base.FormatOptions{})
+	if err != nil {
+		slog.Debug("Failed to format statement", slog.String("engine", engine.String()), log.BBError(err))
+		return nil
+	}
+	// Ignore the difference of the trailing line breaks.
+	if strings.TrimRight(formatted, "\n") == strings.TrimRight(statement, "\n") {
+		return nil
+	}
+	advice := &v1pb.Advice{
+		Status:   v1pb.Advice_WARNING,
+		Code:     code.StatementNotFormatted.Int32(),
+		Title:    "SQL is not formatted",
+		Content:  "The SQL is not formatted. Format it with the SQL formatter, e.g. the Format action in the SQL Editor.",
+		RuleType: v1pb.Advice_PARSER_BASED,
+	}
+	formattedLines, lines := strings.Split(formatted, "\n"), strings.Split(statement, "\n")
+	for i := range lines {
+		if i >= len(formattedLines) || lines[i] != formattedLines[i] {
+			advice.StartPosition = &v1pb.Position{Line: int32(i + 1)}
+			advice.Content = fmt.Sprintf("The SQL is not formatted, starting from line %d. Format it with the SQL formatter, e.g. the Format action in the SQL Editor.", i+1)
+			break
+		}
+	}
+	return advice
+}
@@ -275,7 +275,7 @@ func (s *RolloutService) CreateRollout(ctx context.Context, req *connect.Request
 	}
 
 	// Tickle task run scheduler.
-	s.stateCfg.TaskRunTickleChan <- 0
+	s.stateCfg.TickleTaskRunScheduler(ctx)
 
 	return connect.NewResponse(rolloutV1), nil
 }
@@ -657,7 +657,7 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, req *connect.Request
 		},
 	})
 	// Tickle task run scheduler.
-	s.stateCfg.TaskRunTickleChan <- 0
+	s.stateCfg.TickleTaskRunScheduler(ctx)
 
 	return connect.NewResponse(&v1pb.BatchRunTasksResponse{}), nil
 }
@@ -744,7 +744,7 @@ func (s *RolloutService) BatchSkipTasks(ctx context.Context, req *connect.Reques
 	}
 
 	for _, task := range tasksToSkip {
-		s.stateCfg.TaskSkippedOrDoneChan <- task.ID
+		s.stateCfg.NotifyTaskSkippedOrDone(ctx, task.ID)
 	}
 
 	if issueN != nil {
@@ -862,8 +862,8 @@ func (s *RolloutService) BatchCancelTaskRuns(ctx context.Context, req *connect.R
 
 	for _, taskRun := range taskRuns {
 		if taskRun.Status == storepb.TaskRun_RUNNING {
-			if cancelFunc, ok := s.stateCfg.RunningTaskRunsCancelFunc.Load(taskRun.ID); ok {
-				cancelFunc.(context.CancelFunc)()
+			if err := s.stateCfg.CancelTaskRun(ctx, taskRun.ID); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to cancel task run %v, error: %v", taskRun.ID, err))
 			}
 		}
 	}
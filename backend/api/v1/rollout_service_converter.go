@@ -3,16 +3,20 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/state"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
 )
 
 // formatEnvironmentFromStageID converts stage ID back to environment, handling the EmptyStageID placeholder.
@@ -66,14 +70,20 @@ func convertToTaskRun(ctx context.Context, s *store.Store, stateCfg *state.State
 		t.Sheet = common.FormatSheet(taskRun.ProjectID, sheet.UID)
 	}
 
+	// The scheduler keeps the latest waiting state in memory, and persists the waiting for the rollout window.
+	var info *storepb.SchedulerInfo
 	if v, ok := stateCfg.TaskRunSchedulerInfo.Load(taskRun.ID); ok {
-		if info, ok := v.(*storepb.SchedulerInfo); ok {
-			si, err := convertToSchedulerInfo(ctx, s, info)
-			if err != nil {
-				return nil, errors.Wrapf(err, "failed to convert to scheduler info")
-			}
-			t.SchedulerInfo = si
+		info, _ = v.(*storepb.SchedulerInfo)
+	}
+	if info == nil && taskRun.Status == storepb.TaskRun_PENDING {
+		info = taskRun.Payload.GetSchedulerInfo()
+	}
+	if info != nil {
+		si, err := convertToSchedulerInfo(ctx, s, info)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert to scheduler info")
 		}
+		t.SchedulerInfo = si
 	}
 
 	if taskRun.ResultProto.ExportArchiveUid != 0 {
@@ -161,6 +171,18 @@ func convertToSchedulerInfoWaitingCause(ctx context.Context, s *store.Store, c *
 				ParallelTasksLimit: cause.ParallelTasksLimit,
 			},
 		}, nil
+	case *storepb.SchedulerInfo_WaitingCause_RolloutBatch:
+		return &v1pb.TaskRun_SchedulerInfo_WaitingCause{
+			Cause: &v1pb.TaskRun_SchedulerInfo_WaitingCause_RolloutBatch{
+				RolloutBatch: cause.RolloutBatch,
+			},
+		}, nil
+	case *storepb.SchedulerInfo_WaitingCause_RolloutWindow:
+		return &v1pb.TaskRun_SchedulerInfo_WaitingCause{
+			Cause: &v1pb.TaskRun_SchedulerInfo_WaitingCause_RolloutWindow{
+				RolloutWindow: cause.RolloutWindow,
+			},
+		}, nil
 	default:
 		return nil, nil
 	}
@@ -234,9 +256,11 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get plan")
 	}
+	var rolloutStrategy *storepb.PlanConfig_Deployment_RolloutStrategy
 	if plan != nil {
 		rolloutV1.Plan = common.FormatPlan(project.ResourceID, plan.UID)
 		rolloutV1.Title = plan.Name
+		rolloutStrategy = plan.Config.GetDeployment().GetRolloutStrategy()
 	}
 
 	if rollout.IssueID != nil {
@@ -258,7 +282,9 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 
 	// Group tasks by environment.
 	tasksByEnv := map[string][]*v1pb.Task{}
+	storeTasksByEnv := map[string][]*store.TaskMessage{}
 	for _, task := range rollout.Tasks {
+		storeTasksByEnv[task.Environment] = append(storeTasksByEnv[task.Environment], task)
 		rolloutTask, err := convertToTask(ctx, s, project, task)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to convert task"))
@@ -272,11 +298,17 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 		tasks := tasksByEnv[environment]
 		if len(tasks) > 0 {
 			stageID := common.FormatStageID(environment)
+			nextWindowOpenTime, err := getNextWindowOpenTime(ctx, s, environment)
+			if err != nil {
+				return nil, err
+			}
 			stages = append(stages, &v1pb.Stage{
-				Name:        common.FormatStage(project.ResourceID, rollout.ID, stageID),
-				Id:          stageID,
-				Environment: common.FormatEnvironment(stageID),
-				Tasks:       tasks,
+				Name:               common.FormatStage(project.ResourceID, rollout.ID, stageID),
+				Id:                 stageID,
+				Environment:        common.FormatEnvironment(stageID),
+				Tasks:              tasks,
+				NextWindowOpenTime: nextWindowOpenTime,
+				Halted:             utils.GetRolloutStrategyState(rolloutStrategy, storeTasksByEnv[environment]).Halted,
 			})
 		}
 		delete(tasksByEnv, environment)
@@ -300,6 +332,32 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 	return rolloutV1, nil
 }
 
+// getNextWindowOpenTime returns the next time the rollout window of the environment opens.
+// It returns nil if the environment has no rollout window or the window is open now.
+func getNextWindowOpenTime(ctx context.Context, s *store.Store, environment string) (*timestamppb.Timestamp, error) {
+	if environment == "" {
+		return nil, nil
+	}
+	policy, err := GetValidRolloutPolicyForEnvironment(ctx, s, environment)
+	if err != nil {
+		return nil, err
+	}
+	if policy.GetWindow() == nil {
+		return nil, nil
+	}
+	now := time.Now()
+	openTime, err := common.GetNextRolloutWindowOpenTime(policy.GetWindow(), now)
+	if err != nil {
+		// The window never opens in the foreseeable future, which is surfaced by the scheduler.
+		slog.Warn("failed to get next rollout window open time", slog.String("environment", environment), log.BBError(err))
+		return nil, nil
+	}
+	if !openTime.After(now) {
+		return nil, nil
+	}
+	return timestamppb.New(openTime), nil
+}
+
 func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
 	//exhaustive:enforce
 	switch task.Type {
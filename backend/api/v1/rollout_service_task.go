@@ -14,6 +14,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
+	"github.com/bytebase/bytebase/backend/component/pgosc"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -309,6 +310,12 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(
 			}
 			flags = c.GhostFlags
 		}
+		if c.EnableOnlineSchemaChange {
+			if _, err := pgosc.GetUserFlags(c.OnlineSchemaChangeFlags); err != nil {
+				return nil, errors.Wrapf(err, "invalid online schema change flags %q", c.OnlineSchemaChangeFlags)
+			}
+			flags = c.OnlineSchemaChangeFlags
+		}
 
 		taskCreate := &store.TaskMessage{
 			InstanceID:   database.InstanceID,
@@ -316,11 +323,12 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(
 			Environment:  env,
 			Type:         storepb.Task_DATABASE_MIGRATE,
 			Payload: &storepb.Task{
-				SpecId:            spec.Id,
-				SheetId:           int32(sheetUID),
-				Flags:             flags,
-				EnablePriorBackup: c.EnablePriorBackup,
-				EnableGhost:       c.EnableGhost,
+				SpecId:                   spec.Id,
+				SheetId:                  int32(sheetUID),
+				Flags:                    flags,
+				EnablePriorBackup:        c.EnablePriorBackup,
+				EnableGhost:              c.EnableGhost,
+				EnableOnlineSchemaChange: c.EnableOnlineSchemaChange,
 			},
 		}
 		return []*store.TaskMessage{taskCreate}, nil
@@ -0,0 +1,270 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// rowFilterBypassFunctions are the built-in functions that read the tables named in their text arguments or on the
+// remote servers, so the tables they read are unknown to the row filters.
+var rowFilterBypassFunctions = map[storepb.Engine][]string{
+	storepb.Engine_POSTGRES: {
+		"query_to_xml", "query_to_xmlschema", "query_to_xml_and_xmlschema",
+		"cursor_to_xml", "cursor_to_xmlschema",
+		"table_to_xml", "table_to_xmlschema", "table_to_xml_and_xmlschema",
+		"schema_to_xml", "schema_to_xmlschema", "schema_to_xml_and_xmlschema",
+		"database_to_xml", "database_to_xmlschema", "database_to_xml_and_xmlschema",
+		"ts_stat",
+		"dblink", "dblink_exec", "dblink_open", "dblink_fetch", "dblink_send_query", "dblink_get_result",
+	},
+}
+
+// applyRowAccessPolicy rewrites the statement so that it only reads the rows allowed by the row access policies
+// of the databases accessed by the query.
+// The statement is returned unchanged if the query reads no filtered table. If the query reads a filtered table
+// in a way that cannot be rewritten, e.g. through a view, it is rejected.
+func applyRowAccessPolicy(
+	ctx context.Context,
+	stores *store.Store,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	user *store.UserMessage,
+	spans []*parserbase.QuerySpan,
+	statement string,
+	schema string,
+) (string, error) {
+	databaseNames := map[string]bool{database.DatabaseName: true}
+	for _, span := range spans {
+		for _, columns := range []parserbase.SourceColumnSet{span.SourceColumns, span.PredicateColumns} {
+			for column := range columns {
+				databaseNames[column.Database] = true
+			}
+		}
+	}
+
+	filters, err := getRowFilters(ctx, stores, instance, user, databaseNames)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get row access policy"))
+	}
+	if len(filters) == 0 {
+		return statement, nil
+	}
+	userDefinedFunctions, err := getUserDefinedFunctions(ctx, stores, instance, databaseNames)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get row access policy"))
+	}
+	return applyRowFilters(instance, database.DatabaseName, schema, statement, spans, filters, userDefinedFunctions)
+}
+
+// applyRowFilters applies the row filters to the statement.
+// Whether a filtered table is read is decided from the spans first, so that the queries which cannot be rewritten,
+// e.g. multiple statements, still work if they do not read any filtered table.
+func applyRowFilters(
+	instance *store.InstanceMessage,
+	databaseName string,
+	schema string,
+	statement string,
+	spans []*parserbase.QuerySpan,
+	filters []parserbase.RowFilter,
+	userDefinedFunctions []string,
+) (string, error) {
+	engine := instance.Metadata.GetEngine()
+	ignoreCaseSensitive := !store.IsObjectCaseSensitive(instance)
+	// The functions are not checked for the engines without row filter support, so the query is always rejected.
+	if !parserbase.IsRowFilterSupported(engine) {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.Errorf("row access policy is not supported for engine %s", engine))
+	}
+
+	// The tables read by the query, including the tables read through views.
+	var accessedTables []parserbase.ColumnResource
+	for _, span := range spans {
+		// The tables read by the query are unknown, e.g. reading through a table function.
+		if span.NotFoundError != nil {
+			return "", connect.NewError(connect.CodePermissionDenied, errors.Wrap(span.NotFoundError, "failed to apply row access policy"))
+		}
+		for _, columns := range []parserbase.SourceColumnSet{span.SourceColumns, span.PredicateColumns} {
+			for column := range columns {
+				table := parserbase.ColumnResource{Database: column.Database, Schema: column.Schema, Table: column.Table}
+				if !containsTable(accessedTables, table, ignoreCaseSensitive) {
+					accessedTables = append(accessedTables, table)
+				}
+			}
+		}
+	}
+	// The functions may read the filtered tables without referencing them in the query, e.g. query_to_xml('SELECT * FROM t').
+	functions, err := parserbase.GetCalledFunctions(engine, statement)
+	if err != nil {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.Wrap(err, "failed to apply row access policy"))
+	}
+	for _, function := range functions {
+		if slices.Contains(rowFilterBypassFunctions[engine], function) || slices.ContainsFunc(userDefinedFunctions, func(name string) bool {
+			return strings.EqualFold(name, function)
+		}) {
+			return "", connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to apply row access policy: function %q may read the tables indirectly", function))
+		}
+	}
+
+	applied := false
+	for _, filter := range filters {
+		if containsTable(accessedTables, filter.Table, ignoreCaseSensitive) {
+			applied = true
+			break
+		}
+	}
+	if !applied {
+		return statement, nil
+	}
+
+	result, err := parserbase.ApplyRowFilters(engine, statement, databaseName, schema, filters, ignoreCaseSensitive)
+	if err != nil {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.Wrap(err, "failed to apply row access policy"))
+	}
+	// The rewrite only covers the tables referenced by name, so the query is rejected if it reads
+	// any table that is not referenced directly.
+	for _, table := range accessedTables {
+		if !containsTable(result.ReferencedTables, table, ignoreCaseSensitive) {
+			return "", connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to apply row access policy: table %q is read indirectly, e.g. through a view", table.String()))
+		}
+	}
+	return result.Statement, nil
+}
+
+// getUserDefinedFunctions returns the names of the functions defined in the databases.
+func getUserDefinedFunctions(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, databaseNames map[string]bool) ([]string, error) {
+	var functions []string
+	for databaseName := range databaseNames {
+		dbSchema, err := stores.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+			InstanceID:   instance.ResourceID,
+			DatabaseName: databaseName,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database schema %q", databaseName)
+		}
+		if dbSchema == nil {
+			continue
+		}
+		for _, schema := range dbSchema.GetProto().GetSchemas() {
+			for _, function := range schema.GetFunctions() {
+				functions = append(functions, function.GetName())
+			}
+		}
+	}
+	return functions, nil
+}
+
+// getRowFilters returns the row filters of the rules applying to the user on the tables of the databases.
+// The predicates of the rules on the same table are combined with AND.
+func getRowFilters(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, user *store.UserMessage, databaseNames map[string]bool) ([]parserbase.RowFilter, error) {
+	attributes := map[string]any{
+		common.CELAttributeUserEmail:   user.Email,
+		common.CELAttributeUserGroups:  append([]string{}, user.Groups...),
+		common.CELAttributeRequestTime: time.Now(),
+	}
+	policies := make(map[string]*storepb.RowAccessPolicy)
+	var filters []parserbase.RowFilter
+	for databaseName := range databaseNames {
+		database, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:      &instance.ResourceID,
+			DatabaseName:    &databaseName,
+			IsCaseSensitive: store.IsObjectCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+		}
+		if database == nil {
+			continue
+		}
+		policy, ok := policies[database.ProjectID]
+		if !ok {
+			policy, err = stores.GetRowAccessPolicyByProject(ctx, database.ProjectID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get row access policy of project %q", database.ProjectID)
+			}
+			policies[database.ProjectID] = policy
+		}
+
+		name := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+		for _, rule := range policy.Rules {
+			if rule.Database != name {
+				continue
+			}
+			ok, err := evaluateRowAccessPolicyCondition(rule.Condition.GetExpression(), attributes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to evaluate the row access rule of table %q", rule.Table)
+			}
+			if !ok {
+				continue
+			}
+			table := parserbase.ColumnResource{
+				Database: database.DatabaseName,
+				Schema:   rule.Schema,
+				Table:    rule.Table,
+			}
+			if table.Schema == "" && instance.Metadata.GetEngine() == storepb.Engine_POSTGRES {
+				table.Schema = "public"
+			}
+			if filter := parserbase.FindRowFilter(filters, table, false); filter != nil {
+				filter.Predicate = fmt.Sprintf("(%s) AND (%s)", filter.Predicate, rule.Predicate)
+				continue
+			}
+			filters = append(filters, parserbase.RowFilter{
+				Table:     table,
+				Predicate: rule.Predicate,
+			})
+		}
+	}
+	return filters, nil
+}
+
+func containsTable(tables []parserbase.ColumnResource, table parserbase.ColumnResource, ignoreCaseSensitive bool) bool {
+	for _, t := range tables {
+		if parserbase.IsSameTable(t, table, ignoreCaseSensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func evaluateRowAccessPolicyCondition(expression string, attributes map[string]any) (bool, error) {
+	if expression == "" {
+		return true, nil
+	}
+	rowAccessPolicyEnv, err := cel.NewEnv(common.RowAccessPolicyCELAttributes...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL environment for row access policy")
+	}
+	ast, issues := rowAccessPolicyEnv.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return false, errors.Wrapf(issues.Err(), "failed to get the ast of CEL program for row access rule")
+	}
+	prg, err := rowAccessPolicyEnv.Program(ast)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL program for row access rule")
+	}
+	out, _, err := prg.Eval(attributes)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to eval CEL program for row access rule")
+	}
+	val, err := out.ConvertToNative(reflect.TypeFor[bool]())
+	if err != nil {
+		return false, errors.Wrap(err, "expect bool result for row access rule")
+	}
+	boolVar, ok := val.(bool)
+	if !ok {
+		return false, errors.New("expect bool result for row access rule")
+	}
+	return boolVar, nil
+}
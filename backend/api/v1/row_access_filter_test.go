@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestEvaluateRowAccessPolicyCondition(t *testing.T) {
+	a := require.New(t)
+	attributes := map[string]any{
+		common.CELAttributeUserEmail:   "alice@example.com",
+		common.CELAttributeUserGroups:  []string{"sales@example.com"},
+		common.CELAttributeRequestTime: time.Date(2025, 4, 26, 0, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{expression: "", want: true},
+		{expression: `user.email == "alice@example.com"`, want: true},
+		{expression: `user.email == "bob@example.com"`, want: false},
+		{expression: `"sales@example.com" in user.groups`, want: true},
+		{expression: `"hr@example.com" in user.groups || request.time < timestamp("2025-01-01T00:00:00Z")`, want: false},
+	}
+	for _, tc := range tests {
+		got, err := evaluateRowAccessPolicyCondition(tc.expression, attributes)
+		a.NoError(err, tc.expression)
+		a.Equal(tc.want, got, tc.expression)
+	}
+
+	// A rule whose condition is not a bool fails instead of applying or skipping the rule.
+	_, err := evaluateRowAccessPolicyCondition("user.email", attributes)
+	a.Error(err)
+}
+
+func TestValidateRowAccessPolicy(t *testing.T) {
+	a := require.New(t)
+	newPolicy := func(expression string) *v1pb.Policy {
+		return &v1pb.Policy{
+			Type: v1pb.PolicyType_ROW_ACCESS,
+			Policy: &v1pb.Policy_RowAccessPolicy{RowAccessPolicy: &v1pb.RowAccessPolicy{
+				Rules: []*v1pb.RowAccessPolicy_Rule{{
+					Database:  "instances/i1/databases/db",
+					Table:     "orders",
+					Predicate: "region = 'us'",
+					Condition: &expr.Expr{Expression: expression},
+				}},
+			}},
+		}
+	}
+	a.NoError(validatePolicyPayload(storepb.Policy_ROW_ACCESS, newPolicy(`"sales@example.com" in user.groups`)))
+	a.Error(validatePolicyPayload(storepb.Policy_ROW_ACCESS, newPolicy("user.email")))
+	a.Error(validatePolicyPayload(storepb.Policy_ROW_ACCESS, newPolicy("user.unknown == 1")))
+}
+
+func TestApplyRowFilters(t *testing.T) {
+	a := require.New(t)
+	instance := &store.InstanceMessage{Metadata: &storepb.Instance{Engine: storepb.Engine_POSTGRES}}
+	orders := parserbase.ColumnResource{Database: "db", Schema: "public", Table: "orders"}
+	customers := parserbase.ColumnResource{Database: "db", Schema: "public", Table: "customers"}
+	filters := []parserbase.RowFilter{{Table: orders, Predicate: "region = 'us'"}}
+	readTables := func(tables ...parserbase.ColumnResource) []*parserbase.QuerySpan {
+		columns := parserbase.SourceColumnSet{}
+		for _, table := range tables {
+			columns[table] = true
+		}
+		return []*parserbase.QuerySpan{{SourceColumns: columns, PredicateColumns: parserbase.SourceColumnSet{}}}
+	}
+
+	// The statement is not rewritten if it reads no filtered table, even if it cannot be rewritten.
+	statement := "SELECT * FROM customers; SELECT 1;"
+	got, err := applyRowFilters(instance, "db", "public", statement, readTables(customers), filters, nil)
+	a.NoError(err)
+	a.Equal(statement, got)
+
+	got, err = applyRowFilters(instance, "db", "public", "SELECT * FROM orders", readTables(orders), filters, nil)
+	a.NoError(err)
+	a.Contains(got, "region = 'us'")
+
+	// The functions reading the tables from the query text, the remote servers or the function bodies are rejected.
+	for _, statement := range []string{
+		"SELECT query_to_xml('SELECT * FROM orders', true, false, '')",
+		"SELECT * FROM dblink('dbname=db', 'SELECT id FROM orders') AS t(id int)",
+		"SELECT * FROM customers WHERE id IN (SELECT get_order_customers())",
+	} {
+		_, err := applyRowFilters(instance, "db", "public", statement, readTables(customers), filters, []string{"get_order_customers"})
+		a.ErrorContains(err, "may read the tables indirectly", statement)
+	}
+}
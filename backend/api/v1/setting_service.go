@@ -19,6 +19,7 @@ import (
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/plugin/ai"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/dingtalk"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
@@ -504,10 +505,20 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 				if aiSetting.ApiKey == "" {
 					aiSetting.ApiKey = existedAISetting.Value.GetAiSetting().GetApiKey()
 				}
+				// The header values are not exposed, keep the existing values for the empty ones.
+				for key, value := range aiSetting.Headers {
+					if value == "" {
+						aiSetting.Headers[key] = existedAISetting.Value.GetAiSetting().GetHeaders()[key]
+					}
+				}
 			}
-			if aiSetting.ApiKey == "" {
+			// The OpenAI-compatible endpoints such as Ollama may not require the API key.
+			if aiSetting.ApiKey == "" && aiSetting.Provider != storepb.AISetting_OPENAI_COMPATIBLE {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("API key is required"))
 			}
+			if _, err := ai.NewProvider(aiSetting); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
 		}
 
 		bytes, err := protojson.Marshal(aiSetting)
@@ -139,8 +139,11 @@ func convertToSettingMessage(setting *store.SettingMessage, profile *config.Prof
 		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to unmarshal setting value for %s with error: %v", setting.Name, err))
 		}
-		// DO NOT expose the api key.
+		// DO NOT expose the api key and the header values.
 		storeValue.ApiKey = ""
+		for key := range storeValue.Headers {
+			storeValue.Headers[key] = ""
+		}
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
@@ -899,12 +902,14 @@ func convertAISetting(v1Setting *v1pb.AISetting) *storepb.AISetting {
 	}
 
 	return &storepb.AISetting{
-		Enabled:  v1Setting.Enabled,
-		Provider: storepb.AISetting_Provider(v1Setting.Provider),
-		Endpoint: v1Setting.Endpoint,
-		ApiKey:   v1Setting.ApiKey,
-		Model:    v1Setting.Model,
-		Version:  v1Setting.Version,
+		Enabled:       v1Setting.Enabled,
+		Provider:      storepb.AISetting_Provider(v1Setting.Provider),
+		Endpoint:      v1Setting.Endpoint,
+		ApiKey:        v1Setting.ApiKey,
+		Model:         v1Setting.Model,
+		Version:       v1Setting.Version,
+		Headers:       v1Setting.Headers,
+		CaCertificate: v1Setting.CaCertificate,
 	}
 }
 
@@ -914,12 +919,14 @@ func convertToAISetting(storeSetting *storepb.AISetting) *v1pb.AISetting {
 	}
 
 	return &v1pb.AISetting{
-		Enabled:  storeSetting.Enabled,
-		Provider: v1pb.AISetting_Provider(storeSetting.Provider),
-		Endpoint: storeSetting.Endpoint,
-		ApiKey:   storeSetting.ApiKey,
-		Model:    storeSetting.Model,
-		Version:  storeSetting.Version,
+		Enabled:       storeSetting.Enabled,
+		Provider:      v1pb.AISetting_Provider(storeSetting.Provider),
+		Endpoint:      storeSetting.Endpoint,
+		ApiKey:        storeSetting.ApiKey,
+		Model:         storeSetting.Model,
+		Version:       storeSetting.Version,
+		Headers:       storeSetting.Headers,
+		CaCertificate: storeSetting.CaCertificate,
 	}
 }
 
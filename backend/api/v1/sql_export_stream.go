@@ -0,0 +1,347 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alexmullins/zip"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/export"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// exportStreamChunkSize is the number of rows read from the cursor, masked and written at a time.
+const exportStreamChunkSize = 10000
+
+// DoExportStream does the export like DoExport, and writes the zip archive to w.
+// If the driver supports db.QueryStreamer, the rows are read from a cursor and written to the archive in chunks,
+// so the memory usage is bounded regardless of the result size. Otherwise, it falls back to DoExport.
+func DoExportStream(
+	ctx context.Context,
+	stores *store.Store,
+	dbFactory *dbfactory.DBFactory,
+	licenseService *enterprise.LicenseService,
+	request *v1pb.ExportRequest,
+	user *store.UserMessage,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	optionalAccessCheck accessCheckFunc,
+	schemaSyncer *schemasync.Syncer,
+	dataSource *storepb.DataSource,
+	w io.Writer,
+) (time.Duration, error) {
+	if dataSource == nil {
+		return 0, connect.NewError(connect.CodeNotFound, errors.Errorf("cannot found valid data source"))
+	}
+	driver, err := dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
+		DatabaseName: database.DatabaseName,
+		DataShare:    database.Metadata.GetDatashare(),
+		ReadOnly:     true,
+	})
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
+	}
+	defer driver.Close(ctx)
+
+	streamer, ok := driver.(db.QueryStreamer)
+	sqlDB := driver.GetDB()
+	if !ok || sqlDB == nil {
+		content, duration, err := DoExport(ctx, stores, dbFactory, licenseService, request, user, instance, database, optionalAccessCheck, schemaSyncer, dataSource)
+		if err != nil {
+			return duration, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return duration, errors.Wrap(err, "failed to write export archive")
+		}
+		return duration, nil
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	queryRestriction := getEffectiveQueryDataPolicy(
+		ctx,
+		stores,
+		licenseService,
+		request.Limit,
+		database.ProjectID,
+	)
+	queryContext := db.QueryContext{
+		Limit:         int(queryRestriction.MaximumResultRows),
+		OperatorEmail: user.Email,
+	}
+	if queryRestriction.MaxQueryTimeoutInSeconds > 0 {
+		queryContext.Timeout = &durationpb.Duration{Seconds: queryRestriction.MaxQueryTimeoutInSeconds}
+	}
+	if request.Schema != nil {
+		queryContext.Schema = *request.Schema
+	}
+
+	statements, err := parserbase.SplitMultiSQL(instance.Metadata.GetEngine(), request.Statement)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to split statements")
+	}
+
+	zipw := zip.NewWriter(w)
+	exportCount, statementNumber := 0, 0
+	var totalDuration time.Duration
+	for _, statement := range statements {
+		if statement.Empty {
+			continue
+		}
+		statementNumber++
+		duration, err := exportStatementStreamToZip(ctx, zipw, stores, licenseService, streamer, conn, request, user, instance, database, optionalAccessCheck, schemaSyncer, statement.Text, queryContext, statementNumber)
+		totalDuration += duration
+		if err != nil {
+			var skipErr *exportSkipError
+			if errors.As(err, &skipErr) {
+				logExportError(database, "failed to query result", err)
+				continue
+			}
+			return totalDuration, err
+		}
+		exportCount++
+	}
+
+	if exportCount == 0 {
+		return totalDuration, errors.Errorf("empty export data for database %s", database.DatabaseName)
+	}
+	if err := zipw.Close(); err != nil {
+		return totalDuration, errors.Wrap(err, "failed to close zip writer")
+	}
+	return totalDuration, nil
+}
+
+// exportSkipError is the error of a statement that fails before anything is written to the archive.
+// Like DoExport, such statements are skipped. Errors after the result entry is created fail the export
+// because a zip entry cannot be removed.
+type exportSkipError struct {
+	err error
+}
+
+func (e *exportSkipError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exportSkipError) Unwrap() error {
+	return e.err
+}
+
+// exportStatementStreamToZip streams the result of a single statement to the ZIP archive.
+func exportStatementStreamToZip(
+	ctx context.Context,
+	zipw *zip.Writer,
+	stores *store.Store,
+	licenseService *enterprise.LicenseService,
+	streamer db.QueryStreamer,
+	conn *sql.Conn,
+	request *v1pb.ExportRequest,
+	user *store.UserMessage,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	optionalAccessCheck accessCheckFunc,
+	schemaSyncer *schemasync.Syncer,
+	statement string,
+	queryContext db.QueryContext,
+	statementNumber int,
+) (time.Duration, error) {
+	span, err := getExportQuerySpan(ctx, stores, instance, database, schemaSyncer, statement, queryContext.Schema)
+	if err != nil {
+		return 0, err
+	}
+	if optionalAccessCheck != nil {
+		if err := optionalAccessCheck(ctx, instance, database, user, []*parserbase.QuerySpan{span}, false /* explain */); err != nil {
+			return 0, err
+		}
+	}
+	executedStatement, err := applyRowAccessPolicy(ctx, stores, instance, database, user, []*parserbase.QuerySpan{span}, statement, queryContext.Schema)
+	if err != nil {
+		return 0, err
+	}
+
+	var maskers []masker.Masker
+	var reasons []*v1pb.MaskingReason
+	if licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
+		queryResultMasker := NewQueryResultMasker(stores)
+		sensitivePredicateColumns, err := queryResultMasker.ExtractSensitivePredicateColumns(ctx, []*parserbase.QuerySpan{span}, instance, user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
+		if err != nil {
+			return 0, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+		}
+		if len(sensitivePredicateColumns) > 0 && len(sensitivePredicateColumns[0]) > 0 {
+			return 0, &exportSkipError{err: errors.New(getSensitivePredicateColumnErrorMessages(sensitivePredicateColumns[0]))}
+		}
+		maskers, reasons, err = queryResultMasker.GetMaskers(ctx, span, instance, user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
+		if err != nil {
+			return 0, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+		}
+	}
+
+	queryCtx := ctx
+	if queryContext.Timeout != nil {
+		newCtx, cancelCtx := context.WithTimeout(ctx, queryContext.Timeout.AsDuration())
+		defer cancelCtx()
+		queryCtx = newCtx
+	}
+	start := time.Now()
+	cursor, err := streamer.QueryStream(queryCtx, conn, executedStatement, queryContext)
+	if err != nil {
+		return time.Since(start), &exportSkipError{err: err}
+	}
+	defer cursor.Close()
+
+	baseFilename := fmt.Sprintf("%s/%s/statement-%d", database.InstanceID, database.DatabaseName, statementNumber)
+	if err := export.WriteZipEntry(zipw, fmt.Sprintf("%s.sql", baseFilename), []byte(statement), request.GetPassword()); err != nil {
+		return time.Since(start), errors.Wrap(err, "failed to write statement")
+	}
+	resultFilename := fmt.Sprintf("%s.result.%s", baseFilename, strings.ToLower(request.Format.String()))
+	writer, err := export.CreateZipWriter(zipw, resultFilename, request.GetPassword())
+	if err != nil {
+		return time.Since(start), err
+	}
+	config := export.RowWriterConfig{
+		Format:          request.Format,
+		ColumnNames:     cursor.ColumnNames(),
+		ColumnTypeNames: cursor.ColumnTypeNames(),
+		Engine:          instance.Metadata.GetEngine(),
+	}
+	if request.Format == v1pb.ExportFormat_SQL {
+		statementPrefix, err := getSQLStatementPrefix(ctx, stores, instance, database, statement, config.ColumnNames)
+		if err != nil {
+			return time.Since(start), err
+		}
+		config.StatementPrefix = statementPrefix
+	}
+	rowWriter, err := export.NewRowWriter(writer, config)
+	if err != nil {
+		return time.Since(start), err
+	}
+
+	// The chunk is a query result so that it can be masked in the same way as non-streamed results.
+	chunk := &v1pb.QueryResult{ColumnNames: config.ColumnNames}
+	rowCount := 0
+	for {
+		chunk.Rows = chunk.Rows[:0]
+		for len(chunk.Rows) < exportStreamChunkSize && cursor.Next() {
+			row, err := cursor.Row()
+			if err != nil {
+				_ = rowWriter.Close()
+				return time.Since(start), errors.Wrapf(err, "failed to read row")
+			}
+			chunk.Rows = append(chunk.Rows, row)
+		}
+		if len(chunk.Rows) == 0 {
+			break
+		}
+		if maskers != nil {
+			doMaskResult(maskers, reasons, chunk)
+		}
+		if err := rowWriter.WriteRows(chunk.Rows); err != nil {
+			_ = rowWriter.Close()
+			return time.Since(start), errors.Wrapf(err, "failed to write rows")
+		}
+		rowCount += len(chunk.Rows)
+	}
+	if err := cursor.Err(); err != nil {
+		_ = rowWriter.Close()
+		if queryContext.Timeout != nil && queryCtx.Err() != nil {
+			return time.Since(start), errors.Errorf("timeout reached: %v", queryContext.Timeout.AsDuration())
+		}
+		return time.Since(start), errors.Wrapf(err, "failed to read rows")
+	}
+	if err := rowWriter.Close(); err != nil {
+		return time.Since(start), errors.Wrapf(err, "failed to write result")
+	}
+	slog.Debug("export statement stream",
+		slog.String("instance", instance.ResourceID),
+		slog.String("database", database.DatabaseName),
+		slog.Int("rows", rowCount),
+		slog.Duration("duration", time.Since(start)),
+	)
+	return time.Since(start), nil
+}
+
+// getExportQuerySpan returns the query span of a single statement.
+// Unlike queryRetry, the statement is not executed yet, so the database metadata is synced before
+// the query if the span refers to unknown objects.
+func getExportQuerySpan(
+	ctx context.Context,
+	stores *store.Store,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	schemaSyncer *schemasync.Syncer,
+	statement string,
+	schema string,
+) (*parserbase.QuerySpan, error) {
+	getSpan := func() (*parserbase.QuerySpan, error) {
+		spans, err := parserbase.GetQuerySpan(
+			ctx,
+			parserbase.GetQuerySpanContext{
+				InstanceID:                    instance.ResourceID,
+				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
+				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
+				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Metadata.GetEngine()),
+			},
+			instance.Metadata.GetEngine(),
+			statement,
+			database.DatabaseName,
+			schema,
+			!store.IsObjectCaseSensitive(instance),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(spans) != 1 {
+			return nil, errors.Errorf("expected one query span, got %d", len(spans))
+		}
+		// After replacing backup table with source, we can apply the original access check and mask sensitive data for backup table.
+		if err := replaceBackupTableWithSource(ctx, stores, instance, database, spans); err != nil {
+			slog.Debug("failed to replace backup table with source", log.BBError(err))
+		}
+		return spans[0], nil
+	}
+
+	span, err := getSpan()
+	if err != nil {
+		return nil, err
+	}
+	if span.NotFoundError == nil {
+		return span, nil
+	}
+
+	syncDatabaseMap := make(map[string]bool)
+	for k := range span.SourceColumns {
+		syncDatabaseMap[k.Database] = true
+	}
+	for accessDatabaseName := range syncDatabaseMap {
+		d, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &accessDatabaseName})
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			continue
+		}
+		if err := schemaSyncer.SyncDatabaseSchema(ctx, d); err != nil {
+			return nil, errors.Wrapf(err, "failed to sync database schema for database %q", accessDatabaseName)
+		}
+	}
+	return getSpan()
+}
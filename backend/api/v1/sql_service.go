@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"os"
 	"regexp"
 	"strings"
 	"time"
@@ -149,7 +150,7 @@ func (s *SQLService) AdminExecute(ctx context.Context, stream *connect.BidiStrea
 			queryContext,
 		)
 
-		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.ID, duration, queryErr)
+		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.ID, duration, queryErr, "")
 		response := &v1pb.AdminExecuteResponse{}
 		if queryErr != nil {
 			response.Results = []*v1pb.QueryResult{
@@ -193,6 +194,10 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 	if err != nil {
 		return nil, err
 	}
+
+	// Collect the break-glass access that the access checks rely on.
+	ctx, breakGlass := withBreakGlassAccess(ctx)
+
 	driver, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
 		DatabaseName: database.DatabaseName,
 		DataShare:    database.Metadata.GetDatashare(),
@@ -258,8 +263,10 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 		slog.String("database", database.DatabaseName),
 	)
 
+	// Tag the statements run under a break-glass access in the query history and the audit log.
+	breakGlassIssue := resolveBreakGlassIssue(ctx, s.store, breakGlass)
 	// Update activity.
-	s.createQueryHistory(database, store.QueryHistoryTypeQuery, statement, user.ID, duration, queryErr)
+	s.createQueryHistory(database, store.QueryHistoryTypeQuery, statement, user.ID, duration, queryErr, breakGlassIssue)
 
 	if queryErr != nil {
 		if len(results) == 0 {
@@ -363,6 +370,8 @@ func getSchemaMetadata(engine storepb.Engine, dbMetadata *model.DatabaseMetadata
 		return dbMetadata.GetSchemaMetadata(common.BackupDatabaseNameOfEngine(storepb.Engine_POSTGRES))
 	case storepb.Engine_MSSQL:
 		return dbMetadata.GetSchemaMetadata("dbo")
+	case storepb.Engine_SNOWFLAKE:
+		return dbMetadata.GetSchemaMetadata("PUBLIC")
 	default:
 		return dbMetadata.GetSchemaMetadata("")
 	}
@@ -477,6 +486,8 @@ func queryRetry(
 	var spans []*parserbase.QuerySpan
 	var sensitivePredicateColumns [][]parserbase.ColumnResource
 	var err error
+	// executedStatement is the statement with the row filters applied.
+	executedStatement := statement
 	if !queryContext.Explain {
 		spans, err = parserbase.GetQuerySpan(
 			ctx,
@@ -507,6 +518,10 @@ func queryRetry(
 			}
 			slog.Debug("optional access check", slog.String("instance", instance.ResourceID), slog.String("database", database.DatabaseName))
 		}
+		executedStatement, err = applyRowAccessPolicy(ctx, stores, instance, database, user, spans, statement, queryContext.Schema)
+		if err != nil {
+			return nil, nil, time.Duration(0), err
+		}
 		if licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
 			masker := NewQueryResultMasker(stores)
 			sensitivePredicateColumns, err = masker.ExtractSensitivePredicateColumns(ctx, spans, instance, user, action)
@@ -522,12 +537,18 @@ func queryRetry(
 		ctx,
 		driver,
 		conn,
-		statement,
+		executedStatement,
 		queryContext,
 	)
 	if queryErr != nil {
 		return nil, nil, duration, queryErr
 	}
+	if executedStatement != statement {
+		// Don't reveal the row filters in the results.
+		for _, result := range results {
+			result.Statement = statement
+		}
+	}
 	slog.Debug("execute success", slog.String("instance", instance.ResourceID), slog.String("statement", statement), slog.Duration("duration", duration))
 	if queryContext.Explain {
 		return results, nil, duration, nil
@@ -866,9 +887,13 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 	if err != nil {
 		return nil, err
 	}
+	// Collect the break-glass access that the access checks rely on.
+	ctx, breakGlass := withBreakGlassAccess(ctx)
 	bytes, duration, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource)
 
-	s.createQueryHistory(database, store.QueryHistoryTypeExport, statement, user.ID, duration, exportErr)
+	// Tag the statements exported under a break-glass access in the query history and the audit log.
+	breakGlassIssue := resolveBreakGlassIssue(ctx, s.store, breakGlass)
+	s.createQueryHistory(database, store.QueryHistoryTypeExport, statement, user.ID, duration, exportErr, breakGlassIssue)
 
 	if exportErr != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
@@ -880,6 +905,78 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 }
 
 func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string) (*v1pb.ExportResponse, error) {
+	exportArchives, password, err := s.getIssueExportArchives(ctx, requestName)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := s.writeExportArchives(ctx, &b, exportArchives, password); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to write export archive: %v", err))
+	}
+	return &v1pb.ExportResponse{
+		Content: b.Bytes(),
+	}, nil
+}
+
+// exportDownloadChunkSize is the size of the file content sent in each ExportStream response.
+const exportDownloadChunkSize = 1024 * 1024
+
+// ExportStream downloads the data exported by a rollout or stage, sending the zip file in chunks.
+// The stored archives are read entry by entry, so the file is never held in memory as a whole.
+func (s *SQLService) ExportStream(ctx context.Context, req *connect.Request[v1pb.ExportRequest], stream *connect.ServerStream[v1pb.ExportResponse]) error {
+	if !strings.HasPrefix(req.Msg.Name, common.ProjectNamePrefix) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("only the exports of rollouts or stages can be streamed, got %q", req.Msg.Name))
+	}
+	exportArchives, password, err := s.getIssueExportArchives(ctx, req.Msg.Name)
+	if err != nil {
+		return err
+	}
+	w := &exportStreamWriter{send: func(content []byte) error {
+		return stream.Send(&v1pb.ExportResponse{Content: content})
+	}}
+	if err := s.writeExportArchives(ctx, w, exportArchives, password); err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to write export archive: %v", err))
+	}
+	if err := w.flush(); err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to send export archive: %v", err))
+	}
+	return nil
+}
+
+// exportStreamWriter buffers the written content and sends it in chunks of exportDownloadChunkSize.
+type exportStreamWriter struct {
+	buf  []byte
+	send func([]byte) error
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		m := min(len(p), exportDownloadChunkSize-len(w.buf))
+		w.buf = append(w.buf, p[:m]...)
+		p = p[m:]
+		if len(w.buf) == exportDownloadChunkSize {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (w *exportStreamWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	// The sent content must not be overwritten by the later writes.
+	content := w.buf
+	w.buf = make([]byte, 0, exportDownloadChunkSize)
+	return w.send(content)
+}
+
+// getIssueExportArchives returns the export archives of the data export tasks of the rollout or stage,
+// and the password to encrypt the downloaded zip.
+func (s *SQLService) getIssueExportArchives(ctx context.Context, requestName string) ([]*store.ExportArchiveMessage, string, error) {
 	// Try to parse as rollout name first (more specific), then fallback to stage name
 	var rolloutID int
 	var projectID string
@@ -889,7 +986,7 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 		// If rollout parsing fails, try parsing as stage name
 		projectID, rolloutID, _, err = common.GetProjectIDRolloutIDMaybeStageID(requestName)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to parse request name as rollout or stage: %v", err))
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.Errorf("failed to parse request name as rollout or stage: %v", err))
 		}
 	}
 
@@ -898,21 +995,21 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 		ProjectID: &projectID,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get rollout: %v", err))
+		return nil, "", connect.NewError(connect.CodeInternal, errors.Errorf("failed to get rollout: %v", err))
 	}
 	if pipeline == nil {
-		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("rollout %d not found in project %s", rolloutID, projectID))
+		return nil, "", connect.NewError(connect.CodeNotFound, errors.Errorf("rollout %d not found in project %s", rolloutID, projectID))
 	}
 
 	tasks, err := s.store.ListTasks(ctx, &store.TaskFind{PipelineID: &pipeline.ID})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get tasks: %v", err))
+		return nil, "", connect.NewError(connect.CodeInternal, errors.Errorf("failed to get tasks: %v", err))
 	}
 	if len(tasks) == 0 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %d has no task", pipeline.ID))
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %d has no task", pipeline.ID))
 	}
 
-	pendingEncrypts := []*encryptContent{}
+	var exportArchives []*store.ExportArchiveMessage
 	targetTaskRunStatus := []storepb.TaskRun_Status{storepb.TaskRun_DONE}
 
 	for _, task := range tasks {
@@ -921,58 +1018,108 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 			Status:  &targetTaskRunStatus,
 		})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get task run: %v", err))
+			return nil, "", connect.NewError(connect.CodeInternal, errors.Errorf("failed to get task run: %v", err))
 		}
 		if len(taskRuns) == 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %v has no task run", requestName))
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.Errorf("rollout %v has no task run", requestName))
 		}
 		taskRun := taskRuns[0]
 		exportArchiveUID := int(taskRun.ResultProto.ExportArchiveUid)
 		if exportArchiveUID == 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("issue %v has no export archive", requestName))
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.Errorf("issue %v has no export archive", requestName))
 		}
 		exportArchive, err := s.store.GetExportArchive(ctx, &store.FindExportArchiveMessage{UID: &exportArchiveUID})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get export archive: %v", err))
+			return nil, "", connect.NewError(connect.CodeInternal, errors.Errorf("failed to get export archive: %v", err))
 		}
 		if exportArchive == nil {
-			return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("export not found or expired, please request a new export"))
+			return nil, "", connect.NewError(connect.CodeNotFound, errors.Errorf("export not found or expired, please request a new export"))
 		}
+		exportArchives = append(exportArchives, exportArchive)
+	}
+	return exportArchives, tasks[0].Payload.GetPassword(), nil
+}
 
-		// The exportArchive.Bytes should be a zip without password. We will read it and append all files into the pendingEncrypts,
-		// then create a new file zip for them.
-		zipReader, err := zip.NewReader(bytes.NewReader(exportArchive.Bytes), int64(len(exportArchive.Bytes)))
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to read export archive: %v", err))
+// writeExportArchives writes the files of the export archives into a zip encrypted with the password.
+// The export archives are zips without password, whose files are copied into the new zip one by one.
+func (s *SQLService) writeExportArchives(ctx context.Context, w io.Writer, exportArchives []*store.ExportArchiveMessage, password string) error {
+	zipw := zip.NewWriter(w)
+	for _, exportArchive := range exportArchives {
+		if err := s.copyExportArchive(ctx, zipw, exportArchive, password); err != nil {
+			return err
 		}
+	}
+	if err := zipw.Close(); err != nil {
+		return errors.Wrap(err, "failed to close zip writer")
+	}
+	return nil
+}
 
-		for _, file := range zipReader.File {
-			rc, err := file.Open()
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to open file %s in archive: %v", file.Name, err))
-			}
-
-			content, err := io.ReadAll(rc)
-			rc.Close()
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to read file %s: %v", file.Name, err))
-			}
+func (s *SQLService) copyExportArchive(ctx context.Context, zipw *zip.Writer, exportArchive *store.ExportArchiveMessage, password string) error {
+	zipReader, closeArchive, err := s.openExportArchive(ctx, exportArchive)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read export archive")
+	}
+	defer closeArchive()
 
-			pendingEncrypts = append(pendingEncrypts, &encryptContent{
-				Content: content,
-				Name:    file.Name,
-			})
+	for _, file := range zipReader.File {
+		if err := copyZipFile(zipw, file, password); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	encryptedBytes, err := doEncrypt(pendingEncrypts, tasks[0].Payload.GetPassword())
+func copyZipFile(zipw *zip.Writer, file *zip.File, password string) error {
+	rc, err := file.Open()
+	if err != nil {
+		return errors.Wrapf(err, "failed to open file %s in archive", file.Name)
+	}
+	defer rc.Close()
+	writer, err := export.CreateZipWriter(zipw, file.Name, password)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to encrypt data: %v", err))
+		return err
+	}
+	if _, err := io.Copy(writer, rc); err != nil {
+		return errors.Wrapf(err, "failed to copy file %s", file.Name)
+	}
+	return nil
+}
+
+// openExportArchive opens the zip of the export archive.
+// Archives stored in chunks are written to a temporary file first, which is removed by the returned close function.
+func (s *SQLService) openExportArchive(ctx context.Context, exportArchive *store.ExportArchiveMessage) (*zip.Reader, func(), error) {
+	if len(exportArchive.Bytes) > 0 {
+		zipReader, err := zip.NewReader(bytes.NewReader(exportArchive.Bytes), int64(len(exportArchive.Bytes)))
+		if err != nil {
+			return nil, nil, err
+		}
+		return zipReader, func() {}, nil
 	}
 
-	return &v1pb.ExportResponse{
-		Content: encryptedBytes,
-	}, nil
+	f, err := os.CreateTemp("", "bytebase-export-*.zip")
+	if err != nil {
+		return nil, nil, err
+	}
+	closeFile := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	if err := s.store.WriteExportArchiveContent(ctx, exportArchive.UID, f); err != nil {
+		closeFile()
+		return nil, nil, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		closeFile()
+		return nil, nil, err
+	}
+	zipReader, err := zip.NewReader(f, size)
+	if err != nil {
+		closeFile()
+		return nil, nil, err
+	}
+	return zipReader, closeFile, nil
 }
 
 // DoExport does the export.
@@ -1152,6 +1299,10 @@ func formatExportToZip(
 		return exportSQLWithContext(ctx, writer, stores, instance, database, result, request)
 	case v1pb.ExportFormat_XLSX:
 		return export.XLSXToWriter(writer, result)
+	case v1pb.ExportFormat_PARQUET:
+		return export.ParquetToWriter(writer, result)
+	case v1pb.ExportFormat_AVRO:
+		return export.AvroToWriter(writer, result)
 	default:
 		return errors.Errorf("unsupported export format: %s", request.Format.String())
 	}
@@ -1167,53 +1318,40 @@ func exportSQLWithContext(
 	result *v1pb.QueryResult,
 	request *v1pb.ExportRequest,
 ) error {
+	statementPrefix, err := getSQLStatementPrefix(ctx, stores, instance, database, request.Statement, result.ColumnNames)
+	if err != nil {
+		return err
+	}
+	return export.SQLToWriter(w, instance.Metadata.GetEngine(), statementPrefix, result)
+}
+
+// getSQLStatementPrefix returns the INSERT INTO statement prefix for the columns of the statement result.
+func getSQLStatementPrefix(
+	ctx context.Context,
+	stores *store.Store,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	statement string,
+	columnNames []string,
+) (string, error) {
 	resourceList, err := export.GetResources(
 		ctx,
 		stores,
 		instance.Metadata.GetEngine(),
 		database.DatabaseName,
-		request.Statement,
+		statement,
 		instance,
 		BuildGetDatabaseMetadataFunc(stores),
 		BuildListDatabaseNamesFunc(stores),
 		BuildGetLinkedDatabaseMetadataFunc(stores, instance.Metadata.GetEngine()),
 	)
 	if err != nil {
-		return errors.Wrapf(err, "failed to extract resource list")
+		return "", errors.Wrapf(err, "failed to extract resource list")
 	}
-	statementPrefix, err := export.SQLStatementPrefix(instance.Metadata.GetEngine(), resourceList, result.ColumnNames)
-	if err != nil {
-		return err
-	}
-	return export.SQLToWriter(w, instance.Metadata.GetEngine(), statementPrefix, result)
+	return export.SQLStatementPrefix(instance.Metadata.GetEngine(), resourceList, columnNames)
 }
 
-type encryptContent struct {
-	Name    string
-	Content []byte
-}
-
-func doEncrypt(exports []*encryptContent, password string) ([]byte, error) {
-	var b bytes.Buffer
-	fzip := io.Writer(&b)
-
-	zipw := zip.NewWriter(fzip)
-	defer zipw.Close()
-
-	for _, exportContent := range exports {
-		if err := export.WriteZipEntry(zipw, exportContent.Name, exportContent.Content, password); err != nil {
-			return nil, err
-		}
-	}
-
-	if err := zipw.Close(); err != nil {
-		return nil, errors.Wrap(err, "failed to close zip writer")
-	}
-
-	return b.Bytes(), nil
-}
-
-func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryType store.QueryHistoryType, statement string, userUID int, duration time.Duration, queryErr error) {
+func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryType store.QueryHistoryType, statement string, userUID int, duration time.Duration, queryErr error, breakGlassIssue string) {
 	qh := &store.QueryHistoryMessage{
 		CreatorUID: userUID,
 		ProjectID:  database.ProjectID,
@@ -1221,8 +1359,9 @@ func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryTy
 		Statement:  statement,
 		Type:       queryType,
 		Payload: &storepb.QueryHistoryPayload{
-			Error:    nil,
-			Duration: durationpb.New(duration),
+			Error:           nil,
+			Duration:        durationpb.New(duration),
+			BreakGlassIssue: breakGlassIssue,
 		},
 	}
 	if queryErr != nil {
@@ -1653,27 +1792,51 @@ func (s *SQLService) hasDatabaseAccessRights(
 	iamPolicies ...*storepb.IamPolicy,
 ) (bool, error) {
 	bindings := utils.GetUserIAMPolicyBindings(ctx, s.store, user, iamPolicies...)
+	// The break-glass bindings are checked last, so that only the access granted solely by them is recorded.
+	var breakGlassBindings []*storepb.Binding
 	for _, binding := range bindings {
-		permissions, err := s.iamManager.GetPermissions(binding.Role)
+		if isBreakGlassBinding(binding) {
+			breakGlassBindings = append(breakGlassBindings, binding)
+			continue
+		}
+		ok, err := s.bindingHasAccessRights(binding, permission, attributes)
 		if err != nil {
-			return false, errors.Wrapf(err, "failed to get permissions")
+			return false, err
 		}
-		if !permissions[permission] {
-			continue
+		if ok {
+			return true, nil
 		}
-
-		ok, err := evaluateQueryExportPolicyCondition(binding.Condition.GetExpression(), attributes)
+	}
+	for _, binding := range breakGlassBindings {
+		ok, err := s.bindingHasAccessRights(binding, permission, attributes)
 		if err != nil {
-			slog.Error("failed to evaluate condition", log.BBError(err), slog.String("condition", binding.Condition.GetExpression()))
-			continue
+			return false, err
 		}
 		if ok {
+			recordBreakGlassBinding(ctx, binding)
 			return true, nil
 		}
 	}
 	return false, nil
 }
 
+func (s *SQLService) bindingHasAccessRights(binding *storepb.Binding, permission iam.Permission, attributes map[string]any) (bool, error) {
+	permissions, err := s.iamManager.GetPermissions(binding.Role)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get permissions")
+	}
+	if !permissions[permission] {
+		return false, nil
+	}
+
+	ok, err := evaluateQueryExportPolicyCondition(binding.Condition.GetExpression(), attributes)
+	if err != nil {
+		slog.Error("failed to evaluate condition", log.BBError(err), slog.String("condition", binding.Condition.GetExpression()))
+		return false, nil
+	}
+	return ok, nil
+}
+
 func (*SQLService) getUser(ctx context.Context) (*store.UserMessage, error) {
 	user, ok := GetUserFromContext(ctx)
 	if !ok {
@@ -1706,7 +1869,7 @@ func getUseDatabaseOwner(ctx context.Context, stores *store.Store, instance *sto
 func (*SQLService) DiffMetadata(_ context.Context, req *connect.Request[v1pb.DiffMetadataRequest]) (*connect.Response[v1pb.DiffMetadataResponse], error) {
 	request := req.Msg
 	switch request.Engine {
-	case v1pb.Engine_MYSQL, v1pb.Engine_POSTGRES, v1pb.Engine_TIDB, v1pb.Engine_ORACLE, v1pb.Engine_MSSQL:
+	case v1pb.Engine_MYSQL, v1pb.Engine_POSTGRES, v1pb.Engine_TIDB, v1pb.Engine_ORACLE, v1pb.Engine_MSSQL, v1pb.Engine_SNOWFLAKE, v1pb.Engine_REDSHIFT, v1pb.Engine_CLICKHOUSE:
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported engine: %v", request.Engine))
 	}
@@ -21,9 +21,27 @@ func convertToV1Advice(advice *storepb.Advice) *v1pb.Advice {
 		Content:       advice.Content,
 		StartPosition: convertToPosition(advice.StartPosition),
 		EndPosition:   convertToPosition(advice.EndPosition),
+		SuggestedFix:  convertToV1SuggestedFix(advice.SuggestedFix),
 	}
 }
 
+func convertToV1SuggestedFix(fix *storepb.SuggestedFix) *v1pb.SuggestedFix {
+	if fix == nil {
+		return nil
+	}
+	v1Fix := &v1pb.SuggestedFix{
+		Title: fix.Title,
+	}
+	for _, edit := range fix.Edits {
+		v1Fix.Edits = append(v1Fix.Edits, &v1pb.TextEdit{
+			StartPosition: convertToPosition(edit.StartPosition),
+			EndPosition:   convertToPosition(edit.EndPosition),
+			NewText:       edit.NewText,
+		})
+	}
+	return v1Fix
+}
+
 func convertAdviceStatus(status storepb.Advice_Status) v1pb.Advice_Level {
 	switch status {
 	case storepb.Advice_SUCCESS:
@@ -56,13 +74,14 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 	}
 
 	return &v1pb.QueryHistory{
-		Name:       fmt.Sprintf("queryHistories/%d", history.UID),
-		Statement:  history.Statement,
-		Error:      history.Payload.Error,
-		Database:   history.Database,
-		Creator:    common.FormatUserEmail(user.Email),
-		CreateTime: timestamppb.New(history.CreatedAt),
-		Duration:   history.Payload.Duration,
-		Type:       historyType,
+		Name:            fmt.Sprintf("queryHistories/%d", history.UID),
+		Statement:       history.Statement,
+		Error:           history.Payload.Error,
+		Database:        history.Database,
+		Creator:         common.FormatUserEmail(user.Email),
+		CreateTime:      timestamppb.New(history.CreatedAt),
+		Duration:        history.Payload.Duration,
+		Type:            historyType,
+		BreakGlassIssue: history.Payload.GetBreakGlassIssue(),
 	}, nil
 }
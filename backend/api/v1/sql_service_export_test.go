@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/alexmullins/zip"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestWriteExportArchivesInChunks(t *testing.T) {
+	a := require.New(t)
+	content := strings.Repeat("a,b\n", exportDownloadChunkSize)
+	var archive bytes.Buffer
+	zipw := zip.NewWriter(&archive)
+	w, err := zipw.Create("export.csv")
+	a.NoError(err)
+	_, err = w.Write([]byte(content))
+	a.NoError(err)
+	a.NoError(zipw.Close())
+
+	var chunks [][]byte
+	writer := &exportStreamWriter{send: func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}}
+	s := &SQLService{}
+	a.NoError(s.writeExportArchives(context.Background(), writer, []*store.ExportArchiveMessage{{Bytes: archive.Bytes()}}, ""))
+	a.NoError(writer.flush())
+	a.NotEmpty(chunks)
+	for _, chunk := range chunks {
+		a.LessOrEqual(len(chunk), exportDownloadChunkSize)
+	}
+
+	downloaded := bytes.Join(chunks, nil)
+	zipReader, err := zip.NewReader(bytes.NewReader(downloaded), int64(len(downloaded)))
+	a.NoError(err)
+	a.Len(zipReader.File, 1)
+	a.Equal("export.csv", zipReader.File[0].Name)
+	rc, err := zipReader.File[0].Open()
+	a.NoError(err)
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	a.NoError(err)
+	a.Equal(content, string(got))
+}
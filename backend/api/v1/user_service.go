@@ -420,13 +420,13 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("MFA setup has expired, please regenerate the temporary secret"))
 				}
 				// Promote temp secrets to permanent and clear temp fields to prevent reuse
-				patch.MFAConfig = &storepb.MFAConfig{
-					OtpSecret:                user.MFAConfig.TempOtpSecret,
-					RecoveryCodes:            user.MFAConfig.TempRecoveryCodes,
-					TempOtpSecret:            "",
-					TempRecoveryCodes:        nil,
-					TempOtpSecretCreatedTime: nil,
-				}
+				mfaConfig := cloneMFAConfig(user.MFAConfig)
+				mfaConfig.OtpSecret = mfaConfig.TempOtpSecret
+				mfaConfig.RecoveryCodes = mfaConfig.TempRecoveryCodes
+				mfaConfig.TempOtpSecret = ""
+				mfaConfig.TempRecoveryCodes = nil
+				mfaConfig.TempOtpSecretCreatedTime = nil
+				patch.MFAConfig = mfaConfig
 			} else {
 				setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
 				if err != nil {
@@ -442,7 +442,7 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 						return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("2FA is required and cannot be disabled"))
 					}
 				}
-				patch.MFAConfig = &storepb.MFAConfig{}
+				patch.MFAConfig = clearOTPConfig(user.MFAConfig)
 			}
 		case "phone":
 			if request.Msg.User.Phone != "" {
@@ -497,6 +497,7 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 		if user.MFAConfig != nil {
 			patch.MFAConfig.OtpSecret = user.MFAConfig.OtpSecret
 			patch.MFAConfig.RecoveryCodes = user.MFAConfig.RecoveryCodes
+			patch.MFAConfig.WebauthnCredentials = user.MFAConfig.WebauthnCredentials
 		}
 	}
 	// This flag will update user's recovery codes with temp recovery codes.
@@ -509,8 +510,9 @@ func (s *UserService) UpdateUser(ctx context.Context, request *connect.Request[v
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("No recovery codes to update"))
 		}
 		patch.MFAConfig = &storepb.MFAConfig{
-			OtpSecret:     user.MFAConfig.OtpSecret,
-			RecoveryCodes: user.MFAConfig.TempRecoveryCodes,
+			OtpSecret:           user.MFAConfig.OtpSecret,
+			RecoveryCodes:       user.MFAConfig.TempRecoveryCodes,
+			WebauthnCredentials: user.MFAConfig.WebauthnCredentials,
 		}
 	}
 
@@ -693,7 +695,7 @@ func convertToUser(ctx context.Context, user *store.UserMessage) *v1pb.User {
 	}
 
 	if user.MFAConfig != nil {
-		convertedUser.MfaEnabled = user.MFAConfig.OtpSecret != ""
+		convertedUser.MfaEnabled = user.MFAConfig.OtpSecret != "" || len(user.MFAConfig.WebauthnCredentials) > 0
 		// Only expose temporary MFA secrets and recovery codes to the user themselves
 		if currentUser, ok := GetUserFromContext(ctx); ok && currentUser.ID == user.ID {
 			convertedUser.TempOtpSecret = user.MFAConfig.TempOtpSecret
@@ -852,3 +854,15 @@ func isUserWorkspaceAdmin(ctx context.Context, stores *store.Store, user *store.
 	roles := utils.GetUserFormattedRolesMap(ctx, stores, user, workspacePolicy.Policy)
 	return roles[common.FormatRole(common.WorkspaceAdmin)], nil
 }
+
+// clearOTPConfig returns the MFA config without the OTP secret and recovery codes.
+// The passkeys are kept since they are managed separately.
+func clearOTPConfig(mfaConfig *storepb.MFAConfig) *storepb.MFAConfig {
+	mfaConfig = cloneMFAConfig(mfaConfig)
+	mfaConfig.OtpSecret = ""
+	mfaConfig.RecoveryCodes = nil
+	mfaConfig.TempOtpSecret = ""
+	mfaConfig.TempRecoveryCodes = nil
+	mfaConfig.TempOtpSecretCreatedTime = nil
+	return mfaConfig
+}
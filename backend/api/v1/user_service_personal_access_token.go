@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// CreatePersonalAccessToken creates a personal access token for the caller.
+func (s *UserService) CreatePersonalAccessToken(ctx context.Context, request *connect.Request[v1pb.CreatePersonalAccessTokenRequest]) (*connect.Response[v1pb.PersonalAccessToken], error) {
+	callerUser, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	// Minting tokens with a token could escape the scope and the expiration of the token.
+	if _, ok := common.GetPersonalAccessTokenScopeFromContext(ctx); ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot create personal access token with a personal access token"))
+	}
+	userID, err := common.GetUserID(request.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if callerUser.ID != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("personal access tokens can only be created for yourself"))
+	}
+	if callerUser.Type != storepb.PrincipalType_END_USER {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("personal access tokens can only be created for end users"))
+	}
+
+	token := request.Msg.PersonalAccessToken
+	if token == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("personal_access_token must be set"))
+	}
+	if token.Title == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("title must be set"))
+	}
+	for _, permission := range token.Permissions {
+		if !iam.PermissionExist(permission) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid permission %q", permission))
+		}
+	}
+	var expiresAt *time.Time
+	if token.ExpireTime != nil {
+		if err := token.ExpireTime.CheckValid(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid expire_time"))
+		}
+		t := token.ExpireTime.AsTime()
+		if !t.After(time.Now()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("expire_time must be in the future"))
+		}
+		expiresAt = &t
+	}
+
+	tokenStr, tokenHash, err := auth.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	created, err := s.store.CreatePersonalAccessToken(ctx, &store.PersonalAccessTokenMessage{
+		PrincipalUID: callerUser.ID,
+		Title:        token.Title,
+		TokenHash:    tokenHash,
+		ExpiresAt:    expiresAt,
+		Payload: &storepb.PersonalAccessTokenPayload{
+			Permissions: token.Permissions,
+		},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create personal access token"))
+	}
+
+	response := convertToPersonalAccessToken(created)
+	// The token is only returned on creation.
+	response.Token = tokenStr
+	return connect.NewResponse(response), nil
+}
+
+// ListPersonalAccessTokens lists the personal access tokens of a user.
+func (s *UserService) ListPersonalAccessTokens(ctx context.Context, request *connect.Request[v1pb.ListPersonalAccessTokensRequest]) (*connect.Response[v1pb.ListPersonalAccessTokensResponse], error) {
+	userID, err := common.GetUserID(request.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.checkPersonalAccessTokenPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.store.ListPersonalAccessTokens(ctx, &store.FindPersonalAccessTokenMessage{PrincipalUID: &userID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list personal access tokens"))
+	}
+	response := &v1pb.ListPersonalAccessTokensResponse{}
+	for _, token := range tokens {
+		response.PersonalAccessTokens = append(response.PersonalAccessTokens, convertToPersonalAccessToken(token))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// RevokePersonalAccessToken revokes a personal access token.
+func (s *UserService) RevokePersonalAccessToken(ctx context.Context, request *connect.Request[v1pb.RevokePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	userID, tokenUID, err := common.GetUserIDPersonalAccessTokenUID(request.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.checkPersonalAccessTokenPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	token, err := s.store.GetPersonalAccessToken(ctx, &store.FindPersonalAccessTokenMessage{UID: &tokenUID, PrincipalUID: &userID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get personal access token"))
+	}
+	if token == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("personal access token %q not found", request.Msg.Name))
+	}
+	if err := s.store.DeletePersonalAccessToken(ctx, token.UID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to revoke personal access token"))
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// checkPersonalAccessTokenPermission checks if the caller can manage the personal access tokens of the user.
+// Users can manage their own tokens, and the users with bb.users.update can manage the tokens of others.
+func (s *UserService) checkPersonalAccessTokenPermission(ctx context.Context, userID int) error {
+	callerUser, ok := GetUserFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	if callerUser.ID == userID {
+		return nil
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+	}
+	if !ok {
+		return connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", iam.PermissionUsersUpdate))
+	}
+	return nil
+}
+
+func convertToPersonalAccessToken(token *store.PersonalAccessTokenMessage) *v1pb.PersonalAccessToken {
+	v := &v1pb.PersonalAccessToken{
+		Name:        common.FormatPersonalAccessToken(token.PrincipalUID, token.UID),
+		Title:       token.Title,
+		Permissions: token.Payload.GetPermissions(),
+		CreateTime:  timestamppb.New(token.CreatedAt),
+	}
+	if token.ExpiresAt != nil {
+		v.ExpireTime = timestamppb.New(*token.ExpiresAt)
+	}
+	if token.LastUsedAt != nil {
+		v.LastUsedTime = timestamppb.New(*token.LastUsedAt)
+	}
+	return v
+}
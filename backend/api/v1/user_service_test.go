@@ -1 +1,63 @@
 package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestClearOTPConfig(t *testing.T) {
+	a := require.New(t)
+	mfaConfig := &storepb.MFAConfig{
+		OtpSecret:                "secret",
+		RecoveryCodes:            []string{"code"},
+		TempOtpSecret:            "temp-secret",
+		TempRecoveryCodes:        []string{"temp-code"},
+		TempOtpSecretCreatedTime: timestamppb.Now(),
+		WebauthnCredentials:      []*storepb.WebAuthnCredential{{Id: []byte("id"), Title: "key"}},
+		WebauthnChallenges:       []*storepb.WebAuthnChallenge{{Challenge: []byte("challenge")}},
+	}
+	got := clearOTPConfig(mfaConfig)
+	a.Empty(got.OtpSecret)
+	a.Empty(got.RecoveryCodes)
+	a.Empty(got.TempOtpSecret)
+	a.Empty(got.TempRecoveryCodes)
+	a.Nil(got.TempOtpSecretCreatedTime)
+	a.Len(got.WebauthnCredentials, 1)
+	a.Equal("key", got.WebauthnCredentials[0].Title)
+	a.Len(got.WebauthnChallenges, 1)
+	// The original config is not modified.
+	a.Equal("secret", mfaConfig.OtpSecret)
+}
+
+func TestTakeWebAuthnChallenge(t *testing.T) {
+	a := require.New(t)
+	session, tempToken := getWebAuthnChallengeBinding("session"), getWebAuthnChallengeBinding("temp-token")
+	mfaConfig := &storepb.MFAConfig{
+		WebauthnChallenges: []*storepb.WebAuthnChallenge{
+			{Ceremony: storepb.WebAuthnChallenge_REGISTRATION, Binding: session, Challenge: []byte("registration"), CreateTime: timestamppb.Now()},
+			{Ceremony: storepb.WebAuthnChallenge_LOGIN, Binding: tempToken, Challenge: []byte("login"), CreateTime: timestamppb.Now()},
+			{Ceremony: storepb.WebAuthnChallenge_LOGIN, Binding: session, Challenge: []byte("expired"), CreateTime: timestamppb.New(time.Now().Add(-2 * webAuthnChallengeExpiration))},
+		},
+	}
+
+	// The challenge of another ceremony or another token cannot be used.
+	a.Nil(takeWebAuthnChallenge(mfaConfig, storepb.WebAuthnChallenge_LOGIN, getWebAuthnChallengeBinding("other")))
+	a.Nil(takeWebAuthnChallenge(mfaConfig, storepb.WebAuthnChallenge_LOGIN, session))
+	// The expired challenge is removed.
+	a.Len(mfaConfig.WebauthnChallenges, 2)
+
+	// The login doesn't consume the pending registration.
+	got := takeWebAuthnChallenge(mfaConfig, storepb.WebAuthnChallenge_LOGIN, tempToken)
+	a.NotNil(got)
+	a.Equal([]byte("login"), got.Challenge)
+	a.Len(mfaConfig.WebauthnChallenges, 1)
+	a.Equal([]byte("registration"), mfaConfig.WebauthnChallenges[0].Challenge)
+
+	// The challenge is single-use.
+	a.Nil(takeWebAuthnChallenge(mfaConfig, storepb.WebAuthnChallenge_LOGIN, tempToken))
+}
@@ -0,0 +1,379 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/webauthn"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// webAuthnChallengeExpiration is the duration after which the WebAuthn challenges expire.
+	webAuthnChallengeExpiration = 5 * time.Minute
+	// maxPendingWebAuthnChallenges is the maximum number of pending WebAuthn challenges of a user.
+	maxPendingWebAuthnChallenges = 16
+	// maxMFAConfigUpdateAttempts is the maximum number of attempts to update the MFA config on concurrent changes.
+	maxMFAConfigUpdateAttempts = 5
+)
+
+// BeginWebAuthnRegistration starts registering a WebAuthn credential for the caller.
+func (s *UserService) BeginWebAuthnRegistration(ctx context.Context, request *connect.Request[v1pb.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1pb.BeginWebAuthnRegistrationResponse], error) {
+	user, err := s.getWebAuthnRegistrationUser(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := getWebAuthnRelyingParty(ctx, s.store, s.profile)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	options, err := rp.CreationOptions(challenge, &webauthn.User{
+		ID:          []byte(strconv.Itoa(user.ID)),
+		Name:        user.Email,
+		DisplayName: user.Name,
+	}, convertToWebAuthnCredentials(user.MFAConfig.GetWebauthnCredentials()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to build WebAuthn options"))
+	}
+	binding, err := getWebAuthnRegistrationBinding(request.Header())
+	if err != nil {
+		return nil, err
+	}
+	if err := setWebAuthnChallenge(ctx, s.store, user, storepb.WebAuthnChallenge_REGISTRATION, binding, challenge); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.BeginWebAuthnRegistrationResponse{
+		Options: string(options),
+	}), nil
+}
+
+// CreateWebAuthnCredential finishes registering a WebAuthn credential for the caller.
+func (s *UserService) CreateWebAuthnCredential(ctx context.Context, request *connect.Request[v1pb.CreateWebAuthnCredentialRequest]) (*connect.Response[v1pb.WebAuthnCredential], error) {
+	user, err := s.getWebAuthnRegistrationUser(ctx, request.Msg.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.Msg.Title == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("title must be set"))
+	}
+	binding, err := getWebAuthnRegistrationBinding(request.Header())
+	if err != nil {
+		return nil, err
+	}
+	rp, err := getWebAuthnRelyingParty(ctx, s.store, s.profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *storepb.WebAuthnCredential
+	var verifyErr error
+	// The challenge is single-use, so it is removed whether the verification succeeds or not.
+	if err := updateMFAConfig(ctx, s.store, user, func(mfaConfig *storepb.MFAConfig) error {
+		created, verifyErr = nil, nil
+		challenge := takeWebAuthnChallenge(mfaConfig, storepb.WebAuthnChallenge_REGISTRATION, binding)
+		if challenge == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("WebAuthn registration has expired, please start again"))
+		}
+		credential, err := rp.VerifyRegistration(challenge.Challenge, []byte(request.Msg.RegistrationResponse))
+		if err != nil {
+			verifyErr = err
+			return nil
+		}
+		for _, c := range mfaConfig.WebauthnCredentials {
+			if bytes.Equal(c.Id, credential.ID) {
+				verifyErr = errors.New("the credential is already registered")
+				return nil
+			}
+		}
+		created = &storepb.WebAuthnCredential{
+			Id:         credential.ID,
+			Title:      request.Msg.Title,
+			PublicKey:  credential.PublicKey,
+			SignCount:  credential.SignCount,
+			CreateTime: timestamppb.Now(),
+		}
+		mfaConfig.WebauthnCredentials = append(mfaConfig.WebauthnCredentials, created)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if verifyErr != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(verifyErr, "failed to verify WebAuthn registration"))
+	}
+	return connect.NewResponse(convertToV1WebAuthnCredential(user.ID, created)), nil
+}
+
+// ListWebAuthnCredentials lists the WebAuthn credentials of a user.
+func (s *UserService) ListWebAuthnCredentials(ctx context.Context, request *connect.Request[v1pb.ListWebAuthnCredentialsRequest]) (*connect.Response[v1pb.ListWebAuthnCredentialsResponse], error) {
+	userID, err := common.GetUserID(request.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	user, err := s.getWebAuthnCredentialOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.ListWebAuthnCredentialsResponse{}
+	for _, credential := range user.MFAConfig.GetWebauthnCredentials() {
+		response.WebauthnCredentials = append(response.WebauthnCredentials, convertToV1WebAuthnCredential(user.ID, credential))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// DeleteWebAuthnCredential deletes a WebAuthn credential.
+func (s *UserService) DeleteWebAuthnCredential(ctx context.Context, request *connect.Request[v1pb.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error) {
+	userID, credentialID, err := common.GetUserIDWebAuthnCredentialID(request.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	id, err := base64.RawURLEncoding.DecodeString(credentialID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid credential ID %q", credentialID))
+	}
+	user, err := s.getWebAuthnCredentialOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaConfig := cloneMFAConfig(user.MFAConfig)
+	index := slices.IndexFunc(mfaConfig.WebauthnCredentials, func(c *storepb.WebAuthnCredential) bool {
+		return bytes.Equal(c.Id, id)
+	})
+	if index < 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("WebAuthn credential %q not found", request.Msg.Name))
+	}
+	mfaConfig.WebauthnCredentials = slices.Delete(mfaConfig.WebauthnCredentials, index, index+1)
+
+	if mfaConfig.OtpSecret == "" && len(mfaConfig.WebauthnCredentials) == 0 {
+		setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to find workspace setting, error: %v", err))
+		}
+		if setting.Require_2Fa {
+			callerUser, ok := GetUserFromContext(ctx)
+			if !ok {
+				return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+			}
+			isWorkspaceAdmin, err := isUserWorkspaceAdmin(ctx, s.store, callerUser)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check user roles, error: %v", err))
+			}
+			// Allow workspace admin to remove the last second factor even if 2FA is required.
+			if !isWorkspaceAdmin {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("2FA is required and the last second factor cannot be deleted"))
+			}
+		}
+	}
+
+	if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{MFAConfig: mfaConfig}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to update user"))
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// getWebAuthnRegistrationUser returns the caller if the caller registers the credential for themselves.
+func (*UserService) getWebAuthnRegistrationUser(ctx context.Context, name string) (*store.UserMessage, error) {
+	callerUser, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	// The second factor must be registered in an interactive session.
+	if _, ok := common.GetPersonalAccessTokenScopeFromContext(ctx); ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot register WebAuthn credential with a personal access token"))
+	}
+	userID, err := common.GetUserID(name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if callerUser.ID != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("WebAuthn credentials can only be registered for yourself"))
+	}
+	if callerUser.Type != storepb.PrincipalType_END_USER {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("WebAuthn credentials can only be registered for end users"))
+	}
+	return callerUser, nil
+}
+
+// getWebAuthnCredentialOwner returns the user if the caller can manage the WebAuthn credentials of the user.
+// Users can manage their own credentials, and the users with bb.users.update can manage the credentials of others.
+func (s *UserService) getWebAuthnCredentialOwner(ctx context.Context, userID int) (*store.UserMessage, error) {
+	callerUser, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("failed to get caller user"))
+	}
+	if callerUser.ID != userID {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+		}
+		if !ok {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", iam.PermissionUsersUpdate))
+		}
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get user, error: %v", err))
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("user %d not found", userID))
+	}
+	return user, nil
+}
+
+// getWebAuthnRelyingParty returns the relying party for the console URL.
+// The external URL is required since the request headers such as Origin are controlled by the client.
+func getWebAuthnRelyingParty(ctx context.Context, stores *store.Store, profile *config.Profile) (*webauthn.RelyingParty, error) {
+	setting, err := stores.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get workspace setting"))
+	}
+	consoleURL := common.GetEffectiveExternalURL(profile.ExternalURL, setting.ExternalUrl)
+	if consoleURL == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("external URL is required for WebAuthn"))
+	}
+	rp, err := webauthn.NewRelyingParty(issuerName, consoleURL)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return rp, nil
+}
+
+// setWebAuthnChallenge stores the challenge of the pending ceremony, which replaces the previous challenge
+// of the same ceremony and binding. The challenges of the other ceremonies and bindings are kept.
+func setWebAuthnChallenge(ctx context.Context, stores *store.Store, user *store.UserMessage, ceremony storepb.WebAuthnChallenge_Ceremony, binding string, challenge []byte) error {
+	return updateMFAConfig(ctx, stores, user, func(mfaConfig *storepb.MFAConfig) error {
+		mfaConfig.WebauthnChallenges = slices.DeleteFunc(mfaConfig.WebauthnChallenges, func(c *storepb.WebAuthnChallenge) bool {
+			return isWebAuthnChallengeExpired(c) || (c.Ceremony == ceremony && c.Binding == binding)
+		})
+		// Drop the oldest challenges, so that a user cannot grow the MFA config without bound.
+		if n := len(mfaConfig.WebauthnChallenges) - maxPendingWebAuthnChallenges + 1; n > 0 {
+			mfaConfig.WebauthnChallenges = mfaConfig.WebauthnChallenges[n:]
+		}
+		mfaConfig.WebauthnChallenges = append(mfaConfig.WebauthnChallenges, &storepb.WebAuthnChallenge{
+			Ceremony:   ceremony,
+			Binding:    binding,
+			Challenge:  challenge,
+			CreateTime: timestamppb.Now(),
+		})
+		return nil
+	})
+}
+
+// takeWebAuthnChallenge removes the challenge of the ceremony and binding from the MFA config, together with
+// the expired challenges. It returns nil if there is no pending challenge.
+func takeWebAuthnChallenge(mfaConfig *storepb.MFAConfig, ceremony storepb.WebAuthnChallenge_Ceremony, binding string) *storepb.WebAuthnChallenge {
+	var challenge *storepb.WebAuthnChallenge
+	mfaConfig.WebauthnChallenges = slices.DeleteFunc(mfaConfig.WebauthnChallenges, func(c *storepb.WebAuthnChallenge) bool {
+		if isWebAuthnChallengeExpired(c) {
+			return true
+		}
+		if c.Ceremony == ceremony && c.Binding == binding {
+			challenge = c
+			return true
+		}
+		return false
+	})
+	return challenge
+}
+
+func isWebAuthnChallengeExpired(challenge *storepb.WebAuthnChallenge) bool {
+	if challenge.GetCreateTime() == nil {
+		return true
+	}
+	return time.Since(challenge.CreateTime.AsTime()) > webAuthnChallengeExpiration
+}
+
+// getWebAuthnChallengeBinding returns the binding of the challenge to the token starting the ceremony.
+func getWebAuthnChallengeBinding(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// getWebAuthnRegistrationBinding binds the registration to the session of the caller.
+func getWebAuthnRegistrationBinding(header http.Header) (string, error) {
+	token, err := auth.GetTokenFromHeaders(header)
+	if err != nil || token == "" {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.Errorf("failed to get the session of the WebAuthn registration"))
+	}
+	return getWebAuthnChallengeBinding(token), nil
+}
+
+// updateMFAConfig applies the change to the MFA config of the user with a conditional update.
+// The change is applied again to the latest MFA config if the MFA config was changed concurrently,
+// e.g. by the ceremonies of the other sessions of the user.
+func updateMFAConfig(ctx context.Context, stores *store.Store, user *store.UserMessage, change func(mfaConfig *storepb.MFAConfig) error) error {
+	for attempt := 1; ; attempt++ {
+		mfaConfig := cloneMFAConfig(user.MFAConfig)
+		if err := change(mfaConfig); err != nil {
+			return err
+		}
+		updated, err := stores.UpdateUserMFAConfigIfUnchanged(ctx, user, mfaConfig)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to update user"))
+		}
+		if updated {
+			return nil
+		}
+		if attempt >= maxMFAConfigUpdateAttempts {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("the MFA config is changed concurrently, please try again"))
+		}
+		user, err = stores.GetUserByID(ctx, user.ID)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get user"))
+		}
+		if user == nil {
+			return connect.NewError(connect.CodeNotFound, errors.Errorf("user not found"))
+		}
+	}
+}
+
+func cloneMFAConfig(mfaConfig *storepb.MFAConfig) *storepb.MFAConfig {
+	if mfaConfig == nil {
+		return &storepb.MFAConfig{}
+	}
+	return proto.CloneOf(mfaConfig)
+}
+
+func convertToWebAuthnCredentials(credentials []*storepb.WebAuthnCredential) []*webauthn.Credential {
+	var result []*webauthn.Credential
+	for _, c := range credentials {
+		result = append(result, &webauthn.Credential{
+			ID:        c.Id,
+			PublicKey: c.PublicKey,
+			SignCount: c.SignCount,
+		})
+	}
+	return result
+}
+
+func convertToV1WebAuthnCredential(userID int, credential *storepb.WebAuthnCredential) *v1pb.WebAuthnCredential {
+	return &v1pb.WebAuthnCredential{
+		Name:         common.FormatWebAuthnCredential(userID, base64.RawURLEncoding.EncodeToString(credential.Id)),
+		Title:        credential.Title,
+		CreateTime:   credential.CreateTime,
+		LastUsedTime: credential.LastUsedTime,
+	}
+}
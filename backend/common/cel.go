@@ -30,6 +30,9 @@ var ApprovalFactors = []cel.EnvOption{
 	cel.Variable(CELAttributeStatementTableRows, cel.IntType),
 	cel.Variable(CELAttributeStatementSQLType, cel.StringType),
 	cel.Variable(CELAttributeStatementText, cel.StringType),
+	cel.Variable(CELAttributeStatementLockLevel, cel.StringType),
+	cel.Variable(CELAttributeStatementLockBlockingSeconds, cel.IntType),
+	cel.Variable(CELAttributeStatementRewrittenTableRows, cel.IntType),
 	// Request scope
 	cel.Variable(CELAttributeRequestExpirationDays, cel.IntType),
 	cel.Variable(CELAttributeRequestRole, cel.StringType),
@@ -71,6 +74,14 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// RowAccessPolicyCELAttributes are the variables when evaluating whether a row access rule applies to the user.
+var RowAccessPolicyCELAttributes = []cel.EnvOption{
+	cel.Variable(CELAttributeUserEmail, cel.StringType),
+	cel.Variable(CELAttributeUserGroups, cel.ListType(cel.StringType)),
+	cel.Variable(CELAttributeRequestTime, cel.TimestampType),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // DatabaseGroupCELAttributes are the variables when evaluating database group conditions.
 var DatabaseGroupCELAttributes = []cel.EnvOption{
 	cel.Variable(CELAttributeResourceEnvironmentID, cel.StringType),
@@ -142,6 +153,30 @@ func ValidateMaskingExceptionCELExpr(expression *expr.Expr) (cel.Program, error)
 	return validateCELExpr(expression, MaskingExceptionPolicyCELAttributes)
 }
 
+// ValidateRowAccessCELExpr validates row access rule expr, which must evaluate to a bool.
+func ValidateRowAccessCELExpr(expression *expr.Expr) (cel.Program, error) {
+	if expression == nil || expression.Expression == "" {
+		return nil, nil
+	}
+	e, err := cel.NewEnv(RowAccessPolicyCELAttributes...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	ast, issues := e.Compile(expression.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, issues.Err())
+	}
+	// A dyn result is checked when the rule is evaluated.
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("expect bool result, got %s", outputType))
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return prog, nil
+}
+
 func ValidateProjectMemberCELExpr(expression *expr.Expr) (cel.Program, error) {
 	return validateCELExpr(expression, IAMPolicyConditionCELAttributes)
 }
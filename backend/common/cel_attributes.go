@@ -37,6 +37,12 @@ const (
 	CELAttributeStatementSQLType = "statement.sql_type"
 	// CELAttributeStatementText is the full text of the SQL statement.
 	CELAttributeStatementText = "statement.text"
+	// CELAttributeStatementLockLevel is the strongest lock level taken by the DDL statements (e.g., ACCESS_EXCLUSIVE, METADATA_LOCK).
+	CELAttributeStatementLockLevel = "statement.lock_level"
+	// CELAttributeStatementLockBlockingSeconds is the longest estimated duration in seconds that the DDL statements block the writes.
+	CELAttributeStatementLockBlockingSeconds = "statement.lock_blocking_seconds"
+	// CELAttributeStatementRewrittenTableRows is the total number of rows in the tables rewritten by the DDL statements.
+	CELAttributeStatementRewrittenTableRows = "statement.rewritten_table_rows"
 )
 
 // CEL attribute names for request scope.
@@ -49,6 +55,14 @@ const (
 	CELAttributeRequestTime = "request.time"
 )
 
+// CEL attribute names for user scope.
+const (
+	// CELAttributeUserEmail is the email of the user.
+	CELAttributeUserEmail = "user.email"
+	// CELAttributeUserGroups is the emails of the groups that the user belongs to.
+	CELAttributeUserGroups = "user.groups"
+)
+
 // CEL attribute names for approval scope (deprecated, kept for backward compatibility).
 const (
 	// CELAttributeLevel is the risk level (deprecated).
@@ -5,6 +5,7 @@ const (
 	WorkspaceAdmin  = "workspaceAdmin"
 	WorkspaceMember = "workspaceMember"
 	ProjectOwner    = "projectOwner"
+	SQLEditorUser   = "sqlEditorUser"
 )
 
 const (
@@ -15,6 +15,10 @@ const (
 	UserContextKey ContextKey = iota
 	AuthContextKey
 	ServiceDataKey
+	// PersonalAccessTokenScopeKey is the key name used to store the scope of the personal access token authenticating the request.
+	PersonalAccessTokenScopeKey
+	// BreakGlassAccessKey is the key name used to store the break-glass access resolved during the request.
+	BreakGlassAccessKey
 )
 
 func WithSetServiceData(ctx context.Context, setServiceData func(a *anypb.Any)) context.Context {
@@ -26,6 +30,30 @@ func GetSetServiceDataFromContext(ctx context.Context) (func(a *anypb.Any), bool
 	return setServiceData, ok
 }
 
+// PersonalAccessTokenScope is the scope of the personal access token authenticating the request.
+type PersonalAccessTokenScope struct {
+	// Permissions restricts the request to a subset of the user's permissions, empty means no restriction.
+	Permissions map[string]bool
+}
+
+// Allows returns true if the token scope allows the permission.
+func (s *PersonalAccessTokenScope) Allows(permission string) bool {
+	if s == nil || len(s.Permissions) == 0 {
+		return true
+	}
+	return s.Permissions[permission]
+}
+
+func WithPersonalAccessTokenScope(ctx context.Context, scope *PersonalAccessTokenScope) context.Context {
+	return context.WithValue(ctx, PersonalAccessTokenScopeKey, scope)
+}
+
+// GetPersonalAccessTokenScopeFromContext returns the token scope if the request is authenticated by a personal access token.
+func GetPersonalAccessTokenScopeFromContext(ctx context.Context) (*PersonalAccessTokenScope, bool) {
+	scope, ok := ctx.Value(PersonalAccessTokenScopeKey).(*PersonalAccessTokenScope)
+	return scope, ok
+}
+
 type AuthMethod int
 
 const (
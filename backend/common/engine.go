@@ -236,6 +236,82 @@ func EngineSupportStatementReport(e storepb.Engine) bool {
 	}
 }
 
+func EngineSupportLockImpact(e storepb.Engine) bool {
+	//exhaustive:enforce
+	switch e {
+	case
+		storepb.Engine_POSTGRES,
+		storepb.Engine_MYSQL:
+		return true
+	case
+		storepb.Engine_ENGINE_UNSPECIFIED,
+		storepb.Engine_TIDB,
+		storepb.Engine_OCEANBASE,
+		storepb.Engine_ORACLE,
+		storepb.Engine_MSSQL,
+		storepb.Engine_MARIADB,
+		storepb.Engine_REDSHIFT,
+		storepb.Engine_SNOWFLAKE,
+		storepb.Engine_CASSANDRA,
+		storepb.Engine_SQLITE,
+		storepb.Engine_MONGODB,
+		storepb.Engine_REDIS,
+		storepb.Engine_CLICKHOUSE,
+		storepb.Engine_SPANNER,
+		storepb.Engine_BIGQUERY,
+		storepb.Engine_STARROCKS,
+		storepb.Engine_HIVE,
+		storepb.Engine_COCKROACHDB,
+		storepb.Engine_DORIS,
+		storepb.Engine_DYNAMODB,
+		storepb.Engine_ELASTICSEARCH,
+		storepb.Engine_DATABRICKS,
+		storepb.Engine_COSMOSDB,
+		storepb.Engine_TRINO:
+		return false
+	default:
+		return false
+	}
+}
+
+func EngineSupportExplain(e storepb.Engine) bool {
+	//exhaustive:enforce
+	switch e {
+	case
+		storepb.Engine_POSTGRES,
+		storepb.Engine_MYSQL:
+		return true
+	case
+		storepb.Engine_ENGINE_UNSPECIFIED,
+		storepb.Engine_TIDB,
+		storepb.Engine_OCEANBASE,
+		storepb.Engine_ORACLE,
+		storepb.Engine_MSSQL,
+		storepb.Engine_MARIADB,
+		storepb.Engine_REDSHIFT,
+		storepb.Engine_SNOWFLAKE,
+		storepb.Engine_CASSANDRA,
+		storepb.Engine_SQLITE,
+		storepb.Engine_MONGODB,
+		storepb.Engine_REDIS,
+		storepb.Engine_CLICKHOUSE,
+		storepb.Engine_SPANNER,
+		storepb.Engine_BIGQUERY,
+		storepb.Engine_STARROCKS,
+		storepb.Engine_HIVE,
+		storepb.Engine_COCKROACHDB,
+		storepb.Engine_DORIS,
+		storepb.Engine_DYNAMODB,
+		storepb.Engine_ELASTICSEARCH,
+		storepb.Engine_DATABRICKS,
+		storepb.Engine_COSMOSDB,
+		storepb.Engine_TRINO:
+		return false
+	default:
+		return false
+	}
+}
+
 func EngineSupportPriorBackup(e storepb.Engine) bool {
 	//exhaustive:enforce
 	switch e {
@@ -244,11 +320,11 @@ func EngineSupportPriorBackup(e storepb.Engine) bool {
 		storepb.Engine_TIDB,
 		storepb.Engine_MSSQL,
 		storepb.Engine_ORACLE,
-		storepb.Engine_POSTGRES:
+		storepb.Engine_POSTGRES,
+		storepb.Engine_SNOWFLAKE:
 		return true
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
-		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_CASSANDRA,
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
@@ -398,11 +474,11 @@ func BackupDatabaseNameOfEngine(e storepb.Engine) string {
 		storepb.Engine_POSTGRES:
 		return "bbdataarchive"
 	case
-		storepb.Engine_ORACLE:
+		storepb.Engine_ORACLE,
+		storepb.Engine_SNOWFLAKE:
 		return "BBDATAARCHIVE"
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
-		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_CASSANDRA,
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
@@ -486,6 +562,12 @@ func ConvertToParserEngine(e storepb.Engine) (storepb.Engine, error) {
 		return storepb.Engine_MSSQL, nil
 	case storepb.Engine_COCKROACHDB:
 		return storepb.Engine_COCKROACHDB, nil
+	case storepb.Engine_SNOWFLAKE:
+		return storepb.Engine_SNOWFLAKE, nil
+	case storepb.Engine_REDSHIFT:
+		return storepb.Engine_REDSHIFT, nil
+	case storepb.Engine_CLICKHOUSE:
+		return storepb.Engine_CLICKHOUSE, nil
 	default:
 		return storepb.Engine_ENGINE_UNSPECIFIED, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid engine type %v", e))
 	}
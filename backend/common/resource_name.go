@@ -32,6 +32,7 @@ const (
 	PlanCheckRunPrefix         = "planCheckRuns/"
 	RolePrefix                 = "roles/"
 	WebhookIDPrefix            = "webhooks/"
+	WebhookDeliveryPrefix      = "deliveries/"
 	SheetIDPrefix              = "sheets/"
 	WorksheetIDPrefix          = "worksheets/"
 	DatabaseGroupNamePrefix    = "databaseGroups/"
@@ -50,11 +51,14 @@ const (
 	ReleaseNamePrefix          = "releases/"
 	FileNamePrefix             = "files/"
 	RevisionNamePrefix         = "revisions/"
+	PersonalAccessTokenPrefix  = "personalAccessTokens/"
+	WebAuthnCredentialPrefix   = "webAuthnCredentials/"
 
-	SchemaSuffix    = "/schema"
-	SDLSchemaSuffix = "/sdlSchema"
-	MetadataSuffix  = "/metadata"
-	CatalogSuffix   = "/catalog"
+	SchemaSuffix      = "/schema"
+	SDLSchemaSuffix   = "/sdlSchema"
+	MetadataSuffix    = "/metadata"
+	CatalogSuffix     = "/catalog"
+	SchemaDriftSuffix = "/schemaDrift"
 
 	UserBindingPrefix  = "user:"
 	GroupBindingPrefix = "group:"
@@ -182,6 +186,36 @@ func GetUserID(name string) (int, error) {
 	return GetUIDFromName(name, UserNamePrefix)
 }
 
+// GetUserIDPersonalAccessTokenUID returns the user ID and personal access token UID from a resource name.
+func GetUserIDPersonalAccessTokenUID(name string) (int, int, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, PersonalAccessTokenPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	tokenUID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid personal access token ID %q", tokens[1])
+	}
+	return userID, tokenUID, nil
+}
+
+// GetUserIDWebAuthnCredentialID returns the user ID and the base64url encoded WebAuthn credential ID from a resource name.
+func GetUserIDWebAuthnCredentialID(name string) (int, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, WebAuthnCredentialPrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	return userID, tokens[1], nil
+}
+
 // GetUserEmail returns the user email from a resource name.
 func GetUserEmail(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -524,6 +558,14 @@ func FormatUserUID(uid int) string {
 	return fmt.Sprintf("%s%d", UserNamePrefix, uid)
 }
 
+func FormatPersonalAccessToken(userUID, tokenUID int) string {
+	return fmt.Sprintf("%s%d/%s%d", UserNamePrefix, userUID, PersonalAccessTokenPrefix, tokenUID)
+}
+
+func FormatWebAuthnCredential(userUID int, credentialID string) string {
+	return fmt.Sprintf("%s%d/%s%s", UserNamePrefix, userUID, WebAuthnCredentialPrefix, credentialID)
+}
+
 func FormatGroupEmail(email string) string {
 	return fmt.Sprintf("%s%s", GroupPrefix, email)
 }
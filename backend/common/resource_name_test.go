@@ -32,3 +32,20 @@ func TestGetSchemaTableName(t *testing.T) {
 	require.Equal(t, "", schema)
 	require.Equal(t, "b", table)
 }
+
+func TestGetUserIDPersonalAccessTokenUID(t *testing.T) {
+	userID, tokenUID, err := GetUserIDPersonalAccessTokenUID(FormatPersonalAccessToken(101, 102))
+	require.NoError(t, err)
+	require.Equal(t, 101, userID)
+	require.Equal(t, 102, tokenUID)
+
+	_, _, err = GetUserIDPersonalAccessTokenUID("users/a@b.com/personalAccessTokens/102")
+	require.Error(t, err)
+}
+
+func TestGetUserIDWebAuthnCredentialID(t *testing.T) {
+	userID, credentialID, err := GetUserIDWebAuthnCredentialID(FormatWebAuthnCredential(101, "Y3JlZGVudGlhbC1pZA"))
+	require.NoError(t, err)
+	require.Equal(t, 101, userID)
+	require.Equal(t, "Y3JlZGVudGlhbC1pZA", credentialID)
+}
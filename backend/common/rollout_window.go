@@ -0,0 +1,146 @@
+//nolint:revive
+package common
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// maxRolloutWindowSearchDays is the number of days to search for the next open rollout window.
+const maxRolloutWindowSearchDays = 400
+
+type rolloutWindow struct {
+	location      *time.Location
+	timeRanges    []rolloutTimeRange
+	blackoutDates map[string]bool
+}
+
+type rolloutTimeRange struct {
+	// daysOfWeek is nil if the range applies to every day.
+	daysOfWeek map[time.Weekday]bool
+	// start and end are the offsets from midnight.
+	start time.Duration
+	end   time.Duration
+}
+
+// ValidateRolloutWindow validates the rollout window.
+func ValidateRolloutWindow(window *storepb.RolloutPolicy_Window) error {
+	_, err := parseRolloutWindow(window)
+	return err
+}
+
+// GetNextRolloutWindowOpenTime returns the earliest time not before t at which the rollout window is open.
+// It returns t if the window is nil or open at t.
+func GetNextRolloutWindowOpenTime(window *storepb.RolloutPolicy_Window, t time.Time) (time.Time, error) {
+	if window == nil {
+		return t, nil
+	}
+	w, err := parseRolloutWindow(window)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if w.isOpen(t) {
+		return t, nil
+	}
+
+	// The window can only open at midnight, when a blackout date ends, or at the start of a time range.
+	local := t.In(w.location)
+	for i := range maxRolloutWindowSearchDays {
+		year, month, day := local.Date()
+		candidates := []time.Time{time.Date(year, month, day+i, 0, 0, 0, 0, w.location)}
+		for _, r := range w.timeRanges {
+			candidates = append(candidates, time.Date(year, month, day+i, int(r.start/time.Hour), int(r.start%time.Hour/time.Minute), 0, 0, w.location))
+		}
+		slices.SortFunc(candidates, func(a, b time.Time) int {
+			return a.Compare(b)
+		})
+		for _, candidate := range candidates {
+			if candidate.After(t) && w.isOpen(candidate) {
+				return candidate, nil
+			}
+		}
+	}
+	return time.Time{}, errors.Errorf("rollout window does not open in the next %d days", maxRolloutWindowSearchDays)
+}
+
+func parseRolloutWindow(window *storepb.RolloutPolicy_Window) (*rolloutWindow, error) {
+	location, err := time.LoadLocation(window.GetTimeZone())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid time zone %q", window.GetTimeZone())
+	}
+	w := &rolloutWindow{
+		location:      location,
+		blackoutDates: map[string]bool{},
+	}
+	for _, timeRange := range window.GetTimeRanges() {
+		r := rolloutTimeRange{}
+		for _, day := range timeRange.GetDaysOfWeek() {
+			if day < int32(time.Sunday) || day > int32(time.Saturday) {
+				return nil, errors.Errorf("invalid day of week %d, must be between 0 and 6", day)
+			}
+			if r.daysOfWeek == nil {
+				r.daysOfWeek = map[time.Weekday]bool{}
+			}
+			r.daysOfWeek[time.Weekday(day)] = true
+		}
+		if r.start, err = parseTimeOfDay(timeRange.GetStartTime()); err != nil {
+			return nil, err
+		}
+		if r.end, err = parseTimeOfDay(timeRange.GetEndTime()); err != nil {
+			return nil, err
+		}
+		w.timeRanges = append(w.timeRanges, r)
+	}
+	for _, date := range window.GetBlackoutDates() {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, errors.Errorf("invalid blackout date %q, must be in the format of YYYY-MM-DD", date)
+		}
+		w.blackoutDates[date] = true
+	}
+	return w, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.Errorf("invalid time %q, must be in the format of HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w *rolloutWindow) isOpen(t time.Time) bool {
+	local := t.In(w.location)
+	if w.blackoutDates[local.Format(time.DateOnly)] {
+		return false
+	}
+	if len(w.timeRanges) == 0 {
+		return true
+	}
+	offset := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute + time.Duration(local.Second())*time.Second
+	weekday := local.Weekday()
+	yesterday := (weekday + 6) % 7
+	for _, r := range w.timeRanges {
+		if r.start < r.end {
+			if r.hasDay(weekday) && r.start <= offset && offset < r.end {
+				return true
+			}
+			continue
+		}
+		// The range crosses midnight.
+		if r.hasDay(weekday) && offset >= r.start {
+			return true
+		}
+		if r.hasDay(yesterday) && offset < r.end {
+			return true
+		}
+	}
+	return false
+}
+
+func (r rolloutTimeRange) hasDay(day time.Weekday) bool {
+	return r.daysOfWeek == nil || r.daysOfWeek[day]
+}
@@ -0,0 +1,145 @@
+package common // nolint:revive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestGetNextRolloutWindowOpenTime(t *testing.T) {
+	// Weekday nights from 22:00 to 06:00 in New York.
+	window := &storepb.RolloutPolicy_Window{
+		TimeZone: "America/New_York",
+		TimeRanges: []*storepb.RolloutPolicy_Window_TimeRange{
+			{DaysOfWeek: []int32{1, 2, 3, 4, 5}, StartTime: "22:00", EndTime: "06:00"},
+		},
+		BlackoutDates: []string{"2025-12-24"},
+	}
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		window *storepb.RolloutPolicy_Window
+		now    time.Time
+		want   time.Time
+	}{
+		{
+			name:   "no window",
+			window: nil,
+			now:    time.Date(2025, 12, 1, 12, 0, 0, 0, location),
+			want:   time.Date(2025, 12, 1, 12, 0, 0, 0, location),
+		},
+		{
+			name:   "empty window",
+			window: &storepb.RolloutPolicy_Window{},
+			now:    time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC),
+			want:   time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "before window opens on monday",
+			window: window,
+			now:    time.Date(2025, 12, 1, 12, 0, 0, 0, location),
+			want:   time.Date(2025, 12, 1, 22, 0, 0, 0, location),
+		},
+		{
+			name:   "inside window on monday night",
+			window: window,
+			now:    time.Date(2025, 12, 1, 23, 30, 0, 0, location),
+			want:   time.Date(2025, 12, 1, 23, 30, 0, 0, location),
+		},
+		{
+			name:   "inside window after midnight",
+			window: window,
+			now:    time.Date(2025, 12, 2, 5, 59, 0, 0, location),
+			want:   time.Date(2025, 12, 2, 5, 59, 0, 0, location),
+		},
+		{
+			name:   "after window closes on saturday",
+			window: window,
+			now:    time.Date(2025, 12, 6, 7, 0, 0, 0, location),
+			want:   time.Date(2025, 12, 8, 22, 0, 0, 0, location),
+		},
+		{
+			name:   "saturday morning is inside friday night window",
+			window: window,
+			now:    time.Date(2025, 12, 6, 1, 0, 0, 0, location),
+			want:   time.Date(2025, 12, 6, 1, 0, 0, 0, location),
+		},
+		{
+			name:   "blackout date",
+			window: window,
+			now:    time.Date(2025, 12, 24, 21, 0, 0, 0, location),
+			want:   time.Date(2025, 12, 25, 0, 0, 0, 0, location),
+		},
+		{
+			name:   "utc input",
+			window: window,
+			now:    time.Date(2025, 12, 1, 17, 0, 0, 0, time.UTC),
+			want:   time.Date(2025, 12, 2, 3, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GetNextRolloutWindowOpenTime(tc.window, tc.now)
+			require.NoError(t, err)
+			require.True(t, tc.want.Equal(got), "want %v, got %v", tc.want, got)
+		})
+	}
+}
+
+func TestValidateRolloutWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  *storepb.RolloutPolicy_Window
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			window: &storepb.RolloutPolicy_Window{
+				TimeZone:      "Asia/Shanghai",
+				TimeRanges:    []*storepb.RolloutPolicy_Window_TimeRange{{StartTime: "09:00", EndTime: "17:30"}},
+				BlackoutDates: []string{"2026-01-01"},
+			},
+		},
+		{
+			name:    "invalid time zone",
+			window:  &storepb.RolloutPolicy_Window{TimeZone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			name: "invalid day of week",
+			window: &storepb.RolloutPolicy_Window{
+				TimeRanges: []*storepb.RolloutPolicy_Window_TimeRange{{DaysOfWeek: []int32{7}, StartTime: "09:00", EndTime: "17:00"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid time",
+			window: &storepb.RolloutPolicy_Window{
+				TimeRanges: []*storepb.RolloutPolicy_Window_TimeRange{{StartTime: "9am", EndTime: "17:00"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid blackout date",
+			window:  &storepb.RolloutPolicy_Window{BlackoutDates: []string{"12/25/2025"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRolloutWindow(tc.window)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -64,6 +64,8 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 		},
 	)
 	if err != nil {
+		// The secret may have been rotated in the external secret manager, evict it so that the next connection fetches it again.
+		secretlib.InvalidateExternalSecret(dataSource.GetExternalSecret())
 		return nil, err
 	}
 
@@ -0,0 +1,249 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const (
+	avroRecordName      = "QueryResult"
+	avroRecordNamespace = "com.bytebase.export"
+	// avroBlockLength is the number of records written in each block of the object container file.
+	avroBlockLength = 1000
+)
+
+// Avro exports query results as Avro object container file format.
+func Avro(result *v1pb.QueryResult) ([]byte, error) {
+	return exportToBytes(result, AvroToWriter)
+}
+
+// AvroToWriter writes query results as an Avro object container file to the writer.
+func AvroToWriter(w io.Writer, result *v1pb.QueryResult) error {
+	return writeResult(newAvroRowWriter(w, result.ColumnNames, result.ColumnTypeNames), result)
+}
+
+// avroRowWriter writes rows as an Avro object container file in blocks of avroBlockLength records.
+// Every field is a union of null and the column type inferred from the first chunk of rows.
+type avroRowWriter struct {
+	w               io.Writer
+	columnNames     []string
+	columnTypeNames []string
+
+	columns    []*column
+	fieldNames []string
+	ocfWriter  *goavro.OCFWriter
+	records    []any
+	rowCount   int
+}
+
+func newAvroRowWriter(w io.Writer, columnNames, columnTypeNames []string) *avroRowWriter {
+	return &avroRowWriter{w: w, columnNames: columnNames, columnTypeNames: columnTypeNames}
+}
+
+func (aw *avroRowWriter) init(rows []*v1pb.QueryRow) error {
+	aw.columns = inferColumns(aw.columnNames, aw.columnTypeNames, rows)
+	aw.fieldNames = getAvroFieldNames(aw.columns)
+	schema, err := getAvroSchema(aw.columns, aw.fieldNames)
+	if err != nil {
+		return err
+	}
+	ocfWriter, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W:               aw.w,
+		Schema:          schema,
+		CompressionName: goavro.CompressionDeflateLabel,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create avro writer")
+	}
+	aw.ocfWriter = ocfWriter
+	aw.records = make([]any, 0, avroBlockLength)
+	return nil
+}
+
+func (aw *avroRowWriter) flush() error {
+	if len(aw.records) == 0 {
+		return nil
+	}
+	if err := aw.ocfWriter.Append(aw.records); err != nil {
+		return errors.Wrapf(err, "failed to write avro records")
+	}
+	aw.records = aw.records[:0]
+	return nil
+}
+
+func (aw *avroRowWriter) WriteRows(rows []*v1pb.QueryRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if aw.ocfWriter == nil {
+		if err := aw.init(rows); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		aw.rowCount++
+		record := make(map[string]any, len(aw.columns))
+		for j, c := range aw.columns {
+			value := getRowValue(row, j)
+			if !columnAccepts(c, value) {
+				return errors.Errorf("value of column %q in row %d does not match the column type inferred from the previous rows", c.name, aw.rowCount)
+			}
+			datum, err := getAvroDatum(c, value)
+			if err != nil {
+				return errors.Wrapf(err, "failed to convert value of column %q in row %d", c.name, aw.rowCount)
+			}
+			record[aw.fieldNames[j]] = datum
+		}
+		aw.records = append(aw.records, record)
+		if len(aw.records) == avroBlockLength {
+			if err := aw.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close writes the remaining records. The object container file has no footer.
+func (aw *avroRowWriter) Close() error {
+	if aw.ocfWriter == nil {
+		return aw.init(nil)
+	}
+	return aw.flush()
+}
+
+func getAvroSchema(columns []*column, fieldNames []string) (string, error) {
+	fields := make([]map[string]any, 0, len(columns))
+	for i, c := range columns {
+		field := map[string]any{
+			"name":    fieldNames[i],
+			"type":    []any{"null", getAvroType(c)},
+			"default": nil,
+		}
+		if fieldNames[i] != c.name {
+			field["doc"] = c.name
+		}
+		fields = append(fields, field)
+	}
+	schema, err := json.Marshal(map[string]any{
+		"type":      "record",
+		"name":      avroRecordName,
+		"namespace": avroRecordNamespace,
+		"fields":    fields,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal avro schema")
+	}
+	return string(schema), nil
+}
+
+func getAvroType(c *column) any {
+	switch c.typ {
+	case columnTypeBool:
+		return "boolean"
+	case columnTypeInt32:
+		return "int"
+	case columnTypeInt64:
+		return "long"
+	case columnTypeFloat:
+		return "float"
+	case columnTypeDouble:
+		return "double"
+	case columnTypeBytes:
+		return "bytes"
+	case columnTypeTimestamp:
+		return map[string]any{"type": "long", "logicalType": "local-timestamp-micros"}
+	case columnTypeTimestampTz:
+		return map[string]any{"type": "long", "logicalType": "timestamp-micros"}
+	case columnTypeDecimal:
+		return map[string]any{"type": "bytes", "logicalType": "decimal", "precision": c.precision, "scale": c.scale}
+	case columnTypeJSON:
+		// Avro has no JSON logical type, readers fall back to the string type for unknown logical types.
+		return map[string]any{"type": "string", "logicalType": "json"}
+	default:
+		return "string"
+	}
+}
+
+// getAvroDatum returns the goavro native datum of the value, wrapped in the union branch of the column type.
+func getAvroDatum(c *column, value *v1pb.RowValue) (any, error) {
+	if isNullValue(value) {
+		return nil, nil
+	}
+	switch c.typ {
+	case columnTypeBool:
+		return goavro.Union("boolean", value.GetBoolValue()), nil
+	case columnTypeInt32:
+		return goavro.Union("int", value.GetInt32Value()), nil
+	case columnTypeInt64:
+		return goavro.Union("long", getInt64Value(value)), nil
+	case columnTypeFloat:
+		return goavro.Union("float", value.GetFloatValue()), nil
+	case columnTypeDouble:
+		return goavro.Union("double", getDoubleValue(value)), nil
+	case columnTypeBytes:
+		return goavro.Union("bytes", value.GetBytesValue()), nil
+	case columnTypeTimestamp:
+		// goavro does not know local-timestamp-micros, so it encodes the underlying long.
+		return goavro.Union("long", value.GetTimestampValue().GetGoogleTimestamp().AsTime().UnixMicro()), nil
+	case columnTypeTimestampTz:
+		return goavro.Union("long.timestamp-micros", value.GetTimestampTzValue().GetGoogleTimestamp().AsTime()), nil
+	case columnTypeDecimal:
+		unscaled, err := getDecimalUnscaled(c, getDecimalString(value))
+		if err != nil {
+			return nil, err
+		}
+		denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.scale)), nil)
+		return goavro.Union("bytes.decimal", new(big.Rat).SetFrac(unscaled, denominator)), nil
+	case columnTypeJSON:
+		s, err := getJSONString(value)
+		if err != nil {
+			return nil, err
+		}
+		return goavro.Union("string", s), nil
+	default:
+		return goavro.Union("string", convertValueToStringInXLSX(value)), nil
+	}
+}
+
+// getAvroFieldNames returns unique Avro field names for the columns.
+// Avro names must match [A-Za-z_][A-Za-z0-9_]*, so other characters are replaced with underscores.
+func getAvroFieldNames(columns []*column) []string {
+	names := make([]string, 0, len(columns))
+	seen := make(map[string]bool)
+	for _, c := range columns {
+		var b strings.Builder
+		for i, r := range c.name {
+			switch {
+			case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+				b.WriteRune(r)
+			case r >= '0' && r <= '9':
+				if i == 0 {
+					b.WriteRune('_')
+				}
+				b.WriteRune(r)
+			default:
+				b.WriteRune('_')
+			}
+		}
+		name := b.String()
+		if name == "" {
+			name = "_"
+		}
+		unique := name
+		for i := 2; seen[unique]; i++ {
+			unique = fmt.Sprintf("%s_%d", name, i)
+		}
+		seen[unique] = true
+		names = append(names, unique)
+	}
+	return names
+}
@@ -0,0 +1,299 @@
+package export
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// maxDecimalPrecision is the maximum precision of decimal columns in the typed formats.
+// It is the precision of a 128-bit decimal, which is what Parquet readers such as Spark support.
+const maxDecimalPrecision = 38
+
+// uint64DecimalPrecision is the precision of the decimal columns holding unsigned 64-bit integers.
+const uint64DecimalPrecision = 20
+
+// columnType is the logical type of a column in the typed formats (Parquet and Avro).
+type columnType int
+
+const (
+	columnTypeString columnType = iota
+	columnTypeBool
+	columnTypeInt32
+	columnTypeInt64
+	columnTypeFloat
+	columnTypeDouble
+	columnTypeBytes
+	columnTypeTimestamp
+	columnTypeTimestampTz
+	columnTypeDecimal
+	columnTypeJSON
+)
+
+// column describes a column of the query result in the typed formats.
+type column struct {
+	name string
+	typ  columnType
+	// precision and scale are only set for decimal columns.
+	precision int32
+	scale     int32
+}
+
+// inferColumns infers the column types from the row value kinds and the column type names.
+// The row values carry no decimal or JSON kind, so the column type name is used to
+// recognize them. Columns with mixed value kinds fall back to string.
+// The precision and scale of decimal columns come from the column type, e.g. NUMERIC(10,2), because
+// the later chunks may hold values with more digits. Decimal columns without them fall back to string.
+// When streaming, the rows are the first chunk of the result, and the later chunks must fit
+// the inferred types, see columnAccepts.
+func inferColumns(columnNames, columnTypeNames []string, rows []*v1pb.QueryRow) []*column {
+	columns := make([]*column, len(columnNames))
+	for i, name := range columnNames {
+		typeName := ""
+		if i < len(columnTypeNames) {
+			typeName = columnTypeNames[i]
+		}
+		columns[i] = inferColumn(name, typeName, rows, i)
+	}
+	return columns
+}
+
+func inferColumn(name, typeName string, rows []*v1pb.QueryRow, index int) *column {
+	var kinds []columnType
+	for _, row := range rows {
+		value := getRowValue(row, index)
+		if isNullValue(value) {
+			continue
+		}
+		var kind columnType
+		switch value.Kind.(type) {
+		case *v1pb.RowValue_BoolValue:
+			kind = columnTypeBool
+		case *v1pb.RowValue_Int32Value:
+			kind = columnTypeInt32
+		case *v1pb.RowValue_Int64Value, *v1pb.RowValue_Uint32Value:
+			kind = columnTypeInt64
+		case *v1pb.RowValue_Uint64Value:
+			// Unsigned 64-bit integers do not fit into a signed long, so they are exported as DECIMAL(20, 0).
+			kind = columnTypeDecimal
+		case *v1pb.RowValue_FloatValue:
+			kind = columnTypeFloat
+		case *v1pb.RowValue_DoubleValue:
+			kind = columnTypeDouble
+		case *v1pb.RowValue_BytesValue:
+			kind = columnTypeBytes
+		case *v1pb.RowValue_TimestampValue:
+			kind = columnTypeTimestamp
+		case *v1pb.RowValue_TimestampTzValue:
+			kind = columnTypeTimestampTz
+		case *v1pb.RowValue_ValueValue:
+			kind = columnTypeJSON
+		case *v1pb.RowValue_StringValue:
+			switch {
+			case isJSONTypeName(typeName):
+				kind = columnTypeJSON
+			case isDecimalTypeName(typeName):
+				kind = columnTypeDecimal
+			default:
+				kind = columnTypeString
+			}
+		default:
+			kind = columnTypeString
+		}
+		if len(kinds) == 0 || kinds[len(kinds)-1] != kind {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	c := &column{name: name, typ: mergeColumnTypes(kinds)}
+	if c.typ == columnTypeDecimal {
+		if !isDecimalTypeName(typeName) {
+			c.precision, c.scale = uint64DecimalPrecision, 0
+		} else if precision, scale, ok := getDecimalTypeParameters(typeName); ok {
+			c.precision, c.scale = precision, scale
+		} else {
+			c.typ = columnTypeString
+		}
+	}
+	return c
+}
+
+// columnAccepts returns whether the value can be written to the column without changing its type.
+func columnAccepts(c *column, value *v1pb.RowValue) bool {
+	if isNullValue(value) {
+		return true
+	}
+	switch value.Kind.(type) {
+	case *v1pb.RowValue_BoolValue:
+		return c.typ == columnTypeBool || c.typ == columnTypeString
+	case *v1pb.RowValue_Int32Value:
+		return c.typ == columnTypeInt32 || c.typ == columnTypeInt64 || c.typ == columnTypeString
+	case *v1pb.RowValue_Int64Value, *v1pb.RowValue_Uint32Value:
+		return c.typ == columnTypeInt64 || c.typ == columnTypeString
+	case *v1pb.RowValue_Uint64Value:
+		return c.typ == columnTypeDecimal || c.typ == columnTypeString
+	case *v1pb.RowValue_FloatValue:
+		return c.typ == columnTypeFloat || c.typ == columnTypeDouble || c.typ == columnTypeString
+	case *v1pb.RowValue_DoubleValue:
+		return c.typ == columnTypeDouble || c.typ == columnTypeString
+	case *v1pb.RowValue_BytesValue:
+		return c.typ == columnTypeBytes || c.typ == columnTypeString
+	case *v1pb.RowValue_TimestampValue:
+		return c.typ == columnTypeTimestamp || c.typ == columnTypeString
+	case *v1pb.RowValue_TimestampTzValue:
+		return c.typ == columnTypeTimestampTz || c.typ == columnTypeString
+	case *v1pb.RowValue_ValueValue:
+		return c.typ == columnTypeJSON || c.typ == columnTypeString
+	case *v1pb.RowValue_StringValue:
+		return c.typ == columnTypeString || c.typ == columnTypeJSON || c.typ == columnTypeDecimal
+	default:
+		return c.typ == columnTypeString
+	}
+}
+
+// mergeColumnTypes merges the value kinds seen in a column into a single column type.
+func mergeColumnTypes(kinds []columnType) columnType {
+	if len(kinds) == 0 {
+		return columnTypeString
+	}
+	merged := kinds[0]
+	for _, kind := range kinds[1:] {
+		switch {
+		case kind == merged:
+		case isIntegerColumnType(kind) && isIntegerColumnType(merged):
+			merged = columnTypeInt64
+		case isFloatingColumnType(kind) && isFloatingColumnType(merged):
+			merged = columnTypeDouble
+		default:
+			return columnTypeString
+		}
+	}
+	return merged
+}
+
+func isIntegerColumnType(t columnType) bool {
+	return t == columnTypeInt32 || t == columnTypeInt64
+}
+
+func isFloatingColumnType(t columnType) bool {
+	return t == columnTypeFloat || t == columnTypeDouble
+}
+
+func isJSONTypeName(typeName string) bool {
+	switch getBaseTypeName(typeName) {
+	case "JSON", "JSONB":
+		return true
+	default:
+		return false
+	}
+}
+
+func isDecimalTypeName(typeName string) bool {
+	switch getBaseTypeName(typeName) {
+	case "NUMERIC", "DECIMAL", "NUMBER", "BIGNUMERIC", "DEC":
+		return true
+	default:
+		return false
+	}
+}
+
+// getBaseTypeName returns the upper-cased type name without its parameters, e.g. NUMERIC for numeric(10,2).
+func getBaseTypeName(typeName string) string {
+	if i := strings.Index(typeName, "("); i >= 0 {
+		typeName = typeName[:i]
+	}
+	return strings.ToUpper(strings.TrimSpace(typeName))
+}
+
+var decimalRegexp = regexp.MustCompile(`^[+-]?(\d*)(?:\.(\d*))?$`)
+
+var decimalTypeRegexp = regexp.MustCompile(`^[^(]*\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\)\s*$`)
+
+// getDecimalTypeParameters returns the precision and scale of the decimal type, e.g. 10 and 2 for NUMERIC(10,2).
+// It returns false if the type is unconstrained, e.g. NUMERIC, or the precision exceeds the maximum.
+func getDecimalTypeParameters(typeName string) (int32, int32, bool) {
+	matches := decimalTypeRegexp.FindStringSubmatch(typeName)
+	if matches == nil {
+		return 0, 0, false
+	}
+	precision, err := strconv.Atoi(matches[1])
+	if err != nil || precision <= 0 || precision > maxDecimalPrecision {
+		return 0, 0, false
+	}
+	var scale int
+	if matches[2] != "" {
+		scale, err = strconv.Atoi(matches[2])
+		if err != nil || scale > precision {
+			return 0, 0, false
+		}
+	}
+	return int32(precision), int32(scale), true
+}
+
+// getDecimalUnscaled returns the unscaled integer of the decimal value for the column.
+func getDecimalUnscaled(c *column, value string) (*big.Int, error) {
+	matches := decimalRegexp.FindStringSubmatch(value)
+	if matches == nil || matches[1]+matches[2] == "" {
+		return nil, errors.Errorf("invalid decimal value %q", value)
+	}
+	fraction := matches[2]
+	if len(fraction) > int(c.scale) {
+		return nil, errors.Errorf("decimal value %q exceeds scale %d", value, c.scale)
+	}
+	digits := strings.TrimLeft(matches[1]+fraction+strings.Repeat("0", int(c.scale)-len(fraction)), "0")
+	if len(digits) > int(c.precision) {
+		return nil, errors.Errorf("decimal value %q exceeds precision %d", value, c.precision)
+	}
+	if digits == "" {
+		digits = "0"
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid decimal value %q", value)
+	}
+	if strings.HasPrefix(value, "-") {
+		unscaled.Neg(unscaled)
+	}
+	return unscaled, nil
+}
+
+// getDecimalString returns the decimal value as a string for decimal columns.
+func getDecimalString(value *v1pb.RowValue) string {
+	if _, ok := value.Kind.(*v1pb.RowValue_Uint64Value); ok {
+		return strconv.FormatUint(value.GetUint64Value(), 10)
+	}
+	return value.GetStringValue()
+}
+
+// getJSONString returns the JSON text of the value for JSON columns.
+func getJSONString(value *v1pb.RowValue) (string, error) {
+	if _, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		b, err := protojson.Marshal(value.GetValueValue())
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to marshal JSON value")
+		}
+		return string(b), nil
+	}
+	return value.GetStringValue(), nil
+}
+
+func getRowValue(row *v1pb.QueryRow, index int) *v1pb.RowValue {
+	if row == nil || index >= len(row.Values) {
+		return nil
+	}
+	return row.Values[index]
+}
+
+func isNullValue(value *v1pb.RowValue) bool {
+	if value == nil || value.Kind == nil {
+		return true
+	}
+	_, ok := value.Kind.(*v1pb.RowValue_NullValue)
+	return ok
+}
@@ -0,0 +1,227 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+var testTime = time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+
+func getTypedTestResult() *v1pb.QueryResult {
+	null := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+	return &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "price", "created_at", "updated_at", "data", "payload", "note", "count(*)"},
+		ColumnTypeNames: []string{"INT8", "NUMERIC(10,3)", "TIMESTAMP", "TIMESTAMPTZ", "BYTEA", "JSONB", "TEXT", "UINT64"},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "12.5"}},
+					{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(testTime)}}},
+					{Kind: &v1pb.RowValue_TimestampTzValue{TimestampTzValue: &v1pb.RowValue_TimestampTZ{GoogleTimestamp: timestamppb.New(testTime), Zone: "UTC"}}},
+					{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte{0x01, 0x02}}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: `{"a": 1}`}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "hello"}},
+					{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 18446744073709551615}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int32Value{Int32Value: 2}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "-0.125"}},
+					null,
+					null,
+					null,
+					{Kind: &v1pb.RowValue_ValueValue{ValueValue: structpb.NewBoolValue(true)}},
+					{Kind: &v1pb.RowValue_Int32Value{Int32Value: 3}},
+					null,
+				},
+			},
+		},
+	}
+}
+
+func TestInferColumns(t *testing.T) {
+	result := getTypedTestResult()
+	columns := inferColumns(result.ColumnNames, result.ColumnTypeNames, result.Rows)
+	want := []*column{
+		{name: "id", typ: columnTypeInt64},
+		{name: "price", typ: columnTypeDecimal, precision: 10, scale: 3},
+		{name: "created_at", typ: columnTypeTimestamp},
+		{name: "updated_at", typ: columnTypeTimestampTz},
+		{name: "data", typ: columnTypeBytes},
+		{name: "payload", typ: columnTypeJSON},
+		{name: "note", typ: columnTypeString},
+		{name: "count(*)", typ: columnTypeDecimal, precision: 20, scale: 0},
+	}
+	require.Equal(t, want, columns)
+}
+
+func TestGetDecimalTypeParameters(t *testing.T) {
+	tests := []struct {
+		typeName  string
+		precision int32
+		scale     int32
+		ok        bool
+	}{
+		{typeName: "NUMERIC(10,2)", precision: 10, scale: 2, ok: true},
+		{typeName: "decimal( 12 , 0 )", precision: 12, scale: 0, ok: true},
+		{typeName: "NUMBER(5)", precision: 5, scale: 0, ok: true},
+		{typeName: "NUMERIC", ok: false},
+		{typeName: "NUMBER(*,2)", ok: false},
+		{typeName: "NUMERIC(2,5)", ok: false},
+		{typeName: "NUMERIC(76,2)", ok: false},
+	}
+	for _, test := range tests {
+		precision, scale, ok := getDecimalTypeParameters(test.typeName)
+		require.Equal(t, test.ok, ok, test.typeName)
+		require.Equal(t, test.precision, precision, test.typeName)
+		require.Equal(t, test.scale, scale, test.typeName)
+	}
+}
+
+func TestGetDecimalUnscaled(t *testing.T) {
+	c := &column{typ: columnTypeDecimal, precision: 5, scale: 2}
+	unscaled, err := getDecimalUnscaled(c, "-123.4")
+	require.NoError(t, err)
+	require.Equal(t, "-12340", unscaled.String())
+	_, err = getDecimalUnscaled(c, "1.234")
+	require.Error(t, err)
+	_, err = getDecimalUnscaled(c, "1234")
+	require.Error(t, err)
+}
+
+func TestExportParquet(t *testing.T) {
+	content, err := Parquet(getTypedTestResult())
+	require.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Equal(t, int64(2), reader.NumRows())
+
+	columnSchema := reader.MetaData().Schema
+	require.Equal(t, schema.NewIntLogicalType(64, true), columnSchema.Column(0).LogicalType())
+	require.Equal(t, schema.NewDecimalLogicalType(10, 3), columnSchema.Column(1).LogicalType())
+	require.Equal(t, schema.NewTimestampLogicalType(false, schema.TimeUnitMicros), columnSchema.Column(2).LogicalType())
+	require.Equal(t, schema.NewTimestampLogicalType(true, schema.TimeUnitMicros), columnSchema.Column(3).LogicalType())
+	require.Equal(t, schema.JSONLogicalType{}, columnSchema.Column(5).LogicalType())
+	require.Equal(t, schema.StringLogicalType{}, columnSchema.Column(6).LogicalType())
+	require.Equal(t, schema.NewDecimalLogicalType(20, 0), columnSchema.Column(7).LogicalType())
+
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fileReader.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	ids := table.Column(0).Data().Chunk(0).(*array.Int64)
+	require.Equal(t, []int64{1, 2}, ids.Int64Values())
+	prices := table.Column(1).Data().Chunk(0).(*array.Decimal128)
+	require.Equal(t, "12.500", prices.Value(0).ToString(3))
+	require.Equal(t, "-0.125", prices.Value(1).ToString(3))
+	createdAt := table.Column(2).Data().Chunk(0).(*array.Timestamp)
+	require.Equal(t, arrow.Timestamp(testTime.UnixMicro()), createdAt.Value(0))
+	require.True(t, createdAt.IsNull(1))
+	notes := table.Column(6).Data().Chunk(0).(*array.String)
+	require.Equal(t, "hello", notes.Value(0))
+	require.Equal(t, "3", notes.Value(1))
+}
+
+func TestExportAvro(t *testing.T) {
+	content, err := Avro(getTypedTestResult())
+	require.NoError(t, err)
+
+	reader, err := goavro.NewOCFReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	var records []map[string]any
+	for reader.Scan() {
+		datum, err := reader.Read()
+		require.NoError(t, err)
+		records = append(records, datum.(map[string]any))
+	}
+	require.NoError(t, reader.Err())
+	require.Len(t, records, 2)
+
+	first := records[0]
+	require.Equal(t, map[string]any{"long": int64(1)}, first["id"])
+	require.Equal(t, 0, big.NewRat(25, 2).Cmp(first["price"].(map[string]any)["bytes.decimal"].(*big.Rat)))
+	require.Equal(t, map[string]any{"long": testTime.UnixMicro()}, first["created_at"])
+	require.True(t, testTime.Equal(first["updated_at"].(map[string]any)["long.timestamp-micros"].(time.Time)))
+	require.Equal(t, map[string]any{"bytes": []byte{0x01, 0x02}}, first["data"])
+	require.Equal(t, map[string]any{"string": `{"a": 1}`}, first["payload"])
+	require.Equal(t, 0, new(big.Rat).SetUint64(18446744073709551615).Cmp(first["count___"].(map[string]any)["bytes.decimal"].(*big.Rat)))
+
+	second := records[1]
+	require.Nil(t, second["created_at"])
+	require.Equal(t, map[string]any{"string": "true"}, second["payload"])
+	require.Equal(t, map[string]any{"string": "3"}, second["note"])
+}
+
+func TestRowWriterChunks(t *testing.T) {
+	result := getTypedTestResult()
+	for _, format := range []v1pb.ExportFormat{v1pb.ExportFormat_PARQUET, v1pb.ExportFormat_AVRO} {
+		var buf bytes.Buffer
+		rw, err := NewRowWriter(&buf, RowWriterConfig{
+			Format:          format,
+			ColumnNames:     result.ColumnNames,
+			ColumnTypeNames: result.ColumnTypeNames,
+		})
+		require.NoError(t, err)
+		require.NoError(t, rw.WriteRows(result.Rows), format)
+		require.NoError(t, rw.WriteRows(result.Rows), format)
+		require.NoError(t, rw.Close(), format)
+
+		// The column types are inferred from the first chunk, the later chunks must match.
+		rw, err = NewRowWriter(&bytes.Buffer{}, RowWriterConfig{
+			Format:          format,
+			ColumnNames:     result.ColumnNames,
+			ColumnTypeNames: result.ColumnTypeNames,
+		})
+		require.NoError(t, err)
+		require.NoError(t, rw.WriteRows(result.Rows[:1]), format)
+		mismatched := &v1pb.QueryRow{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_StringValue{StringValue: "x"}}}}
+		require.Error(t, rw.WriteRows([]*v1pb.QueryRow{mismatched}), format)
+		_ = rw.Close()
+	}
+}
+
+func TestRowWriterDecimalScale(t *testing.T) {
+	decimalRow := func(value string) *v1pb.QueryRow {
+		return &v1pb.QueryRow{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_StringValue{StringValue: value}}}}
+	}
+	for _, format := range []v1pb.ExportFormat{v1pb.ExportFormat_PARQUET, v1pb.ExportFormat_AVRO} {
+		// The later chunk has a longer scale than the first one, which the column type allows.
+		for _, typeName := range []string{"NUMERIC(10,4)", "NUMERIC"} {
+			rw, err := NewRowWriter(&bytes.Buffer{}, RowWriterConfig{
+				Format:          format,
+				ColumnNames:     []string{"amount"},
+				ColumnTypeNames: []string{typeName},
+			})
+			require.NoError(t, err)
+			require.NoError(t, rw.WriteRows([]*v1pb.QueryRow{decimalRow("1.5")}), format, typeName)
+			require.NoError(t, rw.WriteRows([]*v1pb.QueryRow{decimalRow("2.1234")}), format, typeName)
+			require.NoError(t, rw.Close(), format, typeName)
+		}
+	}
+
+	// Unconstrained decimal columns are exported as strings.
+	columns := inferColumns([]string{"amount"}, []string{"NUMERIC"}, []*v1pb.QueryRow{decimalRow("1.5")})
+	require.Equal(t, []*column{{name: "amount", typ: columnTypeString}}, columns)
+}
@@ -19,25 +19,51 @@ func CSV(result *v1pb.QueryResult) ([]byte, error) {
 // CSVToWriter streams query results as CSV directly to the writer.
 // This minimizes memory usage by avoiding intermediate buffering.
 func CSVToWriter(w io.Writer, result *v1pb.QueryResult) error {
-	if _, err := w.Write([]byte(strings.Join(result.ColumnNames, ","))); err != nil {
+	return writeResult(newCSVRowWriter(w, result.ColumnNames), result)
+}
+
+type csvRowWriter struct {
+	w           io.Writer
+	columnNames []string
+	started     bool
+	rowCount    int
+}
+
+func newCSVRowWriter(w io.Writer, columnNames []string) *csvRowWriter {
+	return &csvRowWriter{w: w, columnNames: columnNames}
+}
+
+func (cw *csvRowWriter) writeHeader() error {
+	if cw.started {
+		return nil
+	}
+	cw.started = true
+	if _, err := cw.w.Write([]byte(strings.Join(cw.columnNames, ","))); err != nil {
 		return err
 	}
-	if _, err := w.Write([]byte{'\n'}); err != nil {
+	_, err := cw.w.Write([]byte{'\n'})
+	return err
+}
+
+// WriteRows writes the rows separated by new lines, without a trailing new line.
+func (cw *csvRowWriter) WriteRows(rows []*v1pb.QueryRow) error {
+	if err := cw.writeHeader(); err != nil {
 		return err
 	}
-	for i, row := range result.Rows {
+	for _, row := range rows {
+		if cw.rowCount > 0 {
+			if _, err := cw.w.Write([]byte{'\n'}); err != nil {
+				return err
+			}
+		}
+		cw.rowCount++
 		for j, value := range row.Values {
 			if j != 0 {
-				if _, err := w.Write([]byte{','}); err != nil {
+				if _, err := cw.w.Write([]byte{','}); err != nil {
 					return err
 				}
 			}
-			if _, err := w.Write(convertValueToBytesInCSV(value)); err != nil {
-				return err
-			}
-		}
-		if i != len(result.Rows)-1 {
-			if _, err := w.Write([]byte{'\n'}); err != nil {
+			if _, err := cw.w.Write(convertValueToBytesInCSV(value)); err != nil {
 				return err
 			}
 		}
@@ -45,6 +71,10 @@ func CSVToWriter(w io.Writer, result *v1pb.QueryResult) error {
 	return nil
 }
 
+func (cw *csvRowWriter) Close() error {
+	return cw.writeHeader()
+}
+
 func convertValueToBytesInCSV(value *v1pb.RowValue) []byte {
 	if value == nil || value.Kind == nil {
 		return []byte("")
@@ -1,4 +1,4 @@
-// Package export provides data export functionality for various formats (CSV, JSON, SQL, XLSX).
+// Package export provides data export functionality for various formats (CSV, JSON, SQL, XLSX, Parquet, Avro).
 // It implements streaming export to minimize memory usage for large datasets.
 package export
 
@@ -17,23 +17,53 @@ func JSON(result *v1pb.QueryResult) ([]byte, error) {
 
 // JSONToWriter streams query results as pretty-printed JSON directly to the writer.
 func JSONToWriter(w io.Writer, result *v1pb.QueryResult) error {
-	records := make([]map[string]any, 0, len(result.Rows))
-	for _, row := range result.Rows {
-		record := make(map[string]any, len(result.ColumnNames))
+	return writeResult(newJSONRowWriter(w, result.ColumnNames), result)
+}
+
+// jsonRowWriter writes the rows as a pretty-printed JSON array of objects, one record at a time.
+// The output is the same as json.MarshalIndent(records, "", "  ").
+type jsonRowWriter struct {
+	w           io.Writer
+	columnNames []string
+	rowCount    int
+}
+
+func newJSONRowWriter(w io.Writer, columnNames []string) *jsonRowWriter {
+	return &jsonRowWriter{w: w, columnNames: columnNames}
+}
+
+func (jw *jsonRowWriter) WriteRows(rows []*v1pb.QueryRow) error {
+	for _, row := range rows {
+		record := make(map[string]any, len(jw.columnNames))
 		for i, value := range row.Values {
-			record[result.ColumnNames[i]] = convertValueToJSONValue(value)
+			record[jw.columnNames[i]] = convertValueToJSONValue(value)
+		}
+		jsonBytes, err := json.MarshalIndent(record, "  ", "  ")
+		if err != nil {
+			return errors.Errorf("failed to encode JSON: %v", err)
+		}
+		separator := ",\n  "
+		if jw.rowCount == 0 {
+			separator = "[\n  "
+		}
+		jw.rowCount++
+		if _, err := jw.w.Write([]byte(separator)); err != nil {
+			return err
+		}
+		if _, err := jw.w.Write(jsonBytes); err != nil {
+			return err
 		}
-		records = append(records, record)
 	}
+	return nil
+}
 
-	jsonBytes, err := json.MarshalIndent(records, "", "  ")
-	if err != nil {
-		return errors.Errorf("failed to encode JSON: %v", err)
-	}
-	if _, err := w.Write(jsonBytes); err != nil {
-		return err
+func (jw *jsonRowWriter) Close() error {
+	end := "\n]"
+	if jw.rowCount == 0 {
+		end = "[]"
 	}
-	return nil
+	_, err := jw.w.Write([]byte(end))
+	return err
 }
 
 func convertValueToJSONValue(value *v1pb.RowValue) any {
@@ -0,0 +1,220 @@
+package export
+
+import (
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/extensions"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// parquetRowGroupSize is the number of rows buffered in memory and written as one row group.
+const parquetRowGroupSize = 64 * 1024
+
+// Parquet exports query results as Parquet format.
+func Parquet(result *v1pb.QueryResult) ([]byte, error) {
+	return exportToBytes(result, ParquetToWriter)
+}
+
+// ParquetToWriter writes query results as a Parquet file to the writer.
+func ParquetToWriter(w io.Writer, result *v1pb.QueryResult) error {
+	return writeResult(newParquetRowWriter(w, result.ColumnNames, result.ColumnTypeNames), result)
+}
+
+// parquetRowWriter writes rows as a Parquet file in row groups of parquetRowGroupSize.
+// Parquet stores the schema in the file, so the column types are inferred from the first chunk of rows.
+type parquetRowWriter struct {
+	w               io.Writer
+	columnNames     []string
+	columnTypeNames []string
+
+	columns    []*column
+	fileWriter *pqarrow.FileWriter
+	builder    *array.RecordBuilder
+	rowCount   int
+}
+
+func newParquetRowWriter(w io.Writer, columnNames, columnTypeNames []string) *parquetRowWriter {
+	return &parquetRowWriter{w: w, columnNames: columnNames, columnTypeNames: columnTypeNames}
+}
+
+func (pw *parquetRowWriter) init(rows []*v1pb.QueryRow) error {
+	pw.columns = inferColumns(pw.columnNames, pw.columnTypeNames, rows)
+	fields := make([]arrow.Field, 0, len(pw.columns))
+	for _, c := range pw.columns {
+		dataType, err := getArrowDataType(c)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, arrow.Field{Name: c.name, Type: dataType, Nullable: true})
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	fileWriter, err := pqarrow.NewFileWriter(
+		schema,
+		pw.w,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+		pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create parquet writer")
+	}
+	pw.fileWriter = fileWriter
+	pw.builder = array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	return nil
+}
+
+func (pw *parquetRowWriter) writeRowGroup() error {
+	record := pw.builder.NewRecord()
+	defer record.Release()
+	if record.NumRows() == 0 {
+		return nil
+	}
+	if err := pw.fileWriter.Write(record); err != nil {
+		return errors.Wrapf(err, "failed to write parquet row group")
+	}
+	return nil
+}
+
+func (pw *parquetRowWriter) WriteRows(rows []*v1pb.QueryRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if pw.fileWriter == nil {
+		if err := pw.init(rows); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		pw.rowCount++
+		for j, c := range pw.columns {
+			value := getRowValue(row, j)
+			if !columnAccepts(c, value) {
+				return errors.Errorf("value of column %q in row %d does not match the column type inferred from the previous rows", c.name, pw.rowCount)
+			}
+			if err := appendArrowValue(pw.builder.Field(j), c, value); err != nil {
+				return errors.Wrapf(err, "failed to convert value of column %q in row %d", c.name, pw.rowCount)
+			}
+		}
+		if pw.rowCount%parquetRowGroupSize == 0 {
+			if err := pw.writeRowGroup(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close writes the last row group and the file footer.
+func (pw *parquetRowWriter) Close() error {
+	if pw.fileWriter == nil {
+		if err := pw.init(nil); err != nil {
+			return err
+		}
+	}
+	defer pw.builder.Release()
+	if err := pw.writeRowGroup(); err != nil {
+		_ = pw.fileWriter.Close()
+		return err
+	}
+	if err := pw.fileWriter.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close parquet writer")
+	}
+	return nil
+}
+
+func getArrowDataType(c *column) (arrow.DataType, error) {
+	switch c.typ {
+	case columnTypeBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case columnTypeInt32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case columnTypeInt64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case columnTypeFloat:
+		return arrow.PrimitiveTypes.Float32, nil
+	case columnTypeDouble:
+		return arrow.PrimitiveTypes.Float64, nil
+	case columnTypeBytes:
+		return arrow.BinaryTypes.Binary, nil
+	case columnTypeTimestamp:
+		// A timestamp without time zone is stored with isAdjustedToUTC=false.
+		return &arrow.TimestampType{Unit: arrow.Microsecond}, nil
+	case columnTypeTimestampTz:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nil
+	case columnTypeDecimal:
+		return &arrow.Decimal128Type{Precision: c.precision, Scale: c.scale}, nil
+	case columnTypeJSON:
+		return extensions.NewJSONType(arrow.BinaryTypes.String)
+	default:
+		return arrow.BinaryTypes.String, nil
+	}
+}
+
+func appendArrowValue(builder array.Builder, c *column, value *v1pb.RowValue) error {
+	if isNullValue(value) {
+		builder.AppendNull()
+		return nil
+	}
+	switch c.typ {
+	case columnTypeBool:
+		builder.(*array.BooleanBuilder).Append(value.GetBoolValue())
+	case columnTypeInt32:
+		builder.(*array.Int32Builder).Append(value.GetInt32Value())
+	case columnTypeInt64:
+		builder.(*array.Int64Builder).Append(getInt64Value(value))
+	case columnTypeFloat:
+		builder.(*array.Float32Builder).Append(value.GetFloatValue())
+	case columnTypeDouble:
+		builder.(*array.Float64Builder).Append(getDoubleValue(value))
+	case columnTypeBytes:
+		builder.(*array.BinaryBuilder).Append(value.GetBytesValue())
+	case columnTypeTimestamp:
+		builder.(*array.TimestampBuilder).Append(arrow.Timestamp(value.GetTimestampValue().GetGoogleTimestamp().AsTime().UnixMicro()))
+	case columnTypeTimestampTz:
+		builder.(*array.TimestampBuilder).Append(arrow.Timestamp(value.GetTimestampTzValue().GetGoogleTimestamp().AsTime().UnixMicro()))
+	case columnTypeDecimal:
+		unscaled, err := getDecimalUnscaled(c, getDecimalString(value))
+		if err != nil {
+			return err
+		}
+		builder.(*array.Decimal128Builder).Append(decimal128.FromBigInt(unscaled))
+	case columnTypeJSON:
+		s, err := getJSONString(value)
+		if err != nil {
+			return err
+		}
+		builder.(*array.ExtensionBuilder).Builder.(*array.StringBuilder).Append(s)
+	default:
+		builder.(*array.StringBuilder).Append(convertValueToStringInXLSX(value))
+	}
+	return nil
+}
+
+// getInt64Value returns the integer value for int64 columns, which may also hold int32 and uint32 values.
+func getInt64Value(value *v1pb.RowValue) int64 {
+	switch value.Kind.(type) {
+	case *v1pb.RowValue_Int32Value:
+		return int64(value.GetInt32Value())
+	case *v1pb.RowValue_Uint32Value:
+		return int64(value.GetUint32Value())
+	default:
+		return value.GetInt64Value()
+	}
+}
+
+// getDoubleValue returns the floating point value for double columns, which may also hold float values.
+func getDoubleValue(value *v1pb.RowValue) float64 {
+	if _, ok := value.Kind.(*v1pb.RowValue_FloatValue); ok {
+		return float64(value.GetFloatValue())
+	}
+	return value.GetDoubleValue()
+}
@@ -24,33 +24,53 @@ func SQL(engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult
 
 // SQLToWriter streams SQL INSERT statements directly to the writer.
 func SQLToWriter(w io.Writer, engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult) error {
-	for i, row := range result.Rows {
-		if _, err := w.Write([]byte(statementPrefix)); err != nil {
+	return writeResult(newSQLRowWriter(w, engine, statementPrefix), result)
+}
+
+type sqlRowWriter struct {
+	w               io.Writer
+	engine          storepb.Engine
+	statementPrefix string
+	rowCount        int
+}
+
+func newSQLRowWriter(w io.Writer, engine storepb.Engine, statementPrefix string) *sqlRowWriter {
+	return &sqlRowWriter{w: w, engine: engine, statementPrefix: statementPrefix}
+}
+
+// WriteRows writes one INSERT statement per row, separated by new lines.
+func (sw *sqlRowWriter) WriteRows(rows []*v1pb.QueryRow) error {
+	for _, row := range rows {
+		if sw.rowCount > 0 {
+			if _, err := sw.w.Write([]byte{'\n'}); err != nil {
+				return err
+			}
+		}
+		sw.rowCount++
+		if _, err := sw.w.Write([]byte(sw.statementPrefix)); err != nil {
 			return err
 		}
 		for j, value := range row.Values {
 			if j != 0 {
-				if _, err := w.Write([]byte{','}); err != nil {
+				if _, err := sw.w.Write([]byte{','}); err != nil {
 					return err
 				}
 			}
-			if _, err := w.Write(convertValueToBytesInSQL(engine, value)); err != nil {
+			if _, err := sw.w.Write(convertValueToBytesInSQL(sw.engine, value)); err != nil {
 				return err
 			}
 		}
-		if i != len(result.Rows)-1 {
-			if _, err := w.Write([]byte(");\n")); err != nil {
-				return err
-			}
-		} else {
-			if _, err := w.Write([]byte(");")); err != nil {
-				return err
-			}
+		if _, err := sw.w.Write([]byte(");")); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func (*sqlRowWriter) Close() error {
+	return nil
+}
+
 // SQLStatementPrefix generates the INSERT INTO statement prefix.
 func SQLStatementPrefix(engine storepb.Engine, resourceList []base.SchemaResource, columnNames []string) (string, error) {
 	var escapeQuote string
@@ -0,0 +1,58 @@
+package export
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// RowWriter writes the rows of a query result in chunks, so the whole result never needs to be in memory.
+type RowWriter interface {
+	// WriteRows writes a chunk of rows.
+	WriteRows(rows []*v1pb.QueryRow) error
+	// Close finishes the format, e.g. writes the closing bracket or the file footer.
+	// It does not close the underlying writer.
+	Close() error
+}
+
+// RowWriterConfig is the config to create a RowWriter.
+type RowWriterConfig struct {
+	Format          v1pb.ExportFormat
+	ColumnNames     []string
+	ColumnTypeNames []string
+	// Engine and StatementPrefix are only used by the SQL format.
+	Engine          storepb.Engine
+	StatementPrefix string
+}
+
+// NewRowWriter returns the RowWriter of the format writing to w.
+func NewRowWriter(w io.Writer, config RowWriterConfig) (RowWriter, error) {
+	switch config.Format {
+	case v1pb.ExportFormat_CSV:
+		return newCSVRowWriter(w, config.ColumnNames), nil
+	case v1pb.ExportFormat_JSON:
+		return newJSONRowWriter(w, config.ColumnNames), nil
+	case v1pb.ExportFormat_SQL:
+		return newSQLRowWriter(w, config.Engine, config.StatementPrefix), nil
+	case v1pb.ExportFormat_XLSX:
+		return newXLSXRowWriter(w, config.ColumnNames)
+	case v1pb.ExportFormat_PARQUET:
+		return newParquetRowWriter(w, config.ColumnNames, config.ColumnTypeNames), nil
+	case v1pb.ExportFormat_AVRO:
+		return newAvroRowWriter(w, config.ColumnNames, config.ColumnTypeNames), nil
+	default:
+		return nil, errors.Errorf("unsupported export format: %s", config.Format.String())
+	}
+}
+
+// writeResult writes all rows of the result as a single chunk and closes the row writer.
+func writeResult(rw RowWriter, result *v1pb.QueryResult) error {
+	if err := rw.WriteRows(result.Rows); err != nil {
+		_ = rw.Close()
+		return err
+	}
+	return rw.Close()
+}
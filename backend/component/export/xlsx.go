@@ -2,7 +2,6 @@ package export
 
 import (
 	"encoding/base64"
-	"fmt"
 	"io"
 	"strconv"
 
@@ -20,49 +19,74 @@ const (
 )
 
 // XLSX exports query results as XLSX format.
-// Note: XLSX still materializes in memory due to excelize library limitations.
 func XLSX(result *v1pb.QueryResult) ([]byte, error) {
+	return exportToBytes(result, XLSXToWriter)
+}
+
+// XLSXToWriter exports XLSX format to a writer.
+func XLSXToWriter(w io.Writer, result *v1pb.QueryResult) error {
+	xw, err := newXLSXRowWriter(w, result.ColumnNames)
+	if err != nil {
+		return err
+	}
+	return writeResult(xw, result)
+}
+
+// xlsxRowWriter writes the rows with the excelize stream writer,
+// which flushes rows to a temporary file once the buffer is large.
+type xlsxRowWriter struct {
+	w            io.Writer
+	file         *excelize.File
+	streamWriter *excelize.StreamWriter
+	rowCount     int
+}
+
+func newXLSXRowWriter(w io.Writer, columnNames []string) (*xlsxRowWriter, error) {
+	if len(columnNames) > ExcelMaxColumn {
+		return nil, errors.Errorf("column count cannot be greater than %v", ExcelMaxColumn)
+	}
 	f := excelize.NewFile()
-	defer f.Close()
-	index, err := f.NewSheet("Sheet1")
+	streamWriter, err := f.NewStreamWriter(sheet1Name)
 	if err != nil {
+		f.Close()
 		return nil, err
 	}
-	var columnPrefixes []string
-	for i, columnName := range result.ColumnNames {
-		columnPrefix, err := ExcelColumnName(i)
-		if err != nil {
-			return nil, err
+	header := make([]any, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		header = append(header, columnName)
+	}
+	if err := streamWriter.SetRow("A1", header); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &xlsxRowWriter{w: w, file: f, streamWriter: streamWriter}, nil
+}
+
+func (xw *xlsxRowWriter) WriteRows(rows []*v1pb.QueryRow) error {
+	for _, row := range rows {
+		values := make([]any, 0, len(row.Values))
+		for _, value := range row.Values {
+			values = append(values, convertValueToStringInXLSX(value))
 		}
-		columnPrefixes = append(columnPrefixes, columnPrefix)
-		if err := f.SetCellValue(sheet1Name, fmt.Sprintf("%s1", columnPrefix), columnName); err != nil {
-			return nil, err
+		// The first row is the header.
+		cell, err := excelize.CoordinatesToCellName(1, xw.rowCount+2)
+		if err != nil {
+			return err
 		}
-	}
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
-			columnName := fmt.Sprintf("%s%d", columnPrefixes[j], i+2)
-			if err := f.SetCellValue("Sheet1", columnName, convertValueToStringInXLSX(value)); err != nil {
-				return nil, err
-			}
+		if err := xw.streamWriter.SetRow(cell, values); err != nil {
+			return err
 		}
+		xw.rowCount++
 	}
-	f.SetActiveSheet(index)
-	excelBytes, err := f.WriteToBuffer()
-	if err != nil {
-		return nil, err
-	}
-	return excelBytes.Bytes(), nil
+	return nil
 }
 
-// XLSXToWriter exports XLSX format to a writer.
-// Note: XLSX still materializes in memory due to excelize library limitations.
-func XLSXToWriter(w io.Writer, result *v1pb.QueryResult) error {
-	content, err := XLSX(result)
-	if err != nil {
+func (xw *xlsxRowWriter) Close() error {
+	defer xw.file.Close()
+	if err := xw.streamWriter.Flush(); err != nil {
 		return err
 	}
-	_, err = w.Write(content)
+	_, err := xw.file.WriteTo(xw.w)
 	return err
 }
 
@@ -0,0 +1,135 @@
+// Package ha coordinates the Bytebase replicas sharing the same metadata database.
+package ha
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// leaderLockKey is the key of the advisory lock held by the leader, which is "bytebase" in ASCII.
+	leaderLockKey int64 = 0x6279746562617365
+	// electionInterval is the interval for campaigning for the leadership.
+	electionInterval = 5 * time.Second
+	// leaderCheckInterval is the interval for checking the leadership while leading.
+	leaderCheckInterval = time.Second
+	// leaderCheckTimeout is the timeout for checking the connection holding the leader lock.
+	leaderCheckTimeout = time.Second
+	// takeoverDelay is how long a new leader waits before starting the runners. A demoted leader notices
+	// the lost lock within leaderCheckInterval plus leaderCheckTimeout, so by then it has canceled its runners.
+	takeoverDelay = leaderCheckInterval + leaderCheckTimeout
+)
+
+// RunFunc is the Run method of a background runner.
+type RunFunc func(ctx context.Context, wg *sync.WaitGroup)
+
+// Elector elects one of the replicas as the leader with a Postgres advisory lock, and runs the background
+// runners that must not run concurrently, e.g. the task run scheduler, on the leader only.
+//
+// The lock is released once the metadata database ends the session of the leader, which the leader only learns
+// by checking the connection. The new leader waits takeoverDelay before starting the runners to cover the check,
+// but the runners of the demoted leader may still be running after that. So the runners are fenced with the
+// session of the lock, see store.WithFence:
+//   - the task run scheduler only starts executing a task run while the session holds the lock, and the task
+//     runs executing when the lock is lost are canceled and left RUNNING for the new leader to run again.
+//     A statement already sent to the database may still be finishing until the database handles the cancel.
+//   - the other runners are idempotent under overlap: the plan check scheduler reruns the checks which only read
+//     the databases and overwrites the results, the schema syncer overwrites the synced metadata, the approval
+//     runner finds the same approval template for an issue, and the export archive cleaner deletes the expired
+//     archives only.
+type Elector struct {
+	store   *store.Store
+	runners []RunFunc
+
+	isLeader atomic.Bool
+}
+
+// NewElector creates a new Elector.
+func NewElector(store *store.Store, runners ...RunFunc) *Elector {
+	return &Elector{
+		store:   store,
+		runners: runners,
+	}
+}
+
+// IsLeader returns whether the replica is the leader.
+func (e *Elector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// Run campaigns for the leadership until the context is canceled.
+func (e *Elector) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(electionInterval)
+	defer ticker.Stop()
+	slog.Debug("Leader elector started")
+
+	for {
+		lock, err := e.store.TryAdvisoryLock(ctx, leaderLockKey)
+		if err != nil {
+			slog.Error("failed to acquire the leader lock", log.BBError(err))
+		} else if lock != nil {
+			e.lead(ctx, lock)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// lead runs the runners until the leadership is lost or the context is canceled.
+func (e *Elector) lead(ctx context.Context, lock *store.AdvisoryLock) {
+	leaderCtx, cancel := context.WithCancel(store.WithFence(ctx, lock))
+	var runnerWG sync.WaitGroup
+	defer func() {
+		e.isLeader.Store(false)
+		cancel()
+		runnerWG.Wait()
+		// The context may be canceled already on shutdown.
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), leaderCheckTimeout)
+		defer releaseCancel()
+		if err := lock.Release(releaseCtx); err != nil {
+			slog.Warn("failed to release the leader lock", log.BBError(err))
+		}
+	}()
+
+	slog.Info("Became the leader, starting the background runners", slog.Duration("delay", takeoverDelay))
+	select {
+	case <-time.After(takeoverDelay):
+	case <-ctx.Done():
+		return
+	}
+	e.isLeader.Store(true)
+	for _, run := range e.runners {
+		runnerWG.Add(1)
+		go run(leaderCtx, &runnerWG)
+	}
+
+	ticker := time.NewTicker(leaderCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			checkCtx, checkCancel := context.WithTimeout(ctx, leaderCheckTimeout)
+			err := lock.Ping(checkCtx)
+			checkCancel()
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("Lost the leadership, stopping the background runners", log.BBError(err))
+				}
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
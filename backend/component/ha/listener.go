@@ -0,0 +1,135 @@
+package ha
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// listenRetryInterval is the delay before listening again after the connection fails.
+const listenRetryInterval = 5 * time.Second
+
+var notifyChannels = []string{
+	store.NotifyChannelTaskRunTickle,
+	store.NotifyChannelPlanCheckTickle,
+	store.NotifyChannelTaskSkippedOrDone,
+	store.NotifyChannelApprovalFinding,
+	store.NotifyChannelTaskRunCancel,
+	store.NotifyChannelPlanCheckRunCancel,
+	store.NotifyChannelAccessTokenExpired,
+}
+
+// Listener delivers the signals broadcast by the replicas to the in-memory state of this replica.
+// The signals for the background runners are dropped unless the replica is the leader.
+type Listener struct {
+	store    *store.Store
+	stateCfg *state.State
+	elector  *Elector
+}
+
+// NewListener creates a new Listener.
+func NewListener(store *store.Store, stateCfg *state.State, elector *Elector) *Listener {
+	return &Listener{
+		store:    store,
+		stateCfg: stateCfg,
+		elector:  elector,
+	}
+}
+
+// Run listens on the notification channels until the context is canceled.
+func (l *Listener) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	slog.Debug("Notification listener started")
+
+	for {
+		err := l.store.Listen(ctx, notifyChannels, func(channel, payload string) {
+			if err := l.handle(ctx, channel, payload); err != nil {
+				slog.Error("failed to handle notification", slog.String("channel", channel), log.BBError(err))
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Error("failed to listen for notifications", log.BBError(err))
+
+		select {
+		case <-time.After(listenRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (l *Listener) handle(ctx context.Context, channel, payload string) error {
+	switch channel {
+	case store.NotifyChannelAccessTokenExpired:
+		// The payload is the hash of the access token.
+		l.stateCfg.ExpireCache.Add(payload, true)
+		return nil
+	case store.NotifyChannelTaskRunCancel:
+		uid, err := strconv.Atoi(payload)
+		if err != nil {
+			return errors.Wrapf(err, "invalid task run uid %q", payload)
+		}
+		state.CancelRunning(&l.stateCfg.RunningTaskRunsCancelFunc, uid)
+		return nil
+	case store.NotifyChannelPlanCheckRunCancel:
+		uid, err := strconv.Atoi(payload)
+		if err != nil {
+			return errors.Wrapf(err, "invalid plan check run uid %q", payload)
+		}
+		state.CancelRunning(&l.stateCfg.RunningPlanCheckRunsCancelFunc, uid)
+		return nil
+	}
+
+	// The background runners only run on the leader, and pick up the pending work when they start.
+	if !l.elector.IsLeader() {
+		return nil
+	}
+	switch channel {
+	case store.NotifyChannelTaskRunTickle:
+		tickle(l.stateCfg.TaskRunTickleChan)
+	case store.NotifyChannelPlanCheckTickle:
+		tickle(l.stateCfg.PlanCheckTickleChan)
+	case store.NotifyChannelTaskSkippedOrDone:
+		uid, err := strconv.Atoi(payload)
+		if err != nil {
+			return errors.Wrapf(err, "invalid task uid %q", payload)
+		}
+		select {
+		case l.stateCfg.TaskSkippedOrDoneChan <- uid:
+		case <-ctx.Done():
+		}
+	case store.NotifyChannelApprovalFinding:
+		uid, err := strconv.Atoi(payload)
+		if err != nil {
+			return errors.Wrapf(err, "invalid issue uid %q", payload)
+		}
+		issue, err := l.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &uid})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get issue %d", uid)
+		}
+		if issue != nil {
+			l.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+		}
+	default:
+		return errors.Errorf("unexpected channel %q", channel)
+	}
+	return nil
+}
+
+// tickle wakes up the scheduler without blocking, since a full channel already has pending tickles.
+func tickle(ch chan int) {
+	select {
+	case ch <- 0:
+	default:
+	}
+}
@@ -0,0 +1,41 @@
+package ha
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestListenerHandle(t *testing.T) {
+	ctx := context.Background()
+	stateCfg, err := state.New()
+	require.NoError(t, err)
+	elector := &Elector{}
+	l := NewListener(nil, stateCfg, elector)
+
+	// The signals for the in-memory state of every replica are delivered regardless of the leadership.
+	require.NoError(t, l.handle(ctx, store.NotifyChannelAccessTokenExpired, state.HashAccessToken("token")))
+	require.True(t, stateCfg.IsAccessTokenExpired("token"))
+	require.False(t, stateCfg.IsAccessTokenExpired("other"))
+
+	canceled := false
+	stateCfg.RunningTaskRunsCancelFunc.Store(1, context.CancelFunc(func() { canceled = true }))
+	require.NoError(t, l.handle(ctx, store.NotifyChannelTaskRunCancel, "1"))
+	require.True(t, canceled)
+	require.Error(t, l.handle(ctx, store.NotifyChannelPlanCheckRunCancel, "x"))
+
+	// The signals for the background runners are dropped unless the replica is the leader.
+	require.NoError(t, l.handle(ctx, store.NotifyChannelTaskRunTickle, ""))
+	require.Len(t, stateCfg.TaskRunTickleChan, 0)
+
+	elector.isLeader.Store(true)
+	require.NoError(t, l.handle(ctx, store.NotifyChannelTaskRunTickle, ""))
+	require.Len(t, stateCfg.TaskRunTickleChan, 1)
+	require.NoError(t, l.handle(ctx, store.NotifyChannelTaskSkippedOrDone, "2"))
+	require.Equal(t, 2, <-stateCfg.TaskSkippedOrDoneChan)
+	require.Error(t, l.handle(ctx, "unknown", ""))
+}
@@ -51,7 +51,11 @@ func NewManager(store *store.Store, licenseService *enterprise.LicenseService) (
 // Check if the user has permission on the resource hierarchy.
 // CEL on the binding is not considered.
 // When multiple projects are specified, the user should have permission on every projects.
+// The permission is also limited by the personal access token authenticating the request.
 func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
+	if scope, ok := common.GetPersonalAccessTokenScopeFromContext(ctx); ok && !scope.Allows(p) {
+		return false, nil
+	}
 	policyMessage, err := m.store.GetWorkspaceIamPolicy(ctx)
 	if err != nil {
 		return false, err
@@ -36,6 +36,7 @@ const (
 	PermissionIssueCommentsCreate     Permission = "bb.issueComments.create"
 	PermissionIssueCommentsList       Permission = "bb.issueComments.list"
 	PermissionIssueCommentsUpdate     Permission = "bb.issueComments.update"
+	PermissionIssuesBreakGlass        Permission = "bb.issues.breakGlass"
 	PermissionIssuesCreate            Permission = "bb.issues.create"
 	PermissionIssuesGet               Permission = "bb.issues.get"
 	PermissionIssuesList              Permission = "bb.issues.list"
@@ -153,6 +154,7 @@ var allPermissions = []Permission{
 	PermissionIssueCommentsCreate,
 	PermissionIssueCommentsList,
 	PermissionIssueCommentsUpdate,
+	PermissionIssuesBreakGlass,
 	PermissionIssuesCreate,
 	PermissionIssuesGet,
 	PermissionIssuesList,
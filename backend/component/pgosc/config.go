@@ -0,0 +1,171 @@
+// Package pgosc implements the online schema change for PostgreSQL.
+//
+// The migration copies the table into a shadow table with the new schema,
+// captures concurrent changes with a trigger, and swaps the tables at cutover.
+package pgosc
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var defaultConfig = struct {
+	chunkSize                 int64
+	replayBatchSize           int64
+	niceRatio                 float64
+	defaultRetries            int64
+	cutoverLockTimeoutSeconds int64
+	okToDropTable             bool
+}{
+	chunkSize:                 1000,  // chunk-size
+	replayBatchSize:           10000, // replay-batch-size
+	niceRatio:                 0,     // nice-ratio
+	defaultRetries:            60,    // default-retries
+	cutoverLockTimeoutSeconds: 3,     // cut-over-lock-timeout-seconds
+	okToDropTable:             false, // ok-to-drop-table
+}
+
+// UserFlags are the flags set by users for the online schema change.
+type UserFlags struct {
+	chunkSize                 *int64
+	replayBatchSize           *int64
+	niceRatio                 *float64
+	defaultRetries            *int64
+	cutoverLockTimeoutSeconds *int64
+	okToDropTable             *bool
+}
+
+var knownKeys = map[string]bool{
+	"chunk-size":                    true,
+	"replay-batch-size":             true,
+	"nice-ratio":                    true,
+	"default-retries":               true,
+	"cut-over-lock-timeout-seconds": true,
+	"ok-to-drop-table":              true,
+}
+
+// GetUserFlags parses and validates the user flags.
+func GetUserFlags(flags map[string]string) (*UserFlags, error) {
+	f := &UserFlags{}
+	if flags == nil {
+		return f, nil
+	}
+
+	for k := range flags {
+		if !knownKeys[k] {
+			return nil, errors.Errorf("unsupported flag: %s", k)
+		}
+	}
+
+	if v, ok := flags["chunk-size"]; ok {
+		chunkSize, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert chunk-size %q to int", v)
+		}
+		if chunkSize <= 0 {
+			return nil, errors.Errorf("chunk-size must be positive, got %d", chunkSize)
+		}
+		f.chunkSize = &chunkSize
+	}
+	if v, ok := flags["replay-batch-size"]; ok {
+		replayBatchSize, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert replay-batch-size %q to int", v)
+		}
+		if replayBatchSize <= 0 {
+			return nil, errors.Errorf("replay-batch-size must be positive, got %d", replayBatchSize)
+		}
+		f.replayBatchSize = &replayBatchSize
+	}
+	if v, ok := flags["nice-ratio"]; ok {
+		niceRatio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert nice-ratio %q to float", v)
+		}
+		if niceRatio < 0 {
+			return nil, errors.Errorf("nice-ratio must not be negative, got %v", niceRatio)
+		}
+		f.niceRatio = &niceRatio
+	}
+	if v, ok := flags["default-retries"]; ok {
+		defaultRetries, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert default-retries %q to int", v)
+		}
+		if defaultRetries <= 0 {
+			return nil, errors.Errorf("default-retries must be positive, got %d", defaultRetries)
+		}
+		f.defaultRetries = &defaultRetries
+	}
+	if v, ok := flags["cut-over-lock-timeout-seconds"]; ok {
+		cutoverLockTimeoutSeconds, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert cut-over-lock-timeout-seconds %q to int", v)
+		}
+		if cutoverLockTimeoutSeconds <= 0 {
+			return nil, errors.Errorf("cut-over-lock-timeout-seconds must be positive, got %d", cutoverLockTimeoutSeconds)
+		}
+		f.cutoverLockTimeoutSeconds = &cutoverLockTimeoutSeconds
+	}
+	if v, ok := flags["ok-to-drop-table"]; ok {
+		okToDropTable, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert ok-to-drop-table %q to bool", v)
+		}
+		f.okToDropTable = &okToDropTable
+	}
+	return f, nil
+}
+
+// Config is the effective configuration of an online schema change.
+type Config struct {
+	// ChunkSize is the number of rows copied in each backfill chunk.
+	ChunkSize int64
+	// ReplayBatchSize is the maximum number of captured changes replayed in one transaction.
+	ReplayBatchSize int64
+	// NiceRatio is the ratio of sleep time to the time spent on each chunk.
+	NiceRatio float64
+	// DefaultRetries is the number of cutover attempts.
+	DefaultRetries int64
+	// CutoverLockTimeout is the lock timeout of each cutover attempt.
+	CutoverLockTimeout time.Duration
+	// OkToDropTable drops the original table after cutover.
+	OkToDropTable bool
+}
+
+// NewConfig returns the configuration with the user flags applied to the defaults.
+func NewConfig(flags map[string]string) (*Config, error) {
+	userFlags, err := GetUserFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{
+		ChunkSize:          defaultConfig.chunkSize,
+		ReplayBatchSize:    defaultConfig.replayBatchSize,
+		NiceRatio:          defaultConfig.niceRatio,
+		DefaultRetries:     defaultConfig.defaultRetries,
+		CutoverLockTimeout: time.Duration(defaultConfig.cutoverLockTimeoutSeconds) * time.Second,
+		OkToDropTable:      defaultConfig.okToDropTable,
+	}
+	if v := userFlags.chunkSize; v != nil {
+		c.ChunkSize = *v
+	}
+	if v := userFlags.replayBatchSize; v != nil {
+		c.ReplayBatchSize = *v
+	}
+	if v := userFlags.niceRatio; v != nil {
+		c.NiceRatio = *v
+	}
+	if v := userFlags.defaultRetries; v != nil {
+		c.DefaultRetries = *v
+	}
+	if v := userFlags.cutoverLockTimeoutSeconds; v != nil {
+		c.CutoverLockTimeout = time.Duration(*v) * time.Second
+	}
+	if v := userFlags.okToDropTable; v != nil {
+		c.OkToDropTable = *v
+	}
+	return c, nil
+}
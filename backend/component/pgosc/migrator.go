@@ -0,0 +1,692 @@
+package pgosc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+// lockNotAvailable is the SQLSTATE raised when lock_timeout expires.
+const lockNotAvailable = "55P03"
+
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Migrator runs the online schema change of a table.
+//
+// The migration creates a shadow table with the new schema and a trigger that
+// records the primary keys of the changed rows into a log table. Rows are copied
+// into the shadow table in primary key order, then the logged changes are replayed
+// by re-reading the changed rows from the original table. At cutover, the original
+// table is locked, the remaining changes are replayed and the tables are swapped.
+type Migrator struct {
+	db       *sql.DB
+	table    *Table
+	commands string
+	config   *Config
+	names    objectNames
+
+	primaryKey []column
+	// columns are the columns copied from the original table to the shadow table.
+	columns []string
+	// foreignKeys are the validated foreign keys added to the shadow table as NOT VALID.
+	foreignKeys []string
+}
+
+// NewMigrator creates a migrator.
+func NewMigrator(db *sql.DB, table *Table, commands string, config *Config) *Migrator {
+	return &Migrator{
+		db:       db,
+		table:    table,
+		commands: commands,
+		config:   config,
+		names:    newObjectNames(table),
+	}
+}
+
+// Migrate runs the migration.
+func (m *Migrator) Migrate(ctx context.Context) (err error) {
+	validationResult := ValidateFeasibility(ctx, m.db, m.table)
+	if !validationResult.Valid {
+		_, content := validationResult.GetUserFriendlyError()
+		return errors.New(content)
+	}
+
+	swapped := false
+	defer func() {
+		// Use a new context because the migration context may be canceled.
+		cleanupCtx := context.Background()
+		if swapped {
+			if cleanupErr := m.dropTemporaryObjects(cleanupCtx); cleanupErr != nil {
+				slog.Warn("failed to cleanup online schema change objects", slog.String("table", m.table.String()), log.BBError(cleanupErr))
+			}
+			return
+		}
+		if cleanupErr := m.rollback(cleanupCtx); cleanupErr != nil {
+			slog.Warn("failed to rollback online schema change", slog.String("table", m.table.String()), log.BBError(cleanupErr))
+		}
+	}()
+
+	if err := m.prepare(ctx); err != nil {
+		return err
+	}
+	if err := m.backfill(ctx); err != nil {
+		return err
+	}
+	if err := m.replayAll(ctx, m.db); err != nil {
+		return err
+	}
+	if err := m.cutover(ctx); err != nil {
+		return err
+	}
+	swapped = true
+	slog.Info("online schema change completed", slog.String("table", m.table.String()))
+	return nil
+}
+
+// prepare creates the shadow table, the log table and the capture trigger.
+func (m *Migrator) prepare(ctx context.Context) error {
+	primaryKey, err := getPrimaryKey(ctx, m.db, m.table)
+	if err != nil {
+		return err
+	}
+	if len(primaryKey) == 0 {
+		return errors.Errorf("table %s does not have a primary key", m.table)
+	}
+	m.primaryKey = primaryKey
+
+	// CREATE TABLE LIKE does not copy foreign keys. They are added before the ALTER TABLE commands
+	// so that the commands can change them, and are validated after cutover.
+	foreignKeySQLs, err := m.foreignKeySQLs(ctx)
+	if err != nil {
+		return err
+	}
+	statements := []string{createShadowTableSQL(m.table, m.names)}
+	statements = append(statements, foreignKeySQLs...)
+	statements = append(statements,
+		alterShadowTableSQL(m.table, m.names, m.commands),
+		createLogTableSQL(m.table, m.names, m.primaryKey),
+		createCaptureFunctionSQL(m.table, m.names, m.primaryKey),
+		createCaptureTriggerSQL(m.table, m.names),
+	)
+	for _, statement := range statements {
+		if _, err := m.db.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+
+	columns, err := queryStrings(ctx, m.db, `
+		SELECT a.attname FROM pg_attribute a
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped
+			AND EXISTS (
+				SELECT 1 FROM pg_attribute s
+				WHERE s.attrelid = $2::regclass AND s.attname = a.attname AND s.attnum > 0 AND NOT s.attisdropped AND s.attgenerated = ''
+			)
+		ORDER BY a.attnum`, m.table.QuotedName(), m.shadowTable())
+	if err != nil {
+		return errors.Wrap(err, "failed to list columns")
+	}
+	copied := map[string]bool{}
+	for _, c := range columns {
+		copied[c] = true
+	}
+	for _, c := range m.primaryKey {
+		if !copied[c.name] {
+			return errors.Errorf("primary key column %q cannot be dropped or changed to a generated column in online schema change", c.name)
+		}
+	}
+	m.columns = columns
+	slog.Info("online schema change prepared", slog.String("table", m.table.String()), slog.String("shadow", m.names.shadowTable))
+	return nil
+}
+
+// foreignKeySQLs returns the statements adding the foreign keys of the original table to the shadow table.
+func (m *Migrator) foreignKeySQLs(ctx context.Context) ([]string, error) {
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT c.conname, pg_get_constraintdef(c.oid), c.convalidated FROM pg_constraint c
+		WHERE c.conrelid = $1::regclass AND c.contype = 'f'
+		ORDER BY c.conname`, m.table.QuotedName())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list foreign keys")
+	}
+	defer rows.Close()
+	var statements []string
+	for rows.Next() {
+		var name, definition string
+		var validated bool
+		if err := rows.Scan(&name, &definition, &validated); err != nil {
+			return nil, err
+		}
+		statements = append(statements, addForeignKeySQL(m.table, m.names, name, definition))
+		if validated {
+			m.foreignKeys = append(m.foreignKeys, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return statements, nil
+}
+
+// backfill copies the rows into the shadow table in chunks of primary key ranges.
+func (m *Migrator) backfill(ctx context.Context) error {
+	var lower []any
+	var copied int64
+	for {
+		start := time.Now()
+		upper, err := m.nextBoundary(ctx, lower)
+		if err != nil {
+			return err
+		}
+		args := append(append([]any{}, lower...), upper...)
+		result, err := m.db.ExecContext(ctx, copyChunkSQL(m.table, m.names, m.columns, m.primaryKey, lower != nil, upper != nil), args...)
+		if err != nil {
+			return errors.Wrap(err, "failed to copy rows into the shadow table")
+		}
+		if n, err := result.RowsAffected(); err == nil {
+			copied += n
+		}
+		if upper == nil {
+			break
+		}
+		lower = upper
+
+		if m.config.NiceRatio > 0 {
+			sleep := time.Duration(float64(time.Since(start)) * m.config.NiceRatio)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(sleep):
+			}
+		}
+	}
+	slog.Info("online schema change backfill completed", slog.String("table", m.table.String()), slog.Int64("rows", copied))
+	return nil
+}
+
+// nextBoundary returns the primary key of the last row of the chunk after lower.
+// It returns nil if the remaining rows fit in one chunk.
+func (m *Migrator) nextBoundary(ctx context.Context, lower []any) ([]any, error) {
+	values := make([]sql.NullString, len(m.primaryKey))
+	dest := make([]any, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := m.db.QueryRowContext(ctx, chunkBoundarySQL(m.table, m.primaryKey, m.config.ChunkSize, lower != nil), lower...).Scan(dest...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to get the chunk boundary")
+	}
+	upper := make([]any, len(values))
+	for i, v := range values {
+		upper[i] = v.String
+	}
+	return upper, nil
+}
+
+// replayAll replays the captured changes until the log table is empty.
+func (m *Migrator) replayAll(ctx context.Context, q queryer) error {
+	for {
+		n, err := m.replay(ctx, q)
+		if err != nil {
+			return err
+		}
+		if n < m.config.ReplayBatchSize {
+			return nil
+		}
+	}
+}
+
+// replay replays one batch of the captured changes and returns the number of the replayed changes.
+func (m *Migrator) replay(ctx context.Context, q queryer) (int64, error) {
+	if db, ok := q.(*sql.DB); ok {
+		// Use a consistent snapshot so that only the changes read by the replay are removed from the log.
+		tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to begin transaction")
+		}
+		defer tx.Rollback()
+		n, err := m.replay(ctx, tx)
+		if err != nil {
+			return 0, err
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, errors.Wrap(err, "failed to commit replay")
+		}
+		return n, nil
+	}
+
+	var maxID sql.NullInt64
+	if err := q.QueryRowContext(ctx, replayMaxIDSQL(m.table, m.names), m.config.ReplayBatchSize).Scan(&maxID); err != nil {
+		return 0, errors.Wrap(err, "failed to get captured changes")
+	}
+	if !maxID.Valid {
+		return 0, nil
+	}
+	var n int64
+	statements := replaySQLs(m.table, m.names, m.columns, m.primaryKey)
+	for i, statement := range statements {
+		result, err := q.ExecContext(ctx, statement, maxID.Int64)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to replay captured changes")
+		}
+		// The last statement removes the replayed changes from the log.
+		if i == len(statements)-1 {
+			if n, err = result.RowsAffected(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// cutover swaps the tables, retrying if the lock cannot be acquired in time.
+func (m *Migrator) cutover(ctx context.Context) error {
+	var err error
+	for attempt := int64(1); attempt <= m.config.DefaultRetries; attempt++ {
+		err = m.tryCutover(ctx)
+		if err == nil {
+			return nil
+		}
+		var pge *pgconn.PgError
+		if !errors.As(err, &pge) || pge.Code != lockNotAvailable {
+			return err
+		}
+		slog.Info("online schema change cutover lock timeout, retrying", slog.String("table", m.table.String()), slog.Int64("attempt", attempt))
+		// Catch up with the changes made while waiting for the lock.
+		if err := m.replayAll(ctx, m.db); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	return errors.Wrapf(err, "failed to cutover after %d attempts", m.config.DefaultRetries)
+}
+
+func (m *Migrator) tryCutover(ctx context.Context) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL lock_timeout = %d", m.config.CutoverLockTimeout.Milliseconds())); err != nil {
+		return errors.Wrap(err, "failed to set lock timeout")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", m.table.QuotedName())); err != nil {
+		return err
+	}
+	// No more changes can be made to the original table, so the log can be drained.
+	if err := m.replayAll(ctx, tx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TRIGGER %s ON %s", quoteIdentifier(m.names.trigger), m.table.QuotedName())); err != nil {
+		return errors.Wrap(err, "failed to drop capture trigger")
+	}
+
+	statements, err := m.carryOverSQLs(ctx, tx)
+	if err != nil {
+		return err
+	}
+	statements = append(statements, swapTablesSQLs(m.table, m.names)...)
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit cutover")
+	}
+	slog.Info("online schema change cutover completed", slog.String("table", m.table.String()))
+	return nil
+}
+
+// carryOverSQLs returns the statements carrying over the properties that are not copied by CREATE TABLE LIKE,
+// including the sequences, the owner, the privileges and the index names.
+func (m *Migrator) carryOverSQLs(ctx context.Context, q queryer) ([]string, error) {
+	var statements []string
+	shadow := m.shadowTable()
+	copied := map[string]bool{}
+	for _, c := range m.columns {
+		copied[c] = true
+	}
+
+	// Sequences.
+	rows, err := q.QueryContext(ctx, `
+		SELECT a.attname, a.attidentity <> '', pg_get_serial_sequence($1, a.attname), COALESCE(pg_get_serial_sequence($2, a.attname), '')
+		FROM pg_attribute a
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped AND pg_get_serial_sequence($1, a.attname) IS NOT NULL
+		ORDER BY a.attnum`, m.table.QuotedName(), shadow)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sequences")
+	}
+	for rows.Next() {
+		var name, sequence, shadowSequence string
+		var identity bool
+		if err := rows.Scan(&name, &identity, &sequence, &shadowSequence); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if !copied[name] {
+			continue
+		}
+		if identity {
+			// The shadow table has its own identity sequence.
+			if shadowSequence != "" && shadowSequence != sequence {
+				statements = append(statements, fmt.Sprintf("SELECT setval('%s', s.last_value, s.is_called) FROM %s s", strings.ReplaceAll(shadowSequence, "'", "''"), sequence))
+			}
+			continue
+		}
+		// The serial sequence would be dropped with the original table.
+		statements = append(statements, fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s.%s", sequence, shadow, quoteIdentifier(name)))
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	// Owner.
+	var owner string
+	var isCurrentUser bool
+	if err := q.QueryRowContext(ctx, `
+		SELECT pg_get_userbyid(c.relowner), c.relowner = (SELECT oid FROM pg_roles WHERE rolname = current_user)
+		FROM pg_class c WHERE c.oid = $1::regclass`, m.table.QuotedName()).Scan(&owner, &isCurrentUser); err != nil {
+		return nil, errors.Wrap(err, "failed to get table owner")
+	}
+	if !isCurrentUser {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s OWNER TO %s", shadow, quoteIdentifier(owner)))
+	}
+
+	// Privileges.
+	rows, err = q.QueryContext(ctx, `
+		SELECT CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE quote_ident(pg_get_userbyid(a.grantee)) END, a.privilege_type, a.is_grantable
+		FROM pg_class c, aclexplode(c.relacl) a
+		WHERE c.oid = $1::regclass AND a.grantee <> c.relowner
+		ORDER BY 1, 2`, m.table.QuotedName())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list privileges")
+	}
+	for rows.Next() {
+		var grantee, privilege string
+		var grantable bool
+		if err := rows.Scan(&grantee, &privilege, &grantable); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		statement := fmt.Sprintf("GRANT %s ON %s TO %s", privilege, shadow, grantee)
+		if grantable {
+			statement += " WITH GRANT OPTION"
+		}
+		statements = append(statements, statement)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	// Index names. Indexes are matched by their definitions without the names.
+	originalIndexes, err := listIndexes(ctx, q, m.table.QuotedName())
+	if err != nil {
+		return nil, err
+	}
+	shadowIndexes, err := listIndexes(ctx, q, shadow)
+	if err != nil {
+		return nil, err
+	}
+	statements = append(statements, renameIndexSQLs(m.table.Schema, originalIndexes, shadowIndexes)...)
+	return statements, nil
+}
+
+// rollback drops the objects created by the migration before cutover.
+func (m *Migrator) rollback(ctx context.Context) error {
+	var errs []string
+	for _, statement := range []string{
+		fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", quoteIdentifier(m.names.trigger), m.table.QuotedName()),
+		fmt.Sprintf("DROP FUNCTION IF EXISTS %s.%s()", quoteIdentifier(m.table.Schema), quoteIdentifier(m.names.function)),
+		fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", quoteIdentifier(m.table.Schema), quoteIdentifier(m.names.logTable)),
+		fmt.Sprintf("DROP TABLE IF EXISTS %s", m.shadowTable()),
+	} {
+		if _, err := m.db.ExecContext(ctx, statement); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// dropTemporaryObjects drops the objects left after cutover and validates the foreign keys.
+func (m *Migrator) dropTemporaryObjects(ctx context.Context) error {
+	// The foreign keys may be dropped by the ALTER TABLE commands.
+	foreignKeys, err := queryStrings(ctx, m.db, `
+		SELECT c.conname FROM pg_constraint c
+		WHERE c.conrelid = $1::regclass AND c.contype = 'f' AND NOT c.convalidated AND c.conname = ANY($2)
+		ORDER BY c.conname`, m.table.QuotedName(), m.foreignKeys)
+	if err != nil {
+		return errors.Wrap(err, "failed to list foreign keys")
+	}
+	var statements []string
+	for _, name := range foreignKeys {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", m.table.QuotedName(), quoteIdentifier(name)))
+	}
+	statements = append(statements,
+		fmt.Sprintf("DROP FUNCTION IF EXISTS %s.%s()", quoteIdentifier(m.table.Schema), quoteIdentifier(m.names.function)),
+		fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", quoteIdentifier(m.table.Schema), quoteIdentifier(m.names.logTable)),
+	)
+	if m.config.OkToDropTable {
+		statements = append(statements, fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", quoteIdentifier(m.table.Schema), quoteIdentifier(m.names.oldTable)))
+	}
+	for _, statement := range statements {
+		if _, err := m.db.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) shadowTable() string {
+	return quoteIdentifier(m.table.Schema) + "." + quoteIdentifier(m.names.shadowTable)
+}
+
+// index is an index with its definition without the index and table names.
+type index struct {
+	name       string
+	definition string
+}
+
+func listIndexes(ctx context.Context, q queryer, table string) ([]index, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT c.relname, i.indisunique, pg_get_indexdef(i.indexrelid)
+		FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid
+		WHERE i.indrelid = $1::regclass
+		ORDER BY c.relname`, table)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list indexes")
+	}
+	defer rows.Close()
+	var indexes []index
+	for rows.Next() {
+		var name, definition string
+		var unique bool
+		if err := rows.Scan(&name, &unique, &definition); err != nil {
+			return nil, err
+		}
+		// CREATE [UNIQUE] INDEX name ON table USING method (columns) ...
+		if i := strings.Index(definition, " USING "); i >= 0 {
+			definition = definition[i:]
+		}
+		indexes = append(indexes, index{name: name, definition: fmt.Sprintf("%t%s", unique, definition)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+func createShadowTableSQL(table *Table, names objectNames) string {
+	return fmt.Sprintf("CREATE TABLE %s.%s (LIKE %s INCLUDING ALL)", quoteIdentifier(table.Schema), quoteIdentifier(names.shadowTable), table.QuotedName())
+}
+
+func addForeignKeySQL(table *Table, names objectNames, name, definition string) string {
+	return fmt.Sprintf("ALTER TABLE %s.%s ADD CONSTRAINT %s %s NOT VALID", quoteIdentifier(table.Schema), quoteIdentifier(names.shadowTable), quoteIdentifier(name), definition)
+}
+
+func alterShadowTableSQL(table *Table, names objectNames, commands string) string {
+	return fmt.Sprintf("ALTER TABLE %s.%s %s", quoteIdentifier(table.Schema), quoteIdentifier(names.shadowTable), commands)
+}
+
+func createLogTableSQL(table *Table, names objectNames, primaryKey []column) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "CREATE TABLE %s.%s (_osc_id BIGSERIAL PRIMARY KEY", quoteIdentifier(table.Schema), quoteIdentifier(names.logTable))
+	for _, c := range primaryKey {
+		fmt.Fprintf(&buf, ", %s %s NOT NULL", quoteIdentifier(c.name), c.dataType)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func createCaptureFunctionSQL(table *Table, names objectNames, primaryKey []column) string {
+	logTable := quoteIdentifier(table.Schema) + "." + quoteIdentifier(names.logTable)
+	columns := quoteIdentifiers(columnNames(primaryKey))
+	var oldValues, newValues []string
+	for _, c := range primaryKey {
+		oldValues = append(oldValues, "OLD."+quoteIdentifier(c.name))
+		newValues = append(newValues, "NEW."+quoteIdentifier(c.name))
+	}
+	return fmt.Sprintf(`CREATE FUNCTION %s.%s() RETURNS trigger LANGUAGE plpgsql AS $osc$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    INSERT INTO %s (%s) VALUES (%s);
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    INSERT INTO %s (%s) VALUES (%s);
+  END IF;
+  RETURN NULL;
+END;
+$osc$`,
+		quoteIdentifier(table.Schema), quoteIdentifier(names.function),
+		logTable, columns, strings.Join(oldValues, ", "),
+		logTable, columns, strings.Join(newValues, ", "),
+	)
+}
+
+func createCaptureTriggerSQL(table *Table, names objectNames) string {
+	return fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE FUNCTION %s.%s()",
+		quoteIdentifier(names.trigger), table.QuotedName(), quoteIdentifier(table.Schema), quoteIdentifier(names.function))
+}
+
+// primaryKeyTuple returns "(col1, col2)" and "($n::type1, $n+1::type2)" for the primary key.
+func primaryKeyTuple(primaryKey []column, firstParam int) (string, string) {
+	var params []string
+	for i, c := range primaryKey {
+		params = append(params, fmt.Sprintf("$%d::%s", firstParam+i, c.dataType))
+	}
+	return "(" + quoteIdentifiers(columnNames(primaryKey)) + ")", "(" + strings.Join(params, ", ") + ")"
+}
+
+func chunkBoundarySQL(table *Table, primaryKey []column, chunkSize int64, hasLower bool) string {
+	var selects []string
+	for _, c := range primaryKey {
+		selects = append(selects, quoteIdentifier(c.name)+"::text")
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "SELECT %s FROM ONLY %s", strings.Join(selects, ", "), table.QuotedName())
+	if hasLower {
+		columns, params := primaryKeyTuple(primaryKey, 1)
+		fmt.Fprintf(&buf, " WHERE %s > %s", columns, params)
+	}
+	fmt.Fprintf(&buf, " ORDER BY %s LIMIT 1 OFFSET %d", quoteIdentifiers(columnNames(primaryKey)), chunkSize-1)
+	return buf.String()
+}
+
+func copyChunkSQL(table *Table, names objectNames, columns []string, primaryKey []column, hasLower, hasUpper bool) string {
+	quotedColumns := quoteIdentifiers(columns)
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "INSERT INTO %s.%s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM ONLY %s",
+		quoteIdentifier(table.Schema), quoteIdentifier(names.shadowTable), quotedColumns, quotedColumns, table.QuotedName())
+	var conditions []string
+	param := 1
+	if hasLower {
+		keyColumns, params := primaryKeyTuple(primaryKey, param)
+		conditions = append(conditions, fmt.Sprintf("%s > %s", keyColumns, params))
+		param += len(primaryKey)
+	}
+	if hasUpper {
+		keyColumns, params := primaryKeyTuple(primaryKey, param)
+		conditions = append(conditions, fmt.Sprintf("%s <= %s", keyColumns, params))
+	}
+	if len(conditions) > 0 {
+		fmt.Fprintf(&buf, " WHERE %s", strings.Join(conditions, " AND "))
+	}
+	buf.WriteString(" ON CONFLICT DO NOTHING")
+	return buf.String()
+}
+
+func replayMaxIDSQL(table *Table, names objectNames) string {
+	return fmt.Sprintf("SELECT max(_osc_id) FROM (SELECT _osc_id FROM %s.%s ORDER BY _osc_id LIMIT $1) AS batch",
+		quoteIdentifier(table.Schema), quoteIdentifier(names.logTable))
+}
+
+// replaySQLs returns the statements replaying the changes captured up to $1.
+// The changed rows are deleted from the shadow table and copied again from the original table.
+func replaySQLs(table *Table, names objectNames, columns []string, primaryKey []column) []string {
+	logTable := quoteIdentifier(table.Schema) + "." + quoteIdentifier(names.logTable)
+	shadowTable := quoteIdentifier(table.Schema) + "." + quoteIdentifier(names.shadowTable)
+	keyColumns := quoteIdentifiers(columnNames(primaryKey))
+	quotedColumns := quoteIdentifiers(columns)
+	var joins []string
+	for _, c := range primaryKey {
+		joins = append(joins, fmt.Sprintf("s.%s = l.%s", quoteIdentifier(c.name), quoteIdentifier(c.name)))
+	}
+	return []string{
+		fmt.Sprintf("DELETE FROM %s AS s USING (SELECT DISTINCT %s FROM %s WHERE _osc_id <= $1) AS l WHERE %s",
+			shadowTable, keyColumns, logTable, strings.Join(joins, " AND ")),
+		fmt.Sprintf("INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM ONLY %s WHERE (%s) IN (SELECT %s FROM %s WHERE _osc_id <= $1) ON CONFLICT DO NOTHING",
+			shadowTable, quotedColumns, quotedColumns, table.QuotedName(), keyColumns, keyColumns, logTable),
+		fmt.Sprintf("DELETE FROM %s WHERE _osc_id <= $1", logTable),
+	}
+}
+
+func renameIndexSQLs(schema string, originalIndexes, shadowIndexes []index) []string {
+	var statements []string
+	used := map[string]bool{}
+	for _, original := range originalIndexes {
+		for _, shadow := range shadowIndexes {
+			if used[shadow.name] || shadow.definition != original.definition {
+				continue
+			}
+			used[shadow.name] = true
+			statements = append(statements,
+				fmt.Sprintf("ALTER INDEX %s.%s RENAME TO %s", quoteIdentifier(schema), quoteIdentifier(original.name), quoteIdentifier(temporaryName(original.name, "_osc_old"))),
+				fmt.Sprintf("ALTER INDEX %s.%s RENAME TO %s", quoteIdentifier(schema), quoteIdentifier(shadow.name), quoteIdentifier(original.name)),
+			)
+			break
+		}
+	}
+	return statements
+}
+
+func swapTablesSQLs(table *Table, names objectNames) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table.QuotedName(), quoteIdentifier(names.oldTable)),
+		fmt.Sprintf("ALTER TABLE %s.%s RENAME TO %s", quoteIdentifier(table.Schema), quoteIdentifier(names.shadowTable), quoteIdentifier(table.Name)),
+	}
+}
@@ -0,0 +1,95 @@
+package pgosc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfig(t *testing.T) {
+	a := require.New(t)
+
+	config, err := NewConfig(nil)
+	a.NoError(err)
+	a.Equal(&Config{
+		ChunkSize:          1000,
+		ReplayBatchSize:    10000,
+		DefaultRetries:     60,
+		CutoverLockTimeout: 3 * time.Second,
+	}, config)
+
+	config, err = NewConfig(map[string]string{
+		"chunk-size":                    "500",
+		"cut-over-lock-timeout-seconds": "5",
+		"ok-to-drop-table":              "true",
+	})
+	a.NoError(err)
+	a.Equal(int64(500), config.ChunkSize)
+	a.Equal(5*time.Second, config.CutoverLockTimeout)
+	a.True(config.OkToDropTable)
+
+	for _, flags := range []map[string]string{
+		{"max-load": "Threads_running=25"},
+		{"chunk-size": "abc"},
+		{"chunk-size": "0"},
+		{"nice-ratio": "-1"},
+		{"ok-to-drop-table": "maybe"},
+	} {
+		_, err := GetUserFlags(flags)
+		a.Error(err, flags)
+	}
+}
+
+func TestTemporaryName(t *testing.T) {
+	a := require.New(t)
+	a.Equal("_orders_osc_new", temporaryName("orders", "_osc_new"))
+
+	name := temporaryName(strings.Repeat("a", 63), "_osc_capture")
+	a.Len(name, maxIdentifierLength)
+	a.True(strings.HasSuffix(name, "_osc_capture"))
+
+	// Multi-byte characters are not cut in the middle.
+	name = temporaryName(strings.Repeat("表", 21), "_osc_new")
+	a.LessOrEqual(len(name), maxIdentifierLength)
+	a.Equal("_"+strings.Repeat("表", 18)+"_osc_new", name)
+}
+
+func TestMigrationSQL(t *testing.T) {
+	a := require.New(t)
+	table := &Table{Schema: "public", Name: "orders"}
+	names := newObjectNames(table)
+	primaryKey := []column{{name: "tenant", dataType: "text"}, {name: "id", dataType: "bigint"}}
+	columns := []string{"tenant", "id", "amount"}
+
+	a.Equal(`CREATE TABLE "public"."_orders_osc_new" (LIKE "public"."orders" INCLUDING ALL)`, createShadowTableSQL(table, names))
+	a.Equal(`ALTER TABLE "public"."_orders_osc_new" ADD COLUMN note TEXT`, alterShadowTableSQL(table, names, "ADD COLUMN note TEXT"))
+	a.Equal(`CREATE TABLE "public"."_orders_osc_log" (_osc_id BIGSERIAL PRIMARY KEY, "tenant" text NOT NULL, "id" bigint NOT NULL)`, createLogTableSQL(table, names, primaryKey))
+	a.Equal(`CREATE TRIGGER "_orders_osc_capture" AFTER INSERT OR UPDATE OR DELETE ON "public"."orders" FOR EACH ROW EXECUTE FUNCTION "public"."_orders_osc_capture"()`, createCaptureTriggerSQL(table, names))
+	a.Contains(createCaptureFunctionSQL(table, names, primaryKey), `INSERT INTO "public"."_orders_osc_log" ("tenant", "id") VALUES (OLD."tenant", OLD."id");`)
+
+	a.Equal(`SELECT "tenant"::text, "id"::text FROM ONLY "public"."orders" ORDER BY "tenant", "id" LIMIT 1 OFFSET 999`, chunkBoundarySQL(table, primaryKey, 1000, false))
+	a.Equal(`SELECT "tenant"::text, "id"::text FROM ONLY "public"."orders" WHERE ("tenant", "id") > ($1::text, $2::bigint) ORDER BY "tenant", "id" LIMIT 1 OFFSET 999`, chunkBoundarySQL(table, primaryKey, 1000, true))
+	a.Equal(`INSERT INTO "public"."_orders_osc_new" ("tenant", "id", "amount") OVERRIDING SYSTEM VALUE SELECT "tenant", "id", "amount" FROM ONLY "public"."orders" WHERE ("tenant", "id") > ($1::text, $2::bigint) AND ("tenant", "id") <= ($3::text, $4::bigint) ON CONFLICT DO NOTHING`, copyChunkSQL(table, names, columns, primaryKey, true, true))
+	a.Equal(`INSERT INTO "public"."_orders_osc_new" ("tenant", "id", "amount") OVERRIDING SYSTEM VALUE SELECT "tenant", "id", "amount" FROM ONLY "public"."orders" WHERE ("tenant", "id") <= ($1::text, $2::bigint) ON CONFLICT DO NOTHING`, copyChunkSQL(table, names, columns, primaryKey, false, true))
+
+	a.Equal([]string{
+		`DELETE FROM "public"."_orders_osc_new" AS s USING (SELECT DISTINCT "tenant", "id" FROM "public"."_orders_osc_log" WHERE _osc_id <= $1) AS l WHERE s."tenant" = l."tenant" AND s."id" = l."id"`,
+		`INSERT INTO "public"."_orders_osc_new" ("tenant", "id", "amount") OVERRIDING SYSTEM VALUE SELECT "tenant", "id", "amount" FROM ONLY "public"."orders" WHERE ("tenant", "id") IN (SELECT "tenant", "id" FROM "public"."_orders_osc_log" WHERE _osc_id <= $1) ON CONFLICT DO NOTHING`,
+		`DELETE FROM "public"."_orders_osc_log" WHERE _osc_id <= $1`,
+	}, replaySQLs(table, names, columns, primaryKey))
+
+	a.Equal([]string{
+		`ALTER TABLE "public"."orders" RENAME TO "_orders_osc_old"`,
+		`ALTER TABLE "public"."_orders_osc_new" RENAME TO "orders"`,
+	}, swapTablesSQLs(table, names))
+
+	a.Equal([]string{
+		`ALTER INDEX "public"."orders_pkey" RENAME TO "_orders_pkey_osc_old"`,
+		`ALTER INDEX "public"."_orders_osc_new_pkey" RENAME TO "orders_pkey"`,
+	}, renameIndexSQLs("public",
+		[]index{{name: "orders_pkey", definition: "true USING btree (tenant, id)"}, {name: "orders_amount_idx", definition: "false USING btree (amount)"}},
+		[]index{{name: "_orders_osc_new_pkey", definition: "true USING btree (tenant, id)"}},
+	))
+}
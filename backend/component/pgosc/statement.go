@@ -0,0 +1,86 @@
+package pgosc
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/postgresql"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/pg"
+)
+
+// AlterStatement is the ALTER TABLE statement to run as an online schema change.
+type AlterStatement struct {
+	// Schema is the schema of the table. It is empty if the table name is not qualified.
+	Schema string
+	// Table is the name of the table.
+	Table string
+	// Commands is the original text of the ALTER TABLE commands.
+	Commands string
+}
+
+// ParseStatement parses the statement for the online schema change.
+// The statement must be a single ALTER TABLE statement.
+func ParseStatement(statement string) (*AlterStatement, error) {
+	results, err := pg.ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("online schema change requires exactly one ALTER TABLE statement, but got %d statements", len(results))
+	}
+
+	listener := &alterTableListener{tokens: results[0].Tokens}
+	antlr.ParseTreeWalkerDefault.Walk(listener, results[0].Tree)
+	if listener.err != nil {
+		return nil, listener.err
+	}
+	if listener.result == nil {
+		return nil, errors.New("online schema change only supports ALTER TABLE statements")
+	}
+	return listener.result, nil
+}
+
+type alterTableListener struct {
+	*postgresql.BasePostgreSQLParserListener
+
+	tokens *antlr.CommonTokenStream
+	result *AlterStatement
+	err    error
+}
+
+func (l *alterTableListener) EnterAltertablestmt(ctx *postgresql.AltertablestmtContext) {
+	if l.result != nil || l.err != nil {
+		return
+	}
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	if ctx.TABLE() == nil || ctx.INDEX() != nil || ctx.SEQUENCE() != nil || ctx.VIEW() != nil || ctx.FOREIGN() != nil {
+		l.err = errors.New("online schema change only supports ALTER TABLE statements")
+		return
+	}
+	if ctx.Relation_expr() == nil || ctx.Alter_table_cmds() == nil {
+		l.err = errors.New("online schema change does not support partition or tablespace commands")
+		return
+	}
+	relation := ctx.Relation_expr()
+	if relation.Qualified_name() == nil {
+		l.err = errors.New("failed to find the table name of ALTER TABLE statement")
+		return
+	}
+	names := pg.NormalizePostgreSQLQualifiedName(relation.Qualified_name())
+	result := &AlterStatement{}
+	switch len(names) {
+	case 1:
+		result.Table = names[0]
+	case 2:
+		result.Schema, result.Table = names[0], names[1]
+	default:
+		l.err = errors.Errorf("unsupported table name %q", strings.Join(names, "."))
+		return
+	}
+	result.Commands = l.tokens.GetTextFromRuleContext(ctx.Alter_table_cmds())
+	l.result = result
+}
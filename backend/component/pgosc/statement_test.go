@@ -0,0 +1,55 @@
+package pgosc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      *AlterStatement
+		wantErr   bool
+	}{
+		{
+			statement: "ALTER TABLE t ADD COLUMN c INT NOT NULL DEFAULT 0;",
+			want:      &AlterStatement{Table: "t", Commands: "ADD COLUMN c INT NOT NULL DEFAULT 0"},
+		},
+		{
+			statement: `ALTER TABLE "Sales"."Order" ALTER COLUMN amount TYPE NUMERIC(20, 2), DROP COLUMN note`,
+			want:      &AlterStatement{Schema: "Sales", Table: "Order", Commands: "ALTER COLUMN amount TYPE NUMERIC(20, 2), DROP COLUMN note"},
+		},
+		{
+			statement: "ALTER TABLE ONLY public.t ALTER COLUMN id TYPE BIGINT",
+			want:      &AlterStatement{Schema: "public", Table: "t", Commands: "ALTER COLUMN id TYPE BIGINT"},
+		},
+		{
+			statement: "ALTER TABLE t ADD COLUMN a INT; ALTER TABLE t ADD COLUMN b INT;",
+			wantErr:   true,
+		},
+		{
+			statement: "ALTER TABLE t RENAME COLUMN a TO b",
+			wantErr:   true,
+		},
+		{
+			statement: "ALTER INDEX idx SET (fillfactor = 70)",
+			wantErr:   true,
+		},
+		{
+			statement: "CREATE INDEX idx ON t (a)",
+			wantErr:   true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ParseStatement(test.statement)
+		if test.wantErr {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
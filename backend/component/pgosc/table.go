@@ -0,0 +1,128 @@
+package pgosc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// maxIdentifierLength is the maximum length of PostgreSQL identifiers in bytes.
+const maxIdentifierLength = 63
+
+// Table is a table resolved in the database.
+type Table struct {
+	Schema string
+	Name   string
+}
+
+func (t *Table) String() string {
+	return t.Schema + "." + t.Name
+}
+
+// QuotedName returns the quoted qualified name of the table.
+func (t *Table) QuotedName() string {
+	return quoteIdentifier(t.Schema) + "." + quoteIdentifier(t.Name)
+}
+
+// ResolveTable resolves the schema of the table in the statement with the search path of the connection.
+func ResolveTable(ctx context.Context, db *sql.DB, statement *AlterStatement) (*Table, error) {
+	if statement.Schema != "" {
+		return &Table{Schema: statement.Schema, Name: statement.Table}, nil
+	}
+	var schema sql.NullString
+	if err := db.QueryRowContext(ctx, `
+		SELECT n.nspname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.oid = to_regclass($1)`, quoteIdentifier(statement.Table)).Scan(&schema); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrapf(err, "failed to resolve the schema of table %q", statement.Table)
+	}
+	if !schema.Valid {
+		return nil, errors.Errorf("table %q does not exist", statement.Table)
+	}
+	return &Table{Schema: schema.String, Name: statement.Table}, nil
+}
+
+// objectNames are the names of the temporary objects of the migration.
+type objectNames struct {
+	shadowTable string
+	logTable    string
+	oldTable    string
+	function    string
+	trigger     string
+}
+
+func newObjectNames(table *Table) objectNames {
+	return objectNames{
+		shadowTable: temporaryName(table.Name, "_osc_new"),
+		logTable:    temporaryName(table.Name, "_osc_log"),
+		oldTable:    temporaryName(table.Name, "_osc_old"),
+		function:    temporaryName(table.Name, "_osc_capture"),
+		trigger:     temporaryName(table.Name, "_osc_capture"),
+	}
+}
+
+// temporaryName returns "_{name}{suffix}", truncating the name to fit the identifier length limit.
+func temporaryName(name, suffix string) string {
+	limit := maxIdentifierLength - len(suffix) - 1
+	if len(name) > limit {
+		name = name[:limit]
+		// Do not cut in the middle of a multi-byte character.
+		for !utf8.ValidString(name) {
+			name = name[:len(name)-1]
+		}
+	}
+	return "_" + name + suffix
+}
+
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	var quoted []string
+	for _, identifier := range identifiers {
+		quoted = append(quoted, quoteIdentifier(identifier))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// column is a column with its type.
+type column struct {
+	name     string
+	dataType string
+}
+
+func columnNames(columns []column) []string {
+	var names []string
+	for _, c := range columns {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// getPrimaryKey returns the primary key columns of the table in key order.
+func getPrimaryKey(ctx context.Context, db *sql.DB, table *Table) ([]column, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod)
+		FROM pg_index i JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND i.indisprimary
+		ORDER BY array_position(i.indkey::int2[], a.attnum)`, table.QuotedName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get primary key of table %s", table)
+	}
+	defer rows.Close()
+	var columns []column
+	for rows.Next() {
+		var c column
+		if err := rows.Scan(&c.name, &c.dataType); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
@@ -0,0 +1,219 @@
+package pgosc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+// FeasibilityValidationResult contains detailed results of the online schema change feasibility validation.
+type FeasibilityValidationResult struct {
+	// Core validation state
+	Valid bool
+	Error error
+
+	// Detailed findings for specific error messages
+	InRecovery             bool
+	TableExists            bool
+	PartitionedTable       bool
+	HasPrimaryKey          bool
+	Triggers               []string // User triggers on the table, which are not copied to the shadow table
+	ReferencingConstraints []string // Foreign keys in other tables referencing the table
+	DependentViews         []string // Views bound to the original table
+	Policies               []string // Row-level security policies, which are not copied to the shadow table
+	Publications           []string // Publications the table is explicitly a member of
+	ConflictingObjects     []string // Existing objects using the names of the temporary objects
+
+	// Warnings do not block the migration.
+	AllTablesPublications []string // FOR ALL TABLES publications that would replicate the shadow table
+}
+
+// ValidateFeasibility checks whether the table can be migrated by the online schema change.
+// Returns a structured result that can be used for both plan checks and execution.
+func ValidateFeasibility(ctx context.Context, db *sql.DB, table *Table) *FeasibilityValidationResult {
+	result := &FeasibilityValidationResult{
+		Valid: true,
+	}
+	fail := func(err error) *FeasibilityValidationResult {
+		result.Valid = false
+		result.Error = err
+		slog.Error("online schema change feasibility validation failed", slog.String("table", table.String()), log.BBError(err))
+		return result
+	}
+
+	// Test 1: Writes are required to create the shadow table and the trigger.
+	if err := db.QueryRowContext(ctx, "SELECT pg_is_in_recovery()").Scan(&result.InRecovery); err != nil {
+		return fail(errors.Wrap(err, "failed to check recovery status"))
+	}
+	if result.InRecovery {
+		return fail(errors.New("the database is a read-only standby"))
+	}
+
+	// Test 2: The table must be a regular table.
+	var relKind string
+	if err := db.QueryRowContext(ctx, `
+		SELECT c.relkind FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2`, table.Schema, table.Name).Scan(&relKind); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fail(errors.Errorf("table %s does not exist", table))
+		}
+		return fail(errors.Wrapf(err, "failed to get table %s", table))
+	}
+	result.TableExists = true
+	if relKind == "p" {
+		result.PartitionedTable = true
+		return fail(errors.Errorf("table %s is a partitioned table", table))
+	}
+	if relKind != "r" {
+		return fail(errors.Errorf("%s is not a regular table", table))
+	}
+
+	// Test 3: The primary key is used to copy rows and replay captured changes.
+	primaryKey, err := getPrimaryKey(ctx, db, table)
+	if err != nil {
+		return fail(err)
+	}
+	result.HasPrimaryKey = len(primaryKey) > 0
+	if !result.HasPrimaryKey {
+		return fail(errors.Errorf("table %s does not have a primary key", table))
+	}
+
+	// Test 4: Objects bound to the original table would be lost or left behind at cutover.
+	if result.Triggers, err = queryStrings(ctx, db, `
+		SELECT t.tgname FROM pg_trigger t
+		WHERE t.tgrelid = $1::regclass AND NOT t.tgisinternal
+		ORDER BY t.tgname`, table.QuotedName()); err != nil {
+		return fail(errors.Wrap(err, "failed to list triggers"))
+	}
+	if len(result.Triggers) > 0 {
+		return fail(errors.Errorf("table %s has triggers: %s", table, strings.Join(result.Triggers, ", ")))
+	}
+	if result.ReferencingConstraints, err = queryStrings(ctx, db, `
+		SELECT format('%s.%I', c.conrelid::regclass, c.conname) FROM pg_constraint c
+		WHERE c.contype = 'f' AND c.confrelid = $1::regclass
+		ORDER BY 1`, table.QuotedName()); err != nil {
+		return fail(errors.Wrap(err, "failed to list referencing foreign keys"))
+	}
+	if len(result.ReferencingConstraints) > 0 {
+		return fail(errors.Errorf("table %s is referenced by foreign keys: %s", table, strings.Join(result.ReferencingConstraints, ", ")))
+	}
+	if result.DependentViews, err = queryStrings(ctx, db, `
+		SELECT DISTINCT r.ev_class::regclass::text FROM pg_depend d JOIN pg_rewrite r ON r.oid = d.objid
+		WHERE d.classid = 'pg_rewrite'::regclass AND d.refobjid = $1::regclass AND r.ev_class <> $1::regclass
+		ORDER BY 1`, table.QuotedName()); err != nil {
+		return fail(errors.Wrap(err, "failed to list dependent views"))
+	}
+	if len(result.DependentViews) > 0 {
+		return fail(errors.Errorf("table %s has dependent views: %s", table, strings.Join(result.DependentViews, ", ")))
+	}
+	if result.Policies, err = queryStrings(ctx, db, `
+		SELECT p.polname FROM pg_policy p WHERE p.polrelid = $1::regclass ORDER BY p.polname`, table.QuotedName()); err != nil {
+		return fail(errors.Wrap(err, "failed to list row-level security policies"))
+	}
+	if len(result.Policies) > 0 {
+		return fail(errors.Errorf("table %s has row-level security policies: %s", table, strings.Join(result.Policies, ", ")))
+	}
+
+	// Test 5: Logical replication is bound to the table identity.
+	if result.Publications, err = queryStrings(ctx, db, `
+		SELECT p.pubname FROM pg_publication p JOIN pg_publication_rel r ON r.prpubid = p.oid
+		WHERE r.prrelid = $1::regclass ORDER BY p.pubname`, table.QuotedName()); err != nil {
+		return fail(errors.Wrap(err, "failed to list publications"))
+	}
+	if len(result.Publications) > 0 {
+		return fail(errors.Errorf("table %s is a member of publications: %s", table, strings.Join(result.Publications, ", ")))
+	}
+	if result.AllTablesPublications, err = queryStrings(ctx, db, `
+		SELECT p.pubname FROM pg_publication p WHERE p.puballtables ORDER BY p.pubname`); err != nil {
+		return fail(errors.Wrap(err, "failed to list publications"))
+	}
+
+	// Test 6: The temporary objects must not exist.
+	names := newObjectNames(table)
+	if result.ConflictingObjects, err = queryStrings(ctx, db, `
+		SELECT c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname IN ($2, $3, $4)
+		UNION ALL
+		SELECT p.proname FROM pg_proc p JOIN pg_namespace n ON n.oid = p.pronamespace
+		WHERE n.nspname = $1 AND p.proname = $5
+		ORDER BY 1`, table.Schema, names.shadowTable, names.logTable, names.oldTable, names.function); err != nil {
+		return fail(errors.Wrap(err, "failed to check temporary objects"))
+	}
+	if len(result.ConflictingObjects) > 0 {
+		return fail(errors.Errorf("objects %s already exist, they may be left by a previous online schema change", strings.Join(result.ConflictingObjects, ", ")))
+	}
+
+	slog.Info("online schema change feasibility validation passed", slog.String("table", table.String()))
+	return result
+}
+
+// GetUserFriendlyError returns a user-friendly error message based on validation results.
+func (r *FeasibilityValidationResult) GetUserFriendlyError() (title, content string) {
+	if r.Valid {
+		return "", ""
+	}
+
+	title = "Online schema change prerequisites not met"
+
+	switch {
+	case r.InRecovery:
+		content = "The database is a read-only standby. Please run the online schema change on the primary."
+	case r.PartitionedTable:
+		content = "Partitioned tables are not supported. Please alter the partitions separately."
+	case r.TableExists && !r.HasPrimaryKey:
+		content = "The table does not have a primary key. A primary key is required to copy rows and replay concurrent changes."
+	case len(r.Triggers) > 0:
+		content = fmt.Sprintf("The table has triggers (%s) which would be lost after cutover. Please drop them before the migration and recreate them afterwards.", strings.Join(r.Triggers, ", "))
+	case len(r.ReferencingConstraints) > 0:
+		content = fmt.Sprintf("The table is referenced by foreign keys (%s) which would keep referencing the original table after cutover.", strings.Join(r.ReferencingConstraints, ", "))
+	case len(r.DependentViews) > 0:
+		content = fmt.Sprintf("The table has dependent views (%s) which would keep referencing the original table after cutover.", strings.Join(r.DependentViews, ", "))
+	case len(r.Policies) > 0:
+		content = fmt.Sprintf("The table has row-level security policies (%s) which would be lost after cutover.", strings.Join(r.Policies, ", "))
+	case len(r.Publications) > 0:
+		content = fmt.Sprintf("The table is a member of publications (%s). Logical replication would stop after cutover. Please remove the table from the publications before the migration.", strings.Join(r.Publications, ", "))
+	case len(r.ConflictingObjects) > 0:
+		content = fmt.Sprintf("Objects %s already exist. They may be left by a previous online schema change, please drop them before retrying.", strings.Join(r.ConflictingObjects, ", "))
+	case r.Error != nil:
+		content = fmt.Sprintf("Validation failed: %v", r.Error)
+	default:
+		content = "Unknown validation error occurred"
+	}
+
+	return title, content
+}
+
+// GetWarning returns the warning that does not block the migration.
+func (r *FeasibilityValidationResult) GetWarning() (title, content string) {
+	if len(r.AllTablesPublications) == 0 {
+		return "", ""
+	}
+	return "Shadow table will be replicated",
+		fmt.Sprintf("Publications %s publish all tables, so the rows copied into the shadow table will also be sent to the subscribers.", strings.Join(r.AllTablesPublications, ", "))
+}
+
+func queryStrings(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
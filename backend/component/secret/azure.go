@@ -0,0 +1,76 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	azureKeyVaultScope      = "https://vault.azure.net/.default"
+	azureKeyVaultAPIVersion = "7.4"
+)
+
+func getSecretFromAzure(ctx context.Context, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	// for Azure auth we will use the default credentials (environment, workload identity or managed identity)
+	// ref:
+	// https://learn.microsoft.com/en-us/azure/developer/go/azure-sdk-authentication
+	credential, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to found default Azure credential")
+	}
+	token, err := credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureKeyVaultScope}})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get Azure Key Vault token")
+	}
+
+	// The secret name can be "{name}" for the latest version or "{name}/{version}".
+	secretURL := fmt.Sprintf("%s/secrets/%s?api-version=%s", strings.TrimSuffix(externalSecret.Url, "/"), escapeSecretName(externalSecret.SecretName), azureKeyVaultAPIVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to build Azure Key Vault request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get Azure secret %s", externalSecret.SecretName)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read Azure Key Vault response")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", errors.Errorf("cannot found secret %s", externalSecret.SecretName)
+	default:
+		return "", errors.Errorf("failed to get Azure secret %s, status %d: %s", externalSecret.SecretName, resp.StatusCode, string(body))
+	}
+
+	var secret struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal Azure Key Vault response")
+	}
+	return getValueByKey(secret.Value, externalSecret.PasswordKeyName)
+}
+
+func escapeSecretName(name string) string {
+	parts := strings.Split(name, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
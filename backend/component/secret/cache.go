@@ -0,0 +1,82 @@
+package secret
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// defaultCacheTTL is the default duration to cache the external secrets.
+const defaultCacheTTL = 5 * time.Minute
+
+type cacheEntry struct {
+	value    string
+	expireAt time.Time
+}
+
+// cache is a TTL cache for the external secrets.
+type cache struct {
+	sync.Mutex
+	entries map[string]cacheEntry
+	now     func() time.Time
+}
+
+func newCache() *cache {
+	return &cache{
+		entries: map[string]cacheEntry{},
+		now:     time.Now,
+	}
+}
+
+func (c *cache) get(key string) (string, bool) {
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if !c.now().Before(entry.expireAt) {
+		delete(c.entries, key)
+		return "", false
+	}
+	return entry.value, true
+}
+
+// set caches the value for the ttl. A non-positive ttl disables the cache.
+func (c *cache) set(key, value string, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	now := c.now()
+	// Purge the expired entries so that the cache does not grow with stale secrets.
+	for k, entry := range c.entries {
+		if !now.Before(entry.expireAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{value: value, expireAt: now.Add(ttl)}
+}
+
+func (c *cache) delete(key string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.entries, key)
+}
+
+// getCacheKey returns the digest of the external secret, so that the credentials in it are not kept in the cache.
+func getCacheKey(externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(externalSecret)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal external secret")
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,43 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	execTimeout = 30 * time.Second
+	// maxExecStderrLength is the maximum length of stderr in the error message.
+	maxExecStderrLength = 1024
+)
+
+// getSecretFromExec runs the command with the secret name as the only argument, and uses the stdout as the secret.
+// The command is run directly without a shell.
+func getSecretFromExec(ctx context.Context, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	command, err := checkAllowedPath(externalSecret.Url, execDirsEnv)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, execTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command, externalSecret.SecretName)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := stderr.String()
+		if len(msg) > maxExecStderrLength {
+			msg = msg[:maxExecStderrLength] + "..."
+		}
+		return "", errors.Wrapf(err, "failed to run secret command %s: %s", command, msg)
+	}
+	return getValueByKey(strings.TrimRight(stdout.String(), "\r\n"), externalSecret.PasswordKeyName)
+}
@@ -0,0 +1,24 @@
+package secret
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// getSecretFromFile reads the secret from a local file, such as a Kubernetes mounted secret.
+func getSecretFromFile(externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	path, err := checkAllowedPath(externalSecret.SecretName, fileDirsEnv)
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read secret file %s", path)
+	}
+	// Mounted secrets and files written by editors usually end with a newline.
+	return getValueByKey(strings.TrimRight(string(content), "\r\n"), externalSecret.PasswordKeyName)
+}
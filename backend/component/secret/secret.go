@@ -3,27 +3,118 @@ package secret
 
 import (
 	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 )
 
+const (
+	// fileDirsEnv is the environment listing the directories allowed for the FILE secret type.
+	fileDirsEnv = "BB_EXTERNAL_SECRET_FILE_DIRS"
+	// execDirsEnv is the environment listing the directories allowed for the EXEC secret type.
+	execDirsEnv = "BB_EXTERNAL_SECRET_EXEC_DIRS"
+)
+
+var secretCache = newCache()
+
 // ReplaceExternalSecret replaces the secret with external secret.
+// The secret is cached for the TTL of the external secret, use InvalidateExternalSecret to evict it.
 func ReplaceExternalSecret(ctx context.Context, secret string, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
-	if externalSecret != nil {
-		// TODO: consider cache?
-		switch externalSecret.SecretType {
-		case storepb.DataSourceExternalSecret_AWS_SECRETS_MANAGER:
-			return getSecretFromAWS(ctx, externalSecret)
-		case storepb.DataSourceExternalSecret_VAULT_KV_V2:
-			return getSecretFromVault(ctx, externalSecret)
-		case storepb.DataSourceExternalSecret_GCP_SECRET_MANAGER:
-			return getSecretFromGCP(ctx, externalSecret)
-		default:
-			return "", errors.Errorf("unsupported secret type: %v", externalSecret.SecretType)
-		}
+	if externalSecret == nil {
+		return secret, nil
+	}
+	key, err := getCacheKey(externalSecret)
+	if err != nil {
+		return "", err
+	}
+	if value, ok := secretCache.get(key); ok {
+		return value, nil
+	}
+	value, err := getExternalSecret(ctx, externalSecret)
+	if err != nil {
+		return "", err
+	}
+	secretCache.set(key, value, getCacheTTL(externalSecret))
+	return value, nil
+}
+
+// InvalidateExternalSecret evicts the cached secret, so that the next call fetches it from the provider.
+func InvalidateExternalSecret(externalSecret *storepb.DataSourceExternalSecret) {
+	if externalSecret == nil {
+		return
+	}
+	key, err := getCacheKey(externalSecret)
+	if err != nil {
+		return
+	}
+	secretCache.delete(key)
+}
+
+func getExternalSecret(ctx context.Context, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	switch externalSecret.SecretType {
+	case storepb.DataSourceExternalSecret_AWS_SECRETS_MANAGER:
+		return getSecretFromAWS(ctx, externalSecret)
+	case storepb.DataSourceExternalSecret_VAULT_KV_V2:
+		return getSecretFromVault(ctx, externalSecret)
+	case storepb.DataSourceExternalSecret_GCP_SECRET_MANAGER:
+		return getSecretFromGCP(ctx, externalSecret)
+	case storepb.DataSourceExternalSecret_AZURE_KEY_VAULT:
+		return getSecretFromAzure(ctx, externalSecret)
+	case storepb.DataSourceExternalSecret_FILE:
+		return getSecretFromFile(externalSecret)
+	case storepb.DataSourceExternalSecret_EXEC:
+		return getSecretFromExec(ctx, externalSecret)
+	default:
+		return "", errors.Errorf("unsupported secret type: %v", externalSecret.SecretType)
 	}
+}
+
+func getCacheTTL(externalSecret *storepb.DataSourceExternalSecret) time.Duration {
+	if externalSecret.CacheTtlSeconds == 0 {
+		return defaultCacheTTL
+	}
+	return time.Duration(externalSecret.CacheTtlSeconds) * time.Second
+}
+
+// getValueByKey returns the value of the key in the JSON object secret, or the secret itself if the key is empty.
+func getValueByKey(secret, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+	dataMap := make(map[string]any)
+	if err := json.Unmarshal([]byte(secret), &dataMap); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal secret as JSON to get key %q", key)
+	}
+	val, ok := dataMap[key].(string)
+	if !ok {
+		return "", errors.Errorf("cannot get value for %s, please make sure the secret exists", key)
+	}
+	return val, nil
+}
 
-	return secret, nil
+// checkAllowedPath checks that the path is absolute and under one of the directories in the environment.
+func checkAllowedPath(path, env string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", errors.Errorf("path %q must be absolute", path)
+	}
+	path = filepath.Clean(path)
+	for _, dir := range filepath.SplitList(os.Getenv(env)) {
+		if dir == "" {
+			continue
+		}
+		rel, err := filepath.Rel(filepath.Clean(dir), path)
+		if err != nil {
+			continue
+		}
+		if rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return path, nil
+		}
+	}
+	return "", errors.Errorf("path %q is not under the directories allowed by the %s environment", path, env)
 }
@@ -0,0 +1,93 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newCache()
+	c.now = func() time.Time { return now }
+
+	c.set("a", "1", time.Minute)
+	c.set("b", "2", 0)
+	value, ok := c.get("a")
+	require.True(t, ok)
+	require.Equal(t, "1", value)
+	_, ok = c.get("b")
+	require.False(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = c.get("a")
+	require.False(t, ok)
+
+	c.set("a", "1", time.Minute)
+	c.delete("a")
+	_, ok = c.get("a")
+	require.False(t, ok)
+}
+
+func TestReplaceExternalSecretFromFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(fileDirsEnv, dir)
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(path, []byte("secret1\n"), 0600))
+
+	externalSecret := &storepb.DataSourceExternalSecret{
+		SecretType: storepb.DataSourceExternalSecret_FILE,
+		SecretName: path,
+	}
+	ctx := context.Background()
+	value, err := ReplaceExternalSecret(ctx, "", externalSecret)
+	require.NoError(t, err)
+	require.Equal(t, "secret1", value)
+
+	// The cached secret is returned until it is invalidated.
+	require.NoError(t, os.WriteFile(path, []byte(`{"password": "secret2"}`), 0600))
+	value, err = ReplaceExternalSecret(ctx, "", externalSecret)
+	require.NoError(t, err)
+	require.Equal(t, "secret1", value)
+	InvalidateExternalSecret(externalSecret)
+	externalSecret.PasswordKeyName = "password"
+	value, err = ReplaceExternalSecret(ctx, "", externalSecret)
+	require.NoError(t, err)
+	require.Equal(t, "secret2", value)
+	InvalidateExternalSecret(externalSecret)
+
+	// The file must be under the allowed directories.
+	_, err = ReplaceExternalSecret(ctx, "", &storepb.DataSourceExternalSecret{
+		SecretType: storepb.DataSourceExternalSecret_FILE,
+		SecretName: filepath.Join(dir, "..", "password"),
+	})
+	require.Error(t, err)
+}
+
+func TestCheckAllowedPath(t *testing.T) {
+	t.Setenv(execDirsEnv, "/opt/bin"+string(filepath.ListSeparator)+"/usr/local/secret")
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{path: "/opt/bin/get-secret"},
+		{path: "/usr/local/secret/sub/get-secret"},
+		{path: "/opt/binary/get-secret", wantErr: true},
+		{path: "/opt/bin/../get-secret", wantErr: true},
+		{path: "get-secret", wantErr: true},
+	}
+	for _, tc := range tests {
+		_, err := checkAllowedPath(tc.path, execDirsEnv)
+		if tc.wantErr {
+			require.Error(t, err, tc.path)
+		} else {
+			require.NoError(t, err, tc.path)
+		}
+	}
+}
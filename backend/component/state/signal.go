@@ -0,0 +1,112 @@
+package state
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"sync"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// EnableHA makes the signals broadcast to all the replicas through the metadata database, so that they reach
+// the background runners of the leader. The signals are delivered in-process otherwise.
+func (s *State) EnableHA(stores *store.Store) {
+	s.notifier = stores
+}
+
+// TickleTaskRunScheduler wakes up the task run scheduler.
+func (s *State) TickleTaskRunScheduler(ctx context.Context) {
+	if s.notifier == nil {
+		s.TaskRunTickleChan <- 0
+		return
+	}
+	s.notify(ctx, store.NotifyChannelTaskRunTickle, "")
+}
+
+// TicklePlanCheckScheduler wakes up the plan check scheduler.
+func (s *State) TicklePlanCheckScheduler(ctx context.Context) {
+	if s.notifier == nil {
+		s.PlanCheckTickleChan <- 0
+		return
+	}
+	s.notify(ctx, store.NotifyChannelPlanCheckTickle, "")
+}
+
+// NotifyTaskSkippedOrDone notifies the task run scheduler that the task is skipped or done.
+func (s *State) NotifyTaskSkippedOrDone(ctx context.Context, taskUID int) {
+	if s.notifier == nil {
+		s.TaskSkippedOrDoneChan <- taskUID
+		return
+	}
+	s.notify(ctx, store.NotifyChannelTaskSkippedOrDone, strconv.Itoa(taskUID))
+}
+
+// FindApprovalTemplate asks the approval runner to find the approval template for the issue.
+func (s *State) FindApprovalTemplate(ctx context.Context, issue *store.IssueMessage) {
+	if s.notifier == nil {
+		s.ApprovalFinding.Store(issue.UID, issue)
+		return
+	}
+	s.notify(ctx, store.NotifyChannelApprovalFinding, strconv.Itoa(issue.UID))
+}
+
+// CancelTaskRun cancels the running task run, which may be running on another replica in HA mode.
+func (s *State) CancelTaskRun(ctx context.Context, taskRunUID int) error {
+	CancelRunning(&s.RunningTaskRunsCancelFunc, taskRunUID)
+	if s.notifier == nil {
+		return nil
+	}
+	return s.notifier.Notify(ctx, store.NotifyChannelTaskRunCancel, strconv.Itoa(taskRunUID))
+}
+
+// CancelPlanCheckRun cancels the running plan check run, which may be running on another replica in HA mode.
+func (s *State) CancelPlanCheckRun(ctx context.Context, planCheckRunUID int) error {
+	CancelRunning(&s.RunningPlanCheckRunsCancelFunc, planCheckRunUID)
+	if s.notifier == nil {
+		return nil
+	}
+	return s.notifier.Notify(ctx, store.NotifyChannelPlanCheckRunCancel, strconv.Itoa(planCheckRunUID))
+}
+
+// ExpireAccessToken rejects the access token on all the replicas.
+// Only the hash of the token is broadcast, since the notifications are visible to any session of the metadata database.
+func (s *State) ExpireAccessToken(ctx context.Context, accessToken string) error {
+	hash := HashAccessToken(accessToken)
+	s.ExpireCache.Add(hash, true)
+	if s.notifier == nil {
+		return nil
+	}
+	return s.notifier.Notify(ctx, store.NotifyChannelAccessTokenExpired, hash)
+}
+
+// IsAccessTokenExpired returns whether the access token is revoked by logging out.
+func (s *State) IsAccessTokenExpired(accessToken string) bool {
+	_, ok := s.ExpireCache.Get(HashAccessToken(accessToken))
+	return ok
+}
+
+// HashAccessToken returns the hex-encoded SHA-256 hash of the access token.
+func HashAccessToken(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(hash[:])
+}
+
+// CancelRunning calls the cancel function stored in the map under the key, if any.
+func CancelRunning(cancelFuncs *sync.Map, key int) {
+	if cancelFunc, ok := cancelFuncs.Load(key); ok {
+		if cancel, ok := cancelFunc.(context.CancelFunc); ok {
+			cancel()
+		}
+	}
+}
+
+// notify broadcasts the signal. The schedulers poll periodically, so a lost tickle only delays the work.
+func (s *State) notify(ctx context.Context, channel, payload string) {
+	if err := s.notifier.Notify(ctx, channel, payload); err != nil {
+		slog.Warn("failed to broadcast signal", slog.String("channel", channel), log.BBError(err))
+	}
+}
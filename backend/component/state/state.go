@@ -7,6 +7,8 @@ import (
 	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
 )
 
 // State is the state for all in-memory states within the server.
@@ -43,7 +45,11 @@ type State struct {
 	// TaskRunTickleChan is the tickler for task run scheduler.
 	TaskRunTickleChan chan int
 
+	// ExpireCache holds the hashes of the access tokens revoked by logging out.
 	ExpireCache *lru.Cache[string, bool]
+
+	// notifier broadcasts the signals to the replicas in HA mode.
+	notifier *store.Store
 }
 
 func New() (*State, error) {
@@ -0,0 +1,176 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	deliveryInterval = 5 * time.Second
+	// deliveryBatchSize is the maximum number of deliveries to send in each round.
+	deliveryBatchSize = 50
+	// deliveryLease is the duration that a claimed delivery is not claimed again, which must be longer than the request timeout.
+	deliveryLease = 1 * time.Minute
+	// deliveryMaxAttempts is the maximum number of attempts before a delivery fails.
+	deliveryMaxAttempts = 8
+	// deliveryBaseBackoff is the delay after the first failed attempt, which doubles after each failure.
+	deliveryBaseBackoff = 30 * time.Second
+	deliveryMaxBackoff  = 1 * time.Hour
+	// deliveryRetentionPeriod is the retention period of the finished deliveries.
+	deliveryRetentionPeriod = 30 * 24 * time.Hour
+	deliveryCleanupInterval = 1 * time.Hour
+)
+
+// DeliveryRunner sends the queued GENERIC webhook deliveries and retries the failed ones with exponential backoff.
+type DeliveryRunner struct {
+	store *store.Store
+}
+
+// NewDeliveryRunner creates a new DeliveryRunner.
+func NewDeliveryRunner(store *store.Store) *DeliveryRunner {
+	return &DeliveryRunner{
+		store: store,
+	}
+}
+
+// Run starts the DeliveryRunner.
+func (r *DeliveryRunner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(deliveryInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(deliveryCleanupInterval)
+	defer cleanupTicker.Stop()
+	slog.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", deliveryInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.sendDueDeliveries(ctx)
+		case <-cleanupTicker.C:
+			count, err := r.store.DeleteFinishedWebhookDeliveries(ctx, deliveryRetentionPeriod)
+			if err != nil {
+				slog.Error("failed to clean up webhook deliveries", log.BBError(err))
+			} else if count > 0 {
+				slog.Info("Cleaned up finished webhook deliveries", slog.Int64("count", count))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *DeliveryRunner) sendDueDeliveries(ctx context.Context) {
+	deliveries, err := r.store.ClaimWebhookDeliveries(ctx, deliveryBatchSize, deliveryLease)
+	if err != nil {
+		slog.Error("failed to claim webhook deliveries", log.BBError(err))
+		return
+	}
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendDelivery(ctx, r.store, delivery)
+		}()
+	}
+	wg.Wait()
+}
+
+// enqueueDelivery persists the delivery of the event to the GENERIC webhook, and makes the first attempt.
+func enqueueDelivery(ctx context.Context, s *store.Store, webhookCtx *webhook.Context, eventType storepb.Activity_Type, hook *store.ProjectWebhookMessage) {
+	body, err := webhook.NewGenericPayload(*webhookCtx)
+	if err != nil {
+		slog.Error("failed to build webhook payload", slog.String("webhook name", hook.Payload.GetTitle()), log.BBError(err))
+		return
+	}
+	// Lease the delivery for the first attempt, so that the runner does not pick it up at the same time.
+	delivery, err := s.CreateWebhookDelivery(ctx, &store.WebhookDeliveryMessage{
+		WebhookID: hook.ID,
+		Payload: &storepb.WebhookDeliveryPayload{
+			EventType: eventType,
+			Body:      string(body),
+		},
+		NextAttemptAt: time.Now().Add(deliveryLease),
+	})
+	if err != nil {
+		slog.Error("failed to create webhook delivery", slog.String("webhook name", hook.Payload.GetTitle()), log.BBError(err))
+		return
+	}
+	sendDelivery(ctx, s, delivery)
+}
+
+func sendDelivery(ctx context.Context, s *store.Store, delivery *store.WebhookDeliveryMessage) {
+	hook, err := s.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{ID: &delivery.WebhookID})
+	if err != nil {
+		slog.Error("failed to get project webhook", slog.Int("webhook id", delivery.WebhookID), log.BBError(err))
+		return
+	}
+	if hook == nil {
+		// The webhook is removed, and the delivery will be removed with it.
+		return
+	}
+
+	statusCode, postErr := webhook.PostGeneric(
+		hook.Payload.GetUrl(),
+		hook.Payload.GetSigningSecret(),
+		strconv.FormatInt(delivery.ID, 10),
+		delivery.Payload.GetEventType().String(),
+		[]byte(delivery.Payload.GetBody()),
+	)
+
+	attempts := delivery.Attempts + 1
+	update := &store.UpdateWebhookDeliveryMessage{
+		Status:        store.WebhookDeliveryStatusSucceeded,
+		Attempts:      attempts,
+		NextAttemptAt: time.Now(),
+		Payload: &storepb.WebhookDeliveryPayload{
+			EventType:      delivery.Payload.GetEventType(),
+			Body:           delivery.Payload.GetBody(),
+			LastStatusCode: int32(statusCode),
+		},
+	}
+	if postErr != nil {
+		// The delivery log is visible to the project members, so only record a fixed message instead of the error
+		// which may contain the details of the internal network.
+		update.Payload.LastError = getDeliveryError(statusCode)
+		if attempts >= deliveryMaxAttempts {
+			update.Status = store.WebhookDeliveryStatusFailed
+			slog.Warn("failed to deliver webhook event",
+				slog.String("webhook name", hook.Payload.GetTitle()),
+				slog.Int64("delivery id", delivery.ID),
+				log.BBError(postErr))
+		} else {
+			update.Status = store.WebhookDeliveryStatusPending
+			update.NextAttemptAt = time.Now().Add(getDeliveryBackoff(attempts))
+		}
+	}
+	if err := s.UpdateWebhookDelivery(ctx, delivery.ID, update); err != nil {
+		slog.Error("failed to update webhook delivery", slog.Int64("delivery id", delivery.ID), log.BBError(err))
+	}
+}
+
+// getDeliveryError returns the error message recorded in the delivery log.
+func getDeliveryError(statusCode int) string {
+	if statusCode == 0 {
+		return "failed to send the request"
+	}
+	return fmt.Sprintf("unexpected response status code %d", statusCode)
+}
+
+// getDeliveryBackoff returns the delay before the next attempt after the given number of failed attempts.
+func getDeliveryBackoff(attempts int) time.Duration {
+	backoff := deliveryBaseBackoff
+	for i := 1; i < attempts && backoff < deliveryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, deliveryMaxBackoff)
+}
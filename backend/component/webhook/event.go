@@ -19,6 +19,8 @@ type Event struct {
 	IssueRolloutReady   *EventIssueRolloutReady
 	StageStatusUpdate   *EventStageStatusUpdate
 	TaskRunStatusUpdate *EventTaskRunStatusUpdate
+	RolloutWindowOpen   *EventRolloutWindowOpen
+	SchemaDrift         *EventSchemaDrift
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -92,3 +94,12 @@ type EventTaskRunStatusUpdate struct {
 	Detail        string
 	SkippedReason string
 }
+
+type EventRolloutWindowOpen struct {
+	StageTitle string
+}
+
+type EventSchemaDrift struct {
+	// Database is the resource name of the drifted database, e.g. instances/{instance}/databases/{database}.
+	Database string
+}
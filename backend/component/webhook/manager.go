@@ -50,7 +50,10 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		EventType: &e.Type,
 	})
 	if err != nil {
-		slog.Warn("failed to find project webhook", "issue_name", e.Issue.Title, log.BBError(err))
+		slog.Warn("failed to find project webhook",
+			slog.String("project", e.Project.ResourceID),
+			slog.String("event", e.Type.String()),
+			log.BBError(err))
 		return
 	}
 
@@ -61,12 +64,13 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 	webhookCtx, err := m.getWebhookContextFromEvent(ctx, e, e.Type)
 	if err != nil {
 		slog.Warn("failed to get webhook context",
-			slog.String("issue_name", e.Issue.Title),
+			slog.String("project", e.Project.ResourceID),
+			slog.String("event", e.Type.String()),
 			log.BBError(err))
 		return
 	}
 	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go m.postWebhookList(ctx, webhookCtx, webhookList)
+	go m.postWebhookList(ctx, webhookCtx, e.Type, webhookList)
 }
 
 func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, eventType storepb.Activity_Type) (*webhook.Context, error) {
@@ -90,6 +94,8 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 		link = fmt.Sprintf("%s/projects/%s/issues/%s-%d", externalURL, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID)
 	} else if e.Rollout != nil {
 		link = fmt.Sprintf("%s/projects/%s/rollouts/%d", externalURL, e.Project.ResourceID, e.Rollout.UID)
+	} else if e.SchemaDrift != nil {
+		link = fmt.Sprintf("%s/projects/%s/%s", externalURL, e.Project.ResourceID, e.SchemaDrift.Database)
 	}
 	switch e.Type {
 	case storepb.Activity_ISSUE_CREATE:
@@ -191,6 +197,20 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 		}
 		mentionUsers = getUsersForDirectMessage(ctx, e, usersGetters...)
 
+	case storepb.Activity_NOTIFY_ROLLOUT_WINDOW_OPEN:
+		title = "Rollout window opened"
+		titleZh = "发布窗口已开启"
+
+	case storepb.Activity_NOTIFY_BREAK_GLASS:
+		level = webhook.WebhookWarn
+		title = "Break-glass access granted"
+		titleZh = "紧急访问已授予"
+
+	case storepb.Activity_NOTIFY_SCHEMA_DRIFT:
+		level = webhook.WebhookWarn
+		title = "Schema drift detected"
+		titleZh = "检测到数据库结构漂移"
+
 	case storepb.Activity_ISSUE_APPROVAL_NOTIFY:
 		roleWithPrefix := e.IssueApprovalCreate.Role
 
@@ -216,7 +236,7 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 
 	webhookCtx = webhook.Context{
 		Level:     level,
-		EventType: string(eventType),
+		EventType: eventType.String(),
 		Title:     title,
 		TitleZh:   titleZh,
 		Issue:     nil,
@@ -262,6 +282,16 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 			Name: u.StageTitle,
 		}
 	}
+	if u := e.RolloutWindowOpen; u != nil {
+		webhookCtx.Stage = &webhook.Stage{
+			Name: u.StageTitle,
+		}
+	}
+	if u := e.IssueApprovalCreate; u != nil {
+		webhookCtx.Approval = &webhook.Approval{
+			Role: u.Role,
+		}
+	}
 
 	return &webhookCtx, nil
 }
@@ -294,7 +324,7 @@ func getUsersForDirectMessage(ctx context.Context, e *Event, usersGetters ...Use
 	return mentionUsers
 }
 
-func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Context, eventType storepb.Activity_Type, webhookList []*store.ProjectWebhookMessage) {
 	ctx = context.WithoutCancel(ctx)
 	setting, err := m.store.GetAppIMSetting(ctx)
 	if err != nil {
@@ -308,6 +338,11 @@ func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Conte
 		webhookCtx.URL = hook.Payload.GetUrl()
 		webhookCtx.CreatedTS = time.Now().Unix()
 		webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
+		if hook.Payload.GetType() == storepb.ProjectWebhook_GENERIC {
+			// The GENERIC webhook deliveries are persisted and retried by the DeliveryRunner.
+			go enqueueDelivery(ctx, m.store, &webhookCtx, eventType, hook)
+			continue
+		}
 		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
 			if err := common.Retry(ctx, func() error {
 				return webhook.Post(hook.Payload.GetType(), *webhookCtx)
@@ -88,6 +88,8 @@ type Advice struct {
 	// TODO: use range instead.
 	StartPosition *Position `protobuf:"bytes,6,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	EndPosition   *Position `protobuf:"bytes,7,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	// The suggested fix for the advice, it's empty if the advice cannot be fixed mechanically.
+	SuggestedFix  *SuggestedFix `protobuf:"bytes,8,opt,name=suggested_fix,json=suggestedFix,proto3" json:"suggested_fix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -164,23 +166,157 @@ func (x *Advice) GetEndPosition() *Position {
 	return nil
 }
 
+func (x *Advice) GetSuggestedFix() *SuggestedFix {
+	if x != nil {
+		return x.SuggestedFix
+	}
+	return nil
+}
+
+// SuggestedFix is a set of text edits which fixes the advice.
+type SuggestedFix struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The title of the fix, such as "Add CONCURRENTLY".
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The edits to apply to the statement. The edits don't overlap.
+	Edits         []*TextEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestedFix) Reset() {
+	*x = SuggestedFix{}
+	mi := &file_store_advice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestedFix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedFix) ProtoMessage() {}
+
+func (x *SuggestedFix) ProtoReflect() protoreflect.Message {
+	mi := &file_store_advice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedFix.ProtoReflect.Descriptor instead.
+func (*SuggestedFix) Descriptor() ([]byte, []int) {
+	return file_store_advice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SuggestedFix) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SuggestedFix) GetEdits() []*TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+// TextEdit replaces the text between the positions with the new text.
+type TextEdit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start_position is inclusive and the end_position is exclusive.
+	// The text is inserted if the start_position equals the end_position.
+	StartPosition *Position `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	EndPosition   *Position `protobuf:"bytes,2,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	// The new text.
+	NewText       string `protobuf:"bytes,3,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEdit) Reset() {
+	*x = TextEdit{}
+	mi := &file_store_advice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEdit) ProtoMessage() {}
+
+func (x *TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_store_advice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEdit.ProtoReflect.Descriptor instead.
+func (*TextEdit) Descriptor() ([]byte, []int) {
+	return file_store_advice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TextEdit) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+func (x *TextEdit) GetEndPosition() *Position {
+	if x != nil {
+		return x.EndPosition
+	}
+	return nil
+}
+
+func (x *TextEdit) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
 var File_store_advice_proto protoreflect.FileDescriptor
 
 const file_store_advice_proto_rawDesc = "" +
 	"\n" +
-	"\x12store/advice.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xce\x02\n" +
+	"\x12store/advice.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\x91\x03\n" +
 	"\x06Advice\x125\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1d.bytebase.store.Advice.StatusR\x06status\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12?\n" +
 	"\x0estart_position\x18\x06 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
-	"\fend_position\x18\a \x01(\v2\x18.bytebase.store.PositionR\vendPosition\"E\n" +
+	"\fend_position\x18\a \x01(\v2\x18.bytebase.store.PositionR\vendPosition\x12A\n" +
+	"\rsuggested_fix\x18\b \x01(\v2\x1c.bytebase.store.SuggestedFixR\fsuggestedFix\"E\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aSUCCESS\x10\x01\x12\v\n" +
 	"\aWARNING\x10\x02\x12\t\n" +
-	"\x05ERROR\x10\x03J\x04\b\x05\x10\x06B\x8e\x01\n" +
+	"\x05ERROR\x10\x03J\x04\b\x05\x10\x06\"T\n" +
+	"\fSuggestedFix\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12.\n" +
+	"\x05edits\x18\x02 \x03(\v2\x18.bytebase.store.TextEditR\x05edits\"\xa3\x01\n" +
+	"\bTextEdit\x12?\n" +
+	"\x0estart_position\x18\x01 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
+	"\fend_position\x18\x02 \x01(\v2\x18.bytebase.store.PositionR\vendPosition\x12\x19\n" +
+	"\bnew_text\x18\x03 \x01(\tR\anewTextB\x8e\x01\n" +
 	"\x12com.bytebase.storeB\vAdviceProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -196,21 +332,27 @@ func file_store_advice_proto_rawDescGZIP() []byte {
 }
 
 var file_store_advice_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_advice_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_advice_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_advice_proto_goTypes = []any{
-	(Advice_Status)(0), // 0: bytebase.store.Advice.Status
-	(*Advice)(nil),     // 1: bytebase.store.Advice
-	(*Position)(nil),   // 2: bytebase.store.Position
+	(Advice_Status)(0),   // 0: bytebase.store.Advice.Status
+	(*Advice)(nil),       // 1: bytebase.store.Advice
+	(*SuggestedFix)(nil), // 2: bytebase.store.SuggestedFix
+	(*TextEdit)(nil),     // 3: bytebase.store.TextEdit
+	(*Position)(nil),     // 4: bytebase.store.Position
 }
 var file_store_advice_proto_depIdxs = []int32{
 	0, // 0: bytebase.store.Advice.status:type_name -> bytebase.store.Advice.Status
-	2, // 1: bytebase.store.Advice.start_position:type_name -> bytebase.store.Position
-	2, // 2: bytebase.store.Advice.end_position:type_name -> bytebase.store.Position
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 1: bytebase.store.Advice.start_position:type_name -> bytebase.store.Position
+	4, // 2: bytebase.store.Advice.end_position:type_name -> bytebase.store.Position
+	2, // 3: bytebase.store.Advice.suggested_fix:type_name -> bytebase.store.SuggestedFix
+	3, // 4: bytebase.store.SuggestedFix.edits:type_name -> bytebase.store.TextEdit
+	4, // 5: bytebase.store.TextEdit.start_position:type_name -> bytebase.store.Position
+	4, // 6: bytebase.store.TextEdit.end_position:type_name -> bytebase.store.Position
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_store_advice_proto_init() }
@@ -225,7 +367,7 @@ func file_store_advice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_advice_proto_rawDesc), len(file_store_advice_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
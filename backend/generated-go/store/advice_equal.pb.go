@@ -28,5 +28,48 @@ func (x *Advice) Equal(y *Advice) bool {
 	if !x.EndPosition.Equal(y.EndPosition) {
 		return false
 	}
+	if !x.SuggestedFix.Equal(y.SuggestedFix) {
+		return false
+	}
+	return true
+}
+
+func (x *SuggestedFix) Equal(y *SuggestedFix) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if len(x.Edits) != len(y.Edits) {
+		return false
+	}
+	for i := 0; i < len(x.Edits); i++ {
+		if !x.Edits[i].Equal(y.Edits[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *TextEdit) Equal(y *TextEdit) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if !x.StartPosition.Equal(y.StartPosition) {
+		return false
+	}
+	if !x.EndPosition.Equal(y.EndPosition) {
+		return false
+	}
+	if x.NewText != y.NewText {
+		return false
+	}
 	return true
 }
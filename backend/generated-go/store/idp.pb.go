@@ -28,6 +28,7 @@ const (
 	IdentityProviderType_OAUTH2                             IdentityProviderType = 1
 	IdentityProviderType_OIDC                               IdentityProviderType = 2
 	IdentityProviderType_LDAP                               IdentityProviderType = 3
+	IdentityProviderType_SAML                               IdentityProviderType = 4
 )
 
 // Enum value maps for IdentityProviderType.
@@ -37,12 +38,14 @@ var (
 		1: "OAUTH2",
 		2: "OIDC",
 		3: "LDAP",
+		4: "SAML",
 	}
 	IdentityProviderType_value = map[string]int32{
 		"IDENTITY_PROVIDER_TYPE_UNSPECIFIED": 0,
 		"OAUTH2":                             1,
 		"OIDC":                               2,
 		"LDAP":                               3,
+		"SAML":                               4,
 	}
 )
 
@@ -182,6 +185,7 @@ type IdentityProviderConfig struct {
 	//	*IdentityProviderConfig_Oauth2Config
 	//	*IdentityProviderConfig_OidcConfig
 	//	*IdentityProviderConfig_LdapConfig
+	//	*IdentityProviderConfig_SamlConfig
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -251,6 +255,15 @@ func (x *IdentityProviderConfig) GetLdapConfig() *LDAPIdentityProviderConfig {
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetSamlConfig() *SAMLIdentityProviderConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_SamlConfig); ok {
+			return x.SamlConfig
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -267,12 +280,18 @@ type IdentityProviderConfig_LdapConfig struct {
 	LdapConfig *LDAPIdentityProviderConfig `protobuf:"bytes,3,opt,name=ldap_config,json=ldapConfig,proto3,oneof"`
 }
 
+type IdentityProviderConfig_SamlConfig struct {
+	SamlConfig *SAMLIdentityProviderConfig `protobuf:"bytes,4,opt,name=saml_config,json=samlConfig,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2Config) isIdentityProviderConfig_Config() {}
 
 func (*IdentityProviderConfig_OidcConfig) isIdentityProviderConfig_Config() {}
 
 func (*IdentityProviderConfig_LdapConfig) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_SamlConfig) isIdentityProviderConfig_Config() {}
+
 // OAuth2IdentityProviderConfig is the structure for OAuth2 identity provider config.
 type OAuth2IdentityProviderConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -599,6 +618,111 @@ func (x *LDAPIdentityProviderConfig) GetFieldMapping() *FieldMapping {
 	return nil
 }
 
+// SAMLIdentityProviderConfig is the structure for SAML 2.0 identity provider config.
+// Bytebase acts as the service provider (SP).
+type SAMLIdentityProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MetadataURL is the URL of the IdP metadata, e.g.
+	// "https://adfs.example.com/FederationMetadata/2007-06/FederationMetadata.xml".
+	// Either metadata_url or metadata_xml is required.
+	MetadataUrl string `protobuf:"bytes,1,opt,name=metadata_url,json=metadataUrl,proto3" json:"metadata_url,omitempty"`
+	// MetadataXML is the IdP metadata XML document.
+	MetadataXml string `protobuf:"bytes,2,opt,name=metadata_xml,json=metadataXml,proto3" json:"metadata_xml,omitempty"`
+	// EntityID is the entity ID of the SP. When not set, the SP metadata URL is used.
+	EntityId string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Certificate is the PEM encoded X.509 certificate of the SP, published in the SP metadata.
+	Certificate string `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// PrivateKey is the PEM encoded private key of the SP, used to sign the AuthnRequest
+	// and to decrypt encrypted assertions.
+	PrivateKey string `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// FieldMapping is the mapping of the assertion attributes. When the identifier is
+	// not set, the NameID of the assertion subject is used.
+	FieldMapping *FieldMapping `protobuf:"bytes,6,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// SkipTLSVerify controls whether to skip TLS certificate verification when fetching the IdP metadata.
+	SkipTlsVerify bool `protobuf:"varint,7,opt,name=skip_tls_verify,json=skipTlsVerify,proto3" json:"skip_tls_verify,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLIdentityProviderConfig) Reset() {
+	*x = SAMLIdentityProviderConfig{}
+	mi := &file_store_idp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLIdentityProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLIdentityProviderConfig) ProtoMessage() {}
+
+func (x *SAMLIdentityProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLIdentityProviderConfig.ProtoReflect.Descriptor instead.
+func (*SAMLIdentityProviderConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SAMLIdentityProviderConfig) GetMetadataUrl() string {
+	if x != nil {
+		return x.MetadataUrl
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetMetadataXml() string {
+	if x != nil {
+		return x.MetadataXml
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SAMLIdentityProviderConfig) GetFieldMapping() *FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+func (x *SAMLIdentityProviderConfig) GetSkipTlsVerify() bool {
+	if x != nil {
+		return x.SkipTlsVerify
+	}
+	return false
+}
+
 // FieldMapping saves the field names from user info API of identity provider.
 // As we save all raw json string of user info response data into `principal.idp_user_info`,
 // we can extract the relevant data based with `FieldMapping`.
@@ -619,7 +743,7 @@ type FieldMapping struct {
 
 func (x *FieldMapping) Reset() {
 	*x = FieldMapping{}
-	mi := &file_store_idp_proto_msgTypes[4]
+	mi := &file_store_idp_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +755,7 @@ func (x *FieldMapping) String() string {
 func (*FieldMapping) ProtoMessage() {}
 
 func (x *FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_store_idp_proto_msgTypes[4]
+	mi := &file_store_idp_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +768,7 @@ func (x *FieldMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldMapping.ProtoReflect.Descriptor instead.
 func (*FieldMapping) Descriptor() ([]byte, []int) {
-	return file_store_idp_proto_rawDescGZIP(), []int{4}
+	return file_store_idp_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FieldMapping) GetIdentifier() string {
@@ -693,7 +817,7 @@ type IdentityProviderUserInfo struct {
 
 func (x *IdentityProviderUserInfo) Reset() {
 	*x = IdentityProviderUserInfo{}
-	mi := &file_store_idp_proto_msgTypes[5]
+	mi := &file_store_idp_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +829,7 @@ func (x *IdentityProviderUserInfo) String() string {
 func (*IdentityProviderUserInfo) ProtoMessage() {}
 
 func (x *IdentityProviderUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_idp_proto_msgTypes[5]
+	mi := &file_store_idp_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +842,7 @@ func (x *IdentityProviderUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProviderUserInfo.ProtoReflect.Descriptor instead.
 func (*IdentityProviderUserInfo) Descriptor() ([]byte, []int) {
-	return file_store_idp_proto_rawDescGZIP(), []int{5}
+	return file_store_idp_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *IdentityProviderUserInfo) GetIdentifier() string {
@@ -760,13 +884,15 @@ var File_store_idp_proto protoreflect.FileDescriptor
 
 const file_store_idp_proto_rawDesc = "" +
 	"\n" +
-	"\x0fstore/idp.proto\x12\x0ebytebase.store\"\x95\x02\n" +
+	"\x0fstore/idp.proto\x12\x0ebytebase.store\"\xe4\x02\n" +
 	"\x16IdentityProviderConfig\x12S\n" +
 	"\roauth2_config\x18\x01 \x01(\v2,.bytebase.store.OAuth2IdentityProviderConfigH\x00R\foauth2Config\x12M\n" +
 	"\voidc_config\x18\x02 \x01(\v2*.bytebase.store.OIDCIdentityProviderConfigH\x00R\n" +
 	"oidcConfig\x12M\n" +
 	"\vldap_config\x18\x03 \x01(\v2*.bytebase.store.LDAPIdentityProviderConfigH\x00R\n" +
-	"ldapConfigB\b\n" +
+	"ldapConfig\x12M\n" +
+	"\vsaml_config\x18\x04 \x01(\v2*.bytebase.store.SAMLIdentityProviderConfigH\x00R\n" +
+	"samlConfigB\b\n" +
 	"\x06config\"\xff\x02\n" +
 	"\x1cOAuth2IdentityProviderConfig\x12\x19\n" +
 	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x12\x1b\n" +
@@ -802,7 +928,16 @@ const file_store_idp_proto_rawDesc = "" +
 	"\x10SecurityProtocol\x12!\n" +
 	"\x1dSECURITY_PROTOCOL_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tSTART_TLS\x10\x01\x12\t\n" +
-	"\x05LDAPS\x10\x02\"\x85\x01\n" +
+	"\x05LDAPS\x10\x02\"\xad\x02\n" +
+	"\x1aSAMLIdentityProviderConfig\x12!\n" +
+	"\fmetadata_url\x18\x01 \x01(\tR\vmetadataUrl\x12!\n" +
+	"\fmetadata_xml\x18\x02 \x01(\tR\vmetadataXml\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12 \n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12\x1f\n" +
+	"\vprivate_key\x18\x05 \x01(\tR\n" +
+	"privateKey\x12A\n" +
+	"\rfield_mapping\x18\x06 \x01(\v2\x1c.bytebase.store.FieldMappingR\ffieldMapping\x12&\n" +
+	"\x0fskip_tls_verify\x18\a \x01(\bR\rskipTlsVerify\"\x85\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
@@ -818,13 +953,14 @@ const file_store_idp_proto_rawDesc = "" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x16\n" +
 	"\x06groups\x18\x05 \x03(\tR\x06groups\x12\x1d\n" +
 	"\n" +
-	"has_groups\x18\x06 \x01(\bR\thasGroupsJ\x04\b\x03\x10\x04*^\n" +
+	"has_groups\x18\x06 \x01(\bR\thasGroupsJ\x04\b\x03\x10\x04*h\n" +
 	"\x14IdentityProviderType\x12&\n" +
 	"\"IDENTITY_PROVIDER_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06OAUTH2\x10\x01\x12\b\n" +
 	"\x04OIDC\x10\x02\x12\b\n" +
-	"\x04LDAP\x10\x03*R\n" +
+	"\x04LDAP\x10\x03\x12\b\n" +
+	"\x04SAML\x10\x04*R\n" +
 	"\x0fOAuth2AuthStyle\x12!\n" +
 	"\x1dOAUTH2_AUTH_STYLE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tIN_PARAMS\x10\x01\x12\r\n" +
@@ -844,7 +980,7 @@ func file_store_idp_proto_rawDescGZIP() []byte {
 }
 
 var file_store_idp_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_store_idp_proto_goTypes = []any{
 	(IdentityProviderType)(0),                        // 0: bytebase.store.IdentityProviderType
 	(OAuth2AuthStyle)(0),                             // 1: bytebase.store.OAuth2AuthStyle
@@ -853,24 +989,27 @@ var file_store_idp_proto_goTypes = []any{
 	(*OAuth2IdentityProviderConfig)(nil),             // 4: bytebase.store.OAuth2IdentityProviderConfig
 	(*OIDCIdentityProviderConfig)(nil),               // 5: bytebase.store.OIDCIdentityProviderConfig
 	(*LDAPIdentityProviderConfig)(nil),               // 6: bytebase.store.LDAPIdentityProviderConfig
-	(*FieldMapping)(nil),                             // 7: bytebase.store.FieldMapping
-	(*IdentityProviderUserInfo)(nil),                 // 8: bytebase.store.IdentityProviderUserInfo
+	(*SAMLIdentityProviderConfig)(nil),               // 7: bytebase.store.SAMLIdentityProviderConfig
+	(*FieldMapping)(nil),                             // 8: bytebase.store.FieldMapping
+	(*IdentityProviderUserInfo)(nil),                 // 9: bytebase.store.IdentityProviderUserInfo
 }
 var file_store_idp_proto_depIdxs = []int32{
-	4, // 0: bytebase.store.IdentityProviderConfig.oauth2_config:type_name -> bytebase.store.OAuth2IdentityProviderConfig
-	5, // 1: bytebase.store.IdentityProviderConfig.oidc_config:type_name -> bytebase.store.OIDCIdentityProviderConfig
-	6, // 2: bytebase.store.IdentityProviderConfig.ldap_config:type_name -> bytebase.store.LDAPIdentityProviderConfig
-	7, // 3: bytebase.store.OAuth2IdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
-	1, // 4: bytebase.store.OAuth2IdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
-	7, // 5: bytebase.store.OIDCIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
-	1, // 6: bytebase.store.OIDCIdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
-	2, // 7: bytebase.store.LDAPIdentityProviderConfig.security_protocol:type_name -> bytebase.store.LDAPIdentityProviderConfig.SecurityProtocol
-	7, // 8: bytebase.store.LDAPIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	4,  // 0: bytebase.store.IdentityProviderConfig.oauth2_config:type_name -> bytebase.store.OAuth2IdentityProviderConfig
+	5,  // 1: bytebase.store.IdentityProviderConfig.oidc_config:type_name -> bytebase.store.OIDCIdentityProviderConfig
+	6,  // 2: bytebase.store.IdentityProviderConfig.ldap_config:type_name -> bytebase.store.LDAPIdentityProviderConfig
+	7,  // 3: bytebase.store.IdentityProviderConfig.saml_config:type_name -> bytebase.store.SAMLIdentityProviderConfig
+	8,  // 4: bytebase.store.OAuth2IdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	1,  // 5: bytebase.store.OAuth2IdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
+	8,  // 6: bytebase.store.OIDCIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	1,  // 7: bytebase.store.OIDCIdentityProviderConfig.auth_style:type_name -> bytebase.store.OAuth2AuthStyle
+	2,  // 8: bytebase.store.LDAPIdentityProviderConfig.security_protocol:type_name -> bytebase.store.LDAPIdentityProviderConfig.SecurityProtocol
+	8,  // 9: bytebase.store.LDAPIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	8,  // 10: bytebase.store.SAMLIdentityProviderConfig.field_mapping:type_name -> bytebase.store.FieldMapping
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_store_idp_proto_init() }
@@ -882,6 +1021,7 @@ func file_store_idp_proto_init() {
 		(*IdentityProviderConfig_Oauth2Config)(nil),
 		(*IdentityProviderConfig_OidcConfig)(nil),
 		(*IdentityProviderConfig_LdapConfig)(nil),
+		(*IdentityProviderConfig_SamlConfig)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -889,7 +1029,7 @@ func file_store_idp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_idp_proto_rawDesc), len(file_store_idp_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
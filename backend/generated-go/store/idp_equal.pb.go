@@ -19,6 +19,9 @@ func (x *IdentityProviderConfig) Equal(y *IdentityProviderConfig) bool {
 	if !x.GetLdapConfig().Equal(y.GetLdapConfig()) {
 		return false
 	}
+	if !x.GetSamlConfig().Equal(y.GetSamlConfig()) {
+		return false
+	}
 	return true
 }
 
@@ -137,6 +140,37 @@ func (x *LDAPIdentityProviderConfig) Equal(y *LDAPIdentityProviderConfig) bool {
 	return true
 }
 
+func (x *SAMLIdentityProviderConfig) Equal(y *SAMLIdentityProviderConfig) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.MetadataUrl != y.MetadataUrl {
+		return false
+	}
+	if x.MetadataXml != y.MetadataXml {
+		return false
+	}
+	if x.EntityId != y.EntityId {
+		return false
+	}
+	if x.Certificate != y.Certificate {
+		return false
+	}
+	if x.PrivateKey != y.PrivateKey {
+		return false
+	}
+	if !x.FieldMapping.Equal(y.FieldMapping) {
+		return false
+	}
+	if x.SkipTlsVerify != y.SkipTlsVerify {
+		return false
+	}
+	return true
+}
+
 func (x *FieldMapping) Equal(y *FieldMapping) bool {
 	if x == y {
 		return true
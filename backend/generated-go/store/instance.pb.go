@@ -189,6 +189,14 @@ const (
 	DataSourceExternalSecret_AWS_SECRETS_MANAGER DataSourceExternalSecret_SecretType = 2
 	// ref: https://cloud.google.com/secret-manager/docs
 	DataSourceExternalSecret_GCP_SECRET_MANAGER DataSourceExternalSecret_SecretType = 3
+	// ref: https://learn.microsoft.com/en-us/azure/key-vault/secrets/about-secrets
+	DataSourceExternalSecret_AZURE_KEY_VAULT DataSourceExternalSecret_SecretType = 4
+	// A local file, such as a Kubernetes mounted secret.
+	// The file must be under one of the directories in the BB_EXTERNAL_SECRET_FILE_DIRS environment.
+	DataSourceExternalSecret_FILE DataSourceExternalSecret_SecretType = 5
+	// A command that prints the secret to stdout.
+	// The command must be under one of the directories in the BB_EXTERNAL_SECRET_EXEC_DIRS environment.
+	DataSourceExternalSecret_EXEC DataSourceExternalSecret_SecretType = 6
 )
 
 // Enum value maps for DataSourceExternalSecret_SecretType.
@@ -198,12 +206,18 @@ var (
 		1: "VAULT_KV_V2",
 		2: "AWS_SECRETS_MANAGER",
 		3: "GCP_SECRET_MANAGER",
+		4: "AZURE_KEY_VAULT",
+		5: "FILE",
+		6: "EXEC",
 	}
 	DataSourceExternalSecret_SecretType_value = map[string]int32{
 		"SECRET_TYPE_UNSPECIFIED": 0,
 		"VAULT_KV_V2":             1,
 		"AWS_SECRETS_MANAGER":     2,
 		"GCP_SECRET_MANAGER":      3,
+		"AZURE_KEY_VAULT":         4,
+		"FILE":                    5,
+		"EXEC":                    6,
 	}
 )
 
@@ -1198,8 +1212,10 @@ func (x *KerberosConfig) GetKdcTransportProtocol() string {
 type DataSourceExternalSecret struct {
 	state      protoimpl.MessageState              `protogen:"open.v1"`
 	SecretType DataSourceExternalSecret_SecretType `protobuf:"varint,1,opt,name=secret_type,json=secretType,proto3,enum=bytebase.store.DataSourceExternalSecret_SecretType" json:"secret_type,omitempty"`
-	Url        string                              `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	AuthType   DataSourceExternalSecret_AuthType   `protobuf:"varint,3,opt,name=auth_type,json=authType,proto3,enum=bytebase.store.DataSourceExternalSecret_AuthType" json:"auth_type,omitempty"`
+	// For Azure Key Vault, it is the vault URL.
+	// For EXEC, it is the absolute path of the command, which is called with the secret name as the only argument.
+	Url      string                            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	AuthType DataSourceExternalSecret_AuthType `protobuf:"varint,3,opt,name=auth_type,json=authType,proto3,enum=bytebase.store.DataSourceExternalSecret_AuthType" json:"auth_type,omitempty"`
 	// Types that are valid to be assigned to AuthOption:
 	//
 	//	*DataSourceExternalSecret_AppRole
@@ -1226,8 +1242,11 @@ type DataSourceExternalSecret struct {
 	// Client private key for mutual TLS authentication with Vault.
 	VaultSslKey           string `protobuf:"bytes,14,opt,name=vault_ssl_key,json=vaultSslKey,proto3" json:"vault_ssl_key,omitempty"`
 	ObfuscatedVaultSslKey string `protobuf:"bytes,15,opt,name=obfuscated_vault_ssl_key,json=obfuscatedVaultSslKey,proto3" json:"obfuscated_vault_ssl_key,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The duration in seconds to cache the secret.
+	// 0 uses the default TTL, and a negative value disables the cache.
+	CacheTtlSeconds int32 `protobuf:"varint,16,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DataSourceExternalSecret) Reset() {
@@ -1376,6 +1395,13 @@ func (x *DataSourceExternalSecret) GetObfuscatedVaultSslKey() string {
 	return ""
 }
 
+func (x *DataSourceExternalSecret) GetCacheTtlSeconds() int32 {
+	if x != nil {
+		return x.CacheTtlSeconds
+	}
+	return 0
+}
+
 type isDataSourceExternalSecret_AuthOption interface {
 	isDataSourceExternalSecret_AuthOption()
 }
@@ -1876,7 +1902,8 @@ const file_store_instance_proto_rawDesc = "" +
 	"\x06keytab\x18\x04 \x01(\fR\x06keytab\x12\x19\n" +
 	"\bkdc_host\x18\x05 \x01(\tR\akdcHost\x12\x19\n" +
 	"\bkdc_port\x18\x06 \x01(\tR\akdcPort\x124\n" +
-	"\x16kdc_transport_protocol\x18\a \x01(\tR\x14kdcTransportProtocol\"\xd6\t\n" +
+	"\x16kdc_transport_protocol\x18\a \x01(\tR\x14kdcTransportProtocol\"\xac\n" +
+	"\n" +
 	"\x18DataSourceExternalSecret\x12T\n" +
 	"\vsecret_type\x18\x01 \x01(\x0e23.bytebase.store.DataSourceExternalSecret.SecretTypeR\n" +
 	"secretType\x12\x10\n" +
@@ -1897,7 +1924,8 @@ const file_store_instance_proto_rawDesc = "" +
 	"\x0evault_ssl_cert\x18\f \x01(\tR\fvaultSslCert\x129\n" +
 	"\x19obfuscated_vault_ssl_cert\x18\r \x01(\tR\x16obfuscatedVaultSslCert\x12\"\n" +
 	"\rvault_ssl_key\x18\x0e \x01(\tR\vvaultSslKey\x127\n" +
-	"\x18obfuscated_vault_ssl_key\x18\x0f \x01(\tR\x15obfuscatedVaultSslKey\x1a\x8a\x02\n" +
+	"\x18obfuscated_vault_ssl_key\x18\x0f \x01(\tR\x15obfuscatedVaultSslKey\x12*\n" +
+	"\x11cache_ttl_seconds\x18\x10 \x01(\x05R\x0fcacheTtlSeconds\x1a\x8a\x02\n" +
 	"\x11AppRoleAuthOption\x12\x17\n" +
 	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1b\n" +
 	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12Y\n" +
@@ -1908,13 +1936,16 @@ const file_store_instance_proto_rawDesc = "" +
 	"SecretType\x12\x1b\n" +
 	"\x17SECRET_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05PLAIN\x10\x01\x12\x0f\n" +
-	"\vENVIRONMENT\x10\x02\"k\n" +
+	"\vENVIRONMENT\x10\x02\"\x94\x01\n" +
 	"\n" +
 	"SecretType\x12\x1b\n" +
 	"\x17SECRET_TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vVAULT_KV_V2\x10\x01\x12\x17\n" +
 	"\x13AWS_SECRETS_MANAGER\x10\x02\x12\x16\n" +
-	"\x12GCP_SECRET_MANAGER\x10\x03\"D\n" +
+	"\x12GCP_SECRET_MANAGER\x10\x03\x12\x13\n" +
+	"\x0fAZURE_KEY_VAULT\x10\x04\x12\b\n" +
+	"\x04FILE\x10\x05\x12\b\n" +
+	"\x04EXEC\x10\x06\"D\n" +
 	"\bAuthType\x12\x19\n" +
 	"\x15AUTH_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05TOKEN\x10\x01\x12\x12\n" +
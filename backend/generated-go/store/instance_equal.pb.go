@@ -469,5 +469,8 @@ func (x *DataSourceExternalSecret) Equal(y *DataSourceExternalSecret) bool {
 	if x.ObfuscatedVaultSslKey != y.ObfuscatedVaultSslKey {
 		return false
 	}
+	if x.CacheTtlSeconds != y.CacheTtlSeconds {
+		return false
+	}
 	return true
 }
@@ -56,5 +56,8 @@ func (x *GrantRequest) Equal(y *GrantRequest) bool {
 	if p, q := x.Expiration, y.Expiration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if x.BreakGlass != y.BreakGlass {
+		return false
+	}
 	return true
 }
@@ -361,9 +361,13 @@ type PlanConfig_ChangeDatabaseConfig struct {
 	// If set, a backup of the modified data will be created automatically before any changes are applied.
 	EnablePriorBackup bool `protobuf:"varint,8,opt,name=enable_prior_backup,json=enablePriorBackup,proto3" json:"enable_prior_backup,omitempty"`
 	// Whether to use gh-ost for online schema migration.
-	EnableGhost   bool `protobuf:"varint,12,opt,name=enable_ghost,json=enableGhost,proto3" json:"enable_ghost,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	EnableGhost bool `protobuf:"varint,12,opt,name=enable_ghost,json=enableGhost,proto3" json:"enable_ghost,omitempty"`
+	// Flags for the PostgreSQL online schema change.
+	OnlineSchemaChangeFlags map[string]string `protobuf:"bytes,13,rep,name=online_schema_change_flags,json=onlineSchemaChangeFlags,proto3" json:"online_schema_change_flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Whether to use the shadow table online schema change for PostgreSQL.
+	EnableOnlineSchemaChange bool `protobuf:"varint,14,opt,name=enable_online_schema_change,json=enableOnlineSchemaChange,proto3" json:"enable_online_schema_change,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *PlanConfig_ChangeDatabaseConfig) Reset() {
@@ -445,6 +449,20 @@ func (x *PlanConfig_ChangeDatabaseConfig) GetEnableGhost() bool {
 	return false
 }
 
+func (x *PlanConfig_ChangeDatabaseConfig) GetOnlineSchemaChangeFlags() map[string]string {
+	if x != nil {
+		return x.OnlineSchemaChangeFlags
+	}
+	return nil
+}
+
+func (x *PlanConfig_ChangeDatabaseConfig) GetEnableOnlineSchemaChange() bool {
+	if x != nil {
+		return x.EnableOnlineSchemaChange
+	}
+	return false
+}
+
 type PlanConfig_ExportDataConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The list of targets.
@@ -527,8 +545,11 @@ type PlanConfig_Deployment struct {
 	Environments []string `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
 	// The database group mapping.
 	DatabaseGroupMappings []*PlanConfig_Deployment_DatabaseGroupMapping `protobuf:"bytes,2,rep,name=database_group_mappings,json=databaseGroupMappings,proto3" json:"database_group_mappings,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The strategy to roll out the tasks in each stage in batches.
+	// All tasks in a stage can run at once if unset.
+	RolloutStrategy *PlanConfig_Deployment_RolloutStrategy `protobuf:"bytes,3,opt,name=rollout_strategy,json=rolloutStrategy,proto3" json:"rollout_strategy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PlanConfig_Deployment) Reset() {
@@ -575,6 +596,13 @@ func (x *PlanConfig_Deployment) GetDatabaseGroupMappings() []*PlanConfig_Deploym
 	return nil
 }
 
+func (x *PlanConfig_Deployment) GetRolloutStrategy() *PlanConfig_Deployment_RolloutStrategy {
+	if x != nil {
+		return x.RolloutStrategy
+	}
+	return nil
+}
+
 type PlanConfig_Deployment_DatabaseGroupMapping struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Format: projects/{project}/databaseGroups/{databaseGroup}.
@@ -587,7 +615,7 @@ type PlanConfig_Deployment_DatabaseGroupMapping struct {
 
 func (x *PlanConfig_Deployment_DatabaseGroupMapping) Reset() {
 	*x = PlanConfig_Deployment_DatabaseGroupMapping{}
-	mi := &file_store_plan_proto_msgTypes[7]
+	mi := &file_store_plan_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +627,7 @@ func (x *PlanConfig_Deployment_DatabaseGroupMapping) String() string {
 func (*PlanConfig_Deployment_DatabaseGroupMapping) ProtoMessage() {}
 
 func (x *PlanConfig_Deployment_DatabaseGroupMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_store_plan_proto_msgTypes[7]
+	mi := &file_store_plan_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,11 +657,76 @@ func (x *PlanConfig_Deployment_DatabaseGroupMapping) GetDatabases() []string {
 	return nil
 }
 
+type PlanConfig_Deployment_RolloutStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of canary tasks to run first in each stage.
+	CanaryCount int32 `protobuf:"varint,1,opt,name=canary_count,json=canaryCount,proto3" json:"canary_count,omitempty"`
+	// The percentage of the remaining tasks to run in each batch after the canary tasks, from 0 to 100.
+	// All remaining tasks run in one batch if zero.
+	BatchPercentage int32 `protobuf:"varint,2,opt,name=batch_percentage,json=batchPercentage,proto3" json:"batch_percentage,omitempty"`
+	// The number of failed tasks at which the stage halts and the remaining tasks stop.
+	// The stage halts on the first failure if zero.
+	FailureThreshold int32 `protobuf:"varint,3,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PlanConfig_Deployment_RolloutStrategy) Reset() {
+	*x = PlanConfig_Deployment_RolloutStrategy{}
+	mi := &file_store_plan_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanConfig_Deployment_RolloutStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanConfig_Deployment_RolloutStrategy) ProtoMessage() {}
+
+func (x *PlanConfig_Deployment_RolloutStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanConfig_Deployment_RolloutStrategy.ProtoReflect.Descriptor instead.
+func (*PlanConfig_Deployment_RolloutStrategy) Descriptor() ([]byte, []int) {
+	return file_store_plan_proto_rawDescGZIP(), []int{0, 4, 1}
+}
+
+func (x *PlanConfig_Deployment_RolloutStrategy) GetCanaryCount() int32 {
+	if x != nil {
+		return x.CanaryCount
+	}
+	return 0
+}
+
+func (x *PlanConfig_Deployment_RolloutStrategy) GetBatchPercentage() int32 {
+	if x != nil {
+		return x.BatchPercentage
+	}
+	return 0
+}
+
+func (x *PlanConfig_Deployment_RolloutStrategy) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
 var File_store_plan_proto protoreflect.FileDescriptor
 
 const file_store_plan_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/plan.proto\x12\x0ebytebase.store\x1a\x1fgoogle/api/field_behavior.proto\x1a\x12store/common.proto\"\xfe\f\n" +
+	"\x10store/plan.proto\x12\x0ebytebase.store\x1a\x1fgoogle/api/field_behavior.proto\x1a\x12store/common.proto\"\x86\x11\n" +
 	"\n" +
 	"PlanConfig\x125\n" +
 	"\x05specs\x18\x01 \x03(\v2\x1f.bytebase.store.PlanConfig.SpecR\x05specs\x12E\n" +
@@ -654,7 +747,7 @@ const file_store_plan_proto_rawDesc = "" +
 	"\tcollation\x18\x05 \x01(\tB\x03\xe0A\x01R\tcollation\x12\x1d\n" +
 	"\acluster\x18\x06 \x01(\tB\x03\xe0A\x01R\acluster\x12\x19\n" +
 	"\x05owner\x18\a \x01(\tB\x03\xe0A\x01R\x05owner\x12%\n" +
-	"\venvironment\x18\t \x01(\tB\x03\xe0A\x01R\venvironment\x1a\xd2\x03\n" +
+	"\venvironment\x18\t \x01(\tB\x03\xe0A\x01R\venvironment\x1a\xe9\x05\n" +
 	"\x14ChangeDatabaseConfig\x12\x18\n" +
 	"\atargets\x18\n" +
 	" \x03(\tR\atargets\x12\x14\n" +
@@ -664,9 +757,14 @@ const file_store_plan_proto_rawDesc = "" +
 	"\vghost_flags\x18\a \x03(\v2?.bytebase.store.PlanConfig.ChangeDatabaseConfig.GhostFlagsEntryR\n" +
 	"ghostFlags\x12.\n" +
 	"\x13enable_prior_backup\x18\b \x01(\bR\x11enablePriorBackup\x12!\n" +
-	"\fenable_ghost\x18\f \x01(\bR\venableGhost\x1a=\n" +
+	"\fenable_ghost\x18\f \x01(\bR\venableGhost\x12\x89\x01\n" +
+	"\x1aonline_schema_change_flags\x18\r \x03(\v2L.bytebase.store.PlanConfig.ChangeDatabaseConfig.OnlineSchemaChangeFlagsEntryR\x17onlineSchemaChangeFlags\x12=\n" +
+	"\x1benable_online_schema_change\x18\x0e \x01(\bR\x18enableOnlineSchemaChange\x1a=\n" +
 	"\x0fGhostFlagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aJ\n" +
+	"\x1cOnlineSchemaChangeFlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"2\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -677,14 +775,19 @@ const file_store_plan_proto_rawDesc = "" +
 	"\x05sheet\x18\x02 \x01(\tR\x05sheet\x124\n" +
 	"\x06format\x18\x03 \x01(\x0e2\x1c.bytebase.store.ExportFormatR\x06format\x12\x1f\n" +
 	"\bpassword\x18\x04 \x01(\tH\x00R\bpassword\x88\x01\x01B\v\n" +
-	"\t_password\x1a\x81\x02\n" +
+	"\t_password\x1a\xf2\x03\n" +
 	"\n" +
 	"Deployment\x12\"\n" +
 	"\fenvironments\x18\x01 \x03(\tR\fenvironments\x12r\n" +
-	"\x17database_group_mappings\x18\x02 \x03(\v2:.bytebase.store.PlanConfig.Deployment.DatabaseGroupMappingR\x15databaseGroupMappings\x1a[\n" +
+	"\x17database_group_mappings\x18\x02 \x03(\v2:.bytebase.store.PlanConfig.Deployment.DatabaseGroupMappingR\x15databaseGroupMappings\x12`\n" +
+	"\x10rollout_strategy\x18\x03 \x01(\v25.bytebase.store.PlanConfig.Deployment.RolloutStrategyR\x0frolloutStrategy\x1a[\n" +
 	"\x14DatabaseGroupMapping\x12%\n" +
 	"\x0edatabase_group\x18\x01 \x01(\tR\rdatabaseGroup\x12\x1c\n" +
-	"\tdatabases\x18\x02 \x03(\tR\tdatabasesB\x8c\x01\n" +
+	"\tdatabases\x18\x02 \x03(\tR\tdatabases\x1a\x8c\x01\n" +
+	"\x0fRolloutStrategy\x12!\n" +
+	"\fcanary_count\x18\x01 \x01(\x05R\vcanaryCount\x12)\n" +
+	"\x10batch_percentage\x18\x02 \x01(\x05R\x0fbatchPercentage\x12+\n" +
+	"\x11failure_threshold\x18\x03 \x01(\x05R\x10failureThresholdB\x8c\x01\n" +
 	"\x12com.bytebase.storeB\tPlanProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -700,34 +803,38 @@ func file_store_plan_proto_rawDescGZIP() []byte {
 }
 
 var file_store_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_store_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_plan_proto_goTypes = []any{
-	(PlanConfig_ChangeDatabaseConfig_Type)(0),          // 0: bytebase.store.PlanConfig.ChangeDatabaseConfig.Type
-	(*PlanConfig)(nil),                                 // 1: bytebase.store.PlanConfig
-	(*PlanConfig_Spec)(nil),                            // 2: bytebase.store.PlanConfig.Spec
-	(*PlanConfig_CreateDatabaseConfig)(nil),            // 3: bytebase.store.PlanConfig.CreateDatabaseConfig
-	(*PlanConfig_ChangeDatabaseConfig)(nil),            // 4: bytebase.store.PlanConfig.ChangeDatabaseConfig
-	(*PlanConfig_ExportDataConfig)(nil),                // 5: bytebase.store.PlanConfig.ExportDataConfig
-	(*PlanConfig_Deployment)(nil),                      // 6: bytebase.store.PlanConfig.Deployment
-	nil,                                                // 7: bytebase.store.PlanConfig.ChangeDatabaseConfig.GhostFlagsEntry
-	(*PlanConfig_Deployment_DatabaseGroupMapping)(nil), // 8: bytebase.store.PlanConfig.Deployment.DatabaseGroupMapping
-	(ExportFormat)(0),                                  // 9: bytebase.store.ExportFormat
+	(PlanConfig_ChangeDatabaseConfig_Type)(0), // 0: bytebase.store.PlanConfig.ChangeDatabaseConfig.Type
+	(*PlanConfig)(nil),                        // 1: bytebase.store.PlanConfig
+	(*PlanConfig_Spec)(nil),                   // 2: bytebase.store.PlanConfig.Spec
+	(*PlanConfig_CreateDatabaseConfig)(nil),   // 3: bytebase.store.PlanConfig.CreateDatabaseConfig
+	(*PlanConfig_ChangeDatabaseConfig)(nil),   // 4: bytebase.store.PlanConfig.ChangeDatabaseConfig
+	(*PlanConfig_ExportDataConfig)(nil),       // 5: bytebase.store.PlanConfig.ExportDataConfig
+	(*PlanConfig_Deployment)(nil),             // 6: bytebase.store.PlanConfig.Deployment
+	nil,                                       // 7: bytebase.store.PlanConfig.ChangeDatabaseConfig.GhostFlagsEntry
+	nil,                                       // 8: bytebase.store.PlanConfig.ChangeDatabaseConfig.OnlineSchemaChangeFlagsEntry
+	(*PlanConfig_Deployment_DatabaseGroupMapping)(nil), // 9: bytebase.store.PlanConfig.Deployment.DatabaseGroupMapping
+	(*PlanConfig_Deployment_RolloutStrategy)(nil),      // 10: bytebase.store.PlanConfig.Deployment.RolloutStrategy
+	(ExportFormat)(0), // 11: bytebase.store.ExportFormat
 }
 var file_store_plan_proto_depIdxs = []int32{
-	2, // 0: bytebase.store.PlanConfig.specs:type_name -> bytebase.store.PlanConfig.Spec
-	6, // 1: bytebase.store.PlanConfig.deployment:type_name -> bytebase.store.PlanConfig.Deployment
-	3, // 2: bytebase.store.PlanConfig.Spec.create_database_config:type_name -> bytebase.store.PlanConfig.CreateDatabaseConfig
-	4, // 3: bytebase.store.PlanConfig.Spec.change_database_config:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig
-	5, // 4: bytebase.store.PlanConfig.Spec.export_data_config:type_name -> bytebase.store.PlanConfig.ExportDataConfig
-	0, // 5: bytebase.store.PlanConfig.ChangeDatabaseConfig.type:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig.Type
-	7, // 6: bytebase.store.PlanConfig.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig.GhostFlagsEntry
-	9, // 7: bytebase.store.PlanConfig.ExportDataConfig.format:type_name -> bytebase.store.ExportFormat
-	8, // 8: bytebase.store.PlanConfig.Deployment.database_group_mappings:type_name -> bytebase.store.PlanConfig.Deployment.DatabaseGroupMapping
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	2,  // 0: bytebase.store.PlanConfig.specs:type_name -> bytebase.store.PlanConfig.Spec
+	6,  // 1: bytebase.store.PlanConfig.deployment:type_name -> bytebase.store.PlanConfig.Deployment
+	3,  // 2: bytebase.store.PlanConfig.Spec.create_database_config:type_name -> bytebase.store.PlanConfig.CreateDatabaseConfig
+	4,  // 3: bytebase.store.PlanConfig.Spec.change_database_config:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig
+	5,  // 4: bytebase.store.PlanConfig.Spec.export_data_config:type_name -> bytebase.store.PlanConfig.ExportDataConfig
+	0,  // 5: bytebase.store.PlanConfig.ChangeDatabaseConfig.type:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig.Type
+	7,  // 6: bytebase.store.PlanConfig.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig.GhostFlagsEntry
+	8,  // 7: bytebase.store.PlanConfig.ChangeDatabaseConfig.online_schema_change_flags:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig.OnlineSchemaChangeFlagsEntry
+	11, // 8: bytebase.store.PlanConfig.ExportDataConfig.format:type_name -> bytebase.store.ExportFormat
+	9,  // 9: bytebase.store.PlanConfig.Deployment.database_group_mappings:type_name -> bytebase.store.PlanConfig.Deployment.DatabaseGroupMapping
+	10, // 10: bytebase.store.PlanConfig.Deployment.rollout_strategy:type_name -> bytebase.store.PlanConfig.Deployment.RolloutStrategy
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_store_plan_proto_init() }
@@ -748,7 +855,7 @@ func file_store_plan_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_plan_proto_rawDesc), len(file_store_plan_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,123 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The lock levels ordered from the weakest to the strongest.
+type PlanCheckRunResult_Result_LockImpactReport_LockLevel int32
+
+const (
+	PlanCheckRunResult_Result_LockImpactReport_LOCK_LEVEL_UNSPECIFIED PlanCheckRunResult_Result_LockImpactReport_LockLevel = 0
+	// PostgreSQL SHARE UPDATE EXCLUSIVE lock, which does not block reads and writes.
+	PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE PlanCheckRunResult_Result_LockImpactReport_LockLevel = 1
+	// PostgreSQL SHARE lock, which blocks writes.
+	PlanCheckRunResult_Result_LockImpactReport_SHARE PlanCheckRunResult_Result_LockImpactReport_LockLevel = 2
+	// PostgreSQL SHARE ROW EXCLUSIVE lock, which blocks writes.
+	PlanCheckRunResult_Result_LockImpactReport_SHARE_ROW_EXCLUSIVE PlanCheckRunResult_Result_LockImpactReport_LockLevel = 3
+	// PostgreSQL ACCESS EXCLUSIVE lock, which blocks reads and writes.
+	PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE PlanCheckRunResult_Result_LockImpactReport_LockLevel = 4
+	// MySQL exclusive metadata lock. Whether the writes are blocked depends on the algorithm.
+	PlanCheckRunResult_Result_LockImpactReport_METADATA_LOCK PlanCheckRunResult_Result_LockImpactReport_LockLevel = 5
+)
+
+// Enum value maps for PlanCheckRunResult_Result_LockImpactReport_LockLevel.
+var (
+	PlanCheckRunResult_Result_LockImpactReport_LockLevel_name = map[int32]string{
+		0: "LOCK_LEVEL_UNSPECIFIED",
+		1: "SHARE_UPDATE_EXCLUSIVE",
+		2: "SHARE",
+		3: "SHARE_ROW_EXCLUSIVE",
+		4: "ACCESS_EXCLUSIVE",
+		5: "METADATA_LOCK",
+	}
+	PlanCheckRunResult_Result_LockImpactReport_LockLevel_value = map[string]int32{
+		"LOCK_LEVEL_UNSPECIFIED": 0,
+		"SHARE_UPDATE_EXCLUSIVE": 1,
+		"SHARE":                  2,
+		"SHARE_ROW_EXCLUSIVE":    3,
+		"ACCESS_EXCLUSIVE":       4,
+		"METADATA_LOCK":          5,
+	}
+)
+
+func (x PlanCheckRunResult_Result_LockImpactReport_LockLevel) Enum() *PlanCheckRunResult_Result_LockImpactReport_LockLevel {
+	p := new(PlanCheckRunResult_Result_LockImpactReport_LockLevel)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRunResult_Result_LockImpactReport_LockLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRunResult_Result_LockImpactReport_LockLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_plan_check_run_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanCheckRunResult_Result_LockImpactReport_LockLevel) Type() protoreflect.EnumType {
+	return &file_store_plan_check_run_proto_enumTypes[0]
+}
+
+func (x PlanCheckRunResult_Result_LockImpactReport_LockLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_LockImpactReport_LockLevel.Descriptor instead.
+func (PlanCheckRunResult_Result_LockImpactReport_LockLevel) EnumDescriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 2, 0}
+}
+
+// The MySQL online DDL algorithms.
+type PlanCheckRunResult_Result_LockImpactReport_Algorithm int32
+
+const (
+	PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED PlanCheckRunResult_Result_LockImpactReport_Algorithm = 0
+	PlanCheckRunResult_Result_LockImpactReport_INSTANT               PlanCheckRunResult_Result_LockImpactReport_Algorithm = 1
+	PlanCheckRunResult_Result_LockImpactReport_INPLACE               PlanCheckRunResult_Result_LockImpactReport_Algorithm = 2
+	PlanCheckRunResult_Result_LockImpactReport_COPY                  PlanCheckRunResult_Result_LockImpactReport_Algorithm = 3
+)
+
+// Enum value maps for PlanCheckRunResult_Result_LockImpactReport_Algorithm.
+var (
+	PlanCheckRunResult_Result_LockImpactReport_Algorithm_name = map[int32]string{
+		0: "ALGORITHM_UNSPECIFIED",
+		1: "INSTANT",
+		2: "INPLACE",
+		3: "COPY",
+	}
+	PlanCheckRunResult_Result_LockImpactReport_Algorithm_value = map[string]int32{
+		"ALGORITHM_UNSPECIFIED": 0,
+		"INSTANT":               1,
+		"INPLACE":               2,
+		"COPY":                  3,
+	}
+)
+
+func (x PlanCheckRunResult_Result_LockImpactReport_Algorithm) Enum() *PlanCheckRunResult_Result_LockImpactReport_Algorithm {
+	p := new(PlanCheckRunResult_Result_LockImpactReport_Algorithm)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRunResult_Result_LockImpactReport_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRunResult_Result_LockImpactReport_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_plan_check_run_proto_enumTypes[1].Descriptor()
+}
+
+func (PlanCheckRunResult_Result_LockImpactReport_Algorithm) Type() protoreflect.EnumType {
+	return &file_store_plan_check_run_proto_enumTypes[1]
+}
+
+func (x PlanCheckRunResult_Result_LockImpactReport_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_LockImpactReport_Algorithm.Descriptor instead.
+func (PlanCheckRunResult_Result_LockImpactReport_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 2, 1}
+}
+
 type PlanCheckRunConfig struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	SheetUid     int32                  `protobuf:"varint,1,opt,name=sheet_uid,json=sheetUid,proto3" json:"sheet_uid,omitempty"`
@@ -32,7 +149,12 @@ type PlanCheckRunConfig struct {
 	// Whether to use gh-ost for online schema migration.
 	EnableGhost bool `protobuf:"varint,8,opt,name=enable_ghost,json=enableGhost,proto3" json:"enable_ghost,omitempty"`
 	// Whether this is a Schema Definition Language (SDL) change.
-	EnableSdl     bool `protobuf:"varint,9,opt,name=enable_sdl,json=enableSdl,proto3" json:"enable_sdl,omitempty"`
+	EnableSdl               bool              `protobuf:"varint,9,opt,name=enable_sdl,json=enableSdl,proto3" json:"enable_sdl,omitempty"`
+	OnlineSchemaChangeFlags map[string]string `protobuf:"bytes,10,rep,name=online_schema_change_flags,json=onlineSchemaChangeFlags,proto3" json:"online_schema_change_flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Whether to use the shadow table online schema change for PostgreSQL.
+	EnableOnlineSchemaChange bool `protobuf:"varint,11,opt,name=enable_online_schema_change,json=enableOnlineSchemaChange,proto3" json:"enable_online_schema_change,omitempty"`
+	// The plan that the check run belongs to. It is used to find the check runs of the other environments.
+	PlanUid       int64 `protobuf:"varint,12,opt,name=plan_uid,json=planUid,proto3" json:"plan_uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -116,6 +238,27 @@ func (x *PlanCheckRunConfig) GetEnableSdl() bool {
 	return false
 }
 
+func (x *PlanCheckRunConfig) GetOnlineSchemaChangeFlags() map[string]string {
+	if x != nil {
+		return x.OnlineSchemaChangeFlags
+	}
+	return nil
+}
+
+func (x *PlanCheckRunConfig) GetEnableOnlineSchemaChange() bool {
+	if x != nil {
+		return x.EnableOnlineSchemaChange
+	}
+	return false
+}
+
+func (x *PlanCheckRunConfig) GetPlanUid() int64 {
+	if x != nil {
+		return x.PlanUid
+	}
+	return 0
+}
+
 type PlanCheckRunResult struct {
 	state         protoimpl.MessageState       `protogen:"open.v1"`
 	Results       []*PlanCheckRunResult_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
@@ -178,6 +321,8 @@ type PlanCheckRunResult_Result struct {
 	//
 	//	*PlanCheckRunResult_Result_SqlSummaryReport_
 	//	*PlanCheckRunResult_Result_SqlReviewReport_
+	//	*PlanCheckRunResult_Result_LockImpactReport_
+	//	*PlanCheckRunResult_Result_ExplainReport_
 	Report        isPlanCheckRunResult_Result_Report `protobuf_oneof:"report"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -185,7 +330,7 @@ type PlanCheckRunResult_Result struct {
 
 func (x *PlanCheckRunResult_Result) Reset() {
 	*x = PlanCheckRunResult_Result{}
-	mi := &file_store_plan_check_run_proto_msgTypes[3]
+	mi := &file_store_plan_check_run_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +342,7 @@ func (x *PlanCheckRunResult_Result) String() string {
 func (*PlanCheckRunResult_Result) ProtoMessage() {}
 
 func (x *PlanCheckRunResult_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_store_plan_check_run_proto_msgTypes[3]
+	mi := &file_store_plan_check_run_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,6 +411,24 @@ func (x *PlanCheckRunResult_Result) GetSqlReviewReport() *PlanCheckRunResult_Res
 	return nil
 }
 
+func (x *PlanCheckRunResult_Result) GetLockImpactReport() *PlanCheckRunResult_Result_LockImpactReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRunResult_Result_LockImpactReport_); ok {
+			return x.LockImpactReport
+		}
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result) GetExplainReport() *PlanCheckRunResult_Result_ExplainReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRunResult_Result_ExplainReport_); ok {
+			return x.ExplainReport
+		}
+	}
+	return nil
+}
+
 type isPlanCheckRunResult_Result_Report interface {
 	isPlanCheckRunResult_Result_Report()
 }
@@ -278,23 +441,37 @@ type PlanCheckRunResult_Result_SqlReviewReport_ struct {
 	SqlReviewReport *PlanCheckRunResult_Result_SqlReviewReport `protobuf:"bytes,6,opt,name=sql_review_report,json=sqlReviewReport,proto3,oneof"`
 }
 
+type PlanCheckRunResult_Result_LockImpactReport_ struct {
+	LockImpactReport *PlanCheckRunResult_Result_LockImpactReport `protobuf:"bytes,7,opt,name=lock_impact_report,json=lockImpactReport,proto3,oneof"`
+}
+
+type PlanCheckRunResult_Result_ExplainReport_ struct {
+	ExplainReport *PlanCheckRunResult_Result_ExplainReport `protobuf:"bytes,8,opt,name=explain_report,json=explainReport,proto3,oneof"`
+}
+
 func (*PlanCheckRunResult_Result_SqlSummaryReport_) isPlanCheckRunResult_Result_Report() {}
 
 func (*PlanCheckRunResult_Result_SqlReviewReport_) isPlanCheckRunResult_Result_Report() {}
 
+func (*PlanCheckRunResult_Result_LockImpactReport_) isPlanCheckRunResult_Result_Report() {}
+
+func (*PlanCheckRunResult_Result_ExplainReport_) isPlanCheckRunResult_Result_Report() {}
+
 type PlanCheckRunResult_Result_SqlSummaryReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// statement_types are the types of statements found in the SQL.
 	StatementTypes   []string          `protobuf:"bytes,2,rep,name=statement_types,json=statementTypes,proto3" json:"statement_types,omitempty"`
 	AffectedRows     int64             `protobuf:"varint,3,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
 	ChangedResources *ChangedResources `protobuf:"bytes,4,opt,name=changed_resources,json=changedResources,proto3" json:"changed_resources,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The estimated cost of the DML statements from EXPLAIN.
+	EstimatedCost float64 `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlanCheckRunResult_Result_SqlSummaryReport) Reset() {
 	*x = PlanCheckRunResult_Result_SqlSummaryReport{}
-	mi := &file_store_plan_check_run_proto_msgTypes[4]
+	mi := &file_store_plan_check_run_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +483,7 @@ func (x *PlanCheckRunResult_Result_SqlSummaryReport) String() string {
 func (*PlanCheckRunResult_Result_SqlSummaryReport) ProtoMessage() {}
 
 func (x *PlanCheckRunResult_Result_SqlSummaryReport) ProtoReflect() protoreflect.Message {
-	mi := &file_store_plan_check_run_proto_msgTypes[4]
+	mi := &file_store_plan_check_run_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,6 +520,13 @@ func (x *PlanCheckRunResult_Result_SqlSummaryReport) GetChangedResources() *Chan
 	return nil
 }
 
+func (x *PlanCheckRunResult_Result_SqlSummaryReport) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
 type PlanCheckRunResult_Result_SqlReviewReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Position of the SQL statement.
@@ -354,7 +538,7 @@ type PlanCheckRunResult_Result_SqlReviewReport struct {
 
 func (x *PlanCheckRunResult_Result_SqlReviewReport) Reset() {
 	*x = PlanCheckRunResult_Result_SqlReviewReport{}
-	mi := &file_store_plan_check_run_proto_msgTypes[5]
+	mi := &file_store_plan_check_run_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -366,7 +550,7 @@ func (x *PlanCheckRunResult_Result_SqlReviewReport) String() string {
 func (*PlanCheckRunResult_Result_SqlReviewReport) ProtoMessage() {}
 
 func (x *PlanCheckRunResult_Result_SqlReviewReport) ProtoReflect() protoreflect.Message {
-	mi := &file_store_plan_check_run_proto_msgTypes[5]
+	mi := &file_store_plan_check_run_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,11 +580,354 @@ func (x *PlanCheckRunResult_Result_SqlReviewReport) GetEndPosition() *Position {
 	return nil
 }
 
+type PlanCheckRunResult_Result_LockImpactReport struct {
+	state     protoimpl.MessageState                               `protogen:"open.v1"`
+	Schema    string                                               `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table     string                                               `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	LockLevel PlanCheckRunResult_Result_LockImpactReport_LockLevel `protobuf:"varint,3,opt,name=lock_level,json=lockLevel,proto3,enum=bytebase.store.PlanCheckRunResult_Result_LockImpactReport_LockLevel" json:"lock_level,omitempty"`
+	Algorithm PlanCheckRunResult_Result_LockImpactReport_Algorithm `protobuf:"varint,4,opt,name=algorithm,proto3,enum=bytebase.store.PlanCheckRunResult_Result_LockImpactReport_Algorithm" json:"algorithm,omitempty"`
+	// Whether the table is rewritten, e.g. ALTER COLUMN TYPE.
+	TableRewrite bool `protobuf:"varint,5,opt,name=table_rewrite,json=tableRewrite,proto3" json:"table_rewrite,omitempty"`
+	// Whether the table is fully scanned while the lock is held, e.g. SET NOT NULL.
+	TableScan bool `protobuf:"varint,6,opt,name=table_scan,json=tableScan,proto3" json:"table_scan,omitempty"`
+	// The table row count and size in bytes from the synced metadata.
+	TableRows int64 `protobuf:"varint,7,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	TableSize int64 `protobuf:"varint,8,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
+	// The estimated duration in seconds that the concurrent writes are blocked.
+	EstimatedBlockingSeconds int64 `protobuf:"varint,9,opt,name=estimated_blocking_seconds,json=estimatedBlockingSeconds,proto3" json:"estimated_blocking_seconds,omitempty"`
+	// Position of the SQL statement.
+	StartPosition *Position `protobuf:"bytes,10,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) Reset() {
+	*x = PlanCheckRunResult_Result_LockImpactReport{}
+	mi := &file_store_plan_check_run_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_LockImpactReport) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_LockImpactReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_LockImpactReport) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 2}
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetLockLevel() PlanCheckRunResult_Result_LockImpactReport_LockLevel {
+	if x != nil {
+		return x.LockLevel
+	}
+	return PlanCheckRunResult_Result_LockImpactReport_LOCK_LEVEL_UNSPECIFIED
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetAlgorithm() PlanCheckRunResult_Result_LockImpactReport_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetTableRewrite() bool {
+	if x != nil {
+		return x.TableRewrite
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetTableScan() bool {
+	if x != nil {
+		return x.TableScan
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetTableSize() int64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetEstimatedBlockingSeconds() int64 {
+	if x != nil {
+		return x.EstimatedBlockingSeconds
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_LockImpactReport) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+type PlanCheckRunResult_Result_ExplainReport struct {
+	state     protoimpl.MessageState                            `protogen:"open.v1"`
+	Statement string                                            `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Plan      *PlanCheckRunResult_Result_ExplainReport_PlanNode `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The estimated total cost of the statement.
+	TotalCost float64 `protobuf:"fixed64,3,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	// The large tables that are fully scanned.
+	FullScanTables []string `protobuf:"bytes,4,rep,name=full_scan_tables,json=fullScanTables,proto3" json:"full_scan_tables,omitempty"`
+	// The tables that are fully scanned to evaluate a filter without using an index.
+	MissingIndexTables []string `protobuf:"bytes,5,rep,name=missing_index_tables,json=missingIndexTables,proto3" json:"missing_index_tables,omitempty"`
+	// The target whose plan of the previous environment is compared.
+	// Format: instances/{instance}/databases/{database}
+	PreviousTarget    string  `protobuf:"bytes,6,opt,name=previous_target,json=previousTarget,proto3" json:"previous_target,omitempty"`
+	PreviousTotalCost float64 `protobuf:"fixed64,7,opt,name=previous_total_cost,json=previousTotalCost,proto3" json:"previous_total_cost,omitempty"`
+	// The tables that are accessed by an index in the previous environment but fully scanned now.
+	RegressedTables []string `protobuf:"bytes,8,rep,name=regressed_tables,json=regressedTables,proto3" json:"regressed_tables,omitempty"`
+	// Position of the SQL statement.
+	StartPosition *Position `protobuf:"bytes,9,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) Reset() {
+	*x = PlanCheckRunResult_Result_ExplainReport{}
+	mi := &file_store_plan_check_run_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_ExplainReport) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_ExplainReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_ExplainReport) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 3}
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetPlan() *PlanCheckRunResult_Result_ExplainReport_PlanNode {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetFullScanTables() []string {
+	if x != nil {
+		return x.FullScanTables
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetMissingIndexTables() []string {
+	if x != nil {
+		return x.MissingIndexTables
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetPreviousTarget() string {
+	if x != nil {
+		return x.PreviousTarget
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetPreviousTotalCost() float64 {
+	if x != nil {
+		return x.PreviousTotalCost
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetRegressedTables() []string {
+	if x != nil {
+		return x.RegressedTables
+	}
+	return nil
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+// PlanNode is a node of the query plan tree.
+type PlanCheckRunResult_Result_ExplainReport_PlanNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation of the node, e.g. Seq Scan for PostgreSQL or the access type ALL for MySQL.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Table     string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// The index used to access the table.
+	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// The estimated cost reported by the optimizer.
+	Cost float64 `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	// The estimated rows examined by the node.
+	Rows int64 `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Whether the table is fully scanned.
+	FullScan bool `protobuf:"varint,6,opt,name=full_scan,json=fullScan,proto3" json:"full_scan,omitempty"`
+	// Whether the rows are filtered by a condition.
+	HasFilter     bool                                                `protobuf:"varint,7,opt,name=has_filter,json=hasFilter,proto3" json:"has_filter,omitempty"`
+	Children      []*PlanCheckRunResult_Result_ExplainReport_PlanNode `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) Reset() {
+	*x = PlanCheckRunResult_Result_ExplainReport_PlanNode{}
+	mi := &file_store_plan_check_run_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRunResult_Result_ExplainReport_PlanNode) ProtoMessage() {}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_check_run_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRunResult_Result_ExplainReport_PlanNode.ProtoReflect.Descriptor instead.
+func (*PlanCheckRunResult_Result_ExplainReport_PlanNode) Descriptor() ([]byte, []int) {
+	return file_store_plan_check_run_proto_rawDescGZIP(), []int{1, 0, 3, 0}
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetFullScan() bool {
+	if x != nil {
+		return x.FullScan
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetHasFilter() bool {
+	if x != nil {
+		return x.HasFilter
+	}
+	return false
+}
+
+func (x *PlanCheckRunResult_Result_ExplainReport_PlanNode) GetChildren() []*PlanCheckRunResult_Result_ExplainReport_PlanNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 var File_store_plan_check_run_proto protoreflect.FileDescriptor
 
 const file_store_plan_check_run_proto_rawDesc = "" +
 	"\n" +
-	"\x1astore/plan_check_run.proto\x12\x0ebytebase.store\x1a\x12store/advice.proto\x1a\x15store/changelog.proto\x1a\x12store/common.proto\"\x83\x03\n" +
+	"\x1astore/plan_check_run.proto\x12\x0ebytebase.store\x1a\x12store/advice.proto\x1a\x15store/changelog.proto\x1a\x12store/common.proto\"\xa7\x05\n" +
 	"\x12PlanCheckRunConfig\x12\x1b\n" +
 	"\tsheet_uid\x18\x01 \x01(\x05R\bsheetUid\x12\x1f\n" +
 	"\vinstance_id\x18\x03 \x01(\tR\n" +
@@ -411,27 +938,86 @@ const file_store_plan_check_run_proto_rawDesc = "" +
 	"\x13enable_prior_backup\x18\a \x01(\bR\x11enablePriorBackup\x12!\n" +
 	"\fenable_ghost\x18\b \x01(\bR\venableGhost\x12\x1d\n" +
 	"\n" +
-	"enable_sdl\x18\t \x01(\bR\tenableSdl\x1a=\n" +
+	"enable_sdl\x18\t \x01(\bR\tenableSdl\x12|\n" +
+	"\x1aonline_schema_change_flags\x18\n" +
+	" \x03(\v2?.bytebase.store.PlanCheckRunConfig.OnlineSchemaChangeFlagsEntryR\x17onlineSchemaChangeFlags\x12=\n" +
+	"\x1benable_online_schema_change\x18\v \x01(\bR\x18enableOnlineSchemaChange\x12\x19\n" +
+	"\bplan_uid\x18\f \x01(\x03R\aplanUid\x1a=\n" +
 	"\x0fGhostFlagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x02\x10\x03\"\xb6\x06\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aJ\n" +
+	"\x1cOnlineSchemaChangeFlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x02\x10\x03\"\xf6\x13\n" +
 	"\x12PlanCheckRunResult\x12C\n" +
 	"\aresults\x18\x01 \x03(\v2).bytebase.store.PlanCheckRunResult.ResultR\aresults\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\x1a\xc4\x05\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x1a\x84\x13\n" +
 	"\x06Result\x125\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1d.bytebase.store.Advice.StatusR\x06status\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
 	"\x04code\x18\x04 \x01(\x05R\x04code\x12j\n" +
 	"\x12sql_summary_report\x18\x05 \x01(\v2:.bytebase.store.PlanCheckRunResult.Result.SqlSummaryReportH\x00R\x10sqlSummaryReport\x12g\n" +
-	"\x11sql_review_report\x18\x06 \x01(\v29.bytebase.store.PlanCheckRunResult.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x1a\xb5\x01\n" +
+	"\x11sql_review_report\x18\x06 \x01(\v29.bytebase.store.PlanCheckRunResult.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x12j\n" +
+	"\x12lock_impact_report\x18\a \x01(\v2:.bytebase.store.PlanCheckRunResult.Result.LockImpactReportH\x00R\x10lockImpactReport\x12`\n" +
+	"\x0eexplain_report\x18\b \x01(\v27.bytebase.store.PlanCheckRunResult.Result.ExplainReportH\x00R\rexplainReport\x1a\xdc\x01\n" +
 	"\x10SqlSummaryReport\x12'\n" +
 	"\x0fstatement_types\x18\x02 \x03(\tR\x0estatementTypes\x12#\n" +
 	"\raffected_rows\x18\x03 \x01(\x03R\faffectedRows\x12M\n" +
-	"\x11changed_resources\x18\x04 \x01(\v2 .bytebase.store.ChangedResourcesR\x10changedResourcesJ\x04\b\x01\x10\x02\x1a\xa7\x01\n" +
+	"\x11changed_resources\x18\x04 \x01(\v2 .bytebase.store.ChangedResourcesR\x10changedResources\x12%\n" +
+	"\x0eestimated_cost\x18\x05 \x01(\x01R\restimatedCostJ\x04\b\x01\x10\x02\x1a\xa7\x01\n" +
 	"\x0fSqlReviewReport\x12?\n" +
 	"\x0estart_position\x18\b \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
-	"\fend_position\x18\t \x01(\v2\x18.bytebase.store.PositionR\vendPositionJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05B\b\n" +
+	"\fend_position\x18\t \x01(\v2\x18.bytebase.store.PositionR\vendPositionJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\x1a\xe9\x05\n" +
+	"\x10LockImpactReport\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12c\n" +
+	"\n" +
+	"lock_level\x18\x03 \x01(\x0e2D.bytebase.store.PlanCheckRunResult.Result.LockImpactReport.LockLevelR\tlockLevel\x12b\n" +
+	"\talgorithm\x18\x04 \x01(\x0e2D.bytebase.store.PlanCheckRunResult.Result.LockImpactReport.AlgorithmR\talgorithm\x12#\n" +
+	"\rtable_rewrite\x18\x05 \x01(\bR\ftableRewrite\x12\x1d\n" +
+	"\n" +
+	"table_scan\x18\x06 \x01(\bR\ttableScan\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\a \x01(\x03R\ttableRows\x12\x1d\n" +
+	"\n" +
+	"table_size\x18\b \x01(\x03R\ttableSize\x12<\n" +
+	"\x1aestimated_blocking_seconds\x18\t \x01(\x03R\x18estimatedBlockingSeconds\x12?\n" +
+	"\x0estart_position\x18\n" +
+	" \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\"\x90\x01\n" +
+	"\tLockLevel\x12\x1a\n" +
+	"\x16LOCK_LEVEL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SHARE_UPDATE_EXCLUSIVE\x10\x01\x12\t\n" +
+	"\x05SHARE\x10\x02\x12\x17\n" +
+	"\x13SHARE_ROW_EXCLUSIVE\x10\x03\x12\x14\n" +
+	"\x10ACCESS_EXCLUSIVE\x10\x04\x12\x11\n" +
+	"\rMETADATA_LOCK\x10\x05\"J\n" +
+	"\tAlgorithm\x12\x19\n" +
+	"\x15ALGORITHM_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aINSTANT\x10\x01\x12\v\n" +
+	"\aINPLACE\x10\x02\x12\b\n" +
+	"\x04COPY\x10\x03\x1a\xdc\x05\n" +
+	"\rExplainReport\x12\x1c\n" +
+	"\tstatement\x18\x01 \x01(\tR\tstatement\x12T\n" +
+	"\x04plan\x18\x02 \x01(\v2@.bytebase.store.PlanCheckRunResult.Result.ExplainReport.PlanNodeR\x04plan\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x03 \x01(\x01R\ttotalCost\x12(\n" +
+	"\x10full_scan_tables\x18\x04 \x03(\tR\x0efullScanTables\x120\n" +
+	"\x14missing_index_tables\x18\x05 \x03(\tR\x12missingIndexTables\x12'\n" +
+	"\x0fprevious_target\x18\x06 \x01(\tR\x0epreviousTarget\x12.\n" +
+	"\x13previous_total_cost\x18\a \x01(\x01R\x11previousTotalCost\x12)\n" +
+	"\x10regressed_tables\x18\b \x03(\tR\x0fregressedTables\x12?\n" +
+	"\x0estart_position\x18\t \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x1a\x96\x02\n" +
+	"\bPlanNode\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\tR\x05index\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x12\n" +
+	"\x04rows\x18\x05 \x01(\x03R\x04rows\x12\x1b\n" +
+	"\tfull_scan\x18\x06 \x01(\bR\bfullScan\x12\x1d\n" +
+	"\n" +
+	"has_filter\x18\a \x01(\bR\thasFilter\x12\\\n" +
+	"\bchildren\x18\b \x03(\v2@.bytebase.store.PlanCheckRunResult.Result.ExplainReport.PlanNodeR\bchildrenB\b\n" +
 	"\x06reportB\x94\x01\n" +
 	"\x12com.bytebase.storeB\x11PlanCheckRunProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
@@ -447,32 +1033,48 @@ func file_store_plan_check_run_proto_rawDescGZIP() []byte {
 	return file_store_plan_check_run_proto_rawDescData
 }
 
-var file_store_plan_check_run_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_store_plan_check_run_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_plan_check_run_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_plan_check_run_proto_goTypes = []any{
-	(*PlanCheckRunConfig)(nil),        // 0: bytebase.store.PlanCheckRunConfig
-	(*PlanCheckRunResult)(nil),        // 1: bytebase.store.PlanCheckRunResult
-	nil,                               // 2: bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
-	(*PlanCheckRunResult_Result)(nil), // 3: bytebase.store.PlanCheckRunResult.Result
-	(*PlanCheckRunResult_Result_SqlSummaryReport)(nil), // 4: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
-	(*PlanCheckRunResult_Result_SqlReviewReport)(nil),  // 5: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
-	(Advice_Status)(0),       // 6: bytebase.store.Advice.Status
-	(*ChangedResources)(nil), // 7: bytebase.store.ChangedResources
-	(*Position)(nil),         // 8: bytebase.store.Position
+	(PlanCheckRunResult_Result_LockImpactReport_LockLevel)(0), // 0: bytebase.store.PlanCheckRunResult.Result.LockImpactReport.LockLevel
+	(PlanCheckRunResult_Result_LockImpactReport_Algorithm)(0), // 1: bytebase.store.PlanCheckRunResult.Result.LockImpactReport.Algorithm
+	(*PlanCheckRunConfig)(nil),                                // 2: bytebase.store.PlanCheckRunConfig
+	(*PlanCheckRunResult)(nil),                                // 3: bytebase.store.PlanCheckRunResult
+	nil,                                                       // 4: bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
+	nil,                                                       // 5: bytebase.store.PlanCheckRunConfig.OnlineSchemaChangeFlagsEntry
+	(*PlanCheckRunResult_Result)(nil),                         // 6: bytebase.store.PlanCheckRunResult.Result
+	(*PlanCheckRunResult_Result_SqlSummaryReport)(nil),       // 7: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
+	(*PlanCheckRunResult_Result_SqlReviewReport)(nil),        // 8: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
+	(*PlanCheckRunResult_Result_LockImpactReport)(nil),       // 9: bytebase.store.PlanCheckRunResult.Result.LockImpactReport
+	(*PlanCheckRunResult_Result_ExplainReport)(nil),          // 10: bytebase.store.PlanCheckRunResult.Result.ExplainReport
+	(*PlanCheckRunResult_Result_ExplainReport_PlanNode)(nil), // 11: bytebase.store.PlanCheckRunResult.Result.ExplainReport.PlanNode
+	(Advice_Status)(0),       // 12: bytebase.store.Advice.Status
+	(*ChangedResources)(nil), // 13: bytebase.store.ChangedResources
+	(*Position)(nil),         // 14: bytebase.store.Position
 }
 var file_store_plan_check_run_proto_depIdxs = []int32{
-	2, // 0: bytebase.store.PlanCheckRunConfig.ghost_flags:type_name -> bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
-	3, // 1: bytebase.store.PlanCheckRunResult.results:type_name -> bytebase.store.PlanCheckRunResult.Result
-	6, // 2: bytebase.store.PlanCheckRunResult.Result.status:type_name -> bytebase.store.Advice.Status
-	4, // 3: bytebase.store.PlanCheckRunResult.Result.sql_summary_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
-	5, // 4: bytebase.store.PlanCheckRunResult.Result.sql_review_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
-	7, // 5: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.store.ChangedResources
-	8, // 6: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.start_position:type_name -> bytebase.store.Position
-	8, // 7: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.end_position:type_name -> bytebase.store.Position
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4,  // 0: bytebase.store.PlanCheckRunConfig.ghost_flags:type_name -> bytebase.store.PlanCheckRunConfig.GhostFlagsEntry
+	5,  // 1: bytebase.store.PlanCheckRunConfig.online_schema_change_flags:type_name -> bytebase.store.PlanCheckRunConfig.OnlineSchemaChangeFlagsEntry
+	6,  // 2: bytebase.store.PlanCheckRunResult.results:type_name -> bytebase.store.PlanCheckRunResult.Result
+	12, // 3: bytebase.store.PlanCheckRunResult.Result.status:type_name -> bytebase.store.Advice.Status
+	7,  // 4: bytebase.store.PlanCheckRunResult.Result.sql_summary_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport
+	8,  // 5: bytebase.store.PlanCheckRunResult.Result.sql_review_report:type_name -> bytebase.store.PlanCheckRunResult.Result.SqlReviewReport
+	9,  // 6: bytebase.store.PlanCheckRunResult.Result.lock_impact_report:type_name -> bytebase.store.PlanCheckRunResult.Result.LockImpactReport
+	10, // 7: bytebase.store.PlanCheckRunResult.Result.explain_report:type_name -> bytebase.store.PlanCheckRunResult.Result.ExplainReport
+	13, // 8: bytebase.store.PlanCheckRunResult.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.store.ChangedResources
+	14, // 9: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.start_position:type_name -> bytebase.store.Position
+	14, // 10: bytebase.store.PlanCheckRunResult.Result.SqlReviewReport.end_position:type_name -> bytebase.store.Position
+	0,  // 11: bytebase.store.PlanCheckRunResult.Result.LockImpactReport.lock_level:type_name -> bytebase.store.PlanCheckRunResult.Result.LockImpactReport.LockLevel
+	1,  // 12: bytebase.store.PlanCheckRunResult.Result.LockImpactReport.algorithm:type_name -> bytebase.store.PlanCheckRunResult.Result.LockImpactReport.Algorithm
+	14, // 13: bytebase.store.PlanCheckRunResult.Result.LockImpactReport.start_position:type_name -> bytebase.store.Position
+	11, // 14: bytebase.store.PlanCheckRunResult.Result.ExplainReport.plan:type_name -> bytebase.store.PlanCheckRunResult.Result.ExplainReport.PlanNode
+	14, // 15: bytebase.store.PlanCheckRunResult.Result.ExplainReport.start_position:type_name -> bytebase.store.Position
+	11, // 16: bytebase.store.PlanCheckRunResult.Result.ExplainReport.PlanNode.children:type_name -> bytebase.store.PlanCheckRunResult.Result.ExplainReport.PlanNode
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_store_plan_check_run_proto_init() }
@@ -483,22 +1085,25 @@ func file_store_plan_check_run_proto_init() {
 	file_store_advice_proto_init()
 	file_store_changelog_proto_init()
 	file_store_common_proto_init()
-	file_store_plan_check_run_proto_msgTypes[3].OneofWrappers = []any{
+	file_store_plan_check_run_proto_msgTypes[4].OneofWrappers = []any{
 		(*PlanCheckRunResult_Result_SqlSummaryReport_)(nil),
 		(*PlanCheckRunResult_Result_SqlReviewReport_)(nil),
+		(*PlanCheckRunResult_Result_LockImpactReport_)(nil),
+		(*PlanCheckRunResult_Result_ExplainReport_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_plan_check_run_proto_rawDesc), len(file_store_plan_check_run_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_plan_check_run_proto_goTypes,
 		DependencyIndexes: file_store_plan_check_run_proto_depIdxs,
+		EnumInfos:         file_store_plan_check_run_proto_enumTypes,
 		MessageInfos:      file_store_plan_check_run_proto_msgTypes,
 	}.Build()
 	File_store_plan_check_run_proto = out.File
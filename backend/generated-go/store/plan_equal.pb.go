@@ -101,6 +101,21 @@ func (x *PlanConfig_ChangeDatabaseConfig) Equal(y *PlanConfig_ChangeDatabaseConf
 	if x.EnableGhost != y.EnableGhost {
 		return false
 	}
+	if len(x.OnlineSchemaChangeFlags) != len(y.OnlineSchemaChangeFlags) {
+		return false
+	}
+	for k := range x.OnlineSchemaChangeFlags {
+		_, ok := y.OnlineSchemaChangeFlags[k]
+		if !ok {
+			return false
+		}
+		if x.OnlineSchemaChangeFlags[k] != y.OnlineSchemaChangeFlags[k] {
+			return false
+		}
+	}
+	if x.EnableOnlineSchemaChange != y.EnableOnlineSchemaChange {
+		return false
+	}
 	return true
 }
 
@@ -152,6 +167,25 @@ func (x *PlanConfig_Deployment_DatabaseGroupMapping) Equal(y *PlanConfig_Deploym
 	return true
 }
 
+func (x *PlanConfig_Deployment_RolloutStrategy) Equal(y *PlanConfig_Deployment_RolloutStrategy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.CanaryCount != y.CanaryCount {
+		return false
+	}
+	if x.BatchPercentage != y.BatchPercentage {
+		return false
+	}
+	if x.FailureThreshold != y.FailureThreshold {
+		return false
+	}
+	return true
+}
+
 func (x *PlanConfig_Deployment) Equal(y *PlanConfig_Deployment) bool {
 	if x == y {
 		return true
@@ -175,6 +209,9 @@ func (x *PlanConfig_Deployment) Equal(y *PlanConfig_Deployment) bool {
 			return false
 		}
 	}
+	if !x.RolloutStrategy.Equal(y.RolloutStrategy) {
+		return false
+	}
 	return true
 }
 
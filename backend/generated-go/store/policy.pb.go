@@ -83,6 +83,7 @@ const (
 	Policy_IAM               Policy_Type = 8
 	Policy_TAG               Policy_Type = 9
 	Policy_DATA_SOURCE_QUERY Policy_Type = 10
+	Policy_ROW_ACCESS        Policy_Type = 11
 )
 
 // Enum value maps for Policy_Type.
@@ -96,6 +97,7 @@ var (
 		8:  "IAM",
 		9:  "TAG",
 		10: "DATA_SOURCE_QUERY",
+		11: "ROW_ACCESS",
 	}
 	Policy_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":  0,
@@ -106,6 +108,7 @@ var (
 		"IAM":               8,
 		"TAG":               9,
 		"DATA_SOURCE_QUERY": 10,
+		"ROW_ACCESS":        11,
 	}
 )
 
@@ -237,7 +240,7 @@ func (x RolloutPolicy_Checkers_PlanCheckEnforcement) Number() protoreflect.EnumN
 
 // Deprecated: Use RolloutPolicy_Checkers_PlanCheckEnforcement.Descriptor instead.
 func (RolloutPolicy_Checkers_PlanCheckEnforcement) EnumDescriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{1, 0, 0}
+	return file_store_policy_proto_rawDescGZIP(), []int{1, 1, 0}
 }
 
 type MaskingExceptionPolicy_MaskingException_Action int32
@@ -335,7 +338,7 @@ func (x EnvironmentTierPolicy_EnvironmentTier) Number() protoreflect.EnumNumber
 
 // Deprecated: Use EnvironmentTierPolicy_EnvironmentTier.Descriptor instead.
 func (EnvironmentTierPolicy_EnvironmentTier) EnumDescriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{8, 0}
+	return file_store_policy_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type DataSourceQueryPolicy_Restriction int32
@@ -386,7 +389,7 @@ func (x DataSourceQueryPolicy_Restriction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataSourceQueryPolicy_Restriction.Descriptor instead.
 func (DataSourceQueryPolicy_Restriction) EnumDescriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{10, 0}
+	return file_store_policy_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type Policy struct {
@@ -431,7 +434,10 @@ type RolloutPolicy struct {
 	Roles     []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	// Checkers that must pass before rollout execution.
 	// These checks are performed in UI workflows only.
-	Checkers      *RolloutPolicy_Checkers `protobuf:"bytes,4,opt,name=checkers,proto3" json:"checkers,omitempty"`
+	Checkers *RolloutPolicy_Checkers `protobuf:"bytes,4,opt,name=checkers,proto3" json:"checkers,omitempty"`
+	// The window in which tasks of the environment can be rolled out.
+	// Pending tasks wait until the window opens. No restriction if unset.
+	Window        *RolloutPolicy_Window `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -487,6 +493,13 @@ func (x *RolloutPolicy) GetCheckers() *RolloutPolicy_Checkers {
 	return nil
 }
 
+func (x *RolloutPolicy) GetWindow() *RolloutPolicy_Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
 // MaskingExceptionPolicy is the allowlist of users who can access sensitive data.
 type MaskingExceptionPolicy struct {
 	state             protoimpl.MessageState                     `protogen:"open.v1"`
@@ -576,6 +589,52 @@ func (x *MaskingRulePolicy) GetRules() []*MaskingRulePolicy_MaskingRule {
 	return nil
 }
 
+// RowAccessPolicy is the policy of the row-level filters applied to the queries in the SQL Editor.
+type RowAccessPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The predicates of all rules applying to the user on a table are combined with AND.
+	Rules         []*RowAccessPolicy_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowAccessPolicy) Reset() {
+	*x = RowAccessPolicy{}
+	mi := &file_store_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowAccessPolicy) ProtoMessage() {}
+
+func (x *RowAccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowAccessPolicy.ProtoReflect.Descriptor instead.
+func (*RowAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RowAccessPolicy) GetRules() []*RowAccessPolicy_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type SQLReviewRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -589,7 +648,7 @@ type SQLReviewRule struct {
 
 func (x *SQLReviewRule) Reset() {
 	*x = SQLReviewRule{}
-	mi := &file_store_policy_proto_msgTypes[4]
+	mi := &file_store_policy_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +660,7 @@ func (x *SQLReviewRule) String() string {
 func (*SQLReviewRule) ProtoMessage() {}
 
 func (x *SQLReviewRule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[4]
+	mi := &file_store_policy_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +673,7 @@ func (x *SQLReviewRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLReviewRule.ProtoReflect.Descriptor instead.
 func (*SQLReviewRule) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{4}
+	return file_store_policy_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SQLReviewRule) GetType() string {
@@ -663,7 +722,7 @@ type TagPolicy struct {
 
 func (x *TagPolicy) Reset() {
 	*x = TagPolicy{}
-	mi := &file_store_policy_proto_msgTypes[5]
+	mi := &file_store_policy_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +734,7 @@ func (x *TagPolicy) String() string {
 func (*TagPolicy) ProtoMessage() {}
 
 func (x *TagPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[5]
+	mi := &file_store_policy_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +747,7 @@ func (x *TagPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagPolicy.ProtoReflect.Descriptor instead.
 func (*TagPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{5}
+	return file_store_policy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TagPolicy) GetTags() map[string]string {
@@ -717,7 +776,7 @@ type Binding struct {
 
 func (x *Binding) Reset() {
 	*x = Binding{}
-	mi := &file_store_policy_proto_msgTypes[6]
+	mi := &file_store_policy_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -729,7 +788,7 @@ func (x *Binding) String() string {
 func (*Binding) ProtoMessage() {}
 
 func (x *Binding) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[6]
+	mi := &file_store_policy_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +801,7 @@ func (x *Binding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Binding.ProtoReflect.Descriptor instead.
 func (*Binding) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{6}
+	return file_store_policy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Binding) GetRole() string {
@@ -777,7 +836,7 @@ type IamPolicy struct {
 
 func (x *IamPolicy) Reset() {
 	*x = IamPolicy{}
-	mi := &file_store_policy_proto_msgTypes[7]
+	mi := &file_store_policy_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -789,7 +848,7 @@ func (x *IamPolicy) String() string {
 func (*IamPolicy) ProtoMessage() {}
 
 func (x *IamPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[7]
+	mi := &file_store_policy_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +861,7 @@ func (x *IamPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamPolicy.ProtoReflect.Descriptor instead.
 func (*IamPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{7}
+	return file_store_policy_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IamPolicy) GetBindings() []*Binding {
@@ -823,7 +882,7 @@ type EnvironmentTierPolicy struct {
 
 func (x *EnvironmentTierPolicy) Reset() {
 	*x = EnvironmentTierPolicy{}
-	mi := &file_store_policy_proto_msgTypes[8]
+	mi := &file_store_policy_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -835,7 +894,7 @@ func (x *EnvironmentTierPolicy) String() string {
 func (*EnvironmentTierPolicy) ProtoMessage() {}
 
 func (x *EnvironmentTierPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[8]
+	mi := &file_store_policy_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +907,7 @@ func (x *EnvironmentTierPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentTierPolicy.ProtoReflect.Descriptor instead.
 func (*EnvironmentTierPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{8}
+	return file_store_policy_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EnvironmentTierPolicy) GetEnvironmentTier() EnvironmentTierPolicy_EnvironmentTier {
@@ -886,7 +945,7 @@ type QueryDataPolicy struct {
 
 func (x *QueryDataPolicy) Reset() {
 	*x = QueryDataPolicy{}
-	mi := &file_store_policy_proto_msgTypes[9]
+	mi := &file_store_policy_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -898,7 +957,7 @@ func (x *QueryDataPolicy) String() string {
 func (*QueryDataPolicy) ProtoMessage() {}
 
 func (x *QueryDataPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[9]
+	mi := &file_store_policy_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +970,7 @@ func (x *QueryDataPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDataPolicy.ProtoReflect.Descriptor instead.
 func (*QueryDataPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{9}
+	return file_store_policy_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryDataPolicy) GetTimeout() *durationpb.Duration {
@@ -963,7 +1022,7 @@ type DataSourceQueryPolicy struct {
 
 func (x *DataSourceQueryPolicy) Reset() {
 	*x = DataSourceQueryPolicy{}
-	mi := &file_store_policy_proto_msgTypes[10]
+	mi := &file_store_policy_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -975,7 +1034,7 @@ func (x *DataSourceQueryPolicy) String() string {
 func (*DataSourceQueryPolicy) ProtoMessage() {}
 
 func (x *DataSourceQueryPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[10]
+	mi := &file_store_policy_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,7 +1047,7 @@ func (x *DataSourceQueryPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceQueryPolicy.ProtoReflect.Descriptor instead.
 func (*DataSourceQueryPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{10}
+	return file_store_policy_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DataSourceQueryPolicy) GetAdminDataSourceRestriction() DataSourceQueryPolicy_Restriction {
@@ -1012,6 +1071,71 @@ func (x *DataSourceQueryPolicy) GetDisallowDml() bool {
 	return false
 }
 
+type RolloutPolicy_Window struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IANA time zone of the time ranges and blackout dates, e.g. "America/New_York".
+	// UTC is used if empty.
+	TimeZone string `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The recurring time ranges in which rollouts are allowed.
+	// Rollouts are allowed at any time of a day if empty.
+	TimeRanges []*RolloutPolicy_Window_TimeRange `protobuf:"bytes,2,rep,name=time_ranges,json=timeRanges,proto3" json:"time_ranges,omitempty"`
+	// The dates on which rollouts are not allowed, in the format of "YYYY-MM-DD".
+	BlackoutDates []string `protobuf:"bytes,3,rep,name=blackout_dates,json=blackoutDates,proto3" json:"blackout_dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutPolicy_Window) Reset() {
+	*x = RolloutPolicy_Window{}
+	mi := &file_store_policy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutPolicy_Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPolicy_Window) ProtoMessage() {}
+
+func (x *RolloutPolicy_Window) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPolicy_Window.ProtoReflect.Descriptor instead.
+func (*RolloutPolicy_Window) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *RolloutPolicy_Window) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *RolloutPolicy_Window) GetTimeRanges() []*RolloutPolicy_Window_TimeRange {
+	if x != nil {
+		return x.TimeRanges
+	}
+	return nil
+}
+
+func (x *RolloutPolicy_Window) GetBlackoutDates() []string {
+	if x != nil {
+		return x.BlackoutDates
+	}
+	return nil
+}
+
 type RolloutPolicy_Checkers struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Whether issue approval is required before proceeding with rollout.
@@ -1024,7 +1148,7 @@ type RolloutPolicy_Checkers struct {
 
 func (x *RolloutPolicy_Checkers) Reset() {
 	*x = RolloutPolicy_Checkers{}
-	mi := &file_store_policy_proto_msgTypes[11]
+	mi := &file_store_policy_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1036,7 +1160,7 @@ func (x *RolloutPolicy_Checkers) String() string {
 func (*RolloutPolicy_Checkers) ProtoMessage() {}
 
 func (x *RolloutPolicy_Checkers) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[11]
+	mi := &file_store_policy_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1173,7 @@ func (x *RolloutPolicy_Checkers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RolloutPolicy_Checkers.ProtoReflect.Descriptor instead.
 func (*RolloutPolicy_Checkers) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{1, 0}
+	return file_store_policy_proto_rawDescGZIP(), []int{1, 1}
 }
 
 func (x *RolloutPolicy_Checkers) GetRequiredIssueApproval() bool {
@@ -1066,6 +1190,71 @@ func (x *RolloutPolicy_Checkers) GetRequiredStatusChecks() *RolloutPolicy_Checke
 	return nil
 }
 
+type RolloutPolicy_Window_TimeRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The days of the week, from 0 (Sunday) to 6 (Saturday).
+	// Every day if empty.
+	DaysOfWeek []int32 `protobuf:"varint,1,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	// The start time of the range in the format of "HH:MM", inclusive.
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end time of the range in the format of "HH:MM", exclusive.
+	// The range crosses midnight if the end time is not after the start time.
+	EndTime       string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutPolicy_Window_TimeRange) Reset() {
+	*x = RolloutPolicy_Window_TimeRange{}
+	mi := &file_store_policy_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutPolicy_Window_TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPolicy_Window_TimeRange) ProtoMessage() {}
+
+func (x *RolloutPolicy_Window_TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPolicy_Window_TimeRange.ProtoReflect.Descriptor instead.
+func (*RolloutPolicy_Window_TimeRange) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *RolloutPolicy_Window_TimeRange) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *RolloutPolicy_Window_TimeRange) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *RolloutPolicy_Window_TimeRange) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type RolloutPolicy_Checkers_RequiredStatusChecks struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enforcement level for plan check results during rollout validation.
@@ -1076,7 +1265,7 @@ type RolloutPolicy_Checkers_RequiredStatusChecks struct {
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) Reset() {
 	*x = RolloutPolicy_Checkers_RequiredStatusChecks{}
-	mi := &file_store_policy_proto_msgTypes[12]
+	mi := &file_store_policy_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1277,7 @@ func (x *RolloutPolicy_Checkers_RequiredStatusChecks) String() string {
 func (*RolloutPolicy_Checkers_RequiredStatusChecks) ProtoMessage() {}
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[12]
+	mi := &file_store_policy_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1290,7 @@ func (x *RolloutPolicy_Checkers_RequiredStatusChecks) ProtoReflect() protoreflec
 
 // Deprecated: Use RolloutPolicy_Checkers_RequiredStatusChecks.ProtoReflect.Descriptor instead.
 func (*RolloutPolicy_Checkers_RequiredStatusChecks) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{1, 0, 0}
+	return file_store_policy_proto_rawDescGZIP(), []int{1, 1, 0}
 }
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) GetPlanCheckEnforcement() RolloutPolicy_Checkers_PlanCheckEnforcement {
@@ -1127,7 +1316,7 @@ type MaskingExceptionPolicy_MaskingException struct {
 
 func (x *MaskingExceptionPolicy_MaskingException) Reset() {
 	*x = MaskingExceptionPolicy_MaskingException{}
-	mi := &file_store_policy_proto_msgTypes[13]
+	mi := &file_store_policy_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1139,7 +1328,7 @@ func (x *MaskingExceptionPolicy_MaskingException) String() string {
 func (*MaskingExceptionPolicy_MaskingException) ProtoMessage() {}
 
 func (x *MaskingExceptionPolicy_MaskingException) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[13]
+	mi := &file_store_policy_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1377,7 @@ type MaskingRulePolicy_MaskingRule struct {
 
 func (x *MaskingRulePolicy_MaskingRule) Reset() {
 	*x = MaskingRulePolicy_MaskingRule{}
-	mi := &file_store_policy_proto_msgTypes[14]
+	mi := &file_store_policy_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1200,7 +1389,7 @@ func (x *MaskingRulePolicy_MaskingRule) String() string {
 func (*MaskingRulePolicy_MaskingRule) ProtoMessage() {}
 
 func (x *MaskingRulePolicy_MaskingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[14]
+	mi := &file_store_policy_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,12 +1426,94 @@ func (x *MaskingRulePolicy_MaskingRule) GetSemanticType() string {
 	return ""
 }
 
+type RowAccessPolicy_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database of the table.
+	// Format: instances/{instance}/databases/{database}
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// The schema of the table. Empty for the engines without schemas, e.g. MySQL.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The condition deciding whether the rule applies to the user.
+	// The rule applies to all users if empty.
+	Condition *expr.Expr `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The SQL boolean expression on the columns of the table that the rows must satisfy.
+	Predicate     string `protobuf:"bytes,5,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowAccessPolicy_Rule) Reset() {
+	*x = RowAccessPolicy_Rule{}
+	mi := &file_store_policy_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowAccessPolicy_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowAccessPolicy_Rule) ProtoMessage() {}
+
+func (x *RowAccessPolicy_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowAccessPolicy_Rule.ProtoReflect.Descriptor instead.
+func (*RowAccessPolicy_Rule) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RowAccessPolicy_Rule) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *RowAccessPolicy_Rule) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *RowAccessPolicy_Rule) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *RowAccessPolicy_Rule) GetCondition() *expr.Expr {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *RowAccessPolicy_Rule) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
 var File_store_policy_proto protoreflect.FileDescriptor
 
 const file_store_policy_proto_rawDesc = "" +
 	"\n" +
-	"\x12store/policy.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x12store/common.proto\"\xe9\x01\n" +
-	"\x06Policy\"\x8b\x01\n" +
+	"\x12store/policy.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x12store/common.proto\"\xf9\x01\n" +
+	"\x06Policy\"\x9b\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aROLLOUT\x10\x01\x12\x15\n" +
@@ -1253,16 +1524,30 @@ const file_store_policy_proto_rawDesc = "" +
 	"\x03IAM\x10\b\x12\a\n" +
 	"\x03TAG\x10\t\x12\x15\n" +
 	"\x11DATA_SOURCE_QUERY\x10\n" +
-	"\"Q\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"ROW_ACCESS\x10\v\"Q\n" +
 	"\bResource\x12\x18\n" +
 	"\x14RESOURCE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\x0f\n" +
 	"\vENVIRONMENT\x10\x02\x12\v\n" +
-	"\aPROJECT\x10\x03\"\xa7\x04\n" +
+	"\aPROJECT\x10\x03\"\xee\x06\n" +
 	"\rRolloutPolicy\x12\x1c\n" +
 	"\tautomatic\x18\x01 \x01(\bR\tautomatic\x12\x14\n" +
 	"\x05roles\x18\x02 \x03(\tR\x05roles\x12B\n" +
-	"\bcheckers\x18\x04 \x01(\v2&.bytebase.store.RolloutPolicy.CheckersR\bcheckers\x1a\x9d\x03\n" +
+	"\bcheckers\x18\x04 \x01(\v2&.bytebase.store.RolloutPolicy.CheckersR\bcheckers\x12<\n" +
+	"\x06window\x18\x05 \x01(\v2$.bytebase.store.RolloutPolicy.WindowR\x06window\x1a\x86\x02\n" +
+	"\x06Window\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12O\n" +
+	"\vtime_ranges\x18\x02 \x03(\v2..bytebase.store.RolloutPolicy.Window.TimeRangeR\n" +
+	"timeRanges\x12%\n" +
+	"\x0eblackout_dates\x18\x03 \x03(\tR\rblackoutDates\x1ag\n" +
+	"\tTimeRange\x12 \n" +
+	"\fdays_of_week\x18\x01 \x03(\x05R\n" +
+	"daysOfWeek\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x1a\x9d\x03\n" +
 	"\bCheckers\x126\n" +
 	"\x17required_issue_approval\x18\x01 \x01(\bR\x15requiredIssueApproval\x12q\n" +
 	"\x16required_status_checks\x18\x02 \x01(\v2;.bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecksR\x14requiredStatusChecks\x1a\x89\x01\n" +
@@ -1290,7 +1575,15 @@ const file_store_policy_proto_rawDesc = "" +
 	"\vMaskingRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
 	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\x12#\n" +
-	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"\xc1\x01\n" +
+	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"\xef\x01\n" +
+	"\x0fRowAccessPolicy\x12:\n" +
+	"\x05rules\x18\x01 \x03(\v2$.bytebase.store.RowAccessPolicy.RuleR\x05rules\x1a\x9f\x01\n" +
+	"\x04Rule\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x12/\n" +
+	"\tcondition\x18\x04 \x01(\v2\x11.google.type.ExprR\tcondition\x12\x1c\n" +
+	"\tpredicate\x18\x05 \x01(\tR\tpredicate\"\xc1\x01\n" +
 	"\rSQLReviewRule\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x128\n" +
 	"\x05level\x18\x02 \x01(\x0e2\".bytebase.store.SQLReviewRuleLevelR\x05level\x12\x18\n" +
@@ -1348,7 +1641,7 @@ func file_store_policy_proto_rawDescGZIP() []byte {
 }
 
 var file_store_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_store_policy_proto_goTypes = []any{
 	(SQLReviewRuleLevel)(0),                             // 0: bytebase.store.SQLReviewRuleLevel
 	(Policy_Type)(0),                                    // 1: bytebase.store.Policy.Type
@@ -1361,44 +1654,52 @@ var file_store_policy_proto_goTypes = []any{
 	(*RolloutPolicy)(nil),                               // 8: bytebase.store.RolloutPolicy
 	(*MaskingExceptionPolicy)(nil),                      // 9: bytebase.store.MaskingExceptionPolicy
 	(*MaskingRulePolicy)(nil),                           // 10: bytebase.store.MaskingRulePolicy
-	(*SQLReviewRule)(nil),                               // 11: bytebase.store.SQLReviewRule
-	(*TagPolicy)(nil),                                   // 12: bytebase.store.TagPolicy
-	(*Binding)(nil),                                     // 13: bytebase.store.Binding
-	(*IamPolicy)(nil),                                   // 14: bytebase.store.IamPolicy
-	(*EnvironmentTierPolicy)(nil),                       // 15: bytebase.store.EnvironmentTierPolicy
-	(*QueryDataPolicy)(nil),                             // 16: bytebase.store.QueryDataPolicy
-	(*DataSourceQueryPolicy)(nil),                       // 17: bytebase.store.DataSourceQueryPolicy
-	(*RolloutPolicy_Checkers)(nil),                      // 18: bytebase.store.RolloutPolicy.Checkers
-	(*RolloutPolicy_Checkers_RequiredStatusChecks)(nil), // 19: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
-	(*MaskingExceptionPolicy_MaskingException)(nil),     // 20: bytebase.store.MaskingExceptionPolicy.MaskingException
-	(*MaskingRulePolicy_MaskingRule)(nil),               // 21: bytebase.store.MaskingRulePolicy.MaskingRule
-	nil,                                                 // 22: bytebase.store.TagPolicy.TagsEntry
-	(Engine)(0),                                         // 23: bytebase.store.Engine
-	(*expr.Expr)(nil),                                   // 24: google.type.Expr
-	(*durationpb.Duration)(nil),                         // 25: google.protobuf.Duration
+	(*RowAccessPolicy)(nil),                             // 11: bytebase.store.RowAccessPolicy
+	(*SQLReviewRule)(nil),                               // 12: bytebase.store.SQLReviewRule
+	(*TagPolicy)(nil),                                   // 13: bytebase.store.TagPolicy
+	(*Binding)(nil),                                     // 14: bytebase.store.Binding
+	(*IamPolicy)(nil),                                   // 15: bytebase.store.IamPolicy
+	(*EnvironmentTierPolicy)(nil),                       // 16: bytebase.store.EnvironmentTierPolicy
+	(*QueryDataPolicy)(nil),                             // 17: bytebase.store.QueryDataPolicy
+	(*DataSourceQueryPolicy)(nil),                       // 18: bytebase.store.DataSourceQueryPolicy
+	(*RolloutPolicy_Window)(nil),                        // 19: bytebase.store.RolloutPolicy.Window
+	(*RolloutPolicy_Checkers)(nil),                      // 20: bytebase.store.RolloutPolicy.Checkers
+	(*RolloutPolicy_Window_TimeRange)(nil),              // 21: bytebase.store.RolloutPolicy.Window.TimeRange
+	(*RolloutPolicy_Checkers_RequiredStatusChecks)(nil), // 22: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
+	(*MaskingExceptionPolicy_MaskingException)(nil),     // 23: bytebase.store.MaskingExceptionPolicy.MaskingException
+	(*MaskingRulePolicy_MaskingRule)(nil),               // 24: bytebase.store.MaskingRulePolicy.MaskingRule
+	(*RowAccessPolicy_Rule)(nil),                        // 25: bytebase.store.RowAccessPolicy.Rule
+	nil,                                                 // 26: bytebase.store.TagPolicy.TagsEntry
+	(Engine)(0),                                         // 27: bytebase.store.Engine
+	(*expr.Expr)(nil),                                   // 28: google.type.Expr
+	(*durationpb.Duration)(nil),                         // 29: google.protobuf.Duration
 }
 var file_store_policy_proto_depIdxs = []int32{
-	18, // 0: bytebase.store.RolloutPolicy.checkers:type_name -> bytebase.store.RolloutPolicy.Checkers
-	20, // 1: bytebase.store.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException
-	21, // 2: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
-	0,  // 3: bytebase.store.SQLReviewRule.level:type_name -> bytebase.store.SQLReviewRuleLevel
-	23, // 4: bytebase.store.SQLReviewRule.engine:type_name -> bytebase.store.Engine
-	22, // 5: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
-	24, // 6: bytebase.store.Binding.condition:type_name -> google.type.Expr
-	13, // 7: bytebase.store.IamPolicy.bindings:type_name -> bytebase.store.Binding
-	5,  // 8: bytebase.store.EnvironmentTierPolicy.environment_tier:type_name -> bytebase.store.EnvironmentTierPolicy.EnvironmentTier
-	25, // 9: bytebase.store.QueryDataPolicy.timeout:type_name -> google.protobuf.Duration
-	6,  // 10: bytebase.store.DataSourceQueryPolicy.admin_data_source_restriction:type_name -> bytebase.store.DataSourceQueryPolicy.Restriction
-	19, // 11: bytebase.store.RolloutPolicy.Checkers.required_status_checks:type_name -> bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
-	3,  // 12: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks.plan_check_enforcement:type_name -> bytebase.store.RolloutPolicy.Checkers.PlanCheckEnforcement
-	4,  // 13: bytebase.store.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException.Action
-	24, // 14: bytebase.store.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
-	24, // 15: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	20, // 0: bytebase.store.RolloutPolicy.checkers:type_name -> bytebase.store.RolloutPolicy.Checkers
+	19, // 1: bytebase.store.RolloutPolicy.window:type_name -> bytebase.store.RolloutPolicy.Window
+	23, // 2: bytebase.store.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException
+	24, // 3: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
+	25, // 4: bytebase.store.RowAccessPolicy.rules:type_name -> bytebase.store.RowAccessPolicy.Rule
+	0,  // 5: bytebase.store.SQLReviewRule.level:type_name -> bytebase.store.SQLReviewRuleLevel
+	27, // 6: bytebase.store.SQLReviewRule.engine:type_name -> bytebase.store.Engine
+	26, // 7: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
+	28, // 8: bytebase.store.Binding.condition:type_name -> google.type.Expr
+	14, // 9: bytebase.store.IamPolicy.bindings:type_name -> bytebase.store.Binding
+	5,  // 10: bytebase.store.EnvironmentTierPolicy.environment_tier:type_name -> bytebase.store.EnvironmentTierPolicy.EnvironmentTier
+	29, // 11: bytebase.store.QueryDataPolicy.timeout:type_name -> google.protobuf.Duration
+	6,  // 12: bytebase.store.DataSourceQueryPolicy.admin_data_source_restriction:type_name -> bytebase.store.DataSourceQueryPolicy.Restriction
+	21, // 13: bytebase.store.RolloutPolicy.Window.time_ranges:type_name -> bytebase.store.RolloutPolicy.Window.TimeRange
+	22, // 14: bytebase.store.RolloutPolicy.Checkers.required_status_checks:type_name -> bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
+	3,  // 15: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks.plan_check_enforcement:type_name -> bytebase.store.RolloutPolicy.Checkers.PlanCheckEnforcement
+	4,  // 16: bytebase.store.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException.Action
+	28, // 17: bytebase.store.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
+	28, // 18: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
+	28, // 19: bytebase.store.RowAccessPolicy.Rule.condition:type_name -> google.type.Expr
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_store_policy_proto_init() }
@@ -1413,7 +1714,7 @@ func file_store_policy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_policy_proto_rawDesc), len(file_store_policy_proto_rawDesc)),
 			NumEnums:      7,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -33,6 +33,12 @@ const (
 	Activity_NOTIFY_ISSUE_APPROVED Activity_Type = 23
 	// NOTIFY_PIPELINE_ROLLOUT represents the pipeline rollout notification.
 	Activity_NOTIFY_PIPELINE_ROLLOUT Activity_Type = 24
+	// NOTIFY_ROLLOUT_WINDOW_OPEN represents the rollout window opening for the waiting tasks.
+	Activity_NOTIFY_ROLLOUT_WINDOW_OPEN Activity_Type = 25
+	// NOTIFY_BREAK_GLASS represents the break-glass access granted notification.
+	Activity_NOTIFY_BREAK_GLASS Activity_Type = 26
+	// NOTIFY_SCHEMA_DRIFT represents the schema drift detected on a database.
+	Activity_NOTIFY_SCHEMA_DRIFT Activity_Type = 27
 	// Issue related activity types.
 	//
 	// ISSUE_CREATE represents creating an issue.
@@ -57,6 +63,9 @@ var (
 		0:  "TYPE_UNSPECIFIED",
 		23: "NOTIFY_ISSUE_APPROVED",
 		24: "NOTIFY_PIPELINE_ROLLOUT",
+		25: "NOTIFY_ROLLOUT_WINDOW_OPEN",
+		26: "NOTIFY_BREAK_GLASS",
+		27: "NOTIFY_SCHEMA_DRIFT",
 		1:  "ISSUE_CREATE",
 		2:  "ISSUE_COMMENT_CREATE",
 		3:  "ISSUE_FIELD_UPDATE",
@@ -69,6 +78,9 @@ var (
 		"TYPE_UNSPECIFIED":                      0,
 		"NOTIFY_ISSUE_APPROVED":                 23,
 		"NOTIFY_PIPELINE_ROLLOUT":               24,
+		"NOTIFY_ROLLOUT_WINDOW_OPEN":            25,
+		"NOTIFY_BREAK_GLASS":                    26,
+		"NOTIFY_SCHEMA_DRIFT":                   27,
 		"ISSUE_CREATE":                          1,
 		"ISSUE_COMMENT_CREATE":                  2,
 		"ISSUE_FIELD_UPDATE":                    3,
@@ -125,6 +137,8 @@ const (
 	ProjectWebhook_WECOM ProjectWebhook_Type = 6
 	// Lark integration.
 	ProjectWebhook_LARK ProjectWebhook_Type = 8
+	// Generic HTTP receiver with a versioned JSON payload.
+	ProjectWebhook_GENERIC ProjectWebhook_Type = 9
 )
 
 // Enum value maps for ProjectWebhook_Type.
@@ -138,6 +152,7 @@ var (
 		5: "FEISHU",
 		6: "WECOM",
 		8: "LARK",
+		9: "GENERIC",
 	}
 	ProjectWebhook_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
@@ -148,6 +163,7 @@ var (
 		"FEISHU":           5,
 		"WECOM":            6,
 		"LARK":             8,
+		"GENERIC":          9,
 	}
 )
 
@@ -229,6 +245,8 @@ type ProjectWebhook struct {
 	// to the persons and url will be ignored.
 	// IM integration setting should be set for this function to work.
 	DirectMessage bool `protobuf:"varint,5,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
+	// The secret to sign the GENERIC webhook requests with HMAC-SHA256.
+	SigningSecret string `protobuf:"bytes,6,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,23 +316,106 @@ func (x *ProjectWebhook) GetDirectMessage() bool {
 	return false
 }
 
+func (x *ProjectWebhook) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+// WebhookDeliveryPayload is the payload of a GENERIC webhook delivery.
+type WebhookDeliveryPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The activity type of the event.
+	EventType Activity_Type `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=bytebase.store.Activity_Type" json:"event_type,omitempty"`
+	// The JSON request body, which is the same for all attempts.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// The HTTP status code of the last attempt, 0 if no response was received.
+	LastStatusCode int32 `protobuf:"varint,3,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	// The error of the last attempt.
+	LastError     string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryPayload) Reset() {
+	*x = WebhookDeliveryPayload{}
+	mi := &file_store_project_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryPayload) ProtoMessage() {}
+
+func (x *WebhookDeliveryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryPayload.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryPayload) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookDeliveryPayload) GetEventType() Activity_Type {
+	if x != nil {
+		return x.EventType
+	}
+	return Activity_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDeliveryPayload) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryPayload) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryPayload) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
 var File_store_project_webhook_proto protoreflect.FileDescriptor
 
 const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
-	"\x1bstore/project_webhook.proto\x12\x0ebytebase.store\"\xac\x02\n" +
-	"\bActivity\"\x9f\x02\n" +
+	"\x1bstore/project_webhook.proto\x12\x0ebytebase.store\"\xfd\x02\n" +
+	"\bActivity\"\xf0\x02\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15NOTIFY_ISSUE_APPROVED\x10\x17\x12\x1b\n" +
-	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x10\n" +
+	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x1e\n" +
+	"\x1aNOTIFY_ROLLOUT_WINDOW_OPEN\x10\x19\x12\x16\n" +
+	"\x12NOTIFY_BREAK_GLASS\x10\x1a\x12\x17\n" +
+	"\x13NOTIFY_SCHEMA_DRIFT\x10\x1b\x12\x10\n" +
 	"\fISSUE_CREATE\x10\x01\x12\x18\n" +
 	"\x14ISSUE_COMMENT_CREATE\x10\x02\x12\x16\n" +
 	"\x12ISSUE_FIELD_UPDATE\x10\x03\x12\x17\n" +
 	"\x13ISSUE_STATUS_UPDATE\x10\x04\x12\x19\n" +
 	"\x15ISSUE_APPROVAL_NOTIFY\x10\x15\x12&\n" +
 	"\"ISSUE_PIPELINE_STAGE_STATUS_UPDATE\x10\x05\x12)\n" +
-	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x16\"\xc7\x02\n" +
+	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x16\"\xfb\x02\n" +
 	"\x0eProjectWebhook\x127\n" +
 	"\x04type\x18\x01 \x01(\x0e2#.bytebase.store.ProjectWebhook.TypeR\x04type\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
@@ -322,7 +423,8 @@ const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
 	"activities\x18\x04 \x03(\x0e2\x1d.bytebase.store.Activity.TypeR\n" +
 	"activities\x12%\n" +
-	"\x0edirect_message\x18\x05 \x01(\bR\rdirectMessage\"n\n" +
+	"\x0edirect_message\x18\x05 \x01(\bR\rdirectMessage\x12%\n" +
+	"\x0esigning_secret\x18\x06 \x01(\tR\rsigningSecret\"{\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05SLACK\x10\x01\x12\v\n" +
@@ -332,7 +434,15 @@ const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
 	"\x06FEISHU\x10\x05\x12\t\n" +
 	"\x05WECOM\x10\x06\x12\b\n" +
-	"\x04LARK\x10\bB\x96\x01\n" +
+	"\x04LARK\x10\b\x12\v\n" +
+	"\aGENERIC\x10\t\"\xb3\x01\n" +
+	"\x16WebhookDeliveryPayload\x12<\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2\x1d.bytebase.store.Activity.TypeR\teventType\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12(\n" +
+	"\x10last_status_code\x18\x03 \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tR\tlastErrorB\x96\x01\n" +
 	"\x12com.bytebase.storeB\x13ProjectWebhookProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -348,21 +458,23 @@ func file_store_project_webhook_proto_rawDescGZIP() []byte {
 }
 
 var file_store_project_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_project_webhook_proto_goTypes = []any{
-	(Activity_Type)(0),       // 0: bytebase.store.Activity.Type
-	(ProjectWebhook_Type)(0), // 1: bytebase.store.ProjectWebhook.Type
-	(*Activity)(nil),         // 2: bytebase.store.Activity
-	(*ProjectWebhook)(nil),   // 3: bytebase.store.ProjectWebhook
+	(Activity_Type)(0),             // 0: bytebase.store.Activity.Type
+	(ProjectWebhook_Type)(0),       // 1: bytebase.store.ProjectWebhook.Type
+	(*Activity)(nil),               // 2: bytebase.store.Activity
+	(*ProjectWebhook)(nil),         // 3: bytebase.store.ProjectWebhook
+	(*WebhookDeliveryPayload)(nil), // 4: bytebase.store.WebhookDeliveryPayload
 }
 var file_store_project_webhook_proto_depIdxs = []int32{
 	1, // 0: bytebase.store.ProjectWebhook.type:type_name -> bytebase.store.ProjectWebhook.Type
 	0, // 1: bytebase.store.ProjectWebhook.activities:type_name -> bytebase.store.Activity.Type
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 2: bytebase.store.WebhookDeliveryPayload.event_type:type_name -> bytebase.store.Activity.Type
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_project_webhook_proto_init() }
@@ -376,7 +488,7 @@ func file_store_project_webhook_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_project_webhook_proto_rawDesc), len(file_store_project_webhook_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
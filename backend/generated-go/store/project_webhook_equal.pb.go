@@ -40,5 +40,30 @@ func (x *ProjectWebhook) Equal(y *ProjectWebhook) bool {
 	if x.DirectMessage != y.DirectMessage {
 		return false
 	}
+	if x.SigningSecret != y.SigningSecret {
+		return false
+	}
+	return true
+}
+
+func (x *WebhookDeliveryPayload) Equal(y *WebhookDeliveryPayload) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.EventType != y.EventType {
+		return false
+	}
+	if x.Body != y.Body {
+		return false
+	}
+	if x.LastStatusCode != y.LastStatusCode {
+		return false
+	}
+	if x.LastError != y.LastError {
+		return false
+	}
 	return true
 }
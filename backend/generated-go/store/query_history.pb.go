@@ -23,11 +23,14 @@ const (
 )
 
 type QueryHistoryPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Error    *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Duration *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The break-glass grant issue that the statement runs under.
+	// Format: projects/{project}/issues/{issue}
+	BreakGlassIssue string `protobuf:"bytes,3,opt,name=break_glass_issue,json=breakGlassIssue,proto3" json:"break_glass_issue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *QueryHistoryPayload) Reset() {
@@ -74,14 +77,22 @@ func (x *QueryHistoryPayload) GetDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *QueryHistoryPayload) GetBreakGlassIssue() string {
+	if x != nil {
+		return x.BreakGlassIssue
+	}
+	return ""
+}
+
 var File_store_query_history_proto protoreflect.FileDescriptor
 
 const file_store_query_history_proto_rawDesc = "" +
 	"\n" +
-	"\x19store/query_history.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"q\n" +
+	"\x19store/query_history.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"\x9d\x01\n" +
 	"\x13QueryHistoryPayload\x12\x19\n" +
 	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x125\n" +
-	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bdurationB\b\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12*\n" +
+	"\x11break_glass_issue\x18\x03 \x01(\tR\x0fbreakGlassIssueB\b\n" +
 	"\x06_errorB\x94\x01\n" +
 	"\x12com.bytebase.storeB\x11QueryHistoryProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
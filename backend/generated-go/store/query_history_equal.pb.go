@@ -16,5 +16,8 @@ func (x *QueryHistoryPayload) Equal(y *QueryHistoryPayload) bool {
 	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if x.BreakGlassIssue != y.BreakGlassIssue {
+		return false
+	}
 	return true
 }
@@ -106,10 +106,13 @@ type Task struct {
 	SchemaVersion string `protobuf:"bytes,10,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 	// Whether to create an automatic backup before applying changes.
 	EnablePriorBackup bool `protobuf:"varint,11,opt,name=enable_prior_backup,json=enablePriorBackup,proto3" json:"enable_prior_backup,omitempty"`
-	// Configuration flags for gh-ost migration tool.
+	// Configuration flags for the online schema change tool.
+	// They are gh-ost flags for MySQL and shadow table migration flags for PostgreSQL.
 	Flags map[string]string `protobuf:"bytes,12,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Whether to use gh-ost for online schema migration.
 	EnableGhost bool `protobuf:"varint,17,opt,name=enable_ghost,json=enableGhost,proto3" json:"enable_ghost,omitempty"`
+	// Whether to use the shadow table online schema change for PostgreSQL.
+	EnableOnlineSchemaChange bool `protobuf:"varint,18,opt,name=enable_online_schema_change,json=enableOnlineSchemaChange,proto3" json:"enable_online_schema_change,omitempty"`
 	// Source information if task is created from a release.
 	TaskReleaseSource *TaskReleaseSource `protobuf:"bytes,13,opt,name=task_release_source,json=taskReleaseSource,proto3" json:"task_release_source,omitempty"`
 	// Password to encrypt the exported data archive.
@@ -241,6 +244,13 @@ func (x *Task) GetEnableGhost() bool {
 	return false
 }
 
+func (x *Task) GetEnableOnlineSchemaChange() bool {
+	if x != nil {
+		return x.EnableOnlineSchemaChange
+	}
+	return false
+}
+
 func (x *Task) GetTaskReleaseSource() *TaskReleaseSource {
 	if x != nil {
 		return x.TaskReleaseSource
@@ -313,7 +323,7 @@ var File_store_task_proto protoreflect.FileDescriptor
 
 const file_store_task_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/task.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xae\x06\n" +
+	"\x10store/task.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xed\x06\n" +
 	"\x04Task\x12\x18\n" +
 	"\askipped\x18\x01 \x01(\bR\askipped\x12%\n" +
 	"\x0eskipped_reason\x18\x02 \x01(\tR\rskippedReason\x12\x17\n" +
@@ -329,7 +339,8 @@ const file_store_task_proto_rawDesc = "" +
 	" \x01(\tR\rschemaVersion\x12.\n" +
 	"\x13enable_prior_backup\x18\v \x01(\bR\x11enablePriorBackup\x125\n" +
 	"\x05flags\x18\f \x03(\v2\x1f.bytebase.store.Task.FlagsEntryR\x05flags\x12!\n" +
-	"\fenable_ghost\x18\x11 \x01(\bR\venableGhost\x12Q\n" +
+	"\fenable_ghost\x18\x11 \x01(\bR\venableGhost\x12=\n" +
+	"\x1benable_online_schema_change\x18\x12 \x01(\bR\x18enableOnlineSchemaChange\x12Q\n" +
 	"\x13task_release_source\x18\r \x01(\v2!.bytebase.store.TaskReleaseSourceR\x11taskReleaseSource\x12\x1a\n" +
 	"\bpassword\x18\x0e \x01(\tR\bpassword\x124\n" +
 	"\x06format\x18\x0f \x01(\x0e2\x1c.bytebase.store.ExportFormatR\x06format\x1a8\n" +
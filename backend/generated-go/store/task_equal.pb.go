@@ -58,6 +58,9 @@ func (x *Task) Equal(y *Task) bool {
 	if x.EnableGhost != y.EnableGhost {
 		return false
 	}
+	if x.EnableOnlineSchemaChange != y.EnableOnlineSchemaChange {
+		return false
+	}
 	if !x.TaskReleaseSource.Equal(y.TaskReleaseSource) {
 		return false
 	}
@@ -232,6 +232,52 @@ func (x *TaskRunResult) GetPriorBackupDetail() *PriorBackupDetail {
 	return nil
 }
 
+// TaskRunPayload contains the scheduling state of a task run which must survive server restarts.
+type TaskRunPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The waiting state of the task run, set while it waits for the rollout window to open.
+	SchedulerInfo *SchedulerInfo `protobuf:"bytes,1,opt,name=scheduler_info,json=schedulerInfo,proto3" json:"scheduler_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRunPayload) Reset() {
+	*x = TaskRunPayload{}
+	mi := &file_store_task_run_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRunPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRunPayload) ProtoMessage() {}
+
+func (x *TaskRunPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRunPayload.ProtoReflect.Descriptor instead.
+func (*TaskRunPayload) Descriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskRunPayload) GetSchedulerInfo() *SchedulerInfo {
+	if x != nil {
+		return x.SchedulerInfo
+	}
+	return nil
+}
+
 // PriorBackupDetail contains information about automatic backups created before migration.
 type PriorBackupDetail struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -243,7 +289,7 @@ type PriorBackupDetail struct {
 
 func (x *PriorBackupDetail) Reset() {
 	*x = PriorBackupDetail{}
-	mi := &file_store_task_run_proto_msgTypes[2]
+	mi := &file_store_task_run_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +301,7 @@ func (x *PriorBackupDetail) String() string {
 func (*PriorBackupDetail) ProtoMessage() {}
 
 func (x *PriorBackupDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_proto_msgTypes[2]
+	mi := &file_store_task_run_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +314,7 @@ func (x *PriorBackupDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PriorBackupDetail.ProtoReflect.Descriptor instead.
 func (*PriorBackupDetail) Descriptor() ([]byte, []int) {
-	return file_store_task_run_proto_rawDescGZIP(), []int{2}
+	return file_store_task_run_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PriorBackupDetail) GetItems() []*PriorBackupDetail_Item {
@@ -291,7 +337,7 @@ type SchedulerInfo struct {
 
 func (x *SchedulerInfo) Reset() {
 	*x = SchedulerInfo{}
-	mi := &file_store_task_run_proto_msgTypes[3]
+	mi := &file_store_task_run_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +349,7 @@ func (x *SchedulerInfo) String() string {
 func (*SchedulerInfo) ProtoMessage() {}
 
 func (x *SchedulerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_proto_msgTypes[3]
+	mi := &file_store_task_run_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +362,7 @@ func (x *SchedulerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulerInfo.ProtoReflect.Descriptor instead.
 func (*SchedulerInfo) Descriptor() ([]byte, []int) {
-	return file_store_task_run_proto_rawDescGZIP(), []int{3}
+	return file_store_task_run_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SchedulerInfo) GetReportTime() *timestamppb.Timestamp {
@@ -350,7 +396,7 @@ type PriorBackupDetail_Item struct {
 
 func (x *PriorBackupDetail_Item) Reset() {
 	*x = PriorBackupDetail_Item{}
-	mi := &file_store_task_run_proto_msgTypes[4]
+	mi := &file_store_task_run_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +408,7 @@ func (x *PriorBackupDetail_Item) String() string {
 func (*PriorBackupDetail_Item) ProtoMessage() {}
 
 func (x *PriorBackupDetail_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_proto_msgTypes[4]
+	mi := &file_store_task_run_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +421,7 @@ func (x *PriorBackupDetail_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PriorBackupDetail_Item.ProtoReflect.Descriptor instead.
 func (*PriorBackupDetail_Item) Descriptor() ([]byte, []int) {
-	return file_store_task_run_proto_rawDescGZIP(), []int{2, 0}
+	return file_store_task_run_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *PriorBackupDetail_Item) GetSourceTable() *PriorBackupDetail_Item_Table {
@@ -422,7 +468,7 @@ type PriorBackupDetail_Item_Table struct {
 
 func (x *PriorBackupDetail_Item_Table) Reset() {
 	*x = PriorBackupDetail_Item_Table{}
-	mi := &file_store_task_run_proto_msgTypes[5]
+	mi := &file_store_task_run_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +480,7 @@ func (x *PriorBackupDetail_Item_Table) String() string {
 func (*PriorBackupDetail_Item_Table) ProtoMessage() {}
 
 func (x *PriorBackupDetail_Item_Table) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_proto_msgTypes[5]
+	mi := &file_store_task_run_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +493,7 @@ func (x *PriorBackupDetail_Item_Table) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PriorBackupDetail_Item_Table.ProtoReflect.Descriptor instead.
 func (*PriorBackupDetail_Item_Table) Descriptor() ([]byte, []int) {
-	return file_store_task_run_proto_rawDescGZIP(), []int{2, 0, 0}
+	return file_store_task_run_proto_rawDescGZIP(), []int{3, 0, 0}
 }
 
 func (x *PriorBackupDetail_Item_Table) GetDatabase() string {
@@ -479,6 +525,8 @@ type SchedulerInfo_WaitingCause struct {
 	//	*SchedulerInfo_WaitingCause_ConnectionLimit
 	//	*SchedulerInfo_WaitingCause_TaskUid
 	//	*SchedulerInfo_WaitingCause_ParallelTasksLimit
+	//	*SchedulerInfo_WaitingCause_RolloutWindow
+	//	*SchedulerInfo_WaitingCause_RolloutBatch
 	Cause         isSchedulerInfo_WaitingCause_Cause `protobuf_oneof:"cause"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -486,7 +534,7 @@ type SchedulerInfo_WaitingCause struct {
 
 func (x *SchedulerInfo_WaitingCause) Reset() {
 	*x = SchedulerInfo_WaitingCause{}
-	mi := &file_store_task_run_proto_msgTypes[6]
+	mi := &file_store_task_run_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -498,7 +546,7 @@ func (x *SchedulerInfo_WaitingCause) String() string {
 func (*SchedulerInfo_WaitingCause) ProtoMessage() {}
 
 func (x *SchedulerInfo_WaitingCause) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_proto_msgTypes[6]
+	mi := &file_store_task_run_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +559,7 @@ func (x *SchedulerInfo_WaitingCause) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulerInfo_WaitingCause.ProtoReflect.Descriptor instead.
 func (*SchedulerInfo_WaitingCause) Descriptor() ([]byte, []int) {
-	return file_store_task_run_proto_rawDescGZIP(), []int{3, 0}
+	return file_store_task_run_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *SchedulerInfo_WaitingCause) GetCause() isSchedulerInfo_WaitingCause_Cause {
@@ -548,6 +596,24 @@ func (x *SchedulerInfo_WaitingCause) GetParallelTasksLimit() bool {
 	return false
 }
 
+func (x *SchedulerInfo_WaitingCause) GetRolloutWindow() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Cause.(*SchedulerInfo_WaitingCause_RolloutWindow); ok {
+			return x.RolloutWindow
+		}
+	}
+	return nil
+}
+
+func (x *SchedulerInfo_WaitingCause) GetRolloutBatch() bool {
+	if x != nil {
+		if x, ok := x.Cause.(*SchedulerInfo_WaitingCause_RolloutBatch); ok {
+			return x.RolloutBatch
+		}
+	}
+	return false
+}
+
 type isSchedulerInfo_WaitingCause_Cause interface {
 	isSchedulerInfo_WaitingCause_Cause()
 }
@@ -567,12 +633,26 @@ type SchedulerInfo_WaitingCause_ParallelTasksLimit struct {
 	ParallelTasksLimit bool `protobuf:"varint,3,opt,name=parallel_tasks_limit,json=parallelTasksLimit,proto3,oneof"`
 }
 
+type SchedulerInfo_WaitingCause_RolloutWindow struct {
+	// Task is waiting for the rollout window to open at the time.
+	RolloutWindow *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=rollout_window,json=rolloutWindow,proto3,oneof"`
+}
+
+type SchedulerInfo_WaitingCause_RolloutBatch struct {
+	// Task is waiting for the previous batches of the rollout strategy to finish.
+	RolloutBatch bool `protobuf:"varint,5,opt,name=rollout_batch,json=rolloutBatch,proto3,oneof"`
+}
+
 func (*SchedulerInfo_WaitingCause_ConnectionLimit) isSchedulerInfo_WaitingCause_Cause() {}
 
 func (*SchedulerInfo_WaitingCause_TaskUid) isSchedulerInfo_WaitingCause_Cause() {}
 
 func (*SchedulerInfo_WaitingCause_ParallelTasksLimit) isSchedulerInfo_WaitingCause_Cause() {}
 
+func (*SchedulerInfo_WaitingCause_RolloutWindow) isSchedulerInfo_WaitingCause_Cause() {}
+
+func (*SchedulerInfo_WaitingCause_RolloutBatch) isSchedulerInfo_WaitingCause_Cause() {}
+
 var File_store_task_run_proto protoreflect.FileDescriptor
 
 const file_store_task_run_proto_rawDesc = "" +
@@ -596,7 +676,9 @@ const file_store_task_run_proto_rawDesc = "" +
 	"\x0estart_position\x18\x04 \x01(\v2\x18.bytebase.store.PositionR\rstartPosition\x12;\n" +
 	"\fend_position\x18\x05 \x01(\v2\x18.bytebase.store.PositionR\vendPosition\x12,\n" +
 	"\x12export_archive_uid\x18\x06 \x01(\x05R\x10exportArchiveUid\x12Q\n" +
-	"\x13prior_backup_detail\x18\a \x01(\v2!.bytebase.store.PriorBackupDetailR\x11priorBackupDetail\"\xcd\x03\n" +
+	"\x13prior_backup_detail\x18\a \x01(\v2!.bytebase.store.PriorBackupDetailR\x11priorBackupDetail\"V\n" +
+	"\x0eTaskRunPayload\x12D\n" +
+	"\x0escheduler_info\x18\x01 \x01(\v2\x1d.bytebase.store.SchedulerInfoR\rschedulerInfo\"\xcd\x03\n" +
 	"\x11PriorBackupDetail\x12<\n" +
 	"\x05items\x18\x01 \x03(\v2&.bytebase.store.PriorBackupDetail.ItemR\x05items\x1a\xf9\x02\n" +
 	"\x04Item\x12O\n" +
@@ -607,15 +689,17 @@ const file_store_task_run_proto_rawDesc = "" +
 	"\x05Table\x12\x1a\n" +
 	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
 	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
-	"\x05table\x18\x03 \x01(\tR\x05table\"\xb5\x02\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\"\xa1\x03\n" +
 	"\rSchedulerInfo\x12;\n" +
 	"\vreport_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"reportTime\x12O\n" +
-	"\rwaiting_cause\x18\x02 \x01(\v2*.bytebase.store.SchedulerInfo.WaitingCauseR\fwaitingCause\x1a\x95\x01\n" +
+	"\rwaiting_cause\x18\x02 \x01(\v2*.bytebase.store.SchedulerInfo.WaitingCauseR\fwaitingCause\x1a\x81\x02\n" +
 	"\fWaitingCause\x12+\n" +
 	"\x10connection_limit\x18\x01 \x01(\bH\x00R\x0fconnectionLimit\x12\x1b\n" +
 	"\btask_uid\x18\x02 \x01(\x05H\x00R\ataskUid\x122\n" +
-	"\x14parallel_tasks_limit\x18\x03 \x01(\bH\x00R\x12parallelTasksLimitB\a\n" +
+	"\x14parallel_tasks_limit\x18\x03 \x01(\bH\x00R\x12parallelTasksLimit\x12C\n" +
+	"\x0erollout_window\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rrolloutWindow\x12%\n" +
+	"\rrollout_batch\x18\x05 \x01(\bH\x00R\frolloutBatchB\a\n" +
 	"\x05causeB\x8f\x01\n" +
 	"\x12com.bytebase.storeB\fTaskRunProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
@@ -632,35 +716,38 @@ func file_store_task_run_proto_rawDescGZIP() []byte {
 }
 
 var file_store_task_run_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_task_run_proto_goTypes = []any{
 	(TaskRun_Status)(0),                  // 0: bytebase.store.TaskRun.Status
 	(*TaskRun)(nil),                      // 1: bytebase.store.TaskRun
 	(*TaskRunResult)(nil),                // 2: bytebase.store.TaskRunResult
-	(*PriorBackupDetail)(nil),            // 3: bytebase.store.PriorBackupDetail
-	(*SchedulerInfo)(nil),                // 4: bytebase.store.SchedulerInfo
-	(*PriorBackupDetail_Item)(nil),       // 5: bytebase.store.PriorBackupDetail.Item
-	(*PriorBackupDetail_Item_Table)(nil), // 6: bytebase.store.PriorBackupDetail.Item.Table
-	(*SchedulerInfo_WaitingCause)(nil),   // 7: bytebase.store.SchedulerInfo.WaitingCause
-	(*Position)(nil),                     // 8: bytebase.store.Position
-	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
+	(*TaskRunPayload)(nil),               // 3: bytebase.store.TaskRunPayload
+	(*PriorBackupDetail)(nil),            // 4: bytebase.store.PriorBackupDetail
+	(*SchedulerInfo)(nil),                // 5: bytebase.store.SchedulerInfo
+	(*PriorBackupDetail_Item)(nil),       // 6: bytebase.store.PriorBackupDetail.Item
+	(*PriorBackupDetail_Item_Table)(nil), // 7: bytebase.store.PriorBackupDetail.Item.Table
+	(*SchedulerInfo_WaitingCause)(nil),   // 8: bytebase.store.SchedulerInfo.WaitingCause
+	(*Position)(nil),                     // 9: bytebase.store.Position
+	(*timestamppb.Timestamp)(nil),        // 10: google.protobuf.Timestamp
 }
 var file_store_task_run_proto_depIdxs = []int32{
-	8,  // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.Position
-	8,  // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.Position
-	3,  // 2: bytebase.store.TaskRunResult.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
-	5,  // 3: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
-	9,  // 4: bytebase.store.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
-	7,  // 5: bytebase.store.SchedulerInfo.waiting_cause:type_name -> bytebase.store.SchedulerInfo.WaitingCause
-	6,  // 6: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	6,  // 7: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	8,  // 8: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
-	8,  // 9: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.Position
+	9,  // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.Position
+	4,  // 2: bytebase.store.TaskRunResult.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
+	5,  // 3: bytebase.store.TaskRunPayload.scheduler_info:type_name -> bytebase.store.SchedulerInfo
+	6,  // 4: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
+	10, // 5: bytebase.store.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
+	8,  // 6: bytebase.store.SchedulerInfo.waiting_cause:type_name -> bytebase.store.SchedulerInfo.WaitingCause
+	7,  // 7: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
+	7,  // 8: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
+	9,  // 9: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
+	9,  // 10: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
+	10, // 11: bytebase.store.SchedulerInfo.WaitingCause.rollout_window:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_proto_init() }
@@ -669,10 +756,12 @@ func file_store_task_run_proto_init() {
 		return
 	}
 	file_store_common_proto_init()
-	file_store_task_run_proto_msgTypes[6].OneofWrappers = []any{
+	file_store_task_run_proto_msgTypes[7].OneofWrappers = []any{
 		(*SchedulerInfo_WaitingCause_ConnectionLimit)(nil),
 		(*SchedulerInfo_WaitingCause_TaskUid)(nil),
 		(*SchedulerInfo_WaitingCause_ParallelTasksLimit)(nil),
+		(*SchedulerInfo_WaitingCause_RolloutWindow)(nil),
+		(*SchedulerInfo_WaitingCause_RolloutBatch)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -680,7 +769,7 @@ func file_store_task_run_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_task_run_proto_rawDesc), len(file_store_task_run_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
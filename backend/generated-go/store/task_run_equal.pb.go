@@ -44,6 +44,19 @@ func (x *TaskRunResult) Equal(y *TaskRunResult) bool {
 	return true
 }
 
+func (x *TaskRunPayload) Equal(y *TaskRunPayload) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if !x.SchedulerInfo.Equal(y.SchedulerInfo) {
+		return false
+	}
+	return true
+}
+
 func (x *PriorBackupDetail_Item_Table) Equal(y *PriorBackupDetail_Item_Table) bool {
 	if x == y {
 		return true
@@ -119,6 +132,12 @@ func (x *SchedulerInfo_WaitingCause) Equal(y *SchedulerInfo_WaitingCause) bool {
 	if x.GetParallelTasksLimit() != y.GetParallelTasksLimit() {
 		return false
 	}
+	if p, q := x.GetRolloutWindow(), y.GetRolloutWindow(); (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if x.GetRolloutBatch() != y.GetRolloutBatch() {
+		return false
+	}
 	return true
 }
 
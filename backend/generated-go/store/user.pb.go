@@ -78,6 +78,57 @@ func (PrincipalType) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_proto_rawDescGZIP(), []int{0}
 }
 
+type WebAuthnChallenge_Ceremony int32
+
+const (
+	WebAuthnChallenge_CEREMONY_UNSPECIFIED WebAuthnChallenge_Ceremony = 0
+	// REGISTRATION registers a new credential.
+	WebAuthnChallenge_REGISTRATION WebAuthnChallenge_Ceremony = 1
+	// LOGIN asserts a registered credential as the second factor of the login.
+	WebAuthnChallenge_LOGIN WebAuthnChallenge_Ceremony = 2
+)
+
+// Enum value maps for WebAuthnChallenge_Ceremony.
+var (
+	WebAuthnChallenge_Ceremony_name = map[int32]string{
+		0: "CEREMONY_UNSPECIFIED",
+		1: "REGISTRATION",
+		2: "LOGIN",
+	}
+	WebAuthnChallenge_Ceremony_value = map[string]int32{
+		"CEREMONY_UNSPECIFIED": 0,
+		"REGISTRATION":         1,
+		"LOGIN":                2,
+	}
+)
+
+func (x WebAuthnChallenge_Ceremony) Enum() *WebAuthnChallenge_Ceremony {
+	p := new(WebAuthnChallenge_Ceremony)
+	*p = x
+	return p
+}
+
+func (x WebAuthnChallenge_Ceremony) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebAuthnChallenge_Ceremony) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_proto_enumTypes[1].Descriptor()
+}
+
+func (WebAuthnChallenge_Ceremony) Type() protoreflect.EnumType {
+	return &file_store_user_proto_enumTypes[1]
+}
+
+func (x WebAuthnChallenge_Ceremony) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebAuthnChallenge_Ceremony.Descriptor instead.
+func (WebAuthnChallenge_Ceremony) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // MFAConfig is the MFA configuration for a user.
 type MFAConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -91,8 +142,12 @@ type MFAConfig struct {
 	TempRecoveryCodes []string `protobuf:"bytes,4,rep,name=temp_recovery_codes,json=tempRecoveryCodes,proto3" json:"temp_recovery_codes,omitempty"`
 	// The temp_otp_secret_created_time is the timestamp when temp_otp_secret was created. Used to enforce expiration.
 	TempOtpSecretCreatedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=temp_otp_secret_created_time,json=tempOtpSecretCreatedTime,proto3" json:"temp_otp_secret_created_time,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// The webauthn_credentials are the registered passkeys and security keys used as the second factor.
+	WebauthnCredentials []*WebAuthnCredential `protobuf:"bytes,6,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	// The webauthn_challenges are the challenges of the pending WebAuthn registration and login ceremonies.
+	WebauthnChallenges []*WebAuthnChallenge `protobuf:"bytes,7,rep,name=webauthn_challenges,json=webauthnChallenges,proto3" json:"webauthn_challenges,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *MFAConfig) Reset() {
@@ -160,6 +215,180 @@ func (x *MFAConfig) GetTempOtpSecretCreatedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *MFAConfig) GetWebauthnCredentials() []*WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredentials
+	}
+	return nil
+}
+
+func (x *MFAConfig) GetWebauthnChallenges() []*WebAuthnChallenge {
+	if x != nil {
+		return x.WebauthnChallenges
+	}
+	return nil
+}
+
+// WebAuthnChallenge is the challenge of a pending WebAuthn ceremony.
+type WebAuthnChallenge struct {
+	state    protoimpl.MessageState     `protogen:"open.v1"`
+	Ceremony WebAuthnChallenge_Ceremony `protobuf:"varint,1,opt,name=ceremony,proto3,enum=bytebase.store.WebAuthnChallenge_Ceremony" json:"ceremony,omitempty"`
+	// The binding is the SHA-256 digest of the token starting the ceremony, i.e. the access token for
+	// the registration and the MFA temp token for the login. Only the same token can finish the ceremony.
+	Binding   string `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	Challenge []byte `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The create_time is used to enforce expiration.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthnChallenge) Reset() {
+	*x = WebAuthnChallenge{}
+	mi := &file_store_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthnChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnChallenge) ProtoMessage() {}
+
+func (x *WebAuthnChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnChallenge.ProtoReflect.Descriptor instead.
+func (*WebAuthnChallenge) Descriptor() ([]byte, []int) {
+	return file_store_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebAuthnChallenge) GetCeremony() WebAuthnChallenge_Ceremony {
+	if x != nil {
+		return x.Ceremony
+	}
+	return WebAuthnChallenge_CEREMONY_UNSPECIFIED
+}
+
+func (x *WebAuthnChallenge) GetBinding() string {
+	if x != nil {
+		return x.Binding
+	}
+	return ""
+}
+
+func (x *WebAuthnChallenge) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *WebAuthnChallenge) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type WebAuthnCredential struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The credential ID generated by the authenticator.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user-provided title of the credential.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The COSE-encoded credential public key.
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The signature counter of the authenticator, used to detect cloned authenticators.
+	SignCount     uint32                 `protobuf:"varint,4,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
+	mi := &file_store_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthnCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnCredential) ProtoMessage() {}
+
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_store_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebAuthnCredential) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetSignCount() uint32 {
+	if x != nil {
+		return x.SignCount
+	}
+	return 0
+}
+
+func (x *WebAuthnCredential) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
 type UserProfile struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	LastLoginTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`
@@ -172,7 +401,7 @@ type UserProfile struct {
 
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
-	mi := &file_store_user_proto_msgTypes[1]
+	mi := &file_store_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +413,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_proto_msgTypes[1]
+	mi := &file_store_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +426,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_store_user_proto_rawDescGZIP(), []int{1}
+	return file_store_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UserProfile) GetLastLoginTime() *timestamppb.Timestamp {
@@ -221,22 +450,92 @@ func (x *UserProfile) GetSource() string {
 	return ""
 }
 
+type PersonalAccessTokenPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The permissions restricting the token to a subset of the user's permissions.
+	// Empty means the token has all the permissions of the user.
+	Permissions   []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalAccessTokenPayload) Reset() {
+	*x = PersonalAccessTokenPayload{}
+	mi := &file_store_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalAccessTokenPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalAccessTokenPayload) ProtoMessage() {}
+
+func (x *PersonalAccessTokenPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalAccessTokenPayload.ProtoReflect.Descriptor instead.
+func (*PersonalAccessTokenPayload) Descriptor() ([]byte, []int) {
+	return file_store_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PersonalAccessTokenPayload) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 var File_store_user_proto protoreflect.FileDescriptor
 
 const file_store_user_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/user.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\x85\x02\n" +
+	"\x10store/user.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x03\n" +
 	"\tMFAConfig\x12\x1d\n" +
 	"\n" +
 	"otp_secret\x18\x01 \x01(\tR\totpSecret\x12&\n" +
 	"\x0ftemp_otp_secret\x18\x02 \x01(\tR\rtempOtpSecret\x12%\n" +
 	"\x0erecovery_codes\x18\x03 \x03(\tR\rrecoveryCodes\x12.\n" +
 	"\x13temp_recovery_codes\x18\x04 \x03(\tR\x11tempRecoveryCodes\x12Z\n" +
-	"\x1ctemp_otp_secret_created_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x18tempOtpSecretCreatedTime\"\xc0\x01\n" +
+	"\x1ctemp_otp_secret_created_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x18tempOtpSecretCreatedTime\x12U\n" +
+	"\x14webauthn_credentials\x18\x06 \x03(\v2\".bytebase.store.WebAuthnCredentialR\x13webauthnCredentials\x12R\n" +
+	"\x13webauthn_challenges\x18\a \x03(\v2!.bytebase.store.WebAuthnChallengeR\x12webauthnChallenges\"\x93\x02\n" +
+	"\x11WebAuthnChallenge\x12F\n" +
+	"\bceremony\x18\x01 \x01(\x0e2*.bytebase.store.WebAuthnChallenge.CeremonyR\bceremony\x12\x18\n" +
+	"\abinding\x18\x02 \x01(\tR\abinding\x12\x1c\n" +
+	"\tchallenge\x18\x03 \x01(\fR\tchallenge\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"A\n" +
+	"\bCeremony\x12\x18\n" +
+	"\x14CEREMONY_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fREGISTRATION\x10\x01\x12\t\n" +
+	"\x05LOGIN\x10\x02\"\xf7\x01\n" +
+	"\x12WebAuthnCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12\x1d\n" +
+	"\n" +
+	"sign_count\x18\x04 \x01(\rR\tsignCount\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12@\n" +
+	"\x0elast_used_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\"\xc0\x01\n" +
 	"\vUserProfile\x12B\n" +
 	"\x0flast_login_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastLoginTime\x12U\n" +
 	"\x19last_change_password_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x16lastChangePasswordTime\x12\x16\n" +
-	"\x06source\x18\x03 \x01(\tR\x06source*b\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\">\n" +
+	"\x1aPersonalAccessTokenPayload\x12 \n" +
+	"\vpermissions\x18\x01 \x03(\tR\vpermissions*b\n" +
 	"\rPrincipalType\x12\x1e\n" +
 	"\x1aPRINCIPAL_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bEND_USER\x10\x01\x12\x13\n" +
@@ -257,23 +556,33 @@ func file_store_user_proto_rawDescGZIP() []byte {
 	return file_store_user_proto_rawDescData
 }
 
-var file_store_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_user_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_user_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_user_proto_goTypes = []any{
-	(PrincipalType)(0),            // 0: bytebase.store.PrincipalType
-	(*MFAConfig)(nil),             // 1: bytebase.store.MFAConfig
-	(*UserProfile)(nil),           // 2: bytebase.store.UserProfile
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(PrincipalType)(0),                 // 0: bytebase.store.PrincipalType
+	(WebAuthnChallenge_Ceremony)(0),    // 1: bytebase.store.WebAuthnChallenge.Ceremony
+	(*MFAConfig)(nil),                  // 2: bytebase.store.MFAConfig
+	(*WebAuthnChallenge)(nil),          // 3: bytebase.store.WebAuthnChallenge
+	(*WebAuthnCredential)(nil),         // 4: bytebase.store.WebAuthnCredential
+	(*UserProfile)(nil),                // 5: bytebase.store.UserProfile
+	(*PersonalAccessTokenPayload)(nil), // 6: bytebase.store.PersonalAccessTokenPayload
+	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
 }
 var file_store_user_proto_depIdxs = []int32{
-	3, // 0: bytebase.store.MFAConfig.temp_otp_secret_created_time:type_name -> google.protobuf.Timestamp
-	3, // 1: bytebase.store.UserProfile.last_login_time:type_name -> google.protobuf.Timestamp
-	3, // 2: bytebase.store.UserProfile.last_change_password_time:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7, // 0: bytebase.store.MFAConfig.temp_otp_secret_created_time:type_name -> google.protobuf.Timestamp
+	4, // 1: bytebase.store.MFAConfig.webauthn_credentials:type_name -> bytebase.store.WebAuthnCredential
+	3, // 2: bytebase.store.MFAConfig.webauthn_challenges:type_name -> bytebase.store.WebAuthnChallenge
+	1, // 3: bytebase.store.WebAuthnChallenge.ceremony:type_name -> bytebase.store.WebAuthnChallenge.Ceremony
+	7, // 4: bytebase.store.WebAuthnChallenge.create_time:type_name -> google.protobuf.Timestamp
+	7, // 5: bytebase.store.WebAuthnCredential.create_time:type_name -> google.protobuf.Timestamp
+	7, // 6: bytebase.store.WebAuthnCredential.last_used_time:type_name -> google.protobuf.Timestamp
+	7, // 7: bytebase.store.UserProfile.last_login_time:type_name -> google.protobuf.Timestamp
+	7, // 8: bytebase.store.UserProfile.last_change_password_time:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_store_user_proto_init() }
@@ -286,8 +595,8 @@ func file_store_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_proto_rawDesc), len(file_store_user_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
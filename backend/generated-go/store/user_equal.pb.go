@@ -35,6 +35,72 @@ func (x *MFAConfig) Equal(y *MFAConfig) bool {
 	if p, q := x.TempOtpSecretCreatedTime, y.TempOtpSecretCreatedTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if len(x.WebauthnCredentials) != len(y.WebauthnCredentials) {
+		return false
+	}
+	for i := 0; i < len(x.WebauthnCredentials); i++ {
+		if !x.WebauthnCredentials[i].Equal(y.WebauthnCredentials[i]) {
+			return false
+		}
+	}
+	if len(x.WebauthnChallenges) != len(y.WebauthnChallenges) {
+		return false
+	}
+	for i := 0; i < len(x.WebauthnChallenges); i++ {
+		if !x.WebauthnChallenges[i].Equal(y.WebauthnChallenges[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *WebAuthnChallenge) Equal(y *WebAuthnChallenge) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Ceremony != y.Ceremony {
+		return false
+	}
+	if x.Binding != y.Binding {
+		return false
+	}
+	if string(x.Challenge) != string(y.Challenge) {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *WebAuthnCredential) Equal(y *WebAuthnCredential) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if string(x.Id) != string(y.Id) {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if string(x.PublicKey) != string(y.PublicKey) {
+		return false
+	}
+	if x.SignCount != y.SignCount {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.LastUsedTime, y.LastUsedTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
 	return true
 }
 
@@ -56,3 +122,21 @@ func (x *UserProfile) Equal(y *UserProfile) bool {
 	}
 	return true
 }
+
+func (x *PersonalAccessTokenPayload) Equal(y *PersonalAccessTokenPayload) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Permissions) != len(y.Permissions) {
+		return false
+	}
+	for i := 0; i < len(x.Permissions); i++ {
+		if x.Permissions[i] != y.Permissions[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -572,9 +572,12 @@ func (x *AuditLog) GetRequestMetadata() *RequestMetadata {
 type AuditData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Changes to IAM policies.
-	PolicyDelta   *PolicyDelta `protobuf:"bytes,1,opt,name=policy_delta,json=policyDelta,proto3" json:"policy_delta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PolicyDelta *PolicyDelta `protobuf:"bytes,1,opt,name=policy_delta,json=policyDelta,proto3" json:"policy_delta,omitempty"`
+	// The break-glass grant issue that the request runs under.
+	// Format: projects/{project}/issues/{issue}
+	BreakGlassIssue string `protobuf:"bytes,2,opt,name=break_glass_issue,json=breakGlassIssue,proto3" json:"break_glass_issue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AuditData) Reset() {
@@ -614,6 +617,13 @@ func (x *AuditData) GetPolicyDelta() *PolicyDelta {
 	return nil
 }
 
+func (x *AuditData) GetBreakGlassIssue() string {
+	if x != nil {
+		return x.BreakGlassIssue
+	}
+	return ""
+}
+
 // Metadata about the incoming request.
 type RequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -722,9 +732,10 @@ const file_v1_audit_log_service_proto_rawDesc = "" +
 	"\x05ERROR\x10\x05\x12\f\n" +
 	"\bCRITICAL\x10\x06\x12\t\n" +
 	"\x05ALERT\x10\a\x12\r\n" +
-	"\tEMERGENCY\x10\b\"H\n" +
+	"\tEMERGENCY\x10\b\"t\n" +
 	"\tAuditData\x12;\n" +
-	"\fpolicy_delta\x18\x01 \x01(\v2\x18.bytebase.v1.PolicyDeltaR\vpolicyDelta\"k\n" +
+	"\fpolicy_delta\x18\x01 \x01(\v2\x18.bytebase.v1.PolicyDeltaR\vpolicyDelta\x12*\n" +
+	"\x11break_glass_issue\x18\x02 \x01(\tR\x0fbreakGlassIssue\"k\n" +
 	"\x0fRequestMetadata\x12\x1b\n" +
 	"\tcaller_ip\x18\x01 \x01(\tR\bcallerIp\x12;\n" +
 	"\x1acaller_supplied_user_agent\x18\x02 \x01(\tR\x17callerSuppliedUserAgent2\xa5\x03\n" +
@@ -156,6 +156,9 @@ func (x *AuditData) Equal(y *AuditData) bool {
 	if !x.PolicyDelta.Equal(y.PolicyDelta) {
 		return false
 	}
+	if x.BreakGlassIssue != y.BreakGlassIssue {
+		return false
+	}
 	return true
 }
 
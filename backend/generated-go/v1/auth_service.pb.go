@@ -41,9 +41,12 @@ type LoginRequest struct {
 	// The recovery_code is used to recovery the user's identity with MFA.
 	RecoveryCode *string `protobuf:"bytes,7,opt,name=recovery_code,json=recoveryCode,proto3,oneof" json:"recovery_code,omitempty"`
 	// The mfa_temp_token is used to verify the user's identity by MFA.
-	MfaTempToken  *string `protobuf:"bytes,8,opt,name=mfa_temp_token,json=mfaTempToken,proto3,oneof" json:"mfa_temp_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MfaTempToken *string `protobuf:"bytes,8,opt,name=mfa_temp_token,json=mfaTempToken,proto3,oneof" json:"mfa_temp_token,omitempty"`
+	// The webauthn_assertion is the AuthenticationResponseJSON returned by PublicKeyCredential.toJSON()
+	// after navigator.credentials.get(), used to verify the user's identity by MFA.
+	WebauthnAssertion *string `protobuf:"bytes,9,opt,name=webauthn_assertion,json=webauthnAssertion,proto3,oneof" json:"webauthn_assertion,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginRequest) Reset() {
@@ -132,6 +135,13 @@ func (x *LoginRequest) GetMfaTempToken() string {
 	return ""
 }
 
+func (x *LoginRequest) GetWebauthnAssertion() string {
+	if x != nil && x.WebauthnAssertion != nil {
+		return *x.WebauthnAssertion
+	}
+	return ""
+}
+
 // Context for identity provider authentication.
 type IdentityProviderContext struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -139,6 +149,7 @@ type IdentityProviderContext struct {
 	//
 	//	*IdentityProviderContext_Oauth2Context
 	//	*IdentityProviderContext_OidcContext
+	//	*IdentityProviderContext_SamlContext
 	Context       isIdentityProviderContext_Context `protobuf_oneof:"context"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +210,15 @@ func (x *IdentityProviderContext) GetOidcContext() *OIDCIdentityProviderContext
 	return nil
 }
 
+func (x *IdentityProviderContext) GetSamlContext() *SAMLIdentityProviderContext {
+	if x != nil {
+		if x, ok := x.Context.(*IdentityProviderContext_SamlContext); ok {
+			return x.SamlContext
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderContext_Context interface {
 	isIdentityProviderContext_Context()
 }
@@ -213,10 +233,17 @@ type IdentityProviderContext_OidcContext struct {
 	OidcContext *OIDCIdentityProviderContext `protobuf:"bytes,2,opt,name=oidc_context,json=oidcContext,proto3,oneof"`
 }
 
+type IdentityProviderContext_SamlContext struct {
+	// SAML 2.0 authentication context.
+	SamlContext *SAMLIdentityProviderContext `protobuf:"bytes,3,opt,name=saml_context,json=samlContext,proto3,oneof"`
+}
+
 func (*IdentityProviderContext_Oauth2Context) isIdentityProviderContext_Context() {}
 
 func (*IdentityProviderContext_OidcContext) isIdentityProviderContext_Context() {}
 
+func (*IdentityProviderContext_SamlContext) isIdentityProviderContext_Context() {}
+
 // OAuth2 authentication context.
 type OAuth2IdentityProviderContext struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -309,6 +336,52 @@ func (x *OIDCIdentityProviderContext) GetCode() string {
 	return ""
 }
 
+// SAML 2.0 authentication context.
+type SAMLIdentityProviderContext struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base64 encoded SAMLResponse posted by the identity provider to the assertion consumer service.
+	SamlResponse  string `protobuf:"bytes,1,opt,name=saml_response,json=samlResponse,proto3" json:"saml_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLIdentityProviderContext) Reset() {
+	*x = SAMLIdentityProviderContext{}
+	mi := &file_v1_auth_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLIdentityProviderContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLIdentityProviderContext) ProtoMessage() {}
+
+func (x *SAMLIdentityProviderContext) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLIdentityProviderContext.ProtoReflect.Descriptor instead.
+func (*SAMLIdentityProviderContext) Descriptor() ([]byte, []int) {
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SAMLIdentityProviderContext) GetSamlResponse() string {
+	if x != nil {
+		return x.SamlResponse
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Access token for authenticated requests.
@@ -318,14 +391,18 @@ type LoginResponse struct {
 	// Whether user must reset password before continuing.
 	RequireResetPassword bool `protobuf:"varint,3,opt,name=require_reset_password,json=requireResetPassword,proto3" json:"require_reset_password,omitempty"`
 	// The user from the successful login.
-	User          *User `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	User *User `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	// Whether the user can verify MFA with the WebAuthn credentials, set with mfa_temp_token.
+	WebauthnEnabled bool `protobuf:"varint,5,opt,name=webauthn_enabled,json=webauthnEnabled,proto3" json:"webauthn_enabled,omitempty"`
+	// Whether the user can verify MFA with the OTP code or recovery code, set with mfa_temp_token.
+	OtpEnabled    bool `protobuf:"varint,6,opt,name=otp_enabled,json=otpEnabled,proto3" json:"otp_enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_v1_auth_service_proto_msgTypes[4]
+	mi := &file_v1_auth_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +414,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_service_proto_msgTypes[4]
+	mi := &file_v1_auth_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +427,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_v1_auth_service_proto_rawDescGZIP(), []int{4}
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoginResponse) GetToken() string {
@@ -381,6 +458,20 @@ func (x *LoginResponse) GetUser() *User {
 	return nil
 }
 
+func (x *LoginResponse) GetWebauthnEnabled() bool {
+	if x != nil {
+		return x.WebauthnEnabled
+	}
+	return false
+}
+
+func (x *LoginResponse) GetOtpEnabled() bool {
+	if x != nil {
+		return x.OtpEnabled
+	}
+	return false
+}
+
 // Request to logout current user session.
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -390,7 +481,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_v1_auth_service_proto_msgTypes[5]
+	mi := &file_v1_auth_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +493,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_service_proto_msgTypes[5]
+	mi := &file_v1_auth_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,14 +506,105 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_v1_auth_service_proto_rawDescGZIP(), []int{5}
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{6}
+}
+
+type BeginWebAuthnLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The mfa_temp_token returned by the first step of the login.
+	MfaTempToken  string `protobuf:"bytes,1,opt,name=mfa_temp_token,json=mfaTempToken,proto3" json:"mfa_temp_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnLoginRequest) Reset() {
+	*x = BeginWebAuthnLoginRequest{}
+	mi := &file_v1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnLoginRequest) ProtoMessage() {}
+
+func (x *BeginWebAuthnLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnLoginRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BeginWebAuthnLoginRequest) GetMfaTempToken() string {
+	if x != nil {
+		return x.MfaTempToken
+	}
+	return ""
+}
+
+type BeginWebAuthnLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The PublicKeyCredentialRequestOptions in the WebAuthn JSON format,
+	// which can be parsed by PublicKeyCredential.parseRequestOptionsFromJSON() in the browser.
+	Options       string `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnLoginResponse) Reset() {
+	*x = BeginWebAuthnLoginResponse{}
+	mi := &file_v1_auth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnLoginResponse) ProtoMessage() {}
+
+func (x *BeginWebAuthnLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnLoginResponse) Descriptor() ([]byte, []int) {
+	return file_v1_auth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BeginWebAuthnLoginResponse) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
 }
 
 var File_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15v1/auth_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x13v1/annotation.proto\x1a\x15v1/user_service.proto\"\xdb\x02\n" +
+	"\x15v1/auth_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x13v1/annotation.proto\x1a\x15v1/user_service.proto\"\xa6\x03\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x10\n" +
@@ -432,28 +614,41 @@ const file_v1_auth_service_proto_rawDesc = "" +
 	"idpContext\x12\x1e\n" +
 	"\botp_code\x18\x06 \x01(\tH\x00R\aotpCode\x88\x01\x01\x12(\n" +
 	"\rrecovery_code\x18\a \x01(\tH\x01R\frecoveryCode\x88\x01\x01\x12)\n" +
-	"\x0emfa_temp_token\x18\b \x01(\tH\x02R\fmfaTempToken\x88\x01\x01B\v\n" +
+	"\x0emfa_temp_token\x18\b \x01(\tH\x02R\fmfaTempToken\x88\x01\x01\x122\n" +
+	"\x12webauthn_assertion\x18\t \x01(\tH\x03R\x11webauthnAssertion\x88\x01\x01B\v\n" +
 	"\t_otp_codeB\x10\n" +
 	"\x0e_recovery_codeB\x11\n" +
-	"\x0f_mfa_temp_token\"\xc8\x01\n" +
+	"\x0f_mfa_temp_tokenB\x15\n" +
+	"\x13_webauthn_assertion\"\x97\x02\n" +
 	"\x17IdentityProviderContext\x12S\n" +
 	"\x0eoauth2_context\x18\x01 \x01(\v2*.bytebase.v1.OAuth2IdentityProviderContextH\x00R\roauth2Context\x12M\n" +
-	"\foidc_context\x18\x02 \x01(\v2(.bytebase.v1.OIDCIdentityProviderContextH\x00R\voidcContextB\t\n" +
+	"\foidc_context\x18\x02 \x01(\v2(.bytebase.v1.OIDCIdentityProviderContextH\x00R\voidcContext\x12M\n" +
+	"\fsaml_context\x18\x03 \x01(\v2(.bytebase.v1.SAMLIdentityProviderContextH\x00R\vsamlContextB\t\n" +
 	"\acontext\"3\n" +
 	"\x1dOAuth2IdentityProviderContext\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\"1\n" +
 	"\x1bOIDCIdentityProviderContext\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"\xc0\x01\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"B\n" +
+	"\x1bSAMLIdentityProviderContext\x12#\n" +
+	"\rsaml_response\x18\x01 \x01(\tR\fsamlResponse\"\x8c\x02\n" +
 	"\rLoginResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12)\n" +
 	"\x0emfa_temp_token\x18\x02 \x01(\tH\x00R\fmfaTempToken\x88\x01\x01\x124\n" +
 	"\x16require_reset_password\x18\x03 \x01(\bR\x14requireResetPassword\x12%\n" +
-	"\x04user\x18\x04 \x01(\v2\x11.bytebase.v1.UserR\x04userB\x11\n" +
+	"\x04user\x18\x04 \x01(\v2\x11.bytebase.v1.UserR\x04user\x12)\n" +
+	"\x10webauthn_enabled\x18\x05 \x01(\bR\x0fwebauthnEnabled\x12\x1f\n" +
+	"\votp_enabled\x18\x06 \x01(\bR\n" +
+	"otpEnabledB\x11\n" +
 	"\x0f_mfa_temp_token\"\x0f\n" +
-	"\rLogoutRequest2\xd2\x01\n" +
+	"\rLogoutRequest\"F\n" +
+	"\x19BeginWebAuthnLoginRequest\x12)\n" +
+	"\x0emfa_temp_token\x18\x01 \x01(\tB\x03\xe0A\x02R\fmfaTempToken\"6\n" +
+	"\x1aBeginWebAuthnLoginResponse\x12\x18\n" +
+	"\aoptions\x18\x01 \x01(\tR\aoptions2\xe2\x02\n" +
 	"\vAuthService\x12a\n" +
 	"\x05Login\x12\x19.bytebase.v1.LoginRequest\x1a\x1a.bytebase.v1.LoginResponse\"!\x80\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12`\n" +
-	"\x06Logout\x12\x1a.bytebase.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\"\x80\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logoutB\xa6\x01\n" +
+	"\x06Logout\x12\x1a.bytebase.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\"\x80\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12\x8d\x01\n" +
+	"\x12BeginWebAuthnLogin\x12&.bytebase.v1.BeginWebAuthnLoginRequest\x1a'.bytebase.v1.BeginWebAuthnLoginResponse\"&\x80\xea0\x01\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/webauthn:beginB\xa6\x01\n" +
 	"\x0fcom.bytebase.v1B\x10AuthServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -468,31 +663,37 @@ func file_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_v1_auth_service_proto_rawDescData
 }
 
-var file_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_v1_auth_service_proto_goTypes = []any{
 	(*LoginRequest)(nil),                  // 0: bytebase.v1.LoginRequest
 	(*IdentityProviderContext)(nil),       // 1: bytebase.v1.IdentityProviderContext
 	(*OAuth2IdentityProviderContext)(nil), // 2: bytebase.v1.OAuth2IdentityProviderContext
 	(*OIDCIdentityProviderContext)(nil),   // 3: bytebase.v1.OIDCIdentityProviderContext
-	(*LoginResponse)(nil),                 // 4: bytebase.v1.LoginResponse
-	(*LogoutRequest)(nil),                 // 5: bytebase.v1.LogoutRequest
-	(*User)(nil),                          // 6: bytebase.v1.User
-	(*emptypb.Empty)(nil),                 // 7: google.protobuf.Empty
+	(*SAMLIdentityProviderContext)(nil),   // 4: bytebase.v1.SAMLIdentityProviderContext
+	(*LoginResponse)(nil),                 // 5: bytebase.v1.LoginResponse
+	(*LogoutRequest)(nil),                 // 6: bytebase.v1.LogoutRequest
+	(*BeginWebAuthnLoginRequest)(nil),     // 7: bytebase.v1.BeginWebAuthnLoginRequest
+	(*BeginWebAuthnLoginResponse)(nil),    // 8: bytebase.v1.BeginWebAuthnLoginResponse
+	(*User)(nil),                          // 9: bytebase.v1.User
+	(*emptypb.Empty)(nil),                 // 10: google.protobuf.Empty
 }
 var file_v1_auth_service_proto_depIdxs = []int32{
-	1, // 0: bytebase.v1.LoginRequest.idp_context:type_name -> bytebase.v1.IdentityProviderContext
-	2, // 1: bytebase.v1.IdentityProviderContext.oauth2_context:type_name -> bytebase.v1.OAuth2IdentityProviderContext
-	3, // 2: bytebase.v1.IdentityProviderContext.oidc_context:type_name -> bytebase.v1.OIDCIdentityProviderContext
-	6, // 3: bytebase.v1.LoginResponse.user:type_name -> bytebase.v1.User
-	0, // 4: bytebase.v1.AuthService.Login:input_type -> bytebase.v1.LoginRequest
-	5, // 5: bytebase.v1.AuthService.Logout:input_type -> bytebase.v1.LogoutRequest
-	4, // 6: bytebase.v1.AuthService.Login:output_type -> bytebase.v1.LoginResponse
-	7, // 7: bytebase.v1.AuthService.Logout:output_type -> google.protobuf.Empty
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: bytebase.v1.LoginRequest.idp_context:type_name -> bytebase.v1.IdentityProviderContext
+	2,  // 1: bytebase.v1.IdentityProviderContext.oauth2_context:type_name -> bytebase.v1.OAuth2IdentityProviderContext
+	3,  // 2: bytebase.v1.IdentityProviderContext.oidc_context:type_name -> bytebase.v1.OIDCIdentityProviderContext
+	4,  // 3: bytebase.v1.IdentityProviderContext.saml_context:type_name -> bytebase.v1.SAMLIdentityProviderContext
+	9,  // 4: bytebase.v1.LoginResponse.user:type_name -> bytebase.v1.User
+	0,  // 5: bytebase.v1.AuthService.Login:input_type -> bytebase.v1.LoginRequest
+	6,  // 6: bytebase.v1.AuthService.Logout:input_type -> bytebase.v1.LogoutRequest
+	7,  // 7: bytebase.v1.AuthService.BeginWebAuthnLogin:input_type -> bytebase.v1.BeginWebAuthnLoginRequest
+	5,  // 8: bytebase.v1.AuthService.Login:output_type -> bytebase.v1.LoginResponse
+	10, // 9: bytebase.v1.AuthService.Logout:output_type -> google.protobuf.Empty
+	8,  // 10: bytebase.v1.AuthService.BeginWebAuthnLogin:output_type -> bytebase.v1.BeginWebAuthnLoginResponse
+	8,  // [8:11] is the sub-list for method output_type
+	5,  // [5:8] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_v1_auth_service_proto_init() }
@@ -506,15 +707,16 @@ func file_v1_auth_service_proto_init() {
 	file_v1_auth_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*IdentityProviderContext_Oauth2Context)(nil),
 		(*IdentityProviderContext_OidcContext)(nil),
+		(*IdentityProviderContext_SamlContext)(nil),
 	}
-	file_v1_auth_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_v1_auth_service_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_auth_service_proto_rawDesc), len(file_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,6 +34,9 @@ func (x *LoginRequest) Equal(y *LoginRequest) bool {
 	if p, q := x.MfaTempToken, y.MfaTempToken; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
+	if p, q := x.WebauthnAssertion, y.WebauthnAssertion; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
 	return true
 }
 
@@ -50,6 +53,9 @@ func (x *IdentityProviderContext) Equal(y *IdentityProviderContext) bool {
 	if !x.GetOidcContext().Equal(y.GetOidcContext()) {
 		return false
 	}
+	if !x.GetSamlContext().Equal(y.GetSamlContext()) {
+		return false
+	}
 	return true
 }
 
@@ -79,6 +85,19 @@ func (x *OIDCIdentityProviderContext) Equal(y *OIDCIdentityProviderContext) bool
 	return true
 }
 
+func (x *SAMLIdentityProviderContext) Equal(y *SAMLIdentityProviderContext) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.SamlResponse != y.SamlResponse {
+		return false
+	}
+	return true
+}
+
 func (x *LoginResponse) Equal(y *LoginResponse) bool {
 	if x == y {
 		return true
@@ -98,6 +117,12 @@ func (x *LoginResponse) Equal(y *LoginResponse) bool {
 	if !x.User.Equal(y.User) {
 		return false
 	}
+	if x.WebauthnEnabled != y.WebauthnEnabled {
+		return false
+	}
+	if x.OtpEnabled != y.OtpEnabled {
+		return false
+	}
 	return true
 }
 
@@ -110,3 +135,29 @@ func (x *LogoutRequest) Equal(y *LogoutRequest) bool {
 	}
 	return true
 }
+
+func (x *BeginWebAuthnLoginRequest) Equal(y *BeginWebAuthnLoginRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.MfaTempToken != y.MfaTempToken {
+		return false
+	}
+	return true
+}
+
+func (x *BeginWebAuthnLoginResponse) Equal(y *BeginWebAuthnLoginResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Options != y.Options {
+		return false
+	}
+	return true
+}
@@ -293,6 +293,10 @@ const (
 	ExportFormat_SQL ExportFormat = 3
 	// Microsoft Excel spreadsheet format.
 	ExportFormat_XLSX ExportFormat = 4
+	// Apache Parquet columnar format.
+	ExportFormat_PARQUET ExportFormat = 5
+	// Apache Avro object container file format.
+	ExportFormat_AVRO ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -303,6 +307,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "AVRO",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -310,6 +316,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"AVRO":               6,
 	}
 )
 
@@ -636,13 +644,15 @@ const file_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"\x06GITLAB\x10\x02\x12\r\n" +
 	"\tBITBUCKET\x10\x03\x12\x10\n" +
-	"\fAZURE_DEVOPS\x10\x04*L\n" +
+	"\fAZURE_DEVOPS\x10\x04*c\n" +
 	"\fExportFormat\x12\x16\n" +
 	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03CSV\x10\x01\x12\b\n" +
 	"\x04JSON\x10\x02\x12\a\n" +
 	"\x03SQL\x10\x03\x12\b\n" +
-	"\x04XLSX\x10\x04*P\n" +
+	"\x04XLSX\x10\x04\x12\v\n" +
+	"\aPARQUET\x10\x05\x12\b\n" +
+	"\x04AVRO\x10\x06*P\n" +
 	"\x12DatabaseChangeType\x12$\n" +
 	" DATABASE_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aMIGRATE\x10\x02\x12\a\n" +
@@ -552,6 +552,204 @@ func (Changelog_Type) EnumDescriptor() ([]byte, []int) {
 	return file_v1_database_service_proto_rawDescGZIP(), []int{61, 1}
 }
 
+// The type of the database object.
+type SchemaDriftObject_Type int32
+
+const (
+	SchemaDriftObject_TYPE_UNSPECIFIED  SchemaDriftObject_Type = 0
+	SchemaDriftObject_SCHEMA            SchemaDriftObject_Type = 1
+	SchemaDriftObject_TABLE             SchemaDriftObject_Type = 2
+	SchemaDriftObject_COLUMN            SchemaDriftObject_Type = 3
+	SchemaDriftObject_INDEX             SchemaDriftObject_Type = 4
+	SchemaDriftObject_CONSTRAINT        SchemaDriftObject_Type = 5
+	SchemaDriftObject_VIEW              SchemaDriftObject_Type = 6
+	SchemaDriftObject_MATERIALIZED_VIEW SchemaDriftObject_Type = 7
+	SchemaDriftObject_FUNCTION          SchemaDriftObject_Type = 8
+	SchemaDriftObject_PROCEDURE         SchemaDriftObject_Type = 9
+	SchemaDriftObject_TRIGGER           SchemaDriftObject_Type = 10
+	SchemaDriftObject_SEQUENCE          SchemaDriftObject_Type = 11
+	SchemaDriftObject_ENUM_TYPE         SchemaDriftObject_Type = 12
+	SchemaDriftObject_EXTENSION         SchemaDriftObject_Type = 13
+	SchemaDriftObject_EVENT_TRIGGER     SchemaDriftObject_Type = 14
+	SchemaDriftObject_EVENT             SchemaDriftObject_Type = 15
+)
+
+// Enum value maps for SchemaDriftObject_Type.
+var (
+	SchemaDriftObject_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "SCHEMA",
+		2:  "TABLE",
+		3:  "COLUMN",
+		4:  "INDEX",
+		5:  "CONSTRAINT",
+		6:  "VIEW",
+		7:  "MATERIALIZED_VIEW",
+		8:  "FUNCTION",
+		9:  "PROCEDURE",
+		10: "TRIGGER",
+		11: "SEQUENCE",
+		12: "ENUM_TYPE",
+		13: "EXTENSION",
+		14: "EVENT_TRIGGER",
+		15: "EVENT",
+	}
+	SchemaDriftObject_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"SCHEMA":            1,
+		"TABLE":             2,
+		"COLUMN":            3,
+		"INDEX":             4,
+		"CONSTRAINT":        5,
+		"VIEW":              6,
+		"MATERIALIZED_VIEW": 7,
+		"FUNCTION":          8,
+		"PROCEDURE":         9,
+		"TRIGGER":           10,
+		"SEQUENCE":          11,
+		"ENUM_TYPE":         12,
+		"EXTENSION":         13,
+		"EVENT_TRIGGER":     14,
+		"EVENT":             15,
+	}
+)
+
+func (x SchemaDriftObject_Type) Enum() *SchemaDriftObject_Type {
+	p := new(SchemaDriftObject_Type)
+	*p = x
+	return p
+}
+
+func (x SchemaDriftObject_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaDriftObject_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_service_proto_enumTypes[10].Descriptor()
+}
+
+func (SchemaDriftObject_Type) Type() protoreflect.EnumType {
+	return &file_v1_database_service_proto_enumTypes[10]
+}
+
+func (x SchemaDriftObject_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaDriftObject_Type.Descriptor instead.
+func (SchemaDriftObject_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{64, 0}
+}
+
+// The kind of the drift.
+type SchemaDriftObject_Action int32
+
+const (
+	SchemaDriftObject_ACTION_UNSPECIFIED SchemaDriftObject_Action = 0
+	// The object exists in the database but not in the recorded schema.
+	SchemaDriftObject_ADDED SchemaDriftObject_Action = 1
+	// The object exists in the recorded schema but not in the database.
+	SchemaDriftObject_REMOVED SchemaDriftObject_Action = 2
+	// The object exists in both but differs.
+	SchemaDriftObject_CHANGED SchemaDriftObject_Action = 3
+)
+
+// Enum value maps for SchemaDriftObject_Action.
+var (
+	SchemaDriftObject_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ADDED",
+		2: "REMOVED",
+		3: "CHANGED",
+	}
+	SchemaDriftObject_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ADDED":              1,
+		"REMOVED":            2,
+		"CHANGED":            3,
+	}
+)
+
+func (x SchemaDriftObject_Action) Enum() *SchemaDriftObject_Action {
+	p := new(SchemaDriftObject_Action)
+	*p = x
+	return p
+}
+
+func (x SchemaDriftObject_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaDriftObject_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_service_proto_enumTypes[11].Descriptor()
+}
+
+func (SchemaDriftObject_Action) Type() protoreflect.EnumType {
+	return &file_v1_database_service_proto_enumTypes[11]
+}
+
+func (x SchemaDriftObject_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaDriftObject_Action.Descriptor instead.
+func (SchemaDriftObject_Action) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{64, 1}
+}
+
+type ReconcileSchemaDriftRequest_Strategy int32
+
+const (
+	ReconcileSchemaDriftRequest_STRATEGY_UNSPECIFIED ReconcileSchemaDriftRequest_Strategy = 0
+	// Create a plan with the statement migrating the database back to the schema recorded by the latest changelog.
+	// The plan goes through the plan checks and the approval flow as usual.
+	ReconcileSchemaDriftRequest_REVERT ReconcileSchemaDriftRequest_Strategy = 1
+	// Record the drifted schema as a baseline changelog, with the statement migrating the recorded schema
+	// to the drifted one as its content, or the drifted schema if the statement cannot be generated for the engine.
+	ReconcileSchemaDriftRequest_ADOPT ReconcileSchemaDriftRequest_Strategy = 2
+)
+
+// Enum value maps for ReconcileSchemaDriftRequest_Strategy.
+var (
+	ReconcileSchemaDriftRequest_Strategy_name = map[int32]string{
+		0: "STRATEGY_UNSPECIFIED",
+		1: "REVERT",
+		2: "ADOPT",
+	}
+	ReconcileSchemaDriftRequest_Strategy_value = map[string]int32{
+		"STRATEGY_UNSPECIFIED": 0,
+		"REVERT":               1,
+		"ADOPT":                2,
+	}
+)
+
+func (x ReconcileSchemaDriftRequest_Strategy) Enum() *ReconcileSchemaDriftRequest_Strategy {
+	p := new(ReconcileSchemaDriftRequest_Strategy)
+	*p = x
+	return p
+}
+
+func (x ReconcileSchemaDriftRequest_Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconcileSchemaDriftRequest_Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_service_proto_enumTypes[12].Descriptor()
+}
+
+func (ReconcileSchemaDriftRequest_Strategy) Type() protoreflect.EnumType {
+	return &file_v1_database_service_proto_enumTypes[12]
+}
+
+func (x ReconcileSchemaDriftRequest_Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconcileSchemaDriftRequest_Strategy.Descriptor instead.
+func (ReconcileSchemaDriftRequest_Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{65, 0}
+}
+
 type GetSchemaStringRequest_ObjectType int32
 
 const (
@@ -603,11 +801,11 @@ func (x GetSchemaStringRequest_ObjectType) String() string {
 }
 
 func (GetSchemaStringRequest_ObjectType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_database_service_proto_enumTypes[10].Descriptor()
+	return file_v1_database_service_proto_enumTypes[13].Descriptor()
 }
 
 func (GetSchemaStringRequest_ObjectType) Type() protoreflect.EnumType {
-	return &file_v1_database_service_proto_enumTypes[10]
+	return &file_v1_database_service_proto_enumTypes[13]
 }
 
 func (x GetSchemaStringRequest_ObjectType) Number() protoreflect.EnumNumber {
@@ -616,7 +814,7 @@ func (x GetSchemaStringRequest_ObjectType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetSchemaStringRequest_ObjectType.Descriptor instead.
 func (GetSchemaStringRequest_ObjectType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{62, 0}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{67, 0}
 }
 
 type GetDatabaseRequest struct {
@@ -5922,6 +6120,339 @@ func (x *Changelog) GetType() Changelog_Type {
 	return Changelog_TYPE_UNSPECIFIED
 }
 
+type GetSchemaDriftRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the database to retrieve the schema drift.
+	// Format: instances/{instance}/databases/{database}/schemaDrift
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaDriftRequest) Reset() {
+	*x = GetSchemaDriftRequest{}
+	mi := &file_v1_database_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaDriftRequest) ProtoMessage() {}
+
+func (x *GetSchemaDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaDriftRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaDriftRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetSchemaDriftRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// SchemaDrift is the object-level difference from the schema recorded by the latest changelog
+// to the synced schema of the database.
+type SchemaDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the schema drift.
+	// Format: instances/{instance}/databases/{database}/schemaDrift
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the database is drifted as of the last sync.
+	Drifted bool `protobuf:"varint,2,opt,name=drifted,proto3" json:"drifted,omitempty"`
+	// The changelog whose recorded schema the database drifted from.
+	// Format: instances/{instance}/databases/{database}/changelogs/{changelog}
+	Changelog string `protobuf:"bytes,3,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	// The time when the drift was detected.
+	DetectTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=detect_time,json=detectTime,proto3" json:"detect_time,omitempty"`
+	// The drifted objects.
+	Objects       []*SchemaDriftObject `protobuf:"bytes,5,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaDrift) Reset() {
+	*x = SchemaDrift{}
+	mi := &file_v1_database_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaDrift) ProtoMessage() {}
+
+func (x *SchemaDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaDrift.ProtoReflect.Descriptor instead.
+func (*SchemaDrift) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SchemaDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetDrifted() bool {
+	if x != nil {
+		return x.Drifted
+	}
+	return false
+}
+
+func (x *SchemaDrift) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetDetectTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectTime
+	}
+	return nil
+}
+
+func (x *SchemaDrift) GetObjects() []*SchemaDriftObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+// SchemaDriftObject is a database object drifted from the recorded schema.
+type SchemaDriftObject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the object.
+	Type SchemaDriftObject_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.v1.SchemaDriftObject_Type" json:"type,omitempty"`
+	// The kind of the drift.
+	Action SchemaDriftObject_Action `protobuf:"varint,2,opt,name=action,proto3,enum=bytebase.v1.SchemaDriftObject_Action" json:"action,omitempty"`
+	// The schema of the object, which is empty for the database-level objects.
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table of the column, index, constraint and trigger.
+	Table string `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The name of the object.
+	Name          string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaDriftObject) Reset() {
+	*x = SchemaDriftObject{}
+	mi := &file_v1_database_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaDriftObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaDriftObject) ProtoMessage() {}
+
+func (x *SchemaDriftObject) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaDriftObject.ProtoReflect.Descriptor instead.
+func (*SchemaDriftObject) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SchemaDriftObject) GetType() SchemaDriftObject_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SchemaDriftObject_TYPE_UNSPECIFIED
+}
+
+func (x *SchemaDriftObject) GetAction() SchemaDriftObject_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SchemaDriftObject_ACTION_UNSPECIFIED
+}
+
+func (x *SchemaDriftObject) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *SchemaDriftObject) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SchemaDriftObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReconcileSchemaDriftRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the schema drift to reconcile.
+	// Format: instances/{instance}/databases/{database}/schemaDrift
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The strategy to reconcile the schema drift.
+	Strategy      ReconcileSchemaDriftRequest_Strategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=bytebase.v1.ReconcileSchemaDriftRequest_Strategy" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileSchemaDriftRequest) Reset() {
+	*x = ReconcileSchemaDriftRequest{}
+	mi := &file_v1_database_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileSchemaDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileSchemaDriftRequest) ProtoMessage() {}
+
+func (x *ReconcileSchemaDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileSchemaDriftRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileSchemaDriftRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReconcileSchemaDriftRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReconcileSchemaDriftRequest) GetStrategy() ReconcileSchemaDriftRequest_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ReconcileSchemaDriftRequest_STRATEGY_UNSPECIFIED
+}
+
+type ReconcileSchemaDriftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plan reverting the schema drift, set for the REVERT strategy.
+	// Format: projects/{project}/plans/{plan}
+	Plan string `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The baseline changelog adopting the schema drift, set for the ADOPT strategy.
+	// Format: instances/{instance}/databases/{database}/changelogs/{changelog}
+	Changelog string `protobuf:"bytes,2,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	// The generated statement.
+	Statement     string `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileSchemaDriftResponse) Reset() {
+	*x = ReconcileSchemaDriftResponse{}
+	mi := &file_v1_database_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileSchemaDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileSchemaDriftResponse) ProtoMessage() {}
+
+func (x *ReconcileSchemaDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileSchemaDriftResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileSchemaDriftResponse) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReconcileSchemaDriftResponse) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *ReconcileSchemaDriftResponse) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *ReconcileSchemaDriftResponse) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
 type GetSchemaStringRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the database.
@@ -5941,7 +6472,7 @@ type GetSchemaStringRequest struct {
 
 func (x *GetSchemaStringRequest) Reset() {
 	*x = GetSchemaStringRequest{}
-	mi := &file_v1_database_service_proto_msgTypes[62]
+	mi := &file_v1_database_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5953,7 +6484,7 @@ func (x *GetSchemaStringRequest) String() string {
 func (*GetSchemaStringRequest) ProtoMessage() {}
 
 func (x *GetSchemaStringRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[62]
+	mi := &file_v1_database_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5966,7 +6497,7 @@ func (x *GetSchemaStringRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaStringRequest.ProtoReflect.Descriptor instead.
 func (*GetSchemaStringRequest) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{62}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *GetSchemaStringRequest) GetName() string {
@@ -6013,7 +6544,7 @@ type GetSchemaStringResponse struct {
 
 func (x *GetSchemaStringResponse) Reset() {
 	*x = GetSchemaStringResponse{}
-	mi := &file_v1_database_service_proto_msgTypes[63]
+	mi := &file_v1_database_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6025,7 +6556,7 @@ func (x *GetSchemaStringResponse) String() string {
 func (*GetSchemaStringResponse) ProtoMessage() {}
 
 func (x *GetSchemaStringResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[63]
+	mi := &file_v1_database_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6038,7 +6569,7 @@ func (x *GetSchemaStringResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaStringResponse.ProtoReflect.Descriptor instead.
 func (*GetSchemaStringResponse) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{63}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetSchemaStringResponse) GetSchemaString() string {
@@ -6575,7 +7106,62 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\bBASELINE\x10\x01\x12\v\n" +
 	"\aMIGRATE\x10\x02\x12\a\n" +
 	"\x03SDL\x10\x03:e\xeaAb\n" +
-	"\x1ebytebase.com/DatabaseChangelog\x12@instances/{instance}/databases/{database}/changelogs/{changelog}\"\x97\x03\n" +
+	"\x1ebytebase.com/DatabaseChangelog\x12@instances/{instance}/databases/{database}/changelogs/{changelog}\"J\n" +
+	"\x15GetSchemaDriftRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\"\xd0\x01\n" +
+	"\vSchemaDrift\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\adrifted\x18\x02 \x01(\bR\adrifted\x12\x1c\n" +
+	"\tchangelog\x18\x03 \x01(\tR\tchangelog\x12;\n" +
+	"\vdetect_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectTime\x128\n" +
+	"\aobjects\x18\x05 \x03(\v2\x1e.bytebase.v1.SchemaDriftObjectR\aobjects\"\x86\x04\n" +
+	"\x11SchemaDriftObject\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.bytebase.v1.SchemaDriftObject.TypeR\x04type\x12=\n" +
+	"\x06action\x18\x02 \x01(\x0e2%.bytebase.v1.SchemaDriftObject.ActionR\x06action\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x04 \x01(\tR\x05table\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\"\xef\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06SCHEMA\x10\x01\x12\t\n" +
+	"\x05TABLE\x10\x02\x12\n" +
+	"\n" +
+	"\x06COLUMN\x10\x03\x12\t\n" +
+	"\x05INDEX\x10\x04\x12\x0e\n" +
+	"\n" +
+	"CONSTRAINT\x10\x05\x12\b\n" +
+	"\x04VIEW\x10\x06\x12\x15\n" +
+	"\x11MATERIALIZED_VIEW\x10\a\x12\f\n" +
+	"\bFUNCTION\x10\b\x12\r\n" +
+	"\tPROCEDURE\x10\t\x12\v\n" +
+	"\aTRIGGER\x10\n" +
+	"\x12\f\n" +
+	"\bSEQUENCE\x10\v\x12\r\n" +
+	"\tENUM_TYPE\x10\f\x12\r\n" +
+	"\tEXTENSION\x10\r\x12\x11\n" +
+	"\rEVENT_TRIGGER\x10\x0e\x12\t\n" +
+	"\x05EVENT\x10\x0f\"E\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05ADDED\x10\x01\x12\v\n" +
+	"\aREMOVED\x10\x02\x12\v\n" +
+	"\aCHANGED\x10\x03\"\xe1\x01\n" +
+	"\x1bReconcileSchemaDriftRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\x12R\n" +
+	"\bstrategy\x18\x02 \x01(\x0e21.bytebase.v1.ReconcileSchemaDriftRequest.StrategyB\x03\xe0A\x02R\bstrategy\";\n" +
+	"\bStrategy\x12\x18\n" +
+	"\x14STRATEGY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06REVERT\x10\x01\x12\t\n" +
+	"\x05ADOPT\x10\x02\"n\n" +
+	"\x1cReconcileSchemaDriftResponse\x12\x12\n" +
+	"\x04plan\x18\x01 \x01(\tR\x04plan\x12\x1c\n" +
+	"\tchangelog\x18\x02 \x01(\tR\tchangelog\x12\x1c\n" +
+	"\tstatement\x18\x03 \x01(\tR\tstatement\"\x97\x03\n" +
 	"\x16GetSchemaStringRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x04name\x12B\n" +
@@ -6600,7 +7186,7 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\rChangelogView\x12\x1e\n" +
 	"\x1aCHANGELOG_VIEW_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14CHANGELOG_VIEW_BASIC\x10\x01\x12\x17\n" +
-	"\x13CHANGELOG_VIEW_FULL\x10\x022\xec\x14\n" +
+	"\x13CHANGELOG_VIEW_FULL\x10\x022\xef\x17\n" +
 	"\x0fDatabaseService\x12\x90\x01\n" +
 	"\vGetDatabase\x12\x1f.bytebase.v1.GetDatabaseRequest\x1a\x15.bytebase.v1.Database\"I\xdaA\x04name\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02$\x12\"/v1/{name=instances/*/databases/*}\x12\xdd\x01\n" +
 	"\x11BatchGetDatabases\x12%.bytebase.v1.BatchGetDatabasesRequest\x1a&.bytebase.v1.BatchGetDatabasesResponse\"y\x8a\xea0\x10bb.databases.get\x90\xea0\x02\x82\xd3\xe4\x93\x02[Z-\x12+/v1/{parent=instances/*}/databases:batchGet\x12*/v1/{parent=projects/*}/databases:batchGet\x12\xeb\x01\n" +
@@ -6615,7 +7201,9 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\n" +
 	"DiffSchema\x12\x1e.bytebase.v1.DiffSchemaRequest\x1a\x1f.bytebase.v1.DiffSchemaResponse\"\x91\x01\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02s:\x01*Z?:\x01*\":/v1/{name=instances/*/databases/*/changelogs/*}:diffSchema\"-/v1/{name=instances/*/databases/*}:diffSchema\x12\xb5\x01\n" +
 	"\x0eListChangelogs\x12\".bytebase.v1.ListChangelogsRequest\x1a#.bytebase.v1.ListChangelogsResponse\"Z\xdaA\x06parent\x8a\xea0\x12bb.changelogs.list\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{parent=instances/*/databases/*}/changelogs\x12\xa1\x01\n" +
-	"\fGetChangelog\x12 .bytebase.v1.GetChangelogRequest\x1a\x16.bytebase.v1.Changelog\"W\xdaA\x04name\x8a\xea0\x11bb.changelogs.get\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{name=instances/*/databases/*/changelogs/*}\x12\xba\x01\n" +
+	"\fGetChangelog\x12 .bytebase.v1.GetChangelogRequest\x1a\x16.bytebase.v1.Changelog\"W\xdaA\x04name\x8a\xea0\x11bb.changelogs.get\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{name=instances/*/databases/*/changelogs/*}\x12\xab\x01\n" +
+	"\x0eGetSchemaDrift\x12\".bytebase.v1.GetSchemaDriftRequest\x1a\x18.bytebase.v1.SchemaDrift\"[\xdaA\x04name\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x020\x12./v1/{name=instances/*/databases/*/schemaDrift}\x12\xd2\x01\n" +
+	"\x14ReconcileSchemaDrift\x12(.bytebase.v1.ReconcileSchemaDriftRequest\x1a).bytebase.v1.ReconcileSchemaDriftResponse\"e\xdaA\x04name\x8a\xea0\x13bb.databases.update\x90\xea0\x01\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/{name=instances/*/databases/*/schemaDrift}:reconcile\x12\xba\x01\n" +
 	"\x0fGetSchemaString\x12#.bytebase.v1.GetSchemaStringRequest\x1a$.bytebase.v1.GetSchemaStringResponse\"\\\xdaA\x04name\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{name=instances/*/databases/*/schemaString}B\xaa\x01\n" +
 	"\x0fcom.bytebase.v1B\x14DatabaseServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
@@ -6631,8 +7219,8 @@ func file_v1_database_service_proto_rawDescGZIP() []byte {
 	return file_v1_database_service_proto_rawDescData
 }
 
-var file_v1_database_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_v1_database_service_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_v1_database_service_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_v1_database_service_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_v1_database_service_proto_goTypes = []any{
 	(ChangelogView)(0),                         // 0: bytebase.v1.ChangelogView
 	(GetDatabaseSDLSchemaRequest_SDLFormat)(0), // 1: bytebase.v1.GetDatabaseSDLSchemaRequest.SDLFormat
@@ -6644,187 +7232,204 @@ var file_v1_database_service_proto_goTypes = []any{
 	(StreamMetadata_Mode)(0),                   // 7: bytebase.v1.StreamMetadata.Mode
 	(Changelog_Status)(0),                      // 8: bytebase.v1.Changelog.Status
 	(Changelog_Type)(0),                        // 9: bytebase.v1.Changelog.Type
-	(GetSchemaStringRequest_ObjectType)(0),     // 10: bytebase.v1.GetSchemaStringRequest.ObjectType
-	(*GetDatabaseRequest)(nil),                 // 11: bytebase.v1.GetDatabaseRequest
-	(*BatchGetDatabasesRequest)(nil),           // 12: bytebase.v1.BatchGetDatabasesRequest
-	(*BatchGetDatabasesResponse)(nil),          // 13: bytebase.v1.BatchGetDatabasesResponse
-	(*ListDatabasesRequest)(nil),               // 14: bytebase.v1.ListDatabasesRequest
-	(*ListDatabasesResponse)(nil),              // 15: bytebase.v1.ListDatabasesResponse
-	(*UpdateDatabaseRequest)(nil),              // 16: bytebase.v1.UpdateDatabaseRequest
-	(*BatchUpdateDatabasesRequest)(nil),        // 17: bytebase.v1.BatchUpdateDatabasesRequest
-	(*BatchUpdateDatabasesResponse)(nil),       // 18: bytebase.v1.BatchUpdateDatabasesResponse
-	(*BatchSyncDatabasesRequest)(nil),          // 19: bytebase.v1.BatchSyncDatabasesRequest
-	(*BatchSyncDatabasesResponse)(nil),         // 20: bytebase.v1.BatchSyncDatabasesResponse
-	(*SyncDatabaseRequest)(nil),                // 21: bytebase.v1.SyncDatabaseRequest
-	(*SyncDatabaseResponse)(nil),               // 22: bytebase.v1.SyncDatabaseResponse
-	(*GetDatabaseMetadataRequest)(nil),         // 23: bytebase.v1.GetDatabaseMetadataRequest
-	(*GetDatabaseSchemaRequest)(nil),           // 24: bytebase.v1.GetDatabaseSchemaRequest
-	(*GetDatabaseSDLSchemaRequest)(nil),        // 25: bytebase.v1.GetDatabaseSDLSchemaRequest
-	(*DiffSchemaRequest)(nil),                  // 26: bytebase.v1.DiffSchemaRequest
-	(*DiffSchemaResponse)(nil),                 // 27: bytebase.v1.DiffSchemaResponse
-	(*Database)(nil),                           // 28: bytebase.v1.Database
-	(*DatabaseMetadata)(nil),                   // 29: bytebase.v1.DatabaseMetadata
-	(*SchemaMetadata)(nil),                     // 30: bytebase.v1.SchemaMetadata
-	(*EnumTypeMetadata)(nil),                   // 31: bytebase.v1.EnumTypeMetadata
-	(*EventMetadata)(nil),                      // 32: bytebase.v1.EventMetadata
-	(*SequenceMetadata)(nil),                   // 33: bytebase.v1.SequenceMetadata
-	(*TriggerMetadata)(nil),                    // 34: bytebase.v1.TriggerMetadata
-	(*ExternalTableMetadata)(nil),              // 35: bytebase.v1.ExternalTableMetadata
-	(*TableMetadata)(nil),                      // 36: bytebase.v1.TableMetadata
-	(*CheckConstraintMetadata)(nil),            // 37: bytebase.v1.CheckConstraintMetadata
-	(*TablePartitionMetadata)(nil),             // 38: bytebase.v1.TablePartitionMetadata
-	(*ColumnMetadata)(nil),                     // 39: bytebase.v1.ColumnMetadata
-	(*GenerationMetadata)(nil),                 // 40: bytebase.v1.GenerationMetadata
-	(*ViewMetadata)(nil),                       // 41: bytebase.v1.ViewMetadata
-	(*DependencyColumn)(nil),                   // 42: bytebase.v1.DependencyColumn
-	(*MaterializedViewMetadata)(nil),           // 43: bytebase.v1.MaterializedViewMetadata
-	(*DependencyTable)(nil),                    // 44: bytebase.v1.DependencyTable
-	(*FunctionMetadata)(nil),                   // 45: bytebase.v1.FunctionMetadata
-	(*ProcedureMetadata)(nil),                  // 46: bytebase.v1.ProcedureMetadata
-	(*PackageMetadata)(nil),                    // 47: bytebase.v1.PackageMetadata
-	(*TaskMetadata)(nil),                       // 48: bytebase.v1.TaskMetadata
-	(*StreamMetadata)(nil),                     // 49: bytebase.v1.StreamMetadata
-	(*SpatialIndexConfig)(nil),                 // 50: bytebase.v1.SpatialIndexConfig
-	(*TessellationConfig)(nil),                 // 51: bytebase.v1.TessellationConfig
-	(*GridLevel)(nil),                          // 52: bytebase.v1.GridLevel
-	(*BoundingBox)(nil),                        // 53: bytebase.v1.BoundingBox
-	(*StorageConfig)(nil),                      // 54: bytebase.v1.StorageConfig
-	(*DimensionalConfig)(nil),                  // 55: bytebase.v1.DimensionalConfig
-	(*DimensionConstraint)(nil),                // 56: bytebase.v1.DimensionConstraint
-	(*IndexMetadata)(nil),                      // 57: bytebase.v1.IndexMetadata
-	(*ExtensionMetadata)(nil),                  // 58: bytebase.v1.ExtensionMetadata
-	(*ForeignKeyMetadata)(nil),                 // 59: bytebase.v1.ForeignKeyMetadata
-	(*DatabaseSchema)(nil),                     // 60: bytebase.v1.DatabaseSchema
-	(*DatabaseSDLSchema)(nil),                  // 61: bytebase.v1.DatabaseSDLSchema
-	(*ChangedResources)(nil),                   // 62: bytebase.v1.ChangedResources
-	(*ChangedResourceDatabase)(nil),            // 63: bytebase.v1.ChangedResourceDatabase
-	(*ChangedResourceSchema)(nil),              // 64: bytebase.v1.ChangedResourceSchema
-	(*ChangedResourceTable)(nil),               // 65: bytebase.v1.ChangedResourceTable
-	(*ChangedResourceView)(nil),                // 66: bytebase.v1.ChangedResourceView
-	(*ChangedResourceFunction)(nil),            // 67: bytebase.v1.ChangedResourceFunction
-	(*ChangedResourceProcedure)(nil),           // 68: bytebase.v1.ChangedResourceProcedure
-	(*ListChangelogsRequest)(nil),              // 69: bytebase.v1.ListChangelogsRequest
-	(*ListChangelogsResponse)(nil),             // 70: bytebase.v1.ListChangelogsResponse
-	(*GetChangelogRequest)(nil),                // 71: bytebase.v1.GetChangelogRequest
-	(*Changelog)(nil),                          // 72: bytebase.v1.Changelog
-	(*GetSchemaStringRequest)(nil),             // 73: bytebase.v1.GetSchemaStringRequest
-	(*GetSchemaStringResponse)(nil),            // 74: bytebase.v1.GetSchemaStringResponse
-	nil,                                        // 75: bytebase.v1.Database.LabelsEntry
-	(*fieldmaskpb.FieldMask)(nil),              // 76: google.protobuf.FieldMask
-	(State)(0),                                 // 77: bytebase.v1.State
-	(*timestamppb.Timestamp)(nil),              // 78: google.protobuf.Timestamp
-	(*InstanceResource)(nil),                   // 79: bytebase.v1.InstanceResource
-	(*Range)(nil),                              // 80: bytebase.v1.Range
+	(SchemaDriftObject_Type)(0),                // 10: bytebase.v1.SchemaDriftObject.Type
+	(SchemaDriftObject_Action)(0),              // 11: bytebase.v1.SchemaDriftObject.Action
+	(ReconcileSchemaDriftRequest_Strategy)(0),  // 12: bytebase.v1.ReconcileSchemaDriftRequest.Strategy
+	(GetSchemaStringRequest_ObjectType)(0),     // 13: bytebase.v1.GetSchemaStringRequest.ObjectType
+	(*GetDatabaseRequest)(nil),                 // 14: bytebase.v1.GetDatabaseRequest
+	(*BatchGetDatabasesRequest)(nil),           // 15: bytebase.v1.BatchGetDatabasesRequest
+	(*BatchGetDatabasesResponse)(nil),          // 16: bytebase.v1.BatchGetDatabasesResponse
+	(*ListDatabasesRequest)(nil),               // 17: bytebase.v1.ListDatabasesRequest
+	(*ListDatabasesResponse)(nil),              // 18: bytebase.v1.ListDatabasesResponse
+	(*UpdateDatabaseRequest)(nil),              // 19: bytebase.v1.UpdateDatabaseRequest
+	(*BatchUpdateDatabasesRequest)(nil),        // 20: bytebase.v1.BatchUpdateDatabasesRequest
+	(*BatchUpdateDatabasesResponse)(nil),       // 21: bytebase.v1.BatchUpdateDatabasesResponse
+	(*BatchSyncDatabasesRequest)(nil),          // 22: bytebase.v1.BatchSyncDatabasesRequest
+	(*BatchSyncDatabasesResponse)(nil),         // 23: bytebase.v1.BatchSyncDatabasesResponse
+	(*SyncDatabaseRequest)(nil),                // 24: bytebase.v1.SyncDatabaseRequest
+	(*SyncDatabaseResponse)(nil),               // 25: bytebase.v1.SyncDatabaseResponse
+	(*GetDatabaseMetadataRequest)(nil),         // 26: bytebase.v1.GetDatabaseMetadataRequest
+	(*GetDatabaseSchemaRequest)(nil),           // 27: bytebase.v1.GetDatabaseSchemaRequest
+	(*GetDatabaseSDLSchemaRequest)(nil),        // 28: bytebase.v1.GetDatabaseSDLSchemaRequest
+	(*DiffSchemaRequest)(nil),                  // 29: bytebase.v1.DiffSchemaRequest
+	(*DiffSchemaResponse)(nil),                 // 30: bytebase.v1.DiffSchemaResponse
+	(*Database)(nil),                           // 31: bytebase.v1.Database
+	(*DatabaseMetadata)(nil),                   // 32: bytebase.v1.DatabaseMetadata
+	(*SchemaMetadata)(nil),                     // 33: bytebase.v1.SchemaMetadata
+	(*EnumTypeMetadata)(nil),                   // 34: bytebase.v1.EnumTypeMetadata
+	(*EventMetadata)(nil),                      // 35: bytebase.v1.EventMetadata
+	(*SequenceMetadata)(nil),                   // 36: bytebase.v1.SequenceMetadata
+	(*TriggerMetadata)(nil),                    // 37: bytebase.v1.TriggerMetadata
+	(*ExternalTableMetadata)(nil),              // 38: bytebase.v1.ExternalTableMetadata
+	(*TableMetadata)(nil),                      // 39: bytebase.v1.TableMetadata
+	(*CheckConstraintMetadata)(nil),            // 40: bytebase.v1.CheckConstraintMetadata
+	(*TablePartitionMetadata)(nil),             // 41: bytebase.v1.TablePartitionMetadata
+	(*ColumnMetadata)(nil),                     // 42: bytebase.v1.ColumnMetadata
+	(*GenerationMetadata)(nil),                 // 43: bytebase.v1.GenerationMetadata
+	(*ViewMetadata)(nil),                       // 44: bytebase.v1.ViewMetadata
+	(*DependencyColumn)(nil),                   // 45: bytebase.v1.DependencyColumn
+	(*MaterializedViewMetadata)(nil),           // 46: bytebase.v1.MaterializedViewMetadata
+	(*DependencyTable)(nil),                    // 47: bytebase.v1.DependencyTable
+	(*FunctionMetadata)(nil),                   // 48: bytebase.v1.FunctionMetadata
+	(*ProcedureMetadata)(nil),                  // 49: bytebase.v1.ProcedureMetadata
+	(*PackageMetadata)(nil),                    // 50: bytebase.v1.PackageMetadata
+	(*TaskMetadata)(nil),                       // 51: bytebase.v1.TaskMetadata
+	(*StreamMetadata)(nil),                     // 52: bytebase.v1.StreamMetadata
+	(*SpatialIndexConfig)(nil),                 // 53: bytebase.v1.SpatialIndexConfig
+	(*TessellationConfig)(nil),                 // 54: bytebase.v1.TessellationConfig
+	(*GridLevel)(nil),                          // 55: bytebase.v1.GridLevel
+	(*BoundingBox)(nil),                        // 56: bytebase.v1.BoundingBox
+	(*StorageConfig)(nil),                      // 57: bytebase.v1.StorageConfig
+	(*DimensionalConfig)(nil),                  // 58: bytebase.v1.DimensionalConfig
+	(*DimensionConstraint)(nil),                // 59: bytebase.v1.DimensionConstraint
+	(*IndexMetadata)(nil),                      // 60: bytebase.v1.IndexMetadata
+	(*ExtensionMetadata)(nil),                  // 61: bytebase.v1.ExtensionMetadata
+	(*ForeignKeyMetadata)(nil),                 // 62: bytebase.v1.ForeignKeyMetadata
+	(*DatabaseSchema)(nil),                     // 63: bytebase.v1.DatabaseSchema
+	(*DatabaseSDLSchema)(nil),                  // 64: bytebase.v1.DatabaseSDLSchema
+	(*ChangedResources)(nil),                   // 65: bytebase.v1.ChangedResources
+	(*ChangedResourceDatabase)(nil),            // 66: bytebase.v1.ChangedResourceDatabase
+	(*ChangedResourceSchema)(nil),              // 67: bytebase.v1.ChangedResourceSchema
+	(*ChangedResourceTable)(nil),               // 68: bytebase.v1.ChangedResourceTable
+	(*ChangedResourceView)(nil),                // 69: bytebase.v1.ChangedResourceView
+	(*ChangedResourceFunction)(nil),            // 70: bytebase.v1.ChangedResourceFunction
+	(*ChangedResourceProcedure)(nil),           // 71: bytebase.v1.ChangedResourceProcedure
+	(*ListChangelogsRequest)(nil),              // 72: bytebase.v1.ListChangelogsRequest
+	(*ListChangelogsResponse)(nil),             // 73: bytebase.v1.ListChangelogsResponse
+	(*GetChangelogRequest)(nil),                // 74: bytebase.v1.GetChangelogRequest
+	(*Changelog)(nil),                          // 75: bytebase.v1.Changelog
+	(*GetSchemaDriftRequest)(nil),              // 76: bytebase.v1.GetSchemaDriftRequest
+	(*SchemaDrift)(nil),                        // 77: bytebase.v1.SchemaDrift
+	(*SchemaDriftObject)(nil),                  // 78: bytebase.v1.SchemaDriftObject
+	(*ReconcileSchemaDriftRequest)(nil),        // 79: bytebase.v1.ReconcileSchemaDriftRequest
+	(*ReconcileSchemaDriftResponse)(nil),       // 80: bytebase.v1.ReconcileSchemaDriftResponse
+	(*GetSchemaStringRequest)(nil),             // 81: bytebase.v1.GetSchemaStringRequest
+	(*GetSchemaStringResponse)(nil),            // 82: bytebase.v1.GetSchemaStringResponse
+	nil,                                        // 83: bytebase.v1.Database.LabelsEntry
+	(*fieldmaskpb.FieldMask)(nil),              // 84: google.protobuf.FieldMask
+	(State)(0),                                 // 85: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),              // 86: google.protobuf.Timestamp
+	(*InstanceResource)(nil),                   // 87: bytebase.v1.InstanceResource
+	(*Range)(nil),                              // 88: bytebase.v1.Range
 }
 var file_v1_database_service_proto_depIdxs = []int32{
-	28, // 0: bytebase.v1.BatchGetDatabasesResponse.databases:type_name -> bytebase.v1.Database
-	28, // 1: bytebase.v1.ListDatabasesResponse.databases:type_name -> bytebase.v1.Database
-	28, // 2: bytebase.v1.UpdateDatabaseRequest.database:type_name -> bytebase.v1.Database
-	76, // 3: bytebase.v1.UpdateDatabaseRequest.update_mask:type_name -> google.protobuf.FieldMask
-	16, // 4: bytebase.v1.BatchUpdateDatabasesRequest.requests:type_name -> bytebase.v1.UpdateDatabaseRequest
-	28, // 5: bytebase.v1.BatchUpdateDatabasesResponse.databases:type_name -> bytebase.v1.Database
+	31, // 0: bytebase.v1.BatchGetDatabasesResponse.databases:type_name -> bytebase.v1.Database
+	31, // 1: bytebase.v1.ListDatabasesResponse.databases:type_name -> bytebase.v1.Database
+	31, // 2: bytebase.v1.UpdateDatabaseRequest.database:type_name -> bytebase.v1.Database
+	84, // 3: bytebase.v1.UpdateDatabaseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 4: bytebase.v1.BatchUpdateDatabasesRequest.requests:type_name -> bytebase.v1.UpdateDatabaseRequest
+	31, // 5: bytebase.v1.BatchUpdateDatabasesResponse.databases:type_name -> bytebase.v1.Database
 	1,  // 6: bytebase.v1.GetDatabaseSDLSchemaRequest.format:type_name -> bytebase.v1.GetDatabaseSDLSchemaRequest.SDLFormat
-	77, // 7: bytebase.v1.Database.state:type_name -> bytebase.v1.State
-	78, // 8: bytebase.v1.Database.successful_sync_time:type_name -> google.protobuf.Timestamp
-	75, // 9: bytebase.v1.Database.labels:type_name -> bytebase.v1.Database.LabelsEntry
-	79, // 10: bytebase.v1.Database.instance_resource:type_name -> bytebase.v1.InstanceResource
-	30, // 11: bytebase.v1.DatabaseMetadata.schemas:type_name -> bytebase.v1.SchemaMetadata
-	58, // 12: bytebase.v1.DatabaseMetadata.extensions:type_name -> bytebase.v1.ExtensionMetadata
-	36, // 13: bytebase.v1.SchemaMetadata.tables:type_name -> bytebase.v1.TableMetadata
-	35, // 14: bytebase.v1.SchemaMetadata.external_tables:type_name -> bytebase.v1.ExternalTableMetadata
-	41, // 15: bytebase.v1.SchemaMetadata.views:type_name -> bytebase.v1.ViewMetadata
-	45, // 16: bytebase.v1.SchemaMetadata.functions:type_name -> bytebase.v1.FunctionMetadata
-	46, // 17: bytebase.v1.SchemaMetadata.procedures:type_name -> bytebase.v1.ProcedureMetadata
-	49, // 18: bytebase.v1.SchemaMetadata.streams:type_name -> bytebase.v1.StreamMetadata
-	48, // 19: bytebase.v1.SchemaMetadata.tasks:type_name -> bytebase.v1.TaskMetadata
-	43, // 20: bytebase.v1.SchemaMetadata.materialized_views:type_name -> bytebase.v1.MaterializedViewMetadata
-	47, // 21: bytebase.v1.SchemaMetadata.packages:type_name -> bytebase.v1.PackageMetadata
-	33, // 22: bytebase.v1.SchemaMetadata.sequences:type_name -> bytebase.v1.SequenceMetadata
-	32, // 23: bytebase.v1.SchemaMetadata.events:type_name -> bytebase.v1.EventMetadata
-	31, // 24: bytebase.v1.SchemaMetadata.enum_types:type_name -> bytebase.v1.EnumTypeMetadata
-	39, // 25: bytebase.v1.ExternalTableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
-	39, // 26: bytebase.v1.TableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
-	57, // 27: bytebase.v1.TableMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
-	59, // 28: bytebase.v1.TableMetadata.foreign_keys:type_name -> bytebase.v1.ForeignKeyMetadata
-	38, // 29: bytebase.v1.TableMetadata.partitions:type_name -> bytebase.v1.TablePartitionMetadata
-	37, // 30: bytebase.v1.TableMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
-	34, // 31: bytebase.v1.TableMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
+	85, // 7: bytebase.v1.Database.state:type_name -> bytebase.v1.State
+	86, // 8: bytebase.v1.Database.successful_sync_time:type_name -> google.protobuf.Timestamp
+	83, // 9: bytebase.v1.Database.labels:type_name -> bytebase.v1.Database.LabelsEntry
+	87, // 10: bytebase.v1.Database.instance_resource:type_name -> bytebase.v1.InstanceResource
+	33, // 11: bytebase.v1.DatabaseMetadata.schemas:type_name -> bytebase.v1.SchemaMetadata
+	61, // 12: bytebase.v1.DatabaseMetadata.extensions:type_name -> bytebase.v1.ExtensionMetadata
+	39, // 13: bytebase.v1.SchemaMetadata.tables:type_name -> bytebase.v1.TableMetadata
+	38, // 14: bytebase.v1.SchemaMetadata.external_tables:type_name -> bytebase.v1.ExternalTableMetadata
+	44, // 15: bytebase.v1.SchemaMetadata.views:type_name -> bytebase.v1.ViewMetadata
+	48, // 16: bytebase.v1.SchemaMetadata.functions:type_name -> bytebase.v1.FunctionMetadata
+	49, // 17: bytebase.v1.SchemaMetadata.procedures:type_name -> bytebase.v1.ProcedureMetadata
+	52, // 18: bytebase.v1.SchemaMetadata.streams:type_name -> bytebase.v1.StreamMetadata
+	51, // 19: bytebase.v1.SchemaMetadata.tasks:type_name -> bytebase.v1.TaskMetadata
+	46, // 20: bytebase.v1.SchemaMetadata.materialized_views:type_name -> bytebase.v1.MaterializedViewMetadata
+	50, // 21: bytebase.v1.SchemaMetadata.packages:type_name -> bytebase.v1.PackageMetadata
+	36, // 22: bytebase.v1.SchemaMetadata.sequences:type_name -> bytebase.v1.SequenceMetadata
+	35, // 23: bytebase.v1.SchemaMetadata.events:type_name -> bytebase.v1.EventMetadata
+	34, // 24: bytebase.v1.SchemaMetadata.enum_types:type_name -> bytebase.v1.EnumTypeMetadata
+	42, // 25: bytebase.v1.ExternalTableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
+	42, // 26: bytebase.v1.TableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
+	60, // 27: bytebase.v1.TableMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
+	62, // 28: bytebase.v1.TableMetadata.foreign_keys:type_name -> bytebase.v1.ForeignKeyMetadata
+	41, // 29: bytebase.v1.TableMetadata.partitions:type_name -> bytebase.v1.TablePartitionMetadata
+	40, // 30: bytebase.v1.TableMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
+	37, // 31: bytebase.v1.TableMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
 	2,  // 32: bytebase.v1.TablePartitionMetadata.type:type_name -> bytebase.v1.TablePartitionMetadata.Type
-	38, // 33: bytebase.v1.TablePartitionMetadata.subpartitions:type_name -> bytebase.v1.TablePartitionMetadata
-	57, // 34: bytebase.v1.TablePartitionMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
-	37, // 35: bytebase.v1.TablePartitionMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
-	40, // 36: bytebase.v1.ColumnMetadata.generation:type_name -> bytebase.v1.GenerationMetadata
+	41, // 33: bytebase.v1.TablePartitionMetadata.subpartitions:type_name -> bytebase.v1.TablePartitionMetadata
+	60, // 34: bytebase.v1.TablePartitionMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
+	40, // 35: bytebase.v1.TablePartitionMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
+	43, // 36: bytebase.v1.ColumnMetadata.generation:type_name -> bytebase.v1.GenerationMetadata
 	3,  // 37: bytebase.v1.ColumnMetadata.identity_generation:type_name -> bytebase.v1.ColumnMetadata.IdentityGeneration
 	4,  // 38: bytebase.v1.GenerationMetadata.type:type_name -> bytebase.v1.GenerationMetadata.Type
-	42, // 39: bytebase.v1.ViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
-	39, // 40: bytebase.v1.ViewMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
-	34, // 41: bytebase.v1.ViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
-	42, // 42: bytebase.v1.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
-	34, // 43: bytebase.v1.MaterializedViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
-	57, // 44: bytebase.v1.MaterializedViewMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
-	44, // 45: bytebase.v1.FunctionMetadata.dependency_tables:type_name -> bytebase.v1.DependencyTable
+	45, // 39: bytebase.v1.ViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
+	42, // 40: bytebase.v1.ViewMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
+	37, // 41: bytebase.v1.ViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
+	45, // 42: bytebase.v1.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
+	37, // 43: bytebase.v1.MaterializedViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
+	60, // 44: bytebase.v1.MaterializedViewMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
+	47, // 45: bytebase.v1.FunctionMetadata.dependency_tables:type_name -> bytebase.v1.DependencyTable
 	5,  // 46: bytebase.v1.TaskMetadata.state:type_name -> bytebase.v1.TaskMetadata.State
 	6,  // 47: bytebase.v1.StreamMetadata.type:type_name -> bytebase.v1.StreamMetadata.Type
 	7,  // 48: bytebase.v1.StreamMetadata.mode:type_name -> bytebase.v1.StreamMetadata.Mode
-	51, // 49: bytebase.v1.SpatialIndexConfig.tessellation:type_name -> bytebase.v1.TessellationConfig
-	54, // 50: bytebase.v1.SpatialIndexConfig.storage:type_name -> bytebase.v1.StorageConfig
-	55, // 51: bytebase.v1.SpatialIndexConfig.dimensional:type_name -> bytebase.v1.DimensionalConfig
-	52, // 52: bytebase.v1.TessellationConfig.grid_levels:type_name -> bytebase.v1.GridLevel
-	53, // 53: bytebase.v1.TessellationConfig.bounding_box:type_name -> bytebase.v1.BoundingBox
-	56, // 54: bytebase.v1.DimensionalConfig.constraints:type_name -> bytebase.v1.DimensionConstraint
-	50, // 55: bytebase.v1.IndexMetadata.spatial_config:type_name -> bytebase.v1.SpatialIndexConfig
-	63, // 56: bytebase.v1.ChangedResources.databases:type_name -> bytebase.v1.ChangedResourceDatabase
-	64, // 57: bytebase.v1.ChangedResourceDatabase.schemas:type_name -> bytebase.v1.ChangedResourceSchema
-	65, // 58: bytebase.v1.ChangedResourceSchema.tables:type_name -> bytebase.v1.ChangedResourceTable
-	66, // 59: bytebase.v1.ChangedResourceSchema.views:type_name -> bytebase.v1.ChangedResourceView
-	67, // 60: bytebase.v1.ChangedResourceSchema.functions:type_name -> bytebase.v1.ChangedResourceFunction
-	68, // 61: bytebase.v1.ChangedResourceSchema.procedures:type_name -> bytebase.v1.ChangedResourceProcedure
-	80, // 62: bytebase.v1.ChangedResourceTable.ranges:type_name -> bytebase.v1.Range
-	80, // 63: bytebase.v1.ChangedResourceView.ranges:type_name -> bytebase.v1.Range
-	80, // 64: bytebase.v1.ChangedResourceFunction.ranges:type_name -> bytebase.v1.Range
-	80, // 65: bytebase.v1.ChangedResourceProcedure.ranges:type_name -> bytebase.v1.Range
+	54, // 49: bytebase.v1.SpatialIndexConfig.tessellation:type_name -> bytebase.v1.TessellationConfig
+	57, // 50: bytebase.v1.SpatialIndexConfig.storage:type_name -> bytebase.v1.StorageConfig
+	58, // 51: bytebase.v1.SpatialIndexConfig.dimensional:type_name -> bytebase.v1.DimensionalConfig
+	55, // 52: bytebase.v1.TessellationConfig.grid_levels:type_name -> bytebase.v1.GridLevel
+	56, // 53: bytebase.v1.TessellationConfig.bounding_box:type_name -> bytebase.v1.BoundingBox
+	59, // 54: bytebase.v1.DimensionalConfig.constraints:type_name -> bytebase.v1.DimensionConstraint
+	53, // 55: bytebase.v1.IndexMetadata.spatial_config:type_name -> bytebase.v1.SpatialIndexConfig
+	66, // 56: bytebase.v1.ChangedResources.databases:type_name -> bytebase.v1.ChangedResourceDatabase
+	67, // 57: bytebase.v1.ChangedResourceDatabase.schemas:type_name -> bytebase.v1.ChangedResourceSchema
+	68, // 58: bytebase.v1.ChangedResourceSchema.tables:type_name -> bytebase.v1.ChangedResourceTable
+	69, // 59: bytebase.v1.ChangedResourceSchema.views:type_name -> bytebase.v1.ChangedResourceView
+	70, // 60: bytebase.v1.ChangedResourceSchema.functions:type_name -> bytebase.v1.ChangedResourceFunction
+	71, // 61: bytebase.v1.ChangedResourceSchema.procedures:type_name -> bytebase.v1.ChangedResourceProcedure
+	88, // 62: bytebase.v1.ChangedResourceTable.ranges:type_name -> bytebase.v1.Range
+	88, // 63: bytebase.v1.ChangedResourceView.ranges:type_name -> bytebase.v1.Range
+	88, // 64: bytebase.v1.ChangedResourceFunction.ranges:type_name -> bytebase.v1.Range
+	88, // 65: bytebase.v1.ChangedResourceProcedure.ranges:type_name -> bytebase.v1.Range
 	0,  // 66: bytebase.v1.ListChangelogsRequest.view:type_name -> bytebase.v1.ChangelogView
-	72, // 67: bytebase.v1.ListChangelogsResponse.changelogs:type_name -> bytebase.v1.Changelog
+	75, // 67: bytebase.v1.ListChangelogsResponse.changelogs:type_name -> bytebase.v1.Changelog
 	0,  // 68: bytebase.v1.GetChangelogRequest.view:type_name -> bytebase.v1.ChangelogView
-	78, // 69: bytebase.v1.Changelog.create_time:type_name -> google.protobuf.Timestamp
+	86, // 69: bytebase.v1.Changelog.create_time:type_name -> google.protobuf.Timestamp
 	8,  // 70: bytebase.v1.Changelog.status:type_name -> bytebase.v1.Changelog.Status
-	62, // 71: bytebase.v1.Changelog.changed_resources:type_name -> bytebase.v1.ChangedResources
+	65, // 71: bytebase.v1.Changelog.changed_resources:type_name -> bytebase.v1.ChangedResources
 	9,  // 72: bytebase.v1.Changelog.type:type_name -> bytebase.v1.Changelog.Type
-	10, // 73: bytebase.v1.GetSchemaStringRequest.type:type_name -> bytebase.v1.GetSchemaStringRequest.ObjectType
-	29, // 74: bytebase.v1.GetSchemaStringRequest.metadata:type_name -> bytebase.v1.DatabaseMetadata
-	11, // 75: bytebase.v1.DatabaseService.GetDatabase:input_type -> bytebase.v1.GetDatabaseRequest
-	12, // 76: bytebase.v1.DatabaseService.BatchGetDatabases:input_type -> bytebase.v1.BatchGetDatabasesRequest
-	14, // 77: bytebase.v1.DatabaseService.ListDatabases:input_type -> bytebase.v1.ListDatabasesRequest
-	16, // 78: bytebase.v1.DatabaseService.UpdateDatabase:input_type -> bytebase.v1.UpdateDatabaseRequest
-	17, // 79: bytebase.v1.DatabaseService.BatchUpdateDatabases:input_type -> bytebase.v1.BatchUpdateDatabasesRequest
-	21, // 80: bytebase.v1.DatabaseService.SyncDatabase:input_type -> bytebase.v1.SyncDatabaseRequest
-	19, // 81: bytebase.v1.DatabaseService.BatchSyncDatabases:input_type -> bytebase.v1.BatchSyncDatabasesRequest
-	23, // 82: bytebase.v1.DatabaseService.GetDatabaseMetadata:input_type -> bytebase.v1.GetDatabaseMetadataRequest
-	24, // 83: bytebase.v1.DatabaseService.GetDatabaseSchema:input_type -> bytebase.v1.GetDatabaseSchemaRequest
-	25, // 84: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:input_type -> bytebase.v1.GetDatabaseSDLSchemaRequest
-	26, // 85: bytebase.v1.DatabaseService.DiffSchema:input_type -> bytebase.v1.DiffSchemaRequest
-	69, // 86: bytebase.v1.DatabaseService.ListChangelogs:input_type -> bytebase.v1.ListChangelogsRequest
-	71, // 87: bytebase.v1.DatabaseService.GetChangelog:input_type -> bytebase.v1.GetChangelogRequest
-	73, // 88: bytebase.v1.DatabaseService.GetSchemaString:input_type -> bytebase.v1.GetSchemaStringRequest
-	28, // 89: bytebase.v1.DatabaseService.GetDatabase:output_type -> bytebase.v1.Database
-	13, // 90: bytebase.v1.DatabaseService.BatchGetDatabases:output_type -> bytebase.v1.BatchGetDatabasesResponse
-	15, // 91: bytebase.v1.DatabaseService.ListDatabases:output_type -> bytebase.v1.ListDatabasesResponse
-	28, // 92: bytebase.v1.DatabaseService.UpdateDatabase:output_type -> bytebase.v1.Database
-	18, // 93: bytebase.v1.DatabaseService.BatchUpdateDatabases:output_type -> bytebase.v1.BatchUpdateDatabasesResponse
-	22, // 94: bytebase.v1.DatabaseService.SyncDatabase:output_type -> bytebase.v1.SyncDatabaseResponse
-	20, // 95: bytebase.v1.DatabaseService.BatchSyncDatabases:output_type -> bytebase.v1.BatchSyncDatabasesResponse
-	29, // 96: bytebase.v1.DatabaseService.GetDatabaseMetadata:output_type -> bytebase.v1.DatabaseMetadata
-	60, // 97: bytebase.v1.DatabaseService.GetDatabaseSchema:output_type -> bytebase.v1.DatabaseSchema
-	61, // 98: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:output_type -> bytebase.v1.DatabaseSDLSchema
-	27, // 99: bytebase.v1.DatabaseService.DiffSchema:output_type -> bytebase.v1.DiffSchemaResponse
-	70, // 100: bytebase.v1.DatabaseService.ListChangelogs:output_type -> bytebase.v1.ListChangelogsResponse
-	72, // 101: bytebase.v1.DatabaseService.GetChangelog:output_type -> bytebase.v1.Changelog
-	74, // 102: bytebase.v1.DatabaseService.GetSchemaString:output_type -> bytebase.v1.GetSchemaStringResponse
-	89, // [89:103] is the sub-list for method output_type
-	75, // [75:89] is the sub-list for method input_type
-	75, // [75:75] is the sub-list for extension type_name
-	75, // [75:75] is the sub-list for extension extendee
-	0,  // [0:75] is the sub-list for field type_name
+	86, // 73: bytebase.v1.SchemaDrift.detect_time:type_name -> google.protobuf.Timestamp
+	78, // 74: bytebase.v1.SchemaDrift.objects:type_name -> bytebase.v1.SchemaDriftObject
+	10, // 75: bytebase.v1.SchemaDriftObject.type:type_name -> bytebase.v1.SchemaDriftObject.Type
+	11, // 76: bytebase.v1.SchemaDriftObject.action:type_name -> bytebase.v1.SchemaDriftObject.Action
+	12, // 77: bytebase.v1.ReconcileSchemaDriftRequest.strategy:type_name -> bytebase.v1.ReconcileSchemaDriftRequest.Strategy
+	13, // 78: bytebase.v1.GetSchemaStringRequest.type:type_name -> bytebase.v1.GetSchemaStringRequest.ObjectType
+	32, // 79: bytebase.v1.GetSchemaStringRequest.metadata:type_name -> bytebase.v1.DatabaseMetadata
+	14, // 80: bytebase.v1.DatabaseService.GetDatabase:input_type -> bytebase.v1.GetDatabaseRequest
+	15, // 81: bytebase.v1.DatabaseService.BatchGetDatabases:input_type -> bytebase.v1.BatchGetDatabasesRequest
+	17, // 82: bytebase.v1.DatabaseService.ListDatabases:input_type -> bytebase.v1.ListDatabasesRequest
+	19, // 83: bytebase.v1.DatabaseService.UpdateDatabase:input_type -> bytebase.v1.UpdateDatabaseRequest
+	20, // 84: bytebase.v1.DatabaseService.BatchUpdateDatabases:input_type -> bytebase.v1.BatchUpdateDatabasesRequest
+	24, // 85: bytebase.v1.DatabaseService.SyncDatabase:input_type -> bytebase.v1.SyncDatabaseRequest
+	22, // 86: bytebase.v1.DatabaseService.BatchSyncDatabases:input_type -> bytebase.v1.BatchSyncDatabasesRequest
+	26, // 87: bytebase.v1.DatabaseService.GetDatabaseMetadata:input_type -> bytebase.v1.GetDatabaseMetadataRequest
+	27, // 88: bytebase.v1.DatabaseService.GetDatabaseSchema:input_type -> bytebase.v1.GetDatabaseSchemaRequest
+	28, // 89: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:input_type -> bytebase.v1.GetDatabaseSDLSchemaRequest
+	29, // 90: bytebase.v1.DatabaseService.DiffSchema:input_type -> bytebase.v1.DiffSchemaRequest
+	72, // 91: bytebase.v1.DatabaseService.ListChangelogs:input_type -> bytebase.v1.ListChangelogsRequest
+	74, // 92: bytebase.v1.DatabaseService.GetChangelog:input_type -> bytebase.v1.GetChangelogRequest
+	76, // 93: bytebase.v1.DatabaseService.GetSchemaDrift:input_type -> bytebase.v1.GetSchemaDriftRequest
+	79, // 94: bytebase.v1.DatabaseService.ReconcileSchemaDrift:input_type -> bytebase.v1.ReconcileSchemaDriftRequest
+	81, // 95: bytebase.v1.DatabaseService.GetSchemaString:input_type -> bytebase.v1.GetSchemaStringRequest
+	31, // 96: bytebase.v1.DatabaseService.GetDatabase:output_type -> bytebase.v1.Database
+	16, // 97: bytebase.v1.DatabaseService.BatchGetDatabases:output_type -> bytebase.v1.BatchGetDatabasesResponse
+	18, // 98: bytebase.v1.DatabaseService.ListDatabases:output_type -> bytebase.v1.ListDatabasesResponse
+	31, // 99: bytebase.v1.DatabaseService.UpdateDatabase:output_type -> bytebase.v1.Database
+	21, // 100: bytebase.v1.DatabaseService.BatchUpdateDatabases:output_type -> bytebase.v1.BatchUpdateDatabasesResponse
+	25, // 101: bytebase.v1.DatabaseService.SyncDatabase:output_type -> bytebase.v1.SyncDatabaseResponse
+	23, // 102: bytebase.v1.DatabaseService.BatchSyncDatabases:output_type -> bytebase.v1.BatchSyncDatabasesResponse
+	32, // 103: bytebase.v1.DatabaseService.GetDatabaseMetadata:output_type -> bytebase.v1.DatabaseMetadata
+	63, // 104: bytebase.v1.DatabaseService.GetDatabaseSchema:output_type -> bytebase.v1.DatabaseSchema
+	64, // 105: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:output_type -> bytebase.v1.DatabaseSDLSchema
+	30, // 106: bytebase.v1.DatabaseService.DiffSchema:output_type -> bytebase.v1.DiffSchemaResponse
+	73, // 107: bytebase.v1.DatabaseService.ListChangelogs:output_type -> bytebase.v1.ListChangelogsResponse
+	75, // 108: bytebase.v1.DatabaseService.GetChangelog:output_type -> bytebase.v1.Changelog
+	77, // 109: bytebase.v1.DatabaseService.GetSchemaDrift:output_type -> bytebase.v1.SchemaDrift
+	80, // 110: bytebase.v1.DatabaseService.ReconcileSchemaDrift:output_type -> bytebase.v1.ReconcileSchemaDriftResponse
+	82, // 111: bytebase.v1.DatabaseService.GetSchemaString:output_type -> bytebase.v1.GetSchemaStringResponse
+	96, // [96:112] is the sub-list for method output_type
+	80, // [80:96] is the sub-list for method input_type
+	80, // [80:80] is the sub-list for extension type_name
+	80, // [80:80] is the sub-list for extension extendee
+	0,  // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_v1_database_service_proto_init() }
@@ -6845,8 +7450,8 @@ func file_v1_database_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_database_service_proto_rawDesc), len(file_v1_database_service_proto_rawDesc)),
-			NumEnums:      11,
-			NumMessages:   65,
+			NumEnums:      14,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
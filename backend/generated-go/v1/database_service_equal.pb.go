@@ -1896,6 +1896,109 @@ func (x *Changelog) Equal(y *Changelog) bool {
 	return true
 }
 
+func (x *GetSchemaDriftRequest) Equal(y *GetSchemaDriftRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+func (x *SchemaDrift) Equal(y *SchemaDrift) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Drifted != y.Drifted {
+		return false
+	}
+	if x.Changelog != y.Changelog {
+		return false
+	}
+	if p, q := x.DetectTime, y.DetectTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if len(x.Objects) != len(y.Objects) {
+		return false
+	}
+	for i := 0; i < len(x.Objects); i++ {
+		if !x.Objects[i].Equal(y.Objects[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *SchemaDriftObject) Equal(y *SchemaDriftObject) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Action != y.Action {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+func (x *ReconcileSchemaDriftRequest) Equal(y *ReconcileSchemaDriftRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Strategy != y.Strategy {
+		return false
+	}
+	return true
+}
+
+func (x *ReconcileSchemaDriftResponse) Equal(y *ReconcileSchemaDriftResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Plan != y.Plan {
+		return false
+	}
+	if x.Changelog != y.Changelog {
+		return false
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	return true
+}
+
 func (x *GetSchemaStringRequest) Equal(y *GetSchemaStringRequest) bool {
 	if x == y {
 		return true
@@ -32,6 +32,8 @@ const (
 	DatabaseService_DiffSchema_FullMethodName           = "/bytebase.v1.DatabaseService/DiffSchema"
 	DatabaseService_ListChangelogs_FullMethodName       = "/bytebase.v1.DatabaseService/ListChangelogs"
 	DatabaseService_GetChangelog_FullMethodName         = "/bytebase.v1.DatabaseService/GetChangelog"
+	DatabaseService_GetSchemaDrift_FullMethodName       = "/bytebase.v1.DatabaseService/GetSchemaDrift"
+	DatabaseService_ReconcileSchemaDrift_FullMethodName = "/bytebase.v1.DatabaseService/ReconcileSchemaDrift"
 	DatabaseService_GetSchemaString_FullMethodName      = "/bytebase.v1.DatabaseService/GetSchemaString"
 )
 
@@ -80,6 +82,13 @@ type DatabaseServiceClient interface {
 	// Retrieves a specific changelog entry.
 	// Permissions required: bb.changelogs.get
 	GetChangelog(ctx context.Context, in *GetChangelogRequest, opts ...grpc.CallOption) (*Changelog, error)
+	// Retrieves the objects drifted from the schema recorded by the latest changelog.
+	// Permissions required: bb.databases.getSchema
+	GetSchemaDrift(ctx context.Context, in *GetSchemaDriftRequest, opts ...grpc.CallOption) (*SchemaDrift, error)
+	// Generates the statement reconciling the schema drift, and either creates a plan to revert the drift,
+	// or adopts the drifted schema as the new baseline.
+	// Permissions required: bb.databases.update, bb.plans.create
+	ReconcileSchemaDrift(ctx context.Context, in *ReconcileSchemaDriftRequest, opts ...grpc.CallOption) (*ReconcileSchemaDriftResponse, error)
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(ctx context.Context, in *GetSchemaStringRequest, opts ...grpc.CallOption) (*GetSchemaStringResponse, error)
@@ -223,6 +232,26 @@ func (c *databaseServiceClient) GetChangelog(ctx context.Context, in *GetChangel
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetSchemaDrift(ctx context.Context, in *GetSchemaDriftRequest, opts ...grpc.CallOption) (*SchemaDrift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaDrift)
+	err := c.cc.Invoke(ctx, DatabaseService_GetSchemaDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ReconcileSchemaDrift(ctx context.Context, in *ReconcileSchemaDriftRequest, opts ...grpc.CallOption) (*ReconcileSchemaDriftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileSchemaDriftResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ReconcileSchemaDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) GetSchemaString(ctx context.Context, in *GetSchemaStringRequest, opts ...grpc.CallOption) (*GetSchemaStringResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSchemaStringResponse)
@@ -278,6 +307,13 @@ type DatabaseServiceServer interface {
 	// Retrieves a specific changelog entry.
 	// Permissions required: bb.changelogs.get
 	GetChangelog(context.Context, *GetChangelogRequest) (*Changelog, error)
+	// Retrieves the objects drifted from the schema recorded by the latest changelog.
+	// Permissions required: bb.databases.getSchema
+	GetSchemaDrift(context.Context, *GetSchemaDriftRequest) (*SchemaDrift, error)
+	// Generates the statement reconciling the schema drift, and either creates a plan to revert the drift,
+	// or adopts the drifted schema as the new baseline.
+	// Permissions required: bb.databases.update, bb.plans.create
+	ReconcileSchemaDrift(context.Context, *ReconcileSchemaDriftRequest) (*ReconcileSchemaDriftResponse, error)
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(context.Context, *GetSchemaStringRequest) (*GetSchemaStringResponse, error)
@@ -330,6 +366,12 @@ func (UnimplementedDatabaseServiceServer) ListChangelogs(context.Context, *ListC
 func (UnimplementedDatabaseServiceServer) GetChangelog(context.Context, *GetChangelogRequest) (*Changelog, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetChangelog not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GetSchemaDrift(context.Context, *GetSchemaDriftRequest) (*SchemaDrift, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSchemaDrift not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ReconcileSchemaDrift(context.Context, *ReconcileSchemaDriftRequest) (*ReconcileSchemaDriftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcileSchemaDrift not implemented")
+}
 func (UnimplementedDatabaseServiceServer) GetSchemaString(context.Context, *GetSchemaStringRequest) (*GetSchemaStringResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSchemaString not implemented")
 }
@@ -588,6 +630,42 @@ func _DatabaseService_GetChangelog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetSchemaDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetSchemaDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetSchemaDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetSchemaDrift(ctx, req.(*GetSchemaDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ReconcileSchemaDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileSchemaDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ReconcileSchemaDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ReconcileSchemaDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ReconcileSchemaDrift(ctx, req.(*ReconcileSchemaDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_GetSchemaString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSchemaStringRequest)
 	if err := dec(in); err != nil {
@@ -665,6 +743,14 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChangelog",
 			Handler:    _DatabaseService_GetChangelog_Handler,
 		},
+		{
+			MethodName: "GetSchemaDrift",
+			Handler:    _DatabaseService_GetSchemaDrift_Handler,
+		},
+		{
+			MethodName: "ReconcileSchemaDrift",
+			Handler:    _DatabaseService_ReconcileSchemaDrift_Handler,
+		},
 		{
 			MethodName: "GetSchemaString",
 			Handler:    _DatabaseService_GetSchemaString_Handler,
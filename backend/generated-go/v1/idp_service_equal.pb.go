@@ -120,6 +120,9 @@ func (x *TestIdentityProviderRequest) Equal(y *TestIdentityProviderRequest) bool
 	if !x.GetOidcContext().Equal(y.GetOidcContext()) {
 		return false
 	}
+	if !x.GetSamlContext().Equal(y.GetSamlContext()) {
+		return false
+	}
 	return true
 }
 
@@ -149,6 +152,19 @@ func (x *OIDCIdentityProviderTestRequestContext) Equal(y *OIDCIdentityProviderTe
 	return true
 }
 
+func (x *SAMLIdentityProviderTestRequestContext) Equal(y *SAMLIdentityProviderTestRequestContext) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.SamlResponse != y.SamlResponse {
+		return false
+	}
+	return true
+}
+
 func (x *TestIdentityProviderResponse) Equal(y *TestIdentityProviderResponse) bool {
 	if x == y {
 		return true
@@ -224,6 +240,9 @@ func (x *IdentityProviderConfig) Equal(y *IdentityProviderConfig) bool {
 	if !x.GetLdapConfig().Equal(y.GetLdapConfig()) {
 		return false
 	}
+	if !x.GetSamlConfig().Equal(y.GetSamlConfig()) {
+		return false
+	}
 	return true
 }
 
@@ -345,6 +364,43 @@ func (x *LDAPIdentityProviderConfig) Equal(y *LDAPIdentityProviderConfig) bool {
 	return true
 }
 
+func (x *SAMLIdentityProviderConfig) Equal(y *SAMLIdentityProviderConfig) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.MetadataUrl != y.MetadataUrl {
+		return false
+	}
+	if x.MetadataXml != y.MetadataXml {
+		return false
+	}
+	if x.EntityId != y.EntityId {
+		return false
+	}
+	if x.Certificate != y.Certificate {
+		return false
+	}
+	if x.PrivateKey != y.PrivateKey {
+		return false
+	}
+	if !x.FieldMapping.Equal(y.FieldMapping) {
+		return false
+	}
+	if x.SkipTlsVerify != y.SkipTlsVerify {
+		return false
+	}
+	if x.SpMetadataUrl != y.SpMetadataUrl {
+		return false
+	}
+	if x.AcsUrl != y.AcsUrl {
+		return false
+	}
+	return true
+}
+
 func (x *FieldMapping) Equal(y *FieldMapping) bool {
 	if x == y {
 		return true
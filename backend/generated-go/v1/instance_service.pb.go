@@ -90,6 +90,14 @@ const (
 	DataSourceExternalSecret_AWS_SECRETS_MANAGER DataSourceExternalSecret_SecretType = 2
 	// ref: https://cloud.google.com/secret-manager/docs
 	DataSourceExternalSecret_GCP_SECRET_MANAGER DataSourceExternalSecret_SecretType = 3
+	// ref: https://learn.microsoft.com/en-us/azure/key-vault/secrets/about-secrets
+	DataSourceExternalSecret_AZURE_KEY_VAULT DataSourceExternalSecret_SecretType = 4
+	// A local file, such as a Kubernetes mounted secret.
+	// The file must be under one of the directories in the BB_EXTERNAL_SECRET_FILE_DIRS environment.
+	DataSourceExternalSecret_FILE DataSourceExternalSecret_SecretType = 5
+	// A command that prints the secret to stdout.
+	// The command must be under one of the directories in the BB_EXTERNAL_SECRET_EXEC_DIRS environment.
+	DataSourceExternalSecret_EXEC DataSourceExternalSecret_SecretType = 6
 )
 
 // Enum value maps for DataSourceExternalSecret_SecretType.
@@ -99,12 +107,18 @@ var (
 		1: "VAULT_KV_V2",
 		2: "AWS_SECRETS_MANAGER",
 		3: "GCP_SECRET_MANAGER",
+		4: "AZURE_KEY_VAULT",
+		5: "FILE",
+		6: "EXEC",
 	}
 	DataSourceExternalSecret_SecretType_value = map[string]int32{
 		"SECRET_TYPE_UNSPECIFIED": 0,
 		"VAULT_KV_V2":             1,
 		"AWS_SECRETS_MANAGER":     2,
 		"GCP_SECRET_MANAGER":      3,
+		"AZURE_KEY_VAULT":         4,
+		"FILE":                    5,
+		"EXEC":                    6,
 	}
 )
 
@@ -1564,6 +1578,8 @@ type DataSourceExternalSecret struct {
 	// The type of external secret store.
 	SecretType DataSourceExternalSecret_SecretType `protobuf:"varint,1,opt,name=secret_type,json=secretType,proto3,enum=bytebase.v1.DataSourceExternalSecret_SecretType" json:"secret_type,omitempty"`
 	// The URL of the external secret store.
+	// For Azure Key Vault, it is the vault URL, e.g. https://my-vault.vault.azure.net.
+	// For EXEC, it is the absolute path of the command, which is called with the secret name as the only argument.
 	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	// The authentication method for accessing the secret store.
 	AuthType DataSourceExternalSecret_AuthType `protobuf:"varint,3,opt,name=auth_type,json=authType,proto3,enum=bytebase.v1.DataSourceExternalSecret_AuthType" json:"auth_type,omitempty"`
@@ -1589,9 +1605,12 @@ type DataSourceExternalSecret struct {
 	// Client certificate for mutual TLS authentication with Vault.
 	VaultSslCert string `protobuf:"bytes,11,opt,name=vault_ssl_cert,json=vaultSslCert,proto3" json:"vault_ssl_cert,omitempty"`
 	// Client private key for mutual TLS authentication with Vault.
-	VaultSslKey   string `protobuf:"bytes,12,opt,name=vault_ssl_key,json=vaultSslKey,proto3" json:"vault_ssl_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	VaultSslKey string `protobuf:"bytes,12,opt,name=vault_ssl_key,json=vaultSslKey,proto3" json:"vault_ssl_key,omitempty"`
+	// The duration in seconds to cache the secret.
+	// 0 uses the default TTL, and a negative value disables the cache.
+	CacheTtlSeconds int32 `protobuf:"varint,13,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DataSourceExternalSecret) Reset() {
@@ -1719,6 +1738,13 @@ func (x *DataSourceExternalSecret) GetVaultSslKey() string {
 	return ""
 }
 
+func (x *DataSourceExternalSecret) GetCacheTtlSeconds() int32 {
+	if x != nil {
+		return x.CacheTtlSeconds
+	}
+	return 0
+}
+
 type isDataSourceExternalSecret_AuthOption interface {
 	isDataSourceExternalSecret_AuthOption()
 }
@@ -2837,7 +2863,7 @@ const file_v1_instance_service_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:0\xeaA-\n" +
 	"\x15bytebase.com/Instance\x12\x14instances/{instance}B\x0e\n" +
-	"\f_environment\"\xbd\b\n" +
+	"\f_environment\"\x93\t\n" +
 	"\x18DataSourceExternalSecret\x12Q\n" +
 	"\vsecret_type\x18\x01 \x01(\x0e20.bytebase.v1.DataSourceExternalSecret.SecretTypeR\n" +
 	"secretType\x12\x10\n" +
@@ -2855,7 +2881,8 @@ const file_v1_instance_service_proto_rawDesc = "" +
 	" \x01(\tB\x03\xe0A\x04R\n" +
 	"vaultSslCa\x12)\n" +
 	"\x0evault_ssl_cert\x18\v \x01(\tB\x03\xe0A\x04R\fvaultSslCert\x12'\n" +
-	"\rvault_ssl_key\x18\f \x01(\tB\x03\xe0A\x04R\vvaultSslKey\x1a\x91\x02\n" +
+	"\rvault_ssl_key\x18\f \x01(\tB\x03\xe0A\x04R\vvaultSslKey\x12*\n" +
+	"\x11cache_ttl_seconds\x18\r \x01(\x05R\x0fcacheTtlSeconds\x1a\x91\x02\n" +
 	"\x11AppRoleAuthOption\x12\x1c\n" +
 	"\arole_id\x18\x01 \x01(\tB\x03\xe0A\x04R\x06roleId\x12 \n" +
 	"\tsecret_id\x18\x02 \x01(\tB\x03\xe0A\x04R\bsecretId\x12V\n" +
@@ -2866,13 +2893,16 @@ const file_v1_instance_service_proto_rawDesc = "" +
 	"SecretType\x12\x1b\n" +
 	"\x17SECRET_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05PLAIN\x10\x01\x12\x0f\n" +
-	"\vENVIRONMENT\x10\x02\"k\n" +
+	"\vENVIRONMENT\x10\x02\"\x94\x01\n" +
 	"\n" +
 	"SecretType\x12\x1b\n" +
 	"\x17SECRET_TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vVAULT_KV_V2\x10\x01\x12\x17\n" +
 	"\x13AWS_SECRETS_MANAGER\x10\x02\x12\x16\n" +
-	"\x12GCP_SECRET_MANAGER\x10\x03\"D\n" +
+	"\x12GCP_SECRET_MANAGER\x10\x03\x12\x13\n" +
+	"\x0fAZURE_KEY_VAULT\x10\x04\x12\b\n" +
+	"\x04FILE\x10\x05\x12\b\n" +
+	"\x04EXEC\x10\x06\"D\n" +
 	"\bAuthType\x12\x19\n" +
 	"\x15AUTH_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05TOKEN\x10\x01\x12\x12\n" +
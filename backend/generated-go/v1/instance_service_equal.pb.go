@@ -478,6 +478,9 @@ func (x *DataSourceExternalSecret) Equal(y *DataSourceExternalSecret) bool {
 	if x.VaultSslKey != y.VaultSslKey {
 		return false
 	}
+	if x.CacheTtlSeconds != y.CacheTtlSeconds {
+		return false
+	}
 	return true
 }
 
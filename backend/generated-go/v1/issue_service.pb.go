@@ -138,7 +138,7 @@ func (x Issue_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Issue_Type.Descriptor instead.
 func (Issue_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 // The overall approval status for the issue.
@@ -207,7 +207,7 @@ func (x Issue_ApprovalStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Issue_ApprovalStatus.Descriptor instead.
 func (Issue_ApprovalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{12, 1}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 // The approval status of an approver.
@@ -264,7 +264,7 @@ func (x Issue_Approver_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Issue_Approver_Status.Descriptor instead.
 func (Issue_Approver_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{12, 0, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{13, 0, 0}
 }
 
 // Approval status values.
@@ -321,7 +321,7 @@ func (x IssueComment_Approval_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IssueComment_Approval_Status.Descriptor instead.
 func (IssueComment_Approval_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 0, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 0, 0}
 }
 
 // Task status values.
@@ -390,7 +390,7 @@ func (x IssueComment_TaskUpdate_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IssueComment_TaskUpdate_Status.Descriptor instead.
 func (IssueComment_TaskUpdate_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 3, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 3, 0}
 }
 
 type GetIssueRequest struct {
@@ -503,6 +503,80 @@ func (x *CreateIssueRequest) GetIssue() *Issue {
 	return nil
 }
 
+type BreakGlassRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The project of the databases.
+	// Format: projects/{project}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The databases to access in the SQL Editor.
+	// Format: instances/{instance}/databases/{database}
+	Databases []string `protobuf:"bytes,2,rep,name=databases,proto3" json:"databases,omitempty"`
+	// The justification for the emergency access, which is the description of the review issue.
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	// The duration of the access. It must not exceed 4 hours.
+	Duration      *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreakGlassRequest) Reset() {
+	*x = BreakGlassRequest{}
+	mi := &file_v1_issue_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakGlassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakGlassRequest) ProtoMessage() {}
+
+func (x *BreakGlassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_issue_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakGlassRequest.ProtoReflect.Descriptor instead.
+func (*BreakGlassRequest) Descriptor() ([]byte, []int) {
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BreakGlassRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetDatabases() []string {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+func (x *BreakGlassRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
 type ListIssuesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The parent, which owns this collection of issues.
@@ -550,7 +624,7 @@ type ListIssuesRequest struct {
 
 func (x *ListIssuesRequest) Reset() {
 	*x = ListIssuesRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[2]
+	mi := &file_v1_issue_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +636,7 @@ func (x *ListIssuesRequest) String() string {
 func (*ListIssuesRequest) ProtoMessage() {}
 
 func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[2]
+	mi := &file_v1_issue_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +649,7 @@ func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
 func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{2}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListIssuesRequest) GetParent() string {
@@ -626,7 +700,7 @@ type ListIssuesResponse struct {
 
 func (x *ListIssuesResponse) Reset() {
 	*x = ListIssuesResponse{}
-	mi := &file_v1_issue_service_proto_msgTypes[3]
+	mi := &file_v1_issue_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -638,7 +712,7 @@ func (x *ListIssuesResponse) String() string {
 func (*ListIssuesResponse) ProtoMessage() {}
 
 func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[3]
+	mi := &file_v1_issue_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +725,7 @@ func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
 func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{3}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListIssuesResponse) GetIssues() []*Issue {
@@ -696,7 +770,7 @@ type SearchIssuesRequest struct {
 
 func (x *SearchIssuesRequest) Reset() {
 	*x = SearchIssuesRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[4]
+	mi := &file_v1_issue_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +782,7 @@ func (x *SearchIssuesRequest) String() string {
 func (*SearchIssuesRequest) ProtoMessage() {}
 
 func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[4]
+	mi := &file_v1_issue_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +795,7 @@ func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchIssuesRequest.ProtoReflect.Descriptor instead.
 func (*SearchIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{4}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchIssuesRequest) GetParent() string {
@@ -772,7 +846,7 @@ type SearchIssuesResponse struct {
 
 func (x *SearchIssuesResponse) Reset() {
 	*x = SearchIssuesResponse{}
-	mi := &file_v1_issue_service_proto_msgTypes[5]
+	mi := &file_v1_issue_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -784,7 +858,7 @@ func (x *SearchIssuesResponse) String() string {
 func (*SearchIssuesResponse) ProtoMessage() {}
 
 func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[5]
+	mi := &file_v1_issue_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,7 +871,7 @@ func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchIssuesResponse.ProtoReflect.Descriptor instead.
 func (*SearchIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{5}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SearchIssuesResponse) GetIssues() []*Issue {
@@ -832,7 +906,7 @@ type UpdateIssueRequest struct {
 
 func (x *UpdateIssueRequest) Reset() {
 	*x = UpdateIssueRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[6]
+	mi := &file_v1_issue_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +918,7 @@ func (x *UpdateIssueRequest) String() string {
 func (*UpdateIssueRequest) ProtoMessage() {}
 
 func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[6]
+	mi := &file_v1_issue_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +931,7 @@ func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIssueRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIssueRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{6}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateIssueRequest) GetIssue() *Issue {
@@ -901,7 +975,7 @@ type BatchUpdateIssuesStatusRequest struct {
 
 func (x *BatchUpdateIssuesStatusRequest) Reset() {
 	*x = BatchUpdateIssuesStatusRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[7]
+	mi := &file_v1_issue_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -913,7 +987,7 @@ func (x *BatchUpdateIssuesStatusRequest) String() string {
 func (*BatchUpdateIssuesStatusRequest) ProtoMessage() {}
 
 func (x *BatchUpdateIssuesStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[7]
+	mi := &file_v1_issue_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1000,7 @@ func (x *BatchUpdateIssuesStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchUpdateIssuesStatusRequest.ProtoReflect.Descriptor instead.
 func (*BatchUpdateIssuesStatusRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{7}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BatchUpdateIssuesStatusRequest) GetParent() string {
@@ -965,7 +1039,7 @@ type BatchUpdateIssuesStatusResponse struct {
 
 func (x *BatchUpdateIssuesStatusResponse) Reset() {
 	*x = BatchUpdateIssuesStatusResponse{}
-	mi := &file_v1_issue_service_proto_msgTypes[8]
+	mi := &file_v1_issue_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -977,7 +1051,7 @@ func (x *BatchUpdateIssuesStatusResponse) String() string {
 func (*BatchUpdateIssuesStatusResponse) ProtoMessage() {}
 
 func (x *BatchUpdateIssuesStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[8]
+	mi := &file_v1_issue_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1064,7 @@ func (x *BatchUpdateIssuesStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchUpdateIssuesStatusResponse.ProtoReflect.Descriptor instead.
 func (*BatchUpdateIssuesStatusResponse) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{8}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{9}
 }
 
 type ApproveIssueRequest struct {
@@ -1006,7 +1080,7 @@ type ApproveIssueRequest struct {
 
 func (x *ApproveIssueRequest) Reset() {
 	*x = ApproveIssueRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[9]
+	mi := &file_v1_issue_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1018,7 +1092,7 @@ func (x *ApproveIssueRequest) String() string {
 func (*ApproveIssueRequest) ProtoMessage() {}
 
 func (x *ApproveIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[9]
+	mi := &file_v1_issue_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1105,7 @@ func (x *ApproveIssueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveIssueRequest.ProtoReflect.Descriptor instead.
 func (*ApproveIssueRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{9}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ApproveIssueRequest) GetName() string {
@@ -1061,7 +1135,7 @@ type RejectIssueRequest struct {
 
 func (x *RejectIssueRequest) Reset() {
 	*x = RejectIssueRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[10]
+	mi := &file_v1_issue_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1073,7 +1147,7 @@ func (x *RejectIssueRequest) String() string {
 func (*RejectIssueRequest) ProtoMessage() {}
 
 func (x *RejectIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[10]
+	mi := &file_v1_issue_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1160,7 @@ func (x *RejectIssueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectIssueRequest.ProtoReflect.Descriptor instead.
 func (*RejectIssueRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{10}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RejectIssueRequest) GetName() string {
@@ -1116,7 +1190,7 @@ type RequestIssueRequest struct {
 
 func (x *RequestIssueRequest) Reset() {
 	*x = RequestIssueRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[11]
+	mi := &file_v1_issue_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +1202,7 @@ func (x *RequestIssueRequest) String() string {
 func (*RequestIssueRequest) ProtoMessage() {}
 
 func (x *RequestIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[11]
+	mi := &file_v1_issue_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1215,7 @@ func (x *RequestIssueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestIssueRequest.ProtoReflect.Descriptor instead.
 func (*RequestIssueRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RequestIssueRequest) GetName() string {
@@ -1218,7 +1292,7 @@ type Issue struct {
 
 func (x *Issue) Reset() {
 	*x = Issue{}
-	mi := &file_v1_issue_service_proto_msgTypes[12]
+	mi := &file_v1_issue_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1230,7 +1304,7 @@ func (x *Issue) String() string {
 func (*Issue) ProtoMessage() {}
 
 func (x *Issue) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[12]
+	mi := &file_v1_issue_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1317,7 @@ func (x *Issue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Issue.ProtoReflect.Descriptor instead.
 func (*Issue) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{12}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Issue) GetName() string {
@@ -1390,14 +1464,17 @@ type GrantRequest struct {
 	// The condition for the role. Same as the condition in IAM Binding message.
 	Condition *expr.Expr `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
 	// The duration for which the grant is valid.
-	Expiration    *durationpb.Duration `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Expiration *durationpb.Duration `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Whether the grant is a break-glass access, which is granted without approval.
+	// The approval of the issue is the post-incident review.
+	BreakGlass    bool `protobuf:"varint,5,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GrantRequest) Reset() {
 	*x = GrantRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[13]
+	mi := &file_v1_issue_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1486,7 @@ func (x *GrantRequest) String() string {
 func (*GrantRequest) ProtoMessage() {}
 
 func (x *GrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[13]
+	mi := &file_v1_issue_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +1499,7 @@ func (x *GrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantRequest.ProtoReflect.Descriptor instead.
 func (*GrantRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{13}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GrantRequest) GetRole() string {
@@ -1453,6 +1530,13 @@ func (x *GrantRequest) GetExpiration() *durationpb.Duration {
 	return nil
 }
 
+func (x *GrantRequest) GetBreakGlass() bool {
+	if x != nil {
+		return x.BreakGlass
+	}
+	return false
+}
+
 type ApprovalTemplate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The approval flow definition.
@@ -1467,7 +1551,7 @@ type ApprovalTemplate struct {
 
 func (x *ApprovalTemplate) Reset() {
 	*x = ApprovalTemplate{}
-	mi := &file_v1_issue_service_proto_msgTypes[14]
+	mi := &file_v1_issue_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1479,7 +1563,7 @@ func (x *ApprovalTemplate) String() string {
 func (*ApprovalTemplate) ProtoMessage() {}
 
 func (x *ApprovalTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[14]
+	mi := &file_v1_issue_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1492,7 +1576,7 @@ func (x *ApprovalTemplate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalTemplate.ProtoReflect.Descriptor instead.
 func (*ApprovalTemplate) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{14}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ApprovalTemplate) GetFlow() *ApprovalFlow {
@@ -1526,7 +1610,7 @@ type ApprovalFlow struct {
 
 func (x *ApprovalFlow) Reset() {
 	*x = ApprovalFlow{}
-	mi := &file_v1_issue_service_proto_msgTypes[15]
+	mi := &file_v1_issue_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1538,7 +1622,7 @@ func (x *ApprovalFlow) String() string {
 func (*ApprovalFlow) ProtoMessage() {}
 
 func (x *ApprovalFlow) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[15]
+	mi := &file_v1_issue_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1635,7 @@ func (x *ApprovalFlow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalFlow.ProtoReflect.Descriptor instead.
 func (*ApprovalFlow) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{15}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ApprovalFlow) GetRoles() []string {
@@ -1582,7 +1666,7 @@ type ListIssueCommentsRequest struct {
 
 func (x *ListIssueCommentsRequest) Reset() {
 	*x = ListIssueCommentsRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[16]
+	mi := &file_v1_issue_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1594,7 +1678,7 @@ func (x *ListIssueCommentsRequest) String() string {
 func (*ListIssueCommentsRequest) ProtoMessage() {}
 
 func (x *ListIssueCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[16]
+	mi := &file_v1_issue_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +1691,7 @@ func (x *ListIssueCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssueCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListIssueCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{16}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListIssueCommentsRequest) GetParent() string {
@@ -1644,7 +1728,7 @@ type ListIssueCommentsResponse struct {
 
 func (x *ListIssueCommentsResponse) Reset() {
 	*x = ListIssueCommentsResponse{}
-	mi := &file_v1_issue_service_proto_msgTypes[17]
+	mi := &file_v1_issue_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1656,7 +1740,7 @@ func (x *ListIssueCommentsResponse) String() string {
 func (*ListIssueCommentsResponse) ProtoMessage() {}
 
 func (x *ListIssueCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[17]
+	mi := &file_v1_issue_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +1753,7 @@ func (x *ListIssueCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssueCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListIssueCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{17}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListIssueCommentsResponse) GetIssueComments() []*IssueComment {
@@ -1699,7 +1783,7 @@ type CreateIssueCommentRequest struct {
 
 func (x *CreateIssueCommentRequest) Reset() {
 	*x = CreateIssueCommentRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[18]
+	mi := &file_v1_issue_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +1795,7 @@ func (x *CreateIssueCommentRequest) String() string {
 func (*CreateIssueCommentRequest) ProtoMessage() {}
 
 func (x *CreateIssueCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[18]
+	mi := &file_v1_issue_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,7 +1808,7 @@ func (x *CreateIssueCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIssueCommentRequest.ProtoReflect.Descriptor instead.
 func (*CreateIssueCommentRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{18}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateIssueCommentRequest) GetParent() string {
@@ -1759,7 +1843,7 @@ type UpdateIssueCommentRequest struct {
 
 func (x *UpdateIssueCommentRequest) Reset() {
 	*x = UpdateIssueCommentRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[19]
+	mi := &file_v1_issue_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1771,7 +1855,7 @@ func (x *UpdateIssueCommentRequest) String() string {
 func (*UpdateIssueCommentRequest) ProtoMessage() {}
 
 func (x *UpdateIssueCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[19]
+	mi := &file_v1_issue_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +1868,7 @@ func (x *UpdateIssueCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIssueCommentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIssueCommentRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{19}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateIssueCommentRequest) GetParent() string {
@@ -1844,7 +1928,7 @@ type IssueComment struct {
 
 func (x *IssueComment) Reset() {
 	*x = IssueComment{}
-	mi := &file_v1_issue_service_proto_msgTypes[20]
+	mi := &file_v1_issue_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1856,7 +1940,7 @@ func (x *IssueComment) String() string {
 func (*IssueComment) ProtoMessage() {}
 
 func (x *IssueComment) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[20]
+	mi := &file_v1_issue_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,7 +1953,7 @@ func (x *IssueComment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment.ProtoReflect.Descriptor instead.
 func (*IssueComment) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *IssueComment) GetName() string {
@@ -2018,7 +2102,7 @@ type Issue_Approver struct {
 
 func (x *Issue_Approver) Reset() {
 	*x = Issue_Approver{}
-	mi := &file_v1_issue_service_proto_msgTypes[21]
+	mi := &file_v1_issue_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2030,7 +2114,7 @@ func (x *Issue_Approver) String() string {
 func (*Issue_Approver) ProtoMessage() {}
 
 func (x *Issue_Approver) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[21]
+	mi := &file_v1_issue_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2043,7 +2127,7 @@ func (x *Issue_Approver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Issue_Approver.ProtoReflect.Descriptor instead.
 func (*Issue_Approver) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *Issue_Approver) GetStatus() Issue_Approver_Status {
@@ -2071,7 +2155,7 @@ type IssueComment_Approval struct {
 
 func (x *IssueComment_Approval) Reset() {
 	*x = IssueComment_Approval{}
-	mi := &file_v1_issue_service_proto_msgTypes[23]
+	mi := &file_v1_issue_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2083,7 +2167,7 @@ func (x *IssueComment_Approval) String() string {
 func (*IssueComment_Approval) ProtoMessage() {}
 
 func (x *IssueComment_Approval) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[23]
+	mi := &file_v1_issue_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,7 +2180,7 @@ func (x *IssueComment_Approval) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_Approval.ProtoReflect.Descriptor instead.
 func (*IssueComment_Approval) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *IssueComment_Approval) GetStatus() IssueComment_Approval_Status {
@@ -2123,7 +2207,7 @@ type IssueComment_IssueUpdate struct {
 
 func (x *IssueComment_IssueUpdate) Reset() {
 	*x = IssueComment_IssueUpdate{}
-	mi := &file_v1_issue_service_proto_msgTypes[24]
+	mi := &file_v1_issue_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2135,7 +2219,7 @@ func (x *IssueComment_IssueUpdate) String() string {
 func (*IssueComment_IssueUpdate) ProtoMessage() {}
 
 func (x *IssueComment_IssueUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[24]
+	mi := &file_v1_issue_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,7 +2232,7 @@ func (x *IssueComment_IssueUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_IssueUpdate.ProtoReflect.Descriptor instead.
 func (*IssueComment_IssueUpdate) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 1}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *IssueComment_IssueUpdate) GetFromTitle() string {
@@ -2218,7 +2302,7 @@ type IssueComment_StageEnd struct {
 
 func (x *IssueComment_StageEnd) Reset() {
 	*x = IssueComment_StageEnd{}
-	mi := &file_v1_issue_service_proto_msgTypes[25]
+	mi := &file_v1_issue_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2230,7 +2314,7 @@ func (x *IssueComment_StageEnd) String() string {
 func (*IssueComment_StageEnd) ProtoMessage() {}
 
 func (x *IssueComment_StageEnd) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[25]
+	mi := &file_v1_issue_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2243,7 +2327,7 @@ func (x *IssueComment_StageEnd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_StageEnd.ProtoReflect.Descriptor instead.
 func (*IssueComment_StageEnd) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 2}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 2}
 }
 
 func (x *IssueComment_StageEnd) GetStage() string {
@@ -2269,7 +2353,7 @@ type IssueComment_TaskUpdate struct {
 
 func (x *IssueComment_TaskUpdate) Reset() {
 	*x = IssueComment_TaskUpdate{}
-	mi := &file_v1_issue_service_proto_msgTypes[26]
+	mi := &file_v1_issue_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2281,7 +2365,7 @@ func (x *IssueComment_TaskUpdate) String() string {
 func (*IssueComment_TaskUpdate) ProtoMessage() {}
 
 func (x *IssueComment_TaskUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[26]
+	mi := &file_v1_issue_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,7 +2378,7 @@ func (x *IssueComment_TaskUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_TaskUpdate.ProtoReflect.Descriptor instead.
 func (*IssueComment_TaskUpdate) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 3}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 3}
 }
 
 func (x *IssueComment_TaskUpdate) GetTasks() []string {
@@ -2344,7 +2428,7 @@ type IssueComment_TaskPriorBackup struct {
 
 func (x *IssueComment_TaskPriorBackup) Reset() {
 	*x = IssueComment_TaskPriorBackup{}
-	mi := &file_v1_issue_service_proto_msgTypes[27]
+	mi := &file_v1_issue_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2356,7 +2440,7 @@ func (x *IssueComment_TaskPriorBackup) String() string {
 func (*IssueComment_TaskPriorBackup) ProtoMessage() {}
 
 func (x *IssueComment_TaskPriorBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[27]
+	mi := &file_v1_issue_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +2453,7 @@ func (x *IssueComment_TaskPriorBackup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_TaskPriorBackup.ProtoReflect.Descriptor instead.
 func (*IssueComment_TaskPriorBackup) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 4}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 4}
 }
 
 func (x *IssueComment_TaskPriorBackup) GetTask() string {
@@ -2420,7 +2504,7 @@ type IssueComment_TaskPriorBackup_Table struct {
 
 func (x *IssueComment_TaskPriorBackup_Table) Reset() {
 	*x = IssueComment_TaskPriorBackup_Table{}
-	mi := &file_v1_issue_service_proto_msgTypes[28]
+	mi := &file_v1_issue_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +2516,7 @@ func (x *IssueComment_TaskPriorBackup_Table) String() string {
 func (*IssueComment_TaskPriorBackup_Table) ProtoMessage() {}
 
 func (x *IssueComment_TaskPriorBackup_Table) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[28]
+	mi := &file_v1_issue_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +2529,7 @@ func (x *IssueComment_TaskPriorBackup_Table) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use IssueComment_TaskPriorBackup_Table.ProtoReflect.Descriptor instead.
 func (*IssueComment_TaskPriorBackup_Table) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 4, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 4, 0}
 }
 
 func (x *IssueComment_TaskPriorBackup_Table) GetSchema() string {
@@ -2474,7 +2558,13 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\x12CreateIssueRequest\x124\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x06parent\x12-\n" +
-	"\x05issue\x18\x02 \x01(\v2\x12.bytebase.v1.IssueB\x03\xe0A\x02R\x05issue\"\xb3\x01\n" +
+	"\x05issue\x18\x02 \x01(\v2\x12.bytebase.v1.IssueB\x03\xe0A\x02R\x05issue\"\xd3\x01\n" +
+	"\x11BreakGlassRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/ProjectR\x06parent\x12!\n" +
+	"\tdatabases\x18\x02 \x03(\tB\x03\xe0A\x02R\tdatabases\x12)\n" +
+	"\rjustification\x18\x03 \x01(\tB\x03\xe0A\x02R\rjustification\x12:\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x02R\bduration\"\xb3\x01\n" +
 	"\x11ListIssuesRequest\x124\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x06parent\x12\x1b\n" +
@@ -2569,14 +2659,16 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\bREJECTED\x10\x04\x12\v\n" +
 	"\aSKIPPED\x10\x05\x12\t\n" +
 	"\x05ERROR\x10\x06::\xeaA7\n" +
-	"\x12bytebase.com/Issue\x12!projects/{project}/issues/{issue}J\x04\b\x02\x10\x03J\x04\b\a\x10\bJ\x04\b\b\x10\tJ\x04\b\v\x10\fJ\x04\b\f\x10\r\"\xa2\x01\n" +
+	"\x12bytebase.com/Issue\x12!projects/{project}/issues/{issue}J\x04\b\x02\x10\x03J\x04\b\a\x10\bJ\x04\b\b\x10\tJ\x04\b\v\x10\fJ\x04\b\f\x10\r\"\xc8\x01\n" +
 	"\fGrantRequest\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x12\n" +
 	"\x04user\x18\x02 \x01(\tR\x04user\x12/\n" +
 	"\tcondition\x18\x03 \x01(\v2\x11.google.type.ExprR\tcondition\x129\n" +
 	"\n" +
 	"expiration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
-	"expiration\"y\n" +
+	"expiration\x12$\n" +
+	"\vbreak_glass\x18\x05 \x01(\bB\x03\xe0A\x03R\n" +
+	"breakGlass\"y\n" +
 	"\x10ApprovalTemplate\x12-\n" +
 	"\x04flow\x18\x01 \x01(\v2\x19.bytebase.v1.ApprovalFlowR\x04flow\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2683,7 +2775,7 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\x18ISSUE_STATUS_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04OPEN\x10\x01\x12\b\n" +
 	"\x04DONE\x10\x02\x12\f\n" +
-	"\bCANCELED\x10\x032\xd8\x0f\n" +
+	"\bCANCELED\x10\x032\xfa\x10\n" +
 	"\fIssueService\x12\x80\x01\n" +
 	"\bGetIssue\x12\x1c.bytebase.v1.GetIssueRequest\x1a\x12.bytebase.v1.Issue\"B\xdaA\x04name\x8a\xea0\rbb.issues.get\x90\xea0\x01\x82\xd3\xe4\x93\x02 \x12\x1e/v1/{name=projects/*/issues/*}\x12\x9c\x01\n" +
 	"\vCreateIssue\x12\x1f.bytebase.v1.CreateIssueRequest\x1a\x12.bytebase.v1.Issue\"X\xdaA\fparent,issue\x8a\xea0\x10bb.issues.create\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02':\x05issue\"\x1e/v1/{parent=projects/*}/issues\x12\x94\x01\n" +
@@ -2697,7 +2789,9 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\x17BatchUpdateIssuesStatus\x12+.bytebase.v1.BatchUpdateIssuesStatusRequest\x1a,.bytebase.v1.BatchUpdateIssuesStatusResponse\"W\x8a\xea0\x10bb.issues.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x025:\x01*\"0/v1/{parent=projects/*}/issues:batchUpdateStatus\x12\x7f\n" +
 	"\fApproveIssue\x12 .bytebase.v1.ApproveIssueRequest\x1a\x12.bytebase.v1.Issue\"9\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{name=projects/*/issues/*}:approve\x12|\n" +
 	"\vRejectIssue\x12\x1f.bytebase.v1.RejectIssueRequest\x1a\x12.bytebase.v1.Issue\"8\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/{name=projects/*/issues/*}:reject\x12\x7f\n" +
-	"\fRequestIssue\x12 .bytebase.v1.RequestIssueRequest\x1a\x12.bytebase.v1.Issue\"9\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{name=projects/*/issues/*}:requestB\xa7\x01\n" +
+	"\fRequestIssue\x12 .bytebase.v1.RequestIssueRequest\x1a\x12.bytebase.v1.Issue\"9\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{name=projects/*/issues/*}:request\x12\x9f\x01\n" +
+	"\n" +
+	"BreakGlass\x12\x1e.bytebase.v1.BreakGlassRequest\x1a\x12.bytebase.v1.Issue\"]\xdaA\x06parent\x8a\xea0\x14bb.issues.breakGlass\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02.:\x01*\")/v1/{parent=projects/*}/issues:breakGlassB\xa7\x01\n" +
 	"\x0fcom.bytebase.v1B\x11IssueServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -2713,7 +2807,7 @@ func file_v1_issue_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_issue_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_v1_issue_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_v1_issue_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_v1_issue_service_proto_goTypes = []any{
 	(IssueStatus)(0),                           // 0: bytebase.v1.IssueStatus
 	(Issue_Type)(0),                            // 1: bytebase.v1.Issue.Type
@@ -2723,105 +2817,109 @@ var file_v1_issue_service_proto_goTypes = []any{
 	(IssueComment_TaskUpdate_Status)(0),        // 5: bytebase.v1.IssueComment.TaskUpdate.Status
 	(*GetIssueRequest)(nil),                    // 6: bytebase.v1.GetIssueRequest
 	(*CreateIssueRequest)(nil),                 // 7: bytebase.v1.CreateIssueRequest
-	(*ListIssuesRequest)(nil),                  // 8: bytebase.v1.ListIssuesRequest
-	(*ListIssuesResponse)(nil),                 // 9: bytebase.v1.ListIssuesResponse
-	(*SearchIssuesRequest)(nil),                // 10: bytebase.v1.SearchIssuesRequest
-	(*SearchIssuesResponse)(nil),               // 11: bytebase.v1.SearchIssuesResponse
-	(*UpdateIssueRequest)(nil),                 // 12: bytebase.v1.UpdateIssueRequest
-	(*BatchUpdateIssuesStatusRequest)(nil),     // 13: bytebase.v1.BatchUpdateIssuesStatusRequest
-	(*BatchUpdateIssuesStatusResponse)(nil),    // 14: bytebase.v1.BatchUpdateIssuesStatusResponse
-	(*ApproveIssueRequest)(nil),                // 15: bytebase.v1.ApproveIssueRequest
-	(*RejectIssueRequest)(nil),                 // 16: bytebase.v1.RejectIssueRequest
-	(*RequestIssueRequest)(nil),                // 17: bytebase.v1.RequestIssueRequest
-	(*Issue)(nil),                              // 18: bytebase.v1.Issue
-	(*GrantRequest)(nil),                       // 19: bytebase.v1.GrantRequest
-	(*ApprovalTemplate)(nil),                   // 20: bytebase.v1.ApprovalTemplate
-	(*ApprovalFlow)(nil),                       // 21: bytebase.v1.ApprovalFlow
-	(*ListIssueCommentsRequest)(nil),           // 22: bytebase.v1.ListIssueCommentsRequest
-	(*ListIssueCommentsResponse)(nil),          // 23: bytebase.v1.ListIssueCommentsResponse
-	(*CreateIssueCommentRequest)(nil),          // 24: bytebase.v1.CreateIssueCommentRequest
-	(*UpdateIssueCommentRequest)(nil),          // 25: bytebase.v1.UpdateIssueCommentRequest
-	(*IssueComment)(nil),                       // 26: bytebase.v1.IssueComment
-	(*Issue_Approver)(nil),                     // 27: bytebase.v1.Issue.Approver
-	nil,                                        // 28: bytebase.v1.Issue.TaskStatusCountEntry
-	(*IssueComment_Approval)(nil),              // 29: bytebase.v1.IssueComment.Approval
-	(*IssueComment_IssueUpdate)(nil),           // 30: bytebase.v1.IssueComment.IssueUpdate
-	(*IssueComment_StageEnd)(nil),              // 31: bytebase.v1.IssueComment.StageEnd
-	(*IssueComment_TaskUpdate)(nil),            // 32: bytebase.v1.IssueComment.TaskUpdate
-	(*IssueComment_TaskPriorBackup)(nil),       // 33: bytebase.v1.IssueComment.TaskPriorBackup
-	(*IssueComment_TaskPriorBackup_Table)(nil), // 34: bytebase.v1.IssueComment.TaskPriorBackup.Table
-	(*fieldmaskpb.FieldMask)(nil),              // 35: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),              // 36: google.protobuf.Timestamp
-	(RiskLevel)(0),                             // 37: bytebase.v1.RiskLevel
-	(*expr.Expr)(nil),                          // 38: google.type.Expr
-	(*durationpb.Duration)(nil),                // 39: google.protobuf.Duration
+	(*BreakGlassRequest)(nil),                  // 8: bytebase.v1.BreakGlassRequest
+	(*ListIssuesRequest)(nil),                  // 9: bytebase.v1.ListIssuesRequest
+	(*ListIssuesResponse)(nil),                 // 10: bytebase.v1.ListIssuesResponse
+	(*SearchIssuesRequest)(nil),                // 11: bytebase.v1.SearchIssuesRequest
+	(*SearchIssuesResponse)(nil),               // 12: bytebase.v1.SearchIssuesResponse
+	(*UpdateIssueRequest)(nil),                 // 13: bytebase.v1.UpdateIssueRequest
+	(*BatchUpdateIssuesStatusRequest)(nil),     // 14: bytebase.v1.BatchUpdateIssuesStatusRequest
+	(*BatchUpdateIssuesStatusResponse)(nil),    // 15: bytebase.v1.BatchUpdateIssuesStatusResponse
+	(*ApproveIssueRequest)(nil),                // 16: bytebase.v1.ApproveIssueRequest
+	(*RejectIssueRequest)(nil),                 // 17: bytebase.v1.RejectIssueRequest
+	(*RequestIssueRequest)(nil),                // 18: bytebase.v1.RequestIssueRequest
+	(*Issue)(nil),                              // 19: bytebase.v1.Issue
+	(*GrantRequest)(nil),                       // 20: bytebase.v1.GrantRequest
+	(*ApprovalTemplate)(nil),                   // 21: bytebase.v1.ApprovalTemplate
+	(*ApprovalFlow)(nil),                       // 22: bytebase.v1.ApprovalFlow
+	(*ListIssueCommentsRequest)(nil),           // 23: bytebase.v1.ListIssueCommentsRequest
+	(*ListIssueCommentsResponse)(nil),          // 24: bytebase.v1.ListIssueCommentsResponse
+	(*CreateIssueCommentRequest)(nil),          // 25: bytebase.v1.CreateIssueCommentRequest
+	(*UpdateIssueCommentRequest)(nil),          // 26: bytebase.v1.UpdateIssueCommentRequest
+	(*IssueComment)(nil),                       // 27: bytebase.v1.IssueComment
+	(*Issue_Approver)(nil),                     // 28: bytebase.v1.Issue.Approver
+	nil,                                        // 29: bytebase.v1.Issue.TaskStatusCountEntry
+	(*IssueComment_Approval)(nil),              // 30: bytebase.v1.IssueComment.Approval
+	(*IssueComment_IssueUpdate)(nil),           // 31: bytebase.v1.IssueComment.IssueUpdate
+	(*IssueComment_StageEnd)(nil),              // 32: bytebase.v1.IssueComment.StageEnd
+	(*IssueComment_TaskUpdate)(nil),            // 33: bytebase.v1.IssueComment.TaskUpdate
+	(*IssueComment_TaskPriorBackup)(nil),       // 34: bytebase.v1.IssueComment.TaskPriorBackup
+	(*IssueComment_TaskPriorBackup_Table)(nil), // 35: bytebase.v1.IssueComment.TaskPriorBackup.Table
+	(*durationpb.Duration)(nil),                // 36: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),              // 37: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),              // 38: google.protobuf.Timestamp
+	(RiskLevel)(0),                             // 39: bytebase.v1.RiskLevel
+	(*expr.Expr)(nil),                          // 40: google.type.Expr
 }
 var file_v1_issue_service_proto_depIdxs = []int32{
-	18, // 0: bytebase.v1.CreateIssueRequest.issue:type_name -> bytebase.v1.Issue
-	18, // 1: bytebase.v1.ListIssuesResponse.issues:type_name -> bytebase.v1.Issue
-	18, // 2: bytebase.v1.SearchIssuesResponse.issues:type_name -> bytebase.v1.Issue
-	18, // 3: bytebase.v1.UpdateIssueRequest.issue:type_name -> bytebase.v1.Issue
-	35, // 4: bytebase.v1.UpdateIssueRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0,  // 5: bytebase.v1.BatchUpdateIssuesStatusRequest.status:type_name -> bytebase.v1.IssueStatus
-	1,  // 6: bytebase.v1.Issue.type:type_name -> bytebase.v1.Issue.Type
-	0,  // 7: bytebase.v1.Issue.status:type_name -> bytebase.v1.IssueStatus
-	27, // 8: bytebase.v1.Issue.approvers:type_name -> bytebase.v1.Issue.Approver
-	20, // 9: bytebase.v1.Issue.approval_template:type_name -> bytebase.v1.ApprovalTemplate
-	36, // 10: bytebase.v1.Issue.create_time:type_name -> google.protobuf.Timestamp
-	36, // 11: bytebase.v1.Issue.update_time:type_name -> google.protobuf.Timestamp
-	19, // 12: bytebase.v1.Issue.grant_request:type_name -> bytebase.v1.GrantRequest
-	37, // 13: bytebase.v1.Issue.risk_level:type_name -> bytebase.v1.RiskLevel
-	28, // 14: bytebase.v1.Issue.task_status_count:type_name -> bytebase.v1.Issue.TaskStatusCountEntry
-	2,  // 15: bytebase.v1.Issue.approval_status:type_name -> bytebase.v1.Issue.ApprovalStatus
-	38, // 16: bytebase.v1.GrantRequest.condition:type_name -> google.type.Expr
-	39, // 17: bytebase.v1.GrantRequest.expiration:type_name -> google.protobuf.Duration
-	21, // 18: bytebase.v1.ApprovalTemplate.flow:type_name -> bytebase.v1.ApprovalFlow
-	26, // 19: bytebase.v1.ListIssueCommentsResponse.issue_comments:type_name -> bytebase.v1.IssueComment
-	26, // 20: bytebase.v1.CreateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
-	26, // 21: bytebase.v1.UpdateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
-	35, // 22: bytebase.v1.UpdateIssueCommentRequest.update_mask:type_name -> google.protobuf.FieldMask
-	36, // 23: bytebase.v1.IssueComment.create_time:type_name -> google.protobuf.Timestamp
-	36, // 24: bytebase.v1.IssueComment.update_time:type_name -> google.protobuf.Timestamp
-	29, // 25: bytebase.v1.IssueComment.approval:type_name -> bytebase.v1.IssueComment.Approval
-	30, // 26: bytebase.v1.IssueComment.issue_update:type_name -> bytebase.v1.IssueComment.IssueUpdate
-	31, // 27: bytebase.v1.IssueComment.stage_end:type_name -> bytebase.v1.IssueComment.StageEnd
-	32, // 28: bytebase.v1.IssueComment.task_update:type_name -> bytebase.v1.IssueComment.TaskUpdate
-	33, // 29: bytebase.v1.IssueComment.task_prior_backup:type_name -> bytebase.v1.IssueComment.TaskPriorBackup
-	3,  // 30: bytebase.v1.Issue.Approver.status:type_name -> bytebase.v1.Issue.Approver.Status
-	4,  // 31: bytebase.v1.IssueComment.Approval.status:type_name -> bytebase.v1.IssueComment.Approval.Status
-	0,  // 32: bytebase.v1.IssueComment.IssueUpdate.from_status:type_name -> bytebase.v1.IssueStatus
-	0,  // 33: bytebase.v1.IssueComment.IssueUpdate.to_status:type_name -> bytebase.v1.IssueStatus
-	5,  // 34: bytebase.v1.IssueComment.TaskUpdate.to_status:type_name -> bytebase.v1.IssueComment.TaskUpdate.Status
-	34, // 35: bytebase.v1.IssueComment.TaskPriorBackup.tables:type_name -> bytebase.v1.IssueComment.TaskPriorBackup.Table
-	6,  // 36: bytebase.v1.IssueService.GetIssue:input_type -> bytebase.v1.GetIssueRequest
-	7,  // 37: bytebase.v1.IssueService.CreateIssue:input_type -> bytebase.v1.CreateIssueRequest
-	8,  // 38: bytebase.v1.IssueService.ListIssues:input_type -> bytebase.v1.ListIssuesRequest
-	10, // 39: bytebase.v1.IssueService.SearchIssues:input_type -> bytebase.v1.SearchIssuesRequest
-	12, // 40: bytebase.v1.IssueService.UpdateIssue:input_type -> bytebase.v1.UpdateIssueRequest
-	22, // 41: bytebase.v1.IssueService.ListIssueComments:input_type -> bytebase.v1.ListIssueCommentsRequest
-	24, // 42: bytebase.v1.IssueService.CreateIssueComment:input_type -> bytebase.v1.CreateIssueCommentRequest
-	25, // 43: bytebase.v1.IssueService.UpdateIssueComment:input_type -> bytebase.v1.UpdateIssueCommentRequest
-	13, // 44: bytebase.v1.IssueService.BatchUpdateIssuesStatus:input_type -> bytebase.v1.BatchUpdateIssuesStatusRequest
-	15, // 45: bytebase.v1.IssueService.ApproveIssue:input_type -> bytebase.v1.ApproveIssueRequest
-	16, // 46: bytebase.v1.IssueService.RejectIssue:input_type -> bytebase.v1.RejectIssueRequest
-	17, // 47: bytebase.v1.IssueService.RequestIssue:input_type -> bytebase.v1.RequestIssueRequest
-	18, // 48: bytebase.v1.IssueService.GetIssue:output_type -> bytebase.v1.Issue
-	18, // 49: bytebase.v1.IssueService.CreateIssue:output_type -> bytebase.v1.Issue
-	9,  // 50: bytebase.v1.IssueService.ListIssues:output_type -> bytebase.v1.ListIssuesResponse
-	11, // 51: bytebase.v1.IssueService.SearchIssues:output_type -> bytebase.v1.SearchIssuesResponse
-	18, // 52: bytebase.v1.IssueService.UpdateIssue:output_type -> bytebase.v1.Issue
-	23, // 53: bytebase.v1.IssueService.ListIssueComments:output_type -> bytebase.v1.ListIssueCommentsResponse
-	26, // 54: bytebase.v1.IssueService.CreateIssueComment:output_type -> bytebase.v1.IssueComment
-	26, // 55: bytebase.v1.IssueService.UpdateIssueComment:output_type -> bytebase.v1.IssueComment
-	14, // 56: bytebase.v1.IssueService.BatchUpdateIssuesStatus:output_type -> bytebase.v1.BatchUpdateIssuesStatusResponse
-	18, // 57: bytebase.v1.IssueService.ApproveIssue:output_type -> bytebase.v1.Issue
-	18, // 58: bytebase.v1.IssueService.RejectIssue:output_type -> bytebase.v1.Issue
-	18, // 59: bytebase.v1.IssueService.RequestIssue:output_type -> bytebase.v1.Issue
-	48, // [48:60] is the sub-list for method output_type
-	36, // [36:48] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	19, // 0: bytebase.v1.CreateIssueRequest.issue:type_name -> bytebase.v1.Issue
+	36, // 1: bytebase.v1.BreakGlassRequest.duration:type_name -> google.protobuf.Duration
+	19, // 2: bytebase.v1.ListIssuesResponse.issues:type_name -> bytebase.v1.Issue
+	19, // 3: bytebase.v1.SearchIssuesResponse.issues:type_name -> bytebase.v1.Issue
+	19, // 4: bytebase.v1.UpdateIssueRequest.issue:type_name -> bytebase.v1.Issue
+	37, // 5: bytebase.v1.UpdateIssueRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: bytebase.v1.BatchUpdateIssuesStatusRequest.status:type_name -> bytebase.v1.IssueStatus
+	1,  // 7: bytebase.v1.Issue.type:type_name -> bytebase.v1.Issue.Type
+	0,  // 8: bytebase.v1.Issue.status:type_name -> bytebase.v1.IssueStatus
+	28, // 9: bytebase.v1.Issue.approvers:type_name -> bytebase.v1.Issue.Approver
+	21, // 10: bytebase.v1.Issue.approval_template:type_name -> bytebase.v1.ApprovalTemplate
+	38, // 11: bytebase.v1.Issue.create_time:type_name -> google.protobuf.Timestamp
+	38, // 12: bytebase.v1.Issue.update_time:type_name -> google.protobuf.Timestamp
+	20, // 13: bytebase.v1.Issue.grant_request:type_name -> bytebase.v1.GrantRequest
+	39, // 14: bytebase.v1.Issue.risk_level:type_name -> bytebase.v1.RiskLevel
+	29, // 15: bytebase.v1.Issue.task_status_count:type_name -> bytebase.v1.Issue.TaskStatusCountEntry
+	2,  // 16: bytebase.v1.Issue.approval_status:type_name -> bytebase.v1.Issue.ApprovalStatus
+	40, // 17: bytebase.v1.GrantRequest.condition:type_name -> google.type.Expr
+	36, // 18: bytebase.v1.GrantRequest.expiration:type_name -> google.protobuf.Duration
+	22, // 19: bytebase.v1.ApprovalTemplate.flow:type_name -> bytebase.v1.ApprovalFlow
+	27, // 20: bytebase.v1.ListIssueCommentsResponse.issue_comments:type_name -> bytebase.v1.IssueComment
+	27, // 21: bytebase.v1.CreateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
+	27, // 22: bytebase.v1.UpdateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
+	37, // 23: bytebase.v1.UpdateIssueCommentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	38, // 24: bytebase.v1.IssueComment.create_time:type_name -> google.protobuf.Timestamp
+	38, // 25: bytebase.v1.IssueComment.update_time:type_name -> google.protobuf.Timestamp
+	30, // 26: bytebase.v1.IssueComment.approval:type_name -> bytebase.v1.IssueComment.Approval
+	31, // 27: bytebase.v1.IssueComment.issue_update:type_name -> bytebase.v1.IssueComment.IssueUpdate
+	32, // 28: bytebase.v1.IssueComment.stage_end:type_name -> bytebase.v1.IssueComment.StageEnd
+	33, // 29: bytebase.v1.IssueComment.task_update:type_name -> bytebase.v1.IssueComment.TaskUpdate
+	34, // 30: bytebase.v1.IssueComment.task_prior_backup:type_name -> bytebase.v1.IssueComment.TaskPriorBackup
+	3,  // 31: bytebase.v1.Issue.Approver.status:type_name -> bytebase.v1.Issue.Approver.Status
+	4,  // 32: bytebase.v1.IssueComment.Approval.status:type_name -> bytebase.v1.IssueComment.Approval.Status
+	0,  // 33: bytebase.v1.IssueComment.IssueUpdate.from_status:type_name -> bytebase.v1.IssueStatus
+	0,  // 34: bytebase.v1.IssueComment.IssueUpdate.to_status:type_name -> bytebase.v1.IssueStatus
+	5,  // 35: bytebase.v1.IssueComment.TaskUpdate.to_status:type_name -> bytebase.v1.IssueComment.TaskUpdate.Status
+	35, // 36: bytebase.v1.IssueComment.TaskPriorBackup.tables:type_name -> bytebase.v1.IssueComment.TaskPriorBackup.Table
+	6,  // 37: bytebase.v1.IssueService.GetIssue:input_type -> bytebase.v1.GetIssueRequest
+	7,  // 38: bytebase.v1.IssueService.CreateIssue:input_type -> bytebase.v1.CreateIssueRequest
+	9,  // 39: bytebase.v1.IssueService.ListIssues:input_type -> bytebase.v1.ListIssuesRequest
+	11, // 40: bytebase.v1.IssueService.SearchIssues:input_type -> bytebase.v1.SearchIssuesRequest
+	13, // 41: bytebase.v1.IssueService.UpdateIssue:input_type -> bytebase.v1.UpdateIssueRequest
+	23, // 42: bytebase.v1.IssueService.ListIssueComments:input_type -> bytebase.v1.ListIssueCommentsRequest
+	25, // 43: bytebase.v1.IssueService.CreateIssueComment:input_type -> bytebase.v1.CreateIssueCommentRequest
+	26, // 44: bytebase.v1.IssueService.UpdateIssueComment:input_type -> bytebase.v1.UpdateIssueCommentRequest
+	14, // 45: bytebase.v1.IssueService.BatchUpdateIssuesStatus:input_type -> bytebase.v1.BatchUpdateIssuesStatusRequest
+	16, // 46: bytebase.v1.IssueService.ApproveIssue:input_type -> bytebase.v1.ApproveIssueRequest
+	17, // 47: bytebase.v1.IssueService.RejectIssue:input_type -> bytebase.v1.RejectIssueRequest
+	18, // 48: bytebase.v1.IssueService.RequestIssue:input_type -> bytebase.v1.RequestIssueRequest
+	8,  // 49: bytebase.v1.IssueService.BreakGlass:input_type -> bytebase.v1.BreakGlassRequest
+	19, // 50: bytebase.v1.IssueService.GetIssue:output_type -> bytebase.v1.Issue
+	19, // 51: bytebase.v1.IssueService.CreateIssue:output_type -> bytebase.v1.Issue
+	10, // 52: bytebase.v1.IssueService.ListIssues:output_type -> bytebase.v1.ListIssuesResponse
+	12, // 53: bytebase.v1.IssueService.SearchIssues:output_type -> bytebase.v1.SearchIssuesResponse
+	19, // 54: bytebase.v1.IssueService.UpdateIssue:output_type -> bytebase.v1.Issue
+	24, // 55: bytebase.v1.IssueService.ListIssueComments:output_type -> bytebase.v1.ListIssueCommentsResponse
+	27, // 56: bytebase.v1.IssueService.CreateIssueComment:output_type -> bytebase.v1.IssueComment
+	27, // 57: bytebase.v1.IssueService.UpdateIssueComment:output_type -> bytebase.v1.IssueComment
+	15, // 58: bytebase.v1.IssueService.BatchUpdateIssuesStatus:output_type -> bytebase.v1.BatchUpdateIssuesStatusResponse
+	19, // 59: bytebase.v1.IssueService.ApproveIssue:output_type -> bytebase.v1.Issue
+	19, // 60: bytebase.v1.IssueService.RejectIssue:output_type -> bytebase.v1.Issue
+	19, // 61: bytebase.v1.IssueService.RequestIssue:output_type -> bytebase.v1.Issue
+	19, // 62: bytebase.v1.IssueService.BreakGlass:output_type -> bytebase.v1.Issue
+	50, // [50:63] is the sub-list for method output_type
+	37, // [37:50] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_v1_issue_service_proto_init() }
@@ -2831,23 +2929,23 @@ func file_v1_issue_service_proto_init() {
 	}
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
-	file_v1_issue_service_proto_msgTypes[20].OneofWrappers = []any{
+	file_v1_issue_service_proto_msgTypes[21].OneofWrappers = []any{
 		(*IssueComment_Approval_)(nil),
 		(*IssueComment_IssueUpdate_)(nil),
 		(*IssueComment_StageEnd_)(nil),
 		(*IssueComment_TaskUpdate_)(nil),
 		(*IssueComment_TaskPriorBackup_)(nil),
 	}
-	file_v1_issue_service_proto_msgTypes[24].OneofWrappers = []any{}
-	file_v1_issue_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_v1_issue_service_proto_msgTypes[25].OneofWrappers = []any{}
 	file_v1_issue_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_v1_issue_service_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_issue_service_proto_rawDesc), len(file_v1_issue_service_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   29,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -41,6 +41,33 @@ func (x *CreateIssueRequest) Equal(y *CreateIssueRequest) bool {
 	return true
 }
 
+func (x *BreakGlassRequest) Equal(y *BreakGlassRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if len(x.Databases) != len(y.Databases) {
+		return false
+	}
+	for i := 0; i < len(x.Databases); i++ {
+		if x.Databases[i] != y.Databases[i] {
+			return false
+		}
+	}
+	if x.Justification != y.Justification {
+		return false
+	}
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
 func (x *ListIssuesRequest) Equal(y *ListIssuesRequest) bool {
 	if x == y {
 		return true
@@ -369,6 +396,9 @@ func (x *GrantRequest) Equal(y *GrantRequest) bool {
 	if p, q := x.Expiration, y.Expiration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if x.BreakGlass != y.BreakGlass {
+		return false
+	}
 	return true
 }
 
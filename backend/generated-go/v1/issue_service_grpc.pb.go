@@ -31,6 +31,7 @@ const (
 	IssueService_ApproveIssue_FullMethodName            = "/bytebase.v1.IssueService/ApproveIssue"
 	IssueService_RejectIssue_FullMethodName             = "/bytebase.v1.IssueService/RejectIssue"
 	IssueService_RequestIssue_FullMethodName            = "/bytebase.v1.IssueService/RequestIssue"
+	IssueService_BreakGlass_FullMethodName              = "/bytebase.v1.IssueService/BreakGlass"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -75,6 +76,10 @@ type IssueServiceClient interface {
 	// Requests changes on an issue. Access determined by approval flow configuration - caller must be a designated approver for the current approval step.
 	// Permissions required: None (determined by approval flow)
 	RequestIssue(ctx context.Context, in *RequestIssueRequest, opts ...grpc.CallOption) (*Issue, error)
+	// Grants the caller emergency SQL Editor access to databases without going through the approval flow.
+	// The access expires after the requested duration, and a grant request issue is created for the post-incident review.
+	// Permissions required: bb.issues.breakGlass
+	BreakGlass(ctx context.Context, in *BreakGlassRequest, opts ...grpc.CallOption) (*Issue, error)
 }
 
 type issueServiceClient struct {
@@ -205,6 +210,16 @@ func (c *issueServiceClient) RequestIssue(ctx context.Context, in *RequestIssueR
 	return out, nil
 }
 
+func (c *issueServiceClient) BreakGlass(ctx context.Context, in *BreakGlassRequest, opts ...grpc.CallOption) (*Issue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Issue)
+	err := c.cc.Invoke(ctx, IssueService_BreakGlass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueServiceServer is the server API for IssueService service.
 // All implementations must embed UnimplementedIssueServiceServer
 // for forward compatibility.
@@ -247,6 +262,10 @@ type IssueServiceServer interface {
 	// Requests changes on an issue. Access determined by approval flow configuration - caller must be a designated approver for the current approval step.
 	// Permissions required: None (determined by approval flow)
 	RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error)
+	// Grants the caller emergency SQL Editor access to databases without going through the approval flow.
+	// The access expires after the requested duration, and a grant request issue is created for the post-incident review.
+	// Permissions required: bb.issues.breakGlass
+	BreakGlass(context.Context, *BreakGlassRequest) (*Issue, error)
 	mustEmbedUnimplementedIssueServiceServer()
 }
 
@@ -293,6 +312,9 @@ func (UnimplementedIssueServiceServer) RejectIssue(context.Context, *RejectIssue
 func (UnimplementedIssueServiceServer) RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error) {
 	return nil, status.Error(codes.Unimplemented, "method RequestIssue not implemented")
 }
+func (UnimplementedIssueServiceServer) BreakGlass(context.Context, *BreakGlassRequest) (*Issue, error) {
+	return nil, status.Error(codes.Unimplemented, "method BreakGlass not implemented")
+}
 func (UnimplementedIssueServiceServer) mustEmbedUnimplementedIssueServiceServer() {}
 func (UnimplementedIssueServiceServer) testEmbeddedByValue()                      {}
 
@@ -530,6 +552,24 @@ func _IssueService_RequestIssue_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_BreakGlass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreakGlassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).BreakGlass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_BreakGlass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).BreakGlass(ctx, req.(*BreakGlassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueService_ServiceDesc is the grpc.ServiceDesc for IssueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -585,6 +625,10 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestIssue",
 			Handler:    _IssueService_RequestIssue_Handler,
 		},
+		{
+			MethodName: "BreakGlass",
+			Handler:    _IssueService_BreakGlass_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/issue_service.proto",
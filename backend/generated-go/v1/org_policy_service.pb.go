@@ -44,6 +44,8 @@ const (
 	PolicyType_DATA_SOURCE_QUERY PolicyType = 14
 	// Query data access policy.
 	PolicyType_DATA_QUERY PolicyType = 16
+	// Row-level security policy.
+	PolicyType_ROW_ACCESS PolicyType = 17
 )
 
 // Enum value maps for PolicyType.
@@ -56,6 +58,7 @@ var (
 		13: "TAG",
 		14: "DATA_SOURCE_QUERY",
 		16: "DATA_QUERY",
+		17: "ROW_ACCESS",
 	}
 	PolicyType_value = map[string]int32{
 		"POLICY_TYPE_UNSPECIFIED": 0,
@@ -65,6 +68,7 @@ var (
 		"TAG":                     13,
 		"DATA_SOURCE_QUERY":       14,
 		"DATA_QUERY":              16,
+		"ROW_ACCESS":              17,
 	}
 )
 
@@ -254,7 +258,7 @@ func (x RolloutPolicy_Checkers_PlanCheckEnforcement) Number() protoreflect.EnumN
 
 // Deprecated: Use RolloutPolicy_Checkers_PlanCheckEnforcement.Descriptor instead.
 func (RolloutPolicy_Checkers_PlanCheckEnforcement) EnumDescriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 0, 0}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 1, 0}
 }
 
 // The action that the exception permits.
@@ -360,7 +364,7 @@ func (x DataSourceQueryPolicy_Restriction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataSourceQueryPolicy_Restriction.Descriptor instead.
 func (DataSourceQueryPolicy_Restriction) EnumDescriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{13, 0}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type CreatePolicyRequest struct {
@@ -729,6 +733,7 @@ type Policy struct {
 	//	*Policy_TagPolicy
 	//	*Policy_DataSourceQueryPolicy
 	//	*Policy_QueryDataPolicy
+	//	*Policy_RowAccessPolicy
 	Policy isPolicy_Policy `protobuf_oneof:"policy"`
 	// Whether the policy is enforced.
 	Enforce bool `protobuf:"varint,13,opt,name=enforce,proto3" json:"enforce,omitempty"`
@@ -850,6 +855,15 @@ func (x *Policy) GetQueryDataPolicy() *QueryDataPolicy {
 	return nil
 }
 
+func (x *Policy) GetRowAccessPolicy() *RowAccessPolicy {
+	if x != nil {
+		if x, ok := x.Policy.(*Policy_RowAccessPolicy); ok {
+			return x.RowAccessPolicy
+		}
+	}
+	return nil
+}
+
 func (x *Policy) GetEnforce() bool {
 	if x != nil {
 		return x.Enforce
@@ -892,6 +906,10 @@ type Policy_QueryDataPolicy struct {
 	QueryDataPolicy *QueryDataPolicy `protobuf:"bytes,24,opt,name=query_data_policy,json=queryDataPolicy,proto3,oneof"`
 }
 
+type Policy_RowAccessPolicy struct {
+	RowAccessPolicy *RowAccessPolicy `protobuf:"bytes,25,opt,name=row_access_policy,json=rowAccessPolicy,proto3,oneof"`
+}
+
 func (*Policy_RolloutPolicy) isPolicy_Policy() {}
 
 func (*Policy_MaskingRulePolicy) isPolicy_Policy() {}
@@ -904,6 +922,8 @@ func (*Policy_DataSourceQueryPolicy) isPolicy_Policy() {}
 
 func (*Policy_QueryDataPolicy) isPolicy_Policy() {}
 
+func (*Policy_RowAccessPolicy) isPolicy_Policy() {}
+
 // Rollout policy configuration.
 type RolloutPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -913,7 +933,10 @@ type RolloutPolicy struct {
 	Roles []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	// Checkers that must pass before rollout execution.
 	// These checks are performed in UI workflows only.
-	Checkers      *RolloutPolicy_Checkers `protobuf:"bytes,4,opt,name=checkers,proto3" json:"checkers,omitempty"`
+	Checkers *RolloutPolicy_Checkers `protobuf:"bytes,4,opt,name=checkers,proto3" json:"checkers,omitempty"`
+	// The window in which tasks of the environment can be rolled out.
+	// Pending tasks wait until the window opens. No restriction if unset.
+	Window        *RolloutPolicy_Window `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -969,6 +992,13 @@ func (x *RolloutPolicy) GetCheckers() *RolloutPolicy_Checkers {
 	return nil
 }
 
+func (x *RolloutPolicy) GetWindow() *RolloutPolicy_Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
 // QueryDataPolicy is the policy configuration for querying data.
 type QueryDataPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1227,6 +1257,55 @@ func (x *MaskingRulePolicy) GetRules() []*MaskingRulePolicy_MaskingRule {
 	return nil
 }
 
+// Policy for filtering the rows of tables returned by the queries in the SQL editor.
+type RowAccessPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of rules.
+	// The predicates of all rules that apply to the user on the same table are combined with AND.
+	// Queries reading the filtered tables are only supported for MySQL and PostgreSQL, and are rejected
+	// if they cannot be rewritten safely, e.g. reading the tables through views.
+	Rules         []*RowAccessPolicy_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowAccessPolicy) Reset() {
+	*x = RowAccessPolicy{}
+	mi := &file_v1_org_policy_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowAccessPolicy) ProtoMessage() {}
+
+func (x *RowAccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_org_policy_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowAccessPolicy.ProtoReflect.Descriptor instead.
+func (*RowAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RowAccessPolicy) GetRules() []*RowAccessPolicy_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 // Policy for tagging resources with metadata.
 type TagPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1239,7 +1318,7 @@ type TagPolicy struct {
 
 func (x *TagPolicy) Reset() {
 	*x = TagPolicy{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[12]
+	mi := &file_v1_org_policy_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1251,7 +1330,7 @@ func (x *TagPolicy) String() string {
 func (*TagPolicy) ProtoMessage() {}
 
 func (x *TagPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[12]
+	mi := &file_v1_org_policy_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1343,7 @@ func (x *TagPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagPolicy.ProtoReflect.Descriptor instead.
 func (*TagPolicy) Descriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{12}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TagPolicy) GetTags() map[string]string {
@@ -1289,7 +1368,7 @@ type DataSourceQueryPolicy struct {
 
 func (x *DataSourceQueryPolicy) Reset() {
 	*x = DataSourceQueryPolicy{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[13]
+	mi := &file_v1_org_policy_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1301,7 +1380,7 @@ func (x *DataSourceQueryPolicy) String() string {
 func (*DataSourceQueryPolicy) ProtoMessage() {}
 
 func (x *DataSourceQueryPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[13]
+	mi := &file_v1_org_policy_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,7 +1393,7 @@ func (x *DataSourceQueryPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceQueryPolicy.ProtoReflect.Descriptor instead.
 func (*DataSourceQueryPolicy) Descriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{13}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DataSourceQueryPolicy) GetAdminDataSourceRestriction() DataSourceQueryPolicy_Restriction {
@@ -1338,6 +1417,71 @@ func (x *DataSourceQueryPolicy) GetDisallowDml() bool {
 	return false
 }
 
+type RolloutPolicy_Window struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IANA time zone of the time ranges and blackout dates, e.g. "America/New_York".
+	// UTC is used if empty.
+	TimeZone string `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The recurring time ranges in which rollouts are allowed.
+	// Rollouts are allowed at any time of a day if empty.
+	TimeRanges []*RolloutPolicy_Window_TimeRange `protobuf:"bytes,2,rep,name=time_ranges,json=timeRanges,proto3" json:"time_ranges,omitempty"`
+	// The dates on which rollouts are not allowed, in the format of "YYYY-MM-DD".
+	BlackoutDates []string `protobuf:"bytes,3,rep,name=blackout_dates,json=blackoutDates,proto3" json:"blackout_dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutPolicy_Window) Reset() {
+	*x = RolloutPolicy_Window{}
+	mi := &file_v1_org_policy_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutPolicy_Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPolicy_Window) ProtoMessage() {}
+
+func (x *RolloutPolicy_Window) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_org_policy_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPolicy_Window.ProtoReflect.Descriptor instead.
+func (*RolloutPolicy_Window) Descriptor() ([]byte, []int) {
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RolloutPolicy_Window) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *RolloutPolicy_Window) GetTimeRanges() []*RolloutPolicy_Window_TimeRange {
+	if x != nil {
+		return x.TimeRanges
+	}
+	return nil
+}
+
+func (x *RolloutPolicy_Window) GetBlackoutDates() []string {
+	if x != nil {
+		return x.BlackoutDates
+	}
+	return nil
+}
+
 type RolloutPolicy_Checkers struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Whether issue approval is required before proceeding with rollout.
@@ -1350,7 +1494,7 @@ type RolloutPolicy_Checkers struct {
 
 func (x *RolloutPolicy_Checkers) Reset() {
 	*x = RolloutPolicy_Checkers{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[14]
+	mi := &file_v1_org_policy_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1362,7 +1506,7 @@ func (x *RolloutPolicy_Checkers) String() string {
 func (*RolloutPolicy_Checkers) ProtoMessage() {}
 
 func (x *RolloutPolicy_Checkers) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[14]
+	mi := &file_v1_org_policy_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,7 +1519,7 @@ func (x *RolloutPolicy_Checkers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RolloutPolicy_Checkers.ProtoReflect.Descriptor instead.
 func (*RolloutPolicy_Checkers) Descriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 0}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *RolloutPolicy_Checkers) GetRequiredIssueApproval() bool {
@@ -1392,6 +1536,71 @@ func (x *RolloutPolicy_Checkers) GetRequiredStatusChecks() *RolloutPolicy_Checke
 	return nil
 }
 
+type RolloutPolicy_Window_TimeRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The days of the week, from 0 (Sunday) to 6 (Saturday).
+	// Every day if empty.
+	DaysOfWeek []int32 `protobuf:"varint,1,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	// The start time of the range in the format of "HH:MM", inclusive.
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end time of the range in the format of "HH:MM", exclusive.
+	// The range crosses midnight if the end time is not after the start time.
+	EndTime       string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutPolicy_Window_TimeRange) Reset() {
+	*x = RolloutPolicy_Window_TimeRange{}
+	mi := &file_v1_org_policy_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutPolicy_Window_TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPolicy_Window_TimeRange) ProtoMessage() {}
+
+func (x *RolloutPolicy_Window_TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_org_policy_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPolicy_Window_TimeRange.ProtoReflect.Descriptor instead.
+func (*RolloutPolicy_Window_TimeRange) Descriptor() ([]byte, []int) {
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 0, 0}
+}
+
+func (x *RolloutPolicy_Window_TimeRange) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *RolloutPolicy_Window_TimeRange) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *RolloutPolicy_Window_TimeRange) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type RolloutPolicy_Checkers_RequiredStatusChecks struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enforcement level for plan check results during rollout validation.
@@ -1402,7 +1611,7 @@ type RolloutPolicy_Checkers_RequiredStatusChecks struct {
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) Reset() {
 	*x = RolloutPolicy_Checkers_RequiredStatusChecks{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[15]
+	mi := &file_v1_org_policy_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1414,7 +1623,7 @@ func (x *RolloutPolicy_Checkers_RequiredStatusChecks) String() string {
 func (*RolloutPolicy_Checkers_RequiredStatusChecks) ProtoMessage() {}
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[15]
+	mi := &file_v1_org_policy_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1636,7 @@ func (x *RolloutPolicy_Checkers_RequiredStatusChecks) ProtoReflect() protoreflec
 
 // Deprecated: Use RolloutPolicy_Checkers_RequiredStatusChecks.ProtoReflect.Descriptor instead.
 func (*RolloutPolicy_Checkers_RequiredStatusChecks) Descriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 0, 0}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{7, 1, 0}
 }
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) GetPlanCheckEnforcement() RolloutPolicy_Checkers_PlanCheckEnforcement {
@@ -1470,7 +1679,7 @@ type MaskingExceptionPolicy_MaskingException struct {
 
 func (x *MaskingExceptionPolicy_MaskingException) Reset() {
 	*x = MaskingExceptionPolicy_MaskingException{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[16]
+	mi := &file_v1_org_policy_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1482,7 +1691,7 @@ func (x *MaskingExceptionPolicy_MaskingException) String() string {
 func (*MaskingExceptionPolicy_MaskingException) ProtoMessage() {}
 
 func (x *MaskingExceptionPolicy_MaskingException) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[16]
+	mi := &file_v1_org_policy_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +1765,7 @@ type MaskingRulePolicy_MaskingRule struct {
 
 func (x *MaskingRulePolicy_MaskingRule) Reset() {
 	*x = MaskingRulePolicy_MaskingRule{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[17]
+	mi := &file_v1_org_policy_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1568,7 +1777,7 @@ func (x *MaskingRulePolicy_MaskingRule) String() string {
 func (*MaskingRulePolicy_MaskingRule) ProtoMessage() {}
 
 func (x *MaskingRulePolicy_MaskingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[17]
+	mi := &file_v1_org_policy_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,6 +1814,101 @@ func (x *MaskingRulePolicy_MaskingRule) GetSemanticType() string {
 	return ""
 }
 
+// A rule that filters the rows of a table for the users matching the condition.
+type RowAccessPolicy_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database of the table.
+	// Format: instances/{instance}/databases/{database}
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// The schema of the table. Empty for the engines without schemas, e.g. MySQL.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table name.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The condition deciding whether the rule applies to the user.
+	// The syntax and semantics of CEL are documented at https://github.com/google/cel-spec
+	// The rule applies to all users if the condition is empty.
+	//
+	// Support variables:
+	// user.email: the email of the user.
+	// user.groups: the emails of the groups that the user belongs to.
+	// request.time: the time of the query.
+	//
+	// For example:
+	// user.email == "alice@example.com"
+	// "sales@example.com" in user.groups && request.time < timestamp("2025-04-30T11:10:39.000Z")
+	Condition *expr.Expr `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The SQL boolean expression on the columns of the table that the returned rows must satisfy.
+	// For example, `region = 'EMEA'`.
+	Predicate     string `protobuf:"bytes,5,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowAccessPolicy_Rule) Reset() {
+	*x = RowAccessPolicy_Rule{}
+	mi := &file_v1_org_policy_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowAccessPolicy_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowAccessPolicy_Rule) ProtoMessage() {}
+
+func (x *RowAccessPolicy_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_org_policy_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowAccessPolicy_Rule.ProtoReflect.Descriptor instead.
+func (*RowAccessPolicy_Rule) Descriptor() ([]byte, []int) {
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *RowAccessPolicy_Rule) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *RowAccessPolicy_Rule) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *RowAccessPolicy_Rule) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *RowAccessPolicy_Rule) GetCondition() *expr.Expr {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *RowAccessPolicy_Rule) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
 var File_v1_org_policy_service_proto protoreflect.FileDescriptor
 
 const file_v1_org_policy_service_proto_rawDesc = "" +
@@ -1632,7 +1936,7 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeletedB\x0e\n" +
 	"\f_policy_type\"G\n" +
 	"\x14ListPoliciesResponse\x12/\n" +
-	"\bpolicies\x18\x01 \x03(\v2\x13.bytebase.v1.PolicyR\bpolicies\"\xb8\a\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x13.bytebase.v1.PolicyR\bpolicies\"\x84\b\n" +
 	"\x06Policy\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
 	"\x13inherit_from_parent\x18\x04 \x01(\bR\x11inheritFromParent\x12+\n" +
@@ -1643,15 +1947,28 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\n" +
 	"tag_policy\x18\x15 \x01(\v2\x16.bytebase.v1.TagPolicyH\x00R\ttagPolicy\x12]\n" +
 	"\x18data_source_query_policy\x18\x16 \x01(\v2\".bytebase.v1.DataSourceQueryPolicyH\x00R\x15dataSourceQueryPolicy\x12J\n" +
-	"\x11query_data_policy\x18\x18 \x01(\v2\x1c.bytebase.v1.QueryDataPolicyH\x00R\x0fqueryDataPolicy\x12\x18\n" +
+	"\x11query_data_policy\x18\x18 \x01(\v2\x1c.bytebase.v1.QueryDataPolicyH\x00R\x0fqueryDataPolicy\x12J\n" +
+	"\x11row_access_policy\x18\x19 \x01(\v2\x1c.bytebase.v1.RowAccessPolicyH\x00R\x0frowAccessPolicy\x12\x18\n" +
 	"\aenforce\x18\r \x01(\bR\aenforce\x12I\n" +
 	"\rresource_type\x18\x0e \x01(\x0e2\x1f.bytebase.v1.PolicyResourceTypeB\x03\xe0A\x03R\fresourceType:\xe5\x01\xeaA\xe1\x01\n" +
 	"\x13bytebase.com/Policy\x12\x11policies/{policy}\x12$projects/{project}/policies/{policy}\x12,environments/{environment}/policies/{policy}\x12&instances/{instance}/policies/{policy}\x12;instances/{instance}/databases/{database}/policies/{policy}B\b\n" +
-	"\x06policyJ\x04\b\x02\x10\x03J\x04\b\x17\x10\x18\"\x9e\x04\n" +
+	"\x06policyJ\x04\b\x02\x10\x03J\x04\b\x17\x10\x18\"\xdf\x06\n" +
 	"\rRolloutPolicy\x12\x1c\n" +
 	"\tautomatic\x18\x01 \x01(\bR\tautomatic\x12\x14\n" +
 	"\x05roles\x18\x02 \x03(\tR\x05roles\x12?\n" +
-	"\bcheckers\x18\x04 \x01(\v2#.bytebase.v1.RolloutPolicy.CheckersR\bcheckers\x1a\x97\x03\n" +
+	"\bcheckers\x18\x04 \x01(\v2#.bytebase.v1.RolloutPolicy.CheckersR\bcheckers\x129\n" +
+	"\x06window\x18\x05 \x01(\v2!.bytebase.v1.RolloutPolicy.WindowR\x06window\x1a\x83\x02\n" +
+	"\x06Window\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12L\n" +
+	"\vtime_ranges\x18\x02 \x03(\v2+.bytebase.v1.RolloutPolicy.Window.TimeRangeR\n" +
+	"timeRanges\x12%\n" +
+	"\x0eblackout_dates\x18\x03 \x03(\tR\rblackoutDates\x1ag\n" +
+	"\tTimeRange\x12 \n" +
+	"\fdays_of_week\x18\x01 \x03(\x05R\n" +
+	"daysOfWeek\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x1a\x97\x03\n" +
 	"\bCheckers\x126\n" +
 	"\x17required_issue_approval\x18\x01 \x01(\bR\x15requiredIssueApproval\x12n\n" +
 	"\x16required_status_checks\x18\x02 \x01(\v28.bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecksR\x14requiredStatusChecks\x1a\x86\x01\n" +
@@ -1691,7 +2008,15 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\vMaskingRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
 	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\x12#\n" +
-	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"z\n" +
+	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"\xec\x01\n" +
+	"\x0fRowAccessPolicy\x127\n" +
+	"\x05rules\x18\x01 \x03(\v2!.bytebase.v1.RowAccessPolicy.RuleR\x05rules\x1a\x9f\x01\n" +
+	"\x04Rule\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x12/\n" +
+	"\tcondition\x18\x04 \x01(\v2\x11.google.type.ExprR\tcondition\x12\x1c\n" +
+	"\tpredicate\x18\x05 \x01(\tR\tpredicate\"z\n" +
 	"\tTagPolicy\x124\n" +
 	"\x04tags\x18\x01 \x03(\v2 .bytebase.v1.TagPolicy.TagsEntryR\x04tags\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
@@ -1704,7 +2029,7 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\vRestriction\x12\x1b\n" +
 	"\x17RESTRICTION_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bFALLBACK\x10\x01\x12\f\n" +
-	"\bDISALLOW\x10\x02*\xc0\x01\n" +
+	"\bDISALLOW\x10\x02*\xd0\x01\n" +
 	"\n" +
 	"PolicyType\x12\x1b\n" +
 	"\x17POLICY_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
@@ -1715,7 +2040,9 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\x03TAG\x10\r\x12\x15\n" +
 	"\x11DATA_SOURCE_QUERY\x10\x0e\x12\x0e\n" +
 	"\n" +
-	"DATA_QUERY\x10\x10\"\x04\b\x02\x10\x02\"\x04\b\x04\x10\x04\"\x04\b\x06\x10\x06\"\x04\b\x05\x10\x05\"\x04\b\a\x10\a\"\x04\b\f\x10\f\"\x04\b\x0f\x10\x0f*`\n" +
+	"DATA_QUERY\x10\x10\x12\x0e\n" +
+	"\n" +
+	"ROW_ACCESS\x10\x11\"\x04\b\x02\x10\x02\"\x04\b\x04\x10\x04\"\x04\b\x06\x10\x06\"\x04\b\x05\x10\x05\"\x04\b\a\x10\a\"\x04\b\f\x10\f\"\x04\b\x0f\x10\x0f*`\n" +
 	"\x12PolicyResourceType\x12\x1d\n" +
 	"\x19RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\x0f\n" +
@@ -1746,7 +2073,7 @@ func file_v1_org_policy_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_org_policy_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_v1_org_policy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_v1_org_policy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_v1_org_policy_service_proto_goTypes = []any{
 	(PolicyType)(0),                                     // 0: bytebase.v1.PolicyType
 	(PolicyResourceType)(0),                             // 1: bytebase.v1.PolicyResourceType
@@ -1766,62 +2093,71 @@ var file_v1_org_policy_service_proto_goTypes = []any{
 	(*SQLReviewRule)(nil),                               // 15: bytebase.v1.SQLReviewRule
 	(*MaskingExceptionPolicy)(nil),                      // 16: bytebase.v1.MaskingExceptionPolicy
 	(*MaskingRulePolicy)(nil),                           // 17: bytebase.v1.MaskingRulePolicy
-	(*TagPolicy)(nil),                                   // 18: bytebase.v1.TagPolicy
-	(*DataSourceQueryPolicy)(nil),                       // 19: bytebase.v1.DataSourceQueryPolicy
-	(*RolloutPolicy_Checkers)(nil),                      // 20: bytebase.v1.RolloutPolicy.Checkers
-	(*RolloutPolicy_Checkers_RequiredStatusChecks)(nil), // 21: bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks
-	(*MaskingExceptionPolicy_MaskingException)(nil),     // 22: bytebase.v1.MaskingExceptionPolicy.MaskingException
-	(*MaskingRulePolicy_MaskingRule)(nil),               // 23: bytebase.v1.MaskingRulePolicy.MaskingRule
-	nil,                                                 // 24: bytebase.v1.TagPolicy.TagsEntry
-	(*fieldmaskpb.FieldMask)(nil),                       // 25: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),                         // 26: google.protobuf.Duration
-	(Engine)(0),                                         // 27: bytebase.v1.Engine
-	(*expr.Expr)(nil),                                   // 28: google.type.Expr
-	(*emptypb.Empty)(nil),                               // 29: google.protobuf.Empty
+	(*RowAccessPolicy)(nil),                             // 18: bytebase.v1.RowAccessPolicy
+	(*TagPolicy)(nil),                                   // 19: bytebase.v1.TagPolicy
+	(*DataSourceQueryPolicy)(nil),                       // 20: bytebase.v1.DataSourceQueryPolicy
+	(*RolloutPolicy_Window)(nil),                        // 21: bytebase.v1.RolloutPolicy.Window
+	(*RolloutPolicy_Checkers)(nil),                      // 22: bytebase.v1.RolloutPolicy.Checkers
+	(*RolloutPolicy_Window_TimeRange)(nil),              // 23: bytebase.v1.RolloutPolicy.Window.TimeRange
+	(*RolloutPolicy_Checkers_RequiredStatusChecks)(nil), // 24: bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks
+	(*MaskingExceptionPolicy_MaskingException)(nil),     // 25: bytebase.v1.MaskingExceptionPolicy.MaskingException
+	(*MaskingRulePolicy_MaskingRule)(nil),               // 26: bytebase.v1.MaskingRulePolicy.MaskingRule
+	(*RowAccessPolicy_Rule)(nil),                        // 27: bytebase.v1.RowAccessPolicy.Rule
+	nil,                                                 // 28: bytebase.v1.TagPolicy.TagsEntry
+	(*fieldmaskpb.FieldMask)(nil),                       // 29: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                         // 30: google.protobuf.Duration
+	(Engine)(0),                                         // 31: bytebase.v1.Engine
+	(*expr.Expr)(nil),                                   // 32: google.type.Expr
+	(*emptypb.Empty)(nil),                               // 33: google.protobuf.Empty
 }
 var file_v1_org_policy_service_proto_depIdxs = []int32{
 	12, // 0: bytebase.v1.CreatePolicyRequest.policy:type_name -> bytebase.v1.Policy
 	0,  // 1: bytebase.v1.CreatePolicyRequest.type:type_name -> bytebase.v1.PolicyType
 	12, // 2: bytebase.v1.UpdatePolicyRequest.policy:type_name -> bytebase.v1.Policy
-	25, // 3: bytebase.v1.UpdatePolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	29, // 3: bytebase.v1.UpdatePolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 4: bytebase.v1.ListPoliciesRequest.policy_type:type_name -> bytebase.v1.PolicyType
 	12, // 5: bytebase.v1.ListPoliciesResponse.policies:type_name -> bytebase.v1.Policy
 	0,  // 6: bytebase.v1.Policy.type:type_name -> bytebase.v1.PolicyType
 	13, // 7: bytebase.v1.Policy.rollout_policy:type_name -> bytebase.v1.RolloutPolicy
 	17, // 8: bytebase.v1.Policy.masking_rule_policy:type_name -> bytebase.v1.MaskingRulePolicy
 	16, // 9: bytebase.v1.Policy.masking_exception_policy:type_name -> bytebase.v1.MaskingExceptionPolicy
-	18, // 10: bytebase.v1.Policy.tag_policy:type_name -> bytebase.v1.TagPolicy
-	19, // 11: bytebase.v1.Policy.data_source_query_policy:type_name -> bytebase.v1.DataSourceQueryPolicy
+	19, // 10: bytebase.v1.Policy.tag_policy:type_name -> bytebase.v1.TagPolicy
+	20, // 11: bytebase.v1.Policy.data_source_query_policy:type_name -> bytebase.v1.DataSourceQueryPolicy
 	14, // 12: bytebase.v1.Policy.query_data_policy:type_name -> bytebase.v1.QueryDataPolicy
-	1,  // 13: bytebase.v1.Policy.resource_type:type_name -> bytebase.v1.PolicyResourceType
-	20, // 14: bytebase.v1.RolloutPolicy.checkers:type_name -> bytebase.v1.RolloutPolicy.Checkers
-	26, // 15: bytebase.v1.QueryDataPolicy.timeout:type_name -> google.protobuf.Duration
-	2,  // 16: bytebase.v1.SQLReviewRule.level:type_name -> bytebase.v1.SQLReviewRuleLevel
-	27, // 17: bytebase.v1.SQLReviewRule.engine:type_name -> bytebase.v1.Engine
-	22, // 18: bytebase.v1.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.v1.MaskingExceptionPolicy.MaskingException
-	23, // 19: bytebase.v1.MaskingRulePolicy.rules:type_name -> bytebase.v1.MaskingRulePolicy.MaskingRule
-	24, // 20: bytebase.v1.TagPolicy.tags:type_name -> bytebase.v1.TagPolicy.TagsEntry
-	5,  // 21: bytebase.v1.DataSourceQueryPolicy.admin_data_source_restriction:type_name -> bytebase.v1.DataSourceQueryPolicy.Restriction
-	21, // 22: bytebase.v1.RolloutPolicy.Checkers.required_status_checks:type_name -> bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks
-	3,  // 23: bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks.plan_check_enforcement:type_name -> bytebase.v1.RolloutPolicy.Checkers.PlanCheckEnforcement
-	4,  // 24: bytebase.v1.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.v1.MaskingExceptionPolicy.MaskingException.Action
-	28, // 25: bytebase.v1.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
-	28, // 26: bytebase.v1.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
-	9,  // 27: bytebase.v1.OrgPolicyService.GetPolicy:input_type -> bytebase.v1.GetPolicyRequest
-	10, // 28: bytebase.v1.OrgPolicyService.ListPolicies:input_type -> bytebase.v1.ListPoliciesRequest
-	6,  // 29: bytebase.v1.OrgPolicyService.CreatePolicy:input_type -> bytebase.v1.CreatePolicyRequest
-	7,  // 30: bytebase.v1.OrgPolicyService.UpdatePolicy:input_type -> bytebase.v1.UpdatePolicyRequest
-	8,  // 31: bytebase.v1.OrgPolicyService.DeletePolicy:input_type -> bytebase.v1.DeletePolicyRequest
-	12, // 32: bytebase.v1.OrgPolicyService.GetPolicy:output_type -> bytebase.v1.Policy
-	11, // 33: bytebase.v1.OrgPolicyService.ListPolicies:output_type -> bytebase.v1.ListPoliciesResponse
-	12, // 34: bytebase.v1.OrgPolicyService.CreatePolicy:output_type -> bytebase.v1.Policy
-	12, // 35: bytebase.v1.OrgPolicyService.UpdatePolicy:output_type -> bytebase.v1.Policy
-	29, // 36: bytebase.v1.OrgPolicyService.DeletePolicy:output_type -> google.protobuf.Empty
-	32, // [32:37] is the sub-list for method output_type
-	27, // [27:32] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	18, // 13: bytebase.v1.Policy.row_access_policy:type_name -> bytebase.v1.RowAccessPolicy
+	1,  // 14: bytebase.v1.Policy.resource_type:type_name -> bytebase.v1.PolicyResourceType
+	22, // 15: bytebase.v1.RolloutPolicy.checkers:type_name -> bytebase.v1.RolloutPolicy.Checkers
+	21, // 16: bytebase.v1.RolloutPolicy.window:type_name -> bytebase.v1.RolloutPolicy.Window
+	30, // 17: bytebase.v1.QueryDataPolicy.timeout:type_name -> google.protobuf.Duration
+	2,  // 18: bytebase.v1.SQLReviewRule.level:type_name -> bytebase.v1.SQLReviewRuleLevel
+	31, // 19: bytebase.v1.SQLReviewRule.engine:type_name -> bytebase.v1.Engine
+	25, // 20: bytebase.v1.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.v1.MaskingExceptionPolicy.MaskingException
+	26, // 21: bytebase.v1.MaskingRulePolicy.rules:type_name -> bytebase.v1.MaskingRulePolicy.MaskingRule
+	27, // 22: bytebase.v1.RowAccessPolicy.rules:type_name -> bytebase.v1.RowAccessPolicy.Rule
+	28, // 23: bytebase.v1.TagPolicy.tags:type_name -> bytebase.v1.TagPolicy.TagsEntry
+	5,  // 24: bytebase.v1.DataSourceQueryPolicy.admin_data_source_restriction:type_name -> bytebase.v1.DataSourceQueryPolicy.Restriction
+	23, // 25: bytebase.v1.RolloutPolicy.Window.time_ranges:type_name -> bytebase.v1.RolloutPolicy.Window.TimeRange
+	24, // 26: bytebase.v1.RolloutPolicy.Checkers.required_status_checks:type_name -> bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks
+	3,  // 27: bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks.plan_check_enforcement:type_name -> bytebase.v1.RolloutPolicy.Checkers.PlanCheckEnforcement
+	4,  // 28: bytebase.v1.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.v1.MaskingExceptionPolicy.MaskingException.Action
+	32, // 29: bytebase.v1.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
+	32, // 30: bytebase.v1.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
+	32, // 31: bytebase.v1.RowAccessPolicy.Rule.condition:type_name -> google.type.Expr
+	9,  // 32: bytebase.v1.OrgPolicyService.GetPolicy:input_type -> bytebase.v1.GetPolicyRequest
+	10, // 33: bytebase.v1.OrgPolicyService.ListPolicies:input_type -> bytebase.v1.ListPoliciesRequest
+	6,  // 34: bytebase.v1.OrgPolicyService.CreatePolicy:input_type -> bytebase.v1.CreatePolicyRequest
+	7,  // 35: bytebase.v1.OrgPolicyService.UpdatePolicy:input_type -> bytebase.v1.UpdatePolicyRequest
+	8,  // 36: bytebase.v1.OrgPolicyService.DeletePolicy:input_type -> bytebase.v1.DeletePolicyRequest
+	12, // 37: bytebase.v1.OrgPolicyService.GetPolicy:output_type -> bytebase.v1.Policy
+	11, // 38: bytebase.v1.OrgPolicyService.ListPolicies:output_type -> bytebase.v1.ListPoliciesResponse
+	12, // 39: bytebase.v1.OrgPolicyService.CreatePolicy:output_type -> bytebase.v1.Policy
+	12, // 40: bytebase.v1.OrgPolicyService.UpdatePolicy:output_type -> bytebase.v1.Policy
+	33, // 41: bytebase.v1.OrgPolicyService.DeletePolicy:output_type -> google.protobuf.Empty
+	37, // [37:42] is the sub-list for method output_type
+	32, // [32:37] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_v1_org_policy_service_proto_init() }
@@ -1839,6 +2175,7 @@ func file_v1_org_policy_service_proto_init() {
 		(*Policy_TagPolicy)(nil),
 		(*Policy_DataSourceQueryPolicy)(nil),
 		(*Policy_QueryDataPolicy)(nil),
+		(*Policy_RowAccessPolicy)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1846,7 +2183,7 @@ func file_v1_org_policy_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_org_policy_service_proto_rawDesc), len(file_v1_org_policy_service_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -148,6 +148,9 @@ func (x *Policy) Equal(y *Policy) bool {
 	if !x.GetQueryDataPolicy().Equal(y.GetQueryDataPolicy()) {
 		return false
 	}
+	if !x.GetRowAccessPolicy().Equal(y.GetRowAccessPolicy()) {
+		return false
+	}
 	if x.Enforce != y.Enforce {
 		return false
 	}
@@ -157,6 +160,59 @@ func (x *Policy) Equal(y *Policy) bool {
 	return true
 }
 
+func (x *RolloutPolicy_Window_TimeRange) Equal(y *RolloutPolicy_Window_TimeRange) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.DaysOfWeek) != len(y.DaysOfWeek) {
+		return false
+	}
+	for i := 0; i < len(x.DaysOfWeek); i++ {
+		if x.DaysOfWeek[i] != y.DaysOfWeek[i] {
+			return false
+		}
+	}
+	if x.StartTime != y.StartTime {
+		return false
+	}
+	if x.EndTime != y.EndTime {
+		return false
+	}
+	return true
+}
+
+func (x *RolloutPolicy_Window) Equal(y *RolloutPolicy_Window) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.TimeZone != y.TimeZone {
+		return false
+	}
+	if len(x.TimeRanges) != len(y.TimeRanges) {
+		return false
+	}
+	for i := 0; i < len(x.TimeRanges); i++ {
+		if !x.TimeRanges[i].Equal(y.TimeRanges[i]) {
+			return false
+		}
+	}
+	if len(x.BlackoutDates) != len(y.BlackoutDates) {
+		return false
+	}
+	for i := 0; i < len(x.BlackoutDates); i++ {
+		if x.BlackoutDates[i] != y.BlackoutDates[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) Equal(y *RolloutPolicy_Checkers_RequiredStatusChecks) bool {
 	if x == y {
 		return true
@@ -207,6 +263,9 @@ func (x *RolloutPolicy) Equal(y *RolloutPolicy) bool {
 	if !x.Checkers.Equal(y.Checkers) {
 		return false
 	}
+	if !x.Window.Equal(y.Window) {
+		return false
+	}
 	return true
 }
 
@@ -338,6 +397,51 @@ func (x *MaskingRulePolicy) Equal(y *MaskingRulePolicy) bool {
 	return true
 }
 
+func (x *RowAccessPolicy_Rule) Equal(y *RowAccessPolicy_Rule) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Database != y.Database {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if equal, ok := interface{}(x.Condition).(interface{ Equal(*expr.Expr) bool }); !ok || !equal.Equal(y.Condition) {
+		return false
+	} else if !proto.Equal(x.Condition, y.Condition) {
+		return false
+	}
+	if x.Predicate != y.Predicate {
+		return false
+	}
+	return true
+}
+
+func (x *RowAccessPolicy) Equal(y *RowAccessPolicy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Rules) != len(y.Rules) {
+		return false
+	}
+	for i := 0; i < len(x.Rules); i++ {
+		if !x.Rules[i].Equal(y.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (x *TagPolicy) Equal(y *TagPolicy) bool {
 	if x == y {
 		return true
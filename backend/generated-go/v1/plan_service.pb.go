@@ -40,17 +40,26 @@ const (
 	PlanCheckRun_DATABASE_CONNECT PlanCheckRun_Type = 6
 	// Ghost sync check that validates gh-ost online schema change compatibility.
 	PlanCheckRun_DATABASE_GHOST_SYNC PlanCheckRun_Type = 7
+	// Online schema change check that validates PostgreSQL shadow table migration feasibility.
+	PlanCheckRun_DATABASE_ONLINE_SCHEMA_CHANGE PlanCheckRun_Type = 8
+	// Lock impact check that analyzes the locks and table rewrites of the DDL statements.
+	PlanCheckRun_DATABASE_STATEMENT_LOCK_IMPACT PlanCheckRun_Type = 9
+	// Explain check that analyzes the query plans and costs of the DML statements.
+	PlanCheckRun_DATABASE_STATEMENT_EXPLAIN PlanCheckRun_Type = 10
 )
 
 // Enum value maps for PlanCheckRun_Type.
 var (
 	PlanCheckRun_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "DATABASE_STATEMENT_FAKE_ADVISE",
-		3: "DATABASE_STATEMENT_ADVISE",
-		5: "DATABASE_STATEMENT_SUMMARY_REPORT",
-		6: "DATABASE_CONNECT",
-		7: "DATABASE_GHOST_SYNC",
+		0:  "TYPE_UNSPECIFIED",
+		1:  "DATABASE_STATEMENT_FAKE_ADVISE",
+		3:  "DATABASE_STATEMENT_ADVISE",
+		5:  "DATABASE_STATEMENT_SUMMARY_REPORT",
+		6:  "DATABASE_CONNECT",
+		7:  "DATABASE_GHOST_SYNC",
+		8:  "DATABASE_ONLINE_SCHEMA_CHANGE",
+		9:  "DATABASE_STATEMENT_LOCK_IMPACT",
+		10: "DATABASE_STATEMENT_EXPLAIN",
 	}
 	PlanCheckRun_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                  0,
@@ -59,6 +68,9 @@ var (
 		"DATABASE_STATEMENT_SUMMARY_REPORT": 5,
 		"DATABASE_CONNECT":                  6,
 		"DATABASE_GHOST_SYNC":               7,
+		"DATABASE_ONLINE_SCHEMA_CHANGE":     8,
+		"DATABASE_STATEMENT_LOCK_IMPACT":    9,
+		"DATABASE_STATEMENT_EXPLAIN":        10,
 	}
 )
 
@@ -144,6 +156,123 @@ func (PlanCheckRun_Status) EnumDescriptor() ([]byte, []int) {
 	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 1}
 }
 
+// The lock levels ordered from the weakest to the strongest.
+type PlanCheckRun_Result_LockImpactReport_LockLevel int32
+
+const (
+	PlanCheckRun_Result_LockImpactReport_LOCK_LEVEL_UNSPECIFIED PlanCheckRun_Result_LockImpactReport_LockLevel = 0
+	// PostgreSQL SHARE UPDATE EXCLUSIVE lock, which does not block reads and writes.
+	PlanCheckRun_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE PlanCheckRun_Result_LockImpactReport_LockLevel = 1
+	// PostgreSQL SHARE lock, which blocks writes.
+	PlanCheckRun_Result_LockImpactReport_SHARE PlanCheckRun_Result_LockImpactReport_LockLevel = 2
+	// PostgreSQL SHARE ROW EXCLUSIVE lock, which blocks writes.
+	PlanCheckRun_Result_LockImpactReport_SHARE_ROW_EXCLUSIVE PlanCheckRun_Result_LockImpactReport_LockLevel = 3
+	// PostgreSQL ACCESS EXCLUSIVE lock, which blocks reads and writes.
+	PlanCheckRun_Result_LockImpactReport_ACCESS_EXCLUSIVE PlanCheckRun_Result_LockImpactReport_LockLevel = 4
+	// MySQL exclusive metadata lock. Whether the writes are blocked depends on the algorithm.
+	PlanCheckRun_Result_LockImpactReport_METADATA_LOCK PlanCheckRun_Result_LockImpactReport_LockLevel = 5
+)
+
+// Enum value maps for PlanCheckRun_Result_LockImpactReport_LockLevel.
+var (
+	PlanCheckRun_Result_LockImpactReport_LockLevel_name = map[int32]string{
+		0: "LOCK_LEVEL_UNSPECIFIED",
+		1: "SHARE_UPDATE_EXCLUSIVE",
+		2: "SHARE",
+		3: "SHARE_ROW_EXCLUSIVE",
+		4: "ACCESS_EXCLUSIVE",
+		5: "METADATA_LOCK",
+	}
+	PlanCheckRun_Result_LockImpactReport_LockLevel_value = map[string]int32{
+		"LOCK_LEVEL_UNSPECIFIED": 0,
+		"SHARE_UPDATE_EXCLUSIVE": 1,
+		"SHARE":                  2,
+		"SHARE_ROW_EXCLUSIVE":    3,
+		"ACCESS_EXCLUSIVE":       4,
+		"METADATA_LOCK":          5,
+	}
+)
+
+func (x PlanCheckRun_Result_LockImpactReport_LockLevel) Enum() *PlanCheckRun_Result_LockImpactReport_LockLevel {
+	p := new(PlanCheckRun_Result_LockImpactReport_LockLevel)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRun_Result_LockImpactReport_LockLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRun_Result_LockImpactReport_LockLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_plan_service_proto_enumTypes[2].Descriptor()
+}
+
+func (PlanCheckRun_Result_LockImpactReport_LockLevel) Type() protoreflect.EnumType {
+	return &file_v1_plan_service_proto_enumTypes[2]
+}
+
+func (x PlanCheckRun_Result_LockImpactReport_LockLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_LockImpactReport_LockLevel.Descriptor instead.
+func (PlanCheckRun_Result_LockImpactReport_LockLevel) EnumDescriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 2, 0}
+}
+
+// The MySQL online DDL algorithms.
+type PlanCheckRun_Result_LockImpactReport_Algorithm int32
+
+const (
+	PlanCheckRun_Result_LockImpactReport_ALGORITHM_UNSPECIFIED PlanCheckRun_Result_LockImpactReport_Algorithm = 0
+	PlanCheckRun_Result_LockImpactReport_INSTANT               PlanCheckRun_Result_LockImpactReport_Algorithm = 1
+	PlanCheckRun_Result_LockImpactReport_INPLACE               PlanCheckRun_Result_LockImpactReport_Algorithm = 2
+	PlanCheckRun_Result_LockImpactReport_COPY                  PlanCheckRun_Result_LockImpactReport_Algorithm = 3
+)
+
+// Enum value maps for PlanCheckRun_Result_LockImpactReport_Algorithm.
+var (
+	PlanCheckRun_Result_LockImpactReport_Algorithm_name = map[int32]string{
+		0: "ALGORITHM_UNSPECIFIED",
+		1: "INSTANT",
+		2: "INPLACE",
+		3: "COPY",
+	}
+	PlanCheckRun_Result_LockImpactReport_Algorithm_value = map[string]int32{
+		"ALGORITHM_UNSPECIFIED": 0,
+		"INSTANT":               1,
+		"INPLACE":               2,
+		"COPY":                  3,
+	}
+)
+
+func (x PlanCheckRun_Result_LockImpactReport_Algorithm) Enum() *PlanCheckRun_Result_LockImpactReport_Algorithm {
+	p := new(PlanCheckRun_Result_LockImpactReport_Algorithm)
+	*p = x
+	return p
+}
+
+func (x PlanCheckRun_Result_LockImpactReport_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanCheckRun_Result_LockImpactReport_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_plan_service_proto_enumTypes[3].Descriptor()
+}
+
+func (PlanCheckRun_Result_LockImpactReport_Algorithm) Type() protoreflect.EnumType {
+	return &file_v1_plan_service_proto_enumTypes[3]
+}
+
+func (x PlanCheckRun_Result_LockImpactReport_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_LockImpactReport_Algorithm.Descriptor instead.
+func (PlanCheckRun_Result_LockImpactReport_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 2, 1}
+}
+
 type GetPlanRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the plan to retrieve.
@@ -1391,9 +1520,13 @@ type Plan_ChangeDatabaseConfig struct {
 	// If set, a backup of the modified data will be created automatically before any changes are applied.
 	EnablePriorBackup bool `protobuf:"varint,8,opt,name=enable_prior_backup,json=enablePriorBackup,proto3" json:"enable_prior_backup,omitempty"`
 	// Whether to use gh-ost for online schema migration.
-	EnableGhost   bool `protobuf:"varint,12,opt,name=enable_ghost,json=enableGhost,proto3" json:"enable_ghost,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	EnableGhost bool `protobuf:"varint,12,opt,name=enable_ghost,json=enableGhost,proto3" json:"enable_ghost,omitempty"`
+	// Flags for the PostgreSQL online schema change.
+	OnlineSchemaChangeFlags map[string]string `protobuf:"bytes,13,rep,name=online_schema_change_flags,json=onlineSchemaChangeFlags,proto3" json:"online_schema_change_flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Whether to use the shadow table online schema change for PostgreSQL.
+	EnableOnlineSchemaChange bool `protobuf:"varint,14,opt,name=enable_online_schema_change,json=enableOnlineSchemaChange,proto3" json:"enable_online_schema_change,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *Plan_ChangeDatabaseConfig) Reset() {
@@ -1475,6 +1608,20 @@ func (x *Plan_ChangeDatabaseConfig) GetEnableGhost() bool {
 	return false
 }
 
+func (x *Plan_ChangeDatabaseConfig) GetOnlineSchemaChangeFlags() map[string]string {
+	if x != nil {
+		return x.OnlineSchemaChangeFlags
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetEnableOnlineSchemaChange() bool {
+	if x != nil {
+		return x.EnableOnlineSchemaChange
+	}
+	return false
+}
+
 type Plan_ExportDataConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The list of targets.
@@ -1557,8 +1704,11 @@ type Plan_Deployment struct {
 	Environments []string `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
 	// The database group mapping.
 	DatabaseGroupMappings []*Plan_Deployment_DatabaseGroupMapping `protobuf:"bytes,2,rep,name=database_group_mappings,json=databaseGroupMappings,proto3" json:"database_group_mappings,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The strategy to roll out the tasks in each stage in batches.
+	// All tasks in a stage can run at once if unset.
+	RolloutStrategy *Plan_Deployment_RolloutStrategy `protobuf:"bytes,3,opt,name=rollout_strategy,json=rolloutStrategy,proto3" json:"rollout_strategy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Plan_Deployment) Reset() {
@@ -1605,6 +1755,13 @@ func (x *Plan_Deployment) GetDatabaseGroupMappings() []*Plan_Deployment_Database
 	return nil
 }
 
+func (x *Plan_Deployment) GetRolloutStrategy() *Plan_Deployment_RolloutStrategy {
+	if x != nil {
+		return x.RolloutStrategy
+	}
+	return nil
+}
+
 type Plan_Deployment_DatabaseGroupMapping struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Format: projects/{project}/databaseGroups/{databaseGroup}.
@@ -1617,7 +1774,7 @@ type Plan_Deployment_DatabaseGroupMapping struct {
 
 func (x *Plan_Deployment_DatabaseGroupMapping) Reset() {
 	*x = Plan_Deployment_DatabaseGroupMapping{}
-	mi := &file_v1_plan_service_proto_msgTypes[22]
+	mi := &file_v1_plan_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1629,7 +1786,7 @@ func (x *Plan_Deployment_DatabaseGroupMapping) String() string {
 func (*Plan_Deployment_DatabaseGroupMapping) ProtoMessage() {}
 
 func (x *Plan_Deployment_DatabaseGroupMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[22]
+	mi := &file_v1_plan_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,6 +1816,71 @@ func (x *Plan_Deployment_DatabaseGroupMapping) GetDatabases() []string {
 	return nil
 }
 
+type Plan_Deployment_RolloutStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of canary tasks to run first in each stage.
+	CanaryCount int32 `protobuf:"varint,1,opt,name=canary_count,json=canaryCount,proto3" json:"canary_count,omitempty"`
+	// The percentage of the remaining tasks to run in each batch after the canary tasks, from 0 to 100.
+	// All remaining tasks run in one batch if zero.
+	BatchPercentage int32 `protobuf:"varint,2,opt,name=batch_percentage,json=batchPercentage,proto3" json:"batch_percentage,omitempty"`
+	// The number of failed tasks at which the stage halts and the remaining tasks stop.
+	// The stage halts on the first failure if zero.
+	FailureThreshold int32 `protobuf:"varint,3,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Plan_Deployment_RolloutStrategy) Reset() {
+	*x = Plan_Deployment_RolloutStrategy{}
+	mi := &file_v1_plan_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan_Deployment_RolloutStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_Deployment_RolloutStrategy) ProtoMessage() {}
+
+func (x *Plan_Deployment_RolloutStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_Deployment_RolloutStrategy.ProtoReflect.Descriptor instead.
+func (*Plan_Deployment_RolloutStrategy) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{7, 5, 1}
+}
+
+func (x *Plan_Deployment_RolloutStrategy) GetCanaryCount() int32 {
+	if x != nil {
+		return x.CanaryCount
+	}
+	return 0
+}
+
+func (x *Plan_Deployment_RolloutStrategy) GetBatchPercentage() int32 {
+	if x != nil {
+		return x.BatchPercentage
+	}
+	return 0
+}
+
+func (x *Plan_Deployment_RolloutStrategy) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
 type PlanCheckRun_Result struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Status  Advice_Level           `protobuf:"varint,1,opt,name=status,proto3,enum=bytebase.v1.Advice_Level" json:"status,omitempty"`
@@ -1669,6 +1891,8 @@ type PlanCheckRun_Result struct {
 	//
 	//	*PlanCheckRun_Result_SqlSummaryReport_
 	//	*PlanCheckRun_Result_SqlReviewReport_
+	//	*PlanCheckRun_Result_LockImpactReport_
+	//	*PlanCheckRun_Result_ExplainReport_
 	Report        isPlanCheckRun_Result_Report `protobuf_oneof:"report"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1676,7 +1900,7 @@ type PlanCheckRun_Result struct {
 
 func (x *PlanCheckRun_Result) Reset() {
 	*x = PlanCheckRun_Result{}
-	mi := &file_v1_plan_service_proto_msgTypes[23]
+	mi := &file_v1_plan_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1688,7 +1912,7 @@ func (x *PlanCheckRun_Result) String() string {
 func (*PlanCheckRun_Result) ProtoMessage() {}
 
 func (x *PlanCheckRun_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[23]
+	mi := &file_v1_plan_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,6 +1981,24 @@ func (x *PlanCheckRun_Result) GetSqlReviewReport() *PlanCheckRun_Result_SqlRevie
 	return nil
 }
 
+func (x *PlanCheckRun_Result) GetLockImpactReport() *PlanCheckRun_Result_LockImpactReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRun_Result_LockImpactReport_); ok {
+			return x.LockImpactReport
+		}
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result) GetExplainReport() *PlanCheckRun_Result_ExplainReport {
+	if x != nil {
+		if x, ok := x.Report.(*PlanCheckRun_Result_ExplainReport_); ok {
+			return x.ExplainReport
+		}
+	}
+	return nil
+}
+
 type isPlanCheckRun_Result_Report interface {
 	isPlanCheckRun_Result_Report()
 }
@@ -1769,23 +2011,37 @@ type PlanCheckRun_Result_SqlReviewReport_ struct {
 	SqlReviewReport *PlanCheckRun_Result_SqlReviewReport `protobuf:"bytes,6,opt,name=sql_review_report,json=sqlReviewReport,proto3,oneof"`
 }
 
+type PlanCheckRun_Result_LockImpactReport_ struct {
+	LockImpactReport *PlanCheckRun_Result_LockImpactReport `protobuf:"bytes,7,opt,name=lock_impact_report,json=lockImpactReport,proto3,oneof"`
+}
+
+type PlanCheckRun_Result_ExplainReport_ struct {
+	ExplainReport *PlanCheckRun_Result_ExplainReport `protobuf:"bytes,8,opt,name=explain_report,json=explainReport,proto3,oneof"`
+}
+
 func (*PlanCheckRun_Result_SqlSummaryReport_) isPlanCheckRun_Result_Report() {}
 
 func (*PlanCheckRun_Result_SqlReviewReport_) isPlanCheckRun_Result_Report() {}
 
+func (*PlanCheckRun_Result_LockImpactReport_) isPlanCheckRun_Result_Report() {}
+
+func (*PlanCheckRun_Result_ExplainReport_) isPlanCheckRun_Result_Report() {}
+
 type PlanCheckRun_Result_SqlSummaryReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// statement_types are the types of statements that are found in the sql.
 	StatementTypes   []string          `protobuf:"bytes,2,rep,name=statement_types,json=statementTypes,proto3" json:"statement_types,omitempty"`
 	AffectedRows     int64             `protobuf:"varint,3,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
 	ChangedResources *ChangedResources `protobuf:"bytes,4,opt,name=changed_resources,json=changedResources,proto3" json:"changed_resources,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The estimated cost of the DML statements from EXPLAIN.
+	EstimatedCost float64 `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlanCheckRun_Result_SqlSummaryReport) Reset() {
 	*x = PlanCheckRun_Result_SqlSummaryReport{}
-	mi := &file_v1_plan_service_proto_msgTypes[24]
+	mi := &file_v1_plan_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1797,7 +2053,7 @@ func (x *PlanCheckRun_Result_SqlSummaryReport) String() string {
 func (*PlanCheckRun_Result_SqlSummaryReport) ProtoMessage() {}
 
 func (x *PlanCheckRun_Result_SqlSummaryReport) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[24]
+	mi := &file_v1_plan_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1834,6 +2090,13 @@ func (x *PlanCheckRun_Result_SqlSummaryReport) GetChangedResources() *ChangedRes
 	return nil
 }
 
+func (x *PlanCheckRun_Result_SqlSummaryReport) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
 type PlanCheckRun_Result_SqlReviewReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Position of the SQL statement.
@@ -1845,7 +2108,7 @@ type PlanCheckRun_Result_SqlReviewReport struct {
 
 func (x *PlanCheckRun_Result_SqlReviewReport) Reset() {
 	*x = PlanCheckRun_Result_SqlReviewReport{}
-	mi := &file_v1_plan_service_proto_msgTypes[25]
+	mi := &file_v1_plan_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1857,7 +2120,7 @@ func (x *PlanCheckRun_Result_SqlReviewReport) String() string {
 func (*PlanCheckRun_Result_SqlReviewReport) ProtoMessage() {}
 
 func (x *PlanCheckRun_Result_SqlReviewReport) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[25]
+	mi := &file_v1_plan_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,6 +2150,349 @@ func (x *PlanCheckRun_Result_SqlReviewReport) GetEndPosition() *Position {
 	return nil
 }
 
+type PlanCheckRun_Result_LockImpactReport struct {
+	state     protoimpl.MessageState                         `protogen:"open.v1"`
+	Schema    string                                         `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table     string                                         `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	LockLevel PlanCheckRun_Result_LockImpactReport_LockLevel `protobuf:"varint,3,opt,name=lock_level,json=lockLevel,proto3,enum=bytebase.v1.PlanCheckRun_Result_LockImpactReport_LockLevel" json:"lock_level,omitempty"`
+	Algorithm PlanCheckRun_Result_LockImpactReport_Algorithm `protobuf:"varint,4,opt,name=algorithm,proto3,enum=bytebase.v1.PlanCheckRun_Result_LockImpactReport_Algorithm" json:"algorithm,omitempty"`
+	// Whether the table is rewritten, e.g. ALTER COLUMN TYPE.
+	TableRewrite bool `protobuf:"varint,5,opt,name=table_rewrite,json=tableRewrite,proto3" json:"table_rewrite,omitempty"`
+	// Whether the table is fully scanned while the lock is held, e.g. SET NOT NULL.
+	TableScan bool `protobuf:"varint,6,opt,name=table_scan,json=tableScan,proto3" json:"table_scan,omitempty"`
+	// The table row count and size in bytes from the synced metadata.
+	TableRows int64 `protobuf:"varint,7,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	TableSize int64 `protobuf:"varint,8,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
+	// The estimated duration in seconds that the concurrent writes are blocked.
+	EstimatedBlockingSeconds int64 `protobuf:"varint,9,opt,name=estimated_blocking_seconds,json=estimatedBlockingSeconds,proto3" json:"estimated_blocking_seconds,omitempty"`
+	// Position of the SQL statement.
+	StartPosition *Position `protobuf:"bytes,10,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) Reset() {
+	*x = PlanCheckRun_Result_LockImpactReport{}
+	mi := &file_v1_plan_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_LockImpactReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_LockImpactReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_LockImpactReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_LockImpactReport) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 2}
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetLockLevel() PlanCheckRun_Result_LockImpactReport_LockLevel {
+	if x != nil {
+		return x.LockLevel
+	}
+	return PlanCheckRun_Result_LockImpactReport_LOCK_LEVEL_UNSPECIFIED
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetAlgorithm() PlanCheckRun_Result_LockImpactReport_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return PlanCheckRun_Result_LockImpactReport_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetTableRewrite() bool {
+	if x != nil {
+		return x.TableRewrite
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetTableScan() bool {
+	if x != nil {
+		return x.TableScan
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetTableRows() int64 {
+	if x != nil {
+		return x.TableRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetTableSize() int64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetEstimatedBlockingSeconds() int64 {
+	if x != nil {
+		return x.EstimatedBlockingSeconds
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_LockImpactReport) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+type PlanCheckRun_Result_ExplainReport struct {
+	state     protoimpl.MessageState                      `protogen:"open.v1"`
+	Statement string                                      `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Plan      *PlanCheckRun_Result_ExplainReport_PlanNode `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The estimated total cost of the statement.
+	TotalCost float64 `protobuf:"fixed64,3,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	// The large tables that are fully scanned.
+	FullScanTables []string `protobuf:"bytes,4,rep,name=full_scan_tables,json=fullScanTables,proto3" json:"full_scan_tables,omitempty"`
+	// The tables that are fully scanned to evaluate a filter without using an index.
+	MissingIndexTables []string `protobuf:"bytes,5,rep,name=missing_index_tables,json=missingIndexTables,proto3" json:"missing_index_tables,omitempty"`
+	// The target whose plan of the previous environment is compared.
+	// Format: instances/{instance}/databases/{database}
+	PreviousTarget    string  `protobuf:"bytes,6,opt,name=previous_target,json=previousTarget,proto3" json:"previous_target,omitempty"`
+	PreviousTotalCost float64 `protobuf:"fixed64,7,opt,name=previous_total_cost,json=previousTotalCost,proto3" json:"previous_total_cost,omitempty"`
+	// The tables that are accessed by an index in the previous environment but fully scanned now.
+	RegressedTables []string `protobuf:"bytes,8,rep,name=regressed_tables,json=regressedTables,proto3" json:"regressed_tables,omitempty"`
+	// Position of the SQL statement.
+	StartPosition *Position `protobuf:"bytes,9,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) Reset() {
+	*x = PlanCheckRun_Result_ExplainReport{}
+	mi := &file_v1_plan_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_ExplainReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_ExplainReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_ExplainReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_ExplainReport) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 3}
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetPlan() *PlanCheckRun_Result_ExplainReport_PlanNode {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetFullScanTables() []string {
+	if x != nil {
+		return x.FullScanTables
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetMissingIndexTables() []string {
+	if x != nil {
+		return x.MissingIndexTables
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetPreviousTarget() string {
+	if x != nil {
+		return x.PreviousTarget
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetPreviousTotalCost() float64 {
+	if x != nil {
+		return x.PreviousTotalCost
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetRegressedTables() []string {
+	if x != nil {
+		return x.RegressedTables
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) GetStartPosition() *Position {
+	if x != nil {
+		return x.StartPosition
+	}
+	return nil
+}
+
+// PlanNode is a node of the query plan tree.
+type PlanCheckRun_Result_ExplainReport_PlanNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation of the node, e.g. Seq Scan for PostgreSQL or the access type ALL for MySQL.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Table     string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// The index used to access the table.
+	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// The estimated cost reported by the optimizer.
+	Cost float64 `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	// The estimated rows examined by the node.
+	Rows int64 `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Whether the table is fully scanned.
+	FullScan bool `protobuf:"varint,6,opt,name=full_scan,json=fullScan,proto3" json:"full_scan,omitempty"`
+	// Whether the rows are filtered by a condition.
+	HasFilter     bool                                          `protobuf:"varint,7,opt,name=has_filter,json=hasFilter,proto3" json:"has_filter,omitempty"`
+	Children      []*PlanCheckRun_Result_ExplainReport_PlanNode `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) Reset() {
+	*x = PlanCheckRun_Result_ExplainReport_PlanNode{}
+	mi := &file_v1_plan_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_ExplainReport_PlanNode) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_ExplainReport_PlanNode.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_ExplainReport_PlanNode) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{14, 0, 3, 0}
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetFullScan() bool {
+	if x != nil {
+		return x.FullScan
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetHasFilter() bool {
+	if x != nil {
+		return x.HasFilter
+	}
+	return false
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) GetChildren() []*PlanCheckRun_Result_ExplainReport_PlanNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 var File_v1_plan_service_proto protoreflect.FileDescriptor
 
 const file_v1_plan_service_proto_rawDesc = "" +
@@ -1921,7 +2527,7 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x04plan\x18\x01 \x01(\v2\x11.bytebase.v1.PlanB\x03\xe0A\x02R\x04plan\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
 	"updateMask\x12#\n" +
-	"\rallow_missing\x18\x03 \x01(\bR\fallowMissing\"\xf3\x10\n" +
+	"\rallow_missing\x18\x03 \x01(\bR\fallowMissing\"\xe9\x14\n" +
 	"\x04Plan\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x12.bytebase.v1.StateR\x05state\x12\x19\n" +
@@ -1957,7 +2563,7 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\tcollation\x18\x05 \x01(\tB\x03\xe0A\x01R\tcollation\x12\x1d\n" +
 	"\acluster\x18\x06 \x01(\tB\x03\xe0A\x01R\acluster\x12\x19\n" +
 	"\x05owner\x18\a \x01(\tB\x03\xe0A\x01R\x05owner\x12%\n" +
-	"\venvironment\x18\t \x01(\tB\x03\xe0A\x01R\venvironment\x1a\xa7\x03\n" +
+	"\venvironment\x18\t \x01(\tB\x03\xe0A\x01R\venvironment\x1a\xb5\x05\n" +
 	"\x14ChangeDatabaseConfig\x12\x18\n" +
 	"\atargets\x18\n" +
 	" \x03(\tR\atargets\x12\x14\n" +
@@ -1968,23 +2574,33 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\vghost_flags\x18\a \x03(\v26.bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntryR\n" +
 	"ghostFlags\x12.\n" +
 	"\x13enable_prior_backup\x18\b \x01(\bR\x11enablePriorBackup\x12!\n" +
-	"\fenable_ghost\x18\f \x01(\bR\venableGhost\x1a=\n" +
+	"\fenable_ghost\x18\f \x01(\bR\venableGhost\x12\x80\x01\n" +
+	"\x1aonline_schema_change_flags\x18\r \x03(\v2C.bytebase.v1.Plan.ChangeDatabaseConfig.OnlineSchemaChangeFlagsEntryR\x17onlineSchemaChangeFlags\x12=\n" +
+	"\x1benable_online_schema_change\x18\x0e \x01(\bR\x18enableOnlineSchemaChange\x1a=\n" +
 	"\x0fGhostFlagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aJ\n" +
+	"\x1cOnlineSchemaChangeFlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06J\x04\b\x06\x10\a\x1a\xa3\x01\n" +
 	"\x10ExportDataConfig\x12\x18\n" +
 	"\atargets\x18\x05 \x03(\tR\atargets\x12\x14\n" +
 	"\x05sheet\x18\x02 \x01(\tR\x05sheet\x121\n" +
 	"\x06format\x18\x03 \x01(\x0e2\x19.bytebase.v1.ExportFormatR\x06format\x12\x1f\n" +
 	"\bpassword\x18\x04 \x01(\tH\x00R\bpassword\x88\x01\x01B\v\n" +
-	"\t_password\x1a\xf8\x01\n" +
+	"\t_password\x1a\xe0\x03\n" +
 	"\n" +
 	"Deployment\x12\"\n" +
 	"\fenvironments\x18\x01 \x03(\tR\fenvironments\x12i\n" +
-	"\x17database_group_mappings\x18\x02 \x03(\v21.bytebase.v1.Plan.Deployment.DatabaseGroupMappingR\x15databaseGroupMappings\x1a[\n" +
+	"\x17database_group_mappings\x18\x02 \x03(\v21.bytebase.v1.Plan.Deployment.DatabaseGroupMappingR\x15databaseGroupMappings\x12W\n" +
+	"\x10rollout_strategy\x18\x03 \x01(\v2,.bytebase.v1.Plan.Deployment.RolloutStrategyR\x0frolloutStrategy\x1a[\n" +
 	"\x14DatabaseGroupMapping\x12%\n" +
 	"\x0edatabase_group\x18\x01 \x01(\tR\rdatabaseGroup\x12\x1c\n" +
-	"\tdatabases\x18\x02 \x03(\tR\tdatabases:7\xeaA4\n" +
+	"\tdatabases\x18\x02 \x03(\tR\tdatabases\x1a\x8c\x01\n" +
+	"\x0fRolloutStrategy\x12!\n" +
+	"\fcanary_count\x18\x01 \x01(\x05R\vcanaryCount\x12)\n" +
+	"\x10batch_percentage\x18\x02 \x01(\x05R\x0fbatchPercentage\x12+\n" +
+	"\x11failure_threshold\x18\x03 \x01(\x05R\x10failureThreshold:7\xeaA4\n" +
 	"\x11bytebase.com/Plan\x12\x1fprojects/{project}/plans/{plan}\"\x86\x01\n" +
 	"\x18ListPlanCheckRunsRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
@@ -2005,8 +2621,7 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11bytebase.com/PlanR\x06parent\x12&\n" +
 	"\x0fplan_check_runs\x18\x02 \x03(\tR\rplanCheckRuns\"\"\n" +
-	" BatchCancelPlanCheckRunsResponse\"\x8b\n" +
-	"\n" +
+	" BatchCancelPlanCheckRunsResponse\"\xf6\x17\n" +
 	"\fPlanCheckRun\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x1e.bytebase.v1.PlanCheckRun.TypeR\x04type\x128\n" +
@@ -2016,29 +2631,85 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\aresults\x18\a \x03(\v2 .bytebase.v1.PlanCheckRun.ResultR\aresults\x12\x14\n" +
 	"\x05error\x18\b \x01(\tR\x05error\x12@\n" +
 	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"createTime\x1a\xa5\x05\n" +
+	"createTime\x1a\xa9\x12\n" +
 	"\x06Result\x121\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x19.bytebase.v1.Advice.LevelR\x06status\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
 	"\x04code\x18\x04 \x01(\x05R\x04code\x12a\n" +
 	"\x12sql_summary_report\x18\x05 \x01(\v21.bytebase.v1.PlanCheckRun.Result.SqlSummaryReportH\x00R\x10sqlSummaryReport\x12^\n" +
-	"\x11sql_review_report\x18\x06 \x01(\v20.bytebase.v1.PlanCheckRun.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x1a\xb2\x01\n" +
+	"\x11sql_review_report\x18\x06 \x01(\v20.bytebase.v1.PlanCheckRun.Result.SqlReviewReportH\x00R\x0fsqlReviewReport\x12a\n" +
+	"\x12lock_impact_report\x18\a \x01(\v21.bytebase.v1.PlanCheckRun.Result.LockImpactReportH\x00R\x10lockImpactReport\x12W\n" +
+	"\x0eexplain_report\x18\b \x01(\v2..bytebase.v1.PlanCheckRun.Result.ExplainReportH\x00R\rexplainReport\x1a\xd9\x01\n" +
 	"\x10SqlSummaryReport\x12'\n" +
 	"\x0fstatement_types\x18\x02 \x03(\tR\x0estatementTypes\x12#\n" +
 	"\raffected_rows\x18\x03 \x01(\x03R\faffectedRows\x12J\n" +
-	"\x11changed_resources\x18\x04 \x01(\v2\x1d.bytebase.v1.ChangedResourcesR\x10changedResourcesJ\x04\b\x01\x10\x02\x1a\xa1\x01\n" +
+	"\x11changed_resources\x18\x04 \x01(\v2\x1d.bytebase.v1.ChangedResourcesR\x10changedResources\x12%\n" +
+	"\x0eestimated_cost\x18\x05 \x01(\x01R\restimatedCostJ\x04\b\x01\x10\x02\x1a\xa1\x01\n" +
 	"\x0fSqlReviewReport\x12<\n" +
 	"\x0estart_position\x18\x05 \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x128\n" +
-	"\fend_position\x18\x06 \x01(\v2\x15.bytebase.v1.PositionR\vendPositionJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05B\b\n" +
-	"\x06report\"\xb5\x01\n" +
+	"\fend_position\x18\x06 \x01(\v2\x15.bytebase.v1.PositionR\vendPositionJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\x1a\xd4\x05\n" +
+	"\x10LockImpactReport\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12Z\n" +
+	"\n" +
+	"lock_level\x18\x03 \x01(\x0e2;.bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevelR\tlockLevel\x12Y\n" +
+	"\talgorithm\x18\x04 \x01(\x0e2;.bytebase.v1.PlanCheckRun.Result.LockImpactReport.AlgorithmR\talgorithm\x12#\n" +
+	"\rtable_rewrite\x18\x05 \x01(\bR\ftableRewrite\x12\x1d\n" +
+	"\n" +
+	"table_scan\x18\x06 \x01(\bR\ttableScan\x12\x1d\n" +
+	"\n" +
+	"table_rows\x18\a \x01(\x03R\ttableRows\x12\x1d\n" +
+	"\n" +
+	"table_size\x18\b \x01(\x03R\ttableSize\x12<\n" +
+	"\x1aestimated_blocking_seconds\x18\t \x01(\x03R\x18estimatedBlockingSeconds\x12<\n" +
+	"\x0estart_position\x18\n" +
+	" \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\"\x90\x01\n" +
+	"\tLockLevel\x12\x1a\n" +
+	"\x16LOCK_LEVEL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SHARE_UPDATE_EXCLUSIVE\x10\x01\x12\t\n" +
+	"\x05SHARE\x10\x02\x12\x17\n" +
+	"\x13SHARE_ROW_EXCLUSIVE\x10\x03\x12\x14\n" +
+	"\x10ACCESS_EXCLUSIVE\x10\x04\x12\x11\n" +
+	"\rMETADATA_LOCK\x10\x05\"J\n" +
+	"\tAlgorithm\x12\x19\n" +
+	"\x15ALGORITHM_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aINSTANT\x10\x01\x12\v\n" +
+	"\aINPLACE\x10\x02\x12\b\n" +
+	"\x04COPY\x10\x03\x1a\xc7\x05\n" +
+	"\rExplainReport\x12\x1c\n" +
+	"\tstatement\x18\x01 \x01(\tR\tstatement\x12K\n" +
+	"\x04plan\x18\x02 \x01(\v27.bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNodeR\x04plan\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x03 \x01(\x01R\ttotalCost\x12(\n" +
+	"\x10full_scan_tables\x18\x04 \x03(\tR\x0efullScanTables\x120\n" +
+	"\x14missing_index_tables\x18\x05 \x03(\tR\x12missingIndexTables\x12'\n" +
+	"\x0fprevious_target\x18\x06 \x01(\tR\x0epreviousTarget\x12.\n" +
+	"\x13previous_total_cost\x18\a \x01(\x01R\x11previousTotalCost\x12)\n" +
+	"\x10regressed_tables\x18\b \x03(\tR\x0fregressedTables\x12<\n" +
+	"\x0estart_position\x18\t \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x1a\x8d\x02\n" +
+	"\bPlanNode\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\tR\x05index\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x12\n" +
+	"\x04rows\x18\x05 \x01(\x03R\x04rows\x12\x1b\n" +
+	"\tfull_scan\x18\x06 \x01(\bR\bfullScan\x12\x1d\n" +
+	"\n" +
+	"has_filter\x18\a \x01(\bR\thasFilter\x12S\n" +
+	"\bchildren\x18\b \x03(\v27.bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNodeR\bchildrenB\b\n" +
+	"\x06report\"\x9c\x02\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eDATABASE_STATEMENT_FAKE_ADVISE\x10\x01\x12\x1d\n" +
 	"\x19DATABASE_STATEMENT_ADVISE\x10\x03\x12%\n" +
 	"!DATABASE_STATEMENT_SUMMARY_REPORT\x10\x05\x12\x14\n" +
 	"\x10DATABASE_CONNECT\x10\x06\x12\x17\n" +
-	"\x13DATABASE_GHOST_SYNC\x10\a\"Q\n" +
+	"\x13DATABASE_GHOST_SYNC\x10\a\x12!\n" +
+	"\x1dDATABASE_ONLINE_SCHEMA_CHANGE\x10\b\x12\"\n" +
+	"\x1eDATABASE_STATEMENT_LOCK_IMPACT\x10\t\x12\x1e\n" +
+	"\x1aDATABASE_STATEMENT_EXPLAIN\x10\n" +
+	"\"Q\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aRUNNING\x10\x01\x12\b\n" +
@@ -2072,97 +2743,114 @@ func file_v1_plan_service_proto_rawDescGZIP() []byte {
 	return file_v1_plan_service_proto_rawDescData
 }
 
-var file_v1_plan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_plan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_v1_plan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_v1_plan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_v1_plan_service_proto_goTypes = []any{
-	(PlanCheckRun_Type)(0),                       // 0: bytebase.v1.PlanCheckRun.Type
-	(PlanCheckRun_Status)(0),                     // 1: bytebase.v1.PlanCheckRun.Status
-	(*GetPlanRequest)(nil),                       // 2: bytebase.v1.GetPlanRequest
-	(*ListPlansRequest)(nil),                     // 3: bytebase.v1.ListPlansRequest
-	(*ListPlansResponse)(nil),                    // 4: bytebase.v1.ListPlansResponse
-	(*SearchPlansRequest)(nil),                   // 5: bytebase.v1.SearchPlansRequest
-	(*SearchPlansResponse)(nil),                  // 6: bytebase.v1.SearchPlansResponse
-	(*CreatePlanRequest)(nil),                    // 7: bytebase.v1.CreatePlanRequest
-	(*UpdatePlanRequest)(nil),                    // 8: bytebase.v1.UpdatePlanRequest
-	(*Plan)(nil),                                 // 9: bytebase.v1.Plan
-	(*ListPlanCheckRunsRequest)(nil),             // 10: bytebase.v1.ListPlanCheckRunsRequest
-	(*ListPlanCheckRunsResponse)(nil),            // 11: bytebase.v1.ListPlanCheckRunsResponse
-	(*RunPlanChecksRequest)(nil),                 // 12: bytebase.v1.RunPlanChecksRequest
-	(*RunPlanChecksResponse)(nil),                // 13: bytebase.v1.RunPlanChecksResponse
-	(*BatchCancelPlanCheckRunsRequest)(nil),      // 14: bytebase.v1.BatchCancelPlanCheckRunsRequest
-	(*BatchCancelPlanCheckRunsResponse)(nil),     // 15: bytebase.v1.BatchCancelPlanCheckRunsResponse
-	(*PlanCheckRun)(nil),                         // 16: bytebase.v1.PlanCheckRun
-	(*Plan_Spec)(nil),                            // 17: bytebase.v1.Plan.Spec
-	nil,                                          // 18: bytebase.v1.Plan.PlanCheckRunStatusCountEntry
-	(*Plan_CreateDatabaseConfig)(nil),            // 19: bytebase.v1.Plan.CreateDatabaseConfig
-	(*Plan_ChangeDatabaseConfig)(nil),            // 20: bytebase.v1.Plan.ChangeDatabaseConfig
-	(*Plan_ExportDataConfig)(nil),                // 21: bytebase.v1.Plan.ExportDataConfig
-	(*Plan_Deployment)(nil),                      // 22: bytebase.v1.Plan.Deployment
-	nil,                                          // 23: bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
-	(*Plan_Deployment_DatabaseGroupMapping)(nil), // 24: bytebase.v1.Plan.Deployment.DatabaseGroupMapping
-	(*PlanCheckRun_Result)(nil),                  // 25: bytebase.v1.PlanCheckRun.Result
-	(*PlanCheckRun_Result_SqlSummaryReport)(nil), // 26: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	(*PlanCheckRun_Result_SqlReviewReport)(nil),  // 27: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	(*fieldmaskpb.FieldMask)(nil),                // 28: google.protobuf.FieldMask
-	(State)(0),                                   // 29: bytebase.v1.State
-	(*timestamppb.Timestamp)(nil),                // 30: google.protobuf.Timestamp
-	(DatabaseChangeType)(0),                      // 31: bytebase.v1.DatabaseChangeType
-	(ExportFormat)(0),                            // 32: bytebase.v1.ExportFormat
-	(Advice_Level)(0),                            // 33: bytebase.v1.Advice.Level
-	(*ChangedResources)(nil),                     // 34: bytebase.v1.ChangedResources
-	(*Position)(nil),                             // 35: bytebase.v1.Position
+	(PlanCheckRun_Type)(0),                              // 0: bytebase.v1.PlanCheckRun.Type
+	(PlanCheckRun_Status)(0),                            // 1: bytebase.v1.PlanCheckRun.Status
+	(PlanCheckRun_Result_LockImpactReport_LockLevel)(0), // 2: bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel
+	(PlanCheckRun_Result_LockImpactReport_Algorithm)(0), // 3: bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm
+	(*GetPlanRequest)(nil),                              // 4: bytebase.v1.GetPlanRequest
+	(*ListPlansRequest)(nil),                            // 5: bytebase.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                           // 6: bytebase.v1.ListPlansResponse
+	(*SearchPlansRequest)(nil),                          // 7: bytebase.v1.SearchPlansRequest
+	(*SearchPlansResponse)(nil),                         // 8: bytebase.v1.SearchPlansResponse
+	(*CreatePlanRequest)(nil),                           // 9: bytebase.v1.CreatePlanRequest
+	(*UpdatePlanRequest)(nil),                           // 10: bytebase.v1.UpdatePlanRequest
+	(*Plan)(nil),                                        // 11: bytebase.v1.Plan
+	(*ListPlanCheckRunsRequest)(nil),                    // 12: bytebase.v1.ListPlanCheckRunsRequest
+	(*ListPlanCheckRunsResponse)(nil),                   // 13: bytebase.v1.ListPlanCheckRunsResponse
+	(*RunPlanChecksRequest)(nil),                        // 14: bytebase.v1.RunPlanChecksRequest
+	(*RunPlanChecksResponse)(nil),                       // 15: bytebase.v1.RunPlanChecksResponse
+	(*BatchCancelPlanCheckRunsRequest)(nil),             // 16: bytebase.v1.BatchCancelPlanCheckRunsRequest
+	(*BatchCancelPlanCheckRunsResponse)(nil),            // 17: bytebase.v1.BatchCancelPlanCheckRunsResponse
+	(*PlanCheckRun)(nil),                                // 18: bytebase.v1.PlanCheckRun
+	(*Plan_Spec)(nil),                                   // 19: bytebase.v1.Plan.Spec
+	nil,                                                 // 20: bytebase.v1.Plan.PlanCheckRunStatusCountEntry
+	(*Plan_CreateDatabaseConfig)(nil),                   // 21: bytebase.v1.Plan.CreateDatabaseConfig
+	(*Plan_ChangeDatabaseConfig)(nil),                   // 22: bytebase.v1.Plan.ChangeDatabaseConfig
+	(*Plan_ExportDataConfig)(nil),                       // 23: bytebase.v1.Plan.ExportDataConfig
+	(*Plan_Deployment)(nil),                             // 24: bytebase.v1.Plan.Deployment
+	nil,                                                 // 25: bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
+	nil,                                                 // 26: bytebase.v1.Plan.ChangeDatabaseConfig.OnlineSchemaChangeFlagsEntry
+	(*Plan_Deployment_DatabaseGroupMapping)(nil),        // 27: bytebase.v1.Plan.Deployment.DatabaseGroupMapping
+	(*Plan_Deployment_RolloutStrategy)(nil),             // 28: bytebase.v1.Plan.Deployment.RolloutStrategy
+	(*PlanCheckRun_Result)(nil),                         // 29: bytebase.v1.PlanCheckRun.Result
+	(*PlanCheckRun_Result_SqlSummaryReport)(nil),        // 30: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	(*PlanCheckRun_Result_SqlReviewReport)(nil),         // 31: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	(*PlanCheckRun_Result_LockImpactReport)(nil),        // 32: bytebase.v1.PlanCheckRun.Result.LockImpactReport
+	(*PlanCheckRun_Result_ExplainReport)(nil),           // 33: bytebase.v1.PlanCheckRun.Result.ExplainReport
+	(*PlanCheckRun_Result_ExplainReport_PlanNode)(nil),  // 34: bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode
+	(*fieldmaskpb.FieldMask)(nil),                       // 35: google.protobuf.FieldMask
+	(State)(0),                                          // 36: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),                       // 37: google.protobuf.Timestamp
+	(DatabaseChangeType)(0),                             // 38: bytebase.v1.DatabaseChangeType
+	(ExportFormat)(0),                                   // 39: bytebase.v1.ExportFormat
+	(Advice_Level)(0),                                   // 40: bytebase.v1.Advice.Level
+	(*ChangedResources)(nil),                            // 41: bytebase.v1.ChangedResources
+	(*Position)(nil),                                    // 42: bytebase.v1.Position
 }
 var file_v1_plan_service_proto_depIdxs = []int32{
-	9,  // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
-	9,  // 1: bytebase.v1.SearchPlansResponse.plans:type_name -> bytebase.v1.Plan
-	9,  // 2: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	9,  // 3: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	28, // 4: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
-	29, // 5: bytebase.v1.Plan.state:type_name -> bytebase.v1.State
-	17, // 6: bytebase.v1.Plan.specs:type_name -> bytebase.v1.Plan.Spec
-	30, // 7: bytebase.v1.Plan.create_time:type_name -> google.protobuf.Timestamp
-	30, // 8: bytebase.v1.Plan.update_time:type_name -> google.protobuf.Timestamp
-	18, // 9: bytebase.v1.Plan.plan_check_run_status_count:type_name -> bytebase.v1.Plan.PlanCheckRunStatusCountEntry
-	22, // 10: bytebase.v1.Plan.deployment:type_name -> bytebase.v1.Plan.Deployment
-	16, // 11: bytebase.v1.ListPlanCheckRunsResponse.plan_check_runs:type_name -> bytebase.v1.PlanCheckRun
+	11, // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
+	11, // 1: bytebase.v1.SearchPlansResponse.plans:type_name -> bytebase.v1.Plan
+	11, // 2: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
+	11, // 3: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
+	35, // 4: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
+	36, // 5: bytebase.v1.Plan.state:type_name -> bytebase.v1.State
+	19, // 6: bytebase.v1.Plan.specs:type_name -> bytebase.v1.Plan.Spec
+	37, // 7: bytebase.v1.Plan.create_time:type_name -> google.protobuf.Timestamp
+	37, // 8: bytebase.v1.Plan.update_time:type_name -> google.protobuf.Timestamp
+	20, // 9: bytebase.v1.Plan.plan_check_run_status_count:type_name -> bytebase.v1.Plan.PlanCheckRunStatusCountEntry
+	24, // 10: bytebase.v1.Plan.deployment:type_name -> bytebase.v1.Plan.Deployment
+	18, // 11: bytebase.v1.ListPlanCheckRunsResponse.plan_check_runs:type_name -> bytebase.v1.PlanCheckRun
 	0,  // 12: bytebase.v1.PlanCheckRun.type:type_name -> bytebase.v1.PlanCheckRun.Type
 	1,  // 13: bytebase.v1.PlanCheckRun.status:type_name -> bytebase.v1.PlanCheckRun.Status
-	25, // 14: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
-	30, // 15: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
-	19, // 16: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
-	20, // 17: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
-	21, // 18: bytebase.v1.Plan.Spec.export_data_config:type_name -> bytebase.v1.Plan.ExportDataConfig
-	31, // 19: bytebase.v1.Plan.ChangeDatabaseConfig.type:type_name -> bytebase.v1.DatabaseChangeType
-	23, // 20: bytebase.v1.Plan.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
-	32, // 21: bytebase.v1.Plan.ExportDataConfig.format:type_name -> bytebase.v1.ExportFormat
-	24, // 22: bytebase.v1.Plan.Deployment.database_group_mappings:type_name -> bytebase.v1.Plan.Deployment.DatabaseGroupMapping
-	33, // 23: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.Advice.Level
-	26, // 24: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	27, // 25: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	34, // 26: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.v1.ChangedResources
-	35, // 27: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.start_position:type_name -> bytebase.v1.Position
-	35, // 28: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.end_position:type_name -> bytebase.v1.Position
-	2,  // 29: bytebase.v1.PlanService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
-	3,  // 30: bytebase.v1.PlanService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
-	5,  // 31: bytebase.v1.PlanService.SearchPlans:input_type -> bytebase.v1.SearchPlansRequest
-	7,  // 32: bytebase.v1.PlanService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
-	8,  // 33: bytebase.v1.PlanService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
-	10, // 34: bytebase.v1.PlanService.ListPlanCheckRuns:input_type -> bytebase.v1.ListPlanCheckRunsRequest
-	12, // 35: bytebase.v1.PlanService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
-	14, // 36: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:input_type -> bytebase.v1.BatchCancelPlanCheckRunsRequest
-	9,  // 37: bytebase.v1.PlanService.GetPlan:output_type -> bytebase.v1.Plan
-	4,  // 38: bytebase.v1.PlanService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
-	6,  // 39: bytebase.v1.PlanService.SearchPlans:output_type -> bytebase.v1.SearchPlansResponse
-	9,  // 40: bytebase.v1.PlanService.CreatePlan:output_type -> bytebase.v1.Plan
-	9,  // 41: bytebase.v1.PlanService.UpdatePlan:output_type -> bytebase.v1.Plan
-	11, // 42: bytebase.v1.PlanService.ListPlanCheckRuns:output_type -> bytebase.v1.ListPlanCheckRunsResponse
-	13, // 43: bytebase.v1.PlanService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
-	15, // 44: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:output_type -> bytebase.v1.BatchCancelPlanCheckRunsResponse
-	37, // [37:45] is the sub-list for method output_type
-	29, // [29:37] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	29, // 14: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
+	37, // 15: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
+	21, // 16: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
+	22, // 17: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
+	23, // 18: bytebase.v1.Plan.Spec.export_data_config:type_name -> bytebase.v1.Plan.ExportDataConfig
+	38, // 19: bytebase.v1.Plan.ChangeDatabaseConfig.type:type_name -> bytebase.v1.DatabaseChangeType
+	25, // 20: bytebase.v1.Plan.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
+	26, // 21: bytebase.v1.Plan.ChangeDatabaseConfig.online_schema_change_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.OnlineSchemaChangeFlagsEntry
+	39, // 22: bytebase.v1.Plan.ExportDataConfig.format:type_name -> bytebase.v1.ExportFormat
+	27, // 23: bytebase.v1.Plan.Deployment.database_group_mappings:type_name -> bytebase.v1.Plan.Deployment.DatabaseGroupMapping
+	28, // 24: bytebase.v1.Plan.Deployment.rollout_strategy:type_name -> bytebase.v1.Plan.Deployment.RolloutStrategy
+	40, // 25: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.Advice.Level
+	30, // 26: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	31, // 27: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	32, // 28: bytebase.v1.PlanCheckRun.Result.lock_impact_report:type_name -> bytebase.v1.PlanCheckRun.Result.LockImpactReport
+	33, // 29: bytebase.v1.PlanCheckRun.Result.explain_report:type_name -> bytebase.v1.PlanCheckRun.Result.ExplainReport
+	41, // 30: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.v1.ChangedResources
+	42, // 31: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.start_position:type_name -> bytebase.v1.Position
+	42, // 32: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.end_position:type_name -> bytebase.v1.Position
+	2,  // 33: bytebase.v1.PlanCheckRun.Result.LockImpactReport.lock_level:type_name -> bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel
+	3,  // 34: bytebase.v1.PlanCheckRun.Result.LockImpactReport.algorithm:type_name -> bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm
+	42, // 35: bytebase.v1.PlanCheckRun.Result.LockImpactReport.start_position:type_name -> bytebase.v1.Position
+	34, // 36: bytebase.v1.PlanCheckRun.Result.ExplainReport.plan:type_name -> bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode
+	42, // 37: bytebase.v1.PlanCheckRun.Result.ExplainReport.start_position:type_name -> bytebase.v1.Position
+	34, // 38: bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode.children:type_name -> bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode
+	4,  // 39: bytebase.v1.PlanService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
+	5,  // 40: bytebase.v1.PlanService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
+	7,  // 41: bytebase.v1.PlanService.SearchPlans:input_type -> bytebase.v1.SearchPlansRequest
+	9,  // 42: bytebase.v1.PlanService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
+	10, // 43: bytebase.v1.PlanService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
+	12, // 44: bytebase.v1.PlanService.ListPlanCheckRuns:input_type -> bytebase.v1.ListPlanCheckRunsRequest
+	14, // 45: bytebase.v1.PlanService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
+	16, // 46: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:input_type -> bytebase.v1.BatchCancelPlanCheckRunsRequest
+	11, // 47: bytebase.v1.PlanService.GetPlan:output_type -> bytebase.v1.Plan
+	6,  // 48: bytebase.v1.PlanService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
+	8,  // 49: bytebase.v1.PlanService.SearchPlans:output_type -> bytebase.v1.SearchPlansResponse
+	11, // 50: bytebase.v1.PlanService.CreatePlan:output_type -> bytebase.v1.Plan
+	11, // 51: bytebase.v1.PlanService.UpdatePlan:output_type -> bytebase.v1.Plan
+	13, // 52: bytebase.v1.PlanService.ListPlanCheckRuns:output_type -> bytebase.v1.ListPlanCheckRunsResponse
+	15, // 53: bytebase.v1.PlanService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
+	17, // 54: bytebase.v1.PlanService.BatchCancelPlanCheckRuns:output_type -> bytebase.v1.BatchCancelPlanCheckRunsResponse
+	47, // [47:55] is the sub-list for method output_type
+	39, // [39:47] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_v1_plan_service_proto_init() }
@@ -2181,17 +2869,19 @@ func file_v1_plan_service_proto_init() {
 		(*Plan_Spec_ExportDataConfig)(nil),
 	}
 	file_v1_plan_service_proto_msgTypes[19].OneofWrappers = []any{}
-	file_v1_plan_service_proto_msgTypes[23].OneofWrappers = []any{
+	file_v1_plan_service_proto_msgTypes[25].OneofWrappers = []any{
 		(*PlanCheckRun_Result_SqlSummaryReport_)(nil),
 		(*PlanCheckRun_Result_SqlReviewReport_)(nil),
+		(*PlanCheckRun_Result_LockImpactReport_)(nil),
+		(*PlanCheckRun_Result_ExplainReport_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_plan_service_proto_rawDesc), len(file_v1_plan_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   26,
+			NumEnums:      4,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -6,6 +6,7 @@ package v1
 import (
 	proto "google.golang.org/protobuf/proto"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	math "math"
 )
 
 func (x *GetPlanRequest) Equal(y *GetPlanRequest) bool {
@@ -241,6 +242,21 @@ func (x *Plan_ChangeDatabaseConfig) Equal(y *Plan_ChangeDatabaseConfig) bool {
 	if x.EnableGhost != y.EnableGhost {
 		return false
 	}
+	if len(x.OnlineSchemaChangeFlags) != len(y.OnlineSchemaChangeFlags) {
+		return false
+	}
+	for k := range x.OnlineSchemaChangeFlags {
+		_, ok := y.OnlineSchemaChangeFlags[k]
+		if !ok {
+			return false
+		}
+		if x.OnlineSchemaChangeFlags[k] != y.OnlineSchemaChangeFlags[k] {
+			return false
+		}
+	}
+	if x.EnableOnlineSchemaChange != y.EnableOnlineSchemaChange {
+		return false
+	}
 	return true
 }
 
@@ -292,6 +308,25 @@ func (x *Plan_Deployment_DatabaseGroupMapping) Equal(y *Plan_Deployment_Database
 	return true
 }
 
+func (x *Plan_Deployment_RolloutStrategy) Equal(y *Plan_Deployment_RolloutStrategy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.CanaryCount != y.CanaryCount {
+		return false
+	}
+	if x.BatchPercentage != y.BatchPercentage {
+		return false
+	}
+	if x.FailureThreshold != y.FailureThreshold {
+		return false
+	}
+	return true
+}
+
 func (x *Plan_Deployment) Equal(y *Plan_Deployment) bool {
 	if x == y {
 		return true
@@ -315,6 +350,9 @@ func (x *Plan_Deployment) Equal(y *Plan_Deployment) bool {
 			return false
 		}
 	}
+	if !x.RolloutStrategy.Equal(y.RolloutStrategy) {
+		return false
+	}
 	return true
 }
 
@@ -493,6 +531,9 @@ func (x *PlanCheckRun_Result_SqlSummaryReport) Equal(y *PlanCheckRun_Result_SqlS
 	if !x.ChangedResources.Equal(y.ChangedResources) {
 		return false
 	}
+	if (math.IsNaN(float64(x.EstimatedCost)) && !math.IsNaN(float64(y.EstimatedCost)) || !math.IsNaN(float64(x.EstimatedCost)) && math.IsNaN(float64(y.EstimatedCost))) || (!math.IsNaN(float64(x.EstimatedCost)) && !math.IsNaN(float64(y.EstimatedCost)) && x.EstimatedCost != y.EstimatedCost) {
+		return false
+	}
 	return true
 }
 
@@ -512,6 +553,137 @@ func (x *PlanCheckRun_Result_SqlReviewReport) Equal(y *PlanCheckRun_Result_SqlRe
 	return true
 }
 
+func (x *PlanCheckRun_Result_LockImpactReport) Equal(y *PlanCheckRun_Result_LockImpactReport) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.LockLevel != y.LockLevel {
+		return false
+	}
+	if x.Algorithm != y.Algorithm {
+		return false
+	}
+	if x.TableRewrite != y.TableRewrite {
+		return false
+	}
+	if x.TableScan != y.TableScan {
+		return false
+	}
+	if x.TableRows != y.TableRows {
+		return false
+	}
+	if x.TableSize != y.TableSize {
+		return false
+	}
+	if x.EstimatedBlockingSeconds != y.EstimatedBlockingSeconds {
+		return false
+	}
+	if !x.StartPosition.Equal(y.StartPosition) {
+		return false
+	}
+	return true
+}
+
+func (x *PlanCheckRun_Result_ExplainReport_PlanNode) Equal(y *PlanCheckRun_Result_ExplainReport_PlanNode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Operation != y.Operation {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Index != y.Index {
+		return false
+	}
+	if (math.IsNaN(float64(x.Cost)) && !math.IsNaN(float64(y.Cost)) || !math.IsNaN(float64(x.Cost)) && math.IsNaN(float64(y.Cost))) || (!math.IsNaN(float64(x.Cost)) && !math.IsNaN(float64(y.Cost)) && x.Cost != y.Cost) {
+		return false
+	}
+	if x.Rows != y.Rows {
+		return false
+	}
+	if x.FullScan != y.FullScan {
+		return false
+	}
+	if x.HasFilter != y.HasFilter {
+		return false
+	}
+	if len(x.Children) != len(y.Children) {
+		return false
+	}
+	for i := 0; i < len(x.Children); i++ {
+		if !x.Children[i].Equal(y.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *PlanCheckRun_Result_ExplainReport) Equal(y *PlanCheckRun_Result_ExplainReport) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	if !x.Plan.Equal(y.Plan) {
+		return false
+	}
+	if (math.IsNaN(float64(x.TotalCost)) && !math.IsNaN(float64(y.TotalCost)) || !math.IsNaN(float64(x.TotalCost)) && math.IsNaN(float64(y.TotalCost))) || (!math.IsNaN(float64(x.TotalCost)) && !math.IsNaN(float64(y.TotalCost)) && x.TotalCost != y.TotalCost) {
+		return false
+	}
+	if len(x.FullScanTables) != len(y.FullScanTables) {
+		return false
+	}
+	for i := 0; i < len(x.FullScanTables); i++ {
+		if x.FullScanTables[i] != y.FullScanTables[i] {
+			return false
+		}
+	}
+	if len(x.MissingIndexTables) != len(y.MissingIndexTables) {
+		return false
+	}
+	for i := 0; i < len(x.MissingIndexTables); i++ {
+		if x.MissingIndexTables[i] != y.MissingIndexTables[i] {
+			return false
+		}
+	}
+	if x.PreviousTarget != y.PreviousTarget {
+		return false
+	}
+	if (math.IsNaN(float64(x.PreviousTotalCost)) && !math.IsNaN(float64(y.PreviousTotalCost)) || !math.IsNaN(float64(x.PreviousTotalCost)) && math.IsNaN(float64(y.PreviousTotalCost))) || (!math.IsNaN(float64(x.PreviousTotalCost)) && !math.IsNaN(float64(y.PreviousTotalCost)) && x.PreviousTotalCost != y.PreviousTotalCost) {
+		return false
+	}
+	if len(x.RegressedTables) != len(y.RegressedTables) {
+		return false
+	}
+	for i := 0; i < len(x.RegressedTables); i++ {
+		if x.RegressedTables[i] != y.RegressedTables[i] {
+			return false
+		}
+	}
+	if !x.StartPosition.Equal(y.StartPosition) {
+		return false
+	}
+	return true
+}
+
 func (x *PlanCheckRun_Result) Equal(y *PlanCheckRun_Result) bool {
 	if x == y {
 		return true
@@ -537,6 +709,12 @@ func (x *PlanCheckRun_Result) Equal(y *PlanCheckRun_Result) bool {
 	if !x.GetSqlReviewReport().Equal(y.GetSqlReviewReport()) {
 		return false
 	}
+	if !x.GetLockImpactReport().Equal(y.GetLockImpactReport()) {
+		return false
+	}
+	if !x.GetExplainReport().Equal(y.GetExplainReport()) {
+		return false
+	}
 	return true
 }
 
@@ -483,6 +483,89 @@ func (x *Webhook) Equal(y *Webhook) bool {
 			return false
 		}
 	}
+	if x.SigningSecret != y.SigningSecret {
+		return false
+	}
+	return true
+}
+
+func (x *ListWebhookDeliveriesRequest) Equal(y *ListWebhookDeliveriesRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if x.PageSize != y.PageSize {
+		return false
+	}
+	if x.PageToken != y.PageToken {
+		return false
+	}
+	return true
+}
+
+func (x *ListWebhookDeliveriesResponse) Equal(y *ListWebhookDeliveriesResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Deliveries) != len(y.Deliveries) {
+		return false
+	}
+	for i := 0; i < len(x.Deliveries); i++ {
+		if !x.Deliveries[i].Equal(y.Deliveries[i]) {
+			return false
+		}
+	}
+	if x.NextPageToken != y.NextPageToken {
+		return false
+	}
+	return true
+}
+
+func (x *WebhookDelivery) Equal(y *WebhookDelivery) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Status != y.Status {
+		return false
+	}
+	if x.EventType != y.EventType {
+		return false
+	}
+	if x.Body != y.Body {
+		return false
+	}
+	if x.Attempts != y.Attempts {
+		return false
+	}
+	if x.LastStatusCode != y.LastStatusCode {
+		return false
+	}
+	if x.LastError != y.LastError {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.UpdateTime, y.UpdateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.NextAttemptTime, y.NextAttemptTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
 	return true
 }
 
@@ -616,7 +616,11 @@ type CheckReleaseRequest struct {
 	// Each rule should be a clear statement describing the desired schema constraint.
 	// Example: "All tables must have a primary key"
 	// Example: "VARCHAR columns should specify a maximum length"
-	CustomRules   string `protobuf:"bytes,4,opt,name=custom_rules,json=customRules,proto3" json:"custom_rules,omitempty"`
+	CustomRules string `protobuf:"bytes,4,opt,name=custom_rules,json=customRules,proto3" json:"custom_rules,omitempty"`
+	// Whether to check that the release files are formatted by the SQL formatter.
+	// A warning is reported for each file that is not formatted.
+	// Supported engines: MySQL, MariaDB, OceanBase, PostgreSQL, SQL Server and Oracle.
+	CheckFormat   bool `protobuf:"varint,5,opt,name=check_format,json=checkFormat,proto3" json:"check_format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -679,6 +683,13 @@ func (x *CheckReleaseRequest) GetCustomRules() string {
 	return ""
 }
 
+func (x *CheckReleaseRequest) GetCheckFormat() bool {
+	if x != nil {
+		return x.CheckFormat
+	}
+	return false
+}
+
 type CheckReleaseResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The check results for each file and target combination.
@@ -1155,13 +1166,14 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\x14bytebase.com/ReleaseR\x04name\"J\n" +
 	"\x16UndeleteReleaseRequest\x120\n" +
 	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
-	"\x14bytebase.com/ReleaseR\x04name\"\xbd\x01\n" +
+	"\x14bytebase.com/ReleaseR\x04name\"\xe0\x01\n" +
 	"\x13CheckReleaseRequest\x124\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x06parent\x123\n" +
 	"\arelease\x18\x02 \x01(\v2\x14.bytebase.v1.ReleaseB\x03\xe0A\x02R\arelease\x12\x18\n" +
 	"\atargets\x18\x03 \x03(\tR\atargets\x12!\n" +
-	"\fcustom_rules\x18\x04 \x01(\tR\vcustomRules\"\x82\x03\n" +
+	"\fcustom_rules\x18\x04 \x01(\tR\vcustomRules\x12!\n" +
+	"\fcheck_format\x18\x05 \x01(\bR\vcheckFormat\"\x82\x03\n" +
 	"\x14CheckReleaseResponse\x12G\n" +
 	"\aresults\x18\x01 \x03(\v2-.bytebase.v1.CheckReleaseResponse.CheckResultR\aresults\x12#\n" +
 	"\raffected_rows\x18\x02 \x01(\x03R\faffectedRows\x125\n" +
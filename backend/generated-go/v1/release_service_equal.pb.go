@@ -196,6 +196,9 @@ func (x *CheckReleaseRequest) Equal(y *CheckReleaseRequest) bool {
 	if x.CustomRules != y.CustomRules {
 		return false
 	}
+	if x.CheckFormat != y.CheckFormat {
+		return false
+	}
 	return true
 }
 
@@ -322,6 +322,12 @@ func (x *Stage) Equal(y *Stage) bool {
 			return false
 		}
 	}
+	if p, q := x.NextWindowOpenTime, y.NextWindowOpenTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if x.Halted != y.Halted {
+		return false
+	}
 	return true
 }
 
@@ -531,6 +537,12 @@ func (x *TaskRun_SchedulerInfo_WaitingCause) Equal(y *TaskRun_SchedulerInfo_Wait
 	if x.GetParallelTasksLimit() != y.GetParallelTasksLimit() {
 		return false
 	}
+	if p, q := x.GetRolloutWindow(), y.GetRolloutWindow(); (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if x.GetRolloutBatch() != y.GetRolloutBatch() {
+		return false
+	}
 	return true
 }
 
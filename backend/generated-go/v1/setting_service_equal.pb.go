@@ -811,6 +811,21 @@ func (x *AISetting) Equal(y *AISetting) bool {
 	if x.Version != y.Version {
 		return false
 	}
+	if len(x.Headers) != len(y.Headers) {
+		return false
+	}
+	for k := range x.Headers {
+		_, ok := y.Headers[k]
+		if !ok {
+			return false
+		}
+		if x.Headers[k] != y.Headers[k] {
+			return false
+		}
+	}
+	if x.CaCertificate != y.CaCertificate {
+		return false
+	}
 	return true
 }
 
@@ -23,8 +23,10 @@ const (
 	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
 	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
 	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
+	SQLService_ExportStream_FullMethodName         = "/bytebase.v1.SQLService/ExportStream"
 	SQLService_DiffMetadata_FullMethodName         = "/bytebase.v1.SQLService/DiffMetadata"
 	SQLService_AICompletion_FullMethodName         = "/bytebase.v1.SQLService/AICompletion"
+	SQLService_AICompletionStream_FullMethodName   = "/bytebase.v1.SQLService/AICompletionStream"
 )
 
 // SQLServiceClient is the client API for SQLService service.
@@ -45,12 +47,20 @@ type SQLServiceClient interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// Downloads the data exported by a rollout or stage as a zip file, streaming the file
+	// in chunks so that large exports are not held in memory.
+	// Permissions required: bb.databases.get
+	ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (*AICompletionResponse, error)
+	// Provides AI-powered SQL completion and generation, streaming the text as it
+	// is generated.
+	// Permissions required: None (authenticated users only, requires AI to be enabled)
+	AICompletionStream(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AICompletionStreamResponse], error)
 }
 
 type sQLServiceClient struct {
@@ -104,6 +114,25 @@ func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts .
 	return out, nil
 }
 
+func (c *sQLServiceClient) ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_ExportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportStreamClient = grpc.ServerStreamingClient[ExportResponse]
+
 func (c *sQLServiceClient) DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiffMetadataResponse)
@@ -124,6 +153,25 @@ func (c *sQLServiceClient) AICompletion(ctx context.Context, in *AICompletionReq
 	return out, nil
 }
 
+func (c *sQLServiceClient) AICompletionStream(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AICompletionStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[2], SQLService_AICompletionStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AICompletionRequest, AICompletionStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_AICompletionStreamClient = grpc.ServerStreamingClient[AICompletionStreamResponse]
+
 // SQLServiceServer is the server API for SQLService service.
 // All implementations must embed UnimplementedSQLServiceServer
 // for forward compatibility.
@@ -142,12 +190,20 @@ type SQLServiceServer interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// Downloads the data exported by a rollout or stage as a zip file, streaming the file
+	// in chunks so that large exports are not held in memory.
+	// Permissions required: bb.databases.get
+	ExportStream(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *AICompletionRequest) (*AICompletionResponse, error)
+	// Provides AI-powered SQL completion and generation, streaming the text as it
+	// is generated.
+	// Permissions required: None (authenticated users only, requires AI to be enabled)
+	AICompletionStream(*AICompletionRequest, grpc.ServerStreamingServer[AICompletionStreamResponse]) error
 	mustEmbedUnimplementedSQLServiceServer()
 }
 
@@ -170,12 +226,18 @@ func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *Sear
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSQLServiceServer) ExportStream(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportStream not implemented")
+}
 func (UnimplementedSQLServiceServer) DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DiffMetadata not implemented")
 }
 func (UnimplementedSQLServiceServer) AICompletion(context.Context, *AICompletionRequest) (*AICompletionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AICompletion not implemented")
 }
+func (UnimplementedSQLServiceServer) AICompletionStream(*AICompletionRequest, grpc.ServerStreamingServer[AICompletionStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method AICompletionStream not implemented")
+}
 func (UnimplementedSQLServiceServer) mustEmbedUnimplementedSQLServiceServer() {}
 func (UnimplementedSQLServiceServer) testEmbeddedByValue()                    {}
 
@@ -258,6 +320,17 @@ func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ExportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).ExportStream(m, &grpc.GenericServerStream[ExportRequest, ExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportStreamServer = grpc.ServerStreamingServer[ExportResponse]
+
 func _SQLService_DiffMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffMetadataRequest)
 	if err := dec(in); err != nil {
@@ -294,6 +367,17 @@ func _SQLService_AICompletion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_AICompletionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AICompletionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).AICompletionStream(m, &grpc.GenericServerStream[AICompletionRequest, AICompletionStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_AICompletionStreamServer = grpc.ServerStreamingServer[AICompletionStreamResponse]
+
 // SQLService_ServiceDesc is the grpc.ServiceDesc for SQLService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -329,6 +413,16 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportStream",
+			Handler:       _SQLService_ExportStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AICompletionStream",
+			Handler:       _SQLService_AICompletionStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/sql_service.proto",
 }
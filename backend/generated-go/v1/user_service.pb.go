@@ -772,6 +772,664 @@ func (x *User) GetGroups() []string {
 	return nil
 }
 
+type PersonalAccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the personal access token.
+	// Format: users/{user}/personalAccessTokens/{personal_access_token}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title describing what the token is used for.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The token used as the bearer token of the API requests.
+	// It is only returned by CreatePersonalAccessToken and cannot be retrieved afterwards.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// The permissions restricting the token to a subset of the user's permissions, such as "bb.databases.list".
+	// Empty means the token has all the permissions of the user.
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// The expiration time of the token. The token never expires if not set.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last time the token was used to authenticate a request.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalAccessToken) Reset() {
+	*x = PersonalAccessToken{}
+	mi := &file_v1_user_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalAccessToken) ProtoMessage() {}
+
+func (x *PersonalAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalAccessToken.ProtoReflect.Descriptor instead.
+func (*PersonalAccessToken) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PersonalAccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalAccessToken) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PersonalAccessToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PersonalAccessToken) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *PersonalAccessToken) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *PersonalAccessToken) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PersonalAccessToken) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type CreatePersonalAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to create the token for, which must be the caller.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The personal access token to create.
+	PersonalAccessToken *PersonalAccessToken `protobuf:"bytes,2,opt,name=personal_access_token,json=personalAccessToken,proto3" json:"personal_access_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreatePersonalAccessTokenRequest) Reset() {
+	*x = CreatePersonalAccessTokenRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonalAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonalAccessTokenRequest) ProtoMessage() {}
+
+func (x *CreatePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonalAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePersonalAccessTokenRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreatePersonalAccessTokenRequest) GetPersonalAccessToken() *PersonalAccessToken {
+	if x != nil {
+		return x.PersonalAccessToken
+	}
+	return nil
+}
+
+type ListPersonalAccessTokensRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user owning the tokens.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPersonalAccessTokensRequest) Reset() {
+	*x = ListPersonalAccessTokensRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPersonalAccessTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonalAccessTokensRequest) ProtoMessage() {}
+
+func (x *ListPersonalAccessTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonalAccessTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonalAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPersonalAccessTokensRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListPersonalAccessTokensResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The personal access tokens of the user, the tokens themselves are not returned.
+	PersonalAccessTokens []*PersonalAccessToken `protobuf:"bytes,1,rep,name=personal_access_tokens,json=personalAccessTokens,proto3" json:"personal_access_tokens,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListPersonalAccessTokensResponse) Reset() {
+	*x = ListPersonalAccessTokensResponse{}
+	mi := &file_v1_user_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPersonalAccessTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonalAccessTokensResponse) ProtoMessage() {}
+
+func (x *ListPersonalAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonalAccessTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListPersonalAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPersonalAccessTokensResponse) GetPersonalAccessTokens() []*PersonalAccessToken {
+	if x != nil {
+		return x.PersonalAccessTokens
+	}
+	return nil
+}
+
+type RevokePersonalAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the personal access token to revoke.
+	// Format: users/{user}/personalAccessTokens/{personal_access_token}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePersonalAccessTokenRequest) Reset() {
+	*x = RevokePersonalAccessTokenRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePersonalAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePersonalAccessTokenRequest) ProtoMessage() {}
+
+func (x *RevokePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePersonalAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokePersonalAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WebAuthnCredential struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the credential, the credential ID is base64url encoded.
+	// Format: users/{user}/webAuthnCredentials/{webauthn_credential}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the credential, such as the name of the security key.
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last time the credential was used to sign in.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
+	mi := &file_v1_user_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthnCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnCredential) ProtoMessage() {}
+
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WebAuthnCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebAuthnCredential) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type BeginWebAuthnRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to register the credential for, which must be the caller.
+	// Format: users/{user}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnRegistrationRequest) Reset() {
+	*x = BeginWebAuthnRegistrationRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginWebAuthnRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BeginWebAuthnRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BeginWebAuthnRegistrationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The PublicKeyCredentialCreationOptions in the WebAuthn JSON format,
+	// which can be parsed by PublicKeyCredential.parseCreationOptionsFromJSON() in the browser.
+	Options       string `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnRegistrationResponse) Reset() {
+	*x = BeginWebAuthnRegistrationResponse{}
+	mi := &file_v1_user_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginWebAuthnRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+type CreateWebAuthnCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to register the credential for, which must be the caller.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The title of the credential.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The RegistrationResponseJSON returned by PublicKeyCredential.toJSON() after navigator.credentials.create().
+	RegistrationResponse string `protobuf:"bytes,3,opt,name=registration_response,json=registrationResponse,proto3" json:"registration_response,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateWebAuthnCredentialRequest) Reset() {
+	*x = CreateWebAuthnCredentialRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebAuthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebAuthnCredentialRequest) ProtoMessage() {}
+
+func (x *CreateWebAuthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebAuthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebAuthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateWebAuthnCredentialRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateWebAuthnCredentialRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateWebAuthnCredentialRequest) GetRegistrationResponse() string {
+	if x != nil {
+		return x.RegistrationResponse
+	}
+	return ""
+}
+
+type ListWebAuthnCredentialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user owning the credentials.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebAuthnCredentialsRequest) Reset() {
+	*x = ListWebAuthnCredentialsRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebAuthnCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebAuthnCredentialsRequest) ProtoMessage() {}
+
+func (x *ListWebAuthnCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebAuthnCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebAuthnCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListWebAuthnCredentialsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListWebAuthnCredentialsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The WebAuthn credentials of the user.
+	WebauthnCredentials []*WebAuthnCredential `protobuf:"bytes,1,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListWebAuthnCredentialsResponse) Reset() {
+	*x = ListWebAuthnCredentialsResponse{}
+	mi := &file_v1_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebAuthnCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebAuthnCredentialsResponse) ProtoMessage() {}
+
+func (x *ListWebAuthnCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebAuthnCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebAuthnCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWebAuthnCredentialsResponse) GetWebauthnCredentials() []*WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredentials
+	}
+	return nil
+}
+
+type DeleteWebAuthnCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the credential to delete.
+	// Format: users/{user}/webAuthnCredentials/{webauthn_credential}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebAuthnCredentialRequest) Reset() {
+	*x = DeleteWebAuthnCredentialRequest{}
+	mi := &file_v1_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebAuthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebAuthnCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteWebAuthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebAuthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebAuthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteWebAuthnCredentialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type User_Profile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The last time the user successfully logged in.
@@ -786,7 +1444,7 @@ type User_Profile struct {
 
 func (x *User_Profile) Reset() {
 	*x = User_Profile{}
-	mi := &file_v1_user_service_proto_msgTypes[10]
+	mi := &file_v1_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +1456,7 @@ func (x *User_Profile) String() string {
 func (*User_Profile) ProtoMessage() {}
 
 func (x *User_Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_user_service_proto_msgTypes[10]
+	mi := &file_v1_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,13 +1554,61 @@ const file_v1_user_service_proto_rawDesc = "" +
 	"\x0flast_login_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastLoginTime\x12U\n" +
 	"\x19last_change_password_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x16lastChangePasswordTime\x12\x16\n" +
 	"\x06source\x18\x03 \x01(\tR\x06source:$\xeaA!\n" +
-	"\x11bytebase.com/User\x12\fusers/{user}*T\n" +
+	"\x11bytebase.com/User\x12\fusers/{user}\"\xae\x03\n" +
+	"\x13PersonalAccessToken\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x19\n" +
+	"\x05token\x18\x03 \x01(\tB\x03\xe0A\x03R\x05token\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\x0elast_used_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastUsedTime:`\xeaA]\n" +
+	" bytebase.com/PersonalAccessToken\x129users/{user}/personalAccessTokens/{personal_access_token}\"\xb0\x01\n" +
+	" CreatePersonalAccessTokenRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\x12Y\n" +
+	"\x15personal_access_token\x18\x02 \x01(\v2 .bytebase.v1.PersonalAccessTokenB\x03\xe0A\x02R\x13personalAccessToken\"T\n" +
+	"\x1fListPersonalAccessTokensRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\"z\n" +
+	" ListPersonalAccessTokensResponse\x12V\n" +
+	"\x16personal_access_tokens\x18\x01 \x03(\v2 .bytebase.v1.PersonalAccessTokenR\x14personalAccessTokens\"`\n" +
+	" RevokePersonalAccessTokenRequest\x12<\n" +
+	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" bytebase.com/PersonalAccessTokenR\x04name\"\xaa\x02\n" +
+	"\x12WebAuthnCredential\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\x0elast_used_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastUsedTime:\\\xeaAY\n" +
+	"\x1fbytebase.com/WebAuthnCredential\x126users/{user}/webAuthnCredentials/{webauthn_credential}\"Q\n" +
+	" BeginWebAuthnRegistrationRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x04name\"=\n" +
+	"!BeginWebAuthnRegistrationResponse\x12\x18\n" +
+	"\aoptions\x18\x01 \x01(\tR\aoptions\"\xa9\x01\n" +
+	"\x1fCreateWebAuthnCredentialRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x128\n" +
+	"\x15registration_response\x18\x03 \x01(\tB\x03\xe0A\x02R\x14registrationResponse\"S\n" +
+	"\x1eListWebAuthnCredentialsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/UserR\x06parent\"u\n" +
+	"\x1fListWebAuthnCredentialsResponse\x12R\n" +
+	"\x14webauthn_credentials\x18\x01 \x03(\v2\x1f.bytebase.v1.WebAuthnCredentialR\x13webauthnCredentials\"^\n" +
+	"\x1fDeleteWebAuthnCredentialRequest\x12;\n" +
+	"\x04name\x18\x01 \x01(\tB'\xe0A\x02\xfaA!\n" +
+	"\x1fbytebase.com/WebAuthnCredentialR\x04name*T\n" +
 	"\bUserType\x12\x19\n" +
 	"\x15USER_TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04USER\x10\x01\x12\x0e\n" +
 	"\n" +
 	"SYSTEM_BOT\x10\x02\x12\x13\n" +
-	"\x0fSERVICE_ACCOUNT\x10\x032\xb7\a\n" +
+	"\x0fSERVICE_ACCOUNT\x10\x032\xeb\x11\n" +
 	"\vUserService\x12p\n" +
 	"\aGetUser\x12\x1b.bytebase.v1.GetUserRequest\x1a\x11.bytebase.v1.User\"5\xdaA\x04name\x8a\xea0\fbb.users.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/{name=users/*}\x12\x86\x01\n" +
 	"\rBatchGetUsers\x12!.bytebase.v1.BatchGetUsersRequest\x1a\".bytebase.v1.BatchGetUsersResponse\".\x8a\xea0\fbb.users.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users:batchGet\x12Y\n" +
@@ -914,7 +1620,14 @@ const file_v1_user_service_proto_rawDesc = "" +
 	"UpdateUser\x12\x1e.bytebase.v1.UpdateUserRequest\x1a\x11.bytebase.v1.User\"@\xdaA\x10user,update_mask\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02\x1f:\x04user2\x17/v1/{user.name=users/*}\x12o\n" +
 	"\n" +
 	"DeleteUser\x12\x1e.bytebase.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02\x14*\x12/v1/{name=users/*}\x12s\n" +
-	"\fUndeleteUser\x12 .bytebase.v1.UndeleteUserRequest\x1a\x11.bytebase.v1.User\".\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/{name=users/*}:undeleteB\xa6\x01\n" +
+	"\fUndeleteUser\x12 .bytebase.v1.UndeleteUserRequest\x1a\x11.bytebase.v1.User\".\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/{name=users/*}:undelete\x12\xdd\x01\n" +
+	"\x19CreatePersonalAccessToken\x12-.bytebase.v1.CreatePersonalAccessTokenRequest\x1a .bytebase.v1.PersonalAccessToken\"o\xdaA\x1cparent,personal_access_token\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02B:\x15personal_access_token\")/v1/{parent=users/*}/personalAccessTokens\x12\xb7\x01\n" +
+	"\x18ListPersonalAccessTokens\x12,.bytebase.v1.ListPersonalAccessTokensRequest\x1a-.bytebase.v1.ListPersonalAccessTokensResponse\">\xdaA\x06parent\x90\xea0\x02\x82\xd3\xe4\x93\x02+\x12)/v1/{parent=users/*}/personalAccessTokens\x12\xae\x01\n" +
+	"\x19RevokePersonalAccessToken\x12-.bytebase.v1.RevokePersonalAccessTokenRequest\x1a\x16.google.protobuf.Empty\"J\xdaA\x04name\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x025:\x01*\"0/v1/{name=users/*/personalAccessTokens/*}:revoke\x12\xbe\x01\n" +
+	"\x19BeginWebAuthnRegistration\x12-.bytebase.v1.BeginWebAuthnRegistrationRequest\x1a..bytebase.v1.BeginWebAuthnRegistrationResponse\"B\xdaA\x04name\x90\xea0\x02\x82\xd3\xe4\x93\x021:\x01*\",/v1/{name=users/*}:beginWebAuthnRegistration\x12\xcb\x01\n" +
+	"\x18CreateWebAuthnCredential\x12,.bytebase.v1.CreateWebAuthnCredentialRequest\x1a\x1f.bytebase.v1.WebAuthnCredential\"`\xdaA\"parent,title,registration_response\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/{parent=users/*}/webAuthnCredentials\x12\xb3\x01\n" +
+	"\x17ListWebAuthnCredentials\x12+.bytebase.v1.ListWebAuthnCredentialsRequest\x1a,.bytebase.v1.ListWebAuthnCredentialsResponse\"=\xdaA\x06parent\x90\xea0\x02\x82\xd3\xe4\x93\x02*\x12(/v1/{parent=users/*}/webAuthnCredentials\x12\xa1\x01\n" +
+	"\x18DeleteWebAuthnCredential\x12,.bytebase.v1.DeleteWebAuthnCredentialRequest\x1a\x16.google.protobuf.Empty\"?\xdaA\x04name\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02**(/v1/{name=users/*/webAuthnCredentials/*}B\xa6\x01\n" +
 	"\x0fcom.bytebase.v1B\x10UserServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -930,58 +1643,92 @@ func file_v1_user_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_v1_user_service_proto_goTypes = []any{
-	(UserType)(0),                 // 0: bytebase.v1.UserType
-	(*GetUserRequest)(nil),        // 1: bytebase.v1.GetUserRequest
-	(*BatchGetUsersRequest)(nil),  // 2: bytebase.v1.BatchGetUsersRequest
-	(*BatchGetUsersResponse)(nil), // 3: bytebase.v1.BatchGetUsersResponse
-	(*ListUsersRequest)(nil),      // 4: bytebase.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 5: bytebase.v1.ListUsersResponse
-	(*CreateUserRequest)(nil),     // 6: bytebase.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),     // 7: bytebase.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),     // 8: bytebase.v1.DeleteUserRequest
-	(*UndeleteUserRequest)(nil),   // 9: bytebase.v1.UndeleteUserRequest
-	(*User)(nil),                  // 10: bytebase.v1.User
-	(*User_Profile)(nil),          // 11: bytebase.v1.User.Profile
-	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
-	(State)(0),                    // 13: bytebase.v1.State
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 15: google.protobuf.Empty
+	(UserType)(0),                             // 0: bytebase.v1.UserType
+	(*GetUserRequest)(nil),                    // 1: bytebase.v1.GetUserRequest
+	(*BatchGetUsersRequest)(nil),              // 2: bytebase.v1.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),             // 3: bytebase.v1.BatchGetUsersResponse
+	(*ListUsersRequest)(nil),                  // 4: bytebase.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                 // 5: bytebase.v1.ListUsersResponse
+	(*CreateUserRequest)(nil),                 // 6: bytebase.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                 // 7: bytebase.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                 // 8: bytebase.v1.DeleteUserRequest
+	(*UndeleteUserRequest)(nil),               // 9: bytebase.v1.UndeleteUserRequest
+	(*User)(nil),                              // 10: bytebase.v1.User
+	(*PersonalAccessToken)(nil),               // 11: bytebase.v1.PersonalAccessToken
+	(*CreatePersonalAccessTokenRequest)(nil),  // 12: bytebase.v1.CreatePersonalAccessTokenRequest
+	(*ListPersonalAccessTokensRequest)(nil),   // 13: bytebase.v1.ListPersonalAccessTokensRequest
+	(*ListPersonalAccessTokensResponse)(nil),  // 14: bytebase.v1.ListPersonalAccessTokensResponse
+	(*RevokePersonalAccessTokenRequest)(nil),  // 15: bytebase.v1.RevokePersonalAccessTokenRequest
+	(*WebAuthnCredential)(nil),                // 16: bytebase.v1.WebAuthnCredential
+	(*BeginWebAuthnRegistrationRequest)(nil),  // 17: bytebase.v1.BeginWebAuthnRegistrationRequest
+	(*BeginWebAuthnRegistrationResponse)(nil), // 18: bytebase.v1.BeginWebAuthnRegistrationResponse
+	(*CreateWebAuthnCredentialRequest)(nil),   // 19: bytebase.v1.CreateWebAuthnCredentialRequest
+	(*ListWebAuthnCredentialsRequest)(nil),    // 20: bytebase.v1.ListWebAuthnCredentialsRequest
+	(*ListWebAuthnCredentialsResponse)(nil),   // 21: bytebase.v1.ListWebAuthnCredentialsResponse
+	(*DeleteWebAuthnCredentialRequest)(nil),   // 22: bytebase.v1.DeleteWebAuthnCredentialRequest
+	(*User_Profile)(nil),                      // 23: bytebase.v1.User.Profile
+	(*fieldmaskpb.FieldMask)(nil),             // 24: google.protobuf.FieldMask
+	(State)(0),                                // 25: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),             // 26: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 27: google.protobuf.Empty
 }
 var file_v1_user_service_proto_depIdxs = []int32{
 	10, // 0: bytebase.v1.BatchGetUsersResponse.users:type_name -> bytebase.v1.User
 	10, // 1: bytebase.v1.ListUsersResponse.users:type_name -> bytebase.v1.User
 	10, // 2: bytebase.v1.CreateUserRequest.user:type_name -> bytebase.v1.User
 	10, // 3: bytebase.v1.UpdateUserRequest.user:type_name -> bytebase.v1.User
-	12, // 4: bytebase.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	13, // 5: bytebase.v1.User.state:type_name -> bytebase.v1.State
+	24, // 4: bytebase.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	25, // 5: bytebase.v1.User.state:type_name -> bytebase.v1.State
 	0,  // 6: bytebase.v1.User.user_type:type_name -> bytebase.v1.UserType
-	14, // 7: bytebase.v1.User.temp_otp_secret_created_time:type_name -> google.protobuf.Timestamp
-	11, // 8: bytebase.v1.User.profile:type_name -> bytebase.v1.User.Profile
-	14, // 9: bytebase.v1.User.Profile.last_login_time:type_name -> google.protobuf.Timestamp
-	14, // 10: bytebase.v1.User.Profile.last_change_password_time:type_name -> google.protobuf.Timestamp
-	1,  // 11: bytebase.v1.UserService.GetUser:input_type -> bytebase.v1.GetUserRequest
-	2,  // 12: bytebase.v1.UserService.BatchGetUsers:input_type -> bytebase.v1.BatchGetUsersRequest
-	15, // 13: bytebase.v1.UserService.GetCurrentUser:input_type -> google.protobuf.Empty
-	4,  // 14: bytebase.v1.UserService.ListUsers:input_type -> bytebase.v1.ListUsersRequest
-	6,  // 15: bytebase.v1.UserService.CreateUser:input_type -> bytebase.v1.CreateUserRequest
-	7,  // 16: bytebase.v1.UserService.UpdateUser:input_type -> bytebase.v1.UpdateUserRequest
-	8,  // 17: bytebase.v1.UserService.DeleteUser:input_type -> bytebase.v1.DeleteUserRequest
-	9,  // 18: bytebase.v1.UserService.UndeleteUser:input_type -> bytebase.v1.UndeleteUserRequest
-	10, // 19: bytebase.v1.UserService.GetUser:output_type -> bytebase.v1.User
-	3,  // 20: bytebase.v1.UserService.BatchGetUsers:output_type -> bytebase.v1.BatchGetUsersResponse
-	10, // 21: bytebase.v1.UserService.GetCurrentUser:output_type -> bytebase.v1.User
-	5,  // 22: bytebase.v1.UserService.ListUsers:output_type -> bytebase.v1.ListUsersResponse
-	10, // 23: bytebase.v1.UserService.CreateUser:output_type -> bytebase.v1.User
-	10, // 24: bytebase.v1.UserService.UpdateUser:output_type -> bytebase.v1.User
-	15, // 25: bytebase.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	10, // 26: bytebase.v1.UserService.UndeleteUser:output_type -> bytebase.v1.User
-	19, // [19:27] is the sub-list for method output_type
-	11, // [11:19] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	26, // 7: bytebase.v1.User.temp_otp_secret_created_time:type_name -> google.protobuf.Timestamp
+	23, // 8: bytebase.v1.User.profile:type_name -> bytebase.v1.User.Profile
+	26, // 9: bytebase.v1.PersonalAccessToken.expire_time:type_name -> google.protobuf.Timestamp
+	26, // 10: bytebase.v1.PersonalAccessToken.create_time:type_name -> google.protobuf.Timestamp
+	26, // 11: bytebase.v1.PersonalAccessToken.last_used_time:type_name -> google.protobuf.Timestamp
+	11, // 12: bytebase.v1.CreatePersonalAccessTokenRequest.personal_access_token:type_name -> bytebase.v1.PersonalAccessToken
+	11, // 13: bytebase.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> bytebase.v1.PersonalAccessToken
+	26, // 14: bytebase.v1.WebAuthnCredential.create_time:type_name -> google.protobuf.Timestamp
+	26, // 15: bytebase.v1.WebAuthnCredential.last_used_time:type_name -> google.protobuf.Timestamp
+	16, // 16: bytebase.v1.ListWebAuthnCredentialsResponse.webauthn_credentials:type_name -> bytebase.v1.WebAuthnCredential
+	26, // 17: bytebase.v1.User.Profile.last_login_time:type_name -> google.protobuf.Timestamp
+	26, // 18: bytebase.v1.User.Profile.last_change_password_time:type_name -> google.protobuf.Timestamp
+	1,  // 19: bytebase.v1.UserService.GetUser:input_type -> bytebase.v1.GetUserRequest
+	2,  // 20: bytebase.v1.UserService.BatchGetUsers:input_type -> bytebase.v1.BatchGetUsersRequest
+	27, // 21: bytebase.v1.UserService.GetCurrentUser:input_type -> google.protobuf.Empty
+	4,  // 22: bytebase.v1.UserService.ListUsers:input_type -> bytebase.v1.ListUsersRequest
+	6,  // 23: bytebase.v1.UserService.CreateUser:input_type -> bytebase.v1.CreateUserRequest
+	7,  // 24: bytebase.v1.UserService.UpdateUser:input_type -> bytebase.v1.UpdateUserRequest
+	8,  // 25: bytebase.v1.UserService.DeleteUser:input_type -> bytebase.v1.DeleteUserRequest
+	9,  // 26: bytebase.v1.UserService.UndeleteUser:input_type -> bytebase.v1.UndeleteUserRequest
+	12, // 27: bytebase.v1.UserService.CreatePersonalAccessToken:input_type -> bytebase.v1.CreatePersonalAccessTokenRequest
+	13, // 28: bytebase.v1.UserService.ListPersonalAccessTokens:input_type -> bytebase.v1.ListPersonalAccessTokensRequest
+	15, // 29: bytebase.v1.UserService.RevokePersonalAccessToken:input_type -> bytebase.v1.RevokePersonalAccessTokenRequest
+	17, // 30: bytebase.v1.UserService.BeginWebAuthnRegistration:input_type -> bytebase.v1.BeginWebAuthnRegistrationRequest
+	19, // 31: bytebase.v1.UserService.CreateWebAuthnCredential:input_type -> bytebase.v1.CreateWebAuthnCredentialRequest
+	20, // 32: bytebase.v1.UserService.ListWebAuthnCredentials:input_type -> bytebase.v1.ListWebAuthnCredentialsRequest
+	22, // 33: bytebase.v1.UserService.DeleteWebAuthnCredential:input_type -> bytebase.v1.DeleteWebAuthnCredentialRequest
+	10, // 34: bytebase.v1.UserService.GetUser:output_type -> bytebase.v1.User
+	3,  // 35: bytebase.v1.UserService.BatchGetUsers:output_type -> bytebase.v1.BatchGetUsersResponse
+	10, // 36: bytebase.v1.UserService.GetCurrentUser:output_type -> bytebase.v1.User
+	5,  // 37: bytebase.v1.UserService.ListUsers:output_type -> bytebase.v1.ListUsersResponse
+	10, // 38: bytebase.v1.UserService.CreateUser:output_type -> bytebase.v1.User
+	10, // 39: bytebase.v1.UserService.UpdateUser:output_type -> bytebase.v1.User
+	27, // 40: bytebase.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	10, // 41: bytebase.v1.UserService.UndeleteUser:output_type -> bytebase.v1.User
+	11, // 42: bytebase.v1.UserService.CreatePersonalAccessToken:output_type -> bytebase.v1.PersonalAccessToken
+	14, // 43: bytebase.v1.UserService.ListPersonalAccessTokens:output_type -> bytebase.v1.ListPersonalAccessTokensResponse
+	27, // 44: bytebase.v1.UserService.RevokePersonalAccessToken:output_type -> google.protobuf.Empty
+	18, // 45: bytebase.v1.UserService.BeginWebAuthnRegistration:output_type -> bytebase.v1.BeginWebAuthnRegistrationResponse
+	16, // 46: bytebase.v1.UserService.CreateWebAuthnCredential:output_type -> bytebase.v1.WebAuthnCredential
+	21, // 47: bytebase.v1.UserService.ListWebAuthnCredentials:output_type -> bytebase.v1.ListWebAuthnCredentialsResponse
+	27, // 48: bytebase.v1.UserService.DeleteWebAuthnCredential:output_type -> google.protobuf.Empty
+	34, // [34:49] is the sub-list for method output_type
+	19, // [19:34] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_v1_user_service_proto_init() }
@@ -998,7 +1745,7 @@ func file_v1_user_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_service_proto_rawDesc), len(file_v1_user_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
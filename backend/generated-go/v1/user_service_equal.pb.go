@@ -251,3 +251,210 @@ func (x *User) Equal(y *User) bool {
 	}
 	return true
 }
+
+func (x *PersonalAccessToken) Equal(y *PersonalAccessToken) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if x.Token != y.Token {
+		return false
+	}
+	if len(x.Permissions) != len(y.Permissions) {
+		return false
+	}
+	for i := 0; i < len(x.Permissions); i++ {
+		if x.Permissions[i] != y.Permissions[i] {
+			return false
+		}
+	}
+	if p, q := x.ExpireTime, y.ExpireTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.LastUsedTime, y.LastUsedTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *CreatePersonalAccessTokenRequest) Equal(y *CreatePersonalAccessTokenRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if !x.PersonalAccessToken.Equal(y.PersonalAccessToken) {
+		return false
+	}
+	return true
+}
+
+func (x *ListPersonalAccessTokensRequest) Equal(y *ListPersonalAccessTokensRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	return true
+}
+
+func (x *ListPersonalAccessTokensResponse) Equal(y *ListPersonalAccessTokensResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.PersonalAccessTokens) != len(y.PersonalAccessTokens) {
+		return false
+	}
+	for i := 0; i < len(x.PersonalAccessTokens); i++ {
+		if !x.PersonalAccessTokens[i].Equal(y.PersonalAccessTokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *RevokePersonalAccessTokenRequest) Equal(y *RevokePersonalAccessTokenRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+func (x *WebAuthnCredential) Equal(y *WebAuthnCredential) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.LastUsedTime, y.LastUsedTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *BeginWebAuthnRegistrationRequest) Equal(y *BeginWebAuthnRegistrationRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+func (x *BeginWebAuthnRegistrationResponse) Equal(y *BeginWebAuthnRegistrationResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Options != y.Options {
+		return false
+	}
+	return true
+}
+
+func (x *CreateWebAuthnCredentialRequest) Equal(y *CreateWebAuthnCredentialRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if x.RegistrationResponse != y.RegistrationResponse {
+		return false
+	}
+	return true
+}
+
+func (x *ListWebAuthnCredentialsRequest) Equal(y *ListWebAuthnCredentialsRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	return true
+}
+
+func (x *ListWebAuthnCredentialsResponse) Equal(y *ListWebAuthnCredentialsResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.WebauthnCredentials) != len(y.WebauthnCredentials) {
+		return false
+	}
+	for i := 0; i < len(x.WebauthnCredentials); i++ {
+		if !x.WebauthnCredentials[i].Equal(y.WebauthnCredentials[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *DeleteWebAuthnCredentialRequest) Equal(y *DeleteWebAuthnCredentialRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
@@ -38,6 +38,9 @@ const (
 	AuthServiceLoginProcedure = "/bytebase.v1.AuthService/Login"
 	// AuthServiceLogoutProcedure is the fully-qualified name of the AuthService's Logout RPC.
 	AuthServiceLogoutProcedure = "/bytebase.v1.AuthService/Logout"
+	// AuthServiceBeginWebAuthnLoginProcedure is the fully-qualified name of the AuthService's
+	// BeginWebAuthnLogin RPC.
+	AuthServiceBeginWebAuthnLoginProcedure = "/bytebase.v1.AuthService/BeginWebAuthnLogin"
 )
 
 // AuthServiceClient is a client for the bytebase.v1.AuthService service.
@@ -48,6 +51,9 @@ type AuthServiceClient interface {
 	// Logs out the current user session.
 	// Permissions required: None
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[emptypb.Empty], error)
+	// Starts the WebAuthn assertion ceremony for the second step of the MFA login.
+	// Permissions required: None
+	BeginWebAuthnLogin(context.Context, *connect.Request[v1.BeginWebAuthnLoginRequest]) (*connect.Response[v1.BeginWebAuthnLoginResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the bytebase.v1.AuthService service. By default, it
@@ -73,13 +79,20 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("Logout")),
 			connect.WithClientOptions(opts...),
 		),
+		beginWebAuthnLogin: connect.NewClient[v1.BeginWebAuthnLoginRequest, v1.BeginWebAuthnLoginResponse](
+			httpClient,
+			baseURL+AuthServiceBeginWebAuthnLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginWebAuthnLogin")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	login  *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	logout *connect.Client[v1.LogoutRequest, emptypb.Empty]
+	login              *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	logout             *connect.Client[v1.LogoutRequest, emptypb.Empty]
+	beginWebAuthnLogin *connect.Client[v1.BeginWebAuthnLoginRequest, v1.BeginWebAuthnLoginResponse]
 }
 
 // Login calls bytebase.v1.AuthService.Login.
@@ -92,6 +105,11 @@ func (c *authServiceClient) Logout(ctx context.Context, req *connect.Request[v1.
 	return c.logout.CallUnary(ctx, req)
 }
 
+// BeginWebAuthnLogin calls bytebase.v1.AuthService.BeginWebAuthnLogin.
+func (c *authServiceClient) BeginWebAuthnLogin(ctx context.Context, req *connect.Request[v1.BeginWebAuthnLoginRequest]) (*connect.Response[v1.BeginWebAuthnLoginResponse], error) {
+	return c.beginWebAuthnLogin.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the bytebase.v1.AuthService service.
 type AuthServiceHandler interface {
 	// Authenticates a user and returns access tokens.
@@ -100,6 +118,9 @@ type AuthServiceHandler interface {
 	// Logs out the current user session.
 	// Permissions required: None
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[emptypb.Empty], error)
+	// Starts the WebAuthn assertion ceremony for the second step of the MFA login.
+	// Permissions required: None
+	BeginWebAuthnLogin(context.Context, *connect.Request[v1.BeginWebAuthnLoginRequest]) (*connect.Response[v1.BeginWebAuthnLoginResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -121,12 +142,20 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("Logout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceBeginWebAuthnLoginHandler := connect.NewUnaryHandler(
+		AuthServiceBeginWebAuthnLoginProcedure,
+		svc.BeginWebAuthnLogin,
+		connect.WithSchema(authServiceMethods.ByName("BeginWebAuthnLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceLoginProcedure:
 			authServiceLoginHandler.ServeHTTP(w, r)
 		case AuthServiceLogoutProcedure:
 			authServiceLogoutHandler.ServeHTTP(w, r)
+		case AuthServiceBeginWebAuthnLoginProcedure:
+			authServiceBeginWebAuthnLoginHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -143,3 +172,7 @@ func (UnimplementedAuthServiceHandler) Login(context.Context, *connect.Request[v
 func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.AuthService.Logout is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) BeginWebAuthnLogin(context.Context, *connect.Request[v1.BeginWebAuthnLoginRequest]) (*connect.Response[v1.BeginWebAuthnLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.AuthService.BeginWebAuthnLogin is not implemented"))
+}
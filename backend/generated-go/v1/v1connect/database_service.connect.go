@@ -72,6 +72,12 @@ const (
 	// DatabaseServiceGetChangelogProcedure is the fully-qualified name of the DatabaseService's
 	// GetChangelog RPC.
 	DatabaseServiceGetChangelogProcedure = "/bytebase.v1.DatabaseService/GetChangelog"
+	// DatabaseServiceGetSchemaDriftProcedure is the fully-qualified name of the DatabaseService's
+	// GetSchemaDrift RPC.
+	DatabaseServiceGetSchemaDriftProcedure = "/bytebase.v1.DatabaseService/GetSchemaDrift"
+	// DatabaseServiceReconcileSchemaDriftProcedure is the fully-qualified name of the DatabaseService's
+	// ReconcileSchemaDrift RPC.
+	DatabaseServiceReconcileSchemaDriftProcedure = "/bytebase.v1.DatabaseService/ReconcileSchemaDrift"
 	// DatabaseServiceGetSchemaStringProcedure is the fully-qualified name of the DatabaseService's
 	// GetSchemaString RPC.
 	DatabaseServiceGetSchemaStringProcedure = "/bytebase.v1.DatabaseService/GetSchemaString"
@@ -118,6 +124,13 @@ type DatabaseServiceClient interface {
 	// Retrieves a specific changelog entry.
 	// Permissions required: bb.changelogs.get
 	GetChangelog(context.Context, *connect.Request[v1.GetChangelogRequest]) (*connect.Response[v1.Changelog], error)
+	// Retrieves the objects drifted from the schema recorded by the latest changelog.
+	// Permissions required: bb.databases.getSchema
+	GetSchemaDrift(context.Context, *connect.Request[v1.GetSchemaDriftRequest]) (*connect.Response[v1.SchemaDrift], error)
+	// Generates the statement reconciling the schema drift, and either creates a plan to revert the drift,
+	// or adopts the drifted schema as the new baseline.
+	// Permissions required: bb.databases.update, bb.plans.create
+	ReconcileSchemaDrift(context.Context, *connect.Request[v1.ReconcileSchemaDriftRequest]) (*connect.Response[v1.ReconcileSchemaDriftResponse], error)
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(context.Context, *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error)
@@ -212,6 +225,18 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("GetChangelog")),
 			connect.WithClientOptions(opts...),
 		),
+		getSchemaDrift: connect.NewClient[v1.GetSchemaDriftRequest, v1.SchemaDrift](
+			httpClient,
+			baseURL+DatabaseServiceGetSchemaDriftProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetSchemaDrift")),
+			connect.WithClientOptions(opts...),
+		),
+		reconcileSchemaDrift: connect.NewClient[v1.ReconcileSchemaDriftRequest, v1.ReconcileSchemaDriftResponse](
+			httpClient,
+			baseURL+DatabaseServiceReconcileSchemaDriftProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("ReconcileSchemaDrift")),
+			connect.WithClientOptions(opts...),
+		),
 		getSchemaString: connect.NewClient[v1.GetSchemaStringRequest, v1.GetSchemaStringResponse](
 			httpClient,
 			baseURL+DatabaseServiceGetSchemaStringProcedure,
@@ -236,6 +261,8 @@ type databaseServiceClient struct {
 	diffSchema           *connect.Client[v1.DiffSchemaRequest, v1.DiffSchemaResponse]
 	listChangelogs       *connect.Client[v1.ListChangelogsRequest, v1.ListChangelogsResponse]
 	getChangelog         *connect.Client[v1.GetChangelogRequest, v1.Changelog]
+	getSchemaDrift       *connect.Client[v1.GetSchemaDriftRequest, v1.SchemaDrift]
+	reconcileSchemaDrift *connect.Client[v1.ReconcileSchemaDriftRequest, v1.ReconcileSchemaDriftResponse]
 	getSchemaString      *connect.Client[v1.GetSchemaStringRequest, v1.GetSchemaStringResponse]
 }
 
@@ -304,6 +331,16 @@ func (c *databaseServiceClient) GetChangelog(ctx context.Context, req *connect.R
 	return c.getChangelog.CallUnary(ctx, req)
 }
 
+// GetSchemaDrift calls bytebase.v1.DatabaseService.GetSchemaDrift.
+func (c *databaseServiceClient) GetSchemaDrift(ctx context.Context, req *connect.Request[v1.GetSchemaDriftRequest]) (*connect.Response[v1.SchemaDrift], error) {
+	return c.getSchemaDrift.CallUnary(ctx, req)
+}
+
+// ReconcileSchemaDrift calls bytebase.v1.DatabaseService.ReconcileSchemaDrift.
+func (c *databaseServiceClient) ReconcileSchemaDrift(ctx context.Context, req *connect.Request[v1.ReconcileSchemaDriftRequest]) (*connect.Response[v1.ReconcileSchemaDriftResponse], error) {
+	return c.reconcileSchemaDrift.CallUnary(ctx, req)
+}
+
 // GetSchemaString calls bytebase.v1.DatabaseService.GetSchemaString.
 func (c *databaseServiceClient) GetSchemaString(ctx context.Context, req *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error) {
 	return c.getSchemaString.CallUnary(ctx, req)
@@ -350,6 +387,13 @@ type DatabaseServiceHandler interface {
 	// Retrieves a specific changelog entry.
 	// Permissions required: bb.changelogs.get
 	GetChangelog(context.Context, *connect.Request[v1.GetChangelogRequest]) (*connect.Response[v1.Changelog], error)
+	// Retrieves the objects drifted from the schema recorded by the latest changelog.
+	// Permissions required: bb.databases.getSchema
+	GetSchemaDrift(context.Context, *connect.Request[v1.GetSchemaDriftRequest]) (*connect.Response[v1.SchemaDrift], error)
+	// Generates the statement reconciling the schema drift, and either creates a plan to revert the drift,
+	// or adopts the drifted schema as the new baseline.
+	// Permissions required: bb.databases.update, bb.plans.create
+	ReconcileSchemaDrift(context.Context, *connect.Request[v1.ReconcileSchemaDriftRequest]) (*connect.Response[v1.ReconcileSchemaDriftResponse], error)
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(context.Context, *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error)
@@ -440,6 +484,18 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("GetChangelog")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceGetSchemaDriftHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetSchemaDriftProcedure,
+		svc.GetSchemaDrift,
+		connect.WithSchema(databaseServiceMethods.ByName("GetSchemaDrift")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseServiceReconcileSchemaDriftHandler := connect.NewUnaryHandler(
+		DatabaseServiceReconcileSchemaDriftProcedure,
+		svc.ReconcileSchemaDrift,
+		connect.WithSchema(databaseServiceMethods.ByName("ReconcileSchemaDrift")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceGetSchemaStringHandler := connect.NewUnaryHandler(
 		DatabaseServiceGetSchemaStringProcedure,
 		svc.GetSchemaString,
@@ -474,6 +530,10 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceListChangelogsHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetChangelogProcedure:
 			databaseServiceGetChangelogHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetSchemaDriftProcedure:
+			databaseServiceGetSchemaDriftHandler.ServeHTTP(w, r)
+		case DatabaseServiceReconcileSchemaDriftProcedure:
+			databaseServiceReconcileSchemaDriftHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetSchemaStringProcedure:
 			databaseServiceGetSchemaStringHandler.ServeHTTP(w, r)
 		default:
@@ -537,6 +597,14 @@ func (UnimplementedDatabaseServiceHandler) GetChangelog(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetChangelog is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) GetSchemaDrift(context.Context, *connect.Request[v1.GetSchemaDriftRequest]) (*connect.Response[v1.SchemaDrift], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetSchemaDrift is not implemented"))
+}
+
+func (UnimplementedDatabaseServiceHandler) ReconcileSchemaDrift(context.Context, *connect.Request[v1.ReconcileSchemaDriftRequest]) (*connect.Response[v1.ReconcileSchemaDriftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.ReconcileSchemaDrift is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) GetSchemaString(context.Context, *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetSchemaString is not implemented"))
 }
@@ -67,6 +67,8 @@ const (
 	// IssueServiceRequestIssueProcedure is the fully-qualified name of the IssueService's RequestIssue
 	// RPC.
 	IssueServiceRequestIssueProcedure = "/bytebase.v1.IssueService/RequestIssue"
+	// IssueServiceBreakGlassProcedure is the fully-qualified name of the IssueService's BreakGlass RPC.
+	IssueServiceBreakGlassProcedure = "/bytebase.v1.IssueService/BreakGlass"
 )
 
 // IssueServiceClient is a client for the bytebase.v1.IssueService service.
@@ -107,6 +109,10 @@ type IssueServiceClient interface {
 	// Requests changes on an issue. Access determined by approval flow configuration - caller must be a designated approver for the current approval step.
 	// Permissions required: None (determined by approval flow)
 	RequestIssue(context.Context, *connect.Request[v1.RequestIssueRequest]) (*connect.Response[v1.Issue], error)
+	// Grants the caller emergency SQL Editor access to databases without going through the approval flow.
+	// The access expires after the requested duration, and a grant request issue is created for the post-incident review.
+	// Permissions required: bb.issues.breakGlass
+	BreakGlass(context.Context, *connect.Request[v1.BreakGlassRequest]) (*connect.Response[v1.Issue], error)
 }
 
 // NewIssueServiceClient constructs a client for the bytebase.v1.IssueService service. By default,
@@ -192,6 +198,12 @@ func NewIssueServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(issueServiceMethods.ByName("RequestIssue")),
 			connect.WithClientOptions(opts...),
 		),
+		breakGlass: connect.NewClient[v1.BreakGlassRequest, v1.Issue](
+			httpClient,
+			baseURL+IssueServiceBreakGlassProcedure,
+			connect.WithSchema(issueServiceMethods.ByName("BreakGlass")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -209,6 +221,7 @@ type issueServiceClient struct {
 	approveIssue            *connect.Client[v1.ApproveIssueRequest, v1.Issue]
 	rejectIssue             *connect.Client[v1.RejectIssueRequest, v1.Issue]
 	requestIssue            *connect.Client[v1.RequestIssueRequest, v1.Issue]
+	breakGlass              *connect.Client[v1.BreakGlassRequest, v1.Issue]
 }
 
 // GetIssue calls bytebase.v1.IssueService.GetIssue.
@@ -271,6 +284,11 @@ func (c *issueServiceClient) RequestIssue(ctx context.Context, req *connect.Requ
 	return c.requestIssue.CallUnary(ctx, req)
 }
 
+// BreakGlass calls bytebase.v1.IssueService.BreakGlass.
+func (c *issueServiceClient) BreakGlass(ctx context.Context, req *connect.Request[v1.BreakGlassRequest]) (*connect.Response[v1.Issue], error) {
+	return c.breakGlass.CallUnary(ctx, req)
+}
+
 // IssueServiceHandler is an implementation of the bytebase.v1.IssueService service.
 type IssueServiceHandler interface {
 	// Retrieves an issue by name.
@@ -309,6 +327,10 @@ type IssueServiceHandler interface {
 	// Requests changes on an issue. Access determined by approval flow configuration - caller must be a designated approver for the current approval step.
 	// Permissions required: None (determined by approval flow)
 	RequestIssue(context.Context, *connect.Request[v1.RequestIssueRequest]) (*connect.Response[v1.Issue], error)
+	// Grants the caller emergency SQL Editor access to databases without going through the approval flow.
+	// The access expires after the requested duration, and a grant request issue is created for the post-incident review.
+	// Permissions required: bb.issues.breakGlass
+	BreakGlass(context.Context, *connect.Request[v1.BreakGlassRequest]) (*connect.Response[v1.Issue], error)
 }
 
 // NewIssueServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -390,6 +412,12 @@ func NewIssueServiceHandler(svc IssueServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(issueServiceMethods.ByName("RequestIssue")),
 		connect.WithHandlerOptions(opts...),
 	)
+	issueServiceBreakGlassHandler := connect.NewUnaryHandler(
+		IssueServiceBreakGlassProcedure,
+		svc.BreakGlass,
+		connect.WithSchema(issueServiceMethods.ByName("BreakGlass")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.IssueService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IssueServiceGetIssueProcedure:
@@ -416,6 +444,8 @@ func NewIssueServiceHandler(svc IssueServiceHandler, opts ...connect.HandlerOpti
 			issueServiceRejectIssueHandler.ServeHTTP(w, r)
 		case IssueServiceRequestIssueProcedure:
 			issueServiceRequestIssueHandler.ServeHTTP(w, r)
+		case IssueServiceBreakGlassProcedure:
+			issueServiceBreakGlassHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -472,3 +502,7 @@ func (UnimplementedIssueServiceHandler) RejectIssue(context.Context, *connect.Re
 func (UnimplementedIssueServiceHandler) RequestIssue(context.Context, *connect.Request[v1.RequestIssueRequest]) (*connect.Response[v1.Issue], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.IssueService.RequestIssue is not implemented"))
 }
+
+func (UnimplementedIssueServiceHandler) BreakGlass(context.Context, *connect.Request[v1.BreakGlassRequest]) (*connect.Response[v1.Issue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.IssueService.BreakGlass is not implemented"))
+}
@@ -76,6 +76,9 @@ const (
 	// ProjectServiceRemoveWebhookProcedure is the fully-qualified name of the ProjectService's
 	// RemoveWebhook RPC.
 	ProjectServiceRemoveWebhookProcedure = "/bytebase.v1.ProjectService/RemoveWebhook"
+	// ProjectServiceListWebhookDeliveriesProcedure is the fully-qualified name of the ProjectService's
+	// ListWebhookDeliveries RPC.
+	ProjectServiceListWebhookDeliveriesProcedure = "/bytebase.v1.ProjectService/ListWebhookDeliveries"
 	// ProjectServiceTestWebhookProcedure is the fully-qualified name of the ProjectService's
 	// TestWebhook RPC.
 	ProjectServiceTestWebhookProcedure = "/bytebase.v1.ProjectService/TestWebhook"
@@ -126,6 +129,9 @@ type ProjectServiceClient interface {
 	// Removes a webhook from a project.
 	// Permissions required: bb.projects.update
 	RemoveWebhook(context.Context, *connect.Request[v1.RemoveWebhookRequest]) (*connect.Response[v1.Project], error)
+	// Lists the deliveries of a GENERIC webhook, newest first.
+	// Permissions required: bb.projects.update
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
 	// Tests a webhook by sending a test notification.
 	// Permissions required: bb.projects.update
 	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
@@ -226,6 +232,12 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceMethods.ByName("RemoveWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+ProjectServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
 		testWebhook: connect.NewClient[v1.TestWebhookRequest, v1.TestWebhookResponse](
 			httpClient,
 			baseURL+ProjectServiceTestWebhookProcedure,
@@ -237,21 +249,22 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // projectServiceClient implements ProjectServiceClient.
 type projectServiceClient struct {
-	getProject          *connect.Client[v1.GetProjectRequest, v1.Project]
-	listProjects        *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
-	searchProjects      *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
-	createProject       *connect.Client[v1.CreateProjectRequest, v1.Project]
-	updateProject       *connect.Client[v1.UpdateProjectRequest, v1.Project]
-	deleteProject       *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
-	undeleteProject     *connect.Client[v1.UndeleteProjectRequest, v1.Project]
-	batchDeleteProjects *connect.Client[v1.BatchDeleteProjectsRequest, emptypb.Empty]
-	getIamPolicy        *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
-	batchGetIamPolicy   *connect.Client[v1.BatchGetIamPolicyRequest, v1.BatchGetIamPolicyResponse]
-	setIamPolicy        *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
-	addWebhook          *connect.Client[v1.AddWebhookRequest, v1.Project]
-	updateWebhook       *connect.Client[v1.UpdateWebhookRequest, v1.Project]
-	removeWebhook       *connect.Client[v1.RemoveWebhookRequest, v1.Project]
-	testWebhook         *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
+	getProject            *connect.Client[v1.GetProjectRequest, v1.Project]
+	listProjects          *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	searchProjects        *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
+	createProject         *connect.Client[v1.CreateProjectRequest, v1.Project]
+	updateProject         *connect.Client[v1.UpdateProjectRequest, v1.Project]
+	deleteProject         *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
+	undeleteProject       *connect.Client[v1.UndeleteProjectRequest, v1.Project]
+	batchDeleteProjects   *connect.Client[v1.BatchDeleteProjectsRequest, emptypb.Empty]
+	getIamPolicy          *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
+	batchGetIamPolicy     *connect.Client[v1.BatchGetIamPolicyRequest, v1.BatchGetIamPolicyResponse]
+	setIamPolicy          *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
+	addWebhook            *connect.Client[v1.AddWebhookRequest, v1.Project]
+	updateWebhook         *connect.Client[v1.UpdateWebhookRequest, v1.Project]
+	removeWebhook         *connect.Client[v1.RemoveWebhookRequest, v1.Project]
+	listWebhookDeliveries *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	testWebhook           *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
 }
 
 // GetProject calls bytebase.v1.ProjectService.GetProject.
@@ -324,6 +337,11 @@ func (c *projectServiceClient) RemoveWebhook(ctx context.Context, req *connect.R
 	return c.removeWebhook.CallUnary(ctx, req)
 }
 
+// ListWebhookDeliveries calls bytebase.v1.ProjectService.ListWebhookDeliveries.
+func (c *projectServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
 // TestWebhook calls bytebase.v1.ProjectService.TestWebhook.
 func (c *projectServiceClient) TestWebhook(ctx context.Context, req *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error) {
 	return c.testWebhook.CallUnary(ctx, req)
@@ -374,6 +392,9 @@ type ProjectServiceHandler interface {
 	// Removes a webhook from a project.
 	// Permissions required: bb.projects.update
 	RemoveWebhook(context.Context, *connect.Request[v1.RemoveWebhookRequest]) (*connect.Response[v1.Project], error)
+	// Lists the deliveries of a GENERIC webhook, newest first.
+	// Permissions required: bb.projects.update
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
 	// Tests a webhook by sending a test notification.
 	// Permissions required: bb.projects.update
 	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
@@ -470,6 +491,12 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceMethods.ByName("RemoveWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		ProjectServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(projectServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	projectServiceTestWebhookHandler := connect.NewUnaryHandler(
 		ProjectServiceTestWebhookProcedure,
 		svc.TestWebhook,
@@ -506,6 +533,8 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceUpdateWebhookHandler.ServeHTTP(w, r)
 		case ProjectServiceRemoveWebhookProcedure:
 			projectServiceRemoveWebhookHandler.ServeHTTP(w, r)
+		case ProjectServiceListWebhookDeliveriesProcedure:
+			projectServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
 		case ProjectServiceTestWebhookProcedure:
 			projectServiceTestWebhookHandler.ServeHTTP(w, r)
 		default:
@@ -573,6 +602,10 @@ func (UnimplementedProjectServiceHandler) RemoveWebhook(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.RemoveWebhook is not implemented"))
 }
 
+func (UnimplementedProjectServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.ListWebhookDeliveries is not implemented"))
+}
+
 func (UnimplementedProjectServiceHandler) TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.TestWebhook is not implemented"))
 }
@@ -42,10 +42,15 @@ const (
 	SQLServiceSearchQueryHistoriesProcedure = "/bytebase.v1.SQLService/SearchQueryHistories"
 	// SQLServiceExportProcedure is the fully-qualified name of the SQLService's Export RPC.
 	SQLServiceExportProcedure = "/bytebase.v1.SQLService/Export"
+	// SQLServiceExportStreamProcedure is the fully-qualified name of the SQLService's ExportStream RPC.
+	SQLServiceExportStreamProcedure = "/bytebase.v1.SQLService/ExportStream"
 	// SQLServiceDiffMetadataProcedure is the fully-qualified name of the SQLService's DiffMetadata RPC.
 	SQLServiceDiffMetadataProcedure = "/bytebase.v1.SQLService/DiffMetadata"
 	// SQLServiceAICompletionProcedure is the fully-qualified name of the SQLService's AICompletion RPC.
 	SQLServiceAICompletionProcedure = "/bytebase.v1.SQLService/AICompletion"
+	// SQLServiceAICompletionStreamProcedure is the fully-qualified name of the SQLService's
+	// AICompletionStream RPC.
+	SQLServiceAICompletionStreamProcedure = "/bytebase.v1.SQLService/AICompletionStream"
 )
 
 // SQLServiceClient is a client for the bytebase.v1.SQLService service.
@@ -62,12 +67,20 @@ type SQLServiceClient interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	// Downloads the data exported by a rollout or stage as a zip file, streaming the file
+	// in chunks so that large exports are not held in memory.
+	// Permissions required: bb.databases.get
+	ExportStream(context.Context, *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportResponse], error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error)
+	// Provides AI-powered SQL completion and generation, streaming the text as it
+	// is generated.
+	// Permissions required: None (authenticated users only, requires AI to be enabled)
+	AICompletionStream(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.ServerStreamForClient[v1.AICompletionStreamResponse], error)
 }
 
 // NewSQLServiceClient constructs a client for the bytebase.v1.SQLService service. By default, it
@@ -105,6 +118,12 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sQLServiceMethods.ByName("Export")),
 			connect.WithClientOptions(opts...),
 		),
+		exportStream: connect.NewClient[v1.ExportRequest, v1.ExportResponse](
+			httpClient,
+			baseURL+SQLServiceExportStreamProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("ExportStream")),
+			connect.WithClientOptions(opts...),
+		),
 		diffMetadata: connect.NewClient[v1.DiffMetadataRequest, v1.DiffMetadataResponse](
 			httpClient,
 			baseURL+SQLServiceDiffMetadataProcedure,
@@ -117,6 +136,12 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sQLServiceMethods.ByName("AICompletion")),
 			connect.WithClientOptions(opts...),
 		),
+		aICompletionStream: connect.NewClient[v1.AICompletionRequest, v1.AICompletionStreamResponse](
+			httpClient,
+			baseURL+SQLServiceAICompletionStreamProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("AICompletionStream")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -126,8 +151,10 @@ type sQLServiceClient struct {
 	adminExecute         *connect.Client[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
 	searchQueryHistories *connect.Client[v1.SearchQueryHistoriesRequest, v1.SearchQueryHistoriesResponse]
 	export               *connect.Client[v1.ExportRequest, v1.ExportResponse]
+	exportStream         *connect.Client[v1.ExportRequest, v1.ExportResponse]
 	diffMetadata         *connect.Client[v1.DiffMetadataRequest, v1.DiffMetadataResponse]
 	aICompletion         *connect.Client[v1.AICompletionRequest, v1.AICompletionResponse]
+	aICompletionStream   *connect.Client[v1.AICompletionRequest, v1.AICompletionStreamResponse]
 }
 
 // Query calls bytebase.v1.SQLService.Query.
@@ -150,6 +177,11 @@ func (c *sQLServiceClient) Export(ctx context.Context, req *connect.Request[v1.E
 	return c.export.CallUnary(ctx, req)
 }
 
+// ExportStream calls bytebase.v1.SQLService.ExportStream.
+func (c *sQLServiceClient) ExportStream(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportResponse], error) {
+	return c.exportStream.CallServerStream(ctx, req)
+}
+
 // DiffMetadata calls bytebase.v1.SQLService.DiffMetadata.
 func (c *sQLServiceClient) DiffMetadata(ctx context.Context, req *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error) {
 	return c.diffMetadata.CallUnary(ctx, req)
@@ -160,6 +192,11 @@ func (c *sQLServiceClient) AICompletion(ctx context.Context, req *connect.Reques
 	return c.aICompletion.CallUnary(ctx, req)
 }
 
+// AICompletionStream calls bytebase.v1.SQLService.AICompletionStream.
+func (c *sQLServiceClient) AICompletionStream(ctx context.Context, req *connect.Request[v1.AICompletionRequest]) (*connect.ServerStreamForClient[v1.AICompletionStreamResponse], error) {
+	return c.aICompletionStream.CallServerStream(ctx, req)
+}
+
 // SQLServiceHandler is an implementation of the bytebase.v1.SQLService service.
 type SQLServiceHandler interface {
 	// Executes a read-only SQL query against a database.
@@ -174,12 +211,20 @@ type SQLServiceHandler interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	// Downloads the data exported by a rollout or stage as a zip file, streaming the file
+	// in chunks so that large exports are not held in memory.
+	// Permissions required: bb.databases.get
+	ExportStream(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportResponse]) error
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error)
+	// Provides AI-powered SQL completion and generation, streaming the text as it
+	// is generated.
+	// Permissions required: None (authenticated users only, requires AI to be enabled)
+	AICompletionStream(context.Context, *connect.Request[v1.AICompletionRequest], *connect.ServerStream[v1.AICompletionStreamResponse]) error
 }
 
 // NewSQLServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -213,6 +258,12 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("Export")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceExportStreamHandler := connect.NewServerStreamHandler(
+		SQLServiceExportStreamProcedure,
+		svc.ExportStream,
+		connect.WithSchema(sQLServiceMethods.ByName("ExportStream")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceDiffMetadataHandler := connect.NewUnaryHandler(
 		SQLServiceDiffMetadataProcedure,
 		svc.DiffMetadata,
@@ -225,6 +276,12 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("AICompletion")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceAICompletionStreamHandler := connect.NewServerStreamHandler(
+		SQLServiceAICompletionStreamProcedure,
+		svc.AICompletionStream,
+		connect.WithSchema(sQLServiceMethods.ByName("AICompletionStream")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.SQLService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SQLServiceQueryProcedure:
@@ -235,10 +292,14 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 			sQLServiceSearchQueryHistoriesHandler.ServeHTTP(w, r)
 		case SQLServiceExportProcedure:
 			sQLServiceExportHandler.ServeHTTP(w, r)
+		case SQLServiceExportStreamProcedure:
+			sQLServiceExportStreamHandler.ServeHTTP(w, r)
 		case SQLServiceDiffMetadataProcedure:
 			sQLServiceDiffMetadataHandler.ServeHTTP(w, r)
 		case SQLServiceAICompletionProcedure:
 			sQLServiceAICompletionHandler.ServeHTTP(w, r)
+		case SQLServiceAICompletionStreamProcedure:
+			sQLServiceAICompletionStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -264,6 +325,10 @@ func (UnimplementedSQLServiceHandler) Export(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Export is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) ExportStream(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.ExportStream is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.DiffMetadata is not implemented"))
 }
@@ -271,3 +336,7 @@ func (UnimplementedSQLServiceHandler) DiffMetadata(context.Context, *connect.Req
 func (UnimplementedSQLServiceHandler) AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.AICompletion is not implemented"))
 }
+
+func (UnimplementedSQLServiceHandler) AICompletionStream(context.Context, *connect.Request[v1.AICompletionRequest], *connect.ServerStream[v1.AICompletionStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.AICompletionStream is not implemented"))
+}
@@ -53,6 +53,27 @@ const (
 	// UserServiceUndeleteUserProcedure is the fully-qualified name of the UserService's UndeleteUser
 	// RPC.
 	UserServiceUndeleteUserProcedure = "/bytebase.v1.UserService/UndeleteUser"
+	// UserServiceCreatePersonalAccessTokenProcedure is the fully-qualified name of the UserService's
+	// CreatePersonalAccessToken RPC.
+	UserServiceCreatePersonalAccessTokenProcedure = "/bytebase.v1.UserService/CreatePersonalAccessToken"
+	// UserServiceListPersonalAccessTokensProcedure is the fully-qualified name of the UserService's
+	// ListPersonalAccessTokens RPC.
+	UserServiceListPersonalAccessTokensProcedure = "/bytebase.v1.UserService/ListPersonalAccessTokens"
+	// UserServiceRevokePersonalAccessTokenProcedure is the fully-qualified name of the UserService's
+	// RevokePersonalAccessToken RPC.
+	UserServiceRevokePersonalAccessTokenProcedure = "/bytebase.v1.UserService/RevokePersonalAccessToken"
+	// UserServiceBeginWebAuthnRegistrationProcedure is the fully-qualified name of the UserService's
+	// BeginWebAuthnRegistration RPC.
+	UserServiceBeginWebAuthnRegistrationProcedure = "/bytebase.v1.UserService/BeginWebAuthnRegistration"
+	// UserServiceCreateWebAuthnCredentialProcedure is the fully-qualified name of the UserService's
+	// CreateWebAuthnCredential RPC.
+	UserServiceCreateWebAuthnCredentialProcedure = "/bytebase.v1.UserService/CreateWebAuthnCredential"
+	// UserServiceListWebAuthnCredentialsProcedure is the fully-qualified name of the UserService's
+	// ListWebAuthnCredentials RPC.
+	UserServiceListWebAuthnCredentialsProcedure = "/bytebase.v1.UserService/ListWebAuthnCredentials"
+	// UserServiceDeleteWebAuthnCredentialProcedure is the fully-qualified name of the UserService's
+	// DeleteWebAuthnCredential RPC.
+	UserServiceDeleteWebAuthnCredentialProcedure = "/bytebase.v1.UserService/DeleteWebAuthnCredential"
 )
 
 // UserServiceClient is a client for the bytebase.v1.UserService service.
@@ -84,6 +105,29 @@ type UserServiceClient interface {
 	// Restores a deleted user.
 	// Permissions required: bb.users.undelete
 	UndeleteUser(context.Context, *connect.Request[v1.UndeleteUserRequest]) (*connect.Response[v1.User], error)
+	// Creates a personal access token for the caller. The token is only returned in the response.
+	// Personal access tokens cannot create other personal access tokens.
+	// Permissions required: None (self only)
+	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.PersonalAccessToken], error)
+	// Lists the personal access tokens of a user.
+	// Permissions required: bb.users.update (or self)
+	ListPersonalAccessTokens(context.Context, *connect.Request[v1.ListPersonalAccessTokensRequest]) (*connect.Response[v1.ListPersonalAccessTokensResponse], error)
+	// Revokes a personal access token, the token cannot be used anymore.
+	// Permissions required: bb.users.update (or self)
+	RevokePersonalAccessToken(context.Context, *connect.Request[v1.RevokePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// Starts registering a WebAuthn credential (passkey or security key) for the caller as the second factor.
+	// Permissions required: None (self only)
+	BeginWebAuthnRegistration(context.Context, *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error)
+	// Finishes registering a WebAuthn credential with the response of the authenticator.
+	// Permissions required: None (self only)
+	CreateWebAuthnCredential(context.Context, *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error)
+	// Lists the WebAuthn credentials of a user.
+	// Permissions required: bb.users.update (or self)
+	ListWebAuthnCredentials(context.Context, *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error)
+	// Deletes a WebAuthn credential.
+	// The last second factor cannot be deleted if two-factor authentication is required by the workspace, except by workspace admins.
+	// Permissions required: bb.users.update (or self)
+	DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewUserServiceClient constructs a client for the bytebase.v1.UserService service. By default, it
@@ -145,19 +189,68 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("UndeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		createPersonalAccessToken: connect.NewClient[v1.CreatePersonalAccessTokenRequest, v1.PersonalAccessToken](
+			httpClient,
+			baseURL+UserServiceCreatePersonalAccessTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreatePersonalAccessToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listPersonalAccessTokens: connect.NewClient[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse](
+			httpClient,
+			baseURL+UserServiceListPersonalAccessTokensProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListPersonalAccessTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokePersonalAccessToken: connect.NewClient[v1.RevokePersonalAccessTokenRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceRevokePersonalAccessTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokePersonalAccessToken")),
+			connect.WithClientOptions(opts...),
+		),
+		beginWebAuthnRegistration: connect.NewClient[v1.BeginWebAuthnRegistrationRequest, v1.BeginWebAuthnRegistrationResponse](
+			httpClient,
+			baseURL+UserServiceBeginWebAuthnRegistrationProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BeginWebAuthnRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		createWebAuthnCredential: connect.NewClient[v1.CreateWebAuthnCredentialRequest, v1.WebAuthnCredential](
+			httpClient,
+			baseURL+UserServiceCreateWebAuthnCredentialProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateWebAuthnCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebAuthnCredentials: connect.NewClient[v1.ListWebAuthnCredentialsRequest, v1.ListWebAuthnCredentialsResponse](
+			httpClient,
+			baseURL+UserServiceListWebAuthnCredentialsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListWebAuthnCredentials")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebAuthnCredential: connect.NewClient[v1.DeleteWebAuthnCredentialRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteWebAuthnCredentialProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteWebAuthnCredential")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getUser        *connect.Client[v1.GetUserRequest, v1.User]
-	batchGetUsers  *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
-	getCurrentUser *connect.Client[emptypb.Empty, v1.User]
-	listUsers      *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	createUser     *connect.Client[v1.CreateUserRequest, v1.User]
-	updateUser     *connect.Client[v1.UpdateUserRequest, v1.User]
-	deleteUser     *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
-	undeleteUser   *connect.Client[v1.UndeleteUserRequest, v1.User]
+	getUser                   *connect.Client[v1.GetUserRequest, v1.User]
+	batchGetUsers             *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
+	getCurrentUser            *connect.Client[emptypb.Empty, v1.User]
+	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	createUser                *connect.Client[v1.CreateUserRequest, v1.User]
+	updateUser                *connect.Client[v1.UpdateUserRequest, v1.User]
+	deleteUser                *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
+	undeleteUser              *connect.Client[v1.UndeleteUserRequest, v1.User]
+	createPersonalAccessToken *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.PersonalAccessToken]
+	listPersonalAccessTokens  *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
+	revokePersonalAccessToken *connect.Client[v1.RevokePersonalAccessTokenRequest, emptypb.Empty]
+	beginWebAuthnRegistration *connect.Client[v1.BeginWebAuthnRegistrationRequest, v1.BeginWebAuthnRegistrationResponse]
+	createWebAuthnCredential  *connect.Client[v1.CreateWebAuthnCredentialRequest, v1.WebAuthnCredential]
+	listWebAuthnCredentials   *connect.Client[v1.ListWebAuthnCredentialsRequest, v1.ListWebAuthnCredentialsResponse]
+	deleteWebAuthnCredential  *connect.Client[v1.DeleteWebAuthnCredentialRequest, emptypb.Empty]
 }
 
 // GetUser calls bytebase.v1.UserService.GetUser.
@@ -200,6 +293,41 @@ func (c *userServiceClient) UndeleteUser(ctx context.Context, req *connect.Reque
 	return c.undeleteUser.CallUnary(ctx, req)
 }
 
+// CreatePersonalAccessToken calls bytebase.v1.UserService.CreatePersonalAccessToken.
+func (c *userServiceClient) CreatePersonalAccessToken(ctx context.Context, req *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.PersonalAccessToken], error) {
+	return c.createPersonalAccessToken.CallUnary(ctx, req)
+}
+
+// ListPersonalAccessTokens calls bytebase.v1.UserService.ListPersonalAccessTokens.
+func (c *userServiceClient) ListPersonalAccessTokens(ctx context.Context, req *connect.Request[v1.ListPersonalAccessTokensRequest]) (*connect.Response[v1.ListPersonalAccessTokensResponse], error) {
+	return c.listPersonalAccessTokens.CallUnary(ctx, req)
+}
+
+// RevokePersonalAccessToken calls bytebase.v1.UserService.RevokePersonalAccessToken.
+func (c *userServiceClient) RevokePersonalAccessToken(ctx context.Context, req *connect.Request[v1.RevokePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokePersonalAccessToken.CallUnary(ctx, req)
+}
+
+// BeginWebAuthnRegistration calls bytebase.v1.UserService.BeginWebAuthnRegistration.
+func (c *userServiceClient) BeginWebAuthnRegistration(ctx context.Context, req *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error) {
+	return c.beginWebAuthnRegistration.CallUnary(ctx, req)
+}
+
+// CreateWebAuthnCredential calls bytebase.v1.UserService.CreateWebAuthnCredential.
+func (c *userServiceClient) CreateWebAuthnCredential(ctx context.Context, req *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error) {
+	return c.createWebAuthnCredential.CallUnary(ctx, req)
+}
+
+// ListWebAuthnCredentials calls bytebase.v1.UserService.ListWebAuthnCredentials.
+func (c *userServiceClient) ListWebAuthnCredentials(ctx context.Context, req *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error) {
+	return c.listWebAuthnCredentials.CallUnary(ctx, req)
+}
+
+// DeleteWebAuthnCredential calls bytebase.v1.UserService.DeleteWebAuthnCredential.
+func (c *userServiceClient) DeleteWebAuthnCredential(ctx context.Context, req *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebAuthnCredential.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the bytebase.v1.UserService service.
 type UserServiceHandler interface {
 	// Get the user.
@@ -229,6 +357,29 @@ type UserServiceHandler interface {
 	// Restores a deleted user.
 	// Permissions required: bb.users.undelete
 	UndeleteUser(context.Context, *connect.Request[v1.UndeleteUserRequest]) (*connect.Response[v1.User], error)
+	// Creates a personal access token for the caller. The token is only returned in the response.
+	// Personal access tokens cannot create other personal access tokens.
+	// Permissions required: None (self only)
+	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.PersonalAccessToken], error)
+	// Lists the personal access tokens of a user.
+	// Permissions required: bb.users.update (or self)
+	ListPersonalAccessTokens(context.Context, *connect.Request[v1.ListPersonalAccessTokensRequest]) (*connect.Response[v1.ListPersonalAccessTokensResponse], error)
+	// Revokes a personal access token, the token cannot be used anymore.
+	// Permissions required: bb.users.update (or self)
+	RevokePersonalAccessToken(context.Context, *connect.Request[v1.RevokePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// Starts registering a WebAuthn credential (passkey or security key) for the caller as the second factor.
+	// Permissions required: None (self only)
+	BeginWebAuthnRegistration(context.Context, *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error)
+	// Finishes registering a WebAuthn credential with the response of the authenticator.
+	// Permissions required: None (self only)
+	CreateWebAuthnCredential(context.Context, *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error)
+	// Lists the WebAuthn credentials of a user.
+	// Permissions required: bb.users.update (or self)
+	ListWebAuthnCredentials(context.Context, *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error)
+	// Deletes a WebAuthn credential.
+	// The last second factor cannot be deleted if two-factor authentication is required by the workspace, except by workspace admins.
+	// Permissions required: bb.users.update (or self)
+	DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -286,6 +437,48 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("UndeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceCreatePersonalAccessTokenHandler := connect.NewUnaryHandler(
+		UserServiceCreatePersonalAccessTokenProcedure,
+		svc.CreatePersonalAccessToken,
+		connect.WithSchema(userServiceMethods.ByName("CreatePersonalAccessToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListPersonalAccessTokensHandler := connect.NewUnaryHandler(
+		UserServiceListPersonalAccessTokensProcedure,
+		svc.ListPersonalAccessTokens,
+		connect.WithSchema(userServiceMethods.ByName("ListPersonalAccessTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokePersonalAccessTokenHandler := connect.NewUnaryHandler(
+		UserServiceRevokePersonalAccessTokenProcedure,
+		svc.RevokePersonalAccessToken,
+		connect.WithSchema(userServiceMethods.ByName("RevokePersonalAccessToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBeginWebAuthnRegistrationHandler := connect.NewUnaryHandler(
+		UserServiceBeginWebAuthnRegistrationProcedure,
+		svc.BeginWebAuthnRegistration,
+		connect.WithSchema(userServiceMethods.ByName("BeginWebAuthnRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateWebAuthnCredentialHandler := connect.NewUnaryHandler(
+		UserServiceCreateWebAuthnCredentialProcedure,
+		svc.CreateWebAuthnCredential,
+		connect.WithSchema(userServiceMethods.ByName("CreateWebAuthnCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListWebAuthnCredentialsHandler := connect.NewUnaryHandler(
+		UserServiceListWebAuthnCredentialsProcedure,
+		svc.ListWebAuthnCredentials,
+		connect.WithSchema(userServiceMethods.ByName("ListWebAuthnCredentials")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteWebAuthnCredentialHandler := connect.NewUnaryHandler(
+		UserServiceDeleteWebAuthnCredentialProcedure,
+		svc.DeleteWebAuthnCredential,
+		connect.WithSchema(userServiceMethods.ByName("DeleteWebAuthnCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetUserProcedure:
@@ -304,6 +497,20 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
 		case UserServiceUndeleteUserProcedure:
 			userServiceUndeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceCreatePersonalAccessTokenProcedure:
+			userServiceCreatePersonalAccessTokenHandler.ServeHTTP(w, r)
+		case UserServiceListPersonalAccessTokensProcedure:
+			userServiceListPersonalAccessTokensHandler.ServeHTTP(w, r)
+		case UserServiceRevokePersonalAccessTokenProcedure:
+			userServiceRevokePersonalAccessTokenHandler.ServeHTTP(w, r)
+		case UserServiceBeginWebAuthnRegistrationProcedure:
+			userServiceBeginWebAuthnRegistrationHandler.ServeHTTP(w, r)
+		case UserServiceCreateWebAuthnCredentialProcedure:
+			userServiceCreateWebAuthnCredentialHandler.ServeHTTP(w, r)
+		case UserServiceListWebAuthnCredentialsProcedure:
+			userServiceListWebAuthnCredentialsHandler.ServeHTTP(w, r)
+		case UserServiceDeleteWebAuthnCredentialProcedure:
+			userServiceDeleteWebAuthnCredentialHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -344,3 +551,31 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) UndeleteUser(context.Context, *connect.Request[v1.UndeleteUserRequest]) (*connect.Response[v1.User], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.UndeleteUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.PersonalAccessToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.CreatePersonalAccessToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListPersonalAccessTokens(context.Context, *connect.Request[v1.ListPersonalAccessTokensRequest]) (*connect.Response[v1.ListPersonalAccessTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.ListPersonalAccessTokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokePersonalAccessToken(context.Context, *connect.Request[v1.RevokePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.RevokePersonalAccessToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BeginWebAuthnRegistration(context.Context, *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.BeginWebAuthnRegistration is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateWebAuthnCredential(context.Context, *connect.Request[v1.CreateWebAuthnCredentialRequest]) (*connect.Response[v1.WebAuthnCredential], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.CreateWebAuthnCredential is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListWebAuthnCredentials(context.Context, *connect.Request[v1.ListWebAuthnCredentialsRequest]) (*connect.Response[v1.ListWebAuthnCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.ListWebAuthnCredentials is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.UserService.DeleteWebAuthnCredential is not implemented"))
+}
@@ -0,0 +1,17 @@
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    webhook_id integer NOT NULL REFERENCES project_webhook(id) ON DELETE CASCADE,
+    status text NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    -- Stored as WebhookDeliveryPayload (proto/store/store/project_webhook.proto)
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id, id);
+
+CREATE INDEX idx_webhook_delivery_pending ON webhook_delivery(next_attempt_at) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
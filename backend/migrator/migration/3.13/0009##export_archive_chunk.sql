@@ -0,0 +1,8 @@
+-- Large export archives are stored in chunks so that they never need to be held in memory as a whole.
+-- An archive has either export_archive.bytes or chunks.
+CREATE TABLE export_archive_chunk (
+    archive_id integer NOT NULL REFERENCES export_archive(id) ON DELETE CASCADE,
+    seq integer NOT NULL,
+    bytes bytea NOT NULL,
+    PRIMARY KEY (archive_id, seq)
+);
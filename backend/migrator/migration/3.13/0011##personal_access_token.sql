@@ -0,0 +1,18 @@
+CREATE TABLE personal_access_token (
+  id serial PRIMARY KEY,
+  principal_id integer NOT NULL REFERENCES principal(id),
+  title text NOT NULL,
+  -- The SHA-256 hex digest of the token, the token itself is not stored.
+  token_hash text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  expires_at timestamptz,
+  last_used_at timestamptz,
+  -- Stored as PersonalAccessTokenPayload (proto/store/store/user.proto)
+  payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_personal_access_token_unique_token_hash ON personal_access_token(token_hash);
+
+CREATE INDEX idx_personal_access_token_principal_id ON personal_access_token(principal_id);
+
+ALTER SEQUENCE personal_access_token_id_seq RESTART WITH 101;
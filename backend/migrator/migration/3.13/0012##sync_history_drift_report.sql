@@ -0,0 +1,3 @@
+-- Stored as SchemaDriftReport (proto/store/store/sync_history.proto).
+-- It is set on the sync histories recording the drifted schemas.
+ALTER TABLE sync_history ADD COLUMN drift_report jsonb;
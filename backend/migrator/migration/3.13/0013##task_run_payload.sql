@@ -0,0 +1,3 @@
+-- Stored as TaskRunPayload (proto/store/store/task_run.proto).
+-- It persists the waiting state of the pending task runs across server restarts.
+ALTER TABLE task_run ADD COLUMN payload jsonb NOT NULL DEFAULT '{}';
@@ -4,7 +4,7 @@ CREATE TABLE idp (
   resource_id text NOT NULL,
   name text NOT NULL,
   domain text NOT NULL,
-  type text NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP')),
+  type text NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP', 'SAML')),
   -- config stores the corresponding configuration of the IdP, which may vary depending on the type of the IdP.
   -- Stored as IdentityProviderConfig (proto/store/store/idp.proto)
   config jsonb NOT NULL DEFAULT '{}'
@@ -30,6 +30,25 @@ CREATE TABLE principal (
     profile jsonb NOT NULL DEFAULT '{}'
 );
 
+CREATE TABLE personal_access_token (
+  id serial PRIMARY KEY,
+  principal_id integer NOT NULL REFERENCES principal(id),
+  title text NOT NULL,
+  -- The SHA-256 hex digest of the token, the token itself is not stored.
+  token_hash text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  expires_at timestamptz,
+  last_used_at timestamptz,
+  -- Stored as PersonalAccessTokenPayload (proto/store/store/user.proto)
+  payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_personal_access_token_unique_token_hash ON personal_access_token(token_hash);
+
+CREATE INDEX idx_personal_access_token_principal_id ON personal_access_token(principal_id);
+
+ALTER SEQUENCE personal_access_token_id_seq RESTART WITH 101;
+
 -- Setting
 CREATE TABLE setting (
     id serial PRIMARY KEY,
@@ -72,7 +91,7 @@ CREATE TABLE policy (
     resource_type text NOT NULL,
     -- resource: resource name in format like "environments/{environment}", "projects/{project}", etc.
     resource TEXT NOT NULL,
-    -- type: ROLLOUT, MASKING_EXCEPTION, QUERY_DATA, MASKING_RULE, IAM, TAG, DATA_SOURCE_QUERY
+    -- type: ROLLOUT, MASKING_EXCEPTION, QUERY_DATA, MASKING_RULE, IAM, TAG, DATA_SOURCE_QUERY, ROW_ACCESS
     -- Enum: Policy.Type (proto/store/store/policy.proto)
     type text NOT NULL,
     -- Stored as different types based on policy type (proto/store/store/policy.proto):
@@ -83,6 +102,7 @@ CREATE TABLE policy (
     -- IAM: IamPolicy
     -- TAG: TagPolicy
     -- DATA_SOURCE_QUERY: DataSourceQueryPolicy
+    -- ROW_ACCESS: RowAccessPolicy
     payload jsonb NOT NULL DEFAULT '{}',
     inherit_from_parent boolean NOT NULL DEFAULT TRUE
 );
@@ -116,6 +136,24 @@ CREATE INDEX idx_project_webhook_project ON project_webhook(project);
 
 ALTER SEQUENCE project_webhook_id_seq RESTART WITH 101;
 
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    webhook_id integer NOT NULL REFERENCES project_webhook(id) ON DELETE CASCADE,
+    status text NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    -- Stored as WebhookDeliveryPayload (proto/store/store/project_webhook.proto)
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id, id);
+
+CREATE INDEX idx_webhook_delivery_pending ON webhook_delivery(next_attempt_at) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
 -- Instance
 CREATE TABLE instance (
     id serial PRIMARY KEY,
@@ -230,7 +268,9 @@ CREATE TABLE task_run (
     code integer NOT NULL DEFAULT 0,
     -- result saves the task run result in json format
     -- Stored as TaskRunResult (proto/store/store/task_run.proto)
-    result jsonb NOT NULL DEFAULT '{}'
+    result jsonb NOT NULL DEFAULT '{}',
+    -- Stored as TaskRunPayload (proto/store/store/task_run.proto)
+    payload jsonb NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_task_run_task_id ON task_run(task_id);
@@ -449,6 +489,14 @@ CREATE TABLE export_archive (
   payload jsonb NOT NULL DEFAULT '{}'
 );
 
+-- Large export archives are stored in chunks, an archive has either export_archive.bytes or chunks.
+CREATE TABLE export_archive_chunk (
+  archive_id integer NOT NULL REFERENCES export_archive(id) ON DELETE CASCADE,
+  seq integer NOT NULL,
+  bytes bytea NOT NULL,
+  PRIMARY KEY (archive_id, seq)
+);
+
 CREATE TABLE user_group (
   id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
   email text,
@@ -496,6 +544,9 @@ CREATE TABLE sync_history (
     -- Stored as DatabaseSchemaMetadata (proto/store/store/database.proto)
     metadata json NOT NULL DEFAULT '{}',
     raw_dump text NOT NULL DEFAULT '',
+    -- Stored as SchemaDriftReport (proto/store/store/sync_history.proto).
+    -- It is set on the sync histories recording the drifted schemas.
+    drift_report jsonb,
     CONSTRAINT sync_history_instance_db_name_fkey FOREIGN KEY(instance, db_name) REFERENCES db(instance, name)
 );
 
@@ -12,7 +12,7 @@ import (
 func TestLatestVersion(t *testing.T) {
 	files, err := getSortedVersionedFiles()
 	require.NoError(t, err)
-	require.Equal(t, semver.MustParse("3.13.7"), *files[len(files)-1].version)
+	require.Equal(t, semver.MustParse("3.13.13"), *files[len(files)-1].version)
 }
 
 func TestVersionUnique(t *testing.T) {
@@ -81,6 +81,7 @@ const (
 	SDLViewDependencyNotFound                 Code = 254
 	SDLDropOperation                          Code = 255
 	SDLReplaceOperation                       Code = 256
+	StatementNotFormatted                     Code = 257
 
 	// 301 ～ 399 naming error code
 	// 301 table naming advisor error code.
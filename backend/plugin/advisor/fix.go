@@ -0,0 +1,113 @@
+package advisor
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// NewSuggestedFix returns the suggested fix consisting of the edits.
+func NewSuggestedFix(title string, edits ...*storepb.TextEdit) *storepb.SuggestedFix {
+	return &storepb.SuggestedFix{
+		Title: title,
+		Edits: edits,
+	}
+}
+
+// NewReplaceTokensEdit returns the text edit replacing the text from the start token to the stop token (inclusive) with the new text.
+// The statement of the tokens starts at the zero-based baseLine and baseColumn of the statements.
+func NewReplaceTokensEdit(baseLine, baseColumn int, start, stop antlr.Token, newText string) *storepb.TextEdit {
+	endLine, endColumn := getTokenEnd(stop)
+	return &storepb.TextEdit{
+		StartPosition: convertStatementPosition(baseLine, baseColumn, start.GetLine(), start.GetColumn()),
+		EndPosition:   convertStatementPosition(baseLine, baseColumn, endLine, endColumn),
+		NewText:       newText,
+	}
+}
+
+// NewInsertAfterTokenEdit returns the text edit inserting the new text right after the token.
+// The statement of the token starts at the zero-based baseLine and baseColumn of the statements.
+func NewInsertAfterTokenEdit(baseLine, baseColumn int, token antlr.Token, newText string) *storepb.TextEdit {
+	line, column := getTokenEnd(token)
+	position := convertStatementPosition(baseLine, baseColumn, line, column)
+	return &storepb.TextEdit{
+		StartPosition: position,
+		EndPosition:   &storepb.Position{Line: position.Line, Column: position.Column},
+		NewText:       newText,
+	}
+}
+
+// NewInsertBeforeTokenEdit returns the text edit inserting the new text right before the token.
+// The statement of the token starts at the zero-based baseLine and baseColumn of the statements.
+func NewInsertBeforeTokenEdit(baseLine, baseColumn int, token antlr.Token, newText string) *storepb.TextEdit {
+	position := convertStatementPosition(baseLine, baseColumn, token.GetLine(), token.GetColumn())
+	return &storepb.TextEdit{
+		StartPosition: position,
+		EndPosition:   &storepb.Position{Line: position.Line, Column: position.Column},
+		NewText:       newText,
+	}
+}
+
+// RenderNamingTemplate renders the naming format such as `^$|^idx_{{table}}_{{column_list}}$` to the expected name.
+// It returns false if no alternative of the format is a plain name after the template tokens are replaced, such as `^idx_[a-z]+$`.
+func RenderNamingTemplate(format string, templateList []string, tokens map[string]string) (string, bool) {
+	for _, alternative := range strings.Split(format, "|") {
+		if name, ok := renderNamingAlternative(alternative, templateList, tokens); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func renderNamingAlternative(format string, templateList []string, tokens map[string]string) (string, bool) {
+	name := strings.TrimSuffix(strings.TrimPrefix(format, "^"), "$")
+	for _, key := range templateList {
+		if !strings.Contains(name, key) {
+			continue
+		}
+		token, ok := tokens[key]
+		if !ok {
+			return "", false
+		}
+		// Separate the tokens to tell the regular expression characters in the format from the ones in the tokens.
+		name = strings.ReplaceAll(name, key, "\x00"+token+"\x00")
+	}
+	parts := strings.Split(name, "\x00")
+	for i := 0; i < len(parts); i += 2 {
+		if regexp.QuoteMeta(parts[i]) != parts[i] {
+			return "", false
+		}
+	}
+	name = strings.Join(parts, "")
+	if name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+// getTokenEnd returns the one-based line and zero-based column right after the token.
+func getTokenEnd(token antlr.Token) (int, int) {
+	line, column := token.GetLine(), token.GetColumn()
+	for _, r := range token.GetText() {
+		if r == '\n' {
+			line++
+			column = 0
+			continue
+		}
+		column++
+	}
+	return line, column
+}
+
+// convertStatementPosition converts the ANTLR position in the statement to the position in the statements.
+// The lines after the first line of the statement only need the base line offset, while the first line
+// of the statement may start in the middle of the line, such as the second statement in `SELECT 1; SELECT 2;`.
+func convertStatementPosition(baseLine, baseColumn, line, column int) *storepb.Position {
+	if line > 1 {
+		return &storepb.Position{Line: int32(baseLine + line), Column: int32(column + 1)}
+	}
+	return &storepb.Position{Line: int32(baseLine + line), Column: int32(baseColumn + column + 1)}
+}
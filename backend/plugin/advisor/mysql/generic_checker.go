@@ -155,6 +155,7 @@ type BaseRule struct {
 	title      string
 	adviceList []*storepb.Advice
 	baseLine   int
+	baseColumn int
 }
 
 // SetBaseLine sets the base line for the rule.
@@ -162,6 +163,11 @@ func (r *BaseRule) SetBaseLine(baseLine int) {
 	r.baseLine = baseLine
 }
 
+// SetBaseColumn sets the zero-based column where the current statement starts on its base line.
+func (r *BaseRule) SetBaseColumn(baseColumn int) {
+	r.baseColumn = baseColumn
+}
+
 // GetAdviceList returns the accumulated advice.
 func (r *BaseRule) GetAdviceList() []*storepb.Advice {
 	return r.adviceList
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/bytebase/parser/mysql"
@@ -12,6 +13,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	"github.com/bytebase/bytebase/backend/store/model"
 )
 
 var (
@@ -43,12 +45,15 @@ func (*InsertMustSpecifyColumnAdvisor) Check(_ context.Context, checkCtx advisor
 
 	// Create the rule
 	rule := NewInsertMustSpecifyColumnRule(level, string(checkCtx.Rule.Type))
+	rule.currentDatabase = checkCtx.CurrentDatabase
+	rule.originalMetadata = checkCtx.OriginalMetadata
 
 	// Create the generic checker with the rule
 	checker := NewGenericChecker([]Rule{rule})
 
 	for _, stmt := range stmtList {
 		rule.SetBaseLine(stmt.BaseLine)
+		rule.SetBaseColumn(stmt.BaseColumn)
 		checker.SetBaseLine(stmt.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, stmt.Tree)
 	}
@@ -61,6 +66,9 @@ type InsertMustSpecifyColumnRule struct {
 	BaseRule
 	hasSelect bool
 	text      string
+
+	currentDatabase  string
+	originalMetadata *model.DatabaseMetadata
 }
 
 // NewInsertMustSpecifyColumnRule creates a new InsertMustSpecifyColumnRule.
@@ -124,9 +132,50 @@ func (r *InsertMustSpecifyColumnRule) checkInsertStatement(ctx *mysql.InsertStat
 		Title:         r.title,
 		Content:       fmt.Sprintf("The INSERT statement must specify columns but \"%s\" does not", r.text),
 		StartPosition: common.ConvertANTLRLineToPosition(r.baseLine + ctx.GetStart().GetLine()),
+		SuggestedFix:  r.newSpecifyColumnFix(ctx),
 	})
 }
 
+// newSpecifyColumnFix returns the fix inserting all columns of the table in order before the VALUES clause.
+// It only applies to the existing tables, and the rows with values for all columns.
+func (r *InsertMustSpecifyColumnRule) newSpecifyColumnFix(ctx *mysql.InsertStatementContext) *storepb.SuggestedFix {
+	constructor := ctx.InsertFromConstructor()
+	if ctx.TableRef() == nil || constructor.OPEN_PAR_SYMBOL() != nil || constructor.InsertValues() == nil || constructor.InsertValues().ValueList() == nil {
+		return nil
+	}
+	databaseName, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	if databaseName != "" && !strings.EqualFold(databaseName, r.currentDatabase) {
+		return nil
+	}
+	table := r.originalMetadata.GetSchemaMetadata("").GetTable(tableName)
+	if table == nil {
+		return nil
+	}
+	var columns []string
+	for _, column := range table.GetProto().GetColumns() {
+		columns = append(columns, fmt.Sprintf("`%s`", strings.ReplaceAll(column.Name, "`", "``")))
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	valueList := constructor.InsertValues().ValueList()
+	rows := valueList.AllValues()
+	if len(rows) != len(valueList.AllOPEN_PAR_SYMBOL()) {
+		// Some rows are empty, such as `VALUES ()`.
+		return nil
+	}
+	for _, row := range rows {
+		if len(row.AllCOMMA_SYMBOL())+1 != len(columns) {
+			return nil
+		}
+	}
+	return advisor.NewSuggestedFix(
+		"Specify the columns",
+		advisor.NewInsertBeforeTokenEdit(r.baseLine, r.baseColumn, constructor.GetStart(), fmt.Sprintf("(%s) ", strings.Join(columns, ", "))),
+	)
+}
+
 func (r *InsertMustSpecifyColumnRule) checkSelectItemList(ctx *mysql.SelectItemListContext) {
 	if r.hasSelect && ctx.MULT_OPERATOR() != nil {
 		r.AddAdvice(&storepb.Advice{
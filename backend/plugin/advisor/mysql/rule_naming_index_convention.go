@@ -32,6 +32,8 @@ type indexMetaData struct {
 	tableName string
 	metaData  map[string]string
 	line      int
+	// nameCtx is the index name in the statement, it's nil if the index is unnamed.
+	nameCtx antlr.ParserRuleContext
 }
 
 // NamingIndexConventionAdvisor is the advisor checking for index naming convention.
@@ -64,6 +66,7 @@ func (*NamingIndexConventionAdvisor) Check(_ context.Context, checkCtx advisor.C
 
 	for _, stmtNode := range root {
 		rule.SetBaseLine(stmtNode.BaseLine)
+		rule.SetBaseColumn(stmtNode.BaseColumn)
 		checker.SetBaseLine(stmtNode.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, stmtNode.Tree)
 	}
@@ -204,6 +207,7 @@ func (r *NamingIndexConventionRule) checkAlterTable(ctx *mysql.AlterTableContext
 				tableName: tableName,
 				metaData:  metaData,
 				line:      r.baseLine + ctx.GetStart().GetLine(),
+				nameCtx:   alterListItem.IndexName(),
 			}
 			indexDataList = append(indexDataList, indexData)
 		default:
@@ -225,11 +229,14 @@ func (r *NamingIndexConventionRule) checkCreateIndex(ctx *mysql.CreateIndexConte
 	}
 
 	indexName := ""
+	var nameCtx antlr.ParserRuleContext
 	if ctx.IndexName() != nil {
 		indexName = mysqlparser.NormalizeIndexName(ctx.IndexName())
+		nameCtx = ctx.IndexName()
 	}
 	if ctx.IndexNameAndType() != nil && ctx.IndexNameAndType().IndexName() != nil {
 		indexName = mysqlparser.NormalizeIndexName(ctx.IndexNameAndType().IndexName())
+		nameCtx = ctx.IndexNameAndType().IndexName()
 	}
 
 	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.CreateIndexTarget().TableRef())
@@ -248,6 +255,7 @@ func (r *NamingIndexConventionRule) checkCreateIndex(ctx *mysql.CreateIndexConte
 			tableName: tableName,
 			metaData:  metaData,
 			line:      r.baseLine + ctx.GetStart().GetLine(),
+			nameCtx:   nameCtx,
 		},
 	}
 	r.handleIndexList(indexDataList)
@@ -272,6 +280,7 @@ func (r *NamingIndexConventionRule) handleIndexList(indexDataList []*indexMetaDa
 				Title:         r.title,
 				Content:       fmt.Sprintf("Index in table `%s` mismatches the naming convention, expect %q but found `%s`", indexData.tableName, regex, indexData.indexName),
 				StartPosition: common.ConvertANTLRLineToPosition(indexData.line),
+				SuggestedFix:  newRenameFix(r.baseLine, r.baseColumn, indexData.nameCtx, r.format, r.templateList, indexData.metaData, r.maxLength),
 			})
 		}
 		if r.maxLength > 0 && len(indexData.indexName) > r.maxLength {
@@ -296,8 +305,10 @@ func (r *NamingIndexConventionRule) handleConstraintDef(tableName string, ctx my
 	}
 
 	indexName := ""
+	var nameCtx antlr.ParserRuleContext
 	if ctx.IndexNameAndType() != nil && ctx.IndexNameAndType().IndexName() != nil {
 		indexName = mysqlparser.NormalizeIndexName(ctx.IndexNameAndType().IndexName())
+		nameCtx = ctx.IndexNameAndType().IndexName()
 	}
 
 	columnList := mysqlparser.NormalizeKeyListVariants(ctx.KeyListVariants())
@@ -310,5 +321,6 @@ func (r *NamingIndexConventionRule) handleConstraintDef(tableName string, ctx my
 		tableName: tableName,
 		metaData:  metaData,
 		line:      r.baseLine + ctx.GetStart().GetLine(),
+		nameCtx:   nameCtx,
 	}
 }
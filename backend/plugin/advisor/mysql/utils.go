@@ -2,13 +2,16 @@
 package mysql
 
 import (
+	"fmt"
 	"regexp"
 	"slices"
 	"strings"
 
+	"github.com/antlr4-go/antlr/v4"
 	"github.com/bytebase/parser/mysql"
 	"github.com/pkg/errors"
 
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 )
@@ -110,11 +113,37 @@ func getANTLRTree(checkCtx advisor.Context) ([]*base.ParseResult, error) {
 
 		// Reconstruct base.ParseResult from AST
 		parseResults = append(parseResults, &base.ParseResult{
-			Tree:     antlrAST.Tree,
-			Tokens:   antlrAST.Tokens,
-			BaseLine: base.GetLineOffset(antlrAST.StartPosition),
+			Tree:       antlrAST.Tree,
+			Tokens:     antlrAST.Tokens,
+			BaseLine:   base.GetLineOffset(antlrAST.StartPosition),
+			BaseColumn: base.GetColumnOffset(antlrAST.StartPosition),
 		})
 	}
 
 	return parseResults, nil
 }
+
+var plainIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// newRenameFix returns the fix renaming the name to the one rendered from the naming format.
+// It returns nil if the format cannot be rendered to a plain name.
+func newRenameFix(baseLine, baseColumn int, name antlr.ParserRuleContext, format string, templateList []string, tokens map[string]string, maxLength int) *storepb.SuggestedFix {
+	if name == nil {
+		return nil
+	}
+	expected, ok := advisor.RenderNamingTemplate(format, templateList, tokens)
+	if !ok || (maxLength > 0 && len(expected) > maxLength) {
+		return nil
+	}
+	if regex, err := getTemplateRegexp(format, templateList, tokens); err != nil || !regex.MatchString(expected) {
+		return nil
+	}
+	newText := expected
+	if !plainIdentifierRegexp.MatchString(expected) || isKeyword(expected) {
+		newText = fmt.Sprintf("`%s`", strings.ReplaceAll(expected, "`", "``"))
+	}
+	return advisor.NewSuggestedFix(
+		fmt.Sprintf("Rename to %q", expected),
+		advisor.NewReplaceTokensEdit(baseLine, baseColumn, name.GetStart(), name.GetStop(), newText),
+	)
+}
@@ -49,6 +49,7 @@ func (*IndexConcurrentlyAdvisor) Check(_ context.Context, checkCtx advisor.Conte
 
 	for _, parseResult := range parseResults {
 		rule.SetBaseLine(parseResult.BaseLine)
+		rule.SetBaseColumn(parseResult.BaseColumn)
 		checker.SetBaseLine(parseResult.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, parseResult.Tree)
 	}
@@ -134,6 +135,7 @@ func (r *indexCreateConcurrentlyRule) handleIndexstmt(ctx *parser.IndexstmtConte
 				Line:   int32(ctx.GetStart().GetLine()),
 				Column: 0,
 			},
+			SuggestedFix: r.newAddConcurrentlyFix(ctx.INDEX()),
 		})
 	}
 }
@@ -157,7 +159,16 @@ func (r *indexCreateConcurrentlyRule) handleDropstmt(ctx *parser.DropstmtContext
 					Line:   int32(ctx.GetStart().GetLine()),
 					Column: 0,
 				},
+				SuggestedFix: r.newAddConcurrentlyFix(ctx.INDEX()),
 			})
 		}
 	}
 }
+
+// newAddConcurrentlyFix returns the fix inserting CONCURRENTLY after the INDEX keyword.
+func (r *indexCreateConcurrentlyRule) newAddConcurrentlyFix(index antlr.TerminalNode) *storepb.SuggestedFix {
+	if index == nil {
+		return nil
+	}
+	return advisor.NewSuggestedFix("Add CONCURRENTLY", advisor.NewInsertAfterTokenEdit(r.baseLine, r.baseColumn, index.GetSymbol(), " CONCURRENTLY"))
+}
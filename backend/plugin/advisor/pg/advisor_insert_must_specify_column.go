@@ -3,6 +3,7 @@ package pg
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
 
@@ -11,6 +12,7 @@ import (
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	"github.com/bytebase/bytebase/backend/store/model"
 )
 
 var (
@@ -42,13 +44,15 @@ func (*InsertMustSpecifyColumnAdvisor) Check(_ context.Context, checkCtx advisor
 			level: level,
 			title: string(checkCtx.Rule.Type),
 		},
-		statementsText: checkCtx.Statements,
+		statementsText:   checkCtx.Statements,
+		originalMetadata: checkCtx.OriginalMetadata,
 	}
 
 	checker := NewGenericChecker([]Rule{rule})
 
 	for _, parseResult := range parseResults {
 		rule.SetBaseLine(parseResult.BaseLine)
+		rule.SetBaseColumn(parseResult.BaseColumn)
 		checker.SetBaseLine(parseResult.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, parseResult.Tree)
 	}
@@ -59,7 +63,8 @@ func (*InsertMustSpecifyColumnAdvisor) Check(_ context.Context, checkCtx advisor
 type insertMustSpecifyColumnRule struct {
 	BaseRule
 
-	statementsText string
+	statementsText   string
+	originalMetadata *model.DatabaseMetadata
 }
 
 func (*insertMustSpecifyColumnRule) Name() string {
@@ -114,6 +119,61 @@ func (r *insertMustSpecifyColumnRule) handleInsertstmt(ctx antlr.ParserRuleConte
 				Line:   int32(insertstmtCtx.GetStart().GetLine()),
 				Column: 0,
 			},
+			SuggestedFix: r.newSpecifyColumnFix(insertstmtCtx),
 		})
 	}
 }
+
+// newSpecifyColumnFix returns the fix inserting all columns of the table in order after the table name.
+// It only applies to the existing tables, and the INSERT ... VALUES statements with values for all columns.
+func (r *insertMustSpecifyColumnRule) newSpecifyColumnFix(ctx *parser.InsertstmtContext) *storepb.SuggestedFix {
+	target := ctx.Insert_target()
+	if target == nil || target.Qualified_name() == nil || ctx.Insert_rest().Selectstmt() == nil {
+		return nil
+	}
+	schemaName := normalizeSchemaName(extractSchemaName(target.Qualified_name()))
+	tableName := extractTableName(target.Qualified_name())
+	table := r.originalMetadata.GetSchemaMetadata(schemaName).GetTable(tableName)
+	if table == nil {
+		return nil
+	}
+	var columns []string
+	for _, column := range table.GetProto().GetColumns() {
+		columns = append(columns, quoteIdentifierIfNeeded(column.Name))
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	selectstmt := ctx.Insert_rest().Selectstmt()
+	if selectstmt.GetStart().GetTokenType() != parser.PostgreSQLParserVALUES {
+		return nil
+	}
+	var valuesClause *parser.Values_clauseContext
+	antlr.ParseTreeWalkerDefault.Walk(&valuesClauseFinder{result: &valuesClause}, selectstmt)
+	if valuesClause == nil {
+		return nil
+	}
+	for _, row := range valuesClause.AllExpr_list() {
+		if len(row.AllA_expr()) != len(columns) {
+			return nil
+		}
+	}
+	return advisor.NewSuggestedFix(
+		"Specify the columns",
+		advisor.NewInsertAfterTokenEdit(r.baseLine, r.baseColumn, target.GetStop(), fmt.Sprintf(" (%s)", strings.Join(columns, ", "))),
+	)
+}
+
+// valuesClauseFinder finds the first VALUES clause.
+type valuesClauseFinder struct {
+	*parser.BasePostgreSQLParserListener
+
+	result **parser.Values_clauseContext
+}
+
+func (f *valuesClauseFinder) EnterValues_clause(ctx *parser.Values_clauseContext) {
+	if *f.result == nil {
+		*f.result = ctx
+	}
+}
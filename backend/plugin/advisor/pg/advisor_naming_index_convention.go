@@ -60,6 +60,7 @@ func (*NamingIndexConventionAdvisor) Check(_ context.Context, checkCtx advisor.C
 
 	for _, parseResult := range parseResults {
 		rule.SetBaseLine(parseResult.BaseLine)
+		rule.SetBaseColumn(parseResult.BaseColumn)
 		checker.SetBaseLine(parseResult.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, parseResult.Tree)
 	}
@@ -144,7 +145,7 @@ func (r *namingIndexConventionRule) handleIndexstmt(ctx antlr.ParserRuleContext)
 		}
 	}
 
-	r.checkIndexName(indexName, tableName, columnList, indexstmtCtx.GetStart().GetLine())
+	r.checkIndexName(indexstmtCtx.Name(), indexName, tableName, columnList, indexstmtCtx.GetStart().GetLine())
 }
 
 // handleRenamestmt checks ALTER INDEX ... RENAME TO statements
@@ -182,14 +183,14 @@ func (r *namingIndexConventionRule) handleRenamestmt(ctx antlr.ParserRuleContext
 			if index != nil {
 				// Only check if it's a regular index (not unique, not primary)
 				if !index.GetProto().GetUnique() && !index.GetProto().GetPrimary() {
-					r.checkIndexName(newIndexName, tableName, index.GetProto().GetExpressions(), renamestmtCtx.GetStart().GetLine())
+					r.checkIndexName(allNames[0], newIndexName, tableName, index.GetProto().GetExpressions(), renamestmtCtx.GetStart().GetLine())
 				}
 			}
 		}
 	}
 }
 
-func (r *namingIndexConventionRule) checkIndexName(indexName, tableName string, columnList []string, line int) {
+func (r *namingIndexConventionRule) checkIndexName(nameCtx antlr.ParserRuleContext, indexName, tableName string, columnList []string, line int) {
 	metaData := map[string]string{
 		advisor.ColumnListTemplateToken: strings.Join(columnList, "_"),
 		advisor.TableNameTemplateToken:  tableName,
@@ -220,6 +221,7 @@ func (r *namingIndexConventionRule) checkIndexName(indexName, tableName string,
 				Line:   int32(line),
 				Column: 0,
 			},
+			SuggestedFix: newRenameFix(r.baseLine, r.baseColumn, nameCtx, r.format, r.templateList, metaData, r.maxLength),
 		})
 	}
 
@@ -60,6 +60,7 @@ func (*NamingUKConventionAdvisor) Check(_ context.Context, checkCtx advisor.Cont
 
 	for _, parseResult := range parseResults {
 		rule.SetBaseLine(parseResult.BaseLine)
+		rule.SetBaseColumn(parseResult.BaseColumn)
 		checker.SetBaseLine(parseResult.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, parseResult.Tree)
 	}
@@ -138,7 +139,7 @@ func (r *namingUKConventionRule) handleIndexstmt(ctx *parser.IndexstmtContext) {
 		advisor.TableNameTemplateToken:  tableName,
 	}
 
-	r.checkUniqueKeyName(indexName, tableName, metaData, ctx.GetStart().GetLine())
+	r.checkUniqueKeyName(ctx.Name(), indexName, tableName, metaData, ctx.GetStart().GetLine())
 }
 
 // handleCreatestmt handles CREATE TABLE with UNIQUE constraints
@@ -235,7 +236,7 @@ func (r *namingUKConventionRule) handleRenamestmt(ctx *parser.RenamestmtContext)
 		if r.originalMetadata != nil && oldIndexName != "" {
 			tableName, index := r.findIndex("", "", oldIndexName)
 			if index != nil && index.GetProto().GetUnique() && !index.GetProto().GetPrimary() {
-				r.checkUniqueKeyName(newIndexName, tableName, map[string]string{
+				r.checkUniqueKeyName(allNames[0], newIndexName, tableName, map[string]string{
 					advisor.ColumnListTemplateToken: strings.Join(index.GetProto().GetExpressions(), "_"),
 					advisor.TableNameTemplateToken:  tableName,
 				}, ctx.GetStart().GetLine())
@@ -264,7 +265,7 @@ func (r *namingUKConventionRule) handleRenamestmt(ctx *parser.RenamestmtContext)
 					advisor.ColumnListTemplateToken: strings.Join(index.GetProto().GetExpressions(), "_"),
 					advisor.TableNameTemplateToken:  foundTableName,
 				}
-				r.checkUniqueKeyName(newConstraintName, foundTableName, metaData, ctx.GetStart().GetLine())
+				r.checkUniqueKeyName(allNames[1], newConstraintName, foundTableName, metaData, ctx.GetStart().GetLine())
 			}
 		}
 	}
@@ -316,7 +317,7 @@ func (r *namingUKConventionRule) checkTableConstraint(constraint parser.ITableco
 					advisor.ColumnListTemplateToken: strings.Join(columnList, "_"),
 					advisor.TableNameTemplateToken:  tableName,
 				}
-				r.checkUniqueKeyName(constraintName, tableName, metaData, line)
+				r.checkUniqueKeyName(constraint.Name(), constraintName, tableName, metaData, line)
 			}
 		}
 	}
@@ -352,7 +353,7 @@ func (r *namingUKConventionRule) checkColumnDef(columndef parser.IColumnDefConte
 							advisor.ColumnListTemplateToken: colName,
 							advisor.TableNameTemplateToken:  tableName,
 						}
-						r.checkUniqueKeyName(constraintName, tableName, metaData, qual.GetStart().GetLine())
+						r.checkUniqueKeyName(qual.Name(), constraintName, tableName, metaData, qual.GetStart().GetLine())
 					}
 				}
 			}
@@ -360,7 +361,7 @@ func (r *namingUKConventionRule) checkColumnDef(columndef parser.IColumnDefConte
 	}
 }
 
-func (r *namingUKConventionRule) checkUniqueKeyName(indexName, tableName string, metaData map[string]string, line int) {
+func (r *namingUKConventionRule) checkUniqueKeyName(nameCtx antlr.ParserRuleContext, indexName, tableName string, metaData map[string]string, line int) {
 	regex, err := r.getTemplateRegexp(metaData)
 	if err != nil {
 		r.AddAdvice(&storepb.Advice{
@@ -382,6 +383,7 @@ func (r *namingUKConventionRule) checkUniqueKeyName(indexName, tableName string,
 				Line:   int32(line),
 				Column: 0,
 			},
+			SuggestedFix: newRenameFix(r.baseLine, r.baseColumn, nameCtx, r.format, r.templateList, metaData, r.maxLength),
 		})
 	}
 
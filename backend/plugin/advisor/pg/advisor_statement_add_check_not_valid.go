@@ -2,6 +2,7 @@ package pg
 
 import (
 	"context"
+	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
 
@@ -47,6 +48,7 @@ func (*StatementAddCheckNotValidAdvisor) Check(_ context.Context, checkCtx advis
 
 	for _, parseResult := range parseResults {
 		rule.SetBaseLine(parseResult.BaseLine)
+		rule.SetBaseColumn(parseResult.BaseColumn)
 		checker.SetBaseLine(parseResult.BaseLine)
 		antlr.ParseTreeWalkerDefault.Walk(checker, parseResult.Tree)
 	}
@@ -120,6 +122,7 @@ func (r *statementAddCheckNotValidRule) handleColconstraint(ctx antlr.ParserRule
 					Line:   int32(alterTableCtx.GetStart().GetLine()),
 					Column: 0,
 				},
+				SuggestedFix: r.newColumnConstraintFix(colCtx),
 			})
 		}
 	}
@@ -179,8 +182,31 @@ func (r *statementAddCheckNotValidRule) handleTableconstraint(ctx antlr.ParserRu
 						Line:   int32(alterTableCtx.GetStart().GetLine()),
 						Column: 0,
 					},
+					SuggestedFix: r.newAddNotValidFix(tableCtx),
 				})
 			}
 		}
 	}
 }
+
+// newColumnConstraintFix returns the fix for the column-level check constraint.
+// `ADD CONSTRAINT c CHECK (...)` is parsed as a column named "constraint", while NOT VALID is not allowed
+// for the check constraint of a real column such as `ADD COLUMN c int CHECK (...)`.
+func (r *statementAddCheckNotValidRule) newColumnConstraintFix(ctx *parser.ColconstraintContext) *storepb.SuggestedFix {
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		columnDef, ok := parent.(*parser.ColumnDefContext)
+		if !ok {
+			continue
+		}
+		if columnDef.Colid() == nil || !strings.EqualFold(columnDef.Colid().GetText(), "constraint") {
+			return nil
+		}
+		return r.newAddNotValidFix(ctx)
+	}
+	return nil
+}
+
+// newAddNotValidFix returns the fix appending NOT VALID to the check constraint.
+func (r *statementAddCheckNotValidRule) newAddNotValidFix(ctx antlr.ParserRuleContext) *storepb.SuggestedFix {
+	return advisor.NewSuggestedFix("Add NOT VALID", advisor.NewInsertAfterTokenEdit(r.baseLine, r.baseColumn, ctx.GetStop(), " NOT VALID"))
+}
@@ -1,6 +1,7 @@
 package pg
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	parser "github.com/bytebase/parser/postgresql"
 	"github.com/pkg/errors"
 
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/plugin/parser/pg"
@@ -49,9 +51,10 @@ func getANTLRTree(checkCtx advisor.Context) ([]*base.ParseResult, error) {
 
 		// Reconstruct base.ParseResult from AST
 		parseResults = append(parseResults, &base.ParseResult{
-			Tree:     antlrAST.Tree,
-			Tokens:   antlrAST.Tokens,
-			BaseLine: base.GetLineOffset(antlrAST.StartPosition),
+			Tree:       antlrAST.Tree,
+			Tokens:     antlrAST.Tokens,
+			BaseLine:   base.GetLineOffset(antlrAST.StartPosition),
+			BaseColumn: base.GetColumnOffset(antlrAST.StartPosition),
 		})
 	}
 
@@ -151,3 +154,48 @@ func normalizeSchemaName(schemaName string) string {
 	}
 	return schemaName
 }
+
+var reservedKeywords = func() map[string]bool {
+	keywords := make(map[string]bool)
+	for _, keyword := range parser.Keywords {
+		if keyword.Reserved {
+			keywords[strings.ToLower(keyword.Keyword)] = true
+		}
+	}
+	return keywords
+}()
+
+// quoteIdentifierIfNeeded quotes the identifier which cannot be used without quotes, such as `userId` and `user`.
+func quoteIdentifierIfNeeded(s string) string {
+	valid := s != "" && !reservedKeywords[s]
+	for i, r := range s {
+		if r == '_' || (r >= 'a' && r <= 'z') || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+		valid = false
+		break
+	}
+	if valid {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// newRenameFix returns the fix renaming the name to the one rendered from the naming format.
+// It returns nil if the format cannot be rendered to a plain name.
+func newRenameFix(baseLine, baseColumn int, name antlr.ParserRuleContext, format string, templateList []string, tokens map[string]string, maxLength int) *storepb.SuggestedFix {
+	if name == nil {
+		return nil
+	}
+	expected, ok := advisor.RenderNamingTemplate(format, templateList, tokens)
+	if !ok || (maxLength > 0 && len(expected) > maxLength) {
+		return nil
+	}
+	if regex, err := getTemplateRegexp(format, templateList, tokens); err != nil || !regex.MatchString(expected) {
+		return nil
+	}
+	return advisor.NewSuggestedFix(
+		fmt.Sprintf("Rename to %q", expected),
+		advisor.NewReplaceTokensEdit(baseLine, baseColumn, name.GetStart(), name.GetStop(), quoteIdentifierIfNeeded(expected)),
+	)
+}
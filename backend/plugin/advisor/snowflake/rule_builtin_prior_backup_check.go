@@ -0,0 +1,161 @@
+// Package snowflake is the advisor for snowflake database.
+package snowflake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/snowflake"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+)
+
+var (
+	_ advisor.Advisor = (*StatementPriorBackupCheckAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_SNOWFLAKE, advisor.BuiltinRulePriorBackupCheck, &StatementPriorBackupCheckAdvisor{})
+}
+
+// StatementPriorBackupCheckAdvisor is the advisor checking whether the statements can be backed up before execution.
+type StatementPriorBackupCheckAdvisor struct {
+}
+
+// Check checks whether the statements can be backed up before execution.
+func (*StatementPriorBackupCheckAdvisor) Check(ctx context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	if !checkCtx.EnablePriorBackup {
+		return nil, nil
+	}
+
+	parseResults, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := NewStatementPriorBackupCheckRule(level, string(checkCtx.Rule.Type), checkCtx.CurrentDatabase)
+	checker := NewGenericChecker([]Rule{rule})
+
+	for _, parseResult := range parseResults {
+		rule.SetBaseLine(parseResult.BaseLine)
+		checker.SetBaseLine(parseResult.BaseLine)
+		antlr.ParseTreeWalkerDefault.Walk(checker, parseResult.Tree)
+	}
+
+	if len(checkCtx.Statements) > common.MaxSheetCheckSize {
+		rule.AddAdvice(&storepb.Advice{
+			Status:        level,
+			Title:         string(checkCtx.Rule.Type),
+			Content:       fmt.Sprintf("The size of the SQL statements exceeds the maximum limit of %d bytes for backup", common.MaxSheetCheckSize),
+			Code:          code.BuiltinPriorBackupCheck.Int32(),
+			StartPosition: nil,
+		})
+	}
+
+	databaseName := common.BackupDatabaseNameOfEngine(storepb.Engine_SNOWFLAKE)
+	if !advisor.DatabaseExists(ctx, checkCtx, databaseName) {
+		rule.AddAdvice(&storepb.Advice{
+			Status:        level,
+			Title:         string(checkCtx.Rule.Type),
+			Content:       fmt.Sprintf("Need database %q to do prior backup but it does not exist", databaseName),
+			Code:          code.DatabaseNotExists.Int32(),
+			StartPosition: nil,
+		})
+	}
+
+	return checker.GetAdviceList(), nil
+}
+
+// StatementPriorBackupCheckRule checks the statements that prior backup cannot handle.
+type StatementPriorBackupCheckRule struct {
+	BaseRule
+
+	currentDatabase string
+	// tableStatementType is a map of normalized table name to the DML statement type on it.
+	tableStatementType map[string]string
+}
+
+// NewStatementPriorBackupCheckRule creates a new StatementPriorBackupCheckRule.
+func NewStatementPriorBackupCheckRule(level storepb.Advice_Status, title string, currentDatabase string) *StatementPriorBackupCheckRule {
+	return &StatementPriorBackupCheckRule{
+		BaseRule: BaseRule{
+			level: level,
+			title: title,
+		},
+		currentDatabase:    currentDatabase,
+		tableStatementType: make(map[string]string),
+	}
+}
+
+// Name returns the rule name.
+func (*StatementPriorBackupCheckRule) Name() string {
+	return "StatementPriorBackupCheckRule"
+}
+
+// OnEnter is called when entering a parse tree node.
+func (r *StatementPriorBackupCheckRule) OnEnter(ctx antlr.ParserRuleContext, nodeType string) error {
+	switch nodeType {
+	case NodeTypeDdlCommand:
+		r.AddAdvice(&storepb.Advice{
+			Status:        r.level,
+			Code:          code.BuiltinPriorBackupCheck.Int32(),
+			Title:         r.title,
+			Content:       "Prior backup cannot deal with mixed DDL and DML statements",
+			StartPosition: common.ConvertANTLRLineToPosition(r.baseLine + ctx.GetStart().GetLine()),
+		})
+	case NodeTypeUpdateStatement:
+		updateCtx, ok := ctx.(*parser.Update_statementContext)
+		if !ok {
+			return nil
+		}
+		r.checkStatementType(ctx, updateCtx.Object_name(), "UPDATE")
+	case NodeTypeDeleteStatement:
+		deleteCtx, ok := ctx.(*parser.Delete_statementContext)
+		if !ok {
+			return nil
+		}
+		r.checkStatementType(ctx, deleteCtx.Object_name(), "DELETE")
+	default:
+		// Ignore other node types
+	}
+	return nil
+}
+
+// OnExit is called when exiting a parse tree node.
+func (*StatementPriorBackupCheckRule) OnExit(_ antlr.ParserRuleContext, _ string) error {
+	// This rule doesn't need exit processing
+	return nil
+}
+
+// checkStatementType reports the statement if there is another type of DML statement on the same table,
+// since all the statements on the same table are backed up into one backup table.
+func (r *StatementPriorBackupCheckRule) checkStatementType(ctx antlr.ParserRuleContext, objectName parser.IObject_nameContext, statementType string) {
+	if objectName == nil || !snowsqlparser.IsTopLevelStatement(ctx.GetParent()) {
+		return
+	}
+	tableName := snowsqlparser.NormalizeSnowSQLObjectName(objectName, r.currentDatabase, "PUBLIC")
+	previous, ok := r.tableStatementType[tableName]
+	if !ok {
+		r.tableStatementType[tableName] = statementType
+		return
+	}
+	if previous != statementType {
+		r.AddAdvice(&storepb.Advice{
+			Status:        r.level,
+			Code:          code.BuiltinPriorBackupCheck.Int32(),
+			Title:         r.title,
+			Content:       fmt.Sprintf("Prior backup cannot handle mixed DML statements on the same table %q", tableName),
+			StartPosition: common.ConvertANTLRLineToPosition(r.baseLine + ctx.GetStart().GetLine()),
+		})
+	}
+}
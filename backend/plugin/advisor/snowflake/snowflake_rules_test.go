@@ -10,6 +10,7 @@ import (
 
 func TestSnowflakeRules(t *testing.T) {
 	snowflakeRules := []advisor.SQLReviewRuleType{
+		advisor.BuiltinRulePriorBackupCheck,
 		advisor.SchemaRuleTableNaming,
 		advisor.SchemaRuleTableRequirePK,
 		advisor.SchemaRuleTableNoFK,
@@ -0,0 +1,80 @@
+// Package ai is the plugin for the AI providers.
+package ai
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// Message is a chat message sent to the AI provider.
+type Message struct {
+	// Role is "user" or "assistant".
+	Role    string
+	Content string
+}
+
+// Provider is the AI provider completing the chat messages.
+type Provider interface {
+	// Complete returns the text of each candidate of the completion.
+	Complete(ctx context.Context, messages []Message) ([]string, error)
+	// Stream streams the completion, onText is called with each text delta as it is generated.
+	Stream(ctx context.Context, messages []Message, onText func(text string) error) error
+}
+
+// NewProvider returns the AI provider for the setting.
+func NewProvider(setting *storepb.AISetting) (Provider, error) {
+	client, err := newHTTPClient(setting)
+	if err != nil {
+		return nil, err
+	}
+	switch setting.Provider {
+	case storepb.AISetting_OPEN_AI, storepb.AISetting_AZURE_OPENAI, storepb.AISetting_OPENAI_COMPATIBLE:
+		return &openAIProvider{client: client, setting: setting}, nil
+	case storepb.AISetting_GEMINI:
+		return &geminiProvider{client: client, setting: setting}, nil
+	case storepb.AISetting_CLAUDE:
+		return &claudeProvider{client: client, setting: setting}, nil
+	default:
+		return nil, errors.Errorf("unsupported AI provider %s", setting.Provider)
+	}
+}
+
+// newHTTPClient returns the HTTP client trusting the CA certificate of the setting in addition to the system root CAs.
+func newHTTPClient(setting *storepb.AISetting) (*http.Client, error) {
+	if setting.CaCertificate == "" {
+		return &http.Client{}, nil
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM([]byte(setting.CaCertificate)) {
+		return nil, errors.New("failed to parse the CA certificate")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    rootCAs,
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// newHeader returns the request header with the default headers of the provider and the custom headers of the setting.
+// The custom headers take precedence, so that the gateway may replace the authentication for example.
+func newHeader(setting *storepb.AISetting, defaults map[string]string) http.Header {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	for key, value := range defaults {
+		header.Set(key, value)
+	}
+	for key, value := range setting.Headers {
+		header.Set(key, value)
+	}
+	return header
+}
@@ -0,0 +1,143 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestOpenAICompatibleProvider(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("secret", r.Header.Get("X-Gateway-Key"))
+		// The API key is optional for the OpenAI-compatible endpoints.
+		a.Empty(r.Header.Get("Authorization"))
+		var req openAIRequest
+		a.NoError(json.NewDecoder(r.Body).Decode(&req))
+		a.Equal("llama3", req.Model)
+		a.Equal([]openAIMessage{{Role: "user", Content: "hello"}}, req.Messages)
+		if !req.Stream {
+			_, _ = io.WriteString(w, `{"choices":[{"message":{"role":"assistant","content":"SELECT 1"}}]}`)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, ": keep-alive\n\n"+
+			"data: {\"choices\":[{\"delta\":{\"role\":\"assistant\"}}]}\n\n"+
+			"data: {\"choices\":[{\"delta\":{\"content\":\"SELECT\"}}]}\n\n"+
+			"data: {\"choices\":[{\"delta\":{\"content\":\" 1\"}}]}\n\n"+
+			"data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	setting := &storepb.AISetting{
+		Provider:      storepb.AISetting_OPENAI_COMPATIBLE,
+		Endpoint:      server.URL + "/v1/chat/completions",
+		Model:         "llama3",
+		Headers:       map[string]string{"X-Gateway-Key": "secret"},
+		CaCertificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+	}
+	provider, err := NewProvider(setting)
+	a.NoError(err)
+	messages := []Message{{Role: "user", Content: "hello"}}
+
+	texts, err := provider.Complete(context.Background(), messages)
+	a.NoError(err)
+	a.Equal([]string{"SELECT 1"}, texts)
+
+	var deltas []string
+	err = provider.Stream(context.Background(), messages, func(text string) error {
+		deltas = append(deltas, text)
+		return nil
+	})
+	a.NoError(err)
+	a.Equal([]string{"SELECT", " 1"}, deltas)
+
+	// The server certificate is not trusted without the CA certificate.
+	setting.CaCertificate = ""
+	provider, err = NewProvider(setting)
+	a.NoError(err)
+	_, err = provider.Complete(context.Background(), messages)
+	a.Error(err)
+
+	setting.CaCertificate = "invalid"
+	_, err = NewProvider(setting)
+	a.Error(err)
+}
+
+func TestClaudeProviderStream(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("key", r.Header.Get("x-api-key"))
+		a.Equal(defaultClaudeVersion, r.Header.Get("anthropic-version"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, strings.Join([]string{
+			"event: message_start\ndata: {\"type\":\"message_start\"}\n",
+			"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"delta\":{\"type\":\"text_delta\",\"text\":\"SELECT\"}}\n",
+			"event: ping\ndata: {\"type\":\"ping\"}\n",
+			"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"delta\":{\"type\":\"text_delta\",\"text\":\" 1\"}}\n",
+			"event: error\ndata: {\"type\":\"error\",\"error\":{\"type\":\"overloaded_error\",\"message\":\"Overloaded\"}}\n",
+		}, "\n"))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(&storepb.AISetting{
+		Provider: storepb.AISetting_CLAUDE,
+		Endpoint: server.URL,
+		ApiKey:   "key",
+	})
+	a.NoError(err)
+	var sb strings.Builder
+	err = provider.Stream(context.Background(), []Message{{Role: "user", Content: "hello"}}, func(text string) error {
+		sb.WriteString(text)
+		return nil
+	})
+	a.ErrorContains(err, "Overloaded")
+	a.Equal("SELECT 1", sb.String())
+}
+
+func TestGeminiProvider(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("key", r.URL.Query().Get("key"))
+		switch r.URL.Path {
+		case "/models/gemini:generateContent":
+			_, _ = io.WriteString(w, "{\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"```sql\\nSELECT 1\\n```\"}]}}]}")
+		case "/models/gemini:streamGenerateContent":
+			a.Equal("sse", r.URL.Query().Get("alt"))
+			_, _ = io.WriteString(w, "data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"SELECT\"}]}}]}\r\n\r\n"+
+				"data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\" 1\"}]}}]}\r\n\r\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(&storepb.AISetting{
+		Provider: storepb.AISetting_GEMINI,
+		Endpoint: server.URL,
+		ApiKey:   "key",
+		Model:    "gemini",
+	})
+	a.NoError(err)
+	messages := []Message{{Role: "user", Content: "hello"}}
+	texts, err := provider.Complete(context.Background(), messages)
+	a.NoError(err)
+	a.Equal([]string{"SELECT 1"}, texts)
+
+	var sb strings.Builder
+	err = provider.Stream(context.Background(), messages, func(text string) error {
+		sb.WriteString(text)
+		return nil
+	})
+	a.NoError(err)
+	a.Equal("SELECT 1", sb.String())
+}
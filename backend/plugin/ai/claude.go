@@ -0,0 +1,142 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	defaultClaudeVersion = "2023-06-01"
+)
+
+// claudeProvider is the provider for Claude.
+// Claude API docs: https://docs.anthropic.com/en/api/getting-started
+type claudeProvider struct {
+	client  *http.Client
+	setting *storepb.AISetting
+}
+
+// claudeRequest represents the payload for Claude API requests.
+type claudeRequest struct {
+	Model         string          `json:"model"`
+	Messages      []claudeMessage `json:"messages"`
+	MaxTokens     int             `json:"max_tokens"`
+	Temperature   float64         `json:"temperature"`
+	TopP          float64         `json:"top_p"`
+	TopK          int             `json:"top_k"`
+	StopSequences []string        `json:"stop_sequences,omitempty"`
+	Stream        bool            `json:"stream,omitempty"`
+}
+
+type claudeMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// claudeResponse represents the response from Claude API.
+type claudeResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+// claudeEvent represents the streamed event from Claude API.
+// See https://docs.anthropic.com/en/api/messages-streaming.
+type claudeEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (p *claudeProvider) Complete(ctx context.Context, messages []Message) ([]string, error) {
+	body, err := doRequest(ctx, p.client, p.setting.Endpoint, p.newRequest(messages, false /* stream */), p.newHeader())
+	if err != nil {
+		return nil, errors.Wrap(err, "Claude API call failed")
+	}
+	defer body.Close()
+
+	var resp claudeResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal Claude response")
+	}
+	var sb strings.Builder
+	for _, content := range resp.Content {
+		if content.Type == "text" {
+			sb.WriteString(content.Text)
+		}
+	}
+	if sb.Len() == 0 {
+		return nil, nil
+	}
+	return []string{sb.String()}, nil
+}
+
+func (p *claudeProvider) Stream(ctx context.Context, messages []Message, onText func(text string) error) error {
+	body, err := doRequest(ctx, p.client, p.setting.Endpoint, p.newRequest(messages, true /* stream */), p.newHeader())
+	if err != nil {
+		return errors.Wrap(err, "Claude API call failed")
+	}
+	defer body.Close()
+
+	return readEvents(body, func(data []byte) error {
+		var event claudeEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return errors.Wrap(err, "failed to unmarshal Claude event")
+		}
+		switch event.Type {
+		case "content_block_delta":
+			if event.Delta.Type != "text_delta" || event.Delta.Text == "" {
+				return nil
+			}
+			return onText(event.Delta.Text)
+		case "error":
+			return errors.Errorf("Claude API returned error %s: %s", event.Error.Type, event.Error.Message)
+		default:
+			return nil
+		}
+	})
+}
+
+func (p *claudeProvider) newRequest(messages []Message, stream bool) *claudeRequest {
+	payload := &claudeRequest{
+		Model:         p.setting.Model,
+		MaxTokens:     4096,
+		Temperature:   0.7,
+		TopP:          0.95,
+		TopK:          0,
+		StopSequences: []string{"#", ";"},
+		Stream:        stream,
+	}
+	for _, m := range messages {
+		payload.Messages = append(payload.Messages, claudeMessage{
+			Role:    m.Role,
+			Content: m.Content,
+		})
+	}
+	return payload
+}
+
+func (p *claudeProvider) newHeader() http.Header {
+	// Claude API requires anthropic-version header
+	version := p.setting.Version
+	if version == "" {
+		version = defaultClaudeVersion
+	}
+	return newHeader(p.setting, map[string]string{
+		"x-api-key":         p.setting.ApiKey,
+		"anthropic-version": version,
+	})
+}
@@ -0,0 +1,147 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// geminiProvider is the provider for Gemini.
+// Gemini API docs: https://ai.google.dev/gemini-api/docs
+type geminiProvider struct {
+	client  *http.Client
+	setting *storepb.AISetting
+}
+
+// geminiRequest represents the payload for Gemini API requests.
+type geminiRequest struct {
+	Contents         []geminiContent        `json:"contents"`
+	GenerationConfig geminiGenerationConfig `json:"generationConfig"`
+}
+
+type geminiContent struct {
+	Parts []geminiPart `json:"parts"`
+	Role  string       `json:"role"`
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiGenerationConfig struct {
+	Temperature     float64  `json:"temperature"`
+	TopP            float64  `json:"topP"`
+	TopK            int      `json:"topK"`
+	MaxOutputTokens int      `json:"maxOutputTokens"`
+	StopSequences   []string `json:"stopSequences,omitempty"`
+}
+
+// geminiResponse represents the response from Gemini API, the streamed chunks share the same format.
+type geminiResponse struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+}
+
+func (p *geminiProvider) Complete(ctx context.Context, messages []Message) ([]string, error) {
+	// Gemini API endpoint format: https://generativelanguage.googleapis.com/v1beta/models/{model}:generateContent?key={apiKey}
+	url := fmt.Sprintf("%s/models/%s:generateContent?key=%s", p.setting.Endpoint, p.setting.Model, p.setting.ApiKey)
+	body, err := doRequest(ctx, p.client, url, p.newRequest(messages), newHeader(p.setting, nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "Gemini API call failed")
+	}
+	defer body.Close()
+
+	var resp geminiResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal Gemini response")
+	}
+	var texts []string
+	for _, candidate := range resp.Candidates {
+		var sb strings.Builder
+		for _, part := range candidate.Content.Parts {
+			sb.WriteString(part.Text)
+		}
+		texts = append(texts, stripCodeBlock(sb.String()))
+	}
+	return texts, nil
+}
+
+func (p *geminiProvider) Stream(ctx context.Context, messages []Message, onText func(text string) error) error {
+	url := fmt.Sprintf("%s/models/%s:streamGenerateContent?alt=sse&key=%s", p.setting.Endpoint, p.setting.Model, p.setting.ApiKey)
+	body, err := doRequest(ctx, p.client, url, p.newRequest(messages), newHeader(p.setting, nil))
+	if err != nil {
+		return errors.Wrap(err, "Gemini API call failed")
+	}
+	defer body.Close()
+
+	return readEvents(body, func(data []byte) error {
+		var chunk geminiResponse
+		if err := json.Unmarshal(data, &chunk); err != nil {
+			return errors.Wrap(err, "failed to unmarshal Gemini chunk")
+		}
+		// Only the first candidate is streamed.
+		if len(chunk.Candidates) == 0 {
+			return nil
+		}
+		for _, part := range chunk.Candidates[0].Content.Parts {
+			if part.Text == "" {
+				continue
+			}
+			if err := onText(part.Text); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (*geminiProvider) newRequest(messages []Message) *geminiRequest {
+	var contents []geminiContent
+	for _, m := range messages {
+		if m.Content == "" {
+			continue
+		}
+		// Gemini uses "user" and "model" as roles
+		role := m.Role
+		if role != "user" {
+			role = "model"
+		}
+		contents = append(contents, geminiContent{
+			Role: role,
+			Parts: []geminiPart{
+				{Text: m.Content},
+			},
+		})
+	}
+	return &geminiRequest{
+		Contents: contents,
+		GenerationConfig: geminiGenerationConfig{
+			Temperature:     0.7,
+			TopP:            0.95,
+			TopK:            40,
+			MaxOutputTokens: 2048,
+		},
+	}
+}
+
+// stripCodeBlock strips the code block markers (```language or ```) around the text.
+func stripCodeBlock(text string) string {
+	if strings.HasPrefix(text, "```") {
+		// Find the end of the first line (after ```language)
+		firstNewline := strings.Index(text, "\n")
+		if firstNewline != -1 {
+			text = text[firstNewline+1:]
+		} else {
+			text = strings.TrimPrefix(text, "```")
+		}
+	}
+	text = strings.TrimSuffix(text, "```")
+	return strings.TrimSpace(text)
+}
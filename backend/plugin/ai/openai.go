@@ -0,0 +1,113 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// openAIProvider is the provider for OpenAI, Azure OpenAI and the OpenAI-compatible endpoints such as vLLM and Ollama.
+// OpenAI API docs: https://platform.openai.com/docs/api-reference/chat
+type openAIProvider struct {
+	client  *http.Client
+	setting *storepb.AISetting
+}
+
+// openAIRequest represents the payload for OpenAI API requests.
+type openAIRequest struct {
+	Model    string          `json:"model"`
+	Messages []openAIMessage `json:"messages"`
+	TopP     float64         `json:"top_p"`
+	Stop     []string        `json:"stop,omitempty"`
+	Stream   bool            `json:"stream,omitempty"`
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// openAIResponse represents the response from OpenAI API.
+type openAIResponse struct {
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// openAIChunk represents the streamed chunk from OpenAI API.
+type openAIChunk struct {
+	Choices []struct {
+		Delta openAIMessage `json:"delta"`
+	} `json:"choices"`
+}
+
+func (p *openAIProvider) Complete(ctx context.Context, messages []Message) ([]string, error) {
+	body, err := doRequest(ctx, p.client, p.setting.Endpoint, p.newRequest(messages, false /* stream */), p.newHeader())
+	if err != nil {
+		return nil, errors.Wrap(err, "OpenAI API call failed")
+	}
+	defer body.Close()
+
+	var resp openAIResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal OpenAI response")
+	}
+	var texts []string
+	for _, choice := range resp.Choices {
+		texts = append(texts, choice.Message.Content)
+	}
+	return texts, nil
+}
+
+func (p *openAIProvider) Stream(ctx context.Context, messages []Message, onText func(text string) error) error {
+	body, err := doRequest(ctx, p.client, p.setting.Endpoint, p.newRequest(messages, true /* stream */), p.newHeader())
+	if err != nil {
+		return errors.Wrap(err, "OpenAI API call failed")
+	}
+	defer body.Close()
+
+	return readEvents(body, func(data []byte) error {
+		var chunk openAIChunk
+		if err := json.Unmarshal(data, &chunk); err != nil {
+			return errors.Wrap(err, "failed to unmarshal OpenAI chunk")
+		}
+		// Only the first choice is streamed.
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			return nil
+		}
+		return onText(chunk.Choices[0].Delta.Content)
+	})
+}
+
+func (p *openAIProvider) newRequest(messages []Message, stream bool) *openAIRequest {
+	payload := &openAIRequest{
+		Model:  p.setting.Model,
+		TopP:   1.0,
+		Stop:   []string{"#", ";"},
+		Stream: stream,
+	}
+	for _, m := range messages {
+		payload.Messages = append(payload.Messages, openAIMessage{
+			Role:    m.Role,
+			Content: m.Content,
+		})
+	}
+	return payload
+}
+
+func (p *openAIProvider) newHeader() http.Header {
+	defaults := map[string]string{}
+	switch {
+	case p.setting.Provider == storepb.AISetting_AZURE_OPENAI:
+		defaults["api-key"] = p.setting.ApiKey
+	case p.setting.ApiKey != "":
+		// The OpenAI-compatible endpoints such as Ollama may not require the API key.
+		defaults["Authorization"] = fmt.Sprintf("Bearer %s", p.setting.ApiKey)
+	}
+	return newHeader(p.setting, defaults)
+}
@@ -0,0 +1,86 @@
+package ai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxEventSize is the maximum size of a server-sent event line.
+	maxEventSize = 1024 * 1024
+)
+
+// doRequest sends the JSON payload to the URL and returns the response body on success.
+func doRequest(ctx context.Context, client *http.Client, url string, payload any, header http.Header) (io.ReadCloser, error) {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request payload")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payloadBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create HTTP request")
+	}
+	req.Header = header
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send HTTP request")
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxEventSize))
+		return nil, errors.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return resp.Body, nil
+}
+
+// readEvents reads the data of the server-sent events until the end of the stream or the `[DONE]` data.
+// See https://html.spec.whatwg.org/multipage/server-sent-events.html.
+func readEvents(r io.Reader, onData func(data []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+	var data []string
+	flush := func() error {
+		if len(data) == 0 {
+			return nil
+		}
+		event := strings.Join(data, "\n")
+		data = nil
+		if event == "[DONE]" {
+			return io.EOF
+		}
+		return onData([]byte(event))
+	}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if err := flush(); err != nil {
+				return ignoreEOF(err)
+			}
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		if field != "data" {
+			// Ignore the comments and the other fields such as event, id and retry.
+			continue
+		}
+		data = append(data, strings.TrimPrefix(value, " "))
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "failed to read the event stream")
+	}
+	return ignoreEOF(flush())
+}
+
+func ignoreEOF(err error) error {
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
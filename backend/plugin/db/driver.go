@@ -153,6 +153,30 @@ type Driver interface {
 	Dump(ctx context.Context, out io.Writer, dbMetadata *storepb.DatabaseSchemaMetadata) error
 }
 
+// RowCursor iterates over the rows of a single query result without materializing the result in memory.
+type RowCursor interface {
+	// ColumnNames returns the column names of the result.
+	ColumnNames() []string
+	// ColumnTypeNames returns the database type names of the columns.
+	ColumnTypeNames() []string
+	// Next prepares the next row for reading with Row. It returns false when there are no more rows or an error occurred.
+	Next() bool
+	// Row returns the current row.
+	Row() (*v1pb.QueryRow, error)
+	// Err returns the error, if any, that was encountered during iteration.
+	Err() error
+	// Remember to call Close to release the connection resources.
+	Close() error
+}
+
+// QueryStreamer is implemented by drivers that can stream the rows of a readonly statement.
+// It is used by data export to export large results with bounded memory.
+type QueryStreamer interface {
+	// QueryStream executes a single readonly statement and returns a cursor over its rows.
+	// MaximumSQLResultSize in the query context is ignored.
+	QueryStream(ctx context.Context, conn *sql.Conn, statement string, queryContext QueryContext) (RowCursor, error)
+}
+
 // Register makes a database driver available by the provided type.
 // If Register is called twice with the same name or if driver is nil,
 // it panics.
@@ -38,7 +38,8 @@ var (
 	baseTableType = "BASE TABLE"
 	viewTableType = "VIEW"
 
-	_ db.Driver = (*Driver)(nil)
+	_ db.Driver        = (*Driver)(nil)
+	_ db.QueryStreamer = (*Driver)(nil)
 )
 
 func init() {
@@ -607,6 +608,25 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryStream executes a single readonly statement and returns a cursor over its rows.
+func (*Driver) QueryStream(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) (db.RowCursor, error) {
+	if queryContext.Limit > 0 {
+		statement = getStatementWithResultLimit(statement, queryContext.Limit)
+	}
+	_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_MYSQL, statement)
+	if err != nil {
+		return nil, err
+	}
+	if !allQuery {
+		return nil, errors.Errorf("only readonly statements can be streamed")
+	}
+	rows, err := conn.QueryContext(ctx, util.MySQLPrependBytebaseAppComment(statement))
+	if err != nil {
+		return nil, err
+	}
+	return util.NewRowCursor(rows, makeValueByTypeName, convertValue)
+}
+
 func (d *Driver) StopConnectionByID(id string) error {
 	// We cannot use placeholder parameter because TiDB doesn't accept it.
 	_, err := d.db.Exec(fmt.Sprintf("KILL QUERY %s", id))
@@ -69,6 +69,15 @@ func (d *Driver) CountAffectedRows(ctx context.Context, statement string) (int64
 	return 0, nil
 }
 
+// ExplainJSON returns the query plan of the statement in JSON format. The statement is not executed.
+func (d *Driver) ExplainJSON(ctx context.Context, statement string) (string, error) {
+	var plan string
+	if err := d.db.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement)).Scan(&plan); err != nil {
+		return "", err
+	}
+	return plan, nil
+}
+
 func countAffectedRowsForOceanBase(ctx context.Context, sqlDB *sql.DB, dml string) (int64, error) {
 	explainSQL := fmt.Sprintf("EXPLAIN FORMAT=JSON %s", dml)
 	rows, err := sqlDB.QueryContext(ctx, explainSQL)
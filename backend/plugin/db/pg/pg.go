@@ -36,7 +36,8 @@ var (
 	// driverName is the driver name that our driver dependence register, now is "pgx".
 	driverName = "pgx"
 
-	_ db.Driver = (*Driver)(nil)
+	_ db.Driver        = (*Driver)(nil)
+	_ db.QueryStreamer = (*Driver)(nil)
 )
 
 func init() {
@@ -888,6 +889,31 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryStream executes a single readonly statement and returns a cursor over its rows.
+func (d *Driver) QueryStream(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) (db.RowCursor, error) {
+	if queryContext.Limit > 0 {
+		statement = getStatementWithResultLimit(statement, queryContext.Limit)
+	}
+	_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_POSTGRES, statement)
+	if err != nil {
+		return nil, err
+	}
+	if !allQuery {
+		return nil, errors.Errorf("only readonly statements can be streamed")
+	}
+	if queryContext.Schema != "" {
+		safeSchemeName := strings.ReplaceAll(queryContext.Schema, "\"", "\"\"")
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf(`SET search_path TO "%s";`, safeSchemeName)); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	return util.NewRowCursor(rows, makeValueByTypeName, convertValue)
+}
+
 func getPgError(e error) *v1pb.QueryResult_PostgresError_ {
 	if e == nil {
 		return nil
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"database/sql"
+	"strings"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+// rowCursor is the db.RowCursor over *sql.Rows.
+type rowCursor struct {
+	rows              *sql.Rows
+	columnNames       []string
+	columnTypes       []*sql.ColumnType
+	columnTypeNames   []string
+	valueMaker        func(string, *sql.ColumnType) any
+	rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue
+}
+
+// NewRowCursor returns a db.RowCursor over the rows, converting values the same way as RowsToQueryResult.
+// The cursor takes the ownership of the rows and closes them on Close.
+func NewRowCursor(rows *sql.Rows, valueMaker func(string, *sql.ColumnType) any, rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue) (db.RowCursor, error) {
+	columnNames, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+	var columnTypeNames []string
+	for _, v := range columnTypes {
+		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+	}
+	return &rowCursor{
+		rows:              rows,
+		columnNames:       columnNames,
+		columnTypes:       columnTypes,
+		columnTypeNames:   columnTypeNames,
+		valueMaker:        valueMaker,
+		rowValueConverter: rowValueConverter,
+	}, nil
+}
+
+func (c *rowCursor) ColumnNames() []string {
+	return c.columnNames
+}
+
+func (c *rowCursor) ColumnTypeNames() []string {
+	return c.columnTypeNames
+}
+
+func (c *rowCursor) Next() bool {
+	if len(c.columnNames) == 0 {
+		return false
+	}
+	return c.rows.Next()
+}
+
+func (c *rowCursor) Row() (*v1pb.QueryRow, error) {
+	values := make([]any, len(c.columnNames))
+	for i, v := range c.columnTypeNames {
+		values[i] = c.valueMaker(v, c.columnTypes[i])
+	}
+	if err := c.rows.Scan(values...); err != nil {
+		return nil, err
+	}
+	row := &v1pb.QueryRow{Values: make([]*v1pb.RowValue, 0, len(values))}
+	for i := range values {
+		row.Values = append(row.Values, c.rowValueConverter(c.columnTypeNames[i], c.columnTypes[i], values[i]))
+	}
+	return row, nil
+}
+
+func (c *rowCursor) Err() error {
+	return c.rows.Err()
+}
+
+func (c *rowCursor) Close() error {
+	return c.rows.Close()
+}
@@ -0,0 +1,430 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider, where Bytebase acts as the service provider.
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	// NameIDClaim is the claim key of the NameID of the assertion subject.
+	NameIDClaim = "NameID"
+	// RequestIDCookieName is the cookie name of the AuthnRequest ID, which is checked
+	// against the InResponseTo of the SAMLResponse on login.
+	RequestIDCookieName = "bb_saml_request"
+	// EndpointPrefix is the path prefix of the SP endpoints.
+	EndpointPrefix = "/saml/idps"
+
+	metadataFetchTimeout = 10 * time.Second
+	// metadataCacheTTL is how long the IdP metadata fetched from the metadata URL is reused.
+	metadataCacheTTL = 10 * time.Minute
+)
+
+// metadataCache caches the IdP metadata fetched from the metadata URLs, so that the metadata is not fetched
+// on every login. The last good metadata is kept when the refresh fails.
+var metadataCache = &idpMetadataCache{entries: make(map[idpMetadataCacheKey]*idpMetadataCacheEntry)}
+
+type idpMetadataCacheKey struct {
+	url           string
+	skipTLSVerify bool
+}
+
+type idpMetadataCacheEntry struct {
+	metadata  *saml.EntityDescriptor
+	fetchedAt time.Time
+}
+
+type idpMetadataCache struct {
+	mu      sync.Mutex
+	entries map[idpMetadataCacheKey]*idpMetadataCacheEntry
+}
+
+// get returns the IdP metadata of the metadata URL, which is fetched again after the cache TTL.
+// The metadata is shared by the identity providers and must not be modified.
+func (c *idpMetadataCache) get(ctx context.Context, metadataURL string, skipTLSVerify bool) (*saml.EntityDescriptor, error) {
+	key := idpMetadataCacheKey{url: metadataURL, skipTLSVerify: skipTLSVerify}
+	c.mu.Lock()
+	entry := c.entries[key]
+	c.mu.Unlock()
+	if entry != nil && time.Since(entry.fetchedAt) < metadataCacheTTL {
+		return entry.metadata, nil
+	}
+
+	metadata, err := fetchMetadata(ctx, metadataURL, skipTLSVerify)
+	if err == nil {
+		var idpMetadata *saml.EntityDescriptor
+		idpMetadata, err = ParseMetadata(metadata)
+		if err == nil {
+			c.mu.Lock()
+			c.entries[key] = &idpMetadataCacheEntry{metadata: idpMetadata, fetchedAt: time.Now()}
+			c.mu.Unlock()
+			return idpMetadata, nil
+		}
+	}
+	if entry != nil {
+		slog.Warn("failed to refresh IdP metadata, using the last fetched one", slog.String("url", metadataURL), slog.String("error", err.Error()))
+		return entry.metadata, nil
+	}
+	return nil, err
+}
+
+// Endpoints are the endpoints of the service provider, which are registered in the IdP.
+type Endpoints struct {
+	// MetadataURL is the URL of the SP metadata, e.g. "https://bytebase.example.com/saml/idps/adfs/metadata".
+	MetadataURL string
+	// ACSURL is the URL of the assertion consumer service, e.g. "https://bytebase.example.com/saml/idps/adfs/acs".
+	ACSURL string
+}
+
+// GetEndpoints returns the SP endpoints of the identity provider under the external URL.
+func GetEndpoints(externalURL, identityProviderID string) Endpoints {
+	prefix := fmt.Sprintf("%s%s/%s", strings.TrimSuffix(externalURL, "/"), EndpointPrefix, identityProviderID)
+	return Endpoints{
+		MetadataURL: prefix + "/metadata",
+		ACSURL:      prefix + "/acs",
+	}
+}
+
+// IdentityProvider represents a SAML 2.0 Identity Provider.
+type IdentityProvider struct {
+	sp     *saml.ServiceProvider
+	config *storepb.SAMLIdentityProviderConfig
+}
+
+// NewIdentityProvider initializes a new SAML Identity Provider with the given
+// configuration. The IdP metadata is fetched if the metadata URL is set, and cached for metadataCacheTTL.
+func NewIdentityProvider(ctx context.Context, config *storepb.SAMLIdentityProviderConfig, endpoints Endpoints) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.Certificate: "certificate",
+		config.PrivateKey:  "privateKey",
+	} {
+		if v == "" {
+			return nil, errors.Errorf("the field %q is empty but required", field)
+		}
+	}
+	if config.MetadataUrl == "" && config.MetadataXml == "" {
+		return nil, errors.New("either metadataUrl or metadataXml is required")
+	}
+
+	certificate, err := ParseCertificate(config.Certificate)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParsePrivateKey(config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	if !key.PublicKey.Equal(certificate.PublicKey) {
+		return nil, errors.New("the private key does not match the certificate")
+	}
+
+	metadataURL, err := url.Parse(endpoints.MetadataURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse metadata URL")
+	}
+	acsURL, err := url.Parse(endpoints.ACSURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse ACS URL")
+	}
+
+	var idpMetadata *saml.EntityDescriptor
+	if config.MetadataUrl != "" {
+		idpMetadata, err = metadataCache.get(ctx, config.MetadataUrl, config.SkipTlsVerify)
+	} else {
+		idpMetadata, err = ParseMetadata([]byte(config.MetadataXml))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityProvider{
+		sp: &saml.ServiceProvider{
+			EntityID:          config.EntityId,
+			Key:               key,
+			Certificate:       certificate,
+			MetadataURL:       *metadataURL,
+			AcsURL:            *acsURL,
+			IDPMetadata:       idpMetadata,
+			AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+			SignatureMethod:   dsig.RSASHA256SignatureMethod,
+		},
+		config: config,
+	}, nil
+}
+
+// Metadata returns the SP metadata XML document.
+func (p *IdentityProvider) Metadata() ([]byte, error) {
+	b, err := xml.MarshalIndent(p.sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal metadata")
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// IDPEntityID returns the entity ID of the IdP in the IdP metadata.
+func (p *IdentityProvider) IDPEntityID() string {
+	return p.sp.IDPMetadata.EntityID
+}
+
+// SSOURL returns the URL of the IdP single sign-on service with the HTTP-Redirect binding.
+func (p *IdentityProvider) SSOURL() string {
+	return p.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+}
+
+// AuthnRequestURL returns the URL of the IdP single sign-on service with a signed
+// AuthnRequest using the HTTP-Redirect binding, and the ID of the request, which
+// should be kept to validate the InResponseTo of the response.
+func (p *IdentityProvider) AuthnRequestURL(relayState string) (*url.URL, string, error) {
+	ssoURL := p.SSOURL()
+	if ssoURL == "" {
+		return nil, "", errors.New("the IdP metadata has no single sign-on service with the HTTP-Redirect binding")
+	}
+	request, err := p.sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "make authentication request")
+	}
+	redirectURL, err := request.Redirect(url.QueryEscape(relayState), p.sp)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "sign authentication request")
+	}
+	return redirectURL, request.ID, nil
+}
+
+// UserInfo validates the base64 encoded SAMLResponse and returns the parsed user information.
+// The response must be in response to one of the request IDs. If no request ID is given,
+// IdP-initiated responses are accepted, which is only used for testing the configuration.
+func (p *IdentityProvider) UserInfo(samlResponse string, requestIDs []string) (*storepb.IdentityProviderUserInfo, map[string]any, error) {
+	decoded, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decode SAMLResponse")
+	}
+	sp := *p.sp
+	sp.AllowIDPInitiated = len(requestIDs) == 0
+	assertion, err := sp.ParseXMLResponse(decoded, requestIDs)
+	if err != nil {
+		var invalidResponseErr *saml.InvalidResponseError
+		if errors.As(err, &invalidResponseErr) {
+			// The private error explains why the response is invalid, which is not in the error message.
+			slog.Debug("invalid SAMLResponse", slog.String("error", invalidResponseErr.PrivateErr.Error()))
+			return nil, nil, errors.Wrap(invalidResponseErr.PrivateErr, "invalid SAMLResponse")
+		}
+		return nil, nil, errors.Wrap(err, "parse SAMLResponse")
+	}
+	claims := GetAssertionClaims(assertion)
+	return GetUserInfo(claims, p.config.FieldMapping), claims, nil
+}
+
+// GetAssertionClaims returns the attributes of the assertion as claims.
+// Attributes with a single value are strings, and those with multiple values are lists.
+// Attributes are keyed by their name, and also by their friendly name if it is set.
+func GetAssertionClaims(assertion *saml.Assertion) map[string]any {
+	claims := make(map[string]any)
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		claims[NameIDClaim] = assertion.Subject.NameID.Value
+	}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			var values []any
+			for _, value := range attribute.Values {
+				if value.NameID != nil {
+					values = append(values, value.NameID.Value)
+					continue
+				}
+				values = append(values, value.Value)
+			}
+			var claim any = values
+			if len(values) == 1 {
+				claim = values[0]
+			}
+			claims[attribute.Name] = claim
+			if attribute.FriendlyName != "" {
+				if _, ok := claims[attribute.FriendlyName]; !ok {
+					claims[attribute.FriendlyName] = claim
+				}
+			}
+		}
+	}
+	return claims
+}
+
+// GetUserInfo maps the claims to the user information with the field mapping.
+// The attribute names are usually URIs containing dots, so the keys are not treated as paths.
+func GetUserInfo(claims map[string]any, fieldMapping *storepb.FieldMapping) *storepb.IdentityProviderUserInfo {
+	userInfo := &storepb.IdentityProviderUserInfo{}
+	identifierKey := fieldMapping.GetIdentifier()
+	if identifierKey == "" {
+		identifierKey = NameIDClaim
+	}
+	userInfo.Identifier = getFirstString(claims[identifierKey])
+	if fieldMapping.GetDisplayName() != "" {
+		userInfo.DisplayName = getFirstString(claims[fieldMapping.GetDisplayName()])
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.GetPhone() != "" {
+		phone := getFirstString(claims[fieldMapping.GetPhone()])
+		// Only set phone if it's valid.
+		if err := common.ValidatePhone(phone); err == nil {
+			userInfo.Phone = phone
+		}
+	}
+	if fieldMapping.GetGroups() != "" {
+		if v, ok := claims[fieldMapping.GetGroups()]; ok {
+			userInfo.HasGroups = true
+			switch groups := v.(type) {
+			case string:
+				userInfo.Groups = append(userInfo.Groups, groups)
+			case []any:
+				for _, group := range groups {
+					if groupStr, ok := group.(string); ok {
+						userInfo.Groups = append(userInfo.Groups, groupStr)
+					}
+				}
+			default:
+			}
+		}
+	}
+	return userInfo
+}
+
+func getFirstString(v any) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case []any:
+		if len(value) > 0 {
+			if s, ok := value[0].(string); ok {
+				return s
+			}
+		}
+	default:
+	}
+	return ""
+}
+
+// ParseMetadata parses the IdP metadata, which is either an EntityDescriptor or
+// an EntitiesDescriptor containing the IdP EntityDescriptor.
+func ParseMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	root, err := getRootElementName(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse IdP metadata")
+	}
+	if root == "EntityDescriptor" {
+		entity := &saml.EntityDescriptor{}
+		if err := xml.Unmarshal(data, entity); err != nil {
+			return nil, errors.Wrap(err, "parse IdP metadata")
+		}
+		if len(entity.IDPSSODescriptors) == 0 {
+			return nil, errors.New("the metadata has no IDPSSODescriptor")
+		}
+		return entity, nil
+	}
+
+	entities := &saml.EntitiesDescriptor{}
+	if err := xml.Unmarshal(data, entities); err != nil {
+		return nil, errors.Wrap(err, "parse IdP metadata")
+	}
+	for i := range entities.EntityDescriptors {
+		if len(entities.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+			return &entities.EntityDescriptors[i], nil
+		}
+	}
+	return nil, errors.New("the metadata has no EntityDescriptor with IDPSSODescriptor")
+}
+
+func getRootElementName(data []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+// ParseCertificate parses the PEM encoded X.509 certificate.
+func ParseCertificate(s string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("the certificate is not a PEM encoded certificate")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse certificate")
+	}
+	return certificate, nil
+}
+
+// ParsePrivateKey parses the PEM encoded RSA private key in PKCS #1 or PKCS #8 form.
+func ParsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("the private key is not a PEM encoded key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse private key")
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only RSA private keys are supported")
+	}
+	return rsaKey, nil
+}
+
+func fetchMetadata(ctx context.Context, metadataURL string, skipTLSVerify bool) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, metadataFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "construct GET request")
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: skipTLSVerify,
+			},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch IdP metadata")
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("received non-200 response code, code: %d, body: %s", resp.StatusCode, string(b))
+	}
+	return b, nil
+}
@@ -0,0 +1,315 @@
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+type testKeyPair struct {
+	key            *rsa.PrivateKey
+	certificate    *x509.Certificate
+	keyPEM         string
+	certificatePEM string
+}
+
+func newTestKeyPair(t *testing.T, commonName string) *testKeyPair {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testKeyPair{
+		key:            key,
+		certificate:    certificate,
+		keyPEM:         string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		certificatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+type testServiceProviderProvider struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (p *testServiceProviderProvider) GetServiceProvider(_ *http.Request, _ string) (*saml.EntityDescriptor, error) {
+	return p.metadata, nil
+}
+
+func newTestIdP(t *testing.T) (*saml.IdentityProvider, *testServiceProviderProvider, string) {
+	keyPair := newTestKeyPair(t, "idp.example.com")
+	spProvider := &testServiceProviderProvider{}
+	idp := &saml.IdentityProvider{
+		Key:                     keyPair.key,
+		Certificate:             keyPair.certificate,
+		MetadataURL:             url.URL{Scheme: "https", Host: "idp.example.com", Path: "/metadata"},
+		SSOURL:                  url.URL{Scheme: "https", Host: "idp.example.com", Path: "/sso"},
+		ServiceProviderProvider: spProvider,
+	}
+	metadata, err := xml.Marshal(idp.Metadata())
+	require.NoError(t, err)
+	return idp, spProvider, string(metadata)
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	keyPair := newTestKeyPair(t, "sp.example.com")
+	otherKeyPair := newTestKeyPair(t, "other.example.com")
+	_, _, idpMetadata := newTestIdP(t)
+	endpoints := Endpoints{
+		MetadataURL: "https://bytebase.example.com/saml/idps/test/metadata",
+		ACSURL:      "https://bytebase.example.com/saml/idps/test/acs",
+	}
+
+	tests := []struct {
+		name        string
+		config      *storepb.SAMLIdentityProviderConfig
+		containsErr string
+	}{
+		{
+			name: "no certificate",
+			config: &storepb.SAMLIdentityProviderConfig{
+				MetadataXml: idpMetadata,
+				PrivateKey:  keyPair.keyPEM,
+			},
+			containsErr: `the field "certificate" is empty but required`,
+		},
+		{
+			name: "no metadata",
+			config: &storepb.SAMLIdentityProviderConfig{
+				Certificate: keyPair.certificatePEM,
+				PrivateKey:  keyPair.keyPEM,
+			},
+			containsErr: "either metadataUrl or metadataXml is required",
+		},
+		{
+			name: "mismatched key",
+			config: &storepb.SAMLIdentityProviderConfig{
+				MetadataXml: idpMetadata,
+				Certificate: keyPair.certificatePEM,
+				PrivateKey:  otherKeyPair.keyPEM,
+			},
+			containsErr: "the private key does not match the certificate",
+		},
+		{
+			name: "invalid metadata",
+			config: &storepb.SAMLIdentityProviderConfig{
+				MetadataXml: `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com"/>`,
+				Certificate: keyPair.certificatePEM,
+				PrivateKey:  keyPair.keyPEM,
+			},
+			containsErr: "IDPSSODescriptor",
+		},
+		{
+			name: "valid",
+			config: &storepb.SAMLIdentityProviderConfig{
+				MetadataXml: idpMetadata,
+				Certificate: keyPair.certificatePEM,
+				PrivateKey:  keyPair.keyPEM,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(ctx, test.config, endpoints)
+			if test.containsErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.containsErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewIdentityProviderMetadataURL(t *testing.T) {
+	keyPair := newTestKeyPair(t, "sp.example.com")
+	_, _, idpMetadata := newTestIdP(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		_, _ = w.Write([]byte(idpMetadata))
+	}))
+	defer server.Close()
+
+	p, err := NewIdentityProvider(context.Background(), &storepb.SAMLIdentityProviderConfig{
+		MetadataUrl: server.URL,
+		Certificate: keyPair.certificatePEM,
+		PrivateKey:  keyPair.keyPEM,
+	}, Endpoints{
+		MetadataURL: "https://bytebase.example.com/saml/idps/test/metadata",
+		ACSURL:      "https://bytebase.example.com/saml/idps/test/acs",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/metadata", p.sp.IDPMetadata.EntityID)
+}
+
+func TestIDPMetadataCache(t *testing.T) {
+	_, _, idpMetadata := newTestIdP(t)
+	var fetches atomic.Int32
+	var unhealthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		if unhealthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(idpMetadata))
+	}))
+	defer server.Close()
+
+	cache := &idpMetadataCache{entries: make(map[idpMetadataCacheKey]*idpMetadataCacheEntry)}
+	metadata, err := cache.get(context.Background(), server.URL, false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/metadata", metadata.EntityID)
+
+	// The metadata is reused within the TTL.
+	_, err = cache.get(context.Background(), server.URL, false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// The last good metadata is kept if the refresh fails.
+	unhealthy.Store(true)
+	cache.entries[idpMetadataCacheKey{url: server.URL}].fetchedAt = time.Now().Add(-metadataCacheTTL)
+	metadata, err = cache.get(context.Background(), server.URL, false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/metadata", metadata.EntityID)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	// Without the last good metadata, the fetch error is returned.
+	_, err = cache.get(context.Background(), server.URL, true)
+	require.Error(t, err)
+}
+
+func TestParseMetadata(t *testing.T) {
+	_, _, idpMetadata := newTestIdP(t)
+
+	entity, err := ParseMetadata([]byte(idpMetadata))
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/metadata", entity.EntityID)
+
+	entities := `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">` + idpMetadata + `</EntitiesDescriptor>`
+	entity, err = ParseMetadata([]byte(entities))
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/metadata", entity.EntityID)
+
+	_, err = ParseMetadata([]byte("not xml"))
+	require.Error(t, err)
+}
+
+func TestGetUserInfo(t *testing.T) {
+	claims := map[string]any{
+		NameIDClaim:                           "alice@example.com",
+		"urn:oid:2.5.4.3":                     "Alice",
+		"urn:oid:2.5.4.20":                    "+1 415 555 0100",
+		"urn:oid:1.3.6.1.4.1.5923.1.1.1.1":    []any{"dba", "developer"},
+		"http://schemas.example.com/claims/x": "ignored",
+	}
+
+	userInfo := GetUserInfo(claims, &storepb.FieldMapping{})
+	assert.Equal(t, "alice@example.com", userInfo.Identifier)
+	assert.Equal(t, "alice@example.com", userInfo.DisplayName)
+	assert.False(t, userInfo.HasGroups)
+
+	userInfo = GetUserInfo(claims, &storepb.FieldMapping{
+		DisplayName: "urn:oid:2.5.4.3",
+		Groups:      "urn:oid:1.3.6.1.4.1.5923.1.1.1.1",
+	})
+	assert.Equal(t, "alice@example.com", userInfo.Identifier)
+	assert.Equal(t, "Alice", userInfo.DisplayName)
+	assert.True(t, userInfo.HasGroups)
+	assert.Equal(t, []string{"dba", "developer"}, userInfo.Groups)
+
+	userInfo = GetUserInfo(map[string]any{"groups": "dba"}, &storepb.FieldMapping{Groups: "groups"})
+	assert.True(t, userInfo.HasGroups)
+	assert.Equal(t, []string{"dba"}, userInfo.Groups)
+}
+
+func TestUserInfo(t *testing.T) {
+	idp, spProvider, idpMetadata := newTestIdP(t)
+	keyPair := newTestKeyPair(t, "sp.example.com")
+	p, err := NewIdentityProvider(context.Background(), &storepb.SAMLIdentityProviderConfig{
+		MetadataXml: idpMetadata,
+		Certificate: keyPair.certificatePEM,
+		PrivateKey:  keyPair.keyPEM,
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  "eduPersonPrincipalName",
+			DisplayName: "cn",
+			Groups:      "eduPersonAffiliation",
+		},
+	}, Endpoints{
+		MetadataURL: "https://bytebase.example.com/saml/idps/test/metadata",
+		ACSURL:      "https://bytebase.example.com/saml/idps/test/acs",
+	})
+	require.NoError(t, err)
+
+	metadata, err := p.Metadata()
+	require.NoError(t, err)
+	spMetadata := &saml.EntityDescriptor{}
+	require.NoError(t, xml.Unmarshal(metadata, spMetadata))
+	spProvider.metadata = spMetadata
+
+	makeResponse := func(redirectURL *url.URL) string {
+		req, err := saml.NewIdpAuthnRequest(idp, httptest.NewRequest(http.MethodGet, redirectURL.String(), nil))
+		require.NoError(t, err)
+		require.NoError(t, req.Validate())
+		require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(req, &saml.Session{
+			ID:             "session",
+			CreateTime:     time.Now(),
+			ExpireTime:     time.Now().Add(time.Hour),
+			Index:          "1",
+			NameID:         "alice",
+			UserEmail:      "alice@example.com",
+			UserCommonName: "Alice",
+			Groups:         []string{"dba", "developer"},
+		}))
+		form, err := req.PostBinding()
+		require.NoError(t, err)
+		assert.Equal(t, "https://bytebase.example.com/saml/idps/test/acs", form.URL)
+		return form.SAMLResponse
+	}
+
+	redirectURL, requestID, err := p.AuthnRequestURL("state")
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.com", redirectURL.Host)
+	assert.NotEmpty(t, redirectURL.Query().Get("Signature"))
+	assert.Equal(t, "state", redirectURL.Query().Get("RelayState"))
+	samlResponse := makeResponse(redirectURL)
+
+	userInfo, claims, err := p.UserInfo(samlResponse, []string{requestID})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", claims[NameIDClaim])
+	assert.Equal(t, "alice@example.com", userInfo.Identifier)
+	assert.Equal(t, "Alice", userInfo.DisplayName)
+	assert.True(t, userInfo.HasGroups)
+	assert.Equal(t, []string{"dba", "developer"}, userInfo.Groups)
+
+	// The response is not in response to the request.
+	_, _, err = p.UserInfo(samlResponse, []string{"id-other"})
+	require.Error(t, err)
+
+	_, _, err = p.UserInfo("not base64", []string{requestID})
+	require.Error(t, err)
+}
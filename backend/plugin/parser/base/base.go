@@ -168,6 +168,10 @@ type ParseResult struct {
 	// and positions to be correctly reported relative to the original script rather than the isolated statement.
 	// Calculated from StartPosition.Line - 1 (since StartPosition uses 1-based line numbers, but BaseLine is 0-based for offset arithmetic).
 	BaseLine int
+	// BaseColumn stores the zero-based character (code point) column where this SQL statement text starts on the BaseLine,
+	// such as 10 for the second statement in "SELECT 1; SELECT 2;".
+	// Only the positions on the first line of the statement need it to be reported relative to the original script.
+	BaseColumn int
 }
 
 // GetLineOffset returns the 0-based line offset from a StartPosition.
@@ -179,3 +183,27 @@ func GetLineOffset(startPosition *storepb.Position) int {
 	}
 	return int(startPosition.Line) - 1
 }
+
+// GetColumnOffset returns the 0-based column offset from a StartPosition.
+// It returns 0 if the StartPosition has no column.
+func GetColumnOffset(startPosition *storepb.Position) int {
+	if startPosition == nil || startPosition.Column < 1 {
+		return 0
+	}
+	return int(startPosition.Column) - 1
+}
+
+// GetBaseColumn returns the zero-based column where the statement text starts on its base line.
+// The start is the 1-based position of the first default channel token of the statement in the original input,
+// and the tokens are lexed from the statement text.
+func GetBaseColumn(start *storepb.Position, tokens []antlr.Token) int {
+	if start == nil || len(tokens) == 0 {
+		return 0
+	}
+	first := FirstDefaultChannelTokenPosition(tokens)
+	if first.Line != 1 {
+		// The first line of the statement text only has hidden tokens, no position is reported on it.
+		return 0
+	}
+	return max(int(start.Column)-1-int(first.Column), 0)
+}
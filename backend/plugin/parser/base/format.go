@@ -0,0 +1,779 @@
+package base
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+)
+
+// KeywordCase is the letter case of the keywords in the formatted SQL.
+type KeywordCase int
+
+const (
+	// KeywordCaseUpper converts the keywords to upper case.
+	KeywordCaseUpper KeywordCase = iota
+	// KeywordCaseLower converts the keywords to lower case.
+	KeywordCaseLower
+	// KeywordCasePreserve keeps the keywords as they are.
+	KeywordCasePreserve
+)
+
+// CommaStyle is the position of the commas in the lists broken into lines.
+type CommaStyle int
+
+const (
+	// CommaStyleTrailing puts the commas at the end of the lines.
+	CommaStyleTrailing CommaStyle = iota
+	// CommaStyleLeading puts the commas at the beginning of the lines.
+	CommaStyleLeading
+)
+
+// defaultIndentSize is the number of spaces per indentation level if not specified.
+const defaultIndentSize = 2
+
+// FormatOptions is the options of formatting SQL.
+type FormatOptions struct {
+	KeywordCase KeywordCase
+	// IndentSize is the number of spaces per indentation level, 2 if not specified.
+	IndentSize int
+	// UseTabs indents with tabs instead of spaces.
+	UseTabs    bool
+	CommaStyle CommaStyle
+}
+
+// NewLexerFunc creates the ANTLR lexer of the engine for the statement.
+type NewLexerFunc func(statement string) antlr.Lexer
+
+// FormatWithLexer formats the statement based on the tokens from the lexer.
+// Only the whitespaces and the letter case of the keywords are changed, the comments are preserved.
+// The statements of routines such as CREATE PROCEDURE are kept as they are.
+func FormatWithLexer(statement string, newLexer NewLexerFunc, options FormatOptions) (string, error) {
+	tokens := tokenizeForFormat(statement, newLexer)
+	formatter := &sqlFormatter{
+		options:   options,
+		statement: []rune(statement),
+		tokens:    tokens,
+		lineStart: true,
+	}
+	formatted := formatter.format()
+	if strings.HasSuffix(statement, "\n") && formatted != "" {
+		formatted += "\n"
+	}
+
+	// Make sure that the formatter doesn't change the semantics of the statement.
+	if !equalFormatTokens(tokens, tokenizeForFormat(formatted, newLexer)) {
+		return "", errors.New("failed to format the statement: the tokens are changed after formatting")
+	}
+	return formatted, nil
+}
+
+type formatToken struct {
+	text string
+	// keyword is the upper case text if the token is a keyword, otherwise empty.
+	keyword string
+	// convertible reports whether the letter case of the keyword can be changed.
+	convertible bool
+	comment     bool
+	lineComment bool
+	// newlines is the number of the line breaks before the token.
+	newlines int
+	// spaced reports whether there are whitespaces or comments before the token.
+	spaced bool
+	// start and stop are the rune indexes of the token in the statement.
+	start, stop int
+}
+
+func (t *formatToken) isSymbol() bool {
+	if t.text == "" {
+		return false
+	}
+	for _, r := range t.text {
+		if !strings.ContainsRune("()[]{},;.+-*/%<>=!~^&|:?@#", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *formatToken) isOperator() bool {
+	return formatOperators[t.text]
+}
+
+func tokenizeForFormat(statement string, newLexer NewLexerFunc) []*formatToken {
+	lexer := newLexer(statement)
+	lexer.RemoveErrorListeners()
+	symbolicNames := lexer.GetSymbolicNames()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+
+	var tokens []*formatToken
+	newlines, spaced := 0, false
+	for _, token := range stream.GetAllTokens() {
+		if token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		text := token.GetText()
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			if strings.TrimSpace(text) == "" {
+				newlines += strings.Count(text, "\n")
+				spaced = true
+				continue
+			}
+			// Some lexers include the trailing line break in the line comments.
+			trimmed := strings.TrimRightFunc(text, unicode.IsSpace)
+			tokens = append(tokens, &formatToken{
+				text:        trimmed,
+				comment:     true,
+				lineComment: strings.HasPrefix(trimmed, "--") || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "//"),
+				newlines:    newlines,
+				spaced:      spaced,
+				start:       token.GetStart(),
+				stop:        token.GetStop(),
+			})
+			newlines, spaced = strings.Count(text[len(trimmed):], "\n"), true
+			continue
+		}
+
+		t := &formatToken{
+			text:     text,
+			newlines: newlines,
+			spaced:   spaced,
+			start:    token.GetStart(),
+			stop:     token.GetStop(),
+		}
+		newlines, spaced = 0, false
+		// Some lexers split the operators such as `>=` into multiple tokens.
+		if len(tokens) > 0 && !t.spaced {
+			last := tokens[len(tokens)-1]
+			if !last.comment && last.isSymbol() && t.isSymbol() && formatOperators[last.text+t.text] {
+				last.text += t.text
+				last.stop = t.stop
+				continue
+			}
+		}
+		if tokenType := token.GetTokenType(); tokenType > 0 && tokenType < len(symbolicNames) {
+			name, upper := symbolicNames[tokenType], strings.ToUpper(text)
+			if isFormatWord(upper) && (name == upper || name == upper+"_SYMBOL" || name == upper+"_P") {
+				t.keyword = upper
+				t.convertible = formatKeywords[upper]
+			}
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
+
+func isFormatWord(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && r != '_' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func equalFormatTokens(a, b []*formatToken) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].comment != b[i].comment {
+			return false
+		}
+		if a[i].convertible && b[i].convertible {
+			if !strings.EqualFold(a[i].text, b[i].text) {
+				return false
+			}
+			continue
+		}
+		if a[i].text != b[i].text {
+			return false
+		}
+	}
+	return true
+}
+
+type formatFrameKind int
+
+const (
+	// formatFrameStatement is the top level of a statement.
+	formatFrameStatement formatFrameKind = iota
+	// formatFrameSubquery is the parentheses of a subquery.
+	formatFrameSubquery
+	// formatFrameBlock is the parentheses of a list broken into lines, e.g. the column definitions in CREATE TABLE.
+	formatFrameBlock
+	// formatFrameInline is the other parentheses, the content is kept in a line.
+	formatFrameInline
+)
+
+type formatFrame struct {
+	kind formatFrameKind
+	// indent is the indentation level of the clauses or the items in the block.
+	indent int
+	// closeIndent is the indentation level of the closing parenthesis.
+	closeIndent int
+	// first is the first keyword in the frame.
+	first string
+	// count is the number of the tokens written in the frame.
+	count int
+	// query reports whether the frame is a query or DML, whose clauses are broken into lines.
+	query bool
+	// clause is the current clause such as WHERE.
+	clause string
+	// clauseBody reports whether the body of the clause is expected to start in a new line.
+	clauseBody bool
+	caseDepth  int
+	between    bool
+	table      bool
+	tableOpen  bool
+}
+
+type sqlFormatter struct {
+	options   FormatOptions
+	statement []rune
+	tokens    []*formatToken
+
+	out []byte
+	// lineIndent is the indentation level of the current line.
+	lineIndent int
+	// pendingBreak reports whether a line break is required before the next token.
+	pendingBreak bool
+	breakIndent  int
+	blankLine    bool
+	pendingComma bool
+	// lineStart reports whether nothing is written in the current line.
+	lineStart bool
+	frames    []*formatFrame
+	// prev is the last written token except comments.
+	prev        *formatToken
+	prevUnary   bool
+	lastComment bool
+}
+
+func (f *sqlFormatter) format() string {
+	for i := 0; i < len(f.tokens); i++ {
+		t := f.tokens[i]
+		if t.comment {
+			f.writeComment(t)
+			continue
+		}
+		if f.lastComment && t.newlines > 0 && !f.pendingBreak {
+			f.requestBreak(f.lineIndent)
+		}
+		if f.isBatchSeparator(i) {
+			f.requestBreak(0)
+			f.write(t)
+			f.endStatement()
+			continue
+		}
+		if len(f.frames) == 0 {
+			f.startStatement(t)
+			if f.isRoutine(i) {
+				end := f.findRoutineEnd(i)
+				f.writeVerbatim(i, end)
+				i = end
+				if f.tokens[end].text == ";" {
+					f.endStatement()
+				}
+				continue
+			}
+		}
+		if t.text == ";" {
+			f.write(t)
+			f.endStatement()
+			continue
+		}
+		f.layout(i)
+	}
+	return strings.TrimSpace(string(f.out))
+}
+
+func (f *sqlFormatter) startStatement(t *formatToken) {
+	if len(f.out) > 0 {
+		f.requestBreak(0)
+		if t.newlines > 1 {
+			f.blankLine = true
+		}
+	}
+	f.frames = []*formatFrame{{kind: formatFrameStatement, first: t.keyword}}
+}
+
+func (f *sqlFormatter) endStatement() {
+	f.frames = nil
+	f.prevUnary = false
+}
+
+func (f *sqlFormatter) top() *formatFrame {
+	return f.frames[len(f.frames)-1]
+}
+
+// layout writes the token in the statement with the line breaks and indentation.
+func (f *sqlFormatter) layout(i int) {
+	t := f.tokens[i]
+	frame := f.top()
+	next := f.nextToken(i)
+	prevKeyword := ""
+	if f.prev != nil {
+		prevKeyword = f.prev.keyword
+	}
+
+	switch t.text {
+	case "(":
+		kind := formatFrameInline
+		switch {
+		case next != nil && (next.keyword == "SELECT" || next.keyword == "WITH"):
+			kind = formatFrameSubquery
+		case frame.kind == formatFrameStatement && frame.table && !frame.tableOpen && (f.prev == nil || f.prev.keyword != "IN"):
+			kind = formatFrameBlock
+			frame.tableOpen = true
+		default:
+		}
+		f.layoutClauseBody(frame, t)
+		if kind == formatFrameBlock && !f.pendingBreak && len(f.out) > 0 {
+			// Always separate the table name and the column definitions.
+			t.spaced = true
+		}
+		f.write(t)
+		frame.count++
+		if kind == formatFrameInline {
+			f.frames = append(f.frames, &formatFrame{kind: kind})
+			return
+		}
+		f.frames = append(f.frames, &formatFrame{kind: kind, indent: f.lineIndent + 1, closeIndent: f.lineIndent, query: kind == formatFrameSubquery})
+		f.requestBreak(f.lineIndent + 1)
+		return
+	case ")":
+		if len(f.frames) > 1 {
+			f.frames = f.frames[:len(f.frames)-1]
+			if frame.kind != formatFrameInline {
+				f.requestBreak(frame.closeIndent)
+			}
+		}
+		f.write(t)
+		return
+	case ",":
+		switch {
+		case frame.kind == formatFrameBlock:
+			f.writeListComma(t, frame.indent)
+		case frame.kind != formatFrameInline && frame.query && frame.caseDepth == 0:
+			f.writeListComma(t, frame.indent+1)
+		default:
+			f.write(t)
+		}
+		frame.count++
+		return
+	default:
+	}
+
+	if frame.kind == formatFrameInline || frame.kind == formatFrameBlock {
+		f.write(t)
+		frame.count++
+		return
+	}
+	if frame.kind == formatFrameStatement && frame.count > 0 && t.keyword == "TABLE" && frame.first == "CREATE" {
+		frame.table = true
+	}
+
+	switch keyword := t.keyword; {
+	case frame.caseDepth > 0:
+		switch keyword {
+		case "CASE":
+			frame.caseDepth++
+		case "END":
+			frame.caseDepth--
+		default:
+		}
+	case keyword == "SELECT" && (frame.count == 0 || frame.query || prevKeyword == "AS" || formatQueryStatements[frame.first]):
+		frame.query = true
+		f.startClause(frame, keyword)
+	case keyword == "WITH" && frame.count == 0:
+		frame.query = true
+		f.startClause(frame, keyword)
+	case frame.count == 0 && formatQueryStatements[keyword]:
+		frame.query = true
+	case !frame.query:
+	case formatClauses[keyword]:
+		if (keyword == "GROUP" || keyword == "ORDER") && (next == nil || next.keyword != "BY") {
+			break
+		}
+		if keyword == "FROM" && (prevKeyword == "DELETE" || prevKeyword == "DISTINCT") {
+			break
+		}
+		f.startClause(frame, keyword)
+	case formatSetOperators[keyword]:
+		f.requestBreak(frame.indent)
+		frame.clause, frame.clauseBody = "", false
+	case f.isJoin(t, next, prevKeyword):
+		f.requestBreak(frame.indent + 1)
+		frame.clause, frame.clauseBody = "JOIN", false
+	case keyword == "AND" || keyword == "OR":
+		if keyword == "AND" && frame.between {
+			frame.between = false
+			break
+		}
+		switch frame.clause {
+		case "WHERE", "HAVING":
+			f.requestBreak(frame.indent + 1)
+		case "JOIN":
+			f.requestBreak(frame.indent + 2)
+		default:
+		}
+	case keyword == "BETWEEN":
+		frame.between = true
+	case keyword == "CASE":
+		frame.caseDepth++
+	default:
+	}
+	if frame.clause != "" && frame.clauseBody && t.keyword != frame.clause {
+		f.layoutClauseBody(frame, t)
+	}
+	f.write(t)
+	frame.count++
+}
+
+// startClause breaks the line before the clause keyword, and the body of the clause starts in a new line.
+func (f *sqlFormatter) startClause(frame *formatFrame, keyword string) {
+	if frame.count > 0 {
+		f.requestBreak(frame.indent)
+	}
+	frame.clause, frame.clauseBody, frame.between = keyword, true, false
+}
+
+// layoutClauseBody breaks the line before the first token of the clause body.
+func (f *sqlFormatter) layoutClauseBody(frame *formatFrame, t *formatToken) {
+	if !frame.clauseBody || frame.kind == formatFrameInline || frame.kind == formatFrameBlock {
+		return
+	}
+	if formatClauseModifiers[t.keyword] || (f.prev != nil && f.prev.keyword == "TOP") {
+		return
+	}
+	frame.clauseBody = false
+	f.requestBreak(frame.indent + 1)
+}
+
+func (f *sqlFormatter) isJoin(t, next *formatToken, prevKeyword string) bool {
+	switch t.keyword {
+	case "LEFT", "RIGHT":
+		return next == nil || next.text != "("
+	case "FULL", "INNER", "CROSS", "NATURAL", "STRAIGHT_JOIN":
+		return true
+	case "OUTER":
+		return next != nil && next.keyword == "APPLY" && prevKeyword != "CROSS"
+	case "JOIN":
+		return !formatJoinModifiers[prevKeyword]
+	default:
+		return false
+	}
+}
+
+func (f *sqlFormatter) writeListComma(t *formatToken, indent int) {
+	if f.options.CommaStyle == CommaStyleLeading {
+		f.requestBreak(indent)
+		f.pendingComma = true
+		f.prev = t
+		f.prevUnary = false
+		return
+	}
+	f.write(t)
+	f.requestBreak(indent)
+}
+
+func (f *sqlFormatter) requestBreak(indent int) {
+	f.pendingBreak = true
+	f.breakIndent = indent
+}
+
+func (f *sqlFormatter) newline(indent int) {
+	for len(f.out) > 0 && (f.out[len(f.out)-1] == ' ' || f.out[len(f.out)-1] == '\t') {
+		f.out = f.out[:len(f.out)-1]
+	}
+	if len(f.out) > 0 {
+		f.out = append(f.out, '\n')
+		if f.blankLine {
+			f.out = append(f.out, '\n')
+		}
+	}
+	f.out = append(f.out, f.indentString(indent)...)
+	f.lineIndent = indent
+	f.pendingBreak, f.blankLine, f.lineStart = false, false, true
+}
+
+func (f *sqlFormatter) indentString(indent int) string {
+	if f.options.UseTabs {
+		return strings.Repeat("\t", indent)
+	}
+	size := f.options.IndentSize
+	if size <= 0 {
+		size = defaultIndentSize
+	}
+	return strings.Repeat(" ", indent*size)
+}
+
+func (f *sqlFormatter) write(t *formatToken) {
+	if f.pendingBreak {
+		f.newline(f.breakIndent)
+	}
+	if f.pendingComma {
+		f.out = append(f.out, ", "...)
+		f.pendingComma = false
+	} else if !f.lineStart && (f.lastComment || f.needSpace(t)) {
+		f.out = append(f.out, ' ')
+	}
+
+	text := t.text
+	if t.convertible {
+		switch f.options.KeywordCase {
+		case KeywordCaseUpper:
+			text = strings.ToUpper(text)
+		case KeywordCaseLower:
+			text = strings.ToLower(text)
+		default:
+		}
+	}
+	f.out = append(f.out, text...)
+	f.lineStart = false
+	f.prevUnary = (t.text == "-" || t.text == "+") && f.isUnaryPosition()
+	f.prev = t
+	f.lastComment = false
+}
+
+func (f *sqlFormatter) writeComment(t *formatToken) {
+	if t.newlines > 0 || len(f.out) == 0 {
+		indent := f.lineIndent
+		if f.pendingBreak {
+			indent = f.breakIndent
+		}
+		if len(f.out) > 0 && t.newlines > 1 {
+			f.blankLine = true
+		}
+		f.newline(indent)
+	} else if !f.lineStart {
+		f.out = append(f.out, ' ')
+	}
+	f.out = append(f.out, t.text...)
+	f.lineStart = false
+	f.lastComment = true
+	if t.lineComment && !f.pendingBreak {
+		f.requestBreak(f.lineIndent)
+	}
+}
+
+// writeVerbatim writes the tokens from start to end as they are in the statement.
+func (f *sqlFormatter) writeVerbatim(start, end int) {
+	if f.pendingBreak {
+		f.newline(f.breakIndent)
+	} else if !f.lineStart {
+		f.out = append(f.out, ' ')
+	}
+	f.out = append(f.out, string(f.statement[f.tokens[start].start:f.tokens[end].stop+1])...)
+	f.lineStart = false
+	f.prev = f.tokens[end]
+	f.lastComment = f.tokens[end].comment
+	if f.tokens[end].lineComment {
+		f.requestBreak(0)
+	}
+}
+
+func (f *sqlFormatter) needSpace(t *formatToken) bool {
+	prev := f.prev
+	switch {
+	case prev == nil:
+		return false
+	case f.prevUnary:
+		return false
+	case t.text == "," || t.text == ";" || t.text == ")" || t.text == "]":
+		return false
+	case t.text == "." || prev.text == "." || prev.text == "(" || prev.text == "[":
+		return false
+	case t.text == "::" || prev.text == "::":
+		return false
+	case prev.text == ",":
+		return true
+	case t.text == "(" || t.text == "[":
+		return prev.isOperator() || t.spaced
+	case prev.isOperator() || t.isOperator():
+		return true
+	default:
+		return t.spaced
+	}
+}
+
+// isUnaryPosition reports whether a sign at the current position is a unary operator.
+func (f *sqlFormatter) isUnaryPosition() bool {
+	prev := f.prev
+	if prev == nil {
+		return true
+	}
+	if prev.keyword != "" {
+		return formatUnaryAfterKeywords[prev.keyword]
+	}
+	return prev.isSymbol() && prev.text != ")" && prev.text != "]"
+}
+
+func (f *sqlFormatter) nextToken(i int) *formatToken {
+	for j := i + 1; j < len(f.tokens); j++ {
+		if !f.tokens[j].comment {
+			return f.tokens[j]
+		}
+	}
+	return nil
+}
+
+func (f *sqlFormatter) nextTokenIndex(i int) int {
+	for j := i + 1; j < len(f.tokens); j++ {
+		if !f.tokens[j].comment {
+			return j
+		}
+	}
+	return -1
+}
+
+// isBatchSeparator reports whether the token is the batch separator such as GO in T-SQL or / in Oracle SQL*Plus.
+func (f *sqlFormatter) isBatchSeparator(i int) bool {
+	t := f.tokens[i]
+	if t.newlines == 0 && i > 0 {
+		return false
+	}
+	next := f.nextToken(i)
+	if next != nil && next.newlines == 0 {
+		return false
+	}
+	return t.keyword == "GO" || t.text == "/"
+}
+
+// isRoutine reports whether the statement starting at i contains procedural code, such as CREATE PROCEDURE.
+func (f *sqlFormatter) isRoutine(i int) bool {
+	t := f.tokens[i]
+	switch t.keyword {
+	case "DECLARE", "DO", "DELIMITER", "IF", "WHILE":
+		return true
+	case "BEGIN":
+		next := f.nextToken(i)
+		return next != nil && next.text != ";" && !formatTransactionKeywords[next.keyword]
+	case "CREATE", "ALTER":
+	default:
+		return false
+	}
+	for j, count := f.nextTokenIndex(i), 0; j >= 0 && count < 10; j, count = f.nextTokenIndex(j), count+1 {
+		t := f.tokens[j]
+		switch t.keyword {
+		case "FUNCTION", "PROCEDURE", "PROC", "TRIGGER", "PACKAGE", "EVENT":
+			return true
+		case "BODY":
+			// CREATE TYPE BODY in Oracle.
+			return true
+		case "TABLE", "VIEW", "INDEX", "AS":
+			return false
+		default:
+		}
+		if t.text == "(" || t.text == ";" {
+			return false
+		}
+	}
+	return false
+}
+
+// findRoutineEnd returns the index of the last token of the routine statement starting at i.
+func (f *sqlFormatter) findRoutineEnd(i int) int {
+	depth := 0
+	end := i
+	for j := i; j >= 0; j = f.nextTokenIndex(j) {
+		if j > i && f.isBatchSeparator(j) {
+			return end
+		}
+		end = j
+		t := f.tokens[j]
+		switch t.keyword {
+		case "BEGIN":
+			if next := f.nextToken(j); next != nil && next.text != ";" && !formatTransactionKeywords[next.keyword] {
+				depth++
+			}
+		case "CASE":
+			depth++
+		case "END":
+			next := f.nextTokenIndex(j)
+			if next >= 0 {
+				switch f.tokens[next].keyword {
+				case "IF", "LOOP", "WHILE", "REPEAT":
+					j, end = next, next
+					continue
+				case "CASE":
+					j, end = next, next
+				default:
+				}
+			}
+			depth--
+		default:
+		}
+		if t.text == ";" && depth <= 0 {
+			return j
+		}
+	}
+	return end
+}
+
+var (
+	// formatOperators are the operators surrounded by spaces.
+	formatOperators = map[string]bool{
+		"=": true, "<": true, ">": true, "<=": true, ">=": true, "<>": true, "!=": true, "<=>": true,
+		"+": true, "-": true, "*": true, "/": true, "%": true, "||": true, "&&": true,
+		"&": true, "|": true, "^": true, "~": true, "<<": true, ">>": true,
+		":=": true, "=>": true, "->": true, "->>": true, "#>": true, "#>>": true, "@>": true, "<@": true,
+		"!~": true, "~*": true, "!~*": true, "!<": true, "!>": true,
+		"+=": true, "-=": true, "*=": true, "/=": true, "%=": true, "&=": true, "|=": true, "^=": true,
+		// The type cast operator is not surrounded by spaces, but the lexers may split it.
+		"::": true,
+	}
+	// formatQueryStatements are the statements whose clauses are broken into lines.
+	formatQueryStatements = map[string]bool{
+		"SELECT": true, "WITH": true, "INSERT": true, "UPDATE": true, "DELETE": true, "REPLACE": true, "MERGE": true, "EXPLAIN": true,
+	}
+	// formatClauses are the clauses starting in new lines, whose bodies start in new lines with one more indentation level.
+	formatClauses = map[string]bool{
+		"FROM": true, "WHERE": true, "GROUP": true, "ORDER": true, "HAVING": true, "LIMIT": true, "OFFSET": true, "FETCH": true,
+		"VALUES": true, "SET": true, "RETURNING": true, "WINDOW": true,
+	}
+	// formatClauseModifiers are the keywords kept in the line of the clause keyword.
+	formatClauseModifiers = map[string]bool{
+		"BY": true, "ALL": true, "DISTINCT": true, "DISTINCTROW": true, "RECURSIVE": true, "TOP": true,
+	}
+	formatSetOperators = map[string]bool{
+		"UNION": true, "INTERSECT": true, "EXCEPT": true, "MINUS": true,
+	}
+	formatJoinModifiers = map[string]bool{
+		"LEFT": true, "RIGHT": true, "FULL": true, "INNER": true, "CROSS": true, "NATURAL": true, "OUTER": true,
+	}
+	formatTransactionKeywords = map[string]bool{
+		"TRAN": true, "TRANSACTION": true, "WORK": true, "DISTRIBUTED": true,
+	}
+	// formatUnaryAfterKeywords are the keywords followed by an operand, so the sign after them is unary.
+	formatUnaryAfterKeywords = map[string]bool{
+		"SELECT": true, "WHERE": true, "AND": true, "OR": true, "NOT": true, "WHEN": true, "THEN": true, "ELSE": true,
+		"RETURN": true, "BY": true, "ON": true, "SET": true, "HAVING": true, "IN": true, "IS": true, "LIKE": true,
+		"BETWEEN": true, "INTERVAL": true, "CASE": true, "DEFAULT": true, "LIMIT": true, "OFFSET": true,
+	}
+	// formatKeywords are the keywords whose letter case is converted.
+	// The non-reserved keywords which are commonly used as identifiers, such as NAME and STATUS, are not included.
+	formatKeywords = map[string]bool{
+		"ADD": true, "ALL": true, "ALTER": true, "AND": true, "ANY": true, "AS": true, "ASC": true, "AUTO_INCREMENT": true,
+		"BEGIN": true, "BETWEEN": true, "BIGINT": true, "BOOLEAN": true, "BY": true,
+		"CASCADE": true, "CASE": true, "CAST": true, "CHAR": true, "CHECK": true, "COALESCE": true, "COLLATE": true, "COLUMN": true,
+		"COMMIT": true, "CONSTRAINT": true, "COUNT": true, "CREATE": true, "CROSS": true,
+		"CURRENT_DATE": true, "CURRENT_TIME": true, "CURRENT_TIMESTAMP": true,
+		"DATABASE": true, "DECIMAL": true, "DECLARE": true, "DEFAULT": true, "DELETE": true, "DESC": true, "DISTINCT": true, "DROP": true,
+		"ELSE": true, "END": true, "EXCEPT": true, "EXISTS": true, "EXPLAIN": true, "FALSE": true, "FETCH": true, "FLOAT": true,
+		"FOR": true, "FOREIGN": true, "FROM": true, "FULL": true, "FUNCTION": true,
+		"GRANT": true, "GROUP": true, "HAVING": true, "IF": true, "IN": true, "INDEX": true, "INNER": true, "INSERT": true,
+		"INT": true, "INTEGER": true, "INTERSECT": true, "INTERVAL": true, "INTO": true, "IS": true,
+		"JOIN": true, "KEY": true, "LEFT": true, "LIKE": true, "LIMIT": true, "MAX": true, "MERGE": true, "MIN": true, "MINUS": true,
+		"NATURAL": true, "NOT": true, "NULL": true, "NUMERIC": true, "OFFSET": true, "ON": true, "OR": true, "ORDER": true, "OUTER": true, "OVER": true,
+		"PARTITION": true, "PRIMARY": true, "PROCEDURE": true, "REFERENCES": true, "RENAME": true, "REPLACE": true,
+		"RETURNING": true, "REVOKE": true, "RIGHT": true, "ROLLBACK": true,
+		"SCHEMA": true, "SELECT": true, "SET": true, "SMALLINT": true, "SUM": true, "TABLE": true, "THEN": true, "TINYINT": true,
+		"TOP": true, "TRIGGER": true, "TRUE": true, "TRUNCATE": true,
+		"UNION": true, "UNIQUE": true, "UPDATE": true, "USING": true, "VALUES": true, "VARCHAR": true, "VARCHAR2": true, "VIEW": true,
+		"WHEN": true, "WHERE": true, "WINDOW": true, "WITH": true,
+	}
+)
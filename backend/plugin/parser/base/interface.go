@@ -30,6 +30,9 @@ var (
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
 	parsers                 = make(map[storepb.Engine]ParseFunc)
 	statementTypeGetters    = make(map[storepb.Engine]GetStatementTypesFunc)
+	formatters              = make(map[storepb.Engine]FormatFunc)
+	rowFilterAppliers       = make(map[storepb.Engine]ApplyRowFiltersFunc)
+	calledFunctionGetters   = make(map[storepb.Engine]GetCalledFunctionsFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, bool, error)
@@ -56,6 +59,15 @@ type ParseFunc func(statement string) ([]AST, error)
 // Statement types include: INSERT, UPDATE, DELETE (DML), CREATE_TABLE, ALTER_TABLE, DROP_TABLE, etc. (DDL).
 type GetStatementTypesFunc func([]AST) ([]string, error)
 
+// FormatFunc is the interface for formatting SQL statements.
+type FormatFunc func(statement string, options FormatOptions) (string, error)
+
+// ApplyRowFiltersFunc is the interface for applying row filters to a query.
+type ApplyRowFiltersFunc func(statement, database, schema string, filters []RowFilter, ignoreCaseSensitive bool) (*RowFilterResult, error)
+
+// GetCalledFunctionsFunc is the interface for getting the names of the functions called in the statements.
+type GetCalledFunctionsFunc func(statement string) ([]string, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -278,6 +290,76 @@ func Parse(engine storepb.Engine, statement string) ([]AST, error) {
 	return f(statement)
 }
 
+// RegisterFormatFunc registers the format function for the engine.
+func RegisterFormatFunc(engine storepb.Engine, f FormatFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := formatters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	formatters[engine] = f
+}
+
+// Format formats the SQL statements. It returns an error if the statements have syntax errors.
+func Format(engine storepb.Engine, statement string, options FormatOptions) (string, error) {
+	f, ok := formatters[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, options)
+}
+
+// IsFormatSupported returns whether formatting is supported for the engine.
+func IsFormatSupported(engine storepb.Engine) bool {
+	_, ok := formatters[engine]
+	return ok
+}
+
+// RegisterApplyRowFiltersFunc registers the function applying row filters for the engine.
+func RegisterApplyRowFiltersFunc(engine storepb.Engine, f ApplyRowFiltersFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterAppliers[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterAppliers[engine] = f
+}
+
+// ApplyRowFilters rewrites the query so that it only reads the rows of the filtered tables satisfying the predicates.
+// It returns an error if the query cannot be rewritten safely.
+func ApplyRowFilters(engine storepb.Engine, statement, database, schema string, filters []RowFilter, ignoreCaseSensitive bool) (*RowFilterResult, error) {
+	f, ok := rowFilterAppliers[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, database, schema, filters, ignoreCaseSensitive)
+}
+
+// IsRowFilterSupported returns whether applying row filters is supported for the engine.
+func IsRowFilterSupported(engine storepb.Engine) bool {
+	_, ok := rowFilterAppliers[engine]
+	return ok
+}
+
+// RegisterGetCalledFunctionsFunc registers the function getting the called functions for the engine.
+func RegisterGetCalledFunctionsFunc(engine storepb.Engine, f GetCalledFunctionsFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := calledFunctionGetters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	calledFunctionGetters[engine] = f
+}
+
+// GetCalledFunctions returns the unqualified names of the functions called in the statements, without duplicates.
+func GetCalledFunctions(engine storepb.Engine, statement string) ([]string, error) {
+	f, ok := calledFunctionGetters[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement)
+}
+
 func RegisterGetStatementTypes(engine storepb.Engine, f GetStatementTypesFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -0,0 +1,39 @@
+package base
+
+import (
+	"strings"
+)
+
+// RowFilter is the predicate that the rows read from a table must satisfy.
+type RowFilter struct {
+	// Table is the filtered table. The Column is empty.
+	Table ColumnResource
+	// Predicate is the SQL boolean expression on the columns of the table.
+	Predicate string
+}
+
+// RowFilterResult is the result of applying row filters to a query.
+type RowFilterResult struct {
+	// Statement is the query in which the references to the filtered tables are replaced by the filtered subqueries.
+	Statement string
+	// ReferencedTables are the tables referenced by name in the query, including the names of CTEs. The Column is empty.
+	ReferencedTables []ColumnResource
+}
+
+// FindRowFilter returns the filter of the table, or nil if the table is not filtered.
+func FindRowFilter(filters []RowFilter, table ColumnResource, ignoreCaseSensitive bool) *RowFilter {
+	for i, filter := range filters {
+		if IsSameTable(filter.Table, table, ignoreCaseSensitive) {
+			return &filters[i]
+		}
+	}
+	return nil
+}
+
+// IsSameTable returns whether the two resources refer to the same table.
+func IsSameTable(a, b ColumnResource, ignoreCaseSensitive bool) bool {
+	if ignoreCaseSensitive {
+		return strings.EqualFold(a.Database, b.Database) && strings.EqualFold(a.Schema, b.Schema) && strings.EqualFold(a.Table, b.Table)
+	}
+	return a.Database == b.Database && a.Schema == b.Schema && a.Table == b.Table
+}
@@ -0,0 +1,35 @@
+package mysql
+
+import (
+	"regexp"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/parser/mysql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+var delimiterRegexp = regexp.MustCompile(`(?im)^\s*DELIMITER\s`)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_MYSQL, Format)
+	base.RegisterFormatFunc(storepb.Engine_MARIADB, Format)
+	base.RegisterFormatFunc(storepb.Engine_OCEANBASE, Format)
+}
+
+// Format formats the MySQL statements.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	// The DELIMITER command is line based, so the statements with custom delimiters are not formatted.
+	if delimiterRegexp.MatchString(statement) {
+		return "", errors.New("formatting statements with DELIMITER is not supported")
+	}
+	if _, err := ParseMySQL(statement); err != nil {
+		return "", err
+	}
+	return base.FormatWithLexer(statement, func(statement string) antlr.Lexer {
+		return parser.NewMySQLLexer(antlr.NewInputStream(statement))
+	}, options)
+}
@@ -0,0 +1,52 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		statement string
+		options   base.FormatOptions
+		want      string
+	}{
+		{
+			statement: "-- header\nselect a, b as c, count(*) from t1 left join t2 on t1.id=t2.id where a between 1 and 2 and b in (select id from u where x = -1) order by b desc limit 10;\n",
+			want:      "-- header\nSELECT\n  a,\n  b AS c,\n  COUNT(*)\nFROM\n  t1\n  LEFT JOIN t2 ON t1.id = t2.id\nWHERE\n  a BETWEEN 1 AND 2\n  AND b IN (\n    SELECT\n      id\n    FROM\n      u\n    WHERE\n      x = -1\n  )\nORDER BY\n  b DESC\nLIMIT\n  10;\n",
+		},
+		{
+			statement: "insert into t(a,b) values (1,2),(3,4);\n\n\nupdate t set a=1, b=2 where id=1; -- trailing",
+			want:      "INSERT INTO t(a, b)\nVALUES\n  (1, 2),\n  (3, 4);\n\nUPDATE t\nSET\n  a = 1,\n  b = 2\nWHERE\n  id = 1; -- trailing",
+		},
+		{
+			statement: "create table t (id int not null auto_increment, /* the name */ name varchar(10) default 'x', primary key (id))",
+			want:      "CREATE TABLE t (\n  id INT NOT NULL AUTO_INCREMENT, /* the name */\n  name VARCHAR(10) DEFAULT 'x',\n  PRIMARY KEY (id)\n)",
+		},
+		{
+			statement: "SELECT a, b FROM t WHERE x = 1",
+			options:   base.FormatOptions{KeywordCase: base.KeywordCaseLower, CommaStyle: base.CommaStyleLeading, IndentSize: 4},
+			want:      "select\n    a\n    , b\nfrom\n    t\nwhere\n    x = 1",
+		},
+		{
+			statement: "CREATE PROCEDURE p() BEGIN select 1; select 2; END;\nselect 1",
+			options:   base.FormatOptions{UseTabs: true},
+			want:      "CREATE PROCEDURE p() BEGIN select 1; select 2; END;\nSELECT\n\t1",
+		},
+	}
+	for _, tc := range testCases {
+		got, err := Format(tc.statement, tc.options)
+		require.NoError(t, err, tc.statement)
+		require.Equal(t, tc.want, got, tc.statement)
+		// Formatting is idempotent.
+		again, err := Format(got, tc.options)
+		require.NoError(t, err)
+		require.Equal(t, got, again)
+	}
+
+	_, err := Format("select from where", base.FormatOptions{})
+	require.Error(t, err)
+}
@@ -40,7 +40,7 @@ func toAST(results []*base.ParseResult) []base.AST {
 	var asts []base.AST
 	for _, r := range results {
 		asts = append(asts, &base.ANTLRAST{
-			StartPosition: &storepb.Position{Line: int32(r.BaseLine) + 1},
+			StartPosition: &storepb.Position{Line: int32(r.BaseLine) + 1, Column: int32(r.BaseColumn) + 1},
 			Tree:          r.Tree,
 			Tokens:        r.Tokens,
 		})
@@ -264,9 +264,10 @@ func parseInputStream(input *antlr.InputStream, statement string) ([]*base.Parse
 		}
 
 		result = append(result, &base.ParseResult{
-			Tree:     tree,
-			Tokens:   tokens,
-			BaseLine: s.BaseLine,
+			Tree:       tree,
+			Tokens:     tokens,
+			BaseLine:   s.BaseLine,
+			BaseColumn: base.GetBaseColumn(s.Start, tokens.GetAllTokens()),
 		})
 		// s.End.Line is 1-based, but baseLine should be 0-based
 		baseLine = int(s.End.Line) - 1
@@ -0,0 +1,153 @@
+package mysql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/parser/mysql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterApplyRowFiltersFunc(storepb.Engine_MYSQL, ApplyRowFilters)
+	base.RegisterGetCalledFunctionsFunc(storepb.Engine_MYSQL, GetCalledFunctions)
+}
+
+// GetCalledFunctions returns the lower-cased unqualified names of the functions called in the statements.
+// The built-in functions with special syntax, e.g. CAST, are not included.
+func GetCalledFunctions(statement string) ([]string, error) {
+	parseResults, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	l := &calledFunctionListener{}
+	for _, parseResult := range parseResults {
+		antlr.ParseTreeWalkerDefault.Walk(l, parseResult.Tree)
+	}
+	return l.functions, nil
+}
+
+type calledFunctionListener struct {
+	*parser.BaseMySQLParserListener
+
+	functions []string
+}
+
+func (l *calledFunctionListener) EnterFunctionCall(ctx *parser.FunctionCallContext) {
+	var name string
+	if pureIdentifier := ctx.PureIdentifier(); pureIdentifier != nil {
+		name = NormalizeMySQLPureIdentifier(pureIdentifier)
+	} else if qualifiedIdentifier := ctx.QualifiedIdentifier(); qualifiedIdentifier != nil {
+		name = NormalizeMySQLIdentifier(qualifiedIdentifier.Identifier())
+		if dotIdentifier := qualifiedIdentifier.DotIdentifier(); dotIdentifier != nil {
+			name = NormalizeMySQLIdentifier(dotIdentifier.Identifier())
+		}
+	}
+	// The function names are case-insensitive in MySQL.
+	if name = strings.ToLower(name); name != "" && !slices.Contains(l.functions, name) {
+		l.functions = append(l.functions, name)
+	}
+}
+
+// ApplyRowFilters replaces the filtered tables in the FROM clauses of the SELECT statement with the derived tables
+// reading the rows satisfying the predicates, e.g. "FROM t" becomes "FROM (SELECT * FROM t WHERE (pred)) AS `t`".
+// The partition selection and the index hints of the table are moved into the derived table.
+func ApplyRowFilters(statement, database, _ string, filters []base.RowFilter, ignoreCaseSensitive bool) (*base.RowFilterResult, error) {
+	parseResults, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(parseResults) != 1 {
+		return nil, errors.Errorf("expected exactly 1 statement, got %d", len(parseResults))
+	}
+
+	l := &rowFilterListener{
+		database:            database,
+		filters:             filters,
+		ignoreCaseSensitive: ignoreCaseSensitive,
+		tokens:              parseResults[0].Tokens,
+		rewriter:            antlr.NewTokenStreamRewriter(parseResults[0].Tokens),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(l, parseResults[0].Tree)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return &base.RowFilterResult{
+		Statement:        l.rewriter.GetTextDefault(),
+		ReferencedTables: l.referencedTables,
+	}, nil
+}
+
+type rowFilterListener struct {
+	*parser.BaseMySQLParserListener
+
+	database            string
+	filters             []base.RowFilter
+	ignoreCaseSensitive bool
+	tokens              *antlr.CommonTokenStream
+	rewriter            *antlr.TokenStreamRewriter
+
+	isSelect         bool
+	referencedTables []base.ColumnResource
+	err              error
+}
+
+func (l *rowFilterListener) EnterSimpleStatement(ctx *parser.SimpleStatementContext) {
+	l.isSelect = ctx.SelectStatement() != nil
+}
+
+func (l *rowFilterListener) EnterTableRef(ctx *parser.TableRefContext) {
+	if l.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx)
+	if database == "" {
+		database = l.database
+	}
+	resource := base.ColumnResource{
+		Database: database,
+		Table:    table,
+	}
+	l.referencedTables = append(l.referencedTables, resource)
+
+	filter := base.FindRowFilter(l.filters, resource, l.ignoreCaseSensitive)
+	if filter == nil {
+		return
+	}
+	singleTable, ok := ctx.GetParent().(*parser.SingleTableContext)
+	if !ok || !l.isSelect {
+		l.err = errors.Errorf("cannot apply the row filter of table %q outside of the FROM clause of SELECT statements", resource.String())
+		return
+	}
+
+	source := []string{l.getText(ctx)}
+	if usePartition := singleTable.UsePartition(); usePartition != nil {
+		source = append(source, l.getText(usePartition))
+	}
+	if indexHintList := singleTable.IndexHintList(); indexHintList != nil {
+		source = append(source, l.getText(indexHintList))
+	}
+	alias := fmt.Sprintf("AS %s", quoteMySQLIdentifier(table))
+	if tableAlias := singleTable.TableAlias(); tableAlias != nil {
+		alias = l.getText(tableAlias)
+	}
+	l.rewriter.ReplaceDefault(
+		singleTable.GetStart().GetTokenIndex(),
+		singleTable.GetStop().GetTokenIndex(),
+		fmt.Sprintf("(SELECT * FROM %s WHERE (%s)) %s", strings.Join(source, " "), filter.Predicate, alias),
+	)
+}
+
+func (l *rowFilterListener) getText(ctx antlr.ParserRuleContext) string {
+	return l.tokens.GetTextFromTokens(ctx.GetStart(), ctx.GetStop())
+}
+
+func quoteMySQLIdentifier(s string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(s, "`", "``"))
+}
@@ -0,0 +1,67 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestApplyRowFilters(t *testing.T) {
+	filters := []base.RowFilter{
+		{
+			Table:     base.ColumnResource{Database: "db", Table: "orders"},
+			Predicate: "region = 'EMEA'",
+		},
+		{
+			Table:     base.ColumnResource{Database: "hr", Table: "employees"},
+			Predicate: "dept_id = 1",
+		},
+	}
+	testCases := []struct {
+		statement string
+		want      string
+		wantErr   bool
+	}{
+		{
+			statement: "SELECT * FROM orders WHERE id = 1",
+			want:      "SELECT * FROM (SELECT * FROM orders WHERE (region = 'EMEA')) AS `orders` WHERE id = 1;",
+		},
+		{
+			statement: "select o.id, e.name from `ORDERS` partition (p0) o use index (idx) join hr.employees as e on o.user_id = e.id;",
+			want:      "select o.id, e.name from (SELECT * FROM `ORDERS` partition (p0) use index (idx) WHERE (region = 'EMEA')) o join (SELECT * FROM hr.employees WHERE (dept_id = 1)) as e on o.user_id = e.id;",
+		},
+		{
+			statement: "SELECT * FROM items WHERE order_id IN (SELECT id FROM (orders))",
+			want:      "SELECT * FROM items WHERE order_id IN (SELECT id FROM ((SELECT * FROM orders WHERE (region = 'EMEA')) AS `orders`));",
+		},
+		{
+			// The table of other database is not filtered.
+			statement: "SELECT * FROM sales.orders",
+			want:      "SELECT * FROM sales.orders;",
+		},
+		{
+			statement: "TABLE orders",
+			wantErr:   true,
+		},
+		{
+			statement: "DELETE FROM orders",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT 1; SELECT * FROM orders",
+			wantErr:   true,
+		},
+	}
+	// The statement is terminated by a semicolon after parsing.
+	for _, tc := range testCases {
+		result, err := ApplyRowFilters(tc.statement, "db", "", filters, true)
+		if tc.wantErr {
+			require.Error(t, err, tc.statement)
+			continue
+		}
+		require.NoError(t, err, tc.statement)
+		require.Equal(t, tc.want, result.Statement, tc.statement)
+	}
+}
@@ -0,0 +1,24 @@
+package pg
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/parser/postgresql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_POSTGRES, Format)
+}
+
+// Format formats the PostgreSQL statements.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	if _, err := ParsePostgreSQL(statement); err != nil {
+		return "", err
+	}
+	return base.FormatWithLexer(statement, func(statement string) antlr.Lexer {
+		return parser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	}, options)
+}
@@ -0,0 +1,30 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		statement string
+		want      string
+	}{
+		{
+			statement: "with x as (select 1 as a) select a::text, case when a>1 then 'y' else 'n' end from x union all select 2, 'z';",
+			want:      "WITH\n  x AS (\n    SELECT\n      1 AS a\n  )\nSELECT\n  a::text,\n  CASE WHEN a > 1 THEN 'y' ELSE 'n' END\nFROM\n  x\nUNION ALL\nSELECT\n  2,\n  'z';",
+		},
+		{
+			statement: "create function f() returns int as $$ select 1; $$ language sql;\nselect * from t where a is not distinct from b /* c */ and x->>'a' = 'b'",
+			want:      "create function f() returns int as $$ select 1; $$ language sql;\nSELECT\n  *\nFROM\n  t\nWHERE\n  a IS NOT DISTINCT FROM b /* c */\n  AND x ->> 'a' = 'b'",
+		},
+	}
+	for _, tc := range testCases {
+		got, err := Format(tc.statement, base.FormatOptions{})
+		require.NoError(t, err, tc.statement)
+		require.Equal(t, tc.want, got, tc.statement)
+	}
+}
@@ -30,7 +30,7 @@ func toAST(results []*base.ParseResult) []base.AST {
 	var asts []base.AST
 	for _, r := range results {
 		asts = append(asts, &base.ANTLRAST{
-			StartPosition: &storepb.Position{Line: int32(r.BaseLine) + 1},
+			StartPosition: &storepb.Position{Line: int32(r.BaseLine) + 1, Column: int32(r.BaseColumn) + 1},
 			Tree:          r.Tree,
 			Tokens:        r.Tokens,
 		})
@@ -56,6 +56,7 @@ func ParsePostgreSQL(sql string) ([]*base.ParseResult, error) {
 		if err != nil {
 			return nil, err
 		}
+		parseResult.BaseColumn = base.GetBaseColumn(stmt.Start, parseResult.Tokens.GetAllTokens())
 		results = append(results, parseResult)
 	}
 
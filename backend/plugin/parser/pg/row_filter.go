@@ -0,0 +1,162 @@
+package pg
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/parser/postgresql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterApplyRowFiltersFunc(storepb.Engine_POSTGRES, ApplyRowFilters)
+	base.RegisterGetCalledFunctionsFunc(storepb.Engine_POSTGRES, GetCalledFunctions)
+}
+
+// GetCalledFunctions returns the unqualified names of the functions called in the statements,
+// including the table functions in the FROM clauses.
+func GetCalledFunctions(statement string) ([]string, error) {
+	parseResults, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	l := &calledFunctionListener{}
+	for _, parseResult := range parseResults {
+		antlr.ParseTreeWalkerDefault.Walk(l, parseResult.Tree)
+	}
+	return l.functions, nil
+}
+
+type calledFunctionListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	functions []string
+}
+
+func (l *calledFunctionListener) EnterFunc_application(ctx *parser.Func_applicationContext) {
+	names := NormalizePostgreSQLFuncName(ctx.Func_name())
+	if len(names) == 0 {
+		return
+	}
+	if name := names[len(names)-1]; !slices.Contains(l.functions, name) {
+		l.functions = append(l.functions, name)
+	}
+}
+
+// ApplyRowFilters replaces the filtered tables in the FROM clauses of the SELECT statement with the subqueries
+// reading the rows satisfying the predicates, e.g. `FROM t` becomes `FROM (SELECT * FROM t WHERE (pred)) AS "t"`.
+func ApplyRowFilters(statement, database, schema string, filters []base.RowFilter, ignoreCaseSensitive bool) (*base.RowFilterResult, error) {
+	parseResults, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(parseResults) != 1 {
+		return nil, errors.Errorf("expected exactly 1 statement, got %d", len(parseResults))
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	l := &rowFilterListener{
+		database:            database,
+		schema:              schema,
+		filters:             filters,
+		ignoreCaseSensitive: ignoreCaseSensitive,
+		tokens:              parseResults[0].Tokens,
+		rewriter:            antlr.NewTokenStreamRewriter(parseResults[0].Tokens),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(l, parseResults[0].Tree)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return &base.RowFilterResult{
+		Statement:        l.rewriter.GetTextDefault(),
+		ReferencedTables: l.referencedTables,
+	}, nil
+}
+
+type rowFilterListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	database            string
+	schema              string
+	filters             []base.RowFilter
+	ignoreCaseSensitive bool
+	tokens              *antlr.CommonTokenStream
+	rewriter            *antlr.TokenStreamRewriter
+
+	isSelect         bool
+	referencedTables []base.ColumnResource
+	err              error
+}
+
+func (l *rowFilterListener) EnterStmt(ctx *parser.StmtContext) {
+	if _, ok := ctx.GetParent().(*parser.StmtmultiContext); ok {
+		l.isSelect = ctx.Selectstmt() != nil
+	}
+}
+
+func (l *rowFilterListener) EnterQualified_name(ctx *parser.Qualified_nameContext) {
+	if l.err != nil {
+		return
+	}
+	table := base.ColumnResource{
+		Database: l.database,
+	}
+	names := NormalizePostgreSQLQualifiedName(ctx)
+	switch len(names) {
+	case 1:
+		table.Table = names[0]
+	case 2:
+		table.Schema, table.Table = names[0], names[1]
+	case 3:
+		table.Database, table.Schema, table.Table = names[0], names[1], names[2]
+	default:
+		l.err = errors.Errorf("improper qualified name (too many dotted names): %s", ctx.GetText())
+		return
+	}
+	if table.Schema == "" {
+		table.Schema = l.schema
+		if isSystemResource(base.ColumnResource{Table: table.Table}) {
+			table.Schema = "pg_catalog"
+		}
+	}
+	l.referencedTables = append(l.referencedTables, table)
+
+	filter := base.FindRowFilter(l.filters, table, l.ignoreCaseSensitive)
+	if filter == nil {
+		return
+	}
+	var tableRef *parser.Table_refContext
+	if relationExpr, ok := ctx.GetParent().(*parser.Relation_exprContext); ok {
+		tableRef, _ = relationExpr.GetParent().(*parser.Table_refContext)
+	}
+	if tableRef == nil || !l.isSelect {
+		l.err = errors.Errorf("cannot apply the row filter of table %q outside of the FROM clause of SELECT statements", table.String())
+		return
+	}
+	if tableRef.Tablesample_clause() != nil {
+		l.err = errors.Errorf("cannot apply the row filter of table %q with TABLESAMPLE", table.String())
+		return
+	}
+
+	relationExpr := tableRef.Relation_expr()
+	start, stop := relationExpr.GetStart().GetTokenIndex(), relationExpr.GetStop().GetTokenIndex()
+	source := l.tokens.GetTextFromInterval(antlr.NewInterval(start, stop))
+	alias := fmt.Sprintf("AS %s", quotePostgreSQLIdentifier(table.Table))
+	if aliasClause := tableRef.Opt_alias_clause(); aliasClause != nil {
+		alias = l.tokens.GetTextFromTokens(aliasClause.GetStart(), aliasClause.GetStop())
+		stop = aliasClause.GetStop().GetTokenIndex()
+	}
+	l.rewriter.ReplaceDefault(start, stop, fmt.Sprintf("(SELECT * FROM %s WHERE (%s)) %s", source, filter.Predicate, alias))
+}
+
+func quotePostgreSQLIdentifier(s string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `""`))
+}
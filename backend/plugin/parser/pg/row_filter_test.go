@@ -0,0 +1,80 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestApplyRowFilters(t *testing.T) {
+	filters := []base.RowFilter{
+		{
+			Table:     base.ColumnResource{Database: "db", Schema: "public", Table: "orders"},
+			Predicate: "region = 'EMEA'",
+		},
+		{
+			Table:     base.ColumnResource{Database: "db", Schema: "sales", Table: "Users"},
+			Predicate: "active",
+		},
+	}
+	testCases := []struct {
+		statement string
+		want      string
+		wantErr   bool
+	}{
+		{
+			statement: "SELECT * FROM orders WHERE id = 1",
+			want:      `SELECT * FROM (SELECT * FROM orders WHERE (region = 'EMEA')) AS "orders" WHERE id = 1`,
+		},
+		{
+			statement: "select o.id from public.orders o join sales.\"Users\" as u on o.user_id = u.id;",
+			want:      `select o.id from (SELECT * FROM public.orders WHERE (region = 'EMEA')) o join (SELECT * FROM sales."Users" WHERE (active)) as u on o.user_id = u.id;`,
+		},
+		{
+			statement: "WITH x AS (SELECT * FROM ONLY orders) SELECT * FROM x, items WHERE x.id IN (SELECT order_id FROM orders)",
+			want:      `WITH x AS (SELECT * FROM (SELECT * FROM ONLY orders WHERE (region = 'EMEA')) AS "orders") SELECT * FROM x, items WHERE x.id IN (SELECT order_id FROM (SELECT * FROM orders WHERE (region = 'EMEA')) AS "orders")`,
+		},
+		{
+			// The table of other schema is not filtered.
+			statement: "SELECT * FROM sales.orders",
+			want:      "SELECT * FROM sales.orders",
+		},
+		{
+			statement: "SELECT * FROM orders TABLESAMPLE SYSTEM (10)",
+			wantErr:   true,
+		},
+		{
+			statement: "TABLE orders",
+			wantErr:   true,
+		},
+		{
+			statement: "DELETE FROM orders",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT 1; SELECT * FROM orders",
+			wantErr:   true,
+		},
+	}
+	for _, tc := range testCases {
+		result, err := ApplyRowFilters(tc.statement, "db", "", filters, false)
+		if tc.wantErr {
+			require.Error(t, err, tc.statement)
+			continue
+		}
+		require.NoError(t, err, tc.statement)
+		require.Equal(t, tc.want, result.Statement, tc.statement)
+	}
+}
+
+func TestApplyRowFiltersReferencedTables(t *testing.T) {
+	result, err := ApplyRowFilters("WITH x AS (SELECT 1) SELECT * FROM x, s.t, pg_class", "db", "", nil, false)
+	require.NoError(t, err)
+	require.Equal(t, []base.ColumnResource{
+		{Database: "db", Schema: "public", Table: "x"},
+		{Database: "db", Schema: "s", Table: "t"},
+		{Database: "db", Schema: "pg_catalog", Table: "pg_class"},
+	}, result.ReferencedTables)
+}
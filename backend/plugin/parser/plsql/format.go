@@ -0,0 +1,24 @@
+package plsql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/parser/plsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_ORACLE, Format)
+}
+
+// Format formats the Oracle PL/SQL statements.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	if _, err := ParsePLSQL(statement); err != nil {
+		return "", err
+	}
+	return base.FormatWithLexer(statement, func(statement string) antlr.Lexer {
+		return parser.NewPlSqlLexer(antlr.NewInputStream(statement))
+	}, options)
+}
@@ -0,0 +1,17 @@
+package plsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	statement := "select a, b from dual where a = :b and rownum <= 10;\nselect a || b from t"
+	want := "SELECT\n  a,\n  b\nFROM\n  dual\nWHERE\n  a = :b\n  AND rownum <= 10;\nSELECT\n  a || b\nFROM\n  t"
+	got, err := Format(statement, base.FormatOptions{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,350 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/snowflake"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	// maxTableNameLength is the maximum length of a Snowflake identifier.
+	maxTableNameLength = 255
+	// defaultSchema is the schema used when the statement does not specify one.
+	// The backup tables are also created in this schema of the backup database.
+	defaultSchema = "PUBLIC"
+)
+
+func init() {
+	base.RegisterTransformDMLToSelect(storepb.Engine_SNOWFLAKE, TransformDMLToSelect)
+}
+
+type StatementType int
+
+const (
+	StatementTypeUnknown StatementType = iota
+	StatementTypeUpdate
+	StatementTypeDelete
+)
+
+type TableReference struct {
+	Database      string
+	Schema        string
+	Table         string
+	StatementType StatementType
+}
+
+type statementInfo struct {
+	offset    int
+	statement string
+	tree      antlr.ParserRuleContext
+	table     *TableReference
+	// alias is the alias of the target table, e.g. X in "UPDATE T1 AS X SET ...".
+	alias         string
+	startPosition *storepb.Position
+	endPosition   *storepb.Position
+}
+
+// TransformDMLToSelect transforms the UPDATE and DELETE statements to CREATE TABLE AS SELECT statements
+// which back up the affected rows into the backup database.
+func TransformDMLToSelect(_ context.Context, _ base.TransformContext, statement string, sourceDatabase string, targetDatabase string, tablePrefix string) ([]base.BackupStatement, error) {
+	statementInfoList, err := prepareTransformation(sourceDatabase, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare transformation")
+	}
+
+	return generateSQL(statementInfoList, targetDatabase, tablePrefix)
+}
+
+func generateSQL(statementInfoList []statementInfo, targetDatabase string, tablePrefix string) ([]base.BackupStatement, error) {
+	groupByTable := make(map[string][]statementInfo)
+	for _, item := range statementInfoList {
+		key := fmt.Sprintf("%s.%s", item.table.Schema, item.table.Table)
+		groupByTable[key] = append(groupByTable[key], item)
+	}
+
+	// Check if the statement type is the same for all statements in the group.
+	for key, list := range groupByTable {
+		statementType := StatementTypeUnknown
+		for _, item := range list {
+			if statementType == StatementTypeUnknown {
+				statementType = item.table.StatementType
+			}
+			if statementType != item.table.StatementType {
+				return nil, errors.Errorf("prior backup cannot handle statements with different types on the same table: %s", key)
+			}
+		}
+	}
+
+	var result []base.BackupStatement
+	for key, list := range groupByTable {
+		backupStatement, err := generateSQLForTable(list, targetDatabase, tablePrefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate SQL for table: %s", key)
+		}
+		result = append(result, *backupStatement)
+	}
+
+	slices.SortFunc(result, func(i, j base.BackupStatement) int {
+		if i.StartPosition.Line != j.StartPosition.Line {
+			if i.StartPosition.Line < j.StartPosition.Line {
+				return -1
+			}
+			return 1
+		}
+		if i.StartPosition.Column != j.StartPosition.Column {
+			if i.StartPosition.Column < j.StartPosition.Column {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(i.SourceTableName, j.SourceTableName)
+	})
+
+	return result, nil
+}
+
+func generateSQLForTable(statementInfoList []statementInfo, targetDatabase string, tablePrefix string) (*base.BackupStatement, error) {
+	table := statementInfoList[0].table
+
+	targetTable := fmt.Sprintf("%s_%s_%s", tablePrefix, table.Table, table.Schema)
+	targetTable, _ = common.TruncateString(targetTable, maxTableNameLength)
+
+	var buf strings.Builder
+	if _, err := fmt.Fprintf(&buf, "CREATE TABLE %s AS\n", quoteObjectName(targetDatabase, defaultSchema, targetTable)); err != nil {
+		return nil, errors.Wrap(err, "failed to write to buffer")
+	}
+	for i, info := range statementInfoList {
+		if i != 0 {
+			if _, err := buf.WriteString("\n  UNION\n"); err != nil {
+				return nil, errors.Wrap(err, "failed to write to buffer")
+			}
+		}
+		if err := writeSelectClause(&buf, info.tree, info.alias); err != nil {
+			return nil, errors.Wrap(err, "failed to write select clause")
+		}
+	}
+	if _, err := buf.WriteString(";"); err != nil {
+		return nil, errors.Wrap(err, "failed to write to buffer")
+	}
+
+	return &base.BackupStatement{
+		Statement:       buf.String(),
+		SourceSchema:    table.Schema,
+		SourceTableName: table.Table,
+		TargetTableName: targetTable,
+		StartPosition:   statementInfoList[0].startPosition,
+		EndPosition:     statementInfoList[len(statementInfoList)-1].endPosition,
+	}, nil
+}
+
+// writeSelectClause writes the SELECT statement selecting the rows affected by the DML statement.
+// The table name, its alias and the conditions are copied from the original statement, so that references
+// to the table in the WHERE clause are still valid.
+func writeSelectClause(buf *strings.Builder, tree antlr.ParserRuleContext, alias string) error {
+	var tokens antlr.TokenStream
+	var tableName string
+	var sources []string
+	var condition parser.ISearch_conditionContext
+	switch ctx := tree.(type) {
+	case *parser.Update_statementContext:
+		tokens = ctx.GetParser().GetTokenStream()
+		tableName = tokens.GetTextFromRuleContext(ctx.Object_name())
+		if ctx.Table_sources() != nil {
+			sources = append(sources, tokens.GetTextFromRuleContext(ctx.Table_sources()))
+		}
+		condition = ctx.Search_condition()
+	case *parser.Delete_statementContext:
+		tokens = ctx.GetParser().GetTokenStream()
+		tableName = tokens.GetTextFromRuleContext(ctx.Object_name())
+		for _, tableOrQuery := range ctx.AllTable_or_query() {
+			sources = append(sources, tokens.GetTextFromRuleContext(tableOrQuery))
+		}
+		condition = ctx.Search_condition()
+	default:
+		return errors.Errorf("unexpected statement type %T", tree)
+	}
+
+	if alias != "" {
+		if _, err := fmt.Fprintf(buf, "  SELECT %s.* FROM %s AS %s", alias, tableName, alias); err != nil {
+			return err
+		}
+	} else if _, err := fmt.Fprintf(buf, "  SELECT %s.* FROM %s", tableName, tableName); err != nil {
+		return err
+	}
+	for _, source := range sources {
+		if _, err := fmt.Fprintf(buf, ", %s", source); err != nil {
+			return err
+		}
+	}
+	if condition != nil {
+		if _, err := fmt.Fprintf(buf, " WHERE %s", tokens.GetTextFromRuleContext(condition)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func prepareTransformation(databaseName, statement string) ([]statementInfo, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to split sql")
+	}
+
+	var result []statementInfo
+	for i, item := range list {
+		if len(item.Text) == 0 || item.Empty {
+			continue
+		}
+		text, alias := stripTargetAlias(item.Text)
+		parseResult, err := parseSingleSnowSQL(text, item.BaseLine)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse sql")
+		}
+
+		extractor := &dmlExtractor{
+			databaseName: databaseName,
+			offset:       i,
+			alias:        alias,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(extractor, parseResult.Tree)
+		if extractor.err != nil {
+			return nil, extractor.err
+		}
+		for _, dml := range extractor.dmls {
+			dml.startPosition = item.Start
+			dml.endPosition = item.End
+			result = append(result, dml)
+		}
+	}
+
+	return result, nil
+}
+
+// IsTopLevelStatement returns true if the statement is not nested in another statement.
+func IsTopLevelStatement(ctx antlr.Tree) bool {
+	if ctx == nil {
+		return true
+	}
+	switch ctx := ctx.(type) {
+	case *parser.Snowflake_fileContext:
+		return true
+	case *parser.BatchContext, *parser.Sql_commandContext, *parser.Dml_commandContext:
+		return IsTopLevelStatement(ctx.GetParent())
+	default:
+		return false
+	}
+}
+
+type dmlExtractor struct {
+	*parser.BaseSnowflakeParserListener
+
+	databaseName string
+	offset       int
+	alias        string
+	dmls         []statementInfo
+	err          error
+}
+
+func (e *dmlExtractor) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if e.err != nil || !IsTopLevelStatement(ctx.GetParent()) {
+		return
+	}
+	e.appendStatement(ctx, ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx), ctx.Object_name(), StatementTypeUpdate)
+}
+
+func (e *dmlExtractor) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if e.err != nil || !IsTopLevelStatement(ctx.GetParent()) {
+		return
+	}
+	e.appendStatement(ctx, ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx), ctx.Object_name(), StatementTypeDelete)
+}
+
+func (e *dmlExtractor) appendStatement(ctx antlr.ParserRuleContext, statement string, objectName parser.IObject_nameContext, statementType StatementType) {
+	table := normalizeTableReference(objectName, e.databaseName)
+	if table.Database != e.databaseName {
+		e.err = errors.Errorf("database is not matched: %s != %s", table.Database, e.databaseName)
+		return
+	}
+	table.StatementType = statementType
+	e.dmls = append(e.dmls, statementInfo{
+		offset:    e.offset,
+		statement: statement,
+		tree:      ctx,
+		table:     table,
+		alias:     e.alias,
+	})
+}
+
+// stripTargetAlias removes the alias of the target table from an UPDATE or DELETE statement,
+// e.g. "UPDATE T1 AS X SET X.B = 1", which the Snowflake grammar doesn't accept, and returns the alias.
+func stripTargetAlias(statement string) (string, string) {
+	lexer := parser.NewSnowflakeLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	var tokens []antlr.Token
+	for _, token := range stream.GetAllTokens() {
+		if token.GetChannel() == antlr.TokenDefaultChannel && token.GetTokenType() != antlr.TokenEOF {
+			tokens = append(tokens, token)
+		}
+	}
+
+	i := 0
+	switch {
+	case len(tokens) > 0 && tokens[0].GetTokenType() == parser.SnowflakeLexerUPDATE:
+		i = 1
+	case len(tokens) > 1 && tokens[0].GetTokenType() == parser.SnowflakeLexerDELETE && tokens[1].GetTokenType() == parser.SnowflakeLexerFROM:
+		i = 2
+	default:
+		return statement, ""
+	}
+	// Skip the table name, whose parts are separated by dots.
+	i++
+	for i+1 < len(tokens) && tokens[i].GetTokenType() == parser.SnowflakeLexerDOT {
+		i += 2
+	}
+	if i >= len(tokens) {
+		return statement, ""
+	}
+	start := tokens[i].GetStart()
+	if tokens[i].GetTokenType() == parser.SnowflakeLexerAS {
+		i++
+	}
+	if i >= len(tokens) || (tokens[i].GetTokenType() != parser.SnowflakeLexerID && tokens[i].GetTokenType() != parser.SnowflakeLexerDOUBLE_QUOTE_ID) {
+		return statement, ""
+	}
+	runes := []rune(statement)
+	return string(runes[:start]) + string(runes[tokens[i].GetStop()+1:]), tokens[i].GetText()
+}
+
+func normalizeTableReference(objectName parser.IObject_nameContext, databaseName string) *TableReference {
+	table := &TableReference{
+		Database: databaseName,
+		Schema:   defaultSchema,
+		Table:    NormalizeSnowSQLObjectNamePart(objectName.GetO()),
+	}
+	if d := objectName.GetD(); d != nil {
+		table.Database = NormalizeSnowSQLObjectNamePart(d)
+	}
+	if s := objectName.GetS(); s != nil {
+		table.Schema = NormalizeSnowSQLObjectNamePart(s)
+	}
+	return table
+}
+
+func quoteObjectName(database, schema, table string) string {
+	return fmt.Sprintf("%s.%s.%s", quoteIdentifier(database), quoteIdentifier(schema), quoteIdentifier(table))
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
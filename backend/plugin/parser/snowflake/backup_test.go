@@ -0,0 +1,93 @@
+package snowflake
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+type rollbackCase struct {
+	Input  string
+	Result []base.BackupStatement
+}
+
+func TestBackup(t *testing.T) {
+	tests := []rollbackCase{}
+
+	const (
+		record = false
+	)
+	var (
+		filepath = "test-data/test_backup.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(yamlFile.Close())
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	for i, t := range tests {
+		result, err := TransformDMLToSelect(context.Background(), base.TransformContext{}, t.Input, "DB", "BBDATAARCHIVE", "_rollback")
+		a.NoError(err)
+
+		if record {
+			tests[i].Result = result
+		} else {
+			a.Equal(t.Result, result, t.Input)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
+
+func buildFixedMockDatabaseMetadataGetter() base.GetDatabaseMetadataFunc {
+	return func(_ context.Context, _ string, database string) (string, *model.DatabaseMetadata, error) {
+		return database, model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+			Name: database,
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Name: "PUBLIC",
+					Tables: []*storepb.TableMetadata{
+						{
+							Name: "T1",
+							Columns: []*storepb.ColumnMetadata{
+								{Name: "A"},
+								{Name: "B"},
+								{Name: "C"},
+							},
+							Indexes: []*storepb.IndexMetadata{
+								{
+									Name:        "T1_PK",
+									Primary:     true,
+									Unique:      true,
+									Expressions: []string{"A"},
+								},
+								{
+									Name:        "T1_UK",
+									Unique:      true,
+									Expressions: []string{"B"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil, nil, storepb.Engine_SNOWFLAKE, true /* isObjectCaseSensitive */), nil
+	}
+}
@@ -0,0 +1,286 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/snowflake"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func init() {
+	base.RegisterGenerateRestoreSQL(storepb.Engine_SNOWFLAKE, GenerateRestoreSQL)
+}
+
+const (
+	maxCommentLength = 1000
+)
+
+// GenerateRestoreSQL generates the SQL restoring the rows saved in the backup table to the source table.
+func GenerateRestoreSQL(ctx context.Context, rCtx base.RestoreContext, statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	originalSQL, err := extractSQL(statement, backupItem)
+	if err != nil {
+		return "", errors.Errorf("failed to extract single SQL: %v", err)
+	}
+
+	list, err := SplitSQL(originalSQL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to split SQL")
+	}
+	// All statements backed up into the same table modify the same table with the same
+	// statement type, so the first statement is enough to generate the restore SQL.
+	var tree antlr.Tree
+	for _, item := range list {
+		if item.Empty {
+			continue
+		}
+		text, _ := stripTargetAlias(item.Text)
+		result, err := parseSingleSnowSQL(text, item.BaseLine)
+		if err != nil {
+			return "", err
+		}
+		tree = result.Tree
+		break
+	}
+	if tree == nil {
+		return "", errors.Errorf("no parse result")
+	}
+
+	sqlForComment, truncated := common.TruncateString(originalSQL, maxCommentLength)
+	if truncated {
+		sqlForComment += "..."
+	}
+	return doGenerate(ctx, rCtx, sqlForComment, tree, backupItem)
+}
+
+func doGenerate(ctx context.Context, rCtx base.RestoreContext, sqlForComment string, tree antlr.Tree, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+	_, targetDatabase, err := common.GetInstanceDatabaseID(backupItem.TargetTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get target database ID for %s", backupItem.TargetTable.Database)
+	}
+	sourceSchema := backupItem.SourceTable.Schema
+	if sourceSchema == "" {
+		sourceSchema = defaultSchema
+	}
+	targetSchema := backupItem.TargetTable.Schema
+	if targetSchema == "" {
+		targetSchema = defaultSchema
+	}
+
+	if rCtx.GetDatabaseMetadataFunc == nil {
+		return "", errors.Errorf("GetDatabaseMetadataFunc is required")
+	}
+
+	_, metadata, err := rCtx.GetDatabaseMetadataFunc(ctx, rCtx.InstanceID, sourceDatabase)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get database metadata for %s", sourceDatabase)
+	}
+	if metadata == nil {
+		return "", errors.Errorf("database metadata is nil for %s", sourceDatabase)
+	}
+
+	schemaMetadata := metadata.GetSchemaMetadata(sourceSchema)
+	if schemaMetadata == nil {
+		return "", errors.Errorf("no schema metadata for %s.%s", sourceDatabase, sourceSchema)
+	}
+
+	tableMetadata := schemaMetadata.GetTable(backupItem.SourceTable.Table)
+	if tableMetadata == nil {
+		return "", errors.Errorf("no table metadata for %s.%s.%s", sourceDatabase, sourceSchema, backupItem.SourceTable.Table)
+	}
+
+	g := &generator{
+		originalTable: quoteObjectName(sourceDatabase, sourceSchema, backupItem.SourceTable.Table),
+		backupTable:   quoteObjectName(targetDatabase, targetSchema, backupItem.TargetTable.Table),
+		pk:            tableMetadata.GetPrimaryKey(),
+		table:         tableMetadata,
+		isFirst:       true,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(g, tree)
+	if g.err != nil {
+		return "", g.err
+	}
+	return fmt.Sprintf("/*\nOriginal SQL:\n%s\n*/\n%s", sqlForComment, g.result), nil
+}
+
+type generator struct {
+	*parser.BaseSnowflakeParserListener
+
+	// originalTable and backupTable are the quoted fully qualified table names.
+	originalTable string
+	backupTable   string
+	pk            *model.IndexMetadata
+	table         *model.TableMetadata
+
+	isFirst bool
+	result  string
+	err     error
+}
+
+func (g *generator) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if !IsTopLevelStatement(ctx.GetParent()) || !g.isFirst {
+		return
+	}
+
+	g.isFirst = false
+	g.result = fmt.Sprintf("INSERT INTO %s SELECT * FROM %s;", g.originalTable, g.backupTable)
+}
+
+func disjoint(a []string, b map[string]bool) bool {
+	for _, item := range a {
+		if _, ok := b[item]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// findDisjointUniqueKey finds a primary or unique key that is not updated by the statement,
+// so that it can be used to match the backup rows with the updated rows.
+// Snowflake doesn't enforce the constraints, we trust the declared keys here.
+func (g *generator) findDisjointUniqueKey(columns []string) ([]string, error) {
+	columnMap := make(map[string]bool)
+	for _, column := range columns {
+		columnMap[column] = true
+	}
+	if g.pk != nil {
+		if disjoint(g.pk.GetProto().Expressions, columnMap) {
+			return g.pk.GetProto().Expressions, nil
+		}
+	}
+	for _, index := range g.table.GetProto().Indexes {
+		if index.Primary || !index.Unique {
+			continue
+		}
+		if disjoint(index.Expressions, columnMap) {
+			return index.Expressions, nil
+		}
+	}
+	return nil, errors.Errorf("no disjoint unique key found for %s", g.originalTable)
+}
+
+func (g *generator) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if !IsTopLevelStatement(ctx.GetParent()) || !g.isFirst {
+		return
+	}
+
+	g.isFirst = false
+
+	var updatedColumns []string
+	for _, column := range ctx.AllColumn_name() {
+		updatedColumns = append(updatedColumns, NormalizeSnowSQLObjectNamePart(column.Id_()))
+	}
+
+	uk, err := g.findDisjointUniqueKey(updatedColumns)
+	if err != nil {
+		g.err = err
+		return
+	}
+
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "MERGE INTO %s t\nUSING %s b\n  ON ", g.originalTable, g.backupTable)
+	for i, column := range uk {
+		if i > 0 {
+			_, _ = buf.WriteString(" AND ")
+		}
+		_, _ = fmt.Fprintf(&buf, "t.%s = b.%s", quoteIdentifier(column), quoteIdentifier(column))
+	}
+	_, _ = buf.WriteString("\nWHEN MATCHED THEN\n  UPDATE SET ")
+	for i, column := range updatedColumns {
+		if i > 0 {
+			_, _ = buf.WriteString(", ")
+		}
+		_, _ = fmt.Fprintf(&buf, "t.%s = b.%s", quoteIdentifier(column), quoteIdentifier(column))
+	}
+	var columns, values []string
+	for _, column := range g.table.GetProto().GetColumns() {
+		columns = append(columns, quoteIdentifier(column.Name))
+		values = append(values, "b."+quoteIdentifier(column.Name))
+	}
+	_, _ = fmt.Fprintf(&buf, "\nWHEN NOT MATCHED THEN\n  INSERT (%s) VALUES (%s);", strings.Join(columns, ", "), strings.Join(values, ", "))
+	g.result = buf.String()
+}
+
+func extractSQL(statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	if backupItem == nil {
+		return "", errors.New("backup item is nil")
+	}
+
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to split SQL")
+	}
+
+	start := 0
+	end := len(list) - 1
+	for i, item := range list {
+		if equalOrLess(item.Start, backupItem.StartPosition) {
+			start = i
+		}
+	}
+
+	for i := len(list) - 1; i >= 0; i-- {
+		if equalOrGreater(list[i].Start, backupItem.EndPosition) {
+			end = i
+		}
+	}
+
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+	sourceSchema := backupItem.SourceTable.Schema
+	if sourceSchema == "" {
+		sourceSchema = defaultSchema
+	}
+
+	var result []string
+	// We only need statements that contain the source table.
+	for i := start; i <= end; i++ {
+		if list[i].Empty {
+			continue
+		}
+		tables, err := prepareTransformation(sourceDatabase, list[i].Text)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to prepare transformation")
+		}
+		for _, table := range tables {
+			if table.table.Schema == sourceSchema && table.table.Table == backupItem.SourceTable.Table {
+				result = append(result, list[i].Text)
+				break
+			}
+		}
+	}
+	return strings.Join(result, ""), nil
+}
+
+func equalOrLess(a, b *storepb.Position) bool {
+	if a.Line < b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column <= b.Column {
+		return true
+	}
+	return false
+}
+
+func equalOrGreater(a, b *storepb.Position) bool {
+	if a.Line > b.Line {
+		return true
+	}
+	if a.Line == b.Line && a.Column >= b.Column {
+		return true
+	}
+	return false
+}
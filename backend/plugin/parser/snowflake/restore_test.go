@@ -0,0 +1,82 @@
+package snowflake
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+type restoreCase struct {
+	Input          string
+	OriginalSchema string
+	OriginalTable  string
+	BackupTable    string
+	Result         string
+}
+
+func TestRestore(t *testing.T) {
+	tests := []restoreCase{}
+
+	const (
+		record = false
+	)
+	var (
+		filepath = "test-data/test_restore.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(yamlFile.Close())
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	for i, t := range tests {
+		result, err := GenerateRestoreSQL(context.Background(), base.RestoreContext{
+			GetDatabaseMetadataFunc: buildFixedMockDatabaseMetadataGetter(),
+			IsCaseSensitive:         true,
+		}, t.Input, &storepb.PriorBackupDetail_Item{
+			SourceTable: &storepb.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/DB",
+				Schema:   t.OriginalSchema,
+				Table:    t.OriginalTable,
+			},
+			TargetTable: &storepb.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/BBDATAARCHIVE",
+				Schema:   "PUBLIC",
+				Table:    t.BackupTable,
+			},
+			StartPosition: &storepb.Position{
+				Line:   0,
+				Column: 0,
+			},
+			EndPosition: &storepb.Position{
+				Line:   math.MaxInt32,
+				Column: 0,
+			},
+		})
+		a.NoError(err)
+
+		if record {
+			tests[i].Result = result
+		} else {
+			a.Equal(t.Result, result, t.Input)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
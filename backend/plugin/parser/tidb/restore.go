@@ -0,0 +1,20 @@
+package tidb
+
+import (
+	"context"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+)
+
+func init() {
+	base.RegisterGenerateRestoreSQL(storepb.Engine_TIDB, GenerateRestoreSQL)
+}
+
+// GenerateRestoreSQL generates the restore SQL for the prior backup item.
+// TiDB is MySQL compatible for the DML statements we back up, and the backup tables are created
+// by TransformDMLToSelect in the same way as MySQL, so we share the MySQL restore generator.
+func GenerateRestoreSQL(ctx context.Context, rCtx base.RestoreContext, statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	return mysql.GenerateRestoreSQL(ctx, rCtx, statement, backupItem)
+}
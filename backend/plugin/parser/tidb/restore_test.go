@@ -0,0 +1,142 @@
+package tidb
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+type restoreCase struct {
+	Input            string
+	BackupDatabase   string
+	BackupTable      string
+	OriginalDatabase string
+	OriginalTable    string
+	Result           string
+}
+
+func TestRestore(t *testing.T) {
+	tests := []restoreCase{}
+
+	const (
+		record = false
+	)
+	var (
+		filepath = "test-data/test_restore.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(yamlFile.Close())
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	for i, t := range tests {
+		getter, lister := buildRestoreMockDatabaseMetadataGetterAndLister()
+		result, err := GenerateRestoreSQL(context.Background(), base.RestoreContext{
+			GetDatabaseMetadataFunc: getter,
+			ListDatabaseNamesFunc:   lister,
+			IsCaseSensitive:         false,
+		}, t.Input, &store.PriorBackupDetail_Item{
+			SourceTable: &store.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/" + t.OriginalDatabase,
+				Table:    t.OriginalTable,
+			},
+			TargetTable: &store.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/" + t.BackupDatabase,
+				Table:    t.BackupTable,
+			},
+			StartPosition: &store.Position{
+				Line:   0,
+				Column: 0,
+			},
+			EndPosition: &store.Position{
+				Line:   math.MaxInt32,
+				Column: 0,
+			},
+		})
+		a.NoError(err)
+
+		if record {
+			tests[i].Result = result
+		} else {
+			a.Equal(t.Result, result, t.Input)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
+
+func buildRestoreMockDatabaseMetadataGetterAndLister() (base.GetDatabaseMetadataFunc, base.ListDatabaseNamesFunc) {
+	schemaMetadata := []*store.SchemaMetadata{
+		{
+			Name: "",
+			Tables: []*store.TableMetadata{
+				{
+					Name: "t1",
+					Columns: []*store.ColumnMetadata{
+						{
+							Name: "a",
+						},
+						{
+							Name: "b",
+						},
+						{
+							Name: "c",
+						},
+					},
+				},
+				{
+					Name: "t_generated",
+					Indexes: []*store.IndexMetadata{
+						{
+							Name:        "PRIMARY",
+							Primary:     true,
+							Unique:      true,
+							Expressions: []string{"a"},
+						},
+					},
+					Columns: []*store.ColumnMetadata{
+						{
+							Name: "a",
+						},
+						{
+							Name: "b",
+						},
+						{
+							Name: "c_generated",
+							Generation: &store.GenerationMetadata{
+								Expression: "a + b",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return func(_ context.Context, _ string, database string) (string, *model.DatabaseMetadata, error) {
+			return database, model.NewDatabaseMetadata(&store.DatabaseSchemaMetadata{
+				Name:    database,
+				Schemas: schemaMetadata,
+			}, nil, nil, store.Engine_TIDB, false /* isObjectCaseSensitive */), nil
+		}, func(_ context.Context, _ string) ([]string, error) {
+			return []string{"db", "bbdataarchive"}, nil
+		}
+}
@@ -0,0 +1,24 @@
+package tsql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/parser/tsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_MSSQL, Format)
+}
+
+// Format formats the T-SQL statements.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	if _, err := ParseTSQL(statement); err != nil {
+		return "", err
+	}
+	return base.FormatWithLexer(statement, func(statement string) antlr.Lexer {
+		return parser.NewTSqlLexer(antlr.NewInputStream(statement))
+	}, options)
+}
@@ -0,0 +1,17 @@
+package tsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	statement := "select top 10 a, b from t where a >= 1\nGO\ncreate procedure p as\nbegin\n  select 1;\nend\nGO\n"
+	want := "SELECT TOP 10\n  a,\n  b\nFROM\n  t\nWHERE\n  a >= 1\nGO\ncreate procedure p as\nbegin\n  select 1;\nend\nGO\n"
+	got, err := Format(statement, base.FormatOptions{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
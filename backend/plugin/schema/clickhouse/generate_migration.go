@@ -0,0 +1,256 @@
+package clickhouse
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_CLICKHOUSE, generateMigration)
+}
+
+// rebuildTableSuffix is appended to the table name when a table has to be rebuilt,
+// e.g. when its engine, sorting key or primary key changes.
+const rebuildTableSuffix = "_bb_rebuild"
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// ClickHouse has no foreign keys, so the only dependency we need to care about is
+	// views depending on tables. Drop views first and re-create them at the end.
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop || viewDiff.Action == schema.MetadataDiffActionAlter {
+			_, _ = fmt.Fprintf(&buf, "DROP VIEW IF EXISTS %s;\n", quoteIdentifier(viewDiff.ViewName))
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(&buf, "DROP TABLE IF EXISTS %s;\n", quoteIdentifier(tableDiff.TableName))
+		}
+	}
+
+	dropPhaseHasContent := buf.Len() > 0
+	if dropPhaseHasContent && hasCreateOrAlterObjects(diff) {
+		_, _ = buf.WriteString("\n")
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			writeCreateTable(&buf, tableDiff.TableName, tableDiff.NewTable)
+		case schema.MetadataDiffActionAlter:
+			if requiresRebuild(tableDiff) {
+				writeRebuildTable(&buf, tableDiff)
+				continue
+			}
+			writeAlterTable(&buf, tableDiff)
+		default:
+		}
+	}
+
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			writeCreateView(&buf, viewDiff.NewView)
+		}
+	}
+
+	return buf.String(), nil
+}
+
+func hasCreateOrAlterObjects(diff *schema.MetadataDiff) bool {
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionCreate || tableDiff.Action == schema.MetadataDiffActionAlter {
+			return true
+		}
+	}
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			return true
+		}
+	}
+	return false
+}
+
+// requiresRebuild returns true if the table change cannot be done with ALTER TABLE.
+// ClickHouse cannot change the table engine or primary key in place, and the sorting key
+// can only be extended with newly added columns, which we don't try to detect here.
+func requiresRebuild(tableDiff *schema.TableDiff) bool {
+	if tableDiff.OldTable.GetEngine() != tableDiff.NewTable.GetEngine() {
+		return true
+	}
+	if !slices.Equal(tableDiff.OldTable.GetSortingKeys(), tableDiff.NewTable.GetSortingKeys()) {
+		return true
+	}
+	return !slices.Equal(getPrimaryKeys(tableDiff.OldTable), getPrimaryKeys(tableDiff.NewTable))
+}
+
+func writeCreateTable(buf *strings.Builder, tableName string, table *storepb.TableMetadata) {
+	_, _ = fmt.Fprintf(buf, "CREATE TABLE %s (\n", quoteIdentifier(tableName))
+	var items []string
+	for _, column := range table.GetColumns() {
+		items = append(items, "  "+columnDefinition(column))
+	}
+	for _, index := range table.GetIndexes() {
+		if index.Primary {
+			continue
+		}
+		items = append(items, "  "+indexDefinition(index))
+	}
+	_, _ = buf.WriteString(strings.Join(items, ",\n"))
+	_, _ = buf.WriteString("\n)")
+	if table.GetEngine() != "" {
+		_, _ = fmt.Fprintf(buf, "\nENGINE = %s", table.GetEngine())
+	}
+	if len(table.GetSortingKeys()) > 0 {
+		_, _ = fmt.Fprintf(buf, "\nORDER BY (%s)", strings.Join(table.GetSortingKeys(), ", "))
+	} else if strings.HasSuffix(strings.ToLower(table.GetEngine()), "mergetree") {
+		// MergeTree family tables require an ORDER BY clause.
+		_, _ = buf.WriteString("\nORDER BY tuple()")
+	}
+	if primaryKeys := getPrimaryKeys(table); len(primaryKeys) > 0 {
+		_, _ = fmt.Fprintf(buf, "\nPRIMARY KEY (%s)", strings.Join(primaryKeys, ", "))
+	}
+	if table.GetComment() != "" {
+		_, _ = fmt.Fprintf(buf, "\nCOMMENT '%s'", escapeString(table.GetComment()))
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+// writeRebuildTable creates a new table with the target definition, copies the data of the
+// columns that exist in both definitions, and atomically swaps the two tables.
+func writeRebuildTable(buf *strings.Builder, tableDiff *schema.TableDiff) {
+	tableName := tableDiff.TableName
+	rebuildName := tableName + rebuildTableSuffix
+	writeCreateTable(buf, rebuildName, tableDiff.NewTable)
+
+	var commonColumns []string
+	for _, newColumn := range tableDiff.NewTable.GetColumns() {
+		for _, oldColumn := range tableDiff.OldTable.GetColumns() {
+			if oldColumn.Name == newColumn.Name {
+				commonColumns = append(commonColumns, quoteIdentifier(newColumn.Name))
+				break
+			}
+		}
+	}
+	if len(commonColumns) > 0 {
+		columnList := strings.Join(commonColumns, ", ")
+		_, _ = fmt.Fprintf(buf, "INSERT INTO %s (%s) SELECT %s FROM %s;\n", quoteIdentifier(rebuildName), columnList, columnList, quoteIdentifier(tableName))
+	}
+	_, _ = fmt.Fprintf(buf, "EXCHANGE TABLES %s AND %s;\n", quoteIdentifier(rebuildName), quoteIdentifier(tableName))
+	_, _ = fmt.Fprintf(buf, "DROP TABLE %s;\n", quoteIdentifier(rebuildName))
+}
+
+func writeAlterTable(buf *strings.Builder, tableDiff *schema.TableDiff) {
+	table := quoteIdentifier(tableDiff.TableName)
+
+	// Drop data skipping indexes before dropping the columns they reference.
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action == schema.MetadataDiffActionDrop && !indexDiff.OldIndex.Primary {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP INDEX IF EXISTS %s;\n", table, quoteIdentifier(indexDiff.OldIndex.Name))
+		}
+	}
+
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN IF EXISTS %s;\n", table, quoteIdentifier(columnDiff.OldColumn.Name))
+		}
+	}
+
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		switch columnDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s;\n", table, columnDefinition(columnDiff.NewColumn))
+		case schema.MetadataDiffActionAlter:
+			oldColumn, newColumn := columnDiff.OldColumn, columnDiff.NewColumn
+			if oldColumn.Type != newColumn.Type || oldColumn.Nullable != newColumn.Nullable || oldColumn.Default != newColumn.Default {
+				_, _ = fmt.Fprintf(buf, "ALTER TABLE %s MODIFY COLUMN %s;\n", table, columnDefinition(newColumn))
+			} else if oldColumn.Comment != newColumn.Comment {
+				_, _ = fmt.Fprintf(buf, "ALTER TABLE %s COMMENT COLUMN %s '%s';\n", table, quoteIdentifier(newColumn.Name), escapeString(newColumn.Comment))
+			}
+		default:
+		}
+	}
+
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action == schema.MetadataDiffActionCreate && !indexDiff.NewIndex.Primary {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD %s;\n", table, indexDefinition(indexDiff.NewIndex))
+		}
+	}
+
+	if tableDiff.OldTable.GetComment() != tableDiff.NewTable.GetComment() {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s MODIFY COMMENT '%s';\n", table, escapeString(tableDiff.NewTable.GetComment()))
+	}
+}
+
+func writeCreateView(buf *strings.Builder, view *storepb.ViewMetadata) {
+	definition := util.TrimStatement(view.Definition)
+	// ClickHouse stores the full CREATE VIEW statement as the view definition.
+	if strings.HasPrefix(strings.ToUpper(definition), "CREATE") {
+		_, _ = fmt.Fprintf(buf, "%s;\n", definition)
+		return
+	}
+	_, _ = fmt.Fprintf(buf, "CREATE VIEW %s AS %s", quoteIdentifier(view.Name), definition)
+	if view.Comment != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT '%s'", escapeString(view.Comment))
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func columnDefinition(column *storepb.ColumnMetadata) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "%s %s", quoteIdentifier(column.Name), columnType(column))
+	// The syncer records "NULL" for columns without a default expression.
+	if column.Default != "" && column.Default != "NULL" {
+		_, _ = fmt.Fprintf(&buf, " DEFAULT %s", convertToColumnState(0, column).defaultValue.toString())
+	}
+	if column.Comment != "" {
+		_, _ = fmt.Fprintf(&buf, " COMMENT '%s'", escapeString(column.Comment))
+	}
+	return buf.String()
+}
+
+// columnType returns the column type wrapped in Nullable(...) for nullable columns.
+// The syncer may already record the type of a nullable column as Nullable(T).
+func columnType(column *storepb.ColumnMetadata) string {
+	if !column.Nullable || strings.HasPrefix(column.Type, "Nullable(") {
+		return column.Type
+	}
+	return fmt.Sprintf("Nullable(%s)", column.Type)
+}
+
+func indexDefinition(index *storepb.IndexMetadata) string {
+	expression := strings.Join(index.Expressions, ", ")
+	if len(index.Expressions) > 1 {
+		expression = fmt.Sprintf("(%s)", expression)
+	}
+	definition := fmt.Sprintf("INDEX %s %s TYPE %s", quoteIdentifier(index.Name), expression, index.Type)
+	if index.Granularity > 0 {
+		definition += fmt.Sprintf(" GRANULARITY %d", index.Granularity)
+	}
+	return definition
+}
+
+// getPrimaryKeys returns the primary key expressions of the table.
+// The syncer stores the primary key as an unnamed primary index.
+func getPrimaryKeys(table *storepb.TableMetadata) []string {
+	for _, index := range table.GetIndexes() {
+		if index.Primary {
+			return index.Expressions
+		}
+	}
+	return nil
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+func escapeString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	return strings.ReplaceAll(s, "'", "\\'")
+}
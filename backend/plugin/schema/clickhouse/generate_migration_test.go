@@ -0,0 +1,184 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      *storepb.DatabaseSchemaMetadata
+		new      *storepb.DatabaseSchemaMetadata
+		expected string
+	}{
+		{
+			name: "create table",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+							{Name: "payload", Type: "String", Default: "NULL", Comment: "raw payload"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "idx_payload", Expressions: []string{"payload"}, Type: "bloom_filter", Granularity: 4},
+						},
+					}},
+				}},
+			},
+			expected: "CREATE TABLE `events` (\n" +
+				"  `id` UInt64,\n" +
+				"  `payload` String COMMENT 'raw payload',\n" +
+				"  INDEX `idx_payload` payload TYPE bloom_filter GRANULARITY 4\n" +
+				")\nENGINE = MergeTree\nORDER BY (id);\n",
+		},
+		{
+			name: "alter columns",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+							{Name: "payload", Type: "String", Default: "NULL"},
+							{Name: "legacy", Type: "String", Default: "NULL"},
+						},
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Comment:     "all events",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+							{Name: "payload", Type: "String", Default: "NULL", Comment: "raw payload"},
+							{Name: "created_at", Type: "DateTime", Default: "now()"},
+						},
+					}},
+				}},
+			},
+			expected: "ALTER TABLE `events` DROP COLUMN IF EXISTS `legacy`;\n" +
+				"ALTER TABLE `events` ADD COLUMN IF NOT EXISTS `created_at` DateTime DEFAULT now();\n" +
+				"ALTER TABLE `events` COMMENT COLUMN `payload` 'raw payload';\n" +
+				"ALTER TABLE `events` MODIFY COMMENT 'all events';\n",
+		},
+		{
+			name: "nullable columns",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+							{Name: "note", Type: "String", Default: "NULL", Nullable: true},
+							{Name: "score", Type: "Nullable(Float64)", Default: "NULL", Nullable: true},
+						},
+					}},
+				}},
+			},
+			expected: "CREATE TABLE `events` (\n" +
+				"  `id` UInt64,\n" +
+				"  `note` Nullable(String),\n" +
+				"  `score` Nullable(Float64)\n" +
+				")\nENGINE = MergeTree\nORDER BY (id);\n",
+		},
+		{
+			name: "escape comments",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+						},
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Comment:     `C:\logs\`,
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL", Comment: `it's \'; DROP TABLE events; --`},
+						},
+					}},
+				}},
+			},
+			expected: "ALTER TABLE `events` COMMENT COLUMN `id` 'it\\'s \\\\\\'; DROP TABLE events; --';\n" +
+				"ALTER TABLE `events` MODIFY COMMENT 'C:\\\\logs\\\\';\n",
+		},
+		{
+			name: "rebuild table on sorting key change",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "MergeTree",
+						SortingKeys: []string{"id"},
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+						},
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Tables: []*storepb.TableMetadata{{
+						Name:        "events",
+						Engine:      "ReplacingMergeTree",
+						SortingKeys: []string{"id"},
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "UInt64", Default: "NULL"},
+						},
+					}},
+				}},
+			},
+			expected: "CREATE TABLE `events_bb_rebuild` (\n" +
+				"  `id` UInt64\n" +
+				")\nENGINE = ReplacingMergeTree\nORDER BY (id);\n" +
+				"INSERT INTO `events_bb_rebuild` (`id`) SELECT `id` FROM `events`;\n" +
+				"EXCHANGE TABLES `events_bb_rebuild` AND `events`;\n" +
+				"DROP TABLE `events_bb_rebuild`;\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldSchema := model.NewDatabaseMetadata(test.old, nil, nil, storepb.Engine_CLICKHOUSE, false)
+			newSchema := model.NewDatabaseMetadata(test.new, nil, nil, storepb.Engine_CLICKHOUSE, false)
+			diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_CLICKHOUSE, oldSchema, newSchema)
+			require.NoError(t, err)
+			got, err := schema.GenerateMigration(storepb.Engine_CLICKHOUSE, diff)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
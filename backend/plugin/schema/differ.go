@@ -634,6 +634,14 @@ func compareTableDetails(engine storepb.Engine, schemaName, tableName string, ol
 		hasChanges = true
 	}
 
+	// ClickHouse table engine and sorting key are part of the table definition.
+	if engine == storepb.Engine_CLICKHOUSE {
+		if oldTable.GetProto().Engine != newTable.GetProto().Engine ||
+			!slices.Equal(oldTable.GetProto().SortingKeys, newTable.GetProto().SortingKeys) {
+			hasChanges = true
+		}
+	}
+
 	if !hasChanges {
 		return nil
 	}
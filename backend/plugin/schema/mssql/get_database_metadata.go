@@ -40,12 +40,7 @@ func GetDatabaseMetadata(schemaText string) (*storepb.DatabaseSchemaMetadata, er
 		}, nil
 	}
 
-	extractor := &metadataExtractor{
-		currentDatabase: "",
-		currentSchema:   "dbo", // Default schema for MSSQL
-		schemas:         make(map[string]*storepb.SchemaMetadata),
-		tables:          make(map[tableKey]*storepb.TableMetadata),
-	}
+	extractor := newMetadataExtractor()
 
 	for _, parseResult := range parseResults {
 		if parseResult.Tree != nil {
@@ -93,6 +88,14 @@ type metadataExtractor struct {
 	indexCounter    int // Counter for generating unique index names
 }
 
+func newMetadataExtractor() *metadataExtractor {
+	return &metadataExtractor{
+		currentSchema: defaultSchema,
+		schemas:       make(map[string]*storepb.SchemaMetadata),
+		tables:        make(map[tableKey]*storepb.TableMetadata),
+	}
+}
+
 // Helper function to get or create schema
 func (e *metadataExtractor) getOrCreateSchema(schemaName string) *storepb.SchemaMetadata {
 	if schemaName == "" {
@@ -0,0 +1,90 @@
+package mssql
+
+import (
+	"fmt"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGetMultiFileDatabaseDefinition(storepb.Engine_MSSQL, GetMultiFileDatabaseDefinition)
+}
+
+// GetMultiFileDatabaseDefinition generates one SDL file per database object, organized as
+// schemas/<schema>/<object type>/<object>.sql.
+func GetMultiFileDatabaseDefinition(_ schema.GetDefinitionContext, metadata *storepb.DatabaseSchemaMetadata) (*schema.MultiFileSchemaResult, error) {
+	files := []schema.File{}
+	if metadata == nil {
+		return &schema.MultiFileSchemaResult{Files: files}, nil
+	}
+
+	for _, schemaMetadata := range metadata.Schemas {
+		if schemaMetadata.SkipDump {
+			continue
+		}
+		schemaName := schemaMetadata.Name
+		if schemaName == "" {
+			schemaName = defaultSchema
+		}
+
+		if schemaName != defaultSchema {
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/schema.sql", schemaName),
+				Content: fmt.Sprintf("CREATE SCHEMA [%s];\nGO\n", schemaName),
+			})
+		}
+
+		for _, table := range schemaMetadata.Tables {
+			if table.SkipDump {
+				continue
+			}
+			var buf strings.Builder
+			writeTable(&buf, schemaName, table)
+			_, _ = buf.WriteString("GO\n")
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/tables/%s.sql", schemaName, table.Name),
+				Content: buf.String(),
+			})
+		}
+
+		for _, view := range schemaMetadata.Views {
+			if view.SkipDump {
+				continue
+			}
+			var buf strings.Builder
+			writeView(&buf, schemaName, view)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/views/%s.sql", schemaName, view.Name),
+				Content: buf.String(),
+			})
+		}
+
+		for _, function := range schemaMetadata.Functions {
+			if function.SkipDump {
+				continue
+			}
+			var buf strings.Builder
+			writeFunction(&buf, schemaName, function)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/functions/%s.sql", schemaName, function.Name),
+				Content: buf.String(),
+			})
+		}
+
+		for _, procedure := range schemaMetadata.Procedures {
+			if procedure.SkipDump {
+				continue
+			}
+			var buf strings.Builder
+			writeProcedure(&buf, schemaName, procedure)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/procedures/%s.sql", schemaName, procedure.Name),
+				Content: buf.String(),
+			})
+		}
+	}
+
+	return &schema.MultiFileSchemaResult{Files: files}, nil
+}
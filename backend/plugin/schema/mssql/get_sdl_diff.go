@@ -0,0 +1,66 @@
+package mssql
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func init() {
+	schema.RegisterGetSDLDiff(storepb.Engine_MSSQL, GetSDLDiff)
+}
+
+// GetSDLDiff computes the diff between the user's SDL and the current database schema.
+func GetSDLDiff(currentSDLText, previousUserSDLText string, currentSchema, previousSchema *model.DatabaseMetadata) (*schema.MetadataDiff, error) {
+	if err := validateSDL(currentSDLText, currentSchema); err != nil {
+		return nil, err
+	}
+	return schema.GetSDLDiffByMetadata(storepb.Engine_MSSQL, currentSDLText, previousUserSDLText, currentSchema, previousSchema, normalizeSDLMetadata)
+}
+
+// normalizeSDLMetadata makes sure the default schema always exists, so that an empty SDL never
+// drops the dbo schema.
+func normalizeSDLMetadata(metadata *storepb.DatabaseSchemaMetadata) {
+	for _, schema := range metadata.Schemas {
+		if schema.Name == defaultSchema {
+			return
+		}
+	}
+	metadata.Schemas = append(metadata.Schemas, &storepb.SchemaMetadata{Name: defaultSchema})
+}
+
+// validateSDL walks through the SDL statements on an empty database, so that conflicting definitions,
+// e.g. a table defined twice or an index on a missing table, are reported instead of being merged silently.
+func validateSDL(sdlText string, currentSchema *model.DatabaseMetadata) error {
+	if strings.TrimSpace(sdlText) == "" {
+		return nil
+	}
+	parsed, err := GetDatabaseMetadata(sdlText)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse SDL")
+	}
+	// The walk-through doesn't create schemas, so the schemas of the SDL are created up front.
+	state := &storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{{Name: defaultSchema}}}
+	if currentSchema != nil {
+		state.Name = currentSchema.DatabaseName()
+	}
+	for _, schema := range parsed.Schemas {
+		if schema.Name != defaultSchema {
+			state.Schemas = append(state.Schemas, &storepb.SchemaMetadata{Name: schema.Name})
+		}
+	}
+	asts, err := base.Parse(storepb.Engine_MSSQL, sdlText)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse SDL")
+	}
+	advice := WalkThrough(model.NewDatabaseMetadata(state, nil, nil, storepb.Engine_MSSQL, false), asts)
+	if advice != nil && advice.Status == storepb.Advice_ERROR {
+		return errors.Errorf("invalid SDL at line %d: %s", advice.GetStartPosition().GetLine(), advice.Content)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGetSDLDiff(t *testing.T) {
+	currentMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "dbo",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int", Nullable: false},
+						},
+					},
+				},
+			},
+		},
+	}
+	currentSchema := model.NewDatabaseMetadata(currentMetadata, nil, nil, storepb.Engine_MSSQL, false)
+	generatedSDL, err := schema.GetDatabaseDefinition(storepb.Engine_MSSQL, schema.GetDefinitionContext{SkipBackupSchema: true, SDLFormat: true}, currentMetadata)
+	require.NoError(t, err)
+
+	// The dumped schema is the same as the user's SDL.
+	diff, err := GetSDLDiff(generatedSDL, "", currentSchema, nil)
+	require.NoError(t, err)
+	require.Empty(t, diff.TableChanges)
+
+	// The user adds a table.
+	diff, err = GetSDLDiff(generatedSDL+"\nCREATE TABLE [dbo].[t2] (\n  [id] int NOT NULL\n);\nGO\n", "", currentSchema, nil)
+	require.NoError(t, err)
+	require.Len(t, diff.TableChanges, 1)
+	require.Equal(t, schema.MetadataDiffActionCreate, diff.TableChanges[0].Action)
+	require.Equal(t, "t2", diff.TableChanges[0].TableName)
+
+	// An empty SDL drops the table but keeps the default schema.
+	diff, err = GetSDLDiff("", "", currentSchema, nil)
+	require.NoError(t, err)
+	require.Len(t, diff.TableChanges, 1)
+	require.Equal(t, schema.MetadataDiffActionDrop, diff.TableChanges[0].Action)
+	require.Empty(t, diff.SchemaChanges)
+}
+
+func TestGetSDLDiffWithDrift(t *testing.T) {
+	newSchema := func(t1Columns ...*storepb.ColumnMetadata) *model.DatabaseMetadata {
+		return model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+			Name: "db",
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Name: "dbo",
+					Tables: []*storepb.TableMetadata{
+						{Name: "t1", Columns: t1Columns},
+						{Name: "t2", Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "int"}}},
+					},
+				},
+			},
+		}, nil, nil, storepb.Engine_MSSQL, false)
+	}
+	previousSchema := newSchema(&storepb.ColumnMetadata{Name: "id", Type: "int"})
+	// The column c1 is added to t1 outside of the SDL.
+	currentSchema := newSchema(&storepb.ColumnMetadata{Name: "id", Type: "int"}, &storepb.ColumnMetadata{Name: "c1", Type: "int", Nullable: true})
+	previousSDL, err := schema.GetDatabaseDefinition(storepb.Engine_MSSQL, schema.GetDefinitionContext{SkipBackupSchema: true, SDLFormat: true}, previousSchema.GetProto())
+	require.NoError(t, err)
+
+	// Only the table added by the user changes, and the drift of t1 is kept.
+	diff, err := GetSDLDiff(previousSDL+"\nCREATE TABLE [dbo].[t3] (\n  [id] int NOT NULL\n);\nGO\n", previousSDL, currentSchema, previousSchema)
+	require.NoError(t, err)
+	require.Len(t, diff.TableChanges, 1)
+	require.Equal(t, schema.MetadataDiffActionCreate, diff.TableChanges[0].Action)
+	require.Equal(t, "t3", diff.TableChanges[0].TableName)
+}
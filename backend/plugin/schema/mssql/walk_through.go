@@ -0,0 +1,408 @@
+package mssql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/tsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/tsql"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// WalkThrough applies the DDL statements to the database metadata, which validates the SDL statements before
+// computing the SDL diff. The tables, indexes and views are built by the same extractor as the SDL parser.
+func WalkThrough(d *model.DatabaseMetadata, asts []base.AST) *storepb.Advice {
+	listener := &walkThroughListener{
+		BaseTSqlParserListener: &parser.BaseTSqlParserListener{},
+		databaseState:          d,
+		extractor:              newMetadataExtractor(),
+	}
+	for _, ast := range asts {
+		antlrAST, ok := base.GetANTLRAST(ast)
+		if !ok {
+			return &storepb.Advice{
+				Status:        storepb.Advice_ERROR,
+				Code:          code.Internal.Int32(),
+				Title:         "SQL Server walk-through expects ANTLR-based parser result",
+				Content:       "SQL Server walk-through expects ANTLR-based parser result",
+				StartPosition: &storepb.Position{Line: 0},
+			}
+		}
+		listener.baseLine = base.GetLineOffset(antlrAST.StartPosition)
+		antlr.ParseTreeWalkerDefault.Walk(listener, antlrAST.Tree)
+		if listener.advice != nil {
+			return listener.advice
+		}
+	}
+	return nil
+}
+
+type walkThroughListener struct {
+	*parser.BaseTSqlParserListener
+
+	databaseState *model.DatabaseMetadata
+	// extractor normalizes the object names in the same way as the SDL parser.
+	extractor *metadataExtractor
+	baseLine  int
+	advice    *storepb.Advice
+}
+
+// EnterCreate_table applies CREATE TABLE.
+func (l *walkThroughListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	if l.skip(ctx) {
+		return
+	}
+	schemaMetadata, tableName := l.getSchema(ctx, ctx.Table_name())
+	if schemaMetadata == nil {
+		return
+	}
+	if schemaMetadata.GetTable(tableName) != nil {
+		l.setAdvice(ctx, code.TableExists, fmt.Sprintf("The table %q already exists in the schema %q", tableName, schemaMetadata.GetProto().Name))
+		return
+	}
+	extractor := newMetadataExtractor()
+	antlr.ParseTreeWalkerDefault.Walk(extractor, ctx)
+	if extractor.err != nil {
+		l.setAdvice(ctx, code.Internal, extractor.err.Error())
+		return
+	}
+	table, err := schemaMetadata.CreateTable(tableName)
+	if err != nil {
+		l.setAdvice(ctx, code.TableExists, err.Error())
+		return
+	}
+	for _, elements := range extractor.tables {
+		l.addTableElements(ctx, table, elements)
+	}
+}
+
+// EnterDrop_table applies DROP TABLE.
+func (l *walkThroughListener) EnterDrop_table(ctx *parser.Drop_tableContext) {
+	if l.skip(ctx) {
+		return
+	}
+	for _, tableNameCtx := range ctx.AllTable_name() {
+		schemaMetadata, tableName := l.getSchema(ctx, tableNameCtx)
+		if schemaMetadata == nil {
+			return
+		}
+		if schemaMetadata.GetTable(tableName) == nil {
+			if ctx.IF() != nil {
+				continue
+			}
+			l.setAdvice(ctx, code.TableNotExists, fmt.Sprintf("Table %q does not exist in the schema %q", tableName, schemaMetadata.GetProto().Name))
+			return
+		}
+		if err := schemaMetadata.DropTable(tableName); err != nil {
+			l.setAdvice(ctx, code.TableNotExists, err.Error())
+			return
+		}
+	}
+}
+
+// EnterAlter_table applies ALTER TABLE to add, alter and drop the columns and constraints.
+func (l *walkThroughListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	if l.skip(ctx) {
+		return
+	}
+	schemaMetadata, table := l.getTable(ctx, ctx.Table_name(0))
+	if table == nil {
+		return
+	}
+	switch {
+	case ctx.ADD() != nil && ctx.Column_def_table_constraints() != nil:
+		elements := &storepb.TableMetadata{Name: table.GetProto().Name}
+		extractor := newMetadataExtractor()
+		extractor.extractTableElements(ctx.Column_def_table_constraints(), elements, schemaMetadata.GetProto().Name)
+		if extractor.err != nil {
+			l.setAdvice(ctx, code.Internal, extractor.err.Error())
+			return
+		}
+		l.addTableElements(ctx, table, elements)
+	case ctx.COLUMN() != nil && ctx.Column_definition() != nil:
+		elements := &storepb.TableMetadata{Name: table.GetProto().Name}
+		l.extractor.extractColumn(ctx.Column_definition(), elements)
+		for _, newColumn := range elements.Columns {
+			column := table.GetColumn(newColumn.Name)
+			if column == nil {
+				l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", newColumn.Name, table.GetProto().Name))
+				return
+			}
+			column.GetProto().Type = newColumn.Type
+			column.GetProto().Nullable = newColumn.Nullable
+		}
+	case ctx.DROP() != nil && ctx.COLUMN() != nil:
+		for _, id := range ctx.AllId_() {
+			columnName, _ := tsql.NormalizeTSQLIdentifier(id)
+			if err := table.DropColumn(columnName); err != nil {
+				l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", columnName, table.GetProto().Name))
+				return
+			}
+		}
+	case ctx.DROP() != nil && ctx.CONSTRAINT() != nil && ctx.GetConstraint() != nil:
+		constraintName, _ := tsql.NormalizeTSQLIdentifier(ctx.GetConstraint())
+		if !dropConstraint(table, constraintName) {
+			l.setAdvice(ctx, code.ConstraintNotExists, fmt.Sprintf("Constraint %q does not exist in the table %q", constraintName, table.GetProto().Name))
+		}
+	default:
+	}
+}
+
+// EnterCreate_index applies CREATE INDEX.
+func (l *walkThroughListener) EnterCreate_index(ctx *parser.Create_indexContext) {
+	if l.skip(ctx) {
+		return
+	}
+	_, table := l.getTable(ctx, ctx.Table_name())
+	if table == nil {
+		return
+	}
+	extractor := newMetadataExtractor()
+	antlr.ParseTreeWalkerDefault.Walk(extractor, ctx)
+	for _, elements := range extractor.tables {
+		l.addTableElements(ctx, table, elements)
+	}
+}
+
+// EnterDrop_index applies DROP INDEX.
+func (l *walkThroughListener) EnterDrop_index(ctx *parser.Drop_indexContext) {
+	if l.skip(ctx) {
+		return
+	}
+	dropIndex := func(schemaName, tableName, indexName string) bool {
+		schemaMetadata := l.databaseState.GetSchemaMetadata(schemaName)
+		if schemaMetadata == nil {
+			l.setAdvice(ctx, code.SchemaNotExists, fmt.Sprintf("Schema %q does not exist", schemaName))
+			return false
+		}
+		table := schemaMetadata.GetTable(tableName)
+		if table == nil {
+			l.setAdvice(ctx, code.TableNotExists, fmt.Sprintf("Table %q does not exist in the schema %q", tableName, schemaName))
+			return false
+		}
+		if table.GetIndex(indexName) == nil {
+			if ctx.IF() != nil {
+				return true
+			}
+			l.setAdvice(ctx, code.IndexNotExists, fmt.Sprintf("Index %q does not exist in the table %q", indexName, tableName))
+			return false
+		}
+		if err := table.DropIndex(indexName); err != nil {
+			l.setAdvice(ctx, code.IndexNotExists, err.Error())
+			return false
+		}
+		return true
+	}
+	for _, index := range ctx.AllDrop_relational_or_xml_or_spatial_index() {
+		tableName, err := tsql.NormalizeFullTableName(index.Full_table_name())
+		if err != nil {
+			l.setAdvice(ctx, code.Internal, err.Error())
+			return
+		}
+		if !l.isCurrentDatabase(ctx, tableName.Database) {
+			return
+		}
+		schemaName := tableName.Schema
+		if schemaName == "" {
+			schemaName = defaultSchema
+		}
+		indexName, _ := tsql.NormalizeTSQLIdentifier(index.GetIndex_name())
+		if !dropIndex(schemaName, tableName.Table, indexName) {
+			return
+		}
+	}
+	for _, index := range ctx.AllDrop_backward_compatible_index() {
+		schemaName := defaultSchema
+		if index.GetOwner_name() != nil {
+			schemaName, _ = tsql.NormalizeTSQLIdentifier(index.GetOwner_name())
+		}
+		tableName, _ := tsql.NormalizeTSQLIdentifier(index.GetTable_or_view_name())
+		indexName, _ := tsql.NormalizeTSQLIdentifier(index.GetIndex_name())
+		if !dropIndex(schemaName, tableName, indexName) {
+			return
+		}
+	}
+}
+
+// EnterCreate_view applies CREATE VIEW, CREATE OR ALTER VIEW and ALTER VIEW.
+func (l *walkThroughListener) EnterCreate_view(ctx *parser.Create_viewContext) {
+	if l.skip(ctx) || ctx.Simple_name() == nil {
+		return
+	}
+	schemaName, viewName := l.extractor.normalizeSimpleNameSeparated(ctx.Simple_name(), defaultSchema)
+	schemaMetadata := l.databaseState.GetSchemaMetadata(schemaName)
+	if schemaMetadata == nil {
+		l.setAdvice(ctx, code.SchemaNotExists, fmt.Sprintf("Schema %q does not exist", schemaName))
+		return
+	}
+	exists := schemaMetadata.GetView(viewName) != nil
+	switch {
+	case ctx.CREATE() == nil && !exists:
+		l.setAdvice(ctx, code.ViewNotExists, fmt.Sprintf("View %q does not exist in the schema %q", viewName, schemaName))
+		return
+	case ctx.CREATE() != nil && ctx.OR() == nil && exists:
+		l.setAdvice(ctx, code.ViewExists, fmt.Sprintf("The view %q already exists in the schema %q", viewName, schemaName))
+		return
+	case exists:
+		if err := schemaMetadata.DropView(viewName); err != nil {
+			l.setAdvice(ctx, code.ViewNotExists, err.Error())
+			return
+		}
+	default:
+	}
+	definition := ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	if _, err := schemaMetadata.CreateView(viewName, definition, nil); err != nil {
+		l.setAdvice(ctx, code.ViewExists, err.Error())
+	}
+}
+
+// EnterDrop_view applies DROP VIEW.
+func (l *walkThroughListener) EnterDrop_view(ctx *parser.Drop_viewContext) {
+	if l.skip(ctx) {
+		return
+	}
+	for _, simpleName := range ctx.AllSimple_name() {
+		schemaName, viewName := l.extractor.normalizeSimpleNameSeparated(simpleName, defaultSchema)
+		schemaMetadata := l.databaseState.GetSchemaMetadata(schemaName)
+		if schemaMetadata == nil || schemaMetadata.GetView(viewName) == nil {
+			if ctx.IF() != nil {
+				continue
+			}
+			l.setAdvice(ctx, code.ViewNotExists, fmt.Sprintf("View %q does not exist in the schema %q", viewName, schemaName))
+			return
+		}
+		if err := schemaMetadata.DropView(viewName); err != nil {
+			l.setAdvice(ctx, code.ViewNotExists, err.Error())
+			return
+		}
+	}
+}
+
+// addTableElements adds the columns, indexes and constraints extracted from the statement to the table.
+func (l *walkThroughListener) addTableElements(ctx antlr.ParserRuleContext, table *model.TableMetadata, elements *storepb.TableMetadata) {
+	for _, column := range elements.Columns {
+		column.Position = int32(len(table.GetProto().Columns) + 1)
+		if err := table.CreateColumn(column, nil); err != nil {
+			l.setAdvice(ctx, code.ColumnExists, fmt.Sprintf("The column %q already exists in the table %q", column.Name, table.GetProto().Name))
+			return
+		}
+	}
+	for _, index := range elements.Indexes {
+		if index.Primary && table.GetPrimaryKey() != nil {
+			l.setAdvice(ctx, code.PrimaryKeyExists, fmt.Sprintf("Primary key exists in the table %q", table.GetProto().Name))
+			return
+		}
+		for _, expression := range index.Expressions {
+			if table.GetColumn(expression) == nil {
+				l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", expression, table.GetProto().Name))
+				return
+			}
+		}
+		if err := table.CreateIndex(index); err != nil {
+			l.setAdvice(ctx, code.IndexExists, fmt.Sprintf("Index %q already exists in the table %q", index.Name, table.GetProto().Name))
+			return
+		}
+	}
+	table.GetProto().ForeignKeys = append(table.GetProto().ForeignKeys, elements.ForeignKeys...)
+	table.GetProto().CheckConstraints = append(table.GetProto().CheckConstraints, elements.CheckConstraints...)
+}
+
+// dropConstraint drops the index, foreign key or check constraint by name, and returns whether it exists.
+func dropConstraint(table *model.TableMetadata, name string) bool {
+	if table.GetIndex(name) != nil {
+		return table.DropIndex(name) == nil
+	}
+	proto := table.GetProto()
+	for i, foreignKey := range proto.ForeignKeys {
+		if strings.EqualFold(foreignKey.Name, name) {
+			proto.ForeignKeys = append(proto.ForeignKeys[:i], proto.ForeignKeys[i+1:]...)
+			return true
+		}
+	}
+	for i, check := range proto.CheckConstraints {
+		if strings.EqualFold(check.Name, name) {
+			proto.CheckConstraints = append(proto.CheckConstraints[:i], proto.CheckConstraints[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// getSchema returns the schema of the table and the table name.
+func (l *walkThroughListener) getSchema(ctx antlr.ParserRuleContext, tableNameCtx parser.ITable_nameContext) (*model.SchemaMetadata, string) {
+	if tableNameCtx == nil {
+		return nil, ""
+	}
+	if tableNameCtx.GetDatabase() != nil {
+		databaseName, _ := tsql.NormalizeTSQLIdentifier(tableNameCtx.GetDatabase())
+		if !l.isCurrentDatabase(ctx, databaseName) {
+			return nil, ""
+		}
+	}
+	schemaName, tableName := l.extractor.normalizeTableNameSeparated(tableNameCtx, "", defaultSchema)
+	schemaMetadata := l.databaseState.GetSchemaMetadata(schemaName)
+	if schemaMetadata == nil {
+		l.setAdvice(ctx, code.SchemaNotExists, fmt.Sprintf("Schema %q does not exist", schemaName))
+		return nil, ""
+	}
+	return schemaMetadata, tableName
+}
+
+// getTable returns the existing table and its schema.
+func (l *walkThroughListener) getTable(ctx antlr.ParserRuleContext, tableNameCtx parser.ITable_nameContext) (*model.SchemaMetadata, *model.TableMetadata) {
+	schemaMetadata, tableName := l.getSchema(ctx, tableNameCtx)
+	if schemaMetadata == nil {
+		return nil, nil
+	}
+	table := schemaMetadata.GetTable(tableName)
+	if table == nil {
+		l.setAdvice(ctx, code.TableNotExists, fmt.Sprintf("Table %q does not exist in the schema %q", tableName, schemaMetadata.GetProto().Name))
+	}
+	return schemaMetadata, table
+}
+
+func (l *walkThroughListener) isCurrentDatabase(ctx antlr.ParserRuleContext, databaseName string) bool {
+	if databaseName == "" || strings.EqualFold(databaseName, l.databaseState.DatabaseName()) {
+		return true
+	}
+	content := fmt.Sprintf("Database %q is not the current database %q", databaseName, l.databaseState.DatabaseName())
+	l.advice = &storepb.Advice{
+		Status:        storepb.Advice_WARNING,
+		Code:          code.NotCurrentDatabase.Int32(),
+		Title:         content,
+		Content:       content,
+		StartPosition: &storepb.Position{Line: int32(l.baseLine + ctx.GetStart().GetLine())},
+	}
+	return false
+}
+
+// skip returns true if an error is found already, or the statement is in the body of a routine, which is not
+// executed by the change.
+func (l *walkThroughListener) skip(ctx antlr.ParserRuleContext) bool {
+	if l.advice != nil {
+		return true
+	}
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		switch parent.(type) {
+		case *parser.Create_or_alter_procedureContext, *parser.Create_or_alter_functionContext, *parser.Create_or_alter_triggerContext:
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+func (l *walkThroughListener) setAdvice(ctx antlr.ParserRuleContext, adviceCode code.Code, content string) {
+	l.advice = &storepb.Advice{
+		Status:        storepb.Advice_ERROR,
+		Code:          adviceCode.Int32(),
+		Title:         content,
+		Content:       content,
+		StartPosition: &storepb.Position{Line: int32(l.baseLine + ctx.GetStart().GetLine())},
+	}
+}
@@ -0,0 +1,81 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestWalkThrough(t *testing.T) {
+	sm := sheet.NewManager(nil)
+	newState := func() *model.DatabaseMetadata {
+		return model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+			Name: "db",
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Name: "dbo",
+					Tables: []*storepb.TableMetadata{
+						{
+							Name:    "t1",
+							Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "int", Position: 1}},
+						},
+					},
+				},
+			},
+		}, nil, nil, storepb.Engine_MSSQL, false)
+	}
+
+	state := newState()
+	asts, _ := sm.GetASTsForChecks(storepb.Engine_MSSQL, `
+CREATE TABLE dbo.t2 (id int NOT NULL, name nvarchar(10), CONSTRAINT PK_t2 PRIMARY KEY (id));
+CREATE INDEX idx_t2_name ON t2 (name);
+ALTER TABLE t1 ADD c1 int NULL;
+ALTER TABLE t1 ALTER COLUMN c1 bigint NOT NULL;
+ALTER TABLE t2 DROP CONSTRAINT PK_t2;
+CREATE VIEW v1 AS SELECT id FROM t1;
+`)
+	require.Nil(t, WalkThrough(state, asts))
+	schema := state.GetSchemaMetadata("dbo")
+	t1, t2 := schema.GetTable("t1"), schema.GetTable("t2")
+	require.NotNil(t, t2)
+	require.Len(t, t2.GetProto().Columns, 2)
+	require.NotNil(t, t2.GetIndex("idx_t2_name"))
+	require.Nil(t, t2.GetPrimaryKey())
+	require.Equal(t, "bigint", t1.GetColumn("c1").GetProto().Type)
+	require.False(t, t1.GetColumn("c1").GetProto().Nullable)
+	require.Equal(t, int32(2), t1.GetColumn("c1").GetProto().Position)
+	require.NotNil(t, schema.GetView("v1"))
+
+	tests := []struct {
+		statement string
+		code      code.Code
+	}{
+		{statement: "CREATE TABLE t1 (id int);", code: code.TableExists},
+		{statement: "DROP TABLE t2;", code: code.TableNotExists},
+		{statement: "ALTER TABLE t1 ADD id int;", code: code.ColumnExists},
+		{statement: "ALTER TABLE t1 DROP COLUMN c2;", code: code.ColumnNotExists},
+		{statement: "CREATE INDEX idx ON t1 (c2);", code: code.ColumnNotExists},
+		{statement: "DROP INDEX idx ON t1;", code: code.IndexNotExists},
+		{statement: "CREATE TABLE s1.t2 (id int);", code: code.SchemaNotExists},
+		{statement: "ALTER VIEW v2 AS SELECT 1 AS a;", code: code.ViewNotExists},
+	}
+	for _, test := range tests {
+		asts, _ := sm.GetASTsForChecks(storepb.Engine_MSSQL, test.statement)
+		advice := WalkThrough(newState(), asts)
+		require.NotNil(t, advice, test.statement)
+		require.Equal(t, test.code.Int32(), advice.Code, test.statement)
+	}
+
+	// The statements in the routine bodies and the missing objects with IF EXISTS are skipped.
+	asts, _ = sm.GetASTsForChecks(storepb.Engine_MSSQL, `
+CREATE PROCEDURE p1 AS BEGIN DROP TABLE t9; END;
+GO
+DROP TABLE IF EXISTS t9;
+`)
+	require.Nil(t, WalkThrough(newState(), asts))
+}
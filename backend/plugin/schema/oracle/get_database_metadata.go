@@ -29,20 +29,7 @@ func GetDatabaseMetadata(schemaText string) (*storepb.DatabaseSchemaMetadata, er
 		return nil, errors.New("no parse results")
 	}
 
-	extractor := &metadataExtractor{
-		currentSchema:     "",
-		currentTable:      "",
-		tables:            make(map[string]*storepb.TableMetadata),
-		views:             make(map[string]*storepb.ViewMetadata),
-		materializedViews: make(map[string]*storepb.MaterializedViewMetadata),
-		functions:         make(map[string]*storepb.FunctionMetadata),
-		procedures:        make(map[string]*storepb.ProcedureMetadata),
-		triggers:          make(map[string]*storepb.TriggerMetadata),
-		sequences:         make(map[string]*storepb.SequenceMetadata),
-		packages:          make(map[string]*storepb.PackageMetadata),
-		inlinePrimaryKeys: make(map[string][]string),
-		inlineUniqueKeys:  make(map[string][]string),
-	}
+	extractor := newMetadataExtractor()
 
 	// Walk all parse result trees to extract metadata from all statements
 	for _, result := range results {
@@ -164,6 +151,21 @@ type metadataExtractor struct {
 	inlineUniqueKeys  map[string][]string // Track inline unique key columns by table
 }
 
+func newMetadataExtractor() *metadataExtractor {
+	return &metadataExtractor{
+		tables:            make(map[string]*storepb.TableMetadata),
+		views:             make(map[string]*storepb.ViewMetadata),
+		materializedViews: make(map[string]*storepb.MaterializedViewMetadata),
+		functions:         make(map[string]*storepb.FunctionMetadata),
+		procedures:        make(map[string]*storepb.ProcedureMetadata),
+		triggers:          make(map[string]*storepb.TriggerMetadata),
+		sequences:         make(map[string]*storepb.SequenceMetadata),
+		packages:          make(map[string]*storepb.PackageMetadata),
+		inlinePrimaryKeys: make(map[string][]string),
+		inlineUniqueKeys:  make(map[string][]string),
+	}
+}
+
 // Helper function to get or create table
 func (e *metadataExtractor) getOrCreateTable(tableName string) *storepb.TableMetadata {
 	if table, exists := e.tables[tableName]; exists {
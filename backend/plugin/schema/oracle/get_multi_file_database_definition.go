@@ -0,0 +1,114 @@
+package oracle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGetMultiFileDatabaseDefinition(storepb.Engine_ORACLE, GetMultiFileDatabaseDefinition)
+}
+
+// GetMultiFileDatabaseDefinition generates one SDL file per database object, organized as
+// <object type>/<object>.sql. An Oracle database is a single schema, so there is no schema folder.
+func GetMultiFileDatabaseDefinition(_ schema.GetDefinitionContext, metadata *storepb.DatabaseSchemaMetadata) (*schema.MultiFileSchemaResult, error) {
+	files := []schema.File{}
+	if metadata == nil || len(metadata.Schemas) == 0 {
+		return &schema.MultiFileSchemaResult{Files: files}, nil
+	}
+	schemaMetadata := metadata.Schemas[0]
+
+	var sequenceBuf strings.Builder
+	for _, sequence := range schemaMetadata.Sequences {
+		// Skip system-generated sequences.
+		if sequence.SkipDump || strings.HasPrefix(sequence.Name, "ISEQ$$_") {
+			continue
+		}
+		if err := writeSequence(&sequenceBuf, sequence); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate sequence SDL for %s", sequence.Name)
+		}
+	}
+	if sequenceBuf.Len() > 0 {
+		files = append(files, schema.File{
+			Name:    "sequences.sql",
+			Content: sequenceBuf.String(),
+		})
+	}
+
+	for _, table := range schemaMetadata.Tables {
+		if table.SkipDump {
+			continue
+		}
+		var buf strings.Builder
+		if err := writeTable(&buf, schemaMetadata.Name, table); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate table SDL for %s", table.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("tables/%s.sql", table.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, view := range schemaMetadata.Views {
+		if view.SkipDump {
+			continue
+		}
+		var buf strings.Builder
+		if err := writeView(&buf, schemaMetadata.Name, view); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate view SDL for %s", view.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("views/%s.sql", view.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, view := range schemaMetadata.MaterializedViews {
+		if view.SkipDump {
+			continue
+		}
+		var buf strings.Builder
+		if err := writeMaterializedView(&buf, schemaMetadata.Name, view); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate materialized view SDL for %s", view.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("materialized_views/%s.sql", view.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, function := range schemaMetadata.Functions {
+		if function.SkipDump {
+			continue
+		}
+		var buf strings.Builder
+		if err := writeFunction(&buf, schemaMetadata.Name, function); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate function SDL for %s", function.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("functions/%s.sql", function.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, procedure := range schemaMetadata.Procedures {
+		if procedure.SkipDump {
+			continue
+		}
+		var buf strings.Builder
+		if err := writeProcedure(&buf, schemaMetadata.Name, procedure); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate procedure SDL for %s", procedure.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("procedures/%s.sql", procedure.Name),
+			Content: buf.String(),
+		})
+	}
+
+	return &schema.MultiFileSchemaResult{Files: files}, nil
+}
@@ -0,0 +1,67 @@
+package oracle
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func init() {
+	schema.RegisterGetSDLDiff(storepb.Engine_ORACLE, GetSDLDiff)
+}
+
+// GetSDLDiff computes the diff between the user's SDL and the current database schema.
+func GetSDLDiff(currentSDLText, previousUserSDLText string, currentSchema, previousSchema *model.DatabaseMetadata) (*schema.MetadataDiff, error) {
+	if err := validateSDL(currentSDLText, currentSchema); err != nil {
+		return nil, err
+	}
+	return schema.GetSDLDiffByMetadata(storepb.Engine_ORACLE, currentSDLText, previousUserSDLText, currentSchema, previousSchema, normalizeSDLMetadata)
+}
+
+// normalizeSDLMetadata folds all parsed objects into the single unnamed schema used by the
+// Oracle syncer, since an Oracle database is a schema. Every object list of the schemas is kept,
+// so that no object looks dropped in the SDL diff.
+func normalizeSDLMetadata(metadata *storepb.DatabaseSchemaMetadata) {
+	merged := &storepb.SchemaMetadata{}
+	mergedMessage := merged.ProtoReflect()
+	for _, schema := range metadata.Schemas {
+		schema.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			if !field.IsList() {
+				return true
+			}
+			list := mergedMessage.Mutable(field).List()
+			for i := 0; i < value.List().Len(); i++ {
+				list.Append(value.List().Get(i))
+			}
+			return true
+		})
+	}
+	metadata.Schemas = []*storepb.SchemaMetadata{merged}
+}
+
+// validateSDL walks through the SDL statements on an empty database, so that conflicting definitions,
+// e.g. a table defined twice or an index on a missing table, are reported instead of being merged silently.
+func validateSDL(sdlText string, currentSchema *model.DatabaseMetadata) error {
+	if strings.TrimSpace(sdlText) == "" {
+		return nil
+	}
+	state := &storepb.DatabaseSchemaMetadata{}
+	if currentSchema != nil {
+		state.Name = currentSchema.DatabaseName()
+	}
+	asts, err := base.Parse(storepb.Engine_ORACLE, sdlText)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse SDL")
+	}
+	advice := WalkThrough(model.NewDatabaseMetadata(state, nil, nil, storepb.Engine_ORACLE, true), asts)
+	if advice != nil && advice.Status == storepb.Advice_ERROR {
+		return errors.Errorf("invalid SDL at line %d: %s", advice.GetStartPosition().GetLine(), advice.Content)
+	}
+	return nil
+}
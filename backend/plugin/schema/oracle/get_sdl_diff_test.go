@@ -0,0 +1,82 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGetSDLDiff(t *testing.T) {
+	currentMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "T1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER", Nullable: false},
+						},
+					},
+				},
+			},
+		},
+	}
+	currentSchema := model.NewDatabaseMetadata(currentMetadata, nil, nil, storepb.Engine_ORACLE, true)
+	generatedSDL, err := schema.GetDatabaseDefinition(storepb.Engine_ORACLE, schema.GetDefinitionContext{SkipBackupSchema: true, SDLFormat: true}, currentMetadata)
+	require.NoError(t, err)
+
+	// The dumped schema is the same as the user's SDL.
+	diff, err := GetSDLDiff(generatedSDL, "", currentSchema, nil)
+	require.NoError(t, err)
+	require.Empty(t, diff.TableChanges)
+
+	// The user adds a table.
+	diff, err = GetSDLDiff(generatedSDL+"\nCREATE TABLE \"T2\" (\n  \"ID\" NUMBER NOT NULL\n);\n", "", currentSchema, nil)
+	require.NoError(t, err)
+	require.Len(t, diff.TableChanges, 1)
+	require.Equal(t, schema.MetadataDiffActionCreate, diff.TableChanges[0].Action)
+	require.Equal(t, "T2", diff.TableChanges[0].TableName)
+
+	// An empty SDL drops the table of the only schema.
+	diff, err = GetSDLDiff("", "", currentSchema, nil)
+	require.NoError(t, err)
+	require.Len(t, diff.TableChanges, 1)
+	require.Equal(t, schema.MetadataDiffActionDrop, diff.TableChanges[0].Action)
+	require.Empty(t, diff.SchemaChanges)
+}
+
+func TestNormalizeSDLMetadataKeepsAllObjects(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name:   "",
+				Tables: []*storepb.TableMetadata{{Name: "T1"}},
+			},
+			{
+				Name:           "HR",
+				Tables:         []*storepb.TableMetadata{{Name: "T2"}},
+				ExternalTables: []*storepb.ExternalTableMetadata{{Name: "EXT1"}},
+				EnumTypes:      []*storepb.EnumTypeMetadata{{Name: "E1"}},
+			},
+		},
+	}
+	normalizeSDLMetadata(metadata)
+	require.Len(t, metadata.Schemas, 1)
+	merged := metadata.Schemas[0]
+	require.Equal(t, "", merged.Name)
+	require.Len(t, merged.Tables, 2)
+	require.Len(t, merged.ExternalTables, 1)
+	require.Len(t, merged.EnumTypes, 1)
+}
+
+func TestGetSDLDiffInvalidSDL(t *testing.T) {
+	sdl := "CREATE TABLE \"T1\" (\n  \"ID\" NUMBER NOT NULL\n);\nCREATE TABLE \"T1\" (\n  \"ID\" NUMBER NOT NULL\n);\n"
+	_, err := GetSDLDiff(sdl, "", nil, nil)
+	require.ErrorContains(t, err, "invalid SDL")
+}
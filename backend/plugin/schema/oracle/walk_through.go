@@ -0,0 +1,442 @@
+package oracle
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/plsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	oracleparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// WalkThrough applies the DDL statements to the database metadata, which validates the SDL statements before
+// computing the SDL diff. The tables, indexes and columns are built by the same extractor as the SDL parser.
+// An Oracle database is a schema, so the objects are in the single unnamed schema of the metadata.
+func WalkThrough(d *model.DatabaseMetadata, asts []base.AST) *storepb.Advice {
+	listener := &walkThroughListener{
+		BasePlSqlParserListener: &parser.BasePlSqlParserListener{},
+		databaseState:           d,
+		extractor:               newMetadataExtractor(),
+	}
+	for _, ast := range asts {
+		antlrAST, ok := base.GetANTLRAST(ast)
+		if !ok {
+			return &storepb.Advice{
+				Status:        storepb.Advice_ERROR,
+				Code:          code.Internal.Int32(),
+				Title:         "Oracle walk-through expects ANTLR-based parser result",
+				Content:       "Oracle walk-through expects ANTLR-based parser result",
+				StartPosition: &storepb.Position{Line: 0},
+			}
+		}
+		listener.baseLine = base.GetLineOffset(antlrAST.StartPosition)
+		antlr.ParseTreeWalkerDefault.Walk(listener, antlrAST.Tree)
+		if listener.advice != nil {
+			return listener.advice
+		}
+	}
+	return nil
+}
+
+type walkThroughListener struct {
+	*parser.BasePlSqlParserListener
+
+	databaseState *model.DatabaseMetadata
+	// extractor converts the column definitions and constraints in the same way as the SDL parser.
+	extractor *metadataExtractor
+	baseLine  int
+	advice    *storepb.Advice
+
+	// alterSchema and alterTable are the schema and table of the ALTER TABLE statement being walked through.
+	alterSchema *model.SchemaMetadata
+	alterTable  *model.TableMetadata
+}
+
+// EnterCreate_table applies CREATE TABLE.
+func (l *walkThroughListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	if l.advice != nil || ctx.Table_name() == nil {
+		return
+	}
+	schemaMetadata := l.getSchema(ctx, oracleparser.NormalizeSchemaName(ctx.Schema_name()))
+	if schemaMetadata == nil {
+		return
+	}
+	tableName := oracleparser.NormalizeTableName(ctx.Table_name())
+	if schemaMetadata.GetTable(tableName) != nil {
+		l.setAdvice(ctx, code.TableExists, fmt.Sprintf("The table %q already exists", tableName))
+		return
+	}
+	extractor := newMetadataExtractor()
+	antlr.ParseTreeWalkerDefault.Walk(extractor, ctx)
+	if extractor.err != nil {
+		l.setAdvice(ctx, code.Internal, extractor.err.Error())
+		return
+	}
+	table, err := schemaMetadata.CreateTable(tableName)
+	if err != nil {
+		l.setAdvice(ctx, code.TableExists, err.Error())
+		return
+	}
+	if elements, ok := extractor.tables[tableName]; ok {
+		l.addTableElements(ctx, schemaMetadata, table, elements)
+	}
+}
+
+// EnterDrop_table applies DROP TABLE.
+func (l *walkThroughListener) EnterDrop_table(ctx *parser.Drop_tableContext) {
+	if l.advice != nil {
+		return
+	}
+	schemaMetadata, tableName := l.getTableName(ctx, ctx.Tableview_name())
+	if schemaMetadata == nil {
+		return
+	}
+	if err := schemaMetadata.DropTable(tableName); err != nil {
+		l.setAdvice(ctx, code.TableNotExists, fmt.Sprintf("Table %q does not exist", tableName))
+	}
+}
+
+// EnterAlter_table resolves the table altered by the clauses.
+func (l *walkThroughListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	if l.advice != nil {
+		return
+	}
+	schemaMetadata, tableName := l.getTableName(ctx, ctx.Tableview_name())
+	if schemaMetadata == nil {
+		return
+	}
+	table := schemaMetadata.GetTable(tableName)
+	if table == nil {
+		l.setAdvice(ctx, code.TableNotExists, fmt.Sprintf("Table %q does not exist", tableName))
+		return
+	}
+	l.alterSchema, l.alterTable = schemaMetadata, table
+}
+
+// ExitAlter_table resets the altered table.
+func (l *walkThroughListener) ExitAlter_table(*parser.Alter_tableContext) {
+	l.alterSchema, l.alterTable = nil, nil
+}
+
+// EnterAdd_column_clause applies ALTER TABLE ... ADD column.
+func (l *walkThroughListener) EnterAdd_column_clause(ctx *parser.Add_column_clauseContext) {
+	if l.skipAlterTable() {
+		return
+	}
+	elements := &storepb.TableMetadata{}
+	for _, column := range ctx.AllColumn_definition() {
+		if c := l.extractor.extractColumnDefinition(column); c != nil {
+			elements.Columns = append(elements.Columns, c)
+		}
+	}
+	for _, column := range ctx.AllVirtual_column_definition() {
+		if c := l.extractor.extractVirtualColumnDefinition(column); c != nil {
+			elements.Columns = append(elements.Columns, c)
+		}
+	}
+	l.addTableElements(ctx, l.alterSchema, l.alterTable, elements)
+}
+
+// EnterModify_col_properties applies ALTER TABLE ... MODIFY column.
+func (l *walkThroughListener) EnterModify_col_properties(ctx *parser.Modify_col_propertiesContext) {
+	if l.skipAlterTable() {
+		return
+	}
+	columnName := normalizeColumnName(ctx.Column_name())
+	column := l.alterTable.GetColumn(columnName)
+	if column == nil {
+		l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", columnName, l.alterTable.GetProto().Name))
+		return
+	}
+	if ctx.Datatype() != nil {
+		column.GetProto().Type = l.extractor.extractDataType(ctx.Datatype())
+	}
+	if ctx.DEFAULT() != nil && ctx.Expression() != nil {
+		column.GetProto().Default = getTextFromContext(ctx.Expression())
+	}
+	for _, constraint := range ctx.AllInline_constraint() {
+		l.extractor.extractInlineConstraint(constraint, column.GetProto())
+	}
+}
+
+// EnterDrop_column_clause applies ALTER TABLE ... DROP COLUMN and SET UNUSED COLUMN.
+func (l *walkThroughListener) EnterDrop_column_clause(ctx *parser.Drop_column_clauseContext) {
+	if l.skipAlterTable() {
+		return
+	}
+	for _, column := range ctx.AllColumn_name() {
+		columnName := normalizeColumnName(column)
+		if err := l.alterTable.DropColumn(columnName); err != nil {
+			l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", columnName, l.alterTable.GetProto().Name))
+			return
+		}
+	}
+}
+
+// EnterRename_column_clause applies ALTER TABLE ... RENAME COLUMN.
+func (l *walkThroughListener) EnterRename_column_clause(ctx *parser.Rename_column_clauseContext) {
+	if l.skipAlterTable() {
+		return
+	}
+	oldName := normalizeColumnName(ctx.Old_column_name().Column_name())
+	newName := normalizeColumnName(ctx.New_column_name().Column_name())
+	if l.alterTable.GetColumn(oldName) == nil {
+		l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", oldName, l.alterTable.GetProto().Name))
+		return
+	}
+	if err := l.alterTable.RenameColumn(oldName, newName); err != nil {
+		l.setAdvice(ctx, code.ColumnExists, err.Error())
+	}
+}
+
+// EnterAlter_table_properties applies ALTER TABLE ... RENAME TO.
+func (l *walkThroughListener) EnterAlter_table_properties(ctx *parser.Alter_table_propertiesContext) {
+	if l.skipAlterTable() || ctx.RENAME() == nil || ctx.Tableview_name() == nil {
+		return
+	}
+	_, _, newName := oracleparser.NormalizeTableViewName("", ctx.Tableview_name())
+	if err := l.alterSchema.RenameTable(l.alterTable.GetProto().Name, newName); err != nil {
+		l.setAdvice(ctx, code.TableExists, err.Error())
+	}
+}
+
+// EnterConstraint_clauses applies ALTER TABLE ... ADD constraint.
+func (l *walkThroughListener) EnterConstraint_clauses(ctx *parser.Constraint_clausesContext) {
+	if l.skipAlterTable() || ctx.ADD() == nil {
+		return
+	}
+	elements := &storepb.TableMetadata{Name: l.alterTable.GetProto().Name}
+	for _, constraint := range ctx.AllOut_of_line_constraint() {
+		l.extractor.extractOutOfLineConstraint(constraint, elements)
+	}
+	if ctx.Out_of_line_ref_constraint() != nil {
+		l.extractor.extractOutOfLineRefConstraint(ctx.Out_of_line_ref_constraint(), elements)
+	}
+	l.addTableElements(ctx, l.alterSchema, l.alterTable, elements)
+}
+
+// EnterDrop_primary_key_or_unique_or_generic_clause applies ALTER TABLE ... DROP constraint.
+func (l *walkThroughListener) EnterDrop_primary_key_or_unique_or_generic_clause(ctx *parser.Drop_primary_key_or_unique_or_generic_clauseContext) {
+	if l.skipAlterTable() {
+		return
+	}
+	table := l.alterTable
+	switch {
+	case ctx.PRIMARY() != nil:
+		primaryKey := table.GetPrimaryKey()
+		if primaryKey == nil {
+			l.setAdvice(ctx, code.ConstraintNotExists, fmt.Sprintf("Primary key does not exist in the table %q", table.GetProto().Name))
+			return
+		}
+		_ = table.DropIndex(primaryKey.GetProto().Name)
+	case ctx.UNIQUE() != nil:
+		var columns []string
+		for _, column := range ctx.AllColumn_name() {
+			columns = append(columns, normalizeColumnName(column))
+		}
+		for _, index := range table.ListIndexes() {
+			if index.GetProto().Unique && !index.GetProto().Primary && slices.Equal(index.GetProto().Expressions, columns) {
+				_ = table.DropIndex(index.GetProto().Name)
+				return
+			}
+		}
+		l.setAdvice(ctx, code.ConstraintNotExists, fmt.Sprintf("Unique key on %s does not exist in the table %q", strings.Join(columns, ", "), table.GetProto().Name))
+	case ctx.Constraint_name() != nil:
+		constraintName := normalizeConstraintName(ctx.Constraint_name())
+		if !dropConstraint(table, constraintName) {
+			l.setAdvice(ctx, code.ConstraintNotExists, fmt.Sprintf("Constraint %q does not exist in the table %q", constraintName, table.GetProto().Name))
+		}
+	default:
+	}
+}
+
+// EnterCreate_index applies CREATE INDEX.
+func (l *walkThroughListener) EnterCreate_index(ctx *parser.Create_indexContext) {
+	if l.advice != nil || ctx.Table_index_clause() == nil {
+		return
+	}
+	schemaMetadata, tableName := l.getTableName(ctx, ctx.Table_index_clause().Tableview_name())
+	if schemaMetadata == nil {
+		return
+	}
+	table := schemaMetadata.GetTable(tableName)
+	if table == nil {
+		l.setAdvice(ctx, code.TableNotExists, fmt.Sprintf("Table %q does not exist", tableName))
+		return
+	}
+	extractor := newMetadataExtractor()
+	antlr.ParseTreeWalkerDefault.Walk(extractor, ctx)
+	if elements, ok := extractor.tables[tableName]; ok {
+		l.addTableElements(ctx, schemaMetadata, table, elements)
+	}
+}
+
+// EnterDrop_index applies DROP INDEX.
+func (l *walkThroughListener) EnterDrop_index(ctx *parser.Drop_indexContext) {
+	if l.advice != nil {
+		return
+	}
+	schemaName, indexName := oracleparser.NormalizeIndexName(ctx.Index_name())
+	schemaMetadata := l.getSchema(ctx, schemaName)
+	if schemaMetadata == nil {
+		return
+	}
+	index := schemaMetadata.GetIndex(indexName)
+	if index == nil {
+		l.setAdvice(ctx, code.IndexNotExists, fmt.Sprintf("Index %q does not exist", indexName))
+		return
+	}
+	if err := schemaMetadata.GetTable(index.GetTableProto().Name).DropIndex(indexName); err != nil {
+		l.setAdvice(ctx, code.IndexNotExists, err.Error())
+	}
+}
+
+// EnterCreate_view applies CREATE VIEW and CREATE OR REPLACE VIEW.
+func (l *walkThroughListener) EnterCreate_view(ctx *parser.Create_viewContext) {
+	if l.advice != nil || ctx.GetV() == nil {
+		return
+	}
+	schemaMetadata := l.getSchema(ctx, oracleparser.NormalizeSchemaName(ctx.Schema_name()))
+	if schemaMetadata == nil {
+		return
+	}
+	viewName := oracleparser.NormalizeIDExpression(ctx.GetV())
+	if schemaMetadata.GetView(viewName) != nil {
+		if ctx.REPLACE() == nil {
+			l.setAdvice(ctx, code.ViewExists, fmt.Sprintf("The view %q already exists", viewName))
+			return
+		}
+		if err := schemaMetadata.DropView(viewName); err != nil {
+			l.setAdvice(ctx, code.ViewNotExists, err.Error())
+			return
+		}
+	}
+	definition := ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	if _, err := schemaMetadata.CreateView(viewName, definition, nil); err != nil {
+		l.setAdvice(ctx, code.ViewExists, err.Error())
+	}
+}
+
+// EnterDrop_view applies DROP VIEW.
+func (l *walkThroughListener) EnterDrop_view(ctx *parser.Drop_viewContext) {
+	if l.advice != nil {
+		return
+	}
+	schemaMetadata, viewName := l.getTableName(ctx, ctx.Tableview_name())
+	if schemaMetadata == nil {
+		return
+	}
+	if err := schemaMetadata.DropView(viewName); err != nil {
+		l.setAdvice(ctx, code.ViewNotExists, fmt.Sprintf("View %q does not exist", viewName))
+	}
+}
+
+// addTableElements adds the columns, indexes and constraints extracted from the statement to the table.
+func (l *walkThroughListener) addTableElements(ctx antlr.ParserRuleContext, schemaMetadata *model.SchemaMetadata, table *model.TableMetadata, elements *storepb.TableMetadata) {
+	for _, column := range elements.Columns {
+		column.Position = int32(len(table.GetProto().Columns) + 1)
+		if err := table.CreateColumn(column, nil); err != nil {
+			l.setAdvice(ctx, code.ColumnExists, fmt.Sprintf("The column %q already exists in the table %q", column.Name, table.GetProto().Name))
+			return
+		}
+	}
+	for _, index := range elements.Indexes {
+		if index.Primary && table.GetPrimaryKey() != nil {
+			l.setAdvice(ctx, code.PrimaryKeyExists, fmt.Sprintf("Primary key exists in the table %q", table.GetProto().Name))
+			return
+		}
+		// Index names are unique in the schema.
+		if schemaMetadata.GetIndex(index.Name) != nil {
+			l.setAdvice(ctx, code.IndexExists, fmt.Sprintf("Index %q already exists", index.Name))
+			return
+		}
+		for _, expression := range index.Expressions {
+			// The function-based index expressions are not checked.
+			if !strings.Contains(expression, "(") && table.GetColumn(expression) == nil {
+				l.setAdvice(ctx, code.ColumnNotExists, fmt.Sprintf("Column %q does not exist in the table %q", expression, table.GetProto().Name))
+				return
+			}
+		}
+		if err := table.CreateIndex(index); err != nil {
+			l.setAdvice(ctx, code.IndexExists, fmt.Sprintf("Index %q already exists", index.Name))
+			return
+		}
+	}
+	table.GetProto().ForeignKeys = append(table.GetProto().ForeignKeys, elements.ForeignKeys...)
+	table.GetProto().CheckConstraints = append(table.GetProto().CheckConstraints, elements.CheckConstraints...)
+}
+
+// dropConstraint drops the index, foreign key or check constraint by name, and returns whether it exists.
+func dropConstraint(table *model.TableMetadata, name string) bool {
+	if table.GetIndex(name) != nil {
+		return table.DropIndex(name) == nil
+	}
+	proto := table.GetProto()
+	for i, foreignKey := range proto.ForeignKeys {
+		if foreignKey.Name == name {
+			proto.ForeignKeys = append(proto.ForeignKeys[:i], proto.ForeignKeys[i+1:]...)
+			return true
+		}
+	}
+	for i, check := range proto.CheckConstraints {
+		if check.Name == name {
+			proto.CheckConstraints = append(proto.CheckConstraints[:i], proto.CheckConstraints[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// getSchema returns the schema of the metadata if the schema name is empty or the current database.
+func (l *walkThroughListener) getSchema(ctx antlr.ParserRuleContext, schemaName string) *model.SchemaMetadata {
+	if schemaName != "" && schemaName != l.databaseState.DatabaseName() {
+		content := fmt.Sprintf("Database %q is not the current database %q", schemaName, l.databaseState.DatabaseName())
+		l.advice = &storepb.Advice{
+			Status:        storepb.Advice_WARNING,
+			Code:          code.NotCurrentDatabase.Int32(),
+			Title:         content,
+			Content:       content,
+			StartPosition: &storepb.Position{Line: int32(l.baseLine + ctx.GetStart().GetLine())},
+		}
+		return nil
+	}
+	schemaMetadata := l.databaseState.GetSchemaMetadata("")
+	if schemaMetadata == nil {
+		schemaMetadata = l.databaseState.CreateSchema("")
+	}
+	return schemaMetadata
+}
+
+// getTableName returns the schema and the name of the table or view.
+func (l *walkThroughListener) getTableName(ctx antlr.ParserRuleContext, tableViewName parser.ITableview_nameContext) (*model.SchemaMetadata, string) {
+	if tableViewName == nil {
+		return nil, ""
+	}
+	_, schemaName, tableName := oracleparser.NormalizeTableViewName("", tableViewName)
+	schemaMetadata := l.getSchema(ctx, schemaName)
+	if schemaMetadata == nil {
+		return nil, ""
+	}
+	return schemaMetadata, tableName
+}
+
+// skipAlterTable returns true if an error is found already, or the table of the ALTER TABLE statement is unknown.
+func (l *walkThroughListener) skipAlterTable() bool {
+	return l.advice != nil || l.alterTable == nil
+}
+
+func (l *walkThroughListener) setAdvice(ctx antlr.ParserRuleContext, adviceCode code.Code, content string) {
+	l.advice = &storepb.Advice{
+		Status:        storepb.Advice_ERROR,
+		Code:          adviceCode.Int32(),
+		Title:         content,
+		Content:       content,
+		StartPosition: &storepb.Position{Line: int32(l.baseLine + ctx.GetStart().GetLine())},
+	}
+}
@@ -0,0 +1,73 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestWalkThrough(t *testing.T) {
+	sm := sheet.NewManager(nil)
+	newState := func() *model.DatabaseMetadata {
+		return model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+			Name: "HR",
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Tables: []*storepb.TableMetadata{
+						{
+							Name:    "T1",
+							Columns: []*storepb.ColumnMetadata{{Name: "ID", Type: "NUMBER", Position: 1}},
+						},
+					},
+				},
+			},
+		}, nil, nil, storepb.Engine_ORACLE, true)
+	}
+
+	state := newState()
+	asts, _ := sm.GetASTsForChecks(storepb.Engine_ORACLE, `
+CREATE TABLE HR.T2 (ID NUMBER NOT NULL, NAME VARCHAR2(10), CONSTRAINT PK_T2 PRIMARY KEY (ID));
+CREATE INDEX IDX_T2_NAME ON T2 (NAME);
+ALTER TABLE T1 ADD C1 NUMBER;
+ALTER TABLE T1 MODIFY C1 VARCHAR2(20) NOT NULL;
+ALTER TABLE T1 RENAME COLUMN C1 TO C2;
+ALTER TABLE T2 DROP CONSTRAINT PK_T2;
+CREATE VIEW V1 AS SELECT ID FROM T1;
+`)
+	require.Nil(t, WalkThrough(state, asts))
+	schema := state.GetSchemaMetadata("")
+	t1, t2 := schema.GetTable("T1"), schema.GetTable("T2")
+	require.NotNil(t, t2)
+	require.Len(t, t2.GetProto().Columns, 2)
+	require.NotNil(t, t2.GetIndex("IDX_T2_NAME"))
+	require.Nil(t, t2.GetPrimaryKey())
+	require.Nil(t, t1.GetColumn("C1"))
+	require.Equal(t, "VARCHAR2(20 BYTE)", t1.GetColumn("C2").GetProto().Type)
+	require.False(t, t1.GetColumn("C2").GetProto().Nullable)
+	require.NotNil(t, schema.GetView("V1"))
+
+	tests := []struct {
+		statement string
+		code      code.Code
+	}{
+		{statement: "CREATE TABLE T1 (ID NUMBER);", code: code.TableExists},
+		{statement: "DROP TABLE T2;", code: code.TableNotExists},
+		{statement: "ALTER TABLE T1 ADD ID NUMBER;", code: code.ColumnExists},
+		{statement: "ALTER TABLE T1 DROP COLUMN C2;", code: code.ColumnNotExists},
+		{statement: "CREATE INDEX IDX ON T1 (C2);", code: code.ColumnNotExists},
+		{statement: "DROP INDEX IDX;", code: code.IndexNotExists},
+		{statement: "CREATE TABLE SCOTT.T2 (ID NUMBER);", code: code.NotCurrentDatabase},
+		{statement: "DROP VIEW V2;", code: code.ViewNotExists},
+	}
+	for _, test := range tests {
+		asts, _ := sm.GetASTsForChecks(storepb.Engine_ORACLE, test.statement)
+		advice := WalkThrough(newState(), asts)
+		require.NotNil(t, advice, test.statement)
+		require.Equal(t, test.code.Int32(), advice.Code, test.statement)
+	}
+}
@@ -0,0 +1,378 @@
+package redshift
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_REDSHIFT, generateMigration)
+}
+
+// varcharTypeRegexp matches the character varying types whose length Redshift can alter in place.
+var varcharTypeRegexp = regexp.MustCompile(`(?i)^(character varying|varchar|nvarchar|text)\s*(\(\s*\d+\s*\))?$`)
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// Safe order for migrations:
+	// 1. Drop views, foreign keys and constraints that depend on the objects being changed.
+	// 2. Drop tables and schemas.
+	// 3. Create schemas and tables, then alter the remaining tables.
+	// 4. Re-create foreign keys and views, and finally apply comments.
+	dropObjectsInOrder(diff, &buf)
+
+	dropPhaseHasContent := buf.Len() > 0
+	if dropPhaseHasContent && hasCreateOrAlterObjects(diff) {
+		_, _ = buf.WriteString("\n")
+	}
+
+	createObjectsInOrder(diff, &buf)
+
+	return buf.String(), nil
+}
+
+func dropObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) {
+	// Drop views first, altered views are re-created in the create phase.
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop || viewDiff.Action == schema.MetadataDiffActionAlter {
+			writeDropView(buf, viewDiff.SchemaName, viewDiff.ViewName)
+		}
+	}
+
+	// Drop foreign keys before dropping the referenced tables or columns.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, fkDiff := range tableDiff.ForeignKeyChanges {
+			if fkDiff.Action == schema.MetadataDiffActionDrop || fkDiff.Action == schema.MetadataDiffActionAlter {
+				writeDropConstraint(buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.OldForeignKey.Name)
+			}
+		}
+	}
+
+	// Drop primary key and unique constraints.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, indexDiff := range tableDiff.IndexChanges {
+			if indexDiff.Action != schema.MetadataDiffActionDrop && indexDiff.Action != schema.MetadataDiffActionAlter {
+				continue
+			}
+			if !isConstraintIndex(indexDiff.OldIndex) {
+				continue
+			}
+			writeDropConstraint(buf, tableDiff.SchemaName, tableDiff.TableName, indexDiff.OldIndex.Name)
+		}
+	}
+
+	// Drop columns.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, columnDiff := range tableDiff.ColumnChanges {
+			if columnDiff.Action == schema.MetadataDiffActionDrop {
+				writeDropColumn(buf, tableDiff.SchemaName, tableDiff.TableName, columnDiff.OldColumn.Name)
+			}
+		}
+	}
+
+	// Drop tables.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionDrop {
+			writeDropTable(buf, tableDiff.SchemaName, tableDiff.TableName)
+		}
+	}
+
+	// Drop schemas last.
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "DROP SCHEMA IF EXISTS %s;\n", quoteIdentifier(schemaDiff.SchemaName))
+		}
+	}
+}
+
+func createObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) {
+	// Create schemas.
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionCreate {
+			_, _ = fmt.Fprintf(buf, "CREATE SCHEMA IF NOT EXISTS %s;\n", quoteIdentifier(schemaDiff.SchemaName))
+		}
+	}
+
+	// Create tables without foreign keys, those are added after all tables exist.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionCreate {
+			writeCreateTable(buf, tableDiff.SchemaName, tableDiff.TableName, tableDiff.NewTable)
+		}
+	}
+
+	// Alter tables.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, columnDiff := range tableDiff.ColumnChanges {
+			switch columnDiff.Action {
+			case schema.MetadataDiffActionCreate:
+				writeAddColumn(buf, tableDiff.SchemaName, tableDiff.TableName, columnDiff.NewColumn)
+			case schema.MetadataDiffActionAlter:
+				writeAlterColumn(buf, tableDiff.SchemaName, tableDiff.TableName, columnDiff)
+			default:
+			}
+		}
+		for _, indexDiff := range tableDiff.IndexChanges {
+			if indexDiff.Action != schema.MetadataDiffActionCreate && indexDiff.Action != schema.MetadataDiffActionAlter {
+				continue
+			}
+			if !isConstraintIndex(indexDiff.NewIndex) {
+				continue
+			}
+			writeAddConstraint(buf, tableDiff.SchemaName, tableDiff.TableName, indexDiff.NewIndex)
+		}
+	}
+
+	// Add foreign keys.
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			for _, fk := range tableDiff.NewTable.GetForeignKeys() {
+				writeAddForeignKey(buf, tableDiff.SchemaName, tableDiff.TableName, fk)
+			}
+		case schema.MetadataDiffActionAlter:
+			for _, fkDiff := range tableDiff.ForeignKeyChanges {
+				if fkDiff.Action == schema.MetadataDiffActionCreate || fkDiff.Action == schema.MetadataDiffActionAlter {
+					writeAddForeignKey(buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.NewForeignKey)
+				}
+			}
+		default:
+		}
+	}
+
+	// Create views.
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			writeCreateView(buf, viewDiff.SchemaName, viewDiff.NewView)
+		}
+	}
+
+	// Comments.
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			if tableDiff.NewTable.GetComment() != "" {
+				writeComment(buf, "TABLE", qualifiedName(tableDiff.SchemaName, tableDiff.TableName), tableDiff.NewTable.GetComment())
+			}
+			for _, column := range tableDiff.NewTable.GetColumns() {
+				if column.Comment != "" {
+					writeComment(buf, "COLUMN", qualifiedName(tableDiff.SchemaName, tableDiff.TableName)+"."+quoteIdentifier(column.Name), column.Comment)
+				}
+			}
+		case schema.MetadataDiffActionAlter:
+			if tableDiff.OldTable.GetComment() != tableDiff.NewTable.GetComment() {
+				writeComment(buf, "TABLE", qualifiedName(tableDiff.SchemaName, tableDiff.TableName), tableDiff.NewTable.GetComment())
+			}
+			for _, columnDiff := range tableDiff.ColumnChanges {
+				switch columnDiff.Action {
+				case schema.MetadataDiffActionCreate:
+					if columnDiff.NewColumn.Comment == "" {
+						continue
+					}
+				case schema.MetadataDiffActionAlter:
+					if columnDiff.OldColumn.Comment == columnDiff.NewColumn.Comment {
+						continue
+					}
+				default:
+					continue
+				}
+				writeComment(buf, "COLUMN", qualifiedName(tableDiff.SchemaName, tableDiff.TableName)+"."+quoteIdentifier(columnDiff.NewColumn.Name), columnDiff.NewColumn.Comment)
+			}
+		default:
+		}
+	}
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action != schema.MetadataDiffActionCreate && viewDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		// Re-created views lose their comments, so always write the comment back.
+		if viewDiff.NewView.GetComment() != "" {
+			writeComment(buf, "VIEW", qualifiedName(viewDiff.SchemaName, viewDiff.ViewName), viewDiff.NewView.GetComment())
+		}
+	}
+}
+
+func hasCreateOrAlterObjects(diff *schema.MetadataDiff) bool {
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionCreate {
+			return true
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionCreate || tableDiff.Action == schema.MetadataDiffActionAlter {
+			return true
+		}
+	}
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			return true
+		}
+	}
+	return false
+}
+
+func writeCreateTable(buf *strings.Builder, schemaName, tableName string, table *storepb.TableMetadata) {
+	_, _ = fmt.Fprintf(buf, "CREATE TABLE %s (\n", qualifiedName(schemaName, tableName))
+	var items []string
+	for _, column := range table.GetColumns() {
+		items = append(items, "  "+columnDefinition(column))
+	}
+	for _, index := range table.GetIndexes() {
+		if isConstraintIndex(index) {
+			items = append(items, "  "+constraintDefinition(index))
+		}
+	}
+	_, _ = buf.WriteString(strings.Join(items, ",\n"))
+	_, _ = buf.WriteString("\n);\n")
+}
+
+func writeDropTable(buf *strings.Builder, schemaName, tableName string) {
+	_, _ = fmt.Fprintf(buf, "DROP TABLE IF EXISTS %s;\n", qualifiedName(schemaName, tableName))
+}
+
+func writeAddColumn(buf *strings.Builder, schemaName, tableName string, column *storepb.ColumnMetadata) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN %s;\n", qualifiedName(schemaName, tableName), columnDefinition(column))
+}
+
+func writeDropColumn(buf *strings.Builder, schemaName, tableName, columnName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", qualifiedName(schemaName, tableName), quoteIdentifier(columnName))
+}
+
+// writeAlterColumn writes the statements to change a column definition.
+// Redshift only supports altering the length of VARCHAR columns in place. Any other type,
+// nullability or default change is done by adding a replacement column, copying the data
+// and swapping the names.
+func writeAlterColumn(buf *strings.Builder, schemaName, tableName string, columnDiff *schema.ColumnDiff) {
+	oldColumn, newColumn := columnDiff.OldColumn, columnDiff.NewColumn
+	typeChanged := oldColumn.Type != newColumn.Type
+	nullableChanged := oldColumn.Nullable != newColumn.Nullable
+	defaultChanged := oldColumn.Default != newColumn.Default
+	if !typeChanged && !nullableChanged && !defaultChanged {
+		// Only comment changed, handled in the comment phase.
+		return
+	}
+
+	table := qualifiedName(schemaName, tableName)
+	if typeChanged && !nullableChanged && !defaultChanged && varcharTypeRegexp.MatchString(oldColumn.Type) && varcharTypeRegexp.MatchString(newColumn.Type) {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s TYPE %s;\n", table, quoteIdentifier(newColumn.Name), newColumn.Type)
+		return
+	}
+
+	tempColumn := &storepb.ColumnMetadata{
+		Name:     newColumn.Name + "_bb_tmp",
+		Type:     newColumn.Type,
+		Nullable: newColumn.Nullable,
+		Default:  newColumn.Default,
+	}
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN %s;\n", table, columnDefinition(tempColumn))
+	_, _ = fmt.Fprintf(buf, "UPDATE %s SET %s = %s;\n", table, quoteIdentifier(tempColumn.Name), quoteIdentifier(oldColumn.Name))
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", table, quoteIdentifier(oldColumn.Name))
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s RENAME COLUMN %s TO %s;\n", table, quoteIdentifier(tempColumn.Name), quoteIdentifier(newColumn.Name))
+}
+
+func writeAddConstraint(buf *strings.Builder, schemaName, tableName string, index *storepb.IndexMetadata) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD %s;\n", qualifiedName(schemaName, tableName), constraintDefinition(index))
+}
+
+func writeDropConstraint(buf *strings.Builder, schemaName, tableName, constraintName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP CONSTRAINT %s;\n", qualifiedName(schemaName, tableName), quoteIdentifier(constraintName))
+}
+
+func writeAddForeignKey(buf *strings.Builder, schemaName, tableName string, fk *storepb.ForeignKeyMetadata) {
+	referencedSchema := fk.ReferencedSchema
+	if referencedSchema == "" {
+		referencedSchema = schemaName
+	}
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s);\n",
+		qualifiedName(schemaName, tableName),
+		quoteIdentifier(fk.Name),
+		joinIdentifiers(fk.Columns),
+		qualifiedName(referencedSchema, fk.ReferencedTable),
+		joinIdentifiers(fk.ReferencedColumns),
+	)
+}
+
+func writeCreateView(buf *strings.Builder, schemaName string, view *storepb.ViewMetadata) {
+	definition := strings.TrimRight(strings.TrimSpace(view.Definition), ";")
+	_, _ = fmt.Fprintf(buf, "CREATE OR REPLACE VIEW %s AS %s;\n", qualifiedName(schemaName, view.Name), definition)
+}
+
+func writeDropView(buf *strings.Builder, schemaName, viewName string) {
+	_, _ = fmt.Fprintf(buf, "DROP VIEW IF EXISTS %s;\n", qualifiedName(schemaName, viewName))
+}
+
+func writeComment(buf *strings.Builder, objectType, object, comment string) {
+	if comment == "" {
+		_, _ = fmt.Fprintf(buf, "COMMENT ON %s %s IS NULL;\n", objectType, object)
+		return
+	}
+	_, _ = fmt.Fprintf(buf, "COMMENT ON %s %s IS '%s';\n", objectType, object, escapeString(comment))
+}
+
+func columnDefinition(column *storepb.ColumnMetadata) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "%s %s", quoteIdentifier(column.Name), column.Type)
+	if column.Default != "" {
+		_, _ = fmt.Fprintf(&buf, " DEFAULT %s", convertToColumnState(0, column).defaultValue.toString())
+	}
+	if !column.Nullable {
+		_, _ = buf.WriteString(" NOT NULL")
+	}
+	return buf.String()
+}
+
+func constraintDefinition(index *storepb.IndexMetadata) string {
+	keyword := "UNIQUE"
+	if index.Primary {
+		keyword = "PRIMARY KEY"
+	}
+	if index.Name == "" {
+		return fmt.Sprintf("%s (%s)", keyword, joinIdentifiers(index.Expressions))
+	}
+	return fmt.Sprintf("CONSTRAINT %s %s (%s)", quoteIdentifier(index.Name), keyword, joinIdentifiers(index.Expressions))
+}
+
+// isConstraintIndex returns true if the index is backed by a table constraint.
+// Redshift has no secondary indexes, so only primary keys and unique constraints are migrated.
+func isConstraintIndex(index *storepb.IndexMetadata) bool {
+	return index != nil && (index.Primary || index.Unique)
+}
+
+func qualifiedName(schemaName, objectName string) string {
+	if schemaName == "" {
+		return quoteIdentifier(objectName)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schemaName), quoteIdentifier(objectName))
+}
+
+func joinIdentifiers(identifiers []string) string {
+	var quoted []string
+	for _, identifier := range identifiers {
+		quoted = append(quoted, quoteIdentifier(identifier))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func escapeString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
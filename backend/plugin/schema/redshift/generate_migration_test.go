@@ -0,0 +1,108 @@
+package redshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      *storepb.DatabaseSchemaMetadata
+		new      *storepb.DatabaseSchemaMetadata
+		expected string
+	}{
+		{
+			name: "create table and view",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{Name: "public"}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "public",
+					Tables: []*storepb.TableMetadata{{
+						Name:    "orders",
+						Comment: "customer orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "note", Type: "character varying(256)", Nullable: true},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "orders_pkey", Expressions: []string{"id"}, Primary: true, Unique: true},
+						},
+					}},
+					Views: []*storepb.ViewMetadata{{
+						Name:       "v_orders",
+						Definition: "SELECT id FROM public.orders;",
+					}},
+				}},
+			},
+			expected: `CREATE TABLE "public"."orders" (
+  "id" integer NOT NULL,
+  "note" character varying(256),
+  CONSTRAINT "orders_pkey" PRIMARY KEY ("id")
+);
+CREATE OR REPLACE VIEW "public"."v_orders" AS SELECT id FROM public.orders;
+COMMENT ON TABLE "public"."orders" IS 'customer orders';
+`,
+		},
+		{
+			name: "alter columns",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "public",
+					Tables: []*storepb.TableMetadata{{
+						Name: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "note", Type: "character varying(64)", Nullable: true},
+							{Name: "amount", Type: "integer", Nullable: true},
+							{Name: "legacy", Type: "integer", Nullable: true},
+						},
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "public",
+					Tables: []*storepb.TableMetadata{{
+						Name: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "note", Type: "character varying(256)", Nullable: true},
+							{Name: "amount", Type: "bigint", Nullable: true},
+							{Name: "created_at", Type: "timestamp", Nullable: true, Comment: "it's new"},
+						},
+					}},
+				}},
+			},
+			expected: `ALTER TABLE "public"."orders" DROP COLUMN "legacy";
+
+ALTER TABLE "public"."orders" ADD COLUMN "amount_bb_tmp" bigint;
+UPDATE "public"."orders" SET "amount_bb_tmp" = "amount";
+ALTER TABLE "public"."orders" DROP COLUMN "amount";
+ALTER TABLE "public"."orders" RENAME COLUMN "amount_bb_tmp" TO "amount";
+ALTER TABLE "public"."orders" ADD COLUMN "created_at" timestamp;
+ALTER TABLE "public"."orders" ALTER COLUMN "note" TYPE character varying(256);
+COMMENT ON COLUMN "public"."orders"."created_at" IS 'it''s new';
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldSchema := model.NewDatabaseMetadata(test.old, nil, nil, storepb.Engine_REDSHIFT, false)
+			newSchema := model.NewDatabaseMetadata(test.new, nil, nil, storepb.Engine_REDSHIFT, false)
+			diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_REDSHIFT, oldSchema, newSchema)
+			require.NoError(t, err)
+			got, err := schema.GenerateMigration(storepb.Engine_REDSHIFT, diff)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package schema
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// SDLMetadataNormalizer adjusts the metadata parsed from SDL text so that it is comparable with
+// the metadata synced from the database, e.g. Oracle has no schema name in the synced metadata.
+type SDLMetadataNormalizer func(*storepb.DatabaseSchemaMetadata)
+
+// GetSDLDiffByMetadata computes the SDL diff for engines without an AST-based SDL chunker.
+//
+// Both sides of the diff are canonicalized through the same parser: the current database schema is
+// dumped in SDL format and parsed back with GetDatabaseMetadata, so that differences in how the
+// syncer and the parser normalize types and defaults don't show up as changes.
+//
+// The baseline of the diff follows the same rules as the PostgreSQL chunk-based differ:
+//   - Without previous SDL text (initialization), the current database schema is the baseline.
+//   - When the database may have drifted from the previous SDL (previousSchema is provided), the current
+//     database schema is the baseline, and only the objects edited by the user since the previous SDL are
+//     changed, so that the drift of the other objects is kept.
+//   - Otherwise, the previous user SDL is the baseline, so only objects edited by the user change.
+func GetSDLDiffByMetadata(engine storepb.Engine, currentSDLText, previousUserSDLText string, currentSchema, previousSchema *model.DatabaseMetadata, normalize SDLMetadataNormalizer) (*MetadataDiff, error) {
+	var generatedSDL string
+	if currentSchema != nil {
+		text, err := GetDatabaseDefinition(engine, GetDefinitionContext{SkipBackupSchema: true, SDLFormat: true}, currentSchema.GetProto())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert current schema to SDL format")
+		}
+		generatedSDL = text
+		// No changes detected between current SDL and database schema.
+		if strings.TrimSpace(currentSDLText) == strings.TrimSpace(generatedSDL) {
+			return &MetadataDiff{}, nil
+		}
+	}
+
+	target, err := parseSDLMetadata(engine, currentSDLText, normalize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse current SDL")
+	}
+	var baseline *storepb.DatabaseSchemaMetadata
+	switch {
+	case strings.TrimSpace(previousUserSDLText) == "":
+		baseline, err = parseSDLMetadata(engine, generatedSDL, normalize)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse current database schema")
+		}
+	case currentSchema != nil && previousSchema != nil:
+		previous, err := parseSDLMetadata(engine, previousUserSDLText, normalize)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse previous SDL")
+		}
+		baseline, err = parseSDLMetadata(engine, generatedSDL, normalize)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse current database schema")
+		}
+		edited := proto.CloneOf(baseline)
+		applySchemaChanges(edited, previous, target)
+		target = edited
+	default:
+		baseline, err = parseSDLMetadata(engine, previousUserSDLText, normalize)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse previous SDL")
+		}
+	}
+
+	isObjectCaseSensitive := true
+	if currentSchema != nil {
+		isObjectCaseSensitive = currentSchema.GetIsObjectCaseSensitive()
+	}
+	diff, err := GetDatabaseSchemaDiff(
+		engine,
+		model.NewDatabaseMetadata(baseline, nil, nil, engine, isObjectCaseSensitive),
+		model.NewDatabaseMetadata(target, nil, nil, engine, isObjectCaseSensitive),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute schema diff")
+	}
+	if diff == nil {
+		return &MetadataDiff{}, nil
+	}
+	return diff, nil
+}
+
+func parseSDLMetadata(engine storepb.Engine, sdlText string, normalize SDLMetadataNormalizer) (*storepb.DatabaseSchemaMetadata, error) {
+	metadata := &storepb.DatabaseSchemaMetadata{}
+	if strings.TrimSpace(sdlText) != "" {
+		parsed, err := GetDatabaseMetadata(engine, sdlText)
+		if err != nil {
+			return nil, err
+		}
+		metadata = parsed
+	}
+	if normalize != nil {
+		normalize(metadata)
+	}
+	return metadata, nil
+}
+
+// applySchemaChanges replaces the objects of the metadata that are changed between the previous and current
+// schemas with the current ones, and removes the dropped ones. The other objects of the metadata are kept.
+func applySchemaChanges(metadata, previous, current *storepb.DatabaseSchemaMetadata) {
+	for _, name := range unionSchemaNames(previous, current) {
+		previousSchema := findSchemaMetadata(previous, name)
+		currentSchema := findSchemaMetadata(current, name)
+		if currentSchema == nil {
+			metadata.Schemas = slices.DeleteFunc(metadata.Schemas, func(schema *storepb.SchemaMetadata) bool {
+				return schema.GetName() == name
+			})
+			continue
+		}
+		schema := findSchemaMetadata(metadata, name)
+		if schema == nil {
+			if previousSchema != nil {
+				// The schema is dropped in the metadata.
+				continue
+			}
+			schema = &storepb.SchemaMetadata{Name: name}
+			metadata.Schemas = append(metadata.Schemas, schema)
+		}
+		if previousSchema == nil {
+			previousSchema = &storepb.SchemaMetadata{}
+		}
+		applySchemaObjectChanges(schema.ProtoReflect(), previousSchema.ProtoReflect(), currentSchema.ProtoReflect())
+	}
+}
+
+// applySchemaObjectChanges applies the changes to each list of objects of the schema, e.g. tables and views.
+// The objects are identified by their names, and the signatures for the functions.
+func applySchemaObjectChanges(schema, previous, current protoreflect.Message) {
+	fields := schema.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !field.IsList() || field.Kind() != protoreflect.MessageKind || field.Message().Fields().ByName("name") == nil {
+			continue
+		}
+		previousObjects := objectsByKey(previous.Get(field).List())
+		currentObjects := objectsByKey(current.Get(field).List())
+		var keys []string
+		for _, objects := range []*objectList{previousObjects, currentObjects} {
+			for _, key := range objects.keys {
+				if !slices.Contains(keys, key) {
+					keys = append(keys, key)
+				}
+			}
+		}
+
+		list := schema.Mutable(field).List()
+		for _, key := range keys {
+			previousObject, currentObject := previousObjects.objects[key], currentObjects.objects[key]
+			if previousObject != nil && currentObject != nil && proto.Equal(previousObject, currentObject) {
+				continue
+			}
+			index := -1
+			for j := 0; j < list.Len(); j++ {
+				if objectKey(list.Get(j).Message()) == key {
+					index = j
+					break
+				}
+			}
+			switch {
+			case currentObject == nil && index >= 0:
+				for j := index; j < list.Len()-1; j++ {
+					list.Set(j, list.Get(j+1))
+				}
+				list.Truncate(list.Len() - 1)
+			case currentObject != nil && index >= 0:
+				list.Set(index, protoreflect.ValueOfMessage(currentObject.ProtoReflect()))
+			case currentObject != nil:
+				list.Append(protoreflect.ValueOfMessage(currentObject.ProtoReflect()))
+			}
+		}
+	}
+}
+
+type objectList struct {
+	keys    []string
+	objects map[string]proto.Message
+}
+
+func objectsByKey(list protoreflect.List) *objectList {
+	result := &objectList{objects: map[string]proto.Message{}}
+	for i := 0; i < list.Len(); i++ {
+		object := list.Get(i).Message()
+		key := objectKey(object)
+		result.keys = append(result.keys, key)
+		result.objects[key] = object.Interface()
+	}
+	return result
+}
+
+func objectKey(object protoreflect.Message) string {
+	fields := object.Descriptor().Fields()
+	key := object.Get(fields.ByName("name")).String()
+	if signature := fields.ByName("signature"); signature != nil {
+		key += "(" + object.Get(signature).String() + ")"
+	}
+	return key
+}
+
+func unionSchemaNames(metadatas ...*storepb.DatabaseSchemaMetadata) []string {
+	var names []string
+	for _, metadata := range metadatas {
+		for _, schema := range metadata.GetSchemas() {
+			if !slices.Contains(names, schema.GetName()) {
+				names = append(names, schema.GetName())
+			}
+		}
+	}
+	return names
+}
+
+func findSchemaMetadata(metadata *storepb.DatabaseSchemaMetadata, name string) *storepb.SchemaMetadata {
+	for _, schema := range metadata.GetSchemas() {
+		if schema.GetName() == name {
+			return schema
+		}
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_SNOWFLAKE, generateMigration)
+}
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// Safe order for migrations:
+	// 1. Drop views, since they may depend on the tables and columns being changed.
+	// 2. Drop columns, tables and schemas.
+	// 3. Create schemas and tables, then alter the remaining tables.
+	// 4. Re-create views and apply comments.
+	dropObjectsInOrder(diff, &buf)
+
+	dropPhaseHasContent := buf.Len() > 0
+	if dropPhaseHasContent && hasCreateOrAlterObjects(diff) {
+		_, _ = buf.WriteString("\n")
+	}
+
+	if err := createObjectsInOrder(diff, &buf); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func dropObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) {
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop || viewDiff.Action == schema.MetadataDiffActionAlter {
+			_, _ = fmt.Fprintf(buf, "DROP VIEW IF EXISTS %s;\n", qualifiedName(viewDiff.SchemaName, viewDiff.ViewName))
+		}
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, indexDiff := range tableDiff.IndexChanges {
+			if indexDiff.Action == schema.MetadataDiffActionDrop && isConstraintIndex(indexDiff.OldIndex) {
+				_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP CONSTRAINT %s;\n", qualifiedName(tableDiff.SchemaName, tableDiff.TableName), quoteIdentifier(indexDiff.OldIndex.Name))
+			}
+		}
+		for _, columnDiff := range tableDiff.ColumnChanges {
+			if columnDiff.Action == schema.MetadataDiffActionDrop {
+				_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", qualifiedName(tableDiff.SchemaName, tableDiff.TableName), quoteIdentifier(columnDiff.OldColumn.Name))
+			}
+		}
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "DROP TABLE IF EXISTS %s;\n", qualifiedName(tableDiff.SchemaName, tableDiff.TableName))
+		}
+	}
+
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "DROP SCHEMA IF EXISTS %s;\n", quoteIdentifier(schemaDiff.SchemaName))
+		}
+	}
+}
+
+func createObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) error {
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionCreate {
+			_, _ = fmt.Fprintf(buf, "CREATE SCHEMA IF NOT EXISTS %s;\n", quoteIdentifier(schemaDiff.SchemaName))
+		}
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			writeCreateTable(buf, tableDiff.SchemaName, tableDiff.TableName, tableDiff.NewTable)
+		case schema.MetadataDiffActionAlter:
+			if err := writeAlterTable(buf, tableDiff); err != nil {
+				return err
+			}
+		default:
+		}
+	}
+
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			writeCreateView(buf, viewDiff.SchemaName, viewDiff.NewView)
+		}
+	}
+	return nil
+}
+
+func hasCreateOrAlterObjects(diff *schema.MetadataDiff) bool {
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionCreate {
+			return true
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionCreate || tableDiff.Action == schema.MetadataDiffActionAlter {
+			return true
+		}
+	}
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			return true
+		}
+	}
+	return false
+}
+
+func writeCreateTable(buf *strings.Builder, schemaName, tableName string, table *storepb.TableMetadata) {
+	_, _ = fmt.Fprintf(buf, "CREATE TABLE %s (\n", qualifiedName(schemaName, tableName))
+	var items []string
+	for _, column := range table.GetColumns() {
+		items = append(items, "  "+columnDefinition(column))
+	}
+	for _, index := range table.GetIndexes() {
+		if isConstraintIndex(index) {
+			items = append(items, "  "+constraintDefinition(index))
+		}
+	}
+	_, _ = buf.WriteString(strings.Join(items, ",\n"))
+	_, _ = buf.WriteString("\n)")
+	if table.GetComment() != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT = '%s'", escapeString(table.GetComment()))
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeAlterTable(buf *strings.Builder, tableDiff *schema.TableDiff) error {
+	table := qualifiedName(tableDiff.SchemaName, tableDiff.TableName)
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		switch columnDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN %s;\n", table, columnDefinition(columnDiff.NewColumn))
+		case schema.MetadataDiffActionAlter:
+			if err := writeAlterColumn(buf, table, columnDiff); err != nil {
+				return err
+			}
+		default:
+		}
+	}
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action == schema.MetadataDiffActionCreate && isConstraintIndex(indexDiff.NewIndex) {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD %s;\n", table, constraintDefinition(indexDiff.NewIndex))
+		}
+	}
+	if tableDiff.OldTable.GetComment() != tableDiff.NewTable.GetComment() {
+		if tableDiff.NewTable.GetComment() == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s UNSET COMMENT;\n", table)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s SET COMMENT = '%s';\n", table, escapeString(tableDiff.NewTable.GetComment()))
+		}
+	}
+	return nil
+}
+
+// writeAlterColumn writes the ALTER COLUMN statements for a column change.
+// Snowflake only allows setting a column default to a sequence, so default changes to other
+// expressions return an error for the user to recreate the column or the table manually.
+func writeAlterColumn(buf *strings.Builder, table string, columnDiff *schema.ColumnDiff) error {
+	oldColumn, newColumn := columnDiff.OldColumn, columnDiff.NewColumn
+	column := quoteIdentifier(newColumn.Name)
+	if oldColumn.Type != newColumn.Type {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET DATA TYPE %s;\n", table, column, newColumn.Type)
+	}
+	if oldColumn.Nullable != newColumn.Nullable {
+		if newColumn.Nullable {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL;\n", table, column)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;\n", table, column)
+		}
+	}
+	if oldColumn.Default != newColumn.Default {
+		switch {
+		case newColumn.Default == "":
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;\n", table, column)
+		case isSequenceDefault(newColumn.Default):
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;\n", table, column, newColumn.Default)
+		default:
+			return errors.Errorf("Snowflake cannot change the default of column %s on table %s, recreate the column or the table to apply it", column, table)
+		}
+	}
+	if oldColumn.Comment != newColumn.Comment {
+		if newColumn.Comment == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s UNSET COMMENT;\n", table, column)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s COMMENT '%s';\n", table, column, escapeString(newColumn.Comment))
+		}
+	}
+	return nil
+}
+
+func writeCreateView(buf *strings.Builder, schemaName string, view *storepb.ViewMetadata) {
+	definition := strings.TrimRight(strings.TrimSpace(view.Definition), ";")
+	// Snowflake returns the full CREATE VIEW statement as the view definition.
+	if strings.HasPrefix(strings.ToUpper(definition), "CREATE") {
+		_, _ = fmt.Fprintf(buf, "%s;\n", definition)
+	} else {
+		_, _ = fmt.Fprintf(buf, "CREATE OR REPLACE VIEW %s AS %s;\n", qualifiedName(schemaName, view.Name), definition)
+	}
+	if view.Comment != "" {
+		_, _ = fmt.Fprintf(buf, "ALTER VIEW %s SET COMMENT = '%s';\n", qualifiedName(schemaName, view.Name), escapeString(view.Comment))
+	}
+}
+
+// isSequenceDefault returns true if the default is the next value of a sequence, e.g. "SEQ1".NEXTVAL.
+func isSequenceDefault(defaultValue string) bool {
+	return strings.HasSuffix(strings.ToUpper(strings.TrimSpace(defaultValue)), ".NEXTVAL")
+}
+
+func columnDefinition(column *storepb.ColumnMetadata) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "%s %s", quoteIdentifier(column.Name), column.Type)
+	if column.Collation != "" {
+		_, _ = fmt.Fprintf(&buf, " COLLATE '%s'", escapeString(column.Collation))
+	}
+	if column.Default != "" {
+		_, _ = fmt.Fprintf(&buf, " DEFAULT %s", column.Default)
+	}
+	if !column.Nullable {
+		_, _ = buf.WriteString(" NOT NULL")
+	}
+	if column.Comment != "" {
+		_, _ = fmt.Fprintf(&buf, " COMMENT '%s'", escapeString(column.Comment))
+	}
+	return buf.String()
+}
+
+func constraintDefinition(index *storepb.IndexMetadata) string {
+	keyword := "UNIQUE"
+	if index.Primary {
+		keyword = "PRIMARY KEY"
+	}
+	if index.Name == "" {
+		return fmt.Sprintf("%s (%s)", keyword, joinIdentifiers(index.Expressions))
+	}
+	return fmt.Sprintf("CONSTRAINT %s %s (%s)", quoteIdentifier(index.Name), keyword, joinIdentifiers(index.Expressions))
+}
+
+// isConstraintIndex returns true if the index is backed by a table constraint.
+// Snowflake standard tables have no secondary indexes.
+func isConstraintIndex(index *storepb.IndexMetadata) bool {
+	return index != nil && (index.Primary || index.Unique)
+}
+
+func qualifiedName(schemaName, objectName string) string {
+	if schemaName == "" {
+		return quoteIdentifier(objectName)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schemaName), quoteIdentifier(objectName))
+}
+
+func joinIdentifiers(identifiers []string) string {
+	var quoted []string
+	for _, identifier := range identifiers {
+		quoted = append(quoted, quoteIdentifier(identifier))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func escapeString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
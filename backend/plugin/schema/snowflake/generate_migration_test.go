@@ -0,0 +1,162 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      *storepb.DatabaseSchemaMetadata
+		new      *storepb.DatabaseSchemaMetadata
+		expected string
+		wantErr  string
+	}{
+		{
+			name: "create schema and table",
+			old:  &storepb.DatabaseSchemaMetadata{},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name:    "ORDERS",
+						Comment: "customer orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER"},
+							{Name: "NOTE", Type: "TEXT", Nullable: true},
+						},
+					}},
+				}},
+			},
+			expected: `CREATE SCHEMA IF NOT EXISTS "SALES";
+CREATE TABLE "SALES"."ORDERS" (
+  "ID" NUMBER NOT NULL,
+  "NOTE" TEXT
+) COMMENT = 'customer orders';
+`,
+		},
+		{
+			name: "alter table and re-create view",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name: "ORDERS",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER"},
+							{Name: "NOTE", Type: "TEXT", Nullable: true},
+							{Name: "LEGACY", Type: "TEXT", Nullable: true},
+						},
+					}},
+					Views: []*storepb.ViewMetadata{{
+						Name:       "V_ORDERS",
+						Definition: "create view V_ORDERS as select ID from ORDERS;",
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name: "ORDERS",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER"},
+							{Name: "NOTE", Type: "TEXT", Comment: "it's required"},
+						},
+					}},
+					Views: []*storepb.ViewMetadata{{
+						Name:       "V_ORDERS",
+						Definition: "create view V_ORDERS as select ID, NOTE from ORDERS;",
+					}},
+				}},
+			},
+			expected: `DROP VIEW IF EXISTS "SALES"."V_ORDERS";
+ALTER TABLE "SALES"."ORDERS" DROP COLUMN "LEGACY";
+
+ALTER TABLE "SALES"."ORDERS" ALTER COLUMN "NOTE" SET NOT NULL;
+ALTER TABLE "SALES"."ORDERS" ALTER COLUMN "NOTE" COMMENT 'it''s required';
+create view V_ORDERS as select ID, NOTE from ORDERS;
+`,
+		},
+		{
+			name: "alter column default to sequence and table comment",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name:    "ORDERS",
+						Comment: "customer orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER"},
+							{Name: "STATUS", Type: "TEXT", Default: "'NEW'"},
+						},
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name:    "ORDERS",
+						Comment: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER", Default: "SALES.ORDER_SEQ.NEXTVAL"},
+							{Name: "STATUS", Type: "TEXT", Default: "'NEW'"},
+						},
+					}},
+				}},
+			},
+			expected: `ALTER TABLE "SALES"."ORDERS" ALTER COLUMN "ID" SET DEFAULT SALES.ORDER_SEQ.NEXTVAL;
+ALTER TABLE "SALES"."ORDERS" SET COMMENT = 'orders';
+`,
+		},
+		{
+			name: "alter column default to an expression",
+			old: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name: "ORDERS",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "STATUS", Type: "TEXT", Default: "'NEW'"},
+						},
+					}},
+				}},
+			},
+			new: &storepb.DatabaseSchemaMetadata{
+				Schemas: []*storepb.SchemaMetadata{{
+					Name: "SALES",
+					Tables: []*storepb.TableMetadata{{
+						Name: "ORDERS",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "STATUS", Type: "TEXT", Default: "'OPEN'\nDROP TABLE \"SALES\".\"ORDERS\";"},
+						},
+					}},
+				}},
+			},
+			wantErr: `Snowflake cannot change the default of column "STATUS" on table "SALES"."ORDERS"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldSchema := model.NewDatabaseMetadata(test.old, nil, nil, storepb.Engine_SNOWFLAKE, false)
+			newSchema := model.NewDatabaseMetadata(test.new, nil, nil, storepb.Engine_SNOWFLAKE, false)
+			diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_SNOWFLAKE, oldSchema, newSchema)
+			require.NoError(t, err)
+			got, err := schema.GenerateMigration(storepb.Engine_SNOWFLAKE, diff)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// The COSE algorithms, see https://www.iana.org/assignments/cose/cose.xhtml#algorithms.
+const (
+	algES256 int64 = -7
+	algEdDSA int64 = -8
+	algRS256 int64 = -257
+)
+
+// supportedAlgorithms are the COSE algorithms in the order of preference.
+var supportedAlgorithms = []int64{algES256, algEdDSA, algRS256}
+
+// The COSE key parameters, see https://www.rfc-editor.org/rfc/rfc9053.
+const (
+	coseKeyType      int64 = 1
+	coseKeyAlg       int64 = 3
+	coseKeyCurve     int64 = -1
+	coseKeyX         int64 = -2
+	coseKeyY         int64 = -3
+	coseKeyRSAN      int64 = -1
+	coseKeyRSAE      int64 = -2
+	coseKeyTypeOKP   int64 = 1
+	coseKeyTypeEC2   int64 = 2
+	coseKeyTypeRSA   int64 = 3
+	coseCurveP256    int64 = 1
+	coseCurveEd25519 int64 = 6
+)
+
+// publicKey is the credential public key decoded from the COSE key.
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+// parsePublicKey parses the COSE-encoded credential public key.
+func parsePublicKey(data []byte) (*publicKey, error) {
+	item, rest, err := decodeCBOR(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode COSE key")
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("unexpected trailing data after COSE key")
+	}
+	m, ok := item.(map[any]any)
+	if !ok {
+		return nil, errors.New("COSE key is not a map")
+	}
+	kty, _ := m[coseKeyType].(int64)
+	alg, _ := m[coseKeyAlg].(int64)
+
+	switch alg {
+	case algES256:
+		crv, _ := m[coseKeyCurve].(int64)
+		x, _ := m[coseKeyX].([]byte)
+		y, _ := m[coseKeyY].([]byte)
+		if kty != coseKeyTypeEC2 || crv != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid ES256 key")
+		}
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid ES256 key")
+		}
+		return &publicKey{alg: alg, key: key}, nil
+	case algEdDSA:
+		crv, _ := m[coseKeyCurve].(int64)
+		x, _ := m[coseKeyX].([]byte)
+		if kty != coseKeyTypeOKP || crv != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid EdDSA key")
+		}
+		return &publicKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+	case algRS256:
+		n, _ := m[coseKeyRSAN].([]byte)
+		e, _ := m[coseKeyRSAE].([]byte)
+		if kty != coseKeyTypeRSA || len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RS256 key")
+		}
+		exponent := int(new(big.Int).SetBytes(e).Int64())
+		return &publicKey{alg: alg, key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}}, nil
+	default:
+		return nil, errors.Errorf("unsupported COSE algorithm %d", alg)
+	}
+}
+
+// verify verifies the signature of the message.
+func (k *publicKey) verify(message, signature []byte) error {
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, message, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return errors.Wrap(err, "invalid signature")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported public key type %T", k.key)
+	}
+}
@@ -0,0 +1,437 @@
+// Package webauthn implements the WebAuthn relying party ceremonies for registering and verifying
+// the passkeys and security keys as the second factor.
+// See https://www.w3.org/TR/webauthn-3/.
+//
+// Attestation is not verified, the registration requests "none" attestation and trusts the authenticator
+// on first use, which is the common practice for relying parties that don't restrict the authenticator models.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// challengeSize is the size of the random challenge in bytes, the spec requires at least 16 bytes.
+	challengeSize = 32
+	// timeoutMilliseconds is the time the client waits for the user to complete the ceremony.
+	timeoutMilliseconds = 5 * 60 * 1000
+
+	clientDataTypeCreate = "webauthn.create"
+	clientDataTypeGet    = "webauthn.get"
+
+	// The authenticator data flags.
+	flagUserPresent            = 0x01
+	flagAttestedCredentialData = 0x40
+	flagExtensionData          = 0x80
+)
+
+// RelyingParty is the WebAuthn relying party, which is the Bytebase console.
+type RelyingParty struct {
+	// ID is the relying party ID, which is the effective domain of the origin.
+	ID string
+	// Name is the human-palatable name shown by the authenticators.
+	Name string
+	// Origin is the origin of the Bytebase console, e.g. https://bytebase.example.com.
+	Origin string
+}
+
+// Credential is the registered WebAuthn credential.
+type Credential struct {
+	// ID is the credential ID generated by the authenticator.
+	ID []byte
+	// PublicKey is the COSE-encoded credential public key.
+	PublicKey []byte
+	// SignCount is the signature counter of the authenticator, zero if the authenticator doesn't support it.
+	SignCount uint32
+}
+
+// NewRelyingParty creates the relying party from the console URL.
+func NewRelyingParty(name, consoleURL string) (*RelyingParty, error) {
+	u, err := url.Parse(strings.TrimSpace(consoleURL))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid URL %q", consoleURL)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" || u.Hostname() == "" {
+		return nil, errors.Errorf("invalid URL %q, expect http or https URL", consoleURL)
+	}
+	// Browsers only allow WebAuthn in secure contexts, plain http is allowed for localhost.
+	if u.Scheme == "http" && u.Hostname() != "localhost" {
+		return nil, errors.Errorf("WebAuthn requires https, got %q", consoleURL)
+	}
+	return &RelyingParty{
+		ID:     u.Hostname(),
+		Name:   name,
+		Origin: u.Scheme + "://" + u.Host,
+	}, nil
+}
+
+// NewChallenge generates a random challenge for a ceremony.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, challengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, errors.Wrap(err, "failed to generate challenge")
+	}
+	return challenge, nil
+}
+
+// User is the user account of the credentials.
+type User struct {
+	// ID is the opaque user handle, it must not contain personally identifying information.
+	ID          []byte
+	Name        string
+	DisplayName string
+}
+
+// The options are encoded in the JSON format of the WebAuthn Level 3 spec, the binary fields are
+// base64url encoded so that the browsers can parse them with PublicKeyCredential.parseCreationOptionsFromJSON
+// and PublicKeyCredential.parseRequestOptionsFromJSON.
+
+type creationOptions struct {
+	RP                     rpEntity               `json:"rp"`
+	User                   userEntity             `json:"user"`
+	Challenge              string                 `json:"challenge"`
+	PubKeyCredParams       []credentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int                    `json:"timeout"`
+	ExcludeCredentials     []credentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection authenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+type rpEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type userEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type credentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type credentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type authenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+type requestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int                    `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []credentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// CreationOptions returns the options for navigator.credentials.create() in JSON.
+// The existing credentials of the user are excluded to prevent registering the same authenticator twice.
+func (rp *RelyingParty) CreationOptions(challenge []byte, user *User, existing []*Credential) ([]byte, error) {
+	options := creationOptions{
+		RP: rpEntity{ID: rp.ID, Name: rp.Name},
+		User: userEntity{
+			ID:          encode(user.ID),
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+		},
+		Challenge:          encode(challenge),
+		Timeout:            timeoutMilliseconds,
+		ExcludeCredentials: credentialDescriptors(existing),
+		AuthenticatorSelection: authenticatorSelection{
+			// The credentials are used as the second factor, the discoverable credentials are not required.
+			ResidentKey:      "discouraged",
+			UserVerification: "preferred",
+		},
+		Attestation: "none",
+	}
+	for _, alg := range supportedAlgorithms {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, credentialParameter{Type: "public-key", Alg: alg})
+	}
+	return json.Marshal(options)
+}
+
+// RequestOptions returns the options for navigator.credentials.get() in JSON.
+func (rp *RelyingParty) RequestOptions(challenge []byte, credentials []*Credential) ([]byte, error) {
+	return json.Marshal(requestOptions{
+		Challenge:        encode(challenge),
+		Timeout:          timeoutMilliseconds,
+		RPID:             rp.ID,
+		AllowCredentials: credentialDescriptors(credentials),
+		UserVerification: "preferred",
+	})
+}
+
+// registrationResponse is the RegistrationResponseJSON returned by PublicKeyCredential.toJSON().
+type registrationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject"`
+	} `json:"response"`
+}
+
+// authenticationResponse is the AuthenticationResponseJSON returned by PublicKeyCredential.toJSON().
+type authenticationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	// credentialID and credentialPublicKey are only present in the registration.
+	credentialID        []byte
+	credentialPublicKey []byte
+}
+
+// VerifyRegistration verifies the registration response in JSON and returns the new credential.
+func (rp *RelyingParty) VerifyRegistration(challenge []byte, response []byte) (*Credential, error) {
+	var resp registrationResponse
+	if err := json.Unmarshal(response, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal registration response")
+	}
+	if resp.Type != "public-key" {
+		return nil, errors.Errorf("unexpected credential type %q", resp.Type)
+	}
+	clientDataJSON, err := decode(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid clientDataJSON")
+	}
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	attestationObject, err := decode(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attestationObject")
+	}
+	item, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode attestation object")
+	}
+	attestation, ok := item.(map[any]any)
+	if !ok {
+		return nil, errors.New("attestation object is not a map")
+	}
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("attestation object has no authData")
+	}
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+	if authData.flags&flagAttestedCredentialData == 0 {
+		return nil, errors.New("authenticator data has no attested credential data")
+	}
+	if resp.RawID != "" {
+		rawID, err := decode(resp.RawID)
+		if err != nil || !bytes.Equal(rawID, authData.credentialID) {
+			return nil, errors.New("credential ID mismatch")
+		}
+	}
+	if _, err := parsePublicKey(authData.credentialPublicKey); err != nil {
+		return nil, err
+	}
+	return &Credential{
+		ID:        authData.credentialID,
+		PublicKey: authData.credentialPublicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// VerifyAssertion verifies the authentication response in JSON against the registered credentials.
+// It returns the credential used with the updated signature counter.
+func (rp *RelyingParty) VerifyAssertion(challenge []byte, credentials []*Credential, response []byte) (*Credential, error) {
+	var resp authenticationResponse
+	if err := json.Unmarshal(response, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal authentication response")
+	}
+	if resp.Type != "public-key" {
+		return nil, errors.Errorf("unexpected credential type %q", resp.Type)
+	}
+	rawID, err := decode(resp.RawID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rawId")
+	}
+	var credential *Credential
+	for _, c := range credentials {
+		if bytes.Equal(c.ID, rawID) {
+			credential = c
+			break
+		}
+	}
+	if credential == nil {
+		return nil, errors.New("credential is not registered")
+	}
+
+	clientDataJSON, err := decode(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid clientDataJSON")
+	}
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeGet, challenge); err != nil {
+		return nil, err
+	}
+	rawAuthData, err := decode(resp.Response.AuthenticatorData)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid authenticatorData")
+	}
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+	signature, err := decode(resp.Response.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	key, err := parsePublicKey(credential.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	if err := key.verify(append(bytes.Clone(rawAuthData), clientDataHash[:]...), signature); err != nil {
+		return nil, err
+	}
+	// A counter not greater than the stored one signals a cloned authenticator.
+	if (authData.signCount != 0 || credential.SignCount != 0) && authData.signCount <= credential.SignCount {
+		return nil, errors.New("signature counter did not increase, the authenticator may be cloned")
+	}
+	return &Credential{
+		ID:        credential.ID,
+		PublicKey: credential.PublicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+func (rp *RelyingParty) verifyClientData(data []byte, typ string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(data, &cd); err != nil {
+		return errors.Wrap(err, "failed to unmarshal client data")
+	}
+	if cd.Type != typ {
+		return errors.Errorf("unexpected client data type %q, expect %q", cd.Type, typ)
+	}
+	got, err := decode(cd.Challenge)
+	if err != nil || len(challenge) == 0 || !bytes.Equal(got, challenge) {
+		return errors.New("challenge mismatch")
+	}
+	if cd.Origin != rp.Origin {
+		return errors.Errorf("unexpected origin %q, expect %q", cd.Origin, rp.Origin)
+	}
+	if cd.CrossOrigin {
+		return errors.New("cross-origin ceremony is not allowed")
+	}
+	return nil
+}
+
+func (rp *RelyingParty) verifyAuthenticatorData(authData *authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return errors.New("relying party ID mismatch")
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return errors.New("user is not present")
+	}
+	return nil
+}
+
+// parseAuthenticatorData parses the authenticator data.
+// See https://www.w3.org/TR/webauthn-3/#sctn-authenticator-data.
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("authenticator data is too short")
+	}
+	authData := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rest := data[37:]
+	if authData.flags&flagAttestedCredentialData != 0 {
+		// AAGUID (16 bytes) and the credential ID length (2 bytes).
+		if len(rest) < 18 {
+			return nil, errors.New("attested credential data is too short")
+		}
+		idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if idLen == 0 || idLen > 1023 || len(rest) < idLen {
+			return nil, errors.New("invalid credential ID length")
+		}
+		authData.credentialID = rest[:idLen]
+		rest = rest[idLen:]
+		// The credential public key is a CBOR item followed by the optional extensions.
+		_, remaining, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode credential public key")
+		}
+		authData.credentialPublicKey = rest[:len(rest)-len(remaining)]
+		rest = remaining
+	}
+	if authData.flags&flagExtensionData != 0 {
+		_, remaining, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode extensions")
+		}
+		rest = remaining
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("unexpected trailing data in authenticator data")
+	}
+	return authData, nil
+}
+
+func credentialDescriptors(credentials []*Credential) []credentialDescriptor {
+	descriptors := []credentialDescriptor{}
+	for _, c := range credentials {
+		descriptors = append(descriptors, credentialDescriptor{Type: "public-key", ID: encode(c.ID)})
+	}
+	return descriptors
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decode decodes the base64url string, the padding is tolerated since some clients add it.
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
@@ -0,0 +1,227 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// encodeCBOR encodes the test values, the map keys are sorted to get deterministic output.
+func encodeCBOR(v any) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n < 1<<8:
+			return []byte{major<<5 | 24, byte(n)}
+		case n < 1<<16:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+		default:
+			return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(n))
+		}
+	}
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case map[any]any:
+		var keys [][]byte
+		for k := range v {
+			keys = append(keys, append(encodeCBOR(k), encodeCBOR(v[k])...))
+		}
+		slices.SortFunc(keys, bytes.Compare)
+		b := head(5, uint64(len(v)))
+		for _, k := range keys {
+			b = append(b, k...)
+		}
+		return b
+	default:
+		panic("unsupported type")
+	}
+}
+
+type testAuthenticator struct {
+	credentialID []byte
+	signer       crypto.Signer
+	coseKey      []byte
+	signCount    uint32
+}
+
+func newTestAuthenticator(t *testing.T, ed bool) *testAuthenticator {
+	a := &testAuthenticator{credentialID: []byte("credential-id")}
+	if ed {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		a.signer = priv
+		a.coseKey = encodeCBOR(map[any]any{1: 1, 3: -8, -1: 6, -2: []byte(pub)})
+		return a
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	a.signer = key
+	raw, err := key.PublicKey.Bytes()
+	require.NoError(t, err)
+	a.coseKey = encodeCBOR(map[any]any{1: 2, 3: -7, -1: 1, -2: raw[1:33], -3: raw[33:]})
+	return a
+}
+
+func (a *testAuthenticator) authData(rpID string, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	flags := byte(flagUserPresent)
+	if attested {
+		flags |= flagAttestedCredentialData
+	}
+	b := append(rpIDHash[:], flags)
+	b = binary.BigEndian.AppendUint32(b, a.signCount)
+	if attested {
+		b = append(b, make([]byte, 16)...)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(a.credentialID)))
+		b = append(b, a.credentialID...)
+		b = append(b, a.coseKey...)
+	}
+	return b
+}
+
+func clientDataJSON(typ string, challenge []byte, origin string) []byte {
+	b, _ := json.Marshal(clientData{Type: typ, Challenge: encode(challenge), Origin: origin})
+	return b
+}
+
+func (a *testAuthenticator) create(rpID, origin string, challenge []byte) []byte {
+	attestationObject := encodeCBOR(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": a.authData(rpID, true),
+	})
+	b, _ := json.Marshal(map[string]any{
+		"id":    encode(a.credentialID),
+		"rawId": encode(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    encode(clientDataJSON(clientDataTypeCreate, challenge, origin)),
+			"attestationObject": encode(attestationObject),
+		},
+	})
+	return b
+}
+
+func (a *testAuthenticator) get(t *testing.T, rpID, origin string, challenge []byte) []byte {
+	a.signCount++
+	authData := a.authData(rpID, false)
+	cdj := clientDataJSON(clientDataTypeGet, challenge, origin)
+	hash := sha256.Sum256(cdj)
+	message := append(authData, hash[:]...)
+	var signature []byte
+	var err error
+	if _, ok := a.signer.(ed25519.PrivateKey); ok {
+		signature, err = a.signer.Sign(rand.Reader, message, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(message)
+		signature, err = a.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	require.NoError(t, err)
+	b, _ := json.Marshal(map[string]any{
+		"id":    encode(a.credentialID),
+		"rawId": encode(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    encode(cdj),
+			"authenticatorData": encode(authData),
+			"signature":         encode(signature),
+		},
+	})
+	return b
+}
+
+func TestCeremonies(t *testing.T) {
+	for _, ed := range []bool{false, true} {
+		a := require.New(t)
+		rp, err := NewRelyingParty("Bytebase", "https://bytebase.example.com/")
+		a.NoError(err)
+		a.Equal("bytebase.example.com", rp.ID)
+		a.Equal("https://bytebase.example.com", rp.Origin)
+
+		authenticator := newTestAuthenticator(t, ed)
+		challenge, err := NewChallenge()
+		a.NoError(err)
+		options, err := rp.CreationOptions(challenge, &User{ID: []byte("101"), Name: "a@b.com", DisplayName: "A"}, nil)
+		a.NoError(err)
+		a.Contains(string(options), encode(challenge))
+
+		// Wrong challenge and origin.
+		_, err = rp.VerifyRegistration([]byte("other"), authenticator.create(rp.ID, rp.Origin, challenge))
+		a.ErrorContains(err, "challenge mismatch")
+		_, err = rp.VerifyRegistration(challenge, authenticator.create(rp.ID, "https://evil.com", challenge))
+		a.ErrorContains(err, "unexpected origin")
+		_, err = rp.VerifyRegistration(challenge, authenticator.create("evil.com", rp.Origin, challenge))
+		a.ErrorContains(err, "relying party ID mismatch")
+
+		credential, err := rp.VerifyRegistration(challenge, authenticator.create(rp.ID, rp.Origin, challenge))
+		a.NoError(err)
+		a.Equal(authenticator.credentialID, credential.ID)
+		a.Equal(authenticator.coseKey, credential.PublicKey)
+
+		challenge, err = NewChallenge()
+		a.NoError(err)
+		used, err := rp.VerifyAssertion(challenge, []*Credential{credential}, authenticator.get(t, rp.ID, rp.Origin, challenge))
+		a.NoError(err)
+		a.Equal(uint32(1), used.SignCount)
+
+		// The replayed counter is rejected.
+		authenticator.signCount = 0
+		_, err = rp.VerifyAssertion(challenge, []*Credential{used}, authenticator.get(t, rp.ID, rp.Origin, challenge))
+		a.ErrorContains(err, "signature counter")
+
+		// The signature from another key is rejected.
+		other := newTestAuthenticator(t, ed)
+		other.signCount = 10
+		_, err = rp.VerifyAssertion(challenge, []*Credential{used}, other.get(t, rp.ID, rp.Origin, challenge))
+		a.ErrorContains(err, "invalid signature")
+
+		_, err = rp.VerifyAssertion(challenge, nil, authenticator.get(t, rp.ID, rp.Origin, challenge))
+		a.ErrorContains(err, "not registered")
+	}
+}
+
+func TestNewRelyingParty(t *testing.T) {
+	a := require.New(t)
+	rp, err := NewRelyingParty("Bytebase", "http://localhost:8080")
+	a.NoError(err)
+	a.Equal("localhost", rp.ID)
+	a.Equal("http://localhost:8080", rp.Origin)
+
+	_, err = NewRelyingParty("Bytebase", "http://bytebase.example.com")
+	a.Error(err)
+	_, err = NewRelyingParty("Bytebase", "bytebase.example.com")
+	a.Error(err)
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	a := require.New(t)
+	v, rest, err := decodeCBOR(append(encodeCBOR(map[any]any{"a": -300, 1: []byte{1, 2}}), 0xff))
+	a.NoError(err)
+	a.Equal(map[any]any{"a": int64(-300), int64(1): []byte{1, 2}}, v)
+	a.Equal([]byte{0xff}, rest)
+
+	// Truncated and indefinite-length items.
+	_, _, err = decodeCBOR([]byte{0x5a, 0xff, 0xff, 0xff, 0xff})
+	a.Error(err)
+	_, _, err = decodeCBOR([]byte{0x9f})
+	a.Error(err)
+}
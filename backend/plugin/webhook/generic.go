@@ -0,0 +1,246 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// GenericPayloadVersion is the version of the GENERIC webhook payload schema.
+// Fields may be added within a version, but renaming or removing fields requires a new version.
+const GenericPayloadVersion = "v1"
+
+const (
+	// GenericHeaderEvent is the header of the activity type.
+	GenericHeaderEvent = "X-Bytebase-Event"
+	// GenericHeaderDelivery is the header of the delivery ID, which is the same for all attempts of a delivery.
+	GenericHeaderDelivery = "X-Bytebase-Delivery"
+	// GenericHeaderTimestamp is the header of the unix timestamp when the request is sent.
+	GenericHeaderTimestamp = "X-Bytebase-Timestamp"
+	// GenericHeaderSignature is the header of the signature, which is "sha256=" followed by
+	// the hex encoded HMAC-SHA256 of "<timestamp>.<body>" with the signing secret.
+	GenericHeaderSignature = "X-Bytebase-Signature"
+)
+
+// genericMaxRedirects is the maximum number of redirects followed by the GENERIC webhook.
+const genericMaxRedirects = 3
+
+// genericClient is the HTTP client of the GENERIC webhook.
+// It only connects to public addresses, and does not use the proxy from the environment
+// as the address check would apply to the proxy instead of the destination.
+var genericClient = &http.Client{
+	Timeout: Timeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: Timeout,
+			Control: genericDialControl,
+		}).DialContext,
+		TLSHandshakeTimeout: Timeout,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= genericMaxRedirects {
+			return errors.Errorf("stopped after %d redirects", genericMaxRedirects)
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return errors.Errorf("invalid redirect URL scheme: %s", req.URL.Scheme)
+		}
+		return validateGenericHost(req.URL.Hostname())
+	},
+}
+
+// GenericPayload is the JSON request body of the GENERIC webhook.
+type GenericPayload struct {
+	Version     string           `json:"version"`
+	EventType   string           `json:"eventType"`
+	Level       string           `json:"level"`
+	Title       string           `json:"title"`
+	Description string           `json:"description,omitempty"`
+	Link        string           `json:"link,omitempty"`
+	CreateTime  string           `json:"createTime"`
+	Actor       GenericActor     `json:"actor"`
+	Project     *GenericProject  `json:"project,omitempty"`
+	Issue       *GenericIssue    `json:"issue,omitempty"`
+	Rollout     *GenericRollout  `json:"rollout,omitempty"`
+	Stage       *GenericStage    `json:"stage,omitempty"`
+	TaskRun     *GenericTaskRun  `json:"taskRun,omitempty"`
+	Approval    *GenericApproval `json:"approval,omitempty"`
+}
+
+// GenericActor is the user who triggers the event.
+type GenericActor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GenericProject is the project of the event.
+type GenericProject struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// GenericIssue is the issue of the event.
+type GenericIssue struct {
+	ID          int           `json:"id"`
+	Title       string        `json:"title"`
+	Status      string        `json:"status"`
+	Type        string        `json:"type"`
+	Description string        `json:"description"`
+	Creator     *GenericActor `json:"creator,omitempty"`
+}
+
+// GenericRollout is the rollout of the event.
+type GenericRollout struct {
+	ID    int    `json:"id"`
+	Title string `json:"title,omitempty"`
+}
+
+// GenericStage is the stage of the event.
+type GenericStage struct {
+	Title string `json:"title"`
+}
+
+// GenericTaskRun is the task run of the event.
+type GenericTaskRun struct {
+	Title         string `json:"title,omitempty"`
+	Status        string `json:"status"`
+	Detail        string `json:"detail,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+}
+
+// GenericApproval is the approval step of the event.
+type GenericApproval struct {
+	Role string `json:"role"`
+}
+
+func init() {
+	Register(storepb.ProjectWebhook_GENERIC, &GenericReceiver{})
+}
+
+// GenericReceiver is the receiver for the GENERIC webhook.
+type GenericReceiver struct {
+}
+
+func (*GenericReceiver) Post(context Context) error {
+	body, err := NewGenericPayload(context)
+	if err != nil {
+		return err
+	}
+	_, err = PostGeneric(context.URL, context.SigningSecret, context.DeliveryID, context.EventType, body)
+	return err
+}
+
+// NewGenericPayload returns the JSON request body of the GENERIC webhook for the context.
+func NewGenericPayload(context Context) ([]byte, error) {
+	payload := GenericPayload{
+		Version:     GenericPayloadVersion,
+		EventType:   context.EventType,
+		Level:       string(context.Level),
+		Title:       context.Title,
+		Description: context.Description,
+		Link:        context.Link,
+		CreateTime:  time.Unix(context.CreatedTS, 0).UTC().Format(time.RFC3339),
+		Actor: GenericActor{
+			Name:  context.ActorName,
+			Email: context.ActorEmail,
+		},
+	}
+	if p := context.Project; p != nil {
+		payload.Project = &GenericProject{
+			Name:  p.Name,
+			Title: p.Title,
+		}
+	}
+	if i := context.Issue; i != nil {
+		payload.Issue = &GenericIssue{
+			ID:          i.ID,
+			Title:       i.Name,
+			Status:      i.Status,
+			Type:        i.Type,
+			Description: i.Description,
+		}
+		if i.Creator != nil {
+			payload.Issue.Creator = &GenericActor{
+				Name:  i.Creator.Name,
+				Email: i.Creator.Email,
+			}
+		}
+	}
+	if r := context.Rollout; r != nil {
+		payload.Rollout = &GenericRollout{
+			ID:    r.UID,
+			Title: r.Title,
+		}
+	}
+	if s := context.Stage; s != nil {
+		payload.Stage = &GenericStage{
+			Title: s.Name,
+		}
+	}
+	if t := context.TaskResult; t != nil {
+		payload.TaskRun = &GenericTaskRun{
+			Title:         t.Name,
+			Status:        t.Status,
+			Detail:        t.Detail,
+			SkippedReason: t.SkippedReason,
+		}
+	}
+	if a := context.Approval; a != nil {
+		payload.Approval = &GenericApproval{
+			Role: a.Role,
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal webhook payload")
+	}
+	return body, nil
+}
+
+// SignGenericPayload returns the value of the signature header.
+func SignGenericPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PostGeneric posts the body to the GENERIC webhook, and returns the response status code.
+// The status code is 0 if no response is received.
+// The response body is never read into the error, as the error is shown in the delivery log.
+func PostGeneric(url, secret, deliveryID, eventType string, body []byte) (int, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to construct webhook POST request to %s", url)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(GenericHeaderEvent, eventType)
+	req.Header.Set(GenericHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if deliveryID != "" {
+		req.Header.Set(GenericHeaderDelivery, deliveryID)
+	}
+	if secret != "" {
+		req.Header.Set(GenericHeaderSignature, SignGenericPayload(secret, timestamp, body))
+	}
+
+	resp, err := genericClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to POST webhook to %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, errors.Errorf("failed to POST webhook %s, status code: %d", url, resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
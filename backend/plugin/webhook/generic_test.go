@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestGenericReceiver(t *testing.T) {
+	a := require.New(t)
+	allowNonPublicAddresses(t)
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		b, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		body = b
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	err := Post(storepb.ProjectWebhook_GENERIC, Context{
+		URL:           server.URL,
+		SigningSecret: "secret",
+		DeliveryID:    "101",
+		Level:         WebhookError,
+		EventType:     storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE.String(),
+		Title:         "Task run failed",
+		CreatedTS:     1735689600,
+		ActorName:     "Alice",
+		ActorEmail:    "alice@example.com",
+		Project:       &Project{Name: "projects/hello", Title: "Hello"},
+		Rollout:       &Rollout{UID: 1},
+		TaskResult:    &TaskResult{Name: "task", Status: "FAILED", Detail: "syntax error"},
+	})
+	a.NoError(err)
+
+	a.Equal("ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE", header.Get(GenericHeaderEvent))
+	a.Equal("101", header.Get(GenericHeaderDelivery))
+	timestamp, err := strconv.ParseInt(header.Get(GenericHeaderTimestamp), 10, 64)
+	a.NoError(err)
+	a.Equal(SignGenericPayload("secret", timestamp, body), header.Get(GenericHeaderSignature))
+
+	payload := GenericPayload{}
+	a.NoError(json.Unmarshal(body, &payload))
+	a.Equal(GenericPayload{
+		Version:    GenericPayloadVersion,
+		EventType:  "ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE",
+		Level:      "ERROR",
+		Title:      "Task run failed",
+		CreateTime: "2025-01-01T00:00:00Z",
+		Actor:      GenericActor{Name: "Alice", Email: "alice@example.com"},
+		Project:    &GenericProject{Name: "projects/hello", Title: "Hello"},
+		Rollout:    &GenericRollout{ID: 1},
+		TaskRun:    &GenericTaskRun{Title: "task", Status: "FAILED", Detail: "syntax error"},
+	}, payload)
+}
+
+func TestPostGenericFailure(t *testing.T) {
+	a := require.New(t)
+	allowNonPublicAddresses(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("internal secret"))
+	}))
+	defer server.Close()
+
+	statusCode, err := PostGeneric(server.URL, "", "", "ISSUE_CREATE", []byte("{}"))
+	a.Error(err)
+	a.Equal(http.StatusServiceUnavailable, statusCode)
+	a.NotContains(err.Error(), "internal secret")
+}
+
+func TestPostGenericNonPublicAddress(t *testing.T) {
+	a := require.New(t)
+	var requested bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	statusCode, err := PostGeneric(server.URL, "", "", "ISSUE_CREATE", []byte("{}"))
+	a.Error(err)
+	a.Zero(statusCode)
+	a.False(requested)
+}
+
+func allowNonPublicAddresses(t *testing.T) {
+	TestOnlyAllowNonPublicAddresses = true
+	t.Cleanup(func() {
+		TestOnlyAllowNonPublicAddresses = false
+	})
+}
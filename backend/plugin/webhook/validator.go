@@ -1,8 +1,12 @@
 package webhook
 
 import (
+	"context"
+	"net"
+	"net/netip"
 	"net/url"
 	"strings"
+	"syscall"
 
 	"github.com/pkg/errors"
 
@@ -42,6 +46,20 @@ var (
 	// TestOnlyAllowedDomains contains additional domains allowed for testing purposes only.
 	// This should only be modified in test files.
 	TestOnlyAllowedDomains = map[storepb.ProjectWebhook_Type][]string{}
+
+	// TestOnlyAllowNonPublicAddresses allows the GENERIC webhook to connect to the loopback and private addresses for testing purposes only.
+	// This should only be modified in test files.
+	TestOnlyAllowNonPublicAddresses = false
+
+	// nonPublicPrefixes are the special-purpose ranges that are not covered by the netip.Addr predicates.
+	nonPublicPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("192.0.0.0/24"),
+		netip.MustParsePrefix("198.18.0.0/15"),
+		netip.MustParsePrefix("240.0.0.0/4"),
+		netip.MustParsePrefix("64:ff9b::/96"),
+	}
 )
 
 // ValidateWebhookURL validates that the webhook URL matches the allowed domains for the webhook type.
@@ -57,6 +75,12 @@ func ValidateWebhookURL(webhookType storepb.ProjectWebhook_Type, webhookURL stri
 		return errors.Errorf("invalid URL scheme: %s (only http and https are allowed)", u.Scheme)
 	}
 
+	// The GENERIC webhook posts to the user's own endpoints, so any host resolving to public addresses is allowed.
+	// The addresses are checked again when connecting, see genericDialControl.
+	if webhookType == storepb.ProjectWebhook_GENERIC {
+		return validateGenericHost(u.Hostname())
+	}
+
 	// Get allowed domains for this webhook type
 	allowedDomainsForType, ok := allowedDomains[webhookType]
 	if !ok {
@@ -90,3 +114,59 @@ func ValidateWebhookURL(webhookType storepb.ProjectWebhook_Type, webhookURL stri
 	return errors.Errorf("webhook URL domain %q is not allowed for webhook type %s (allowed domains: %v)",
 		hostname, webhookType, allowedDomainsForType)
 }
+
+// validateGenericHost validates that the host only resolves to public addresses.
+func validateGenericHost(host string) error {
+	if host == "" {
+		return errors.Errorf("webhook URL host is empty")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve webhook URL host %q", host)
+	}
+	for _, addr := range addrs {
+		if !isPublicAddress(addr) {
+			return errors.Errorf("webhook URL host %q resolves to non-public address %s", host, addr)
+		}
+	}
+	return nil
+}
+
+// genericDialControl rejects the connections to non-public addresses.
+// It runs after the DNS resolution for every connection, so it also covers redirects and DNS rebinding.
+func genericDialControl(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "invalid address %q", address)
+	}
+	if !isPublicAddress(addrPort.Addr()) {
+		return errors.Errorf("connecting to non-public address %s is not allowed", addrPort.Addr())
+	}
+	return nil
+}
+
+// isPublicAddress returns false for the loopback, private, link-local, multicast and other special-purpose addresses.
+func isPublicAddress(addr netip.Addr) bool {
+	if TestOnlyAllowNonPublicAddresses {
+		return true
+	}
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsUnspecified() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
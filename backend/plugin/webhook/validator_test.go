@@ -44,6 +44,49 @@ func TestValidateWebhookURL(t *testing.T) {
 			webhookURL:  "http://192.168.1.1/",
 			wantErr:     true,
 		},
+		// Generic tests
+		{
+			name:        "valid generic URL",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "https://203.0.113.10/bytebase",
+			wantErr:     false,
+		},
+		{
+			name:        "invalid generic scheme",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "ftp://203.0.113.10/bytebase",
+			wantErr:     true,
+		},
+		{
+			name:        "generic SSRF attempt loopback",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "http://127.0.0.1:8080/",
+			wantErr:     true,
+		},
+		{
+			name:        "generic SSRF attempt metadata service",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "http://169.254.169.254/latest/meta-data/",
+			wantErr:     true,
+		},
+		{
+			name:        "generic SSRF attempt private network",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "http://10.0.0.5:5432/",
+			wantErr:     true,
+		},
+		{
+			name:        "generic SSRF attempt IPv6 loopback",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "http://[::1]/",
+			wantErr:     true,
+		},
+		{
+			name:        "generic SSRF attempt IPv4-mapped IPv6",
+			webhookType: storepb.ProjectWebhook_GENERIC,
+			webhookURL:  "http://[::ffff:192.168.1.1]/",
+			wantErr:     true,
+		},
 		// Discord tests
 		{
 			name:        "valid discord URL",
@@ -70,6 +70,11 @@ type TaskResult struct {
 	SkippedReason string
 }
 
+// Approval is the approval step of an issue.
+type Approval struct {
+	Role string
+}
+
 // Project object of project.
 type Project struct {
 	Name  string
@@ -94,11 +99,16 @@ type Context struct {
 	Stage       *Stage
 	Project     *Project
 	TaskResult  *TaskResult
+	Approval    *Approval
 	// End users that should be mentioned.
 	MentionEndUsers []*store.UserMessage
 
 	DirectMessage bool
 	IMSetting     *storepb.AppIMSetting
+
+	// SigningSecret and DeliveryID are used by the GENERIC webhook.
+	SigningSecret string
+	DeliveryID    string
 }
 
 // Receiver is the webhook receiver.
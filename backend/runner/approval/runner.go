@@ -177,7 +177,8 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 	}
 
 	// Grant privilege and close issue similar to actions on issue approval.
-	if issue.Type == storepb.Issue_GRANT_REQUEST && approvalTemplate == nil {
+	// The break-glass access has been granted on creation, and its issue is left open for the post-incident review.
+	if issue.Type == storepb.Issue_GRANT_REQUEST && approvalTemplate == nil && !payload.GrantRequest.GetBreakGlass() {
 		if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, r.store, issue, payload.GrantRequest); err != nil {
 			return false, err
 		}
@@ -379,7 +380,7 @@ func (r *Runner) buildCELVariablesForDatabaseChange(ctx context.Context, issue *
 	// Check plan check runs status
 	planCheckRuns, err := r.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
 		PlanUID: &plan.UID,
-		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport},
+		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport, store.PlanCheckDatabaseStatementLockImpact},
 	})
 	if err != nil {
 		return nil, false, errors.Wrapf(err, "failed to list plan check runs for plan %v", plan.UID)
@@ -390,22 +391,29 @@ func (r *Runner) buildCELVariablesForDatabaseChange(ctx context.Context, issue *
 		DatabaseName string
 	}
 	latestPlanCheckRun := map[Key]*store.PlanCheckRunMessage{}
+	latestLockImpactRun := map[Key]*store.PlanCheckRunMessage{}
 	for _, run := range planCheckRuns {
 		key := Key{
 			InstanceID:   run.Config.InstanceId,
 			DatabaseName: run.Config.DatabaseName,
 		}
+		if run.Type == store.PlanCheckDatabaseStatementLockImpact {
+			latestLockImpactRun[key] = run
+			continue
+		}
 		latestPlanCheckRun[key] = run
 	}
 
 	// Wait for plan check runs to complete
 	var planCheckRunDone int
-	for _, run := range latestPlanCheckRun {
-		if run.Status != store.PlanCheckRunStatusRunning {
-			planCheckRunDone++
+	for _, runs := range []map[Key]*store.PlanCheckRunMessage{latestPlanCheckRun, latestLockImpactRun} {
+		for _, run := range runs {
+			if run.Status != store.PlanCheckRunStatusRunning {
+				planCheckRunDone++
+			}
 		}
 	}
-	planCheckRunCount := len(latestPlanCheckRun)
+	planCheckRunCount := len(latestPlanCheckRun) + len(latestLockImpactRun)
 	if planCheckRunCount < common.MinimumCompletedPlanCheckRun && planCheckRunCount != planCheckRunDone {
 		return nil, false, nil // Not ready yet, retry later
 	}
@@ -470,11 +478,17 @@ func (r *Runner) buildCELVariablesForDatabaseChange(ctx context.Context, issue *
 			common.CELAttributeStatementText:         taskStatement,
 		}
 
-		// Add summary report data if available
-		run, ok := latestPlanCheckRun[Key{
+		key := Key{
 			InstanceID:   instance.ResourceID,
 			DatabaseName: databaseName,
-		}]
+		}
+		// Add lock impact report data if available
+		if run, ok := latestLockImpactRun[key]; ok {
+			setLockImpactCELVars(celVars, run.Result.Results)
+		}
+
+		// Add summary report data if available
+		run, ok := latestPlanCheckRun[key]
 		if !ok {
 			celVarsList = append(celVarsList, celVars)
 			continue
@@ -732,6 +746,31 @@ func findSQLSummaryReport(results []*storepb.PlanCheckRunResult_Result) *storepb
 	return nil
 }
 
+// setLockImpactCELVars sets the strongest lock level, the longest estimated blocking duration and the total rows of the rewritten tables.
+func setLockImpactCELVars(celVars map[string]any, results []*storepb.PlanCheckRunResult_Result) {
+	var found bool
+	var lockLevel storepb.PlanCheckRunResult_Result_LockImpactReport_LockLevel
+	var blockingSeconds, rewrittenTableRows int64
+	for _, result := range results {
+		report := result.GetLockImpactReport()
+		if report == nil {
+			continue
+		}
+		found = true
+		lockLevel = max(lockLevel, report.LockLevel)
+		blockingSeconds = max(blockingSeconds, report.EstimatedBlockingSeconds)
+		if report.TableRewrite {
+			rewrittenTableRows += report.TableRows
+		}
+	}
+	if !found {
+		return
+	}
+	celVars[common.CELAttributeStatementLockLevel] = lockLevel.String()
+	celVars[common.CELAttributeStatementLockBlockingSeconds] = blockingSeconds
+	celVars[common.CELAttributeStatementRewrittenTableRows] = rewrittenTableRows
+}
+
 // expandCELVars creates CEL variable maps for each combination of statement types and table names.
 func expandCELVars(base map[string]any, statementTypes, tableNames []string) []map[string]any {
 	if len(statementTypes) == 0 {
@@ -0,0 +1,461 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	mysqldriver "github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	pgdriver "github.com/bytebase/bytebase/backend/plugin/db/pg"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	// explainMaxStatements is the maximum number of statements explained in a sheet.
+	explainMaxStatements = 20
+	// explainLargeTableRows is the row count from which a fully scanned table is reported.
+	explainLargeTableRows = 100000
+)
+
+// explainableStatementKeywords are the leading keywords of the DML statements and queries that can be explained without executing them.
+var explainableStatementKeywords = []string{"SELECT", "WITH", "INSERT", "UPDATE", "DELETE", "REPLACE"}
+
+// getExplainJSON returns the query plan of the statement in JSON format.
+type getExplainJSON func(context.Context, string) (string, error)
+
+// explainPlanParser parses the query plan in JSON format into the plan tree.
+type explainPlanParser func(string) (*storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode, error)
+
+// NewExplainExecutor creates an explain executor.
+func NewExplainExecutor(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory) Executor {
+	return &ExplainExecutor{
+		store:        store,
+		sheetManager: sheetManager,
+		dbFactory:    dbFactory,
+	}
+}
+
+// ExplainExecutor is the explain executor.
+// It explains the DML statements without executing them, reports the full scans on large tables and the missing index use,
+// and compares the plans with the ones of the previous environment in the same plan.
+type ExplainExecutor struct {
+	store        *store.Store
+	sheetManager *sheet.Manager
+	dbFactory    *dbfactory.DBFactory
+}
+
+// Run runs the explain executor.
+func (e *ExplainExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Explain for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &config.InstanceId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %v", config.InstanceId)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %s not found", config.InstanceId)
+	}
+	engine := instance.Metadata.GetEngine()
+	if !common.EngineSupportExplain(engine) {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Explain is not supported for %s", engine),
+				Content: "",
+			},
+		}, nil
+	}
+
+	// The syntax errors are reported by the other checks.
+	if _, syntaxAdvices := e.sheetManager.GetASTsForChecks(engine, statement); len(syntaxAdvices) > 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "Skip explain for the statement with syntax errors",
+				Content: "",
+			},
+		}, nil
+	}
+
+	statements, err := getExplainStatements(engine, statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "OK",
+				Content: "No DML statement to explain",
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		InstanceID:   instance.ResourceID,
+		DatabaseName: config.DatabaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database schema %q", config.DatabaseName)
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema %q not found", config.DatabaseName)
+	}
+
+	useDatabaseOwner, err := getUseDatabaseOwner(ctx, e.store, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{
+		UseDatabaseOwner: useDatabaseOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	explain, parser, err := getExplainer(engine, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	previousTarget, previousReports, err := e.getPreviousEnvironmentReports(ctx, config, database)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	for _, stmt := range statements {
+		text := strings.TrimSpace(stmt.Text)
+		planJSON, err := explain(ctx, text)
+		if err != nil {
+			// The statement may depend on the schema changes of the previous statements in the sheet.
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "Failed to explain the statement",
+				Content: err.Error(),
+			})
+			continue
+		}
+		plan, err := parser(planJSON)
+		if err != nil {
+			return nil, err
+		}
+		report := buildExplainReport(engine, dbSchema, text, plan)
+		report.StartPosition = stmt.Start
+		if previous, ok := previousReports[text]; ok {
+			compareExplainReport(report, previous, previousTarget)
+		}
+		results = append(results, buildExplainResult(report))
+	}
+	return results, nil
+}
+
+// getPreviousEnvironmentReports returns the explain reports of the latest run in the closest previous environment
+// for the same sheet in the plan, keyed by the statement.
+func (e *ExplainExecutor) getPreviousEnvironmentReports(ctx context.Context, config *storepb.PlanCheckRunConfig, database *store.DatabaseMessage) (string, map[string]*storepb.PlanCheckRunResult_Result_ExplainReport, error) {
+	if config.PlanUid == 0 || database.EffectiveEnvironmentID == nil {
+		return "", nil, nil
+	}
+	environmentSetting, err := e.store.GetEnvironmentSetting(ctx)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get environment setting")
+	}
+	environmentOrders := map[string]int{}
+	for i, environment := range environmentSetting.GetEnvironments() {
+		environmentOrders[environment.GetId()] = i
+	}
+	currentOrder, ok := environmentOrders[*database.EffectiveEnvironmentID]
+	if !ok {
+		return "", nil, nil
+	}
+
+	runs, err := e.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		PlanUID: &config.PlanUid,
+		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementExplain},
+		Status:  &[]store.PlanCheckRunStatus{store.PlanCheckRunStatusDone},
+	})
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to list plan check runs")
+	}
+	var previous *store.PlanCheckRunMessage
+	previousOrder := -1
+	for _, run := range runs {
+		if run.Config.GetSheetUid() != config.SheetUid {
+			continue
+		}
+		runDatabase, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &run.Config.InstanceId, DatabaseName: &run.Config.DatabaseName})
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "failed to get database %q", run.Config.DatabaseName)
+		}
+		if runDatabase == nil || runDatabase.EffectiveEnvironmentID == nil {
+			continue
+		}
+		order, ok := environmentOrders[*runDatabase.EffectiveEnvironmentID]
+		if !ok || order >= currentOrder {
+			continue
+		}
+		// The runs are ordered by ID, so the latest run of the closest previous environment wins.
+		if order >= previousOrder {
+			previous = run
+			previousOrder = order
+		}
+	}
+	if previous == nil {
+		return "", nil, nil
+	}
+
+	reports := map[string]*storepb.PlanCheckRunResult_Result_ExplainReport{}
+	for _, result := range previous.Result.GetResults() {
+		if report := result.GetExplainReport(); report != nil {
+			reports[report.Statement] = report
+		}
+	}
+	return common.FormatDatabase(previous.Config.InstanceId, previous.Config.DatabaseName), reports, nil
+}
+
+func getExplainer(engine storepb.Engine, driver db.Driver) (getExplainJSON, explainPlanParser, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		pd, ok := driver.(*pgdriver.Driver)
+		if !ok {
+			return nil, nil, errors.Errorf("invalid pg driver type")
+		}
+		return pd.ExplainJSON, parsePostgreSQLPlan, nil
+	case storepb.Engine_MYSQL:
+		md, ok := driver.(*mysqldriver.Driver)
+		if !ok {
+			return nil, nil, errors.Errorf("invalid mysql driver type")
+		}
+		return md.ExplainJSON, parseMySQLPlan, nil
+	default:
+		return nil, nil, errors.Errorf("explain is not supported for %s", engine)
+	}
+}
+
+// getExplainStatements returns the DML statements and queries in the sheet, up to explainMaxStatements.
+func getExplainStatements(engine storepb.Engine, statement string) ([]parserbase.SingleSQL, error) {
+	list, err := parserbase.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to split statements")
+	}
+	var statements []parserbase.SingleSQL
+	for _, stmt := range list {
+		if stmt.Empty || !isExplainableStatement(stmt.Text) {
+			continue
+		}
+		statements = append(statements, stmt)
+		if len(statements) >= explainMaxStatements {
+			break
+		}
+	}
+	return statements, nil
+}
+
+func isExplainableStatement(text string) bool {
+	text = strings.TrimSpace(text)
+	// Skip the leading comments.
+	for {
+		if strings.HasPrefix(text, "--") || strings.HasPrefix(text, "#") {
+			i := strings.IndexByte(text, '\n')
+			if i < 0 {
+				return false
+			}
+			text = strings.TrimSpace(text[i+1:])
+			continue
+		}
+		if strings.HasPrefix(text, "/*") {
+			i := strings.Index(text, "*/")
+			if i < 0 {
+				return false
+			}
+			text = strings.TrimSpace(text[i+2:])
+			continue
+		}
+		break
+	}
+	if i := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsLetter(r) }); i >= 0 {
+		text = text[:i]
+	}
+	return slices.Contains(explainableStatementKeywords, strings.ToUpper(text))
+}
+
+// buildExplainReport builds the report of the plan, and finds the large tables that are fully scanned.
+func buildExplainReport(engine storepb.Engine, dbSchema *model.DatabaseMetadata, statement string, plan *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) *storepb.PlanCheckRunResult_Result_ExplainReport {
+	report := &storepb.PlanCheckRunResult_Result_ExplainReport{
+		Statement: statement,
+		Plan:      plan,
+		TotalCost: plan.GetCost(),
+	}
+	walkPlanNodes(plan, func(node *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) {
+		if !node.FullScan || node.Table == "" {
+			return
+		}
+		rows := node.Rows
+		if table := findExplainTable(engine, dbSchema, node.Table); table != nil && table.GetRowCount() > 0 {
+			rows = table.GetRowCount()
+		}
+		if rows < explainLargeTableRows {
+			return
+		}
+		if !slices.Contains(report.FullScanTables, node.Table) {
+			report.FullScanTables = append(report.FullScanTables, node.Table)
+		}
+		if node.HasFilter && !slices.Contains(report.MissingIndexTables, node.Table) {
+			report.MissingIndexTables = append(report.MissingIndexTables, node.Table)
+		}
+	})
+	return report
+}
+
+// compareExplainReport compares the report with the one of the previous environment.
+// The costs are not compared as the data size differs between the environments, only the access paths are.
+func compareExplainReport(report, previous *storepb.PlanCheckRunResult_Result_ExplainReport, previousTarget string) {
+	report.PreviousTarget = previousTarget
+	report.PreviousTotalCost = previous.TotalCost
+
+	indexedTables := map[string]bool{}
+	walkPlanNodes(previous.Plan, func(node *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) {
+		if node.Table != "" && node.Index != "" {
+			indexedTables[node.Table] = true
+		}
+	})
+	walkPlanNodes(report.Plan, func(node *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) {
+		if node.FullScan && indexedTables[node.Table] && !slices.Contains(report.RegressedTables, node.Table) {
+			report.RegressedTables = append(report.RegressedTables, node.Table)
+		}
+	})
+}
+
+func buildExplainResult(report *storepb.PlanCheckRunResult_Result_ExplainReport) *storepb.PlanCheckRunResult_Result {
+	var title string
+	switch {
+	case len(report.RegressedTables) > 0:
+		title = fmt.Sprintf("Plan regression on %s", strings.Join(report.RegressedTables, ", "))
+	case len(report.MissingIndexTables) > 0:
+		title = fmt.Sprintf("Missing index on %s", strings.Join(report.MissingIndexTables, ", "))
+	case len(report.FullScanTables) > 0:
+		title = fmt.Sprintf("Full scan on %s", strings.Join(report.FullScanTables, ", "))
+	default:
+		title = "OK"
+	}
+
+	var content strings.Builder
+	_, _ = fmt.Fprintf(&content, "Estimated cost: %.2f.", report.TotalCost)
+	if len(report.RegressedTables) > 0 {
+		_, _ = fmt.Fprintf(&content, " The tables accessed by an index on %s are fully scanned.", report.PreviousTarget)
+	}
+	if len(report.MissingIndexTables) > 0 {
+		_, _ = content.WriteString(" The filter is evaluated by a full scan without an index.")
+	} else if len(report.FullScanTables) > 0 {
+		_, _ = content.WriteString(" The large tables are fully scanned.")
+	}
+	if report.PreviousTarget != "" {
+		_, _ = fmt.Fprintf(&content, " Estimated cost on %s: %.2f.", report.PreviousTarget, report.PreviousTotalCost)
+	}
+
+	status := storepb.Advice_SUCCESS
+	if len(report.RegressedTables) > 0 || len(report.FullScanTables) > 0 {
+		status = storepb.Advice_WARNING
+	}
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  status,
+		Code:    common.Ok.Int32(),
+		Title:   title,
+		Content: content.String(),
+		Report: &storepb.PlanCheckRunResult_Result_ExplainReport_{
+			ExplainReport: report,
+		},
+	}
+}
+
+func findExplainTable(engine storepb.Engine, dbSchema *model.DatabaseMetadata, tableName string) *storepb.TableMetadata {
+	if dbSchema == nil {
+		return nil
+	}
+	schemaName := ""
+	if engine == storepb.Engine_POSTGRES {
+		schemaName = "public"
+	}
+	if schema := dbSchema.GetSchemaMetadata(schemaName); schema != nil {
+		if table := schema.GetTable(tableName); table != nil {
+			return table.GetProto()
+		}
+	}
+	// The plan does not qualify the table with the schema, so fall back to the other schemas.
+	for _, schema := range dbSchema.GetProto().GetSchemas() {
+		for _, table := range schema.GetTables() {
+			if table.GetName() == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+func walkPlanNodes(node *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode, visit func(*storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode)) {
+	if node == nil {
+		return
+	}
+	visit(node)
+	for _, child := range node.Children {
+		walkPlanNodes(child, visit)
+	}
+}
+
+// explainCostCalculator returns the function that estimates the cost of the statement from the plan.
+func explainCostCalculator(explain getExplainJSON, parser explainPlanParser) getCostFromExplain {
+	return func(ctx context.Context, statement string) (float64, error) {
+		planJSON, err := explain(ctx, statement)
+		if err != nil {
+			return 0, err
+		}
+		plan, err := parser(planJSON)
+		if err != nil {
+			return 0, err
+		}
+		return plan.GetCost(), nil
+	}
+}
@@ -0,0 +1,88 @@
+package plancheck
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func parseMySQLPlan(planJSON string) (*storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode, error) {
+	var plan map[string]any
+	if err := json.Unmarshal([]byte(planJSON), &plan); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse query plan %q", planJSON)
+	}
+	queryBlock, ok := plan["query_block"].(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("query block not found in %q", planJSON)
+	}
+	root := &storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+		Operation: "query_block",
+	}
+	if costInfo, ok := queryBlock["cost_info"].(map[string]any); ok {
+		root.Cost = getMySQLPlanNumber(costInfo["query_cost"])
+	}
+	walkMySQLPlan(root, queryBlock)
+	// MySQL does not report the query cost of UPDATE and DELETE, so the examined rows are used as the cost.
+	if root.Cost == 0 {
+		walkPlanNodes(root, func(node *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) {
+			root.Cost += float64(node.Rows)
+		})
+	}
+	return root, nil
+}
+
+// walkMySQLPlan adds the tables in the plan object as the children of the parent node.
+// The tables are nested in the query block, e.g. nested_loop, ordering_operation and attached_subqueries.
+func walkMySQLPlan(parent *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode, value any) {
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			walkMySQLPlan(parent, item)
+		}
+	case map[string]any:
+		if tableName, ok := v["table_name"].(string); ok {
+			accessType, _ := v["access_type"].(string)
+			key, _ := v["key"].(string)
+			condition, _ := v["attached_condition"].(string)
+			node := &storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+				Operation: accessType,
+				Table:     tableName,
+				Index:     key,
+				Rows:      int64(getMySQLPlanNumber(v["rows_examined_per_scan"])),
+				FullScan:  accessType == "ALL",
+				HasFilter: condition != "",
+			}
+			if costInfo, ok := v["cost_info"].(map[string]any); ok {
+				node.Cost = getMySQLPlanNumber(costInfo["prefix_cost"])
+			}
+			parent.Children = append(parent.Children, node)
+			parent = node
+		}
+		// Sort the keys to keep the order of the children stable.
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			walkMySQLPlan(parent, v[key])
+		}
+	default:
+	}
+}
+
+// getMySQLPlanNumber returns the number in the plan. The costs are formatted as strings, e.g. "1.25".
+func getMySQLPlanNumber(value any) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,62 @@
+package plancheck
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// postgreSQLPlanNode is the plan node of EXPLAIN (FORMAT JSON).
+type postgreSQLPlanNode struct {
+	NodeType     string                `json:"Node Type"`
+	Operation    string                `json:"Operation"`
+	RelationName string                `json:"Relation Name"`
+	IndexName    string                `json:"Index Name"`
+	TotalCost    float64               `json:"Total Cost"`
+	PlanRows     int64                 `json:"Plan Rows"`
+	Filter       string                `json:"Filter"`
+	Plans        []*postgreSQLPlanNode `json:"Plans"`
+}
+
+func parsePostgreSQLPlan(planJSON string) (*storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode, error) {
+	var plans []struct {
+		Plan *postgreSQLPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(planJSON), &plans); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse query plan %q", planJSON)
+	}
+	if len(plans) == 0 || plans[0].Plan == nil {
+		return nil, errors.Errorf("query plan not found in %q", planJSON)
+	}
+	return convertPostgreSQLPlanNode(plans[0].Plan), nil
+}
+
+func convertPostgreSQLPlanNode(n *postgreSQLPlanNode) *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode {
+	node := &storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+		Operation: n.NodeType,
+		Table:     n.RelationName,
+		Index:     n.IndexName,
+		Cost:      n.TotalCost,
+		Rows:      n.PlanRows,
+		FullScan:  n.NodeType == "Seq Scan",
+		HasFilter: n.Filter != "",
+	}
+	// The operation of ModifyTable is Insert, Update, Delete or Merge.
+	if n.NodeType == "ModifyTable" && n.Operation != "" {
+		node.Operation = n.Operation
+	}
+	for _, child := range n.Plans {
+		node.Children = append(node.Children, convertPostgreSQLPlanNode(child))
+	}
+	// The index of the bitmap heap scan is on its bitmap index scan children.
+	if n.NodeType == "Bitmap Heap Scan" {
+		walkPlanNodes(node, func(child *storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode) {
+			if node.Index == "" {
+				node.Index = child.Index
+			}
+		})
+	}
+	return node
+}
@@ -0,0 +1,142 @@
+package plancheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestParsePostgreSQLPlan(t *testing.T) {
+	plan, err := parsePostgreSQLPlan(`[
+  {
+    "Plan": {
+      "Node Type": "ModifyTable",
+      "Operation": "Update",
+      "Relation Name": "orders",
+      "Total Cost": 2041.5,
+      "Plan Rows": 0,
+      "Plans": [
+        {
+          "Node Type": "Seq Scan",
+          "Relation Name": "orders",
+          "Total Cost": 2041.5,
+          "Plan Rows": 50,
+          "Filter": "(customer_id = 1)"
+        }
+      ]
+    }
+  }
+]`)
+	require.NoError(t, err)
+	require.Equal(t, "Update", plan.Operation)
+	require.Equal(t, 2041.5, plan.Cost)
+	require.Len(t, plan.Children, 1)
+	require.Equal(t, "orders", plan.Children[0].Table)
+	require.True(t, plan.Children[0].FullScan)
+	require.True(t, plan.Children[0].HasFilter)
+
+	plan, err = parsePostgreSQLPlan(`[
+  {
+    "Plan": {
+      "Node Type": "Bitmap Heap Scan",
+      "Relation Name": "orders",
+      "Total Cost": 12.3,
+      "Plan Rows": 50,
+      "Plans": [
+        {
+          "Node Type": "Bitmap Index Scan",
+          "Index Name": "idx_orders_customer_id",
+          "Total Cost": 4.3,
+          "Plan Rows": 50
+        }
+      ]
+    }
+  }
+]`)
+	require.NoError(t, err)
+	require.Equal(t, "idx_orders_customer_id", plan.Index)
+	require.False(t, plan.FullScan)
+}
+
+func TestParseMySQLPlan(t *testing.T) {
+	plan, err := parseMySQLPlan(`{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "1203.50"},
+    "nested_loop": [
+      {"table": {"table_name": "o", "access_type": "ALL", "rows_examined_per_scan": 10000, "attached_condition": "(o.status = 'new')", "cost_info": {"prefix_cost": "1003.50"}}},
+      {"table": {"table_name": "c", "access_type": "eq_ref", "key": "PRIMARY", "rows_examined_per_scan": 1, "cost_info": {"prefix_cost": "1203.50"}}}
+    ]
+  }
+}`)
+	require.NoError(t, err)
+	require.Equal(t, 1203.5, plan.Cost)
+	require.Len(t, plan.Children, 2)
+	require.Equal(t, "o", plan.Children[0].Table)
+	require.True(t, plan.Children[0].FullScan)
+	require.True(t, plan.Children[0].HasFilter)
+	require.Equal(t, int64(10000), plan.Children[0].Rows)
+	require.Equal(t, "PRIMARY", plan.Children[1].Index)
+
+	// UPDATE and DELETE have no query cost.
+	plan, err = parseMySQLPlan(`{"query_block": {"select_id": 1, "table": {"update": true, "table_name": "t", "access_type": "range", "key": "idx", "rows_examined_per_scan": 30, "attached_condition": "(t.c > 1)"}}}`)
+	require.NoError(t, err)
+	require.Equal(t, float64(30), plan.Cost)
+	require.Len(t, plan.Children, 1)
+	require.False(t, plan.Children[0].FullScan)
+}
+
+func TestIsExplainableStatement(t *testing.T) {
+	tests := map[string]bool{
+		"SELECT * FROM t;":                            true,
+		"  update t SET c = 1;":                       true,
+		"-- comment\n/* block */ DELETE FROM t;":      true,
+		"WITH x AS (SELECT 1) SELECT * FROM x;":       true,
+		"ALTER TABLE t ADD COLUMN c int;":             false,
+		"CREATE TABLE t AS SELECT * FROM s;":          false,
+		"/* unterminated comment SELECT 1":            false,
+		"SELECTED;":                                   false,
+		"insert into t values (1);":                   true,
+		"# mysql comment\nREPLACE INTO t VALUES (1);": true,
+	}
+	for statement, want := range tests {
+		require.Equal(t, want, isExplainableStatement(statement), statement)
+	}
+}
+
+func TestExplainReport(t *testing.T) {
+	plan := &storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+		Operation: "query_block",
+		Cost:      2000,
+		Children: []*storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+			{Operation: "ALL", Table: "big", Rows: 200000, FullScan: true, HasFilter: true},
+			{Operation: "ALL", Table: "small", Rows: 10, FullScan: true, HasFilter: true},
+			{Operation: "ALL", Table: "unfiltered", Rows: 100000, FullScan: true},
+		},
+	}
+	report := buildExplainReport(storepb.Engine_MYSQL, nil, "SELECT 1", plan)
+	require.Equal(t, float64(2000), report.TotalCost)
+	require.Equal(t, []string{"big", "unfiltered"}, report.FullScanTables)
+	require.Equal(t, []string{"big"}, report.MissingIndexTables)
+
+	previous := &storepb.PlanCheckRunResult_Result_ExplainReport{
+		TotalCost: 20,
+		Plan: &storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+			Operation: "query_block",
+			Children: []*storepb.PlanCheckRunResult_Result_ExplainReport_PlanNode{
+				{Operation: "ref", Table: "small", Index: "idx_small"},
+				{Operation: "ALL", Table: "big", FullScan: true},
+			},
+		},
+	}
+	compareExplainReport(report, previous, "instances/test/databases/db")
+	require.Equal(t, "instances/test/databases/db", report.PreviousTarget)
+	require.Equal(t, float64(20), report.PreviousTotalCost)
+	require.Equal(t, []string{"small"}, report.RegressedTables)
+
+	result := buildExplainResult(report)
+	require.Equal(t, storepb.Advice_WARNING, result.Status)
+	require.Equal(t, "Plan regression on small", result.Title)
+}
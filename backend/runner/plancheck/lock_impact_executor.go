@@ -0,0 +1,278 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	// lockImpactRewriteBytesPerSecond is the assumed throughput of rewriting the table including its indexes.
+	lockImpactRewriteBytesPerSecond = 50 * 1024 * 1024
+	// lockImpactScanBytesPerSecond is the assumed throughput of scanning the table, e.g. validating the constraint or building the index.
+	lockImpactScanBytesPerSecond = 200 * 1024 * 1024
+	// lockImpactDefaultRowBytes is the assumed row size if the table size is not synced.
+	lockImpactDefaultRowBytes = 100
+	// lockImpactBlockingThresholdSeconds is the estimated blocking duration from which the lock impact is reported as a warning.
+	lockImpactBlockingThresholdSeconds = 10
+)
+
+// NewLockImpactExecutor creates a lock impact executor.
+func NewLockImpactExecutor(store *store.Store, sheetManager *sheet.Manager) Executor {
+	return &LockImpactExecutor{
+		store:        store,
+		sheetManager: sheetManager,
+	}
+}
+
+// LockImpactExecutor is the lock impact executor.
+// It classifies the locks taken by the DDL statements, and estimates how long the concurrent writes are blocked from the synced table size.
+type LockImpactExecutor struct {
+	store        *store.Store
+	sheetManager *sheet.Manager
+}
+
+// Run runs the lock impact executor.
+func (e *LockImpactExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Lock impact analysis for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &config.InstanceId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %v", config.InstanceId)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %s not found", config.InstanceId)
+	}
+	engine := instance.Metadata.GetEngine()
+	if !common.EngineSupportLockImpact(engine) {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Lock impact analysis is not supported for %s", engine),
+				Content: "",
+			},
+		}, nil
+	}
+
+	// The syntax errors are reported by the other checks.
+	if _, syntaxAdvices := e.sheetManager.GetASTsForChecks(engine, statement); len(syntaxAdvices) > 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "Skip lock impact analysis for the statement with syntax errors",
+				Content: "",
+			},
+		}, nil
+	}
+
+	dbSchema, err := e.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		InstanceID:   instance.ResourceID,
+		DatabaseName: config.DatabaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database schema %q", config.DatabaseName)
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema %q not found", config.DatabaseName)
+	}
+
+	var impacts []*lockImpact
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		impacts, err = getPostgreSQLLockImpacts(statement)
+	case storepb.Engine_MYSQL:
+		impacts, err = getMySQLLockImpacts(statement, instance.Metadata.GetVersion())
+	default:
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	for _, impact := range impacts {
+		results = append(results, impact.buildResult(engine, dbSchema))
+	}
+	if len(results) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "OK",
+				Content: "",
+			},
+		}, nil
+	}
+	return results, nil
+}
+
+// lockOperation is the lock taken by a single DDL operation, e.g. an ALTER TABLE command.
+type lockOperation struct {
+	name         string
+	lockLevel    storepb.PlanCheckRunResult_Result_LockImpactReport_LockLevel
+	algorithm    storepb.PlanCheckRunResult_Result_LockImpactReport_Algorithm
+	tableRewrite bool
+	tableScan    bool
+	// blockWrites is true if the concurrent writes are blocked while the table is rewritten or scanned.
+	blockWrites bool
+}
+
+// lockImpact is the lock impact of a DDL statement on a table, combining the locks of its operations.
+type lockImpact struct {
+	schema string
+	table  string
+	// index is the name of the dropped index if the table is unknown from the statement.
+	index string
+	line  int
+
+	operations   []string
+	lockLevel    storepb.PlanCheckRunResult_Result_LockImpactReport_LockLevel
+	algorithm    storepb.PlanCheckRunResult_Result_LockImpactReport_Algorithm
+	tableRewrite bool
+	tableScan    bool
+	blockWrites  bool
+}
+
+// add combines the operation into the impact. The strongest lock level and the slowest algorithm take effect.
+func (i *lockImpact) add(op *lockOperation) {
+	i.operations = append(i.operations, op.name)
+	i.lockLevel = max(i.lockLevel, op.lockLevel)
+	i.algorithm = max(i.algorithm, op.algorithm)
+	i.tableRewrite = i.tableRewrite || op.tableRewrite
+	i.tableScan = i.tableScan || op.tableScan
+	i.blockWrites = i.blockWrites || op.blockWrites
+}
+
+func (i *lockImpact) buildResult(engine storepb.Engine, dbSchema *model.DatabaseMetadata) *storepb.PlanCheckRunResult_Result {
+	schemaName := i.schema
+	if schemaName == "" && engine == storepb.Engine_POSTGRES {
+		schemaName = "public"
+	}
+	report := &storepb.PlanCheckRunResult_Result_LockImpactReport{
+		Schema:        schemaName,
+		Table:         i.table,
+		LockLevel:     i.lockLevel,
+		Algorithm:     i.algorithm,
+		TableRewrite:  i.tableRewrite,
+		TableScan:     i.tableScan,
+		StartPosition: common.ConvertANTLRLineToPosition(i.line),
+	}
+	if table := i.findTable(dbSchema, schemaName); table != nil {
+		report.Table = table.GetName()
+		report.TableRows = table.GetRowCount()
+		report.TableSize = table.GetDataSize() + table.GetIndexSize()
+	}
+	report.EstimatedBlockingSeconds = i.estimateBlockingSeconds(report.TableRows, report.TableSize)
+
+	tableName := report.Table
+	if tableName == "" {
+		tableName = i.index
+	}
+	if report.Schema != "" {
+		tableName = fmt.Sprintf("%s.%s", report.Schema, tableName)
+	}
+	var title string
+	if engine == storepb.Engine_MYSQL && i.algorithm != storepb.PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED {
+		title = fmt.Sprintf("ALGORITHM=%s on %s", i.algorithm, tableName)
+	} else {
+		title = fmt.Sprintf("%s lock on %s", strings.ReplaceAll(i.lockLevel.String(), "_", " "), tableName)
+	}
+
+	var content strings.Builder
+	_, _ = fmt.Fprintf(&content, "Operations: %s.", strings.Join(i.operations, ", "))
+	switch {
+	case i.tableRewrite:
+		_, _ = content.WriteString(" The table is rewritten.")
+	case i.tableScan:
+		_, _ = content.WriteString(" The table is fully scanned.")
+	default:
+	}
+	if report.EstimatedBlockingSeconds > 0 {
+		blocked := "Writes are"
+		if i.lockLevel == storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE {
+			blocked = "Reads and writes are"
+		}
+		_, _ = fmt.Fprintf(&content, " %s blocked for about %d seconds estimated from %d rows and %d bytes.", blocked, report.EstimatedBlockingSeconds, report.TableRows, report.TableSize)
+	}
+
+	status := storepb.Advice_SUCCESS
+	if i.blockWrites && (i.tableRewrite || report.EstimatedBlockingSeconds >= lockImpactBlockingThresholdSeconds) {
+		status = storepb.Advice_WARNING
+	}
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  status,
+		Code:    common.Ok.Int32(),
+		Title:   title,
+		Content: content.String(),
+		Report: &storepb.PlanCheckRunResult_Result_LockImpactReport_{
+			LockImpactReport: report,
+		},
+	}
+}
+
+func (i *lockImpact) findTable(dbSchema *model.DatabaseMetadata, schemaName string) *storepb.TableMetadata {
+	schema := dbSchema.GetSchemaMetadata(schemaName)
+	if schema == nil {
+		return nil
+	}
+	if i.table != "" {
+		table := schema.GetTable(i.table)
+		if table == nil {
+			return nil
+		}
+		return table.GetProto()
+	}
+	for _, table := range schema.GetProto().GetTables() {
+		for _, index := range table.GetIndexes() {
+			if index.GetName() == i.index {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+// estimateBlockingSeconds estimates how long the concurrent writes are blocked while the table is rewritten or scanned.
+func (i *lockImpact) estimateBlockingSeconds(rows, size int64) int64 {
+	if !i.blockWrites || (!i.tableRewrite && !i.tableScan) {
+		return 0
+	}
+	if size == 0 {
+		size = rows * lockImpactDefaultRowBytes
+	}
+	bytesPerSecond := int64(lockImpactScanBytesPerSecond)
+	if i.tableRewrite {
+		bytesPerSecond = lockImpactRewriteBytesPerSecond
+	}
+	return (size + bytesPerSecond - 1) / bytesPerSecond
+}
@@ -0,0 +1,310 @@
+package plancheck
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/blang/semver/v4"
+	"github.com/bytebase/parser/mysql"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+)
+
+var (
+	// mysqlInstantAddColumnVersion is the first version which adds the column as the last column instantly.
+	mysqlInstantAddColumnVersion = semver.MustParse("8.0.12")
+	// mysqlInstantColumnVersion is the first version which adds the column in any position and drops the column instantly.
+	mysqlInstantColumnVersion = semver.MustParse("8.0.29")
+)
+
+// getMySQLLockImpacts returns the lock impacts of the DDL statements for MySQL.
+// The rules follow the online DDL operations documented in https://dev.mysql.com/doc/refman/8.0/en/innodb-online-ddl-operations.html.
+// All DDL statements take the exclusive metadata lock briefly, so the algorithm tells whether the concurrent writes are blocked.
+func getMySQLLockImpacts(statement string, version string) ([]*lockImpact, error) {
+	results, err := mysqlparser.ParseMySQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	// Treat the unknown version as the oldest one which does not support the instant DDL.
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		v = semver.Version{}
+	}
+	var impacts []*lockImpact
+	for _, result := range results {
+		listener := &mysqlLockImpactListener{
+			tokens:   result.Tokens,
+			baseLine: result.BaseLine,
+			version:  v,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+		impacts = append(impacts, listener.impacts...)
+	}
+	return impacts, nil
+}
+
+type mysqlLockImpactListener struct {
+	*mysql.BaseMySQLParserListener
+
+	tokens   *antlr.CommonTokenStream
+	baseLine int
+	version  semver.Version
+	impacts  []*lockImpact
+}
+
+func (l *mysqlLockImpactListener) newImpact(ctx antlr.ParserRuleContext, tableRef mysql.ITableRefContext) *lockImpact {
+	if tableRef == nil {
+		return nil
+	}
+	_, table := mysqlparser.NormalizeMySQLTableRef(tableRef)
+	return &lockImpact{
+		table: table,
+		line:  l.baseLine + ctx.GetStart().GetLine(),
+	}
+}
+
+func (l *mysqlLockImpactListener) EnterAlterTable(ctx *mysql.AlterTableContext) {
+	impact := l.newImpact(ctx, ctx.TableRef())
+	if impact == nil || ctx.AlterTableActions() == nil {
+		return
+	}
+	actions := ctx.AlterTableActions()
+	var modifiers []mysql.IAlterCommandsModifierContext
+	if actions.AlterCommandsModifierList() != nil {
+		modifiers = append(modifiers, actions.AlterCommandsModifierList().AllAlterCommandsModifier()...)
+	}
+	if commandList := actions.AlterCommandList(); commandList != nil {
+		if commandList.AlterCommandsModifierList() != nil {
+			modifiers = append(modifiers, commandList.AlterCommandsModifierList().AllAlterCommandsModifier()...)
+		}
+		if alterList := commandList.AlterList(); alterList != nil {
+			modifiers = append(modifiers, alterList.AllAlterCommandsModifier()...)
+			for _, item := range alterList.AllAlterListItem() {
+				impact.add(l.classifyAlterListItem(item))
+			}
+			for _, option := range alterList.AllCreateTableOptionsSpaceSeparated() {
+				impact.add(l.classifyTableOption(option))
+			}
+		}
+	}
+	if actions.PartitionClause() != nil || actions.RemovePartitioning() != nil {
+		impact.add(mysqlLockOperation(l.text(actions), storepb.PlanCheckRunResult_Result_LockImpactReport_COPY))
+	}
+	if actions.StandaloneAlterCommands() != nil {
+		impact.add(mysqlLockOperation(l.text(actions.StandaloneAlterCommands()), storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE))
+	}
+	if len(impact.operations) == 0 {
+		return
+	}
+	for _, modifier := range modifiers {
+		impact.applyMySQLOptions(modifier.AlterAlgorithmOption(), modifier.AlterLockOption())
+	}
+	l.impacts = append(l.impacts, impact)
+}
+
+func (l *mysqlLockImpactListener) classifyAlterListItem(ctx mysql.IAlterListItemContext) *lockOperation {
+	text := l.text(ctx)
+	switch {
+	case ctx.ADD_SYMBOL() != nil && ctx.FieldDefinition() != nil:
+		return l.addColumnLockOperation(text, ctx.FieldDefinition(), ctx.Place() != nil)
+	case ctx.ADD_SYMBOL() != nil && ctx.TableElementList() != nil:
+		for _, element := range ctx.TableElementList().AllTableElement() {
+			if element.ColumnDefinition() != nil && element.ColumnDefinition().FieldDefinition() != nil {
+				if op := l.addColumnLockOperation(text, element.ColumnDefinition().FieldDefinition(), false); op.algorithm != storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT {
+					return op
+				}
+			}
+		}
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+	case ctx.ADD_SYMBOL() != nil && ctx.TableConstraintDef() != nil:
+		return mysqlConstraintLockOperation(text, ctx.TableConstraintDef())
+	case ctx.CHANGE_SYMBOL() != nil, ctx.MODIFY_SYMBOL() != nil:
+		// Changing the column definition copies the table unless only the name or the default is changed, which we cannot tell without the old definition.
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_COPY)
+	case ctx.DROP_SYMBOL() != nil && ctx.PRIMARY_SYMBOL() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_COPY)
+	case ctx.DROP_SYMBOL() != nil && ctx.ColumnInternalRef() != nil && ctx.FOREIGN_SYMBOL() == nil:
+		if l.version.GE(mysqlInstantColumnVersion) {
+			return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+		}
+		return mysqlRebuildLockOperation(text)
+	case ctx.DROP_SYMBOL() != nil, ctx.ENABLE_SYMBOL() != nil, ctx.DISABLE_SYMBOL() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+	case ctx.ALTER_SYMBOL() != nil && ctx.ColumnInternalRef() != nil:
+		// SET DEFAULT, DROP DEFAULT and SET VISIBLE | INVISIBLE only change the metadata.
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+	case ctx.ALTER_SYMBOL() != nil && ctx.INDEX_SYMBOL() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+	case ctx.ALTER_SYMBOL() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+	case ctx.RENAME_SYMBOL() != nil && ctx.KeyOrIndex() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+	case ctx.RENAME_SYMBOL() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+	case ctx.FORCE_SYMBOL() != nil:
+		return mysqlRebuildLockOperation(text)
+	default:
+		// CONVERT TO CHARACTER SET, ORDER BY and the other operations copy the table.
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_COPY)
+	}
+}
+
+func (l *mysqlLockImpactListener) addColumnLockOperation(text string, ctx mysql.IFieldDefinitionContext, hasPlace bool) *lockOperation {
+	switch {
+	case ctx.STORED_SYMBOL() != nil:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_COPY)
+	case mysqlparser.IsAutoIncrement(ctx):
+		// Adding the auto-increment column rebuilds the table without the concurrent DML.
+		op := mysqlRebuildLockOperation(text)
+		op.blockWrites = true
+		return op
+	case l.version.GE(mysqlInstantColumnVersion), l.version.GE(mysqlInstantAddColumnVersion) && !hasPlace:
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+	default:
+		return mysqlRebuildLockOperation(text)
+	}
+}
+
+func mysqlConstraintLockOperation(text string, ctx mysql.ITableConstraintDefContext) *lockOperation {
+	if ctx.GetType_() == nil {
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_COPY)
+	}
+	switch ctx.GetType_().GetTokenType() {
+	case mysql.MySQLParserPRIMARY_SYMBOL:
+		return mysqlRebuildLockOperation(text)
+	case mysql.MySQLParserFULLTEXT_SYMBOL, mysql.MySQLParserSPATIAL_SYMBOL:
+		// Building the FULLTEXT and SPATIAL index does not permit the concurrent DML.
+		op := mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+		op.tableScan, op.blockWrites = true, true
+		return op
+	case mysql.MySQLParserKEY_SYMBOL, mysql.MySQLParserINDEX_SYMBOL, mysql.MySQLParserUNIQUE_SYMBOL:
+		op := mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+		op.tableScan = true
+		return op
+	default:
+		// FOREIGN KEY and CHECK constraints validate the existing rows by copying the table.
+		return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_COPY)
+	}
+}
+
+func (l *mysqlLockImpactListener) classifyTableOption(ctx mysql.ICreateTableOptionsSpaceSeparatedContext) *lockOperation {
+	text := l.text(ctx)
+	upper := strings.ToUpper(text)
+	for _, option := range []string{"ENGINE", "ROW_FORMAT", "KEY_BLOCK_SIZE"} {
+		if strings.Contains(upper, option) {
+			return mysqlRebuildLockOperation(text)
+		}
+	}
+	return mysqlLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT)
+}
+
+func (l *mysqlLockImpactListener) EnterCreateIndex(ctx *mysql.CreateIndexContext) {
+	if ctx.CreateIndexTarget() == nil {
+		return
+	}
+	impact := l.newImpact(ctx, ctx.CreateIndexTarget().TableRef())
+	if impact == nil {
+		return
+	}
+	op := mysqlLockOperation("CREATE INDEX", storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+	op.tableScan = true
+	op.blockWrites = ctx.FULLTEXT_SYMBOL() != nil || ctx.SPATIAL_SYMBOL() != nil
+	impact.add(op)
+	if option := ctx.IndexLockAndAlgorithm(); option != nil {
+		impact.applyMySQLOptions(option.AlterAlgorithmOption(), option.AlterLockOption())
+	}
+	l.impacts = append(l.impacts, impact)
+}
+
+func (l *mysqlLockImpactListener) EnterDropIndex(ctx *mysql.DropIndexContext) {
+	impact := l.newImpact(ctx, ctx.TableRef())
+	if impact == nil {
+		return
+	}
+	impact.add(mysqlLockOperation("DROP INDEX", storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE))
+	if option := ctx.IndexLockAndAlgorithm(); option != nil {
+		impact.applyMySQLOptions(option.AlterAlgorithmOption(), option.AlterLockOption())
+	}
+	l.impacts = append(l.impacts, impact)
+}
+
+func (l *mysqlLockImpactListener) EnterDropTable(ctx *mysql.DropTableContext) {
+	if ctx.TEMPORARY_SYMBOL() != nil || ctx.TableRefList() == nil {
+		return
+	}
+	for _, tableRef := range ctx.TableRefList().AllTableRef() {
+		if impact := l.newImpact(ctx, tableRef); impact != nil {
+			impact.add(mysqlLockOperation("DROP TABLE", storepb.PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED))
+			l.impacts = append(l.impacts, impact)
+		}
+	}
+}
+
+func (l *mysqlLockImpactListener) EnterTruncateTableStatement(ctx *mysql.TruncateTableStatementContext) {
+	if impact := l.newImpact(ctx, ctx.TableRef()); impact != nil {
+		impact.add(mysqlLockOperation("TRUNCATE", storepb.PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED))
+		l.impacts = append(l.impacts, impact)
+	}
+}
+
+func (l *mysqlLockImpactListener) EnterRenameTableStatement(ctx *mysql.RenameTableStatementContext) {
+	for _, pair := range ctx.AllRenamePair() {
+		if impact := l.newImpact(ctx, pair.TableRef()); impact != nil {
+			impact.add(mysqlLockOperation("RENAME TABLE", storepb.PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED))
+			l.impacts = append(l.impacts, impact)
+		}
+	}
+}
+
+func (l *mysqlLockImpactListener) EnterCreateTrigger(ctx *mysql.CreateTriggerContext) {
+	if impact := l.newImpact(ctx, ctx.TableRef()); impact != nil {
+		impact.add(mysqlLockOperation("CREATE TRIGGER", storepb.PlanCheckRunResult_Result_LockImpactReport_ALGORITHM_UNSPECIFIED))
+		l.impacts = append(l.impacts, impact)
+	}
+}
+
+func (l *mysqlLockImpactListener) text(ctx antlr.ParserRuleContext) string {
+	return l.tokens.GetTextFromRuleContext(ctx)
+}
+
+// applyMySQLOptions applies the explicit ALGORITHM and LOCK clauses, which take precedence over the default algorithm of the operations.
+func (i *lockImpact) applyMySQLOptions(algorithmOption mysql.IAlterAlgorithmOptionContext, lockOption mysql.IAlterLockOptionContext) {
+	if algorithmOption != nil && algorithmOption.Identifier() != nil {
+		switch strings.ToUpper(mysqlparser.NormalizeMySQLIdentifier(algorithmOption.Identifier())) {
+		case "INSTANT":
+			i.algorithm = storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT
+		case "INPLACE":
+			i.algorithm = storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE
+		case "COPY":
+			i.algorithm = storepb.PlanCheckRunResult_Result_LockImpactReport_COPY
+			i.tableRewrite, i.blockWrites = true, true
+		}
+	}
+	if lockOption != nil && lockOption.Identifier() != nil {
+		switch strings.ToUpper(mysqlparser.NormalizeMySQLIdentifier(lockOption.Identifier())) {
+		case "SHARED", "EXCLUSIVE":
+			i.blockWrites = true
+		}
+	}
+}
+
+// mysqlLockOperation creates the lock operation for MySQL. The COPY algorithm rebuilds the table without the concurrent writes.
+func mysqlLockOperation(name string, algorithm storepb.PlanCheckRunResult_Result_LockImpactReport_Algorithm) *lockOperation {
+	isCopy := algorithm == storepb.PlanCheckRunResult_Result_LockImpactReport_COPY
+	return &lockOperation{
+		name:         name,
+		lockLevel:    storepb.PlanCheckRunResult_Result_LockImpactReport_METADATA_LOCK,
+		algorithm:    algorithm,
+		tableRewrite: isCopy,
+		blockWrites:  isCopy,
+	}
+}
+
+// mysqlRebuildLockOperation creates the lock operation which rebuilds the table in place with the concurrent DML.
+func mysqlRebuildLockOperation(name string) *lockOperation {
+	op := mysqlLockOperation(name, storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE)
+	op.tableRewrite = true
+	return op
+}
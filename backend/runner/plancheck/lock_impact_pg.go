@@ -0,0 +1,290 @@
+package plancheck
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/postgresql"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/pg"
+)
+
+// pgVolatileFunctions are the volatile functions which force a table rewrite if used as the default value of a new column.
+var pgVolatileFunctions = []string{
+	"random(",
+	"clock_timestamp(",
+	"timeofday(",
+	"nextval(",
+	"gen_random_uuid(",
+	"uuid_generate_",
+}
+
+// getPostgreSQLLockImpacts returns the lock impacts of the DDL statements for PostgreSQL.
+// The rules follow the lock levels documented in https://www.postgresql.org/docs/current/sql-altertable.html.
+func getPostgreSQLLockImpacts(statement string) ([]*lockImpact, error) {
+	results, err := pg.ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	var impacts []*lockImpact
+	for _, result := range results {
+		listener := &pgLockImpactListener{
+			tokens:   result.Tokens,
+			baseLine: result.BaseLine,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+		impacts = append(impacts, listener.impacts...)
+	}
+	return impacts, nil
+}
+
+type pgLockImpactListener struct {
+	*postgresql.BasePostgreSQLParserListener
+
+	tokens   *antlr.CommonTokenStream
+	baseLine int
+	impacts  []*lockImpact
+}
+
+func (l *pgLockImpactListener) newImpact(ctx antlr.ParserRuleContext, names []string) *lockImpact {
+	impact := &lockImpact{
+		line: l.baseLine + ctx.GetStart().GetLine(),
+	}
+	switch len(names) {
+	case 1:
+		impact.table = names[0]
+	case 2:
+		impact.schema, impact.table = names[0], names[1]
+	default:
+		return nil
+	}
+	return impact
+}
+
+func (l *pgLockImpactListener) EnterAltertablestmt(ctx *postgresql.AltertablestmtContext) {
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	if ctx.TABLE() == nil || ctx.FOREIGN() != nil || ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil {
+		return
+	}
+	impact := l.newImpact(ctx, pg.NormalizePostgreSQLQualifiedName(ctx.Relation_expr().Qualified_name()))
+	if impact == nil {
+		return
+	}
+	if ctx.Partition_cmd() != nil {
+		impact.add(pgLockOperation(l.tokens.GetTextFromRuleContext(ctx.Partition_cmd()), storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false))
+	}
+	if ctx.Alter_table_cmds() != nil {
+		for _, cmd := range ctx.Alter_table_cmds().AllAlter_table_cmd() {
+			impact.add(l.classifyAlterTableCmd(cmd))
+		}
+	}
+	l.impacts = append(l.impacts, impact)
+}
+
+func (l *pgLockImpactListener) classifyAlterTableCmd(ctx postgresql.IAlter_table_cmdContext) *lockOperation {
+	text := l.tokens.GetTextFromRuleContext(ctx)
+	switch {
+	case ctx.ColumnDef() != nil:
+		rewrite, scan := pgColumnDefRewriteOrScan(ctx.ColumnDef())
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, rewrite, scan)
+	case ctx.TYPE_P() != nil && ctx.Typename() != nil:
+		// Changing the column type rewrites the table unless the types are binary coercible, which we cannot tell without the old type.
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, true, false)
+	case ctx.ALTER() != nil && ctx.SET() != nil && ctx.NOT() != nil && ctx.NULL_P() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, true)
+	case ctx.Tableconstraint() != nil:
+		return pgConstraintLockOperation(text, ctx.Tableconstraint().Constraintelem())
+	case ctx.VALIDATE() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE, false, true)
+	case ctx.LOGGED() != nil, ctx.UNLOGGED() != nil, ctx.TABLESPACE() != nil, ctx.ACCESS() != nil && ctx.METHOD() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, true, false)
+	case ctx.TRIGGER() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_ROW_EXCLUSIVE, false, false)
+	case ctx.STATISTICS() != nil, ctx.CLUSTER() != nil, ctx.Reloptions() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE, false, false)
+	default:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false)
+	}
+}
+
+// pgColumnDefRewriteOrScan returns whether adding the column rewrites or scans the table.
+func pgColumnDefRewriteOrScan(ctx postgresql.IColumnDefContext) (bool, bool) {
+	rewrite, scan := false, false
+	if ctx.Typename() != nil {
+		switch strings.ToLower(ctx.Typename().GetText()) {
+		case "serial", "serial4", "bigserial", "serial8", "smallserial", "serial2":
+			rewrite = true
+		}
+	}
+	if ctx.Colquallist() == nil {
+		return rewrite, scan
+	}
+	for _, constraint := range ctx.Colquallist().AllColconstraint() {
+		elem := constraint.Colconstraintelem()
+		if elem == nil {
+			continue
+		}
+		switch {
+		case elem.GENERATED() != nil:
+			// Both the stored generated column and the identity column fill the existing rows.
+			rewrite = true
+		case elem.DEFAULT() != nil && elem.B_expr() != nil:
+			if isPostgreSQLVolatileExpression(elem.B_expr().GetText()) {
+				rewrite = true
+			}
+		case elem.UNIQUE() != nil, elem.PRIMARY() != nil, elem.CHECK() != nil:
+			scan = true
+		}
+	}
+	return rewrite, scan
+}
+
+func pgConstraintLockOperation(text string, ctx postgresql.IConstraintelemContext) *lockOperation {
+	if ctx == nil {
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false)
+	}
+	notValid := false
+	if spec := ctx.Constraintattributespec(); spec != nil {
+		for _, elem := range spec.AllConstraintattributeElem() {
+			if elem.NOT() != nil && elem.VALID() != nil {
+				notValid = true
+			}
+		}
+	}
+	switch {
+	case ctx.FOREIGN() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_ROW_EXCLUSIVE, false, !notValid)
+	case ctx.CHECK() != nil:
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, !notValid)
+	case ctx.Existingindex() != nil:
+		// The constraint is attached to an index built beforehand.
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false)
+	default:
+		// UNIQUE, PRIMARY KEY and EXCLUDE build the index.
+		return pgLockOperation(text, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, true)
+	}
+}
+
+func (l *pgLockImpactListener) EnterIndexstmt(ctx *postgresql.IndexstmtContext) {
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil {
+		return
+	}
+	impact := l.newImpact(ctx, pg.NormalizePostgreSQLQualifiedName(ctx.Relation_expr().Qualified_name()))
+	if impact == nil {
+		return
+	}
+	lockLevel := storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE
+	if ctx.Opt_concurrently() != nil {
+		lockLevel = storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE
+	}
+	impact.add(pgLockOperation("CREATE INDEX", lockLevel, false, true))
+	l.impacts = append(l.impacts, impact)
+}
+
+func (l *pgLockImpactListener) EnterDropstmt(ctx *postgresql.DropstmtContext) {
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	// DROP INDEX CONCURRENTLY does not block the table.
+	if ctx.Object_type_any_name() == nil || ctx.Any_name_list() == nil {
+		return
+	}
+	objectType := ctx.Object_type_any_name()
+	isTable := objectType.TABLE() != nil && objectType.FOREIGN() == nil
+	isIndex := objectType.INDEX() != nil
+	if !isTable && !isIndex {
+		return
+	}
+	for _, name := range ctx.Any_name_list().AllAny_name() {
+		impact := l.newImpact(ctx, pg.NormalizePostgreSQLAnyName(name))
+		if impact == nil {
+			continue
+		}
+		operation := "DROP TABLE"
+		if isIndex {
+			// The table of the index is resolved from the metadata.
+			impact.index, impact.table = impact.table, ""
+			operation = "DROP INDEX"
+		}
+		impact.add(pgLockOperation(operation, storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false))
+		l.impacts = append(l.impacts, impact)
+	}
+}
+
+func (l *pgLockImpactListener) EnterTruncatestmt(ctx *postgresql.TruncatestmtContext) {
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	if ctx.Relation_expr_list() == nil {
+		return
+	}
+	for _, relation := range ctx.Relation_expr_list().AllRelation_expr() {
+		if relation.Qualified_name() == nil {
+			continue
+		}
+		impact := l.newImpact(ctx, pg.NormalizePostgreSQLQualifiedName(relation.Qualified_name()))
+		if impact == nil {
+			continue
+		}
+		impact.add(pgLockOperation("TRUNCATE", storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false))
+		l.impacts = append(l.impacts, impact)
+	}
+}
+
+func (l *pgLockImpactListener) EnterRenamestmt(ctx *postgresql.RenamestmtContext) {
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	if ctx.TABLE() == nil || ctx.FOREIGN() != nil || ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil {
+		return
+	}
+	impact := l.newImpact(ctx, pg.NormalizePostgreSQLQualifiedName(ctx.Relation_expr().Qualified_name()))
+	if impact == nil {
+		return
+	}
+	impact.add(pgLockOperation("RENAME", storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, false, false))
+	l.impacts = append(l.impacts, impact)
+}
+
+func (l *pgLockImpactListener) EnterCreatetrigstmt(ctx *postgresql.CreatetrigstmtContext) {
+	if _, ok := ctx.GetParent().(*postgresql.StmtContext); !ok {
+		return
+	}
+	if ctx.Qualified_name() == nil {
+		return
+	}
+	impact := l.newImpact(ctx, pg.NormalizePostgreSQLQualifiedName(ctx.Qualified_name()))
+	if impact == nil {
+		return
+	}
+	impact.add(pgLockOperation("CREATE TRIGGER", storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_ROW_EXCLUSIVE, false, false))
+	l.impacts = append(l.impacts, impact)
+}
+
+// pgLockOperation creates the lock operation for PostgreSQL. All lock levels from SHARE block the concurrent writes.
+func pgLockOperation(name string, lockLevel storepb.PlanCheckRunResult_Result_LockImpactReport_LockLevel, tableRewrite, tableScan bool) *lockOperation {
+	return &lockOperation{
+		name:         name,
+		lockLevel:    lockLevel,
+		tableRewrite: tableRewrite,
+		tableScan:    tableScan,
+		blockWrites:  lockLevel >= storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE,
+	}
+}
+
+func isPostgreSQLVolatileExpression(expression string) bool {
+	expression = strings.ToLower(expression)
+	for _, function := range pgVolatileFunctions {
+		if strings.Contains(expression, function) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package plancheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+type lockImpactTestCase struct {
+	statement    string
+	schema       string
+	table        string
+	lockLevel    storepb.PlanCheckRunResult_Result_LockImpactReport_LockLevel
+	algorithm    storepb.PlanCheckRunResult_Result_LockImpactReport_Algorithm
+	tableRewrite bool
+	tableScan    bool
+	blockWrites  bool
+}
+
+func TestGetPostgreSQLLockImpacts(t *testing.T) {
+	tests := []lockImpactTestCase{
+		{
+			statement:   "ALTER TABLE t ADD COLUMN c int DEFAULT 0;",
+			table:       "t",
+			lockLevel:   storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE,
+			blockWrites: true,
+		},
+		{
+			statement:    "ALTER TABLE public.t ADD COLUMN c uuid DEFAULT gen_random_uuid();",
+			schema:       "public",
+			table:        "t",
+			lockLevel:    storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE,
+			tableRewrite: true,
+			blockWrites:  true,
+		},
+		{
+			statement:    "ALTER TABLE t ALTER COLUMN c TYPE bigint, ADD COLUMN d int;",
+			table:        "t",
+			lockLevel:    storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE,
+			tableRewrite: true,
+			blockWrites:  true,
+		},
+		{
+			statement:   "ALTER TABLE t ALTER COLUMN c SET NOT NULL;",
+			table:       "t",
+			lockLevel:   storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE,
+			tableScan:   true,
+			blockWrites: true,
+		},
+		{
+			statement:   "ALTER TABLE t ADD CONSTRAINT fk FOREIGN KEY (c) REFERENCES p (id);",
+			table:       "t",
+			lockLevel:   storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_ROW_EXCLUSIVE,
+			tableScan:   true,
+			blockWrites: true,
+		},
+		{
+			statement:   "ALTER TABLE t ADD CONSTRAINT ck CHECK (c > 0) NOT VALID;",
+			table:       "t",
+			lockLevel:   storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE,
+			blockWrites: true,
+		},
+		{
+			statement: "ALTER TABLE t VALIDATE CONSTRAINT ck;",
+			table:     "t",
+			lockLevel: storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE,
+			tableScan: true,
+		},
+		{
+			statement:   "CREATE INDEX idx ON t (c);",
+			table:       "t",
+			lockLevel:   storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE,
+			tableScan:   true,
+			blockWrites: true,
+		},
+		{
+			statement: "CREATE INDEX CONCURRENTLY idx ON t (c);",
+			table:     "t",
+			lockLevel: storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE,
+			tableScan: true,
+		},
+		{
+			statement:   "TRUNCATE t;",
+			table:       "t",
+			lockLevel:   storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE,
+			blockWrites: true,
+		},
+	}
+	for _, test := range tests {
+		impacts, err := getPostgreSQLLockImpacts(test.statement)
+		require.NoError(t, err, test.statement)
+		require.Len(t, impacts, 1, test.statement)
+		requireLockImpact(t, test, impacts[0])
+	}
+
+	impacts, err := getPostgreSQLLockImpacts("CREATE TABLE t (id int); DROP INDEX CONCURRENTLY idx; DROP INDEX s.idx;")
+	require.NoError(t, err)
+	require.Len(t, impacts, 1)
+	require.Equal(t, "s", impacts[0].schema)
+	require.Equal(t, "idx", impacts[0].index)
+	require.Equal(t, 1, impacts[0].line)
+}
+
+func TestGetMySQLLockImpacts(t *testing.T) {
+	tests := []struct {
+		lockImpactTestCase
+		version string
+	}{
+		{
+			lockImpactTestCase: lockImpactTestCase{
+				statement: "ALTER TABLE t ADD COLUMN c int AFTER id;",
+				table:     "t",
+				algorithm: storepb.PlanCheckRunResult_Result_LockImpactReport_INSTANT,
+			},
+			version: "8.0.32",
+		},
+		{
+			lockImpactTestCase: lockImpactTestCase{
+				statement:    "ALTER TABLE t ADD COLUMN c int AFTER id;",
+				table:        "t",
+				algorithm:    storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE,
+				tableRewrite: true,
+			},
+			version: "8.0.20",
+		},
+		{
+			lockImpactTestCase: lockImpactTestCase{
+				statement: "ALTER TABLE t ADD INDEX idx (c);",
+				table:     "t",
+				algorithm: storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE,
+				tableScan: true,
+			},
+			version: "8.0.32",
+		},
+		{
+			lockImpactTestCase: lockImpactTestCase{
+				statement:    "ALTER TABLE db.t MODIFY COLUMN c bigint, ALTER COLUMN d SET DEFAULT 1;",
+				table:        "t",
+				algorithm:    storepb.PlanCheckRunResult_Result_LockImpactReport_COPY,
+				tableRewrite: true,
+				blockWrites:  true,
+			},
+			version: "8.0.32",
+		},
+		{
+			lockImpactTestCase: lockImpactTestCase{
+				statement:    "ALTER TABLE t ADD COLUMN c int, ALGORITHM=COPY;",
+				table:        "t",
+				algorithm:    storepb.PlanCheckRunResult_Result_LockImpactReport_COPY,
+				tableRewrite: true,
+				blockWrites:  true,
+			},
+			version: "8.0.32",
+		},
+		{
+			lockImpactTestCase: lockImpactTestCase{
+				statement:   "CREATE FULLTEXT INDEX idx ON t (c);",
+				table:       "t",
+				algorithm:   storepb.PlanCheckRunResult_Result_LockImpactReport_INPLACE,
+				tableScan:   true,
+				blockWrites: true,
+			},
+			version: "8.0.32",
+		},
+	}
+	for _, test := range tests {
+		test.lockLevel = storepb.PlanCheckRunResult_Result_LockImpactReport_METADATA_LOCK
+		impacts, err := getMySQLLockImpacts(test.statement, test.version)
+		require.NoError(t, err, test.statement)
+		require.Len(t, impacts, 1, test.statement)
+		requireLockImpact(t, test.lockImpactTestCase, impacts[0])
+	}
+}
+
+func TestEstimateBlockingSeconds(t *testing.T) {
+	impact := &lockImpact{table: "t", line: 2}
+	impact.add(pgLockOperation("ALTER COLUMN c TYPE bigint", storepb.PlanCheckRunResult_Result_LockImpactReport_ACCESS_EXCLUSIVE, true, false))
+
+	// 1 GiB is rewritten in about 21 seconds.
+	require.Equal(t, int64(21), impact.estimateBlockingSeconds(0, 1024*1024*1024))
+	// The size is estimated from the row count if it is not synced.
+	require.Equal(t, int64(2), impact.estimateBlockingSeconds(1000000, 0))
+
+	impact = &lockImpact{table: "t"}
+	impact.add(pgLockOperation("VALIDATE CONSTRAINT ck", storepb.PlanCheckRunResult_Result_LockImpactReport_SHARE_UPDATE_EXCLUSIVE, false, true))
+	require.Zero(t, impact.estimateBlockingSeconds(0, 1024*1024*1024))
+}
+
+func requireLockImpact(t *testing.T, want lockImpactTestCase, got *lockImpact) {
+	require.Equal(t, want.schema, got.schema, want.statement)
+	require.Equal(t, want.table, got.table, want.statement)
+	require.Equal(t, want.lockLevel, got.lockLevel, want.statement)
+	require.Equal(t, want.algorithm, got.algorithm, want.statement)
+	require.Equal(t, want.tableRewrite, got.tableRewrite, want.statement)
+	require.Equal(t, want.tableScan, got.tableScan, want.statement)
+	require.Equal(t, want.blockWrites, got.blockWrites, want.statement)
+}
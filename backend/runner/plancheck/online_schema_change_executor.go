@@ -0,0 +1,144 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/pgosc"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// NewOnlineSchemaChangeExecutor creates a PostgreSQL online schema change check executor.
+func NewOnlineSchemaChangeExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &OnlineSchemaChangeExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// OnlineSchemaChangeExecutor is the PostgreSQL online schema change check executor.
+// It validates that the statement and the table are feasible for the shadow table migration.
+type OnlineSchemaChangeExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run runs the online schema change check executor.
+func (e *OnlineSchemaChangeExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &config.InstanceId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %s", config.InstanceId)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %s not found", config.InstanceId)
+	}
+	if instance.Metadata.GetEngine() != storepb.Engine_POSTGRES {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Unsupported engine",
+				Content: fmt.Sprintf("Online schema change is only supported for PostgreSQL, but got %s", instance.Metadata.GetEngine()),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	sheetUID := int(config.SheetUid)
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+
+	if _, err := pgosc.GetUserFlags(config.OnlineSchemaChangeFlags); err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Invalid online schema change flags",
+				Content: err.Error(),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	alterStatement, err := pgosc.ParseStatement(statement)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Unsupported statement",
+				Content: err.Error(),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Failed to connect to database",
+				Content: fmt.Sprintf("Cannot establish connection: %v", err),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+	defer driver.Close(ctx)
+
+	table, err := pgosc.ResolveTable(ctx, driver.GetDB(), alterStatement)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Table not found",
+				Content: err.Error(),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	validationResult := pgosc.ValidateFeasibility(ctx, driver.GetDB(), table)
+	if !validationResult.Valid {
+		title, content := validationResult.GetUserFriendlyError()
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   title,
+				Content: content,
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	results := []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.Advice_SUCCESS,
+			Title:   "OK",
+			Content: fmt.Sprintf("Table %s can be migrated by online schema change", table),
+			Code:    common.Ok.Int32(),
+		},
+	}
+	if title, content := validationResult.GetWarning(); title != "" {
+		results = append(results, &storepb.PlanCheckRunResult_Result{
+			Status:  storepb.Advice_WARNING,
+			Title:   title,
+			Content: content,
+			Code:    common.Internal.Int32(),
+		})
+	}
+	return results, nil
+}
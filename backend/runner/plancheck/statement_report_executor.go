@@ -164,6 +164,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 	}
 
 	var explainCalculator getAffectedRowsFromExplain
+	var costCalculator getCostFromExplain
 	var sqlTypes []string
 	var defaultSchema string
 	useDatabaseOwner, err := getUseDatabaseOwner(ctx, stores, instance, database)
@@ -185,6 +186,7 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 			return nil, errors.Errorf("invalid pg driver type")
 		}
 		explainCalculator = pd.CountAffectedRows
+		costCalculator = explainCostCalculator(pd.ExplainJSON, parsePostgreSQLPlan)
 
 		stmtsWithPos, err := pg.GetStatementTypes(asts)
 		if err != nil {
@@ -214,6 +216,9 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 			return nil, errors.Errorf("invalid mysql driver type")
 		}
 		explainCalculator = md.CountAffectedRows
+		if common.EngineSupportExplain(instance.Metadata.GetEngine()) {
+			costCalculator = explainCostCalculator(md.ExplainJSON, parseMySQLPlan)
+		}
 
 		if instance.Metadata.GetEngine() != storepb.Engine_OCEANBASE {
 			sqlTypes, err = mysqlparser.GetStatementTypes(asts)
@@ -271,11 +276,13 @@ func GetSQLSummaryReport(ctx context.Context, stores *store.Store, sheetManager
 		return nil, errors.Wrapf(err, "failed to extract changed resources")
 	}
 	totalAffectedRows := calculateAffectedRows(ctx, changeSummary, explainCalculator)
+	estimatedCost := calculateEstimatedCost(ctx, changeSummary, costCalculator)
 
 	return &storepb.PlanCheckRunResult_Result_SqlSummaryReport{
 		StatementTypes:   sqlTypes,
 		AffectedRows:     totalAffectedRows,
 		ChangedResources: changeSummary.ChangedResources.Build(),
+		EstimatedCost:    estimatedCost,
 	}, nil
 }
 
@@ -323,3 +330,26 @@ func calculateAffectedRows(ctx context.Context, changeSummary *parserbase.Change
 
 	return totalAffectedRows
 }
+
+type getCostFromExplain func(context.Context, string) (float64, error)
+
+func calculateEstimatedCost(ctx context.Context, changeSummary *parserbase.ChangeSummary, costCalculator getCostFromExplain) float64 {
+	if costCalculator == nil {
+		return 0
+	}
+	var totalCost float64
+	sampleCount := 0
+	for _, dml := range changeSummary.SampleDMLS {
+		cost, err := costCalculator(ctx, dml)
+		if err != nil {
+			slog.Error("failed to calculate estimated cost", log.BBError(err))
+			continue
+		}
+		sampleCount++
+		totalCost += cost
+	}
+	if sampleCount == 0 {
+		return 0
+	}
+	return totalCost / float64(sampleCount) * float64(changeSummary.DMLCount)
+}
@@ -0,0 +1,277 @@
+package schemasync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// getSchemaDrift compares the synced schema with the schema recorded by the latest changelog.
+// It returns nil if the database is not drifted.
+func (s *Syncer) getSchemaDrift(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata, rawDump string) (*storepb.SchemaDriftReport, error) {
+	engine := instance.Metadata.GetEngine()
+	// Redis and MongoDB are schemaless.
+	if disableSchemaDriftCheck(engine) {
+		return nil, nil
+	}
+	limit := 1
+	list, err := s.store.ListChangelogs(ctx, &store.FindChangelogMessage{
+		InstanceID:     &database.InstanceID,
+		DatabaseName:   &database.DatabaseName,
+		TypeList:       []string{storepb.ChangelogPayload_BASELINE.String(), storepb.ChangelogPayload_MIGRATE.String(), storepb.ChangelogPayload_SDL.String()},
+		HasSyncHistory: true,
+		Limit:          &limit,
+		ShowFull:       true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list changelogs")
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	changelog := list[0]
+	if changelog.SyncHistoryUID == nil {
+		return nil, errors.Errorf("expect sync history but get nil")
+	}
+	// The dump format may change after upgrades, so the same dump only means no drift with the same version.
+	if changelog.Payload.GetGitCommit() == s.profile.GitCommit && changelog.Schema == rawDump {
+		return nil, nil
+	}
+	syncHistory, err := s.store.GetSyncHistoryByUID(ctx, *changelog.SyncHistoryUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sync history %d", *changelog.SyncHistoryUID)
+	}
+
+	isCaseSensitive := store.IsObjectCaseSensitive(instance)
+	recordedSchema := model.NewDatabaseMetadata(syncHistory.Metadata, []byte(syncHistory.Schema), &storepb.DatabaseConfig{}, engine, isCaseSensitive)
+	syncedSchema := model.NewDatabaseMetadata(metadata, []byte(rawDump), &storepb.DatabaseConfig{}, engine, isCaseSensitive)
+	diff, err := schema.GetDatabaseSchemaDiff(engine, recordedSchema, syncedSchema)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to diff schema")
+	}
+	if engine == storepb.Engine_POSTGRES {
+		diff = schema.FilterPostgresArchiveSchema(diff)
+	}
+	objects := convertToSchemaDriftObjects(diff)
+	if len(objects) == 0 {
+		return nil, nil
+	}
+	return &storepb.SchemaDriftReport{
+		ChangelogUid: changelog.UID,
+		Objects:      objects,
+	}, nil
+}
+
+// recordSchemaDrift stores the drifted schema with its drift report as a sync history if the drift is new or changed,
+// and notifies the project webhooks when the drift first appears.
+func (s *Syncer) recordSchemaDrift(ctx context.Context, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata, rawDump string, report *storepb.SchemaDriftReport) error {
+	wasDrifted := database.Metadata.GetDrifted()
+	latest, err := s.store.GetLatestDriftSyncHistory(ctx, database.InstanceID, database.DatabaseName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get latest drift sync history")
+	}
+	if wasDrifted && latest != nil && proto.Equal(latest.DriftReport, report) {
+		return nil
+	}
+	if _, err := s.store.CreateDriftSyncHistory(ctx, database.InstanceID, database.DatabaseName, metadata, rawDump, report); err != nil {
+		return errors.Wrapf(err, "failed to create drift sync history")
+	}
+	if wasDrifted {
+		return nil
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return nil
+	}
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   s.store.GetSystemBotUser(ctx),
+		Type:    storepb.Activity_NOTIFY_SCHEMA_DRIFT,
+		Comment: formatSchemaDriftSummary(database, report),
+		Project: webhook.NewProject(project),
+		SchemaDrift: &webhook.EventSchemaDrift{
+			Database: common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		},
+	})
+	return nil
+}
+
+// formatSchemaDriftSummary formats the drifted objects for the webhook message, e.g. "ADDED TABLE public.t".
+func formatSchemaDriftSummary(database *store.DatabaseMessage, report *storepb.SchemaDriftReport) string {
+	const maxObjects = 10
+	var lines []string
+	for i, object := range report.Objects {
+		if i == maxObjects {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(report.Objects)-maxObjects))
+			break
+		}
+		var names []string
+		for _, name := range []string{object.Schema, object.Table, object.Name} {
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", object.Action, strings.ReplaceAll(object.Type.String(), "_", " "), strings.Join(names, ".")))
+	}
+	return fmt.Sprintf("Database %q drifted from its latest changelog:\n%s", database.DatabaseName, strings.Join(lines, "\n"))
+}
+
+// convertToSchemaDriftObjects lists the objects changed in the diff. The table-level objects are only listed
+// for the altered tables, and the altered tables without such changes are listed as changed.
+func convertToSchemaDriftObjects(diff *schema.MetadataDiff) []*storepb.SchemaDriftObject {
+	if diff == nil {
+		return nil
+	}
+	c := &schemaDriftObjectCollector{seen: map[string]bool{}}
+
+	for _, d := range diff.SchemaChanges {
+		c.add(storepb.SchemaDriftObject_SCHEMA, d.Action, "", "", d.SchemaName)
+	}
+	for _, d := range diff.TableChanges {
+		if d.Action != schema.MetadataDiffActionAlter {
+			c.add(storepb.SchemaDriftObject_TABLE, d.Action, d.SchemaName, "", d.TableName)
+			continue
+		}
+		count := len(c.objects)
+		for _, column := range d.ColumnChanges {
+			c.add(storepb.SchemaDriftObject_COLUMN, column.Action, d.SchemaName, d.TableName, objectName(column.OldColumn, column.NewColumn))
+		}
+		for _, index := range d.IndexChanges {
+			c.add(storepb.SchemaDriftObject_INDEX, index.Action, d.SchemaName, d.TableName, objectName(index.OldIndex, index.NewIndex))
+		}
+		for _, pk := range d.PrimaryKeyChanges {
+			c.add(storepb.SchemaDriftObject_CONSTRAINT, pk.Action, d.SchemaName, d.TableName, objectName(pk.OldPrimaryKey, pk.NewPrimaryKey))
+		}
+		for _, uk := range d.UniqueConstraintChanges {
+			c.add(storepb.SchemaDriftObject_CONSTRAINT, uk.Action, d.SchemaName, d.TableName, objectName(uk.OldUniqueConstraint, uk.NewUniqueConstraint))
+		}
+		for _, fk := range d.ForeignKeyChanges {
+			c.add(storepb.SchemaDriftObject_CONSTRAINT, fk.Action, d.SchemaName, d.TableName, objectName(fk.OldForeignKey, fk.NewForeignKey))
+		}
+		for _, check := range d.CheckConstraintChanges {
+			c.add(storepb.SchemaDriftObject_CONSTRAINT, check.Action, d.SchemaName, d.TableName, objectName(check.OldCheckConstraint, check.NewCheckConstraint))
+		}
+		for _, exclude := range d.ExcludeConstraintChanges {
+			c.add(storepb.SchemaDriftObject_CONSTRAINT, exclude.Action, d.SchemaName, d.TableName, objectName(exclude.OldExcludeConstraint, exclude.NewExcludeConstraint))
+		}
+		for _, trigger := range d.TriggerChanges {
+			c.add(storepb.SchemaDriftObject_TRIGGER, trigger.Action, d.SchemaName, d.TableName, trigger.TriggerName)
+		}
+		if len(c.objects) == count {
+			c.add(storepb.SchemaDriftObject_TABLE, d.Action, d.SchemaName, "", d.TableName)
+		}
+	}
+	for _, d := range diff.ViewChanges {
+		c.add(storepb.SchemaDriftObject_VIEW, d.Action, d.SchemaName, "", d.ViewName)
+	}
+	for _, d := range diff.MaterializedViewChanges {
+		c.add(storepb.SchemaDriftObject_MATERIALIZED_VIEW, d.Action, d.SchemaName, "", d.MaterializedViewName)
+	}
+	for _, d := range diff.FunctionChanges {
+		c.add(storepb.SchemaDriftObject_FUNCTION, d.Action, d.SchemaName, "", d.FunctionName)
+	}
+	for _, d := range diff.ProcedureChanges {
+		c.add(storepb.SchemaDriftObject_PROCEDURE, d.Action, d.SchemaName, "", d.ProcedureName)
+	}
+	for _, d := range diff.SequenceChanges {
+		c.add(storepb.SchemaDriftObject_SEQUENCE, d.Action, d.SchemaName, "", d.SequenceName)
+	}
+	for _, d := range diff.EnumTypeChanges {
+		c.add(storepb.SchemaDriftObject_ENUM_TYPE, d.Action, d.SchemaName, "", d.EnumTypeName)
+	}
+	for _, d := range diff.ExtensionChanges {
+		c.add(storepb.SchemaDriftObject_EXTENSION, d.Action, "", "", d.ExtensionName)
+	}
+	for _, d := range diff.EventTriggerChanges {
+		c.add(storepb.SchemaDriftObject_EVENT_TRIGGER, d.Action, "", "", d.EventTriggerName)
+	}
+	for _, d := range diff.EventChanges {
+		c.add(storepb.SchemaDriftObject_EVENT, d.Action, "", "", d.EventName)
+	}
+	for _, d := range diff.CommentChanges {
+		switch d.ObjectType {
+		case schema.CommentObjectTypeSchema:
+			c.add(storepb.SchemaDriftObject_SCHEMA, schema.MetadataDiffActionAlter, "", "", d.ObjectName)
+		case schema.CommentObjectTypeTable:
+			c.add(storepb.SchemaDriftObject_TABLE, schema.MetadataDiffActionAlter, d.SchemaName, "", d.ObjectName)
+		case schema.CommentObjectTypeColumn:
+			c.add(storepb.SchemaDriftObject_COLUMN, schema.MetadataDiffActionAlter, d.SchemaName, d.ObjectName, d.ColumnName)
+		case schema.CommentObjectTypeView:
+			c.add(storepb.SchemaDriftObject_VIEW, schema.MetadataDiffActionAlter, d.SchemaName, "", d.ObjectName)
+		case schema.CommentObjectTypeMaterializedView:
+			c.add(storepb.SchemaDriftObject_MATERIALIZED_VIEW, schema.MetadataDiffActionAlter, d.SchemaName, "", d.ObjectName)
+		case schema.CommentObjectTypeFunction:
+			c.add(storepb.SchemaDriftObject_FUNCTION, schema.MetadataDiffActionAlter, d.SchemaName, "", d.ObjectName)
+		case schema.CommentObjectTypeSequence:
+			c.add(storepb.SchemaDriftObject_SEQUENCE, schema.MetadataDiffActionAlter, d.SchemaName, "", d.ObjectName)
+		case schema.CommentObjectTypeIndex:
+			c.add(storepb.SchemaDriftObject_INDEX, schema.MetadataDiffActionAlter, d.SchemaName, d.ObjectName, d.IndexName)
+		case schema.CommentObjectTypeTrigger:
+			c.add(storepb.SchemaDriftObject_TRIGGER, schema.MetadataDiffActionAlter, d.SchemaName, d.TableName, d.ObjectName)
+		case schema.CommentObjectTypeType:
+			c.add(storepb.SchemaDriftObject_ENUM_TYPE, schema.MetadataDiffActionAlter, d.SchemaName, "", d.ObjectName)
+		case schema.CommentObjectTypeExtension:
+			c.add(storepb.SchemaDriftObject_EXTENSION, schema.MetadataDiffActionAlter, "", "", d.ObjectName)
+		case schema.CommentObjectTypeEventTrigger:
+			c.add(storepb.SchemaDriftObject_EVENT_TRIGGER, schema.MetadataDiffActionAlter, "", "", d.ObjectName)
+		default:
+		}
+	}
+	return c.objects
+}
+
+type schemaDriftObjectCollector struct {
+	objects []*storepb.SchemaDriftObject
+	seen    map[string]bool
+}
+
+// add adds the object unless it is listed already, e.g. a column with both its definition and comment changed.
+func (c *schemaDriftObjectCollector) add(tp storepb.SchemaDriftObject_Type, action schema.MetadataDiffAction, schemaName, table, name string) {
+	key := strings.Join([]string{tp.String(), schemaName, table, name}, "\x00")
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+	c.objects = append(c.objects, &storepb.SchemaDriftObject{
+		Type:   tp,
+		Action: convertToSchemaDriftAction(action),
+		Schema: schemaName,
+		Table:  table,
+		Name:   name,
+	})
+}
+
+func convertToSchemaDriftAction(action schema.MetadataDiffAction) storepb.SchemaDriftObject_Action {
+	switch action {
+	case schema.MetadataDiffActionCreate:
+		return storepb.SchemaDriftObject_ADDED
+	case schema.MetadataDiffActionDrop:
+		return storepb.SchemaDriftObject_REMOVED
+	case schema.MetadataDiffActionAlter:
+		return storepb.SchemaDriftObject_CHANGED
+	default:
+		return storepb.SchemaDriftObject_ACTION_UNSPECIFIED
+	}
+}
+
+// objectName returns the name of the new object, or the old one if the object is dropped.
+func objectName(oldObject, newObject interface{ GetName() string }) string {
+	if name := newObject.GetName(); name != "" {
+		return name
+	}
+	return oldObject.GetName()
+}
@@ -0,0 +1,52 @@
+package schemasync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func TestConvertToSchemaDriftObjects(t *testing.T) {
+	diff := &schema.MetadataDiff{
+		TableChanges: []*schema.TableDiff{
+			{Action: schema.MetadataDiffActionCreate, SchemaName: "public", TableName: "t1"},
+			{
+				Action:     schema.MetadataDiffActionAlter,
+				SchemaName: "public",
+				TableName:  "t2",
+				ColumnChanges: []*schema.ColumnDiff{
+					{Action: schema.MetadataDiffActionDrop, OldColumn: &storepb.ColumnMetadata{Name: "c1"}},
+					{Action: schema.MetadataDiffActionAlter, OldColumn: &storepb.ColumnMetadata{Name: "c2"}, NewColumn: &storepb.ColumnMetadata{Name: "c2"}},
+				},
+				IndexChanges: []*schema.IndexDiff{
+					{Action: schema.MetadataDiffActionCreate, NewIndex: &storepb.IndexMetadata{Name: "idx"}},
+				},
+			},
+			// The altered table without the table-level object changes, e.g. its options are changed.
+			{Action: schema.MetadataDiffActionAlter, SchemaName: "public", TableName: "t3"},
+		},
+		FunctionChanges: []*schema.FunctionDiff{
+			{Action: schema.MetadataDiffActionDrop, SchemaName: "public", FunctionName: "f"},
+		},
+		CommentChanges: []*schema.CommentDiff{
+			// The column is listed already.
+			{Action: schema.MetadataDiffActionAlter, ObjectType: schema.CommentObjectTypeColumn, SchemaName: "public", ObjectName: "t2", ColumnName: "c2"},
+			{Action: schema.MetadataDiffActionCreate, ObjectType: schema.CommentObjectTypeView, SchemaName: "public", ObjectName: "v"},
+		},
+	}
+
+	want := []*storepb.SchemaDriftObject{
+		{Type: storepb.SchemaDriftObject_TABLE, Action: storepb.SchemaDriftObject_ADDED, Schema: "public", Name: "t1"},
+		{Type: storepb.SchemaDriftObject_COLUMN, Action: storepb.SchemaDriftObject_REMOVED, Schema: "public", Table: "t2", Name: "c1"},
+		{Type: storepb.SchemaDriftObject_COLUMN, Action: storepb.SchemaDriftObject_CHANGED, Schema: "public", Table: "t2", Name: "c2"},
+		{Type: storepb.SchemaDriftObject_INDEX, Action: storepb.SchemaDriftObject_ADDED, Schema: "public", Table: "t2", Name: "idx"},
+		{Type: storepb.SchemaDriftObject_TABLE, Action: storepb.SchemaDriftObject_CHANGED, Schema: "public", Name: "t3"},
+		{Type: storepb.SchemaDriftObject_FUNCTION, Action: storepb.SchemaDriftObject_REMOVED, Schema: "public", Name: "f"},
+		{Type: storepb.SchemaDriftObject_VIEW, Action: storepb.SchemaDriftObject_CHANGED, Schema: "public", Name: "v"},
+	}
+	require.Equal(t, want, convertToSchemaDriftObjects(diff))
+	require.Nil(t, convertToSchemaDriftObjects(nil))
+}
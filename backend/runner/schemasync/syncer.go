@@ -22,6 +22,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -39,12 +40,13 @@ const (
 )
 
 // NewSyncer creates a schema syncer.
-func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, profile *config.Profile, stateCfg *state.State, licenseService *enterprise.LicenseService) *Syncer {
+func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, profile *config.Profile, stateCfg *state.State, webhookManager *webhook.Manager, licenseService *enterprise.LicenseService) *Syncer {
 	return &Syncer{
 		store:          stores,
 		dbFactory:      dbFactory,
 		profile:        profile,
 		stateCfg:       stateCfg,
+		webhookManager: webhookManager,
 		licenseService: licenseService,
 	}
 }
@@ -57,91 +59,112 @@ type Syncer struct {
 	dbFactory       *dbfactory.DBFactory
 	profile         *config.Profile
 	stateCfg        *state.State
+	webhookManager  *webhook.Manager
 	licenseService  *enterprise.LicenseService
 	databaseSyncMap sync.Map // map[string]*store.DatabaseMessage
 }
 
-// Run will run the schema syncer once.
+// Run will run the schema syncer.
 func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	sp := pool.New()
 	sp.Go(func() {
-		slog.Debug(fmt.Sprintf("Schema syncer started and will run every %v", instanceSyncInterval))
-		ticker := time.NewTicker(instanceSyncInterval)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ticker.C:
-				s.trySyncAll(ctx)
-			case <-ctx.Done(): // if cancel() execute
-				return
-			}
-		}
+		s.runScheduledSync(ctx)
 	})
-
 	sp.Go(func() {
-		ticker := time.NewTicker(databaseSyncCheckerInterval)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				instances, err := s.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+		s.runDatabaseSync(ctx)
+	})
+	sp.Wait()
+}
+
+// RunScheduledSync only runs the periodic sync of the instances and databases by their sync intervals.
+// In HA mode, it runs on the leader only, while Run is replaced by RunDatabaseSync on every replica.
+func (s *Syncer) RunScheduledSync(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	s.runScheduledSync(ctx)
+}
+
+// RunDatabaseSync only syncs the databases requested by SyncDatabaseAsync and the periodic sync.
+func (s *Syncer) RunDatabaseSync(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	s.runDatabaseSync(ctx)
+}
+
+func (s *Syncer) runScheduledSync(ctx context.Context) {
+	slog.Debug(fmt.Sprintf("Schema syncer started and will run every %v", instanceSyncInterval))
+	ticker := time.NewTicker(instanceSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.trySyncAll(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (s *Syncer) runDatabaseSync(ctx context.Context) {
+	ticker := time.NewTicker(databaseSyncCheckerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			instances, err := s.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+			if err != nil {
 				if err != nil {
-					if err != nil {
-						slog.Error("Failed to list instance", log.BBError(err))
-						return
-					}
+					slog.Error("Failed to list instance", log.BBError(err))
+					return
 				}
-				instanceMap := make(map[string]*store.InstanceMessage)
-				for _, instance := range instances {
-					instanceMap[instance.ResourceID] = instance
+			}
+			instanceMap := make(map[string]*store.InstanceMessage)
+			for _, instance := range instances {
+				instanceMap[instance.ResourceID] = instance
+			}
+			dbwp := pool.New().WithMaxGoroutines(MaximumOutstanding)
+			s.databaseSyncMap.Range(func(key, value any) bool {
+				database, ok := value.(*store.DatabaseMessage)
+				if !ok {
+					return true
+				}
+
+				instance, ok := instanceMap[database.InstanceID]
+				if !ok {
+					slog.Debug("Instance not found",
+						slog.String("instance", database.InstanceID),
+						log.BBError(err))
+					return true
+				}
+				maximumConnections := int(instance.Metadata.GetMaximumConnections())
+				if maximumConnections <= 0 {
+					maximumConnections = common.DefaultInstanceMaximumConnections
+				}
+				if s.stateCfg.InstanceOutstandingConnections.Increment(instance.ResourceID, maximumConnections) {
+					return true
 				}
-				dbwp := pool.New().WithMaxGoroutines(MaximumOutstanding)
-				s.databaseSyncMap.Range(func(key, value any) bool {
-					database, ok := value.(*store.DatabaseMessage)
-					if !ok {
-						return true
-					}
 
-					instance, ok := instanceMap[database.InstanceID]
-					if !ok {
-						slog.Debug("Instance not found",
+				s.databaseSyncMap.Delete(key)
+				dbwp.Go(func() {
+					defer func() {
+						s.stateCfg.InstanceOutstandingConnections.Decrement(instance.ResourceID)
+					}()
+					slog.Debug("Sync database schema", slog.String("instance", database.InstanceID), slog.String("database", database.DatabaseName))
+					if err := s.SyncDatabaseSchema(ctx, database); err != nil {
+						slog.Debug("Failed to sync database schema",
 							slog.String("instance", database.InstanceID),
+							slog.String("databaseName", database.DatabaseName),
 							log.BBError(err))
-						return true
-					}
-					maximumConnections := int(instance.Metadata.GetMaximumConnections())
-					if maximumConnections <= 0 {
-						maximumConnections = common.DefaultInstanceMaximumConnections
 					}
-					if s.stateCfg.InstanceOutstandingConnections.Increment(instance.ResourceID, maximumConnections) {
-						return true
-					}
-
-					s.databaseSyncMap.Delete(key)
-					dbwp.Go(func() {
-						defer func() {
-							s.stateCfg.InstanceOutstandingConnections.Decrement(instance.ResourceID)
-						}()
-						slog.Debug("Sync database schema", slog.String("instance", database.InstanceID), slog.String("database", database.DatabaseName))
-						if err := s.SyncDatabaseSchema(ctx, database); err != nil {
-							slog.Debug("Failed to sync database schema",
-								slog.String("instance", database.InstanceID),
-								slog.String("databaseName", database.DatabaseName),
-								log.BBError(err))
-						}
-					})
-					return true
 				})
-				dbwp.Wait()
-			case <-ctx.Done(): // if cancel() execute
-				return
-			}
+				return true
+			})
+			dbwp.Wait()
+		case <-ctx.Done(): // if cancel() execute
+			return
 		}
-	})
-	sp.Wait()
+	}
 }
 
 func (s *Syncer) trySyncAll(ctx context.Context) {
@@ -394,11 +417,11 @@ func (s *Syncer) doSyncDatabaseSchema(ctx context.Context, database *store.Datab
 	dbConfig := dbMetadata.GetConfig()
 
 	// Check for schema drift only when not creating sync history
-	var drifted, skipped bool
+	var driftReport *storepb.SchemaDriftReport
 	if !createSyncHistory {
-		drifted, skipped, err = s.getSchemaDrifted(ctx, instance, database, string(rawDump))
+		driftReport, err = s.getSchemaDrift(ctx, instance, database, syncedDatabaseMetadata, string(rawDump))
 		if err != nil {
-			return 0, errors.Wrapf(err, "failed to get schema drifted for database %q", database.DatabaseName)
+			return 0, errors.Wrapf(err, "failed to get schema drift for database %q", database.DatabaseName)
 		}
 	}
 
@@ -409,7 +432,7 @@ func (s *Syncer) doSyncDatabaseSchema(ctx context.Context, database *store.Datab
 			md.BackupAvailable = s.databaseBackupAvailable(ctx, instance, syncedDatabaseMetadata)
 			md.Datashare = syncedDatabaseMetadata.Datashare
 			if !createSyncHistory {
-				md.Drifted = !skipped && drifted
+				md.Drifted = driftReport != nil
 			}
 		},
 	}
@@ -435,6 +458,12 @@ func (s *Syncer) doSyncDatabaseSchema(ctx context.Context, database *store.Datab
 		return 0, errors.Wrapf(err, "failed to upsert database schema for database %q", database.DatabaseName)
 	}
 
+	if driftReport != nil {
+		if err := s.recordSchemaDrift(ctx, database, syncedDatabaseMetadata, string(rawDump), driftReport); err != nil {
+			return 0, errors.Wrapf(err, "failed to record schema drift for database %q", database.DatabaseName)
+		}
+	}
+
 	// Create sync history if requested
 	if createSyncHistory {
 		id, err := s.store.CreateSyncHistory(ctx, database.InstanceID, database.DatabaseName, syncedDatabaseMetadata, string(rawDump))
@@ -463,38 +492,6 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	return err
 }
 
-func (s *Syncer) getSchemaDrifted(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, rawDump string) (drifted bool, skipped bool, err error) {
-	// Redis and MongoDB are schemaless.
-	if disableSchemaDriftCheck(instance.Metadata.GetEngine()) {
-		return false, false, nil
-	}
-	limit := 1
-	list, err := s.store.ListChangelogs(ctx, &store.FindChangelogMessage{
-		InstanceID:     &database.InstanceID,
-		DatabaseName:   &database.DatabaseName,
-		TypeList:       []string{storepb.ChangelogPayload_BASELINE.String(), storepb.ChangelogPayload_MIGRATE.String(), storepb.ChangelogPayload_SDL.String()},
-		HasSyncHistory: true,
-		Limit:          &limit,
-		ShowFull:       true,
-	})
-	if err != nil {
-		return false, false, errors.Wrapf(err, "failed to list changelogs")
-	}
-	if len(list) == 0 {
-		return false, false, nil
-	}
-
-	changelog := list[0]
-	if changelog.Payload.GetGitCommit() != s.profile.GitCommit {
-		return false, true, nil
-	}
-	if changelog.SyncHistoryUID == nil {
-		return false, false, errors.Errorf("expect sync history but get nil")
-	}
-	latestSchema := string(rawDump)
-	return changelog.Schema != latestSchema, false, nil
-}
-
 func (s *Syncer) databaseBackupAvailable(ctx context.Context, instance *store.InstanceMessage, dbMetadata *storepb.DatabaseSchemaMetadata) bool {
 	if !common.EngineSupportPriorBackup(instance.Metadata.GetEngine()) {
 		return false
@@ -520,8 +517,8 @@ func (s *Syncer) databaseBackupAvailable(ctx context.Context, instance *store.In
 			return false
 		}
 		return backupDB != nil
-	case storepb.Engine_ORACLE:
-		dbName := common.BackupDatabaseNameOfEngine(storepb.Engine_ORACLE)
+	case storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE:
+		dbName := common.BackupDatabaseNameOfEngine(instance.Metadata.GetEngine())
 		backupDB, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
 			InstanceID:   &instance.ResourceID,
 			DatabaseName: &dbName,
@@ -2,6 +2,8 @@ package taskrun
 
 import (
 	"context"
+	"io"
+	"os"
 
 	"github.com/pkg/errors"
 
@@ -74,17 +76,27 @@ func (exec *DataExportExecutor) RunOnce(ctx context.Context, _ context.Context,
 		Format:    v1pb.ExportFormat(task.Payload.GetFormat()),
 		Password:  "", /* do not pass the password, we will encrypt the files will password when users download them */
 	}
-	bytes, _, exportErr := apiv1.DoExport(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, issue.Creator /* user */, instance, database, nil /* access check */, exec.schemaSyncer, dataSource)
-	if exportErr != nil {
+	// The archive is written to a temporary file and stored in chunks, so large exports are never held in memory.
+	f, err := os.CreateTemp("", "bytebase-export-*.zip")
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to create temporary file")
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+	if _, exportErr := apiv1.DoExportStream(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, issue.Creator /* user */, instance, database, nil /* access check */, exec.schemaSyncer, dataSource, f); exportErr != nil {
 		return true, nil, errors.Wrap(exportErr, "failed to export data")
 	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return true, nil, errors.Wrap(err, "failed to read temporary file")
+	}
 
-	exportArchive, err := exec.store.CreateExportArchive(ctx, &store.ExportArchiveMessage{
-		Bytes: bytes,
+	exportArchive, err := exec.store.CreateExportArchiveFromReader(ctx, &store.ExportArchiveMessage{
 		Payload: &storepb.ExportArchivePayload{
 			FileFormat: task.Payload.GetFormat(),
 		},
-	})
+	}, f)
 	if err != nil {
 		return true, nil, errors.Wrap(err, "failed to create export archive")
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
+	"github.com/bytebase/bytebase/backend/component/pgosc"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -58,6 +59,9 @@ func (exec *DatabaseMigrateExecutor) RunOnce(ctx context.Context, driverCtx cont
 	if task.Payload.GetEnableGhost() {
 		return exec.runGhostMigration(ctx, driverCtx, task, taskRunUID)
 	}
+	if task.Payload.GetEnableOnlineSchemaChange() {
+		return exec.runOnlineSchemaChangeMigration(ctx, driverCtx, task, taskRunUID)
+	}
 	return exec.runMigrationWithPriorBackup(ctx, driverCtx, task, taskRunUID)
 }
 
@@ -251,6 +255,42 @@ func (exec *DatabaseMigrateExecutor) runGhostMigration(ctx context.Context, driv
 	return runMigrationWithFunc(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, statement, task.Payload.GetSchemaVersion(), &sheetID, execFunc)
 }
 
+func (exec *DatabaseMigrateExecutor) runOnlineSchemaChangeMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (bool, *storepb.TaskRunResult, error) {
+	sheetID := int(task.Payload.GetSheetId())
+	statement, err := exec.store.GetSheetStatementByID(ctx, sheetID)
+	if err != nil {
+		return true, nil, err
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if instance == nil {
+		return true, nil, errors.Errorf("instance %s not found", task.InstanceID)
+	}
+	if engine := instance.Metadata.GetEngine(); engine != storepb.Engine_POSTGRES {
+		return true, nil, errors.Errorf("online schema change is not supported for engine %s", engine)
+	}
+	config, err := pgosc.NewConfig(task.Payload.GetFlags())
+	if err != nil {
+		return true, nil, errors.Wrap(err, "invalid online schema change flags")
+	}
+
+	execFunc := func(execCtx context.Context, execStatement string, driver db.Driver, _ db.ExecuteOptions) error {
+		alterStatement, err := pgosc.ParseStatement(execStatement)
+		if err != nil {
+			return err
+		}
+		table, err := pgosc.ResolveTable(execCtx, driver.GetDB(), alterStatement)
+		if err != nil {
+			return err
+		}
+		return pgosc.NewMigrator(driver.GetDB(), table, alterStatement.Commands, config).Migrate(execCtx)
+	}
+
+	return runMigrationWithFunc(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, statement, task.Payload.GetSchemaVersion(), &sheetID, execFunc)
+}
+
 func (exec *DatabaseMigrateExecutor) shouldSkipBackupError(ctx context.Context, task *store.TaskMessage) (bool, error) {
 	pipeline, pipelineErr := exec.store.GetPipelineV2ByID(ctx, task.PipelineID)
 	if pipelineErr != nil {
@@ -395,6 +435,14 @@ func (exec *DatabaseMigrateExecutor) backupData(
 			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."%s" IS '%s, source table (%s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
 				return nil, errors.Wrap(err, "failed to set table comment")
 			}
+		case storepb.Engine_SNOWFLAKE:
+			schemaName := statement.SourceSchema
+			if schemaName == "" {
+				schemaName = "PUBLIC"
+			}
+			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."PUBLIC"."%s" IS '%s, source table (%s, %s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, schemaName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
+				return nil, errors.Wrap(err, "failed to set table comment")
+			}
 		default:
 			// No action needed for other database engines
 		}
@@ -413,6 +461,10 @@ func (exec *DatabaseMigrateExecutor) backupData(
 			StartPosition: statement.StartPosition,
 			EndPosition:   statement.EndPosition,
 		}
+		if instance.Metadata.GetEngine() == storepb.Engine_SNOWFLAKE {
+			// Snowflake backs up the tables to the PUBLIC schema of the backup database.
+			item.TargetTable.Schema = "PUBLIC"
+		}
 		if instance.Metadata.GetEngine() == storepb.Engine_POSTGRES {
 			item.TargetTable = &storepb.PriorBackupDetail_Item_Table{
 				Database: sourceDatabaseName,
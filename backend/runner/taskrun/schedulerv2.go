@@ -288,16 +288,29 @@ func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to list pending tasks")
 	}
+	// The stages whose rollout window opened in this round.
+	openedStages := map[rolloutStage]bool{}
 	for _, taskRun := range taskRuns {
-		if err := s.schedulePendingTaskRun(ctx, taskRun); err != nil {
+		if err := s.schedulePendingTaskRun(ctx, taskRun, openedStages); err != nil {
 			slog.Error("failed to schedule pending task run", log.BBError(err))
 		}
 	}
+	for stage := range openedStages {
+		if err := s.notifyRolloutWindowOpen(ctx, stage); err != nil {
+			slog.Error("failed to notify rollout window open", log.BBError(err))
+		}
+	}
 
 	return nil
 }
 
-func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store.TaskRunMessage) error {
+// rolloutStage is the stage of a rollout.
+type rolloutStage struct {
+	pipelineID  int
+	environment string
+}
+
+func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store.TaskRunMessage, openedStages map[rolloutStage]bool) error {
 	// here, we move pending taskruns to running taskruns which means they are ready to be executed.
 	// pending taskruns remain pending if
 	// 1. taskRun.RunAt not met.
@@ -311,6 +324,81 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 		return nil
 	}
 
+	// Hold the task run until the rollout window of the environment opens.
+	if task.Environment != "" {
+		policy, err := s.store.GetRolloutPolicy(ctx, task.Environment)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get rollout policy for environment %s", task.Environment)
+		}
+		now := time.Now()
+		openTime, err := common.GetNextRolloutWindowOpenTime(policy.GetWindow(), now)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get rollout window for environment %s", task.Environment)
+		}
+		if openTime.After(now) {
+			info := &storepb.SchedulerInfo{
+				ReportTime: timestamppb.Now(),
+				WaitingCause: &storepb.SchedulerInfo_WaitingCause{
+					Cause: &storepb.SchedulerInfo_WaitingCause_RolloutWindow{
+						RolloutWindow: timestamppb.New(openTime),
+					},
+				},
+			}
+			// The waiting state is persisted on the task run, so that the opening of the window
+			// is still notified after a restart or by another replica.
+			if !taskRun.Payload.GetSchedulerInfo().GetWaitingCause().Equal(info.WaitingCause) {
+				if err := s.store.SetTaskRunSchedulerInfo(ctx, taskRun.ID, info); err != nil {
+					return errors.Wrapf(err, "failed to persist the waiting state of task run %d", taskRun.ID)
+				}
+			}
+			s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, info)
+			return nil
+		}
+		if taskRun.Payload.GetSchedulerInfo().GetWaitingCause().GetRolloutWindow() != nil {
+			// Only the scheduler clearing the waiting state notifies the opening of the window.
+			cleared, err := s.store.ClearTaskRunSchedulerInfo(ctx, taskRun.ID)
+			if err != nil {
+				return errors.Wrapf(err, "failed to clear the waiting state of task run %d", taskRun.ID)
+			}
+			if cleared {
+				openedStages[rolloutStage{pipelineID: task.PipelineID, environment: task.Environment}] = true
+			}
+			s.stateCfg.TaskRunSchedulerInfo.Delete(taskRun.ID)
+		}
+	}
+
+	// Hold the task run until the previous batches of the rollout strategy finish,
+	// and stop it if the stage is halted.
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan")
+	}
+	var strategy *storepb.PlanConfig_Deployment_RolloutStrategy
+	if plan != nil {
+		strategy = plan.Config.GetDeployment().GetRolloutStrategy()
+	}
+	if strategy != nil {
+		stageTasks, err := s.store.ListTasks(ctx, &store.TaskFind{PipelineID: &task.PipelineID, Environment: &task.Environment})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list tasks")
+		}
+		state := utils.GetRolloutStrategyState(strategy, stageTasks)
+		if state.Halted {
+			return s.haltTaskRun(ctx, taskRun, state.FailedCount)
+		}
+		if !state.CanRun(task.ID) {
+			s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
+				ReportTime: timestamppb.Now(),
+				WaitingCause: &storepb.SchedulerInfo_WaitingCause{
+					Cause: &storepb.SchedulerInfo_WaitingCause_RolloutBatch{
+						RolloutBatch: true,
+					},
+				},
+			})
+			return nil
+		}
+	}
+
 	doSchedule, err := func() (bool, error) {
 		if task.DatabaseName == nil {
 			return true, nil
@@ -362,6 +450,60 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 	return nil
 }
 
+// haltTaskRun cancels the pending task run because its stage is halted by the rollout strategy.
+func (s *SchedulerV2) haltTaskRun(ctx context.Context, taskRun *store.TaskRunMessage, failedCount int) error {
+	resultBytes, err := protojson.Marshal(&storepb.TaskRunResult{
+		Detail: fmt.Sprintf("The stage is halted because %d tasks failed, reaching the failure threshold of the rollout strategy", failedCount),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal task run result")
+	}
+	result := string(resultBytes)
+	if _, err := s.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
+		ID:        taskRun.ID,
+		UpdaterID: common.SystemBotID,
+		Status:    storepb.TaskRun_CANCELED,
+		Result:    &result,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to cancel task run")
+	}
+	s.stateCfg.TaskRunSchedulerInfo.Delete(taskRun.ID)
+	return nil
+}
+
+func (s *SchedulerV2) notifyRolloutWindowOpen(ctx context.Context, stage rolloutStage) error {
+	pipeline, err := s.store.GetPipelineV2ByID(ctx, stage.pipelineID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get pipeline")
+	}
+	if pipeline == nil {
+		return errors.Errorf("pipeline %v not found", stage.pipelineID)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &pipeline.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project")
+	}
+	if project == nil {
+		return errors.Errorf("project %v not found", pipeline.ProjectID)
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &stage.pipelineID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   s.store.GetSystemBotUser(ctx),
+		Type:    storepb.Activity_NOTIFY_ROLLOUT_WINDOW_OPEN,
+		Comment: fmt.Sprintf("The rollout window of environment %q is open, the waiting tasks start to run.", stage.environment),
+		Issue:   webhook.NewIssue(issue),
+		Rollout: webhook.NewRollout(pipeline),
+		Project: webhook.NewProject(project),
+		RolloutWindowOpen: &webhook.EventRolloutWindowOpen{
+			StageTitle: stage.environment,
+		},
+	})
+	return nil
+}
+
 func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
 		Status: &[]storepb.TaskRun_Status{storepb.TaskRun_RUNNING},
@@ -14,15 +14,18 @@ import (
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/saml"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	samlidp "github.com/bytebase/bytebase/backend/plugin/idp/saml"
 )
 
 func configureEchoRouters(
 	e *echo.Echo,
 	lspServer *lsp.Server,
 	directorySyncServer *directorysync.Service,
+	samlServer *saml.Service,
 	profile *config.Profile,
 ) {
 	e.Use(recoverMiddleware)
@@ -77,6 +80,9 @@ func configureEchoRouters(
 	hookGroup := e.Group(webhookAPIPrefix)
 	scimGroup := hookGroup.Group(scimAPIPrefix)
 	directorySyncServer.RegisterDirectorySyncRoutes(scimGroup)
+
+	// SAML service provider.
+	samlServer.RegisterRoutes(e.Group(samlidp.EndpointPrefix))
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
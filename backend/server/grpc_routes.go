@@ -77,7 +77,7 @@ func configureGrpcRouters(
 	celService := apiv1.NewCelService()
 	databaseCatalogService := apiv1.NewDatabaseCatalogService(stores, licenseService)
 	databaseGroupService := apiv1.NewDatabaseGroupService(stores, profile, iamManager, licenseService)
-	databaseService := apiv1.NewDatabaseService(stores, schemaSyncer, licenseService, profile, iamManager)
+	databaseService := apiv1.NewDatabaseService(stores, schemaSyncer, licenseService, profile, iamManager, sheetManager, dbFactory, stateCfg)
 	groupService := apiv1.NewGroupService(stores, iamManager, licenseService)
 	identityProviderService := apiv1.NewIdentityProviderService(stores, licenseService, profile)
 	instanceRoleService := apiv1.NewInstanceRoleService(stores, dbFactory)
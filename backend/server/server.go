@@ -17,10 +17,12 @@ import (
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/saml"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/ha"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sampleinstance"
 	"github.com/bytebase/bytebase/backend/component/sheet"
@@ -63,6 +65,7 @@ type Server struct {
 	runnerWG             sync.WaitGroup
 
 	webhookManager        *webhook.Manager
+	webhookDeliveryRunner *webhook.DeliveryRunner
 	iamManager            *iam.Manager
 	sampleInstanceManager *sampleinstance.Manager
 
@@ -153,6 +156,9 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create state config")
 	}
+	if profile.HA {
+		s.stateCfg.EnableHA(s.store)
+	}
 
 	if err := s.store.BackfillIssueTSVector(ctx); err != nil {
 		slog.Warn("failed to backfill issue ts vector", log.BBError(err))
@@ -180,13 +186,14 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		return nil, errors.Wrapf(err, "failed to create iam manager")
 	}
 	s.webhookManager = webhook.NewManager(stores, s.iamManager, profile)
+	s.webhookDeliveryRunner = webhook.NewDeliveryRunner(stores)
 	s.dbFactory = dbfactory.New(s.store, s.licenseService)
 
 	// Configure echo server.
 	s.echoServer = echo.New()
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile)
-	s.schemaSyncer = schemasync.NewSyncer(stores, s.dbFactory, s.profile, s.stateCfg, s.licenseService)
+	s.schemaSyncer = schemasync.NewSyncer(stores, s.dbFactory, s.profile, s.stateCfg, s.webhookManager, s.licenseService)
 	s.approvalRunner = approval.NewRunner(stores, sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.licenseService)
 
 	s.taskSchedulerV2 = taskrun.NewSchedulerV2(stores, s.stateCfg, s.webhookManager, profile, s.licenseService)
@@ -202,8 +209,14 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementAdvise, statementAdviseExecutor)
 	ghostSyncExecutor := plancheck.NewGhostSyncExecutor(stores, s.dbFactory)
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
+	onlineSchemaChangeExecutor := plancheck.NewOnlineSchemaChangeExecutor(stores, s.dbFactory)
+	s.planCheckScheduler.Register(store.PlanCheckDatabaseOnlineSchemaChange, onlineSchemaChangeExecutor)
 	statementReportExecutor := plancheck.NewStatementReportExecutor(stores, sheetManager, s.dbFactory)
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
+	lockImpactExecutor := plancheck.NewLockImpactExecutor(stores, sheetManager)
+	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementLockImpact, lockImpactExecutor)
+	explainExecutor := plancheck.NewExplainExecutor(stores, sheetManager, s.dbFactory)
+	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementExplain, explainExecutor)
 
 	// Export archive cleaner
 	s.exportArchiveCleaner = runnermigrator.NewExportArchiveCleaner(stores)
@@ -212,14 +225,15 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.initMetricReporter()
 
 	// LSP server.
-	s.lspServer = lsp.NewServer(s.store, profile, secret, s.stateCfg, s.iamManager, s.licenseService)
+	s.lspServer = lsp.NewServer(s.store, sheetManager, profile, secret, s.stateCfg, s.iamManager, s.licenseService)
 
 	directorySyncServer := directorysync.NewService(s.store, s.licenseService, s.iamManager, profile)
+	samlServer := saml.NewService(s.store, profile)
 
 	if err := configureGrpcRouters(ctx, s.echoServer, s.store, sheetManager, s.dbFactory, s.licenseService, s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.webhookManager, s.iamManager, secret, s.sampleInstanceManager); err != nil {
 		return nil, errors.Wrapf(err, "failed to configure gRPC routers")
 	}
-	configureEchoRouters(s.echoServer, s.lspServer, directorySyncServer, profile)
+	configureEchoRouters(s.echoServer, s.lspServer, directorySyncServer, samlServer, profile)
 
 	serverStarted = true
 	return s, nil
@@ -230,21 +244,43 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	ctx, cancel := context.WithCancel(ctx)
 	s.cancel = cancel
 	// runnerWG waits for all goroutines to complete.
-	s.runnerWG.Add(1)
-	go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
-	s.runnerWG.Add(1)
-	go s.schemaSyncer.Run(ctx, &s.runnerWG)
-	s.runnerWG.Add(1)
-	go s.approvalRunner.Run(ctx, &s.runnerWG)
+	if s.profile.HA {
+		// Only the leader runs the runners scheduling the shared work, and the replicas
+		// signal each other through the metadata database.
+		elector := ha.NewElector(
+			s.store,
+			s.taskSchedulerV2.Run,
+			s.schemaSyncer.RunScheduledSync,
+			s.approvalRunner.Run,
+			s.planCheckScheduler.Run,
+			s.exportArchiveCleaner.Run,
+		)
+		s.runnerWG.Add(1)
+		go elector.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go ha.NewListener(s.store, s.stateCfg, elector).Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.schemaSyncer.RunDatabaseSync(ctx, &s.runnerWG)
+	} else {
+		s.runnerWG.Add(1)
+		go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.schemaSyncer.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.approvalRunner.Run(ctx, &s.runnerWG)
+
+		s.runnerWG.Add(1)
+		go s.planCheckScheduler.Run(ctx, &s.runnerWG)
+
+		s.runnerWG.Add(1)
+		go s.exportArchiveCleaner.Run(ctx, &s.runnerWG)
+	}
 
 	s.runnerWG.Add(1)
 	go s.metricReporter.Run(ctx, &s.runnerWG)
 
 	s.runnerWG.Add(1)
-	go s.planCheckScheduler.Run(ctx, &s.runnerWG)
-
-	s.runnerWG.Add(1)
-	go s.exportArchiveCleaner.Run(ctx, &s.runnerWG)
+	go s.webhookDeliveryRunner.Run(ctx, &s.runnerWG)
 
 	s.runnerWG.Add(1)
 	mmm := monitor.NewMemoryMonitor(s.profile)
@@ -14,6 +14,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 
 	"github.com/bytebase/bytebase/backend/common"
+	samlidp "github.com/bytebase/bytebase/backend/plugin/idp/saml"
 )
 
 //go:embed dist/assets/*
@@ -107,5 +108,5 @@ func embedFrontend(e *echo.Echo) {
 // defaultAPIRequestSkipper is echo skipper for api requests.
 func defaultAPIRequestSkipper(c echo.Context) bool {
 	path := c.Path()
-	return common.HasPrefixes(path, "/api", "/v1", webhookAPIPrefix)
+	return common.HasPrefixes(path, "/api", "/v1", webhookAPIPrefix, samlidp.EndpointPrefix)
 }
@@ -60,6 +60,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/redshift"
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/tidb"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/trino"
 
@@ -0,0 +1,182 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/qb"
+)
+
+// The notification channels used to coordinate the replicas sharing the metadata database.
+const (
+	// NotifyChannelTaskRunTickle wakes up the task run scheduler.
+	NotifyChannelTaskRunTickle = "bb_task_run_tickle"
+	// NotifyChannelPlanCheckTickle wakes up the plan check scheduler.
+	NotifyChannelPlanCheckTickle = "bb_plan_check_tickle"
+	// NotifyChannelTaskSkippedOrDone carries the ID of the task that is skipped or done.
+	NotifyChannelTaskSkippedOrDone = "bb_task_skipped_or_done"
+	// NotifyChannelApprovalFinding carries the UID of the issue to find the approval template for.
+	NotifyChannelApprovalFinding = "bb_approval_finding"
+	// NotifyChannelTaskRunCancel carries the ID of the running task run to cancel.
+	NotifyChannelTaskRunCancel = "bb_task_run_cancel"
+	// NotifyChannelPlanCheckRunCancel carries the UID of the running plan check run to cancel.
+	NotifyChannelPlanCheckRunCancel = "bb_plan_check_run_cancel"
+	// NotifyChannelAccessTokenExpired carries the SHA-256 hash of the access token revoked by logging out.
+	NotifyChannelAccessTokenExpired = "bb_access_token_expired"
+)
+
+// AdvisoryLock is a session-level advisory lock held on a dedicated connection to the metadata database.
+// The lock is released when the connection is closed, e.g. when the replica holding it crashes.
+type AdvisoryLock struct {
+	conn *sql.Conn
+	key  int64
+	// pid and backendStart identify the session holding the lock, the pid alone may be reused by a later session.
+	pid          int
+	backendStart time.Time
+}
+
+type fenceContextKey struct{}
+
+// WithFence returns the context fencing the writes of the store with the lock.
+// The fenced writes only take effect while the session of the lock still holds it, so that a replica
+// cannot claim any work with the context once the metadata database has ended the session, even before
+// the replica notices it.
+func WithFence(ctx context.Context, lock *AdvisoryLock) context.Context {
+	return context.WithValue(ctx, fenceContextKey{}, lock)
+}
+
+// getFenceCondition returns the condition that the session of the fence lock in the context still holds the lock.
+// It returns nil if the context is not fenced.
+func getFenceCondition(ctx context.Context) *qb.Query {
+	lock, ok := ctx.Value(fenceContextKey{}).(*AdvisoryLock)
+	if !ok || lock == nil {
+		return nil
+	}
+	// The bigint key of an advisory lock is split into the classid and objid of pg_locks with objsubid 1.
+	return qb.Q().Space(`EXISTS (
+		SELECT 1 FROM pg_locks
+		JOIN pg_stat_activity ON pg_stat_activity.pid = pg_locks.pid
+		WHERE pg_locks.locktype = 'advisory'
+			AND pg_locks.granted
+			AND pg_locks.classid::bigint = ?
+			AND pg_locks.objid::bigint = ?
+			AND pg_locks.objsubid = 1
+			AND pg_locks.pid = ?
+			AND pg_stat_activity.backend_start = ?
+	)`, int64(uint32(lock.key>>32)), int64(uint32(lock.key)), lock.pid, lock.backendStart)
+}
+
+// TryAdvisoryLock tries to acquire the session-level advisory lock of the key without waiting.
+// It returns nil if the lock is held by another session.
+func (s *Store) TryAdvisoryLock(ctx context.Context, key int64) (*AdvisoryLock, error) {
+	conn, err := s.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get connection")
+	}
+	q := qb.Q().Space(`
+		SELECT
+			pg_try_advisory_lock(?),
+			pg_backend_pid(),
+			(SELECT backend_start FROM pg_stat_activity WHERE pid = pg_backend_pid())
+	`, key)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to build sql")
+	}
+	var acquired bool
+	lock := &AdvisoryLock{conn: conn, key: key}
+	if err := conn.QueryRowContext(ctx, query, args...).Scan(&acquired, &lock.pid, &lock.backendStart); err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to acquire advisory lock")
+	}
+	if !acquired {
+		if err := conn.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to close connection")
+		}
+		return nil, nil
+	}
+	return lock, nil
+}
+
+// Ping checks that the connection holding the lock is still alive.
+func (l *AdvisoryLock) Ping(ctx context.Context) error {
+	return l.conn.PingContext(ctx)
+}
+
+// Release releases the lock and discards its connection.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	q := qb.Q().Space("SELECT pg_advisory_unlock(?)", l.key)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, "failed to build sql")
+	}
+	_, unlockErr := l.conn.ExecContext(ctx, query, args...)
+	// Discard the connection so that the session and any lock left on it are ended.
+	_ = l.conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+	if err := l.conn.Close(); err != nil && !errors.Is(err, sql.ErrConnDone) {
+		return errors.Wrap(err, "failed to close connection")
+	}
+	if unlockErr != nil {
+		return errors.Wrap(unlockErr, "failed to release advisory lock")
+	}
+	return nil
+}
+
+// Notify sends the notification to the sessions listening on the channel, including the ones of other replicas.
+func (s *Store) Notify(ctx context.Context, channel, payload string) error {
+	q := qb.Q().Space("SELECT pg_notify(?, ?)", channel, payload)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to notify channel %q", channel)
+	}
+	return nil
+}
+
+// Listen listens on the channels with a dedicated connection and calls the handler for each notification.
+// It blocks until the context is canceled or the connection fails, and the notifications sent in between
+// the calls are lost.
+func (s *Store) Listen(ctx context.Context, channels []string, handler func(channel, payload string)) error {
+	conn, err := s.GetDB().Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	var listenErr error
+	// The connection is discarded afterwards because it is left listening.
+	_ = conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			listenErr = errors.Errorf("unexpected driver connection type %T", driverConn)
+			return driver.ErrBadConn
+		}
+		pgxConn := stdlibConn.Conn()
+		for _, channel := range channels {
+			if _, err := pgxConn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+				listenErr = errors.Wrapf(err, "failed to listen on channel %q", channel)
+				return driver.ErrBadConn
+			}
+		}
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				listenErr = errors.Wrap(err, "failed to wait for notification")
+				return driver.ErrBadConn
+			}
+			handler(notification.Channel, notification.Payload)
+		}
+	})
+	return listenErr
+}
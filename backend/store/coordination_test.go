@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFenceCondition(t *testing.T) {
+	ctx := context.Background()
+	require.Nil(t, getFenceCondition(ctx))
+
+	backendStart := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+	lock := &AdvisoryLock{key: 0x6279746562617365, pid: 42, backendStart: backendStart}
+	fence := getFenceCondition(WithFence(ctx, lock))
+	require.NotNil(t, fence)
+	query, args, err := fence.ToSQL()
+	require.NoError(t, err)
+	require.Contains(t, query, "pg_locks.classid::bigint = $1")
+	require.Contains(t, query, "pg_locks.objid::bigint = $2")
+	require.Contains(t, query, "pg_locks.pid = $3")
+	require.Contains(t, query, "pg_stat_activity.backend_start = $4")
+	require.Equal(t, []any{int64(0x62797465), int64(0x62617365), 42, backendStart}, args)
+
+	// The objid is the unsigned low half of the key.
+	lock = &AdvisoryLock{key: -1, pid: 42, backendStart: backendStart}
+	_, args, err = getFenceCondition(WithFence(ctx, lock)).ToSQL()
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(0xffffffff), int64(0xffffffff), 42, backendStart}, args)
+}
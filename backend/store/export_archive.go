@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"io"
 	"time"
 
 	"github.com/pkg/errors"
@@ -13,6 +14,9 @@ import (
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 )
 
+// exportArchiveChunkSize is the size of each chunk of the export archives created from readers.
+const exportArchiveChunkSize = 8 * 1024 * 1024
+
 type ExportArchiveMessage struct {
 	UID       int
 	CreatedAt time.Time
@@ -139,6 +143,126 @@ func (s *Store) CreateExportArchive(ctx context.Context, create *ExportArchiveMe
 	return create, nil
 }
 
+// CreateExportArchiveFromReader creates a export archive with the content read from r.
+// The content is stored in chunks of exportArchiveChunkSize, so it is never held in memory as a whole.
+// The Bytes of the message is ignored.
+func (s *Store) CreateExportArchiveFromReader(ctx context.Context, create *ExportArchiveMessage, r io.Reader) (*ExportArchiveMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO export_archive (
+			payload
+		)
+		VALUES (?)
+		RETURNING id
+	`, payload)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&create.UID); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, exportArchiveChunkSize)
+	for seq := 0; ; seq++ {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, errors.Wrapf(err, "failed to read export archive content")
+		}
+		q := qb.Q().Space(`
+			INSERT INTO export_archive_chunk (
+				archive_id,
+				seq,
+				bytes
+			)
+			VALUES (?, ?, ?)
+		`, create.UID, seq, buf[:n])
+		query, args, err := q.ToSQL()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build sql")
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, err
+		}
+		if n < len(buf) {
+			break
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	create.Bytes = nil
+	return create, nil
+}
+
+// WriteExportArchiveContent writes the content of the export archive to w.
+// The content is either the bytes of the archive or its chunks in order.
+func (s *Store) WriteExportArchiveContent(ctx context.Context, uid int, w io.Writer) error {
+	tx, err := s.GetDB().BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := qb.Q().Space("SELECT bytes FROM export_archive WHERE id = ?", uid)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	var bytes []byte
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&bytes); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("export archive %d not found", uid)
+		}
+		return err
+	}
+	if len(bytes) > 0 {
+		if _, err := w.Write(bytes); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	q = qb.Q().Space("SELECT bytes FROM export_archive_chunk WHERE archive_id = ? ORDER BY seq", uid)
+	query, args, err = q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chunk []byte
+		if err := rows.Scan(&chunk); err != nil {
+			return err
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 // DeleteExportArchive deletes a export archive.
 func (s *Store) DeleteExportArchive(ctx context.Context, uid int) error {
 	q := qb.Q().Space("DELETE FROM export_archive WHERE id = ?", uid)
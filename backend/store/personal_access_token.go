@@ -0,0 +1,185 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// PersonalAccessTokenMessage is the message for the personal access token.
+type PersonalAccessTokenMessage struct {
+	PrincipalUID int
+	Title        string
+	// TokenHash is the SHA-256 hex digest of the token.
+	TokenHash string
+	// ExpiresAt is nil if the token never expires.
+	ExpiresAt *time.Time
+	Payload   *storepb.PersonalAccessTokenPayload
+
+	// Output only.
+	UID        int
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+// FindPersonalAccessTokenMessage is the message for finding personal access tokens.
+type FindPersonalAccessTokenMessage struct {
+	UID          *int
+	PrincipalUID *int
+	TokenHash    *string
+}
+
+// GetPersonalAccessToken gets a personal access token.
+func (s *Store) GetPersonalAccessToken(ctx context.Context, find *FindPersonalAccessTokenMessage) (*PersonalAccessTokenMessage, error) {
+	tokens, err := s.ListPersonalAccessTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	if len(tokens) > 1 {
+		return nil, errors.Errorf("expected 1 personal access token, got %d", len(tokens))
+	}
+	return tokens[0], nil
+}
+
+// ListPersonalAccessTokens lists personal access tokens.
+func (s *Store) ListPersonalAccessTokens(ctx context.Context, find *FindPersonalAccessTokenMessage) ([]*PersonalAccessTokenMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			principal_id,
+			title,
+			token_hash,
+			created_at,
+			expires_at,
+			last_used_at,
+			payload
+		FROM personal_access_token
+		WHERE TRUE
+	`)
+	if v := find.UID; v != nil {
+		q.And("id = ?", *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		q.And("principal_id = ?", *v)
+	}
+	if v := find.TokenHash; v != nil {
+		q.And("token_hash = ?", *v)
+	}
+	q.Space("ORDER BY id ASC")
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []*PersonalAccessTokenMessage
+	for rows.Next() {
+		var token PersonalAccessTokenMessage
+		var expiresAt, lastUsedAt sql.NullTime
+		var payload []byte
+		if err := rows.Scan(
+			&token.UID,
+			&token.PrincipalUID,
+			&token.Title,
+			&token.TokenHash,
+			&token.CreatedAt,
+			&expiresAt,
+			&lastUsedAt,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		if expiresAt.Valid {
+			token.ExpiresAt = &expiresAt.Time
+		}
+		if lastUsedAt.Valid {
+			token.LastUsedAt = &lastUsedAt.Time
+		}
+		tokenPayload := &storepb.PersonalAccessTokenPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, tokenPayload); err != nil {
+			return nil, err
+		}
+		token.Payload = tokenPayload
+		tokens = append(tokens, &token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// CreatePersonalAccessToken creates a personal access token.
+func (s *Store) CreatePersonalAccessToken(ctx context.Context, create *PersonalAccessTokenMessage) (*PersonalAccessTokenMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO personal_access_token (
+			principal_id,
+			title,
+			token_hash,
+			expires_at,
+			payload
+		)
+		VALUES (?, ?, ?, ?, ?)
+		RETURNING id, created_at
+	`, create.PrincipalUID, create.Title, create.TokenHash, create.ExpiresAt, payload)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(&create.UID, &create.CreatedAt); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+// UpdatePersonalAccessTokenLastUsedAt updates the last used time of the personal access token.
+func (s *Store) UpdatePersonalAccessTokenLastUsedAt(ctx context.Context, uid int, lastUsedAt time.Time) error {
+	q := qb.Q().Space("UPDATE personal_access_token SET last_used_at = ? WHERE id = ?", lastUsedAt, uid)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeletePersonalAccessToken deletes a personal access token.
+func (s *Store) DeletePersonalAccessToken(ctx context.Context, uid int) error {
+	q := qb.Q().Space("DELETE FROM personal_access_token WHERE id = ?", uid)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -28,6 +28,12 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabaseOnlineSchemaChange is the plan check type for the PostgreSQL online schema change.
+	PlanCheckDatabaseOnlineSchemaChange PlanCheckRunType = "bb.plan-check.database.online-schema-change"
+	// PlanCheckDatabaseStatementLockImpact is the plan check type for the lock impact of the DDL statements.
+	PlanCheckDatabaseStatementLockImpact PlanCheckRunType = "bb.plan-check.database.statement.lock-impact"
+	// PlanCheckDatabaseStatementExplain is the plan check type for the query plans of the DML statements.
+	PlanCheckDatabaseStatementExplain PlanCheckRunType = "bb.plan-check.database.statement.explain"
 )
 
 // PlanCheckRunStatus is the status of a plan check run.
@@ -385,6 +385,32 @@ func (s *Store) GetMaskingExceptionPolicyByProject(ctx context.Context, projectI
 	return p, nil
 }
 
+// GetRowAccessPolicyByProject gets the row access policy for a project.
+func (s *Store) GetRowAccessPolicyByProject(ctx context.Context, projectID string) (*storepb.RowAccessPolicy, error) {
+	resourceType := storepb.Policy_PROJECT
+	resource := common.FormatProject(projectID)
+	pType := storepb.Policy_ROW_ACCESS
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		Resource:     &resource,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.RowAccessPolicy{}, nil
+	}
+
+	p := new(storepb.RowAccessPolicy)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // PolicyMessage is the mssage for policy.
 type PolicyMessage struct {
 	Resource          string
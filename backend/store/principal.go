@@ -414,6 +414,39 @@ func (s *Store) CreateUser(ctx context.Context, create *UserMessage) (*UserMessa
 	return user, nil
 }
 
+// UpdateUserMFAConfigIfUnchanged updates the MFA config of the user only if it is unchanged since the user was read.
+// It returns false if the MFA config was changed concurrently, and the caller should read the user again.
+func (s *Store) UpdateUserMFAConfigIfUnchanged(ctx context.Context, currentUser *UserMessage, mfaConfig *storepb.MFAConfig) (bool, error) {
+	oldMFAConfig := currentUser.MFAConfig
+	if oldMFAConfig == nil {
+		oldMFAConfig = &storepb.MFAConfig{}
+	}
+	oldBytes, err := protojson.Marshal(oldMFAConfig)
+	if err != nil {
+		return false, err
+	}
+	newBytes, err := protojson.Marshal(mfaConfig)
+	if err != nil {
+		return false, err
+	}
+	sql, args, err := qb.Q().Space("UPDATE principal SET mfa_config = ? WHERE id = ? AND mfa_config = ?::jsonb", newBytes, currentUser.ID, oldBytes).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	result, err := s.GetDB().ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	// The cached user is stale in both cases.
+	s.userEmailCache.Remove(currentUser.Email)
+	s.userIDCache.Remove(currentUser.ID)
+	return rows > 0, nil
+}
+
 // UpdateUser updates a user.
 func (s *Store) UpdateUser(ctx context.Context, currentUser *UserMessage, patch *UpdateUserMessage) (*UserMessage, error) {
 	if currentUser.ID == common.SystemBotID {
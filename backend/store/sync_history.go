@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/pkg/errors"
@@ -18,6 +19,8 @@ type SyncHistory struct {
 	DatabaseName string
 	Schema       string
 	Metadata     *storepb.DatabaseSchemaMetadata
+	// DriftReport is set if the sync history records a drifted schema.
+	DriftReport *storepb.SchemaDriftReport
 
 	CreatedAt time.Time
 }
@@ -30,11 +33,44 @@ func (s *Store) GetSyncHistoryByUID(ctx context.Context, uid int64) (*SyncHistor
 			instance,
 			db_name,
 			metadata,
-			raw_dump
+			raw_dump,
+			drift_report
 		FROM sync_history
 		WHERE id = ?
 	`, uid)
 
+	return s.getSyncHistory(ctx, q)
+}
+
+// GetLatestDriftSyncHistory gets the latest sync history recording a drifted schema of the database.
+// It returns nil if there is none.
+func (s *Store) GetLatestDriftSyncHistory(ctx context.Context, instanceID, databaseName string) (*SyncHistory, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			created_at,
+			instance,
+			db_name,
+			metadata,
+			raw_dump,
+			drift_report
+		FROM sync_history
+		WHERE instance = ? AND db_name = ? AND drift_report IS NOT NULL
+		ORDER BY id DESC
+		LIMIT 1
+	`, instanceID, databaseName)
+
+	h, err := s.getSyncHistory(ctx, q)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+func (s *Store) getSyncHistory(ctx context.Context, q *qb.Query) (*SyncHistory, error) {
 	query, args, err := q.ToSQL()
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to build sql")
@@ -44,7 +80,7 @@ func (s *Store) GetSyncHistoryByUID(ctx context.Context, uid int64) (*SyncHistor
 		Metadata: &storepb.DatabaseSchemaMetadata{},
 	}
 
-	var m []byte
+	var m, driftReport []byte
 	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(
 		&h.UID,
 		&h.CreatedAt,
@@ -52,6 +88,7 @@ func (s *Store) GetSyncHistoryByUID(ctx context.Context, uid int64) (*SyncHistor
 		&h.DatabaseName,
 		&m,
 		&h.Schema,
+		&driftReport,
 	); err != nil {
 		return nil, errors.Wrapf(err, "failed to scan")
 	}
@@ -59,27 +96,50 @@ func (s *Store) GetSyncHistoryByUID(ctx context.Context, uid int64) (*SyncHistor
 	if err := common.ProtojsonUnmarshaler.Unmarshal(m, h.Metadata); err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal")
 	}
+	if driftReport != nil {
+		h.DriftReport = &storepb.SchemaDriftReport{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(driftReport, h.DriftReport); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal drift report")
+		}
+	}
 
 	return &h, nil
 }
 
-// UpsertDBSchema upserts a database schema.
+// CreateSyncHistory creates a sync history of the database schema.
 func (s *Store) CreateSyncHistory(ctx context.Context, instanceID, databaseName string, metadata *storepb.DatabaseSchemaMetadata, schema string) (int64, error) {
+	return s.createSyncHistory(ctx, instanceID, databaseName, metadata, schema, nil)
+}
+
+// CreateDriftSyncHistory creates a sync history of the drifted database schema with its drift report.
+func (s *Store) CreateDriftSyncHistory(ctx context.Context, instanceID, databaseName string, metadata *storepb.DatabaseSchemaMetadata, schema string, driftReport *storepb.SchemaDriftReport) (int64, error) {
+	return s.createSyncHistory(ctx, instanceID, databaseName, metadata, schema, driftReport)
+}
+
+func (s *Store) createSyncHistory(ctx context.Context, instanceID, databaseName string, metadata *storepb.DatabaseSchemaMetadata, schema string, driftReport *storepb.SchemaDriftReport) (int64, error) {
 	metadataBytes, err := protojson.Marshal(metadata)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to marshal")
 	}
+	var driftReportBytes []byte
+	if driftReport != nil {
+		driftReportBytes, err = protojson.Marshal(driftReport)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to marshal drift report")
+		}
+	}
 
 	q := qb.Q().Space(`
 		INSERT INTO sync_history (
 			instance,
 			db_name,
 			metadata,
-			raw_dump
+			raw_dump,
+			drift_report
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?)
 		RETURNING id
-	`, instanceID, databaseName, metadataBytes, schema)
+	`, instanceID, databaseName, metadataBytes, schema, driftReportBytes)
 
 	query, args, err := q.ToSQL()
 	if err != nil {
@@ -84,8 +84,10 @@ type TaskPatch struct {
 	ExportPassword    *string
 	EnablePriorBackup *bool
 	EnableGhost       *bool
+	// EnableOnlineSchemaChange enables the PostgreSQL online schema change.
+	EnableOnlineSchemaChange *bool
 
-	// Flags for gh-ost.
+	// Flags for gh-ost or the PostgreSQL online schema change.
 	Flags *map[string]string
 }
 
@@ -418,6 +420,9 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *TaskPatch) (*TaskMessag
 	if v := patch.EnableGhost; v != nil {
 		payloadParts.Join(" || ", "jsonb_build_object('enableGhost', ?::BOOLEAN)", *v)
 	}
+	if v := patch.EnableOnlineSchemaChange; v != nil {
+		payloadParts.Join(" || ", "jsonb_build_object('enableOnlineSchemaChange', ?::BOOLEAN)", *v)
+	}
 	if v := patch.Flags; v != nil {
 		jsonb, err := json.Marshal(v)
 		if err != nil {
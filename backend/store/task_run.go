@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/qb"
@@ -22,6 +23,7 @@ type TaskRunMessage struct {
 	Result      string
 	ResultProto *storepb.TaskRunResult
 	SheetUID    *int
+	Payload     *storepb.TaskRunPayload
 
 	// Output only.
 	ID        int
@@ -72,6 +74,7 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			task_run.code,
 			task_run.result,
 			task_run.sheet_id,
+			task_run.payload,
 			task.pipeline_id,
 			task.environment,
 			project.resource_id
@@ -123,6 +126,7 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 		var taskRun TaskRunMessage
 		var startedAt, runAt sql.NullTime
 		var statusString string
+		var payload []byte
 		if err := rows.Scan(
 			&taskRun.ID,
 			&taskRun.CreatorID,
@@ -135,6 +139,7 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			&taskRun.Code,
 			&taskRun.Result,
 			&taskRun.SheetUID,
+			&payload,
 			&taskRun.PipelineUID,
 			&taskRun.Environment,
 			&taskRun.ProjectID,
@@ -158,6 +163,10 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			return nil, errors.Wrapf(err, "failed to unmarshal task run result: %s", taskRun.Result)
 		}
 		taskRun.ResultProto = &resultProto
+		taskRun.Payload = &storepb.TaskRunPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, taskRun.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal task run payload")
+		}
 
 		taskRuns = append(taskRuns, &taskRun)
 	}
@@ -231,14 +240,19 @@ func (s *Store) UpdateTaskRunStatus(ctx context.Context, patch *TaskRunStatusPat
 	return taskRun, nil
 }
 
+// UpdateTaskRunStartAt sets the start time of the task run which is about to run.
+// The update is fenced by the context, see WithFence, because it marks the start of the execution.
 func (s *Store) UpdateTaskRunStartAt(ctx context.Context, taskRunID int) error {
 	// Get the pipeline ID for cache invalidation
 	q := qb.Q().Space(`
 		UPDATE task_run
 		SET started_at = now(), updated_at = now()
 		WHERE id = ?
-		RETURNING (SELECT pipeline_id FROM task WHERE task.id = task_run.task_id)
 	`, taskRunID)
+	if fence := getFenceCondition(ctx); fence != nil {
+		q.And("?", fence)
+	}
+	q.Space("RETURNING (SELECT pipeline_id FROM task WHERE task.id = task_run.task_id)")
 
 	query, args, err := q.ToSQL()
 	if err != nil {
@@ -247,6 +261,9 @@ func (s *Store) UpdateTaskRunStartAt(ctx context.Context, taskRunID int) error {
 
 	var pipelineID int
 	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(&pipelineID); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("task run %d not found or the fence lock is lost", taskRunID)
+		}
 		return errors.Wrapf(err, "failed to update task run start at")
 	}
 
@@ -256,6 +273,43 @@ func (s *Store) UpdateTaskRunStartAt(ctx context.Context, taskRunID int) error {
 	return nil
 }
 
+// SetTaskRunSchedulerInfo persists the waiting state of a pending task run.
+func (s *Store) SetTaskRunSchedulerInfo(ctx context.Context, taskRunID int, info *storepb.SchedulerInfo) error {
+	payload, err := protojson.Marshal(&storepb.TaskRunPayload{SchedulerInfo: info})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal task run payload")
+	}
+	q := qb.Q().Space("UPDATE task_run SET payload = ? WHERE id = ? AND status = ?", payload, taskRunID, storepb.TaskRun_PENDING.String())
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update task run payload")
+	}
+	return nil
+}
+
+// ClearTaskRunSchedulerInfo clears the persisted waiting state of a task run.
+// It returns true only for the caller that cleared the state, so that the follow-up
+// work of the waiting, e.g. the notification, is done once.
+func (s *Store) ClearTaskRunSchedulerInfo(ctx context.Context, taskRunID int) (bool, error) {
+	q := qb.Q().Space("UPDATE task_run SET payload = payload - 'schedulerInfo' WHERE id = ? AND payload ?? 'schedulerInfo'", taskRunID)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to clear task run payload")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get affected rows")
+	}
+	return rows > 0, nil
+}
+
 // CreatePendingTaskRuns creates pending task runs.
 // This operation is idempotent and safe for concurrent calls:
 // - Uses WHERE NOT EXISTS to skip tasks that already have active (PENDING/RUNNING/DONE) task runs
@@ -0,0 +1,244 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending is the webhook delivery status for PENDING.
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliveryStatusSucceeded is the webhook delivery status for SUCCEEDED.
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryStatusFailed is the webhook delivery status for FAILED.
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookDeliveryMessage is the message for a webhook delivery.
+type WebhookDeliveryMessage struct {
+	WebhookID int
+	Payload   *storepb.WebhookDeliveryPayload
+	// NextAttemptAt is the time when the delivery is due.
+	NextAttemptAt time.Time
+
+	// Output only fields.
+	ID        int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Status    WebhookDeliveryStatus
+	Attempts  int
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	WebhookID *int
+	Limit     *int
+	Offset    *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery after an attempt.
+type UpdateWebhookDeliveryMessage struct {
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	Payload       *storepb.WebhookDeliveryPayload
+}
+
+// CreateWebhookDelivery creates a pending webhook delivery.
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO webhook_delivery (
+			webhook_id,
+			status,
+			next_attempt_at,
+			payload
+		)
+		VALUES (?, ?, ?, ?)
+		RETURNING id, created_at, updated_at, status, attempts, next_attempt_at
+	`, create.WebhookID, WebhookDeliveryStatusPending, create.NextAttemptAt, payload)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	delivery := &WebhookDeliveryMessage{
+		WebhookID: create.WebhookID,
+		Payload:   create.Payload,
+	}
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(
+		&delivery.ID,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return nil, errors.Wrapf(err, "failed to create webhook delivery")
+	}
+	return delivery, nil
+}
+
+// ListWebhookDeliveries lists the webhook deliveries, newest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			created_at,
+			updated_at,
+			webhook_id,
+			status,
+			attempts,
+			next_attempt_at,
+			payload
+		FROM webhook_delivery
+		WHERE TRUE
+	`)
+	if v := find.WebhookID; v != nil {
+		q.And("webhook_id = ?", *v)
+	}
+	q.Space("ORDER BY id DESC")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	if v := find.Offset; v != nil {
+		q.Space("OFFSET ?", *v)
+	}
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list webhook deliveries")
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+// ClaimWebhookDeliveries claims the pending webhook deliveries that are due.
+// The claimed deliveries are leased by moving their next attempt time forward,
+// so that other replicas in HA mode do not send them concurrently.
+func (s *Store) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDeliveryMessage, error) {
+	now := time.Now()
+	q := qb.Q().Space(`
+		UPDATE webhook_delivery
+		SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_delivery
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			webhook_id,
+			status,
+			attempts,
+			next_attempt_at,
+			payload
+	`, now.Add(lease), WebhookDeliveryStatusPending, now, limit)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim webhook deliveries")
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+// UpdateWebhookDelivery updates the webhook delivery after an attempt.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, id int64, update *UpdateWebhookDeliveryMessage) error {
+	payload, err := protojson.Marshal(update.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal payload")
+	}
+	q := qb.Q().Space(`
+		UPDATE webhook_delivery
+		SET
+			updated_at = ?,
+			status = ?,
+			attempts = ?,
+			next_attempt_at = ?,
+			payload = ?
+		WHERE id = ?`, time.Now(), update.Status, update.Attempts, update.NextAttemptAt, payload, id)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery")
+	}
+	return nil
+}
+
+// DeleteFinishedWebhookDeliveries deletes the finished webhook deliveries older than the retention period.
+func (s *Store) DeleteFinishedWebhookDeliveries(ctx context.Context, retention time.Duration) (int64, error) {
+	q := qb.Q().Space(`
+		DELETE FROM webhook_delivery
+		WHERE status != ? AND updated_at < ?`, WebhookDeliveryStatusPending, time.Now().Add(-retention))
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build sql")
+	}
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete webhook deliveries")
+	}
+	return result.RowsAffected()
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]*WebhookDeliveryMessage, error) {
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery := &WebhookDeliveryMessage{}
+		var payload []byte
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+			&delivery.WebhookID,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		deliveryPayload := &storepb.WebhookDeliveryPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, deliveryPayload); err != nil {
+			return nil, err
+		}
+		delivery.Payload = deliveryPayload
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,106 @@
+//nolint:revive
+package utils
+
+import (
+	"slices"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// RolloutStrategyState is the state of the tasks in a stage under the rollout strategy.
+type RolloutStrategyState struct {
+	// Halted is true if the failed tasks reached the failure threshold.
+	Halted bool
+	// FailedCount is the number of failed tasks in the stage.
+	FailedCount int
+	// runnable is the set of task IDs whose batch is allowed to run.
+	// It is nil if all tasks are allowed to run.
+	runnable map[int]bool
+}
+
+// CanRun returns whether the batch of the task is allowed to run.
+func (s *RolloutStrategyState) CanRun(taskID int) bool {
+	if s.Halted {
+		return false
+	}
+	return s.runnable == nil || s.runnable[taskID]
+}
+
+// GetRolloutStrategyState evaluates the rollout strategy on the tasks of a stage.
+// The tasks are split into batches in the order of task IDs: the canary tasks first, then the percentage batches of the rest.
+// A batch is allowed to run after all tasks in the previous batches have finished.
+func GetRolloutStrategyState(strategy *storepb.PlanConfig_Deployment_RolloutStrategy, tasks []*store.TaskMessage) *RolloutStrategyState {
+	state := &RolloutStrategyState{}
+	if strategy == nil {
+		return state
+	}
+
+	for _, task := range tasks {
+		if isTaskFailed(task) {
+			state.FailedCount++
+		}
+	}
+	if state.FailedCount >= max(int(strategy.GetFailureThreshold()), 1) {
+		state.Halted = true
+	}
+
+	sorted := slices.Clone(tasks)
+	slices.SortFunc(sorted, func(a, b *store.TaskMessage) int {
+		return a.ID - b.ID
+	})
+	state.runnable = map[int]bool{}
+	for _, batch := range GetRolloutBatches(strategy, sorted) {
+		finished := true
+		for _, task := range batch {
+			state.runnable[task.ID] = true
+			if !isTaskFinished(task) {
+				finished = false
+			}
+		}
+		if !finished {
+			break
+		}
+	}
+	return state
+}
+
+// GetRolloutBatches splits the tasks into batches by the rollout strategy.
+func GetRolloutBatches[T any](strategy *storepb.PlanConfig_Deployment_RolloutStrategy, tasks []T) [][]T {
+	var batches [][]T
+	canaryCount := min(max(int(strategy.GetCanaryCount()), 0), len(tasks))
+	if canaryCount > 0 {
+		batches = append(batches, tasks[:canaryCount])
+	}
+	rest := tasks[canaryCount:]
+	if len(rest) == 0 {
+		return batches
+	}
+	batchSize := len(rest)
+	if percentage := int(strategy.GetBatchPercentage()); percentage > 0 && percentage < 100 {
+		// Round up so that every batch has at least one task.
+		batchSize = (len(rest)*percentage + 99) / 100
+	}
+	for len(rest) > 0 {
+		size := min(batchSize, len(rest))
+		batches = append(batches, rest[:size])
+		rest = rest[size:]
+	}
+	return batches
+}
+
+func isTaskFailed(task *store.TaskMessage) bool {
+	return !task.Payload.GetSkipped() && task.LatestTaskRunStatus == storepb.TaskRun_FAILED
+}
+
+func isTaskFinished(task *store.TaskMessage) bool {
+	if task.Payload.GetSkipped() {
+		return true
+	}
+	switch task.LatestTaskRunStatus {
+	case storepb.TaskRun_DONE, storepb.TaskRun_FAILED, storepb.TaskRun_SKIPPED:
+		return true
+	default:
+		return false
+	}
+}
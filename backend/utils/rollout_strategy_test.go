@@ -0,0 +1,151 @@
+//nolint:revive
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetRolloutBatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy *storepb.PlanConfig_Deployment_RolloutStrategy
+		count    int
+		want     [][]int
+	}{
+		{
+			name:     "no strategy",
+			strategy: nil,
+			count:    3,
+			want:     [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "canary only",
+			strategy: &storepb.PlanConfig_Deployment_RolloutStrategy{CanaryCount: 1},
+			count:    4,
+			want:     [][]int{{1}, {2, 3, 4}},
+		},
+		{
+			name:     "canary and percentage",
+			strategy: &storepb.PlanConfig_Deployment_RolloutStrategy{CanaryCount: 2, BatchPercentage: 25},
+			count:    10,
+			want:     [][]int{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}},
+		},
+		{
+			name:     "percentage rounds up",
+			strategy: &storepb.PlanConfig_Deployment_RolloutStrategy{BatchPercentage: 40},
+			count:    4,
+			want:     [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "canary exceeds tasks",
+			strategy: &storepb.PlanConfig_Deployment_RolloutStrategy{CanaryCount: 5, BatchPercentage: 50},
+			count:    2,
+			want:     [][]int{{1, 2}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var tasks []int
+			for i := 1; i <= tc.count; i++ {
+				tasks = append(tasks, i)
+			}
+			require.Equal(t, tc.want, GetRolloutBatches(tc.strategy, tasks))
+		})
+	}
+}
+
+func TestGetRolloutStrategyState(t *testing.T) {
+	newTask := func(id int, status storepb.TaskRun_Status) *store.TaskMessage {
+		return &store.TaskMessage{ID: id, LatestTaskRunStatus: status, Payload: &storepb.Task{}}
+	}
+	strategy := &storepb.PlanConfig_Deployment_RolloutStrategy{CanaryCount: 1, BatchPercentage: 50, FailureThreshold: 2}
+
+	tests := []struct {
+		name       string
+		strategy   *storepb.PlanConfig_Deployment_RolloutStrategy
+		tasks      []*store.TaskMessage
+		wantHalted bool
+		wantRun    []int
+	}{
+		{
+			name:     "no strategy",
+			strategy: nil,
+			tasks: []*store.TaskMessage{
+				newTask(1, storepb.TaskRun_FAILED),
+				newTask(2, storepb.TaskRun_STATUS_UNSPECIFIED),
+			},
+			wantRun: []int{1, 2},
+		},
+		{
+			name:     "canary is running",
+			strategy: strategy,
+			tasks: []*store.TaskMessage{
+				newTask(3, storepb.TaskRun_PENDING),
+				newTask(1, storepb.TaskRun_RUNNING),
+				newTask(2, storepb.TaskRun_PENDING),
+			},
+			wantRun: []int{1},
+		},
+		{
+			name:     "canary is done",
+			strategy: strategy,
+			tasks: []*store.TaskMessage{
+				newTask(1, storepb.TaskRun_DONE),
+				newTask(2, storepb.TaskRun_PENDING),
+				newTask(3, storepb.TaskRun_PENDING),
+				newTask(4, storepb.TaskRun_STATUS_UNSPECIFIED),
+				newTask(5, storepb.TaskRun_STATUS_UNSPECIFIED),
+			},
+			wantRun: []int{1, 2, 3},
+		},
+		{
+			name:     "failure below threshold",
+			strategy: strategy,
+			tasks: []*store.TaskMessage{
+				newTask(1, storepb.TaskRun_FAILED),
+				newTask(2, storepb.TaskRun_PENDING),
+				newTask(3, storepb.TaskRun_PENDING),
+			},
+			wantRun: []int{1, 2},
+		},
+		{
+			name:     "failure reaches threshold",
+			strategy: strategy,
+			tasks: []*store.TaskMessage{
+				newTask(1, storepb.TaskRun_FAILED),
+				newTask(2, storepb.TaskRun_FAILED),
+				newTask(3, storepb.TaskRun_PENDING),
+			},
+			wantHalted: true,
+		},
+		{
+			name:     "halt on the first failure by default",
+			strategy: &storepb.PlanConfig_Deployment_RolloutStrategy{CanaryCount: 1},
+			tasks: []*store.TaskMessage{
+				newTask(1, storepb.TaskRun_FAILED),
+				newTask(2, storepb.TaskRun_STATUS_UNSPECIFIED),
+			},
+			wantHalted: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := GetRolloutStrategyState(tc.strategy, tc.tasks)
+			require.Equal(t, tc.wantHalted, state.Halted)
+			var run []int
+			for _, task := range tc.tasks {
+				if state.CanRun(task.ID) {
+					run = append(run, task.ID)
+				}
+			}
+			require.ElementsMatch(t, tc.wantRun, run)
+		})
+	}
+}
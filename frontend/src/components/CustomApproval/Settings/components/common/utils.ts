@@ -20,6 +20,7 @@ import {
   PresetRiskLevelList,
 } from "@/types";
 import { Engine, RiskLevel } from "@/types/proto-es/v1/common_pb";
+import { PlanCheckRun_Result_LockImpactReport_LockLevel } from "@/types/proto-es/v1/plan_service_pb";
 import type { Project } from "@/types/proto-es/v1/project_service_pb";
 import { WorkspaceApprovalSetting_Rule_Source } from "@/types/proto-es/v1/setting_service_pb";
 import {
@@ -41,6 +42,9 @@ import {
   CEL_ATTRIBUTE_RESOURCE_SCHEMA_NAME,
   CEL_ATTRIBUTE_RESOURCE_TABLE_NAME,
   CEL_ATTRIBUTE_STATEMENT_AFFECTED_ROWS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL,
+  CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_SQL_TYPE,
   CEL_ATTRIBUTE_STATEMENT_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_TEXT,
@@ -79,6 +83,9 @@ const migrationFactorList: Factor[] = [
   CEL_ATTRIBUTE_STATEMENT_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_SQL_TYPE,
   CEL_ATTRIBUTE_STATEMENT_TEXT,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS,
+  CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS,
 ] as const;
 
 export const getRenderOptionFunc = (resource: {
@@ -188,6 +195,19 @@ const getSQLTypeOptions = (source: WorkspaceApprovalSetting_Rule_Source) => {
   return [];
 };
 
+const getLockLevelOptions = () => {
+  return [
+    PlanCheckRun_Result_LockImpactReport_LockLevel.SHARE_UPDATE_EXCLUSIVE,
+    PlanCheckRun_Result_LockImpactReport_LockLevel.SHARE,
+    PlanCheckRun_Result_LockImpactReport_LockLevel.SHARE_ROW_EXCLUSIVE,
+    PlanCheckRun_Result_LockImpactReport_LockLevel.ACCESS_EXCLUSIVE,
+    PlanCheckRun_Result_LockImpactReport_LockLevel.METADATA_LOCK,
+  ].map<SelectOption>((level) => {
+    const name = PlanCheckRun_Result_LockImpactReport_LockLevel[level];
+    return { label: name, value: name };
+  });
+};
+
 const getRoleOptions = () => {
   return useRoleStore()
     .roleList.filter((role) => !PRESET_WORKSPACE_ROLES.includes(role.name))
@@ -308,6 +328,9 @@ export const getApprovalOptionConfigMap = (
       case CEL_ATTRIBUTE_STATEMENT_SQL_TYPE:
         options = getSQLTypeOptions(source);
         break;
+      case CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL:
+        options = getLockLevelOptions();
+        break;
       case CEL_ATTRIBUTE_REQUEST_ROLE:
         options = getRoleOptions();
         break;
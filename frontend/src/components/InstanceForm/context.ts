@@ -198,10 +198,17 @@ export const provideInstanceFormContext = (baseContext: {
           }
           break;
         case DataSourceExternalSecret_SecretType.GCP_SECRET_MANAGER:
+        case DataSourceExternalSecret_SecretType.FILE:
           if (!ds.externalSecret.secretName) {
             return false;
           }
           break;
+        case DataSourceExternalSecret_SecretType.AZURE_KEY_VAULT:
+        case DataSourceExternalSecret_SecretType.EXEC:
+          if (!ds.externalSecret.url || !ds.externalSecret.secretName) {
+            return false;
+          }
+          break;
       }
 
       switch (ds.externalSecret.authType) {
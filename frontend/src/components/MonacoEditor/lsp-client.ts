@@ -7,6 +7,7 @@ import {
   WebSocketMessageReader,
   WebSocketMessageWriter,
 } from "vscode-ws-jsonrpc";
+import { workspace } from "vscode";
 import { shallowReactive, toRef } from "vue";
 import { sleep } from "@/utils";
 import {
@@ -236,9 +237,27 @@ const createWebSocketAndStartClient = (): {
   };
 };
 
+// The definitions of the database objects (views, functions, etc.) are
+// served by the language server as virtual documents with the "bytebase"
+// scheme, so "Go to Definition" can open them in a peek view.
+const registerDefinitionContentProvider = () => {
+  workspace.registerTextDocumentContentProvider("bytebase", {
+    provideTextDocumentContent: async (uri, token) => {
+      const client = await initializeLSPClient();
+      const result = await client.sendRequest<{ text: string }>(
+        "workspace/textDocumentContent",
+        { uri: uri.toString() },
+        token
+      );
+      return result.text;
+    },
+  });
+};
+
 const initializeRunner = async () => {
   const client = await createWebSocketAndStartClient().languageClient;
   state.client = client;
+  registerDefinitionContentProvider();
   return client;
 };
 
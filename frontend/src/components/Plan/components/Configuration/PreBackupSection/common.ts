@@ -6,4 +6,5 @@ export const BACKUP_AVAILABLE_ENGINES = [
   Engine.MSSQL,
   Engine.ORACLE,
   Engine.POSTGRES,
+  Engine.SNOWFLAKE,
 ];
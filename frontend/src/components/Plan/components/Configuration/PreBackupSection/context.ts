@@ -32,6 +32,7 @@ export const PRE_BACKUP_AVAILABLE_ENGINES = [
   Engine.MSSQL,
   Engine.ORACLE,
   Engine.POSTGRES,
+  Engine.SNOWFLAKE,
 ];
 
 const KEY = Symbol(
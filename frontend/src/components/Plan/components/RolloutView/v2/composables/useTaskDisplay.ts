@@ -79,6 +79,10 @@ export const useTaskDisplay = (
         return t("task.waiting.parallel-tasks-limit");
       case "task":
         return t("task.waiting.blocking-task");
+      case "rolloutWindow":
+        return t("task.waiting.rollout-window");
+      case "rolloutBatch":
+        return t("task.waiting.rollout-batch");
       default:
         return "";
     }
@@ -15,6 +15,9 @@ import {
   CEL_ATTRIBUTE_RESOURCE_TABLE_NAME,
   CEL_ATTRIBUTE_SOURCE,
   CEL_ATTRIBUTE_STATEMENT_AFFECTED_ROWS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL,
+  CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_SQL_TYPE,
   CEL_ATTRIBUTE_STATEMENT_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_TEXT,
@@ -24,6 +27,8 @@ export const NumberFactorList = [
   // Risk related factors
   CEL_ATTRIBUTE_STATEMENT_AFFECTED_ROWS,
   CEL_ATTRIBUTE_STATEMENT_TABLE_ROWS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS,
+  CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS,
 
   // Request query/export factors
   CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS,
@@ -43,6 +48,7 @@ export const StringFactorList = [
   CEL_ATTRIBUTE_RESOURCE_DB_ENGINE,
   CEL_ATTRIBUTE_STATEMENT_SQL_TYPE,
   CEL_ATTRIBUTE_STATEMENT_TEXT,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL,
   CEL_ATTRIBUTE_REQUEST_ROLE,
 
   // Grant request issue related factors
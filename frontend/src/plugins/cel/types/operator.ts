@@ -16,6 +16,9 @@ import {
   CEL_ATTRIBUTE_RESOURCE_TABLE_NAME,
   CEL_ATTRIBUTE_SOURCE,
   CEL_ATTRIBUTE_STATEMENT_AFFECTED_ROWS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS,
+  CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL,
+  CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_SQL_TYPE,
   CEL_ATTRIBUTE_STATEMENT_TABLE_ROWS,
   CEL_ATTRIBUTE_STATEMENT_TEXT,
@@ -85,6 +88,14 @@ const OperatorList: Record<Factor, Operator[]> = {
     ...EqualityOperatorList,
     ...CompareOperatorList,
   ]),
+  [CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS]: uniq([
+    ...EqualityOperatorList,
+    ...CompareOperatorList,
+  ]),
+  [CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS]: uniq([
+    ...EqualityOperatorList,
+    ...CompareOperatorList,
+  ]),
 
   [CEL_ATTRIBUTE_LEVEL]: uniq([
     ...EqualityOperatorList,
@@ -140,6 +151,10 @@ const OperatorList: Record<Factor, Operator[]> = {
     ...StringOperatorList,
   ]),
   [CEL_ATTRIBUTE_STATEMENT_TEXT]: uniq([...StringOperatorList]),
+  [CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL]: uniq([
+    ...EqualityOperatorList,
+    ...CollectionOperatorList,
+  ]),
   [CEL_ATTRIBUTE_RESOURCE_CLASSIFICATION_LEVEL]: uniq([
     ...CollectionOperatorList,
   ]),
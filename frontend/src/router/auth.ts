@@ -10,6 +10,7 @@ export const AUTH_PASSWORD_RESET_MODULE = "auth.password.reset";
 export const AUTH_PASSWORD_FORGOT_MODULE = "auth.password.forgot";
 export const AUTH_OAUTH_CALLBACK_MODULE = "auth.oauth.callback";
 export const AUTH_OIDC_CALLBACK_MODULE = "auth.oidc.callback";
+export const AUTH_SAML_CALLBACK_MODULE = "auth.saml.callback";
 export const AUTH_IDP_INIT_MODULE = "auth.idp.init";
 export const AUTH_2FA_SETUP_MODULE = "auth.2fa.setup";
 
@@ -75,6 +76,11 @@ const authRoutes: RouteRecordRaw[] = [
     name: AUTH_OIDC_CALLBACK_MODULE,
     component: () => import("@/views/OAuthCallback.vue"),
   },
+  {
+    path: "/saml/callback",
+    name: AUTH_SAML_CALLBACK_MODULE,
+    component: () => import("@/views/OAuthCallback.vue"),
+  },
   {
     path: "/2fa/setup",
     name: AUTH_2FA_SETUP_MODULE,
@@ -22,6 +22,7 @@ import authRoutes, {
   AUTH_OAUTH_CALLBACK_MODULE,
   AUTH_OIDC_CALLBACK_MODULE,
   AUTH_PASSWORD_RESET_MODULE,
+  AUTH_SAML_CALLBACK_MODULE,
   AUTH_SIGNIN_MODULE,
 } from "./auth";
 import dashboardRoutes from "./dashboard";
@@ -86,7 +87,8 @@ router.beforeEach((to, from, next) => {
   // Auth callbacks can be accessed directly
   if (
     to.name === AUTH_OAUTH_CALLBACK_MODULE ||
-    to.name === AUTH_OIDC_CALLBACK_MODULE
+    to.name === AUTH_OIDC_CALLBACK_MODULE ||
+    to.name === AUTH_SAML_CALLBACK_MODULE
   ) {
     next();
     return;
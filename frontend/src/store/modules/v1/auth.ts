@@ -107,6 +107,8 @@ export const useAuthStore = defineStore("auth_v1", () => {
         name: AUTH_MFA_MODULE,
         query: {
           mfaTempToken: resp.mfaTempToken,
+          otp: resp.otpEnabled ? "1" : undefined,
+          webauthn: resp.webauthnEnabled ? "1" : undefined,
           redirect: nextPage,
         },
       });
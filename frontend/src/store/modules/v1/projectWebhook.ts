@@ -4,6 +4,7 @@ import { projectServiceClientConnect } from "@/grpcweb";
 import type { IdType } from "@/types";
 import {
   AddWebhookRequestSchema,
+  ListWebhookDeliveriesRequestSchema,
   type Project,
   RemoveWebhookRequestSchema,
   TestWebhookRequestSchema,
@@ -60,6 +61,20 @@ export const useProjectWebhookV1Store = defineStore("projectWebhook_v1", () => {
       error: response.error,
     };
   };
+  const listWebhookDeliveries = async (
+    webhook: string,
+    pageSize: number,
+    pageToken?: string
+  ) => {
+    const request = create(ListWebhookDeliveriesRequestSchema, {
+      parent: webhook,
+      pageSize,
+      pageToken,
+    });
+    const response =
+      await projectServiceClientConnect.listWebhookDeliveries(request);
+    return response;
+  };
 
   return {
     getProjectWebhookFromProjectById,
@@ -67,5 +82,6 @@ export const useProjectWebhookV1Store = defineStore("projectWebhook_v1", () => {
     updateProjectWebhook,
     deleteProjectWebhook,
     testProjectWebhook,
+    listWebhookDeliveries,
   };
 });
@@ -49,8 +49,24 @@ export const useSQLStore = defineStore("sql", () => {
     return newResponse.content;
   };
 
+  // Streams the data exported by a rollout or stage, so that large exports
+  // are downloaded in chunks.
+  const exportDataStream = async (params: ExportRequest) => {
+    const chunks: Uint8Array[] = [];
+    for await (const response of sqlServiceClientConnect.exportStream(params, {
+      // Won't jump to 403 page when permission denied.
+      contextValues: createContextValues().set(ignoredCodesContextKey, [
+        Code.PermissionDenied,
+      ]),
+    })) {
+      chunks.push(response.content);
+    }
+    return chunks;
+  };
+
   return {
     query,
     exportData,
+    exportDataStream,
   };
 });
@@ -39,6 +39,7 @@ export type Permission =
   | "bb.issueComments.create"
   | "bb.issueComments.list"
   | "bb.issueComments.update"
+  | "bb.issues.breakGlass"
   | "bb.issues.create"
   | "bb.issues.get"
   | "bb.issues.list"
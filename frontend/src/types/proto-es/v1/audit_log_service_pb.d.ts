@@ -389,6 +389,14 @@ export declare type AuditData = Message<"bytebase.v1.AuditData"> & {
    * @generated from field: bytebase.v1.PolicyDelta policy_delta = 1;
    */
   policyDelta?: PolicyDelta;
+
+  /**
+   * The break-glass grant issue that the request runs under.
+   * Format: projects/{project}/issues/{issue}
+   *
+   * @generated from field: string break_glass_issue = 2;
+   */
+  breakGlassIssue: string;
 };
 
 /**
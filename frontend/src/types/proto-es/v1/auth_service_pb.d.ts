@@ -72,6 +72,14 @@ export declare type LoginRequest = Message<"bytebase.v1.LoginRequest"> & {
    * @generated from field: optional string mfa_temp_token = 8;
    */
   mfaTempToken?: string;
+
+  /**
+   * The webauthn_assertion is the AuthenticationResponseJSON returned by PublicKeyCredential.toJSON()
+   * after navigator.credentials.get(), used to verify the user's identity by MFA.
+   *
+   * @generated from field: optional string webauthn_assertion = 9;
+   */
+  webauthnAssertion?: string;
 };
 
 /**
@@ -105,6 +113,14 @@ export declare type IdentityProviderContext = Message<"bytebase.v1.IdentityProvi
      */
     value: OIDCIdentityProviderContext;
     case: "oidcContext";
+  } | {
+    /**
+     * SAML 2.0 authentication context.
+     *
+     * @generated from field: bytebase.v1.SAMLIdentityProviderContext saml_context = 3;
+     */
+    value: SAMLIdentityProviderContext;
+    case: "samlContext";
   } | { case: undefined; value?: undefined };
 };
 
@@ -154,6 +170,26 @@ export declare type OIDCIdentityProviderContext = Message<"bytebase.v1.OIDCIdent
  */
 export declare const OIDCIdentityProviderContextSchema: GenMessage<OIDCIdentityProviderContext>;
 
+/**
+ * SAML 2.0 authentication context.
+ *
+ * @generated from message bytebase.v1.SAMLIdentityProviderContext
+ */
+export declare type SAMLIdentityProviderContext = Message<"bytebase.v1.SAMLIdentityProviderContext"> & {
+  /**
+   * The base64 encoded SAMLResponse posted by the identity provider to the assertion consumer service.
+   *
+   * @generated from field: string saml_response = 1;
+   */
+  samlResponse: string;
+};
+
+/**
+ * Describes the message bytebase.v1.SAMLIdentityProviderContext.
+ * Use `create(SAMLIdentityProviderContextSchema)` to create a new message.
+ */
+export declare const SAMLIdentityProviderContextSchema: GenMessage<SAMLIdentityProviderContext>;
+
 /**
  * @generated from message bytebase.v1.LoginResponse
  */
@@ -185,6 +221,20 @@ export declare type LoginResponse = Message<"bytebase.v1.LoginResponse"> & {
    * @generated from field: bytebase.v1.User user = 4;
    */
   user?: User;
+
+  /**
+   * Whether the user can verify MFA with the WebAuthn credentials, set with mfa_temp_token.
+   *
+   * @generated from field: bool webauthn_enabled = 5;
+   */
+  webauthnEnabled: boolean;
+
+  /**
+   * Whether the user can verify MFA with the OTP code or recovery code, set with mfa_temp_token.
+   *
+   * @generated from field: bool otp_enabled = 6;
+   */
+  otpEnabled: boolean;
 };
 
 /**
@@ -207,6 +257,43 @@ export declare type LogoutRequest = Message<"bytebase.v1.LogoutRequest"> & {
  */
 export declare const LogoutRequestSchema: GenMessage<LogoutRequest>;
 
+/**
+ * @generated from message bytebase.v1.BeginWebAuthnLoginRequest
+ */
+export declare type BeginWebAuthnLoginRequest = Message<"bytebase.v1.BeginWebAuthnLoginRequest"> & {
+  /**
+   * The mfa_temp_token returned by the first step of the login.
+   *
+   * @generated from field: string mfa_temp_token = 1;
+   */
+  mfaTempToken: string;
+};
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnLoginRequest.
+ * Use `create(BeginWebAuthnLoginRequestSchema)` to create a new message.
+ */
+export declare const BeginWebAuthnLoginRequestSchema: GenMessage<BeginWebAuthnLoginRequest>;
+
+/**
+ * @generated from message bytebase.v1.BeginWebAuthnLoginResponse
+ */
+export declare type BeginWebAuthnLoginResponse = Message<"bytebase.v1.BeginWebAuthnLoginResponse"> & {
+  /**
+   * The PublicKeyCredentialRequestOptions in the WebAuthn JSON format,
+   * which can be parsed by PublicKeyCredential.parseRequestOptionsFromJSON() in the browser.
+   *
+   * @generated from field: string options = 1;
+   */
+  options: string;
+};
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnLoginResponse.
+ * Use `create(BeginWebAuthnLoginResponseSchema)` to create a new message.
+ */
+export declare const BeginWebAuthnLoginResponseSchema: GenMessage<BeginWebAuthnLoginResponse>;
+
 /**
  * AuthService handles user authentication operations.
  *
@@ -235,5 +322,16 @@ export declare const AuthService: GenService<{
     input: typeof LogoutRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * Starts the WebAuthn assertion ceremony for the second step of the MFA login.
+   * Permissions required: None
+   *
+   * @generated from rpc bytebase.v1.AuthService.BeginWebAuthnLogin
+   */
+  beginWebAuthnLogin: {
+    methodKind: "unary";
+    input: typeof BeginWebAuthnLoginRequestSchema;
+    output: typeof BeginWebAuthnLoginResponseSchema;
+  },
 }>;
 
@@ -4,6 +4,7 @@
 
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_google_api_annotations } from "../google/api/annotations_pb";
+import { file_google_api_field_behavior } from "../google/api/field_behavior_pb";
 import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
 import { file_v1_annotation } from "./annotation_pb";
 import { file_v1_user_service } from "./user_service_pb";
@@ -12,7 +13,7 @@ import { file_v1_user_service } from "./user_service_pb";
  * Describes the file v1/auth_service.proto.
  */
 export const file_v1_auth_service = /*@__PURE__*/
-  fileDesc("ChV2MS9hdXRoX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIosCCgxMb2dpblJlcXVlc3QSDQoFZW1haWwYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSCwoDd2ViGAMgASgIEhAKCGlkcF9uYW1lGAQgASgJEjkKC2lkcF9jb250ZXh0GAUgASgLMiQuYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlckNvbnRleHQSFQoIb3RwX2NvZGUYBiABKAlIAIgBARIaCg1yZWNvdmVyeV9jb2RlGAcgASgJSAGIAQESGwoObWZhX3RlbXBfdG9rZW4YCCABKAlIAogBAUILCglfb3RwX2NvZGVCEAoOX3JlY292ZXJ5X2NvZGVCEQoPX21mYV90ZW1wX3Rva2VuIqwBChdJZGVudGl0eVByb3ZpZGVyQ29udGV4dBJECg5vYXV0aDJfY29udGV4dBgBIAEoCzIqLmJ5dGViYXNlLnYxLk9BdXRoMklkZW50aXR5UHJvdmlkZXJDb250ZXh0SAASQAoMb2lkY19jb250ZXh0GAIgASgLMiguYnl0ZWJhc2UudjEuT0lEQ0lkZW50aXR5UHJvdmlkZXJDb250ZXh0SABCCQoHY29udGV4dCItCh1PQXV0aDJJZGVudGl0eVByb3ZpZGVyQ29udGV4dBIMCgRjb2RlGAEgASgJIisKG09JRENJZGVudGl0eVByb3ZpZGVyQ29udGV4dBIMCgRjb2RlGAEgASgJIo8BCg1Mb2dpblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEhsKDm1mYV90ZW1wX3Rva2VuGAIgASgJSACIAQESHgoWcmVxdWlyZV9yZXNldF9wYXNzd29yZBgDIAEoCBIfCgR1c2VyGAQgASgLMhEuYnl0ZWJhc2UudjEuVXNlckIRCg9fbWZhX3RlbXBfdG9rZW4iDwoNTG9nb3V0UmVxdWVzdDLSAQoLQXV0aFNlcnZpY2USYQoFTG9naW4SGS5ieXRlYmFzZS52MS5Mb2dpblJlcXVlc3QaGi5ieXRlYmFzZS52MS5Mb2dpblJlc3BvbnNlIiGA6jABmOowAYLT5JMCEzoBKiIOL3YxL2F1dGgvbG9naW4SYAoGTG9nb3V0EhouYnl0ZWJhc2UudjEuTG9nb3V0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIigOowAZjqMAGC0+STAhQ6ASoiDy92MS9hdXRoL2xvZ291dEKmAQoPY29tLmJ5dGViYXNlLnYxQhBBdXRoU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_protobuf_empty, file_v1_annotation, file_v1_user_service]);
+  fileDesc("ChV2MS9hdXRoX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIsMCCgxMb2dpblJlcXVlc3QSDQoFZW1haWwYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSCwoDd2ViGAMgASgIEhAKCGlkcF9uYW1lGAQgASgJEjkKC2lkcF9jb250ZXh0GAUgASgLMiQuYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlckNvbnRleHQSFQoIb3RwX2NvZGUYBiABKAlIAIgBARIaCg1yZWNvdmVyeV9jb2RlGAcgASgJSAGIAQESGwoObWZhX3RlbXBfdG9rZW4YCCABKAlIAogBARIfChJ3ZWJhdXRobl9hc3NlcnRpb24YCSABKAlIA4gBAUILCglfb3RwX2NvZGVCEAoOX3JlY292ZXJ5X2NvZGVCEQoPX21mYV90ZW1wX3Rva2VuQhUKE193ZWJhdXRobl9hc3NlcnRpb24i7gEKF0lkZW50aXR5UHJvdmlkZXJDb250ZXh0EkQKDm9hdXRoMl9jb250ZXh0GAEgASgLMiouYnl0ZWJhc2UudjEuT0F1dGgySWRlbnRpdHlQcm92aWRlckNvbnRleHRIABJACgxvaWRjX2NvbnRleHQYAiABKAsyKC5ieXRlYmFzZS52MS5PSURDSWRlbnRpdHlQcm92aWRlckNvbnRleHRIABJACgxzYW1sX2NvbnRleHQYAyABKAsyKC5ieXRlYmFzZS52MS5TQU1MSWRlbnRpdHlQcm92aWRlckNvbnRleHRIAEIJCgdjb250ZXh0Ii0KHU9BdXRoMklkZW50aXR5UHJvdmlkZXJDb250ZXh0EgwKBGNvZGUYASABKAkiKwobT0lEQ0lkZW50aXR5UHJvdmlkZXJDb250ZXh0EgwKBGNvZGUYASABKAkiNAobU0FNTElkZW50aXR5UHJvdmlkZXJDb250ZXh0EhUKDXNhbWxfcmVzcG9uc2UYASABKAkivgEKDUxvZ2luUmVzcG9uc2USDQoFdG9rZW4YASABKAkSGwoObWZhX3RlbXBfdG9rZW4YAiABKAlIAIgBARIeChZyZXF1aXJlX3Jlc2V0X3Bhc3N3b3JkGAMgASgIEh8KBHVzZXIYBCABKAsyES5ieXRlYmFzZS52MS5Vc2VyEhgKEHdlYmF1dGhuX2VuYWJsZWQYBSABKAgSEwoLb3RwX2VuYWJsZWQYBiABKAhCEQoPX21mYV90ZW1wX3Rva2VuIg8KDUxvZ291dFJlcXVlc3QiOAoZQmVnaW5XZWJBdXRobkxvZ2luUmVxdWVzdBIbCg5tZmFfdGVtcF90b2tlbhgBIAEoCUID4EECIi0KGkJlZ2luV2ViQXV0aG5Mb2dpblJlc3BvbnNlEg8KB29wdGlvbnMYASABKAky4gIKC0F1dGhTZXJ2aWNlEmEKBUxvZ2luEhkuYnl0ZWJhc2UudjEuTG9naW5SZXF1ZXN0GhouYnl0ZWJhc2UudjEuTG9naW5SZXNwb25zZSIhgOowAZjqMAGC0+STAhM6ASoiDi92MS9hdXRoL2xvZ2luEmAKBkxvZ291dBIaLmJ5dGViYXNlLnYxLkxvZ291dFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiIoDqMAGY6jABgtPkkwIUOgEqIg8vdjEvYXV0aC9sb2dvdXQSjQEKEkJlZ2luV2ViQXV0aG5Mb2dpbhImLmJ5dGViYXNlLnYxLkJlZ2luV2ViQXV0aG5Mb2dpblJlcXVlc3QaJy5ieXRlYmFzZS52MS5CZWdpbldlYkF1dGhuTG9naW5SZXNwb25zZSImgOowAYLT5JMCHDoBKiIXL3YxL2F1dGgvd2ViYXV0aG46YmVnaW5CpgEKD2NvbS5ieXRlYmFzZS52MUIQQXV0aFNlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_empty, file_v1_annotation, file_v1_user_service]);
 
 /**
  * Describes the message bytebase.v1.LoginRequest.
@@ -42,19 +43,40 @@ export const OAuth2IdentityProviderContextSchema = /*@__PURE__*/
 export const OIDCIdentityProviderContextSchema = /*@__PURE__*/
   messageDesc(file_v1_auth_service, 3);
 
+/**
+ * Describes the message bytebase.v1.SAMLIdentityProviderContext.
+ * Use `create(SAMLIdentityProviderContextSchema)` to create a new message.
+ */
+export const SAMLIdentityProviderContextSchema = /*@__PURE__*/
+  messageDesc(file_v1_auth_service, 4);
+
 /**
  * Describes the message bytebase.v1.LoginResponse.
  * Use `create(LoginResponseSchema)` to create a new message.
  */
 export const LoginResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_auth_service, 4);
+  messageDesc(file_v1_auth_service, 5);
 
 /**
  * Describes the message bytebase.v1.LogoutRequest.
  * Use `create(LogoutRequestSchema)` to create a new message.
  */
 export const LogoutRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_auth_service, 5);
+  messageDesc(file_v1_auth_service, 6);
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnLoginRequest.
+ * Use `create(BeginWebAuthnLoginRequestSchema)` to create a new message.
+ */
+export const BeginWebAuthnLoginRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_auth_service, 7);
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnLoginResponse.
+ * Use `create(BeginWebAuthnLoginResponseSchema)` to create a new message.
+ */
+export const BeginWebAuthnLoginResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_auth_service, 8);
 
 /**
  * AuthService handles user authentication operations.
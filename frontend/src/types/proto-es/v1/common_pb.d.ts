@@ -398,6 +398,20 @@ export enum ExportFormat {
    * @generated from enum value: XLSX = 4;
    */
   XLSX = 4,
+
+  /**
+   * Apache Parquet columnar format.
+   *
+   * @generated from enum value: PARQUET = 5;
+   */
+  PARQUET = 5,
+
+  /**
+   * Apache Avro object container file format.
+   *
+   * @generated from enum value: AVRO = 6;
+   */
+  AVRO = 6,
 }
 
 /**
@@ -3358,6 +3358,345 @@ export enum Changelog_Type {
  */
 export declare const Changelog_TypeSchema: GenEnum<Changelog_Type>;
 
+/**
+ * @generated from message bytebase.v1.GetSchemaDriftRequest
+ */
+export declare type GetSchemaDriftRequest = Message<"bytebase.v1.GetSchemaDriftRequest"> & {
+  /**
+   * The name of the database to retrieve the schema drift.
+   * Format: instances/{instance}/databases/{database}/schemaDrift
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message bytebase.v1.GetSchemaDriftRequest.
+ * Use `create(GetSchemaDriftRequestSchema)` to create a new message.
+ */
+export declare const GetSchemaDriftRequestSchema: GenMessage<GetSchemaDriftRequest>;
+
+/**
+ * SchemaDrift is the object-level difference from the schema recorded by the latest changelog
+ * to the synced schema of the database.
+ *
+ * @generated from message bytebase.v1.SchemaDrift
+ */
+export declare type SchemaDrift = Message<"bytebase.v1.SchemaDrift"> & {
+  /**
+   * The name of the schema drift.
+   * Format: instances/{instance}/databases/{database}/schemaDrift
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether the database is drifted as of the last sync.
+   *
+   * @generated from field: bool drifted = 2;
+   */
+  drifted: boolean;
+
+  /**
+   * The changelog whose recorded schema the database drifted from.
+   * Format: instances/{instance}/databases/{database}/changelogs/{changelog}
+   *
+   * @generated from field: string changelog = 3;
+   */
+  changelog: string;
+
+  /**
+   * The time when the drift was detected.
+   *
+   * @generated from field: google.protobuf.Timestamp detect_time = 4;
+   */
+  detectTime?: Timestamp;
+
+  /**
+   * The drifted objects.
+   *
+   * @generated from field: repeated bytebase.v1.SchemaDriftObject objects = 5;
+   */
+  objects: SchemaDriftObject[];
+};
+
+/**
+ * Describes the message bytebase.v1.SchemaDrift.
+ * Use `create(SchemaDriftSchema)` to create a new message.
+ */
+export declare const SchemaDriftSchema: GenMessage<SchemaDrift>;
+
+/**
+ * SchemaDriftObject is a database object drifted from the recorded schema.
+ *
+ * @generated from message bytebase.v1.SchemaDriftObject
+ */
+export declare type SchemaDriftObject = Message<"bytebase.v1.SchemaDriftObject"> & {
+  /**
+   * The type of the object.
+   *
+   * @generated from field: bytebase.v1.SchemaDriftObject.Type type = 1;
+   */
+  type: SchemaDriftObject_Type;
+
+  /**
+   * The kind of the drift.
+   *
+   * @generated from field: bytebase.v1.SchemaDriftObject.Action action = 2;
+   */
+  action: SchemaDriftObject_Action;
+
+  /**
+   * The schema of the object, which is empty for the database-level objects.
+   *
+   * @generated from field: string schema = 3;
+   */
+  schema: string;
+
+  /**
+   * The table of the column, index, constraint and trigger.
+   *
+   * @generated from field: string table = 4;
+   */
+  table: string;
+
+  /**
+   * The name of the object.
+   *
+   * @generated from field: string name = 5;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message bytebase.v1.SchemaDriftObject.
+ * Use `create(SchemaDriftObjectSchema)` to create a new message.
+ */
+export declare const SchemaDriftObjectSchema: GenMessage<SchemaDriftObject>;
+
+/**
+ * The type of the database object.
+ *
+ * @generated from enum bytebase.v1.SchemaDriftObject.Type
+ */
+export enum SchemaDriftObject_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHEMA = 1;
+   */
+  SCHEMA = 1,
+
+  /**
+   * @generated from enum value: TABLE = 2;
+   */
+  TABLE = 2,
+
+  /**
+   * @generated from enum value: COLUMN = 3;
+   */
+  COLUMN = 3,
+
+  /**
+   * @generated from enum value: INDEX = 4;
+   */
+  INDEX = 4,
+
+  /**
+   * @generated from enum value: CONSTRAINT = 5;
+   */
+  CONSTRAINT = 5,
+
+  /**
+   * @generated from enum value: VIEW = 6;
+   */
+  VIEW = 6,
+
+  /**
+   * @generated from enum value: MATERIALIZED_VIEW = 7;
+   */
+  MATERIALIZED_VIEW = 7,
+
+  /**
+   * @generated from enum value: FUNCTION = 8;
+   */
+  FUNCTION = 8,
+
+  /**
+   * @generated from enum value: PROCEDURE = 9;
+   */
+  PROCEDURE = 9,
+
+  /**
+   * @generated from enum value: TRIGGER = 10;
+   */
+  TRIGGER = 10,
+
+  /**
+   * @generated from enum value: SEQUENCE = 11;
+   */
+  SEQUENCE = 11,
+
+  /**
+   * @generated from enum value: ENUM_TYPE = 12;
+   */
+  ENUM_TYPE = 12,
+
+  /**
+   * @generated from enum value: EXTENSION = 13;
+   */
+  EXTENSION = 13,
+
+  /**
+   * @generated from enum value: EVENT_TRIGGER = 14;
+   */
+  EVENT_TRIGGER = 14,
+
+  /**
+   * @generated from enum value: EVENT = 15;
+   */
+  EVENT = 15,
+}
+
+/**
+ * Describes the enum bytebase.v1.SchemaDriftObject.Type.
+ */
+export declare const SchemaDriftObject_TypeSchema: GenEnum<SchemaDriftObject_Type>;
+
+/**
+ * The kind of the drift.
+ *
+ * @generated from enum bytebase.v1.SchemaDriftObject.Action
+ */
+export enum SchemaDriftObject_Action {
+  /**
+   * @generated from enum value: ACTION_UNSPECIFIED = 0;
+   */
+  ACTION_UNSPECIFIED = 0,
+
+  /**
+   * The object exists in the database but not in the recorded schema.
+   *
+   * @generated from enum value: ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * The object exists in the recorded schema but not in the database.
+   *
+   * @generated from enum value: REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * The object exists in both but differs.
+   *
+   * @generated from enum value: CHANGED = 3;
+   */
+  CHANGED = 3,
+}
+
+/**
+ * Describes the enum bytebase.v1.SchemaDriftObject.Action.
+ */
+export declare const SchemaDriftObject_ActionSchema: GenEnum<SchemaDriftObject_Action>;
+
+/**
+ * @generated from message bytebase.v1.ReconcileSchemaDriftRequest
+ */
+export declare type ReconcileSchemaDriftRequest = Message<"bytebase.v1.ReconcileSchemaDriftRequest"> & {
+  /**
+   * The name of the schema drift to reconcile.
+   * Format: instances/{instance}/databases/{database}/schemaDrift
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The strategy to reconcile the schema drift.
+   *
+   * @generated from field: bytebase.v1.ReconcileSchemaDriftRequest.Strategy strategy = 2;
+   */
+  strategy: ReconcileSchemaDriftRequest_Strategy;
+};
+
+/**
+ * Describes the message bytebase.v1.ReconcileSchemaDriftRequest.
+ * Use `create(ReconcileSchemaDriftRequestSchema)` to create a new message.
+ */
+export declare const ReconcileSchemaDriftRequestSchema: GenMessage<ReconcileSchemaDriftRequest>;
+
+/**
+ * @generated from enum bytebase.v1.ReconcileSchemaDriftRequest.Strategy
+ */
+export enum ReconcileSchemaDriftRequest_Strategy {
+  /**
+   * @generated from enum value: STRATEGY_UNSPECIFIED = 0;
+   */
+  STRATEGY_UNSPECIFIED = 0,
+
+  /**
+   * Create a plan with the statement migrating the database back to the schema recorded by the latest changelog.
+   * The plan goes through the plan checks and the approval flow as usual.
+   *
+   * @generated from enum value: REVERT = 1;
+   */
+  REVERT = 1,
+
+  /**
+   * Record the drifted schema as a baseline changelog, with the statement migrating the recorded schema
+   * to the drifted one as its content, or the drifted schema if the statement cannot be generated for the engine.
+   *
+   * @generated from enum value: ADOPT = 2;
+   */
+  ADOPT = 2,
+}
+
+/**
+ * Describes the enum bytebase.v1.ReconcileSchemaDriftRequest.Strategy.
+ */
+export declare const ReconcileSchemaDriftRequest_StrategySchema: GenEnum<ReconcileSchemaDriftRequest_Strategy>;
+
+/**
+ * @generated from message bytebase.v1.ReconcileSchemaDriftResponse
+ */
+export declare type ReconcileSchemaDriftResponse = Message<"bytebase.v1.ReconcileSchemaDriftResponse"> & {
+  /**
+   * The plan reverting the schema drift, set for the REVERT strategy.
+   * Format: projects/{project}/plans/{plan}
+   *
+   * @generated from field: string plan = 1;
+   */
+  plan: string;
+
+  /**
+   * The baseline changelog adopting the schema drift, set for the ADOPT strategy.
+   * Format: instances/{instance}/databases/{database}/changelogs/{changelog}
+   *
+   * @generated from field: string changelog = 2;
+   */
+  changelog: string;
+
+  /**
+   * The generated statement.
+   *
+   * @generated from field: string statement = 3;
+   */
+  statement: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ReconcileSchemaDriftResponse.
+ * Use `create(ReconcileSchemaDriftResponseSchema)` to create a new message.
+ */
+export declare const ReconcileSchemaDriftResponseSchema: GenMessage<ReconcileSchemaDriftResponse>;
+
 /**
  * @generated from message bytebase.v1.GetSchemaStringRequest
  */
@@ -3652,6 +3991,29 @@ export declare const DatabaseService: GenService<{
     input: typeof GetChangelogRequestSchema;
     output: typeof ChangelogSchema;
   },
+  /**
+   * Retrieves the objects drifted from the schema recorded by the latest changelog.
+   * Permissions required: bb.databases.getSchema
+   *
+   * @generated from rpc bytebase.v1.DatabaseService.GetSchemaDrift
+   */
+  getSchemaDrift: {
+    methodKind: "unary";
+    input: typeof GetSchemaDriftRequestSchema;
+    output: typeof SchemaDriftSchema;
+  },
+  /**
+   * Generates the statement reconciling the schema drift, and either creates a plan to revert the drift,
+   * or adopts the drifted schema as the new baseline.
+   * Permissions required: bb.databases.update, bb.plans.create
+   *
+   * @generated from rpc bytebase.v1.DatabaseService.ReconcileSchemaDrift
+   */
+  reconcileSchemaDrift: {
+    methodKind: "unary";
+    input: typeof ReconcileSchemaDriftRequestSchema;
+    output: typeof ReconcileSchemaDriftResponseSchema;
+  },
   /**
    * Generates schema DDL for a database object.
    * Permissions required: bb.databases.getSchema
@@ -16,7 +16,7 @@ import { file_v1_instance_service } from "./instance_service_pb";
  * Describes the file v1/database_service.proto.
  */
 export const file_v1_database_service = /*@__PURE__*/
-  fileDesc("Chl2MS9kYXRhYmFzZV9zZXJ2aWNlLnByb3RvEgtieXRlYmFzZS52MSJBChJHZXREYXRhYmFzZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2UidwoYQmF0Y2hHZXREYXRhYmFzZXNSZXF1ZXN0Ei0KBnBhcmVudBgBIAEoCUId4EEC+kEXEhVieXRlYmFzZS5jb20vRGF0YWJhc2USLAoFbmFtZXMYAiADKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlIkUKGUJhdGNoR2V0RGF0YWJhc2VzUmVzcG9uc2USKAoJZGF0YWJhc2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuRGF0YWJhc2UikgEKFExpc3REYXRhYmFzZXNSZXF1ZXN0Ei0KBnBhcmVudBgBIAEoCUId4EEC+kEXEhVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJEhQKDHNob3dfZGVsZXRlZBgFIAEoCCJaChVMaXN0RGF0YWJhc2VzUmVzcG9uc2USKAoJZGF0YWJhc2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuRGF0YWJhc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIo0BChVVcGRhdGVEYXRhYmFzZVJlcXVlc3QSLAoIZGF0YWJhc2UYASABKAsyFS5ieXRlYmFzZS52MS5EYXRhYmFzZUID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1hbGxvd19taXNzaW5nGAMgASgIImgKG0JhdGNoVXBkYXRlRGF0YWJhc2VzUmVxdWVzdBIOCgZwYXJlbnQYASABKAkSOQoIcmVxdWVzdHMYAiADKAsyIi5ieXRlYmFzZS52MS5VcGRhdGVEYXRhYmFzZVJlcXVlc3RCA+BBAiJIChxCYXRjaFVwZGF0ZURhdGFiYXNlc1Jlc3BvbnNlEigKCWRhdGFiYXNlcxgBIAMoCzIVLmJ5dGViYXNlLnYxLkRhdGFiYXNlIlkKGUJhdGNoU3luY0RhdGFiYXNlc1JlcXVlc3QSDgoGcGFyZW50GAEgASgJEiwKBW5hbWVzGAIgAygJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZSIcChpCYXRjaFN5bmNEYXRhYmFzZXNSZXNwb25zZSJCChNTeW5jRGF0YWJhc2VSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlIhYKFFN5bmNEYXRhYmFzZVJlc3BvbnNlInAKGkdldERhdGFiYXNlTWV0YWRhdGFSZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodYnl0ZWJhc2UuY29tL0RhdGFiYXNlTWV0YWRhdGESDgoGZmlsdGVyGAIgASgJEg0KBWxpbWl0GAMgASgFIk0KGEdldERhdGFiYXNlU2NoZW1hUmVxdWVzdBIxCgRuYW1lGAEgASgJQiPgQQL6QR0KG2J5dGViYXNlLmNvbS9EYXRhYmFzZVNjaGVtYSLYAQobR2V0RGF0YWJhc2VTRExTY2hlbWFSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlEkIKBmZvcm1hdBgCIAEoDjIyLmJ5dGViYXNlLnYxLkdldERhdGFiYXNlU0RMU2NoZW1hUmVxdWVzdC5TRExGb3JtYXQiSAoJU0RMRm9ybWF0EhoKFlNETF9GT1JNQVRfVU5TUEVDSUZJRUQQABIPCgtTSU5HTEVfRklMRRABEg4KCk1VTFRJX0ZJTEUQAiJxChFEaWZmU2NoZW1hUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIQCgZzY2hlbWEYAiABKAlIABITCgljaGFuZ2Vsb2cYAyABKAlIAEIICgZ0YXJnZXQiIgoSRGlmZlNjaGVtYVJlc3BvbnNlEgwKBGRpZmYYASABKAkiwgQKCERhdGFiYXNlEgwKBG5hbWUYASABKAkSJgoFc3RhdGUYAyABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZUID4EEDEj0KFHN1Y2Nlc3NmdWxfc3luY190aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEg8KB3Byb2plY3QYBSABKAkSGwoOc2NoZW1hX3ZlcnNpb24YBiABKAlCA+BBAxIdCgtlbnZpcm9ubWVudBgHIAEoCUID4EEBSACIAQESJwoVZWZmZWN0aXZlX2Vudmlyb25tZW50GAggASgJQgPgQQNIAYgBARIxCgZsYWJlbHMYCSADKAsyIS5ieXRlYmFzZS52MS5EYXRhYmFzZS5MYWJlbHNFbnRyeRI9ChFpbnN0YW5jZV9yZXNvdXJjZRgKIAEoCzIdLmJ5dGViYXNlLnYxLkluc3RhbmNlUmVzb3VyY2VCA+BBAxIdChBiYWNrdXBfYXZhaWxhYmxlGAsgASgIQgPgQQMSFAoHZHJpZnRlZBgMIAEoCEID4EEDGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAE6RepBQgoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlEilpbnN0YW5jZXMve2luc3RhbmNlfS9kYXRhYmFzZXMve2RhdGFiYXNlfUIOCgxfZW52aXJvbm1lbnRCGAoWX2VmZmVjdGl2ZV9lbnZpcm9ubWVudEoECAIQAyKoAgoQRGF0YWJhc2VNZXRhZGF0YRIMCgRuYW1lGAEgASgJEiwKB3NjaGVtYXMYAiADKAsyGy5ieXRlYmFzZS52MS5TY2hlbWFNZXRhZGF0YRIVCg1jaGFyYWN0ZXJfc2V0GAMgASgJEhEKCWNvbGxhdGlvbhgEIAEoCRIyCgpleHRlbnNpb25zGAUgAygLMh4uYnl0ZWJhc2UudjEuRXh0ZW5zaW9uTWV0YWRhdGESDQoFb3duZXIYByABKAkSEwoLc2VhcmNoX3BhdGgYCCABKAk6VupBUwodYnl0ZWJhc2UuY29tL0RhdGFiYXNlTWV0YWRhdGESMmluc3RhbmNlcy97aW5zdGFuY2V9L2RhdGFiYXNlcy97ZGF0YWJhc2V9L21ldGFkYXRhIqYFCg5TY2hlbWFNZXRhZGF0YRIMCgRuYW1lGAEgASgJEioKBnRhYmxlcxgCIAMoCzIaLmJ5dGViYXNlLnYxLlRhYmxlTWV0YWRhdGESOwoPZXh0ZXJuYWxfdGFibGVzGAMgAygLMiIuYnl0ZWJhc2UudjEuRXh0ZXJuYWxUYWJsZU1ldGFkYXRhEigKBXZpZXdzGAQgAygLMhkuYnl0ZWJhc2UudjEuVmlld01ldGFkYXRhEjAKCWZ1bmN0aW9ucxgFIAMoCzIdLmJ5dGViYXNlLnYxLkZ1bmN0aW9uTWV0YWRhdGESMgoKcHJvY2VkdXJlcxgGIAMoCzIeLmJ5dGViYXNlLnYxLlByb2NlZHVyZU1ldGFkYXRhEiwKB3N0cmVhbXMYByADKAsyGy5ieXRlYmFzZS52MS5TdHJlYW1NZXRhZGF0YRIoCgV0YXNrcxgIIAMoCzIZLmJ5dGViYXNlLnYxLlRhc2tNZXRhZGF0YRJBChJtYXRlcmlhbGl6ZWRfdmlld3MYCSADKAsyJS5ieXRlYmFzZS52MS5NYXRlcmlhbGl6ZWRWaWV3TWV0YWRhdGESLgoIcGFja2FnZXMYCiADKAsyHC5ieXRlYmFzZS52MS5QYWNrYWdlTWV0YWRhdGESDQoFb3duZXIYCyABKAkSMAoJc2VxdWVuY2VzGA0gAygLMh0uYnl0ZWJhc2UudjEuU2VxdWVuY2VNZXRhZGF0YRIqCgZldmVudHMYDiADKAsyGi5ieXRlYmFzZS52MS5FdmVudE1ldGFkYXRhEjEKCmVudW1fdHlwZXMYDyADKAsyHS5ieXRlYmFzZS52MS5FbnVtVHlwZU1ldGFkYXRhEhEKCXNraXBfZHVtcBgQIAEoCBIPCgdjb21tZW50GBEgASgJIlQKEEVudW1UeXBlTWV0YWRhdGESDAoEbmFtZRgBIAEoCRIOCgZ2YWx1ZXMYAiADKAkSDwoHY29tbWVudBgDIAEoCRIRCglza2lwX2R1bXAYBCABKAgiowEKDUV2ZW50TWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEhEKCXRpbWVfem9uZRgDIAEoCRIQCghzcWxfbW9kZRgEIAEoCRIcChRjaGFyYWN0ZXJfc2V0X2NsaWVudBgFIAEoCRIcChRjb2xsYXRpb25fY29ubmVjdGlvbhgGIAEoCRIPCgdjb21tZW50GAcgASgJIoECChBTZXF1ZW5jZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSEQoJZGF0YV90eXBlGAIgASgJEg0KBXN0YXJ0GAMgASgJEhEKCW1pbl92YWx1ZRgEIAEoCRIRCgltYXhfdmFsdWUYBSABKAkSEQoJaW5jcmVtZW50GAYgASgJEg0KBWN5Y2xlGAcgASgIEhIKCmNhY2hlX3NpemUYCCABKAkSEgoKbGFzdF92YWx1ZRgJIAEoCRITCgtvd25lcl90YWJsZRgKIAEoCRIUCgxvd25lcl9jb2x1bW4YCyABKAkSDwoHY29tbWVudBgMIAEoCRIRCglza2lwX2R1bXAYDSABKAgivgEKD1RyaWdnZXJNZXRhZGF0YRIMCgRuYW1lGAEgASgJEg0KBWV2ZW50GAMgASgJEg4KBnRpbWluZxgEIAEoCRIMCgRib2R5GAUgASgJEhAKCHNxbF9tb2RlGAYgASgJEhwKFGNoYXJhY3Rlcl9zZXRfY2xpZW50GAcgASgJEhwKFGNvbGxhdGlvbl9jb25uZWN0aW9uGAggASgJEg8KB2NvbW1lbnQYCSABKAkSEQoJc2tpcF9kdW1wGAogASgIIpEBChVFeHRlcm5hbFRhYmxlTWV0YWRhdGESDAoEbmFtZRgBIAEoCRIcChRleHRlcm5hbF9zZXJ2ZXJfbmFtZRgCIAEoCRIeChZleHRlcm5hbF9kYXRhYmFzZV9uYW1lGAMgASgJEiwKB2NvbHVtbnMYBCADKAsyGy5ieXRlYmFzZS52MS5Db2x1bW5NZXRhZGF0YSLsBAoNVGFibGVNZXRhZGF0YRIMCgRuYW1lGAEgASgJEiwKB2NvbHVtbnMYAiADKAsyGy5ieXRlYmFzZS52MS5Db2x1bW5NZXRhZGF0YRIrCgdpbmRleGVzGAMgAygLMhouYnl0ZWJhc2UudjEuSW5kZXhNZXRhZGF0YRIOCgZlbmdpbmUYBCABKAkSEQoJY29sbGF0aW9uGAUgASgJEg8KB2NoYXJzZXQYESABKAkSEQoJcm93X2NvdW50GAYgASgDEhEKCWRhdGFfc2l6ZRgHIAEoAxISCgppbmRleF9zaXplGAggASgDEhEKCWRhdGFfZnJlZRgJIAEoAxIWCg5jcmVhdGVfb3B0aW9ucxgKIAEoCRIPCgdjb21tZW50GAsgASgJEjUKDGZvcmVpZ25fa2V5cxgMIAMoCzIfLmJ5dGViYXNlLnYxLkZvcmVpZ25LZXlNZXRhZGF0YRI3CgpwYXJ0aXRpb25zGA8gAygLMiMuYnl0ZWJhc2UudjEuVGFibGVQYXJ0aXRpb25NZXRhZGF0YRI/ChFjaGVja19jb25zdHJhaW50cxgQIAMoCzIkLmJ5dGViYXNlLnYxLkNoZWNrQ29uc3RyYWludE1ldGFkYXRhEg0KBW93bmVyGBIgASgJEhQKDHNvcnRpbmdfa2V5cxgTIAMoCRIuCgh0cmlnZ2VycxgUIAMoCzIcLmJ5dGViYXNlLnYxLlRyaWdnZXJNZXRhZGF0YRIRCglza2lwX2R1bXAYFSABKAgSFQoNc2hhcmRpbmdfaW5mbxgWIAEoCRIYChBwcmltYXJ5X2tleV90eXBlGBcgASgJIjsKF0NoZWNrQ29uc3RyYWludE1ldGFkYXRhEgwKBG5hbWUYASABKAkSEgoKZXhwcmVzc2lvbhgCIAEoCSLNAwoWVGFibGVQYXJ0aXRpb25NZXRhZGF0YRIMCgRuYW1lGAEgASgJEjYKBHR5cGUYAiABKA4yKC5ieXRlYmFzZS52MS5UYWJsZVBhcnRpdGlvbk1ldGFkYXRhLlR5cGUSEgoKZXhwcmVzc2lvbhgDIAEoCRINCgV2YWx1ZRgEIAEoCRITCgt1c2VfZGVmYXVsdBgFIAEoCRI6Cg1zdWJwYXJ0aXRpb25zGAYgAygLMiMuYnl0ZWJhc2UudjEuVGFibGVQYXJ0aXRpb25NZXRhZGF0YRIrCgdpbmRleGVzGAcgAygLMhouYnl0ZWJhc2UudjEuSW5kZXhNZXRhZGF0YRI/ChFjaGVja19jb25zdHJhaW50cxgIIAMoCzIkLmJ5dGViYXNlLnYxLkNoZWNrQ29uc3RyYWludE1ldGFkYXRhIooBCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVSQU5HRRABEhEKDVJBTkdFX0NPTFVNTlMQAhIICgRMSVNUEAMSEAoMTElTVF9DT0xVTU5TEAQSCAoESEFTSBAFEg8KC0xJTkVBUl9IQVNIEAYSBwoDS0VZEAcSDgoKTElORUFSX0tFWRAIIp8ECg5Db2x1bW5NZXRhZGF0YRIMCgRuYW1lGAEgASgJEhAKCHBvc2l0aW9uGAIgASgFEhMKC2hhc19kZWZhdWx0GAMgASgIEg8KB2RlZmF1bHQYFyABKAkSFwoPZGVmYXVsdF9vbl9udWxsGBIgASgIEhEKCW9uX3VwZGF0ZRgPIAEoCRIQCghudWxsYWJsZRgHIAEoCBIMCgR0eXBlGAggASgJEhUKDWNoYXJhY3Rlcl9zZXQYCSABKAkSEQoJY29sbGF0aW9uGAogASgJEg8KB2NvbW1lbnQYCyABKAkSMwoKZ2VuZXJhdGlvbhgQIAEoCzIfLmJ5dGViYXNlLnYxLkdlbmVyYXRpb25NZXRhZGF0YRITCgtpc19pZGVudGl0eRgTIAEoCBJLChNpZGVudGl0eV9nZW5lcmF0aW9uGBEgASgOMi4uYnl0ZWJhc2UudjEuQ29sdW1uTWV0YWRhdGEuSWRlbnRpdHlHZW5lcmF0aW9uEhUKDWlkZW50aXR5X3NlZWQYFCABKAMSGgoSaWRlbnRpdHlfaW5jcmVtZW50GBUgASgDEh8KF2RlZmF1bHRfY29uc3RyYWludF9uYW1lGBYgASgJIlUKEklkZW50aXR5R2VuZXJhdGlvbhIjCh9JREVOVElUWV9HRU5FUkFUSU9OX1VOU1BFQ0lGSUVEEAASCgoGQUxXQVlTEAESDgoKQllfREVGQVVMVBACIpMBChJHZW5lcmF0aW9uTWV0YWRhdGESMgoEdHlwZRgBIAEoDjIkLmJ5dGViYXNlLnYxLkdlbmVyYXRpb25NZXRhZGF0YS5UeXBlEhIKCmV4cHJlc3Npb24YAiABKAkiNQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCwoHVklSVFVBTBABEgoKBlNUT1JFRBACIu0BCgxWaWV3TWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEg8KB2NvbW1lbnQYAyABKAkSOQoSZGVwZW5kZW5jeV9jb2x1bW5zGAQgAygLMh0uYnl0ZWJhc2UudjEuRGVwZW5kZW5jeUNvbHVtbhIsCgdjb2x1bW5zGAUgAygLMhsuYnl0ZWJhc2UudjEuQ29sdW1uTWV0YWRhdGESLgoIdHJpZ2dlcnMYBiADKAsyHC5ieXRlYmFzZS52MS5UcmlnZ2VyTWV0YWRhdGESEQoJc2tpcF9kdW1wGAcgASgIIkEKEERlcGVuZGVuY3lDb2x1bW4SDgoGc2NoZW1hGAEgASgJEg0KBXRhYmxlGAIgASgJEg4KBmNvbHVtbhgDIAEoCSL4AQoYTWF0ZXJpYWxpemVkVmlld01ldGFkYXRhEgwKBG5hbWUYASABKAkSEgoKZGVmaW5pdGlvbhgCIAEoCRIPCgdjb21tZW50GAMgASgJEjkKEmRlcGVuZGVuY3lfY29sdW1ucxgEIAMoCzIdLmJ5dGViYXNlLnYxLkRlcGVuZGVuY3lDb2x1bW4SLgoIdHJpZ2dlcnMYBSADKAsyHC5ieXRlYmFzZS52MS5UcmlnZ2VyTWV0YWRhdGESKwoHaW5kZXhlcxgGIAMoCzIaLmJ5dGViYXNlLnYxLkluZGV4TWV0YWRhdGESEQoJc2tpcF9kdW1wGAcgASgIIjAKD0RlcGVuZGVuY3lUYWJsZRIOCgZzY2hlbWEYASABKAkSDQoFdGFibGUYAiABKAkijgIKEEZ1bmN0aW9uTWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEhEKCXNpZ25hdHVyZRgDIAEoCRIcChRjaGFyYWN0ZXJfc2V0X2NsaWVudBgEIAEoCRIcChRjb2xsYXRpb25fY29ubmVjdGlvbhgFIAEoCRIaChJkYXRhYmFzZV9jb2xsYXRpb24YBiABKAkSEAoIc3FsX21vZGUYByABKAkSDwoHY29tbWVudBgIIAEoCRI3ChFkZXBlbmRlbmN5X3RhYmxlcxgJIAMoCzIcLmJ5dGViYXNlLnYxLkRlcGVuZGVuY3lUYWJsZRIRCglza2lwX2R1bXAYCiABKAgi1gEKEVByb2NlZHVyZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSEgoKZGVmaW5pdGlvbhgCIAEoCRIRCglzaWduYXR1cmUYAyABKAkSHAoUY2hhcmFjdGVyX3NldF9jbGllbnQYBCABKAkSHAoUY29sbGF0aW9uX2Nvbm5lY3Rpb24YBSABKAkSGgoSZGF0YWJhc2VfY29sbGF0aW9uGAYgASgJEhAKCHNxbF9tb2RlGAcgASgJEg8KB2NvbW1lbnQYCSABKAkSEQoJc2tpcF9kdW1wGAggASgIIjMKD1BhY2thZ2VNZXRhZGF0YRIMCgRuYW1lGAEgASgJEhIKCmRlZmluaXRpb24YAiABKAkilgIKDFRhc2tNZXRhZGF0YRIMCgRuYW1lGAEgASgJEgoKAmlkGAIgASgJEg0KBW93bmVyGAMgASgJEg8KB2NvbW1lbnQYBCABKAkSEQoJd2FyZWhvdXNlGAUgASgJEhAKCHNjaGVkdWxlGAYgASgJEhQKDHByZWRlY2Vzc29ycxgHIAMoCRIuCgVzdGF0ZRgIIAEoDjIfLmJ5dGViYXNlLnYxLlRhc2tNZXRhZGF0YS5TdGF0ZRIRCgljb25kaXRpb24YCSABKAkSEgoKZGVmaW5pdGlvbhgKIAEoCSI6CgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEgsKB1NUQVJURUQQARINCglTVVNQRU5ERUQQAiLLAgoOU3RyZWFtTWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgp0YWJsZV9uYW1lGAIgASgJEg0KBW93bmVyGAMgASgJEg8KB2NvbW1lbnQYBCABKAkSLgoEdHlwZRgFIAEoDjIgLmJ5dGViYXNlLnYxLlN0cmVhbU1ldGFkYXRhLlR5cGUSDQoFc3RhbGUYBiABKAgSLgoEbW9kZRgHIAEoDjIgLmJ5dGViYXNlLnYxLlN0cmVhbU1ldGFkYXRhLk1vZGUSEgoKZGVmaW5pdGlvbhgIIAEoCSInCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVERUxUQRABIksKBE1vZGUSFAoQTU9ERV9VTlNQRUNJRklFRBAAEgsKB0RFRkFVTFQQARIPCgtBUFBFTkRfT05MWRACEg8KC0lOU0VSVF9PTkxZEAMivQEKElNwYXRpYWxJbmRleENvbmZpZxIOCgZtZXRob2QYASABKAkSNQoMdGVzc2VsbGF0aW9uGAIgASgLMh8uYnl0ZWJhc2UudjEuVGVzc2VsbGF0aW9uQ29uZmlnEisKB3N0b3JhZ2UYAyABKAsyGi5ieXRlYmFzZS52MS5TdG9yYWdlQ29uZmlnEjMKC2RpbWVuc2lvbmFsGAQgASgLMh4uYnl0ZWJhc2UudjEuRGltZW5zaW9uYWxDb25maWcimwEKElRlc3NlbGxhdGlvbkNvbmZpZxIOCgZzY2hlbWUYASABKAkSKwoLZ3JpZF9sZXZlbHMYAiADKAsyFi5ieXRlYmFzZS52MS5HcmlkTGV2ZWwSGAoQY2VsbHNfcGVyX29iamVjdBgDIAEoBRIuCgxib3VuZGluZ19ib3gYBCABKAsyGC5ieXRlYmFzZS52MS5Cb3VuZGluZ0JveCIrCglHcmlkTGV2ZWwSDQoFbGV2ZWwYASABKAUSDwoHZGVuc2l0eRgCIAEoCSJFCgtCb3VuZGluZ0JveBIMCgR4bWluGAEgASgBEgwKBHltaW4YAiABKAESDAoEeG1heBgDIAEoARIMCgR5bWF4GAQgASgBIr4CCg1TdG9yYWdlQ29uZmlnEhIKCmZpbGxmYWN0b3IYASABKAUSEQoJYnVmZmVyaW5nGAIgASgJEhIKCnRhYmxlc3BhY2UYAyABKAkSFwoPd29ya190YWJsZXNwYWNlGAQgASgJEhEKCXNkb19sZXZlbBgFIAEoBRIXCg9jb21taXRfaW50ZXJ2YWwYBiABKAUSEQoJcGFkX2luZGV4GAcgASgIEhYKDnNvcnRfaW5fdGVtcGRiGAggASgJEhUKDWRyb3BfZXhpc3RpbmcYCSABKAgSDgoGb25saW5lGAogASgIEhcKD2FsbG93X3Jvd19sb2NrcxgLIAEoCBIYChBhbGxvd19wYWdlX2xvY2tzGAwgASgIEg4KBm1heGRvcBgNIAEoBRIYChBkYXRhX2NvbXByZXNzaW9uGA4gASgJIn8KEURpbWVuc2lvbmFsQ29uZmlnEhIKCmRpbWVuc2lvbnMYASABKAUSEQoJZGF0YV90eXBlGAIgASgJEgwKBHNyaWQYAyABKAUSNQoLY29uc3RyYWludHMYBCADKAsyIC5ieXRlYmFzZS52MS5EaW1lbnNpb25Db25zdHJhaW50ImEKE0RpbWVuc2lvbkNvbnN0cmFpbnQSEQoJZGltZW5zaW9uGAEgASgJEhEKCW1pbl92YWx1ZRgCIAEoARIRCgltYXhfdmFsdWUYAyABKAESEQoJdG9sZXJhbmNlGAQgASgBIo0DCg1JbmRleE1ldGFkYXRhEgwKBG5hbWUYASABKAkSEwoLZXhwcmVzc2lvbnMYAiADKAkSEgoKa2V5X2xlbmd0aBgJIAMoAxISCgpkZXNjZW5kaW5nGAogAygIEgwKBHR5cGUYAyABKAkSDgoGdW5pcXVlGAQgASgIEg8KB3ByaW1hcnkYBSABKAgSDwoHdmlzaWJsZRgGIAEoCBIPCgdjb21tZW50GAcgASgJEhIKCmRlZmluaXRpb24YCCABKAkSGwoTcGFyZW50X2luZGV4X3NjaGVtYRgLIAEoCRIZChFwYXJlbnRfaW5kZXhfbmFtZRgMIAEoCRITCgtncmFudWxhcml0eRgNIAEoAxIVCg1pc19jb25zdHJhaW50GA4gASgIEjcKDnNwYXRpYWxfY29uZmlnGA8gASgLMh8uYnl0ZWJhc2UudjEuU3BhdGlhbEluZGV4Q29uZmlnEhUKDW9wY2xhc3NfbmFtZXMYECADKAkSGAoQb3BjbGFzc19kZWZhdWx0cxgRIAMoCCJXChFFeHRlbnNpb25NZXRhZGF0YRIMCgRuYW1lGAEgASgJEg4KBnNjaGVtYRgCIAEoCRIPCgd2ZXJzaW9uGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJIr4BChJGb3JlaWduS2V5TWV0YWRhdGESDAoEbmFtZRgBIAEoCRIPCgdjb2x1bW5zGAIgAygJEhkKEXJlZmVyZW5jZWRfc2NoZW1hGAMgASgJEhgKEHJlZmVyZW5jZWRfdGFibGUYBCABKAkSGgoScmVmZXJlbmNlZF9jb2x1bW5zGAUgAygJEhEKCW9uX2RlbGV0ZRgGIAEoCRIRCglvbl91cGRhdGUYByABKAkSEgoKbWF0Y2hfdHlwZRgIIAEoCSIgCg5EYXRhYmFzZVNjaGVtYRIOCgZzY2hlbWEYASABKAkiPgoRRGF0YWJhc2VTRExTY2hlbWESDgoGc2NoZW1hGAEgASgMEhkKDGNvbnRlbnRfdHlwZRgCIAEoCUID4EEDIksKEENoYW5nZWRSZXNvdXJjZXMSNwoJZGF0YWJhc2VzGAEgAygLMiQuYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlRGF0YWJhc2UiXAoXQ2hhbmdlZFJlc291cmNlRGF0YWJhc2USDAoEbmFtZRgBIAEoCRIzCgdzY2hlbWFzGAIgAygLMiIuYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlU2NoZW1hIv0BChVDaGFuZ2VkUmVzb3VyY2VTY2hlbWESDAoEbmFtZRgBIAEoCRIxCgZ0YWJsZXMYAiADKAsyIS5ieXRlYmFzZS52MS5DaGFuZ2VkUmVzb3VyY2VUYWJsZRIvCgV2aWV3cxgDIAMoCzIgLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZVZpZXcSNwoJZnVuY3Rpb25zGAQgAygLMiQuYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlRnVuY3Rpb24SOQoKcHJvY2VkdXJlcxgFIAMoCzIlLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZVByb2NlZHVyZSJIChRDaGFuZ2VkUmVzb3VyY2VUYWJsZRIMCgRuYW1lGAEgASgJEiIKBnJhbmdlcxgDIAMoCzISLmJ5dGViYXNlLnYxLlJhbmdlIkcKE0NoYW5nZWRSZXNvdXJjZVZpZXcSDAoEbmFtZRgBIAEoCRIiCgZyYW5nZXMYAiADKAsyEi5ieXRlYmFzZS52MS5SYW5nZSJLChdDaGFuZ2VkUmVzb3VyY2VGdW5jdGlvbhIMCgRuYW1lGAEgASgJEiIKBnJhbmdlcxgCIAMoCzISLmJ5dGViYXNlLnYxLlJhbmdlIkwKGENoYW5nZWRSZXNvdXJjZVByb2NlZHVyZRIMCgRuYW1lGAEgASgJEiIKBnJhbmdlcxgCIAMoCzISLmJ5dGViYXNlLnYxLlJhbmdlIqcBChVMaXN0Q2hhbmdlbG9nc1JlcXVlc3QSLQoGcGFyZW50GAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCRIoCgR2aWV3GAQgASgOMhouYnl0ZWJhc2UudjEuQ2hhbmdlbG9nVmlldxIOCgZmaWx0ZXIYBSABKAkiXQoWTGlzdENoYW5nZWxvZ3NSZXNwb25zZRIqCgpjaGFuZ2Vsb2dzGAEgAygLMhYuYnl0ZWJhc2UudjEuQ2hhbmdlbG9nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJ1ChNHZXRDaGFuZ2Vsb2dSZXF1ZXN0EjQKBG5hbWUYASABKAlCJuBBAvpBIAoeYnl0ZWJhc2UuY29tL0RhdGFiYXNlQ2hhbmdlbG9nEigKBHZpZXcYAiABKA4yGi5ieXRlYmFzZS52MS5DaGFuZ2Vsb2dWaWV3IqgFCglDaGFuZ2Vsb2cSDAoEbmFtZRgBIAEoCRIvCgtjcmVhdGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoGc3RhdHVzGAQgASgOMh0uYnl0ZWJhc2UudjEuQ2hhbmdlbG9nLlN0YXR1cxIRCglzdGF0ZW1lbnQYBSABKAkSFgoOc3RhdGVtZW50X3NpemUYBiABKAMSFwoPc3RhdGVtZW50X3NoZWV0GAcgASgJEg4KBnNjaGVtYRgIIAEoCRITCgtzY2hlbWFfc2l6ZRgJIAEoAxITCgtwcmV2X3NjaGVtYRgKIAEoCRIYChBwcmV2X3NjaGVtYV9zaXplGAsgASgDEg0KBWlzc3VlGAwgASgJEhAKCHRhc2tfcnVuGA0gASgJEg8KB3ZlcnNpb24YDiABKAkSEAoIcmV2aXNpb24YDyABKAkSOAoRY2hhbmdlZF9yZXNvdXJjZXMYECABKAsyHS5ieXRlYmFzZS52MS5DaGFuZ2VkUmVzb3VyY2VzEikKBHR5cGUYESABKA4yGy5ieXRlYmFzZS52MS5DaGFuZ2Vsb2cuVHlwZSJDCgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEggKBERPTkUQAhIKCgZGQUlMRUQQAyJACgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIMCghCQVNFTElORRABEgsKB01JR1JBVEUQAhIHCgNTREwQAzpl6kFiCh5ieXRlYmFzZS5jb20vRGF0YWJhc2VDaGFuZ2Vsb2cSQGluc3RhbmNlcy97aW5zdGFuY2V9L2RhdGFiYXNlcy97ZGF0YWJhc2V9L2NoYW5nZWxvZ3Mve2NoYW5nZWxvZ30i8QIKFkdldFNjaGVtYVN0cmluZ1JlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USPAoEdHlwZRgCIAEoDjIuLmJ5dGViYXNlLnYxLkdldFNjaGVtYVN0cmluZ1JlcXVlc3QuT2JqZWN0VHlwZRIOCgZzY2hlbWEYAyABKAkSDgoGb2JqZWN0GAQgASgJEi8KCG1ldGFkYXRhGAUgASgLMh0uYnl0ZWJhc2UudjEuRGF0YWJhc2VNZXRhZGF0YSKaAQoKT2JqZWN0VHlwZRIbChdPQkpFQ1RfVFlQRV9VTlNQRUNJRklFRBAAEgwKCERBVEFCQVNFEAESCgoGU0NIRU1BEAISCQoFVEFCTEUQAxIICgRWSUVXEAQSFQoRTUFURVJJQUxJWkVEX1ZJRVcQBRIMCghGVU5DVElPThAGEg0KCVBST0NFRFVSRRAHEgwKCFNFUVVFTkNFEAgiMAoXR2V0U2NoZW1hU3RyaW5nUmVzcG9uc2USFQoNc2NoZW1hX3N0cmluZxgBIAEoCSpiCg1DaGFuZ2Vsb2dWaWV3Eh4KGkNIQU5HRUxPR19WSUVXX1VOU1BFQ0lGSUVEEAASGAoUQ0hBTkdFTE9HX1ZJRVdfQkFTSUMQARIXChNDSEFOR0VMT0dfVklFV19GVUxMEAIy7BQKD0RhdGFiYXNlU2VydmljZRKQAQoLR2V0RGF0YWJhc2USHy5ieXRlYmFzZS52MS5HZXREYXRhYmFzZVJlcXVlc3QaFS5ieXRlYmFzZS52MS5EYXRhYmFzZSJJ2kEEbmFtZYrqMBBiYi5kYXRhYmFzZXMuZ2V0kOowAYLT5JMCJBIiL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfRLdAQoRQmF0Y2hHZXREYXRhYmFzZXMSJS5ieXRlYmFzZS52MS5CYXRjaEdldERhdGFiYXNlc1JlcXVlc3QaJi5ieXRlYmFzZS52MS5CYXRjaEdldERhdGFiYXNlc1Jlc3BvbnNlInmK6jAQYmIuZGF0YWJhc2VzLmdldJDqMAKC0+STAltaLRIrL3YxL3twYXJlbnQ9aW5zdGFuY2VzLyp9L2RhdGFiYXNlczpiYXRjaEdldBIqL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vZGF0YWJhc2VzOmJhdGNoR2V0EusBCg1MaXN0RGF0YWJhc2VzEiEuYnl0ZWJhc2UudjEuTGlzdERhdGFiYXNlc1JlcXVlc3QaIi5ieXRlYmFzZS52MS5MaXN0RGF0YWJhc2VzUmVzcG9uc2UikgHaQQCK6jARYmIuZGF0YWJhc2VzLmxpc3SQ6jACgtPkkwJwWiQSIi92MS97cGFyZW50PWluc3RhbmNlcy8qfS9kYXRhYmFzZXNaJRIjL3YxL3twYXJlbnQ9d29ya3NwYWNlcy8qfS9kYXRhYmFzZXMSIS92MS97cGFyZW50PXByb2plY3RzLyp9L2RhdGFiYXNlcxLAAQoOVXBkYXRlRGF0YWJhc2USIi5ieXRlYmFzZS52MS5VcGRhdGVEYXRhYmFzZVJlcXVlc3QaFS5ieXRlYmFzZS52MS5EYXRhYmFzZSJz2kEUZGF0YWJhc2UsdXBkYXRlX21hc2uK6jATYmIuZGF0YWJhc2VzLnVwZGF0ZZDqMAGY6jABgtPkkwI3OghkYXRhYmFzZTIrL3YxL3tkYXRhYmFzZS5uYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfRLFAQoUQmF0Y2hVcGRhdGVEYXRhYmFzZXMSKC5ieXRlYmFzZS52MS5CYXRjaFVwZGF0ZURhdGFiYXNlc1JlcXVlc3QaKS5ieXRlYmFzZS52MS5CYXRjaFVwZGF0ZURhdGFiYXNlc1Jlc3BvbnNlIliK6jATYmIuZGF0YWJhc2VzLnVwZGF0ZZDqMAGY6jABgtPkkwIzOgEqIi4vdjEve3BhcmVudD1pbnN0YW5jZXMvKn0vZGF0YWJhc2VzOmJhdGNoVXBkYXRlEqABCgxTeW5jRGF0YWJhc2USIC5ieXRlYmFzZS52MS5TeW5jRGF0YWJhc2VSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuU3luY0RhdGFiYXNlUmVzcG9uc2UiS4rqMBFiYi5kYXRhYmFzZXMuc3luY5DqMAGC0+STAiw6ASoiJy92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKn06c3luYxK3AQoSQmF0Y2hTeW5jRGF0YWJhc2VzEiYuYnl0ZWJhc2UudjEuQmF0Y2hTeW5jRGF0YWJhc2VzUmVxdWVzdBonLmJ5dGViYXNlLnYxLkJhdGNoU3luY0RhdGFiYXNlc1Jlc3BvbnNlIlCK6jARYmIuZGF0YWJhc2VzLnN5bmOQ6jABgtPkkwIxOgEqIiwvdjEve3BhcmVudD1pbnN0YW5jZXMvKn0vZGF0YWJhc2VzOmJhdGNoU3luYxKwAQoTR2V0RGF0YWJhc2VNZXRhZGF0YRInLmJ5dGViYXNlLnYxLkdldERhdGFiYXNlTWV0YWRhdGFSZXF1ZXN0Gh0uYnl0ZWJhc2UudjEuRGF0YWJhc2VNZXRhZGF0YSJRiuowFmJiLmRhdGFiYXNlcy5nZXRTY2hlbWGQ6jABgtPkkwItEisvdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyovbWV0YWRhdGF9EqgBChFHZXREYXRhYmFzZVNjaGVtYRIlLmJ5dGViYXNlLnYxLkdldERhdGFiYXNlU2NoZW1hUmVxdWVzdBobLmJ5dGViYXNlLnYxLkRhdGFiYXNlU2NoZW1hIk+K6jAWYmIuZGF0YWJhc2VzLmdldFNjaGVtYZDqMAGC0+STAisSKS92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9zY2hlbWF9ErQBChRHZXREYXRhYmFzZVNETFNjaGVtYRIoLmJ5dGViYXNlLnYxLkdldERhdGFiYXNlU0RMU2NoZW1hUmVxdWVzdBoeLmJ5dGViYXNlLnYxLkRhdGFiYXNlU0RMU2NoZW1hIlKK6jAWYmIuZGF0YWJhc2VzLmdldFNjaGVtYZDqMAGC0+STAi4SLC92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9zZGxTY2hlbWF9EuEBCgpEaWZmU2NoZW1hEh4uYnl0ZWJhc2UudjEuRGlmZlNjaGVtYVJlcXVlc3QaHy5ieXRlYmFzZS52MS5EaWZmU2NoZW1hUmVzcG9uc2UikQGK6jAQYmIuZGF0YWJhc2VzLmdldJDqMAGC0+STAnM6ASpaPzoBKiI6L3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL2NoYW5nZWxvZ3MvKn06ZGlmZlNjaGVtYSItL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfTpkaWZmU2NoZW1hErUBCg5MaXN0Q2hhbmdlbG9ncxIiLmJ5dGViYXNlLnYxLkxpc3RDaGFuZ2Vsb2dzUmVxdWVzdBojLmJ5dGViYXNlLnYxLkxpc3RDaGFuZ2Vsb2dzUmVzcG9uc2UiWtpBBnBhcmVudIrqMBJiYi5jaGFuZ2Vsb2dzLmxpc3SQ6jABgtPkkwIxEi8vdjEve3BhcmVudD1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKn0vY2hhbmdlbG9ncxKhAQoMR2V0Q2hhbmdlbG9nEiAuYnl0ZWJhc2UudjEuR2V0Q2hhbmdlbG9nUmVxdWVzdBoWLmJ5dGViYXNlLnYxLkNoYW5nZWxvZyJX2kEEbmFtZYrqMBFiYi5jaGFuZ2Vsb2dzLmdldJDqMAGC0+STAjESLy92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9jaGFuZ2Vsb2dzLyp9EroBCg9HZXRTY2hlbWFTdHJpbmcSIy5ieXRlYmFzZS52MS5HZXRTY2hlbWFTdHJpbmdSZXF1ZXN0GiQuYnl0ZWJhc2UudjEuR2V0U2NoZW1hU3RyaW5nUmVzcG9uc2UiXNpBBG5hbWWK6jAWYmIuZGF0YWJhc2VzLmdldFNjaGVtYZDqMAGC0+STAjESLy92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9zY2hlbWFTdHJpbmd9QqoBCg9jb20uYnl0ZWJhc2UudjFCFERhdGFiYXNlU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_instance_service]);
+  fileDesc("Chl2MS9kYXRhYmFzZV9zZXJ2aWNlLnByb3RvEgtieXRlYmFzZS52MSJBChJHZXREYXRhYmFzZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2UidwoYQmF0Y2hHZXREYXRhYmFzZXNSZXF1ZXN0Ei0KBnBhcmVudBgBIAEoCUId4EEC+kEXEhVieXRlYmFzZS5jb20vRGF0YWJhc2USLAoFbmFtZXMYAiADKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlIkUKGUJhdGNoR2V0RGF0YWJhc2VzUmVzcG9uc2USKAoJZGF0YWJhc2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuRGF0YWJhc2UikgEKFExpc3REYXRhYmFzZXNSZXF1ZXN0Ei0KBnBhcmVudBgBIAEoCUId4EEC+kEXEhVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJEhQKDHNob3dfZGVsZXRlZBgFIAEoCCJaChVMaXN0RGF0YWJhc2VzUmVzcG9uc2USKAoJZGF0YWJhc2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuRGF0YWJhc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIo0BChVVcGRhdGVEYXRhYmFzZVJlcXVlc3QSLAoIZGF0YWJhc2UYASABKAsyFS5ieXRlYmFzZS52MS5EYXRhYmFzZUID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1hbGxvd19taXNzaW5nGAMgASgIImgKG0JhdGNoVXBkYXRlRGF0YWJhc2VzUmVxdWVzdBIOCgZwYXJlbnQYASABKAkSOQoIcmVxdWVzdHMYAiADKAsyIi5ieXRlYmFzZS52MS5VcGRhdGVEYXRhYmFzZVJlcXVlc3RCA+BBAiJIChxCYXRjaFVwZGF0ZURhdGFiYXNlc1Jlc3BvbnNlEigKCWRhdGFiYXNlcxgBIAMoCzIVLmJ5dGViYXNlLnYxLkRhdGFiYXNlIlkKGUJhdGNoU3luY0RhdGFiYXNlc1JlcXVlc3QSDgoGcGFyZW50GAEgASgJEiwKBW5hbWVzGAIgAygJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZSIcChpCYXRjaFN5bmNEYXRhYmFzZXNSZXNwb25zZSJCChNTeW5jRGF0YWJhc2VSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlIhYKFFN5bmNEYXRhYmFzZVJlc3BvbnNlInAKGkdldERhdGFiYXNlTWV0YWRhdGFSZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodYnl0ZWJhc2UuY29tL0RhdGFiYXNlTWV0YWRhdGESDgoGZmlsdGVyGAIgASgJEg0KBWxpbWl0GAMgASgFIk0KGEdldERhdGFiYXNlU2NoZW1hUmVxdWVzdBIxCgRuYW1lGAEgASgJQiPgQQL6QR0KG2J5dGViYXNlLmNvbS9EYXRhYmFzZVNjaGVtYSLYAQobR2V0RGF0YWJhc2VTRExTY2hlbWFSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlEkIKBmZvcm1hdBgCIAEoDjIyLmJ5dGViYXNlLnYxLkdldERhdGFiYXNlU0RMU2NoZW1hUmVxdWVzdC5TRExGb3JtYXQiSAoJU0RMRm9ybWF0EhoKFlNETF9GT1JNQVRfVU5TUEVDSUZJRUQQABIPCgtTSU5HTEVfRklMRRABEg4KCk1VTFRJX0ZJTEUQAiJxChFEaWZmU2NoZW1hUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIQCgZzY2hlbWEYAiABKAlIABITCgljaGFuZ2Vsb2cYAyABKAlIAEIICgZ0YXJnZXQiIgoSRGlmZlNjaGVtYVJlc3BvbnNlEgwKBGRpZmYYASABKAkiwgQKCERhdGFiYXNlEgwKBG5hbWUYASABKAkSJgoFc3RhdGUYAyABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZUID4EEDEj0KFHN1Y2Nlc3NmdWxfc3luY190aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEg8KB3Byb2plY3QYBSABKAkSGwoOc2NoZW1hX3ZlcnNpb24YBiABKAlCA+BBAxIdCgtlbnZpcm9ubWVudBgHIAEoCUID4EEBSACIAQESJwoVZWZmZWN0aXZlX2Vudmlyb25tZW50GAggASgJQgPgQQNIAYgBARIxCgZsYWJlbHMYCSADKAsyIS5ieXRlYmFzZS52MS5EYXRhYmFzZS5MYWJlbHNFbnRyeRI9ChFpbnN0YW5jZV9yZXNvdXJjZRgKIAEoCzIdLmJ5dGViYXNlLnYxLkluc3RhbmNlUmVzb3VyY2VCA+BBAxIdChBiYWNrdXBfYXZhaWxhYmxlGAsgASgIQgPgQQMSFAoHZHJpZnRlZBgMIAEoCEID4EEDGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAE6RepBQgoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlEilpbnN0YW5jZXMve2luc3RhbmNlfS9kYXRhYmFzZXMve2RhdGFiYXNlfUIOCgxfZW52aXJvbm1lbnRCGAoWX2VmZmVjdGl2ZV9lbnZpcm9ubWVudEoECAIQAyKoAgoQRGF0YWJhc2VNZXRhZGF0YRIMCgRuYW1lGAEgASgJEiwKB3NjaGVtYXMYAiADKAsyGy5ieXRlYmFzZS52MS5TY2hlbWFNZXRhZGF0YRIVCg1jaGFyYWN0ZXJfc2V0GAMgASgJEhEKCWNvbGxhdGlvbhgEIAEoCRIyCgpleHRlbnNpb25zGAUgAygLMh4uYnl0ZWJhc2UudjEuRXh0ZW5zaW9uTWV0YWRhdGESDQoFb3duZXIYByABKAkSEwoLc2VhcmNoX3BhdGgYCCABKAk6VupBUwodYnl0ZWJhc2UuY29tL0RhdGFiYXNlTWV0YWRhdGESMmluc3RhbmNlcy97aW5zdGFuY2V9L2RhdGFiYXNlcy97ZGF0YWJhc2V9L21ldGFkYXRhIqYFCg5TY2hlbWFNZXRhZGF0YRIMCgRuYW1lGAEgASgJEioKBnRhYmxlcxgCIAMoCzIaLmJ5dGViYXNlLnYxLlRhYmxlTWV0YWRhdGESOwoPZXh0ZXJuYWxfdGFibGVzGAMgAygLMiIuYnl0ZWJhc2UudjEuRXh0ZXJuYWxUYWJsZU1ldGFkYXRhEigKBXZpZXdzGAQgAygLMhkuYnl0ZWJhc2UudjEuVmlld01ldGFkYXRhEjAKCWZ1bmN0aW9ucxgFIAMoCzIdLmJ5dGViYXNlLnYxLkZ1bmN0aW9uTWV0YWRhdGESMgoKcHJvY2VkdXJlcxgGIAMoCzIeLmJ5dGViYXNlLnYxLlByb2NlZHVyZU1ldGFkYXRhEiwKB3N0cmVhbXMYByADKAsyGy5ieXRlYmFzZS52MS5TdHJlYW1NZXRhZGF0YRIoCgV0YXNrcxgIIAMoCzIZLmJ5dGViYXNlLnYxLlRhc2tNZXRhZGF0YRJBChJtYXRlcmlhbGl6ZWRfdmlld3MYCSADKAsyJS5ieXRlYmFzZS52MS5NYXRlcmlhbGl6ZWRWaWV3TWV0YWRhdGESLgoIcGFja2FnZXMYCiADKAsyHC5ieXRlYmFzZS52MS5QYWNrYWdlTWV0YWRhdGESDQoFb3duZXIYCyABKAkSMAoJc2VxdWVuY2VzGA0gAygLMh0uYnl0ZWJhc2UudjEuU2VxdWVuY2VNZXRhZGF0YRIqCgZldmVudHMYDiADKAsyGi5ieXRlYmFzZS52MS5FdmVudE1ldGFkYXRhEjEKCmVudW1fdHlwZXMYDyADKAsyHS5ieXRlYmFzZS52MS5FbnVtVHlwZU1ldGFkYXRhEhEKCXNraXBfZHVtcBgQIAEoCBIPCgdjb21tZW50GBEgASgJIlQKEEVudW1UeXBlTWV0YWRhdGESDAoEbmFtZRgBIAEoCRIOCgZ2YWx1ZXMYAiADKAkSDwoHY29tbWVudBgDIAEoCRIRCglza2lwX2R1bXAYBCABKAgiowEKDUV2ZW50TWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEhEKCXRpbWVfem9uZRgDIAEoCRIQCghzcWxfbW9kZRgEIAEoCRIcChRjaGFyYWN0ZXJfc2V0X2NsaWVudBgFIAEoCRIcChRjb2xsYXRpb25fY29ubmVjdGlvbhgGIAEoCRIPCgdjb21tZW50GAcgASgJIoECChBTZXF1ZW5jZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSEQoJZGF0YV90eXBlGAIgASgJEg0KBXN0YXJ0GAMgASgJEhEKCW1pbl92YWx1ZRgEIAEoCRIRCgltYXhfdmFsdWUYBSABKAkSEQoJaW5jcmVtZW50GAYgASgJEg0KBWN5Y2xlGAcgASgIEhIKCmNhY2hlX3NpemUYCCABKAkSEgoKbGFzdF92YWx1ZRgJIAEoCRITCgtvd25lcl90YWJsZRgKIAEoCRIUCgxvd25lcl9jb2x1bW4YCyABKAkSDwoHY29tbWVudBgMIAEoCRIRCglza2lwX2R1bXAYDSABKAgivgEKD1RyaWdnZXJNZXRhZGF0YRIMCgRuYW1lGAEgASgJEg0KBWV2ZW50GAMgASgJEg4KBnRpbWluZxgEIAEoCRIMCgRib2R5GAUgASgJEhAKCHNxbF9tb2RlGAYgASgJEhwKFGNoYXJhY3Rlcl9zZXRfY2xpZW50GAcgASgJEhwKFGNvbGxhdGlvbl9jb25uZWN0aW9uGAggASgJEg8KB2NvbW1lbnQYCSABKAkSEQoJc2tpcF9kdW1wGAogASgIIpEBChVFeHRlcm5hbFRhYmxlTWV0YWRhdGESDAoEbmFtZRgBIAEoCRIcChRleHRlcm5hbF9zZXJ2ZXJfbmFtZRgCIAEoCRIeChZleHRlcm5hbF9kYXRhYmFzZV9uYW1lGAMgASgJEiwKB2NvbHVtbnMYBCADKAsyGy5ieXRlYmFzZS52MS5Db2x1bW5NZXRhZGF0YSLsBAoNVGFibGVNZXRhZGF0YRIMCgRuYW1lGAEgASgJEiwKB2NvbHVtbnMYAiADKAsyGy5ieXRlYmFzZS52MS5Db2x1bW5NZXRhZGF0YRIrCgdpbmRleGVzGAMgAygLMhouYnl0ZWJhc2UudjEuSW5kZXhNZXRhZGF0YRIOCgZlbmdpbmUYBCABKAkSEQoJY29sbGF0aW9uGAUgASgJEg8KB2NoYXJzZXQYESABKAkSEQoJcm93X2NvdW50GAYgASgDEhEKCWRhdGFfc2l6ZRgHIAEoAxISCgppbmRleF9zaXplGAggASgDEhEKCWRhdGFfZnJlZRgJIAEoAxIWCg5jcmVhdGVfb3B0aW9ucxgKIAEoCRIPCgdjb21tZW50GAsgASgJEjUKDGZvcmVpZ25fa2V5cxgMIAMoCzIfLmJ5dGViYXNlLnYxLkZvcmVpZ25LZXlNZXRhZGF0YRI3CgpwYXJ0aXRpb25zGA8gAygLMiMuYnl0ZWJhc2UudjEuVGFibGVQYXJ0aXRpb25NZXRhZGF0YRI/ChFjaGVja19jb25zdHJhaW50cxgQIAMoCzIkLmJ5dGViYXNlLnYxLkNoZWNrQ29uc3RyYWludE1ldGFkYXRhEg0KBW93bmVyGBIgASgJEhQKDHNvcnRpbmdfa2V5cxgTIAMoCRIuCgh0cmlnZ2VycxgUIAMoCzIcLmJ5dGViYXNlLnYxLlRyaWdnZXJNZXRhZGF0YRIRCglza2lwX2R1bXAYFSABKAgSFQoNc2hhcmRpbmdfaW5mbxgWIAEoCRIYChBwcmltYXJ5X2tleV90eXBlGBcgASgJIjsKF0NoZWNrQ29uc3RyYWludE1ldGFkYXRhEgwKBG5hbWUYASABKAkSEgoKZXhwcmVzc2lvbhgCIAEoCSLNAwoWVGFibGVQYXJ0aXRpb25NZXRhZGF0YRIMCgRuYW1lGAEgASgJEjYKBHR5cGUYAiABKA4yKC5ieXRlYmFzZS52MS5UYWJsZVBhcnRpdGlvbk1ldGFkYXRhLlR5cGUSEgoKZXhwcmVzc2lvbhgDIAEoCRINCgV2YWx1ZRgEIAEoCRITCgt1c2VfZGVmYXVsdBgFIAEoCRI6Cg1zdWJwYXJ0aXRpb25zGAYgAygLMiMuYnl0ZWJhc2UudjEuVGFibGVQYXJ0aXRpb25NZXRhZGF0YRIrCgdpbmRleGVzGAcgAygLMhouYnl0ZWJhc2UudjEuSW5kZXhNZXRhZGF0YRI/ChFjaGVja19jb25zdHJhaW50cxgIIAMoCzIkLmJ5dGViYXNlLnYxLkNoZWNrQ29uc3RyYWludE1ldGFkYXRhIooBCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVSQU5HRRABEhEKDVJBTkdFX0NPTFVNTlMQAhIICgRMSVNUEAMSEAoMTElTVF9DT0xVTU5TEAQSCAoESEFTSBAFEg8KC0xJTkVBUl9IQVNIEAYSBwoDS0VZEAcSDgoKTElORUFSX0tFWRAIIp8ECg5Db2x1bW5NZXRhZGF0YRIMCgRuYW1lGAEgASgJEhAKCHBvc2l0aW9uGAIgASgFEhMKC2hhc19kZWZhdWx0GAMgASgIEg8KB2RlZmF1bHQYFyABKAkSFwoPZGVmYXVsdF9vbl9udWxsGBIgASgIEhEKCW9uX3VwZGF0ZRgPIAEoCRIQCghudWxsYWJsZRgHIAEoCBIMCgR0eXBlGAggASgJEhUKDWNoYXJhY3Rlcl9zZXQYCSABKAkSEQoJY29sbGF0aW9uGAogASgJEg8KB2NvbW1lbnQYCyABKAkSMwoKZ2VuZXJhdGlvbhgQIAEoCzIfLmJ5dGViYXNlLnYxLkdlbmVyYXRpb25NZXRhZGF0YRITCgtpc19pZGVudGl0eRgTIAEoCBJLChNpZGVudGl0eV9nZW5lcmF0aW9uGBEgASgOMi4uYnl0ZWJhc2UudjEuQ29sdW1uTWV0YWRhdGEuSWRlbnRpdHlHZW5lcmF0aW9uEhUKDWlkZW50aXR5X3NlZWQYFCABKAMSGgoSaWRlbnRpdHlfaW5jcmVtZW50GBUgASgDEh8KF2RlZmF1bHRfY29uc3RyYWludF9uYW1lGBYgASgJIlUKEklkZW50aXR5R2VuZXJhdGlvbhIjCh9JREVOVElUWV9HRU5FUkFUSU9OX1VOU1BFQ0lGSUVEEAASCgoGQUxXQVlTEAESDgoKQllfREVGQVVMVBACIpMBChJHZW5lcmF0aW9uTWV0YWRhdGESMgoEdHlwZRgBIAEoDjIkLmJ5dGViYXNlLnYxLkdlbmVyYXRpb25NZXRhZGF0YS5UeXBlEhIKCmV4cHJlc3Npb24YAiABKAkiNQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCwoHVklSVFVBTBABEgoKBlNUT1JFRBACIu0BCgxWaWV3TWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEg8KB2NvbW1lbnQYAyABKAkSOQoSZGVwZW5kZW5jeV9jb2x1bW5zGAQgAygLMh0uYnl0ZWJhc2UudjEuRGVwZW5kZW5jeUNvbHVtbhIsCgdjb2x1bW5zGAUgAygLMhsuYnl0ZWJhc2UudjEuQ29sdW1uTWV0YWRhdGESLgoIdHJpZ2dlcnMYBiADKAsyHC5ieXRlYmFzZS52MS5UcmlnZ2VyTWV0YWRhdGESEQoJc2tpcF9kdW1wGAcgASgIIkEKEERlcGVuZGVuY3lDb2x1bW4SDgoGc2NoZW1hGAEgASgJEg0KBXRhYmxlGAIgASgJEg4KBmNvbHVtbhgDIAEoCSL4AQoYTWF0ZXJpYWxpemVkVmlld01ldGFkYXRhEgwKBG5hbWUYASABKAkSEgoKZGVmaW5pdGlvbhgCIAEoCRIPCgdjb21tZW50GAMgASgJEjkKEmRlcGVuZGVuY3lfY29sdW1ucxgEIAMoCzIdLmJ5dGViYXNlLnYxLkRlcGVuZGVuY3lDb2x1bW4SLgoIdHJpZ2dlcnMYBSADKAsyHC5ieXRlYmFzZS52MS5UcmlnZ2VyTWV0YWRhdGESKwoHaW5kZXhlcxgGIAMoCzIaLmJ5dGViYXNlLnYxLkluZGV4TWV0YWRhdGESEQoJc2tpcF9kdW1wGAcgASgIIjAKD0RlcGVuZGVuY3lUYWJsZRIOCgZzY2hlbWEYASABKAkSDQoFdGFibGUYAiABKAkijgIKEEZ1bmN0aW9uTWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEhEKCXNpZ25hdHVyZRgDIAEoCRIcChRjaGFyYWN0ZXJfc2V0X2NsaWVudBgEIAEoCRIcChRjb2xsYXRpb25fY29ubmVjdGlvbhgFIAEoCRIaChJkYXRhYmFzZV9jb2xsYXRpb24YBiABKAkSEAoIc3FsX21vZGUYByABKAkSDwoHY29tbWVudBgIIAEoCRI3ChFkZXBlbmRlbmN5X3RhYmxlcxgJIAMoCzIcLmJ5dGViYXNlLnYxLkRlcGVuZGVuY3lUYWJsZRIRCglza2lwX2R1bXAYCiABKAgi1gEKEVByb2NlZHVyZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSEgoKZGVmaW5pdGlvbhgCIAEoCRIRCglzaWduYXR1cmUYAyABKAkSHAoUY2hhcmFjdGVyX3NldF9jbGllbnQYBCABKAkSHAoUY29sbGF0aW9uX2Nvbm5lY3Rpb24YBSABKAkSGgoSZGF0YWJhc2VfY29sbGF0aW9uGAYgASgJEhAKCHNxbF9tb2RlGAcgASgJEg8KB2NvbW1lbnQYCSABKAkSEQoJc2tpcF9kdW1wGAggASgIIjMKD1BhY2thZ2VNZXRhZGF0YRIMCgRuYW1lGAEgASgJEhIKCmRlZmluaXRpb24YAiABKAkilgIKDFRhc2tNZXRhZGF0YRIMCgRuYW1lGAEgASgJEgoKAmlkGAIgASgJEg0KBW93bmVyGAMgASgJEg8KB2NvbW1lbnQYBCABKAkSEQoJd2FyZWhvdXNlGAUgASgJEhAKCHNjaGVkdWxlGAYgASgJEhQKDHByZWRlY2Vzc29ycxgHIAMoCRIuCgVzdGF0ZRgIIAEoDjIfLmJ5dGViYXNlLnYxLlRhc2tNZXRhZGF0YS5TdGF0ZRIRCgljb25kaXRpb24YCSABKAkSEgoKZGVmaW5pdGlvbhgKIAEoCSI6CgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEgsKB1NUQVJURUQQARINCglTVVNQRU5ERUQQAiLLAgoOU3RyZWFtTWV0YWRhdGESDAoEbmFtZRgBIAEoCRISCgp0YWJsZV9uYW1lGAIgASgJEg0KBW93bmVyGAMgASgJEg8KB2NvbW1lbnQYBCABKAkSLgoEdHlwZRgFIAEoDjIgLmJ5dGViYXNlLnYxLlN0cmVhbU1ldGFkYXRhLlR5cGUSDQoFc3RhbGUYBiABKAgSLgoEbW9kZRgHIAEoDjIgLmJ5dGViYXNlLnYxLlN0cmVhbU1ldGFkYXRhLk1vZGUSEgoKZGVmaW5pdGlvbhgIIAEoCSInCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVERUxUQRABIksKBE1vZGUSFAoQTU9ERV9VTlNQRUNJRklFRBAAEgsKB0RFRkFVTFQQARIPCgtBUFBFTkRfT05MWRACEg8KC0lOU0VSVF9PTkxZEAMivQEKElNwYXRpYWxJbmRleENvbmZpZxIOCgZtZXRob2QYASABKAkSNQoMdGVzc2VsbGF0aW9uGAIgASgLMh8uYnl0ZWJhc2UudjEuVGVzc2VsbGF0aW9uQ29uZmlnEisKB3N0b3JhZ2UYAyABKAsyGi5ieXRlYmFzZS52MS5TdG9yYWdlQ29uZmlnEjMKC2RpbWVuc2lvbmFsGAQgASgLMh4uYnl0ZWJhc2UudjEuRGltZW5zaW9uYWxDb25maWcimwEKElRlc3NlbGxhdGlvbkNvbmZpZxIOCgZzY2hlbWUYASABKAkSKwoLZ3JpZF9sZXZlbHMYAiADKAsyFi5ieXRlYmFzZS52MS5HcmlkTGV2ZWwSGAoQY2VsbHNfcGVyX29iamVjdBgDIAEoBRIuCgxib3VuZGluZ19ib3gYBCABKAsyGC5ieXRlYmFzZS52MS5Cb3VuZGluZ0JveCIrCglHcmlkTGV2ZWwSDQoFbGV2ZWwYASABKAUSDwoHZGVuc2l0eRgCIAEoCSJFCgtCb3VuZGluZ0JveBIMCgR4bWluGAEgASgBEgwKBHltaW4YAiABKAESDAoEeG1heBgDIAEoARIMCgR5bWF4GAQgASgBIr4CCg1TdG9yYWdlQ29uZmlnEhIKCmZpbGxmYWN0b3IYASABKAUSEQoJYnVmZmVyaW5nGAIgASgJEhIKCnRhYmxlc3BhY2UYAyABKAkSFwoPd29ya190YWJsZXNwYWNlGAQgASgJEhEKCXNkb19sZXZlbBgFIAEoBRIXCg9jb21taXRfaW50ZXJ2YWwYBiABKAUSEQoJcGFkX2luZGV4GAcgASgIEhYKDnNvcnRfaW5fdGVtcGRiGAggASgJEhUKDWRyb3BfZXhpc3RpbmcYCSABKAgSDgoGb25saW5lGAogASgIEhcKD2FsbG93X3Jvd19sb2NrcxgLIAEoCBIYChBhbGxvd19wYWdlX2xvY2tzGAwgASgIEg4KBm1heGRvcBgNIAEoBRIYChBkYXRhX2NvbXByZXNzaW9uGA4gASgJIn8KEURpbWVuc2lvbmFsQ29uZmlnEhIKCmRpbWVuc2lvbnMYASABKAUSEQoJZGF0YV90eXBlGAIgASgJEgwKBHNyaWQYAyABKAUSNQoLY29uc3RyYWludHMYBCADKAsyIC5ieXRlYmFzZS52MS5EaW1lbnNpb25Db25zdHJhaW50ImEKE0RpbWVuc2lvbkNvbnN0cmFpbnQSEQoJZGltZW5zaW9uGAEgASgJEhEKCW1pbl92YWx1ZRgCIAEoARIRCgltYXhfdmFsdWUYAyABKAESEQoJdG9sZXJhbmNlGAQgASgBIo0DCg1JbmRleE1ldGFkYXRhEgwKBG5hbWUYASABKAkSEwoLZXhwcmVzc2lvbnMYAiADKAkSEgoKa2V5X2xlbmd0aBgJIAMoAxISCgpkZXNjZW5kaW5nGAogAygIEgwKBHR5cGUYAyABKAkSDgoGdW5pcXVlGAQgASgIEg8KB3ByaW1hcnkYBSABKAgSDwoHdmlzaWJsZRgGIAEoCBIPCgdjb21tZW50GAcgASgJEhIKCmRlZmluaXRpb24YCCABKAkSGwoTcGFyZW50X2luZGV4X3NjaGVtYRgLIAEoCRIZChFwYXJlbnRfaW5kZXhfbmFtZRgMIAEoCRITCgtncmFudWxhcml0eRgNIAEoAxIVCg1pc19jb25zdHJhaW50GA4gASgIEjcKDnNwYXRpYWxfY29uZmlnGA8gASgLMh8uYnl0ZWJhc2UudjEuU3BhdGlhbEluZGV4Q29uZmlnEhUKDW9wY2xhc3NfbmFtZXMYECADKAkSGAoQb3BjbGFzc19kZWZhdWx0cxgRIAMoCCJXChFFeHRlbnNpb25NZXRhZGF0YRIMCgRuYW1lGAEgASgJEg4KBnNjaGVtYRgCIAEoCRIPCgd2ZXJzaW9uGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJIr4BChJGb3JlaWduS2V5TWV0YWRhdGESDAoEbmFtZRgBIAEoCRIPCgdjb2x1bW5zGAIgAygJEhkKEXJlZmVyZW5jZWRfc2NoZW1hGAMgASgJEhgKEHJlZmVyZW5jZWRfdGFibGUYBCABKAkSGgoScmVmZXJlbmNlZF9jb2x1bW5zGAUgAygJEhEKCW9uX2RlbGV0ZRgGIAEoCRIRCglvbl91cGRhdGUYByABKAkSEgoKbWF0Y2hfdHlwZRgIIAEoCSIgCg5EYXRhYmFzZVNjaGVtYRIOCgZzY2hlbWEYASABKAkiPgoRRGF0YWJhc2VTRExTY2hlbWESDgoGc2NoZW1hGAEgASgMEhkKDGNvbnRlbnRfdHlwZRgCIAEoCUID4EEDIksKEENoYW5nZWRSZXNvdXJjZXMSNwoJZGF0YWJhc2VzGAEgAygLMiQuYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlRGF0YWJhc2UiXAoXQ2hhbmdlZFJlc291cmNlRGF0YWJhc2USDAoEbmFtZRgBIAEoCRIzCgdzY2hlbWFzGAIgAygLMiIuYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlU2NoZW1hIv0BChVDaGFuZ2VkUmVzb3VyY2VTY2hlbWESDAoEbmFtZRgBIAEoCRIxCgZ0YWJsZXMYAiADKAsyIS5ieXRlYmFzZS52MS5DaGFuZ2VkUmVzb3VyY2VUYWJsZRIvCgV2aWV3cxgDIAMoCzIgLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZVZpZXcSNwoJZnVuY3Rpb25zGAQgAygLMiQuYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlRnVuY3Rpb24SOQoKcHJvY2VkdXJlcxgFIAMoCzIlLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZVByb2NlZHVyZSJIChRDaGFuZ2VkUmVzb3VyY2VUYWJsZRIMCgRuYW1lGAEgASgJEiIKBnJhbmdlcxgDIAMoCzISLmJ5dGViYXNlLnYxLlJhbmdlIkcKE0NoYW5nZWRSZXNvdXJjZVZpZXcSDAoEbmFtZRgBIAEoCRIiCgZyYW5nZXMYAiADKAsyEi5ieXRlYmFzZS52MS5SYW5nZSJLChdDaGFuZ2VkUmVzb3VyY2VGdW5jdGlvbhIMCgRuYW1lGAEgASgJEiIKBnJhbmdlcxgCIAMoCzISLmJ5dGViYXNlLnYxLlJhbmdlIkwKGENoYW5nZWRSZXNvdXJjZVByb2NlZHVyZRIMCgRuYW1lGAEgASgJEiIKBnJhbmdlcxgCIAMoCzISLmJ5dGViYXNlLnYxLlJhbmdlIqcBChVMaXN0Q2hhbmdlbG9nc1JlcXVlc3QSLQoGcGFyZW50GAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCRIoCgR2aWV3GAQgASgOMhouYnl0ZWJhc2UudjEuQ2hhbmdlbG9nVmlldxIOCgZmaWx0ZXIYBSABKAkiXQoWTGlzdENoYW5nZWxvZ3NSZXNwb25zZRIqCgpjaGFuZ2Vsb2dzGAEgAygLMhYuYnl0ZWJhc2UudjEuQ2hhbmdlbG9nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJ1ChNHZXRDaGFuZ2Vsb2dSZXF1ZXN0EjQKBG5hbWUYASABKAlCJuBBAvpBIAoeYnl0ZWJhc2UuY29tL0RhdGFiYXNlQ2hhbmdlbG9nEigKBHZpZXcYAiABKA4yGi5ieXRlYmFzZS52MS5DaGFuZ2Vsb2dWaWV3IqgFCglDaGFuZ2Vsb2cSDAoEbmFtZRgBIAEoCRIvCgtjcmVhdGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoGc3RhdHVzGAQgASgOMh0uYnl0ZWJhc2UudjEuQ2hhbmdlbG9nLlN0YXR1cxIRCglzdGF0ZW1lbnQYBSABKAkSFgoOc3RhdGVtZW50X3NpemUYBiABKAMSFwoPc3RhdGVtZW50X3NoZWV0GAcgASgJEg4KBnNjaGVtYRgIIAEoCRITCgtzY2hlbWFfc2l6ZRgJIAEoAxITCgtwcmV2X3NjaGVtYRgKIAEoCRIYChBwcmV2X3NjaGVtYV9zaXplGAsgASgDEg0KBWlzc3VlGAwgASgJEhAKCHRhc2tfcnVuGA0gASgJEg8KB3ZlcnNpb24YDiABKAkSEAoIcmV2aXNpb24YDyABKAkSOAoRY2hhbmdlZF9yZXNvdXJjZXMYECABKAsyHS5ieXRlYmFzZS52MS5DaGFuZ2VkUmVzb3VyY2VzEikKBHR5cGUYESABKA4yGy5ieXRlYmFzZS52MS5DaGFuZ2Vsb2cuVHlwZSJDCgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEggKBERPTkUQAhIKCgZGQUlMRUQQAyJACgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIMCghCQVNFTElORRABEgsKB01JR1JBVEUQAhIHCgNTREwQAzpl6kFiCh5ieXRlYmFzZS5jb20vRGF0YWJhc2VDaGFuZ2Vsb2cSQGluc3RhbmNlcy97aW5zdGFuY2V9L2RhdGFiYXNlcy97ZGF0YWJhc2V9L2NoYW5nZWxvZ3Mve2NoYW5nZWxvZ30iRAoVR2V0U2NoZW1hRHJpZnRSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlIqEBCgtTY2hlbWFEcmlmdBIMCgRuYW1lGAEgASgJEg8KB2RyaWZ0ZWQYAiABKAgSEQoJY2hhbmdlbG9nGAMgASgJEi8KC2RldGVjdF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgdvYmplY3RzGAUgAygLMh4uYnl0ZWJhc2UudjEuU2NoZW1hRHJpZnRPYmplY3Qi4wMKEVNjaGVtYURyaWZ0T2JqZWN0EjEKBHR5cGUYASABKA4yIy5ieXRlYmFzZS52MS5TY2hlbWFEcmlmdE9iamVjdC5UeXBlEjUKBmFjdGlvbhgCIAEoDjIlLmJ5dGViYXNlLnYxLlNjaGVtYURyaWZ0T2JqZWN0LkFjdGlvbhIOCgZzY2hlbWEYAyABKAkSDQoFdGFibGUYBCABKAkSDAoEbmFtZRgFIAEoCSLvAQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCgoGU0NIRU1BEAESCQoFVEFCTEUQAhIKCgZDT0xVTU4QAxIJCgVJTkRFWBAEEg4KCkNPTlNUUkFJTlQQBRIICgRWSUVXEAYSFQoRTUFURVJJQUxJWkVEX1ZJRVcQBxIMCghGVU5DVElPThAIEg0KCVBST0NFRFVSRRAJEgsKB1RSSUdHRVIQChIMCghTRVFVRU5DRRALEg0KCUVOVU1fVFlQRRAMEg0KCUVYVEVOU0lPThANEhEKDUVWRU5UX1RSSUdHRVIQDhIJCgVFVkVOVBAPIkUKBkFjdGlvbhIWChJBQ1RJT05fVU5TUEVDSUZJRUQQABIJCgVBRERFRBABEgsKB1JFTU9WRUQQAhILCgdDSEFOR0VEEAMi0QEKG1JlY29uY2lsZVNjaGVtYURyaWZ0UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRJICghzdHJhdGVneRgCIAEoDjIxLmJ5dGViYXNlLnYxLlJlY29uY2lsZVNjaGVtYURyaWZ0UmVxdWVzdC5TdHJhdGVneUID4EECIjsKCFN0cmF0ZWd5EhgKFFNUUkFURUdZX1VOU1BFQ0lGSUVEEAASCgoGUkVWRVJUEAESCQoFQURPUFQQAiJSChxSZWNvbmNpbGVTY2hlbWFEcmlmdFJlc3BvbnNlEgwKBHBsYW4YASABKAkSEQoJY2hhbmdlbG9nGAIgASgJEhEKCXN0YXRlbWVudBgDIAEoCSLxAgoWR2V0U2NoZW1hU3RyaW5nUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRI8CgR0eXBlGAIgASgOMi4uYnl0ZWJhc2UudjEuR2V0U2NoZW1hU3RyaW5nUmVxdWVzdC5PYmplY3RUeXBlEg4KBnNjaGVtYRgDIAEoCRIOCgZvYmplY3QYBCABKAkSLwoIbWV0YWRhdGEYBSABKAsyHS5ieXRlYmFzZS52MS5EYXRhYmFzZU1ldGFkYXRhIpoBCgpPYmplY3RUeXBlEhsKF09CSkVDVF9UWVBFX1VOU1BFQ0lGSUVEEAASDAoIREFUQUJBU0UQARIKCgZTQ0hFTUEQAhIJCgVUQUJMRRADEggKBFZJRVcQBBIVChFNQVRFUklBTElaRURfVklFVxAFEgwKCEZVTkNUSU9OEAYSDQoJUFJPQ0VEVVJFEAcSDAoIU0VRVUVOQ0UQCCIwChdHZXRTY2hlbWFTdHJpbmdSZXNwb25zZRIVCg1zY2hlbWFfc3RyaW5nGAEgASgJKmIKDUNoYW5nZWxvZ1ZpZXcSHgoaQ0hBTkdFTE9HX1ZJRVdfVU5TUEVDSUZJRUQQABIYChRDSEFOR0VMT0dfVklFV19CQVNJQxABEhcKE0NIQU5HRUxPR19WSUVXX0ZVTEwQAjLvFwoPRGF0YWJhc2VTZXJ2aWNlEpABCgtHZXREYXRhYmFzZRIfLmJ5dGViYXNlLnYxLkdldERhdGFiYXNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkRhdGFiYXNlIknaQQRuYW1liuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABgtPkkwIkEiIvdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9Et0BChFCYXRjaEdldERhdGFiYXNlcxIlLmJ5dGViYXNlLnYxLkJhdGNoR2V0RGF0YWJhc2VzUmVxdWVzdBomLmJ5dGViYXNlLnYxLkJhdGNoR2V0RGF0YWJhc2VzUmVzcG9uc2UieYrqMBBiYi5kYXRhYmFzZXMuZ2V0kOowAoLT5JMCW1otEisvdjEve3BhcmVudD1pbnN0YW5jZXMvKn0vZGF0YWJhc2VzOmJhdGNoR2V0EiovdjEve3BhcmVudD1wcm9qZWN0cy8qfS9kYXRhYmFzZXM6YmF0Y2hHZXQS6wEKDUxpc3REYXRhYmFzZXMSIS5ieXRlYmFzZS52MS5MaXN0RGF0YWJhc2VzUmVxdWVzdBoiLmJ5dGViYXNlLnYxLkxpc3REYXRhYmFzZXNSZXNwb25zZSKSAdpBAIrqMBFiYi5kYXRhYmFzZXMubGlzdJDqMAKC0+STAnBaJBIiL3YxL3twYXJlbnQ9aW5zdGFuY2VzLyp9L2RhdGFiYXNlc1olEiMvdjEve3BhcmVudD13b3Jrc3BhY2VzLyp9L2RhdGFiYXNlcxIhL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vZGF0YWJhc2VzEsABCg5VcGRhdGVEYXRhYmFzZRIiLmJ5dGViYXNlLnYxLlVwZGF0ZURhdGFiYXNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkRhdGFiYXNlInPaQRRkYXRhYmFzZSx1cGRhdGVfbWFza4rqMBNiYi5kYXRhYmFzZXMudXBkYXRlkOowAZjqMAGC0+STAjc6CGRhdGFiYXNlMisvdjEve2RhdGFiYXNlLm5hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9EsUBChRCYXRjaFVwZGF0ZURhdGFiYXNlcxIoLmJ5dGViYXNlLnYxLkJhdGNoVXBkYXRlRGF0YWJhc2VzUmVxdWVzdBopLmJ5dGViYXNlLnYxLkJhdGNoVXBkYXRlRGF0YWJhc2VzUmVzcG9uc2UiWIrqMBNiYi5kYXRhYmFzZXMudXBkYXRlkOowAZjqMAGC0+STAjM6ASoiLi92MS97cGFyZW50PWluc3RhbmNlcy8qfS9kYXRhYmFzZXM6YmF0Y2hVcGRhdGUSoAEKDFN5bmNEYXRhYmFzZRIgLmJ5dGViYXNlLnYxLlN5bmNEYXRhYmFzZVJlcXVlc3QaIS5ieXRlYmFzZS52MS5TeW5jRGF0YWJhc2VSZXNwb25zZSJLiuowEWJiLmRhdGFiYXNlcy5zeW5jkOowAYLT5JMCLDoBKiInL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfTpzeW5jErcBChJCYXRjaFN5bmNEYXRhYmFzZXMSJi5ieXRlYmFzZS52MS5CYXRjaFN5bmNEYXRhYmFzZXNSZXF1ZXN0GicuYnl0ZWJhc2UudjEuQmF0Y2hTeW5jRGF0YWJhc2VzUmVzcG9uc2UiUIrqMBFiYi5kYXRhYmFzZXMuc3luY5DqMAGC0+STAjE6ASoiLC92MS97cGFyZW50PWluc3RhbmNlcy8qfS9kYXRhYmFzZXM6YmF0Y2hTeW5jErABChNHZXREYXRhYmFzZU1ldGFkYXRhEicuYnl0ZWJhc2UudjEuR2V0RGF0YWJhc2VNZXRhZGF0YVJlcXVlc3QaHS5ieXRlYmFzZS52MS5EYXRhYmFzZU1ldGFkYXRhIlGK6jAWYmIuZGF0YWJhc2VzLmdldFNjaGVtYZDqMAGC0+STAi0SKy92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9tZXRhZGF0YX0SqAEKEUdldERhdGFiYXNlU2NoZW1hEiUuYnl0ZWJhc2UudjEuR2V0RGF0YWJhc2VTY2hlbWFSZXF1ZXN0GhsuYnl0ZWJhc2UudjEuRGF0YWJhc2VTY2hlbWEiT4rqMBZiYi5kYXRhYmFzZXMuZ2V0U2NoZW1hkOowAYLT5JMCKxIpL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3NjaGVtYX0StAEKFEdldERhdGFiYXNlU0RMU2NoZW1hEiguYnl0ZWJhc2UudjEuR2V0RGF0YWJhc2VTRExTY2hlbWFSZXF1ZXN0Gh4uYnl0ZWJhc2UudjEuRGF0YWJhc2VTRExTY2hlbWEiUorqMBZiYi5kYXRhYmFzZXMuZ2V0U2NoZW1hkOowAYLT5JMCLhIsL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3NkbFNjaGVtYX0S4QEKCkRpZmZTY2hlbWESHi5ieXRlYmFzZS52MS5EaWZmU2NoZW1hUmVxdWVzdBofLmJ5dGViYXNlLnYxLkRpZmZTY2hlbWFSZXNwb25zZSKRAYrqMBBiYi5kYXRhYmFzZXMuZ2V0kOowAYLT5JMCczoBKlo/OgEqIjovdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyovY2hhbmdlbG9ncy8qfTpkaWZmU2NoZW1hIi0vdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9OmRpZmZTY2hlbWEStQEKDkxpc3RDaGFuZ2Vsb2dzEiIuYnl0ZWJhc2UudjEuTGlzdENoYW5nZWxvZ3NSZXF1ZXN0GiMuYnl0ZWJhc2UudjEuTGlzdENoYW5nZWxvZ3NSZXNwb25zZSJa2kEGcGFyZW50iuowEmJiLmNoYW5nZWxvZ3MubGlzdJDqMAGC0+STAjESLy92MS97cGFyZW50PWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfS9jaGFuZ2Vsb2dzEqEBCgxHZXRDaGFuZ2Vsb2cSIC5ieXRlYmFzZS52MS5HZXRDaGFuZ2Vsb2dSZXF1ZXN0GhYuYnl0ZWJhc2UudjEuQ2hhbmdlbG9nIlfaQQRuYW1liuowEWJiLmNoYW5nZWxvZ3MuZ2V0kOowAYLT5JMCMRIvL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL2NoYW5nZWxvZ3MvKn0SqwEKDkdldFNjaGVtYURyaWZ0EiIuYnl0ZWJhc2UudjEuR2V0U2NoZW1hRHJpZnRSZXF1ZXN0GhguYnl0ZWJhc2UudjEuU2NoZW1hRHJpZnQiW9pBBG5hbWWK6jAWYmIuZGF0YWJhc2VzLmdldFNjaGVtYZDqMAGC0+STAjASLi92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9zY2hlbWFEcmlmdH0S0gEKFFJlY29uY2lsZVNjaGVtYURyaWZ0EiguYnl0ZWJhc2UudjEuUmVjb25jaWxlU2NoZW1hRHJpZnRSZXF1ZXN0GikuYnl0ZWJhc2UudjEuUmVjb25jaWxlU2NoZW1hRHJpZnRSZXNwb25zZSJl2kEEbmFtZYrqMBNiYi5kYXRhYmFzZXMudXBkYXRlkOowAYLT5JMCPToBKiI4L3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3NjaGVtYURyaWZ0fTpyZWNvbmNpbGUSugEKD0dldFNjaGVtYVN0cmluZxIjLmJ5dGViYXNlLnYxLkdldFNjaGVtYVN0cmluZ1JlcXVlc3QaJC5ieXRlYmFzZS52MS5HZXRTY2hlbWFTdHJpbmdSZXNwb25zZSJc2kEEbmFtZYrqMBZiYi5kYXRhYmFzZXMuZ2V0U2NoZW1hkOowAYLT5JMCMRIvL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3NjaGVtYVN0cmluZ31CqgEKD2NvbS5ieXRlYmFzZS52MUIURGF0YWJhc2VTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_instance_service]);
 
 /**
  * Describes the message bytebase.v1.GetDatabaseRequest.
@@ -570,18 +570,93 @@ export const Changelog_TypeSchema = /*@__PURE__*/
 export const Changelog_Type = /*@__PURE__*/
   tsEnum(Changelog_TypeSchema);
 
+/**
+ * Describes the message bytebase.v1.GetSchemaDriftRequest.
+ * Use `create(GetSchemaDriftRequestSchema)` to create a new message.
+ */
+export const GetSchemaDriftRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_database_service, 62);
+
+/**
+ * Describes the message bytebase.v1.SchemaDrift.
+ * Use `create(SchemaDriftSchema)` to create a new message.
+ */
+export const SchemaDriftSchema = /*@__PURE__*/
+  messageDesc(file_v1_database_service, 63);
+
+/**
+ * Describes the message bytebase.v1.SchemaDriftObject.
+ * Use `create(SchemaDriftObjectSchema)` to create a new message.
+ */
+export const SchemaDriftObjectSchema = /*@__PURE__*/
+  messageDesc(file_v1_database_service, 64);
+
+/**
+ * Describes the enum bytebase.v1.SchemaDriftObject.Type.
+ */
+export const SchemaDriftObject_TypeSchema = /*@__PURE__*/
+  enumDesc(file_v1_database_service, 64, 0);
+
+/**
+ * The type of the database object.
+ *
+ * @generated from enum bytebase.v1.SchemaDriftObject.Type
+ */
+export const SchemaDriftObject_Type = /*@__PURE__*/
+  tsEnum(SchemaDriftObject_TypeSchema);
+
+/**
+ * Describes the enum bytebase.v1.SchemaDriftObject.Action.
+ */
+export const SchemaDriftObject_ActionSchema = /*@__PURE__*/
+  enumDesc(file_v1_database_service, 64, 1);
+
+/**
+ * The kind of the drift.
+ *
+ * @generated from enum bytebase.v1.SchemaDriftObject.Action
+ */
+export const SchemaDriftObject_Action = /*@__PURE__*/
+  tsEnum(SchemaDriftObject_ActionSchema);
+
+/**
+ * Describes the message bytebase.v1.ReconcileSchemaDriftRequest.
+ * Use `create(ReconcileSchemaDriftRequestSchema)` to create a new message.
+ */
+export const ReconcileSchemaDriftRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_database_service, 65);
+
+/**
+ * Describes the enum bytebase.v1.ReconcileSchemaDriftRequest.Strategy.
+ */
+export const ReconcileSchemaDriftRequest_StrategySchema = /*@__PURE__*/
+  enumDesc(file_v1_database_service, 65, 0);
+
+/**
+ * @generated from enum bytebase.v1.ReconcileSchemaDriftRequest.Strategy
+ */
+export const ReconcileSchemaDriftRequest_Strategy = /*@__PURE__*/
+  tsEnum(ReconcileSchemaDriftRequest_StrategySchema);
+
+/**
+ * Describes the message bytebase.v1.ReconcileSchemaDriftResponse.
+ * Use `create(ReconcileSchemaDriftResponseSchema)` to create a new message.
+ */
+export const ReconcileSchemaDriftResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_database_service, 66);
+
 /**
  * Describes the message bytebase.v1.GetSchemaStringRequest.
  * Use `create(GetSchemaStringRequestSchema)` to create a new message.
  */
 export const GetSchemaStringRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_database_service, 62);
+  messageDesc(file_v1_database_service, 67);
 
 /**
  * Describes the enum bytebase.v1.GetSchemaStringRequest.ObjectType.
  */
 export const GetSchemaStringRequest_ObjectTypeSchema = /*@__PURE__*/
-  enumDesc(file_v1_database_service, 62, 0);
+  enumDesc(file_v1_database_service, 67, 0);
 
 /**
  * @generated from enum bytebase.v1.GetSchemaStringRequest.ObjectType
@@ -594,7 +669,7 @@ export const GetSchemaStringRequest_ObjectType = /*@__PURE__*/
  * Use `create(GetSchemaStringResponseSchema)` to create a new message.
  */
 export const GetSchemaStringResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_database_service, 63);
+  messageDesc(file_v1_database_service, 68);
 
 /**
  * Describes the enum bytebase.v1.ChangelogView.
@@ -179,6 +179,14 @@ export declare type TestIdentityProviderRequest = Message<"bytebase.v1.TestIdent
      */
     value: OIDCIdentityProviderTestRequestContext;
     case: "oidcContext";
+  } | {
+    /**
+     * SAML authentication context for test connection.
+     *
+     * @generated from field: bytebase.v1.SAMLIdentityProviderTestRequestContext saml_context = 4;
+     */
+    value: SAMLIdentityProviderTestRequestContext;
+    case: "samlContext";
   } | { case: undefined; value?: undefined };
 };
 
@@ -224,6 +232,25 @@ export declare type OIDCIdentityProviderTestRequestContext = Message<"bytebase.v
  */
 export declare const OIDCIdentityProviderTestRequestContextSchema: GenMessage<OIDCIdentityProviderTestRequestContext>;
 
+/**
+ * @generated from message bytebase.v1.SAMLIdentityProviderTestRequestContext
+ */
+export declare type SAMLIdentityProviderTestRequestContext = Message<"bytebase.v1.SAMLIdentityProviderTestRequestContext"> & {
+  /**
+   * The base64 encoded SAMLResponse posted by the SAML identity provider.
+   * When empty, only the configuration is validated.
+   *
+   * @generated from field: string saml_response = 1;
+   */
+  samlResponse: string;
+};
+
+/**
+ * Describes the message bytebase.v1.SAMLIdentityProviderTestRequestContext.
+ * Use `create(SAMLIdentityProviderTestRequestContextSchema)` to create a new message.
+ */
+export declare const SAMLIdentityProviderTestRequestContextSchema: GenMessage<SAMLIdentityProviderTestRequestContext>;
+
 /**
  * @generated from message bytebase.v1.TestIdentityProviderResponse
  */
@@ -327,6 +354,14 @@ export declare type IdentityProviderConfig = Message<"bytebase.v1.IdentityProvid
      */
     value: LDAPIdentityProviderConfig;
     case: "ldapConfig";
+  } | {
+    /**
+     * SAML 2.0 protocol configuration.
+     *
+     * @generated from field: bytebase.v1.SAMLIdentityProviderConfig saml_config = 4;
+     */
+    value: SAMLIdentityProviderConfig;
+    case: "samlConfig";
   } | { case: undefined; value?: undefined };
 };
 
@@ -594,6 +629,84 @@ export enum LDAPIdentityProviderConfig_SecurityProtocol {
  */
 export declare const LDAPIdentityProviderConfig_SecurityProtocolSchema: GenEnum<LDAPIdentityProviderConfig_SecurityProtocol>;
 
+/**
+ * SAMLIdentityProviderConfig is the structure for SAML 2.0 identity provider config.
+ * Bytebase acts as the service provider (SP).
+ *
+ * @generated from message bytebase.v1.SAMLIdentityProviderConfig
+ */
+export declare type SAMLIdentityProviderConfig = Message<"bytebase.v1.SAMLIdentityProviderConfig"> & {
+  /**
+   * The URL of the IdP metadata. Either metadata_url or metadata_xml is required.
+   *
+   * @generated from field: string metadata_url = 1;
+   */
+  metadataUrl: string;
+
+  /**
+   * The IdP metadata XML document.
+   *
+   * @generated from field: string metadata_xml = 2;
+   */
+  metadataXml: string;
+
+  /**
+   * The entity ID of the SP. When not set, the SP metadata URL is used.
+   *
+   * @generated from field: string entity_id = 3;
+   */
+  entityId: string;
+
+  /**
+   * The PEM encoded X.509 certificate of the SP.
+   *
+   * @generated from field: string certificate = 4;
+   */
+  certificate: string;
+
+  /**
+   * The PEM encoded private key of the SP, used to sign the AuthnRequest.
+   *
+   * @generated from field: string private_key = 5;
+   */
+  privateKey: string;
+
+  /**
+   * Mapping configuration for user attributes from the SAML assertion.
+   * When the identifier is not set, the NameID of the assertion subject is used.
+   *
+   * @generated from field: bytebase.v1.FieldMapping field_mapping = 6;
+   */
+  fieldMapping?: FieldMapping;
+
+  /**
+   * Whether to skip TLS certificate verification when fetching the IdP metadata.
+   *
+   * @generated from field: bool skip_tls_verify = 7;
+   */
+  skipTlsVerify: boolean;
+
+  /**
+   * The SP metadata URL to register in the IdP.
+   *
+   * @generated from field: string sp_metadata_url = 8;
+   */
+  spMetadataUrl: string;
+
+  /**
+   * The assertion consumer service URL to register in the IdP.
+   *
+   * @generated from field: string acs_url = 9;
+   */
+  acsUrl: string;
+};
+
+/**
+ * Describes the message bytebase.v1.SAMLIdentityProviderConfig.
+ * Use `create(SAMLIdentityProviderConfigSchema)` to create a new message.
+ */
+export declare const SAMLIdentityProviderConfigSchema: GenMessage<SAMLIdentityProviderConfig>;
+
 /**
  * FieldMapping saves the field names from user info API of identity provider.
  * As we save all raw json string of user info response data into `principal.idp_user_info`,
@@ -669,6 +782,13 @@ export enum IdentityProviderType {
    * @generated from enum value: LDAP = 3;
    */
   LDAP = 3,
+
+  /**
+   * SAML 2.0 authentication protocol.
+   *
+   * @generated from enum value: SAML = 4;
+   */
+  SAML = 4,
 }
 
 /**
@@ -15,7 +15,7 @@ import { file_v1_annotation } from "./annotation_pb";
  * Describes the file v1/idp_service.proto.
  */
 export const file_v1_idp_service = /*@__PURE__*/
-  fileDesc("ChR2MS9pZHBfc2VydmljZS5wcm90bxILYnl0ZWJhc2UudjEiRAoaR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSJgoEbmFtZRgBIAEoCUIY4EEC+kESChBieXRlYmFzZS5jb20vSWRQIh4KHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QiWgodTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2USOQoSaWRlbnRpdHlfcHJvdmlkZXJzGAEgAygLMh0uYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlciKTAQodQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPQoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyQgPgQQISHAoUaWRlbnRpdHlfcHJvdmlkZXJfaWQYAiABKAkSFQoNdmFsaWRhdGVfb25seRgDIAEoCCKmAQodVXBkYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPQoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyQgPgQQISLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEhUKDWFsbG93X21pc3NpbmcYAyABKAgiRwodRGVsZXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSJgoEbmFtZRgBIAEoCUIY4EEC+kESChBieXRlYmFzZS5jb20vSWRQIoACChtUZXN0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSOAoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyEk8KDm9hdXRoMl9jb250ZXh0GAIgASgLMjUuYnl0ZWJhc2UudjEuT0F1dGgySWRlbnRpdHlQcm92aWRlclRlc3RSZXF1ZXN0Q29udGV4dEgAEksKDG9pZGNfY29udGV4dBgDIAEoCzIzLmJ5dGViYXNlLnYxLk9JRENJZGVudGl0eVByb3ZpZGVyVGVzdFJlcXVlc3RDb250ZXh0SABCCQoHY29udGV4dCI4CihPQXV0aDJJZGVudGl0eVByb3ZpZGVyVGVzdFJlcXVlc3RDb250ZXh0EgwKBGNvZGUYASABKAkiNgomT0lEQ0lkZW50aXR5UHJvdmlkZXJUZXN0UmVxdWVzdENvbnRleHQSDAoEY29kZRgBIAEoCSKRAgocVGVzdElkZW50aXR5UHJvdmlkZXJSZXNwb25zZRJFCgZjbGFpbXMYASADKAsyNS5ieXRlYmFzZS52MS5UZXN0SWRlbnRpdHlQcm92aWRlclJlc3BvbnNlLkNsYWltc0VudHJ5EkoKCXVzZXJfaW5mbxgCIAMoCzI3LmJ5dGViYXNlLnYxLlRlc3RJZGVudGl0eVByb3ZpZGVyUmVzcG9uc2UuVXNlckluZm9FbnRyeRotCgtDbGFpbXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGi8KDVVzZXJJbmZvRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLYAQoQSWRlbnRpdHlQcm92aWRlchIMCgRuYW1lGAEgASgJEhcKBXRpdGxlGAQgASgJQgi6SAVyAxjIARIOCgZkb21haW4YBSABKAkSLwoEdHlwZRgGIAEoDjIhLmJ5dGViYXNlLnYxLklkZW50aXR5UHJvdmlkZXJUeXBlEjMKBmNvbmZpZxgHIAEoCzIjLmJ5dGViYXNlLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWc6IepBHgoQYnl0ZWJhc2UuY29tL0lkUBIKaWRwcy97aWRwfUoECAIQAyLmAQoWSWRlbnRpdHlQcm92aWRlckNvbmZpZxJCCg1vYXV0aDJfY29uZmlnGAEgASgLMikuYnl0ZWJhc2UudjEuT0F1dGgySWRlbnRpdHlQcm92aWRlckNvbmZpZ0gAEj4KC29pZGNfY29uZmlnGAIgASgLMicuYnl0ZWJhc2UudjEuT0lEQ0lkZW50aXR5UHJvdmlkZXJDb25maWdIABI+CgtsZGFwX2NvbmZpZxgDIAEoCzInLmJ5dGViYXNlLnYxLkxEQVBJZGVudGl0eVByb3ZpZGVyQ29uZmlnSABCCAoGY29uZmlnIpECChxPQXV0aDJJZGVudGl0eVByb3ZpZGVyQ29uZmlnEhAKCGF1dGhfdXJsGAEgASgJEhEKCXRva2VuX3VybBgCIAEoCRIVCg11c2VyX2luZm9fdXJsGAMgASgJEhEKCWNsaWVudF9pZBgEIAEoCRIVCg1jbGllbnRfc2VjcmV0GAUgASgJEg4KBnNjb3BlcxgGIAMoCRIwCg1maWVsZF9tYXBwaW5nGAcgASgLMhkuYnl0ZWJhc2UudjEuRmllbGRNYXBwaW5nEhcKD3NraXBfdGxzX3ZlcmlmeRgIIAEoCBIwCgphdXRoX3N0eWxlGAkgASgOMhwuYnl0ZWJhc2UudjEuT0F1dGgyQXV0aFN0eWxlIv8BChpPSURDSWRlbnRpdHlQcm92aWRlckNvbmZpZxIOCgZpc3N1ZXIYASABKAkSEQoJY2xpZW50X2lkGAIgASgJEhUKDWNsaWVudF9zZWNyZXQYAyABKAkSDgoGc2NvcGVzGAQgAygJEjAKDWZpZWxkX21hcHBpbmcYBSABKAsyGS5ieXRlYmFzZS52MS5GaWVsZE1hcHBpbmcSFwoPc2tpcF90bHNfdmVyaWZ5GAYgASgIEjAKCmF1dGhfc3R5bGUYByABKA4yHC5ieXRlYmFzZS52MS5PQXV0aDJBdXRoU3R5bGUSGgoNYXV0aF9lbmRwb2ludBgIIAEoCUID4EEDIvcCChpMREFQSWRlbnRpdHlQcm92aWRlckNvbmZpZxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAUSFwoPc2tpcF90bHNfdmVyaWZ5GAMgASgIEg8KB2JpbmRfZG4YBCABKAkSFQoNYmluZF9wYXNzd29yZBgFIAEoCRIPCgdiYXNlX2RuGAYgASgJEhMKC3VzZXJfZmlsdGVyGAcgASgJElMKEXNlY3VyaXR5X3Byb3RvY29sGAggASgOMjguYnl0ZWJhc2UudjEuTERBUElkZW50aXR5UHJvdmlkZXJDb25maWcuU2VjdXJpdHlQcm90b2NvbBIwCg1maWVsZF9tYXBwaW5nGAkgASgLMhkuYnl0ZWJhc2UudjEuRmllbGRNYXBwaW5nIk8KEFNlY3VyaXR5UHJvdG9jb2wSIQodU0VDVVJJVFlfUFJPVE9DT0xfVU5TUEVDSUZJRUQQABINCglTVEFSVF9UTFMQARIJCgVMREFQUxACIl0KDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVwaG9uZRgEIAEoCRIOCgZncm91cHMYBSABKAlKBAgDEAQqXgoUSWRlbnRpdHlQcm92aWRlclR5cGUSJgoiSURFTlRJVFlfUFJPVklERVJfVFlQRV9VTlNQRUNJRklFRBAAEgoKBk9BVVRIMhABEggKBE9JREMQAhIICgRMREFQEAMqUgoPT0F1dGgyQXV0aFN0eWxlEiEKHU9BVVRIMl9BVVRIX1NUWUxFX1VOU1BFQ0lGSUVEEAASDQoJSU5fUEFSQU1TEAESDQoJSU5fSEVBREVSEAIy3AgKF0lkZW50aXR5UHJvdmlkZXJTZXJ2aWNlEp8BChNHZXRJZGVudGl0eVByb3ZpZGVyEicuYnl0ZWJhc2UudjEuR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyIkDaQQRuYW1liuowGGJiLmlkZW50aXR5UHJvdmlkZXJzLmdldJDqMAGC0+STAhMSES92MS97bmFtZT1pZHBzLyp9EocBChVMaXN0SWRlbnRpdHlQcm92aWRlcnMSKS5ieXRlYmFzZS52MS5MaXN0SWRlbnRpdHlQcm92aWRlcnNSZXF1ZXN0GiouYnl0ZWJhc2UudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2UiF9pBAIDqMAGC0+STAgoSCC92MS9pZHBzErIBChZDcmVhdGVJZGVudGl0eVByb3ZpZGVyEiouYnl0ZWJhc2UudjEuQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyIk3aQQCK6jAbYmIuaWRlbnRpdHlQcm92aWRlcnMuY3JlYXRlkOowAZjqMAGC0+STAh06EWlkZW50aXR5X3Byb3ZpZGVyIggvdjEvaWRwcxKKAgoWVXBkYXRlSWRlbnRpdHlQcm92aWRlchIqLmJ5dGViYXNlLnYxLlVwZGF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Gh0uYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlciKkAdpBHWlkZW50aXR5X3Byb3ZpZGVyLHVwZGF0ZV9tYXNriuowG2JiLmlkZW50aXR5UHJvdmlkZXJzLnVwZGF0ZZDqMAGY6jABouowG2JiLmlkZW50aXR5UHJvdmlkZXJzLmNyZWF0ZYLT5JMCODoRaWRlbnRpdHlfcHJvdmlkZXIyIy92MS97aWRlbnRpdHlfcHJvdmlkZXIubmFtZT1pZHBzLyp9EqUBChZEZWxldGVJZGVudGl0eVByb3ZpZGVyEiouYnl0ZWJhc2UudjEuRGVsZXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiR9pBBG5hbWWK6jAbYmIuaWRlbnRpdHlQcm92aWRlcnMuZGVsZXRlkOowAZjqMAGC0+STAhMqES92MS97bmFtZT1pZHBzLyp9EqoBChRUZXN0SWRlbnRpdHlQcm92aWRlchIoLmJ5dGViYXNlLnYxLlRlc3RJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBopLmJ5dGViYXNlLnYxLlRlc3RJZGVudGl0eVByb3ZpZGVyUmVzcG9uc2UiPYrqMBtiYi5pZGVudGl0eVByb3ZpZGVycy51cGRhdGWQ6jABgtPkkwIUOgEqIg8vdjEvaWRwcy8qOnRlc3RCpQEKD2NvbS5ieXRlYmFzZS52MUIPSWRwU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_v1_annotation]);
+  fileDesc("ChR2MS9pZHBfc2VydmljZS5wcm90bxILYnl0ZWJhc2UudjEiRAoaR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSJgoEbmFtZRgBIAEoCUIY4EEC+kESChBieXRlYmFzZS5jb20vSWRQIh4KHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QiWgodTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2USOQoSaWRlbnRpdHlfcHJvdmlkZXJzGAEgAygLMh0uYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlciKTAQodQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPQoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyQgPgQQISHAoUaWRlbnRpdHlfcHJvdmlkZXJfaWQYAiABKAkSFQoNdmFsaWRhdGVfb25seRgDIAEoCCKmAQodVXBkYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPQoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyQgPgQQISLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEhUKDWFsbG93X21pc3NpbmcYAyABKAgiRwodRGVsZXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSJgoEbmFtZRgBIAEoCUIY4EEC+kESChBieXRlYmFzZS5jb20vSWRQIs0CChtUZXN0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSOAoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyEk8KDm9hdXRoMl9jb250ZXh0GAIgASgLMjUuYnl0ZWJhc2UudjEuT0F1dGgySWRlbnRpdHlQcm92aWRlclRlc3RSZXF1ZXN0Q29udGV4dEgAEksKDG9pZGNfY29udGV4dBgDIAEoCzIzLmJ5dGViYXNlLnYxLk9JRENJZGVudGl0eVByb3ZpZGVyVGVzdFJlcXVlc3RDb250ZXh0SAASSwoMc2FtbF9jb250ZXh0GAQgASgLMjMuYnl0ZWJhc2UudjEuU0FNTElkZW50aXR5UHJvdmlkZXJUZXN0UmVxdWVzdENvbnRleHRIAEIJCgdjb250ZXh0IjgKKE9BdXRoMklkZW50aXR5UHJvdmlkZXJUZXN0UmVxdWVzdENvbnRleHQSDAoEY29kZRgBIAEoCSI2CiZPSURDSWRlbnRpdHlQcm92aWRlclRlc3RSZXF1ZXN0Q29udGV4dBIMCgRjb2RlGAEgASgJIj8KJlNBTUxJZGVudGl0eVByb3ZpZGVyVGVzdFJlcXVlc3RDb250ZXh0EhUKDXNhbWxfcmVzcG9uc2UYASABKAkikQIKHFRlc3RJZGVudGl0eVByb3ZpZGVyUmVzcG9uc2USRQoGY2xhaW1zGAEgAygLMjUuYnl0ZWJhc2UudjEuVGVzdElkZW50aXR5UHJvdmlkZXJSZXNwb25zZS5DbGFpbXNFbnRyeRJKCgl1c2VyX2luZm8YAiADKAsyNy5ieXRlYmFzZS52MS5UZXN0SWRlbnRpdHlQcm92aWRlclJlc3BvbnNlLlVzZXJJbmZvRW50cnkaLQoLQ2xhaW1zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARovCg1Vc2VySW5mb0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEi2AEKEElkZW50aXR5UHJvdmlkZXISDAoEbmFtZRgBIAEoCRIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESDgoGZG9tYWluGAUgASgJEi8KBHR5cGUYBiABKA4yIS5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyVHlwZRIzCgZjb25maWcYByABKAsyIy5ieXRlYmFzZS52MS5JZGVudGl0eVByb3ZpZGVyQ29uZmlnOiHqQR4KEGJ5dGViYXNlLmNvbS9JZFASCmlkcHMve2lkcH1KBAgCEAMipgIKFklkZW50aXR5UHJvdmlkZXJDb25maWcSQgoNb2F1dGgyX2NvbmZpZxgBIAEoCzIpLmJ5dGViYXNlLnYxLk9BdXRoMklkZW50aXR5UHJvdmlkZXJDb25maWdIABI+CgtvaWRjX2NvbmZpZxgCIAEoCzInLmJ5dGViYXNlLnYxLk9JRENJZGVudGl0eVByb3ZpZGVyQ29uZmlnSAASPgoLbGRhcF9jb25maWcYAyABKAsyJy5ieXRlYmFzZS52MS5MREFQSWRlbnRpdHlQcm92aWRlckNvbmZpZ0gAEj4KC3NhbWxfY29uZmlnGAQgASgLMicuYnl0ZWJhc2UudjEuU0FNTElkZW50aXR5UHJvdmlkZXJDb25maWdIAEIICgZjb25maWcikQIKHE9BdXRoMklkZW50aXR5UHJvdmlkZXJDb25maWcSEAoIYXV0aF91cmwYASABKAkSEQoJdG9rZW5fdXJsGAIgASgJEhUKDXVzZXJfaW5mb191cmwYAyABKAkSEQoJY2xpZW50X2lkGAQgASgJEhUKDWNsaWVudF9zZWNyZXQYBSABKAkSDgoGc2NvcGVzGAYgAygJEjAKDWZpZWxkX21hcHBpbmcYByABKAsyGS5ieXRlYmFzZS52MS5GaWVsZE1hcHBpbmcSFwoPc2tpcF90bHNfdmVyaWZ5GAggASgIEjAKCmF1dGhfc3R5bGUYCSABKA4yHC5ieXRlYmFzZS52MS5PQXV0aDJBdXRoU3R5bGUi/wEKGk9JRENJZGVudGl0eVByb3ZpZGVyQ29uZmlnEg4KBmlzc3VlchgBIAEoCRIRCgljbGllbnRfaWQYAiABKAkSFQoNY2xpZW50X3NlY3JldBgDIAEoCRIOCgZzY29wZXMYBCADKAkSMAoNZmllbGRfbWFwcGluZxgFIAEoCzIZLmJ5dGViYXNlLnYxLkZpZWxkTWFwcGluZxIXCg9za2lwX3Rsc192ZXJpZnkYBiABKAgSMAoKYXV0aF9zdHlsZRgHIAEoDjIcLmJ5dGViYXNlLnYxLk9BdXRoMkF1dGhTdHlsZRIaCg1hdXRoX2VuZHBvaW50GAggASgJQgPgQQMi9wIKGkxEQVBJZGVudGl0eVByb3ZpZGVyQ29uZmlnEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBRIXCg9za2lwX3Rsc192ZXJpZnkYAyABKAgSDwoHYmluZF9kbhgEIAEoCRIVCg1iaW5kX3Bhc3N3b3JkGAUgASgJEg8KB2Jhc2VfZG4YBiABKAkSEwoLdXNlcl9maWx0ZXIYByABKAkSUwoRc2VjdXJpdHlfcHJvdG9jb2wYCCABKA4yOC5ieXRlYmFzZS52MS5MREFQSWRlbnRpdHlQcm92aWRlckNvbmZpZy5TZWN1cml0eVByb3RvY29sEjAKDWZpZWxkX21hcHBpbmcYCSABKAsyGS5ieXRlYmFzZS52MS5GaWVsZE1hcHBpbmciTwoQU2VjdXJpdHlQcm90b2NvbBIhCh1TRUNVUklUWV9QUk9UT0NPTF9VTlNQRUNJRklFRBAAEg0KCVNUQVJUX1RMUxABEgkKBUxEQVBTEAIiiQIKGlNBTUxJZGVudGl0eVByb3ZpZGVyQ29uZmlnEhQKDG1ldGFkYXRhX3VybBgBIAEoCRIUCgxtZXRhZGF0YV94bWwYAiABKAkSEQoJZW50aXR5X2lkGAMgASgJEhMKC2NlcnRpZmljYXRlGAQgASgJEhgKC3ByaXZhdGVfa2V5GAUgASgJQgPgQQQSMAoNZmllbGRfbWFwcGluZxgGIAEoCzIZLmJ5dGViYXNlLnYxLkZpZWxkTWFwcGluZxIXCg9za2lwX3Rsc192ZXJpZnkYByABKAgSHAoPc3BfbWV0YWRhdGFfdXJsGAggASgJQgPgQQMSFAoHYWNzX3VybBgJIAEoCUID4EEDIl0KDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVwaG9uZRgEIAEoCRIOCgZncm91cHMYBSABKAlKBAgDEAQqaAoUSWRlbnRpdHlQcm92aWRlclR5cGUSJgoiSURFTlRJVFlfUFJPVklERVJfVFlQRV9VTlNQRUNJRklFRBAAEgoKBk9BVVRIMhABEggKBE9JREMQAhIICgRMREFQEAMSCAoEU0FNTBAEKlIKD09BdXRoMkF1dGhTdHlsZRIhCh1PQVVUSDJfQVVUSF9TVFlMRV9VTlNQRUNJRklFRBAAEg0KCUlOX1BBUkFNUxABEg0KCUlOX0hFQURFUhACMtwIChdJZGVudGl0eVByb3ZpZGVyU2VydmljZRKfAQoTR2V0SWRlbnRpdHlQcm92aWRlchInLmJ5dGViYXNlLnYxLkdldElkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Gh0uYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlciJA2kEEbmFtZYrqMBhiYi5pZGVudGl0eVByb3ZpZGVycy5nZXSQ6jABgtPkkwITEhEvdjEve25hbWU9aWRwcy8qfRKHAQoVTGlzdElkZW50aXR5UHJvdmlkZXJzEikuYnl0ZWJhc2UudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVxdWVzdBoqLmJ5dGViYXNlLnYxLkxpc3RJZGVudGl0eVByb3ZpZGVyc1Jlc3BvbnNlIhfaQQCA6jABgtPkkwIKEggvdjEvaWRwcxKyAQoWQ3JlYXRlSWRlbnRpdHlQcm92aWRlchIqLmJ5dGViYXNlLnYxLkNyZWF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Gh0uYnl0ZWJhc2UudjEuSWRlbnRpdHlQcm92aWRlciJN2kEAiuowG2JiLmlkZW50aXR5UHJvdmlkZXJzLmNyZWF0ZZDqMAGY6jABgtPkkwIdOhFpZGVudGl0eV9wcm92aWRlciIIL3YxL2lkcHMSigIKFlVwZGF0ZUlkZW50aXR5UHJvdmlkZXISKi5ieXRlYmFzZS52MS5VcGRhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBodLmJ5dGViYXNlLnYxLklkZW50aXR5UHJvdmlkZXIipAHaQR1pZGVudGl0eV9wcm92aWRlcix1cGRhdGVfbWFza4rqMBtiYi5pZGVudGl0eVByb3ZpZGVycy51cGRhdGWQ6jABmOowAaLqMBtiYi5pZGVudGl0eVByb3ZpZGVycy5jcmVhdGWC0+STAjg6EWlkZW50aXR5X3Byb3ZpZGVyMiMvdjEve2lkZW50aXR5X3Byb3ZpZGVyLm5hbWU9aWRwcy8qfRKlAQoWRGVsZXRlSWRlbnRpdHlQcm92aWRlchIqLmJ5dGViYXNlLnYxLkRlbGV0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IkfaQQRuYW1liuowG2JiLmlkZW50aXR5UHJvdmlkZXJzLmRlbGV0ZZDqMAGY6jABgtPkkwITKhEvdjEve25hbWU9aWRwcy8qfRKqAQoUVGVzdElkZW50aXR5UHJvdmlkZXISKC5ieXRlYmFzZS52MS5UZXN0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QaKS5ieXRlYmFzZS52MS5UZXN0SWRlbnRpdHlQcm92aWRlclJlc3BvbnNlIj2K6jAbYmIuaWRlbnRpdHlQcm92aWRlcnMudXBkYXRlkOowAYLT5JMCFDoBKiIPL3YxL2lkcHMvKjp0ZXN0QqUBCg9jb20uYnl0ZWJhc2UudjFCD0lkcFNlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_v1_annotation]);
 
 /**
  * Describes the message bytebase.v1.GetIdentityProviderRequest.
@@ -80,53 +80,60 @@ export const OAuth2IdentityProviderTestRequestContextSchema = /*@__PURE__*/
 export const OIDCIdentityProviderTestRequestContextSchema = /*@__PURE__*/
   messageDesc(file_v1_idp_service, 8);
 
+/**
+ * Describes the message bytebase.v1.SAMLIdentityProviderTestRequestContext.
+ * Use `create(SAMLIdentityProviderTestRequestContextSchema)` to create a new message.
+ */
+export const SAMLIdentityProviderTestRequestContextSchema = /*@__PURE__*/
+  messageDesc(file_v1_idp_service, 9);
+
 /**
  * Describes the message bytebase.v1.TestIdentityProviderResponse.
  * Use `create(TestIdentityProviderResponseSchema)` to create a new message.
  */
 export const TestIdentityProviderResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 9);
+  messageDesc(file_v1_idp_service, 10);
 
 /**
  * Describes the message bytebase.v1.IdentityProvider.
  * Use `create(IdentityProviderSchema)` to create a new message.
  */
 export const IdentityProviderSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 10);
+  messageDesc(file_v1_idp_service, 11);
 
 /**
  * Describes the message bytebase.v1.IdentityProviderConfig.
  * Use `create(IdentityProviderConfigSchema)` to create a new message.
  */
 export const IdentityProviderConfigSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 11);
+  messageDesc(file_v1_idp_service, 12);
 
 /**
  * Describes the message bytebase.v1.OAuth2IdentityProviderConfig.
  * Use `create(OAuth2IdentityProviderConfigSchema)` to create a new message.
  */
 export const OAuth2IdentityProviderConfigSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 12);
+  messageDesc(file_v1_idp_service, 13);
 
 /**
  * Describes the message bytebase.v1.OIDCIdentityProviderConfig.
  * Use `create(OIDCIdentityProviderConfigSchema)` to create a new message.
  */
 export const OIDCIdentityProviderConfigSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 13);
+  messageDesc(file_v1_idp_service, 14);
 
 /**
  * Describes the message bytebase.v1.LDAPIdentityProviderConfig.
  * Use `create(LDAPIdentityProviderConfigSchema)` to create a new message.
  */
 export const LDAPIdentityProviderConfigSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 14);
+  messageDesc(file_v1_idp_service, 15);
 
 /**
  * Describes the enum bytebase.v1.LDAPIdentityProviderConfig.SecurityProtocol.
  */
 export const LDAPIdentityProviderConfig_SecurityProtocolSchema = /*@__PURE__*/
-  enumDesc(file_v1_idp_service, 14, 0);
+  enumDesc(file_v1_idp_service, 15, 0);
 
 /**
  * @generated from enum bytebase.v1.LDAPIdentityProviderConfig.SecurityProtocol
@@ -134,12 +141,19 @@ export const LDAPIdentityProviderConfig_SecurityProtocolSchema = /*@__PURE__*/
 export const LDAPIdentityProviderConfig_SecurityProtocol = /*@__PURE__*/
   tsEnum(LDAPIdentityProviderConfig_SecurityProtocolSchema);
 
+/**
+ * Describes the message bytebase.v1.SAMLIdentityProviderConfig.
+ * Use `create(SAMLIdentityProviderConfigSchema)` to create a new message.
+ */
+export const SAMLIdentityProviderConfigSchema = /*@__PURE__*/
+  messageDesc(file_v1_idp_service, 16);
+
 /**
  * Describes the message bytebase.v1.FieldMapping.
  * Use `create(FieldMappingSchema)` to create a new message.
  */
 export const FieldMappingSchema = /*@__PURE__*/
-  messageDesc(file_v1_idp_service, 15);
+  messageDesc(file_v1_idp_service, 17);
 
 /**
  * Describes the enum bytebase.v1.IdentityProviderType.
@@ -662,6 +662,8 @@ export declare type DataSourceExternalSecret = Message<"bytebase.v1.DataSourceEx
 
   /**
    * The URL of the external secret store.
+   * For Azure Key Vault, it is the vault URL, e.g. https://my-vault.vault.azure.net.
+   * For EXEC, it is the absolute path of the command, which is called with the secret name as the only argument.
    *
    * @generated from field: string url = 2;
    */
@@ -747,6 +749,14 @@ export declare type DataSourceExternalSecret = Message<"bytebase.v1.DataSourceEx
    * @generated from field: string vault_ssl_key = 12;
    */
   vaultSslKey: string;
+
+  /**
+   * The duration in seconds to cache the secret.
+   * 0 uses the default TTL, and a negative value disables the cache.
+   *
+   * @generated from field: int32 cache_ttl_seconds = 13;
+   */
+  cacheTtlSeconds: number;
 };
 
 /**
@@ -856,6 +866,29 @@ export enum DataSourceExternalSecret_SecretType {
    * @generated from enum value: GCP_SECRET_MANAGER = 3;
    */
   GCP_SECRET_MANAGER = 3,
+
+  /**
+   * ref: https://learn.microsoft.com/en-us/azure/key-vault/secrets/about-secrets
+   *
+   * @generated from enum value: AZURE_KEY_VAULT = 4;
+   */
+  AZURE_KEY_VAULT = 4,
+
+  /**
+   * A local file, such as a Kubernetes mounted secret.
+   * The file must be under one of the directories in the BB_EXTERNAL_SECRET_FILE_DIRS environment.
+   *
+   * @generated from enum value: FILE = 5;
+   */
+  FILE = 5,
+
+  /**
+   * A command that prints the secret to stdout.
+   * The command must be under one of the directories in the BB_EXTERNAL_SECRET_EXEC_DIRS environment.
+   *
+   * @generated from enum value: EXEC = 6;
+   */
+  EXEC = 6,
 }
 
 /**
@@ -17,7 +17,7 @@ import { file_v1_instance_role_service } from "./instance_role_service_pb";
  * Describes the file v1/instance_service.proto.
  */
 export const file_v1_instance_service = /*@__PURE__*/
-  fileDesc("Chl2MS9pbnN0YW5jZV9zZXJ2aWNlLnByb3RvEgtieXRlYmFzZS52MSJBChJHZXRJbnN0YW5jZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2UiYwoUTGlzdEluc3RhbmNlc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkSFAoMc2hvd19kZWxldGVkGAMgASgIEg4KBmZpbHRlchgEIAEoCSJaChVMaXN0SW5zdGFuY2VzUmVzcG9uc2USKAoJaW5zdGFuY2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuSW5zdGFuY2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInEKFUNyZWF0ZUluc3RhbmNlUmVxdWVzdBIsCghpbnN0YW5jZRgBIAEoCzIVLmJ5dGViYXNlLnYxLkluc3RhbmNlQgPgQQISEwoLaW5zdGFuY2VfaWQYAiABKAkSFQoNdmFsaWRhdGVfb25seRgDIAEoCCKNAQoVVXBkYXRlSW5zdGFuY2VSZXF1ZXN0EiwKCGluc3RhbmNlGAEgASgLMhUuYnl0ZWJhc2UudjEuSW5zdGFuY2VCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNYWxsb3dfbWlzc2luZxgDIAEoCCJiChVEZWxldGVJbnN0YW5jZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2USDQoFZm9yY2UYAiABKAgSDQoFcHVyZ2UYAyABKAgiRgoXVW5kZWxldGVJbnN0YW5jZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2UiXAoTU3luY0luc3RhbmNlUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9JbnN0YW5jZRIYChBlbmFibGVfZnVsbF9zeW5jGAIgASgIIooBChtMaXN0SW5zdGFuY2VEYXRhYmFzZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2USMQoIaW5zdGFuY2UYAiABKAsyFS5ieXRlYmFzZS52MS5JbnN0YW5jZUID4EECSACIAQFCCwoJX2luc3RhbmNlIjEKHExpc3RJbnN0YW5jZURhdGFiYXNlUmVzcG9uc2USEQoJZGF0YWJhc2VzGAEgAygJIikKFFN5bmNJbnN0YW5jZVJlc3BvbnNlEhEKCWRhdGFiYXNlcxgBIAMoCSJUChlCYXRjaFN5bmNJbnN0YW5jZXNSZXF1ZXN0EjcKCHJlcXVlc3RzGAEgAygLMiAuYnl0ZWJhc2UudjEuU3luY0luc3RhbmNlUmVxdWVzdEID4EECIhwKGkJhdGNoU3luY0luc3RhbmNlc1Jlc3BvbnNlIlgKG0JhdGNoVXBkYXRlSW5zdGFuY2VzUmVxdWVzdBI5CghyZXF1ZXN0cxgBIAMoCzIiLmJ5dGViYXNlLnYxLlVwZGF0ZUluc3RhbmNlUmVxdWVzdEID4EECIkgKHEJhdGNoVXBkYXRlSW5zdGFuY2VzUmVzcG9uc2USKAoJaW5zdGFuY2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UijQEKFEFkZERhdGFTb3VyY2VSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0luc3RhbmNlEjEKC2RhdGFfc291cmNlGAIgASgLMhcuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZUID4EECEhUKDXZhbGlkYXRlX29ubHkYAyABKAgieQoXUmVtb3ZlRGF0YVNvdXJjZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2USMQoLZGF0YV9zb3VyY2UYAiABKAsyFy5ieXRlYmFzZS52MS5EYXRhU291cmNlQgPgQQIi2AEKF1VwZGF0ZURhdGFTb3VyY2VSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0luc3RhbmNlEjEKC2RhdGFfc291cmNlGAIgASgLMhcuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZUID4EECEi8KC3VwZGF0ZV9tYXNrGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg12YWxpZGF0ZV9vbmx5GAQgASgIEhUKDWFsbG93X21pc3NpbmcYBSABKAgiggUKCEluc3RhbmNlEgwKBG5hbWUYASABKAkSIQoFc3RhdGUYAyABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZRIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESIwoGZW5naW5lGAUgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhsKDmVuZ2luZV92ZXJzaW9uGAYgASgJQgPgQQMSFQoNZXh0ZXJuYWxfbGluaxgHIAEoCRItCgxkYXRhX3NvdXJjZXMYCCADKAsyFy5ieXRlYmFzZS52MS5EYXRhU291cmNlEh0KC2Vudmlyb25tZW50GAkgASgJQgPgQQFIAIgBARISCgphY3RpdmF0aW9uGAogASgIEi0KBXJvbGVzGAwgAygLMhkuYnl0ZWJhc2UudjEuSW5zdGFuY2VSb2xlQgPgQQMSMAoNc3luY19pbnRlcnZhbBgNIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIbChNtYXhpbXVtX2Nvbm5lY3Rpb25zGA4gASgFEhYKDnN5bmNfZGF0YWJhc2VzGA8gAygJEjcKDmxhc3Rfc3luY190aW1lGBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjEKBmxhYmVscxgRIAMoCzIhLmJ5dGViYXNlLnYxLkluc3RhbmNlLkxhYmVsc0VudHJ5Gi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAE6MOpBLQoVYnl0ZWJhc2UuY29tL0luc3RhbmNlEhRpbnN0YW5jZXMve2luc3RhbmNlfUIOCgxfZW52aXJvbm1lbnQihQcKGERhdGFTb3VyY2VFeHRlcm5hbFNlY3JldBJFCgtzZWNyZXRfdHlwZRgBIAEoDjIwLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2VFeHRlcm5hbFNlY3JldC5TZWNyZXRUeXBlEgsKA3VybBgCIAEoCRJBCglhdXRoX3R5cGUYAyABKA4yLi5ieXRlYmFzZS52MS5EYXRhU291cmNlRXh0ZXJuYWxTZWNyZXQuQXV0aFR5cGUSSwoIYXBwX3JvbGUYBCABKAsyNy5ieXRlYmFzZS52MS5EYXRhU291cmNlRXh0ZXJuYWxTZWNyZXQuQXBwUm9sZUF1dGhPcHRpb25IABIUCgV0b2tlbhgFIAEoCUID4EEESAASEwoLZW5naW5lX25hbWUYBiABKAkSEwoLc2VjcmV0X25hbWUYByABKAkSGQoRcGFzc3dvcmRfa2V5X25hbWUYCCABKAkSIwobc2tpcF92YXVsdF90bHNfdmVyaWZpY2F0aW9uGAkgASgIEhkKDHZhdWx0X3NzbF9jYRgKIAEoCUID4EEEEhsKDnZhdWx0X3NzbF9jZXJ0GAsgASgJQgPgQQQSGgoNdmF1bHRfc3NsX2tleRgMIAEoCUID4EEEGu4BChFBcHBSb2xlQXV0aE9wdGlvbhIUCgdyb2xlX2lkGAEgASgJQgPgQQQSFgoJc2VjcmV0X2lkGAIgASgJQgPgQQQSUAoEdHlwZRgDIAEoDjJCLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2VFeHRlcm5hbFNlY3JldC5BcHBSb2xlQXV0aE9wdGlvbi5TZWNyZXRUeXBlEhIKCm1vdW50X3BhdGgYBCABKAkiRQoKU2VjcmV0VHlwZRIbChdTRUNSRVRfVFlQRV9VTlNQRUNJRklFRBAAEgkKBVBMQUlOEAESDwoLRU5WSVJPTk1FTlQQAiJrCgpTZWNyZXRUeXBlEhsKF1NFQ1JFVF9UWVBFX1VOU1BFQ0lGSUVEEAASDwoLVkFVTFRfS1ZfVjIQARIXChNBV1NfU0VDUkVUU19NQU5BR0VSEAISFgoSR0NQX1NFQ1JFVF9NQU5BR0VSEAMiRAoIQXV0aFR5cGUSGQoVQVVUSF9UWVBFX1VOU1BFQ0lGSUVEEAASCQoFVE9LRU4QARISCg5WQVVMVF9BUFBfUk9MRRACQg0KC2F1dGhfb3B0aW9uIt0OCgpEYXRhU291cmNlEgoKAmlkGAEgASgJEikKBHR5cGUYAiABKA4yGy5ieXRlYmFzZS52MS5EYXRhU291cmNlVHlwZRIQCgh1c2VybmFtZRgDIAEoCRIVCghwYXNzd29yZBgEIAEoCUID4EEEEg8KB3VzZV9zc2wYHiABKAgSEwoGc3NsX2NhGAUgASgJQgPgQQQSFQoIc3NsX2NlcnQYBiABKAlCA+BBBBIUCgdzc2xfa2V5GAcgASgJQgPgQQQSHgoWdmVyaWZ5X3Rsc19jZXJ0aWZpY2F0ZRgnIAEoCBIMCgRob3N0GAggASgJEgwKBHBvcnQYCSABKAkSEAoIZGF0YWJhc2UYCiABKAkSCwoDc3J2GAsgASgIEh8KF2F1dGhlbnRpY2F0aW9uX2RhdGFiYXNlGAwgASgJEhMKC3JlcGxpY2Ffc2V0GBkgASgJEgsKA3NpZBgNIAEoCRIUCgxzZXJ2aWNlX25hbWUYDiABKAkSEAoIc3NoX2hvc3QYDyABKAkSEAoIc3NoX3BvcnQYECABKAkSEAoIc3NoX3VzZXIYESABKAkSGQoMc3NoX3Bhc3N3b3JkGBIgASgJQgPgQQQSHAoPc3NoX3ByaXZhdGVfa2V5GBMgASgJQgPgQQQSJwoaYXV0aGVudGljYXRpb25fcHJpdmF0ZV9rZXkYFCABKAlCA+BBBBI+Cg9leHRlcm5hbF9zZWNyZXQYFSABKAsyJS5ieXRlYmFzZS52MS5EYXRhU291cmNlRXh0ZXJuYWxTZWNyZXQSRwoTYXV0aGVudGljYXRpb25fdHlwZRgWIAEoDjIqLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2UuQXV0aGVudGljYXRpb25UeXBlEkMKEGF6dXJlX2NyZWRlbnRpYWwYFyABKAsyJy5ieXRlYmFzZS52MS5EYXRhU291cmNlLkF6dXJlQ3JlZGVudGlhbEgAEj8KDmF3c19jcmVkZW50aWFsGCUgASgLMiUuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZS5BV1NDcmVkZW50aWFsSAASPwoOZ2NwX2NyZWRlbnRpYWwYJiABKAsyJS5ieXRlYmFzZS52MS5EYXRhU291cmNlLkdDUENyZWRlbnRpYWxIABIsCgtzYXNsX2NvbmZpZxgYIAEoCzIXLmJ5dGViYXNlLnYxLlNBU0xDb25maWcSQgoUYWRkaXRpb25hbF9hZGRyZXNzZXMYGiADKAsyHy5ieXRlYmFzZS52MS5EYXRhU291cmNlLkFkZHJlc3NCA+BBARIZChFkaXJlY3RfY29ubmVjdGlvbhgbIAEoCBIOCgZyZWdpb24YHCABKAkSFAoMd2FyZWhvdXNlX2lkGB0gASgJEhMKC21hc3Rlcl9uYW1lGB8gASgJEhcKD21hc3Rlcl91c2VybmFtZRggIAEoCRIXCg9tYXN0ZXJfcGFzc3dvcmQYISABKAkSNQoKcmVkaXNfdHlwZRgiIAEoDjIhLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2UuUmVkaXNUeXBlEg8KB2NsdXN0ZXIYIyABKAkSWwobZXh0cmFfY29ubmVjdGlvbl9wYXJhbWV0ZXJzGCQgAygLMjYuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZS5FeHRyYUNvbm5lY3Rpb25QYXJhbWV0ZXJzRW50cnkaUwoPQXp1cmVDcmVkZW50aWFsEhEKCXRlbmFudF9pZBgBIAEoCRIRCgljbGllbnRfaWQYAiABKAkSGgoNY2xpZW50X3NlY3JldBgDIAEoCUID4EEEGpgBCg1BV1NDcmVkZW50aWFsEhoKDWFjY2Vzc19rZXlfaWQYASABKAlCA+BBBBIeChFzZWNyZXRfYWNjZXNzX2tleRgCIAEoCUID4EEEEhoKDXNlc3Npb25fdG9rZW4YAyABKAlCA+BBBBIVCghyb2xlX2FybhgEIAEoCUID4EEEEhgKC2V4dGVybmFsX2lkGAUgASgJQgPgQQQaJQoNR0NQQ3JlZGVudGlhbBIUCgdjb250ZW50GAEgASgJQgPgQQQaJQoHQWRkcmVzcxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAkaQAoeRXh0cmFDb25uZWN0aW9uUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEifAoSQXV0aGVudGljYXRpb25UeXBlEh4KGkFVVEhFTlRJQ0FUSU9OX1VOU1BFQ0lGSUVEEAASDAoIUEFTU1dPUkQQARIYChRHT09HTEVfQ0xPVURfU1FMX0lBTRACEg8KC0FXU19SRFNfSUFNEAMSDQoJQVpVUkVfSUFNEAQiUgoJUmVkaXNUeXBlEhoKFlJFRElTX1RZUEVfVU5TUEVDSUZJRUQQABIOCgpTVEFOREFMT05FEAESDAoIU0VOVElORUwQAhILCgdDTFVTVEVSEANCDwoNaWFtX2V4dGVuc2lvbiLeAQoQSW5zdGFuY2VSZXNvdXJjZRINCgV0aXRsZRgBIAEoCRIjCgZlbmdpbmUYAiABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSGwoOZW5naW5lX3ZlcnNpb24YAyABKAlCA+BBAxItCgxkYXRhX3NvdXJjZXMYBCADKAsyFy5ieXRlYmFzZS52MS5EYXRhU291cmNlEhIKCmFjdGl2YXRpb24YBSABKAgSDAoEbmFtZRgGIAEoCRIYCgtlbnZpcm9ubWVudBgHIAEoCUgAiAEBQg4KDF9lbnZpcm9ubWVudCJMCgpTQVNMQ29uZmlnEjEKCmtyYl9jb25maWcYASABKAsyGy5ieXRlYmFzZS52MS5LZXJiZXJvc0NvbmZpZ0gAQgsKCW1lY2hhbmlzbSKWAQoOS2VyYmVyb3NDb25maWcSDwoHcHJpbWFyeRgBIAEoCRIQCghpbnN0YW5jZRgCIAEoCRINCgVyZWFsbRgDIAEoCRIOCgZrZXl0YWIYBCABKAwSEAoIa2RjX2hvc3QYBSABKAkSEAoIa2RjX3BvcnQYBiABKAkSHgoWa2RjX3RyYW5zcG9ydF9wcm90b2NvbBgHIAEoCSpHCg5EYXRhU291cmNlVHlwZRIbChdEQVRBX1NPVVJDRV9VTlNQRUNJRklFRBAAEgkKBUFETUlOEAESDQoJUkVBRF9PTkxZEAIy1BAKD0luc3RhbmNlU2VydmljZRKEAQoLR2V0SW5zdGFuY2USHy5ieXRlYmFzZS52MS5HZXRJbnN0YW5jZVJlcXVlc3QaFS5ieXRlYmFzZS52MS5JbnN0YW5jZSI92kEEbmFtZYrqMBBiYi5pbnN0YW5jZXMuZ2V0kOowAYLT5JMCGBIWL3YxL3tuYW1lPWluc3RhbmNlcy8qfRKJAQoNTGlzdEluc3RhbmNlcxIhLmJ5dGViYXNlLnYxLkxpc3RJbnN0YW5jZXNSZXF1ZXN0GiIuYnl0ZWJhc2UudjEuTGlzdEluc3RhbmNlc1Jlc3BvbnNlIjHaQQCK6jARYmIuaW5zdGFuY2VzLmxpc3SQ6jABgtPkkwIPEg0vdjEvaW5zdGFuY2VzEpYBCg5DcmVhdGVJbnN0YW5jZRIiLmJ5dGViYXNlLnYxLkNyZWF0ZUluc3RhbmNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkluc3RhbmNlIknaQQhpbnN0YW5jZYrqMBNiYi5pbnN0YW5jZXMuY3JlYXRlkOowAZjqMAGC0+STAhk6CGluc3RhbmNlIg0vdjEvaW5zdGFuY2VzErQBCg5VcGRhdGVJbnN0YW5jZRIiLmJ5dGViYXNlLnYxLlVwZGF0ZUluc3RhbmNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkluc3RhbmNlImfaQRRpbnN0YW5jZSx1cGRhdGVfbWFza4rqMBNiYi5pbnN0YW5jZXMudXBkYXRlkOowAZjqMAGC0+STAis6CGluc3RhbmNlMh8vdjEve2luc3RhbmNlLm5hbWU9aW5zdGFuY2VzLyp9EpIBCg5EZWxldGVJbnN0YW5jZRIiLmJ5dGViYXNlLnYxLkRlbGV0ZUluc3RhbmNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSJE2kEEbmFtZYrqMBNiYi5pbnN0YW5jZXMuZGVsZXRlkOowAZjqMAGC0+STAhgqFi92MS97bmFtZT1pbnN0YW5jZXMvKn0SnAEKEFVuZGVsZXRlSW5zdGFuY2USJC5ieXRlYmFzZS52MS5VbmRlbGV0ZUluc3RhbmNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkluc3RhbmNlIkuK6jAVYmIuaW5zdGFuY2VzLnVuZGVsZXRlkOowAZjqMAGC0+STAiQ6ASoiHy92MS97bmFtZT1pbnN0YW5jZXMvKn06dW5kZWxldGUSlAEKDFN5bmNJbnN0YW5jZRIgLmJ5dGViYXNlLnYxLlN5bmNJbnN0YW5jZVJlcXVlc3QaIS5ieXRlYmFzZS52MS5TeW5jSW5zdGFuY2VSZXNwb25zZSI/iuowEWJiLmluc3RhbmNlcy5zeW5jkOowAYLT5JMCIDoBKiIbL3YxL3tuYW1lPWluc3RhbmNlcy8qfTpzeW5jErABChRMaXN0SW5zdGFuY2VEYXRhYmFzZRIoLmJ5dGViYXNlLnYxLkxpc3RJbnN0YW5jZURhdGFiYXNlUmVxdWVzdBopLmJ5dGViYXNlLnYxLkxpc3RJbnN0YW5jZURhdGFiYXNlUmVzcG9uc2UiQ4rqMBBiYi5pbnN0YW5jZXMuZ2V0kOowAYLT5JMCJToBKiIgL3YxL3tuYW1lPWluc3RhbmNlcy8qfTpkYXRhYmFzZXMSogEKEkJhdGNoU3luY0luc3RhbmNlcxImLmJ5dGViYXNlLnYxLkJhdGNoU3luY0luc3RhbmNlc1JlcXVlc3QaJy5ieXRlYmFzZS52MS5CYXRjaFN5bmNJbnN0YW5jZXNSZXNwb25zZSI7iuowEWJiLmluc3RhbmNlcy5zeW5jkOowAYLT5JMCHDoBKiIXL3YxL2luc3RhbmNlczpiYXRjaFN5bmMSsAEKFEJhdGNoVXBkYXRlSW5zdGFuY2VzEiguYnl0ZWJhc2UudjEuQmF0Y2hVcGRhdGVJbnN0YW5jZXNSZXF1ZXN0GikuYnl0ZWJhc2UudjEuQmF0Y2hVcGRhdGVJbnN0YW5jZXNSZXNwb25zZSJDiuowE2JiLmluc3RhbmNlcy51cGRhdGWQ6jABmOowAYLT5JMCHjoBKiIZL3YxL2luc3RhbmNlczpiYXRjaFVwZGF0ZRKZAQoNQWRkRGF0YVNvdXJjZRIhLmJ5dGViYXNlLnYxLkFkZERhdGFTb3VyY2VSZXF1ZXN0GhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UiTorqMBNiYi5pbnN0YW5jZXMudXBkYXRlkOowAZjqMAGC0+STAik6ASoiJC92MS97bmFtZT1pbnN0YW5jZXMvKn06YWRkRGF0YVNvdXJjZRKiAQoQUmVtb3ZlRGF0YVNvdXJjZRIkLmJ5dGViYXNlLnYxLlJlbW92ZURhdGFTb3VyY2VSZXF1ZXN0GhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UiUYrqMBNiYi5pbnN0YW5jZXMudXBkYXRlkOowAZjqMAGC0+STAiw6ASoiJy92MS97bmFtZT1pbnN0YW5jZXMvKn06cmVtb3ZlRGF0YVNvdXJjZRLGAQoQVXBkYXRlRGF0YVNvdXJjZRIkLmJ5dGViYXNlLnYxLlVwZGF0ZURhdGFTb3VyY2VSZXF1ZXN0GhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UiddpBF2RhdGFfc291cmNlLHVwZGF0ZV9tYXNriuowE2JiLmluc3RhbmNlcy51cGRhdGWQ6jABmOowAYLT5JMCNjoLZGF0YV9zb3VyY2UyJy92MS97bmFtZT1pbnN0YW5jZXMvKn06dXBkYXRlRGF0YVNvdXJjZUKqAQoPY29tLmJ5dGViYXNlLnYxQhRJbnN0YW5jZVNlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_instance_role_service]);
+  fileDesc("Chl2MS9pbnN0YW5jZV9zZXJ2aWNlLnByb3RvEgtieXRlYmFzZS52MSJBChJHZXRJbnN0YW5jZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2UiYwoUTGlzdEluc3RhbmNlc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkSFAoMc2hvd19kZWxldGVkGAMgASgIEg4KBmZpbHRlchgEIAEoCSJaChVMaXN0SW5zdGFuY2VzUmVzcG9uc2USKAoJaW5zdGFuY2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuSW5zdGFuY2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInEKFUNyZWF0ZUluc3RhbmNlUmVxdWVzdBIsCghpbnN0YW5jZRgBIAEoCzIVLmJ5dGViYXNlLnYxLkluc3RhbmNlQgPgQQISEwoLaW5zdGFuY2VfaWQYAiABKAkSFQoNdmFsaWRhdGVfb25seRgDIAEoCCKNAQoVVXBkYXRlSW5zdGFuY2VSZXF1ZXN0EiwKCGluc3RhbmNlGAEgASgLMhUuYnl0ZWJhc2UudjEuSW5zdGFuY2VCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNYWxsb3dfbWlzc2luZxgDIAEoCCJiChVEZWxldGVJbnN0YW5jZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2USDQoFZm9yY2UYAiABKAgSDQoFcHVyZ2UYAyABKAgiRgoXVW5kZWxldGVJbnN0YW5jZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2UiXAoTU3luY0luc3RhbmNlUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9JbnN0YW5jZRIYChBlbmFibGVfZnVsbF9zeW5jGAIgASgIIooBChtMaXN0SW5zdGFuY2VEYXRhYmFzZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2USMQoIaW5zdGFuY2UYAiABKAsyFS5ieXRlYmFzZS52MS5JbnN0YW5jZUID4EECSACIAQFCCwoJX2luc3RhbmNlIjEKHExpc3RJbnN0YW5jZURhdGFiYXNlUmVzcG9uc2USEQoJZGF0YWJhc2VzGAEgAygJIikKFFN5bmNJbnN0YW5jZVJlc3BvbnNlEhEKCWRhdGFiYXNlcxgBIAMoCSJUChlCYXRjaFN5bmNJbnN0YW5jZXNSZXF1ZXN0EjcKCHJlcXVlc3RzGAEgAygLMiAuYnl0ZWJhc2UudjEuU3luY0luc3RhbmNlUmVxdWVzdEID4EECIhwKGkJhdGNoU3luY0luc3RhbmNlc1Jlc3BvbnNlIlgKG0JhdGNoVXBkYXRlSW5zdGFuY2VzUmVxdWVzdBI5CghyZXF1ZXN0cxgBIAMoCzIiLmJ5dGViYXNlLnYxLlVwZGF0ZUluc3RhbmNlUmVxdWVzdEID4EECIkgKHEJhdGNoVXBkYXRlSW5zdGFuY2VzUmVzcG9uc2USKAoJaW5zdGFuY2VzGAEgAygLMhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UijQEKFEFkZERhdGFTb3VyY2VSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0luc3RhbmNlEjEKC2RhdGFfc291cmNlGAIgASgLMhcuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZUID4EECEhUKDXZhbGlkYXRlX29ubHkYAyABKAgieQoXUmVtb3ZlRGF0YVNvdXJjZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vSW5zdGFuY2USMQoLZGF0YV9zb3VyY2UYAiABKAsyFy5ieXRlYmFzZS52MS5EYXRhU291cmNlQgPgQQIi2AEKF1VwZGF0ZURhdGFTb3VyY2VSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0luc3RhbmNlEjEKC2RhdGFfc291cmNlGAIgASgLMhcuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZUID4EECEi8KC3VwZGF0ZV9tYXNrGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg12YWxpZGF0ZV9vbmx5GAQgASgIEhUKDWFsbG93X21pc3NpbmcYBSABKAgiggUKCEluc3RhbmNlEgwKBG5hbWUYASABKAkSIQoFc3RhdGUYAyABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZRIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESIwoGZW5naW5lGAUgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhsKDmVuZ2luZV92ZXJzaW9uGAYgASgJQgPgQQMSFQoNZXh0ZXJuYWxfbGluaxgHIAEoCRItCgxkYXRhX3NvdXJjZXMYCCADKAsyFy5ieXRlYmFzZS52MS5EYXRhU291cmNlEh0KC2Vudmlyb25tZW50GAkgASgJQgPgQQFIAIgBARISCgphY3RpdmF0aW9uGAogASgIEi0KBXJvbGVzGAwgAygLMhkuYnl0ZWJhc2UudjEuSW5zdGFuY2VSb2xlQgPgQQMSMAoNc3luY19pbnRlcnZhbBgNIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIbChNtYXhpbXVtX2Nvbm5lY3Rpb25zGA4gASgFEhYKDnN5bmNfZGF0YWJhc2VzGA8gAygJEjcKDmxhc3Rfc3luY190aW1lGBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjEKBmxhYmVscxgRIAMoCzIhLmJ5dGViYXNlLnYxLkluc3RhbmNlLkxhYmVsc0VudHJ5Gi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAE6MOpBLQoVYnl0ZWJhc2UuY29tL0luc3RhbmNlEhRpbnN0YW5jZXMve2luc3RhbmNlfUIOCgxfZW52aXJvbm1lbnQiygcKGERhdGFTb3VyY2VFeHRlcm5hbFNlY3JldBJFCgtzZWNyZXRfdHlwZRgBIAEoDjIwLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2VFeHRlcm5hbFNlY3JldC5TZWNyZXRUeXBlEgsKA3VybBgCIAEoCRJBCglhdXRoX3R5cGUYAyABKA4yLi5ieXRlYmFzZS52MS5EYXRhU291cmNlRXh0ZXJuYWxTZWNyZXQuQXV0aFR5cGUSSwoIYXBwX3JvbGUYBCABKAsyNy5ieXRlYmFzZS52MS5EYXRhU291cmNlRXh0ZXJuYWxTZWNyZXQuQXBwUm9sZUF1dGhPcHRpb25IABIUCgV0b2tlbhgFIAEoCUID4EEESAASEwoLZW5naW5lX25hbWUYBiABKAkSEwoLc2VjcmV0X25hbWUYByABKAkSGQoRcGFzc3dvcmRfa2V5X25hbWUYCCABKAkSIwobc2tpcF92YXVsdF90bHNfdmVyaWZpY2F0aW9uGAkgASgIEhkKDHZhdWx0X3NzbF9jYRgKIAEoCUID4EEEEhsKDnZhdWx0X3NzbF9jZXJ0GAsgASgJQgPgQQQSGgoNdmF1bHRfc3NsX2tleRgMIAEoCUID4EEEEhkKEWNhY2hlX3R0bF9zZWNvbmRzGA0gASgFGu4BChFBcHBSb2xlQXV0aE9wdGlvbhIUCgdyb2xlX2lkGAEgASgJQgPgQQQSFgoJc2VjcmV0X2lkGAIgASgJQgPgQQQSUAoEdHlwZRgDIAEoDjJCLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2VFeHRlcm5hbFNlY3JldC5BcHBSb2xlQXV0aE9wdGlvbi5TZWNyZXRUeXBlEhIKCm1vdW50X3BhdGgYBCABKAkiRQoKU2VjcmV0VHlwZRIbChdTRUNSRVRfVFlQRV9VTlNQRUNJRklFRBAAEgkKBVBMQUlOEAESDwoLRU5WSVJPTk1FTlQQAiKUAQoKU2VjcmV0VHlwZRIbChdTRUNSRVRfVFlQRV9VTlNQRUNJRklFRBAAEg8KC1ZBVUxUX0tWX1YyEAESFwoTQVdTX1NFQ1JFVFNfTUFOQUdFUhACEhYKEkdDUF9TRUNSRVRfTUFOQUdFUhADEhMKD0FaVVJFX0tFWV9WQVVMVBAEEggKBEZJTEUQBRIICgRFWEVDEAYiRAoIQXV0aFR5cGUSGQoVQVVUSF9UWVBFX1VOU1BFQ0lGSUVEEAASCQoFVE9LRU4QARISCg5WQVVMVF9BUFBfUk9MRRACQg0KC2F1dGhfb3B0aW9uIt0OCgpEYXRhU291cmNlEgoKAmlkGAEgASgJEikKBHR5cGUYAiABKA4yGy5ieXRlYmFzZS52MS5EYXRhU291cmNlVHlwZRIQCgh1c2VybmFtZRgDIAEoCRIVCghwYXNzd29yZBgEIAEoCUID4EEEEg8KB3VzZV9zc2wYHiABKAgSEwoGc3NsX2NhGAUgASgJQgPgQQQSFQoIc3NsX2NlcnQYBiABKAlCA+BBBBIUCgdzc2xfa2V5GAcgASgJQgPgQQQSHgoWdmVyaWZ5X3Rsc19jZXJ0aWZpY2F0ZRgnIAEoCBIMCgRob3N0GAggASgJEgwKBHBvcnQYCSABKAkSEAoIZGF0YWJhc2UYCiABKAkSCwoDc3J2GAsgASgIEh8KF2F1dGhlbnRpY2F0aW9uX2RhdGFiYXNlGAwgASgJEhMKC3JlcGxpY2Ffc2V0GBkgASgJEgsKA3NpZBgNIAEoCRIUCgxzZXJ2aWNlX25hbWUYDiABKAkSEAoIc3NoX2hvc3QYDyABKAkSEAoIc3NoX3BvcnQYECABKAkSEAoIc3NoX3VzZXIYESABKAkSGQoMc3NoX3Bhc3N3b3JkGBIgASgJQgPgQQQSHAoPc3NoX3ByaXZhdGVfa2V5GBMgASgJQgPgQQQSJwoaYXV0aGVudGljYXRpb25fcHJpdmF0ZV9rZXkYFCABKAlCA+BBBBI+Cg9leHRlcm5hbF9zZWNyZXQYFSABKAsyJS5ieXRlYmFzZS52MS5EYXRhU291cmNlRXh0ZXJuYWxTZWNyZXQSRwoTYXV0aGVudGljYXRpb25fdHlwZRgWIAEoDjIqLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2UuQXV0aGVudGljYXRpb25UeXBlEkMKEGF6dXJlX2NyZWRlbnRpYWwYFyABKAsyJy5ieXRlYmFzZS52MS5EYXRhU291cmNlLkF6dXJlQ3JlZGVudGlhbEgAEj8KDmF3c19jcmVkZW50aWFsGCUgASgLMiUuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZS5BV1NDcmVkZW50aWFsSAASPwoOZ2NwX2NyZWRlbnRpYWwYJiABKAsyJS5ieXRlYmFzZS52MS5EYXRhU291cmNlLkdDUENyZWRlbnRpYWxIABIsCgtzYXNsX2NvbmZpZxgYIAEoCzIXLmJ5dGViYXNlLnYxLlNBU0xDb25maWcSQgoUYWRkaXRpb25hbF9hZGRyZXNzZXMYGiADKAsyHy5ieXRlYmFzZS52MS5EYXRhU291cmNlLkFkZHJlc3NCA+BBARIZChFkaXJlY3RfY29ubmVjdGlvbhgbIAEoCBIOCgZyZWdpb24YHCABKAkSFAoMd2FyZWhvdXNlX2lkGB0gASgJEhMKC21hc3Rlcl9uYW1lGB8gASgJEhcKD21hc3Rlcl91c2VybmFtZRggIAEoCRIXCg9tYXN0ZXJfcGFzc3dvcmQYISABKAkSNQoKcmVkaXNfdHlwZRgiIAEoDjIhLmJ5dGViYXNlLnYxLkRhdGFTb3VyY2UuUmVkaXNUeXBlEg8KB2NsdXN0ZXIYIyABKAkSWwobZXh0cmFfY29ubmVjdGlvbl9wYXJhbWV0ZXJzGCQgAygLMjYuYnl0ZWJhc2UudjEuRGF0YVNvdXJjZS5FeHRyYUNvbm5lY3Rpb25QYXJhbWV0ZXJzRW50cnkaUwoPQXp1cmVDcmVkZW50aWFsEhEKCXRlbmFudF9pZBgBIAEoCRIRCgljbGllbnRfaWQYAiABKAkSGgoNY2xpZW50X3NlY3JldBgDIAEoCUID4EEEGpgBCg1BV1NDcmVkZW50aWFsEhoKDWFjY2Vzc19rZXlfaWQYASABKAlCA+BBBBIeChFzZWNyZXRfYWNjZXNzX2tleRgCIAEoCUID4EEEEhoKDXNlc3Npb25fdG9rZW4YAyABKAlCA+BBBBIVCghyb2xlX2FybhgEIAEoCUID4EEEEhgKC2V4dGVybmFsX2lkGAUgASgJQgPgQQQaJQoNR0NQQ3JlZGVudGlhbBIUCgdjb250ZW50GAEgASgJQgPgQQQaJQoHQWRkcmVzcxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAkaQAoeRXh0cmFDb25uZWN0aW9uUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEifAoSQXV0aGVudGljYXRpb25UeXBlEh4KGkFVVEhFTlRJQ0FUSU9OX1VOU1BFQ0lGSUVEEAASDAoIUEFTU1dPUkQQARIYChRHT09HTEVfQ0xPVURfU1FMX0lBTRACEg8KC0FXU19SRFNfSUFNEAMSDQoJQVpVUkVfSUFNEAQiUgoJUmVkaXNUeXBlEhoKFlJFRElTX1RZUEVfVU5TUEVDSUZJRUQQABIOCgpTVEFOREFMT05FEAESDAoIU0VOVElORUwQAhILCgdDTFVTVEVSEANCDwoNaWFtX2V4dGVuc2lvbiLeAQoQSW5zdGFuY2VSZXNvdXJjZRINCgV0aXRsZRgBIAEoCRIjCgZlbmdpbmUYAiABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSGwoOZW5naW5lX3ZlcnNpb24YAyABKAlCA+BBAxItCgxkYXRhX3NvdXJjZXMYBCADKAsyFy5ieXRlYmFzZS52MS5EYXRhU291cmNlEhIKCmFjdGl2YXRpb24YBSABKAgSDAoEbmFtZRgGIAEoCRIYCgtlbnZpcm9ubWVudBgHIAEoCUgAiAEBQg4KDF9lbnZpcm9ubWVudCJMCgpTQVNMQ29uZmlnEjEKCmtyYl9jb25maWcYASABKAsyGy5ieXRlYmFzZS52MS5LZXJiZXJvc0NvbmZpZ0gAQgsKCW1lY2hhbmlzbSKWAQoOS2VyYmVyb3NDb25maWcSDwoHcHJpbWFyeRgBIAEoCRIQCghpbnN0YW5jZRgCIAEoCRINCgVyZWFsbRgDIAEoCRIOCgZrZXl0YWIYBCABKAwSEAoIa2RjX2hvc3QYBSABKAkSEAoIa2RjX3BvcnQYBiABKAkSHgoWa2RjX3RyYW5zcG9ydF9wcm90b2NvbBgHIAEoCSpHCg5EYXRhU291cmNlVHlwZRIbChdEQVRBX1NPVVJDRV9VTlNQRUNJRklFRBAAEgkKBUFETUlOEAESDQoJUkVBRF9PTkxZEAIy1BAKD0luc3RhbmNlU2VydmljZRKEAQoLR2V0SW5zdGFuY2USHy5ieXRlYmFzZS52MS5HZXRJbnN0YW5jZVJlcXVlc3QaFS5ieXRlYmFzZS52MS5JbnN0YW5jZSI92kEEbmFtZYrqMBBiYi5pbnN0YW5jZXMuZ2V0kOowAYLT5JMCGBIWL3YxL3tuYW1lPWluc3RhbmNlcy8qfRKJAQoNTGlzdEluc3RhbmNlcxIhLmJ5dGViYXNlLnYxLkxpc3RJbnN0YW5jZXNSZXF1ZXN0GiIuYnl0ZWJhc2UudjEuTGlzdEluc3RhbmNlc1Jlc3BvbnNlIjHaQQCK6jARYmIuaW5zdGFuY2VzLmxpc3SQ6jABgtPkkwIPEg0vdjEvaW5zdGFuY2VzEpYBCg5DcmVhdGVJbnN0YW5jZRIiLmJ5dGViYXNlLnYxLkNyZWF0ZUluc3RhbmNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkluc3RhbmNlIknaQQhpbnN0YW5jZYrqMBNiYi5pbnN0YW5jZXMuY3JlYXRlkOowAZjqMAGC0+STAhk6CGluc3RhbmNlIg0vdjEvaW5zdGFuY2VzErQBCg5VcGRhdGVJbnN0YW5jZRIiLmJ5dGViYXNlLnYxLlVwZGF0ZUluc3RhbmNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkluc3RhbmNlImfaQRRpbnN0YW5jZSx1cGRhdGVfbWFza4rqMBNiYi5pbnN0YW5jZXMudXBkYXRlkOowAZjqMAGC0+STAis6CGluc3RhbmNlMh8vdjEve2luc3RhbmNlLm5hbWU9aW5zdGFuY2VzLyp9EpIBCg5EZWxldGVJbnN0YW5jZRIiLmJ5dGViYXNlLnYxLkRlbGV0ZUluc3RhbmNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSJE2kEEbmFtZYrqMBNiYi5pbnN0YW5jZXMuZGVsZXRlkOowAZjqMAGC0+STAhgqFi92MS97bmFtZT1pbnN0YW5jZXMvKn0SnAEKEFVuZGVsZXRlSW5zdGFuY2USJC5ieXRlYmFzZS52MS5VbmRlbGV0ZUluc3RhbmNlUmVxdWVzdBoVLmJ5dGViYXNlLnYxLkluc3RhbmNlIkuK6jAVYmIuaW5zdGFuY2VzLnVuZGVsZXRlkOowAZjqMAGC0+STAiQ6ASoiHy92MS97bmFtZT1pbnN0YW5jZXMvKn06dW5kZWxldGUSlAEKDFN5bmNJbnN0YW5jZRIgLmJ5dGViYXNlLnYxLlN5bmNJbnN0YW5jZVJlcXVlc3QaIS5ieXRlYmFzZS52MS5TeW5jSW5zdGFuY2VSZXNwb25zZSI/iuowEWJiLmluc3RhbmNlcy5zeW5jkOowAYLT5JMCIDoBKiIbL3YxL3tuYW1lPWluc3RhbmNlcy8qfTpzeW5jErABChRMaXN0SW5zdGFuY2VEYXRhYmFzZRIoLmJ5dGViYXNlLnYxLkxpc3RJbnN0YW5jZURhdGFiYXNlUmVxdWVzdBopLmJ5dGViYXNlLnYxLkxpc3RJbnN0YW5jZURhdGFiYXNlUmVzcG9uc2UiQ4rqMBBiYi5pbnN0YW5jZXMuZ2V0kOowAYLT5JMCJToBKiIgL3YxL3tuYW1lPWluc3RhbmNlcy8qfTpkYXRhYmFzZXMSogEKEkJhdGNoU3luY0luc3RhbmNlcxImLmJ5dGViYXNlLnYxLkJhdGNoU3luY0luc3RhbmNlc1JlcXVlc3QaJy5ieXRlYmFzZS52MS5CYXRjaFN5bmNJbnN0YW5jZXNSZXNwb25zZSI7iuowEWJiLmluc3RhbmNlcy5zeW5jkOowAYLT5JMCHDoBKiIXL3YxL2luc3RhbmNlczpiYXRjaFN5bmMSsAEKFEJhdGNoVXBkYXRlSW5zdGFuY2VzEiguYnl0ZWJhc2UudjEuQmF0Y2hVcGRhdGVJbnN0YW5jZXNSZXF1ZXN0GikuYnl0ZWJhc2UudjEuQmF0Y2hVcGRhdGVJbnN0YW5jZXNSZXNwb25zZSJDiuowE2JiLmluc3RhbmNlcy51cGRhdGWQ6jABmOowAYLT5JMCHjoBKiIZL3YxL2luc3RhbmNlczpiYXRjaFVwZGF0ZRKZAQoNQWRkRGF0YVNvdXJjZRIhLmJ5dGViYXNlLnYxLkFkZERhdGFTb3VyY2VSZXF1ZXN0GhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UiTorqMBNiYi5pbnN0YW5jZXMudXBkYXRlkOowAZjqMAGC0+STAik6ASoiJC92MS97bmFtZT1pbnN0YW5jZXMvKn06YWRkRGF0YVNvdXJjZRKiAQoQUmVtb3ZlRGF0YVNvdXJjZRIkLmJ5dGViYXNlLnYxLlJlbW92ZURhdGFTb3VyY2VSZXF1ZXN0GhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UiUYrqMBNiYi5pbnN0YW5jZXMudXBkYXRlkOowAZjqMAGC0+STAiw6ASoiJy92MS97bmFtZT1pbnN0YW5jZXMvKn06cmVtb3ZlRGF0YVNvdXJjZRLGAQoQVXBkYXRlRGF0YVNvdXJjZRIkLmJ5dGViYXNlLnYxLlVwZGF0ZURhdGFTb3VyY2VSZXF1ZXN0GhUuYnl0ZWJhc2UudjEuSW5zdGFuY2UiddpBF2RhdGFfc291cmNlLHVwZGF0ZV9tYXNriuowE2JiLmluc3RhbmNlcy51cGRhdGWQ6jABmOowAYLT5JMCNjoLZGF0YV9zb3VyY2UyJy92MS97bmFtZT1pbnN0YW5jZXMvKn06dXBkYXRlRGF0YVNvdXJjZUKqAQoPY29tLmJ5dGViYXNlLnYxQhRJbnN0YW5jZVNlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_instance_role_service]);
 
 /**
  * Describes the message bytebase.v1.GetInstanceRequest.
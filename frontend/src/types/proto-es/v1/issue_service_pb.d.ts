@@ -65,6 +65,47 @@ export declare type CreateIssueRequest = Message<"bytebase.v1.CreateIssueRequest
  */
 export declare const CreateIssueRequestSchema: GenMessage<CreateIssueRequest>;
 
+/**
+ * @generated from message bytebase.v1.BreakGlassRequest
+ */
+export declare type BreakGlassRequest = Message<"bytebase.v1.BreakGlassRequest"> & {
+  /**
+   * The project of the databases.
+   * Format: projects/{project}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The databases to access in the SQL Editor.
+   * Format: instances/{instance}/databases/{database}
+   *
+   * @generated from field: repeated string databases = 2;
+   */
+  databases: string[];
+
+  /**
+   * The justification for the emergency access, which is the description of the review issue.
+   *
+   * @generated from field: string justification = 3;
+   */
+  justification: string;
+
+  /**
+   * The duration of the access. It must not exceed 4 hours.
+   *
+   * @generated from field: google.protobuf.Duration duration = 4;
+   */
+  duration?: Duration;
+};
+
+/**
+ * Describes the message bytebase.v1.BreakGlassRequest.
+ * Use `create(BreakGlassRequestSchema)` to create a new message.
+ */
+export declare const BreakGlassRequestSchema: GenMessage<BreakGlassRequest>;
+
 /**
  * @generated from message bytebase.v1.ListIssuesRequest
  */
@@ -771,6 +812,14 @@ export declare type GrantRequest = Message<"bytebase.v1.GrantRequest"> & {
    * @generated from field: google.protobuf.Duration expiration = 4;
    */
   expiration?: Duration;
+
+  /**
+   * Whether the grant is a break-glass access, which is granted without approval.
+   * The approval of the issue is the post-incident review.
+   *
+   * @generated from field: bool break_glass = 5;
+   */
+  breakGlass: boolean;
 };
 
 /**
@@ -1545,5 +1594,17 @@ export declare const IssueService: GenService<{
     input: typeof RequestIssueRequestSchema;
     output: typeof IssueSchema;
   },
+  /**
+   * Grants the caller emergency SQL Editor access to databases without going through the approval flow.
+   * The access expires after the requested duration, and a grant request issue is created for the post-incident review.
+   * Permissions required: bb.issues.breakGlass
+   *
+   * @generated from rpc bytebase.v1.IssueService.BreakGlass
+   */
+  breakGlass: {
+    methodKind: "unary";
+    input: typeof BreakGlassRequestSchema;
+    output: typeof IssueSchema;
+  },
 }>;
 
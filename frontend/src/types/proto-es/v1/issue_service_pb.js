@@ -17,7 +17,7 @@ import { file_v1_common } from "./common_pb";
  * Describes the file v1/issue_service.proto.
  */
 export const file_v1_issue_service = /*@__PURE__*/
-  fileDesc("ChZ2MS9pc3N1ZV9zZXJ2aWNlLnByb3RvEgtieXRlYmFzZS52MSJKCg9HZXRJc3N1ZVJlcXVlc3QSKAoEbmFtZRgBIAEoCUIa4EEC+kEUChJieXRlYmFzZS5jb20vSXNzdWUSDQoFZm9yY2UYAiABKAgiagoSQ3JlYXRlSXNzdWVSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBImCgVpc3N1ZRgCIAEoCzISLmJ5dGViYXNlLnYxLklzc3VlQgPgQQIihwEKEUxpc3RJc3N1ZXNSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCRIOCgZmaWx0ZXIYBCABKAkSDQoFcXVlcnkYBSABKAkiUQoSTGlzdElzc3Vlc1Jlc3BvbnNlEiIKBmlzc3VlcxgBIAMoCzISLmJ5dGViYXNlLnYxLklzc3VlEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJwChNTZWFyY2hJc3N1ZXNSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECEhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEg4KBmZpbHRlchgEIAEoCRINCgVxdWVyeRgFIAEoCSJTChRTZWFyY2hJc3N1ZXNSZXNwb25zZRIiCgZpc3N1ZXMYASADKAsyEi5ieXRlYmFzZS52MS5Jc3N1ZRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkioAEKElVwZGF0ZUlzc3VlUmVxdWVzdBI9CgVpc3N1ZRgBIAEoCzISLmJ5dGViYXNlLnYxLklzc3VlQhrgQQL6QRQKEmJ5dGViYXNlLmNvbS9Jc3N1ZRI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIVCg1hbGxvd19taXNzaW5nGAMgASgIIpgBCh5CYXRjaFVwZGF0ZUlzc3Vlc1N0YXR1c1JlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0Eg4KBmlzc3VlcxgCIAMoCRIoCgZzdGF0dXMYAyABKA4yGC5ieXRlYmFzZS52MS5Jc3N1ZVN0YXR1cxIOCgZyZWFzb24YBCABKAkiIQofQmF0Y2hVcGRhdGVJc3N1ZXNTdGF0dXNSZXNwb25zZSJQChNBcHByb3ZlSXNzdWVSZXF1ZXN0EigKBG5hbWUYASABKAlCGuBBAvpBFAoSYnl0ZWJhc2UuY29tL0lzc3VlEg8KB2NvbW1lbnQYAiABKAkiTwoSUmVqZWN0SXNzdWVSZXF1ZXN0EigKBG5hbWUYASABKAlCGuBBAvpBFAoSYnl0ZWJhc2UuY29tL0lzc3VlEg8KB2NvbW1lbnQYAiABKAkiUAoTUmVxdWVzdElzc3VlUmVxdWVzdBIoCgRuYW1lGAEgASgJQhrgQQL6QRQKEmJ5dGViYXNlLmNvbS9Jc3N1ZRIPCgdjb21tZW50GAIgASgJIuIJCgVJc3N1ZRIMCgRuYW1lGAEgASgJEhcKBXRpdGxlGAMgASgJQgi6SAVyAxjIARIdCgtkZXNjcmlwdGlvbhgEIAEoCUIIukgFcgMYkE4SJQoEdHlwZRgFIAEoDjIXLmJ5dGViYXNlLnYxLklzc3VlLlR5cGUSKAoGc3RhdHVzGAYgASgOMhguYnl0ZWJhc2UudjEuSXNzdWVTdGF0dXMSLgoJYXBwcm92ZXJzGAkgAygLMhsuYnl0ZWJhc2UudjEuSXNzdWUuQXBwcm92ZXISOAoRYXBwcm92YWxfdGVtcGxhdGUYCiABKAsyHS5ieXRlYmFzZS52MS5BcHByb3ZhbFRlbXBsYXRlEhQKB2NyZWF0b3IYDiABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIMCgRwbGFuGBEgASgJEg8KB3JvbGxvdXQYEiABKAkSMAoNZ3JhbnRfcmVxdWVzdBgTIAEoCzIZLmJ5dGViYXNlLnYxLkdyYW50UmVxdWVzdBIRCglyZWxlYXNlcnMYFCADKAkSKgoKcmlza19sZXZlbBgVIAEoDjIWLmJ5dGViYXNlLnYxLlJpc2tMZXZlbBJCChF0YXNrX3N0YXR1c19jb3VudBgWIAMoCzInLmJ5dGViYXNlLnYxLklzc3VlLlRhc2tTdGF0dXNDb3VudEVudHJ5Eg4KBmxhYmVscxgXIAMoCRI/Cg9hcHByb3ZhbF9zdGF0dXMYGCABKA4yIS5ieXRlYmFzZS52MS5Jc3N1ZS5BcHByb3ZhbFN0YXR1c0ID4EEDEiIKFWFwcHJvdmFsX3N0YXR1c19lcnJvchgZIAEoCUID4EEDGpwBCghBcHByb3ZlchIyCgZzdGF0dXMYASABKA4yIi5ieXRlYmFzZS52MS5Jc3N1ZS5BcHByb3Zlci5TdGF0dXMSEQoJcHJpbmNpcGFsGAIgASgJIkkKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdQRU5ESU5HEAESDAoIQVBQUk9WRUQQAhIMCghSRUpFQ1RFRBADGjYKFFRhc2tTdGF0dXNDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEiWQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEwoPREFUQUJBU0VfQ0hBTkdFEAESEQoNR1JBTlRfUkVRVUVTVBACEhMKD0RBVEFCQVNFX0VYUE9SVBADIoABCg5BcHByb3ZhbFN0YXR1cxIfChtBUFBST1ZBTF9TVEFUVVNfVU5TUEVDSUZJRUQQABIMCghDSEVDS0lORxABEgsKB1BFTkRJTkcQAhIMCghBUFBST1ZFRBADEgwKCFJFSkVDVEVEEAQSCwoHU0tJUFBFRBAFEgkKBUVSUk9SEAY6OupBNwoSYnl0ZWJhc2UuY29tL0lzc3VlEiFwcm9qZWN0cy97cHJvamVjdH0vaXNzdWVzL3tpc3N1ZX1KBAgCEANKBAgHEAhKBAgIEAlKBAgLEAxKBAgMEA0ifwoMR3JhbnRSZXF1ZXN0EgwKBHJvbGUYASABKAkSDAoEdXNlchgCIAEoCRIkCgljb25kaXRpb24YAyABKAsyES5nb29nbGUudHlwZS5FeHByEi0KCmV4cGlyYXRpb24YBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iXwoQQXBwcm92YWxUZW1wbGF0ZRInCgRmbG93GAEgASgLMhkuYnl0ZWJhc2UudjEuQXBwcm92YWxGbG93Eg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJIh0KDEFwcHJvdmFsRmxvdxINCgVyb2xlcxgBIAMoCSJtChhMaXN0SXNzdWVDb21tZW50c1JlcXVlc3QSKgoGcGFyZW50GAEgASgJQhrgQQL6QRQKEmJ5dGViYXNlLmNvbS9Jc3N1ZRIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCSJnChlMaXN0SXNzdWVDb21tZW50c1Jlc3BvbnNlEjEKDmlzc3VlX2NvbW1lbnRzGAEgAygLMhkuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJ5ChlDcmVhdGVJc3N1ZUNvbW1lbnRSZXF1ZXN0EioKBnBhcmVudBgBIAEoCUIa4EEC+kEUChJieXRlYmFzZS5jb20vSXNzdWUSMAoNaXNzdWVfY29tbWVudBgCIAEoCzIZLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudCLGAQoZVXBkYXRlSXNzdWVDb21tZW50UmVxdWVzdBIqCgZwYXJlbnQYASABKAlCGuBBAvpBFAoSYnl0ZWJhc2UuY29tL0lzc3VlEjAKDWlzc3VlX2NvbW1lbnQYAiABKAsyGS5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQSNAoLdXBkYXRlX21hc2sYAyABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQISFQoNYWxsb3dfbWlzc2luZxgEIAEoCCK5DAoMSXNzdWVDb21tZW50EgwKBG5hbWUYASABKAkSGgoHY29tbWVudBgCIAEoCUIJukgGcgQYgIAEEg8KB3BheWxvYWQYAyABKAkSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSFAoHY3JlYXRvchgHIAEoCUID4EEDEjYKCGFwcHJvdmFsGAggASgLMiIuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50LkFwcHJvdmFsSAASPQoMaXNzdWVfdXBkYXRlGAkgASgLMiUuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50Lklzc3VlVXBkYXRlSAASNwoJc3RhZ2VfZW5kGAogASgLMiIuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50LlN0YWdlRW5kSAASOwoLdGFza191cGRhdGUYCyABKAsyJC5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuVGFza1VwZGF0ZUgAEkYKEXRhc2tfcHJpb3JfYmFja3VwGAwgASgLMikuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50LlRhc2tQcmlvckJhY2t1cEgAGpABCghBcHByb3ZhbBI5CgZzdGF0dXMYASABKA4yKS5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuQXBwcm92YWwuU3RhdHVzIkkKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdQRU5ESU5HEAESDAoIQVBQUk9WRUQQAhIMCghSRUpFQ1RFRBADGvUCCgtJc3N1ZVVwZGF0ZRIXCgpmcm9tX3RpdGxlGAEgASgJSACIAQESFQoIdG9fdGl0bGUYAiABKAlIAYgBARIdChBmcm9tX2Rlc2NyaXB0aW9uGAMgASgJSAKIAQESGwoOdG9fZGVzY3JpcHRpb24YBCABKAlIA4gBARIyCgtmcm9tX3N0YXR1cxgFIAEoDjIYLmJ5dGViYXNlLnYxLklzc3VlU3RhdHVzSASIAQESMAoJdG9fc3RhdHVzGAYgASgOMhguYnl0ZWJhc2UudjEuSXNzdWVTdGF0dXNIBYgBARITCgtmcm9tX2xhYmVscxgJIAMoCRIRCgl0b19sYWJlbHMYCiADKAlCDQoLX2Zyb21fdGl0bGVCCwoJX3RvX3RpdGxlQhMKEV9mcm9tX2Rlc2NyaXB0aW9uQhEKD190b19kZXNjcmlwdGlvbkIOCgxfZnJvbV9zdGF0dXNCDAoKX3RvX3N0YXR1c0oECAcQCEoECAgQCRoZCghTdGFnZUVuZBINCgVzdGFnZRgBIAEoCRqnAgoKVGFza1VwZGF0ZRINCgV0YXNrcxgBIAMoCRIXCgpmcm9tX3NoZWV0GAIgASgJSACIAQESFQoIdG9fc2hlZXQYAyABKAlIAYgBARJDCgl0b19zdGF0dXMYBiABKA4yKy5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuVGFza1VwZGF0ZS5TdGF0dXNIAogBASJrCgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEgsKB1JVTk5JTkcQAhIICgRET05FEAMSCgoGRkFJTEVEEAQSCwoHU0tJUFBFRBAFEgwKCENBTkNFTEVEEAZCDQoLX2Zyb21fc2hlZXRCCwoJX3RvX3NoZWV0QgwKCl90b19zdGF0dXMa1wEKD1Rhc2tQcmlvckJhY2t1cBIMCgR0YXNrGAEgASgJEj8KBnRhYmxlcxgCIAMoCzIvLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudC5UYXNrUHJpb3JCYWNrdXAuVGFibGUSGgoNb3JpZ2luYWxfbGluZRgDIAEoBUgAiAEBEhAKCGRhdGFiYXNlGAQgASgJEg0KBWVycm9yGAUgASgJGiYKBVRhYmxlEg4KBnNjaGVtYRgBIAEoCRINCgV0YWJsZRgCIAEoCUIQCg5fb3JpZ2luYWxfbGluZUIHCgVldmVudEoECAYQBypNCgtJc3N1ZVN0YXR1cxIcChhJU1NVRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIICgRPUEVOEAESCAoERE9ORRACEgwKCENBTkNFTEVEEAMy2A8KDElzc3VlU2VydmljZRKAAQoIR2V0SXNzdWUSHC5ieXRlYmFzZS52MS5HZXRJc3N1ZVJlcXVlc3QaEi5ieXRlYmFzZS52MS5Jc3N1ZSJC2kEEbmFtZYrqMA1iYi5pc3N1ZXMuZ2V0kOowAYLT5JMCIBIeL3YxL3tuYW1lPXByb2plY3RzLyovaXNzdWVzLyp9EpwBCgtDcmVhdGVJc3N1ZRIfLmJ5dGViYXNlLnYxLkNyZWF0ZUlzc3VlUmVxdWVzdBoSLmJ5dGViYXNlLnYxLklzc3VlIljaQQxwYXJlbnQsaXNzdWWK6jAQYmIuaXNzdWVzLmNyZWF0ZZDqMAGY6jABgtPkkwInOgVpc3N1ZSIeL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vaXNzdWVzEpQBCgpMaXN0SXNzdWVzEh4uYnl0ZWJhc2UudjEuTGlzdElzc3Vlc1JlcXVlc3QaHy5ieXRlYmFzZS52MS5MaXN0SXNzdWVzUmVzcG9uc2UiRdpBBnBhcmVudIrqMA5iYi5pc3N1ZXMubGlzdJDqMAGC0+STAiASHi92MS97cGFyZW50PXByb2plY3RzLyp9L2lzc3VlcxKaAQoMU2VhcmNoSXNzdWVzEiAuYnl0ZWJhc2UudjEuU2VhcmNoSXNzdWVzUmVxdWVzdBohLmJ5dGViYXNlLnYxLlNlYXJjaElzc3Vlc1Jlc3BvbnNlIkWK6jANYmIuaXNzdWVzLmdldJDqMAKC0+STAio6ASoiJS92MS97cGFyZW50PXByb2plY3RzLyp9L2lzc3VlczpzZWFyY2gSpwEKC1VwZGF0ZUlzc3VlEh8uYnl0ZWJhc2UudjEuVXBkYXRlSXNzdWVSZXF1ZXN0GhIuYnl0ZWJhc2UudjEuSXNzdWUiY9pBEWlzc3VlLHVwZGF0ZV9tYXNriuowEGJiLmlzc3Vlcy51cGRhdGWQ6jABmOowAYLT5JMCLToFaXNzdWUyJC92MS97aXNzdWUubmFtZT1wcm9qZWN0cy8qL2lzc3Vlcy8qfRLAAQoRTGlzdElzc3VlQ29tbWVudHMSJS5ieXRlYmFzZS52MS5MaXN0SXNzdWVDb21tZW50c1JlcXVlc3QaJi5ieXRlYmFzZS52MS5MaXN0SXNzdWVDb21tZW50c1Jlc3BvbnNlIlzaQQZwYXJlbnSK6jAVYmIuaXNzdWVDb21tZW50cy5saXN0kOowAYLT5JMCMBIuL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9pc3N1ZXMvKn0vaXNzdWVDb21tZW50cxLSAQoSQ3JlYXRlSXNzdWVDb21tZW50EiYuYnl0ZWJhc2UudjEuQ3JlYXRlSXNzdWVDb21tZW50UmVxdWVzdBoZLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudCJ52kEUcGFyZW50LGlzc3VlX2NvbW1lbnSK6jAXYmIuaXNzdWVDb21tZW50cy5jcmVhdGWQ6jABmOowAYLT5JMCOToNaXNzdWVfY29tbWVudCIoL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9pc3N1ZXMvKn06Y29tbWVudBLfAQoSVXBkYXRlSXNzdWVDb21tZW50EiYuYnl0ZWJhc2UudjEuVXBkYXRlSXNzdWVDb21tZW50UmVxdWVzdBoZLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudCKFAdpBIHBhcmVudCxpc3N1ZV9jb21tZW50LHVwZGF0ZV9tYXNriuowF2JiLmlzc3VlQ29tbWVudHMudXBkYXRlkOowAZjqMAGC0+STAjk6DWlzc3VlX2NvbW1lbnQyKC92MS97cGFyZW50PXByb2plY3RzLyovaXNzdWVzLyp9OmNvbW1lbnQSzQEKF0JhdGNoVXBkYXRlSXNzdWVzU3RhdHVzEisuYnl0ZWJhc2UudjEuQmF0Y2hVcGRhdGVJc3N1ZXNTdGF0dXNSZXF1ZXN0GiwuYnl0ZWJhc2UudjEuQmF0Y2hVcGRhdGVJc3N1ZXNTdGF0dXNSZXNwb25zZSJXiuowEGJiLmlzc3Vlcy51cGRhdGWQ6jABmOowAYLT5JMCNToBKiIwL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vaXNzdWVzOmJhdGNoVXBkYXRlU3RhdHVzEn8KDEFwcHJvdmVJc3N1ZRIgLmJ5dGViYXNlLnYxLkFwcHJvdmVJc3N1ZVJlcXVlc3QaEi5ieXRlYmFzZS52MS5Jc3N1ZSI5kOowApjqMAGC0+STAis6ASoiJi92MS97bmFtZT1wcm9qZWN0cy8qL2lzc3Vlcy8qfTphcHByb3ZlEnwKC1JlamVjdElzc3VlEh8uYnl0ZWJhc2UudjEuUmVqZWN0SXNzdWVSZXF1ZXN0GhIuYnl0ZWJhc2UudjEuSXNzdWUiOJDqMAKY6jABgtPkkwIqOgEqIiUvdjEve25hbWU9cHJvamVjdHMvKi9pc3N1ZXMvKn06cmVqZWN0En8KDFJlcXVlc3RJc3N1ZRIgLmJ5dGViYXNlLnYxLlJlcXVlc3RJc3N1ZVJlcXVlc3QaEi5ieXRlYmFzZS52MS5Jc3N1ZSI5kOowApjqMAGC0+STAis6ASoiJi92MS97bmFtZT1wcm9qZWN0cy8qL2lzc3Vlcy8qfTpyZXF1ZXN0QqcBCg9jb20uYnl0ZWJhc2UudjFCEUlzc3VlU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_google_type_expr, file_v1_annotation, file_v1_common]);
+  fileDesc("ChZ2MS9pc3N1ZV9zZXJ2aWNlLnByb3RvEgtieXRlYmFzZS52MSJKCg9HZXRJc3N1ZVJlcXVlc3QSKAoEbmFtZRgBIAEoCUIa4EEC+kEUChJieXRlYmFzZS5jb20vSXNzdWUSDQoFZm9yY2UYAiABKAgiagoSQ3JlYXRlSXNzdWVSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBImCgVpc3N1ZRgCIAEoCzISLmJ5dGViYXNlLnYxLklzc3VlQgPgQQIipwEKEUJyZWFrR2xhc3NSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIWCglkYXRhYmFzZXMYAiADKAlCA+BBAhIaCg1qdXN0aWZpY2F0aW9uGAMgASgJQgPgQQISMAoIZHVyYXRpb24YBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBAiKHAQoRTGlzdElzc3Vlc1JlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEg4KBmZpbHRlchgEIAEoCRINCgVxdWVyeRgFIAEoCSJRChJMaXN0SXNzdWVzUmVzcG9uc2USIgoGaXNzdWVzGAEgAygLMhIuYnl0ZWJhc2UudjEuSXNzdWUSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInAKE1NlYXJjaElzc3Vlc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJEg0KBXF1ZXJ5GAUgASgJIlMKFFNlYXJjaElzc3Vlc1Jlc3BvbnNlEiIKBmlzc3VlcxgBIAMoCzISLmJ5dGViYXNlLnYxLklzc3VlEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKgAQoSVXBkYXRlSXNzdWVSZXF1ZXN0Ej0KBWlzc3VlGAEgASgLMhIuYnl0ZWJhc2UudjEuSXNzdWVCGuBBAvpBFAoSYnl0ZWJhc2UuY29tL0lzc3VlEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECEhUKDWFsbG93X21pc3NpbmcYAyABKAgimAEKHkJhdGNoVXBkYXRlSXNzdWVzU3RhdHVzUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSDgoGaXNzdWVzGAIgAygJEigKBnN0YXR1cxgDIAEoDjIYLmJ5dGViYXNlLnYxLklzc3VlU3RhdHVzEg4KBnJlYXNvbhgEIAEoCSIhCh9CYXRjaFVwZGF0ZUlzc3Vlc1N0YXR1c1Jlc3BvbnNlIlAKE0FwcHJvdmVJc3N1ZVJlcXVlc3QSKAoEbmFtZRgBIAEoCUIa4EEC+kEUChJieXRlYmFzZS5jb20vSXNzdWUSDwoHY29tbWVudBgCIAEoCSJPChJSZWplY3RJc3N1ZVJlcXVlc3QSKAoEbmFtZRgBIAEoCUIa4EEC+kEUChJieXRlYmFzZS5jb20vSXNzdWUSDwoHY29tbWVudBgCIAEoCSJQChNSZXF1ZXN0SXNzdWVSZXF1ZXN0EigKBG5hbWUYASABKAlCGuBBAvpBFAoSYnl0ZWJhc2UuY29tL0lzc3VlEg8KB2NvbW1lbnQYAiABKAki4gkKBUlzc3VlEgwKBG5hbWUYASABKAkSFwoFdGl0bGUYAyABKAlCCLpIBXIDGMgBEh0KC2Rlc2NyaXB0aW9uGAQgASgJQgi6SAVyAxiQThIlCgR0eXBlGAUgASgOMhcuYnl0ZWJhc2UudjEuSXNzdWUuVHlwZRIoCgZzdGF0dXMYBiABKA4yGC5ieXRlYmFzZS52MS5Jc3N1ZVN0YXR1cxIuCglhcHByb3ZlcnMYCSADKAsyGy5ieXRlYmFzZS52MS5Jc3N1ZS5BcHByb3ZlchI4ChFhcHByb3ZhbF90ZW1wbGF0ZRgKIAEoCzIdLmJ5dGViYXNlLnYxLkFwcHJvdmFsVGVtcGxhdGUSFAoHY3JlYXRvchgOIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEgwKBHBsYW4YESABKAkSDwoHcm9sbG91dBgSIAEoCRIwCg1ncmFudF9yZXF1ZXN0GBMgASgLMhkuYnl0ZWJhc2UudjEuR3JhbnRSZXF1ZXN0EhEKCXJlbGVhc2VycxgUIAMoCRIqCgpyaXNrX2xldmVsGBUgASgOMhYuYnl0ZWJhc2UudjEuUmlza0xldmVsEkIKEXRhc2tfc3RhdHVzX2NvdW50GBYgAygLMicuYnl0ZWJhc2UudjEuSXNzdWUuVGFza1N0YXR1c0NvdW50RW50cnkSDgoGbGFiZWxzGBcgAygJEj8KD2FwcHJvdmFsX3N0YXR1cxgYIAEoDjIhLmJ5dGViYXNlLnYxLklzc3VlLkFwcHJvdmFsU3RhdHVzQgPgQQMSIgoVYXBwcm92YWxfc3RhdHVzX2Vycm9yGBkgASgJQgPgQQManAEKCEFwcHJvdmVyEjIKBnN0YXR1cxgBIAEoDjIiLmJ5dGViYXNlLnYxLklzc3VlLkFwcHJvdmVyLlN0YXR1cxIRCglwcmluY2lwYWwYAiABKAkiSQoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgsKB1BFTkRJTkcQARIMCghBUFBST1ZFRBACEgwKCFJFSkVDVEVEEAMaNgoUVGFza1N0YXR1c0NvdW50RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASJZCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABITCg9EQVRBQkFTRV9DSEFOR0UQARIRCg1HUkFOVF9SRVFVRVNUEAISEwoPREFUQUJBU0VfRVhQT1JUEAMigAEKDkFwcHJvdmFsU3RhdHVzEh8KG0FQUFJPVkFMX1NUQVRVU19VTlNQRUNJRklFRBAAEgwKCENIRUNLSU5HEAESCwoHUEVORElORxACEgwKCEFQUFJPVkVEEAMSDAoIUkVKRUNURUQQBBILCgdTS0lQUEVEEAUSCQoFRVJST1IQBjo66kE3ChJieXRlYmFzZS5jb20vSXNzdWUSIXByb2plY3RzL3twcm9qZWN0fS9pc3N1ZXMve2lzc3VlfUoECAIQA0oECAcQCEoECAgQCUoECAsQDEoECAwQDSKZAQoMR3JhbnRSZXF1ZXN0EgwKBHJvbGUYASABKAkSDAoEdXNlchgCIAEoCRIkCgljb25kaXRpb24YAyABKAsyES5nb29nbGUudHlwZS5FeHByEi0KCmV4cGlyYXRpb24YBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SGAoLYnJlYWtfZ2xhc3MYBSABKAhCA+BBAyJfChBBcHByb3ZhbFRlbXBsYXRlEicKBGZsb3cYASABKAsyGS5ieXRlYmFzZS52MS5BcHByb3ZhbEZsb3cSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkiHQoMQXBwcm92YWxGbG93Eg0KBXJvbGVzGAEgAygJIm0KGExpc3RJc3N1ZUNvbW1lbnRzUmVxdWVzdBIqCgZwYXJlbnQYASABKAlCGuBBAvpBFAoSYnl0ZWJhc2UuY29tL0lzc3VlEhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJImcKGUxpc3RJc3N1ZUNvbW1lbnRzUmVzcG9uc2USMQoOaXNzdWVfY29tbWVudHMYASADKAsyGS5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInkKGUNyZWF0ZUlzc3VlQ29tbWVudFJlcXVlc3QSKgoGcGFyZW50GAEgASgJQhrgQQL6QRQKEmJ5dGViYXNlLmNvbS9Jc3N1ZRIwCg1pc3N1ZV9jb21tZW50GAIgASgLMhkuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50IsYBChlVcGRhdGVJc3N1ZUNvbW1lbnRSZXF1ZXN0EioKBnBhcmVudBgBIAEoCUIa4EEC+kEUChJieXRlYmFzZS5jb20vSXNzdWUSMAoNaXNzdWVfY29tbWVudBgCIAEoCzIZLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudBI0Cgt1cGRhdGVfbWFzaxgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIVCg1hbGxvd19taXNzaW5nGAQgASgIIrkMCgxJc3N1ZUNvbW1lbnQSDAoEbmFtZRgBIAEoCRIaCgdjb21tZW50GAIgASgJQgm6SAZyBBiAgAQSDwoHcGF5bG9hZBgDIAEoCRI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIUCgdjcmVhdG9yGAcgASgJQgPgQQMSNgoIYXBwcm92YWwYCCABKAsyIi5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuQXBwcm92YWxIABI9Cgxpc3N1ZV91cGRhdGUYCSABKAsyJS5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuSXNzdWVVcGRhdGVIABI3CglzdGFnZV9lbmQYCiABKAsyIi5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuU3RhZ2VFbmRIABI7Cgt0YXNrX3VwZGF0ZRgLIAEoCzIkLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudC5UYXNrVXBkYXRlSAASRgoRdGFza19wcmlvcl9iYWNrdXAYDCABKAsyKS5ieXRlYmFzZS52MS5Jc3N1ZUNvbW1lbnQuVGFza1ByaW9yQmFja3VwSAAakAEKCEFwcHJvdmFsEjkKBnN0YXR1cxgBIAEoDjIpLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudC5BcHByb3ZhbC5TdGF0dXMiSQoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgsKB1BFTkRJTkcQARIMCghBUFBST1ZFRBACEgwKCFJFSkVDVEVEEAMa9QIKC0lzc3VlVXBkYXRlEhcKCmZyb21fdGl0bGUYASABKAlIAIgBARIVCgh0b190aXRsZRgCIAEoCUgBiAEBEh0KEGZyb21fZGVzY3JpcHRpb24YAyABKAlIAogBARIbCg50b19kZXNjcmlwdGlvbhgEIAEoCUgDiAEBEjIKC2Zyb21fc3RhdHVzGAUgASgOMhguYnl0ZWJhc2UudjEuSXNzdWVTdGF0dXNIBIgBARIwCgl0b19zdGF0dXMYBiABKA4yGC5ieXRlYmFzZS52MS5Jc3N1ZVN0YXR1c0gFiAEBEhMKC2Zyb21fbGFiZWxzGAkgAygJEhEKCXRvX2xhYmVscxgKIAMoCUINCgtfZnJvbV90aXRsZUILCglfdG9fdGl0bGVCEwoRX2Zyb21fZGVzY3JpcHRpb25CEQoPX3RvX2Rlc2NyaXB0aW9uQg4KDF9mcm9tX3N0YXR1c0IMCgpfdG9fc3RhdHVzSgQIBxAISgQICBAJGhkKCFN0YWdlRW5kEg0KBXN0YWdlGAEgASgJGqcCCgpUYXNrVXBkYXRlEg0KBXRhc2tzGAEgAygJEhcKCmZyb21fc2hlZXQYAiABKAlIAIgBARIVCgh0b19zaGVldBgDIAEoCUgBiAEBEkMKCXRvX3N0YXR1cxgGIAEoDjIrLmJ5dGViYXNlLnYxLklzc3VlQ29tbWVudC5UYXNrVXBkYXRlLlN0YXR1c0gCiAEBImsKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdQRU5ESU5HEAESCwoHUlVOTklORxACEggKBERPTkUQAxIKCgZGQUlMRUQQBBILCgdTS0lQUEVEEAUSDAoIQ0FOQ0VMRUQQBkINCgtfZnJvbV9zaGVldEILCglfdG9fc2hlZXRCDAoKX3RvX3N0YXR1cxrXAQoPVGFza1ByaW9yQmFja3VwEgwKBHRhc2sYASABKAkSPwoGdGFibGVzGAIgAygLMi8uYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50LlRhc2tQcmlvckJhY2t1cC5UYWJsZRIaCg1vcmlnaW5hbF9saW5lGAMgASgFSACIAQESEAoIZGF0YWJhc2UYBCABKAkSDQoFZXJyb3IYBSABKAkaJgoFVGFibGUSDgoGc2NoZW1hGAEgASgJEg0KBXRhYmxlGAIgASgJQhAKDl9vcmlnaW5hbF9saW5lQgcKBWV2ZW50SgQIBhAHKk0KC0lzc3VlU3RhdHVzEhwKGElTU1VFX1NUQVRVU19VTlNQRUNJRklFRBAAEggKBE9QRU4QARIICgRET05FEAISDAoIQ0FOQ0VMRUQQAzL6EAoMSXNzdWVTZXJ2aWNlEoABCghHZXRJc3N1ZRIcLmJ5dGViYXNlLnYxLkdldElzc3VlUmVxdWVzdBoSLmJ5dGViYXNlLnYxLklzc3VlIkLaQQRuYW1liuowDWJiLmlzc3Vlcy5nZXSQ6jABgtPkkwIgEh4vdjEve25hbWU9cHJvamVjdHMvKi9pc3N1ZXMvKn0SnAEKC0NyZWF0ZUlzc3VlEh8uYnl0ZWJhc2UudjEuQ3JlYXRlSXNzdWVSZXF1ZXN0GhIuYnl0ZWJhc2UudjEuSXNzdWUiWNpBDHBhcmVudCxpc3N1ZYrqMBBiYi5pc3N1ZXMuY3JlYXRlkOowAZjqMAGC0+STAic6BWlzc3VlIh4vdjEve3BhcmVudD1wcm9qZWN0cy8qfS9pc3N1ZXMSlAEKCkxpc3RJc3N1ZXMSHi5ieXRlYmFzZS52MS5MaXN0SXNzdWVzUmVxdWVzdBofLmJ5dGViYXNlLnYxLkxpc3RJc3N1ZXNSZXNwb25zZSJF2kEGcGFyZW50iuowDmJiLmlzc3Vlcy5saXN0kOowAYLT5JMCIBIeL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vaXNzdWVzEpoBCgxTZWFyY2hJc3N1ZXMSIC5ieXRlYmFzZS52MS5TZWFyY2hJc3N1ZXNSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuU2VhcmNoSXNzdWVzUmVzcG9uc2UiRYrqMA1iYi5pc3N1ZXMuZ2V0kOowAoLT5JMCKjoBKiIlL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vaXNzdWVzOnNlYXJjaBKnAQoLVXBkYXRlSXNzdWUSHy5ieXRlYmFzZS52MS5VcGRhdGVJc3N1ZVJlcXVlc3QaEi5ieXRlYmFzZS52MS5Jc3N1ZSJj2kERaXNzdWUsdXBkYXRlX21hc2uK6jAQYmIuaXNzdWVzLnVwZGF0ZZDqMAGY6jABgtPkkwItOgVpc3N1ZTIkL3YxL3tpc3N1ZS5uYW1lPXByb2plY3RzLyovaXNzdWVzLyp9EsABChFMaXN0SXNzdWVDb21tZW50cxIlLmJ5dGViYXNlLnYxLkxpc3RJc3N1ZUNvbW1lbnRzUmVxdWVzdBomLmJ5dGViYXNlLnYxLkxpc3RJc3N1ZUNvbW1lbnRzUmVzcG9uc2UiXNpBBnBhcmVudIrqMBViYi5pc3N1ZUNvbW1lbnRzLmxpc3SQ6jABgtPkkwIwEi4vdjEve3BhcmVudD1wcm9qZWN0cy8qL2lzc3Vlcy8qfS9pc3N1ZUNvbW1lbnRzEtIBChJDcmVhdGVJc3N1ZUNvbW1lbnQSJi5ieXRlYmFzZS52MS5DcmVhdGVJc3N1ZUNvbW1lbnRSZXF1ZXN0GhkuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50InnaQRRwYXJlbnQsaXNzdWVfY29tbWVudIrqMBdiYi5pc3N1ZUNvbW1lbnRzLmNyZWF0ZZDqMAGY6jABgtPkkwI5Og1pc3N1ZV9jb21tZW50IigvdjEve3BhcmVudD1wcm9qZWN0cy8qL2lzc3Vlcy8qfTpjb21tZW50Et8BChJVcGRhdGVJc3N1ZUNvbW1lbnQSJi5ieXRlYmFzZS52MS5VcGRhdGVJc3N1ZUNvbW1lbnRSZXF1ZXN0GhkuYnl0ZWJhc2UudjEuSXNzdWVDb21tZW50IoUB2kEgcGFyZW50LGlzc3VlX2NvbW1lbnQsdXBkYXRlX21hc2uK6jAXYmIuaXNzdWVDb21tZW50cy51cGRhdGWQ6jABmOowAYLT5JMCOToNaXNzdWVfY29tbWVudDIoL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9pc3N1ZXMvKn06Y29tbWVudBLNAQoXQmF0Y2hVcGRhdGVJc3N1ZXNTdGF0dXMSKy5ieXRlYmFzZS52MS5CYXRjaFVwZGF0ZUlzc3Vlc1N0YXR1c1JlcXVlc3QaLC5ieXRlYmFzZS52MS5CYXRjaFVwZGF0ZUlzc3Vlc1N0YXR1c1Jlc3BvbnNlIleK6jAQYmIuaXNzdWVzLnVwZGF0ZZDqMAGY6jABgtPkkwI1OgEqIjAvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9pc3N1ZXM6YmF0Y2hVcGRhdGVTdGF0dXMSfwoMQXBwcm92ZUlzc3VlEiAuYnl0ZWJhc2UudjEuQXBwcm92ZUlzc3VlUmVxdWVzdBoSLmJ5dGViYXNlLnYxLklzc3VlIjmQ6jACmOowAYLT5JMCKzoBKiImL3YxL3tuYW1lPXByb2plY3RzLyovaXNzdWVzLyp9OmFwcHJvdmUSfAoLUmVqZWN0SXNzdWUSHy5ieXRlYmFzZS52MS5SZWplY3RJc3N1ZVJlcXVlc3QaEi5ieXRlYmFzZS52MS5Jc3N1ZSI4kOowApjqMAGC0+STAio6ASoiJS92MS97bmFtZT1wcm9qZWN0cy8qL2lzc3Vlcy8qfTpyZWplY3QSfwoMUmVxdWVzdElzc3VlEiAuYnl0ZWJhc2UudjEuUmVxdWVzdElzc3VlUmVxdWVzdBoSLmJ5dGViYXNlLnYxLklzc3VlIjmQ6jACmOowAYLT5JMCKzoBKiImL3YxL3tuYW1lPXByb2plY3RzLyovaXNzdWVzLyp9OnJlcXVlc3QSnwEKCkJyZWFrR2xhc3MSHi5ieXRlYmFzZS52MS5CcmVha0dsYXNzUmVxdWVzdBoSLmJ5dGViYXNlLnYxLklzc3VlIl3aQQZwYXJlbnSK6jAUYmIuaXNzdWVzLmJyZWFrR2xhc3OQ6jABmOowAYLT5JMCLjoBKiIpL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vaXNzdWVzOmJyZWFrR2xhc3NCpwEKD2NvbS5ieXRlYmFzZS52MUIRSXNzdWVTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_google_type_expr, file_v1_annotation, file_v1_common]);
 
 /**
  * Describes the message bytebase.v1.GetIssueRequest.
@@ -33,95 +33,102 @@ export const GetIssueRequestSchema = /*@__PURE__*/
 export const CreateIssueRequestSchema = /*@__PURE__*/
   messageDesc(file_v1_issue_service, 1);
 
+/**
+ * Describes the message bytebase.v1.BreakGlassRequest.
+ * Use `create(BreakGlassRequestSchema)` to create a new message.
+ */
+export const BreakGlassRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_issue_service, 2);
+
 /**
  * Describes the message bytebase.v1.ListIssuesRequest.
  * Use `create(ListIssuesRequestSchema)` to create a new message.
  */
 export const ListIssuesRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 2);
+  messageDesc(file_v1_issue_service, 3);
 
 /**
  * Describes the message bytebase.v1.ListIssuesResponse.
  * Use `create(ListIssuesResponseSchema)` to create a new message.
  */
 export const ListIssuesResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 3);
+  messageDesc(file_v1_issue_service, 4);
 
 /**
  * Describes the message bytebase.v1.SearchIssuesRequest.
  * Use `create(SearchIssuesRequestSchema)` to create a new message.
  */
 export const SearchIssuesRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 4);
+  messageDesc(file_v1_issue_service, 5);
 
 /**
  * Describes the message bytebase.v1.SearchIssuesResponse.
  * Use `create(SearchIssuesResponseSchema)` to create a new message.
  */
 export const SearchIssuesResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 5);
+  messageDesc(file_v1_issue_service, 6);
 
 /**
  * Describes the message bytebase.v1.UpdateIssueRequest.
  * Use `create(UpdateIssueRequestSchema)` to create a new message.
  */
 export const UpdateIssueRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 6);
+  messageDesc(file_v1_issue_service, 7);
 
 /**
  * Describes the message bytebase.v1.BatchUpdateIssuesStatusRequest.
  * Use `create(BatchUpdateIssuesStatusRequestSchema)` to create a new message.
  */
 export const BatchUpdateIssuesStatusRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 7);
+  messageDesc(file_v1_issue_service, 8);
 
 /**
  * Describes the message bytebase.v1.BatchUpdateIssuesStatusResponse.
  * Use `create(BatchUpdateIssuesStatusResponseSchema)` to create a new message.
  */
 export const BatchUpdateIssuesStatusResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 8);
+  messageDesc(file_v1_issue_service, 9);
 
 /**
  * Describes the message bytebase.v1.ApproveIssueRequest.
  * Use `create(ApproveIssueRequestSchema)` to create a new message.
  */
 export const ApproveIssueRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 9);
+  messageDesc(file_v1_issue_service, 10);
 
 /**
  * Describes the message bytebase.v1.RejectIssueRequest.
  * Use `create(RejectIssueRequestSchema)` to create a new message.
  */
 export const RejectIssueRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 10);
+  messageDesc(file_v1_issue_service, 11);
 
 /**
  * Describes the message bytebase.v1.RequestIssueRequest.
  * Use `create(RequestIssueRequestSchema)` to create a new message.
  */
 export const RequestIssueRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 11);
+  messageDesc(file_v1_issue_service, 12);
 
 /**
  * Describes the message bytebase.v1.Issue.
  * Use `create(IssueSchema)` to create a new message.
  */
 export const IssueSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 12);
+  messageDesc(file_v1_issue_service, 13);
 
 /**
  * Describes the message bytebase.v1.Issue.Approver.
  * Use `create(Issue_ApproverSchema)` to create a new message.
  */
 export const Issue_ApproverSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 12, 0);
+  messageDesc(file_v1_issue_service, 13, 0);
 
 /**
  * Describes the enum bytebase.v1.Issue.Approver.Status.
  */
 export const Issue_Approver_StatusSchema = /*@__PURE__*/
-  enumDesc(file_v1_issue_service, 12, 0, 0);
+  enumDesc(file_v1_issue_service, 13, 0, 0);
 
 /**
  * The approval status of an approver.
@@ -135,7 +142,7 @@ export const Issue_Approver_Status = /*@__PURE__*/
  * Describes the enum bytebase.v1.Issue.Type.
  */
 export const Issue_TypeSchema = /*@__PURE__*/
-  enumDesc(file_v1_issue_service, 12, 0);
+  enumDesc(file_v1_issue_service, 13, 0);
 
 /**
  * The type of issue.
@@ -149,7 +156,7 @@ export const Issue_Type = /*@__PURE__*/
  * Describes the enum bytebase.v1.Issue.ApprovalStatus.
  */
 export const Issue_ApprovalStatusSchema = /*@__PURE__*/
-  enumDesc(file_v1_issue_service, 12, 1);
+  enumDesc(file_v1_issue_service, 13, 1);
 
 /**
  * The overall approval status for the issue.
@@ -164,69 +171,69 @@ export const Issue_ApprovalStatus = /*@__PURE__*/
  * Use `create(GrantRequestSchema)` to create a new message.
  */
 export const GrantRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 13);
+  messageDesc(file_v1_issue_service, 14);
 
 /**
  * Describes the message bytebase.v1.ApprovalTemplate.
  * Use `create(ApprovalTemplateSchema)` to create a new message.
  */
 export const ApprovalTemplateSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 14);
+  messageDesc(file_v1_issue_service, 15);
 
 /**
  * Describes the message bytebase.v1.ApprovalFlow.
  * Use `create(ApprovalFlowSchema)` to create a new message.
  */
 export const ApprovalFlowSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 15);
+  messageDesc(file_v1_issue_service, 16);
 
 /**
  * Describes the message bytebase.v1.ListIssueCommentsRequest.
  * Use `create(ListIssueCommentsRequestSchema)` to create a new message.
  */
 export const ListIssueCommentsRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 16);
+  messageDesc(file_v1_issue_service, 17);
 
 /**
  * Describes the message bytebase.v1.ListIssueCommentsResponse.
  * Use `create(ListIssueCommentsResponseSchema)` to create a new message.
  */
 export const ListIssueCommentsResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 17);
+  messageDesc(file_v1_issue_service, 18);
 
 /**
  * Describes the message bytebase.v1.CreateIssueCommentRequest.
  * Use `create(CreateIssueCommentRequestSchema)` to create a new message.
  */
 export const CreateIssueCommentRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 18);
+  messageDesc(file_v1_issue_service, 19);
 
 /**
  * Describes the message bytebase.v1.UpdateIssueCommentRequest.
  * Use `create(UpdateIssueCommentRequestSchema)` to create a new message.
  */
 export const UpdateIssueCommentRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 19);
+  messageDesc(file_v1_issue_service, 20);
 
 /**
  * Describes the message bytebase.v1.IssueComment.
  * Use `create(IssueCommentSchema)` to create a new message.
  */
 export const IssueCommentSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20);
+  messageDesc(file_v1_issue_service, 21);
 
 /**
  * Describes the message bytebase.v1.IssueComment.Approval.
  * Use `create(IssueComment_ApprovalSchema)` to create a new message.
  */
 export const IssueComment_ApprovalSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20, 0);
+  messageDesc(file_v1_issue_service, 21, 0);
 
 /**
  * Describes the enum bytebase.v1.IssueComment.Approval.Status.
  */
 export const IssueComment_Approval_StatusSchema = /*@__PURE__*/
-  enumDesc(file_v1_issue_service, 20, 0, 0);
+  enumDesc(file_v1_issue_service, 21, 0, 0);
 
 /**
  * Approval status values.
@@ -241,27 +248,27 @@ export const IssueComment_Approval_Status = /*@__PURE__*/
  * Use `create(IssueComment_IssueUpdateSchema)` to create a new message.
  */
 export const IssueComment_IssueUpdateSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20, 1);
+  messageDesc(file_v1_issue_service, 21, 1);
 
 /**
  * Describes the message bytebase.v1.IssueComment.StageEnd.
  * Use `create(IssueComment_StageEndSchema)` to create a new message.
  */
 export const IssueComment_StageEndSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20, 2);
+  messageDesc(file_v1_issue_service, 21, 2);
 
 /**
  * Describes the message bytebase.v1.IssueComment.TaskUpdate.
  * Use `create(IssueComment_TaskUpdateSchema)` to create a new message.
  */
 export const IssueComment_TaskUpdateSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20, 3);
+  messageDesc(file_v1_issue_service, 21, 3);
 
 /**
  * Describes the enum bytebase.v1.IssueComment.TaskUpdate.Status.
  */
 export const IssueComment_TaskUpdate_StatusSchema = /*@__PURE__*/
-  enumDesc(file_v1_issue_service, 20, 3, 0);
+  enumDesc(file_v1_issue_service, 21, 3, 0);
 
 /**
  * Task status values.
@@ -276,14 +283,14 @@ export const IssueComment_TaskUpdate_Status = /*@__PURE__*/
  * Use `create(IssueComment_TaskPriorBackupSchema)` to create a new message.
  */
 export const IssueComment_TaskPriorBackupSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20, 4);
+  messageDesc(file_v1_issue_service, 21, 4);
 
 /**
  * Describes the message bytebase.v1.IssueComment.TaskPriorBackup.Table.
  * Use `create(IssueComment_TaskPriorBackup_TableSchema)` to create a new message.
  */
 export const IssueComment_TaskPriorBackup_TableSchema = /*@__PURE__*/
-  messageDesc(file_v1_issue_service, 20, 4, 0);
+  messageDesc(file_v1_issue_service, 21, 4, 0);
 
 /**
  * Describes the enum bytebase.v1.IssueStatus.
@@ -253,6 +253,12 @@ export declare type Policy = Message<"bytebase.v1.Policy"> & {
      */
     value: QueryDataPolicy;
     case: "queryDataPolicy";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.RowAccessPolicy row_access_policy = 25;
+     */
+    value: RowAccessPolicy;
+    case: "rowAccessPolicy";
   } | { case: undefined; value?: undefined };
 
   /**
@@ -303,6 +309,14 @@ export declare type RolloutPolicy = Message<"bytebase.v1.RolloutPolicy"> & {
    * @generated from field: bytebase.v1.RolloutPolicy.Checkers checkers = 4;
    */
   checkers?: RolloutPolicy_Checkers;
+
+  /**
+   * The window in which tasks of the environment can be rolled out.
+   * Pending tasks wait until the window opens. No restriction if unset.
+   *
+   * @generated from field: bytebase.v1.RolloutPolicy.Window window = 5;
+   */
+  window?: RolloutPolicy_Window;
 };
 
 /**
@@ -311,6 +325,74 @@ export declare type RolloutPolicy = Message<"bytebase.v1.RolloutPolicy"> & {
  */
 export declare const RolloutPolicySchema: GenMessage<RolloutPolicy>;
 
+/**
+ * @generated from message bytebase.v1.RolloutPolicy.Window
+ */
+export declare type RolloutPolicy_Window = Message<"bytebase.v1.RolloutPolicy.Window"> & {
+  /**
+   * The IANA time zone of the time ranges and blackout dates, e.g. "America/New_York".
+   * UTC is used if empty.
+   *
+   * @generated from field: string time_zone = 1;
+   */
+  timeZone: string;
+
+  /**
+   * The recurring time ranges in which rollouts are allowed.
+   * Rollouts are allowed at any time of a day if empty.
+   *
+   * @generated from field: repeated bytebase.v1.RolloutPolicy.Window.TimeRange time_ranges = 2;
+   */
+  timeRanges: RolloutPolicy_Window_TimeRange[];
+
+  /**
+   * The dates on which rollouts are not allowed, in the format of "YYYY-MM-DD".
+   *
+   * @generated from field: repeated string blackout_dates = 3;
+   */
+  blackoutDates: string[];
+};
+
+/**
+ * Describes the message bytebase.v1.RolloutPolicy.Window.
+ * Use `create(RolloutPolicy_WindowSchema)` to create a new message.
+ */
+export declare const RolloutPolicy_WindowSchema: GenMessage<RolloutPolicy_Window>;
+
+/**
+ * @generated from message bytebase.v1.RolloutPolicy.Window.TimeRange
+ */
+export declare type RolloutPolicy_Window_TimeRange = Message<"bytebase.v1.RolloutPolicy.Window.TimeRange"> & {
+  /**
+   * The days of the week, from 0 (Sunday) to 6 (Saturday).
+   * Every day if empty.
+   *
+   * @generated from field: repeated int32 days_of_week = 1;
+   */
+  daysOfWeek: number[];
+
+  /**
+   * The start time of the range in the format of "HH:MM", inclusive.
+   *
+   * @generated from field: string start_time = 2;
+   */
+  startTime: string;
+
+  /**
+   * The end time of the range in the format of "HH:MM", exclusive.
+   * The range crosses midnight if the end time is not after the start time.
+   *
+   * @generated from field: string end_time = 3;
+   */
+  endTime: string;
+};
+
+/**
+ * Describes the message bytebase.v1.RolloutPolicy.Window.TimeRange.
+ * Use `create(RolloutPolicy_Window_TimeRangeSchema)` to create a new message.
+ */
+export declare const RolloutPolicy_Window_TimeRangeSchema: GenMessage<RolloutPolicy_Window_TimeRange>;
+
 /**
  * @generated from message bytebase.v1.RolloutPolicy.Checkers
  */
@@ -664,6 +746,90 @@ export declare type MaskingRulePolicy_MaskingRule = Message<"bytebase.v1.Masking
  */
 export declare const MaskingRulePolicy_MaskingRuleSchema: GenMessage<MaskingRulePolicy_MaskingRule>;
 
+/**
+ * Policy for filtering the rows of tables returned by the queries in the SQL editor.
+ *
+ * @generated from message bytebase.v1.RowAccessPolicy
+ */
+export declare type RowAccessPolicy = Message<"bytebase.v1.RowAccessPolicy"> & {
+  /**
+   * The list of rules.
+   * The predicates of all rules that apply to the user on the same table are combined with AND.
+   * Queries reading the filtered tables are only supported for MySQL and PostgreSQL, and are rejected
+   * if they cannot be rewritten safely, e.g. reading the tables through views.
+   *
+   * @generated from field: repeated bytebase.v1.RowAccessPolicy.Rule rules = 1;
+   */
+  rules: RowAccessPolicy_Rule[];
+};
+
+/**
+ * Describes the message bytebase.v1.RowAccessPolicy.
+ * Use `create(RowAccessPolicySchema)` to create a new message.
+ */
+export declare const RowAccessPolicySchema: GenMessage<RowAccessPolicy>;
+
+/**
+ * A rule that filters the rows of a table for the users matching the condition.
+ *
+ * @generated from message bytebase.v1.RowAccessPolicy.Rule
+ */
+export declare type RowAccessPolicy_Rule = Message<"bytebase.v1.RowAccessPolicy.Rule"> & {
+  /**
+   * The database of the table.
+   * Format: instances/{instance}/databases/{database}
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * The schema of the table. Empty for the engines without schemas, e.g. MySQL.
+   *
+   * @generated from field: string schema = 2;
+   */
+  schema: string;
+
+  /**
+   * The table name.
+   *
+   * @generated from field: string table = 3;
+   */
+  table: string;
+
+  /**
+   * The condition deciding whether the rule applies to the user.
+   * The syntax and semantics of CEL are documented at https://github.com/google/cel-spec
+   * The rule applies to all users if the condition is empty.
+   *
+   * Support variables:
+   * user.email: the email of the user.
+   * user.groups: the emails of the groups that the user belongs to.
+   * request.time: the time of the query.
+   *
+   * For example:
+   * user.email == "alice@example.com"
+   * "sales@example.com" in user.groups && request.time < timestamp("2025-04-30T11:10:39.000Z")
+   *
+   * @generated from field: google.type.Expr condition = 4;
+   */
+  condition?: Expr;
+
+  /**
+   * The SQL boolean expression on the columns of the table that the returned rows must satisfy.
+   * For example, `region = 'EMEA'`.
+   *
+   * @generated from field: string predicate = 5;
+   */
+  predicate: string;
+};
+
+/**
+ * Describes the message bytebase.v1.RowAccessPolicy.Rule.
+ * Use `create(RowAccessPolicy_RuleSchema)` to create a new message.
+ */
+export declare const RowAccessPolicy_RuleSchema: GenMessage<RowAccessPolicy_Rule>;
+
 /**
  * Policy for tagging resources with metadata.
  *
@@ -806,6 +972,13 @@ export enum PolicyType {
    * @generated from enum value: DATA_QUERY = 16;
    */
   DATA_QUERY = 16,
+
+  /**
+   * Row-level security policy.
+   *
+   * @generated from enum value: ROW_ACCESS = 17;
+   */
+  ROW_ACCESS = 17,
 }
 
 /**
@@ -16,7 +16,7 @@ import { file_v1_common } from "./common_pb";
  * Describes the file v1/org_policy_service.proto.
  */
 export const file_v1_org_policy_service = /*@__PURE__*/
-  fileDesc("Cht2MS9vcmdfcG9saWN5X3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIpMBChNDcmVhdGVQb2xpY3lSZXF1ZXN0EisKBnBhcmVudBgBIAEoCUIb4EEC+kEVEhNieXRlYmFzZS5jb20vUG9saWN5EigKBnBvbGljeRgCIAEoCzITLmJ5dGViYXNlLnYxLlBvbGljeUID4EECEiUKBHR5cGUYAyABKA4yFy5ieXRlYmFzZS52MS5Qb2xpY3lUeXBlIocBChNVcGRhdGVQb2xpY3lSZXF1ZXN0EigKBnBvbGljeRgBIAEoCzITLmJ5dGViYXNlLnYxLlBvbGljeUID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1hbGxvd19taXNzaW5nGAMgASgIIkAKE0RlbGV0ZVBvbGljeVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIb4EEC+kEVChNieXRlYmFzZS5jb20vUG9saWN5Ij0KEEdldFBvbGljeVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIb4EEC+kEVChNieXRlYmFzZS5jb20vUG9saWN5IpsBChNMaXN0UG9saWNpZXNSZXF1ZXN0EisKBnBhcmVudBgBIAEoCUIb4EEC+kEVEhNieXRlYmFzZS5jb20vUG9saWN5EjEKC3BvbGljeV90eXBlGAIgASgOMhcuYnl0ZWJhc2UudjEuUG9saWN5VHlwZUgAiAEBEhQKDHNob3dfZGVsZXRlZBgDIAEoCEIOCgxfcG9saWN5X3R5cGUiPQoUTGlzdFBvbGljaWVzUmVzcG9uc2USJQoIcG9saWNpZXMYASADKAsyEy5ieXRlYmFzZS52MS5Qb2xpY3kilQYKBlBvbGljeRIMCgRuYW1lGAEgASgJEhsKE2luaGVyaXRfZnJvbV9wYXJlbnQYBCABKAgSJQoEdHlwZRgFIAEoDjIXLmJ5dGViYXNlLnYxLlBvbGljeVR5cGUSNAoOcm9sbG91dF9wb2xpY3kYEyABKAsyGi5ieXRlYmFzZS52MS5Sb2xsb3V0UG9saWN5SAASPQoTbWFza2luZ19ydWxlX3BvbGljeRgRIAEoCzIeLmJ5dGViYXNlLnYxLk1hc2tpbmdSdWxlUG9saWN5SAASRwoYbWFza2luZ19leGNlcHRpb25fcG9saWN5GBIgASgLMiMuYnl0ZWJhc2UudjEuTWFza2luZ0V4Y2VwdGlvblBvbGljeUgAEiwKCnRhZ19wb2xpY3kYFSABKAsyFi5ieXRlYmFzZS52MS5UYWdQb2xpY3lIABJGChhkYXRhX3NvdXJjZV9xdWVyeV9wb2xpY3kYFiABKAsyIi5ieXRlYmFzZS52MS5EYXRhU291cmNlUXVlcnlQb2xpY3lIABI5ChFxdWVyeV9kYXRhX3BvbGljeRgYIAEoCzIcLmJ5dGViYXNlLnYxLlF1ZXJ5RGF0YVBvbGljeUgAEg8KB2VuZm9yY2UYDSABKAgSOwoNcmVzb3VyY2VfdHlwZRgOIAEoDjIfLmJ5dGViYXNlLnYxLlBvbGljeVJlc291cmNlVHlwZUID4EEDOuUB6kHhAQoTYnl0ZWJhc2UuY29tL1BvbGljeRIRcG9saWNpZXMve3BvbGljeX0SJHByb2plY3RzL3twcm9qZWN0fS9wb2xpY2llcy97cG9saWN5fRIsZW52aXJvbm1lbnRzL3tlbnZpcm9ubWVudH0vcG9saWNpZXMve3BvbGljeX0SJmluc3RhbmNlcy97aW5zdGFuY2V9L3BvbGljaWVzL3twb2xpY3l9EjtpbnN0YW5jZXMve2luc3RhbmNlfS9kYXRhYmFzZXMve2RhdGFiYXNlfS9wb2xpY2llcy97cG9saWN5fUIICgZwb2xpY3lKBAgCEANKBAgXEBgivgMKDVJvbGxvdXRQb2xpY3kSEQoJYXV0b21hdGljGAEgASgIEg0KBXJvbGVzGAIgAygJEjUKCGNoZWNrZXJzGAQgASgLMiMuYnl0ZWJhc2UudjEuUm9sbG91dFBvbGljeS5DaGVja2VycxrTAgoIQ2hlY2tlcnMSHwoXcmVxdWlyZWRfaXNzdWVfYXBwcm92YWwYASABKAgSWAoWcmVxdWlyZWRfc3RhdHVzX2NoZWNrcxgCIAEoCzI4LmJ5dGViYXNlLnYxLlJvbGxvdXRQb2xpY3kuQ2hlY2tlcnMuUmVxdWlyZWRTdGF0dXNDaGVja3MacAoUUmVxdWlyZWRTdGF0dXNDaGVja3MSWAoWcGxhbl9jaGVja19lbmZvcmNlbWVudBgBIAEoDjI4LmJ5dGViYXNlLnYxLlJvbGxvdXRQb2xpY3kuQ2hlY2tlcnMuUGxhbkNoZWNrRW5mb3JjZW1lbnQiWgoUUGxhbkNoZWNrRW5mb3JjZW1lbnQSJgoiUExBTl9DSEVDS19FTkZPUkNFTUVOVF9VTlNQRUNJRklFRBAAEg4KCkVSUk9SX09OTFkQARIKCgZTVFJJQ1QQAiKqAQoPUXVlcnlEYXRhUG9saWN5EioKB3RpbWVvdXQYASABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SFgoOZGlzYWJsZV9leHBvcnQYAiABKAgSGwoTbWF4aW11bV9yZXN1bHRfc2l6ZRgDIAEoAxIbChNtYXhpbXVtX3Jlc3VsdF9yb3dzGAQgASgFEhkKEWRpc2FibGVfY29weV9kYXRhGAUgASgIIpQBCg1TUUxSZXZpZXdSdWxlEgwKBHR5cGUYASABKAkSLgoFbGV2ZWwYAiABKA4yHy5ieXRlYmFzZS52MS5TUUxSZXZpZXdSdWxlTGV2ZWwSDwoHcGF5bG9hZBgDIAEoCRIjCgZlbmdpbmUYBCABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSDwoHY29tbWVudBgFIAEoCSK7AgoWTWFza2luZ0V4Y2VwdGlvblBvbGljeRJQChJtYXNraW5nX2V4Y2VwdGlvbnMYASADKAsyNC5ieXRlYmFzZS52MS5NYXNraW5nRXhjZXB0aW9uUG9saWN5Lk1hc2tpbmdFeGNlcHRpb24azgEKEE1hc2tpbmdFeGNlcHRpb24SSwoGYWN0aW9uGAEgASgOMjsuYnl0ZWJhc2UudjEuTWFza2luZ0V4Y2VwdGlvblBvbGljeS5NYXNraW5nRXhjZXB0aW9uLkFjdGlvbhIOCgZtZW1iZXIYAyABKAkSJAoJY29uZGl0aW9uGAQgASgLMhEuZ29vZ2xlLnR5cGUuRXhwciI3CgZBY3Rpb24SFgoSQUNUSU9OX1VOU1BFQ0lGSUVEEAASCQoFUVVFUlkQARIKCgZFWFBPUlQQAiKmAQoRTWFza2luZ1J1bGVQb2xpY3kSOQoFcnVsZXMYASADKAsyKi5ieXRlYmFzZS52MS5NYXNraW5nUnVsZVBvbGljeS5NYXNraW5nUnVsZRpWCgtNYXNraW5nUnVsZRIKCgJpZBgBIAEoCRIkCgljb25kaXRpb24YAiABKAsyES5nb29nbGUudHlwZS5FeHByEhUKDXNlbWFudGljX3R5cGUYAyABKAkiaAoJVGFnUG9saWN5Ei4KBHRhZ3MYASADKAsyIC5ieXRlYmFzZS52MS5UYWdQb2xpY3kuVGFnc0VudHJ5GisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIuIBChVEYXRhU291cmNlUXVlcnlQb2xpY3kSVQodYWRtaW5fZGF0YV9zb3VyY2VfcmVzdHJpY3Rpb24YASABKA4yLi5ieXRlYmFzZS52MS5EYXRhU291cmNlUXVlcnlQb2xpY3kuUmVzdHJpY3Rpb24SFAoMZGlzYWxsb3dfZGRsGAIgASgIEhQKDGRpc2FsbG93X2RtbBgDIAEoCCJGCgtSZXN0cmljdGlvbhIbChdSRVNUUklDVElPTl9VTlNQRUNJRklFRBAAEgwKCEZBTExCQUNLEAESDAoIRElTQUxMT1cQAirAAQoKUG9saWN5VHlwZRIbChdQT0xJQ1lfVFlQRV9VTlNQRUNJRklFRBAAEhIKDlJPTExPVVRfUE9MSUNZEAsSEAoMTUFTS0lOR19SVUxFEAkSFQoRTUFTS0lOR19FWENFUFRJT04QChIHCgNUQUcQDRIVChFEQVRBX1NPVVJDRV9RVUVSWRAOEg4KCkRBVEFfUVVFUlkQECIECAIQAiIECAQQBCIECAYQBiIECAUQBSIECAcQByIECAwQDCIECA8QDypgChJQb2xpY3lSZXNvdXJjZVR5cGUSHQoZUkVTT1VSQ0VfVFlQRV9VTlNQRUNJRklFRBAAEg0KCVdPUktTUEFDRRABEg8KC0VOVklST05NRU5UEAISCwoHUFJPSkVDVBADKkMKElNRTFJldmlld1J1bGVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEgkKBUVSUk9SEAESCwoHV0FSTklORxACMvQMChBPcmdQb2xpY3lTZXJ2aWNlEqACCglHZXRQb2xpY3kSHS5ieXRlYmFzZS52MS5HZXRQb2xpY3lSZXF1ZXN0GhMuYnl0ZWJhc2UudjEuUG9saWN5It4B2kEEbmFtZYrqMA9iYi5wb2xpY2llcy5nZXSQ6jABgtPkkwK5AVoiEiAvdjEve25hbWU9cHJvamVjdHMvKi9wb2xpY2llcy8qfVomEiQvdjEve25hbWU9ZW52aXJvbm1lbnRzLyovcG9saWNpZXMvKn1aIxIhL3YxL3tuYW1lPWluc3RhbmNlcy8qL3BvbGljaWVzLyp9Wi8SLS92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9wb2xpY2llcy8qfRIVL3YxL3tuYW1lPXBvbGljaWVzLyp9EqgCCgxMaXN0UG9saWNpZXMSIC5ieXRlYmFzZS52MS5MaXN0UG9saWNpZXNSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuTGlzdFBvbGljaWVzUmVzcG9uc2Ui0gHaQQCK6jAQYmIucG9saWNpZXMubGlzdJDqMAGC0+STArABWiISIC92MS97cGFyZW50PXByb2plY3RzLyp9L3BvbGljaWVzWiYSJC92MS97cGFyZW50PWVudmlyb25tZW50cy8qfS9wb2xpY2llc1ojEiEvdjEve3BhcmVudD1pbnN0YW5jZXMvKn0vcG9saWNpZXNaLxItL3YxL3twYXJlbnQ9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9L3BvbGljaWVzEgwvdjEvcG9saWNpZXMS1QIKDENyZWF0ZVBvbGljeRIgLmJ5dGViYXNlLnYxLkNyZWF0ZVBvbGljeVJlcXVlc3QaEy5ieXRlYmFzZS52MS5Qb2xpY3kijQLaQQ1wYXJlbnQscG9saWN5iuowEmJiLnBvbGljaWVzLmNyZWF0ZZDqMAGY6jABgtPkkwLYAToGcG9saWN5Wio6BnBvbGljeSIgL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcG9saWNpZXNaLjoGcG9saWN5IiQvdjEve3BhcmVudD1lbnZpcm9ubWVudHMvKn0vcG9saWNpZXNaKzoGcG9saWN5IiEvdjEve3BhcmVudD1pbnN0YW5jZXMvKn0vcG9saWNpZXNaNzoGcG9saWN5Ii0vdjEve3BhcmVudD1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKn0vcG9saWNpZXMiDC92MS9wb2xpY2llcxKGAwoMVXBkYXRlUG9saWN5EiAuYnl0ZWJhc2UudjEuVXBkYXRlUG9saWN5UmVxdWVzdBoTLmJ5dGViYXNlLnYxLlBvbGljeSK+AtpBEnBvbGljeSx1cGRhdGVfbWFza4rqMBJiYi5wb2xpY2llcy51cGRhdGWQ6jABmOowAYLT5JMChAI6BnBvbGljeVoxOgZwb2xpY3kyJy92MS97cG9saWN5Lm5hbWU9cHJvamVjdHMvKi9wb2xpY2llcy8qfVo1OgZwb2xpY3kyKy92MS97cG9saWN5Lm5hbWU9ZW52aXJvbm1lbnRzLyovcG9saWNpZXMvKn1aMjoGcG9saWN5MigvdjEve3BvbGljeS5uYW1lPWluc3RhbmNlcy8qL3BvbGljaWVzLyp9Wj46BnBvbGljeTI0L3YxL3twb2xpY3kubmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKi9wb2xpY2llcy8qfTIcL3YxL3twb2xpY3kubmFtZT1wb2xpY2llcy8qfRKwAgoMRGVsZXRlUG9saWN5EiAuYnl0ZWJhc2UudjEuRGVsZXRlUG9saWN5UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSLlAdpBBG5hbWWK6jASYmIucG9saWNpZXMuZGVsZXRlkOowAZjqMAGC0+STArkBWiIqIC92MS97bmFtZT1wcm9qZWN0cy8qL3BvbGljaWVzLyp9WiYqJC92MS97bmFtZT1lbnZpcm9ubWVudHMvKi9wb2xpY2llcy8qfVojKiEvdjEve25hbWU9aW5zdGFuY2VzLyovcG9saWNpZXMvKn1aLyotL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3BvbGljaWVzLyp9KhUvdjEve25hbWU9cG9saWNpZXMvKn1CqwEKD2NvbS5ieXRlYmFzZS52MUIVT3JnUG9saWN5U2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_type_expr, file_v1_annotation, file_v1_common]);
+  fileDesc("Cht2MS9vcmdfcG9saWN5X3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIpMBChNDcmVhdGVQb2xpY3lSZXF1ZXN0EisKBnBhcmVudBgBIAEoCUIb4EEC+kEVEhNieXRlYmFzZS5jb20vUG9saWN5EigKBnBvbGljeRgCIAEoCzITLmJ5dGViYXNlLnYxLlBvbGljeUID4EECEiUKBHR5cGUYAyABKA4yFy5ieXRlYmFzZS52MS5Qb2xpY3lUeXBlIocBChNVcGRhdGVQb2xpY3lSZXF1ZXN0EigKBnBvbGljeRgBIAEoCzITLmJ5dGViYXNlLnYxLlBvbGljeUID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1hbGxvd19taXNzaW5nGAMgASgIIkAKE0RlbGV0ZVBvbGljeVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIb4EEC+kEVChNieXRlYmFzZS5jb20vUG9saWN5Ij0KEEdldFBvbGljeVJlcXVlc3QSKQoEbmFtZRgBIAEoCUIb4EEC+kEVChNieXRlYmFzZS5jb20vUG9saWN5IpsBChNMaXN0UG9saWNpZXNSZXF1ZXN0EisKBnBhcmVudBgBIAEoCUIb4EEC+kEVEhNieXRlYmFzZS5jb20vUG9saWN5EjEKC3BvbGljeV90eXBlGAIgASgOMhcuYnl0ZWJhc2UudjEuUG9saWN5VHlwZUgAiAEBEhQKDHNob3dfZGVsZXRlZBgDIAEoCEIOCgxfcG9saWN5X3R5cGUiPQoUTGlzdFBvbGljaWVzUmVzcG9uc2USJQoIcG9saWNpZXMYASADKAsyEy5ieXRlYmFzZS52MS5Qb2xpY3ki0AYKBlBvbGljeRIMCgRuYW1lGAEgASgJEhsKE2luaGVyaXRfZnJvbV9wYXJlbnQYBCABKAgSJQoEdHlwZRgFIAEoDjIXLmJ5dGViYXNlLnYxLlBvbGljeVR5cGUSNAoOcm9sbG91dF9wb2xpY3kYEyABKAsyGi5ieXRlYmFzZS52MS5Sb2xsb3V0UG9saWN5SAASPQoTbWFza2luZ19ydWxlX3BvbGljeRgRIAEoCzIeLmJ5dGViYXNlLnYxLk1hc2tpbmdSdWxlUG9saWN5SAASRwoYbWFza2luZ19leGNlcHRpb25fcG9saWN5GBIgASgLMiMuYnl0ZWJhc2UudjEuTWFza2luZ0V4Y2VwdGlvblBvbGljeUgAEiwKCnRhZ19wb2xpY3kYFSABKAsyFi5ieXRlYmFzZS52MS5UYWdQb2xpY3lIABJGChhkYXRhX3NvdXJjZV9xdWVyeV9wb2xpY3kYFiABKAsyIi5ieXRlYmFzZS52MS5EYXRhU291cmNlUXVlcnlQb2xpY3lIABI5ChFxdWVyeV9kYXRhX3BvbGljeRgYIAEoCzIcLmJ5dGViYXNlLnYxLlF1ZXJ5RGF0YVBvbGljeUgAEjkKEXJvd19hY2Nlc3NfcG9saWN5GBkgASgLMhwuYnl0ZWJhc2UudjEuUm93QWNjZXNzUG9saWN5SAASDwoHZW5mb3JjZRgNIAEoCBI7Cg1yZXNvdXJjZV90eXBlGA4gASgOMh8uYnl0ZWJhc2UudjEuUG9saWN5UmVzb3VyY2VUeXBlQgPgQQM65QHqQeEBChNieXRlYmFzZS5jb20vUG9saWN5EhFwb2xpY2llcy97cG9saWN5fRIkcHJvamVjdHMve3Byb2plY3R9L3BvbGljaWVzL3twb2xpY3l9EixlbnZpcm9ubWVudHMve2Vudmlyb25tZW50fS9wb2xpY2llcy97cG9saWN5fRImaW5zdGFuY2VzL3tpbnN0YW5jZX0vcG9saWNpZXMve3BvbGljeX0SO2luc3RhbmNlcy97aW5zdGFuY2V9L2RhdGFiYXNlcy97ZGF0YWJhc2V9L3BvbGljaWVzL3twb2xpY3l9QggKBnBvbGljeUoECAIQA0oECBcQGCKyBQoNUm9sbG91dFBvbGljeRIRCglhdXRvbWF0aWMYASABKAgSDQoFcm9sZXMYAiADKAkSNQoIY2hlY2tlcnMYBCABKAsyIy5ieXRlYmFzZS52MS5Sb2xsb3V0UG9saWN5LkNoZWNrZXJzEjEKBndpbmRvdxgFIAEoCzIhLmJ5dGViYXNlLnYxLlJvbGxvdXRQb2xpY3kuV2luZG93Gr4BCgZXaW5kb3cSEQoJdGltZV96b25lGAEgASgJEkAKC3RpbWVfcmFuZ2VzGAIgAygLMisuYnl0ZWJhc2UudjEuUm9sbG91dFBvbGljeS5XaW5kb3cuVGltZVJhbmdlEhYKDmJsYWNrb3V0X2RhdGVzGAMgAygJGkcKCVRpbWVSYW5nZRIUCgxkYXlzX29mX3dlZWsYASADKAUSEgoKc3RhcnRfdGltZRgCIAEoCRIQCghlbmRfdGltZRgDIAEoCRrTAgoIQ2hlY2tlcnMSHwoXcmVxdWlyZWRfaXNzdWVfYXBwcm92YWwYASABKAgSWAoWcmVxdWlyZWRfc3RhdHVzX2NoZWNrcxgCIAEoCzI4LmJ5dGViYXNlLnYxLlJvbGxvdXRQb2xpY3kuQ2hlY2tlcnMuUmVxdWlyZWRTdGF0dXNDaGVja3MacAoUUmVxdWlyZWRTdGF0dXNDaGVja3MSWAoWcGxhbl9jaGVja19lbmZvcmNlbWVudBgBIAEoDjI4LmJ5dGViYXNlLnYxLlJvbGxvdXRQb2xpY3kuQ2hlY2tlcnMuUGxhbkNoZWNrRW5mb3JjZW1lbnQiWgoUUGxhbkNoZWNrRW5mb3JjZW1lbnQSJgoiUExBTl9DSEVDS19FTkZPUkNFTUVOVF9VTlNQRUNJRklFRBAAEg4KCkVSUk9SX09OTFkQARIKCgZTVFJJQ1QQAiKqAQoPUXVlcnlEYXRhUG9saWN5EioKB3RpbWVvdXQYASABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SFgoOZGlzYWJsZV9leHBvcnQYAiABKAgSGwoTbWF4aW11bV9yZXN1bHRfc2l6ZRgDIAEoAxIbChNtYXhpbXVtX3Jlc3VsdF9yb3dzGAQgASgFEhkKEWRpc2FibGVfY29weV9kYXRhGAUgASgIIpQBCg1TUUxSZXZpZXdSdWxlEgwKBHR5cGUYASABKAkSLgoFbGV2ZWwYAiABKA4yHy5ieXRlYmFzZS52MS5TUUxSZXZpZXdSdWxlTGV2ZWwSDwoHcGF5bG9hZBgDIAEoCRIjCgZlbmdpbmUYBCABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSDwoHY29tbWVudBgFIAEoCSK7AgoWTWFza2luZ0V4Y2VwdGlvblBvbGljeRJQChJtYXNraW5nX2V4Y2VwdGlvbnMYASADKAsyNC5ieXRlYmFzZS52MS5NYXNraW5nRXhjZXB0aW9uUG9saWN5Lk1hc2tpbmdFeGNlcHRpb24azgEKEE1hc2tpbmdFeGNlcHRpb24SSwoGYWN0aW9uGAEgASgOMjsuYnl0ZWJhc2UudjEuTWFza2luZ0V4Y2VwdGlvblBvbGljeS5NYXNraW5nRXhjZXB0aW9uLkFjdGlvbhIOCgZtZW1iZXIYAyABKAkSJAoJY29uZGl0aW9uGAQgASgLMhEuZ29vZ2xlLnR5cGUuRXhwciI3CgZBY3Rpb24SFgoSQUNUSU9OX1VOU1BFQ0lGSUVEEAASCQoFUVVFUlkQARIKCgZFWFBPUlQQAiKmAQoRTWFza2luZ1J1bGVQb2xpY3kSOQoFcnVsZXMYASADKAsyKi5ieXRlYmFzZS52MS5NYXNraW5nUnVsZVBvbGljeS5NYXNraW5nUnVsZRpWCgtNYXNraW5nUnVsZRIKCgJpZBgBIAEoCRIkCgljb25kaXRpb24YAiABKAsyES5nb29nbGUudHlwZS5FeHByEhUKDXNlbWFudGljX3R5cGUYAyABKAkitQEKD1Jvd0FjY2Vzc1BvbGljeRIwCgVydWxlcxgBIAMoCzIhLmJ5dGViYXNlLnYxLlJvd0FjY2Vzc1BvbGljeS5SdWxlGnAKBFJ1bGUSEAoIZGF0YWJhc2UYASABKAkSDgoGc2NoZW1hGAIgASgJEg0KBXRhYmxlGAMgASgJEiQKCWNvbmRpdGlvbhgEIAEoCzIRLmdvb2dsZS50eXBlLkV4cHISEQoJcHJlZGljYXRlGAUgASgJImgKCVRhZ1BvbGljeRIuCgR0YWdzGAEgAygLMiAuYnl0ZWJhc2UudjEuVGFnUG9saWN5LlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLiAQoVRGF0YVNvdXJjZVF1ZXJ5UG9saWN5ElUKHWFkbWluX2RhdGFfc291cmNlX3Jlc3RyaWN0aW9uGAEgASgOMi4uYnl0ZWJhc2UudjEuRGF0YVNvdXJjZVF1ZXJ5UG9saWN5LlJlc3RyaWN0aW9uEhQKDGRpc2FsbG93X2RkbBgCIAEoCBIUCgxkaXNhbGxvd19kbWwYAyABKAgiRgoLUmVzdHJpY3Rpb24SGwoXUkVTVFJJQ1RJT05fVU5TUEVDSUZJRUQQABIMCghGQUxMQkFDSxABEgwKCERJU0FMTE9XEAIq0AEKClBvbGljeVR5cGUSGwoXUE9MSUNZX1RZUEVfVU5TUEVDSUZJRUQQABISCg5ST0xMT1VUX1BPTElDWRALEhAKDE1BU0tJTkdfUlVMRRAJEhUKEU1BU0tJTkdfRVhDRVBUSU9OEAoSBwoDVEFHEA0SFQoRREFUQV9TT1VSQ0VfUVVFUlkQDhIOCgpEQVRBX1FVRVJZEBASDgoKUk9XX0FDQ0VTUxARIgQIAhACIgQIBBAEIgQIBhAGIgQIBRAFIgQIBxAHIgQIDBAMIgQIDxAPKmAKElBvbGljeVJlc291cmNlVHlwZRIdChlSRVNPVVJDRV9UWVBFX1VOU1BFQ0lGSUVEEAASDQoJV09SS1NQQUNFEAESDwoLRU5WSVJPTk1FTlQQAhILCgdQUk9KRUNUEAMqQwoSU1FMUmV2aWV3UnVsZUxldmVsEhUKEUxFVkVMX1VOU1BFQ0lGSUVEEAASCQoFRVJST1IQARILCgdXQVJOSU5HEAIy9AwKEE9yZ1BvbGljeVNlcnZpY2USoAIKCUdldFBvbGljeRIdLmJ5dGViYXNlLnYxLkdldFBvbGljeVJlcXVlc3QaEy5ieXRlYmFzZS52MS5Qb2xpY3ki3gHaQQRuYW1liuowD2JiLnBvbGljaWVzLmdldJDqMAGC0+STArkBWiISIC92MS97bmFtZT1wcm9qZWN0cy8qL3BvbGljaWVzLyp9WiYSJC92MS97bmFtZT1lbnZpcm9ubWVudHMvKi9wb2xpY2llcy8qfVojEiEvdjEve25hbWU9aW5zdGFuY2VzLyovcG9saWNpZXMvKn1aLxItL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3BvbGljaWVzLyp9EhUvdjEve25hbWU9cG9saWNpZXMvKn0SqAIKDExpc3RQb2xpY2llcxIgLmJ5dGViYXNlLnYxLkxpc3RQb2xpY2llc1JlcXVlc3QaIS5ieXRlYmFzZS52MS5MaXN0UG9saWNpZXNSZXNwb25zZSLSAdpBAIrqMBBiYi5wb2xpY2llcy5saXN0kOowAYLT5JMCsAFaIhIgL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcG9saWNpZXNaJhIkL3YxL3twYXJlbnQ9ZW52aXJvbm1lbnRzLyp9L3BvbGljaWVzWiMSIS92MS97cGFyZW50PWluc3RhbmNlcy8qfS9wb2xpY2llc1ovEi0vdjEve3BhcmVudD1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKn0vcG9saWNpZXMSDC92MS9wb2xpY2llcxLVAgoMQ3JlYXRlUG9saWN5EiAuYnl0ZWJhc2UudjEuQ3JlYXRlUG9saWN5UmVxdWVzdBoTLmJ5dGViYXNlLnYxLlBvbGljeSKNAtpBDXBhcmVudCxwb2xpY3mK6jASYmIucG9saWNpZXMuY3JlYXRlkOowAZjqMAGC0+STAtgBOgZwb2xpY3laKjoGcG9saWN5IiAvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wb2xpY2llc1ouOgZwb2xpY3kiJC92MS97cGFyZW50PWVudmlyb25tZW50cy8qfS9wb2xpY2llc1orOgZwb2xpY3kiIS92MS97cGFyZW50PWluc3RhbmNlcy8qfS9wb2xpY2llc1o3OgZwb2xpY3kiLS92MS97cGFyZW50PWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfS9wb2xpY2llcyIML3YxL3BvbGljaWVzEoYDCgxVcGRhdGVQb2xpY3kSIC5ieXRlYmFzZS52MS5VcGRhdGVQb2xpY3lSZXF1ZXN0GhMuYnl0ZWJhc2UudjEuUG9saWN5Ir4C2kEScG9saWN5LHVwZGF0ZV9tYXNriuowEmJiLnBvbGljaWVzLnVwZGF0ZZDqMAGY6jABgtPkkwKEAjoGcG9saWN5WjE6BnBvbGljeTInL3YxL3twb2xpY3kubmFtZT1wcm9qZWN0cy8qL3BvbGljaWVzLyp9WjU6BnBvbGljeTIrL3YxL3twb2xpY3kubmFtZT1lbnZpcm9ubWVudHMvKi9wb2xpY2llcy8qfVoyOgZwb2xpY3kyKC92MS97cG9saWN5Lm5hbWU9aW5zdGFuY2VzLyovcG9saWNpZXMvKn1aPjoGcG9saWN5MjQvdjEve3BvbGljeS5uYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qL3BvbGljaWVzLyp9MhwvdjEve3BvbGljeS5uYW1lPXBvbGljaWVzLyp9ErACCgxEZWxldGVQb2xpY3kSIC5ieXRlYmFzZS52MS5EZWxldGVQb2xpY3lSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IuUB2kEEbmFtZYrqMBJiYi5wb2xpY2llcy5kZWxldGWQ6jABmOowAYLT5JMCuQFaIiogL3YxL3tuYW1lPXByb2plY3RzLyovcG9saWNpZXMvKn1aJiokL3YxL3tuYW1lPWVudmlyb25tZW50cy8qL3BvbGljaWVzLyp9WiMqIS92MS97bmFtZT1pbnN0YW5jZXMvKi9wb2xpY2llcy8qfVovKi0vdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyovcG9saWNpZXMvKn0qFS92MS97bmFtZT1wb2xpY2llcy8qfUKrAQoPY29tLmJ5dGViYXNlLnYxQhVPcmdQb2xpY3lTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_type_expr, file_v1_annotation, file_v1_common]);
 
 /**
  * Describes the message bytebase.v1.CreatePolicyRequest.
@@ -74,25 +74,39 @@ export const PolicySchema = /*@__PURE__*/
 export const RolloutPolicySchema = /*@__PURE__*/
   messageDesc(file_v1_org_policy_service, 7);
 
+/**
+ * Describes the message bytebase.v1.RolloutPolicy.Window.
+ * Use `create(RolloutPolicy_WindowSchema)` to create a new message.
+ */
+export const RolloutPolicy_WindowSchema = /*@__PURE__*/
+  messageDesc(file_v1_org_policy_service, 7, 0);
+
+/**
+ * Describes the message bytebase.v1.RolloutPolicy.Window.TimeRange.
+ * Use `create(RolloutPolicy_Window_TimeRangeSchema)` to create a new message.
+ */
+export const RolloutPolicy_Window_TimeRangeSchema = /*@__PURE__*/
+  messageDesc(file_v1_org_policy_service, 7, 0, 0);
+
 /**
  * Describes the message bytebase.v1.RolloutPolicy.Checkers.
  * Use `create(RolloutPolicy_CheckersSchema)` to create a new message.
  */
 export const RolloutPolicy_CheckersSchema = /*@__PURE__*/
-  messageDesc(file_v1_org_policy_service, 7, 0);
+  messageDesc(file_v1_org_policy_service, 7, 1);
 
 /**
  * Describes the message bytebase.v1.RolloutPolicy.Checkers.RequiredStatusChecks.
  * Use `create(RolloutPolicy_Checkers_RequiredStatusChecksSchema)` to create a new message.
  */
 export const RolloutPolicy_Checkers_RequiredStatusChecksSchema = /*@__PURE__*/
-  messageDesc(file_v1_org_policy_service, 7, 0, 0);
+  messageDesc(file_v1_org_policy_service, 7, 1, 0);
 
 /**
  * Describes the enum bytebase.v1.RolloutPolicy.Checkers.PlanCheckEnforcement.
  */
 export const RolloutPolicy_Checkers_PlanCheckEnforcementSchema = /*@__PURE__*/
-  enumDesc(file_v1_org_policy_service, 7, 0, 0);
+  enumDesc(file_v1_org_policy_service, 7, 1, 0);
 
 /**
  * @generated from enum bytebase.v1.RolloutPolicy.Checkers.PlanCheckEnforcement
@@ -156,25 +170,39 @@ export const MaskingRulePolicySchema = /*@__PURE__*/
 export const MaskingRulePolicy_MaskingRuleSchema = /*@__PURE__*/
   messageDesc(file_v1_org_policy_service, 11, 0);
 
+/**
+ * Describes the message bytebase.v1.RowAccessPolicy.
+ * Use `create(RowAccessPolicySchema)` to create a new message.
+ */
+export const RowAccessPolicySchema = /*@__PURE__*/
+  messageDesc(file_v1_org_policy_service, 12);
+
+/**
+ * Describes the message bytebase.v1.RowAccessPolicy.Rule.
+ * Use `create(RowAccessPolicy_RuleSchema)` to create a new message.
+ */
+export const RowAccessPolicy_RuleSchema = /*@__PURE__*/
+  messageDesc(file_v1_org_policy_service, 12, 0);
+
 /**
  * Describes the message bytebase.v1.TagPolicy.
  * Use `create(TagPolicySchema)` to create a new message.
  */
 export const TagPolicySchema = /*@__PURE__*/
-  messageDesc(file_v1_org_policy_service, 12);
+  messageDesc(file_v1_org_policy_service, 13);
 
 /**
  * Describes the message bytebase.v1.DataSourceQueryPolicy.
  * Use `create(DataSourceQueryPolicySchema)` to create a new message.
  */
 export const DataSourceQueryPolicySchema = /*@__PURE__*/
-  messageDesc(file_v1_org_policy_service, 13);
+  messageDesc(file_v1_org_policy_service, 14);
 
 /**
  * Describes the enum bytebase.v1.DataSourceQueryPolicy.Restriction.
  */
 export const DataSourceQueryPolicy_RestrictionSchema = /*@__PURE__*/
-  enumDesc(file_v1_org_policy_service, 13, 0);
+  enumDesc(file_v1_org_policy_service, 14, 0);
 
 /**
  * Restriction level for admin data source access.
@@ -519,6 +519,20 @@ export declare type Plan_ChangeDatabaseConfig = Message<"bytebase.v1.Plan.Change
    * @generated from field: bool enable_ghost = 12;
    */
   enableGhost: boolean;
+
+  /**
+   * Flags for the PostgreSQL online schema change.
+   *
+   * @generated from field: map<string, string> online_schema_change_flags = 13;
+   */
+  onlineSchemaChangeFlags: { [key: string]: string };
+
+  /**
+   * Whether to use the shadow table online schema change for PostgreSQL.
+   *
+   * @generated from field: bool enable_online_schema_change = 14;
+   */
+  enableOnlineSchemaChange: boolean;
 };
 
 /**
@@ -587,6 +601,14 @@ export declare type Plan_Deployment = Message<"bytebase.v1.Plan.Deployment"> & {
    * @generated from field: repeated bytebase.v1.Plan.Deployment.DatabaseGroupMapping database_group_mappings = 2;
    */
   databaseGroupMappings: Plan_Deployment_DatabaseGroupMapping[];
+
+  /**
+   * The strategy to roll out the tasks in each stage in batches.
+   * All tasks in a stage can run at once if unset.
+   *
+   * @generated from field: bytebase.v1.Plan.Deployment.RolloutStrategy rollout_strategy = 3;
+   */
+  rolloutStrategy?: Plan_Deployment_RolloutStrategy;
 };
 
 /**
@@ -620,6 +642,40 @@ export declare type Plan_Deployment_DatabaseGroupMapping = Message<"bytebase.v1.
  */
 export declare const Plan_Deployment_DatabaseGroupMappingSchema: GenMessage<Plan_Deployment_DatabaseGroupMapping>;
 
+/**
+ * @generated from message bytebase.v1.Plan.Deployment.RolloutStrategy
+ */
+export declare type Plan_Deployment_RolloutStrategy = Message<"bytebase.v1.Plan.Deployment.RolloutStrategy"> & {
+  /**
+   * The number of canary tasks to run first in each stage.
+   *
+   * @generated from field: int32 canary_count = 1;
+   */
+  canaryCount: number;
+
+  /**
+   * The percentage of the remaining tasks to run in each batch after the canary tasks, from 0 to 100.
+   * All remaining tasks run in one batch if zero.
+   *
+   * @generated from field: int32 batch_percentage = 2;
+   */
+  batchPercentage: number;
+
+  /**
+   * The number of failed tasks at which the stage halts and the remaining tasks stop.
+   * The stage halts on the first failure if zero.
+   *
+   * @generated from field: int32 failure_threshold = 3;
+   */
+  failureThreshold: number;
+};
+
+/**
+ * Describes the message bytebase.v1.Plan.Deployment.RolloutStrategy.
+ * Use `create(Plan_Deployment_RolloutStrategySchema)` to create a new message.
+ */
+export declare const Plan_Deployment_RolloutStrategySchema: GenMessage<Plan_Deployment_RolloutStrategy>;
+
 /**
  * @generated from message bytebase.v1.ListPlanCheckRunsRequest
  */
@@ -860,6 +916,18 @@ export declare type PlanCheckRun_Result = Message<"bytebase.v1.PlanCheckRun.Resu
      */
     value: PlanCheckRun_Result_SqlReviewReport;
     case: "sqlReviewReport";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.PlanCheckRun.Result.LockImpactReport lock_impact_report = 7;
+     */
+    value: PlanCheckRun_Result_LockImpactReport;
+    case: "lockImpactReport";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.PlanCheckRun.Result.ExplainReport explain_report = 8;
+     */
+    value: PlanCheckRun_Result_ExplainReport;
+    case: "explainReport";
   } | { case: undefined; value?: undefined };
 };
 
@@ -889,6 +957,13 @@ export declare type PlanCheckRun_Result_SqlSummaryReport = Message<"bytebase.v1.
    * @generated from field: bytebase.v1.ChangedResources changed_resources = 4;
    */
   changedResources?: ChangedResources;
+
+  /**
+   * The estimated cost of the DML statements from EXPLAIN.
+   *
+   * @generated from field: double estimated_cost = 5;
+   */
+  estimatedCost: number;
 };
 
 /**
@@ -920,6 +995,295 @@ export declare type PlanCheckRun_Result_SqlReviewReport = Message<"bytebase.v1.P
  */
 export declare const PlanCheckRun_Result_SqlReviewReportSchema: GenMessage<PlanCheckRun_Result_SqlReviewReport>;
 
+/**
+ * @generated from message bytebase.v1.PlanCheckRun.Result.LockImpactReport
+ */
+export declare type PlanCheckRun_Result_LockImpactReport = Message<"bytebase.v1.PlanCheckRun.Result.LockImpactReport"> & {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema: string;
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table: string;
+
+  /**
+   * @generated from field: bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel lock_level = 3;
+   */
+  lockLevel: PlanCheckRun_Result_LockImpactReport_LockLevel;
+
+  /**
+   * @generated from field: bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm algorithm = 4;
+   */
+  algorithm: PlanCheckRun_Result_LockImpactReport_Algorithm;
+
+  /**
+   * Whether the table is rewritten, e.g. ALTER COLUMN TYPE.
+   *
+   * @generated from field: bool table_rewrite = 5;
+   */
+  tableRewrite: boolean;
+
+  /**
+   * Whether the table is fully scanned while the lock is held, e.g. SET NOT NULL.
+   *
+   * @generated from field: bool table_scan = 6;
+   */
+  tableScan: boolean;
+
+  /**
+   * The table row count and size in bytes from the synced metadata.
+   *
+   * @generated from field: int64 table_rows = 7;
+   */
+  tableRows: bigint;
+
+  /**
+   * @generated from field: int64 table_size = 8;
+   */
+  tableSize: bigint;
+
+  /**
+   * The estimated duration in seconds that the concurrent writes are blocked.
+   *
+   * @generated from field: int64 estimated_blocking_seconds = 9;
+   */
+  estimatedBlockingSeconds: bigint;
+
+  /**
+   * Position of the SQL statement.
+   *
+   * @generated from field: bytebase.v1.Position start_position = 10;
+   */
+  startPosition?: Position;
+};
+
+/**
+ * Describes the message bytebase.v1.PlanCheckRun.Result.LockImpactReport.
+ * Use `create(PlanCheckRun_Result_LockImpactReportSchema)` to create a new message.
+ */
+export declare const PlanCheckRun_Result_LockImpactReportSchema: GenMessage<PlanCheckRun_Result_LockImpactReport>;
+
+/**
+ * The lock levels ordered from the weakest to the strongest.
+ *
+ * @generated from enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel
+ */
+export enum PlanCheckRun_Result_LockImpactReport_LockLevel {
+  /**
+   * @generated from enum value: LOCK_LEVEL_UNSPECIFIED = 0;
+   */
+  LOCK_LEVEL_UNSPECIFIED = 0,
+
+  /**
+   * PostgreSQL SHARE UPDATE EXCLUSIVE lock, which does not block reads and writes.
+   *
+   * @generated from enum value: SHARE_UPDATE_EXCLUSIVE = 1;
+   */
+  SHARE_UPDATE_EXCLUSIVE = 1,
+
+  /**
+   * PostgreSQL SHARE lock, which blocks writes.
+   *
+   * @generated from enum value: SHARE = 2;
+   */
+  SHARE = 2,
+
+  /**
+   * PostgreSQL SHARE ROW EXCLUSIVE lock, which blocks writes.
+   *
+   * @generated from enum value: SHARE_ROW_EXCLUSIVE = 3;
+   */
+  SHARE_ROW_EXCLUSIVE = 3,
+
+  /**
+   * PostgreSQL ACCESS EXCLUSIVE lock, which blocks reads and writes.
+   *
+   * @generated from enum value: ACCESS_EXCLUSIVE = 4;
+   */
+  ACCESS_EXCLUSIVE = 4,
+
+  /**
+   * MySQL exclusive metadata lock. Whether the writes are blocked depends on the algorithm.
+   *
+   * @generated from enum value: METADATA_LOCK = 5;
+   */
+  METADATA_LOCK = 5,
+}
+
+/**
+ * Describes the enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel.
+ */
+export declare const PlanCheckRun_Result_LockImpactReport_LockLevelSchema: GenEnum<PlanCheckRun_Result_LockImpactReport_LockLevel>;
+
+/**
+ * The MySQL online DDL algorithms.
+ *
+ * @generated from enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm
+ */
+export enum PlanCheckRun_Result_LockImpactReport_Algorithm {
+  /**
+   * @generated from enum value: ALGORITHM_UNSPECIFIED = 0;
+   */
+  ALGORITHM_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INSTANT = 1;
+   */
+  INSTANT = 1,
+
+  /**
+   * @generated from enum value: INPLACE = 2;
+   */
+  INPLACE = 2,
+
+  /**
+   * @generated from enum value: COPY = 3;
+   */
+  COPY = 3,
+}
+
+/**
+ * Describes the enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm.
+ */
+export declare const PlanCheckRun_Result_LockImpactReport_AlgorithmSchema: GenEnum<PlanCheckRun_Result_LockImpactReport_Algorithm>;
+
+/**
+ * @generated from message bytebase.v1.PlanCheckRun.Result.ExplainReport
+ */
+export declare type PlanCheckRun_Result_ExplainReport = Message<"bytebase.v1.PlanCheckRun.Result.ExplainReport"> & {
+  /**
+   * @generated from field: string statement = 1;
+   */
+  statement: string;
+
+  /**
+   * @generated from field: bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode plan = 2;
+   */
+  plan?: PlanCheckRun_Result_ExplainReport_PlanNode;
+
+  /**
+   * The estimated total cost of the statement.
+   *
+   * @generated from field: double total_cost = 3;
+   */
+  totalCost: number;
+
+  /**
+   * The large tables that are fully scanned.
+   *
+   * @generated from field: repeated string full_scan_tables = 4;
+   */
+  fullScanTables: string[];
+
+  /**
+   * The tables that are fully scanned to evaluate a filter without using an index.
+   *
+   * @generated from field: repeated string missing_index_tables = 5;
+   */
+  missingIndexTables: string[];
+
+  /**
+   * The target whose plan of the previous environment is compared.
+   * Format: instances/{instance}/databases/{database}
+   *
+   * @generated from field: string previous_target = 6;
+   */
+  previousTarget: string;
+
+  /**
+   * @generated from field: double previous_total_cost = 7;
+   */
+  previousTotalCost: number;
+
+  /**
+   * The tables that are accessed by an index in the previous environment but fully scanned now.
+   *
+   * @generated from field: repeated string regressed_tables = 8;
+   */
+  regressedTables: string[];
+
+  /**
+   * Position of the SQL statement.
+   *
+   * @generated from field: bytebase.v1.Position start_position = 9;
+   */
+  startPosition?: Position;
+};
+
+/**
+ * Describes the message bytebase.v1.PlanCheckRun.Result.ExplainReport.
+ * Use `create(PlanCheckRun_Result_ExplainReportSchema)` to create a new message.
+ */
+export declare const PlanCheckRun_Result_ExplainReportSchema: GenMessage<PlanCheckRun_Result_ExplainReport>;
+
+/**
+ * PlanNode is a node of the query plan tree.
+ *
+ * @generated from message bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode
+ */
+export declare type PlanCheckRun_Result_ExplainReport_PlanNode = Message<"bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode"> & {
+  /**
+   * The operation of the node, e.g. Seq Scan for PostgreSQL or the access type ALL for MySQL.
+   *
+   * @generated from field: string operation = 1;
+   */
+  operation: string;
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table: string;
+
+  /**
+   * The index used to access the table.
+   *
+   * @generated from field: string index = 3;
+   */
+  index: string;
+
+  /**
+   * The estimated cost reported by the optimizer.
+   *
+   * @generated from field: double cost = 4;
+   */
+  cost: number;
+
+  /**
+   * The estimated rows examined by the node.
+   *
+   * @generated from field: int64 rows = 5;
+   */
+  rows: bigint;
+
+  /**
+   * Whether the table is fully scanned.
+   *
+   * @generated from field: bool full_scan = 6;
+   */
+  fullScan: boolean;
+
+  /**
+   * Whether the rows are filtered by a condition.
+   *
+   * @generated from field: bool has_filter = 7;
+   */
+  hasFilter: boolean;
+
+  /**
+   * @generated from field: repeated bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode children = 8;
+   */
+  children: PlanCheckRun_Result_ExplainReport_PlanNode[];
+};
+
+/**
+ * Describes the message bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode.
+ * Use `create(PlanCheckRun_Result_ExplainReport_PlanNodeSchema)` to create a new message.
+ */
+export declare const PlanCheckRun_Result_ExplainReport_PlanNodeSchema: GenMessage<PlanCheckRun_Result_ExplainReport_PlanNode>;
+
 /**
  * @generated from enum bytebase.v1.PlanCheckRun.Type
  */
@@ -965,6 +1329,27 @@ export enum PlanCheckRun_Type {
    * @generated from enum value: DATABASE_GHOST_SYNC = 7;
    */
   DATABASE_GHOST_SYNC = 7,
+
+  /**
+   * Online schema change check that validates PostgreSQL shadow table migration feasibility.
+   *
+   * @generated from enum value: DATABASE_ONLINE_SCHEMA_CHANGE = 8;
+   */
+  DATABASE_ONLINE_SCHEMA_CHANGE = 8,
+
+  /**
+   * Lock impact check that analyzes the locks and table rewrites of the DDL statements.
+   *
+   * @generated from enum value: DATABASE_STATEMENT_LOCK_IMPACT = 9;
+   */
+  DATABASE_STATEMENT_LOCK_IMPACT = 9,
+
+  /**
+   * Explain check that analyzes the query plans and costs of the DML statements.
+   *
+   * @generated from enum value: DATABASE_STATEMENT_EXPLAIN = 10;
+   */
+  DATABASE_STATEMENT_EXPLAIN = 10,
 }
 
 /**
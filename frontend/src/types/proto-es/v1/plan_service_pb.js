@@ -18,7 +18,7 @@ import { file_v1_sql_service } from "./sql_service_pb";
  * Describes the file v1/plan_service.proto.
  */
 export const file_v1_plan_service = /*@__PURE__*/
-  fileDesc("ChV2MS9wbGFuX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIjkKDkdldFBsYW5SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4iZwoQTGlzdFBsYW5zUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiTgoRTGlzdFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJgChJTZWFyY2hQbGFuc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJIlAKE1NlYXJjaFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJnChFDcmVhdGVQbGFuUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSJAoEcGxhbhgCIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAiKGAQoRVXBkYXRlUGxhblJlcXVlc3QSJAoEcGxhbhgBIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIVCg1hbGxvd19taXNzaW5nGAMgASgIIqANCgRQbGFuEgwKBG5hbWUYASABKAkSIQoFc3RhdGUYAiABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZRISCgVpc3N1ZRgDIAEoCUID4EEDEhQKB3JvbGxvdXQYDyABKAlCA+BBAxIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESHQoLZGVzY3JpcHRpb24YBSABKAlCCLpIBXIDGJBOEiUKBXNwZWNzGA4gAygLMhYuYnl0ZWJhc2UudjEuUGxhbi5TcGVjEhQKB2NyZWF0b3IYCCABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxJYChtwbGFuX2NoZWNrX3J1bl9zdGF0dXNfY291bnQYCyADKAsyLi5ieXRlYmFzZS52MS5QbGFuLlBsYW5DaGVja1J1blN0YXR1c0NvdW50RW50cnlCA+BBAxIwCgpkZXBsb3ltZW50GA0gASgLMhwuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50GvIBCgRTcGVjEgoKAmlkGAUgASgJEkgKFmNyZWF0ZV9kYXRhYmFzZV9jb25maWcYASABKAsyJi5ieXRlYmFzZS52MS5QbGFuLkNyZWF0ZURhdGFiYXNlQ29uZmlnSAASSAoWY2hhbmdlX2RhdGFiYXNlX2NvbmZpZxgCIAEoCzImLmJ5dGViYXNlLnYxLlBsYW4uQ2hhbmdlRGF0YWJhc2VDb25maWdIABJAChJleHBvcnRfZGF0YV9jb25maWcYByABKAsyIi5ieXRlYmFzZS52MS5QbGFuLkV4cG9ydERhdGFDb25maWdIAEIICgZjb25maWcaPgocUGxhbkNoZWNrUnVuU3RhdHVzQ291bnRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGs4BChRDcmVhdGVEYXRhYmFzZUNvbmZpZxITCgZ0YXJnZXQYASABKAlCA+BBAhIVCghkYXRhYmFzZRgCIAEoCUID4EECEhIKBXRhYmxlGAMgASgJQgPgQQESGgoNY2hhcmFjdGVyX3NldBgEIAEoCUID4EEBEhYKCWNvbGxhdGlvbhgFIAEoCUID4EEBEhQKB2NsdXN0ZXIYBiABKAlCA+BBARISCgVvd25lchgHIAEoCUID4EEBEhgKC2Vudmlyb25tZW50GAkgASgJQgPgQQEa0AIKFENoYW5nZURhdGFiYXNlQ29uZmlnEg8KB3RhcmdldHMYCiADKAkSDQoFc2hlZXQYAiABKAkSKgoHcmVsZWFzZRgJIAEoCUIZ+kEWChRieXRlYmFzZS5jb20vUmVsZWFzZRItCgR0eXBlGAMgASgOMh8uYnl0ZWJhc2UudjEuRGF0YWJhc2VDaGFuZ2VUeXBlEksKC2dob3N0X2ZsYWdzGAcgAygLMjYuYnl0ZWJhc2UudjEuUGxhbi5DaGFuZ2VEYXRhYmFzZUNvbmZpZy5HaG9zdEZsYWdzRW50cnkSGwoTZW5hYmxlX3ByaW9yX2JhY2t1cBgIIAEoCBIUCgxlbmFibGVfZ2hvc3QYDCABKAgaMQoPR2hvc3RGbGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFKBAgFEAZKBAgGEAcagQEKEEV4cG9ydERhdGFDb25maWcSDwoHdGFyZ2V0cxgFIAMoCRINCgVzaGVldBgCIAEoCRIpCgZmb3JtYXQYAyABKA4yGS5ieXRlYmFzZS52MS5FeHBvcnRGb3JtYXQSFQoIcGFzc3dvcmQYBCABKAlIAIgBAUILCglfcGFzc3dvcmQauQEKCkRlcGxveW1lbnQSFAoMZW52aXJvbm1lbnRzGAEgAygJElIKF2RhdGFiYXNlX2dyb3VwX21hcHBpbmdzGAIgAygLMjEuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50LkRhdGFiYXNlR3JvdXBNYXBwaW5nGkEKFERhdGFiYXNlR3JvdXBNYXBwaW5nEhYKDmRhdGFiYXNlX2dyb3VwGAEgASgJEhEKCWRhdGFiYXNlcxgCIAMoCTo36kE0ChFieXRlYmFzZS5jb20vUGxhbhIfcHJvamVjdHMve3Byb2plY3R9L3BsYW5zL3twbGFufSJqChhMaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhMKC2xhdGVzdF9vbmx5GAIgASgIEg4KBmZpbHRlchgDIAEoCSJPChlMaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlEjIKD3BsYW5fY2hlY2tfcnVucxgBIAMoCzIZLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1biJhChRSdW5QbGFuQ2hlY2tzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhQKB3NwZWNfaWQYAiABKAlIAIgBAUIKCghfc3BlY19pZCIXChVSdW5QbGFuQ2hlY2tzUmVzcG9uc2UiZQofQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4SFwoPcGxhbl9jaGVja19ydW5zGAIgAygJIiIKIEJhdGNoQ2FuY2VsUGxhbkNoZWNrUnVuc1Jlc3BvbnNlIr8ICgxQbGFuQ2hlY2tSdW4SDAoEbmFtZRgBIAEoCRIsCgR0eXBlGAMgASgOMh4uYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlR5cGUSMAoGc3RhdHVzGAQgASgOMiAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlN0YXR1cxIOCgZ0YXJnZXQYBSABKAkSDQoFc2hlZXQYBiABKAkSMQoHcmVzdWx0cxgHIAMoCzIgLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQSDQoFZXJyb3IYCCABKAkSNAoLY3JlYXRlX3RpbWUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMamAQKBlJlc3VsdBIpCgZzdGF0dXMYASABKA4yGS5ieXRlYmFzZS52MS5BZHZpY2UuTGV2ZWwSDQoFdGl0bGUYAiABKAkSDwoHY29udGVudBgDIAEoCRIMCgRjb2RlGAQgASgFEk8KEnNxbF9zdW1tYXJ5X3JlcG9ydBgFIAEoCzIxLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuU3FsU3VtbWFyeVJlcG9ydEgAEk0KEXNxbF9yZXZpZXdfcmVwb3J0GAYgASgLMjAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlJlc3VsdC5TcWxSZXZpZXdSZXBvcnRIABqCAQoQU3FsU3VtbWFyeVJlcG9ydBIXCg9zdGF0ZW1lbnRfdHlwZXMYAiADKAkSFQoNYWZmZWN0ZWRfcm93cxgDIAEoAxI4ChFjaGFuZ2VkX3Jlc291cmNlcxgEIAEoCzIdLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZXNKBAgBEAIahQEKD1NxbFJldmlld1JlcG9ydBItCg5zdGFydF9wb3NpdGlvbhgFIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgGIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uSgQIARACSgQIAhADSgQIAxAESgQIBBAFQggKBnJlcG9ydCK1AQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASIgoeREFUQUJBU0VfU1RBVEVNRU5UX0ZBS0VfQURWSVNFEAESHQoZREFUQUJBU0VfU1RBVEVNRU5UX0FEVklTRRADEiUKIURBVEFCQVNFX1NUQVRFTUVOVF9TVU1NQVJZX1JFUE9SVBAFEhQKEERBVEFCQVNFX0NPTk5FQ1QQBhIXChNEQVRBQkFTRV9HSE9TVF9TWU5DEAciUQoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgsKB1JVTk5JTkcQARIICgRET05FEAISCgoGRkFJTEVEEAMSDAoIQ0FOQ0VMRUQQBEoECAIQAzLSCgoLUGxhblNlcnZpY2USewoHR2V0UGxhbhIbLmJ5dGViYXNlLnYxLkdldFBsYW5SZXF1ZXN0GhEuYnl0ZWJhc2UudjEuUGxhbiJA2kEEbmFtZYrqMAxiYi5wbGFucy5nZXSQ6jABgtPkkwIfEh0vdjEve25hbWU9cHJvamVjdHMvKi9wbGFucy8qfRKPAQoJTGlzdFBsYW5zEh0uYnl0ZWJhc2UudjEuTGlzdFBsYW5zUmVxdWVzdBoeLmJ5dGViYXNlLnYxLkxpc3RQbGFuc1Jlc3BvbnNlIkPaQQZwYXJlbnSK6jANYmIucGxhbnMubGlzdJDqMAGC0+STAh8SHS92MS97cGFyZW50PXByb2plY3RzLyp9L3BsYW5zEp4BCgtTZWFyY2hQbGFucxIfLmJ5dGViYXNlLnYxLlNlYXJjaFBsYW5zUmVxdWVzdBogLmJ5dGViYXNlLnYxLlNlYXJjaFBsYW5zUmVzcG9uc2UiTNpBBnBhcmVudIrqMAxiYi5wbGFucy5nZXSQ6jACgtPkkwIpOgEqIiQvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFuczpzZWFyY2gSlQEKCkNyZWF0ZVBsYW4SHi5ieXRlYmFzZS52MS5DcmVhdGVQbGFuUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlBsYW4iVNpBC3BhcmVudCxwbGFuiuowD2JiLnBsYW5zLmNyZWF0ZZDqMAGY6jABgtPkkwIlOgRwbGFuIh0vdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFucxKfAQoKVXBkYXRlUGxhbhIeLmJ5dGViYXNlLnYxLlVwZGF0ZVBsYW5SZXF1ZXN0GhEuYnl0ZWJhc2UudjEuUGxhbiJe2kEQcGxhbix1cGRhdGVfbWFza4rqMA9iYi5wbGFucy51cGRhdGWQ6jACmOowAYLT5JMCKjoEcGxhbjIiL3YxL3twbGFuLm5hbWU9cHJvamVjdHMvKi9wbGFucy8qfRK/AQoRTGlzdFBsYW5DaGVja1J1bnMSJS5ieXRlYmFzZS52MS5MaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QaJi5ieXRlYmFzZS52MS5MaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlIlvaQQZwYXJlbnSK6jAVYmIucGxhbkNoZWNrUnVucy5saXN0kOowAYLT5JMCLxItL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9wbGFucy8qfS9wbGFuQ2hlY2tSdW5zErEBCg1SdW5QbGFuQ2hlY2tzEiEuYnl0ZWJhc2UudjEuUnVuUGxhbkNoZWNrc1JlcXVlc3QaIi5ieXRlYmFzZS52MS5SdW5QbGFuQ2hlY2tzUmVzcG9uc2UiWdpBBG5hbWWK6jAUYmIucGxhbkNoZWNrUnVucy5ydW6Q6jABgtPkkwIwOgEqIisvdjEve25hbWU9cHJvamVjdHMvKi9wbGFucy8qfTpydW5QbGFuQ2hlY2tzEuIBChhCYXRjaENhbmNlbFBsYW5DaGVja1J1bnMSLC5ieXRlYmFzZS52MS5CYXRjaENhbmNlbFBsYW5DaGVja1J1bnNSZXF1ZXN0Gi0uYnl0ZWJhc2UudjEuQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVzcG9uc2UiadpBBnBhcmVudIrqMBRiYi5wbGFuQ2hlY2tSdW5zLnJ1bpDqMAGC0+STAj46ASoiOS92MS97cGFyZW50PXByb2plY3RzLyovcGxhbnMvKn0vcGxhbkNoZWNrUnVuczpiYXRjaENhbmNlbEKmAQoPY29tLmJ5dGViYXNlLnYxQhBQbGFuU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_service, file_v1_sql_service]);
+  fileDesc("ChV2MS9wbGFuX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIjkKDkdldFBsYW5SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4iZwoQTGlzdFBsYW5zUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiTgoRTGlzdFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJgChJTZWFyY2hQbGFuc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJIlAKE1NlYXJjaFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJnChFDcmVhdGVQbGFuUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSJAoEcGxhbhgCIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAiKGAQoRVXBkYXRlUGxhblJlcXVlc3QSJAoEcGxhbhgBIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIVCg1hbGxvd19taXNzaW5nGAMgASgIIpQQCgRQbGFuEgwKBG5hbWUYASABKAkSIQoFc3RhdGUYAiABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZRISCgVpc3N1ZRgDIAEoCUID4EEDEhQKB3JvbGxvdXQYDyABKAlCA+BBAxIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESHQoLZGVzY3JpcHRpb24YBSABKAlCCLpIBXIDGJBOEiUKBXNwZWNzGA4gAygLMhYuYnl0ZWJhc2UudjEuUGxhbi5TcGVjEhQKB2NyZWF0b3IYCCABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxJYChtwbGFuX2NoZWNrX3J1bl9zdGF0dXNfY291bnQYCyADKAsyLi5ieXRlYmFzZS52MS5QbGFuLlBsYW5DaGVja1J1blN0YXR1c0NvdW50RW50cnlCA+BBAxIwCgpkZXBsb3ltZW50GA0gASgLMhwuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50GvIBCgRTcGVjEgoKAmlkGAUgASgJEkgKFmNyZWF0ZV9kYXRhYmFzZV9jb25maWcYASABKAsyJi5ieXRlYmFzZS52MS5QbGFuLkNyZWF0ZURhdGFiYXNlQ29uZmlnSAASSAoWY2hhbmdlX2RhdGFiYXNlX2NvbmZpZxgCIAEoCzImLmJ5dGViYXNlLnYxLlBsYW4uQ2hhbmdlRGF0YWJhc2VDb25maWdIABJAChJleHBvcnRfZGF0YV9jb25maWcYByABKAsyIi5ieXRlYmFzZS52MS5QbGFuLkV4cG9ydERhdGFDb25maWdIAEIICgZjb25maWcaPgocUGxhbkNoZWNrUnVuU3RhdHVzQ291bnRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGs4BChRDcmVhdGVEYXRhYmFzZUNvbmZpZxITCgZ0YXJnZXQYASABKAlCA+BBAhIVCghkYXRhYmFzZRgCIAEoCUID4EECEhIKBXRhYmxlGAMgASgJQgPgQQESGgoNY2hhcmFjdGVyX3NldBgEIAEoCUID4EEBEhYKCWNvbGxhdGlvbhgFIAEoCUID4EEBEhQKB2NsdXN0ZXIYBiABKAlCA+BBARISCgVvd25lchgHIAEoCUID4EEBEhgKC2Vudmlyb25tZW50GAkgASgJQgPgQQEangQKFENoYW5nZURhdGFiYXNlQ29uZmlnEg8KB3RhcmdldHMYCiADKAkSDQoFc2hlZXQYAiABKAkSKgoHcmVsZWFzZRgJIAEoCUIZ+kEWChRieXRlYmFzZS5jb20vUmVsZWFzZRItCgR0eXBlGAMgASgOMh8uYnl0ZWJhc2UudjEuRGF0YWJhc2VDaGFuZ2VUeXBlEksKC2dob3N0X2ZsYWdzGAcgAygLMjYuYnl0ZWJhc2UudjEuUGxhbi5DaGFuZ2VEYXRhYmFzZUNvbmZpZy5HaG9zdEZsYWdzRW50cnkSGwoTZW5hYmxlX3ByaW9yX2JhY2t1cBgIIAEoCBIUCgxlbmFibGVfZ2hvc3QYDCABKAgSZwoab25saW5lX3NjaGVtYV9jaGFuZ2VfZmxhZ3MYDSADKAsyQy5ieXRlYmFzZS52MS5QbGFuLkNoYW5nZURhdGFiYXNlQ29uZmlnLk9ubGluZVNjaGVtYUNoYW5nZUZsYWdzRW50cnkSIwobZW5hYmxlX29ubGluZV9zY2hlbWFfY2hhbmdlGA4gASgIGjEKD0dob3N0RmxhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGj4KHE9ubGluZVNjaGVtYUNoYW5nZUZsYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUoECAUQBkoECAYQBxqBAQoQRXhwb3J0RGF0YUNvbmZpZxIPCgd0YXJnZXRzGAUgAygJEg0KBXNoZWV0GAIgASgJEikKBmZvcm1hdBgDIAEoDjIZLmJ5dGViYXNlLnYxLkV4cG9ydEZvcm1hdBIVCghwYXNzd29yZBgEIAEoCUgAiAEBQgsKCV9wYXNzd29yZBrfAgoKRGVwbG95bWVudBIUCgxlbnZpcm9ubWVudHMYASADKAkSUgoXZGF0YWJhc2VfZ3JvdXBfbWFwcGluZ3MYAiADKAsyMS5ieXRlYmFzZS52MS5QbGFuLkRlcGxveW1lbnQuRGF0YWJhc2VHcm91cE1hcHBpbmcSRgoQcm9sbG91dF9zdHJhdGVneRgDIAEoCzIsLmJ5dGViYXNlLnYxLlBsYW4uRGVwbG95bWVudC5Sb2xsb3V0U3RyYXRlZ3kaQQoURGF0YWJhc2VHcm91cE1hcHBpbmcSFgoOZGF0YWJhc2VfZ3JvdXAYASABKAkSEQoJZGF0YWJhc2VzGAIgAygJGlwKD1JvbGxvdXRTdHJhdGVneRIUCgxjYW5hcnlfY291bnQYASABKAUSGAoQYmF0Y2hfcGVyY2VudGFnZRgCIAEoBRIZChFmYWlsdXJlX3RocmVzaG9sZBgDIAEoBTo36kE0ChFieXRlYmFzZS5jb20vUGxhbhIfcHJvamVjdHMve3Byb2plY3R9L3BsYW5zL3twbGFufSJqChhMaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhMKC2xhdGVzdF9vbmx5GAIgASgIEg4KBmZpbHRlchgDIAEoCSJPChlMaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlEjIKD3BsYW5fY2hlY2tfcnVucxgBIAMoCzIZLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1biJhChRSdW5QbGFuQ2hlY2tzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhQKB3NwZWNfaWQYAiABKAlIAIgBAUIKCghfc3BlY19pZCIXChVSdW5QbGFuQ2hlY2tzUmVzcG9uc2UiZQofQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4SFwoPcGxhbl9jaGVja19ydW5zGAIgAygJIiIKIEJhdGNoQ2FuY2VsUGxhbkNoZWNrUnVuc1Jlc3BvbnNlIrYTCgxQbGFuQ2hlY2tSdW4SDAoEbmFtZRgBIAEoCRIsCgR0eXBlGAMgASgOMh4uYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlR5cGUSMAoGc3RhdHVzGAQgASgOMiAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlN0YXR1cxIOCgZ0YXJnZXQYBSABKAkSDQoFc2hlZXQYBiABKAkSMQoHcmVzdWx0cxgHIAMoCzIgLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQSDQoFZXJyb3IYCCABKAkSNAoLY3JlYXRlX3RpbWUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMaqA4KBlJlc3VsdBIpCgZzdGF0dXMYASABKA4yGS5ieXRlYmFzZS52MS5BZHZpY2UuTGV2ZWwSDQoFdGl0bGUYAiABKAkSDwoHY29udGVudBgDIAEoCRIMCgRjb2RlGAQgASgFEk8KEnNxbF9zdW1tYXJ5X3JlcG9ydBgFIAEoCzIxLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuU3FsU3VtbWFyeVJlcG9ydEgAEk0KEXNxbF9yZXZpZXdfcmVwb3J0GAYgASgLMjAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlJlc3VsdC5TcWxSZXZpZXdSZXBvcnRIABJPChJsb2NrX2ltcGFjdF9yZXBvcnQYByABKAsyMS5ieXRlYmFzZS52MS5QbGFuQ2hlY2tSdW4uUmVzdWx0LkxvY2tJbXBhY3RSZXBvcnRIABJICg5leHBsYWluX3JlcG9ydBgIIAEoCzIuLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuRXhwbGFpblJlcG9ydEgAGpoBChBTcWxTdW1tYXJ5UmVwb3J0EhcKD3N0YXRlbWVudF90eXBlcxgCIAMoCRIVCg1hZmZlY3RlZF9yb3dzGAMgASgDEjgKEWNoYW5nZWRfcmVzb3VyY2VzGAQgASgLMh0uYnl0ZWJhc2UudjEuQ2hhbmdlZFJlc291cmNlcxIWCg5lc3RpbWF0ZWRfY29zdBgFIAEoAUoECAEQAhqFAQoPU3FsUmV2aWV3UmVwb3J0Ei0KDnN0YXJ0X3Bvc2l0aW9uGAUgASgLMhUuYnl0ZWJhc2UudjEuUG9zaXRpb24SKwoMZW5kX3Bvc2l0aW9uGAYgASgLMhUuYnl0ZWJhc2UudjEuUG9zaXRpb25KBAgBEAJKBAgCEANKBAgDEARKBAgEEAUa1wQKEExvY2tJbXBhY3RSZXBvcnQSDgoGc2NoZW1hGAEgASgJEg0KBXRhYmxlGAIgASgJEk8KCmxvY2tfbGV2ZWwYAyABKA4yOy5ieXRlYmFzZS52MS5QbGFuQ2hlY2tSdW4uUmVzdWx0LkxvY2tJbXBhY3RSZXBvcnQuTG9ja0xldmVsEk4KCWFsZ29yaXRobRgEIAEoDjI7LmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuTG9ja0ltcGFjdFJlcG9ydC5BbGdvcml0aG0SFQoNdGFibGVfcmV3cml0ZRgFIAEoCBISCgp0YWJsZV9zY2FuGAYgASgIEhIKCnRhYmxlX3Jvd3MYByABKAMSEgoKdGFibGVfc2l6ZRgIIAEoAxIiChplc3RpbWF0ZWRfYmxvY2tpbmdfc2Vjb25kcxgJIAEoAxItCg5zdGFydF9wb3NpdGlvbhgKIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uIpABCglMb2NrTGV2ZWwSGgoWTE9DS19MRVZFTF9VTlNQRUNJRklFRBAAEhoKFlNIQVJFX1VQREFURV9FWENMVVNJVkUQARIJCgVTSEFSRRACEhcKE1NIQVJFX1JPV19FWENMVVNJVkUQAxIUChBBQ0NFU1NfRVhDTFVTSVZFEAQSEQoNTUVUQURBVEFfTE9DSxAFIkoKCUFsZ29yaXRobRIZChVBTEdPUklUSE1fVU5TUEVDSUZJRUQQABILCgdJTlNUQU5UEAESCwoHSU5QTEFDRRACEggKBENPUFkQAxqABAoNRXhwbGFpblJlcG9ydBIRCglzdGF0ZW1lbnQYASABKAkSRQoEcGxhbhgCIAEoCzI3LmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuRXhwbGFpblJlcG9ydC5QbGFuTm9kZRISCgp0b3RhbF9jb3N0GAMgASgBEhgKEGZ1bGxfc2Nhbl90YWJsZXMYBCADKAkSHAoUbWlzc2luZ19pbmRleF90YWJsZXMYBSADKAkSFwoPcHJldmlvdXNfdGFyZ2V0GAYgASgJEhsKE3ByZXZpb3VzX3RvdGFsX2Nvc3QYByABKAESGAoQcmVncmVzc2VkX3RhYmxlcxgIIAMoCRItCg5zdGFydF9wb3NpdGlvbhgJIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uGskBCghQbGFuTm9kZRIRCglvcGVyYXRpb24YASABKAkSDQoFdGFibGUYAiABKAkSDQoFaW5kZXgYAyABKAkSDAoEY29zdBgEIAEoARIMCgRyb3dzGAUgASgDEhEKCWZ1bGxfc2NhbhgGIAEoCBISCgpoYXNfZmlsdGVyGAcgASgIEkkKCGNoaWxkcmVuGAggAygLMjcuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlJlc3VsdC5FeHBsYWluUmVwb3J0LlBsYW5Ob2RlQggKBnJlcG9ydCKcAgoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASIgoeREFUQUJBU0VfU1RBVEVNRU5UX0ZBS0VfQURWSVNFEAESHQoZREFUQUJBU0VfU1RBVEVNRU5UX0FEVklTRRADEiUKIURBVEFCQVNFX1NUQVRFTUVOVF9TVU1NQVJZX1JFUE9SVBAFEhQKEERBVEFCQVNFX0NPTk5FQ1QQBhIXChNEQVRBQkFTRV9HSE9TVF9TWU5DEAcSIQodREFUQUJBU0VfT05MSU5FX1NDSEVNQV9DSEFOR0UQCBIiCh5EQVRBQkFTRV9TVEFURU1FTlRfTE9DS19JTVBBQ1QQCRIeChpEQVRBQkFTRV9TVEFURU1FTlRfRVhQTEFJThAKIlEKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdSVU5OSU5HEAESCAoERE9ORRACEgoKBkZBSUxFRBADEgwKCENBTkNFTEVEEARKBAgCEAMy0goKC1BsYW5TZXJ2aWNlEnsKB0dldFBsYW4SGy5ieXRlYmFzZS52MS5HZXRQbGFuUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlBsYW4iQNpBBG5hbWWK6jAMYmIucGxhbnMuZ2V0kOowAYLT5JMCHxIdL3YxL3tuYW1lPXByb2plY3RzLyovcGxhbnMvKn0SjwEKCUxpc3RQbGFucxIdLmJ5dGViYXNlLnYxLkxpc3RQbGFuc1JlcXVlc3QaHi5ieXRlYmFzZS52MS5MaXN0UGxhbnNSZXNwb25zZSJD2kEGcGFyZW50iuowDWJiLnBsYW5zLmxpc3SQ6jABgtPkkwIfEh0vdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFucxKeAQoLU2VhcmNoUGxhbnMSHy5ieXRlYmFzZS52MS5TZWFyY2hQbGFuc1JlcXVlc3QaIC5ieXRlYmFzZS52MS5TZWFyY2hQbGFuc1Jlc3BvbnNlIkzaQQZwYXJlbnSK6jAMYmIucGxhbnMuZ2V0kOowAoLT5JMCKToBKiIkL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcGxhbnM6c2VhcmNoEpUBCgpDcmVhdGVQbGFuEh4uYnl0ZWJhc2UudjEuQ3JlYXRlUGxhblJlcXVlc3QaES5ieXRlYmFzZS52MS5QbGFuIlTaQQtwYXJlbnQscGxhborqMA9iYi5wbGFucy5jcmVhdGWQ6jABmOowAYLT5JMCJToEcGxhbiIdL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcGxhbnMSnwEKClVwZGF0ZVBsYW4SHi5ieXRlYmFzZS52MS5VcGRhdGVQbGFuUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlBsYW4iXtpBEHBsYW4sdXBkYXRlX21hc2uK6jAPYmIucGxhbnMudXBkYXRlkOowApjqMAGC0+STAio6BHBsYW4yIi92MS97cGxhbi5uYW1lPXByb2plY3RzLyovcGxhbnMvKn0SvwEKEUxpc3RQbGFuQ2hlY2tSdW5zEiUuYnl0ZWJhc2UudjEuTGlzdFBsYW5DaGVja1J1bnNSZXF1ZXN0GiYuYnl0ZWJhc2UudjEuTGlzdFBsYW5DaGVja1J1bnNSZXNwb25zZSJb2kEGcGFyZW50iuowFWJiLnBsYW5DaGVja1J1bnMubGlzdJDqMAGC0+STAi8SLS92MS97cGFyZW50PXByb2plY3RzLyovcGxhbnMvKn0vcGxhbkNoZWNrUnVucxKxAQoNUnVuUGxhbkNoZWNrcxIhLmJ5dGViYXNlLnYxLlJ1blBsYW5DaGVja3NSZXF1ZXN0GiIuYnl0ZWJhc2UudjEuUnVuUGxhbkNoZWNrc1Jlc3BvbnNlIlnaQQRuYW1liuowFGJiLnBsYW5DaGVja1J1bnMucnVukOowAYLT5JMCMDoBKiIrL3YxL3tuYW1lPXByb2plY3RzLyovcGxhbnMvKn06cnVuUGxhbkNoZWNrcxLiAQoYQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zEiwuYnl0ZWJhc2UudjEuQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVxdWVzdBotLmJ5dGViYXNlLnYxLkJhdGNoQ2FuY2VsUGxhbkNoZWNrUnVuc1Jlc3BvbnNlImnaQQZwYXJlbnSK6jAUYmIucGxhbkNoZWNrUnVucy5ydW6Q6jABgtPkkwI+OgEqIjkvdjEve3BhcmVudD1wcm9qZWN0cy8qL3BsYW5zLyp9L3BsYW5DaGVja1J1bnM6YmF0Y2hDYW5jZWxCpgEKD2NvbS5ieXRlYmFzZS52MUIQUGxhblNlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_service, file_v1_sql_service]);
 
 /**
  * Describes the message bytebase.v1.GetPlanRequest.
@@ -118,6 +118,13 @@ export const Plan_DeploymentSchema = /*@__PURE__*/
 export const Plan_Deployment_DatabaseGroupMappingSchema = /*@__PURE__*/
   messageDesc(file_v1_plan_service, 7, 4, 0);
 
+/**
+ * Describes the message bytebase.v1.Plan.Deployment.RolloutStrategy.
+ * Use `create(Plan_Deployment_RolloutStrategySchema)` to create a new message.
+ */
+export const Plan_Deployment_RolloutStrategySchema = /*@__PURE__*/
+  messageDesc(file_v1_plan_service, 7, 4, 1);
+
 /**
  * Describes the message bytebase.v1.ListPlanCheckRunsRequest.
  * Use `create(ListPlanCheckRunsRequestSchema)` to create a new message.
@@ -188,6 +195,55 @@ export const PlanCheckRun_Result_SqlSummaryReportSchema = /*@__PURE__*/
 export const PlanCheckRun_Result_SqlReviewReportSchema = /*@__PURE__*/
   messageDesc(file_v1_plan_service, 14, 0, 1);
 
+/**
+ * Describes the message bytebase.v1.PlanCheckRun.Result.LockImpactReport.
+ * Use `create(PlanCheckRun_Result_LockImpactReportSchema)` to create a new message.
+ */
+export const PlanCheckRun_Result_LockImpactReportSchema = /*@__PURE__*/
+  messageDesc(file_v1_plan_service, 14, 0, 2);
+
+/**
+ * Describes the enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel.
+ */
+export const PlanCheckRun_Result_LockImpactReport_LockLevelSchema = /*@__PURE__*/
+  enumDesc(file_v1_plan_service, 14, 0, 2, 0);
+
+/**
+ * The lock levels ordered from the weakest to the strongest.
+ *
+ * @generated from enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.LockLevel
+ */
+export const PlanCheckRun_Result_LockImpactReport_LockLevel = /*@__PURE__*/
+  tsEnum(PlanCheckRun_Result_LockImpactReport_LockLevelSchema);
+
+/**
+ * Describes the enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm.
+ */
+export const PlanCheckRun_Result_LockImpactReport_AlgorithmSchema = /*@__PURE__*/
+  enumDesc(file_v1_plan_service, 14, 0, 2, 1);
+
+/**
+ * The MySQL online DDL algorithms.
+ *
+ * @generated from enum bytebase.v1.PlanCheckRun.Result.LockImpactReport.Algorithm
+ */
+export const PlanCheckRun_Result_LockImpactReport_Algorithm = /*@__PURE__*/
+  tsEnum(PlanCheckRun_Result_LockImpactReport_AlgorithmSchema);
+
+/**
+ * Describes the message bytebase.v1.PlanCheckRun.Result.ExplainReport.
+ * Use `create(PlanCheckRun_Result_ExplainReportSchema)` to create a new message.
+ */
+export const PlanCheckRun_Result_ExplainReportSchema = /*@__PURE__*/
+  messageDesc(file_v1_plan_service, 14, 0, 3);
+
+/**
+ * Describes the message bytebase.v1.PlanCheckRun.Result.ExplainReport.PlanNode.
+ * Use `create(PlanCheckRun_Result_ExplainReport_PlanNodeSchema)` to create a new message.
+ */
+export const PlanCheckRun_Result_ExplainReport_PlanNodeSchema = /*@__PURE__*/
+  messageDesc(file_v1_plan_service, 14, 0, 3, 0);
+
 /**
  * Describes the enum bytebase.v1.PlanCheckRun.Type.
  */
@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
-import type { EmptySchema, FieldMask } from "@bufbuild/protobuf/wkt";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
 import type { GetIamPolicyRequestSchema, IamPolicy, IamPolicySchema, SetIamPolicyRequestSchema } from "./iam_policy_pb";
 import type { State } from "./common_pb";
 
@@ -800,10 +800,22 @@ export declare type Webhook = Message<"bytebase.v1.Webhook"> & {
    * - ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE
    * - NOTIFY_ISSUE_APPROVED
    * - NOTIFY_PIPELINE_ROLLOUT
+   * - NOTIFY_ROLLOUT_WINDOW_OPEN
+   * - NOTIFY_BREAK_GLASS
+   * - NOTIFY_SCHEMA_DRIFT
    *
    * @generated from field: repeated bytebase.v1.Activity.Type notification_types = 5;
    */
   notificationTypes: Activity_Type[];
+
+  /**
+   * signing_secret is the secret to sign the GENERIC webhook requests.
+   * The signature is sent in the X-Bytebase-Signature header as "sha256=<hex>",
+   * which is the HMAC-SHA256 of "<X-Bytebase-Timestamp>.<body>".
+   *
+   * @generated from field: string signing_secret = 7;
+   */
+  signingSecret: string;
 };
 
 /**
@@ -873,6 +885,15 @@ export enum Webhook_Type {
    * @generated from enum value: LARK = 8;
    */
   LARK = 8,
+
+  /**
+   * Generic HTTP receiver.
+   * Bytebase posts a versioned JSON payload signed with HMAC-SHA256,
+   * and retries the failed deliveries with exponential backoff.
+   *
+   * @generated from enum value: GENERIC = 9;
+   */
+  GENERIC = 9,
 }
 
 /**
@@ -880,6 +901,190 @@ export enum Webhook_Type {
  */
 export declare const Webhook_TypeSchema: GenEnum<Webhook_Type>;
 
+/**
+ * @generated from message bytebase.v1.ListWebhookDeliveriesRequest
+ */
+export declare type ListWebhookDeliveriesRequest = Message<"bytebase.v1.ListWebhookDeliveriesRequest"> & {
+  /**
+   * The webhook to list the deliveries of.
+   * Format: projects/{project}/webhooks/{webhook}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The maximum number of deliveries to return.
+   * The service may return fewer than this value.
+   * If unspecified, at most 10 deliveries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * A page token, received from a previous `ListWebhookDeliveries` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListWebhookDeliveries` must match
+   * the call that provided the page token.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export declare const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest>;
+
+/**
+ * @generated from message bytebase.v1.ListWebhookDeliveriesResponse
+ */
+export declare type ListWebhookDeliveriesResponse = Message<"bytebase.v1.ListWebhookDeliveriesResponse"> & {
+  /**
+   * The deliveries of the webhook.
+   *
+   * @generated from field: repeated bytebase.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export declare const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse>;
+
+/**
+ * WebhookDelivery is a delivery of an event to a GENERIC webhook.
+ *
+ * @generated from message bytebase.v1.WebhookDelivery
+ */
+export declare type WebhookDelivery = Message<"bytebase.v1.WebhookDelivery"> & {
+  /**
+   * The name of the delivery.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The status of the delivery.
+   *
+   * @generated from field: bytebase.v1.WebhookDelivery.Status status = 2;
+   */
+  status: WebhookDelivery_Status;
+
+  /**
+   * The activity type of the event.
+   *
+   * @generated from field: bytebase.v1.Activity.Type event_type = 3;
+   */
+  eventType: Activity_Type;
+
+  /**
+   * The JSON request body.
+   *
+   * @generated from field: string body = 4;
+   */
+  body: string;
+
+  /**
+   * The number of attempts made.
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * The HTTP status code of the last attempt, 0 if no response was received.
+   *
+   * @generated from field: int32 last_status_code = 6;
+   */
+  lastStatusCode: number;
+
+  /**
+   * The error of the last attempt.
+   *
+   * @generated from field: string last_error = 7;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 8;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp update_time = 9;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * The time of the next attempt for a PENDING delivery.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_time = 10;
+   */
+  nextAttemptTime?: Timestamp;
+};
+
+/**
+ * Describes the message bytebase.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export declare const WebhookDeliverySchema: GenMessage<WebhookDelivery>;
+
+/**
+ * @generated from enum bytebase.v1.WebhookDelivery.Status
+ */
+export enum WebhookDelivery_Status {
+  /**
+   * Unspecified status.
+   *
+   * @generated from enum value: STATUS_UNSPECIFIED = 0;
+   */
+  STATUS_UNSPECIFIED = 0,
+
+  /**
+   * The delivery is waiting for the first or the next attempt.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The receiver accepted the delivery.
+   *
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * The delivery failed after all attempts.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum bytebase.v1.WebhookDelivery.Status.
+ */
+export declare const WebhookDelivery_StatusSchema: GenEnum<WebhookDelivery_Status>;
+
 /**
  * Activity types for webhook notifications.
  *
@@ -923,6 +1128,27 @@ export enum Activity_Type {
    */
   NOTIFY_PIPELINE_ROLLOUT = 24,
 
+  /**
+   * NOTIFY_ROLLOUT_WINDOW_OPEN represents the rollout window opening for the waiting tasks.
+   *
+   * @generated from enum value: NOTIFY_ROLLOUT_WINDOW_OPEN = 25;
+   */
+  NOTIFY_ROLLOUT_WINDOW_OPEN = 25,
+
+  /**
+   * NOTIFY_BREAK_GLASS represents the break-glass access granted notification.
+   *
+   * @generated from enum value: NOTIFY_BREAK_GLASS = 26;
+   */
+  NOTIFY_BREAK_GLASS = 26,
+
+  /**
+   * NOTIFY_SCHEMA_DRIFT represents the schema drift detected on a database.
+   *
+   * @generated from enum value: NOTIFY_SCHEMA_DRIFT = 27;
+   */
+  NOTIFY_SCHEMA_DRIFT = 27,
+
   /**
    * Issue related activity types.
    *
@@ -1141,6 +1367,17 @@ export declare const ProjectService: GenService<{
     input: typeof RemoveWebhookRequestSchema;
     output: typeof ProjectSchema;
   },
+  /**
+   * Lists the deliveries of a GENERIC webhook, newest first.
+   * Permissions required: bb.projects.update
+   *
+   * @generated from rpc bytebase.v1.ProjectService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
   /**
    * Tests a webhook by sending a test notification.
    * Permissions required: bb.projects.update
@@ -307,6 +307,15 @@ export declare type CheckReleaseRequest = Message<"bytebase.v1.CheckReleaseReque
    * @generated from field: string custom_rules = 4;
    */
   customRules: string;
+
+  /**
+   * Whether to check that the release files are formatted by the SQL formatter.
+   * A warning is reported for each file that is not formatted.
+   * Supported engines: MySQL, MariaDB, OceanBase, PostgreSQL, SQL Server and Oracle.
+   *
+   * @generated from field: bool check_format = 5;
+   */
+  checkFormat: boolean;
 };
 
 /**
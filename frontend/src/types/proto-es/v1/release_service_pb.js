@@ -17,7 +17,7 @@ import { file_v1_sql_service } from "./sql_service_pb";
  * Describes the file v1/release_service.proto.
  */
 export const file_v1_release_service = /*@__PURE__*/
-  fileDesc("Chh2MS9yZWxlYXNlX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIj8KEUdldFJlbGVhc2VSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1JlbGVhc2UigAEKE0xpc3RSZWxlYXNlc1JlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEhQKDHNob3dfZGVsZXRlZBgEIAEoCCJXChRMaXN0UmVsZWFzZXNSZXNwb25zZRImCghyZWxlYXNlcxgBIAMoCzIULmJ5dGViYXNlLnYxLlJlbGVhc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIowBChVTZWFyY2hSZWxlYXNlc1JlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEhMKBmRpZ2VzdBgEIAEoCUgAiAEBQgkKB19kaWdlc3QiWQoWU2VhcmNoUmVsZWFzZXNSZXNwb25zZRImCghyZWxlYXNlcxgBIAMoCzIULmJ5dGViYXNlLnYxLlJlbGVhc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInAKFENyZWF0ZVJlbGVhc2VSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIqCgdyZWxlYXNlGAIgASgLMhQuYnl0ZWJhc2UudjEuUmVsZWFzZUID4EECIooBChRVcGRhdGVSZWxlYXNlUmVxdWVzdBIqCgdyZWxlYXNlGAEgASgLMhQuYnl0ZWJhc2UudjEuUmVsZWFzZUID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1hbGxvd19taXNzaW5nGAMgASgIIkIKFERlbGV0ZVJlbGVhc2VSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1JlbGVhc2UiRAoWVW5kZWxldGVSZWxlYXNlUmVxdWVzdBIqCgRuYW1lGAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9SZWxlYXNlIpYBChNDaGVja1JlbGVhc2VSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIqCgdyZWxlYXNlGAIgASgLMhQuYnl0ZWJhc2UudjEuUmVsZWFzZUID4EECEg8KB3RhcmdldHMYAyADKAkSFAoMY3VzdG9tX3J1bGVzGAQgASgJIrACChRDaGVja1JlbGVhc2VSZXNwb25zZRI+CgdyZXN1bHRzGAEgAygLMi0uYnl0ZWJhc2UudjEuQ2hlY2tSZWxlYXNlUmVzcG9uc2UuQ2hlY2tSZXN1bHQSFQoNYWZmZWN0ZWRfcm93cxgCIAEoAxIqCgpyaXNrX2xldmVsGAMgASgOMhYuYnl0ZWJhc2UudjEuUmlza0xldmVsGpQBCgtDaGVja1Jlc3VsdBIMCgRmaWxlGAEgASgJEg4KBnRhcmdldBgCIAEoCRIkCgdhZHZpY2VzGAMgAygLMhMuYnl0ZWJhc2UudjEuQWR2aWNlEhUKDWFmZmVjdGVkX3Jvd3MYBCABKAMSKgoKcmlza19sZXZlbBgFIAEoDjIWLmJ5dGViYXNlLnYxLlJpc2tMZXZlbCLEBQoHUmVsZWFzZRIRCgRuYW1lGAEgASgJQgPgQQMSFwoFdGl0bGUYAiABKAlCCLpIBXIDGMgBEigKBWZpbGVzGAMgAygLMhkuYnl0ZWJhc2UudjEuUmVsZWFzZS5GaWxlEjIKCnZjc19zb3VyY2UYBCABKAsyHi5ieXRlYmFzZS52MS5SZWxlYXNlLlZDU1NvdXJjZRIUCgdjcmVhdG9yGAUgASgJQgPgQQMSNAoLY3JlYXRlX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSJgoFc3RhdGUYByABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZUID4EEDEg4KBmRpZ2VzdBgIIAEoCRqmAgoERmlsZRIKCgJpZBgBIAEoCRIMCgRwYXRoGAIgASgJEiwKBHR5cGUYBSABKA4yHi5ieXRlYmFzZS52MS5SZWxlYXNlLkZpbGUuVHlwZRIPCgd2ZXJzaW9uGAYgASgJEhQKDGVuYWJsZV9naG9zdBgJIAEoCBImCgVzaGVldBgDIAEoCUIX+kEUChJieXRlYmFzZS5jb20vU2hlZXQSEQoJc3RhdGVtZW50GAcgASgMEhkKDHNoZWV0X3NoYTI1NhgEIAEoCUID4EEDEhsKDnN0YXRlbWVudF9zaXplGAggASgDQgPgQQMiPAoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASDQoJVkVSU0lPTkVEEAESDwoLREVDTEFSQVRJVkUQAhpACglWQ1NTb3VyY2USJgoIdmNzX3R5cGUYASABKA4yFC5ieXRlYmFzZS52MS5WQ1NUeXBlEgsKA3VybBgCIAEoCTpA6kE9ChRieXRlYmFzZS5jb20vUmVsZWFzZRIlcHJvamVjdHMve3Byb2plY3R9L3JlbGVhc2VzL3tyZWxlYXNlfTK4CgoOUmVsZWFzZVNlcnZpY2USigEKCkdldFJlbGVhc2USHi5ieXRlYmFzZS52MS5HZXRSZWxlYXNlUmVxdWVzdBoULmJ5dGViYXNlLnYxLlJlbGVhc2UiRtpBBG5hbWWK6jAPYmIucmVsZWFzZXMuZ2V0kOowAYLT5JMCIhIgL3YxL3tuYW1lPXByb2plY3RzLyovcmVsZWFzZXMvKn0SngEKDExpc3RSZWxlYXNlcxIgLmJ5dGViYXNlLnYxLkxpc3RSZWxlYXNlc1JlcXVlc3QaIS5ieXRlYmFzZS52MS5MaXN0UmVsZWFzZXNSZXNwb25zZSJJ2kEGcGFyZW50iuowEGJiLnJlbGVhc2VzLmxpc3SQ6jABgtPkkwIiEiAvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9yZWxlYXNlcxKqAQoOU2VhcmNoUmVsZWFzZXMSIi5ieXRlYmFzZS52MS5TZWFyY2hSZWxlYXNlc1JlcXVlc3QaIy5ieXRlYmFzZS52MS5TZWFyY2hSZWxlYXNlc1Jlc3BvbnNlIk/aQQZwYXJlbnSK6jAPYmIucmVsZWFzZXMuZ2V0kOowAYLT5JMCKRInL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcmVsZWFzZXM6c2VhcmNoEqYBCg1DcmVhdGVSZWxlYXNlEiEuYnl0ZWJhc2UudjEuQ3JlYXRlUmVsZWFzZVJlcXVlc3QaFC5ieXRlYmFzZS52MS5SZWxlYXNlIlzaQQ5wYXJlbnQscmVsZWFzZYrqMBJiYi5yZWxlYXNlcy5jcmVhdGWQ6jABgtPkkwIrOgdyZWxlYXNlIiAvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9yZWxlYXNlcxLJAQoNVXBkYXRlUmVsZWFzZRIhLmJ5dGViYXNlLnYxLlVwZGF0ZVJlbGVhc2VSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUmVsZWFzZSJ/2kETcmVsZWFzZSx1cGRhdGVfbWFza4rqMBJiYi5yZWxlYXNlcy51cGRhdGWQ6jABouowEmJiLnJlbGVhc2VzLmNyZWF0ZYLT5JMCMzoHcmVsZWFzZTIoL3YxL3tyZWxlYXNlLm5hbWU9cHJvamVjdHMvKi9yZWxlYXNlcy8qfRKVAQoNRGVsZXRlUmVsZWFzZRIhLmJ5dGViYXNlLnYxLkRlbGV0ZVJlbGVhc2VSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IknaQQRuYW1liuowEmJiLnJlbGVhc2VzLmRlbGV0ZZDqMAGC0+STAiIqIC92MS97bmFtZT1wcm9qZWN0cy8qL3JlbGVhc2VzLyp9EpsBCg9VbmRlbGV0ZVJlbGVhc2USIy5ieXRlYmFzZS52MS5VbmRlbGV0ZVJlbGVhc2VSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUmVsZWFzZSJNiuowFGJiLnJlbGVhc2VzLnVuZGVsZXRlkOowAYLT5JMCKyIpL3YxL3tuYW1lPXByb2plY3RzLyovcmVsZWFzZXMvKn06dW5kZWxldGUSnwEKDENoZWNrUmVsZWFzZRIgLmJ5dGViYXNlLnYxLkNoZWNrUmVsZWFzZVJlcXVlc3QaIS5ieXRlYmFzZS52MS5DaGVja1JlbGVhc2VSZXNwb25zZSJKiuowEWJiLnJlbGVhc2VzLmNoZWNrkOowAYLT5JMCKzoBKiImL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcmVsZWFzZXM6Y2hlY2tCqQEKD2NvbS5ieXRlYmFzZS52MUITUmVsZWFzZVNlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_sql_service]);
+  fileDesc("Chh2MS9yZWxlYXNlX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIj8KEUdldFJlbGVhc2VSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1JlbGVhc2UigAEKE0xpc3RSZWxlYXNlc1JlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEhQKDHNob3dfZGVsZXRlZBgEIAEoCCJXChRMaXN0UmVsZWFzZXNSZXNwb25zZRImCghyZWxlYXNlcxgBIAMoCzIULmJ5dGViYXNlLnYxLlJlbGVhc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIowBChVTZWFyY2hSZWxlYXNlc1JlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEhMKBmRpZ2VzdBgEIAEoCUgAiAEBQgkKB19kaWdlc3QiWQoWU2VhcmNoUmVsZWFzZXNSZXNwb25zZRImCghyZWxlYXNlcxgBIAMoCzIULmJ5dGViYXNlLnYxLlJlbGVhc2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInAKFENyZWF0ZVJlbGVhc2VSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIqCgdyZWxlYXNlGAIgASgLMhQuYnl0ZWJhc2UudjEuUmVsZWFzZUID4EECIooBChRVcGRhdGVSZWxlYXNlUmVxdWVzdBIqCgdyZWxlYXNlGAEgASgLMhQuYnl0ZWJhc2UudjEuUmVsZWFzZUID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1hbGxvd19taXNzaW5nGAMgASgIIkIKFERlbGV0ZVJlbGVhc2VSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1JlbGVhc2UiRAoWVW5kZWxldGVSZWxlYXNlUmVxdWVzdBIqCgRuYW1lGAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9SZWxlYXNlIqwBChNDaGVja1JlbGVhc2VSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIqCgdyZWxlYXNlGAIgASgLMhQuYnl0ZWJhc2UudjEuUmVsZWFzZUID4EECEg8KB3RhcmdldHMYAyADKAkSFAoMY3VzdG9tX3J1bGVzGAQgASgJEhQKDGNoZWNrX2Zvcm1hdBgFIAEoCCKwAgoUQ2hlY2tSZWxlYXNlUmVzcG9uc2USPgoHcmVzdWx0cxgBIAMoCzItLmJ5dGViYXNlLnYxLkNoZWNrUmVsZWFzZVJlc3BvbnNlLkNoZWNrUmVzdWx0EhUKDWFmZmVjdGVkX3Jvd3MYAiABKAMSKgoKcmlza19sZXZlbBgDIAEoDjIWLmJ5dGViYXNlLnYxLlJpc2tMZXZlbBqUAQoLQ2hlY2tSZXN1bHQSDAoEZmlsZRgBIAEoCRIOCgZ0YXJnZXQYAiABKAkSJAoHYWR2aWNlcxgDIAMoCzITLmJ5dGViYXNlLnYxLkFkdmljZRIVCg1hZmZlY3RlZF9yb3dzGAQgASgDEioKCnJpc2tfbGV2ZWwYBSABKA4yFi5ieXRlYmFzZS52MS5SaXNrTGV2ZWwixAUKB1JlbGVhc2USEQoEbmFtZRgBIAEoCUID4EEDEhcKBXRpdGxlGAIgASgJQgi6SAVyAxjIARIoCgVmaWxlcxgDIAMoCzIZLmJ5dGViYXNlLnYxLlJlbGVhc2UuRmlsZRIyCgp2Y3Nfc291cmNlGAQgASgLMh4uYnl0ZWJhc2UudjEuUmVsZWFzZS5WQ1NTb3VyY2USFAoHY3JlYXRvchgFIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEiYKBXN0YXRlGAcgASgOMhIuYnl0ZWJhc2UudjEuU3RhdGVCA+BBAxIOCgZkaWdlc3QYCCABKAkapgIKBEZpbGUSCgoCaWQYASABKAkSDAoEcGF0aBgCIAEoCRIsCgR0eXBlGAUgASgOMh4uYnl0ZWJhc2UudjEuUmVsZWFzZS5GaWxlLlR5cGUSDwoHdmVyc2lvbhgGIAEoCRIUCgxlbmFibGVfZ2hvc3QYCSABKAgSJgoFc2hlZXQYAyABKAlCF/pBFAoSYnl0ZWJhc2UuY29tL1NoZWV0EhEKCXN0YXRlbWVudBgHIAEoDBIZCgxzaGVldF9zaGEyNTYYBCABKAlCA+BBAxIbCg5zdGF0ZW1lbnRfc2l6ZRgIIAEoA0ID4EEDIjwKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEg0KCVZFUlNJT05FRBABEg8KC0RFQ0xBUkFUSVZFEAIaQAoJVkNTU291cmNlEiYKCHZjc190eXBlGAEgASgOMhQuYnl0ZWJhc2UudjEuVkNTVHlwZRILCgN1cmwYAiABKAk6QOpBPQoUYnl0ZWJhc2UuY29tL1JlbGVhc2USJXByb2plY3RzL3twcm9qZWN0fS9yZWxlYXNlcy97cmVsZWFzZX0yuAoKDlJlbGVhc2VTZXJ2aWNlEooBCgpHZXRSZWxlYXNlEh4uYnl0ZWJhc2UudjEuR2V0UmVsZWFzZVJlcXVlc3QaFC5ieXRlYmFzZS52MS5SZWxlYXNlIkbaQQRuYW1liuowD2JiLnJlbGVhc2VzLmdldJDqMAGC0+STAiISIC92MS97bmFtZT1wcm9qZWN0cy8qL3JlbGVhc2VzLyp9Ep4BCgxMaXN0UmVsZWFzZXMSIC5ieXRlYmFzZS52MS5MaXN0UmVsZWFzZXNSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuTGlzdFJlbGVhc2VzUmVzcG9uc2UiSdpBBnBhcmVudIrqMBBiYi5yZWxlYXNlcy5saXN0kOowAYLT5JMCIhIgL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcmVsZWFzZXMSqgEKDlNlYXJjaFJlbGVhc2VzEiIuYnl0ZWJhc2UudjEuU2VhcmNoUmVsZWFzZXNSZXF1ZXN0GiMuYnl0ZWJhc2UudjEuU2VhcmNoUmVsZWFzZXNSZXNwb25zZSJP2kEGcGFyZW50iuowD2JiLnJlbGVhc2VzLmdldJDqMAGC0+STAikSJy92MS97cGFyZW50PXByb2plY3RzLyp9L3JlbGVhc2VzOnNlYXJjaBKmAQoNQ3JlYXRlUmVsZWFzZRIhLmJ5dGViYXNlLnYxLkNyZWF0ZVJlbGVhc2VSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUmVsZWFzZSJc2kEOcGFyZW50LHJlbGVhc2WK6jASYmIucmVsZWFzZXMuY3JlYXRlkOowAYLT5JMCKzoHcmVsZWFzZSIgL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcmVsZWFzZXMSyQEKDVVwZGF0ZVJlbGVhc2USIS5ieXRlYmFzZS52MS5VcGRhdGVSZWxlYXNlUmVxdWVzdBoULmJ5dGViYXNlLnYxLlJlbGVhc2Uif9pBE3JlbGVhc2UsdXBkYXRlX21hc2uK6jASYmIucmVsZWFzZXMudXBkYXRlkOowAaLqMBJiYi5yZWxlYXNlcy5jcmVhdGWC0+STAjM6B3JlbGVhc2UyKC92MS97cmVsZWFzZS5uYW1lPXByb2plY3RzLyovcmVsZWFzZXMvKn0SlQEKDURlbGV0ZVJlbGVhc2USIS5ieXRlYmFzZS52MS5EZWxldGVSZWxlYXNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSJJ2kEEbmFtZYrqMBJiYi5yZWxlYXNlcy5kZWxldGWQ6jABgtPkkwIiKiAvdjEve25hbWU9cHJvamVjdHMvKi9yZWxlYXNlcy8qfRKbAQoPVW5kZWxldGVSZWxlYXNlEiMuYnl0ZWJhc2UudjEuVW5kZWxldGVSZWxlYXNlUmVxdWVzdBoULmJ5dGViYXNlLnYxLlJlbGVhc2UiTYrqMBRiYi5yZWxlYXNlcy51bmRlbGV0ZZDqMAGC0+STAisiKS92MS97bmFtZT1wcm9qZWN0cy8qL3JlbGVhc2VzLyp9OnVuZGVsZXRlEp8BCgxDaGVja1JlbGVhc2USIC5ieXRlYmFzZS52MS5DaGVja1JlbGVhc2VSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuQ2hlY2tSZWxlYXNlUmVzcG9uc2UiSorqMBFiYi5yZWxlYXNlcy5jaGVja5DqMAGC0+STAis6ASoiJi92MS97cGFyZW50PXByb2plY3RzLyp9L3JlbGVhc2VzOmNoZWNrQqkBCg9jb20uYnl0ZWJhc2UudjFCE1JlbGVhc2VTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_sql_service]);
 
 /**
  * Describes the message bytebase.v1.GetReleaseRequest.
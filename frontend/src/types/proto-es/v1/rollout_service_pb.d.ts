@@ -510,6 +510,21 @@ export declare type Stage = Message<"bytebase.v1.Stage"> & {
    * @generated from field: repeated bytebase.v1.Task tasks = 5;
    */
   tasks: Task[];
+
+  /**
+   * The next time the rollout window of the environment opens.
+   * Unset if the environment has no rollout window or the window is open.
+   *
+   * @generated from field: google.protobuf.Timestamp next_window_open_time = 6;
+   */
+  nextWindowOpenTime?: Timestamp;
+
+  /**
+   * Whether the stage is halted because the failed tasks reached the failure threshold of the rollout strategy.
+   *
+   * @generated from field: bool halted = 7;
+   */
+  halted: boolean;
 };
 
 /**
@@ -1139,6 +1154,22 @@ export declare type TaskRun_SchedulerInfo_WaitingCause = Message<"bytebase.v1.Ta
      */
     value: boolean;
     case: "parallelTasksLimit";
+  } | {
+    /**
+     * Waiting for the rollout window to open at the time.
+     *
+     * @generated from field: google.protobuf.Timestamp rollout_window = 4;
+     */
+    value: Timestamp;
+    case: "rolloutWindow";
+  } | {
+    /**
+     * Waiting for the previous batches of the rollout strategy to finish.
+     *
+     * @generated from field: bool rollout_batch = 5;
+     */
+    value: boolean;
+    case: "rolloutBatch";
   } | { case: undefined; value?: undefined };
 };
 
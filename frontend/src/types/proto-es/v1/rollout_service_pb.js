@@ -17,7 +17,7 @@ import { file_v1_plan_service } from "./plan_service_pb";
  * Describes the file v1/rollout_service.proto.
  */
 export const file_v1_rollout_service = /*@__PURE__*/
-  fileDesc("Chh2MS9yb2xsb3V0X3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIo8BChRCYXRjaFJ1blRhc2tzUmVxdWVzdBIOCgZwYXJlbnQYASABKAkSDQoFdGFza3MYAiADKAkSGAoGcmVhc29uGAMgASgJQgi6SAVyAxjoBxIxCghydW5fdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBAUILCglfcnVuX3RpbWUiFwoVQmF0Y2hSdW5UYXNrc1Jlc3BvbnNlIlAKFUJhdGNoU2tpcFRhc2tzUmVxdWVzdBIOCgZwYXJlbnQYASABKAkSDQoFdGFza3MYAiADKAkSGAoGcmVhc29uGAMgASgJQgi6SAVyAxjoByIYChZCYXRjaFNraXBUYXNrc1Jlc3BvbnNlIlkKGkJhdGNoQ2FuY2VsVGFza1J1bnNSZXF1ZXN0Eg4KBnBhcmVudBgBIAEoCRIRCgl0YXNrX3J1bnMYAiADKAkSGAoGcmVhc29uGAMgASgJQgi6SAVyAxjoByIdChtCYXRjaENhbmNlbFRhc2tSdW5zUmVzcG9uc2UiPwoRR2V0Um9sbG91dFJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUm9sbG91dCJ6ChNMaXN0Um9sbG91dHNSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCRIOCgZmaWx0ZXIYBCABKAkiVwoUTGlzdFJvbGxvdXRzUmVzcG9uc2USJgoIcm9sbG91dHMYASADKAsyFC5ieXRlYmFzZS52MS5Sb2xsb3V0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKnAQoUQ3JlYXRlUm9sbG91dFJlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EioKB3JvbGxvdXQYAiABKAsyFC5ieXRlYmFzZS52MS5Sb2xsb3V0QgPgQQISEwoGdGFyZ2V0GAMgASgJSACIAQESFQoNdmFsaWRhdGVfb25seRgEIAEoCEIJCgdfdGFyZ2V0ImcKFVByZXZpZXdSb2xsb3V0UmVxdWVzdBItCgdwcm9qZWN0GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0Eh8KBHBsYW4YAiABKAsyES5ieXRlYmFzZS52MS5QbGFuIkAKE0xpc3RUYXNrUnVuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9UYXNrIj8KFExpc3RUYXNrUnVuc1Jlc3BvbnNlEicKCXRhc2tfcnVucxgBIAMoCzIULmJ5dGViYXNlLnYxLlRhc2tSdW4iPwoRR2V0VGFza1J1blJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vVGFza1J1biJEChRHZXRUYXNrUnVuTG9nUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Rhc2tSdW4iwAIKB1JvbGxvdXQSDAoEbmFtZRgBIAEoCRIRCgRwbGFuGAMgASgJQgPgQQISEgoFdGl0bGUYBCABKAlCA+BBAxIiCgZzdGFnZXMYBSADKAsyEi5ieXRlYmFzZS52MS5TdGFnZRIUCgdjcmVhdG9yGAYgASgJQgPgQQMSNAoLY3JlYXRlX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEgoFaXNzdWUYCSABKAlCA+BBAzpA6kE9ChRieXRlYmFzZS5jb20vUm9sbG91dBIlcHJvamVjdHMve3Byb2plY3R9L3JvbGxvdXRzL3tyb2xsb3V0fUoECAIQAyKyAQoFU3RhZ2USDAoEbmFtZRgBIAEoCRIPCgJpZBgDIAEoCUID4EEDEhMKC2Vudmlyb25tZW50GAQgASgJEiAKBXRhc2tzGAUgAygLMhEuYnl0ZWJhc2UudjEuVGFzazpN6kFKChJieXRlYmFzZS5jb20vU3RhZ2USNHByb2plY3RzL3twcm9qZWN0fS9yb2xsb3V0cy97cm9sbG91dH0vc3RhZ2VzL3tzdGFnZX1KBAgCEAMi5AkKBFRhc2sSDAoEbmFtZRgBIAEoCRIPCgdzcGVjX2lkGAQgASgJEigKBnN0YXR1cxgFIAEoDjIYLmJ5dGViYXNlLnYxLlRhc2suU3RhdHVzEhYKDnNraXBwZWRfcmVhc29uGA8gASgJEiQKBHR5cGUYBiABKA4yFi5ieXRlYmFzZS52MS5UYXNrLlR5cGUSDgoGdGFyZ2V0GAggASgJEjsKD2RhdGFiYXNlX2NyZWF0ZRgJIAEoCzIgLmJ5dGViYXNlLnYxLlRhc2suRGF0YWJhc2VDcmVhdGVIABI7Cg9kYXRhYmFzZV91cGRhdGUYCyABKAsyIC5ieXRlYmFzZS52MS5UYXNrLkRhdGFiYXNlVXBkYXRlSAASRAoUZGF0YWJhc2VfZGF0YV9leHBvcnQYECABKAsyJC5ieXRlYmFzZS52MS5UYXNrLkRhdGFiYXNlRGF0YUV4cG9ydEgAEjkKC3VwZGF0ZV90aW1lGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDSAGIAQESNgoIcnVuX3RpbWUYFSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQNIAogBARqQAQoORGF0YWJhc2VDcmVhdGUSDwoHcHJvamVjdBgBIAEoCRIQCghkYXRhYmFzZRgCIAEoCRINCgV0YWJsZRgDIAEoCRINCgVzaGVldBgEIAEoCRIVCg1jaGFyYWN0ZXJfc2V0GAUgASgJEhEKCWNvbGxhdGlvbhgGIAEoCRITCgtlbnZpcm9ubWVudBgHIAEoCRp2Cg5EYXRhYmFzZVVwZGF0ZRINCgVzaGVldBgBIAEoCRIWCg5zY2hlbWFfdmVyc2lvbhgCIAEoCRI9ChRkYXRhYmFzZV9jaGFuZ2VfdHlwZRgDIAEoDjIfLmJ5dGViYXNlLnYxLkRhdGFiYXNlQ2hhbmdlVHlwZRqCAQoSRGF0YWJhc2VEYXRhRXhwb3J0Eg4KBnRhcmdldBgBIAEoCRINCgVzaGVldBgCIAEoCRIpCgZmb3JtYXQYAyABKA4yGS5ieXRlYmFzZS52MS5FeHBvcnRGb3JtYXQSFQoIcGFzc3dvcmQYBCABKAlIAIgBAUILCglfcGFzc3dvcmQifAoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEg8KC05PVF9TVEFSVEVEEAESCwoHUEVORElORxACEgsKB1JVTk5JTkcQAxIICgRET05FEAQSCgoGRkFJTEVEEAUSDAoIQ0FOQ0VMRUQQBhILCgdTS0lQUEVEEAciewoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCwoHR0VORVJBTBABEhMKD0RBVEFCQVNFX0NSRUFURRACEhQKEERBVEFCQVNFX01JR1JBVEUQAxIQCgxEQVRBQkFTRV9TREwQBhITCg9EQVRBQkFTRV9FWFBPUlQQBTpZ6kFWChFieXRlYmFzZS5jb20vVGFzaxJBcHJvamVjdHMve3Byb2plY3R9L3JvbGxvdXRzL3tyb2xsb3V0fS9zdGFnZXMve3N0YWdlfS90YXNrcy97dGFza31CCQoHcGF5bG9hZEIOCgxfdXBkYXRlX3RpbWVCCwoJX3J1bl90aW1lSgQIAhADIucMCgdUYXNrUnVuEgwKBG5hbWUYASABKAkSDwoHY3JlYXRvchgDIAEoCRI0CgtjcmVhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIrCgZzdGF0dXMYCCABKA4yGy5ieXRlYmFzZS52MS5UYXNrUnVuLlN0YXR1cxIOCgZkZXRhaWwYCSABKAkSFgoJY2hhbmdlbG9nGBQgASgJQgPgQQMSFgoOc2NoZW1hX3ZlcnNpb24YCyABKAkSMwoKc3RhcnRfdGltZRgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxJHChVleHBvcnRfYXJjaGl2ZV9zdGF0dXMYECABKA4yKC5ieXRlYmFzZS52MS5UYXNrUnVuLkV4cG9ydEFyY2hpdmVTdGF0dXMSQwoTcHJpb3JfYmFja3VwX2RldGFpbBgRIAEoCzImLmJ5dGViYXNlLnYxLlRhc2tSdW4uUHJpb3JCYWNrdXBEZXRhaWwSPwoOc2NoZWR1bGVyX2luZm8YEiABKAsyIi5ieXRlYmFzZS52MS5UYXNrUnVuLlNjaGVkdWxlckluZm9CA+BBAxISCgVzaGVldBgTIAEoCUID4EEDEjYKCHJ1bl90aW1lGBUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDSACIAQEagAMKEVByaW9yQmFja3VwRGV0YWlsEjoKBWl0ZW1zGAEgAygLMisuYnl0ZWJhc2UudjEuVGFza1J1bi5QcmlvckJhY2t1cERldGFpbC5JdGVtGq4CCgRJdGVtEkcKDHNvdXJjZV90YWJsZRgBIAEoCzIxLmJ5dGViYXNlLnYxLlRhc2tSdW4uUHJpb3JCYWNrdXBEZXRhaWwuSXRlbS5UYWJsZRJHCgx0YXJnZXRfdGFibGUYAiABKAsyMS5ieXRlYmFzZS52MS5UYXNrUnVuLlByaW9yQmFja3VwRGV0YWlsLkl0ZW0uVGFibGUSLQoOc3RhcnRfcG9zaXRpb24YAyABKAsyFS5ieXRlYmFzZS52MS5Qb3NpdGlvbhIrCgxlbmRfcG9zaXRpb24YBCABKAsyFS5ieXRlYmFzZS52MS5Qb3NpdGlvbho4CgVUYWJsZRIQCghkYXRhYmFzZRgBIAEoCRIOCgZzY2hlbWEYAiABKAkSDQoFdGFibGUYAyABKAkayQIKDVNjaGVkdWxlckluZm8SLwoLcmVwb3J0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEkYKDXdhaXRpbmdfY2F1c2UYAiABKAsyLy5ieXRlYmFzZS52MS5UYXNrUnVuLlNjaGVkdWxlckluZm8uV2FpdGluZ0NhdXNlGr4BCgxXYWl0aW5nQ2F1c2USGgoQY29ubmVjdGlvbl9saW1pdBgBIAEoCEgAEkQKBHRhc2sYAiABKAsyNC5ieXRlYmFzZS52MS5UYXNrUnVuLlNjaGVkdWxlckluZm8uV2FpdGluZ0NhdXNlLlRhc2tIABIeChRwYXJhbGxlbF90YXNrc19saW1pdBgDIAEoCEgAGiMKBFRhc2sSDAoEdGFzaxgBIAEoCRINCgVpc3N1ZRgCIAEoCUIHCgVjYXVzZSJeCgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEgsKB1JVTk5JTkcQAhIICgRET05FEAMSCgoGRkFJTEVEEAQSDAoIQ0FOQ0VMRUQQBSJVChNFeHBvcnRBcmNoaXZlU3RhdHVzEiUKIUVYUE9SVF9BUkNISVZFX1NUQVRVU19VTlNQRUNJRklFRBAAEgkKBVJFQURZEAESDAoIRVhQT1JURUQQAjpv6kFsChRieXRlYmFzZS5jb20vVGFza1J1bhJUcHJvamVjdHMve3Byb2plY3R9L3JvbGxvdXRzL3tyb2xsb3V0fS9zdGFnZXMve3N0YWdlfS90YXNrcy97dGFza30vdGFza1J1bnMve3Rhc2tSdW59QgsKCV9ydW5fdGltZUoECAIQA0oECAwQDUoECA8QECLBAQoKVGFza1J1bkxvZxIMCgRuYW1lGAEgASgJEi0KB2VudHJpZXMYAiADKAsyHC5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnk6dupBcwoXYnl0ZWJhc2UuY29tL1Rhc2tSdW5Mb2cSWHByb2plY3RzL3twcm9qZWN0fS9yb2xsb3V0cy97cm9sbG91dH0vc3RhZ2VzL3tzdGFnZX0vdGFza3Mve3Rhc2t9L3Rhc2tSdW5zL3t0YXNrUnVufS9sb2ci/xAKD1Rhc2tSdW5Mb2dFbnRyeRIvCgR0eXBlGAEgASgOMiEuYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LlR5cGUSLAoIbG9nX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWRlcGxveV9pZBgMIAEoCRI8CgtzY2hlbWFfZHVtcBgCIAEoCzInLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5TY2hlbWFEdW1wEkQKD2NvbW1hbmRfZXhlY3V0ZRgDIAEoCzIrLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5Db21tYW5kRXhlY3V0ZRJACg1kYXRhYmFzZV9zeW5jGAQgASgLMikuYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LkRhdGFiYXNlU3luYxJQChZ0YXNrX3J1bl9zdGF0dXNfdXBkYXRlGAUgASgLMjAuYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LlRhc2tSdW5TdGF0dXNVcGRhdGUSTAoTdHJhbnNhY3Rpb25fY29udHJvbBgHIAEoCzIvLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5UcmFuc2FjdGlvbkNvbnRyb2wSPgoMcHJpb3JfYmFja3VwGAggASgLMiguYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LlByaW9yQmFja3VwEjoKCnJldHJ5X2luZm8YCSABKAsyJi5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuUmV0cnlJbmZvEj4KDGNvbXB1dGVfZGlmZhgKIAEoCzIoLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5Db21wdXRlRGlmZhp5CgpTY2hlbWFEdW1wEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVlcnJvchgDIAEoCRq8AgoOQ29tbWFuZEV4ZWN1dGUSLAoIbG9nX3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD2NvbW1hbmRfaW5kZXhlcxgCIAMoBRIRCglzdGF0ZW1lbnQYBCABKAkSTQoIcmVzcG9uc2UYAyABKAsyOy5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuQ29tbWFuZEV4ZWN1dGUuQ29tbWFuZFJlc3BvbnNlGoABCg9Db21tYW5kUmVzcG9uc2USLAoIbG9nX3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWVycm9yGAIgASgJEhUKDWFmZmVjdGVkX3Jvd3MYAyABKAMSGQoRYWxsX2FmZmVjdGVkX3Jvd3MYBCADKAMaewoMRGF0YWJhc2VTeW5jEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVlcnJvchgDIAEoCRqqAQoTVGFza1J1blN0YXR1c1VwZGF0ZRJHCgZzdGF0dXMYASABKA4yNy5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuVGFza1J1blN0YXR1c1VwZGF0ZS5TdGF0dXMiSgoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEhMKD1JVTk5JTkdfV0FJVElORxABEhMKD1JVTk5JTkdfUlVOTklORxACGqoBChJUcmFuc2FjdGlvbkNvbnRyb2wSQgoEdHlwZRgBIAEoDjI0LmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5UcmFuc2FjdGlvbkNvbnRyb2wuVHlwZRINCgVlcnJvchgCIAEoCSJBCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVCRUdJThABEgoKBkNPTU1JVBACEgwKCFJPTExCQUNLEAMavwEKC1ByaW9yQmFja3VwEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBJDChNwcmlvcl9iYWNrdXBfZGV0YWlsGAMgASgLMiYuYnl0ZWJhc2UudjEuVGFza1J1bi5QcmlvckJhY2t1cERldGFpbBINCgVlcnJvchgEIAEoCRpICglSZXRyeUluZm8SDQoFZXJyb3IYASABKAkSEwoLcmV0cnlfY291bnQYAiABKAUSFwoPbWF4aW11bV9yZXRyaWVzGAMgASgFGnoKC0NvbXB1dGVEaWZmEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVlcnJvchgDIAEoCSK+AQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASDwoLU0NIRU1BX0RVTVAQARITCg9DT01NQU5EX0VYRUNVVEUQAhIRCg1EQVRBQkFTRV9TWU5DEAMSGgoWVEFTS19SVU5fU1RBVFVTX1VQREFURRAEEhcKE1RSQU5TQUNUSU9OX0NPTlRST0wQBRIQCgxQUklPUl9CQUNLVVAQBhIOCgpSRVRSWV9JTkZPEAcSEAoMQ09NUFVURV9ESUZGEAgiSAoYR2V0VGFza1J1blNlc3Npb25SZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vVGFza1J1biLoBwoOVGFza1J1blNlc3Npb24SDAoEbmFtZRgBIAEoCRI4Cghwb3N0Z3JlcxgCIAEoCzIkLmJ5dGViYXNlLnYxLlRhc2tSdW5TZXNzaW9uLlBvc3RncmVzSAAaggYKCFBvc3RncmVzEj0KB3Nlc3Npb24YASABKAsyLC5ieXRlYmFzZS52MS5UYXNrUnVuU2Vzc2lvbi5Qb3N0Z3Jlcy5TZXNzaW9uEkcKEWJsb2NraW5nX3Nlc3Npb25zGAIgAygLMiwuYnl0ZWJhc2UudjEuVGFza1J1blNlc3Npb24uUG9zdGdyZXMuU2Vzc2lvbhJGChBibG9ja2VkX3Nlc3Npb25zGAMgAygLMiwuYnl0ZWJhc2UudjEuVGFza1J1blNlc3Npb24uUG9zdGdyZXMuU2Vzc2lvbhqlBAoHU2Vzc2lvbhILCgNwaWQYASABKAkSFwoPYmxvY2tlZF9ieV9waWRzGAIgAygJEg0KBXF1ZXJ5GAMgASgJEhIKBXN0YXRlGAQgASgJSACIAQESHAoPd2FpdF9ldmVudF90eXBlGAUgASgJSAGIAQESFwoKd2FpdF9ldmVudBgGIAEoCUgCiAEBEhQKB2RhdG5hbWUYByABKAlIA4gBARIUCgd1c2VuYW1lGAggASgJSASIAQESGAoQYXBwbGljYXRpb25fbmFtZRgJIAEoCRIYCgtjbGllbnRfYWRkchgKIAEoCUgFiAEBEhgKC2NsaWVudF9wb3J0GAsgASgJSAaIAQESMQoNYmFja2VuZF9zdGFydBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoKeGFjdF9zdGFydBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIB4gBARI0CgtxdWVyeV9zdGFydBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBICIgBAUIICgZfc3RhdGVCEgoQX3dhaXRfZXZlbnRfdHlwZUINCgtfd2FpdF9ldmVudEIKCghfZGF0bmFtZUIKCghfdXNlbmFtZUIOCgxfY2xpZW50X2FkZHJCDgoMX2NsaWVudF9wb3J0Qg0KC194YWN0X3N0YXJ0Qg4KDF9xdWVyeV9zdGFydDp+6kF7ChtieXRlYmFzZS5jb20vVGFza1J1blNlc3Npb24SXHByb2plY3RzL3twcm9qZWN0fS9yb2xsb3V0cy97cm9sbG91dH0vc3RhZ2VzL3tzdGFnZX0vdGFza3Mve3Rhc2t9L3Rhc2tSdW5zL3t0YXNrUnVufS9zZXNzaW9uQgkKB3Nlc3Npb24iSwodUHJldmlld1Rhc2tSdW5Sb2xsYmFja1JlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vVGFza1J1biIzCh5QcmV2aWV3VGFza1J1blJvbGxiYWNrUmVzcG9uc2USEQoJc3RhdGVtZW50GAEgASgJMpIRCg5Sb2xsb3V0U2VydmljZRKKAQoKR2V0Um9sbG91dBIeLmJ5dGViYXNlLnYxLkdldFJvbGxvdXRSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUm9sbG91dCJG2kEEbmFtZYrqMA9iYi5yb2xsb3V0cy5nZXSQ6jABgtPkkwIiEiAvdjEve25hbWU9cHJvamVjdHMvKi9yb2xsb3V0cy8qfRKeAQoMTGlzdFJvbGxvdXRzEiAuYnl0ZWJhc2UudjEuTGlzdFJvbGxvdXRzUmVxdWVzdBohLmJ5dGViYXNlLnYxLkxpc3RSb2xsb3V0c1Jlc3BvbnNlIknaQQZwYXJlbnSK6jAQYmIucm9sbG91dHMubGlzdJDqMAGC0+STAiISIC92MS97cGFyZW50PXByb2plY3RzLyp9L3JvbGxvdXRzEqoBCg1DcmVhdGVSb2xsb3V0EiEuYnl0ZWJhc2UudjEuQ3JlYXRlUm9sbG91dFJlcXVlc3QaFC5ieXRlYmFzZS52MS5Sb2xsb3V0ImDaQQ5wYXJlbnQscm9sbG91dIrqMBJiYi5yb2xsb3V0cy5jcmVhdGWQ6jABmOowAYLT5JMCKzoHcm9sbG91dCIgL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcm9sbG91dHMSoAEKDlByZXZpZXdSb2xsb3V0EiIuYnl0ZWJhc2UudjEuUHJldmlld1JvbGxvdXRSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUm9sbG91dCJU2kEEbmFtZYrqMBNiYi5yb2xsb3V0cy5wcmV2aWV3kOowAYLT5JMCLDoBKiInL3YxL3twcm9qZWN0PXByb2plY3RzLyp9OnByZXZpZXdSb2xsb3V0EroBCgxMaXN0VGFza1J1bnMSIC5ieXRlYmFzZS52MS5MaXN0VGFza1J1bnNSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuTGlzdFRhc2tSdW5zUmVzcG9uc2UiZdpBBnBhcmVudIrqMBBiYi50YXNrUnVucy5saXN0kOowAYLT5JMCPhI8L3YxL3twYXJlbnQ9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qL3Rhc2tzLyp9L3Rhc2tSdW5zEqcBCgpHZXRUYXNrUnVuEh4uYnl0ZWJhc2UudjEuR2V0VGFza1J1blJlcXVlc3QaFC5ieXRlYmFzZS52MS5UYXNrUnVuImPaQQRuYW1liuowEGJiLnRhc2tSdW5zLmxpc3SQ6jABgtPkkwI+EjwvdjEve25hbWU9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qL3Rhc2tzLyovdGFza1J1bnMvKn0SuAEKDUdldFRhc2tSdW5Mb2cSIS5ieXRlYmFzZS52MS5HZXRUYXNrUnVuTG9nUmVxdWVzdBoXLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2cia9pBBnBhcmVudIrqMBBiYi50YXNrUnVucy5saXN0kOowAYLT5JMCRBJCL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qL3Rhc2tzLyovdGFza1J1bnMvKn0vbG9nEsgBChFHZXRUYXNrUnVuU2Vzc2lvbhIlLmJ5dGViYXNlLnYxLkdldFRhc2tSdW5TZXNzaW9uUmVxdWVzdBobLmJ5dGViYXNlLnYxLlRhc2tSdW5TZXNzaW9uIm/aQQZwYXJlbnSK6jAQYmIudGFza1J1bnMubGlzdJDqMAGC0+STAkgSRi92MS97cGFyZW50PXByb2plY3RzLyovcm9sbG91dHMvKi9zdGFnZXMvKi90YXNrcy8qL3Rhc2tSdW5zLyp9L3Nlc3Npb24SqgEKDUJhdGNoUnVuVGFza3MSIS5ieXRlYmFzZS52MS5CYXRjaFJ1blRhc2tzUmVxdWVzdBoiLmJ5dGViYXNlLnYxLkJhdGNoUnVuVGFza3NSZXNwb25zZSJS2kEGcGFyZW50kOowAoLT5JMCPzoBKiI6L3YxL3twYXJlbnQ9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qfS90YXNrczpiYXRjaFJ1bhKuAQoOQmF0Y2hTa2lwVGFza3MSIi5ieXRlYmFzZS52MS5CYXRjaFNraXBUYXNrc1JlcXVlc3QaIy5ieXRlYmFzZS52MS5CYXRjaFNraXBUYXNrc1Jlc3BvbnNlIlPaQQZwYXJlbnSQ6jACgtPkkwJAOgEqIjsvdjEve3BhcmVudD1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyp9L3Rhc2tzOmJhdGNoU2tpcBLKAQoTQmF0Y2hDYW5jZWxUYXNrUnVucxInLmJ5dGViYXNlLnYxLkJhdGNoQ2FuY2VsVGFza1J1bnNSZXF1ZXN0GiguYnl0ZWJhc2UudjEuQmF0Y2hDYW5jZWxUYXNrUnVuc1Jlc3BvbnNlImDaQQZwYXJlbnSQ6jACgtPkkwJNOgEqIkgvdjEve3BhcmVudD1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyovdGFza3MvKn0vdGFza1J1bnM6YmF0Y2hDYW5jZWwS6QEKFlByZXZpZXdUYXNrUnVuUm9sbGJhY2sSKi5ieXRlYmFzZS52MS5QcmV2aWV3VGFza1J1blJvbGxiYWNrUmVxdWVzdBorLmJ5dGViYXNlLnYxLlByZXZpZXdUYXNrUnVuUm9sbGJhY2tSZXNwb25zZSJ22kEEbmFtZYrqMBBiYi50YXNrUnVucy5saXN0kOowAYLT5JMCUToBKiJML3YxL3tuYW1lPXByb2plY3RzLyovcm9sbG91dHMvKi9zdGFnZXMvKi90YXNrcy8qL3Rhc2tSdW5zLyp9OnByZXZpZXdSb2xsYmFja0KpAQoPY29tLmJ5dGViYXNlLnYxQhNSb2xsb3V0U2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_plan_service]);
+  fileDesc("Chh2MS9yb2xsb3V0X3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIo8BChRCYXRjaFJ1blRhc2tzUmVxdWVzdBIOCgZwYXJlbnQYASABKAkSDQoFdGFza3MYAiADKAkSGAoGcmVhc29uGAMgASgJQgi6SAVyAxjoBxIxCghydW5fdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBAUILCglfcnVuX3RpbWUiFwoVQmF0Y2hSdW5UYXNrc1Jlc3BvbnNlIlAKFUJhdGNoU2tpcFRhc2tzUmVxdWVzdBIOCgZwYXJlbnQYASABKAkSDQoFdGFza3MYAiADKAkSGAoGcmVhc29uGAMgASgJQgi6SAVyAxjoByIYChZCYXRjaFNraXBUYXNrc1Jlc3BvbnNlIlkKGkJhdGNoQ2FuY2VsVGFza1J1bnNSZXF1ZXN0Eg4KBnBhcmVudBgBIAEoCRIRCgl0YXNrX3J1bnMYAiADKAkSGAoGcmVhc29uGAMgASgJQgi6SAVyAxjoByIdChtCYXRjaENhbmNlbFRhc2tSdW5zUmVzcG9uc2UiPwoRR2V0Um9sbG91dFJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUm9sbG91dCJ6ChNMaXN0Um9sbG91dHNSZXF1ZXN0EiwKBnBhcmVudBgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCRIOCgZmaWx0ZXIYBCABKAkiVwoUTGlzdFJvbGxvdXRzUmVzcG9uc2USJgoIcm9sbG91dHMYASADKAsyFC5ieXRlYmFzZS52MS5Sb2xsb3V0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKnAQoUQ3JlYXRlUm9sbG91dFJlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EioKB3JvbGxvdXQYAiABKAsyFC5ieXRlYmFzZS52MS5Sb2xsb3V0QgPgQQISEwoGdGFyZ2V0GAMgASgJSACIAQESFQoNdmFsaWRhdGVfb25seRgEIAEoCEIJCgdfdGFyZ2V0ImcKFVByZXZpZXdSb2xsb3V0UmVxdWVzdBItCgdwcm9qZWN0GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0Eh8KBHBsYW4YAiABKAsyES5ieXRlYmFzZS52MS5QbGFuIkAKE0xpc3RUYXNrUnVuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9UYXNrIj8KFExpc3RUYXNrUnVuc1Jlc3BvbnNlEicKCXRhc2tfcnVucxgBIAMoCzIULmJ5dGViYXNlLnYxLlRhc2tSdW4iPwoRR2V0VGFza1J1blJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vVGFza1J1biJEChRHZXRUYXNrUnVuTG9nUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Rhc2tSdW4iwAIKB1JvbGxvdXQSDAoEbmFtZRgBIAEoCRIRCgRwbGFuGAMgASgJQgPgQQISEgoFdGl0bGUYBCABKAlCA+BBAxIiCgZzdGFnZXMYBSADKAsyEi5ieXRlYmFzZS52MS5TdGFnZRIUCgdjcmVhdG9yGAYgASgJQgPgQQMSNAoLY3JlYXRlX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEgoFaXNzdWUYCSABKAlCA+BBAzpA6kE9ChRieXRlYmFzZS5jb20vUm9sbG91dBIlcHJvamVjdHMve3Byb2plY3R9L3JvbGxvdXRzL3tyb2xsb3V0fUoECAIQAyKHAgoFU3RhZ2USDAoEbmFtZRgBIAEoCRIPCgJpZBgDIAEoCUID4EEDEhMKC2Vudmlyb25tZW50GAQgASgJEiAKBXRhc2tzGAUgAygLMhEuYnl0ZWJhc2UudjEuVGFzaxI+ChVuZXh0X3dpbmRvd19vcGVuX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEwoGaGFsdGVkGAcgASgIQgPgQQM6TepBSgoSYnl0ZWJhc2UuY29tL1N0YWdlEjRwcm9qZWN0cy97cHJvamVjdH0vcm9sbG91dHMve3JvbGxvdXR9L3N0YWdlcy97c3RhZ2V9SgQIAhADIuQJCgRUYXNrEgwKBG5hbWUYASABKAkSDwoHc3BlY19pZBgEIAEoCRIoCgZzdGF0dXMYBSABKA4yGC5ieXRlYmFzZS52MS5UYXNrLlN0YXR1cxIWCg5za2lwcGVkX3JlYXNvbhgPIAEoCRIkCgR0eXBlGAYgASgOMhYuYnl0ZWJhc2UudjEuVGFzay5UeXBlEg4KBnRhcmdldBgIIAEoCRI7Cg9kYXRhYmFzZV9jcmVhdGUYCSABKAsyIC5ieXRlYmFzZS52MS5UYXNrLkRhdGFiYXNlQ3JlYXRlSAASOwoPZGF0YWJhc2VfdXBkYXRlGAsgASgLMiAuYnl0ZWJhc2UudjEuVGFzay5EYXRhYmFzZVVwZGF0ZUgAEkQKFGRhdGFiYXNlX2RhdGFfZXhwb3J0GBAgASgLMiQuYnl0ZWJhc2UudjEuVGFzay5EYXRhYmFzZURhdGFFeHBvcnRIABI5Cgt1cGRhdGVfdGltZRgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBA0gBiAEBEjYKCHJ1bl90aW1lGBUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDSAKIAQEakAEKDkRhdGFiYXNlQ3JlYXRlEg8KB3Byb2plY3QYASABKAkSEAoIZGF0YWJhc2UYAiABKAkSDQoFdGFibGUYAyABKAkSDQoFc2hlZXQYBCABKAkSFQoNY2hhcmFjdGVyX3NldBgFIAEoCRIRCgljb2xsYXRpb24YBiABKAkSEwoLZW52aXJvbm1lbnQYByABKAkadgoORGF0YWJhc2VVcGRhdGUSDQoFc2hlZXQYASABKAkSFgoOc2NoZW1hX3ZlcnNpb24YAiABKAkSPQoUZGF0YWJhc2VfY2hhbmdlX3R5cGUYAyABKA4yHy5ieXRlYmFzZS52MS5EYXRhYmFzZUNoYW5nZVR5cGUaggEKEkRhdGFiYXNlRGF0YUV4cG9ydBIOCgZ0YXJnZXQYASABKAkSDQoFc2hlZXQYAiABKAkSKQoGZm9ybWF0GAMgASgOMhkuYnl0ZWJhc2UudjEuRXhwb3J0Rm9ybWF0EhUKCHBhc3N3b3JkGAQgASgJSACIAQFCCwoJX3Bhc3N3b3JkInwKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABIPCgtOT1RfU1RBUlRFRBABEgsKB1BFTkRJTkcQAhILCgdSVU5OSU5HEAMSCAoERE9ORRAEEgoKBkZBSUxFRBAFEgwKCENBTkNFTEVEEAYSCwoHU0tJUFBFRBAHInsKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARITCg9EQVRBQkFTRV9DUkVBVEUQAhIUChBEQVRBQkFTRV9NSUdSQVRFEAMSEAoMREFUQUJBU0VfU0RMEAYSEwoPREFUQUJBU0VfRVhQT1JUEAU6WepBVgoRYnl0ZWJhc2UuY29tL1Rhc2sSQXByb2plY3RzL3twcm9qZWN0fS9yb2xsb3V0cy97cm9sbG91dH0vc3RhZ2VzL3tzdGFnZX0vdGFza3Mve3Rhc2t9QgkKB3BheWxvYWRCDgoMX3VwZGF0ZV90aW1lQgsKCV9ydW5fdGltZUoECAIQAyK2DQoHVGFza1J1bhIMCgRuYW1lGAEgASgJEg8KB2NyZWF0b3IYAyABKAkSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSKwoGc3RhdHVzGAggASgOMhsuYnl0ZWJhc2UudjEuVGFza1J1bi5TdGF0dXMSDgoGZGV0YWlsGAkgASgJEhYKCWNoYW5nZWxvZxgUIAEoCUID4EEDEhYKDnNjaGVtYV92ZXJzaW9uGAsgASgJEjMKCnN0YXJ0X3RpbWUYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSRwoVZXhwb3J0X2FyY2hpdmVfc3RhdHVzGBAgASgOMiguYnl0ZWJhc2UudjEuVGFza1J1bi5FeHBvcnRBcmNoaXZlU3RhdHVzEkMKE3ByaW9yX2JhY2t1cF9kZXRhaWwYESABKAsyJi5ieXRlYmFzZS52MS5UYXNrUnVuLlByaW9yQmFja3VwRGV0YWlsEj8KDnNjaGVkdWxlcl9pbmZvGBIgASgLMiIuYnl0ZWJhc2UudjEuVGFza1J1bi5TY2hlZHVsZXJJbmZvQgPgQQMSEgoFc2hlZXQYEyABKAlCA+BBAxI2CghydW5fdGltZRgVIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBA0gAiAEBGoADChFQcmlvckJhY2t1cERldGFpbBI6CgVpdGVtcxgBIAMoCzIrLmJ5dGViYXNlLnYxLlRhc2tSdW4uUHJpb3JCYWNrdXBEZXRhaWwuSXRlbRquAgoESXRlbRJHCgxzb3VyY2VfdGFibGUYASABKAsyMS5ieXRlYmFzZS52MS5UYXNrUnVuLlByaW9yQmFja3VwRGV0YWlsLkl0ZW0uVGFibGUSRwoMdGFyZ2V0X3RhYmxlGAIgASgLMjEuYnl0ZWJhc2UudjEuVGFza1J1bi5QcmlvckJhY2t1cERldGFpbC5JdGVtLlRhYmxlEi0KDnN0YXJ0X3Bvc2l0aW9uGAMgASgLMhUuYnl0ZWJhc2UudjEuUG9zaXRpb24SKwoMZW5kX3Bvc2l0aW9uGAQgASgLMhUuYnl0ZWJhc2UudjEuUG9zaXRpb24aOAoFVGFibGUSEAoIZGF0YWJhc2UYASABKAkSDgoGc2NoZW1hGAIgASgJEg0KBXRhYmxlGAMgASgJGpgDCg1TY2hlZHVsZXJJbmZvEi8KC3JlcG9ydF90aW1lGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBJGCg13YWl0aW5nX2NhdXNlGAIgASgLMi8uYnl0ZWJhc2UudjEuVGFza1J1bi5TY2hlZHVsZXJJbmZvLldhaXRpbmdDYXVzZRqNAgoMV2FpdGluZ0NhdXNlEhoKEGNvbm5lY3Rpb25fbGltaXQYASABKAhIABJECgR0YXNrGAIgASgLMjQuYnl0ZWJhc2UudjEuVGFza1J1bi5TY2hlZHVsZXJJbmZvLldhaXRpbmdDYXVzZS5UYXNrSAASHgoUcGFyYWxsZWxfdGFza3NfbGltaXQYAyABKAhIABI0Cg5yb2xsb3V0X3dpbmRvdxgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIABIXCg1yb2xsb3V0X2JhdGNoGAUgASgISAAaIwoEVGFzaxIMCgR0YXNrGAEgASgJEg0KBWlzc3VlGAIgASgJQgcKBWNhdXNlIl4KBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdQRU5ESU5HEAESCwoHUlVOTklORxACEggKBERPTkUQAxIKCgZGQUlMRUQQBBIMCghDQU5DRUxFRBAFIlUKE0V4cG9ydEFyY2hpdmVTdGF0dXMSJQohRVhQT1JUX0FSQ0hJVkVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASCQoFUkVBRFkQARIMCghFWFBPUlRFRBACOm/qQWwKFGJ5dGViYXNlLmNvbS9UYXNrUnVuElRwcm9qZWN0cy97cHJvamVjdH0vcm9sbG91dHMve3JvbGxvdXR9L3N0YWdlcy97c3RhZ2V9L3Rhc2tzL3t0YXNrfS90YXNrUnVucy97dGFza1J1bn1CCwoJX3J1bl90aW1lSgQIAhADSgQIDBANSgQIDxAQIsEBCgpUYXNrUnVuTG9nEgwKBG5hbWUYASABKAkSLQoHZW50cmllcxgCIAMoCzIcLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeTp26kFzChdieXRlYmFzZS5jb20vVGFza1J1bkxvZxJYcHJvamVjdHMve3Byb2plY3R9L3JvbGxvdXRzL3tyb2xsb3V0fS9zdGFnZXMve3N0YWdlfS90YXNrcy97dGFza30vdGFza1J1bnMve3Rhc2tSdW59L2xvZyL/EAoPVGFza1J1bkxvZ0VudHJ5Ei8KBHR5cGUYASABKA4yIS5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuVHlwZRIsCghsb2dfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJZGVwbG95X2lkGAwgASgJEjwKC3NjaGVtYV9kdW1wGAIgASgLMicuYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LlNjaGVtYUR1bXASRAoPY29tbWFuZF9leGVjdXRlGAMgASgLMisuYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LkNvbW1hbmRFeGVjdXRlEkAKDWRhdGFiYXNlX3N5bmMYBCABKAsyKS5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuRGF0YWJhc2VTeW5jElAKFnRhc2tfcnVuX3N0YXR1c191cGRhdGUYBSABKAsyMC5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuVGFza1J1blN0YXR1c1VwZGF0ZRJMChN0cmFuc2FjdGlvbl9jb250cm9sGAcgASgLMi8uYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LlRyYW5zYWN0aW9uQ29udHJvbBI+Cgxwcmlvcl9iYWNrdXAYCCABKAsyKC5ieXRlYmFzZS52MS5UYXNrUnVuTG9nRW50cnkuUHJpb3JCYWNrdXASOgoKcmV0cnlfaW5mbxgJIAEoCzImLmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5SZXRyeUluZm8SPgoMY29tcHV0ZV9kaWZmGAogASgLMiguYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LkNvbXB1dGVEaWZmGnkKClNjaGVtYUR1bXASLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWVycm9yGAMgASgJGrwCCg5Db21tYW5kRXhlY3V0ZRIsCghsb2dfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPY29tbWFuZF9pbmRleGVzGAIgAygFEhEKCXN0YXRlbWVudBgEIAEoCRJNCghyZXNwb25zZRgDIAEoCzI7LmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5Db21tYW5kRXhlY3V0ZS5Db21tYW5kUmVzcG9uc2UagAEKD0NvbW1hbmRSZXNwb25zZRIsCghsb2dfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFZXJyb3IYAiABKAkSFQoNYWZmZWN0ZWRfcm93cxgDIAEoAxIZChFhbGxfYWZmZWN0ZWRfcm93cxgEIAMoAxp7CgxEYXRhYmFzZVN5bmMSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWVycm9yGAMgASgJGqoBChNUYXNrUnVuU3RhdHVzVXBkYXRlEkcKBnN0YXR1cxgBIAEoDjI3LmJ5dGViYXNlLnYxLlRhc2tSdW5Mb2dFbnRyeS5UYXNrUnVuU3RhdHVzVXBkYXRlLlN0YXR1cyJKCgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASEwoPUlVOTklOR19XQUlUSU5HEAESEwoPUlVOTklOR19SVU5OSU5HEAIaqgEKElRyYW5zYWN0aW9uQ29udHJvbBJCCgR0eXBlGAEgASgOMjQuYnl0ZWJhc2UudjEuVGFza1J1bkxvZ0VudHJ5LlRyYW5zYWN0aW9uQ29udHJvbC5UeXBlEg0KBWVycm9yGAIgASgJIkEKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEgkKBUJFR0lOEAESCgoGQ09NTUlUEAISDAoIUk9MTEJBQ0sQAxq/AQoLUHJpb3JCYWNrdXASLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEkMKE3ByaW9yX2JhY2t1cF9kZXRhaWwYAyABKAsyJi5ieXRlYmFzZS52MS5UYXNrUnVuLlByaW9yQmFja3VwRGV0YWlsEg0KBWVycm9yGAQgASgJGkgKCVJldHJ5SW5mbxINCgVlcnJvchgBIAEoCRITCgtyZXRyeV9jb3VudBgCIAEoBRIXCg9tYXhpbXVtX3JldHJpZXMYAyABKAUaegoLQ29tcHV0ZURpZmYSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWVycm9yGAMgASgJIr4BCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIPCgtTQ0hFTUFfRFVNUBABEhMKD0NPTU1BTkRfRVhFQ1VURRACEhEKDURBVEFCQVNFX1NZTkMQAxIaChZUQVNLX1JVTl9TVEFUVVNfVVBEQVRFEAQSFwoTVFJBTlNBQ1RJT05fQ09OVFJPTBAFEhAKDFBSSU9SX0JBQ0tVUBAGEg4KClJFVFJZX0lORk8QBxIQCgxDT01QVVRFX0RJRkYQCCJIChhHZXRUYXNrUnVuU2Vzc2lvblJlcXVlc3QSLAoGcGFyZW50GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9UYXNrUnVuIugHCg5UYXNrUnVuU2Vzc2lvbhIMCgRuYW1lGAEgASgJEjgKCHBvc3RncmVzGAIgASgLMiQuYnl0ZWJhc2UudjEuVGFza1J1blNlc3Npb24uUG9zdGdyZXNIABqCBgoIUG9zdGdyZXMSPQoHc2Vzc2lvbhgBIAEoCzIsLmJ5dGViYXNlLnYxLlRhc2tSdW5TZXNzaW9uLlBvc3RncmVzLlNlc3Npb24SRwoRYmxvY2tpbmdfc2Vzc2lvbnMYAiADKAsyLC5ieXRlYmFzZS52MS5UYXNrUnVuU2Vzc2lvbi5Qb3N0Z3Jlcy5TZXNzaW9uEkYKEGJsb2NrZWRfc2Vzc2lvbnMYAyADKAsyLC5ieXRlYmFzZS52MS5UYXNrUnVuU2Vzc2lvbi5Qb3N0Z3Jlcy5TZXNzaW9uGqUECgdTZXNzaW9uEgsKA3BpZBgBIAEoCRIXCg9ibG9ja2VkX2J5X3BpZHMYAiADKAkSDQoFcXVlcnkYAyABKAkSEgoFc3RhdGUYBCABKAlIAIgBARIcCg93YWl0X2V2ZW50X3R5cGUYBSABKAlIAYgBARIXCgp3YWl0X2V2ZW50GAYgASgJSAKIAQESFAoHZGF0bmFtZRgHIAEoCUgDiAEBEhQKB3VzZW5hbWUYCCABKAlIBIgBARIYChBhcHBsaWNhdGlvbl9uYW1lGAkgASgJEhgKC2NsaWVudF9hZGRyGAogASgJSAWIAQESGAoLY2xpZW50X3BvcnQYCyABKAlIBogBARIxCg1iYWNrZW5kX3N0YXJ0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCgp4YWN0X3N0YXJ0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgHiAEBEjQKC3F1ZXJ5X3N0YXJ0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgIiAEBQggKBl9zdGF0ZUISChBfd2FpdF9ldmVudF90eXBlQg0KC193YWl0X2V2ZW50QgoKCF9kYXRuYW1lQgoKCF91c2VuYW1lQg4KDF9jbGllbnRfYWRkckIOCgxfY2xpZW50X3BvcnRCDQoLX3hhY3Rfc3RhcnRCDgoMX3F1ZXJ5X3N0YXJ0On7qQXsKG2J5dGViYXNlLmNvbS9UYXNrUnVuU2Vzc2lvbhJccHJvamVjdHMve3Byb2plY3R9L3JvbGxvdXRzL3tyb2xsb3V0fS9zdGFnZXMve3N0YWdlfS90YXNrcy97dGFza30vdGFza1J1bnMve3Rhc2tSdW59L3Nlc3Npb25CCQoHc2Vzc2lvbiJLCh1QcmV2aWV3VGFza1J1blJvbGxiYWNrUmVxdWVzdBIqCgRuYW1lGAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9UYXNrUnVuIjMKHlByZXZpZXdUYXNrUnVuUm9sbGJhY2tSZXNwb25zZRIRCglzdGF0ZW1lbnQYASABKAkykhEKDlJvbGxvdXRTZXJ2aWNlEooBCgpHZXRSb2xsb3V0Eh4uYnl0ZWJhc2UudjEuR2V0Um9sbG91dFJlcXVlc3QaFC5ieXRlYmFzZS52MS5Sb2xsb3V0IkbaQQRuYW1liuowD2JiLnJvbGxvdXRzLmdldJDqMAGC0+STAiISIC92MS97bmFtZT1wcm9qZWN0cy8qL3JvbGxvdXRzLyp9Ep4BCgxMaXN0Um9sbG91dHMSIC5ieXRlYmFzZS52MS5MaXN0Um9sbG91dHNSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuTGlzdFJvbGxvdXRzUmVzcG9uc2UiSdpBBnBhcmVudIrqMBBiYi5yb2xsb3V0cy5saXN0kOowAYLT5JMCIhIgL3YxL3twYXJlbnQ9cHJvamVjdHMvKn0vcm9sbG91dHMSqgEKDUNyZWF0ZVJvbGxvdXQSIS5ieXRlYmFzZS52MS5DcmVhdGVSb2xsb3V0UmVxdWVzdBoULmJ5dGViYXNlLnYxLlJvbGxvdXQiYNpBDnBhcmVudCxyb2xsb3V0iuowEmJiLnJvbGxvdXRzLmNyZWF0ZZDqMAGY6jABgtPkkwIrOgdyb2xsb3V0IiAvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9yb2xsb3V0cxKgAQoOUHJldmlld1JvbGxvdXQSIi5ieXRlYmFzZS52MS5QcmV2aWV3Um9sbG91dFJlcXVlc3QaFC5ieXRlYmFzZS52MS5Sb2xsb3V0IlTaQQRuYW1liuowE2JiLnJvbGxvdXRzLnByZXZpZXeQ6jABgtPkkwIsOgEqIicvdjEve3Byb2plY3Q9cHJvamVjdHMvKn06cHJldmlld1JvbGxvdXQSugEKDExpc3RUYXNrUnVucxIgLmJ5dGViYXNlLnYxLkxpc3RUYXNrUnVuc1JlcXVlc3QaIS5ieXRlYmFzZS52MS5MaXN0VGFza1J1bnNSZXNwb25zZSJl2kEGcGFyZW50iuowEGJiLnRhc2tSdW5zLmxpc3SQ6jABgtPkkwI+EjwvdjEve3BhcmVudD1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyovdGFza3MvKn0vdGFza1J1bnMSpwEKCkdldFRhc2tSdW4SHi5ieXRlYmFzZS52MS5HZXRUYXNrUnVuUmVxdWVzdBoULmJ5dGViYXNlLnYxLlRhc2tSdW4iY9pBBG5hbWWK6jAQYmIudGFza1J1bnMubGlzdJDqMAGC0+STAj4SPC92MS97bmFtZT1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyovdGFza3MvKi90YXNrUnVucy8qfRK4AQoNR2V0VGFza1J1bkxvZxIhLmJ5dGViYXNlLnYxLkdldFRhc2tSdW5Mb2dSZXF1ZXN0GhcuYnl0ZWJhc2UudjEuVGFza1J1bkxvZyJr2kEGcGFyZW50iuowEGJiLnRhc2tSdW5zLmxpc3SQ6jABgtPkkwJEEkIvdjEve3BhcmVudD1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyovdGFza3MvKi90YXNrUnVucy8qfS9sb2cSyAEKEUdldFRhc2tSdW5TZXNzaW9uEiUuYnl0ZWJhc2UudjEuR2V0VGFza1J1blNlc3Npb25SZXF1ZXN0GhsuYnl0ZWJhc2UudjEuVGFza1J1blNlc3Npb24ib9pBBnBhcmVudIrqMBBiYi50YXNrUnVucy5saXN0kOowAYLT5JMCSBJGL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qL3Rhc2tzLyovdGFza1J1bnMvKn0vc2Vzc2lvbhKqAQoNQmF0Y2hSdW5UYXNrcxIhLmJ5dGViYXNlLnYxLkJhdGNoUnVuVGFza3NSZXF1ZXN0GiIuYnl0ZWJhc2UudjEuQmF0Y2hSdW5UYXNrc1Jlc3BvbnNlIlLaQQZwYXJlbnSQ6jACgtPkkwI/OgEqIjovdjEve3BhcmVudD1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyp9L3Rhc2tzOmJhdGNoUnVuEq4BCg5CYXRjaFNraXBUYXNrcxIiLmJ5dGViYXNlLnYxLkJhdGNoU2tpcFRhc2tzUmVxdWVzdBojLmJ5dGViYXNlLnYxLkJhdGNoU2tpcFRhc2tzUmVzcG9uc2UiU9pBBnBhcmVudJDqMAKC0+STAkA6ASoiOy92MS97cGFyZW50PXByb2plY3RzLyovcm9sbG91dHMvKi9zdGFnZXMvKn0vdGFza3M6YmF0Y2hTa2lwEsoBChNCYXRjaENhbmNlbFRhc2tSdW5zEicuYnl0ZWJhc2UudjEuQmF0Y2hDYW5jZWxUYXNrUnVuc1JlcXVlc3QaKC5ieXRlYmFzZS52MS5CYXRjaENhbmNlbFRhc2tSdW5zUmVzcG9uc2UiYNpBBnBhcmVudJDqMAKC0+STAk06ASoiSC92MS97cGFyZW50PXByb2plY3RzLyovcm9sbG91dHMvKi9zdGFnZXMvKi90YXNrcy8qfS90YXNrUnVuczpiYXRjaENhbmNlbBLpAQoWUHJldmlld1Rhc2tSdW5Sb2xsYmFjaxIqLmJ5dGViYXNlLnYxLlByZXZpZXdUYXNrUnVuUm9sbGJhY2tSZXF1ZXN0GisuYnl0ZWJhc2UudjEuUHJldmlld1Rhc2tSdW5Sb2xsYmFja1Jlc3BvbnNlInbaQQRuYW1liuowEGJiLnRhc2tSdW5zLmxpc3SQ6jABgtPkkwJROgEqIkwvdjEve25hbWU9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qL3Rhc2tzLyovdGFza1J1bnMvKn06cHJldmlld1JvbGxiYWNrQqkBCg9jb20uYnl0ZWJhc2UudjFCE1JvbGxvdXRTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_plan_service]);
 
 /**
  * Describes the message bytebase.v1.BatchRunTasksRequest.
@@ -724,6 +724,9 @@ export declare type WorkspaceApprovalSetting_Rule = Message<"bytebase.v1.Workspa
    * resource.schema_name: the schema name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
    * resource.table_name: the table name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
    * statement.text: the SQL statement, support "contains()", "matches()", "startsWith()", "endsWith()" operations.
+   * statement.lock_level: the strongest lock level of the DDL statements, e.g. "ACCESS_EXCLUSIVE", support "==", "!=", "in [xx]", "!(in [xx])" operations.
+   * statement.lock_blocking_seconds: the estimated duration in seconds that the DDL statements block the writes, support "==", "!=", "<", "<=", ">", ">=" operations.
+   * statement.rewritten_table_rows: the row count of the tables rewritten by the DDL statements, support "==", "!=", "<", "<=", ">", ">=" operations.
    * request.expiration_days: the role expiration days for the request, support "==", "!=", "<", "<=", ">", ">=" operations.
    * request.role: the request role full name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
    *
@@ -1378,6 +1381,22 @@ export declare type AISetting = Message<"bytebase.v1.AISetting"> & {
    * @generated from field: string version = 6;
    */
   version: string;
+
+  /**
+   * headers are the custom HTTP headers sent with each request to the endpoint.
+   * The header values are not exposed once saved.
+   *
+   * @generated from field: map<string, string> headers = 7;
+   */
+  headers: { [key: string]: string };
+
+  /**
+   * ca_certificate is the PEM encoded CA bundle used to verify the endpoint
+   * certificate, in addition to the system root CAs.
+   *
+   * @generated from field: string ca_certificate = 8;
+   */
+  caCertificate: string;
 };
 
 /**
@@ -1414,6 +1433,14 @@ export enum AISetting_Provider {
    * @generated from enum value: AZURE_OPENAI = 4;
    */
   AZURE_OPENAI = 4,
+
+  /**
+   * OPENAI_COMPATIBLE is a self-hosted or gateway endpoint serving the OpenAI
+   * chat completions API, such as vLLM and Ollama.
+   *
+   * @generated from enum value: OPENAI_COMPATIBLE = 5;
+   */
+  OPENAI_COMPATIBLE = 5,
 }
 
 /**
@@ -20,7 +20,7 @@ import { file_v1_project_service } from "./project_service_pb";
  * Describes the file v1/setting_service.proto.
  */
 export const file_v1_setting_service = /*@__PURE__*/
-  fileDesc("Chh2MS9zZXR0aW5nX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIhUKE0xpc3RTZXR0aW5nc1JlcXVlc3QiPgoUTGlzdFNldHRpbmdzUmVzcG9uc2USJgoIc2V0dGluZ3MYASADKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIj8KEUdldFNldHRpbmdSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1NldHRpbmciOwoSR2V0U2V0dGluZ1Jlc3BvbnNlEiUKB3NldHRpbmcYASABKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIqEBChRVcGRhdGVTZXR0aW5nUmVxdWVzdBIqCgdzZXR0aW5nGAEgASgLMhQuYnl0ZWJhc2UudjEuU2V0dGluZ0ID4EECEhUKDXZhbGlkYXRlX29ubHkYAiABKAgSFQoNYWxsb3dfbWlzc2luZxgDIAEoCBIvCgt1cGRhdGVfbWFzaxgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2si1QMKB1NldHRpbmcSDAoEbmFtZRgBIAEoCRIhCgV2YWx1ZRgCIAEoCzISLmJ5dGViYXNlLnYxLlZhbHVlIuMCCgtTZXR0aW5nTmFtZRIcChhTRVRUSU5HX05BTUVfVU5TUEVDSUZJRUQQABIPCgtBVVRIX1NFQ1JFVBABEhEKDUJSQU5ESU5HX0xPR08QAhIQCgxXT1JLU1BBQ0VfSUQQAxIVChFXT1JLU1BBQ0VfUFJPRklMRRAEEhYKEldPUktTUEFDRV9BUFBST1ZBTBAFEh8KG1dPUktTUEFDRV9FWFRFUk5BTF9BUFBST1ZBTBAGEhYKEkVOVEVSUFJJU0VfTElDRU5TRRAHEgoKBkFQUF9JTRAIEg0KCVdBVEVSTUFSSxAJEgYKAkFJEAoSEwoPU0NIRU1BX1RFTVBMQVRFEA0SFwoTREFUQV9DTEFTU0lGSUNBVElPThAOEhIKDlNFTUFOVElDX1RZUEVTEA8SCAoEU0NJTRAREhgKFFBBU1NXT1JEX1JFU1RSSUNUSU9OEBISDwoLRU5WSVJPTk1FTlQQEzot6kEqChRieXRlYmFzZS5jb20vU2V0dGluZxISc2V0dGluZ3Mve3NldHRpbmd9SgQIEBARIukFCgVWYWx1ZRIWCgxzdHJpbmdfdmFsdWUYASABKAlIABI5ChRhcHBfaW1fc2V0dGluZ192YWx1ZRgDIAEoCzIZLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZ0gAEk8KH3dvcmtzcGFjZV9wcm9maWxlX3NldHRpbmdfdmFsdWUYBSABKAsyJC5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VQcm9maWxlU2V0dGluZ0gAElEKIHdvcmtzcGFjZV9hcHByb3ZhbF9zZXR0aW5nX3ZhbHVlGAYgASgLMiUuYnl0ZWJhc2UudjEuV29ya3NwYWNlQXBwcm92YWxTZXR0aW5nSAASSwodc2NoZW1hX3RlbXBsYXRlX3NldHRpbmdfdmFsdWUYCSABKAsyIi5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmdIABJTCiFkYXRhX2NsYXNzaWZpY2F0aW9uX3NldHRpbmdfdmFsdWUYCiABKAsyJi5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nSAASRwobc2VtYW50aWNfdHlwZV9zZXR0aW5nX3ZhbHVlGAsgASgLMiAuYnl0ZWJhc2UudjEuU2VtYW50aWNUeXBlU2V0dGluZ0gAEjAKDHNjaW1fc2V0dGluZxgOIAEoCzIYLmJ5dGViYXNlLnYxLlNDSU1TZXR0aW5nSAASTwoccGFzc3dvcmRfcmVzdHJpY3Rpb25fc2V0dGluZxgPIAEoCzInLmJ5dGViYXNlLnYxLlBhc3N3b3JkUmVzdHJpY3Rpb25TZXR0aW5nSAASLAoKYWlfc2V0dGluZxgQIAEoCzIWLmJ5dGViYXNlLnYxLkFJU2V0dGluZ0gAEj4KE2Vudmlyb25tZW50X3NldHRpbmcYESABKAsyHy5ieXRlYmFzZS52MS5FbnZpcm9ubWVudFNldHRpbmdIAEIHCgV2YWx1ZUoECBIQEyK2BQoMQXBwSU1TZXR0aW5nEjUKCHNldHRpbmdzGAEgAygLMiMuYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLklNU2V0dGluZxobCgVTbGFjaxISCgV0b2tlbhgBIAEoCUID4EEEGjYKBkZlaXNodRITCgZhcHBfaWQYASABKAlCA+BBBBIXCgphcHBfc2VjcmV0GAIgASgJQgPgQQQaSQoFV2Vjb20SFAoHY29ycF9pZBgBIAEoCUID4EEEEhUKCGFnZW50X2lkGAIgASgJQgPgQQQSEwoGc2VjcmV0GAMgASgJQgPgQQQaNAoETGFyaxITCgZhcHBfaWQYASABKAlCA+BBBBIXCgphcHBfc2VjcmV0GAIgASgJQgPgQQQaVwoIRGluZ1RhbGsSFgoJY2xpZW50X2lkGAEgASgJQgPgQQQSGgoNY2xpZW50X3NlY3JldBgCIAEoCUID4EEEEhcKCnJvYm90X2NvZGUYAyABKAlCA+BBBBq/AgoJSU1TZXR0aW5nEicKBHR5cGUYASABKA4yGS5ieXRlYmFzZS52MS5XZWJob29rLlR5cGUSMAoFc2xhY2sYAiABKAsyHy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuU2xhY2tIABIyCgZmZWlzaHUYAyABKAsyIC5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuRmVpc2h1SAASMAoFd2Vjb20YBCABKAsyHy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuV2Vjb21IABIuCgRsYXJrGAUgASgLMh4uYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLkxhcmtIABI2CghkaW5ndGFsaxgGIAEoCzIiLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZy5EaW5nVGFsa0gAQgkKB3BheWxvYWQikAQKF1dvcmtzcGFjZVByb2ZpbGVTZXR0aW5nEhQKDGV4dGVybmFsX3VybBgBIAEoCRIXCg9kaXNhbGxvd19zaWdudXAYAiABKAgSEwoLcmVxdWlyZV8yZmEYAyABKAgSMQoOdG9rZW5fZHVyYXRpb24YBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLwoMYW5ub3VuY2VtZW50GAcgASgLMhkuYnl0ZWJhc2UudjEuQW5ub3VuY2VtZW50EjoKF21heGltdW1fcm9sZV9leHBpcmF0aW9uGAggASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEg8KB2RvbWFpbnMYCSADKAkSHwoXZW5mb3JjZV9pZGVudGl0eV9kb21haW4YCiABKAgSPQoUZGF0YWJhc2VfY2hhbmdlX21vZGUYCyABKA4yHy5ieXRlYmFzZS52MS5EYXRhYmFzZUNoYW5nZU1vZGUSIAoYZGlzYWxsb3dfcGFzc3dvcmRfc2lnbmluGAwgASgIEiAKGGVuYWJsZV9tZXRyaWNfY29sbGVjdGlvbhgNIAEoCBI7ChhpbmFjdGl2ZV9zZXNzaW9uX3RpbWVvdXQYDiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SHwoXZW5hYmxlX2F1ZGl0X2xvZ19zdGRvdXQYDyABKAgirwEKDEFubm91bmNlbWVudBIzCgVsZXZlbBgBIAEoDjIkLmJ5dGViYXNlLnYxLkFubm91bmNlbWVudC5BbGVydExldmVsEgwKBHRleHQYAiABKAkSDAoEbGluaxgDIAEoCSJOCgpBbGVydExldmVsEhsKF0FMRVJUX0xFVkVMX1VOU1BFQ0lGSUVEEAASCAoESU5GTxABEgsKB1dBUk5JTkcQAhIMCghDUklUSUNBTBADIucCChhXb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcSOQoFcnVsZXMYASADKAsyKi5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcuUnVsZRqPAgoEUnVsZRIvCgh0ZW1wbGF0ZRgBIAEoCzIdLmJ5dGViYXNlLnYxLkFwcHJvdmFsVGVtcGxhdGUSJAoJY29uZGl0aW9uGAIgASgLMhEuZ29vZ2xlLnR5cGUuRXhwchJBCgZzb3VyY2UYAyABKA4yMS5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcuUnVsZS5Tb3VyY2UibQoGU291cmNlEhYKElNPVVJDRV9VTlNQRUNJRklFRBAAEhMKD0NIQU5HRV9EQVRBQkFTRRABEhMKD0NSRUFURV9EQVRBQkFTRRACEg8KC0VYUE9SVF9EQVRBEAMSEAoMUkVRVUVTVF9ST0xFEAQioAUKFVNjaGVtYVRlbXBsYXRlU2V0dGluZxJJCg9maWVsZF90ZW1wbGF0ZXMYASADKAsyMC5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmcuRmllbGRUZW1wbGF0ZRJDCgxjb2x1bW5fdHlwZXMYAiADKAsyLS5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmcuQ29sdW1uVHlwZRJJCg90YWJsZV90ZW1wbGF0ZXMYAyADKAsyMC5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmcuVGFibGVUZW1wbGF0ZRqsAQoNRmllbGRUZW1wbGF0ZRIKCgJpZBgBIAEoCRIjCgZlbmdpbmUYAiABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSEAoIY2F0ZWdvcnkYAyABKAkSKwoGY29sdW1uGAQgASgLMhsuYnl0ZWJhc2UudjEuQ29sdW1uTWV0YWRhdGESKwoHY2F0YWxvZxgFIAEoCzIaLmJ5dGViYXNlLnYxLkNvbHVtbkNhdGFsb2caUQoKQ29sdW1uVHlwZRIjCgZlbmdpbmUYASABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSDwoHZW5hYmxlZBgCIAEoCBINCgV0eXBlcxgDIAMoCRqpAQoNVGFibGVUZW1wbGF0ZRIKCgJpZBgBIAEoCRIjCgZlbmdpbmUYAiABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSEAoIY2F0ZWdvcnkYAyABKAkSKQoFdGFibGUYBCABKAsyGi5ieXRlYmFzZS52MS5UYWJsZU1ldGFkYXRhEioKB2NhdGFsb2cYBSABKAsyGS5ieXRlYmFzZS52MS5UYWJsZUNhdGFsb2cimAUKGURhdGFDbGFzc2lmaWNhdGlvblNldHRpbmcSUAoHY29uZmlncxgBIAMoCzI/LmJ5dGViYXNlLnYxLkRhdGFDbGFzc2lmaWNhdGlvblNldHRpbmcuRGF0YUNsYXNzaWZpY2F0aW9uQ29uZmlnGqgEChhEYXRhQ2xhc3NpZmljYXRpb25Db25maWcSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSVQoGbGV2ZWxzGAMgAygLMkUuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZy5EYXRhQ2xhc3NpZmljYXRpb25Db25maWcuTGV2ZWwSawoOY2xhc3NpZmljYXRpb24YBCADKAsyUy5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nLkRhdGFDbGFzc2lmaWNhdGlvbkNvbmZpZy5DbGFzc2lmaWNhdGlvbkVudHJ5GjcKBUxldmVsEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJGmgKEkRhdGFDbGFzc2lmaWNhdGlvbhIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIVCghsZXZlbF9pZBgEIAEoCUgAiAEBQgsKCV9sZXZlbF9pZBqJAQoTQ2xhc3NpZmljYXRpb25FbnRyeRILCgNrZXkYASABKAkSYQoFdmFsdWUYAiABKAsyUi5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nLkRhdGFDbGFzc2lmaWNhdGlvbkNvbmZpZy5EYXRhQ2xhc3NpZmljYXRpb246AjgBIswBChNTZW1hbnRpY1R5cGVTZXR0aW5nEjwKBXR5cGVzGAEgAygLMi0uYnl0ZWJhc2UudjEuU2VtYW50aWNUeXBlU2V0dGluZy5TZW1hbnRpY1R5cGUadwoMU2VtYW50aWNUeXBlEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEikKCWFsZ29yaXRobRgGIAEoCzIWLmJ5dGViYXNlLnYxLkFsZ29yaXRobRIMCgRpY29uGAcgASgJIv8ECglBbGdvcml0aG0SNAoJZnVsbF9tYXNrGAUgASgLMh8uYnl0ZWJhc2UudjEuQWxnb3JpdGhtLkZ1bGxNYXNrSAASNgoKcmFuZ2VfbWFzaxgGIAEoCzIgLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5SYW5nZU1hc2tIABIyCghtZDVfbWFzaxgHIAEoCzIeLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5NRDVNYXNrSAASQQoQaW5uZXJfb3V0ZXJfbWFzaxgIIAEoCzIlLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5Jbm5lck91dGVyTWFza0gAGiAKCEZ1bGxNYXNrEhQKDHN1YnN0aXR1dGlvbhgBIAEoCRp+CglSYW5nZU1hc2sSNgoGc2xpY2VzGAEgAygLMiYuYnl0ZWJhc2UudjEuQWxnb3JpdGhtLlJhbmdlTWFzay5TbGljZRo5CgVTbGljZRINCgVzdGFydBgBIAEoBRILCgNlbmQYAiABKAUSFAoMc3Vic3RpdHV0aW9uGAMgASgJGhcKB01ENU1hc2sSDAoEc2FsdBgBIAEoCRrJAQoOSW5uZXJPdXRlck1hc2sSEgoKcHJlZml4X2xlbhgBIAEoBRISCgpzdWZmaXhfbGVuGAIgASgFEjwKBHR5cGUYAyABKA4yLi5ieXRlYmFzZS52MS5BbGdvcml0aG0uSW5uZXJPdXRlck1hc2suTWFza1R5cGUSFAoMc3Vic3RpdHV0aW9uGAQgASgJIjsKCE1hc2tUeXBlEhkKFU1BU0tfVFlQRV9VTlNQRUNJRklFRBAAEgkKBUlOTkVSEAESCQoFT1VURVIQAkIGCgRtYXNrIhwKC1NDSU1TZXR0aW5nEg0KBXRva2VuGAEgASgJIosCChpQYXNzd29yZFJlc3RyaWN0aW9uU2V0dGluZxISCgptaW5fbGVuZ3RoGAEgASgFEhYKDnJlcXVpcmVfbnVtYmVyGAIgASgIEhYKDnJlcXVpcmVfbGV0dGVyGAMgASgIEiAKGHJlcXVpcmVfdXBwZXJjYXNlX2xldHRlchgEIAEoCBIhChlyZXF1aXJlX3NwZWNpYWxfY2hhcmFjdGVyGAUgASgIEi4KJnJlcXVpcmVfcmVzZXRfcGFzc3dvcmRfZm9yX2ZpcnN0X2xvZ2luGAYgASgIEjQKEXBhc3N3b3JkX3JvdGF0aW9uGAcgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIu8BCglBSVNldHRpbmcSDwoHZW5hYmxlZBgBIAEoCBIxCghwcm92aWRlchgCIAEoDjIfLmJ5dGViYXNlLnYxLkFJU2V0dGluZy5Qcm92aWRlchIQCghlbmRwb2ludBgDIAEoCRIPCgdhcGlfa2V5GAQgASgJEg0KBW1vZGVsGAUgASgJEg8KB3ZlcnNpb24YBiABKAkiWwoIUHJvdmlkZXISGAoUUFJPVklERVJfVU5TUEVDSUZJRUQQABILCgdPUEVOX0FJEAESCgoGQ0xBVURFEAISCgoGR0VNSU5JEAMSEAoMQVpVUkVfT1BFTkFJEAQilgIKEkVudmlyb25tZW50U2V0dGluZxJBCgxlbnZpcm9ubWVudHMYASADKAsyKy5ieXRlYmFzZS52MS5FbnZpcm9ubWVudFNldHRpbmcuRW52aXJvbm1lbnQavAEKC0Vudmlyb25tZW50EhEKBG5hbWUYASABKAlCA+BBAxIKCgJpZBgCIAEoCRINCgV0aXRsZRgDIAEoCRJDCgR0YWdzGAQgAygLMjUuYnl0ZWJhc2UudjEuRW52aXJvbm1lbnRTZXR0aW5nLkVudmlyb25tZW50LlRhZ3NFbnRyeRINCgVjb2xvchgFIAEoCRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASpUChJEYXRhYmFzZUNoYW5nZU1vZGUSJAogREFUQUJBU0VfQ0hBTkdFX01PREVfVU5TUEVDSUZJRUQQABIMCghQSVBFTElORRABEgoKBkVESVRPUhACMq4DCg5TZXR0aW5nU2VydmljZRKEAQoMTGlzdFNldHRpbmdzEiAuYnl0ZWJhc2UudjEuTGlzdFNldHRpbmdzUmVxdWVzdBohLmJ5dGViYXNlLnYxLkxpc3RTZXR0aW5nc1Jlc3BvbnNlIi/aQQCK6jAQYmIuc2V0dGluZ3MubGlzdJDqMAGC0+STAg4SDC92MS9zZXR0aW5ncxJ/CgpHZXRTZXR0aW5nEh4uYnl0ZWJhc2UudjEuR2V0U2V0dGluZ1JlcXVlc3QaFC5ieXRlYmFzZS52MS5TZXR0aW5nIjvaQQRuYW1liuowD2JiLnNldHRpbmdzLmdldJDqMAGC0+STAhcSFS92MS97bmFtZT1zZXR0aW5ncy8qfRKTAQoNVXBkYXRlU2V0dGluZxIhLmJ5dGViYXNlLnYxLlVwZGF0ZVNldHRpbmdSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuU2V0dGluZyJJiuowD2JiLnNldHRpbmdzLnNldJDqMAGY6jABgtPkkwIoOgdzZXR0aW5nMh0vdjEve3NldHRpbmcubmFtZT1zZXR0aW5ncy8qfUKpAQoPY29tLmJ5dGViYXNlLnYxQhNTZXR0aW5nU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_type_expr, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service, file_v1_issue_service, file_v1_project_service]);
+  fileDesc("Chh2MS9zZXR0aW5nX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIhUKE0xpc3RTZXR0aW5nc1JlcXVlc3QiPgoUTGlzdFNldHRpbmdzUmVzcG9uc2USJgoIc2V0dGluZ3MYASADKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIj8KEUdldFNldHRpbmdSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1NldHRpbmciOwoSR2V0U2V0dGluZ1Jlc3BvbnNlEiUKB3NldHRpbmcYASABKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIqEBChRVcGRhdGVTZXR0aW5nUmVxdWVzdBIqCgdzZXR0aW5nGAEgASgLMhQuYnl0ZWJhc2UudjEuU2V0dGluZ0ID4EECEhUKDXZhbGlkYXRlX29ubHkYAiABKAgSFQoNYWxsb3dfbWlzc2luZxgDIAEoCBIvCgt1cGRhdGVfbWFzaxgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2si1QMKB1NldHRpbmcSDAoEbmFtZRgBIAEoCRIhCgV2YWx1ZRgCIAEoCzISLmJ5dGViYXNlLnYxLlZhbHVlIuMCCgtTZXR0aW5nTmFtZRIcChhTRVRUSU5HX05BTUVfVU5TUEVDSUZJRUQQABIPCgtBVVRIX1NFQ1JFVBABEhEKDUJSQU5ESU5HX0xPR08QAhIQCgxXT1JLU1BBQ0VfSUQQAxIVChFXT1JLU1BBQ0VfUFJPRklMRRAEEhYKEldPUktTUEFDRV9BUFBST1ZBTBAFEh8KG1dPUktTUEFDRV9FWFRFUk5BTF9BUFBST1ZBTBAGEhYKEkVOVEVSUFJJU0VfTElDRU5TRRAHEgoKBkFQUF9JTRAIEg0KCVdBVEVSTUFSSxAJEgYKAkFJEAoSEwoPU0NIRU1BX1RFTVBMQVRFEA0SFwoTREFUQV9DTEFTU0lGSUNBVElPThAOEhIKDlNFTUFOVElDX1RZUEVTEA8SCAoEU0NJTRAREhgKFFBBU1NXT1JEX1JFU1RSSUNUSU9OEBISDwoLRU5WSVJPTk1FTlQQEzot6kEqChRieXRlYmFzZS5jb20vU2V0dGluZxISc2V0dGluZ3Mve3NldHRpbmd9SgQIEBARIukFCgVWYWx1ZRIWCgxzdHJpbmdfdmFsdWUYASABKAlIABI5ChRhcHBfaW1fc2V0dGluZ192YWx1ZRgDIAEoCzIZLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZ0gAEk8KH3dvcmtzcGFjZV9wcm9maWxlX3NldHRpbmdfdmFsdWUYBSABKAsyJC5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VQcm9maWxlU2V0dGluZ0gAElEKIHdvcmtzcGFjZV9hcHByb3ZhbF9zZXR0aW5nX3ZhbHVlGAYgASgLMiUuYnl0ZWJhc2UudjEuV29ya3NwYWNlQXBwcm92YWxTZXR0aW5nSAASSwodc2NoZW1hX3RlbXBsYXRlX3NldHRpbmdfdmFsdWUYCSABKAsyIi5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmdIABJTCiFkYXRhX2NsYXNzaWZpY2F0aW9uX3NldHRpbmdfdmFsdWUYCiABKAsyJi5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nSAASRwobc2VtYW50aWNfdHlwZV9zZXR0aW5nX3ZhbHVlGAsgASgLMiAuYnl0ZWJhc2UudjEuU2VtYW50aWNUeXBlU2V0dGluZ0gAEjAKDHNjaW1fc2V0dGluZxgOIAEoCzIYLmJ5dGViYXNlLnYxLlNDSU1TZXR0aW5nSAASTwoccGFzc3dvcmRfcmVzdHJpY3Rpb25fc2V0dGluZxgPIAEoCzInLmJ5dGViYXNlLnYxLlBhc3N3b3JkUmVzdHJpY3Rpb25TZXR0aW5nSAASLAoKYWlfc2V0dGluZxgQIAEoCzIWLmJ5dGViYXNlLnYxLkFJU2V0dGluZ0gAEj4KE2Vudmlyb25tZW50X3NldHRpbmcYESABKAsyHy5ieXRlYmFzZS52MS5FbnZpcm9ubWVudFNldHRpbmdIAEIHCgV2YWx1ZUoECBIQEyK2BQoMQXBwSU1TZXR0aW5nEjUKCHNldHRpbmdzGAEgAygLMiMuYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLklNU2V0dGluZxobCgVTbGFjaxISCgV0b2tlbhgBIAEoCUID4EEEGjYKBkZlaXNodRITCgZhcHBfaWQYASABKAlCA+BBBBIXCgphcHBfc2VjcmV0GAIgASgJQgPgQQQaSQoFV2Vjb20SFAoHY29ycF9pZBgBIAEoCUID4EEEEhUKCGFnZW50X2lkGAIgASgJQgPgQQQSEwoGc2VjcmV0GAMgASgJQgPgQQQaNAoETGFyaxITCgZhcHBfaWQYASABKAlCA+BBBBIXCgphcHBfc2VjcmV0GAIgASgJQgPgQQQaVwoIRGluZ1RhbGsSFgoJY2xpZW50X2lkGAEgASgJQgPgQQQSGgoNY2xpZW50X3NlY3JldBgCIAEoCUID4EEEEhcKCnJvYm90X2NvZGUYAyABKAlCA+BBBBq/AgoJSU1TZXR0aW5nEicKBHR5cGUYASABKA4yGS5ieXRlYmFzZS52MS5XZWJob29rLlR5cGUSMAoFc2xhY2sYAiABKAsyHy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuU2xhY2tIABIyCgZmZWlzaHUYAyABKAsyIC5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuRmVpc2h1SAASMAoFd2Vjb20YBCABKAsyHy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuV2Vjb21IABIuCgRsYXJrGAUgASgLMh4uYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLkxhcmtIABI2CghkaW5ndGFsaxgGIAEoCzIiLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZy5EaW5nVGFsa0gAQgkKB3BheWxvYWQikAQKF1dvcmtzcGFjZVByb2ZpbGVTZXR0aW5nEhQKDGV4dGVybmFsX3VybBgBIAEoCRIXCg9kaXNhbGxvd19zaWdudXAYAiABKAgSEwoLcmVxdWlyZV8yZmEYAyABKAgSMQoOdG9rZW5fZHVyYXRpb24YBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLwoMYW5ub3VuY2VtZW50GAcgASgLMhkuYnl0ZWJhc2UudjEuQW5ub3VuY2VtZW50EjoKF21heGltdW1fcm9sZV9leHBpcmF0aW9uGAggASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEg8KB2RvbWFpbnMYCSADKAkSHwoXZW5mb3JjZV9pZGVudGl0eV9kb21haW4YCiABKAgSPQoUZGF0YWJhc2VfY2hhbmdlX21vZGUYCyABKA4yHy5ieXRlYmFzZS52MS5EYXRhYmFzZUNoYW5nZU1vZGUSIAoYZGlzYWxsb3dfcGFzc3dvcmRfc2lnbmluGAwgASgIEiAKGGVuYWJsZV9tZXRyaWNfY29sbGVjdGlvbhgNIAEoCBI7ChhpbmFjdGl2ZV9zZXNzaW9uX3RpbWVvdXQYDiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SHwoXZW5hYmxlX2F1ZGl0X2xvZ19zdGRvdXQYDyABKAgirwEKDEFubm91bmNlbWVudBIzCgVsZXZlbBgBIAEoDjIkLmJ5dGViYXNlLnYxLkFubm91bmNlbWVudC5BbGVydExldmVsEgwKBHRleHQYAiABKAkSDAoEbGluaxgDIAEoCSJOCgpBbGVydExldmVsEhsKF0FMRVJUX0xFVkVMX1VOU1BFQ0lGSUVEEAASCAoESU5GTxABEgsKB1dBUk5JTkcQAhIMCghDUklUSUNBTBADIucCChhXb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcSOQoFcnVsZXMYASADKAsyKi5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcuUnVsZRqPAgoEUnVsZRIvCgh0ZW1wbGF0ZRgBIAEoCzIdLmJ5dGViYXNlLnYxLkFwcHJvdmFsVGVtcGxhdGUSJAoJY29uZGl0aW9uGAIgASgLMhEuZ29vZ2xlLnR5cGUuRXhwchJBCgZzb3VyY2UYAyABKA4yMS5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcuUnVsZS5Tb3VyY2UibQoGU291cmNlEhYKElNPVVJDRV9VTlNQRUNJRklFRBAAEhMKD0NIQU5HRV9EQVRBQkFTRRABEhMKD0NSRUFURV9EQVRBQkFTRRACEg8KC0VYUE9SVF9EQVRBEAMSEAoMUkVRVUVTVF9ST0xFEAQioAUKFVNjaGVtYVRlbXBsYXRlU2V0dGluZxJJCg9maWVsZF90ZW1wbGF0ZXMYASADKAsyMC5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmcuRmllbGRUZW1wbGF0ZRJDCgxjb2x1bW5fdHlwZXMYAiADKAsyLS5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmcuQ29sdW1uVHlwZRJJCg90YWJsZV90ZW1wbGF0ZXMYAyADKAsyMC5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmcuVGFibGVUZW1wbGF0ZRqsAQoNRmllbGRUZW1wbGF0ZRIKCgJpZBgBIAEoCRIjCgZlbmdpbmUYAiABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSEAoIY2F0ZWdvcnkYAyABKAkSKwoGY29sdW1uGAQgASgLMhsuYnl0ZWJhc2UudjEuQ29sdW1uTWV0YWRhdGESKwoHY2F0YWxvZxgFIAEoCzIaLmJ5dGViYXNlLnYxLkNvbHVtbkNhdGFsb2caUQoKQ29sdW1uVHlwZRIjCgZlbmdpbmUYASABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSDwoHZW5hYmxlZBgCIAEoCBINCgV0eXBlcxgDIAMoCRqpAQoNVGFibGVUZW1wbGF0ZRIKCgJpZBgBIAEoCRIjCgZlbmdpbmUYAiABKA4yEy5ieXRlYmFzZS52MS5FbmdpbmUSEAoIY2F0ZWdvcnkYAyABKAkSKQoFdGFibGUYBCABKAsyGi5ieXRlYmFzZS52MS5UYWJsZU1ldGFkYXRhEioKB2NhdGFsb2cYBSABKAsyGS5ieXRlYmFzZS52MS5UYWJsZUNhdGFsb2cimAUKGURhdGFDbGFzc2lmaWNhdGlvblNldHRpbmcSUAoHY29uZmlncxgBIAMoCzI/LmJ5dGViYXNlLnYxLkRhdGFDbGFzc2lmaWNhdGlvblNldHRpbmcuRGF0YUNsYXNzaWZpY2F0aW9uQ29uZmlnGqgEChhEYXRhQ2xhc3NpZmljYXRpb25Db25maWcSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSVQoGbGV2ZWxzGAMgAygLMkUuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZy5EYXRhQ2xhc3NpZmljYXRpb25Db25maWcuTGV2ZWwSawoOY2xhc3NpZmljYXRpb24YBCADKAsyUy5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nLkRhdGFDbGFzc2lmaWNhdGlvbkNvbmZpZy5DbGFzc2lmaWNhdGlvbkVudHJ5GjcKBUxldmVsEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJGmgKEkRhdGFDbGFzc2lmaWNhdGlvbhIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIVCghsZXZlbF9pZBgEIAEoCUgAiAEBQgsKCV9sZXZlbF9pZBqJAQoTQ2xhc3NpZmljYXRpb25FbnRyeRILCgNrZXkYASABKAkSYQoFdmFsdWUYAiABKAsyUi5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nLkRhdGFDbGFzc2lmaWNhdGlvbkNvbmZpZy5EYXRhQ2xhc3NpZmljYXRpb246AjgBIswBChNTZW1hbnRpY1R5cGVTZXR0aW5nEjwKBXR5cGVzGAEgAygLMi0uYnl0ZWJhc2UudjEuU2VtYW50aWNUeXBlU2V0dGluZy5TZW1hbnRpY1R5cGUadwoMU2VtYW50aWNUeXBlEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEikKCWFsZ29yaXRobRgGIAEoCzIWLmJ5dGViYXNlLnYxLkFsZ29yaXRobRIMCgRpY29uGAcgASgJIv8ECglBbGdvcml0aG0SNAoJZnVsbF9tYXNrGAUgASgLMh8uYnl0ZWJhc2UudjEuQWxnb3JpdGhtLkZ1bGxNYXNrSAASNgoKcmFuZ2VfbWFzaxgGIAEoCzIgLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5SYW5nZU1hc2tIABIyCghtZDVfbWFzaxgHIAEoCzIeLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5NRDVNYXNrSAASQQoQaW5uZXJfb3V0ZXJfbWFzaxgIIAEoCzIlLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5Jbm5lck91dGVyTWFza0gAGiAKCEZ1bGxNYXNrEhQKDHN1YnN0aXR1dGlvbhgBIAEoCRp+CglSYW5nZU1hc2sSNgoGc2xpY2VzGAEgAygLMiYuYnl0ZWJhc2UudjEuQWxnb3JpdGhtLlJhbmdlTWFzay5TbGljZRo5CgVTbGljZRINCgVzdGFydBgBIAEoBRILCgNlbmQYAiABKAUSFAoMc3Vic3RpdHV0aW9uGAMgASgJGhcKB01ENU1hc2sSDAoEc2FsdBgBIAEoCRrJAQoOSW5uZXJPdXRlck1hc2sSEgoKcHJlZml4X2xlbhgBIAEoBRISCgpzdWZmaXhfbGVuGAIgASgFEjwKBHR5cGUYAyABKA4yLi5ieXRlYmFzZS52MS5BbGdvcml0aG0uSW5uZXJPdXRlck1hc2suTWFza1R5cGUSFAoMc3Vic3RpdHV0aW9uGAQgASgJIjsKCE1hc2tUeXBlEhkKFU1BU0tfVFlQRV9VTlNQRUNJRklFRBAAEgkKBUlOTkVSEAESCQoFT1VURVIQAkIGCgRtYXNrIhwKC1NDSU1TZXR0aW5nEg0KBXRva2VuGAEgASgJIosCChpQYXNzd29yZFJlc3RyaWN0aW9uU2V0dGluZxISCgptaW5fbGVuZ3RoGAEgASgFEhYKDnJlcXVpcmVfbnVtYmVyGAIgASgIEhYKDnJlcXVpcmVfbGV0dGVyGAMgASgIEiAKGHJlcXVpcmVfdXBwZXJjYXNlX2xldHRlchgEIAEoCBIhChlyZXF1aXJlX3NwZWNpYWxfY2hhcmFjdGVyGAUgASgIEi4KJnJlcXVpcmVfcmVzZXRfcGFzc3dvcmRfZm9yX2ZpcnN0X2xvZ2luGAYgASgIEjQKEXBhc3N3b3JkX3JvdGF0aW9uGAcgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIoQDCglBSVNldHRpbmcSDwoHZW5hYmxlZBgBIAEoCBIxCghwcm92aWRlchgCIAEoDjIfLmJ5dGViYXNlLnYxLkFJU2V0dGluZy5Qcm92aWRlchIQCghlbmRwb2ludBgDIAEoCRIPCgdhcGlfa2V5GAQgASgJEg0KBW1vZGVsGAUgASgJEg8KB3ZlcnNpb24YBiABKAkSNAoHaGVhZGVycxgHIAMoCzIjLmJ5dGViYXNlLnYxLkFJU2V0dGluZy5IZWFkZXJzRW50cnkSFgoOY2FfY2VydGlmaWNhdGUYCCABKAkaLgoMSGVhZGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEicgoIUHJvdmlkZXISGAoUUFJPVklERVJfVU5TUEVDSUZJRUQQABILCgdPUEVOX0FJEAESCgoGQ0xBVURFEAISCgoGR0VNSU5JEAMSEAoMQVpVUkVfT1BFTkFJEAQSFQoRT1BFTkFJX0NPTVBBVElCTEUQBSKWAgoSRW52aXJvbm1lbnRTZXR0aW5nEkEKDGVudmlyb25tZW50cxgBIAMoCzIrLmJ5dGViYXNlLnYxLkVudmlyb25tZW50U2V0dGluZy5FbnZpcm9ubWVudBq8AQoLRW52aXJvbm1lbnQSEQoEbmFtZRgBIAEoCUID4EEDEgoKAmlkGAIgASgJEg0KBXRpdGxlGAMgASgJEkMKBHRhZ3MYBCADKAsyNS5ieXRlYmFzZS52MS5FbnZpcm9ubWVudFNldHRpbmcuRW52aXJvbm1lbnQuVGFnc0VudHJ5Eg0KBWNvbG9yGAUgASgJGisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBKlQKEkRhdGFiYXNlQ2hhbmdlTW9kZRIkCiBEQVRBQkFTRV9DSEFOR0VfTU9ERV9VTlNQRUNJRklFRBAAEgwKCFBJUEVMSU5FEAESCgoGRURJVE9SEAIyrgMKDlNldHRpbmdTZXJ2aWNlEoQBCgxMaXN0U2V0dGluZ3MSIC5ieXRlYmFzZS52MS5MaXN0U2V0dGluZ3NSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuTGlzdFNldHRpbmdzUmVzcG9uc2UiL9pBAIrqMBBiYi5zZXR0aW5ncy5saXN0kOowAYLT5JMCDhIML3YxL3NldHRpbmdzEn8KCkdldFNldHRpbmcSHi5ieXRlYmFzZS52MS5HZXRTZXR0aW5nUmVxdWVzdBoULmJ5dGViYXNlLnYxLlNldHRpbmciO9pBBG5hbWWK6jAPYmIuc2V0dGluZ3MuZ2V0kOowAYLT5JMCFxIVL3YxL3tuYW1lPXNldHRpbmdzLyp9EpMBCg1VcGRhdGVTZXR0aW5nEiEuYnl0ZWJhc2UudjEuVXBkYXRlU2V0dGluZ1JlcXVlc3QaFC5ieXRlYmFzZS52MS5TZXR0aW5nIkmK6jAPYmIuc2V0dGluZ3Muc2V0kOowAZjqMAGC0+STAig6B3NldHRpbmcyHS92MS97c2V0dGluZy5uYW1lPXNldHRpbmdzLyp9QqkBCg9jb20uYnl0ZWJhc2UudjFCE1NldHRpbmdTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_type_expr, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service, file_v1_issue_service, file_v1_project_service]);
 
 /**
  * Describes the message bytebase.v1.ListSettingsRequest.
@@ -908,6 +908,14 @@ export declare type Advice = Message<"bytebase.v1.Advice"> & {
    * @generated from field: bytebase.v1.Advice.RuleType rule_type = 10;
    */
   ruleType: Advice_RuleType;
+
+  /**
+   * The suggested fix for the advice.
+   * It's empty if the advice cannot be fixed mechanically.
+   *
+   * @generated from field: bytebase.v1.SuggestedFix suggested_fix = 11;
+   */
+  suggestedFix?: SuggestedFix;
 };
 
 /**
@@ -991,6 +999,66 @@ export enum Advice_RuleType {
  */
 export declare const Advice_RuleTypeSchema: GenEnum<Advice_RuleType>;
 
+/**
+ * SuggestedFix is a set of text edits which fixes the advice.
+ *
+ * @generated from message bytebase.v1.SuggestedFix
+ */
+export declare type SuggestedFix = Message<"bytebase.v1.SuggestedFix"> & {
+  /**
+   * The title of the fix, such as "Add CONCURRENTLY".
+   *
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * The edits to apply to the statement. The edits don't overlap.
+   *
+   * @generated from field: repeated bytebase.v1.TextEdit edits = 2;
+   */
+  edits: TextEdit[];
+};
+
+/**
+ * Describes the message bytebase.v1.SuggestedFix.
+ * Use `create(SuggestedFixSchema)` to create a new message.
+ */
+export declare const SuggestedFixSchema: GenMessage<SuggestedFix>;
+
+/**
+ * TextEdit replaces the text between the positions with the new text.
+ *
+ * @generated from message bytebase.v1.TextEdit
+ */
+export declare type TextEdit = Message<"bytebase.v1.TextEdit"> & {
+  /**
+   * The start_position is inclusive and the end_position is exclusive.
+   * The text is inserted if the start_position equals the end_position.
+   *
+   * @generated from field: bytebase.v1.Position start_position = 1;
+   */
+  startPosition?: Position;
+
+  /**
+   * @generated from field: bytebase.v1.Position end_position = 2;
+   */
+  endPosition?: Position;
+
+  /**
+   * The new text.
+   *
+   * @generated from field: string new_text = 3;
+   */
+  newText: string;
+};
+
+/**
+ * Describes the message bytebase.v1.TextEdit.
+ * Use `create(TextEditSchema)` to create a new message.
+ */
+export declare const TextEditSchema: GenMessage<TextEdit>;
+
 /**
  * @generated from message bytebase.v1.ExportRequest
  */
@@ -1073,6 +1141,7 @@ export declare const ExportRequestSchema: GenMessage<ExportRequest>;
 export declare type ExportResponse = Message<"bytebase.v1.ExportResponse"> & {
   /**
    * The export file content.
+   * For ExportStream, it is the next chunk of the file content.
    *
    * @generated from field: bytes content = 1;
    */
@@ -1274,6 +1343,14 @@ export declare type QueryHistory = Message<"bytebase.v1.QueryHistory"> & {
    * @generated from field: bytebase.v1.QueryHistory.Type type = 8;
    */
   type: QueryHistory_Type;
+
+  /**
+   * The break-glass grant issue that the statement runs under.
+   * Format: projects/{project}/issues/{issue}
+   *
+   * @generated from field: string break_glass_issue = 9;
+   */
+  breakGlassIssue: string;
 };
 
 /**
@@ -1419,6 +1496,24 @@ export declare type AICompletionResponse_Candidate_Content_Part = Message<"byteb
  */
 export declare const AICompletionResponse_Candidate_Content_PartSchema: GenMessage<AICompletionResponse_Candidate_Content_Part>;
 
+/**
+ * @generated from message bytebase.v1.AICompletionStreamResponse
+ */
+export declare type AICompletionStreamResponse = Message<"bytebase.v1.AICompletionStreamResponse"> & {
+  /**
+   * text is the text delta of the completion since the previous response.
+   *
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message bytebase.v1.AICompletionStreamResponse.
+ * Use `create(AICompletionStreamResponseSchema)` to create a new message.
+ */
+export declare const AICompletionStreamResponseSchema: GenMessage<AICompletionStreamResponse>;
+
 /**
  * SQLService executes SQL queries and manages query operations.
  *
@@ -1469,6 +1564,18 @@ export declare const SQLService: GenService<{
     input: typeof ExportRequestSchema;
     output: typeof ExportResponseSchema;
   },
+  /**
+   * Downloads the data exported by a rollout or stage as a zip file, streaming the file
+   * in chunks so that large exports are not held in memory.
+   * Permissions required: bb.databases.get
+   *
+   * @generated from rpc bytebase.v1.SQLService.ExportStream
+   */
+  exportStream: {
+    methodKind: "server_streaming";
+    input: typeof ExportRequestSchema;
+    output: typeof ExportResponseSchema;
+  },
   /**
    * Computes schema differences between two database metadata.
    * Permissions required: None
@@ -1491,5 +1598,17 @@ export declare const SQLService: GenService<{
     input: typeof AICompletionRequestSchema;
     output: typeof AICompletionResponseSchema;
   },
+  /**
+   * Provides AI-powered SQL completion and generation, streaming the text as it
+   * is generated.
+   * Permissions required: None (authenticated users only, requires AI to be enabled)
+   *
+   * @generated from rpc bytebase.v1.SQLService.AICompletionStream
+   */
+  aICompletionStream: {
+    methodKind: "server_streaming";
+    input: typeof AICompletionRequestSchema;
+    output: typeof AICompletionStreamResponseSchema;
+  },
 }>;
 
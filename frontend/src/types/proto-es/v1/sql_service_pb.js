@@ -16,7 +16,7 @@ import { file_v1_database_service } from "./database_service_pb";
  * Describes the file v1/sql_service.proto.
  */
 export const file_v1_sql_service = /*@__PURE__*/
-  fileDesc("ChR2MS9zcWxfc2VydmljZS5wcm90bxILYnl0ZWJhc2UudjEisAEKE0FkbWluRXhlY3V0ZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJc3RhdGVtZW50GAMgASgJEg0KBWxpbWl0GAQgASgFEhMKBnNjaGVtYRgGIAEoCUgAiAEBEhYKCWNvbnRhaW5lchgHIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJBChRBZG1pbkV4ZWN1dGVSZXNwb25zZRIpCgdyZXN1bHRzGAEgAygLMhguYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQihwIKDFF1ZXJ5UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYAyABKAkSDQoFbGltaXQYBCABKAUSGwoOZGF0YV9zb3VyY2VfaWQYBiABKAlCA+BBAhIPCgdleHBsYWluGAcgASgIEhMKBnNjaGVtYRgIIAEoCUgAiAEBEi4KDHF1ZXJ5X29wdGlvbhgJIAEoCzIYLmJ5dGViYXNlLnYxLlF1ZXJ5T3B0aW9uEhYKCWNvbnRhaW5lchgKIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJACg1RdWVyeVJlc3BvbnNlEikKB3Jlc3VsdHMYASADKAsyGC5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdEoECAIQAyL5AgoLUXVlcnlPcHRpb24SSgoVcmVkaXNfcnVuX2NvbW1hbmRzX29uGAEgASgOMisuYnl0ZWJhc2UudjEuUXVlcnlPcHRpb24uUmVkaXNSdW5Db21tYW5kc09uEkkKFG1zc3FsX2V4cGxhaW5fZm9ybWF0GAIgASgOMisuYnl0ZWJhc2UudjEuUXVlcnlPcHRpb24uTVNTUUxFeHBsYWluRm9ybWF0IlsKElJlZGlzUnVuQ29tbWFuZHNPbhIlCiFSRURJU19SVU5fQ09NTUFORFNfT05fVU5TUEVDSUZJRUQQABIPCgtTSU5HTEVfTk9ERRABEg0KCUFMTF9OT0RFUxACInYKEk1TU1FMRXhwbGFpbkZvcm1hdBIkCiBNU1NRTF9FWFBMQUlOX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhwKGE1TU1FMX0VYUExBSU5fRk9STUFUX0FMTBABEhwKGE1TU1FMX0VYUExBSU5fRk9STUFUX1hNTBACIpUKCgtRdWVyeVJlc3VsdBIUCgxjb2x1bW5fbmFtZXMYASADKAkSGQoRY29sdW1uX3R5cGVfbmFtZXMYAiADKAkSIwoEcm93cxgDIAMoCzIVLmJ5dGViYXNlLnYxLlF1ZXJ5Um93EhIKCnJvd3NfY291bnQYCiABKAMSDQoFZXJyb3IYBiABKAkSKgoHbGF0ZW5jeRgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIRCglzdGF0ZW1lbnQYCCABKAkSQAoOcG9zdGdyZXNfZXJyb3IYCSABKAsyJi5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdC5Qb3N0Z3Jlc0Vycm9ySAASPAoMc3ludGF4X2Vycm9yGA0gASgLMiQuYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQuU3ludGF4RXJyb3JIABJGChFwZXJtaXNzaW9uX2RlbmllZBgOIAEoCzIpLmJ5dGViYXNlLnYxLlF1ZXJ5UmVzdWx0LlBlcm1pc3Npb25EZW5pZWRIABIyCghtZXNzYWdlcxgMIAMoCzIgLmJ5dGViYXNlLnYxLlF1ZXJ5UmVzdWx0Lk1lc3NhZ2USKgoGbWFza2VkGAQgAygLMhouYnl0ZWJhc2UudjEuTWFza2luZ1JlYXNvbhrOAgoNUG9zdGdyZXNFcnJvchIQCghzZXZlcml0eRgBIAEoCRIMCgRjb2RlGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSDgoGZGV0YWlsGAQgASgJEgwKBGhpbnQYBSABKAkSEAoIcG9zaXRpb24YBiABKAUSGQoRaW50ZXJuYWxfcG9zaXRpb24YByABKAUSFgoOaW50ZXJuYWxfcXVlcnkYCCABKAkSDQoFd2hlcmUYCSABKAkSEwoLc2NoZW1hX25hbWUYCiABKAkSEgoKdGFibGVfbmFtZRgLIAEoCRITCgtjb2x1bW5fbmFtZRgMIAEoCRIWCg5kYXRhX3R5cGVfbmFtZRgNIAEoCRIXCg9jb25zdHJhaW50X25hbWUYDiABKAkSDAoEZmlsZRgPIAEoCRIMCgRsaW5lGBAgASgFEg8KB3JvdXRpbmUYESABKAkaPAoLU3ludGF4RXJyb3ISLQoOc3RhcnRfcG9zaXRpb24YASABKAsyFS5ieXRlYmFzZS52MS5Qb3NpdGlvbhrEAQoQUGVybWlzc2lvbkRlbmllZBIRCglyZXNvdXJjZXMYASADKAkSSwoMY29tbWFuZF90eXBlGAIgASgOMjUuYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQuUGVybWlzc2lvbkRlbmllZC5Db21tYW5kVHlwZSJQCgtDb21tYW5kVHlwZRIcChhDT01NQU5EX1RZUEVfVU5TUEVDSUZJRUQQABIHCgNEREwQARIHCgNETUwQAhIRCg1OT05fUkVBRF9PTkxZEAMatwEKB01lc3NhZ2USNQoFbGV2ZWwYASABKA4yJi5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdC5NZXNzYWdlLkxldmVsEg8KB2NvbnRlbnQYAiABKAkiZAoFTGV2ZWwSFQoRTEVWRUxfVU5TUEVDSUZJRUQQABIICgRJTkZPEAESCwoHV0FSTklORxACEgkKBURFQlVHEAMSBwoDTE9HEAQSCgoGTk9USUNFEAUSDQoJRVhDRVBUSU9OEAZCEAoOZGV0YWlsZWRfZXJyb3JKBAgLEAwivQEKDU1hc2tpbmdSZWFzb24SGAoQc2VtYW50aWNfdHlwZV9pZBgBIAEoCRIbChNzZW1hbnRpY190eXBlX3RpdGxlGAIgASgJEhcKD21hc2tpbmdfcnVsZV9pZBgDIAEoCRIRCglhbGdvcml0aG0YBCABKAkSDwoHY29udGV4dBgFIAEoCRIcChRjbGFzc2lmaWNhdGlvbl9sZXZlbBgGIAEoCRIaChJzZW1hbnRpY190eXBlX2ljb24YByABKAkiMQoIUXVlcnlSb3cSJQoGdmFsdWVzGAEgAygLMhUuYnl0ZWJhc2UudjEuUm93VmFsdWUijAUKCFJvd1ZhbHVlEjAKCm51bGxfdmFsdWUYASABKA4yGi5nb29nbGUucHJvdG9idWYuTnVsbFZhbHVlSAASFAoKYm9vbF92YWx1ZRgCIAEoCEgAEhUKC2J5dGVzX3ZhbHVlGAMgASgMSAASFgoMZG91YmxlX3ZhbHVlGAQgASgBSAASFQoLZmxvYXRfdmFsdWUYBSABKAJIABIVCgtpbnQzMl92YWx1ZRgGIAEoBUgAEhUKC2ludDY0X3ZhbHVlGAcgASgDSAASFgoMc3RyaW5nX3ZhbHVlGAggASgJSAASFgoMdWludDMyX3ZhbHVlGAkgASgNSAASFgoMdWludDY0X3ZhbHVlGAogASgESAASLQoLdmFsdWVfdmFsdWUYCyABKAsyFi5nb29nbGUucHJvdG9idWYuVmFsdWVIABI6Cg90aW1lc3RhbXBfdmFsdWUYDCABKAsyHy5ieXRlYmFzZS52MS5Sb3dWYWx1ZS5UaW1lc3RhbXBIABI/ChJ0aW1lc3RhbXBfdHpfdmFsdWUYDSABKAsyIS5ieXRlYmFzZS52MS5Sb3dWYWx1ZS5UaW1lc3RhbXBUWkgAGlMKCVRpbWVzdGFtcBI0ChBnb29nbGVfdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghhY2N1cmFjeRgCIAEoBRpzCgtUaW1lc3RhbXBUWhI0ChBnb29nbGVfdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgR6b25lGAIgASgJEg4KBm9mZnNldBgDIAEoBRIQCghhY2N1cmFjeRgEIAEoBUIGCgRraW5kIpUDCgZBZHZpY2USKQoGc3RhdHVzGAEgASgOMhkuYnl0ZWJhc2UudjEuQWR2aWNlLkxldmVsEgwKBGNvZGUYAiABKAUSDQoFdGl0bGUYAyABKAkSDwoHY29udGVudBgEIAEoCRItCg5zdGFydF9wb3NpdGlvbhgIIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgJIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEi8KCXJ1bGVfdHlwZRgKIAEoDjIcLmJ5dGViYXNlLnYxLkFkdmljZS5SdWxlVHlwZSJKCgVMZXZlbBIcChhBRFZJQ0VfTEVWRUxfVU5TUEVDSUZJRUQQABILCgdTVUNDRVNTEAESCwoHV0FSTklORxACEgkKBUVSUk9SEAMiRwoIUnVsZVR5cGUSGQoVUlVMRV9UWVBFX1VOU1BFQ0lGSUVEEAASEAoMUEFSU0VSX0JBU0VEEAESDgoKQUlfUE9XRVJFRBACSgQIBxAISgQIBRAGSgQIBhAHIugBCg1FeHBvcnRSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVYnl0ZWJhc2UuY29tL0RhdGFiYXNlEhEKCXN0YXRlbWVudBgDIAEoCRINCgVsaW1pdBgEIAEoBRIpCgZmb3JtYXQYBSABKA4yGS5ieXRlYmFzZS52MS5FeHBvcnRGb3JtYXQSDQoFYWRtaW4YBiABKAgSEAoIcGFzc3dvcmQYByABKAkSFgoOZGF0YV9zb3VyY2VfaWQYCCABKAkSEwoGc2NoZW1hGAkgASgJSACIAQFCCQoHX3NjaGVtYUoECAIQAyIhCg5FeHBvcnRSZXNwb25zZRIPCgdjb250ZW50GAEgASgMIqACChNEaWZmTWV0YWRhdGFSZXF1ZXN0EjsKD3NvdXJjZV9tZXRhZGF0YRgBIAEoCzIdLmJ5dGViYXNlLnYxLkRhdGFiYXNlTWV0YWRhdGFCA+BBAhI7Cg90YXJnZXRfbWV0YWRhdGEYAiABKAsyHS5ieXRlYmFzZS52MS5EYXRhYmFzZU1ldGFkYXRhQgPgQQISNAoOc291cmNlX2NhdGFsb2cYBSABKAsyHC5ieXRlYmFzZS52MS5EYXRhYmFzZUNhdGFsb2cSNAoOdGFyZ2V0X2NhdGFsb2cYBiABKAsyHC5ieXRlYmFzZS52MS5EYXRhYmFzZUNhdGFsb2cSIwoGZW5naW5lGAMgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lIiQKFERpZmZNZXRhZGF0YVJlc3BvbnNlEgwKBGRpZmYYASABKAkiVAobU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJEg4KBmZpbHRlchgDIAEoCSJwChxTZWFyY2hRdWVyeUhpc3Rvcmllc1Jlc3BvbnNlEjcKD3F1ZXJ5X2hpc3RvcmllcxgBIAMoCzIZLmJ5dGViYXNlLnYxLlF1ZXJ5SGlzdG9yeUID4EEDEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSLUAgoMUXVlcnlIaXN0b3J5EhEKBG5hbWUYASABKAlCA+BBAxIVCghkYXRhYmFzZRgCIAEoCUID4EEDEhQKB2NyZWF0b3IYAyABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIWCglzdGF0ZW1lbnQYBSABKAlCA+BBAxIXCgVlcnJvchgGIAEoCUID4EEDSACIAQESMAoIZHVyYXRpb24YByABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBAxIsCgR0eXBlGAggASgOMh4uYnl0ZWJhc2UudjEuUXVlcnlIaXN0b3J5LlR5cGUiMwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCQoFUVVFUlkQARIKCgZFWFBPUlQQAkIICgZfZXJyb3IiewoTQUlDb21wbGV0aW9uUmVxdWVzdBI6CghtZXNzYWdlcxgBIAMoCzIoLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlcXVlc3QuTWVzc2FnZRooCgdNZXNzYWdlEgwKBHJvbGUYASABKAkSDwoHY29udGVudBgCIAEoCSKVAgoUQUlDb21wbGV0aW9uUmVzcG9uc2USPwoKY2FuZGlkYXRlcxgBIAMoCzIrLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlc3BvbnNlLkNhbmRpZGF0ZRq7AQoJQ2FuZGlkYXRlEkQKB2NvbnRlbnQYASABKAsyMy5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXNwb25zZS5DYW5kaWRhdGUuQ29udGVudBpoCgdDb250ZW50EkcKBXBhcnRzGAEgAygLMjguYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uUmVzcG9uc2UuQ2FuZGlkYXRlLkNvbnRlbnQuUGFydBoUCgRQYXJ0EgwKBHRleHQYASABKAkyvQcKClNRTFNlcnZpY2USjwEKBVF1ZXJ5EhkuYnl0ZWJhc2UudjEuUXVlcnlSZXF1ZXN0GhouYnl0ZWJhc2UudjEuUXVlcnlSZXNwb25zZSJPiuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABmOowAYLT5JMCLToBKiIoL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfTpxdWVyeRKJAQoMQWRtaW5FeGVjdXRlEiAuYnl0ZWJhc2UudjEuQWRtaW5FeGVjdXRlUmVxdWVzdBohLmJ5dGViYXNlLnYxLkFkbWluRXhlY3V0ZVJlc3BvbnNlIjCK6jAMYmIuc3FsLmFkbWlukOowAZjqMAGC0+STAhISEC92MTphZG1pbkV4ZWN1dGUoATABEpUBChRTZWFyY2hRdWVyeUhpc3RvcmllcxIoLmJ5dGViYXNlLnYxLlNlYXJjaFF1ZXJ5SGlzdG9yaWVzUmVxdWVzdBopLmJ5dGViYXNlLnYxLlNlYXJjaFF1ZXJ5SGlzdG9yaWVzUmVzcG9uc2UiKJDqMAKC0+STAh46ASoiGS92MS9xdWVyeUhpc3RvcmllczpzZWFyY2gS+gEKBkV4cG9ydBIaLmJ5dGViYXNlLnYxLkV4cG9ydFJlcXVlc3QaGy5ieXRlYmFzZS52MS5FeHBvcnRSZXNwb25zZSK2AYrqMBBiYi5kYXRhYmFzZXMuZ2V0kOowAZjqMAGC0+STApMBOgEqWiw6ASoiJy92MS97bmFtZT1wcm9qZWN0cy8qL3JvbGxvdXRzLyp9OmV4cG9ydFo1OgEqIjAvdjEve25hbWU9cHJvamVjdHMvKi9yb2xsb3V0cy8qL3N0YWdlcy8qfTpleHBvcnQiKS92MS97bmFtZT1pbnN0YW5jZXMvKi9kYXRhYmFzZXMvKn06ZXhwb3J0EoEBCgxEaWZmTWV0YWRhdGESIC5ieXRlYmFzZS52MS5EaWZmTWV0YWRhdGFSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuRGlmZk1ldGFkYXRhUmVzcG9uc2UiLIDqMAGC0+STAiI6ASoiHS92MS9zY2hlbWFEZXNpZ246ZGlmZk1ldGFkYXRhEngKDEFJQ29tcGxldGlvbhIgLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlcXVlc3QaIS5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXNwb25zZSIjkOowAoLT5JMCGToBKiIUL3YxL3NxbC9haUNvbXBsZXRpb25CpQEKD2NvbS5ieXRlYmFzZS52MUIPU3FsU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_struct, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service]);
+  fileDesc("ChR2MS9zcWxfc2VydmljZS5wcm90bxILYnl0ZWJhc2UudjEisAEKE0FkbWluRXhlY3V0ZVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVieXRlYmFzZS5jb20vRGF0YWJhc2USEQoJc3RhdGVtZW50GAMgASgJEg0KBWxpbWl0GAQgASgFEhMKBnNjaGVtYRgGIAEoCUgAiAEBEhYKCWNvbnRhaW5lchgHIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJBChRBZG1pbkV4ZWN1dGVSZXNwb25zZRIpCgdyZXN1bHRzGAEgAygLMhguYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQihwIKDFF1ZXJ5UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYAyABKAkSDQoFbGltaXQYBCABKAUSGwoOZGF0YV9zb3VyY2VfaWQYBiABKAlCA+BBAhIPCgdleHBsYWluGAcgASgIEhMKBnNjaGVtYRgIIAEoCUgAiAEBEi4KDHF1ZXJ5X29wdGlvbhgJIAEoCzIYLmJ5dGViYXNlLnYxLlF1ZXJ5T3B0aW9uEhYKCWNvbnRhaW5lchgKIAEoCUgBiAEBQgkKB19zY2hlbWFCDAoKX2NvbnRhaW5lckoECAIQAyJACg1RdWVyeVJlc3BvbnNlEikKB3Jlc3VsdHMYASADKAsyGC5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdEoECAIQAyL5AgoLUXVlcnlPcHRpb24SSgoVcmVkaXNfcnVuX2NvbW1hbmRzX29uGAEgASgOMisuYnl0ZWJhc2UudjEuUXVlcnlPcHRpb24uUmVkaXNSdW5Db21tYW5kc09uEkkKFG1zc3FsX2V4cGxhaW5fZm9ybWF0GAIgASgOMisuYnl0ZWJhc2UudjEuUXVlcnlPcHRpb24uTVNTUUxFeHBsYWluRm9ybWF0IlsKElJlZGlzUnVuQ29tbWFuZHNPbhIlCiFSRURJU19SVU5fQ09NTUFORFNfT05fVU5TUEVDSUZJRUQQABIPCgtTSU5HTEVfTk9ERRABEg0KCUFMTF9OT0RFUxACInYKEk1TU1FMRXhwbGFpbkZvcm1hdBIkCiBNU1NRTF9FWFBMQUlOX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhwKGE1TU1FMX0VYUExBSU5fRk9STUFUX0FMTBABEhwKGE1TU1FMX0VYUExBSU5fRk9STUFUX1hNTBACIpUKCgtRdWVyeVJlc3VsdBIUCgxjb2x1bW5fbmFtZXMYASADKAkSGQoRY29sdW1uX3R5cGVfbmFtZXMYAiADKAkSIwoEcm93cxgDIAMoCzIVLmJ5dGViYXNlLnYxLlF1ZXJ5Um93EhIKCnJvd3NfY291bnQYCiABKAMSDQoFZXJyb3IYBiABKAkSKgoHbGF0ZW5jeRgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIRCglzdGF0ZW1lbnQYCCABKAkSQAoOcG9zdGdyZXNfZXJyb3IYCSABKAsyJi5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdC5Qb3N0Z3Jlc0Vycm9ySAASPAoMc3ludGF4X2Vycm9yGA0gASgLMiQuYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQuU3ludGF4RXJyb3JIABJGChFwZXJtaXNzaW9uX2RlbmllZBgOIAEoCzIpLmJ5dGViYXNlLnYxLlF1ZXJ5UmVzdWx0LlBlcm1pc3Npb25EZW5pZWRIABIyCghtZXNzYWdlcxgMIAMoCzIgLmJ5dGViYXNlLnYxLlF1ZXJ5UmVzdWx0Lk1lc3NhZ2USKgoGbWFza2VkGAQgAygLMhouYnl0ZWJhc2UudjEuTWFza2luZ1JlYXNvbhrOAgoNUG9zdGdyZXNFcnJvchIQCghzZXZlcml0eRgBIAEoCRIMCgRjb2RlGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSDgoGZGV0YWlsGAQgASgJEgwKBGhpbnQYBSABKAkSEAoIcG9zaXRpb24YBiABKAUSGQoRaW50ZXJuYWxfcG9zaXRpb24YByABKAUSFgoOaW50ZXJuYWxfcXVlcnkYCCABKAkSDQoFd2hlcmUYCSABKAkSEwoLc2NoZW1hX25hbWUYCiABKAkSEgoKdGFibGVfbmFtZRgLIAEoCRITCgtjb2x1bW5fbmFtZRgMIAEoCRIWCg5kYXRhX3R5cGVfbmFtZRgNIAEoCRIXCg9jb25zdHJhaW50X25hbWUYDiABKAkSDAoEZmlsZRgPIAEoCRIMCgRsaW5lGBAgASgFEg8KB3JvdXRpbmUYESABKAkaPAoLU3ludGF4RXJyb3ISLQoOc3RhcnRfcG9zaXRpb24YASABKAsyFS5ieXRlYmFzZS52MS5Qb3NpdGlvbhrEAQoQUGVybWlzc2lvbkRlbmllZBIRCglyZXNvdXJjZXMYASADKAkSSwoMY29tbWFuZF90eXBlGAIgASgOMjUuYnl0ZWJhc2UudjEuUXVlcnlSZXN1bHQuUGVybWlzc2lvbkRlbmllZC5Db21tYW5kVHlwZSJQCgtDb21tYW5kVHlwZRIcChhDT01NQU5EX1RZUEVfVU5TUEVDSUZJRUQQABIHCgNEREwQARIHCgNETUwQAhIRCg1OT05fUkVBRF9PTkxZEAMatwEKB01lc3NhZ2USNQoFbGV2ZWwYASABKA4yJi5ieXRlYmFzZS52MS5RdWVyeVJlc3VsdC5NZXNzYWdlLkxldmVsEg8KB2NvbnRlbnQYAiABKAkiZAoFTGV2ZWwSFQoRTEVWRUxfVU5TUEVDSUZJRUQQABIICgRJTkZPEAESCwoHV0FSTklORxACEgkKBURFQlVHEAMSBwoDTE9HEAQSCgoGTk9USUNFEAUSDQoJRVhDRVBUSU9OEAZCEAoOZGV0YWlsZWRfZXJyb3JKBAgLEAwivQEKDU1hc2tpbmdSZWFzb24SGAoQc2VtYW50aWNfdHlwZV9pZBgBIAEoCRIbChNzZW1hbnRpY190eXBlX3RpdGxlGAIgASgJEhcKD21hc2tpbmdfcnVsZV9pZBgDIAEoCRIRCglhbGdvcml0aG0YBCABKAkSDwoHY29udGV4dBgFIAEoCRIcChRjbGFzc2lmaWNhdGlvbl9sZXZlbBgGIAEoCRIaChJzZW1hbnRpY190eXBlX2ljb24YByABKAkiMQoIUXVlcnlSb3cSJQoGdmFsdWVzGAEgAygLMhUuYnl0ZWJhc2UudjEuUm93VmFsdWUijAUKCFJvd1ZhbHVlEjAKCm51bGxfdmFsdWUYASABKA4yGi5nb29nbGUucHJvdG9idWYuTnVsbFZhbHVlSAASFAoKYm9vbF92YWx1ZRgCIAEoCEgAEhUKC2J5dGVzX3ZhbHVlGAMgASgMSAASFgoMZG91YmxlX3ZhbHVlGAQgASgBSAASFQoLZmxvYXRfdmFsdWUYBSABKAJIABIVCgtpbnQzMl92YWx1ZRgGIAEoBUgAEhUKC2ludDY0X3ZhbHVlGAcgASgDSAASFgoMc3RyaW5nX3ZhbHVlGAggASgJSAASFgoMdWludDMyX3ZhbHVlGAkgASgNSAASFgoMdWludDY0X3ZhbHVlGAogASgESAASLQoLdmFsdWVfdmFsdWUYCyABKAsyFi5nb29nbGUucHJvdG9idWYuVmFsdWVIABI6Cg90aW1lc3RhbXBfdmFsdWUYDCABKAsyHy5ieXRlYmFzZS52MS5Sb3dWYWx1ZS5UaW1lc3RhbXBIABI/ChJ0aW1lc3RhbXBfdHpfdmFsdWUYDSABKAsyIS5ieXRlYmFzZS52MS5Sb3dWYWx1ZS5UaW1lc3RhbXBUWkgAGlMKCVRpbWVzdGFtcBI0ChBnb29nbGVfdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghhY2N1cmFjeRgCIAEoBRpzCgtUaW1lc3RhbXBUWhI0ChBnb29nbGVfdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgR6b25lGAIgASgJEg4KBm9mZnNldBgDIAEoBRIQCghhY2N1cmFjeRgEIAEoBUIGCgRraW5kIscDCgZBZHZpY2USKQoGc3RhdHVzGAEgASgOMhkuYnl0ZWJhc2UudjEuQWR2aWNlLkxldmVsEgwKBGNvZGUYAiABKAUSDQoFdGl0bGUYAyABKAkSDwoHY29udGVudBgEIAEoCRItCg5zdGFydF9wb3NpdGlvbhgIIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgJIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEi8KCXJ1bGVfdHlwZRgKIAEoDjIcLmJ5dGViYXNlLnYxLkFkdmljZS5SdWxlVHlwZRIwCg1zdWdnZXN0ZWRfZml4GAsgASgLMhkuYnl0ZWJhc2UudjEuU3VnZ2VzdGVkRml4IkoKBUxldmVsEhwKGEFEVklDRV9MRVZFTF9VTlNQRUNJRklFRBAAEgsKB1NVQ0NFU1MQARILCgdXQVJOSU5HEAISCQoFRVJST1IQAyJHCghSdWxlVHlwZRIZChVSVUxFX1RZUEVfVU5TUEVDSUZJRUQQABIQCgxQQVJTRVJfQkFTRUQQARIOCgpBSV9QT1dFUkVEEAJKBAgHEAhKBAgFEAZKBAgGEAciQwoMU3VnZ2VzdGVkRml4Eg0KBXRpdGxlGAEgASgJEiQKBWVkaXRzGAIgAygLMhUuYnl0ZWJhc2UudjEuVGV4dEVkaXQieAoIVGV4dEVkaXQSLQoOc3RhcnRfcG9zaXRpb24YASABKAsyFS5ieXRlYmFzZS52MS5Qb3NpdGlvbhIrCgxlbmRfcG9zaXRpb24YAiABKAsyFS5ieXRlYmFzZS52MS5Qb3NpdGlvbhIQCghuZXdfdGV4dBgDIAEoCSLoAQoNRXhwb3J0UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFWJ5dGViYXNlLmNvbS9EYXRhYmFzZRIRCglzdGF0ZW1lbnQYAyABKAkSDQoFbGltaXQYBCABKAUSKQoGZm9ybWF0GAUgASgOMhkuYnl0ZWJhc2UudjEuRXhwb3J0Rm9ybWF0Eg0KBWFkbWluGAYgASgIEhAKCHBhc3N3b3JkGAcgASgJEhYKDmRhdGFfc291cmNlX2lkGAggASgJEhMKBnNjaGVtYRgJIAEoCUgAiAEBQgkKB19zY2hlbWFKBAgCEAMiIQoORXhwb3J0UmVzcG9uc2USDwoHY29udGVudBgBIAEoDCKgAgoTRGlmZk1ldGFkYXRhUmVxdWVzdBI7Cg9zb3VyY2VfbWV0YWRhdGEYASABKAsyHS5ieXRlYmFzZS52MS5EYXRhYmFzZU1ldGFkYXRhQgPgQQISOwoPdGFyZ2V0X21ldGFkYXRhGAIgASgLMh0uYnl0ZWJhc2UudjEuRGF0YWJhc2VNZXRhZGF0YUID4EECEjQKDnNvdXJjZV9jYXRhbG9nGAUgASgLMhwuYnl0ZWJhc2UudjEuRGF0YWJhc2VDYXRhbG9nEjQKDnRhcmdldF9jYXRhbG9nGAYgASgLMhwuYnl0ZWJhc2UudjEuRGF0YWJhc2VDYXRhbG9nEiMKBmVuZ2luZRgDIAEoDjITLmJ5dGViYXNlLnYxLkVuZ2luZSIkChREaWZmTWV0YWRhdGFSZXNwb25zZRIMCgRkaWZmGAEgASgJIlQKG1NlYXJjaFF1ZXJ5SGlzdG9yaWVzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCRIOCgZmaWx0ZXIYAyABKAkicAocU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXNwb25zZRI3Cg9xdWVyeV9oaXN0b3JpZXMYASADKAsyGS5ieXRlYmFzZS52MS5RdWVyeUhpc3RvcnlCA+BBAxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAki9AIKDFF1ZXJ5SGlzdG9yeRIRCgRuYW1lGAEgASgJQgPgQQMSFQoIZGF0YWJhc2UYAiABKAlCA+BBAxIUCgdjcmVhdG9yGAMgASgJQgPgQQMSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSFgoJc3RhdGVtZW50GAUgASgJQgPgQQMSFwoFZXJyb3IYBiABKAlCA+BBA0gAiAEBEjAKCGR1cmF0aW9uGAcgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uQgPgQQMSLAoEdHlwZRgIIAEoDjIeLmJ5dGViYXNlLnYxLlF1ZXJ5SGlzdG9yeS5UeXBlEh4KEWJyZWFrX2dsYXNzX2lzc3VlGAkgASgJQgPgQQMiMwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCQoFUVVFUlkQARIKCgZFWFBPUlQQAkIICgZfZXJyb3IiewoTQUlDb21wbGV0aW9uUmVxdWVzdBI6CghtZXNzYWdlcxgBIAMoCzIoLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlcXVlc3QuTWVzc2FnZRooCgdNZXNzYWdlEgwKBHJvbGUYASABKAkSDwoHY29udGVudBgCIAEoCSKVAgoUQUlDb21wbGV0aW9uUmVzcG9uc2USPwoKY2FuZGlkYXRlcxgBIAMoCzIrLmJ5dGViYXNlLnYxLkFJQ29tcGxldGlvblJlc3BvbnNlLkNhbmRpZGF0ZRq7AQoJQ2FuZGlkYXRlEkQKB2NvbnRlbnQYASABKAsyMy5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXNwb25zZS5DYW5kaWRhdGUuQ29udGVudBpoCgdDb250ZW50EkcKBXBhcnRzGAEgAygLMjguYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uUmVzcG9uc2UuQ2FuZGlkYXRlLkNvbnRlbnQuUGFydBoUCgRQYXJ0EgwKBHRleHQYASABKAkiKgoaQUlDb21wbGV0aW9uU3RyZWFtUmVzcG9uc2USDAoEdGV4dBgBIAEoCTKsCgoKU1FMU2VydmljZRKPAQoFUXVlcnkSGS5ieXRlYmFzZS52MS5RdWVyeVJlcXVlc3QaGi5ieXRlYmFzZS52MS5RdWVyeVJlc3BvbnNlIk+K6jAQYmIuZGF0YWJhc2VzLmdldJDqMAGY6jABgtPkkwItOgEqIigvdjEve25hbWU9aW5zdGFuY2VzLyovZGF0YWJhc2VzLyp9OnF1ZXJ5EokBCgxBZG1pbkV4ZWN1dGUSIC5ieXRlYmFzZS52MS5BZG1pbkV4ZWN1dGVSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuQWRtaW5FeGVjdXRlUmVzcG9uc2UiMIrqMAxiYi5zcWwuYWRtaW6Q6jABmOowAYLT5JMCEhIQL3YxOmFkbWluRXhlY3V0ZSgBMAESlQEKFFNlYXJjaFF1ZXJ5SGlzdG9yaWVzEiguYnl0ZWJhc2UudjEuU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXF1ZXN0GikuYnl0ZWJhc2UudjEuU2VhcmNoUXVlcnlIaXN0b3JpZXNSZXNwb25zZSIokOowAoLT5JMCHjoBKiIZL3YxL3F1ZXJ5SGlzdG9yaWVzOnNlYXJjaBL6AQoGRXhwb3J0EhouYnl0ZWJhc2UudjEuRXhwb3J0UmVxdWVzdBobLmJ5dGViYXNlLnYxLkV4cG9ydFJlc3BvbnNlIrYBiuowEGJiLmRhdGFiYXNlcy5nZXSQ6jABmOowAYLT5JMCkwE6ASpaLDoBKiInL3YxL3tuYW1lPXByb2plY3RzLyovcm9sbG91dHMvKn06ZXhwb3J0WjU6ASoiMC92MS97bmFtZT1wcm9qZWN0cy8qL3JvbGxvdXRzLyovc3RhZ2VzLyp9OmV4cG9ydCIpL3YxL3tuYW1lPWluc3RhbmNlcy8qL2RhdGFiYXNlcy8qfTpleHBvcnQS3QEKDEV4cG9ydFN0cmVhbRIaLmJ5dGViYXNlLnYxLkV4cG9ydFJlcXVlc3QaGy5ieXRlYmFzZS52MS5FeHBvcnRSZXNwb25zZSKRAYrqMBBiYi5kYXRhYmFzZXMuZ2V0kOowAZjqMAGC0+STAm86ASpaOzoBKiI2L3YxL3tuYW1lPXByb2plY3RzLyovcm9sbG91dHMvKi9zdGFnZXMvKn06ZXhwb3J0U3RyZWFtIi0vdjEve25hbWU9cHJvamVjdHMvKi9yb2xsb3V0cy8qfTpleHBvcnRTdHJlYW0wARKBAQoMRGlmZk1ldGFkYXRhEiAuYnl0ZWJhc2UudjEuRGlmZk1ldGFkYXRhUmVxdWVzdBohLmJ5dGViYXNlLnYxLkRpZmZNZXRhZGF0YVJlc3BvbnNlIiyA6jABgtPkkwIiOgEqIh0vdjEvc2NoZW1hRGVzaWduOmRpZmZNZXRhZGF0YRJ4CgxBSUNvbXBsZXRpb24SIC5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXF1ZXN0GiEuYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uUmVzcG9uc2UiI5DqMAKC0+STAhk6ASoiFC92MS9zcWwvYWlDb21wbGV0aW9uEowBChJBSUNvbXBsZXRpb25TdHJlYW0SIC5ieXRlYmFzZS52MS5BSUNvbXBsZXRpb25SZXF1ZXN0GicuYnl0ZWJhc2UudjEuQUlDb21wbGV0aW9uU3RyZWFtUmVzcG9uc2UiKZDqMAKC0+STAh86ASoiGi92MS9zcWwvYWlDb21wbGV0aW9uU3RyZWFtMAFCpQEKD2NvbS5ieXRlYmFzZS52MUIPU3FsU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_struct, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service]);
 
 /**
  * Describes the message bytebase.v1.AdminExecuteRequest.
@@ -206,60 +206,74 @@ export const Advice_RuleTypeSchema = /*@__PURE__*/
 export const Advice_RuleType = /*@__PURE__*/
   tsEnum(Advice_RuleTypeSchema);
 
+/**
+ * Describes the message bytebase.v1.SuggestedFix.
+ * Use `create(SuggestedFixSchema)` to create a new message.
+ */
+export const SuggestedFixSchema = /*@__PURE__*/
+  messageDesc(file_v1_sql_service, 10);
+
+/**
+ * Describes the message bytebase.v1.TextEdit.
+ * Use `create(TextEditSchema)` to create a new message.
+ */
+export const TextEditSchema = /*@__PURE__*/
+  messageDesc(file_v1_sql_service, 11);
+
 /**
  * Describes the message bytebase.v1.ExportRequest.
  * Use `create(ExportRequestSchema)` to create a new message.
  */
 export const ExportRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 10);
+  messageDesc(file_v1_sql_service, 12);
 
 /**
  * Describes the message bytebase.v1.ExportResponse.
  * Use `create(ExportResponseSchema)` to create a new message.
  */
 export const ExportResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 11);
+  messageDesc(file_v1_sql_service, 13);
 
 /**
  * Describes the message bytebase.v1.DiffMetadataRequest.
  * Use `create(DiffMetadataRequestSchema)` to create a new message.
  */
 export const DiffMetadataRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 12);
+  messageDesc(file_v1_sql_service, 14);
 
 /**
  * Describes the message bytebase.v1.DiffMetadataResponse.
  * Use `create(DiffMetadataResponseSchema)` to create a new message.
  */
 export const DiffMetadataResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 13);
+  messageDesc(file_v1_sql_service, 15);
 
 /**
  * Describes the message bytebase.v1.SearchQueryHistoriesRequest.
  * Use `create(SearchQueryHistoriesRequestSchema)` to create a new message.
  */
 export const SearchQueryHistoriesRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 14);
+  messageDesc(file_v1_sql_service, 16);
 
 /**
  * Describes the message bytebase.v1.SearchQueryHistoriesResponse.
  * Use `create(SearchQueryHistoriesResponseSchema)` to create a new message.
  */
 export const SearchQueryHistoriesResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 15);
+  messageDesc(file_v1_sql_service, 17);
 
 /**
  * Describes the message bytebase.v1.QueryHistory.
  * Use `create(QueryHistorySchema)` to create a new message.
  */
 export const QueryHistorySchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 16);
+  messageDesc(file_v1_sql_service, 18);
 
 /**
  * Describes the enum bytebase.v1.QueryHistory.Type.
  */
 export const QueryHistory_TypeSchema = /*@__PURE__*/
-  enumDesc(file_v1_sql_service, 16, 0);
+  enumDesc(file_v1_sql_service, 18, 0);
 
 /**
  * @generated from enum bytebase.v1.QueryHistory.Type
@@ -272,42 +286,49 @@ export const QueryHistory_Type = /*@__PURE__*/
  * Use `create(AICompletionRequestSchema)` to create a new message.
  */
 export const AICompletionRequestSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 17);
+  messageDesc(file_v1_sql_service, 19);
 
 /**
  * Describes the message bytebase.v1.AICompletionRequest.Message.
  * Use `create(AICompletionRequest_MessageSchema)` to create a new message.
  */
 export const AICompletionRequest_MessageSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 17, 0);
+  messageDesc(file_v1_sql_service, 19, 0);
 
 /**
  * Describes the message bytebase.v1.AICompletionResponse.
  * Use `create(AICompletionResponseSchema)` to create a new message.
  */
 export const AICompletionResponseSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 18);
+  messageDesc(file_v1_sql_service, 20);
 
 /**
  * Describes the message bytebase.v1.AICompletionResponse.Candidate.
  * Use `create(AICompletionResponse_CandidateSchema)` to create a new message.
  */
 export const AICompletionResponse_CandidateSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 18, 0);
+  messageDesc(file_v1_sql_service, 20, 0);
 
 /**
  * Describes the message bytebase.v1.AICompletionResponse.Candidate.Content.
  * Use `create(AICompletionResponse_Candidate_ContentSchema)` to create a new message.
  */
 export const AICompletionResponse_Candidate_ContentSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 18, 0, 0);
+  messageDesc(file_v1_sql_service, 20, 0, 0);
 
 /**
  * Describes the message bytebase.v1.AICompletionResponse.Candidate.Content.Part.
  * Use `create(AICompletionResponse_Candidate_Content_PartSchema)` to create a new message.
  */
 export const AICompletionResponse_Candidate_Content_PartSchema = /*@__PURE__*/
-  messageDesc(file_v1_sql_service, 18, 0, 0, 0);
+  messageDesc(file_v1_sql_service, 20, 0, 0, 0);
+
+/**
+ * Describes the message bytebase.v1.AICompletionStreamResponse.
+ * Use `create(AICompletionStreamResponseSchema)` to create a new message.
+ */
+export const AICompletionStreamResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_sql_service, 21);
 
 /**
  * SQLService executes SQL queries and manages query operations.
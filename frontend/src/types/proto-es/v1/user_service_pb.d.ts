@@ -420,6 +420,314 @@ export declare type User_Profile = Message<"bytebase.v1.User.Profile"> & {
  */
 export declare const User_ProfileSchema: GenMessage<User_Profile>;
 
+/**
+ * @generated from message bytebase.v1.PersonalAccessToken
+ */
+export declare type PersonalAccessToken = Message<"bytebase.v1.PersonalAccessToken"> & {
+  /**
+   * The name of the personal access token.
+   * Format: users/{user}/personalAccessTokens/{personal_access_token}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The title describing what the token is used for.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The token used as the bearer token of the API requests.
+   * It is only returned by CreatePersonalAccessToken and cannot be retrieved afterwards.
+   *
+   * @generated from field: string token = 3;
+   */
+  token: string;
+
+  /**
+   * The permissions restricting the token to a subset of the user's permissions, such as "bb.databases.list".
+   * Empty means the token has all the permissions of the user.
+   *
+   * @generated from field: repeated string permissions = 4;
+   */
+  permissions: string[];
+
+  /**
+   * The expiration time of the token. The token never expires if not set.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 5;
+   */
+  expireTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 6;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The last time the token was used to authenticate a request.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_time = 7;
+   */
+  lastUsedTime?: Timestamp;
+};
+
+/**
+ * Describes the message bytebase.v1.PersonalAccessToken.
+ * Use `create(PersonalAccessTokenSchema)` to create a new message.
+ */
+export declare const PersonalAccessTokenSchema: GenMessage<PersonalAccessToken>;
+
+/**
+ * @generated from message bytebase.v1.CreatePersonalAccessTokenRequest
+ */
+export declare type CreatePersonalAccessTokenRequest = Message<"bytebase.v1.CreatePersonalAccessTokenRequest"> & {
+  /**
+   * The user to create the token for, which must be the caller.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The personal access token to create.
+   *
+   * @generated from field: bytebase.v1.PersonalAccessToken personal_access_token = 2;
+   */
+  personalAccessToken?: PersonalAccessToken;
+};
+
+/**
+ * Describes the message bytebase.v1.CreatePersonalAccessTokenRequest.
+ * Use `create(CreatePersonalAccessTokenRequestSchema)` to create a new message.
+ */
+export declare const CreatePersonalAccessTokenRequestSchema: GenMessage<CreatePersonalAccessTokenRequest>;
+
+/**
+ * @generated from message bytebase.v1.ListPersonalAccessTokensRequest
+ */
+export declare type ListPersonalAccessTokensRequest = Message<"bytebase.v1.ListPersonalAccessTokensRequest"> & {
+  /**
+   * The user owning the tokens.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ListPersonalAccessTokensRequest.
+ * Use `create(ListPersonalAccessTokensRequestSchema)` to create a new message.
+ */
+export declare const ListPersonalAccessTokensRequestSchema: GenMessage<ListPersonalAccessTokensRequest>;
+
+/**
+ * @generated from message bytebase.v1.ListPersonalAccessTokensResponse
+ */
+export declare type ListPersonalAccessTokensResponse = Message<"bytebase.v1.ListPersonalAccessTokensResponse"> & {
+  /**
+   * The personal access tokens of the user, the tokens themselves are not returned.
+   *
+   * @generated from field: repeated bytebase.v1.PersonalAccessToken personal_access_tokens = 1;
+   */
+  personalAccessTokens: PersonalAccessToken[];
+};
+
+/**
+ * Describes the message bytebase.v1.ListPersonalAccessTokensResponse.
+ * Use `create(ListPersonalAccessTokensResponseSchema)` to create a new message.
+ */
+export declare const ListPersonalAccessTokensResponseSchema: GenMessage<ListPersonalAccessTokensResponse>;
+
+/**
+ * @generated from message bytebase.v1.RevokePersonalAccessTokenRequest
+ */
+export declare type RevokePersonalAccessTokenRequest = Message<"bytebase.v1.RevokePersonalAccessTokenRequest"> & {
+  /**
+   * The name of the personal access token to revoke.
+   * Format: users/{user}/personalAccessTokens/{personal_access_token}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message bytebase.v1.RevokePersonalAccessTokenRequest.
+ * Use `create(RevokePersonalAccessTokenRequestSchema)` to create a new message.
+ */
+export declare const RevokePersonalAccessTokenRequestSchema: GenMessage<RevokePersonalAccessTokenRequest>;
+
+/**
+ * @generated from message bytebase.v1.WebAuthnCredential
+ */
+export declare type WebAuthnCredential = Message<"bytebase.v1.WebAuthnCredential"> & {
+  /**
+   * The name of the credential, the credential ID is base64url encoded.
+   * Format: users/{user}/webAuthnCredentials/{webauthn_credential}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The title of the credential, such as the name of the security key.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The last time the credential was used to sign in.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_time = 4;
+   */
+  lastUsedTime?: Timestamp;
+};
+
+/**
+ * Describes the message bytebase.v1.WebAuthnCredential.
+ * Use `create(WebAuthnCredentialSchema)` to create a new message.
+ */
+export declare const WebAuthnCredentialSchema: GenMessage<WebAuthnCredential>;
+
+/**
+ * @generated from message bytebase.v1.BeginWebAuthnRegistrationRequest
+ */
+export declare type BeginWebAuthnRegistrationRequest = Message<"bytebase.v1.BeginWebAuthnRegistrationRequest"> & {
+  /**
+   * The user to register the credential for, which must be the caller.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnRegistrationRequest.
+ * Use `create(BeginWebAuthnRegistrationRequestSchema)` to create a new message.
+ */
+export declare const BeginWebAuthnRegistrationRequestSchema: GenMessage<BeginWebAuthnRegistrationRequest>;
+
+/**
+ * @generated from message bytebase.v1.BeginWebAuthnRegistrationResponse
+ */
+export declare type BeginWebAuthnRegistrationResponse = Message<"bytebase.v1.BeginWebAuthnRegistrationResponse"> & {
+  /**
+   * The PublicKeyCredentialCreationOptions in the WebAuthn JSON format,
+   * which can be parsed by PublicKeyCredential.parseCreationOptionsFromJSON() in the browser.
+   *
+   * @generated from field: string options = 1;
+   */
+  options: string;
+};
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnRegistrationResponse.
+ * Use `create(BeginWebAuthnRegistrationResponseSchema)` to create a new message.
+ */
+export declare const BeginWebAuthnRegistrationResponseSchema: GenMessage<BeginWebAuthnRegistrationResponse>;
+
+/**
+ * @generated from message bytebase.v1.CreateWebAuthnCredentialRequest
+ */
+export declare type CreateWebAuthnCredentialRequest = Message<"bytebase.v1.CreateWebAuthnCredentialRequest"> & {
+  /**
+   * The user to register the credential for, which must be the caller.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The title of the credential.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The RegistrationResponseJSON returned by PublicKeyCredential.toJSON() after navigator.credentials.create().
+   *
+   * @generated from field: string registration_response = 3;
+   */
+  registrationResponse: string;
+};
+
+/**
+ * Describes the message bytebase.v1.CreateWebAuthnCredentialRequest.
+ * Use `create(CreateWebAuthnCredentialRequestSchema)` to create a new message.
+ */
+export declare const CreateWebAuthnCredentialRequestSchema: GenMessage<CreateWebAuthnCredentialRequest>;
+
+/**
+ * @generated from message bytebase.v1.ListWebAuthnCredentialsRequest
+ */
+export declare type ListWebAuthnCredentialsRequest = Message<"bytebase.v1.ListWebAuthnCredentialsRequest"> & {
+  /**
+   * The user owning the credentials.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ListWebAuthnCredentialsRequest.
+ * Use `create(ListWebAuthnCredentialsRequestSchema)` to create a new message.
+ */
+export declare const ListWebAuthnCredentialsRequestSchema: GenMessage<ListWebAuthnCredentialsRequest>;
+
+/**
+ * @generated from message bytebase.v1.ListWebAuthnCredentialsResponse
+ */
+export declare type ListWebAuthnCredentialsResponse = Message<"bytebase.v1.ListWebAuthnCredentialsResponse"> & {
+  /**
+   * The WebAuthn credentials of the user.
+   *
+   * @generated from field: repeated bytebase.v1.WebAuthnCredential webauthn_credentials = 1;
+   */
+  webauthnCredentials: WebAuthnCredential[];
+};
+
+/**
+ * Describes the message bytebase.v1.ListWebAuthnCredentialsResponse.
+ * Use `create(ListWebAuthnCredentialsResponseSchema)` to create a new message.
+ */
+export declare const ListWebAuthnCredentialsResponseSchema: GenMessage<ListWebAuthnCredentialsResponse>;
+
+/**
+ * @generated from message bytebase.v1.DeleteWebAuthnCredentialRequest
+ */
+export declare type DeleteWebAuthnCredentialRequest = Message<"bytebase.v1.DeleteWebAuthnCredentialRequest"> & {
+  /**
+   * The name of the credential to delete.
+   * Format: users/{user}/webAuthnCredentials/{webauthn_credential}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message bytebase.v1.DeleteWebAuthnCredentialRequest.
+ * Use `create(DeleteWebAuthnCredentialRequestSchema)` to create a new message.
+ */
+export declare const DeleteWebAuthnCredentialRequestSchema: GenMessage<DeleteWebAuthnCredentialRequest>;
+
 /**
  * @generated from enum bytebase.v1.UserType
  */
@@ -555,5 +863,84 @@ export declare const UserService: GenService<{
     input: typeof UndeleteUserRequestSchema;
     output: typeof UserSchema;
   },
+  /**
+   * Creates a personal access token for the caller. The token is only returned in the response.
+   * Personal access tokens cannot create other personal access tokens.
+   * Permissions required: None (self only)
+   *
+   * @generated from rpc bytebase.v1.UserService.CreatePersonalAccessToken
+   */
+  createPersonalAccessToken: {
+    methodKind: "unary";
+    input: typeof CreatePersonalAccessTokenRequestSchema;
+    output: typeof PersonalAccessTokenSchema;
+  },
+  /**
+   * Lists the personal access tokens of a user.
+   * Permissions required: bb.users.update (or self)
+   *
+   * @generated from rpc bytebase.v1.UserService.ListPersonalAccessTokens
+   */
+  listPersonalAccessTokens: {
+    methodKind: "unary";
+    input: typeof ListPersonalAccessTokensRequestSchema;
+    output: typeof ListPersonalAccessTokensResponseSchema;
+  },
+  /**
+   * Revokes a personal access token, the token cannot be used anymore.
+   * Permissions required: bb.users.update (or self)
+   *
+   * @generated from rpc bytebase.v1.UserService.RevokePersonalAccessToken
+   */
+  revokePersonalAccessToken: {
+    methodKind: "unary";
+    input: typeof RevokePersonalAccessTokenRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Starts registering a WebAuthn credential (passkey or security key) for the caller as the second factor.
+   * Permissions required: None (self only)
+   *
+   * @generated from rpc bytebase.v1.UserService.BeginWebAuthnRegistration
+   */
+  beginWebAuthnRegistration: {
+    methodKind: "unary";
+    input: typeof BeginWebAuthnRegistrationRequestSchema;
+    output: typeof BeginWebAuthnRegistrationResponseSchema;
+  },
+  /**
+   * Finishes registering a WebAuthn credential with the response of the authenticator.
+   * Permissions required: None (self only)
+   *
+   * @generated from rpc bytebase.v1.UserService.CreateWebAuthnCredential
+   */
+  createWebAuthnCredential: {
+    methodKind: "unary";
+    input: typeof CreateWebAuthnCredentialRequestSchema;
+    output: typeof WebAuthnCredentialSchema;
+  },
+  /**
+   * Lists the WebAuthn credentials of a user.
+   * Permissions required: bb.users.update (or self)
+   *
+   * @generated from rpc bytebase.v1.UserService.ListWebAuthnCredentials
+   */
+  listWebAuthnCredentials: {
+    methodKind: "unary";
+    input: typeof ListWebAuthnCredentialsRequestSchema;
+    output: typeof ListWebAuthnCredentialsResponseSchema;
+  },
+  /**
+   * Deletes a WebAuthn credential.
+   * The last second factor cannot be deleted if two-factor authentication is required by the workspace, except by workspace admins.
+   * Permissions required: bb.users.update (or self)
+   *
+   * @generated from rpc bytebase.v1.UserService.DeleteWebAuthnCredential
+   */
+  deleteWebAuthnCredential: {
+    methodKind: "unary";
+    input: typeof DeleteWebAuthnCredentialRequestSchema;
+    output: typeof EmptySchema;
+  },
 }>;
 
@@ -16,7 +16,7 @@ import { file_v1_common } from "./common_pb";
  * Describes the file v1/user_service.proto.
  */
 export const file_v1_user_service = /*@__PURE__*/
-  fileDesc("ChV2MS91c2VyX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIjkKDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIiQAoUQmF0Y2hHZXRVc2Vyc1JlcXVlc3QSKAoFbmFtZXMYASADKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIiOQoVQmF0Y2hHZXRVc2Vyc1Jlc3BvbnNlEiAKBXVzZXJzGAEgAygLMhEuYnl0ZWJhc2UudjEuVXNlciJfChBMaXN0VXNlcnNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJEhQKDHNob3dfZGVsZXRlZBgDIAEoCBIOCgZmaWx0ZXIYBCABKAkiTgoRTGlzdFVzZXJzUmVzcG9uc2USIAoFdXNlcnMYASADKAsyES5ieXRlYmFzZS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSI5ChFDcmVhdGVVc2VyUmVxdWVzdBIkCgR1c2VyGAEgASgLMhEuYnl0ZWJhc2UudjEuVXNlckID4EECIuwBChFVcGRhdGVVc2VyUmVxdWVzdBIkCgR1c2VyGAEgASgLMhEuYnl0ZWJhc2UudjEuVXNlckID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCghvdHBfY29kZRgDIAEoCUgAiAEBEiIKGnJlZ2VuZXJhdGVfdGVtcF9tZmFfc2VjcmV0GAQgASgIEiEKGXJlZ2VuZXJhdGVfcmVjb3ZlcnlfY29kZXMYBSABKAgSFQoNYWxsb3dfbWlzc2luZxgGIAEoCEILCglfb3RwX2NvZGUiPAoRRGVsZXRlVXNlclJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFieXRlYmFzZS5jb20vVXNlciI+ChNVbmRlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIi0gQKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEDEiEKBXN0YXRlGAIgASgOMhIuYnl0ZWJhc2UudjEuU3RhdGUSDQoFZW1haWwYAyABKAkSFwoFdGl0bGUYBCABKAlCCLpIBXIDGMgBEigKCXVzZXJfdHlwZRgFIAEoDjIVLmJ5dGViYXNlLnYxLlVzZXJUeXBlEhUKCHBhc3N3b3JkGAcgASgJQgPgQQQSGAoLc2VydmljZV9rZXkYCCABKAlCA+BBBBITCgttZmFfZW5hYmxlZBgJIAEoCBIXCg90ZW1wX290cF9zZWNyZXQYCiABKAkSGwoTdGVtcF9yZWNvdmVyeV9jb2RlcxgLIAMoCRJAChx0ZW1wX290cF9zZWNyZXRfY3JlYXRlZF90aW1lGA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVwaG9uZRgMIAEoCRIqCgdwcm9maWxlGA0gASgLMhkuYnl0ZWJhc2UudjEuVXNlci5Qcm9maWxlEhMKBmdyb3VwcxgOIAMoCUID4EEDGo0BCgdQcm9maWxlEjMKD2xhc3RfbG9naW5fdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPQoZbGFzdF9jaGFuZ2VfcGFzc3dvcmRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGc291cmNlGAMgASgJOiTqQSEKEWJ5dGViYXNlLmNvbS9Vc2VyEgx1c2Vycy97dXNlcn0qVAoIVXNlclR5cGUSGQoVVVNFUl9UWVBFX1VOU1BFQ0lGSUVEEAASCAoEVVNFUhABEg4KClNZU1RFTV9CT1QQAhITCg9TRVJWSUNFX0FDQ09VTlQQAzK3BwoLVXNlclNlcnZpY2UScAoHR2V0VXNlchIbLmJ5dGViYXNlLnYxLkdldFVzZXJSZXF1ZXN0GhEuYnl0ZWJhc2UudjEuVXNlciI12kEEbmFtZYrqMAxiYi51c2Vycy5nZXSQ6jABgtPkkwIUEhIvdjEve25hbWU9dXNlcnMvKn0ShgEKDUJhdGNoR2V0VXNlcnMSIS5ieXRlYmFzZS52MS5CYXRjaEdldFVzZXJzUmVxdWVzdBoiLmJ5dGViYXNlLnYxLkJhdGNoR2V0VXNlcnNSZXNwb25zZSIuiuowDGJiLnVzZXJzLmdldJDqMAGC0+STAhQSEi92MS91c2VyczpiYXRjaEdldBJZCg5HZXRDdXJyZW50VXNlchIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoRLmJ5dGViYXNlLnYxLlVzZXIiHIDqMAGQ6jACgtPkkwIOEgwvdjEvdXNlcnMvbWUSewoJTGlzdFVzZXJzEh0uYnl0ZWJhc2UudjEuTGlzdFVzZXJzUmVxdWVzdBoeLmJ5dGViYXNlLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlIi/aQQZwYXJlbnSK6jANYmIudXNlcnMubGlzdJDqMAGC0+STAgsSCS92MS91c2VycxJrCgpDcmVhdGVVc2VyEh4uYnl0ZWJhc2UudjEuQ3JlYXRlVXNlclJlcXVlc3QaES5ieXRlYmFzZS52MS5Vc2VyIiraQQR1c2VygOowAZDqMAKY6jABgtPkkwIROgR1c2VyIgkvdjEvdXNlcnMSgQEKClVwZGF0ZVVzZXISHi5ieXRlYmFzZS52MS5VcGRhdGVVc2VyUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlVzZXIiQNpBEHVzZXIsdXBkYXRlX21hc2uQ6jACmOowAYLT5JMCHzoEdXNlcjIXL3YxL3t1c2VyLm5hbWU9dXNlcnMvKn0SbwoKRGVsZXRlVXNlchIeLmJ5dGViYXNlLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IinaQQRuYW1lkOowApjqMAGC0+STAhQqEi92MS97bmFtZT11c2Vycy8qfRJzCgxVbmRlbGV0ZVVzZXISIC5ieXRlYmFzZS52MS5VbmRlbGV0ZVVzZXJSZXF1ZXN0GhEuYnl0ZWJhc2UudjEuVXNlciIukOowApjqMAGC0+STAiA6ASoiGy92MS97bmFtZT11c2Vycy8qfTp1bmRlbGV0ZUKmAQoPY29tLmJ5dGViYXNlLnYxQhBVc2VyU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common]);
+  fileDesc("ChV2MS91c2VyX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIjkKDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIiQAoUQmF0Y2hHZXRVc2Vyc1JlcXVlc3QSKAoFbmFtZXMYASADKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIiOQoVQmF0Y2hHZXRVc2Vyc1Jlc3BvbnNlEiAKBXVzZXJzGAEgAygLMhEuYnl0ZWJhc2UudjEuVXNlciJfChBMaXN0VXNlcnNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJEhQKDHNob3dfZGVsZXRlZBgDIAEoCBIOCgZmaWx0ZXIYBCABKAkiTgoRTGlzdFVzZXJzUmVzcG9uc2USIAoFdXNlcnMYASADKAsyES5ieXRlYmFzZS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSI5ChFDcmVhdGVVc2VyUmVxdWVzdBIkCgR1c2VyGAEgASgLMhEuYnl0ZWJhc2UudjEuVXNlckID4EECIuwBChFVcGRhdGVVc2VyUmVxdWVzdBIkCgR1c2VyGAEgASgLMhEuYnl0ZWJhc2UudjEuVXNlckID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCghvdHBfY29kZRgDIAEoCUgAiAEBEiIKGnJlZ2VuZXJhdGVfdGVtcF9tZmFfc2VjcmV0GAQgASgIEiEKGXJlZ2VuZXJhdGVfcmVjb3ZlcnlfY29kZXMYBSABKAgSFQoNYWxsb3dfbWlzc2luZxgGIAEoCEILCglfb3RwX2NvZGUiPAoRRGVsZXRlVXNlclJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFieXRlYmFzZS5jb20vVXNlciI+ChNVbmRlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIi0gQKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEDEiEKBXN0YXRlGAIgASgOMhIuYnl0ZWJhc2UudjEuU3RhdGUSDQoFZW1haWwYAyABKAkSFwoFdGl0bGUYBCABKAlCCLpIBXIDGMgBEigKCXVzZXJfdHlwZRgFIAEoDjIVLmJ5dGViYXNlLnYxLlVzZXJUeXBlEhUKCHBhc3N3b3JkGAcgASgJQgPgQQQSGAoLc2VydmljZV9rZXkYCCABKAlCA+BBBBITCgttZmFfZW5hYmxlZBgJIAEoCBIXCg90ZW1wX290cF9zZWNyZXQYCiABKAkSGwoTdGVtcF9yZWNvdmVyeV9jb2RlcxgLIAMoCRJAChx0ZW1wX290cF9zZWNyZXRfY3JlYXRlZF90aW1lGA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVwaG9uZRgMIAEoCRIqCgdwcm9maWxlGA0gASgLMhkuYnl0ZWJhc2UudjEuVXNlci5Qcm9maWxlEhMKBmdyb3VwcxgOIAMoCUID4EEDGo0BCgdQcm9maWxlEjMKD2xhc3RfbG9naW5fdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPQoZbGFzdF9jaGFuZ2VfcGFzc3dvcmRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGc291cmNlGAMgASgJOiTqQSEKEWJ5dGViYXNlLmNvbS9Vc2VyEgx1c2Vycy97dXNlcn0i5wIKE1BlcnNvbmFsQWNjZXNzVG9rZW4SEQoEbmFtZRgBIAEoCUID4EEDEhIKBXRpdGxlGAIgASgJQgPgQQISEgoFdG9rZW4YAyABKAlCA+BBAxITCgtwZXJtaXNzaW9ucxgEIAMoCRIvCgtleHBpcmVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoLY3JlYXRlX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNwoObGFzdF91c2VkX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6YOpBXQogYnl0ZWJhc2UuY29tL1BlcnNvbmFsQWNjZXNzVG9rZW4SOXVzZXJzL3t1c2VyfS9wZXJzb25hbEFjY2Vzc1Rva2Vucy97cGVyc29uYWxfYWNjZXNzX3Rva2VufSKTAQogQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9Vc2VyEkQKFXBlcnNvbmFsX2FjY2Vzc190b2tlbhgCIAEoCzIgLmJ5dGViYXNlLnYxLlBlcnNvbmFsQWNjZXNzVG9rZW5CA+BBAiJMCh9MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFieXRlYmFzZS5jb20vVXNlciJkCiBMaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXNwb25zZRJAChZwZXJzb25hbF9hY2Nlc3NfdG9rZW5zGAEgAygLMiAuYnl0ZWJhc2UudjEuUGVyc29uYWxBY2Nlc3NUb2tlbiJaCiBSZXZva2VQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBI2CgRuYW1lGAEgASgJQijgQQL6QSIKIGJ5dGViYXNlLmNvbS9QZXJzb25hbEFjY2Vzc1Rva2VuIoMCChJXZWJBdXRobkNyZWRlbnRpYWwSEQoEbmFtZRgBIAEoCUID4EEDEg0KBXRpdGxlGAIgASgJEjQKC2NyZWF0ZV90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjcKDmxhc3RfdXNlZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOlzqQVkKH2J5dGViYXNlLmNvbS9XZWJBdXRobkNyZWRlbnRpYWwSNnVzZXJzL3t1c2VyfS93ZWJBdXRobkNyZWRlbnRpYWxzL3t3ZWJhdXRobl9jcmVkZW50aWFsfSJLCiBCZWdpbldlYkF1dGhuUmVnaXN0cmF0aW9uUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9Vc2VyIjQKIUJlZ2luV2ViQXV0aG5SZWdpc3RyYXRpb25SZXNwb25zZRIPCgdvcHRpb25zGAEgASgJIoQBCh9DcmVhdGVXZWJBdXRobkNyZWRlbnRpYWxSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFieXRlYmFzZS5jb20vVXNlchISCgV0aXRsZRgCIAEoCUID4EECEiIKFXJlZ2lzdHJhdGlvbl9yZXNwb25zZRgDIAEoCUID4EECIksKHkxpc3RXZWJBdXRobkNyZWRlbnRpYWxzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1VzZXIiYAofTGlzdFdlYkF1dGhuQ3JlZGVudGlhbHNSZXNwb25zZRI9ChR3ZWJhdXRobl9jcmVkZW50aWFscxgBIAMoCzIfLmJ5dGViYXNlLnYxLldlYkF1dGhuQ3JlZGVudGlhbCJYCh9EZWxldGVXZWJBdXRobkNyZWRlbnRpYWxSZXF1ZXN0EjUKBG5hbWUYASABKAlCJ+BBAvpBIQofYnl0ZWJhc2UuY29tL1dlYkF1dGhuQ3JlZGVudGlhbCpUCghVc2VyVHlwZRIZChVVU0VSX1RZUEVfVU5TUEVDSUZJRUQQABIICgRVU0VSEAESDgoKU1lTVEVNX0JPVBACEhMKD1NFUlZJQ0VfQUNDT1VOVBADMusRCgtVc2VyU2VydmljZRJwCgdHZXRVc2VyEhsuYnl0ZWJhc2UudjEuR2V0VXNlclJlcXVlc3QaES5ieXRlYmFzZS52MS5Vc2VyIjXaQQRuYW1liuowDGJiLnVzZXJzLmdldJDqMAGC0+STAhQSEi92MS97bmFtZT11c2Vycy8qfRKGAQoNQmF0Y2hHZXRVc2VycxIhLmJ5dGViYXNlLnYxLkJhdGNoR2V0VXNlcnNSZXF1ZXN0GiIuYnl0ZWJhc2UudjEuQmF0Y2hHZXRVc2Vyc1Jlc3BvbnNlIi6K6jAMYmIudXNlcnMuZ2V0kOowAYLT5JMCFBISL3YxL3VzZXJzOmJhdGNoR2V0ElkKDkdldEN1cnJlbnRVc2VyEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhEuYnl0ZWJhc2UudjEuVXNlciIcgOowAZDqMAKC0+STAg4SDC92MS91c2Vycy9tZRJ7CglMaXN0VXNlcnMSHS5ieXRlYmFzZS52MS5MaXN0VXNlcnNSZXF1ZXN0Gh4uYnl0ZWJhc2UudjEuTGlzdFVzZXJzUmVzcG9uc2UiL9pBBnBhcmVudIrqMA1iYi51c2Vycy5saXN0kOowAYLT5JMCCxIJL3YxL3VzZXJzEmsKCkNyZWF0ZVVzZXISHi5ieXRlYmFzZS52MS5DcmVhdGVVc2VyUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlVzZXIiKtpBBHVzZXKA6jABkOowApjqMAGC0+STAhE6BHVzZXIiCS92MS91c2VycxKBAQoKVXBkYXRlVXNlchIeLmJ5dGViYXNlLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0GhEuYnl0ZWJhc2UudjEuVXNlciJA2kEQdXNlcix1cGRhdGVfbWFza5DqMAKY6jABgtPkkwIfOgR1c2VyMhcvdjEve3VzZXIubmFtZT11c2Vycy8qfRJvCgpEZWxldGVVc2VyEh4uYnl0ZWJhc2UudjEuRGVsZXRlVXNlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiKdpBBG5hbWWQ6jACmOowAYLT5JMCFCoSL3YxL3tuYW1lPXVzZXJzLyp9EnMKDFVuZGVsZXRlVXNlchIgLmJ5dGViYXNlLnYxLlVuZGVsZXRlVXNlclJlcXVlc3QaES5ieXRlYmFzZS52MS5Vc2VyIi6Q6jACmOowAYLT5JMCIDoBKiIbL3YxL3tuYW1lPXVzZXJzLyp9OnVuZGVsZXRlEt0BChlDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuEi0uYnl0ZWJhc2UudjEuQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QaIC5ieXRlYmFzZS52MS5QZXJzb25hbEFjY2Vzc1Rva2VuIm/aQRxwYXJlbnQscGVyc29uYWxfYWNjZXNzX3Rva2VukOowApjqMAGC0+STAkI6FXBlcnNvbmFsX2FjY2Vzc190b2tlbiIpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMStwEKGExpc3RQZXJzb25hbEFjY2Vzc1Rva2VucxIsLmJ5dGViYXNlLnYxLkxpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1JlcXVlc3QaLS5ieXRlYmFzZS52MS5MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXNwb25zZSI+2kEGcGFyZW50kOowAoLT5JMCKxIpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMSrgEKGVJldm9rZVBlcnNvbmFsQWNjZXNzVG9rZW4SLS5ieXRlYmFzZS52MS5SZXZva2VQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSJK2kEEbmFtZZDqMAKY6jABgtPkkwI1OgEqIjAvdjEve25hbWU9dXNlcnMvKi9wZXJzb25hbEFjY2Vzc1Rva2Vucy8qfTpyZXZva2USvgEKGUJlZ2luV2ViQXV0aG5SZWdpc3RyYXRpb24SLS5ieXRlYmFzZS52MS5CZWdpbldlYkF1dGhuUmVnaXN0cmF0aW9uUmVxdWVzdBouLmJ5dGViYXNlLnYxLkJlZ2luV2ViQXV0aG5SZWdpc3RyYXRpb25SZXNwb25zZSJC2kEEbmFtZZDqMAKC0+STAjE6ASoiLC92MS97bmFtZT11c2Vycy8qfTpiZWdpbldlYkF1dGhuUmVnaXN0cmF0aW9uEssBChhDcmVhdGVXZWJBdXRobkNyZWRlbnRpYWwSLC5ieXRlYmFzZS52MS5DcmVhdGVXZWJBdXRobkNyZWRlbnRpYWxSZXF1ZXN0Gh8uYnl0ZWJhc2UudjEuV2ViQXV0aG5DcmVkZW50aWFsImDaQSJwYXJlbnQsdGl0bGUscmVnaXN0cmF0aW9uX3Jlc3BvbnNlkOowApjqMAGC0+STAi06ASoiKC92MS97cGFyZW50PXVzZXJzLyp9L3dlYkF1dGhuQ3JlZGVudGlhbHMSswEKF0xpc3RXZWJBdXRobkNyZWRlbnRpYWxzEisuYnl0ZWJhc2UudjEuTGlzdFdlYkF1dGhuQ3JlZGVudGlhbHNSZXF1ZXN0GiwuYnl0ZWJhc2UudjEuTGlzdFdlYkF1dGhuQ3JlZGVudGlhbHNSZXNwb25zZSI92kEGcGFyZW50kOowAoLT5JMCKhIoL3YxL3twYXJlbnQ9dXNlcnMvKn0vd2ViQXV0aG5DcmVkZW50aWFscxKhAQoYRGVsZXRlV2ViQXV0aG5DcmVkZW50aWFsEiwuYnl0ZWJhc2UudjEuRGVsZXRlV2ViQXV0aG5DcmVkZW50aWFsUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI/2kEEbmFtZZDqMAKY6jABgtPkkwIqKigvdjEve25hbWU9dXNlcnMvKi93ZWJBdXRobkNyZWRlbnRpYWxzLyp9QqYBCg9jb20uYnl0ZWJhc2UudjFCEFVzZXJTZXJ2aWNlUHJvdG9QAVo0Z2l0aHViLmNvbS9ieXRlYmFzZS9ieXRlYmFzZS9iYWNrZW5kL2dlbmVyYXRlZC1nby92MaICA0JYWKoCC0J5dGViYXNlLlYxygILQnl0ZWJhc2VcVjHiAhdCeXRlYmFzZVxWMVxHUEJNZXRhZGF0YeoCDEJ5dGViYXNlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common]);
 
 /**
  * Describes the message bytebase.v1.GetUserRequest.
@@ -95,6 +95,90 @@ export const UserSchema = /*@__PURE__*/
 export const User_ProfileSchema = /*@__PURE__*/
   messageDesc(file_v1_user_service, 9, 0);
 
+/**
+ * Describes the message bytebase.v1.PersonalAccessToken.
+ * Use `create(PersonalAccessTokenSchema)` to create a new message.
+ */
+export const PersonalAccessTokenSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 10);
+
+/**
+ * Describes the message bytebase.v1.CreatePersonalAccessTokenRequest.
+ * Use `create(CreatePersonalAccessTokenRequestSchema)` to create a new message.
+ */
+export const CreatePersonalAccessTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 11);
+
+/**
+ * Describes the message bytebase.v1.ListPersonalAccessTokensRequest.
+ * Use `create(ListPersonalAccessTokensRequestSchema)` to create a new message.
+ */
+export const ListPersonalAccessTokensRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 12);
+
+/**
+ * Describes the message bytebase.v1.ListPersonalAccessTokensResponse.
+ * Use `create(ListPersonalAccessTokensResponseSchema)` to create a new message.
+ */
+export const ListPersonalAccessTokensResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 13);
+
+/**
+ * Describes the message bytebase.v1.RevokePersonalAccessTokenRequest.
+ * Use `create(RevokePersonalAccessTokenRequestSchema)` to create a new message.
+ */
+export const RevokePersonalAccessTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 14);
+
+/**
+ * Describes the message bytebase.v1.WebAuthnCredential.
+ * Use `create(WebAuthnCredentialSchema)` to create a new message.
+ */
+export const WebAuthnCredentialSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 15);
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnRegistrationRequest.
+ * Use `create(BeginWebAuthnRegistrationRequestSchema)` to create a new message.
+ */
+export const BeginWebAuthnRegistrationRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 16);
+
+/**
+ * Describes the message bytebase.v1.BeginWebAuthnRegistrationResponse.
+ * Use `create(BeginWebAuthnRegistrationResponseSchema)` to create a new message.
+ */
+export const BeginWebAuthnRegistrationResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 17);
+
+/**
+ * Describes the message bytebase.v1.CreateWebAuthnCredentialRequest.
+ * Use `create(CreateWebAuthnCredentialRequestSchema)` to create a new message.
+ */
+export const CreateWebAuthnCredentialRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 18);
+
+/**
+ * Describes the message bytebase.v1.ListWebAuthnCredentialsRequest.
+ * Use `create(ListWebAuthnCredentialsRequestSchema)` to create a new message.
+ */
+export const ListWebAuthnCredentialsRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 19);
+
+/**
+ * Describes the message bytebase.v1.ListWebAuthnCredentialsResponse.
+ * Use `create(ListWebAuthnCredentialsResponseSchema)` to create a new message.
+ */
+export const ListWebAuthnCredentialsResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 20);
+
+/**
+ * Describes the message bytebase.v1.DeleteWebAuthnCredentialRequest.
+ * Use `create(DeleteWebAuthnCredentialRequestSchema)` to create a new message.
+ */
+export const DeleteWebAuthnCredentialRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_user_service, 21);
+
 /**
  * Describes the enum bytebase.v1.UserType.
  */
@@ -85,6 +85,14 @@ export const projectWebhookV1TypeItemList = (): ProjectWebhookV1TypeItem[] => {
       docUrl: "https://open.work.weixin.qq.com/help2/pc/14931",
       supportDirectMessage: true,
     },
+    {
+      type: Webhook_Type.GENERIC,
+      name: t("common.generic-webhook"),
+      urlPrefix: "",
+      urlPlaceholder: "https://example.com/bytebase-webhook",
+      docUrl: "https://docs.bytebase.com/change-database/webhook",
+      supportDirectMessage: false,
+    },
   ];
 };
 
@@ -160,5 +168,27 @@ export const projectWebhookV1ActivityItemList =
         activity: Activity_Type.NOTIFY_PIPELINE_ROLLOUT,
         supportDirectMessage: true,
       },
+      {
+        title: t(
+          "project.webhook.activity-item.notify-rollout-window-open.title"
+        ),
+        label: t(
+          "project.webhook.activity-item.notify-rollout-window-open.label"
+        ),
+        activity: Activity_Type.NOTIFY_ROLLOUT_WINDOW_OPEN,
+        supportDirectMessage: false,
+      },
+      {
+        title: t("project.webhook.activity-item.notify-break-glass.title"),
+        label: t("project.webhook.activity-item.notify-break-glass.label"),
+        activity: Activity_Type.NOTIFY_BREAK_GLASS,
+        supportDirectMessage: false,
+      },
+      {
+        title: t("project.webhook.activity-item.notify-schema-drift.title"),
+        label: t("project.webhook.activity-item.notify-schema-drift.label"),
+        activity: Activity_Type.NOTIFY_SCHEMA_DRIFT,
+        supportDirectMessage: false,
+      },
     ];
   };
@@ -5,6 +5,7 @@ import {
   AUTH_OIDC_CALLBACK_MODULE,
   AUTH_PASSWORD_FORGOT_MODULE,
   AUTH_PASSWORD_RESET_MODULE,
+  AUTH_SAML_CALLBACK_MODULE,
   AUTH_SIGNIN_MODULE,
   AUTH_SIGNUP_MODULE,
 } from "@/router/auth";
@@ -18,6 +19,7 @@ export const isAuthRelatedRoute = (routeName: string) => {
     AUTH_PASSWORD_FORGOT_MODULE,
     AUTH_OAUTH_CALLBACK_MODULE,
     AUTH_OIDC_CALLBACK_MODULE,
+    AUTH_SAML_CALLBACK_MODULE,
     AUTH_IDP_INIT_MODULE,
   ].includes(routeName);
 };
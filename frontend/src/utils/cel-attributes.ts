@@ -18,6 +18,11 @@ export const CEL_ATTRIBUTE_STATEMENT_AFFECTED_ROWS = "statement.affected_rows";
 export const CEL_ATTRIBUTE_STATEMENT_TABLE_ROWS = "statement.table_rows";
 export const CEL_ATTRIBUTE_STATEMENT_SQL_TYPE = "statement.sql_type";
 export const CEL_ATTRIBUTE_STATEMENT_TEXT = "statement.text";
+export const CEL_ATTRIBUTE_STATEMENT_LOCK_LEVEL = "statement.lock_level";
+export const CEL_ATTRIBUTE_STATEMENT_LOCK_BLOCKING_SECONDS =
+  "statement.lock_blocking_seconds";
+export const CEL_ATTRIBUTE_STATEMENT_REWRITTEN_TABLE_ROWS =
+  "statement.rewritten_table_rows";
 
 // CEL attribute names for request scope.
 export const CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS = "request.expiration_days";
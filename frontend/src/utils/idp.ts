@@ -10,6 +10,8 @@ export const identityProviderTypeToString = (
     return "OIDC";
   } else if (type == IdentityProviderType.LDAP) {
     return "LDAP";
+  } else if (type == IdentityProviderType.SAML) {
+    return "SAML";
   } else {
     throw new Error(`identity provider type ${type} not found`);
   }
@@ -23,6 +23,7 @@ export * from "./sheet";
 export * from "./naive-ui";
 export * from "./useDashboardSidebar";
 export * from "./release";
+export * from "./webauthn";
 
 export * from "./v1";
 export * from "./iam";
@@ -1,3 +1,4 @@
+import { getIdentityProviderResourceId } from "@/store/modules/v1/common";
 import type { OAuthState } from "@/types";
 import type { IdentityProvider } from "@/types/proto-es/v1/idp_service_pb";
 import { IdentityProviderType } from "@/types/proto-es/v1/idp_service_pb";
@@ -163,6 +164,13 @@ export async function openWindowForSSO(
       scope: oidcConfig.scopes.join(" "),
       redirect_uri: `${window.location.origin}/oidc/callback`,
     });
+  } else if (identityProvider.type === IdentityProviderType.SAML) {
+    // The SP endpoint signs the AuthnRequest and redirects to the IdP.
+    // The state token is passed as the relay state and returned to /saml/callback.
+    uri.basePath = `${window.location.origin}/saml/idps/${getIdentityProviderResourceId(identityProvider.name)}/sso`;
+    uri.query = {
+      relay_state: token,
+    };
   } else {
     throw new Error(
       `identity provider type ${identityProvider.type.toString()} is not supported`
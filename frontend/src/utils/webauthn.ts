@@ -0,0 +1,109 @@
+// Helpers for the WebAuthn ceremonies. The server exchanges the options and the
+// responses in the WebAuthn JSON format, where the binary fields are base64url encoded.
+
+const base64URLToBuffer = (value: string): ArrayBuffer => {
+  const base64 = value.replace(/-/g, "+").replace(/_/g, "/");
+  const padded = base64.padEnd(
+    base64.length + ((4 - (base64.length % 4)) % 4),
+    "="
+  );
+  const binary = atob(padded);
+  const bytes = new Uint8Array(binary.length);
+  for (let i = 0; i < binary.length; i++) {
+    bytes[i] = binary.charCodeAt(i);
+  }
+  return bytes.buffer;
+};
+
+const bufferToBase64URL = (buffer: ArrayBuffer): string => {
+  const bytes = new Uint8Array(buffer);
+  let binary = "";
+  for (const byte of bytes) {
+    binary += String.fromCharCode(byte);
+  }
+  return btoa(binary)
+    .replace(/\+/g, "-")
+    .replace(/\//g, "_")
+    .replace(/=+$/, "");
+};
+
+export const isWebAuthnSupported = () => {
+  return (
+    typeof window !== "undefined" &&
+    window.isSecureContext &&
+    typeof window.PublicKeyCredential !== "undefined"
+  );
+};
+
+// createWebAuthnCredential runs navigator.credentials.create() with the creation options
+// in JSON and returns the RegistrationResponseJSON.
+export const createWebAuthnCredential = async (
+  optionsJSON: string
+): Promise<string> => {
+  const options = JSON.parse(optionsJSON);
+  const publicKey: PublicKeyCredentialCreationOptions = {
+    ...options,
+    challenge: base64URLToBuffer(options.challenge),
+    user: { ...options.user, id: base64URLToBuffer(options.user.id) },
+    excludeCredentials: (options.excludeCredentials ?? []).map(
+      (c: { type: PublicKeyCredentialType; id: string }) => ({
+        type: c.type,
+        id: base64URLToBuffer(c.id),
+      })
+    ),
+  };
+  const credential = (await navigator.credentials.create({
+    publicKey,
+  })) as PublicKeyCredential | null;
+  if (!credential) {
+    throw new Error("No credential is created");
+  }
+  const response = credential.response as AuthenticatorAttestationResponse;
+  return JSON.stringify({
+    id: credential.id,
+    rawId: bufferToBase64URL(credential.rawId),
+    type: credential.type,
+    response: {
+      clientDataJSON: bufferToBase64URL(response.clientDataJSON),
+      attestationObject: bufferToBase64URL(response.attestationObject),
+    },
+  });
+};
+
+// getWebAuthnAssertion runs navigator.credentials.get() with the request options
+// in JSON and returns the AuthenticationResponseJSON.
+export const getWebAuthnAssertion = async (
+  optionsJSON: string
+): Promise<string> => {
+  const options = JSON.parse(optionsJSON);
+  const publicKey: PublicKeyCredentialRequestOptions = {
+    ...options,
+    challenge: base64URLToBuffer(options.challenge),
+    allowCredentials: (options.allowCredentials ?? []).map(
+      (c: { type: PublicKeyCredentialType; id: string }) => ({
+        type: c.type,
+        id: base64URLToBuffer(c.id),
+      })
+    ),
+  };
+  const credential = (await navigator.credentials.get({
+    publicKey,
+  })) as PublicKeyCredential | null;
+  if (!credential) {
+    throw new Error("No credential is selected");
+  }
+  const response = credential.response as AuthenticatorAssertionResponse;
+  return JSON.stringify({
+    id: credential.id,
+    rawId: bufferToBase64URL(credential.rawId),
+    type: credential.type,
+    response: {
+      clientDataJSON: bufferToBase64URL(response.clientDataJSON),
+      authenticatorData: bufferToBase64URL(response.authenticatorData),
+      signature: bufferToBase64URL(response.signature),
+      userHandle: response.userHandle
+        ? bufferToBase64URL(response.userHandle)
+        : undefined,
+    },
+  });
+};